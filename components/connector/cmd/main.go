@@ -11,6 +11,7 @@ import (
 	"github.com/kyma-incubator/compass/components/connector/config"
 	"github.com/kyma-incubator/compass/components/connector/internal/api"
 	"github.com/kyma-incubator/compass/components/connector/internal/authentication"
+	"github.com/kyma-incubator/compass/components/connector/internal/certstatus"
 	"github.com/kyma-incubator/compass/components/director/pkg/correlation"
 	"github.com/kyma-incubator/compass/components/director/pkg/graphql_client"
 	"github.com/kyma-incubator/compass/components/director/pkg/log"
@@ -47,22 +48,30 @@ func main() {
 	exitOnError(appErr, "Failed to initialize Kubernetes client.")
 
 	directorGCLI := graphql_client.NewGraphQLClient(cfg.OneTimeTokenURL, cfg.HTTPClientTimeout)
-	internalComponents, certsLoader, revokedCertsLoader := config.InitInternalComponents(cfg, k8sClientSet, directorGCLI)
+	internalComponents, certsLoader, revokedCertsLoader, issuanceLoader := config.InitInternalComponents(cfg, k8sClientSet, directorGCLI)
 	go certsLoader.Run(ctx)
 	go revokedCertsLoader.Run(ctx)
+	go issuanceLoader.Run(ctx)
+
+	certificateProfiles, err := config.NewCertificateProfiles(cfg)
+	exitOnError(err, "Failed to parse certificate profiles")
 
 	certificateResolver := api.NewCertificateResolver(
 		internalComponents.Authenticator,
 		internalComponents.TokenService,
 		internalComponents.CertificateService,
 		internalComponents.CSRSubjectConsts,
+		certificateProfiles,
 		cfg.DirectorURL,
 		cfg.CertificateSecuredConnectorURL,
-		internalComponents.RevokedCertsRepository)
+		internalComponents.RevokedCertsRepository,
+		internalComponents.IssuanceRepository)
+
+	certStatusHandler := certstatus.NewHandler(internalComponents.CertificateService, internalComponents.IssuanceRepository, cfg.CRLValidityTime)
 
 	authContextMiddleware := authentication.NewAuthenticationContextMiddleware()
 
-	externalGqlServer, err := config.PrepareExternalGraphQLServer(cfg, certificateResolver, correlation.AttachCorrelationIDToContext(), log.RequestLogger(), authContextMiddleware.PropagateAuthentication)
+	externalGqlServer, err := config.PrepareExternalGraphQLServer(cfg, certificateResolver, certStatusHandler, correlation.AttachCorrelationIDToContext(), log.RequestLogger(), authContextMiddleware.PropagateAuthentication)
 	exitOnError(err, "Failed configuring external graphQL handler")
 
 	hydratorServer, err := config.PrepareHydratorServer(cfg, internalComponents.CSRSubjectConsts, internalComponents.RevokedCertsRepository, correlation.AttachCorrelationIDToContext(), log.RequestLogger())
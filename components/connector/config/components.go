@@ -7,6 +7,7 @@ import (
 
 	"github.com/kyma-incubator/compass/components/connector/internal/authentication"
 	"github.com/kyma-incubator/compass/components/connector/internal/certificates"
+	"github.com/kyma-incubator/compass/components/connector/internal/issuance"
 	"github.com/kyma-incubator/compass/components/connector/internal/namespacedname"
 	"github.com/kyma-incubator/compass/components/connector/internal/revocation"
 	"github.com/kyma-incubator/compass/components/connector/internal/secrets"
@@ -20,18 +21,29 @@ type Components struct {
 
 	CertificateService     certificates.Service
 	RevokedCertsRepository revocation.RevokedCertificatesRepository
+	IssuanceRepository     issuance.Repository
 
 	CSRSubjectConsts certificates.CSRSubjectConsts
 }
 
-func InitInternalComponents(cfg Config, k8sClientSet kubernetes.Interface, directorGCLI tokens.GraphQLClient) (Components, certificates.Loader, revocation.Loader) {
+func InitInternalComponents(cfg Config, k8sClientSet kubernetes.Interface, directorGCLI tokens.GraphQLClient) (Components, certificates.Loader, revocation.Loader, issuance.Loader) {
 	caSecret := namespacedname.Parse(cfg.CASecret.Name)
 	rootCASecret := namespacedname.Parse(cfg.RootCASecret.Name)
 
+	issuanceCache := issuance.NewCache()
+	issuedCertsConfigMaps := k8sClientSet.CoreV1().ConfigMaps(cfg.IssuedCertificatesNamespace)
+	issuanceRepository := issuance.NewRepository(issuedCertsConfigMaps, issuanceCache)
+	issuanceLoader := issuance.NewLoader(issuanceCache,
+		issuedCertsConfigMaps,
+		time.Second,
+		cfg.IssuedCertificatesPruneInterval,
+	)
+
 	certsCache := certificates.NewCertificateCache()
 	certsService := certificates.NewCertificateService(
 		certsCache,
-		certificates.NewCertificateUtility(cfg.CertificateValidityTime),
+		certificates.NewCertificateUtility(cfg.CRLURL, cfg.OCSPURL),
+		issuanceRepository,
 		caSecret.Name,
 		rootCASecret.Name,
 		cfg.CASecret.CertificateKey,
@@ -54,8 +66,18 @@ func InitInternalComponents(cfg Config, k8sClientSet kubernetes.Interface, direc
 		TokenService:           tokens.NewTokenService(directorGCLI),
 		CertificateService:     certsService,
 		RevokedCertsRepository: revokedCertsRepository,
+		IssuanceRepository:     issuanceRepository,
 		CSRSubjectConsts:       newCSRSubjectConsts(cfg),
-	}, certsLoader, revokedCertsLoader
+	}, certsLoader, revokedCertsLoader, issuanceLoader
+}
+
+// NewCertificateProfiles returns the configured certificate profiles, or a single default profile if none are configured
+func NewCertificateProfiles(cfg Config) (certificates.Profiles, error) {
+	if cfg.CertificateProfiles == "" {
+		return certificates.NewDefaultProfiles(cfg.CertificateValidityTime), nil
+	}
+
+	return certificates.ParseProfiles(cfg.CertificateProfiles, cfg.CertificateValidityTime)
 }
 
 func newRevokedCertsRepository(k8sClientSet kubernetes.Interface, revokedCertsConfigMap types.NamespacedName, revokedCertsCache revocation.Cache) revocation.RevokedCertificatesRepository {
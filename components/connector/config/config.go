@@ -26,6 +26,7 @@ type Config struct {
 		Province           string `envconfig:"default=State"`
 	}
 	CertificateValidityTime time.Duration `envconfig:"default=2160h"`
+	CertificateProfiles     string        `envconfig:"optional"`
 	CASecret                struct {
 		Name           string `envconfig:"default=kyma-integration/connector-service-app-ca"`
 		CertificateKey string `envconfig:"default=ca.crt"`
@@ -39,6 +40,12 @@ type Config struct {
 	CertificateDataHeader   string `envconfig:"default=Certificate-Data"`
 	RevocationConfigMapName string `envconfig:"default=compass-system/revocations-Config"`
 
+	IssuedCertificatesNamespace     string        `envconfig:"default=compass-system"`
+	IssuedCertificatesPruneInterval time.Duration `envconfig:"default=1h"`
+	CRLValidityTime                 time.Duration `envconfig:"default=24h"`
+	CRLURL                          string        `envconfig:"optional"`
+	OCSPURL                         string        `envconfig:"optional"`
+
 	DirectorURL                    string `envconfig:"default=127.0.0.1:3003"`
 	CertificateSecuredConnectorURL string `envconfig:"default=https://compass-gateway-mtls.kyma.local"`
 	KubernetesClient               struct {
@@ -55,20 +62,22 @@ func (c *Config) String() string {
 	return fmt.Sprintf("ExternalAddress: %s, APIEndpoint: %s, HydratorAddress: %s, "+
 		"CSRSubjectCountry: %s, CSRSubjectOrganization: %s, CSRSubjectOrganizationalUnit: %s, "+
 		"CSRSubjectLocality: %s, CSRSubjectProvince: %s, "+
-		"CertificateValidityTime: %s, CASecretName: %s, CASecretCertificateKey: %s, CASecretKeyKey: %s, "+
+		"CertificateValidityTime: %s, CertificateProfiles: %s, CASecretName: %s, CASecretCertificateKey: %s, CASecretKeyKey: %s, "+
 		"RootCASecretName: %s, RootCASecretCertificateKey: %s, CertificateDataHeader: %s, "+
 		"CertificateSecuredConnectorURL: %s, "+
 		"RevocationConfigMapName: %s, "+
+		"IssuedCertificatesNamespace: %s, IssuedCertificatesPruneInterval: %s, CRLValidityTime: %s, CRLURL: %s, OCSPURL: %s, "+
 		"DirectorURL: %s "+
 		"KubernetesClientPollInteval: %s, KubernetesClientPollTimeout: %s"+
 		"OneTimeTokenURL: %s, HTTPClienttimeout: %s",
 		c.ExternalAddress, c.APIEndpoint, c.HydratorAddress,
 		c.CSRSubject.Country, c.CSRSubject.Organization, c.CSRSubject.OrganizationalUnit,
 		c.CSRSubject.Locality, c.CSRSubject.Province,
-		c.CertificateValidityTime, c.CASecret.Name, c.CASecret.CertificateKey, c.CASecret.KeyKey,
+		c.CertificateValidityTime, c.CertificateProfiles, c.CASecret.Name, c.CASecret.CertificateKey, c.CASecret.KeyKey,
 		c.RootCASecret.Name, c.RootCASecret.CertificateKey, c.CertificateDataHeader,
 		c.CertificateSecuredConnectorURL,
 		c.RevocationConfigMapName,
+		c.IssuedCertificatesNamespace, c.IssuedCertificatesPruneInterval, c.CRLValidityTime, c.CRLURL, c.OCSPURL,
 		c.DirectorURL,
 		c.KubernetesClient.PollInteval, c.KubernetesClient.PollTimeout,
 		c.OneTimeTokenURL, c.HTTPClientTimeout)
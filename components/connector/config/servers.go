@@ -9,13 +9,14 @@ import (
 	"github.com/gorilla/mux"
 	"github.com/kyma-incubator/compass/components/connector/internal/api"
 	"github.com/kyma-incubator/compass/components/connector/internal/certificates"
+	"github.com/kyma-incubator/compass/components/connector/internal/certstatus"
 	"github.com/kyma-incubator/compass/components/connector/internal/healthz"
 	"github.com/kyma-incubator/compass/components/connector/internal/revocation"
 	"github.com/kyma-incubator/compass/components/connector/pkg/graphql/externalschema"
 	"github.com/kyma-incubator/compass/components/connector/pkg/oathkeeper"
 )
 
-func PrepareExternalGraphQLServer(cfg Config, certResolver api.CertificateResolver, middlewares ...mux.MiddlewareFunc) (*http.Server, error) {
+func PrepareExternalGraphQLServer(cfg Config, certResolver api.CertificateResolver, certStatusHandler certstatus.Handler, middlewares ...mux.MiddlewareFunc) (*http.Server, error) {
 	gqlInternalCfg := externalschema.Config{
 		Resolvers: &api.ExternalResolver{CertificateResolver: certResolver},
 	}
@@ -27,6 +28,11 @@ func PrepareExternalGraphQLServer(cfg Config, certResolver api.CertificateResolv
 	externalRouter.HandleFunc(cfg.APIEndpoint, handler.GraphQL(externalExecutableSchema))
 	externalRouter.HandleFunc("/healthz", healthz.NewHTTPHandler())
 
+	certificatesRouter := externalRouter.PathPrefix("/v1/certificates").Subrouter()
+	certificatesRouter.HandleFunc("/crl", certStatusHandler.CRL).Methods(http.MethodGet)
+	certificatesRouter.HandleFunc("/ocsp", certStatusHandler.OCSP).Methods(http.MethodPost)
+	certificatesRouter.HandleFunc("/ocsp/{"+certstatus.OCSPRequestPathVariable+":.+}", certStatusHandler.OCSP).Methods(http.MethodGet)
+
 	externalRouter.Use(middlewares...)
 
 	handlerWithTimeout, err := timeouthandler.WithTimeout(externalRouter, cfg.ServerTimeout)
@@ -3,12 +3,14 @@ package api
 import (
 	"context"
 	"encoding/base64"
+	"time"
 
 	"github.com/kyma-incubator/compass/components/director/pkg/log"
 
 	"github.com/kyma-incubator/compass/components/connector/internal/apperrors"
 	"github.com/kyma-incubator/compass/components/connector/internal/authentication"
 	"github.com/kyma-incubator/compass/components/connector/internal/certificates"
+	"github.com/kyma-incubator/compass/components/connector/internal/issuance"
 	"github.com/kyma-incubator/compass/components/connector/internal/revocation"
 	"github.com/kyma-incubator/compass/components/connector/internal/tokens"
 	"github.com/kyma-incubator/compass/components/connector/pkg/graphql/externalschema"
@@ -26,9 +28,11 @@ type certificateResolver struct {
 	tokenService                   tokens.Service
 	certificatesService            certificates.Service
 	csrSubjectConsts               certificates.CSRSubjectConsts
+	certificateProfiles            certificates.Profiles
 	directorURL                    string
 	certificateSecuredConnectorURL string
 	revokedCertsRepository         revocation.RevokedCertificatesRepository
+	issuanceRepository             issuance.Repository
 }
 
 func NewCertificateResolver(
@@ -36,17 +40,21 @@ func NewCertificateResolver(
 	tokenService tokens.Service,
 	certificatesService certificates.Service,
 	csrSubjectConsts certificates.CSRSubjectConsts,
+	certificateProfiles certificates.Profiles,
 	directorURL string,
 	certificateSecuredConnectorURL string,
-	revokedCertsRepository revocation.RevokedCertificatesRepository) CertificateResolver {
+	revokedCertsRepository revocation.RevokedCertificatesRepository,
+	issuanceRepository issuance.Repository) CertificateResolver {
 	return &certificateResolver{
 		authenticator:                  authenticator,
 		tokenService:                   tokenService,
 		certificatesService:            certificatesService,
 		csrSubjectConsts:               csrSubjectConsts,
+		certificateProfiles:            certificateProfiles,
 		directorURL:                    directorURL,
 		certificateSecuredConnectorURL: certificateSecuredConnectorURL,
 		revokedCertsRepository:         revokedCertsRepository,
+		issuanceRepository:             issuanceRepository,
 	}
 }
 
@@ -67,9 +75,20 @@ func (r *certificateResolver) Configuration(ctx context.Context) (*externalschem
 		return nil, errors.Wrap(err, "Failed to get one-time token during fetching configuration process")
 	}
 
+	profile := r.certificateProfile(ctx)
+	log.C(ctx).Debugf("Using certificate profile %s for client with id %s", profile.Name, clientId)
+
+	supportedKeyAlgorithms := make([]string, 0, len(profile.KeyAlgorithms))
+	for _, algorithm := range profile.KeyAlgorithms {
+		supportedKeyAlgorithms = append(supportedKeyAlgorithms, string(algorithm))
+	}
+
 	csrInfo := &externalschema.CertificateSigningRequestInfo{
-		Subject:      r.csrSubjectConsts.ToString(clientId),
-		KeyAlgorithm: "rsa2048",
+		Subject:                r.csrSubjectConsts.ToString(clientId),
+		KeyAlgorithm:           string(profile.PreferredKeyAlgorithm()),
+		SupportedKeyAlgorithms: supportedKeyAlgorithms,
+		Profile:                profile.Name,
+		Validity:               profile.Validity.String(),
 	}
 
 	log.C(ctx).Infof("Configuration for client with id %s successfully fetched.", clientId)
@@ -106,7 +125,10 @@ func (r *certificateResolver) SignCertificateSigningRequest(ctx context.Context,
 		CSRSubjectConsts: r.csrSubjectConsts,
 	}
 
-	encodedCertificates, err := r.certificatesService.SignCSR(ctx, rawCSR, subject)
+	profile := r.certificateProfile(ctx)
+	log.C(ctx).Debugf("Using certificate profile %s for client with id %s", profile.Name, clientId)
+
+	encodedCertificates, err := r.certificatesService.SignCSR(ctx, rawCSR, subject, profile)
 	if err != nil {
 		log.C(ctx).WithError(err).Errorf("Error occurred while signing the CSR with Common Name %s of client with id %s", subject.CommonName, clientId)
 		return nil, errors.Wrap(err, "Error while signing Certificate Signing Request")
@@ -136,10 +158,38 @@ func (r *certificateResolver) RevokeCertificate(ctx context.Context) (bool, erro
 		return false, errors.Wrap(err, "Failed to add hash to revocation list")
 	}
 
+	log.C(ctx).Debugf("Marking issuance record of certificate of client with id %s as revoked", clientId)
+	found, err := r.issuanceRepository.Revoke(certificateHash, time.Now())
+	if err != nil {
+		log.C(ctx).WithError(err).Errorf("Failed to mark issuance record of certificate of client with id %s as revoked.", clientId)
+		return false, errors.Wrap(err, "Failed to mark issuance record as revoked")
+	}
+	if !found {
+		log.C(ctx).Warnf("No issuance record found for certificate of client with id %s. The certificate will not be listed in the CRL.", clientId)
+	}
+
 	log.C(ctx).Infof("Certificate of client with id %s successfully revoked.", clientId)
 	return true, nil
 }
 
+// certificateProfile selects the certificate profile of the client. Clients authenticated with a one-time token get the profile of
+// their consumer type, while clients authenticated with a certificate get the profile with which the certificate has been issued.
+func (r *certificateResolver) certificateProfile(ctx context.Context) certificates.Profile {
+	if consumerType, err := authentication.GetStringFromContext(ctx, authentication.ClientTypeFromTokenKey); err == nil && consumerType != "" {
+		return r.certificateProfiles.ForConsumerType(consumerType)
+	}
+
+	if certificateHash, err := authentication.GetStringFromContext(ctx, authentication.ClientCertificateHashKey); err == nil && certificateHash != "" {
+		if record, found := r.issuanceRepository.GetByHash(certificateHash); found {
+			if profile, found := r.certificateProfiles.Get(record.Profile); found {
+				return profile
+			}
+		}
+	}
+
+	return r.certificateProfiles.Default()
+}
+
 func decodeStringFromBase64(string string) ([]byte, apperrors.AppError) {
 	bytes, err := base64.StdEncoding.DecodeString(string)
 	if err != nil {
@@ -4,14 +4,18 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/mock"
 
 	"github.com/kyma-incubator/compass/components/connector/internal/apperrors"
+	"github.com/kyma-incubator/compass/components/connector/internal/authentication"
 	authenticationMocks "github.com/kyma-incubator/compass/components/connector/internal/authentication/mocks"
 	"github.com/kyma-incubator/compass/components/connector/internal/certificates"
 	certificatesMocks "github.com/kyma-incubator/compass/components/connector/internal/certificates/mocks"
+	"github.com/kyma-incubator/compass/components/connector/internal/issuance"
+	issuanceMocks "github.com/kyma-incubator/compass/components/connector/internal/issuance/mocks"
 	revocationMocks "github.com/kyma-incubator/compass/components/connector/internal/revocation/mocks"
 	tokensMocks "github.com/kyma-incubator/compass/components/connector/internal/tokens/automock"
 	"github.com/stretchr/testify/assert"
@@ -37,6 +41,7 @@ var (
 			Province:           "province",
 		},
 	}
+	profiles                = certificates.NewDefaultProfiles(time.Hour)
 	directorURL             = "https://compass-gateway.kyma.local/director/graphql"
 	certSecuredConnectorURL = "https://compass-gateway-mtls.kyma.local/connector/graphql"
 )
@@ -56,13 +61,14 @@ func TestCertificateResolver_SignCertificateSigningRequest(t *testing.T) {
 
 		tokenService := &tokensMocks.Service{}
 		revokedCertsRepository := &revocationMocks.RevokedCertificatesRepository{}
+		issuanceRepository := &issuanceMocks.Repository{}
 		authenticator := &authenticationMocks.Authenticator{}
 		authenticator.On("Authenticate", context.TODO()).Return(clientId, nil)
 
 		certService := &certificatesMocks.Service{}
-		certService.On("SignCSR", mock.Anything, decodedCSR, subject).Return(encodedChain, nil)
+		certService.On("SignCSR", mock.Anything, decodedCSR, subject, profiles.Default()).Return(encodedChain, nil)
 
-		certificateResolver := NewCertificateResolver(authenticator, tokenService, certService, subject.CSRSubjectConsts, directorURL, certSecuredConnectorURL, revokedCertsRepository)
+		certificateResolver := NewCertificateResolver(authenticator, tokenService, certService, subject.CSRSubjectConsts, profiles, directorURL, certSecuredConnectorURL, revokedCertsRepository, issuanceRepository)
 
 		// when
 		certificationResult, err := certificateResolver.SignCertificateSigningRequest(context.TODO(), CSR)
@@ -89,13 +95,14 @@ func TestCertificateResolver_SignCertificateSigningRequest(t *testing.T) {
 
 		tokenService := &tokensMocks.Service{}
 		revokedCertsRepository := &revocationMocks.RevokedCertificatesRepository{}
+		issuanceRepository := &issuanceMocks.Repository{}
 		authenticator := &authenticationMocks.Authenticator{}
 		authenticator.On("Authenticate", context.TODO()).Return("", fmt.Errorf("error"))
 
 		certService := &certificatesMocks.Service{}
-		certService.On("SignCSR", decodedCSR, subject).Return(encodedChain, nil)
+		certService.On("SignCSR", mock.Anything, decodedCSR, subject, profiles.Default()).Return(encodedChain, nil)
 
-		certificateResolver := NewCertificateResolver(authenticator, tokenService, certService, subject.CSRSubjectConsts, directorURL, certSecuredConnectorURL, revokedCertsRepository)
+		certificateResolver := NewCertificateResolver(authenticator, tokenService, certService, subject.CSRSubjectConsts, profiles, directorURL, certSecuredConnectorURL, revokedCertsRepository, issuanceRepository)
 
 		// when
 		_, err := certificateResolver.SignCertificateSigningRequest(context.TODO(), CSR)
@@ -119,13 +126,14 @@ func TestCertificateResolver_SignCertificateSigningRequest(t *testing.T) {
 
 		tokenService := &tokensMocks.Service{}
 		revokedCertsRepository := &revocationMocks.RevokedCertificatesRepository{}
+		issuanceRepository := &issuanceMocks.Repository{}
 		authenticator := &authenticationMocks.Authenticator{}
 		authenticator.On("Authenticate", context.TODO()).Return(clientId, nil)
 
 		certService := &certificatesMocks.Service{}
-		certService.On("SignCSR", decodedCSR, subject).Return(encodedChain, nil)
+		certService.On("SignCSR", mock.Anything, decodedCSR, subject, profiles.Default()).Return(encodedChain, nil)
 
-		certificateResolver := NewCertificateResolver(authenticator, tokenService, certService, subject.CSRSubjectConsts, directorURL, certSecuredConnectorURL, revokedCertsRepository)
+		certificateResolver := NewCertificateResolver(authenticator, tokenService, certService, subject.CSRSubjectConsts, profiles, directorURL, certSecuredConnectorURL, revokedCertsRepository, issuanceRepository)
 
 		// when
 		_, err := certificateResolver.SignCertificateSigningRequest(context.TODO(), "not base 64 csr")
@@ -139,13 +147,14 @@ func TestCertificateResolver_SignCertificateSigningRequest(t *testing.T) {
 		// given
 		tokenService := &tokensMocks.Service{}
 		revokedCertsRepository := &revocationMocks.RevokedCertificatesRepository{}
+		issuanceRepository := &issuanceMocks.Repository{}
 		authenticator := &authenticationMocks.Authenticator{}
 		authenticator.On("Authenticate", context.TODO()).Return(clientId, nil)
 
 		certService := &certificatesMocks.Service{}
-		certService.On("SignCSR", mock.Anything, decodedCSR, subject).Return(certificates.EncodedCertificateChain{}, apperrors.Internal("error"))
+		certService.On("SignCSR", mock.Anything, decodedCSR, subject, profiles.Default()).Return(certificates.EncodedCertificateChain{}, apperrors.Internal("error"))
 
-		certificateResolver := NewCertificateResolver(authenticator, tokenService, certService, subject.CSRSubjectConsts, directorURL, certSecuredConnectorURL, revokedCertsRepository)
+		certificateResolver := NewCertificateResolver(authenticator, tokenService, certService, subject.CSRSubjectConsts, profiles, directorURL, certSecuredConnectorURL, revokedCertsRepository, issuanceRepository)
 
 		// when
 		_, err := certificateResolver.SignCertificateSigningRequest(context.TODO(), CSR)
@@ -154,6 +163,33 @@ func TestCertificateResolver_SignCertificateSigningRequest(t *testing.T) {
 		require.Error(t, err)
 		mock.AssertExpectationsForObjects(t, tokenService, authenticator, certService)
 	})
+
+	t.Run("should sign client certificate with profile the certificate was issued with", func(t *testing.T) {
+		// given
+		ctx := authentication.PutIntoContext(context.TODO(), authentication.ClientCertificateHashKey, certificateHash)
+		consumerProfiles := newConsumerProfiles(t)
+		runtimeProfile, found := consumerProfiles.Get("runtime")
+		require.True(t, found)
+
+		tokenService := &tokensMocks.Service{}
+		revokedCertsRepository := &revocationMocks.RevokedCertificatesRepository{}
+		issuanceRepository := &issuanceMocks.Repository{}
+		issuanceRepository.On("GetByHash", certificateHash).Return(issuance.Record{Hash: certificateHash, Profile: "runtime"}, true)
+		authenticator := &authenticationMocks.Authenticator{}
+		authenticator.On("Authenticate", ctx).Return(clientId, nil)
+
+		certService := &certificatesMocks.Service{}
+		certService.On("SignCSR", mock.Anything, decodedCSR, subject, runtimeProfile).Return(certificates.EncodedCertificateChain{}, nil)
+
+		certificateResolver := NewCertificateResolver(authenticator, tokenService, certService, subject.CSRSubjectConsts, consumerProfiles, directorURL, certSecuredConnectorURL, revokedCertsRepository, issuanceRepository)
+
+		// when
+		_, err := certificateResolver.SignCertificateSigningRequest(ctx, CSR)
+
+		// then
+		require.NoError(t, err)
+		mock.AssertExpectationsForObjects(t, tokenService, authenticator, certService, issuanceRepository)
+	})
 }
 
 func TestCertificateResolver_RevokeCertificate(t *testing.T) {
@@ -164,8 +200,10 @@ func TestCertificateResolver_RevokeCertificate(t *testing.T) {
 		authenticator.On("AuthenticateCertificate", context.Background()).Return(clientId, certificateHash, nil)
 		revokedCertsRepository := &revocationMocks.RevokedCertificatesRepository{}
 		revokedCertsRepository.On("Insert", certificateHash).Return(nil)
+		issuanceRepository := &issuanceMocks.Repository{}
+		issuanceRepository.On("Revoke", certificateHash, mock.AnythingOfType("time.Time")).Return(true, nil)
 
-		certificateResolver := NewCertificateResolver(authenticator, nil, nil, subject.CSRSubjectConsts, directorURL, certSecuredConnectorURL, revokedCertsRepository)
+		certificateResolver := NewCertificateResolver(authenticator, nil, nil, subject.CSRSubjectConsts, profiles, directorURL, certSecuredConnectorURL, revokedCertsRepository, issuanceRepository)
 
 		// when
 		revocationResult, err := certificateResolver.RevokeCertificate(context.Background())
@@ -173,6 +211,47 @@ func TestCertificateResolver_RevokeCertificate(t *testing.T) {
 		// then
 		require.NoError(t, err)
 		assert.Equal(t, true, revocationResult)
+		mock.AssertExpectationsForObjects(t, revokedCertsRepository, issuanceRepository)
+	})
+
+	t.Run("should revoke certificate without issuance record", func(t *testing.T) {
+		// given
+		authenticator := &authenticationMocks.Authenticator{}
+		authenticator.On("AuthenticateCertificate", context.Background()).Return(clientId, certificateHash, nil)
+		revokedCertsRepository := &revocationMocks.RevokedCertificatesRepository{}
+		revokedCertsRepository.On("Insert", certificateHash).Return(nil)
+		issuanceRepository := &issuanceMocks.Repository{}
+		issuanceRepository.On("Revoke", certificateHash, mock.AnythingOfType("time.Time")).Return(false, nil)
+
+		certificateResolver := NewCertificateResolver(authenticator, nil, nil, subject.CSRSubjectConsts, profiles, directorURL, certSecuredConnectorURL, revokedCertsRepository, issuanceRepository)
+
+		// when
+		revocationResult, err := certificateResolver.RevokeCertificate(context.Background())
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, true, revocationResult)
+		mock.AssertExpectationsForObjects(t, revokedCertsRepository, issuanceRepository)
+	})
+
+	t.Run("should return error if failed to mark issuance record as revoked", func(t *testing.T) {
+		// given
+		authenticator := &authenticationMocks.Authenticator{}
+		authenticator.On("AuthenticateCertificate", context.Background()).Return(clientId, certificateHash, nil)
+		revokedCertsRepository := &revocationMocks.RevokedCertificatesRepository{}
+		revokedCertsRepository.On("Insert", certificateHash).Return(nil)
+		issuanceRepository := &issuanceMocks.Repository{}
+		issuanceRepository.On("Revoke", certificateHash, mock.AnythingOfType("time.Time")).Return(false, errors.Errorf("error"))
+
+		certificateResolver := NewCertificateResolver(authenticator, nil, nil, subject.CSRSubjectConsts, profiles, directorURL, certSecuredConnectorURL, revokedCertsRepository, issuanceRepository)
+
+		// when
+		revocationResult, err := certificateResolver.RevokeCertificate(context.Background())
+
+		// then
+		require.Error(t, err)
+		assert.Equal(t, false, revocationResult)
+		mock.AssertExpectationsForObjects(t, revokedCertsRepository, issuanceRepository)
 	})
 
 	t.Run("should return error if failed to verify certificate", func(t *testing.T) {
@@ -180,9 +259,10 @@ func TestCertificateResolver_RevokeCertificate(t *testing.T) {
 		authenticator := &authenticationMocks.Authenticator{}
 		authenticator.On("AuthenticateCertificate", context.Background()).Return("", "", errors.Errorf("error"))
 		revokedCertsRepository := &revocationMocks.RevokedCertificatesRepository{}
+		issuanceRepository := &issuanceMocks.Repository{}
 		revokedCertsRepository.On("Insert", certificateHash).Return(nil)
 
-		certificateResolver := NewCertificateResolver(authenticator, nil, nil, subject.CSRSubjectConsts, directorURL, certSecuredConnectorURL, revokedCertsRepository)
+		certificateResolver := NewCertificateResolver(authenticator, nil, nil, subject.CSRSubjectConsts, profiles, directorURL, certSecuredConnectorURL, revokedCertsRepository, issuanceRepository)
 
 		// when
 		revocationResult, err := certificateResolver.RevokeCertificate(context.Background())
@@ -197,9 +277,10 @@ func TestCertificateResolver_RevokeCertificate(t *testing.T) {
 		authenticator := &authenticationMocks.Authenticator{}
 		authenticator.On("AuthenticateCertificate", context.Background()).Return(clientId, certificateHash, nil)
 		revokedCertsRepository := &revocationMocks.RevokedCertificatesRepository{}
+		issuanceRepository := &issuanceMocks.Repository{}
 		revokedCertsRepository.On("Insert", certificateHash).Return(errors.Errorf("error"))
 
-		certificateResolver := NewCertificateResolver(authenticator, nil, nil, subject.CSRSubjectConsts, directorURL, certSecuredConnectorURL, revokedCertsRepository)
+		certificateResolver := NewCertificateResolver(authenticator, nil, nil, subject.CSRSubjectConsts, profiles, directorURL, certSecuredConnectorURL, revokedCertsRepository, issuanceRepository)
 
 		// when
 		revocationResult, err := certificateResolver.RevokeCertificate(context.Background())
@@ -219,8 +300,9 @@ func TestCertificateResolver_Configuration(t *testing.T) {
 		tokenService := &tokensMocks.Service{}
 		tokenService.On("GetToken", mock.Anything, subject.CommonName).Return(token, nil)
 		revokedCertsRepository := &revocationMocks.RevokedCertificatesRepository{}
+		issuanceRepository := &issuanceMocks.Repository{}
 
-		certificateResolver := NewCertificateResolver(authenticator, tokenService, nil, subject.CSRSubjectConsts, directorURL, certSecuredConnectorURL, revokedCertsRepository)
+		certificateResolver := NewCertificateResolver(authenticator, tokenService, nil, subject.CSRSubjectConsts, profiles, directorURL, certSecuredConnectorURL, revokedCertsRepository, issuanceRepository)
 
 		// when
 		configurationResult, err := certificateResolver.Configuration(context.Background())
@@ -232,6 +314,33 @@ func TestCertificateResolver_Configuration(t *testing.T) {
 		assert.Equal(t, &certSecuredConnectorURL, configurationResult.ManagementPlaneInfo.CertificateSecuredConnectorURL)
 		assert.Equal(t, expectedSubject(subject.CSRSubjectConsts, subject.CommonName), configurationResult.CertificateSigningRequestInfo.Subject)
 		assert.Equal(t, "rsa2048", configurationResult.CertificateSigningRequestInfo.KeyAlgorithm)
+		assert.Equal(t, []string{"rsa2048", "ecdsa-p256", "ecdsa-p384"}, configurationResult.CertificateSigningRequestInfo.SupportedKeyAlgorithms)
+		assert.Equal(t, certificates.DefaultProfileName, configurationResult.CertificateSigningRequestInfo.Profile)
+		assert.Equal(t, "1h0m0s", configurationResult.CertificateSigningRequestInfo.Validity)
+		mock.AssertExpectationsForObjects(t, tokenService, authenticator)
+	})
+
+	t.Run("should return configuration with profile of consumer type", func(t *testing.T) {
+		// given
+		ctx := authentication.PutIntoContext(context.Background(), authentication.ClientTypeFromTokenKey, "Runtime")
+		authenticator := &authenticationMocks.Authenticator{}
+		authenticator.On("Authenticate", ctx).Return(clientId, nil)
+		tokenService := &tokensMocks.Service{}
+		tokenService.On("GetToken", mock.Anything, subject.CommonName).Return(token, nil)
+		revokedCertsRepository := &revocationMocks.RevokedCertificatesRepository{}
+		issuanceRepository := &issuanceMocks.Repository{}
+
+		certificateResolver := NewCertificateResolver(authenticator, tokenService, nil, subject.CSRSubjectConsts, newConsumerProfiles(t), directorURL, certSecuredConnectorURL, revokedCertsRepository, issuanceRepository)
+
+		// when
+		configurationResult, err := certificateResolver.Configuration(ctx)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, "ecdsa-p256", configurationResult.CertificateSigningRequestInfo.KeyAlgorithm)
+		assert.Equal(t, []string{"ecdsa-p256"}, configurationResult.CertificateSigningRequestInfo.SupportedKeyAlgorithms)
+		assert.Equal(t, "runtime", configurationResult.CertificateSigningRequestInfo.Profile)
+		assert.Equal(t, "720h0m0s", configurationResult.CertificateSigningRequestInfo.Validity)
 		mock.AssertExpectationsForObjects(t, tokenService, authenticator)
 	})
 
@@ -242,8 +351,9 @@ func TestCertificateResolver_Configuration(t *testing.T) {
 		tokenService := &tokensMocks.Service{}
 		tokenService.On("GetToken", mock.Anything, subject.CommonName).Return("", apperrors.Internal("error"))
 		revokedCertsRepository := &revocationMocks.RevokedCertificatesRepository{}
+		issuanceRepository := &issuanceMocks.Repository{}
 
-		certificateResolver := NewCertificateResolver(authenticator, tokenService, nil, subject.CSRSubjectConsts, directorURL, certSecuredConnectorURL, revokedCertsRepository)
+		certificateResolver := NewCertificateResolver(authenticator, tokenService, nil, subject.CSRSubjectConsts, profiles, directorURL, certSecuredConnectorURL, revokedCertsRepository, issuanceRepository)
 
 		// when
 		configurationResult, err := certificateResolver.Configuration(context.Background())
@@ -260,8 +370,9 @@ func TestCertificateResolver_Configuration(t *testing.T) {
 		authenticator.On("Authenticate", context.Background()).Return("", apperrors.Forbidden("Error"))
 		tokenService := &tokensMocks.Service{}
 		revokedCertsRepository := &revocationMocks.RevokedCertificatesRepository{}
+		issuanceRepository := &issuanceMocks.Repository{}
 
-		certificateResolver := NewCertificateResolver(authenticator, tokenService, nil, subject.CSRSubjectConsts, directorURL, certSecuredConnectorURL, revokedCertsRepository)
+		certificateResolver := NewCertificateResolver(authenticator, tokenService, nil, subject.CSRSubjectConsts, profiles, directorURL, certSecuredConnectorURL, revokedCertsRepository, issuanceRepository)
 
 		// when
 		configurationResult, err := certificateResolver.Configuration(context.Background())
@@ -274,6 +385,19 @@ func TestCertificateResolver_Configuration(t *testing.T) {
 
 }
 
+func newConsumerProfiles(t *testing.T) certificates.Profiles {
+	consumerProfiles, err := certificates.ParseProfiles(`{
+		"default": "application",
+		"consumerTypes": {"Runtime": "runtime"},
+		"profiles": [
+			{"name": "application", "keyAlgorithms": ["rsa2048"]},
+			{"name": "runtime", "validity": "720h", "keyAlgorithms": ["ecdsa-p256"]}
+		]
+	}`, time.Hour)
+	require.NoError(t, err)
+	return consumerProfiles
+}
+
 func expectedSubject(c certificates.CSRSubjectConsts, commonName string) string {
 	return fmt.Sprintf("O=%s,OU=%s,L=%s,ST=%s,C=%s,CN=%s", c.Organization, c.OrganizationalUnit, c.Locality, c.Province, c.Country, commonName)
 }
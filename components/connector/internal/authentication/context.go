@@ -11,6 +11,7 @@ type ContextKey string
 const (
 	ConnectorTokenKey          ContextKey = "ConnectorToken"
 	ClientIdFromTokenKey       ContextKey = "ClientIdFromToken"
+	ClientTypeFromTokenKey     ContextKey = "ClientTypeFromToken"
 	ClientIdFromCertificateKey ContextKey = "ClientIdFromCertificate"
 	ClientCertificateHashKey   ContextKey = "ClientCertificateHash"
 )
@@ -18,6 +18,9 @@ func (acm *authContextMiddleware) PropagateAuthentication(handler http.Handler)
 		clientIdFromToken := r.Header.Get(oathkeeper.ClientIdFromTokenHeader)
 		r = r.WithContext(PutIntoContext(r.Context(), ClientIdFromTokenKey, clientIdFromToken))
 
+		clientTypeFromToken := r.Header.Get(oathkeeper.ClientTypeFromTokenHeader)
+		r = r.WithContext(PutIntoContext(r.Context(), ClientTypeFromTokenKey, clientTypeFromToken))
+
 		clientIdFromCertificate := r.Header.Get(oathkeeper.ClientIdFromCertificateHeader)
 		r = r.WithContext(PutIntoContext(r.Context(), ClientIdFromCertificateKey, clientIdFromCertificate))
 
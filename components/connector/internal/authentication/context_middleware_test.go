@@ -21,6 +21,10 @@ func TestAuthContextMiddleware_PropagateAuthentication(t *testing.T) {
 			require.NoError(t, err)
 			assert.Equal(t, clientId, idFromToken)
 
+			typeFromToken, err := GetStringFromContext(r.Context(), ClientTypeFromTokenKey)
+			require.NoError(t, err)
+			assert.Equal(t, "Runtime", typeFromToken)
+
 			idFromCert, err := GetStringFromContext(r.Context(), ClientIdFromCertificateKey)
 			require.NoError(t, err)
 			assert.Equal(t, clientId, idFromCert)
@@ -36,6 +40,7 @@ func TestAuthContextMiddleware_PropagateAuthentication(t *testing.T) {
 		require.NoError(t, err)
 
 		request.Header.Add(oathkeeper.ClientIdFromTokenHeader, clientId)
+		request.Header.Add(oathkeeper.ClientTypeFromTokenHeader, "Runtime")
 		request.Header.Add(oathkeeper.ClientIdFromCertificateHeader, clientId)
 		request.Header.Add(oathkeeper.ClientCertificateHashHeader, certHash)
 		rr := httptest.NewRecorder()
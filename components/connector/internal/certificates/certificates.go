@@ -1,11 +1,12 @@
 package certificates
 
 import (
+	"crypto"
 	"crypto/rand"
-	"crypto/rsa"
 	"crypto/x509"
 	"encoding/pem"
 	"math/big"
+	"strings"
 	"time"
 
 	"github.com/kyma-incubator/compass/components/connector/internal/apperrors"
@@ -14,20 +15,27 @@ import (
 //go:generate mockery -name=CertificateUtility
 type CertificateUtility interface {
 	LoadCert(encodedData []byte) (*x509.Certificate, apperrors.AppError)
-	LoadKey(encodedData []byte) (*rsa.PrivateKey, apperrors.AppError)
+	LoadKey(encodedData []byte) (crypto.Signer, apperrors.AppError)
 	LoadCSR(encodedData []byte) (*x509.CertificateRequest, apperrors.AppError)
 	CheckCSRValues(csr *x509.CertificateRequest, subject CSRSubject) apperrors.AppError
-	SignCSR(caCrt *x509.Certificate, csr *x509.CertificateRequest, caKey *rsa.PrivateKey) ([]byte, apperrors.AppError)
+	CheckCSRProfile(csr *x509.CertificateRequest, profile Profile) apperrors.AppError
+	SignCSR(caCrt *x509.Certificate, csr *x509.CertificateRequest, caKey crypto.Signer, profile Profile) ([]byte, apperrors.AppError)
 	AddCertificateHeaderAndFooter(crtRaw []byte) []byte
 }
 
+// serialNumberLimit is the upper bound of the serial numbers of the signed certificates.
+// Serial numbers are random, so that they are unique and can identify the certificates in CRLs and OCSP responses.
+var serialNumberLimit = new(big.Int).Lsh(big.NewInt(1), 128)
+
 type certificateUtility struct {
-	certificateValidityTime time.Duration
+	crlURL  string
+	ocspURL string
 }
 
-func NewCertificateUtility(certificateValidityTime time.Duration) CertificateUtility {
+func NewCertificateUtility(crlURL, ocspURL string) CertificateUtility {
 	return &certificateUtility{
-		certificateValidityTime: certificateValidityTime,
+		crlURL:  crlURL,
+		ocspURL: ocspURL,
 	}
 }
 
@@ -45,7 +53,7 @@ func (cu *certificateUtility) LoadCert(encodedData []byte) (*x509.Certificate, a
 	return caCRT, nil
 }
 
-func (cu *certificateUtility) LoadKey(encodedData []byte) (*rsa.PrivateKey, apperrors.AppError) {
+func (cu *certificateUtility) LoadKey(encodedData []byte) (crypto.Signer, apperrors.AppError) {
 	pemBlock, _ := pem.Decode(encodedData)
 	if pemBlock == nil {
 		return nil, apperrors.Internal("Error while decoding pem block.")
@@ -55,12 +63,21 @@ func (cu *certificateUtility) LoadKey(encodedData []byte) (*rsa.PrivateKey, appe
 		return caPrivateKey, nil
 	}
 
+	if caPrivateKey, err := x509.ParseECPrivateKey(pemBlock.Bytes); err == nil {
+		return caPrivateKey, nil
+	}
+
 	caPrivateKey, err := x509.ParsePKCS8PrivateKey(pemBlock.Bytes)
 	if err != nil {
 		return nil, apperrors.Internal("Error while parsing private key: %s", err)
 	}
 
-	return caPrivateKey.(*rsa.PrivateKey), nil
+	signer, ok := caPrivateKey.(crypto.Signer)
+	if !ok {
+		return nil, apperrors.Internal("Error while parsing private key: unsupported key type %T", caPrivateKey)
+	}
+
+	return signer, nil
 }
 
 func (cu *certificateUtility) LoadCSR(encodedData []byte) (*x509.CertificateRequest, apperrors.AppError) {
@@ -119,8 +136,28 @@ func (cu *certificateUtility) CheckCSRValues(csr *x509.CertificateRequest, subje
 	return nil
 }
 
-func (cu *certificateUtility) SignCSR(caCrt *x509.Certificate, csr *x509.CertificateRequest, caKey *rsa.PrivateKey) ([]byte, apperrors.AppError) {
-	clientCRTTemplate := cu.prepareCRTTemplate(csr)
+func (cu *certificateUtility) CheckCSRProfile(csr *x509.CertificateRequest, profile Profile) apperrors.AppError {
+	if !profile.AcceptsKey(csr.PublicKey) {
+		return apperrors.WrongInput("CSR: Key algorithm not supported. Supported key algorithms: %s.", joinKeyAlgorithms(profile.KeyAlgorithms))
+	}
+
+	if len(csr.IPAddresses) > 0 || len(csr.EmailAddresses) > 0 || len(csr.URIs) > 0 {
+		return apperrors.WrongInput("CSR: Only DNS names are supported as subject alternative names.")
+	}
+
+	for _, dnsName := range csr.DNSNames {
+		if !profile.AllowsDNSName(dnsName) {
+			return apperrors.WrongInput("CSR: DNS name %s not allowed.", dnsName)
+		}
+	}
+	return nil
+}
+
+func (cu *certificateUtility) SignCSR(caCrt *x509.Certificate, csr *x509.CertificateRequest, caKey crypto.Signer, profile Profile) ([]byte, apperrors.AppError) {
+	clientCRTTemplate, err := cu.prepareCRTTemplate(csr, profile)
+	if err != nil {
+		return nil, apperrors.Internal("Error while preparing certificate template: %s", err)
+	}
 
 	clientCrtRaw, err := x509.CreateCertificate(rand.Reader, &clientCRTTemplate, caCrt, csr.PublicKey, caKey)
 	if err != nil {
@@ -130,19 +167,42 @@ func (cu *certificateUtility) SignCSR(caCrt *x509.Certificate, csr *x509.Certifi
 	return clientCrtRaw, nil
 }
 
-func (cu *certificateUtility) prepareCRTTemplate(csr *x509.CertificateRequest) x509.Certificate {
-	return x509.Certificate{
-		SignatureAlgorithm: csr.SignatureAlgorithm,
+// prepareCRTTemplate prepares the template of the client certificate according to the profile.
+// The signature algorithm is not set, so that it is derived from the CA key, which can be of a different type than the client key.
+func (cu *certificateUtility) prepareCRTTemplate(csr *x509.CertificateRequest, profile Profile) (x509.Certificate, error) {
+	serialNumber, err := rand.Int(rand.Reader, serialNumberLimit)
+	if err != nil {
+		return x509.Certificate{}, err
+	}
 
-		SerialNumber: big.NewInt(2),
+	template := x509.Certificate{
+		SerialNumber: serialNumber,
 		Subject:      csr.Subject,
+		DNSNames:     csr.DNSNames,
 		NotBefore:    time.Now(),
-		NotAfter:     time.Now().Add(cu.certificateValidityTime),
-		KeyUsage:     x509.KeyUsageDigitalSignature,
-		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		NotAfter:     time.Now().Add(profile.Validity),
+		KeyUsage:     profile.KeyUsage,
+		ExtKeyUsage:  profile.ExtKeyUsage,
+	}
+
+	if cu.crlURL != "" {
+		template.CRLDistributionPoints = []string{cu.crlURL}
+	}
+	if cu.ocspURL != "" {
+		template.OCSPServer = []string{cu.ocspURL}
 	}
+
+	return template, nil
 }
 
 func (cu *certificateUtility) AddCertificateHeaderAndFooter(crtRaw []byte) []byte {
 	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: crtRaw})
 }
+
+func joinKeyAlgorithms(algorithms []KeyAlgorithm) string {
+	names := make([]string, 0, len(algorithms))
+	for _, algorithm := range algorithms {
+		names = append(names, string(algorithm))
+	}
+	return strings.Join(names, ", ")
+}
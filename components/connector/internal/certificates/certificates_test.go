@@ -1,10 +1,15 @@
 package certificates
 
 import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
 	"crypto/rsa"
 	"crypto/x509"
 	"crypto/x509/pkix"
 	"encoding/pem"
+	"math/big"
 	"testing"
 	"time"
 
@@ -223,13 +228,15 @@ var (
 	encodedCert        = []byte(cert)
 	encodedInvalidCert = []byte(invalidCert)
 	encodedInvalidKey  = []byte(invalidKey)
+
+	defaultProfile = NewDefaultProfiles(validityTime).Default()
 )
 
 func TestCertificateUtility_LoadCert(t *testing.T) {
 
 	t.Run("should load cert", func(t *testing.T) {
 		// given
-		certificateUtility := NewCertificateUtility(validityTime)
+		certificateUtility := NewCertificateUtility("", "")
 
 		// when
 		crt, err := certificateUtility.LoadCert(encodedCert)
@@ -248,7 +255,7 @@ func TestCertificateUtility_LoadCert(t *testing.T) {
 
 	t.Run("should fail decoding cert", func(t *testing.T) {
 		// given
-		certificateUtility := NewCertificateUtility(validityTime)
+		certificateUtility := NewCertificateUtility("", "")
 
 		// when
 		crt, err := certificateUtility.LoadCert([]byte("invalid data"))
@@ -261,7 +268,7 @@ func TestCertificateUtility_LoadCert(t *testing.T) {
 
 	t.Run("should fail parsing cert", func(t *testing.T) {
 		// given
-		certificateUtility := NewCertificateUtility(validityTime)
+		certificateUtility := NewCertificateUtility("", "")
 
 		// when
 		crt, err := certificateUtility.LoadCert(encodedInvalidCert)
@@ -277,7 +284,7 @@ func TestCertificateUtility_LoadKey(t *testing.T) {
 
 	t.Run("should load RSA key", func(t *testing.T) {
 		// given
-		certificateUtility := NewCertificateUtility(validityTime)
+		certificateUtility := NewCertificateUtility("", "")
 
 		// when
 		key, err := certificateUtility.LoadKey(encodedRSAKey)
@@ -289,7 +296,7 @@ func TestCertificateUtility_LoadKey(t *testing.T) {
 
 	t.Run("should load key", func(t *testing.T) {
 		// given
-		certificateUtility := NewCertificateUtility(validityTime)
+		certificateUtility := NewCertificateUtility("", "")
 
 		// when
 		key, err := certificateUtility.LoadKey(encodedKey)
@@ -299,9 +306,25 @@ func TestCertificateUtility_LoadKey(t *testing.T) {
 		assert.NotNil(t, key)
 	})
 
+	t.Run("should load EC key", func(t *testing.T) {
+		// given
+		certificateUtility := NewCertificateUtility("", "")
+		ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		require.NoError(t, err)
+		rawECKey, err := x509.MarshalECPrivateKey(ecKey)
+		require.NoError(t, err)
+
+		// when
+		key, apperr := certificateUtility.LoadKey(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: rawECKey}))
+
+		// then
+		require.NoError(t, apperr)
+		assert.Equal(t, ecKey, key)
+	})
+
 	t.Run("should fail decoding key", func(t *testing.T) {
 		// given
-		certificateUtility := NewCertificateUtility(validityTime)
+		certificateUtility := NewCertificateUtility("", "")
 
 		// when
 		crt, err := certificateUtility.LoadKey([]byte("invalid data"))
@@ -314,7 +337,7 @@ func TestCertificateUtility_LoadKey(t *testing.T) {
 
 	t.Run("should fail parsing key", func(t *testing.T) {
 		// given
-		certificateUtility := NewCertificateUtility(validityTime)
+		certificateUtility := NewCertificateUtility("", "")
 
 		// when
 		crt, err := certificateUtility.LoadKey(encodedInvalidKey)
@@ -330,7 +353,7 @@ func TestCertificateUtility_LoadCSR(t *testing.T) {
 
 	t.Run("should load CSR", func(t *testing.T) {
 		// given
-		certificateUtility := NewCertificateUtility(validityTime)
+		certificateUtility := NewCertificateUtility("", "")
 
 		// when
 		key, err := certificateUtility.LoadCSR([]byte(CSR))
@@ -342,7 +365,7 @@ func TestCertificateUtility_LoadCSR(t *testing.T) {
 
 	t.Run("should fail decoding CSR", func(t *testing.T) {
 		// given
-		certificateUtility := NewCertificateUtility(validityTime)
+		certificateUtility := NewCertificateUtility("", "")
 
 		// when
 		crt, err := certificateUtility.LoadCSR([]byte("aW52YWxpZCBkYXRh"))
@@ -355,7 +378,7 @@ func TestCertificateUtility_LoadCSR(t *testing.T) {
 
 	t.Run("should fail parsing CSR", func(t *testing.T) {
 		// given
-		certificateUtility := NewCertificateUtility(validityTime)
+		certificateUtility := NewCertificateUtility("", "")
 
 		// when
 		crt, err := certificateUtility.LoadCSR([]byte(invalidCSR))
@@ -393,7 +416,7 @@ func TestCertificateUtility_CheckCSRValues(t *testing.T) {
 			},
 		}
 
-		certificateUtility := NewCertificateUtility(validityTime)
+		certificateUtility := NewCertificateUtility("", "")
 
 		// when
 		err := certificateUtility.CheckCSRValues(csr, csrSubject)
@@ -414,7 +437,7 @@ func TestCertificateUtility_CheckCSRValues(t *testing.T) {
 			},
 		}
 
-		certificateUtility := NewCertificateUtility(validityTime)
+		certificateUtility := NewCertificateUtility("", "")
 
 		// when
 		err := certificateUtility.CheckCSRValues(csr, csrSubject)
@@ -438,7 +461,7 @@ func TestCertificateUtility_CheckCSRValues(t *testing.T) {
 			},
 		}
 
-		certificateUtility := NewCertificateUtility(validityTime)
+		certificateUtility := NewCertificateUtility("", "")
 
 		// when
 		err := certificateUtility.CheckCSRValues(csr, csrSubject)
@@ -462,7 +485,7 @@ func TestCertificateUtility_CheckCSRValues(t *testing.T) {
 			},
 		}
 
-		certificateUtility := NewCertificateUtility(validityTime)
+		certificateUtility := NewCertificateUtility("", "")
 
 		// when
 		err := certificateUtility.CheckCSRValues(csr, csrSubject)
@@ -487,7 +510,7 @@ func TestCertificateUtility_CheckCSRValues(t *testing.T) {
 			},
 		}
 
-		certificateUtility := NewCertificateUtility(validityTime)
+		certificateUtility := NewCertificateUtility("", "")
 
 		// when
 		err := certificateUtility.CheckCSRValues(csr, csrSubject)
@@ -511,7 +534,7 @@ func TestCertificateUtility_CheckCSRValues(t *testing.T) {
 			},
 		}
 
-		certificateUtility := NewCertificateUtility(validityTime)
+		certificateUtility := NewCertificateUtility("", "")
 
 		// when
 		err := certificateUtility.CheckCSRValues(csr, csrSubject)
@@ -535,7 +558,7 @@ func TestCertificateUtility_CheckCSRValues(t *testing.T) {
 			},
 		}
 
-		certificateUtility := NewCertificateUtility(validityTime)
+		certificateUtility := NewCertificateUtility("", "")
 
 		// when
 		err := certificateUtility.CheckCSRValues(csr, csrSubject)
@@ -559,7 +582,7 @@ func TestCertificateUtility_CheckCSRValues(t *testing.T) {
 			},
 		}
 
-		certificateUtility := NewCertificateUtility(validityTime)
+		certificateUtility := NewCertificateUtility("", "")
 
 		// when
 		err := certificateUtility.CheckCSRValues(csr, csrSubject)
@@ -571,15 +594,91 @@ func TestCertificateUtility_CheckCSRValues(t *testing.T) {
 	})
 }
 
+func TestCertificateUtility_CheckCSRProfile(t *testing.T) {
+	profile := Profile{
+		Name:              "runtime",
+		Validity:          validityTime,
+		KeyAlgorithms:     []KeyAlgorithm{ECDSAP256},
+		AllowedDNSDomains: []string{"example.com"},
+	}
+
+	t.Run("should successfully check CSR against profile", func(t *testing.T) {
+		// given
+		certificateUtility := NewCertificateUtility("", "")
+		csr := prepareECDSACSR(t, elliptic.P256(), "example.com", "runtime.Example.com")
+
+		// when
+		err := certificateUtility.CheckCSRProfile(csr, profile)
+
+		// then
+		require.NoError(t, err)
+	})
+
+	t.Run("should fail when key algorithm is not supported", func(t *testing.T) {
+		// given
+		certificateUtility := NewCertificateUtility("", "")
+		csr := prepareECDSACSR(t, elliptic.P384())
+
+		// when
+		err := certificateUtility.CheckCSRProfile(csr, profile)
+
+		// then
+		require.Error(t, err)
+		assert.Equal(t, apperrors.CodeWrongInput, err.Code())
+		assert.Contains(t, err.Error(), "CSR: Key algorithm not supported. Supported key algorithms: ecdsa-p256.")
+	})
+
+	t.Run("should fail when RSA key is too short", func(t *testing.T) {
+		// given
+		certificateUtility := NewCertificateUtility("", "")
+		csr, apperr := certificateUtility.LoadCSR([]byte(CSR))
+		require.NoError(t, apperr)
+
+		// when
+		err := certificateUtility.CheckCSRProfile(csr, Profile{KeyAlgorithms: []KeyAlgorithm{RSA4096}})
+
+		// then
+		require.Error(t, err)
+		assert.Equal(t, apperrors.CodeWrongInput, err.Code())
+	})
+
+	t.Run("should fail when DNS name is not allowed", func(t *testing.T) {
+		// given
+		certificateUtility := NewCertificateUtility("", "")
+		csr := prepareECDSACSR(t, elliptic.P256(), "runtime.example.org")
+
+		// when
+		err := certificateUtility.CheckCSRProfile(csr, profile)
+
+		// then
+		require.Error(t, err)
+		assert.Equal(t, apperrors.CodeWrongInput, err.Code())
+		assert.Contains(t, err.Error(), "CSR: DNS name runtime.example.org not allowed.")
+	})
+
+	t.Run("should fail when DNS names are requested and profile allows none", func(t *testing.T) {
+		// given
+		certificateUtility := NewCertificateUtility("", "")
+		csr := prepareECDSACSR(t, elliptic.P256(), "runtime.example.com")
+
+		// when
+		err := certificateUtility.CheckCSRProfile(csr, defaultProfile)
+
+		// then
+		require.Error(t, err)
+		assert.Equal(t, apperrors.CodeWrongInput, err.Code())
+	})
+}
+
 func TestCertificateUtility_SignCSR(t *testing.T) {
 
 	t.Run("should sign client certificate", func(t *testing.T) {
 		// given
-		certificateUtility := NewCertificateUtility(validityTime)
+		certificateUtility := NewCertificateUtility("", "")
 		caCrt, csr, key := prepareCrtAndKey(certificateUtility)
 
 		// when
-		rawClientCRT, apperr := certificateUtility.SignCSR(caCrt, csr, key)
+		rawClientCRT, apperr := certificateUtility.SignCSR(caCrt, csr, key, defaultProfile)
 
 		//then
 		require.NoError(t, apperr)
@@ -590,6 +689,85 @@ func TestCertificateUtility_SignCSR(t *testing.T) {
 
 		certificateValidityTime := calculateValidityTime(decodedCrt)
 		assert.Equal(t, validityTime, certificateValidityTime)
+		assert.Empty(t, decodedCrt.CRLDistributionPoints)
+		assert.Empty(t, decodedCrt.OCSPServer)
+	})
+
+	t.Run("should sign client certificates with unique serial numbers and revocation endpoints", func(t *testing.T) {
+		// given
+		crlURL := "https://connector.example.com/v1/certificates/crl"
+		ocspURL := "https://connector.example.com/v1/certificates/ocsp"
+
+		certificateUtility := NewCertificateUtility(crlURL, ocspURL)
+		caCrt, csr, key := prepareCrtAndKey(certificateUtility)
+
+		// when
+		firstRawCRT, apperr := certificateUtility.SignCSR(caCrt, csr, key, defaultProfile)
+		require.NoError(t, apperr)
+		secondRawCRT, apperr := certificateUtility.SignCSR(caCrt, csr, key, defaultProfile)
+		require.NoError(t, apperr)
+
+		//then
+		first, err := x509.ParseCertificate(firstRawCRT)
+		require.NoError(t, err)
+		second, err := x509.ParseCertificate(secondRawCRT)
+		require.NoError(t, err)
+
+		assert.NotEqual(t, first.SerialNumber, second.SerialNumber)
+		assert.Equal(t, []string{crlURL}, first.CRLDistributionPoints)
+		assert.Equal(t, []string{ocspURL}, first.OCSPServer)
+	})
+
+	t.Run("should sign ECDSA client certificate with ECDSA CA according to profile", func(t *testing.T) {
+		// given
+		profile := Profile{
+			Name:              "runtime",
+			Validity:          time.Hour,
+			KeyAlgorithms:     []KeyAlgorithm{ECDSAP256},
+			KeyUsage:          x509.KeyUsageDigitalSignature | x509.KeyUsageKeyAgreement,
+			ExtKeyUsage:       []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth, x509.ExtKeyUsageServerAuth},
+			AllowedDNSDomains: []string{"example.com"},
+		}
+
+		certificateUtility := NewCertificateUtility("", "")
+		caCrt, caKey := prepareECDSACA(t, elliptic.P384())
+		csr := prepareECDSACSR(t, elliptic.P256(), "runtime.example.com")
+
+		// when
+		rawClientCRT, apperr := certificateUtility.SignCSR(caCrt, csr, caKey, profile)
+
+		//then
+		require.NoError(t, apperr)
+
+		decodedCrt, err := x509.ParseCertificate(rawClientCRT)
+		require.NoError(t, err)
+		require.NoError(t, decodedCrt.CheckSignatureFrom(caCrt))
+
+		assert.Equal(t, x509.ECDSAWithSHA384, decodedCrt.SignatureAlgorithm)
+		assert.Equal(t, x509.ECDSA, decodedCrt.PublicKeyAlgorithm)
+		assert.Equal(t, time.Hour, calculateValidityTime(decodedCrt))
+		assert.Equal(t, profile.KeyUsage, decodedCrt.KeyUsage)
+		assert.Equal(t, profile.ExtKeyUsage, decodedCrt.ExtKeyUsage)
+		assert.Equal(t, []string{"runtime.example.com"}, decodedCrt.DNSNames)
+	})
+
+	t.Run("should sign RSA client certificate with ECDSA CA", func(t *testing.T) {
+		// given
+		certificateUtility := NewCertificateUtility("", "")
+		caCrt, caKey := prepareECDSACA(t, elliptic.P256())
+		csr, apperr := certificateUtility.LoadCSR([]byte(CSR))
+		require.NoError(t, apperr)
+
+		// when
+		rawClientCRT, apperr := certificateUtility.SignCSR(caCrt, csr, caKey, defaultProfile)
+
+		//then
+		require.NoError(t, apperr)
+
+		decodedCrt, err := x509.ParseCertificate(rawClientCRT)
+		require.NoError(t, err)
+		require.NoError(t, decodedCrt.CheckSignatureFrom(caCrt))
+		assert.Equal(t, x509.RSA, decodedCrt.PublicKeyAlgorithm)
 	})
 
 	t.Run("should return when failed to create certificate", func(t *testing.T) {
@@ -598,10 +776,10 @@ func TestCertificateUtility_SignCSR(t *testing.T) {
 		csr := &x509.CertificateRequest{}
 		key := &rsa.PrivateKey{}
 
-		certificateUtility := NewCertificateUtility(validityTime)
+		certificateUtility := NewCertificateUtility("", "")
 
 		// when
-		rawClientCRT, err := certificateUtility.SignCSR(caCrt, csr, key)
+		rawClientCRT, err := certificateUtility.SignCSR(caCrt, csr, key, defaultProfile)
 
 		// then
 		require.Error(t, err)
@@ -615,7 +793,7 @@ func TestCertificateUtility_AddCertificateHeaderAndFooter(t *testing.T) {
 
 	t.Run("should add certificate header and footer", func(t *testing.T) {
 		// given
-		certificateUtility := NewCertificateUtility(validityTime)
+		certificateUtility := NewCertificateUtility("", "")
 		certificate, apperr := certificateUtility.LoadCert([]byte(cert))
 		require.NoError(t, apperr)
 
@@ -645,7 +823,7 @@ func calculateValidityTime(certificate *x509.Certificate) time.Duration {
 	return difference
 }
 
-func prepareCrtAndKey(certificateUtility CertificateUtility) (*x509.Certificate, *x509.CertificateRequest, *rsa.PrivateKey) {
+func prepareCrtAndKey(certificateUtility CertificateUtility) (*x509.Certificate, *x509.CertificateRequest, crypto.Signer) {
 	caCrt, err := certificateUtility.LoadCert(encodedCert)
 	if err != nil {
 	}
@@ -657,3 +835,42 @@ func prepareCrtAndKey(certificateUtility CertificateUtility) (*x509.Certificate,
 	}
 	return caCrt, csr, key
 }
+
+func prepareECDSACA(t *testing.T, curve elliptic.Curve) (*x509.Certificate, crypto.Signer) {
+	key, err := ecdsa.GenerateKey(curve, rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "connector-ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+
+	raw, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	crt, err := x509.ParseCertificate(raw)
+	require.NoError(t, err)
+
+	return crt, key
+}
+
+func prepareECDSACSR(t *testing.T, curve elliptic.Curve, dnsNames ...string) *x509.CertificateRequest {
+	key, err := ecdsa.GenerateKey(curve, rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.CertificateRequest{
+		Subject:  pkix.Name{CommonName: commonName},
+		DNSNames: dnsNames,
+	}
+
+	raw, err := x509.CreateCertificateRequest(rand.Reader, template, key)
+	require.NoError(t, err)
+	csr, err := x509.ParseCertificateRequest(raw)
+	require.NoError(t, err)
+
+	return csr
+}
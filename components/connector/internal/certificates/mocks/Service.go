@@ -8,7 +8,11 @@ import (
 
 	context "context"
 
+	crypto "crypto"
+
 	mock "github.com/stretchr/testify/mock"
+
+	x509 "crypto/x509"
 )
 
 // Service is an autogenerated mock type for the Service type
@@ -16,20 +20,54 @@ type Service struct {
 	mock.Mock
 }
 
-// SignCSR provides a mock function with given fields: ctx, encodedCSR, subject
-func (_m *Service) SignCSR(ctx context.Context, encodedCSR []byte, subject certificates.CSRSubject) (certificates.EncodedCertificateChain, apperrors.AppError) {
-	ret := _m.Called(ctx, encodedCSR, subject)
+// GetCA provides a mock function with given fields: ctx
+func (_m *Service) GetCA(ctx context.Context) (*x509.Certificate, crypto.Signer, apperrors.AppError) {
+	ret := _m.Called(ctx)
+
+	var r0 *x509.Certificate
+	if rf, ok := ret.Get(0).(func(context.Context) *x509.Certificate); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*x509.Certificate)
+		}
+	}
+
+	var r1 crypto.Signer
+	if rf, ok := ret.Get(1).(func(context.Context) crypto.Signer); ok {
+		r1 = rf(ctx)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(crypto.Signer)
+		}
+	}
+
+	var r2 apperrors.AppError
+	if rf, ok := ret.Get(2).(func(context.Context) apperrors.AppError); ok {
+		r2 = rf(ctx)
+	} else {
+		if ret.Get(2) != nil {
+			r2 = ret.Get(2).(apperrors.AppError)
+		}
+	}
+
+	return r0, r1, r2
+}
+
+// SignCSR provides a mock function with given fields: ctx, encodedCSR, subject, profile
+func (_m *Service) SignCSR(ctx context.Context, encodedCSR []byte, subject certificates.CSRSubject, profile certificates.Profile) (certificates.EncodedCertificateChain, apperrors.AppError) {
+	ret := _m.Called(ctx, encodedCSR, subject, profile)
 
 	var r0 certificates.EncodedCertificateChain
-	if rf, ok := ret.Get(0).(func(context.Context, []byte, certificates.CSRSubject) certificates.EncodedCertificateChain); ok {
-		r0 = rf(ctx, encodedCSR, subject)
+	if rf, ok := ret.Get(0).(func(context.Context, []byte, certificates.CSRSubject, certificates.Profile) certificates.EncodedCertificateChain); ok {
+		r0 = rf(ctx, encodedCSR, subject, profile)
 	} else {
 		r0 = ret.Get(0).(certificates.EncodedCertificateChain)
 	}
 
 	var r1 apperrors.AppError
-	if rf, ok := ret.Get(1).(func(context.Context, []byte, certificates.CSRSubject) apperrors.AppError); ok {
-		r1 = rf(ctx, encodedCSR, subject)
+	if rf, ok := ret.Get(1).(func(context.Context, []byte, certificates.CSRSubject, certificates.Profile) apperrors.AppError); ok {
+		r1 = rf(ctx, encodedCSR, subject, profile)
 	} else {
 		if ret.Get(1) != nil {
 			r1 = ret.Get(1).(apperrors.AppError)
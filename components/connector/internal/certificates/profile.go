@@ -0,0 +1,272 @@
+package certificates
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// KeyAlgorithm is the algorithm of the client key which a profile accepts in CSRs
+type KeyAlgorithm string
+
+const (
+	RSA2048   KeyAlgorithm = "rsa2048"
+	RSA4096   KeyAlgorithm = "rsa4096"
+	ECDSAP256 KeyAlgorithm = "ecdsa-p256"
+	ECDSAP384 KeyAlgorithm = "ecdsa-p384"
+
+	// DefaultProfileName is the name of the profile used when no profiles are configured
+	DefaultProfileName = "default"
+)
+
+// Matches returns true if the public key is of the algorithm. RSA keys match if they are at least of the algorithm size.
+func (a KeyAlgorithm) Matches(publicKey interface{}) bool {
+	switch key := publicKey.(type) {
+	case *rsa.PublicKey:
+		switch a {
+		case RSA2048:
+			return key.N.BitLen() >= 2048
+		case RSA4096:
+			return key.N.BitLen() >= 4096
+		}
+	case *ecdsa.PublicKey:
+		switch a {
+		case ECDSAP256:
+			return key.Curve == elliptic.P256()
+		case ECDSAP384:
+			return key.Curve == elliptic.P384()
+		}
+	}
+	return false
+}
+
+func (a KeyAlgorithm) validate() error {
+	switch a {
+	case RSA2048, RSA4096, ECDSAP256, ECDSAP384:
+		return nil
+	default:
+		return errors.Errorf("unsupported key algorithm %q", a)
+	}
+}
+
+// Profile defines how the client certificates of a consumer type are issued
+type Profile struct {
+	Name          string
+	Validity      time.Duration
+	KeyAlgorithms []KeyAlgorithm
+	KeyUsage      x509.KeyUsage
+	ExtKeyUsage   []x509.ExtKeyUsage
+	// AllowedDNSDomains are the domains, together with their subdomains, which can be requested as DNS subject alternative names.
+	// CSRs with subject alternative names are rejected if no domains are allowed.
+	AllowedDNSDomains []string
+}
+
+// PreferredKeyAlgorithm is the key algorithm advertised to the clients
+func (p Profile) PreferredKeyAlgorithm() KeyAlgorithm {
+	return p.KeyAlgorithms[0]
+}
+
+// AcceptsKey returns true if the public key matches any of the key algorithms of the profile
+func (p Profile) AcceptsKey(publicKey interface{}) bool {
+	for _, algorithm := range p.KeyAlgorithms {
+		if algorithm.Matches(publicKey) {
+			return true
+		}
+	}
+	return false
+}
+
+// AllowsDNSName returns true if the DNS name is one of the allowed domains or their subdomain
+func (p Profile) AllowsDNSName(dnsName string) bool {
+	dnsName = strings.ToLower(dnsName)
+	for _, domain := range p.AllowedDNSDomains {
+		domain = strings.ToLower(domain)
+		if dnsName == domain || strings.HasSuffix(dnsName, "."+domain) {
+			return true
+		}
+	}
+	return false
+}
+
+// Profiles are the certificate profiles, selectable by the consumer type of the client
+type Profiles struct {
+	profiles       map[string]Profile
+	byConsumerType map[string]string
+	defaultProfile string
+}
+
+// Get returns the profile with the given name
+func (p Profiles) Get(name string) (Profile, bool) {
+	profile, found := p.profiles[name]
+	return profile, found
+}
+
+// Default returns the profile used for clients whose consumer type is not known
+func (p Profiles) Default() Profile {
+	return p.profiles[p.defaultProfile]
+}
+
+// ForConsumerType returns the profile of the consumer type, or the default profile if none is configured for it
+func (p Profiles) ForConsumerType(consumerType string) Profile {
+	if name, found := p.byConsumerType[consumerType]; found {
+		return p.profiles[name]
+	}
+	return p.Default()
+}
+
+// NewDefaultProfiles returns a single profile, used for all consumer types, which issues client authentication certificates
+// with the given validity for RSA and ECDSA keys and does not allow subject alternative names
+func NewDefaultProfiles(validity time.Duration) Profiles {
+	return Profiles{
+		profiles: map[string]Profile{
+			DefaultProfileName: {
+				Name:          DefaultProfileName,
+				Validity:      validity,
+				KeyAlgorithms: []KeyAlgorithm{RSA2048, ECDSAP256, ECDSAP384},
+				KeyUsage:      x509.KeyUsageDigitalSignature,
+				ExtKeyUsage:   []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+			},
+		},
+		byConsumerType: map[string]string{},
+		defaultProfile: DefaultProfileName,
+	}
+}
+
+type profilesConfig struct {
+	Default       string            `json:"default"`
+	ConsumerTypes map[string]string `json:"consumerTypes"`
+	Profiles      []profileConfig   `json:"profiles"`
+}
+
+type profileConfig struct {
+	Name              string         `json:"name"`
+	Validity          string         `json:"validity"`
+	KeyAlgorithms     []KeyAlgorithm `json:"keyAlgorithms"`
+	KeyUsages         []string       `json:"keyUsages"`
+	ExtKeyUsages      []string       `json:"extKeyUsages"`
+	AllowedDNSDomains []string       `json:"allowedDNSDomains"`
+}
+
+var keyUsages = map[string]x509.KeyUsage{
+	"digitalSignature": x509.KeyUsageDigitalSignature,
+	"keyEncipherment":  x509.KeyUsageKeyEncipherment,
+	"keyAgreement":     x509.KeyUsageKeyAgreement,
+}
+
+var extKeyUsages = map[string]x509.ExtKeyUsage{
+	"clientAuth": x509.ExtKeyUsageClientAuth,
+	"serverAuth": x509.ExtKeyUsageServerAuth,
+}
+
+// ParseProfiles parses the JSON configuration of the certificate profiles, for example
+// {"default": "application", "consumerTypes": {"Runtime": "runtime"}, "profiles": [{"name": "application", "keyAlgorithms": ["rsa2048"]},
+// {"name": "runtime", "validity": "720h", "keyAlgorithms": ["ecdsa-p256"], "allowedDNSDomains": ["runtimes.example.com"]}]}.
+// Key usages default to digitalSignature, extended key usages to clientAuth, and the validity to the given default validity.
+func ParseProfiles(config string, defaultValidity time.Duration) (Profiles, error) {
+	var cfg profilesConfig
+	if err := json.Unmarshal([]byte(config), &cfg); err != nil {
+		return Profiles{}, errors.Wrap(err, "while unmarshalling certificate profiles")
+	}
+
+	if len(cfg.Profiles) == 0 {
+		return Profiles{}, errors.New("no certificate profiles defined")
+	}
+
+	profiles := Profiles{
+		profiles:       make(map[string]Profile, len(cfg.Profiles)),
+		byConsumerType: make(map[string]string, len(cfg.ConsumerTypes)),
+		defaultProfile: cfg.Default,
+	}
+
+	for _, profileCfg := range cfg.Profiles {
+		profile, err := profileCfg.toProfile(defaultValidity)
+		if err != nil {
+			return Profiles{}, errors.Wrapf(err, "while parsing certificate profile %q", profileCfg.Name)
+		}
+		if _, exists := profiles.profiles[profile.Name]; exists {
+			return Profiles{}, errors.Errorf("certificate profile %q defined more than once", profile.Name)
+		}
+		profiles.profiles[profile.Name] = profile
+	}
+
+	if profiles.defaultProfile == "" {
+		profiles.defaultProfile = cfg.Profiles[0].Name
+	}
+	if _, found := profiles.profiles[profiles.defaultProfile]; !found {
+		return Profiles{}, errors.Errorf("default certificate profile %q is not defined", profiles.defaultProfile)
+	}
+
+	for consumerType, name := range cfg.ConsumerTypes {
+		if _, found := profiles.profiles[name]; !found {
+			return Profiles{}, errors.Errorf("certificate profile %q of consumer type %q is not defined", name, consumerType)
+		}
+		profiles.byConsumerType[consumerType] = name
+	}
+
+	return profiles, nil
+}
+
+func (c profileConfig) toProfile(defaultValidity time.Duration) (Profile, error) {
+	if c.Name == "" {
+		return Profile{}, errors.New("name is required")
+	}
+
+	validity := defaultValidity
+	if c.Validity != "" {
+		var err error
+		if validity, err = time.ParseDuration(c.Validity); err != nil {
+			return Profile{}, errors.Wrap(err, "while parsing validity")
+		}
+	}
+	if validity <= 0 {
+		return Profile{}, errors.New("validity must be positive")
+	}
+
+	if len(c.KeyAlgorithms) == 0 {
+		return Profile{}, errors.New("at least one key algorithm is required")
+	}
+	for _, algorithm := range c.KeyAlgorithms {
+		if err := algorithm.validate(); err != nil {
+			return Profile{}, err
+		}
+	}
+
+	keyUsage := x509.KeyUsageDigitalSignature
+	if len(c.KeyUsages) > 0 {
+		keyUsage = 0
+		for _, name := range c.KeyUsages {
+			usage, found := keyUsages[name]
+			if !found {
+				return Profile{}, errors.Errorf("unsupported key usage %q", name)
+			}
+			keyUsage |= usage
+		}
+	}
+
+	extKeyUsage := []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth}
+	if len(c.ExtKeyUsages) > 0 {
+		extKeyUsage = make([]x509.ExtKeyUsage, 0, len(c.ExtKeyUsages))
+		for _, name := range c.ExtKeyUsages {
+			usage, found := extKeyUsages[name]
+			if !found {
+				return Profile{}, errors.Errorf("unsupported extended key usage %q", name)
+			}
+			extKeyUsage = append(extKeyUsage, usage)
+		}
+	}
+
+	return Profile{
+		Name:              c.Name,
+		Validity:          validity,
+		KeyAlgorithms:     c.KeyAlgorithms,
+		KeyUsage:          keyUsage,
+		ExtKeyUsage:       extKeyUsage,
+		AllowedDNSDomains: c.AllowedDNSDomains,
+	}, nil
+}
@@ -0,0 +1,192 @@
+package certificates
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeyAlgorithm_Matches(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	p256Key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	p384Key, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	require.NoError(t, err)
+
+	assert.True(t, RSA2048.Matches(&rsaKey.PublicKey))
+	assert.False(t, RSA4096.Matches(&rsaKey.PublicKey))
+	assert.False(t, ECDSAP256.Matches(&rsaKey.PublicKey))
+
+	assert.True(t, ECDSAP256.Matches(&p256Key.PublicKey))
+	assert.False(t, ECDSAP384.Matches(&p256Key.PublicKey))
+	assert.False(t, RSA2048.Matches(&p256Key.PublicKey))
+
+	assert.True(t, ECDSAP384.Matches(&p384Key.PublicKey))
+	assert.False(t, ECDSAP256.Matches(&p384Key.PublicKey))
+}
+
+func TestProfile_AllowsDNSName(t *testing.T) {
+	profile := Profile{AllowedDNSDomains: []string{"example.com"}}
+
+	assert.True(t, profile.AllowsDNSName("example.com"))
+	assert.True(t, profile.AllowsDNSName("Runtime.Example.com"))
+	assert.False(t, profile.AllowsDNSName("badexample.com"))
+	assert.False(t, profile.AllowsDNSName("example.com.evil.org"))
+	assert.False(t, Profile{}.AllowsDNSName("example.com"))
+}
+
+func TestNewDefaultProfiles(t *testing.T) {
+	// when
+	profiles := NewDefaultProfiles(time.Hour)
+
+	// then
+	profile := profiles.Default()
+	assert.Equal(t, DefaultProfileName, profile.Name)
+	assert.Equal(t, time.Hour, profile.Validity)
+	assert.Equal(t, RSA2048, profile.PreferredKeyAlgorithm())
+	assert.Equal(t, []KeyAlgorithm{RSA2048, ECDSAP256, ECDSAP384}, profile.KeyAlgorithms)
+	assert.Equal(t, x509.KeyUsageDigitalSignature, profile.KeyUsage)
+	assert.Equal(t, []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth}, profile.ExtKeyUsage)
+	assert.Equal(t, profile, profiles.ForConsumerType("Runtime"))
+}
+
+func TestParseProfiles(t *testing.T) {
+	t.Run("should parse profiles", func(t *testing.T) {
+		// given
+		config := `{
+			"default": "application",
+			"consumerTypes": {"Application": "application", "Runtime": "runtime"},
+			"profiles": [
+				{"name": "application", "keyAlgorithms": ["rsa2048", "ecdsa-p256"]},
+				{
+					"name": "runtime",
+					"validity": "720h",
+					"keyAlgorithms": ["ecdsa-p384"],
+					"keyUsages": ["digitalSignature", "keyAgreement"],
+					"extKeyUsages": ["clientAuth", "serverAuth"],
+					"allowedDNSDomains": ["runtimes.example.com"]
+				}
+			]
+		}`
+
+		// when
+		profiles, err := ParseProfiles(config, time.Hour)
+
+		// then
+		require.NoError(t, err)
+
+		application := profiles.ForConsumerType("Application")
+		assert.Equal(t, "application", application.Name)
+		assert.Equal(t, time.Hour, application.Validity)
+		assert.Equal(t, []KeyAlgorithm{RSA2048, ECDSAP256}, application.KeyAlgorithms)
+		assert.Equal(t, x509.KeyUsageDigitalSignature, application.KeyUsage)
+		assert.Equal(t, []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth}, application.ExtKeyUsage)
+
+		runtime := profiles.ForConsumerType("Runtime")
+		assert.Equal(t, "runtime", runtime.Name)
+		assert.Equal(t, 720*time.Hour, runtime.Validity)
+		assert.Equal(t, ECDSAP384, runtime.PreferredKeyAlgorithm())
+		assert.Equal(t, x509.KeyUsageDigitalSignature|x509.KeyUsageKeyAgreement, runtime.KeyUsage)
+		assert.Equal(t, []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth, x509.ExtKeyUsageServerAuth}, runtime.ExtKeyUsage)
+		assert.Equal(t, []string{"runtimes.example.com"}, runtime.AllowedDNSDomains)
+
+		assert.Equal(t, application, profiles.ForConsumerType("Integration System"))
+		assert.Equal(t, application, profiles.Default())
+
+		byName, found := profiles.Get("runtime")
+		assert.True(t, found)
+		assert.Equal(t, runtime, byName)
+		_, found = profiles.Get("missing")
+		assert.False(t, found)
+	})
+
+	t.Run("should use first profile as default", func(t *testing.T) {
+		// when
+		profiles, err := ParseProfiles(`{"profiles": [{"name": "first", "keyAlgorithms": ["rsa2048"]}, {"name": "second", "keyAlgorithms": ["rsa4096"]}]}`, time.Hour)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, "first", profiles.Default().Name)
+	})
+
+	testCases := []struct {
+		name          string
+		config        string
+		expectedError string
+	}{
+		{
+			name:          "invalid JSON",
+			config:        `{`,
+			expectedError: "while unmarshalling certificate profiles",
+		},
+		{
+			name:          "no profiles",
+			config:        `{"profiles": []}`,
+			expectedError: "no certificate profiles defined",
+		},
+		{
+			name:          "missing name",
+			config:        `{"profiles": [{"keyAlgorithms": ["rsa2048"]}]}`,
+			expectedError: "name is required",
+		},
+		{
+			name:          "invalid validity",
+			config:        `{"profiles": [{"name": "app", "validity": "forever", "keyAlgorithms": ["rsa2048"]}]}`,
+			expectedError: "while parsing validity",
+		},
+		{
+			name:          "no key algorithms",
+			config:        `{"profiles": [{"name": "app"}]}`,
+			expectedError: "at least one key algorithm is required",
+		},
+		{
+			name:          "unsupported key algorithm",
+			config:        `{"profiles": [{"name": "app", "keyAlgorithms": ["dsa1024"]}]}`,
+			expectedError: `unsupported key algorithm "dsa1024"`,
+		},
+		{
+			name:          "unsupported key usage",
+			config:        `{"profiles": [{"name": "app", "keyAlgorithms": ["rsa2048"], "keyUsages": ["certSign"]}]}`,
+			expectedError: `unsupported key usage "certSign"`,
+		},
+		{
+			name:          "unsupported extended key usage",
+			config:        `{"profiles": [{"name": "app", "keyAlgorithms": ["rsa2048"], "extKeyUsages": ["codeSigning"]}]}`,
+			expectedError: `unsupported extended key usage "codeSigning"`,
+		},
+		{
+			name:          "duplicated profile",
+			config:        `{"profiles": [{"name": "app", "keyAlgorithms": ["rsa2048"]}, {"name": "app", "keyAlgorithms": ["rsa2048"]}]}`,
+			expectedError: `certificate profile "app" defined more than once`,
+		},
+		{
+			name:          "undefined default profile",
+			config:        `{"default": "missing", "profiles": [{"name": "app", "keyAlgorithms": ["rsa2048"]}]}`,
+			expectedError: `default certificate profile "missing" is not defined`,
+		},
+		{
+			name:          "undefined consumer type profile",
+			config:        `{"consumerTypes": {"Runtime": "missing"}, "profiles": [{"name": "app", "keyAlgorithms": ["rsa2048"]}]}`,
+			expectedError: `certificate profile "missing" of consumer type "Runtime" is not defined`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run("should fail for "+testCase.name, func(t *testing.T) {
+			// when
+			_, err := ParseProfiles(testCase.config, time.Hour)
+
+			// then
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), testCase.expectedError)
+		})
+	}
+}
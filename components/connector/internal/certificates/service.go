@@ -2,24 +2,31 @@ package certificates
 
 import (
 	"context"
+	"crypto"
+	"crypto/sha256"
 	"crypto/x509"
 	"encoding/base64"
+	"encoding/hex"
 
 	"github.com/kyma-incubator/compass/components/director/pkg/log"
 
 	"github.com/kyma-incubator/compass/components/connector/internal/apperrors"
+	"github.com/kyma-incubator/compass/components/connector/internal/issuance"
 )
 
 //go:generate mockery -name=Service
 type Service interface {
-	// SignCSR takes encoded CSR, validates subject and generates Certificate based on CA stored in secret
+	// SignCSR takes encoded CSR, validates subject and key against the profile and generates Certificate based on CA stored in secret
 	// returns base64 encoded certificate chain
-	SignCSR(ctx context.Context, encodedCSR []byte, subject CSRSubject) (EncodedCertificateChain, apperrors.AppError)
+	SignCSR(ctx context.Context, encodedCSR []byte, subject CSRSubject, profile Profile) (EncodedCertificateChain, apperrors.AppError)
+	// GetCA returns the CA certificate and key used for signing, so that the revocation status of the signed certificates can be attested
+	GetCA(ctx context.Context) (*x509.Certificate, crypto.Signer, apperrors.AppError)
 }
 
 type certificateService struct {
 	certsCache           Cache
 	certUtil             CertificateUtility
+	issuanceRepository   issuance.Repository
 	caCertSecretName     string
 	caCertSecretKey      string
 	caKeySecretKey       string
@@ -30,12 +37,14 @@ type certificateService struct {
 func NewCertificateService(
 	certsCache Cache,
 	certUtil CertificateUtility,
+	issuanceRepository issuance.Repository,
 	caCertSecretName, rootCACertSecretName string,
 	caCertSecretKey, caKeySecretKey, rootCACertSecretKey string) Service {
 
 	return &certificateService{
 		certsCache:           certsCache,
 		certUtil:             certUtil,
+		issuanceRepository:   issuanceRepository,
 		caCertSecretName:     caCertSecretName,
 		caCertSecretKey:      caCertSecretKey,
 		caKeySecretKey:       caKeySecretKey,
@@ -44,7 +53,7 @@ func NewCertificateService(
 	}
 }
 
-func (svc *certificateService) SignCSR(ctx context.Context, encodedCSR []byte, subject CSRSubject) (EncodedCertificateChain, apperrors.AppError) {
+func (svc *certificateService) SignCSR(ctx context.Context, encodedCSR []byte, subject CSRSubject, profile Profile) (EncodedCertificateChain, apperrors.AppError) {
 	csr, err := svc.certUtil.LoadCSR(encodedCSR)
 	if err != nil {
 		log.C(ctx).WithError(err).Errorf("Error occurred while loading the CSR with Common Name %s", subject.CommonName)
@@ -52,14 +61,14 @@ func (svc *certificateService) SignCSR(ctx context.Context, encodedCSR []byte, s
 	}
 	log.C(ctx).Debugf("Successfully loaded the CSR with Common Name %s", subject.CommonName)
 
-	err = svc.checkCSR(csr, subject)
+	err = svc.checkCSR(csr, subject, profile)
 	if err != nil {
-		log.C(ctx).WithError(err).Errorf("Error occurred while checking the values of the CSR with Common Name %s", subject.CommonName)
+		log.C(ctx).WithError(err).Errorf("Error occurred while checking the values of the CSR with Common Name %s against profile %s", subject.CommonName, profile.Name)
 		return EncodedCertificateChain{}, err
 	}
-	log.C(ctx).Debugf("Successfully checked the values of the CSR with Common Name %s", subject.CommonName)
+	log.C(ctx).Debugf("Successfully checked the values of the CSR with Common Name %s against profile %s", subject.CommonName, profile.Name)
 
-	encodedCertChain, err := svc.signCSR(csr)
+	encodedCertChain, err := svc.signCSR(ctx, csr, profile)
 	if err != nil {
 		return EncodedCertificateChain{}, err
 	}
@@ -68,30 +77,69 @@ func (svc *certificateService) SignCSR(ctx context.Context, encodedCSR []byte, s
 	return encodedCertChain, nil
 }
 
-func (svc *certificateService) signCSR(csr *x509.CertificateRequest) (EncodedCertificateChain, apperrors.AppError) {
+func (svc *certificateService) GetCA(_ context.Context) (*x509.Certificate, crypto.Signer, apperrors.AppError) {
 	secretData, err := svc.certsCache.Get(svc.caCertSecretName)
 	if err != nil {
-		return EncodedCertificateChain{}, err
+		return nil, nil, err
 	}
 
 	caCrt, err := svc.certUtil.LoadCert(secretData[svc.caCertSecretKey])
 	if err != nil {
-		return EncodedCertificateChain{}, err
+		return nil, nil, err
 	}
 
 	caKey, err := svc.certUtil.LoadKey(secretData[svc.caKeySecretKey])
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return caCrt, caKey, nil
+}
+
+func (svc *certificateService) signCSR(ctx context.Context, csr *x509.CertificateRequest, profile Profile) (EncodedCertificateChain, apperrors.AppError) {
+	caCrt, caKey, err := svc.GetCA(ctx)
 	if err != nil {
 		return EncodedCertificateChain{}, err
 	}
 
-	signedCrt, err := svc.certUtil.SignCSR(caCrt, csr, caKey)
+	signedCrt, err := svc.certUtil.SignCSR(caCrt, csr, caKey, profile)
 	if err != nil {
 		return EncodedCertificateChain{}, err
 	}
 
+	if err := svc.recordIssuance(signedCrt, profile); err != nil {
+		log.C(ctx).WithError(err).Errorf("Error occurred while recording the issuance of certificate with Common Name %s", csr.Subject.CommonName)
+		return EncodedCertificateChain{}, err
+	}
+	log.C(ctx).Debugf("Successfully recorded the issuance of certificate with Common Name %s", csr.Subject.CommonName)
+
 	return svc.encodeCertificates(caCrt.Raw, signedCrt)
 }
 
+// recordIssuance persists the serial number and expiry of the signed certificate, so that its revocation status can be served.
+// The certificate is recorded under the same hash that is used to revoke it, together with its profile, which is reused when the certificate is renewed.
+func (svc *certificateService) recordIssuance(rawCrt []byte, profile Profile) apperrors.AppError {
+	crt, err := x509.ParseCertificate(rawCrt)
+	if err != nil {
+		return apperrors.Internal("Error while parsing signed certificate: %s", err)
+	}
+
+	hash := sha256.Sum256(rawCrt)
+	record := issuance.Record{
+		SerialNumber: crt.SerialNumber.Text(16),
+		CommonName:   crt.Subject.CommonName,
+		Hash:         hex.EncodeToString(hash[:]),
+		NotAfter:     crt.NotAfter,
+		Profile:      profile.Name,
+	}
+
+	if err := svc.issuanceRepository.Insert(record); err != nil {
+		return apperrors.Internal("Error while recording issuance of certificate with serial number %s: %s", record.SerialNumber, err)
+	}
+
+	return nil
+}
+
 func (svc *certificateService) encodeCertificates(rawCaCertificate, rawClientCertificate []byte) (EncodedCertificateChain, apperrors.AppError) {
 	caCrtBytes := svc.certUtil.AddCertificateHeaderAndFooter(rawCaCertificate)
 	signedCrtBytes := svc.certUtil.AddCertificateHeaderAndFooter(rawClientCertificate)
@@ -124,8 +172,11 @@ func (svc *certificateService) loadRootCACert() ([]byte, apperrors.AppError) {
 	return svc.certUtil.AddCertificateHeaderAndFooter(rootCACrt.Raw), nil
 }
 
-func (svc *certificateService) checkCSR(csr *x509.CertificateRequest, expectedSubject CSRSubject) apperrors.AppError {
-	return svc.certUtil.CheckCSRValues(csr, expectedSubject)
+func (svc *certificateService) checkCSR(csr *x509.CertificateRequest, expectedSubject CSRSubject, profile Profile) apperrors.AppError {
+	if err := svc.certUtil.CheckCSRValues(csr, expectedSubject); err != nil {
+		return err
+	}
+	return svc.certUtil.CheckCSRProfile(csr, profile)
 }
 
 func encodeCertificateBase64(certChain, clientCRT, caCRT []byte) EncodedCertificateChain {
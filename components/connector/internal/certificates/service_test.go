@@ -2,13 +2,25 @@ package certificates_test
 
 import (
 	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
 	"crypto/rsa"
+	"crypto/sha256"
 	"crypto/x509"
+	"crypto/x509/pkix"
 	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"math/big"
 	"testing"
+	"time"
 
 	"github.com/kyma-incubator/compass/components/connector/internal/apperrors"
 	"github.com/kyma-incubator/compass/components/connector/internal/certificates"
+	"github.com/kyma-incubator/compass/components/connector/internal/issuance"
+	issuanceMocks "github.com/kyma-incubator/compass/components/connector/internal/issuance/mocks"
+	"github.com/stretchr/testify/mock"
 
 	certificatesMocks "github.com/kyma-incubator/compass/components/connector/internal/certificates/mocks"
 	"github.com/stretchr/testify/assert"
@@ -54,11 +66,14 @@ var (
 	csr       = &x509.CertificateRequest{}
 
 	rootCACrtBytes = []byte("rootCACertificate")
-	clientCRT      = []byte("clientCertificate")
+	clientCRT      = newClientCertificate()
 	clientCRTBytes = []byte("clientCertificateBytes")
 	caCRTBytes     = []byte("caCRTBytes")
 	certChain      = append(clientCRTBytes, caCRTBytes...)
 
+	profile                = certificates.NewDefaultProfiles(time.Hour).Default()
+	expectedIssuanceRecord = issuanceRecordOf(clientCRT)
+
 	subjectValues = certificates.CSRSubject{
 		CommonName: appName,
 		CSRSubjectConsts: certificates.CSRSubjectConsts{
@@ -79,17 +94,21 @@ func TestCertificateService_SignCSR(t *testing.T) {
 		cache.Put(authSecretName, certsSecretData)
 
 		certUtils := &certificatesMocks.CertificateUtility{}
+		issuanceRepository := &issuanceMocks.Repository{}
 		certUtils.On("LoadCert", caCrtEncoded).Return(caCrt, nil)
 		certUtils.On("LoadKey", caKeyEncoded).Return(caKey, nil)
 		certUtils.On("LoadCSR", rawCSR).Return(csr, nil)
 		certUtils.On("CheckCSRValues", csr, subjectValues).Return(nil)
-		certUtils.On("SignCSR", caCrt, csr, caKey).Return(clientCRT, nil)
+		certUtils.On("CheckCSRProfile", csr, profile).Return(nil)
+		certUtils.On("SignCSR", caCrt, csr, caKey, profile).Return(clientCRT, nil)
+		issuanceRepository.On("Insert", expectedIssuanceRecord).Return(nil)
 		certUtils.On("AddCertificateHeaderAndFooter", caCrt.Raw).Return(caCRTBytes)
 		certUtils.On("AddCertificateHeaderAndFooter", clientCRT).Return(clientCRTBytes)
 
 		certificatesService := certificates.NewCertificateService(
 			cache,
 			certUtils,
+			issuanceRepository,
 			authSecretName,
 			"",
 			caCertificateSecretKey,
@@ -97,7 +116,7 @@ func TestCertificateService_SignCSR(t *testing.T) {
 			rootCACertificateSecretKey)
 
 		// when
-		encodedCertChain, apperr := certificatesService.SignCSR(context.TODO(), rawCSR, subjectValues)
+		encodedCertChain, apperr := certificatesService.SignCSR(context.TODO(), rawCSR, subjectValues, profile)
 
 		// then
 		require.NoError(t, apperr)
@@ -112,6 +131,7 @@ func TestCertificateService_SignCSR(t *testing.T) {
 		assert.Equal(t, certChain, decodedChain)
 
 		certUtils.AssertExpectations(t)
+		issuanceRepository.AssertExpectations(t)
 	})
 
 	t.Run("should create certificate with additional root certificate", func(t *testing.T) {
@@ -123,12 +143,15 @@ func TestCertificateService_SignCSR(t *testing.T) {
 		cache.Put(rootCASecretName, rootCASecretData)
 
 		certUtils := &certificatesMocks.CertificateUtility{}
+		issuanceRepository := &issuanceMocks.Repository{}
 		certUtils.On("LoadCert", caCrtEncoded).Return(caCrt, nil).
 			On("LoadCert", rootCaEncoded).Return(rootCACrt, nil)
 		certUtils.On("LoadKey", caKeyEncoded).Return(caKey, nil)
 		certUtils.On("LoadCSR", rawCSR).Return(csr, nil)
 		certUtils.On("CheckCSRValues", csr, subjectValues).Return(nil)
-		certUtils.On("SignCSR", caCrt, csr, caKey).Return(clientCRT, nil)
+		certUtils.On("CheckCSRProfile", csr, profile).Return(nil)
+		certUtils.On("SignCSR", caCrt, csr, caKey, profile).Return(clientCRT, nil)
+		issuanceRepository.On("Insert", expectedIssuanceRecord).Return(nil)
 		certUtils.On("AddCertificateHeaderAndFooter", caCrt.Raw).Return(caCRTBytes).Once().
 			On("AddCertificateHeaderAndFooter", rootCACrt.Raw).Return(rootCACrtBytes)
 		certUtils.On("AddCertificateHeaderAndFooter", clientCRT).Return(clientCRTBytes)
@@ -136,6 +159,7 @@ func TestCertificateService_SignCSR(t *testing.T) {
 		certificatesService := certificates.NewCertificateService(
 			cache,
 			certUtils,
+			issuanceRepository,
 			authSecretName,
 			rootCASecretName,
 			caCertificateSecretKey,
@@ -143,7 +167,7 @@ func TestCertificateService_SignCSR(t *testing.T) {
 			rootCACertificateSecretKey)
 
 		// when
-		encodedCertChain, apperr := certificatesService.SignCSR(context.TODO(), rawCSR, subjectValues)
+		encodedCertChain, apperr := certificatesService.SignCSR(context.TODO(), rawCSR, subjectValues, profile)
 
 		// then
 		require.NoError(t, apperr)
@@ -158,18 +182,22 @@ func TestCertificateService_SignCSR(t *testing.T) {
 		assert.Equal(t, certChain, decodedChain)
 
 		certUtils.AssertExpectations(t)
+		issuanceRepository.AssertExpectations(t)
 	})
 
 	t.Run("should return Not Found error when secret not found", func(t *testing.T) {
 		// given
 		cache := certificates.NewCertificateCache()
 		certUtils := &certificatesMocks.CertificateUtility{}
+		issuanceRepository := &issuanceMocks.Repository{}
 		certUtils.On("LoadCSR", rawCSR).Return(csr, nil)
 		certUtils.On("CheckCSRValues", csr, subjectValues).Return(nil)
+		certUtils.On("CheckCSRProfile", csr, profile).Return(nil)
 
 		certificatesService := certificates.NewCertificateService(
 			cache,
 			certUtils,
+			issuanceRepository,
 			authSecretName,
 			"",
 			caCertificateSecretKey,
@@ -177,13 +205,14 @@ func TestCertificateService_SignCSR(t *testing.T) {
 			rootCACertificateSecretKey)
 
 		// when
-		encodedChain, err := certificatesService.SignCSR(context.TODO(), rawCSR, subjectValues)
+		encodedChain, err := certificatesService.SignCSR(context.TODO(), rawCSR, subjectValues, profile)
 
 		// then
 		require.Error(t, err)
 		assert.Equal(t, apperrors.CodeNotFound, err.Code())
 		assert.Empty(t, encodedChain)
 		certUtils.AssertExpectations(t)
+		issuanceRepository.AssertExpectations(t)
 	})
 
 	t.Run("should return error when couldn't load csr", func(t *testing.T) {
@@ -191,11 +220,13 @@ func TestCertificateService_SignCSR(t *testing.T) {
 		cache := certificates.NewCertificateCache()
 
 		certUtils := &certificatesMocks.CertificateUtility{}
+		issuanceRepository := &issuanceMocks.Repository{}
 		certUtils.On("LoadCSR", rawCSR).Return(nil, apperrors.Internal("error"))
 
 		certificatesService := certificates.NewCertificateService(
 			cache,
 			certUtils,
+			issuanceRepository,
 			authSecretName,
 			"",
 			caCertificateSecretKey,
@@ -203,13 +234,14 @@ func TestCertificateService_SignCSR(t *testing.T) {
 			rootCACertificateSecretKey)
 
 		// when
-		encodedChain, err := certificatesService.SignCSR(context.TODO(), rawCSR, subjectValues)
+		encodedChain, err := certificatesService.SignCSR(context.TODO(), rawCSR, subjectValues, profile)
 
 		// then
 		require.Error(t, err)
 		assert.Empty(t, encodedChain)
 		assert.Equal(t, apperrors.CodeInternal, err.Code())
 		certUtils.AssertExpectations(t)
+		issuanceRepository.AssertExpectations(t)
 	})
 
 	t.Run("should return error when subject check failed", func(t *testing.T) {
@@ -217,12 +249,14 @@ func TestCertificateService_SignCSR(t *testing.T) {
 		cache := certificates.NewCertificateCache()
 
 		certUtils := &certificatesMocks.CertificateUtility{}
+		issuanceRepository := &issuanceMocks.Repository{}
 		certUtils.On("LoadCSR", rawCSR).Return(csr, nil)
 		certUtils.On("CheckCSRValues", csr, subjectValues).Return(apperrors.Forbidden("error"))
 
 		certificatesService := certificates.NewCertificateService(
 			cache,
 			certUtils,
+			issuanceRepository,
 			authSecretName,
 			"",
 			caCertificateSecretKey,
@@ -230,13 +264,45 @@ func TestCertificateService_SignCSR(t *testing.T) {
 			rootCACertificateSecretKey)
 
 		// when
-		encodedChain, err := certificatesService.SignCSR(context.TODO(), rawCSR, subjectValues)
+		encodedChain, err := certificatesService.SignCSR(context.TODO(), rawCSR, subjectValues, profile)
 
 		// then
 		require.Error(t, err)
 		assert.Empty(t, encodedChain)
 		assert.Equal(t, apperrors.CodeForbidden, err.Code())
 		certUtils.AssertExpectations(t)
+		issuanceRepository.AssertExpectations(t)
+	})
+
+	t.Run("should return error when profile check failed", func(t *testing.T) {
+		// given
+		cache := certificates.NewCertificateCache()
+
+		certUtils := &certificatesMocks.CertificateUtility{}
+		issuanceRepository := &issuanceMocks.Repository{}
+		certUtils.On("LoadCSR", rawCSR).Return(csr, nil)
+		certUtils.On("CheckCSRValues", csr, subjectValues).Return(nil)
+		certUtils.On("CheckCSRProfile", csr, profile).Return(apperrors.WrongInput("error"))
+
+		certificatesService := certificates.NewCertificateService(
+			cache,
+			certUtils,
+			issuanceRepository,
+			authSecretName,
+			"",
+			caCertificateSecretKey,
+			caKeySecretKey,
+			rootCACertificateSecretKey)
+
+		// when
+		encodedChain, err := certificatesService.SignCSR(context.TODO(), rawCSR, subjectValues, profile)
+
+		// then
+		require.Error(t, err)
+		assert.Empty(t, encodedChain)
+		assert.Equal(t, apperrors.CodeWrongInput, err.Code())
+		certUtils.AssertExpectations(t)
+		issuanceRepository.AssertExpectations(t)
 	})
 
 	t.Run("should return error when couldn't load cert", func(t *testing.T) {
@@ -245,13 +311,16 @@ func TestCertificateService_SignCSR(t *testing.T) {
 		cache.Put(authSecretName, certsSecretData)
 
 		certUtils := &certificatesMocks.CertificateUtility{}
+		issuanceRepository := &issuanceMocks.Repository{}
 		certUtils.On("LoadCSR", rawCSR).Return(csr, nil)
 		certUtils.On("CheckCSRValues", csr, subjectValues).Return(nil)
+		certUtils.On("CheckCSRProfile", csr, profile).Return(nil)
 		certUtils.On("LoadCert", caCrtEncoded).Return(nil, apperrors.Internal("error"))
 
 		certificatesService := certificates.NewCertificateService(
 			cache,
 			certUtils,
+			issuanceRepository,
 			authSecretName,
 			"",
 			caCertificateSecretKey,
@@ -259,13 +328,14 @@ func TestCertificateService_SignCSR(t *testing.T) {
 			rootCACertificateSecretKey)
 
 		// when
-		encodedChain, err := certificatesService.SignCSR(context.TODO(), rawCSR, subjectValues)
+		encodedChain, err := certificatesService.SignCSR(context.TODO(), rawCSR, subjectValues, profile)
 
 		// then
 		require.Error(t, err)
 		assert.Empty(t, encodedChain)
 		assert.Equal(t, apperrors.CodeInternal, err.Code())
 		certUtils.AssertExpectations(t)
+		issuanceRepository.AssertExpectations(t)
 	})
 
 	t.Run("should return error when couldn't load key", func(t *testing.T) {
@@ -274,14 +344,17 @@ func TestCertificateService_SignCSR(t *testing.T) {
 		cache.Put(authSecretName, certsSecretData)
 
 		certUtils := &certificatesMocks.CertificateUtility{}
+		issuanceRepository := &issuanceMocks.Repository{}
 		certUtils.On("LoadCSR", rawCSR).Return(csr, nil)
 		certUtils.On("CheckCSRValues", csr, subjectValues).Return(nil)
+		certUtils.On("CheckCSRProfile", csr, profile).Return(nil)
 		certUtils.On("LoadCert", caCrtEncoded).Return(caCrt, nil)
 		certUtils.On("LoadKey", caKeyEncoded).Return(nil, apperrors.Internal("error"))
 
 		certificatesService := certificates.NewCertificateService(
 			cache,
 			certUtils,
+			issuanceRepository,
 			authSecretName,
 			"",
 			caCertificateSecretKey,
@@ -289,13 +362,14 @@ func TestCertificateService_SignCSR(t *testing.T) {
 			rootCACertificateSecretKey)
 
 		// when
-		encodedChain, err := certificatesService.SignCSR(context.TODO(), rawCSR, subjectValues)
+		encodedChain, err := certificatesService.SignCSR(context.TODO(), rawCSR, subjectValues, profile)
 
 		// then
 		require.Error(t, err)
 		assert.Empty(t, encodedChain)
 		assert.Equal(t, apperrors.CodeInternal, err.Code())
 		certUtils.AssertExpectations(t)
+		issuanceRepository.AssertExpectations(t)
 	})
 
 	t.Run("should return error when failed to sign CSR", func(t *testing.T) {
@@ -304,15 +378,54 @@ func TestCertificateService_SignCSR(t *testing.T) {
 		cache.Put(authSecretName, certsSecretData)
 
 		certUtils := &certificatesMocks.CertificateUtility{}
+		issuanceRepository := &issuanceMocks.Repository{}
+		certUtils.On("LoadCert", caCrtEncoded).Return(caCrt, nil)
+		certUtils.On("LoadKey", caKeyEncoded).Return(caKey, nil)
+		certUtils.On("LoadCSR", rawCSR).Return(csr, nil)
+		certUtils.On("CheckCSRValues", csr, subjectValues).Return(nil)
+		certUtils.On("CheckCSRProfile", csr, profile).Return(nil)
+		certUtils.On("SignCSR", caCrt, csr, caKey, profile).Return(nil, apperrors.Internal("error"))
+
+		certificatesService := certificates.NewCertificateService(
+			cache,
+			certUtils,
+			issuanceRepository,
+			authSecretName,
+			"",
+			caCertificateSecretKey,
+			caKeySecretKey,
+			rootCACertificateSecretKey)
+
+		// when
+		encodedChain, err := certificatesService.SignCSR(context.TODO(), rawCSR, subjectValues, profile)
+
+		// then
+		require.Error(t, err)
+		assert.Empty(t, encodedChain)
+		assert.Equal(t, apperrors.CodeInternal, err.Code())
+		certUtils.AssertExpectations(t)
+		issuanceRepository.AssertExpectations(t)
+	})
+
+	t.Run("should return error when failed to record issuance", func(t *testing.T) {
+		// given
+		cache := certificates.NewCertificateCache()
+		cache.Put(authSecretName, certsSecretData)
+
+		certUtils := &certificatesMocks.CertificateUtility{}
+		issuanceRepository := &issuanceMocks.Repository{}
 		certUtils.On("LoadCert", caCrtEncoded).Return(caCrt, nil)
 		certUtils.On("LoadKey", caKeyEncoded).Return(caKey, nil)
 		certUtils.On("LoadCSR", rawCSR).Return(csr, nil)
 		certUtils.On("CheckCSRValues", csr, subjectValues).Return(nil)
-		certUtils.On("SignCSR", caCrt, csr, caKey).Return(nil, apperrors.Internal("error"))
+		certUtils.On("CheckCSRProfile", csr, profile).Return(nil)
+		certUtils.On("SignCSR", caCrt, csr, caKey, profile).Return(clientCRT, nil)
+		issuanceRepository.On("Insert", mock.AnythingOfType("issuance.Record")).Return(errors.New("some error"))
 
 		certificatesService := certificates.NewCertificateService(
 			cache,
 			certUtils,
+			issuanceRepository,
 			authSecretName,
 			"",
 			caCertificateSecretKey,
@@ -320,16 +433,101 @@ func TestCertificateService_SignCSR(t *testing.T) {
 			rootCACertificateSecretKey)
 
 		// when
-		encodedChain, err := certificatesService.SignCSR(context.TODO(), rawCSR, subjectValues)
+		encodedChain, err := certificatesService.SignCSR(context.TODO(), rawCSR, subjectValues, profile)
 
 		// then
 		require.Error(t, err)
 		assert.Empty(t, encodedChain)
 		assert.Equal(t, apperrors.CodeInternal, err.Code())
 		certUtils.AssertExpectations(t)
+		issuanceRepository.AssertExpectations(t)
 	})
 }
 
 func decodeBase64(base64CrtChain string) ([]byte, error) {
 	return base64.StdEncoding.DecodeString(base64CrtChain)
 }
+
+func TestCertificateService_GetCA(t *testing.T) {
+
+	t.Run("should return CA certificate and key", func(t *testing.T) {
+		// given
+		cache := certificates.NewCertificateCache()
+		cache.Put(authSecretName, certsSecretData)
+
+		certUtils := &certificatesMocks.CertificateUtility{}
+		certUtils.On("LoadCert", caCrtEncoded).Return(caCrt, nil)
+		certUtils.On("LoadKey", caKeyEncoded).Return(caKey, nil)
+
+		certificatesService := certificates.NewCertificateService(
+			cache,
+			certUtils,
+			&issuanceMocks.Repository{},
+			authSecretName,
+			"",
+			caCertificateSecretKey,
+			caKeySecretKey,
+			rootCACertificateSecretKey)
+
+		// when
+		crt, key, err := certificatesService.GetCA(context.TODO())
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, caCrt, crt)
+		assert.Equal(t, caKey, key)
+		certUtils.AssertExpectations(t)
+	})
+
+	t.Run("should return Not Found error when secret not found", func(t *testing.T) {
+		// given
+		certificatesService := certificates.NewCertificateService(
+			certificates.NewCertificateCache(),
+			&certificatesMocks.CertificateUtility{},
+			&issuanceMocks.Repository{},
+			authSecretName,
+			"",
+			caCertificateSecretKey,
+			caKeySecretKey,
+			rootCACertificateSecretKey)
+
+		// when
+		_, _, err := certificatesService.GetCA(context.TODO())
+
+		// then
+		require.Error(t, err)
+		assert.Equal(t, apperrors.CodeNotFound, err.Code())
+	})
+}
+
+func newClientCertificate() []byte {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		panic(err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1234),
+		Subject:      pkix.Name{CommonName: appName},
+		NotBefore:    time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+		NotAfter:     time.Date(2021, 4, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	rawCrt, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		panic(err)
+	}
+
+	return rawCrt
+}
+
+func issuanceRecordOf(rawCrt []byte) issuance.Record {
+	hash := sha256.Sum256(rawCrt)
+	return issuance.Record{
+		SerialNumber: "4d2",
+		CommonName:   appName,
+		Hash:         hex.EncodeToString(hash[:]),
+		NotAfter:     time.Date(2021, 4, 1, 0, 0, 0, 0, time.UTC),
+		Profile:      profile.Name,
+	}
+}
@@ -0,0 +1,228 @@
+package certstatus
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/base64"
+	"io"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/kyma-incubator/compass/components/connector/internal/certificates"
+	"github.com/kyma-incubator/compass/components/connector/internal/issuance"
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/ocsp"
+)
+
+const (
+	// OCSPRequestPathVariable is the path variable carrying the base64 encoded OCSP request of GET requests
+	OCSPRequestPathVariable = "request"
+
+	crlContentType          = "application/pkix-crl"
+	ocspRequestContentType  = "application/ocsp-request"
+	ocspResponseContentType = "application/ocsp-response"
+
+	maxOCSPRequestSize = 10 * 1024
+)
+
+// Handler serves the revocation status of the certificates signed by the connector,
+// as a CRL and as OCSP responses, both signed with the CA that signed the certificates.
+type Handler interface {
+	CRL(w http.ResponseWriter, r *http.Request)
+	OCSP(w http.ResponseWriter, r *http.Request)
+}
+
+type handler struct {
+	certService        certificates.Service
+	issuanceRepository issuance.Repository
+	validityTime       time.Duration
+	currentTimeFunc    func() time.Time
+}
+
+// NewHandler returns a Handler whose CRLs and OCSP responses are valid for the given time
+func NewHandler(certService certificates.Service, issuanceRepository issuance.Repository, validityTime time.Duration) Handler {
+	return &handler{
+		certService:        certService,
+		issuanceRepository: issuanceRepository,
+		validityTime:       validityTime,
+		currentTimeFunc:    time.Now,
+	}
+}
+
+func (h *handler) CRL(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	caCrt, caKey, appErr := h.certService.GetCA(ctx)
+	if appErr != nil {
+		log.C(ctx).WithError(appErr).Error("Failed to load CA for signing the CRL")
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	revoked := h.issuanceRepository.ListRevoked()
+	revokedCertificates := make([]pkix.RevokedCertificate, 0, len(revoked))
+	for _, record := range revoked {
+		serialNumber, ok := new(big.Int).SetString(record.SerialNumber, 16)
+		if !ok {
+			log.C(ctx).Warnf("Skipping revoked certificate with malformed serial number %q", record.SerialNumber)
+			continue
+		}
+		revokedCertificates = append(revokedCertificates, pkix.RevokedCertificate{
+			SerialNumber:   serialNumber,
+			RevocationTime: *record.RevokedAt,
+		})
+	}
+
+	now := h.currentTimeFunc()
+	template := &x509.RevocationList{
+		Number:              big.NewInt(now.Unix()),
+		ThisUpdate:          now,
+		NextUpdate:          now.Add(h.validityTime),
+		RevokedCertificates: revokedCertificates,
+	}
+
+	crl, err := x509.CreateRevocationList(rand.Reader, template, caCrt, caKey)
+	if err != nil {
+		log.C(ctx).WithError(err).Error("Failed to create CRL")
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	log.C(ctx).Debugf("Serving CRL with %d revoked certificates", len(revokedCertificates))
+	respond(w, r, crlContentType, crl)
+}
+
+func (h *handler) OCSP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	rawRequest, err := readOCSPRequest(r)
+	if err != nil {
+		log.C(ctx).WithError(err).Info("Failed to read OCSP request")
+		respond(w, r, ocspResponseContentType, ocsp.MalformedRequestErrorResponse)
+		return
+	}
+
+	request, err := ocsp.ParseRequest(rawRequest)
+	if err != nil {
+		log.C(ctx).WithError(err).Info("Failed to parse OCSP request")
+		respond(w, r, ocspResponseContentType, ocsp.MalformedRequestErrorResponse)
+		return
+	}
+
+	caCrt, caKey, appErr := h.certService.GetCA(ctx)
+	if appErr != nil {
+		log.C(ctx).WithError(appErr).Error("Failed to load CA for signing the OCSP response")
+		respond(w, r, ocspResponseContentType, ocsp.InternalErrorErrorResponse)
+		return
+	}
+
+	issued, err := isIssuedBy(request, caCrt)
+	if err != nil {
+		log.C(ctx).WithError(err).Info("Failed to match the issuer of the OCSP request")
+		respond(w, r, ocspResponseContentType, ocsp.MalformedRequestErrorResponse)
+		return
+	}
+	if !issued {
+		log.C(ctx).Infof("OCSP request for certificate with serial number %s is not issued by the connector CA", request.SerialNumber.Text(16))
+		respond(w, r, ocspResponseContentType, ocsp.UnauthorizedErrorResponse)
+		return
+	}
+
+	now := h.currentTimeFunc()
+	template := ocsp.Response{
+		Status:       ocsp.Unknown,
+		SerialNumber: request.SerialNumber,
+		ThisUpdate:   now,
+		NextUpdate:   now.Add(h.validityTime),
+	}
+
+	if record, found := h.issuanceRepository.Get(request.SerialNumber.Text(16)); found {
+		template.Status = ocsp.Good
+		if record.IsRevoked() {
+			template.Status = ocsp.Revoked
+			template.RevokedAt = *record.RevokedAt
+			template.RevocationReason = ocsp.Unspecified
+		}
+	}
+
+	response, err := ocsp.CreateResponse(caCrt, caCrt, template, caKey)
+	if err != nil {
+		log.C(ctx).WithError(err).Error("Failed to create OCSP response")
+		respond(w, r, ocspResponseContentType, ocsp.InternalErrorErrorResponse)
+		return
+	}
+
+	log.C(ctx).Debugf("Serving OCSP response with status %d for certificate with serial number %s", template.Status, request.SerialNumber.Text(16))
+	respond(w, r, ocspResponseContentType, response)
+}
+
+// readOCSPRequest reads the DER encoded OCSP request from the body of POST requests,
+// or from the path of GET requests, where it is base64 encoded as defined in RFC 6960, Appendix A.1.
+func readOCSPRequest(r *http.Request) ([]byte, error) {
+	switch r.Method {
+	case http.MethodPost:
+		if contentType := r.Header.Get("Content-Type"); contentType != "" && contentType != ocspRequestContentType {
+			return nil, errors.Errorf("unsupported content type %q", contentType)
+		}
+		body, err := ioutil.ReadAll(io.LimitReader(r.Body, maxOCSPRequestSize))
+		if err != nil {
+			return nil, errors.Wrap(err, "while reading request body")
+		}
+		return body, nil
+	case http.MethodGet:
+		encoded, err := url.PathUnescape(mux.Vars(r)[OCSPRequestPathVariable])
+		if err != nil {
+			return nil, errors.Wrap(err, "while unescaping request")
+		}
+		decoded, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return nil, errors.Wrap(err, "while decoding request")
+		}
+		return decoded, nil
+	default:
+		return nil, errors.Errorf("unsupported method %s", r.Method)
+	}
+}
+
+// isIssuedBy checks whether the issuer name and key hashes of the request identify the CA certificate
+func isIssuedBy(request *ocsp.Request, caCrt *x509.Certificate) (bool, error) {
+	if !request.HashAlgorithm.Available() {
+		return false, errors.Errorf("unsupported hash algorithm %d", request.HashAlgorithm)
+	}
+
+	var publicKeyInfo struct {
+		Algorithm pkix.AlgorithmIdentifier
+		PublicKey asn1.BitString
+	}
+	if _, err := asn1.Unmarshal(caCrt.RawSubjectPublicKeyInfo, &publicKeyInfo); err != nil {
+		return false, errors.Wrap(err, "while parsing CA public key")
+	}
+
+	nameHash := hash(request.HashAlgorithm, caCrt.RawSubject)
+	keyHash := hash(request.HashAlgorithm, publicKeyInfo.PublicKey.RightAlign())
+
+	return bytes.Equal(nameHash, request.IssuerNameHash) && bytes.Equal(keyHash, request.IssuerKeyHash), nil
+}
+
+func hash(algorithm crypto.Hash, data []byte) []byte {
+	h := algorithm.New()
+	h.Write(data)
+	return h.Sum(nil)
+}
+
+func respond(w http.ResponseWriter, r *http.Request, contentType string, body []byte) {
+	w.Header().Set("Content-Type", contentType)
+	w.WriteHeader(http.StatusOK)
+	if _, err := w.Write(body); err != nil {
+		log.C(r.Context()).WithError(err).Error("Failed to write response")
+	}
+}
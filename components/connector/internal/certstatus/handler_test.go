@@ -0,0 +1,289 @@
+package certstatus_test
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/kyma-incubator/compass/components/connector/internal/apperrors"
+	"github.com/kyma-incubator/compass/components/connector/internal/certificates/mocks"
+	"github.com/kyma-incubator/compass/components/connector/internal/certstatus"
+	"github.com/kyma-incubator/compass/components/connector/internal/issuance"
+	issuanceMocks "github.com/kyma-incubator/compass/components/connector/internal/issuance/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ocsp"
+)
+
+const validityTime = time.Hour
+
+func TestHandler_CRL(t *testing.T) {
+	caCrt, caKey := newCA(t, "connector-ca")
+	revokedAt := time.Date(2021, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	t.Run("should serve CRL signed by the CA", func(t *testing.T) {
+		// given
+		certService := &mocks.Service{}
+		certService.On("GetCA", mock.Anything).Return(caCrt, caKey, nil)
+		issuanceRepository := &issuanceMocks.Repository{}
+		issuanceRepository.On("ListRevoked").Return([]issuance.Record{
+			{SerialNumber: "1a2b", Hash: "hash", RevokedAt: &revokedAt},
+			{SerialNumber: "malformed", Hash: "otherHash", RevokedAt: &revokedAt},
+		})
+
+		handler := certstatus.NewHandler(certService, issuanceRepository, validityTime)
+		rr := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, "/v1/certificates/crl", nil)
+
+		// when
+		handler.CRL(rr, req)
+
+		// then
+		require.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, "application/pkix-crl", rr.Header().Get("Content-Type"))
+
+		crl, err := x509.ParseCRL(rr.Body.Bytes())
+		require.NoError(t, err)
+		require.NoError(t, caCrt.CheckCRLSignature(crl))
+		require.Len(t, crl.TBSCertList.RevokedCertificates, 1)
+		assert.Equal(t, big.NewInt(0x1a2b), crl.TBSCertList.RevokedCertificates[0].SerialNumber)
+		assert.True(t, revokedAt.Equal(crl.TBSCertList.RevokedCertificates[0].RevocationTime))
+
+		certService.AssertExpectations(t)
+		issuanceRepository.AssertExpectations(t)
+	})
+
+	t.Run("should return internal server error when failed to load CA", func(t *testing.T) {
+		// given
+		certService := &mocks.Service{}
+		certService.On("GetCA", mock.Anything).Return(nil, nil, apperrors.NotFound("error"))
+		issuanceRepository := &issuanceMocks.Repository{}
+
+		handler := certstatus.NewHandler(certService, issuanceRepository, validityTime)
+		rr := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, "/v1/certificates/crl", nil)
+
+		// when
+		handler.CRL(rr, req)
+
+		// then
+		assert.Equal(t, http.StatusInternalServerError, rr.Code)
+		certService.AssertExpectations(t)
+		issuanceRepository.AssertExpectations(t)
+	})
+}
+
+func TestHandler_OCSP(t *testing.T) {
+	caCrt, caKey := newCA(t, "connector-ca")
+	clientCrt := newClientCertificate(t, caCrt, caKey)
+	serialNumber := clientCrt.SerialNumber.Text(16)
+
+	ocspRequest, err := ocsp.CreateRequest(clientCrt, caCrt, nil)
+	require.NoError(t, err)
+
+	revokedAt := time.Date(2021, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name           string
+		record         issuance.Record
+		found          bool
+		expectedStatus int
+	}{
+		{
+			name:           "should respond with good status for issued certificate",
+			record:         issuance.Record{SerialNumber: serialNumber},
+			found:          true,
+			expectedStatus: ocsp.Good,
+		},
+		{
+			name:           "should respond with revoked status for revoked certificate",
+			record:         issuance.Record{SerialNumber: serialNumber, RevokedAt: &revokedAt},
+			found:          true,
+			expectedStatus: ocsp.Revoked,
+		},
+		{
+			name:           "should respond with unknown status for certificate without issuance record",
+			found:          false,
+			expectedStatus: ocsp.Unknown,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// given
+			certService := &mocks.Service{}
+			certService.On("GetCA", mock.Anything).Return(caCrt, caKey, nil)
+			issuanceRepository := &issuanceMocks.Repository{}
+			issuanceRepository.On("Get", serialNumber).Return(testCase.record, testCase.found)
+
+			handler := certstatus.NewHandler(certService, issuanceRepository, validityTime)
+			rr := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPost, "/v1/certificates/ocsp", bytes.NewReader(ocspRequest))
+			req.Header.Set("Content-Type", "application/ocsp-request")
+
+			// when
+			handler.OCSP(rr, req)
+
+			// then
+			require.Equal(t, http.StatusOK, rr.Code)
+			assert.Equal(t, "application/ocsp-response", rr.Header().Get("Content-Type"))
+
+			response, err := ocsp.ParseResponseForCert(rr.Body.Bytes(), clientCrt, caCrt)
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expectedStatus, response.Status)
+			if testCase.expectedStatus == ocsp.Revoked {
+				assert.True(t, revokedAt.Equal(response.RevokedAt))
+			}
+
+			certService.AssertExpectations(t)
+			issuanceRepository.AssertExpectations(t)
+		})
+	}
+
+	t.Run("should serve OCSP request encoded in path", func(t *testing.T) {
+		// given
+		certService := &mocks.Service{}
+		certService.On("GetCA", mock.Anything).Return(caCrt, caKey, nil)
+		issuanceRepository := &issuanceMocks.Repository{}
+		issuanceRepository.On("Get", serialNumber).Return(issuance.Record{SerialNumber: serialNumber}, true)
+
+		handler := certstatus.NewHandler(certService, issuanceRepository, validityTime)
+		router := mux.NewRouter()
+		router.HandleFunc("/v1/certificates/ocsp/{request:.+}", handler.OCSP).Methods(http.MethodGet)
+
+		rr := httptest.NewRecorder()
+		encoded := url.PathEscape(base64.StdEncoding.EncodeToString(ocspRequest))
+		req := httptest.NewRequest(http.MethodGet, "/v1/certificates/ocsp/"+encoded, nil)
+
+		// when
+		router.ServeHTTP(rr, req)
+
+		// then
+		require.Equal(t, http.StatusOK, rr.Code)
+		response, err := ocsp.ParseResponseForCert(rr.Body.Bytes(), clientCrt, caCrt)
+		require.NoError(t, err)
+		assert.Equal(t, ocsp.Good, response.Status)
+
+		certService.AssertExpectations(t)
+		issuanceRepository.AssertExpectations(t)
+	})
+
+	t.Run("should respond with malformed request error for invalid request", func(t *testing.T) {
+		// given
+		certService := &mocks.Service{}
+		issuanceRepository := &issuanceMocks.Repository{}
+
+		handler := certstatus.NewHandler(certService, issuanceRepository, validityTime)
+		rr := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodPost, "/v1/certificates/ocsp", bytes.NewReader([]byte("invalid")))
+
+		// when
+		handler.OCSP(rr, req)
+
+		// then
+		require.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, ocsp.MalformedRequestErrorResponse, rr.Body.Bytes())
+		certService.AssertExpectations(t)
+		issuanceRepository.AssertExpectations(t)
+	})
+
+	t.Run("should respond with unauthorized error for certificate issued by other CA", func(t *testing.T) {
+		// given
+		otherCACrt, otherCAKey := newCA(t, "other-ca")
+		otherClientCrt := newClientCertificate(t, otherCACrt, otherCAKey)
+		otherRequest, err := ocsp.CreateRequest(otherClientCrt, otherCACrt, nil)
+		require.NoError(t, err)
+
+		certService := &mocks.Service{}
+		certService.On("GetCA", mock.Anything).Return(caCrt, caKey, nil)
+		issuanceRepository := &issuanceMocks.Repository{}
+
+		handler := certstatus.NewHandler(certService, issuanceRepository, validityTime)
+		rr := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodPost, "/v1/certificates/ocsp", bytes.NewReader(otherRequest))
+
+		// when
+		handler.OCSP(rr, req)
+
+		// then
+		require.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, ocsp.UnauthorizedErrorResponse, rr.Body.Bytes())
+		certService.AssertExpectations(t)
+		issuanceRepository.AssertExpectations(t)
+	})
+
+	t.Run("should respond with internal error when failed to load CA", func(t *testing.T) {
+		// given
+		certService := &mocks.Service{}
+		certService.On("GetCA", mock.Anything).Return(nil, nil, apperrors.NotFound("error"))
+		issuanceRepository := &issuanceMocks.Repository{}
+
+		handler := certstatus.NewHandler(certService, issuanceRepository, validityTime)
+		rr := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodPost, "/v1/certificates/ocsp", bytes.NewReader(ocspRequest))
+
+		// when
+		handler.OCSP(rr, req)
+
+		// then
+		require.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, ocsp.InternalErrorErrorResponse, rr.Body.Bytes())
+		certService.AssertExpectations(t)
+		issuanceRepository.AssertExpectations(t)
+	})
+}
+
+func newCA(t *testing.T, commonName string) (*x509.Certificate, *rsa.PrivateKey) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: commonName},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign | x509.KeyUsageDigitalSignature,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+		SubjectKeyId:          []byte{1, 2, 3, 4},
+	}
+
+	raw, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	crt, err := x509.ParseCertificate(raw)
+	require.NoError(t, err)
+
+	return crt, key
+}
+
+func newClientCertificate(t *testing.T, caCrt *x509.Certificate, caKey *rsa.PrivateKey) *x509.Certificate {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(0x3c4d5e),
+		Subject:      pkix.Name{CommonName: "app"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+
+	raw, err := x509.CreateCertificate(rand.Reader, template, caCrt, &key.PublicKey, caKey)
+	require.NoError(t, err)
+	crt, err := x509.ParseCertificate(raw)
+	require.NoError(t, err)
+
+	return crt
+}
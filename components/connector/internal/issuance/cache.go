@@ -0,0 +1,127 @@
+package issuance
+
+import (
+	"sync"
+	"time"
+)
+
+// Cache keeps the issuance records in memory, indexed by both the hash and the serial number of the certificate
+type Cache interface {
+	Put(record Record)
+	Delete(hash string)
+	Replace(records []Record)
+	Get(serialNumber string) (Record, bool)
+	GetByHash(hash string) (Record, bool)
+	ListRevoked() []Record
+	ListExpired(now time.Time) []Record
+}
+
+type recordsCache struct {
+	mutex    sync.RWMutex
+	byHash   map[string]Record
+	bySerial map[string]string
+	revoked  map[string]bool
+}
+
+func NewCache() Cache {
+	return &recordsCache{
+		byHash:   make(map[string]Record),
+		bySerial: make(map[string]string),
+		revoked:  make(map[string]bool),
+	}
+}
+
+func (c *recordsCache) Put(record Record) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.put(record)
+}
+
+func (c *recordsCache) Delete(hash string) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.delete(hash)
+}
+
+// Replace drops all records from the cache and puts the given ones instead
+func (c *recordsCache) Replace(records []Record) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.byHash = make(map[string]Record, len(records))
+	c.bySerial = make(map[string]string, len(records))
+	c.revoked = make(map[string]bool)
+	for _, record := range records {
+		c.put(record)
+	}
+}
+
+func (c *recordsCache) Get(serialNumber string) (Record, bool) {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+
+	hash, found := c.bySerial[serialNumber]
+	if !found {
+		return Record{}, false
+	}
+
+	record, found := c.byHash[hash]
+	return record, found
+}
+
+func (c *recordsCache) GetByHash(hash string) (Record, bool) {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+
+	record, found := c.byHash[hash]
+	return record, found
+}
+
+func (c *recordsCache) ListRevoked() []Record {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+
+	revoked := make([]Record, 0, len(c.revoked))
+	for hash := range c.revoked {
+		revoked = append(revoked, c.byHash[hash])
+	}
+	return revoked
+}
+
+func (c *recordsCache) ListExpired(now time.Time) []Record {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+
+	expired := make([]Record, 0)
+	for _, record := range c.byHash {
+		if record.NotAfter.Before(now) {
+			expired = append(expired, record)
+		}
+	}
+	return expired
+}
+
+func (c *recordsCache) put(record Record) {
+	c.delete(record.Hash)
+
+	c.byHash[record.Hash] = record
+	c.bySerial[record.SerialNumber] = record.Hash
+	if record.IsRevoked() {
+		c.revoked[record.Hash] = true
+	}
+}
+
+func (c *recordsCache) delete(hash string) {
+	record, found := c.byHash[hash]
+	if !found {
+		return
+	}
+
+	delete(c.byHash, hash)
+	delete(c.revoked, hash)
+	if c.bySerial[record.SerialNumber] == hash {
+		delete(c.bySerial, record.SerialNumber)
+	}
+}
@@ -0,0 +1,18 @@
+package issuance
+
+import (
+	"context"
+	"time"
+)
+
+const RecordKey = recordKey
+
+func ConfigMapName(hash string) string {
+	return configMapName(hash)
+}
+
+func PruneExpired(ctx context.Context, loader Loader, now time.Time) {
+	rl := loader.(*recordsLoader)
+	rl.currentTimeFunc = func() time.Time { return now }
+	rl.pruneExpired(ctx)
+}
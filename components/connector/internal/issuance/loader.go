@@ -0,0 +1,172 @@
+package issuance
+
+import (
+	"context"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	v1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/watch"
+)
+
+const issuanceLoaderCorrelationID = "issuance-loader"
+
+type Loader interface {
+	Run(ctx context.Context)
+}
+
+// recordsLoader fills the cache with the issuance records, by listing their config maps and watching them for changes
+// from the listed version on. Records of expired certificates are deleted periodically, as they are no longer needed in the CRL.
+type recordsLoader struct {
+	recordsCache      Cache
+	configMapManager  Manager
+	reconnectInterval time.Duration
+	pruneInterval     time.Duration
+	currentTimeFunc   func() time.Time
+}
+
+func NewLoader(recordsCache Cache, configMapManager Manager, reconnectInterval, pruneInterval time.Duration) Loader {
+	return &recordsLoader{
+		recordsCache:      recordsCache,
+		configMapManager:  configMapManager,
+		reconnectInterval: reconnectInterval,
+		pruneInterval:     pruneInterval,
+		currentTimeFunc:   time.Now,
+	}
+}
+
+func (rl *recordsLoader) Run(ctx context.Context) {
+	entry := log.C(ctx)
+	entry = entry.WithField(log.FieldRequestID, issuanceLoaderCorrelationID)
+	ctx = log.ContextWithLogger(ctx, entry)
+
+	go rl.startPruning(ctx)
+	rl.startKubeWatch(ctx)
+}
+
+func (rl *recordsLoader) startKubeWatch(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			log.C(ctx).Info("Context cancelled, stopping issuance records watcher...")
+			return
+		default:
+		}
+
+		resourceVersion, err := rl.load(ctx)
+		if err != nil {
+			log.C(ctx).WithError(err).Errorf("Could not list issuance records. Sleep for %s and try again...", rl.reconnectInterval.String())
+			time.Sleep(rl.reconnectInterval)
+			continue
+		}
+
+		log.C(ctx).Info("Starting watcher for issuance records changes...")
+		watcher, err := rl.configMapManager.Watch(metav1.ListOptions{
+			LabelSelector:   RecordLabel,
+			ResourceVersion: resourceVersion,
+			Watch:           true,
+		})
+		if err != nil {
+			log.C(ctx).WithError(err).Errorf("Could not initialize watcher. Sleep for %s and try again...", rl.reconnectInterval.String())
+			time.Sleep(rl.reconnectInterval)
+			continue
+		}
+
+		log.C(ctx).Info("Waiting for issuance records events...")
+		rl.processEvents(ctx, watcher.ResultChan())
+
+		// Cleanup any allocated resources
+		watcher.Stop()
+		time.Sleep(rl.reconnectInterval)
+	}
+}
+
+// load replaces the cached records with the listed ones and returns the version of the list, from which the changes are watched
+func (rl *recordsLoader) load(ctx context.Context) (string, error) {
+	configMaps, err := rl.configMapManager.List(metav1.ListOptions{LabelSelector: RecordLabel})
+	if err != nil {
+		return "", err
+	}
+
+	records := make([]Record, 0, len(configMaps.Items))
+	for i := range configMaps.Items {
+		record, err := recordFromConfigMap(&configMaps.Items[i])
+		if err != nil {
+			log.C(ctx).WithError(err).Errorf("Skipping malformed issuance record")
+			continue
+		}
+		records = append(records, record)
+	}
+
+	rl.recordsCache.Replace(records)
+	log.C(ctx).Infof("Loaded %d issuance records", len(records))
+
+	return configMaps.ResourceVersion, nil
+}
+
+func (rl *recordsLoader) processEvents(ctx context.Context, events <-chan watch.Event) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case ev, ok := <-events:
+			if !ok {
+				return
+			}
+			switch ev.Type {
+			case watch.Added, watch.Modified, watch.Deleted:
+				configMap, ok := ev.Object.(*v1.ConfigMap)
+				if !ok {
+					log.C(ctx).Error("Unexpected error: object is not configmap. Try again")
+					continue
+				}
+				record, err := recordFromConfigMap(configMap)
+				if err != nil {
+					log.C(ctx).WithError(err).Errorf("Skipping malformed issuance record")
+					continue
+				}
+
+				if ev.Type == watch.Deleted {
+					rl.recordsCache.Delete(record.Hash)
+				} else {
+					rl.recordsCache.Put(record)
+				}
+			case watch.Error:
+				log.C(ctx).Error("Error event is received, stop issuance records watcher and try again...")
+				return
+			}
+		}
+	}
+}
+
+func (rl *recordsLoader) startPruning(ctx context.Context) {
+	ticker := time.NewTicker(rl.pruneInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			rl.pruneExpired(ctx)
+		}
+	}
+}
+
+// pruneExpired deletes the records of the expired certificates. Every replica of the connector prunes the records,
+// so records which are already deleted are skipped.
+func (rl *recordsLoader) pruneExpired(ctx context.Context) {
+	expired := rl.recordsCache.ListExpired(rl.currentTimeFunc())
+	for _, record := range expired {
+		err := rl.configMapManager.Delete(configMapName(record.Hash), &metav1.DeleteOptions{})
+		if err != nil && !k8serrors.IsNotFound(err) {
+			log.C(ctx).WithError(err).Errorf("Failed to delete issuance record of expired certificate with serial number %s", record.SerialNumber)
+		}
+	}
+
+	if len(expired) > 0 {
+		log.C(ctx).Infof("Pruned %d issuance records of expired certificates", len(expired))
+	}
+}
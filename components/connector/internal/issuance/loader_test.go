@@ -0,0 +1,163 @@
+package issuance_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/connector/internal/issuance"
+	"github.com/kyma-incubator/compass/components/connector/internal/issuance/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
+)
+
+func TestLoader(t *testing.T) {
+	now := time.Date(2021, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	record := issuance.Record{
+		SerialNumber: "1a2b",
+		CommonName:   "app",
+		Hash:         "0a1b2c",
+		NotAfter:     now.Add(time.Hour),
+	}
+	otherRecord := issuance.Record{
+		SerialNumber: "3c4d",
+		CommonName:   "other-app",
+		Hash:         "3d4e5f",
+		NotAfter:     now.Add(time.Hour),
+	}
+	expiredRecord := issuance.Record{
+		SerialNumber: "5e6f",
+		CommonName:   "old-app",
+		Hash:         "6f7a8b",
+		NotAfter:     now.Add(-time.Hour),
+	}
+
+	fixList := func(resourceVersion string, records ...issuance.Record) *v1.ConfigMapList {
+		list := &v1.ConfigMapList{ListMeta: metav1.ListMeta{ResourceVersion: resourceVersion}}
+		for _, record := range records {
+			list.Items = append(list.Items, *fixRecordConfigMap(t, record))
+		}
+		list.Items = append(list.Items, v1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: issuance.ConfigMapName("malformed")}})
+		return list
+	}
+
+	run := func(ctx context.Context, manager *mocks.Manager) issuance.Cache {
+		cache := issuance.NewCache()
+		loader := issuance.NewLoader(cache, manager, time.Millisecond, time.Hour)
+		go loader.Run(ctx)
+		return cache
+	}
+
+	t.Run("should load listed records and watch changes from the listed version", func(t *testing.T) {
+		// given
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		watcher := &testWatch{events: make(chan watch.Event, 100)}
+		managerMock := &mocks.Manager{}
+		managerMock.On("List", metav1.ListOptions{LabelSelector: issuance.RecordLabel}).Return(fixList("10", record), nil).Once()
+		managerMock.On("Watch", metav1.ListOptions{LabelSelector: issuance.RecordLabel, ResourceVersion: "10", Watch: true}).Return(watcher, nil).Once()
+
+		// when
+		cache := run(ctx, managerMock)
+
+		// then
+		assert.Eventually(t, func() bool {
+			_, found := cache.Get(record.SerialNumber)
+			return found
+		}, time.Second*2, time.Millisecond*100)
+
+		// when
+		revoked := otherRecord
+		revoked.RevokedAt = &now
+		watcher.putEvent(watch.Event{Type: watch.Added, Object: fixRecordConfigMap(t, otherRecord)})
+		watcher.putEvent(watch.Event{Type: watch.Modified, Object: fixRecordConfigMap(t, revoked)})
+		watcher.putEvent(watch.Event{Type: watch.Deleted, Object: fixRecordConfigMap(t, record)})
+
+		// then
+		assert.Eventually(t, func() bool {
+			_, found := cache.GetByHash(record.Hash)
+			return !found && len(cache.ListRevoked()) == 1
+		}, time.Second*2, time.Millisecond*100)
+		assert.Equal(t, otherRecord.SerialNumber, cache.ListRevoked()[0].SerialNumber)
+
+		cancel()
+		managerMock.AssertExpectations(t)
+	})
+
+	t.Run("should list records again when there is error event", func(t *testing.T) {
+		// given
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		watcher := &testWatch{events: make(chan watch.Event, 100)}
+		newWatcher := &testWatch{events: make(chan watch.Event, 100)}
+		managerMock := &mocks.Manager{}
+		managerMock.On("List", mock.AnythingOfType("v1.ListOptions")).Return(nil, errors.New("some error")).Once()
+		managerMock.On("List", mock.AnythingOfType("v1.ListOptions")).Return(fixList("10", record), nil).Once()
+		managerMock.On("List", mock.AnythingOfType("v1.ListOptions")).Return(fixList("20", otherRecord), nil).Once()
+		managerMock.On("Watch", mock.AnythingOfType("v1.ListOptions")).Return(watcher, nil).Once()
+		managerMock.On("Watch", mock.AnythingOfType("v1.ListOptions")).Return(newWatcher, nil).Once()
+
+		// when
+		watcher.putEvent(watch.Event{Type: watch.Error})
+		cache := run(ctx, managerMock)
+
+		// then
+		assert.Eventually(t, func() bool {
+			_, found := cache.GetByHash(otherRecord.Hash)
+			return found
+		}, time.Second*2, time.Millisecond*100)
+		_, found := cache.GetByHash(record.Hash)
+		assert.False(t, found)
+
+		cancel()
+		managerMock.AssertExpectations(t)
+	})
+
+	t.Run("should delete records of expired certificates", func(t *testing.T) {
+		// given
+		otherExpiredRecord := expiredRecord
+		otherExpiredRecord.SerialNumber = "7a8b"
+		otherExpiredRecord.Hash = "8b9c0d"
+
+		cache := issuance.NewCache()
+		cache.Replace([]issuance.Record{record, expiredRecord, otherExpiredRecord})
+
+		managerMock := &mocks.Manager{}
+		managerMock.On("Delete", issuance.ConfigMapName(expiredRecord.Hash), &metav1.DeleteOptions{}).Return(nil).Once()
+		managerMock.On("Delete", issuance.ConfigMapName(otherExpiredRecord.Hash), &metav1.DeleteOptions{}).
+			Return(k8serrors.NewNotFound(schema.GroupResource{Resource: "configmaps"}, issuance.ConfigMapName(otherExpiredRecord.Hash))).Once()
+
+		loader := issuance.NewLoader(cache, managerMock, time.Millisecond, time.Hour)
+
+		// when
+		issuance.PruneExpired(context.TODO(), loader, now)
+
+		// then
+		managerMock.AssertExpectations(t)
+		_, found := cache.GetByHash(record.Hash)
+		require.True(t, found)
+	})
+}
+
+type testWatch struct {
+	events chan watch.Event
+}
+
+func (tw *testWatch) putEvent(ev watch.Event) {
+	tw.events <- ev
+}
+
+func (tw *testWatch) Stop() {}
+func (tw *testWatch) ResultChan() <-chan watch.Event {
+	return tw.events
+}
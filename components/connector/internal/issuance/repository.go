@@ -0,0 +1,160 @@
+package issuance
+
+import (
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	v1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/util/retry"
+)
+
+const (
+	// RecordLabel marks the config maps holding the issuance records
+	RecordLabel = "connector.compass.kyma-project.io/issued-certificate"
+
+	configMapNamePrefix = "issued-certificate-"
+	recordKey           = "record"
+)
+
+// Record is the issuance record of a certificate signed by the connector
+type Record struct {
+	SerialNumber string     `json:"serialNumber"`
+	CommonName   string     `json:"commonName"`
+	Hash         string     `json:"hash"`
+	NotAfter     time.Time  `json:"notAfter"`
+	RevokedAt    *time.Time `json:"revokedAt,omitempty"`
+	Profile      string     `json:"profile,omitempty"`
+}
+
+// IsRevoked returns true if the certificate has been revoked
+func (r Record) IsRevoked() bool {
+	return r.RevokedAt != nil
+}
+
+//go:generate mockery --name=Manager
+type Manager interface {
+	Create(configMap *v1.ConfigMap) (*v1.ConfigMap, error)
+	Get(name string, options metav1.GetOptions) (*v1.ConfigMap, error)
+	Update(configMap *v1.ConfigMap) (*v1.ConfigMap, error)
+	Delete(name string, options *metav1.DeleteOptions) error
+	List(opts metav1.ListOptions) (*v1.ConfigMapList, error)
+	Watch(opts metav1.ListOptions) (watch.Interface, error)
+}
+
+//go:generate mockery --name=Repository
+type Repository interface {
+	Insert(record Record) error
+	Revoke(hash string, revokedAt time.Time) (bool, error)
+	Get(serialNumber string) (Record, bool)
+	GetByHash(hash string) (Record, bool)
+	ListRevoked() []Record
+}
+
+// repository keeps every issuance record in its own config map, named after the hash of the certificate, so that records
+// are written without reading the other ones. Records are read from the cache, which is kept up to date by the Loader.
+type repository struct {
+	configMapManager Manager
+	recordsCache     Cache
+}
+
+func NewRepository(configMapManager Manager, recordsCache Cache) Repository {
+	return &repository{
+		configMapManager: configMapManager,
+		recordsCache:     recordsCache,
+	}
+}
+
+func (r *repository) Insert(record Record) error {
+	configMap := &v1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   configMapName(record.Hash),
+			Labels: map[string]string{RecordLabel: "true"},
+		},
+	}
+	if err := setRecord(configMap, record); err != nil {
+		return err
+	}
+
+	_, err := r.configMapManager.Create(configMap)
+	return errors.Wrapf(err, "while creating record of certificate with serial number %s", record.SerialNumber)
+}
+
+// Revoke marks the record of the certificate with the given hash as revoked. The record is read again on every conflict,
+// so that concurrent revocations do not fail.
+func (r *repository) Revoke(hash string, revokedAt time.Time) (bool, error) {
+	found := false
+	err := retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		configMap, err := r.configMapManager.Get(configMapName(hash), metav1.GetOptions{})
+		if k8serrors.IsNotFound(err) {
+			found = false
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		found = true
+		record, err := recordFromConfigMap(configMap)
+		if err != nil {
+			return err
+		}
+		if record.IsRevoked() {
+			return nil
+		}
+
+		record.RevokedAt = &revokedAt
+		if err := setRecord(configMap, record); err != nil {
+			return err
+		}
+
+		_, err = r.configMapManager.Update(configMap)
+		return err
+	})
+
+	return found, err
+}
+
+func (r *repository) Get(serialNumber string) (Record, bool) {
+	return r.recordsCache.Get(serialNumber)
+}
+
+func (r *repository) GetByHash(hash string) (Record, bool) {
+	return r.recordsCache.GetByHash(hash)
+}
+
+func (r *repository) ListRevoked() []Record {
+	return r.recordsCache.ListRevoked()
+}
+
+// configMapName returns the name of the config map holding the record of the certificate with the given hex encoded hash
+func configMapName(hash string) string {
+	return configMapNamePrefix + strings.ToLower(hash)
+}
+
+func setRecord(configMap *v1.ConfigMap, record Record) error {
+	marshalled, err := json.Marshal(record)
+	if err != nil {
+		return errors.Wrapf(err, "while marshalling record of certificate with serial number %s", record.SerialNumber)
+	}
+
+	configMap.Data = map[string]string{recordKey: string(marshalled)}
+	return nil
+}
+
+func recordFromConfigMap(configMap *v1.ConfigMap) (Record, error) {
+	value, found := configMap.Data[recordKey]
+	if !found {
+		return Record{}, errors.Errorf("config map %s does not contain an issuance record", configMap.Name)
+	}
+
+	var record Record
+	if err := json.Unmarshal([]byte(value), &record); err != nil {
+		return Record{}, errors.Wrapf(err, "while unmarshalling issuance record of config map %s", configMap.Name)
+	}
+	return record, nil
+}
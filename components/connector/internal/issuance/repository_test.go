@@ -0,0 +1,236 @@
+package issuance_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/connector/internal/issuance"
+	"github.com/kyma-incubator/compass/components/connector/internal/issuance/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func TestRepository(t *testing.T) {
+	now := time.Date(2021, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	record := issuance.Record{
+		SerialNumber: "1a2b",
+		CommonName:   "app",
+		Hash:         "0a1b2c",
+		NotAfter:     now.Add(time.Hour),
+	}
+	revokedAt := now.Add(-time.Minute)
+	revokedRecord := issuance.Record{
+		SerialNumber: "3c4d",
+		CommonName:   "other-app",
+		Hash:         "3d4e5f",
+		NotAfter:     now.Add(time.Hour),
+		RevokedAt:    &revokedAt,
+	}
+	conflictErr := k8serrors.NewConflict(schema.GroupResource{Resource: "configmaps"}, issuance.ConfigMapName(record.Hash), errors.New("conflict"))
+
+	t.Run("should create config map with record", func(t *testing.T) {
+		// given
+		configMapManagerMock := &mocks.Manager{}
+		configMapManagerMock.On("Create", fixRecordConfigMap(t, record)).Return(nil, nil)
+
+		repo := issuance.NewRepository(configMapManagerMock, issuance.NewCache())
+
+		// when
+		err := repo.Insert(record)
+
+		// then
+		require.NoError(t, err)
+		configMapManagerMock.AssertExpectations(t)
+	})
+
+	t.Run("should return error when failed to create config map", func(t *testing.T) {
+		// given
+		configMapManagerMock := &mocks.Manager{}
+		configMapManagerMock.On("Create", fixRecordConfigMap(t, record)).Return(nil, errors.New("some error"))
+
+		repo := issuance.NewRepository(configMapManagerMock, issuance.NewCache())
+
+		// when
+		err := repo.Insert(record)
+
+		// then
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "some error")
+		configMapManagerMock.AssertExpectations(t)
+	})
+
+	t.Run("should revoke record with matching hash", func(t *testing.T) {
+		// given
+		revoked := record
+		revoked.RevokedAt = &now
+
+		configMapManagerMock := &mocks.Manager{}
+		configMapManagerMock.On("Get", issuance.ConfigMapName(record.Hash), metav1.GetOptions{}).Return(fixRecordConfigMap(t, record), nil)
+		configMapManagerMock.On("Update", fixRecordConfigMap(t, revoked)).Return(nil, nil)
+
+		repo := issuance.NewRepository(configMapManagerMock, issuance.NewCache())
+
+		// when
+		found, err := repo.Revoke(record.Hash, now)
+
+		// then
+		require.NoError(t, err)
+		assert.True(t, found)
+		configMapManagerMock.AssertExpectations(t)
+	})
+
+	t.Run("should read record again when update conflicts", func(t *testing.T) {
+		// given
+		revoked := record
+		revoked.RevokedAt = &now
+
+		configMapManagerMock := &mocks.Manager{}
+		configMapManagerMock.On("Get", issuance.ConfigMapName(record.Hash), metav1.GetOptions{}).Return(func(string, metav1.GetOptions) *v1.ConfigMap {
+			return fixRecordConfigMap(t, record)
+		}, nil).Twice()
+		configMapManagerMock.On("Update", fixRecordConfigMap(t, revoked)).Return(nil, conflictErr).Once()
+		configMapManagerMock.On("Update", fixRecordConfigMap(t, revoked)).Return(nil, nil).Once()
+
+		repo := issuance.NewRepository(configMapManagerMock, issuance.NewCache())
+
+		// when
+		found, err := repo.Revoke(record.Hash, now)
+
+		// then
+		require.NoError(t, err)
+		assert.True(t, found)
+		configMapManagerMock.AssertExpectations(t)
+	})
+
+	t.Run("should not update config map when record is already revoked", func(t *testing.T) {
+		// given
+		configMapManagerMock := &mocks.Manager{}
+		configMapManagerMock.On("Get", issuance.ConfigMapName(revokedRecord.Hash), metav1.GetOptions{}).Return(fixRecordConfigMap(t, revokedRecord), nil)
+
+		repo := issuance.NewRepository(configMapManagerMock, issuance.NewCache())
+
+		// when
+		found, err := repo.Revoke(revokedRecord.Hash, now)
+
+		// then
+		require.NoError(t, err)
+		assert.True(t, found)
+		configMapManagerMock.AssertExpectations(t)
+	})
+
+	t.Run("should return false when no record matches the hash", func(t *testing.T) {
+		// given
+		configMapManagerMock := &mocks.Manager{}
+		configMapManagerMock.On("Get", issuance.ConfigMapName("abcd"), metav1.GetOptions{}).
+			Return(nil, k8serrors.NewNotFound(schema.GroupResource{Resource: "configmaps"}, issuance.ConfigMapName("abcd")))
+
+		repo := issuance.NewRepository(configMapManagerMock, issuance.NewCache())
+
+		// when
+		found, err := repo.Revoke("ABCD", now)
+
+		// then
+		require.NoError(t, err)
+		assert.False(t, found)
+		configMapManagerMock.AssertExpectations(t)
+	})
+
+	t.Run("should return error when failed to get config map", func(t *testing.T) {
+		// given
+		configMapManagerMock := &mocks.Manager{}
+		configMapManagerMock.On("Get", issuance.ConfigMapName(record.Hash), metav1.GetOptions{}).Return(nil, errors.New("some error"))
+
+		repo := issuance.NewRepository(configMapManagerMock, issuance.NewCache())
+
+		// when
+		_, err := repo.Revoke(record.Hash, now)
+
+		// then
+		require.Error(t, err)
+		configMapManagerMock.AssertExpectations(t)
+	})
+
+	t.Run("should get records from cache", func(t *testing.T) {
+		// given
+		profileRecord := record
+		profileRecord.Profile = "runtime"
+
+		cache := issuance.NewCache()
+		cache.Replace([]issuance.Record{profileRecord, revokedRecord})
+		configMapManagerMock := &mocks.Manager{}
+
+		repo := issuance.NewRepository(configMapManagerMock, cache)
+
+		// when
+		bySerial, bySerialOk := repo.Get(record.SerialNumber)
+		byHash, byHashOk := repo.GetByHash(record.Hash)
+		_, missingSerialOk := repo.Get("missing")
+		_, missingHashOk := repo.GetByHash("missingHash")
+
+		// then
+		assert.True(t, bySerialOk)
+		assert.Equal(t, profileRecord, bySerial)
+		assert.True(t, byHashOk)
+		assert.Equal(t, profileRecord, byHash)
+		assert.False(t, missingSerialOk)
+		assert.False(t, missingHashOk)
+		configMapManagerMock.AssertExpectations(t)
+	})
+
+	t.Run("should list revoked records from cache", func(t *testing.T) {
+		// given
+		cache := issuance.NewCache()
+		cache.Replace([]issuance.Record{record, revokedRecord})
+		configMapManagerMock := &mocks.Manager{}
+
+		repo := issuance.NewRepository(configMapManagerMock, cache)
+
+		// when
+		revokedBefore := repo.ListRevoked()
+		cache.Put(issuance.Record{SerialNumber: revokedRecord.SerialNumber, Hash: revokedRecord.Hash, NotAfter: revokedRecord.NotAfter})
+		revokedAfter := repo.ListRevoked()
+
+		// then
+		assert.Equal(t, []issuance.Record{revokedRecord}, revokedBefore)
+		assert.Empty(t, revokedAfter)
+		configMapManagerMock.AssertExpectations(t)
+	})
+
+	t.Run("should not list revoked records which are deleted from cache", func(t *testing.T) {
+		// given
+		cache := issuance.NewCache()
+		cache.Replace([]issuance.Record{record, revokedRecord})
+		configMapManagerMock := &mocks.Manager{}
+
+		repo := issuance.NewRepository(configMapManagerMock, cache)
+
+		// when
+		cache.Delete(revokedRecord.Hash)
+
+		// then
+		assert.Empty(t, repo.ListRevoked())
+		_, found := repo.Get(revokedRecord.SerialNumber)
+		assert.False(t, found)
+		configMapManagerMock.AssertExpectations(t)
+	})
+}
+
+func fixRecordConfigMap(t *testing.T, record issuance.Record) *v1.ConfigMap {
+	marshalled, err := json.Marshal(record)
+	require.NoError(t, err)
+
+	return &v1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   issuance.ConfigMapName(record.Hash),
+			Labels: map[string]string{issuance.RecordLabel: "true"},
+		},
+		Data: map[string]string{issuance.RecordKey: string(marshalled)},
+	}
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/kyma-incubator/compass/components/connector/config"
 	"github.com/kyma-incubator/compass/components/connector/internal/api"
 	"github.com/kyma-incubator/compass/components/connector/internal/authentication"
+	"github.com/kyma-incubator/compass/components/connector/internal/certstatus"
 	"github.com/kyma-incubator/compass/components/connector/internal/tokens"
 	gcliMocks "github.com/kyma-incubator/compass/components/connector/internal/tokens/automock"
 	"github.com/kyma-incubator/compass/components/connector/pkg/oathkeeper"
@@ -33,6 +34,7 @@ const (
 
 	testSecretName    = "test-secret"
 	testConfigMapName = "test-secret"
+	issuanceNamespace = "default"
 	oneTimeTokenURL   = "http://director.com"
 	clientID          = "abcd-efgh"
 )
@@ -47,6 +49,8 @@ func TestMain(m *testing.M) {
 	exitOnError(err, "Error setting APP_CA_SECRET_NAME env")
 	err = os.Setenv("APP_REVOCATION_CONFIG_MAP_NAME", testConfigMapName)
 	exitOnError(err, "Error setting APP_CA_SECRET_NAME env")
+	err = os.Setenv("APP_ISSUED_CERTIFICATES_NAMESPACE", issuanceNamespace)
+	exitOnError(err, "Error setting APP_ISSUED_CERTIFICATES_NAMESPACE env")
 	err = os.Setenv("APP_ONE_TIME_TOKEN_URL", oneTimeTokenURL)
 	exitOnError(err, "Error setting APP_ONE_TIME_TOKEN_URL env")
 
@@ -77,21 +81,29 @@ func TestMain(m *testing.M) {
 	directorGCLI := &gcliMocks.GraphQLClient{}
 	directorGCLI.On("Run", mock.Anything, mock.Anything, mock.Anything).
 		Run(GenerateTestToken(tokens.NewCSRTokenResponse("abcd"))).Return(nil).Twice()
-	internalComponents, certsLoader, revokedCertsLoader := config.InitInternalComponents(cfg, k8sClientSet, directorGCLI)
+	internalComponents, certsLoader, revokedCertsLoader, issuanceLoader := config.InitInternalComponents(cfg, k8sClientSet, directorGCLI)
 
 	go certsLoader.Run(context.TODO())
 	go revokedCertsLoader.Run(context.TODO())
+	go issuanceLoader.Run(context.TODO())
 
 	externalAPIUrl = fmt.Sprintf("https://%s%s", cfg.ExternalAddress, cfg.APIEndpoint)
 
+	certificateProfiles, err := config.NewCertificateProfiles(cfg)
+	exitOnError(err, "Error while parsing certificate profiles")
+
 	certificateResolver := api.NewCertificateResolver(
 		internalComponents.Authenticator,
 		internalComponents.TokenService,
 		internalComponents.CertificateService,
 		internalComponents.CSRSubjectConsts,
+		certificateProfiles,
 		cfg.DirectorURL,
 		cfg.CertificateSecuredConnectorURL,
-		internalComponents.RevokedCertsRepository)
+		internalComponents.RevokedCertsRepository,
+		internalComponents.IssuanceRepository)
+
+	certStatusHandler := certstatus.NewHandler(internalComponents.CertificateService, internalComponents.IssuanceRepository, cfg.CRLValidityTime)
 
 	authContextTestMiddleware := func(handler http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -116,7 +128,7 @@ func TestMain(m *testing.M) {
 		})
 	}
 
-	externalGqlServer, err := config.PrepareExternalGraphQLServer(cfg, certificateResolver, authContextTestMiddleware)
+	externalGqlServer, err := config.PrepareExternalGraphQLServer(cfg, certificateResolver, certStatusHandler, authContextTestMiddleware)
 	exitOnError(err, "Error configuring external graphQL handler")
 
 	externalGqlServer.TLSConfig = &tls.Config{ClientAuth: tls.RequestClientCert}
@@ -3,8 +3,11 @@
 package externalschema
 
 type CertificateSigningRequestInfo struct {
-	Subject      string `json:"subject"`
-	KeyAlgorithm string `json:"keyAlgorithm"`
+	Subject                string   `json:"subject"`
+	KeyAlgorithm           string   `json:"keyAlgorithm"`
+	SupportedKeyAlgorithms []string `json:"supportedKeyAlgorithms"`
+	Profile                string   `json:"profile"`
+	Validity               string   `json:"validity"`
 }
 
 type CertificationResult struct {
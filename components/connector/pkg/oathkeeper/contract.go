@@ -8,6 +8,7 @@ const (
 	ConnectorTokenQueryParam string = "token"
 
 	ClientIdFromTokenHeader       = "Client-Id-From-Token"
+	ClientTypeFromTokenHeader     = "Client-Type-From-Token"
 	ClientIdFromCertificateHeader = "Client-Id-From-Certificate"
 	ClientCertificateHashHeader   = "Client-Certificate-Hash"
 )
@@ -15,6 +15,7 @@ import (
 	graphqlAPI "github.com/kyma-incubator/compass/components/director/internal/api"
 	mp_authenticator "github.com/kyma-incubator/compass/components/director/internal/authenticator"
 	"github.com/kyma-incubator/compass/components/director/internal/authnmappinghandler"
+	"github.com/kyma-incubator/compass/components/director/internal/dataloader"
 	"github.com/kyma-incubator/compass/components/director/internal/domain"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/api"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/application"
@@ -24,11 +25,16 @@ import (
 	"github.com/kyma-incubator/compass/components/director/internal/domain/document"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/eventdef"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/fetchrequest"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/healthcheck"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/integrationsystem"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/label"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/labeldef"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/oauth20"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/onetimetoken"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/operationhistory"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/ordvendor"
+	mp_package "github.com/kyma-incubator/compass/components/director/internal/domain/package"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/product"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/runtime"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/scenarioassignment"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/spec"
@@ -36,15 +42,20 @@ import (
 	"github.com/kyma-incubator/compass/components/director/internal/domain/tenant"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/version"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/webhook"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/webhookdelivery"
 	"github.com/kyma-incubator/compass/components/director/internal/error_presenter"
 	"github.com/kyma-incubator/compass/components/director/internal/features"
 	"github.com/kyma-incubator/compass/components/director/internal/healthz"
+	"github.com/kyma-incubator/compass/components/director/internal/joblease"
 	"github.com/kyma-incubator/compass/components/director/internal/metrics"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
 	"github.com/kyma-incubator/compass/components/director/internal/oathkeeper"
+	"github.com/kyma-incubator/compass/components/director/internal/ordservice"
 	"github.com/kyma-incubator/compass/components/director/internal/packagetobundles"
 	"github.com/kyma-incubator/compass/components/director/internal/panic_handler"
+	"github.com/kyma-incubator/compass/components/director/internal/querylimit"
 	"github.com/kyma-incubator/compass/components/director/internal/runtimemapping"
+	"github.com/kyma-incubator/compass/components/director/internal/specrefetch"
 	"github.com/kyma-incubator/compass/components/director/internal/statusupdate"
 	"github.com/kyma-incubator/compass/components/director/internal/tenantmapping"
 	"github.com/kyma-incubator/compass/components/director/internal/uid"
@@ -61,6 +72,7 @@ import (
 	"github.com/kyma-incubator/compass/components/director/pkg/log"
 	"github.com/kyma-incubator/compass/components/director/pkg/normalizer"
 	"github.com/kyma-incubator/compass/components/director/pkg/operation"
+	"github.com/kyma-incubator/compass/components/director/pkg/operation/db"
 	"github.com/kyma-incubator/compass/components/director/pkg/operation/k8s"
 	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
 	"github.com/kyma-incubator/compass/components/director/pkg/resource"
@@ -78,6 +90,11 @@ import (
 
 const envPrefix = "APP"
 
+const (
+	kubernetesOperationsScheduler = "kubernetes"
+	databaseOperationsScheduler   = "database"
+)
+
 type config struct {
 	Address                string `envconfig:"default=127.0.0.1:3000"`
 	InternalGraphQLAddress string `envconfig:"default=127.0.0.1:3001"`
@@ -117,6 +134,15 @@ type config struct {
 
 	OneTimeToken onetimetoken.Config
 	OAuth20      oauth20.Config
+	ORDService   ordservice.Config
+	SpecRefetch  specrefetch.Config
+	HealthCheck  healthcheck.ProberConfig
+
+	WebhookDelivery webhookdelivery.Config
+
+	QueryLimits querylimit.Config
+
+	Dataloader dataloader.Config
 
 	Features features.Config
 
@@ -124,6 +150,10 @@ type config struct {
 	OperationsNamespace   string `envconfig:"default=compass-system"`
 
 	DisableAsyncMode bool `envconfig:"default=false"`
+	// OperationsScheduler selects where async operations are scheduled - either as Operation resources processed by the operations controller
+	// in the Kubernetes cluster (kubernetes), or in the database from which they are processed by the director itself (database)
+	OperationsScheduler string `envconfig:"default=kubernetes"`
+	OperationsWorker    db.Config
 }
 
 func main() {
@@ -165,27 +195,31 @@ func main() {
 
 	httpClient := &http.Client{
 		Timeout:   cfg.ClientTimeout,
-		Transport: httputil.NewCorrelationIDTransport(http.DefaultTransport),
+		Transport: httputil.NewCorrelationIDTransport(httputil.NewSigningTransport(httputil.NewClientCertificateTransport(http.DefaultTransport.(*http.Transport).Clone()))),
 	}
 
 	appRepo := applicationRepo()
+	runtimeRepo := runtime.NewRepository()
+	historyRecorder := operationHistoryService()
+
+	rootResolver := domain.NewRootResolver(
+		&normalizer.DefaultNormalizator{},
+		transact,
+		cfgProvider,
+		cfg.OneTimeToken,
+		cfg.OAuth20,
+		pairingAdapters,
+		cfg.Features,
+		metricsCollector,
+		httpClient,
+		cfg.ProtectedLabelPattern,
+		cfg.OneTimeToken.Length,
+	)
 
 	gqlCfg := graphql.Config{
-		Resolvers: domain.NewRootResolver(
-			&normalizer.DefaultNormalizator{},
-			transact,
-			cfgProvider,
-			cfg.OneTimeToken,
-			cfg.OAuth20,
-			pairingAdapters,
-			cfg.Features,
-			metricsCollector,
-			httpClient,
-			cfg.ProtectedLabelPattern,
-			cfg.OneTimeToken.Length,
-		),
+		Resolvers: rootResolver,
 		Directives: graphql.DirectiveRoot{
-			Async:       getAsyncDirective(ctx, cfg, transact, appRepo),
+			Async:       getAsyncDirective(ctx, cfg, transact, appRepo, runtimeRepo, historyRecorder),
 			HasScenario: scenario.NewDirective(transact, label.NewRepository(label.NewConverter()), bundleRepo(), bundleInstanceAuthRepo()).HasScenario,
 			HasScopes:   scope.NewDirective(cfgProvider).VerifyScopes,
 			Validate:    inputvalidation.NewDirective().Validate,
@@ -208,6 +242,54 @@ func main() {
 		go periodicExecutor.Run(ctx)
 	}
 
+	if cfg.SpecRefetch.RefetchInterval != 0 {
+		logger.Infof("Specification refetching enabled. Refetch interval: %v", cfg.SpecRefetch.RefetchInterval)
+		refetchSvc := specRefetchService(cfg, transact, httpClient)
+		periodicExecutor := executor.NewPeriodic(cfg.SpecRefetch.JobInterval, func(ctx context.Context) {
+			err := refetchSvc.RefetchDueSpecs(ctx)
+			if err != nil {
+				logger.WithError(err).Error("An error has occurred while refetching Specifications")
+			}
+		})
+		go periodicExecutor.Run(ctx)
+	}
+
+	if cfg.HealthCheck.Interval != 0 {
+		logger.Infof("Application health checks enabled. Interval: %v", cfg.HealthCheck.Interval)
+		prober := healthCheckProber(cfg, transact, httpClient)
+		periodicExecutor := executor.NewPeriodic(cfg.HealthCheck.Interval, func(ctx context.Context) {
+			err := prober.ProbeApplications(ctx)
+			if err != nil {
+				logger.WithError(err).Error("An error has occurred while checking the health of Applications")
+			}
+		})
+		go periodicExecutor.Run(ctx)
+	}
+
+	if cfg.WebhookDelivery.Interval != 0 {
+		logger.Infof("Configuration changed webhook deliveries enabled. Interval: %v", cfg.WebhookDelivery.Interval)
+		dispatcher := webhookDeliveryService(cfg, transact, httpClient)
+		periodicExecutor := executor.NewPeriodic(cfg.WebhookDelivery.Interval, func(ctx context.Context) {
+			err := dispatcher.Dispatch(ctx)
+			if err != nil {
+				logger.WithError(err).Error("An error has occurred while delivering webhooks")
+			}
+		})
+		go periodicExecutor.Run(ctx)
+	}
+
+	if !cfg.DisableAsyncMode && cfg.OperationsScheduler == databaseOperationsScheduler {
+		logger.Infof("Database operations worker enabled. Interval: %v", cfg.OperationsWorker.Interval)
+		worker := operationsWorker(cfg, transact, appRepo, runtimeRepo, historyRecorder, httpClient)
+		periodicExecutor := executor.NewPeriodic(cfg.OperationsWorker.Interval, func(ctx context.Context) {
+			err := worker.Process(ctx)
+			if err != nil {
+				logger.WithError(err).Error("An error has occurred while processing scheduled operations")
+			}
+		})
+		go periodicExecutor.Run(ctx)
+	}
+
 	packageToBundlesMiddleware := packagetobundles.NewHandler(transact)
 
 	statusMiddleware := statusupdate.New(transact, statusupdate.NewRepository())
@@ -224,9 +306,14 @@ func main() {
 	gqlAPIRouter.Use(authMiddleware.Handler())
 	gqlAPIRouter.Use(packageToBundlesMiddleware.Handler())
 	gqlAPIRouter.Use(statusMiddleware.Handler())
+	gqlAPIRouter.Use(dataloader.NewHandler(rootResolver.DataloaderFetchers(), cfg.Dataloader).Handler())
+
+	queryLimitExtension, err := querylimit.NewExtension(cfg.QueryLimits, executableSchema.Schema(), metricsCollector, directorTime.NewService())
+	exitOnError(err, "Error while configuring GraphQL query limits")
 
 	gqlServ := handler.NewDefaultServer(executableSchema)
 	gqlServ.Use(operationMiddleware)
+	gqlServ.Use(queryLimitExtension)
 	gqlServ.SetErrorPresenter(presenter.Do)
 	gqlServ.SetRecoverFunc(panic_handler.RecoverFn)
 
@@ -250,21 +337,39 @@ func main() {
 
 	mainRouter.HandleFunc(cfg.AuthenticationMappingEndpoint, authnMappingHandlerFunc.ServeHTTP)
 
-	operationHandler := operation.NewHandler(transact, func(ctx context.Context, tenantID, resourceID string) (model.Entity, error) {
-		return appRepo.GetByID(ctx, tenantID, resourceID)
-	}, tenant.LoadFromContext)
+	operationHandler := operation.NewHandler(transact, resourceFetcherFuncs(appRepo, runtimeRepo), tenant.LoadFromContext)
 
 	operationsAPIRouter := mainRouter.PathPrefix(cfg.LastOperationPath).Subrouter()
 	operationsAPIRouter.Use(authMiddleware.Handler())
 	operationsAPIRouter.HandleFunc("/{resource_type}/{resource_id}", operationHandler.ServeHTTP)
 
-	operationUpdaterHandler := operation.NewUpdateOperationHandler(transact, map[resource.Type]operation.ResourceUpdaterFunc{
+	operationManager, err := buildOperationManager(ctx, cfg)
+	exitOnError(err, "Error while creating operations manager")
+	recordingOperationManager := operation.NewRecordingManager(operationManager, historyRecorder)
+
+	resourceUpdaterFuncs := map[resource.Type]operation.ResourceUpdaterFunc{
 		resource.Application: appUpdaterFunc(appRepo),
-	}, map[resource.Type]operation.ResourceDeleterFunc{
-		resource.Application: func(ctx context.Context, id string) error {
-			return appRepo.DeleteGlobal(ctx, id)
-		},
-	})
+		resource.Runtime:     runtimeUpdaterFunc(runtimeRepo),
+	}
+
+	retryOperationHandler := operation.NewRetryOperationHandler(transact, resourceFetcherFuncs(appRepo, runtimeRepo), resourceUpdaterFuncs, tenant.LoadFromContext, cfgProvider, recordingOperationManager)
+	operationsAPIRouter.HandleFunc("/{resource_type}/{resource_id}/retry", retryOperationHandler.ServeHTTP).Methods(http.MethodPost)
+
+	cancelOperationHandler := operation.NewCancelOperationHandler(transact, resourceFetcherFuncs(appRepo, runtimeRepo), resourceUpdaterFuncs, tenant.LoadFromContext, cfgProvider, recordingOperationManager)
+	operationsAPIRouter.HandleFunc("/{resource_type}/{resource_id}/cancel", cancelOperationHandler.ServeHTTP).Methods(http.MethodPost)
+
+	operationUpdaterHandler := operation.NewUpdateOperationHandler(transact, resourceUpdaterFuncs, resourceDeleterFuncs(appRepo, runtimeRepo), historyRecorder)
+
+	logger.Infof("Registering ORD service endpoints on %s and %s...", cfg.ORDService.APIEndpoint, cfg.ORDService.StaticEndpoint)
+	ordHandler := ordServiceHandler(cfg, transact, cfgProvider, httpClient)
+
+	ordAPIRouter := mainRouter.PathPrefix(cfg.ORDService.APIEndpoint).Subrouter()
+	ordAPIRouter.Use(authMiddleware.Handler(), ordservice.ScopesMiddleware(cfgProvider))
+	ordHandler.RegisterRoutes(ordAPIRouter)
+
+	ordStaticRouter := mainRouter.PathPrefix(cfg.ORDService.StaticEndpoint).Subrouter()
+	ordStaticRouter.Use(authMiddleware.Handler(), ordservice.ScopesMiddleware(cfgProvider))
+	ordHandler.RegisterStaticRoutes(ordStaticRouter)
 
 	internalRouter := mux.NewRouter()
 	internalRouter.Use(correlation.AttachCorrelationIDToContext(), log.RequestLogger(), header.AttachHeadersToContext())
@@ -495,6 +600,11 @@ func applicationRepo() application.ApplicationRepository {
 	return application.NewRepository(appConverter)
 }
 
+func operationHistoryService() operation.HistoryRecorder {
+	operationRepo := operationhistory.NewRepository(operationhistory.NewConverter())
+	return operationhistory.NewService(operationRepo, uid.NewService())
+}
+
 func webhookService() webhook.WebhookService {
 	uidSvc := uid.NewService()
 	authConverter := auth.NewConverter()
@@ -566,7 +676,7 @@ func tokenService(cfg config, cfgProvider *configprovider.Provider, httpClient *
 	fetchRequestSvc := fetchrequest.NewService(fetchRequestRepo, httpClient)
 	eventAPIRepo := eventdef.NewRepository(eventAPIConverter)
 	specRepo := spec.NewRepository(specConverter)
-	specSvc := spec.NewService(specRepo, fetchRequestRepo, uidSvc, fetchRequestSvc)
+	specSvc := spec.NewService(specRepo, fetchRequestRepo, uidSvc, fetchRequestSvc, cfg.Features.ValidateSpecs, cfg.Features.ConvertSpecsToJSON)
 	apiSvc := api.NewService(apiRepo, uidSvc, specSvc)
 	eventAPISvc := eventdef.NewService(eventAPIRepo, uidSvc, specSvc)
 	documentSvc := document.NewService(docRepo, fetchRequestRepo, uidSvc)
@@ -576,6 +686,117 @@ func tokenService(cfg config, cfgProvider *configprovider.Provider, httpClient *
 	return onetimetoken.NewTokenService(systemAuthSvc, appSvc, appConverter, tenantSvc, httpClient, onetimetoken.NewTokenGenerator(cfg.OneTimeToken.Length), cfg.OneTimeToken.ConnectorURL, pairingAdapters, timeService)
 }
 
+func ordServiceHandler(cfg config, transact persistence.Transactioner, cfgProvider *configprovider.Provider, httpClient *http.Client) *ordservice.Handler {
+	uidSvc := uid.NewService()
+	authConverter := auth.NewConverter()
+	labelConverter := label.NewConverter()
+	frConverter := fetchrequest.NewConverter(authConverter)
+	versionConverter := version.NewConverter()
+	specConverter := spec.NewConverter(frConverter)
+	intSysConverter := integrationsystem.NewConverter()
+	webhookConverter := webhook.NewConverter(authConverter)
+	eventAPIConverter := eventdef.NewConverter(versionConverter, specConverter)
+	apiConverter := api.NewConverter(versionConverter, specConverter)
+	docConverter := document.NewConverter(frConverter)
+	bundleConverter := mp_bundle.NewConverter(authConverter, apiConverter, eventAPIConverter, docConverter)
+	appConverter := application.NewConverter(webhookConverter, bundleConverter)
+	labelDefConverter := labeldef.NewConverter()
+	pkgConverter := mp_package.NewConverter()
+	productConverter := product.NewConverter()
+	vendorConverter := ordvendor.NewConverter()
+
+	intSysRepo := integrationsystem.NewRepository(intSysConverter)
+	applicationRepo := application.NewRepository(appConverter)
+	webhookRepo := webhook.NewRepository(webhookConverter)
+	runtimeRepo := runtime.NewRepository()
+	labelRepo := label.NewRepository(labelConverter)
+	labelDefRepo := labeldef.NewRepository(labelDefConverter)
+	bundleRepo := mp_bundle.NewRepository(bundleConverter)
+	apiRepo := api.NewRepository(apiConverter)
+	eventAPIRepo := eventdef.NewRepository(eventAPIConverter)
+	docRepo := document.NewRepository(docConverter)
+	fetchRequestRepo := fetchrequest.NewRepository(frConverter)
+	specRepo := spec.NewRepository(specConverter)
+	pkgRepo := mp_package.NewRepository(pkgConverter)
+	productRepo := product.NewRepository(productConverter)
+	vendorRepo := ordvendor.NewRepository(vendorConverter)
+
+	labelUpsertSvc := label.NewLabelUpsertService(labelRepo, labelDefRepo, uidSvc)
+	scenariosSvc := labeldef.NewScenariosService(labelDefRepo, uidSvc, cfg.Features.DefaultScenarioEnabled)
+	fetchRequestSvc := fetchrequest.NewService(fetchRequestRepo, httpClient)
+	specSvc := spec.NewService(specRepo, fetchRequestRepo, uidSvc, fetchRequestSvc, cfg.Features.ValidateSpecs, cfg.Features.ConvertSpecsToJSON)
+	apiSvc := api.NewService(apiRepo, uidSvc, specSvc)
+	eventAPISvc := eventdef.NewService(eventAPIRepo, uidSvc, specSvc)
+	documentSvc := document.NewService(docRepo, fetchRequestRepo, uidSvc)
+	bundleSvc := mp_bundle.NewService(bundleRepo, apiSvc, eventAPISvc, documentSvc, uidSvc)
+	appSvc := application.NewService(&normalizer.DefaultNormalizator{}, cfgProvider, applicationRepo, webhookRepo, runtimeRepo, labelRepo, intSysRepo, labelUpsertSvc, scenariosSvc, bundleSvc, uidSvc)
+	pkgSvc := mp_package.NewService(pkgRepo, uidSvc)
+	productSvc := product.NewService(productRepo)
+	vendorSvc := ordvendor.NewService(vendorRepo)
+
+	return ordservice.NewHandler(transact, appSvc, bundleSvc, apiSvc, eventAPISvc, specSvc, pkgSvc, productSvc, vendorSvc, cfg.ORDService)
+}
+
+func specRefetchService(cfg config, transact persistence.Transactioner, httpClient *http.Client) *specrefetch.Service {
+	uidSvc := uid.NewService()
+	authConverter := auth.NewConverter()
+	frConverter := fetchrequest.NewConverter(authConverter)
+	specConverter := spec.NewConverter(frConverter)
+
+	fetchRequestRepo := fetchrequest.NewRepository(frConverter)
+	specRepo := spec.NewRepository(specConverter)
+
+	fetchRequestSvc := fetchrequest.NewService(fetchRequestRepo, httpClient)
+	specSvc := spec.NewService(specRepo, fetchRequestRepo, uidSvc, fetchRequestSvc, cfg.Features.ValidateSpecs, cfg.Features.ConvertSpecsToJSON)
+
+	return specrefetch.NewService(cfg.SpecRefetch, transact, fetchRequestRepo, specSvc)
+}
+
+func healthCheckProber(cfg config, transact persistence.Transactioner, httpClient *http.Client) *healthcheck.Prober {
+	uidSvc := uid.NewService()
+	authConverter := auth.NewConverter()
+	frConverter := fetchrequest.NewConverter(authConverter)
+	versionConverter := version.NewConverter()
+	specConverter := spec.NewConverter(frConverter)
+	webhookConverter := webhook.NewConverter(authConverter)
+	eventAPIConverter := eventdef.NewConverter(versionConverter, specConverter)
+	apiConverter := api.NewConverter(versionConverter, specConverter)
+	docConverter := document.NewConverter(frConverter)
+	bundleConverter := mp_bundle.NewConverter(authConverter, apiConverter, eventAPIConverter, docConverter)
+	appConverter := application.NewConverter(webhookConverter, bundleConverter)
+	healthCheckConverter := healthcheck.NewConverter()
+
+	applicationRepo := application.NewRepository(appConverter)
+	healthCheckRepo := healthcheck.NewRepository(healthCheckConverter)
+
+	leaseRepo := joblease.NewRepository()
+
+	healthCheckSvc := healthcheck.NewService(healthCheckRepo, uidSvc)
+
+	return healthcheck.NewProber(cfg.HealthCheck, transact, applicationRepo, healthCheckSvc, leaseRepo, httpClient)
+}
+
+func webhookDeliveryService(cfg config, transact persistence.Transactioner, httpClient *http.Client) *webhookdelivery.Service {
+	uidSvc := uid.NewService()
+	authConverter := auth.NewConverter()
+	frConverter := fetchrequest.NewConverter(authConverter)
+	versionConverter := version.NewConverter()
+	specConverter := spec.NewConverter(frConverter)
+	webhookConverter := webhook.NewConverter(authConverter)
+	eventAPIConverter := eventdef.NewConverter(versionConverter, specConverter)
+	apiConverter := api.NewConverter(versionConverter, specConverter)
+	docConverter := document.NewConverter(frConverter)
+	bundleConverter := mp_bundle.NewConverter(authConverter, apiConverter, eventAPIConverter, docConverter)
+	appConverter := application.NewConverter(webhookConverter, bundleConverter)
+	deliveryConverter := webhookdelivery.NewConverter()
+
+	applicationRepo := application.NewRepository(appConverter)
+	webhookRepo := webhook.NewRepository(webhookConverter)
+	deliveryRepo := webhookdelivery.NewRepository(deliveryConverter)
+
+	return webhookdelivery.NewService(cfg.WebhookDelivery, transact, deliveryRepo, webhookRepo, applicationRepo, appConverter, webhookConverter, uidSvc, httpClient)
+}
+
 func systemAuthSvc() oathkeeper.Service {
 	uidSvc := uid.NewService()
 	authConverter := auth.NewConverter()
@@ -605,15 +826,43 @@ func PrepareHydratorHandler(cfg config, tokenService oathkeeper.Service, transac
 	return handlerWithTimeout, nil
 }
 
-func getAsyncDirective(ctx context.Context, cfg config, transact persistence.Transactioner, appRepo application.ApplicationRepository) func(context.Context, interface{}, gqlgen.Resolver, graphql.OperationType, *graphql.WebhookType, *string) (res interface{}, err error) {
-	resourceFetcherFunc := func(ctx context.Context, tenantID, resourceID string) (model.Entity, error) {
-		return appRepo.GetByID(ctx, tenantID, resourceID)
-	}
-
+func getAsyncDirective(ctx context.Context, cfg config, transact persistence.Transactioner, appRepo application.ApplicationRepository, runtimeRepo runtime.RuntimeRepository, historyRecorder operation.HistoryRecorder) func(context.Context, interface{}, gqlgen.Resolver, graphql.OperationType, *graphql.WebhookType, *string) (res interface{}, err error) {
 	scheduler, err := buildScheduler(ctx, cfg)
 	exitOnError(err, "Error while creating operations scheduler")
 
-	return operation.NewDirective(transact, webhookService().ListAllApplicationWebhooks, resourceFetcherFunc, appUpdaterFunc(appRepo), tenant.LoadFromContext, scheduler).HandleOperation
+	webhookSvc := webhookService()
+	webhookFetcherFuncs := map[resource.Type]operation.WebhookFetcherFunc{
+		resource.Application: webhookSvc.ListAllApplicationWebhooks,
+		resource.Runtime:     webhookSvc.ListForRuntime,
+	}
+	resourceUpdaterFuncs := map[resource.Type]operation.ResourceUpdaterFunc{
+		resource.Application: appUpdaterFunc(appRepo),
+		resource.Runtime:     runtimeUpdaterFunc(runtimeRepo),
+	}
+
+	return operation.NewDirective(transact, webhookFetcherFuncs, resourceFetcherFuncs(appRepo, runtimeRepo), resourceUpdaterFuncs, tenant.LoadFromContext, operation.NewRecordingScheduler(scheduler, historyRecorder)).HandleOperation
+}
+
+func resourceFetcherFuncs(appRepo application.ApplicationRepository, runtimeRepo runtime.RuntimeRepository) map[resource.Type]operation.ResourceFetcherFunc {
+	return map[resource.Type]operation.ResourceFetcherFunc{
+		resource.Application: func(ctx context.Context, tenantID, resourceID string) (model.Entity, error) {
+			return appRepo.GetByID(ctx, tenantID, resourceID)
+		},
+		resource.Runtime: func(ctx context.Context, tenantID, resourceID string) (model.Entity, error) {
+			return runtimeRepo.GetByID(ctx, tenantID, resourceID)
+		},
+	}
+}
+
+func resourceDeleterFuncs(appRepo application.ApplicationRepository, runtimeRepo runtime.RuntimeRepository) map[resource.Type]operation.ResourceDeleterFunc {
+	return map[resource.Type]operation.ResourceDeleterFunc{
+		resource.Application: func(ctx context.Context, id string) error {
+			return appRepo.DeleteGlobal(ctx, id)
+		},
+		resource.Runtime: func(ctx context.Context, id string) error {
+			return runtimeRepo.DeleteGlobal(ctx, id)
+		},
+	}
 }
 
 func buildScheduler(ctx context.Context, config config) (operation.Scheduler, error) {
@@ -622,6 +871,60 @@ func buildScheduler(ctx context.Context, config config) (operation.Scheduler, er
 		return &operation.DisabledScheduler{}, nil
 	}
 
+	switch config.OperationsScheduler {
+	case kubernetesOperationsScheduler:
+		operationsK8sClient, err := buildOperationsK8sClient(config)
+		if err != nil {
+			return nil, err
+		}
+
+		return k8s.NewScheduler(operationsK8sClient), nil
+	case databaseOperationsScheduler:
+		log.C(ctx).Info("Async operations are scheduled in the database")
+		return db.NewScheduler(db.NewRepository(), uid.NewService()), nil
+	default:
+		return nil, errors.Errorf("unsupported operations scheduler %q", config.OperationsScheduler)
+	}
+}
+
+func buildOperationManager(ctx context.Context, config config) (operation.Manager, error) {
+	if config.DisableAsyncMode {
+		log.C(ctx).Info("Async operations are disabled")
+		return &operation.DisabledManager{}, nil
+	}
+
+	switch config.OperationsScheduler {
+	case kubernetesOperationsScheduler:
+		operationsK8sClient, err := buildOperationsK8sClient(config)
+		if err != nil {
+			return nil, err
+		}
+
+		return k8s.NewManager(operationsK8sClient, uid.NewService()), nil
+	case databaseOperationsScheduler:
+		return db.NewManager(db.NewRepository(), uid.NewService()), nil
+	default:
+		return nil, errors.Errorf("unsupported operations scheduler %q", config.OperationsScheduler)
+	}
+}
+
+func operationsWorker(cfg config, transact persistence.Transactioner, appRepo application.ApplicationRepository, runtimeRepo runtime.RuntimeRepository, historyRecorder operation.HistoryRecorder, httpClient *http.Client) *db.Worker {
+	webhookSvc := webhookService()
+	webhookFetcherFuncs := map[resource.Type]operation.WebhookFetcherFunc{
+		resource.Application: webhookSvc.ListAllApplicationWebhooks,
+		resource.Runtime:     webhookSvc.ListForRuntime,
+	}
+	resourceUpdaterFuncs := map[resource.Type]operation.ResourceUpdaterFunc{
+		resource.Application: appUpdaterFunc(appRepo),
+		resource.Runtime:     runtimeUpdaterFunc(runtimeRepo),
+	}
+	finisher := operation.NewFinisher(resourceUpdaterFuncs, resourceDeleterFuncs(appRepo, runtimeRepo), historyRecorder)
+	webhookConverter := webhook.NewConverter(auth.NewConverter())
+
+	return db.NewWorker(cfg.OperationsWorker, transact, db.NewRepository(), resourceFetcherFuncs(appRepo, runtimeRepo), webhookFetcherFuncs, webhookConverter, finisher, uid.NewService(), httpClient)
+}
+
+func buildOperationsK8sClient(config config) (k8s.K8SClient, error) {
 	cfg, err := cr.GetConfig()
 	exitOnError(err, "Failed to get cluster config for operations k8s client")
 
@@ -630,9 +933,8 @@ func buildScheduler(ctx context.Context, config config) (operation.Scheduler, er
 	if err != nil {
 		return nil, err
 	}
-	operationsK8sClient := k8sClient.Operations(config.OperationsNamespace)
 
-	return k8s.NewScheduler(operationsK8sClient), nil
+	return k8sClient.Operations(config.OperationsNamespace), nil
 }
 
 func appUpdaterFunc(appRepo application.ApplicationRepository) operation.ResourceUpdaterFunc {
@@ -650,3 +952,21 @@ func appUpdaterFunc(appRepo application.ApplicationRepository) operation.Resourc
 		return appRepo.Update(ctx, app)
 	}
 }
+
+func runtimeUpdaterFunc(runtimeRepo runtime.RuntimeRepository) operation.ResourceUpdaterFunc {
+	return func(ctx context.Context, id string, ready bool, errorMsg *string, appStatusCondition model.ApplicationStatusCondition) error {
+		rtm, err := runtimeRepo.GetGlobalByID(ctx, id)
+		if err != nil {
+			return err
+		}
+		if appStatusCondition == model.ApplicationStatusConditionCreateFailed || appStatusCondition == model.ApplicationStatusConditionDeleteFailed {
+			rtm.Status = &model.RuntimeStatus{
+				Condition: model.RuntimeStatusConditionFailed,
+				Timestamp: time.Now(),
+			}
+		}
+		rtm.Ready = ready
+		rtm.Error = errorMsg
+		return runtimeRepo.Update(ctx, rtm)
+	}
+}
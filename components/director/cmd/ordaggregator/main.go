@@ -2,7 +2,9 @@ package main
 
 import (
 	"context"
+	"crypto/tls"
 	"net/http"
+	"os"
 	"time"
 
 	"github.com/kyma-incubator/compass/components/director/internal/domain/api"
@@ -15,6 +17,7 @@ import (
 	"github.com/kyma-incubator/compass/components/director/internal/domain/integrationsystem"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/label"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/labeldef"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/ordsyncstatus"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/ordvendor"
 	mp_package "github.com/kyma-incubator/compass/components/director/internal/domain/package"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/product"
@@ -24,13 +27,16 @@ import (
 	"github.com/kyma-incubator/compass/components/director/internal/domain/version"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/webhook"
 	"github.com/kyma-incubator/compass/components/director/internal/features"
+	"github.com/kyma-incubator/compass/components/director/internal/joblease"
 	"github.com/kyma-incubator/compass/components/director/internal/open_resource_discovery"
 	"github.com/kyma-incubator/compass/components/director/internal/uid"
 	configprovider "github.com/kyma-incubator/compass/components/director/pkg/config"
 	"github.com/kyma-incubator/compass/components/director/pkg/executor"
+	httputil "github.com/kyma-incubator/compass/components/director/pkg/http"
 	"github.com/kyma-incubator/compass/components/director/pkg/log"
 	"github.com/kyma-incubator/compass/components/director/pkg/normalizer"
 	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+	"github.com/kyma-incubator/compass/components/director/pkg/signal"
 	"github.com/pkg/errors"
 	"github.com/vrischmann/envconfig"
 )
@@ -46,6 +52,12 @@ type config struct {
 	ConfigurationFileReload time.Duration `envconfig:"default=1m"`
 
 	ClientTimeout time.Duration `envconfig:"default=60s"`
+
+	Daemon bool `envconfig:"default=false"`
+	Sync   open_resource_discovery.SyncConfig
+
+	MTLSCertPath string `envconfig:"optional"`
+	MTLSKeyPath  string `envconfig:"optional"`
 }
 
 func main() {
@@ -65,16 +77,32 @@ func main() {
 		exitOnError(err, "Error while closing the connection to the database")
 	}()
 
-	ordAggregator := createORDAggregatorSvc(cfgProvider, cfg.Features, transact, &http.Client{
-		Timeout: cfg.ClientTimeout,
-	})
+	accessStrategyExecutors := createAccessStrategyExecutors(cfg)
+
+	ordAggregator := createORDAggregatorSvc(cfgProvider, cfg.Features, cfg.Sync, transact, &http.Client{
+		Timeout:   cfg.ClientTimeout,
+		Transport: httputil.NewSigningTransport(httputil.NewClientCertificateTransport(http.DefaultTransport.(*http.Transport).Clone())),
+	}, accessStrategyExecutors)
+
+	if cfg.Daemon {
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		term := make(chan os.Signal, 1)
+		signal.HandleInterrupts(ctx, cancel, term)
+
+		err = ordAggregator.Run(ctx)
+		exitOnError(err, "Error while running Open Resource Discovery synchronization")
+		return
+	}
+
 	err = ordAggregator.SyncORDDocuments(ctx)
 	exitOnError(err, "Error while synchronizing Open Resource Discovery Documents")
 
 	log.C(ctx).Info("Successfully synchronized Open Resource Discovery Documents")
 }
 
-func createORDAggregatorSvc(cfgProvider *configprovider.Provider, featuresConfig features.Config, transact persistence.Transactioner, httpClient *http.Client) *open_resource_discovery.Service {
+func createORDAggregatorSvc(cfgProvider *configprovider.Provider, featuresConfig features.Config, syncConfig open_resource_discovery.SyncConfig, transact persistence.Transactioner, httpClient *http.Client, accessStrategyExecutors open_resource_discovery.AccessStrategyExecutors) *open_resource_discovery.Service {
 	authConverter := auth.NewConverter()
 	frConverter := fetchrequest.NewConverter(authConverter)
 	versionConverter := version.NewConverter()
@@ -92,6 +120,7 @@ func createORDAggregatorSvc(cfgProvider *configprovider.Provider, featuresConfig
 	productConverter := product.NewConverter()
 	vendorConverter := ordvendor.NewConverter()
 	tombstoneConverter := tombstone.NewConverter()
+	syncStatusConverter := ordsyncstatus.NewConverter()
 
 	runtimeRepo := runtime.NewRepository()
 	applicationRepo := application.NewRepository(appConverter)
@@ -109,12 +138,14 @@ func createORDAggregatorSvc(cfgProvider *configprovider.Provider, featuresConfig
 	productRepo := product.NewRepository(productConverter)
 	vendorRepo := ordvendor.NewRepository(vendorConverter)
 	tombstoneRepo := tombstone.NewRepository(tombstoneConverter)
+	syncStatusRepo := ordsyncstatus.NewRepository(syncStatusConverter)
+	leaseRepo := joblease.NewRepository()
 
 	uidSvc := uid.NewService()
 	labelUpsertSvc := label.NewLabelUpsertService(labelRepo, labelDefRepo, uidSvc)
 	scenariosSvc := labeldef.NewScenariosService(labelDefRepo, uidSvc, featuresConfig.DefaultScenarioEnabled)
 	fetchRequestSvc := fetchrequest.NewService(fetchRequestRepo, httpClient)
-	specSvc := spec.NewService(specRepo, fetchRequestRepo, uidSvc, fetchRequestSvc)
+	specSvc := spec.NewService(specRepo, fetchRequestRepo, uidSvc, fetchRequestSvc, featuresConfig.ValidateSpecs, featuresConfig.ConvertSpecsToJSON)
 	apiSvc := api.NewService(apiRepo, uidSvc, specSvc)
 	eventAPISvc := eventdef.NewService(eventAPIRepo, uidSvc, specSvc)
 	webhookSvc := webhook.NewService(webhookRepo, applicationRepo, uidSvc)
@@ -125,10 +156,32 @@ func createORDAggregatorSvc(cfgProvider *configprovider.Provider, featuresConfig
 	productSvc := product.NewService(productRepo)
 	vendorSvc := ordvendor.NewService(vendorRepo)
 	tombstoneSvc := tombstone.NewService(tombstoneRepo)
+	syncStatusSvc := ordsyncstatus.NewService(syncStatusRepo)
+
+	ordClient := open_resource_discovery.NewClient(httpClient, accessStrategyExecutors)
 
-	ordClient := open_resource_discovery.NewClient(httpClient)
+	return open_resource_discovery.NewAggregatorService(syncConfig, transact, appSvc, webhookSvc, bundleSvc, apiSvc, eventAPISvc, specSvc, packageSvc, productSvc, vendorSvc, tombstoneSvc, syncStatusSvc, labelRepo, leaseRepo, ordClient)
+}
+
+func createAccessStrategyExecutors(cfg config) open_resource_discovery.AccessStrategyExecutors {
+	executors := open_resource_discovery.DefaultAccessStrategyExecutors()
+	if cfg.MTLSCertPath == "" || cfg.MTLSKeyPath == "" {
+		return executors
+	}
+
+	cert, err := tls.LoadX509KeyPair(cfg.MTLSCertPath, cfg.MTLSKeyPath)
+	exitOnError(err, "Error while loading client certificate for the mTLS access strategy")
+
+	executors[open_resource_discovery.CMPmTLSAccessStrategy] = open_resource_discovery.NewCMPmTLSAccessStrategyExecutor(&http.Client{
+		Timeout: cfg.ClientTimeout,
+		Transport: &http.Transport{
+			TLSClientConfig: &tls.Config{
+				Certificates: []tls.Certificate{cert},
+			},
+		},
+	})
 
-	return open_resource_discovery.NewAggregatorService(transact, appSvc, webhookSvc, bundleSvc, apiSvc, eventAPISvc, specSvc, packageSvc, productSvc, vendorSvc, tombstoneSvc, ordClient)
+	return executors
 }
 
 func createAndRunConfigProvider(ctx context.Context, cfg config) *configprovider.Provider {
@@ -0,0 +1,10 @@
+package dataloader
+
+import "time"
+
+type Config struct {
+	// MaxBatch is the maximum number of parent objects whose children are fetched with a single query. Zero means that batches are not limited.
+	MaxBatch int `envconfig:"default=100,APP_DATALOADER_MAX_BATCH"`
+	// Wait is the time for which a batch collects parent objects before their children are fetched
+	Wait time.Duration `envconfig:"default=5ms,APP_DATALOADER_WAIT"`
+}
@@ -0,0 +1,120 @@
+package dataloader
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+)
+
+// fetchFunc fetches the results for all of the keys of a batch, in the order of the keys.
+// The group identifies the parameters shared by all of the keys of the batch, such as the requested page.
+type fetchFunc func(ctx context.Context, group interface{}, keys []interface{}) ([]interface{}, error)
+
+// loader collects the keys which are loaded concurrently and fetches them in batches.
+// A batch is fetched when it reaches the maximum size, or when the wait time passes after its first key was loaded.
+type loader struct {
+	fetch    fetchFunc
+	wait     time.Duration
+	maxBatch int
+
+	mu      sync.Mutex
+	batches map[interface{}]*batch
+}
+
+type batch struct {
+	ctx   context.Context
+	group interface{}
+	ids   map[string]int
+	keys  []interface{}
+
+	done    chan struct{}
+	results []interface{}
+	err     error
+}
+
+func newLoader(fetch fetchFunc, cfg Config) *loader {
+	return &loader{
+		fetch:    fetch,
+		wait:     cfg.Wait,
+		maxBatch: cfg.MaxBatch,
+		batches:  make(map[interface{}]*batch),
+	}
+}
+
+// load adds the key to the batch of its group and waits until the batch is fetched.
+// Keys with the same ID are fetched only once per batch. The batch is fetched with the context of its first key.
+func (l *loader) load(ctx context.Context, group interface{}, id string, key interface{}) (interface{}, error) {
+	l.mu.Lock()
+	b, ok := l.batches[group]
+	if !ok {
+		b = &batch{
+			ctx:   ctx,
+			group: group,
+			ids:   make(map[string]int),
+			done:  make(chan struct{}),
+		}
+		l.batches[group] = b
+		go l.fetchAfterWait(b)
+	}
+
+	pos, ok := b.ids[id]
+	if !ok {
+		pos = len(b.keys)
+		b.ids[id] = pos
+		b.keys = append(b.keys, key)
+
+		if l.maxBatch > 0 && len(b.keys) >= l.maxBatch {
+			delete(l.batches, group)
+			go l.fetchBatch(b)
+		}
+	}
+	l.mu.Unlock()
+
+	select {
+	case <-b.done:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+
+	if b.err != nil {
+		return nil, b.err
+	}
+	return b.results[pos], nil
+}
+
+func (l *loader) fetchAfterWait(b *batch) {
+	time.Sleep(l.wait)
+
+	l.mu.Lock()
+	if l.batches[b.group] != b {
+		// the batch was already fetched, as it reached the maximum size
+		l.mu.Unlock()
+		return
+	}
+	delete(l.batches, b.group)
+	l.mu.Unlock()
+
+	l.fetchBatch(b)
+}
+
+func (l *loader) fetchBatch(b *batch) {
+	defer close(b.done)
+	defer func() {
+		if r := recover(); r != nil {
+			b.err = apperrors.NewInternalError("panic while fetching batch: %v", r)
+		}
+	}()
+
+	results, err := l.fetch(b.ctx, b.group, b.keys)
+	if err != nil {
+		b.err = err
+		return
+	}
+	if len(results) != len(b.keys) {
+		b.err = apperrors.NewInternalError("fetched %d results for %d keys", len(results), len(b.keys))
+		return
+	}
+	b.results = results
+}
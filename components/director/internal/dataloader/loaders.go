@@ -0,0 +1,248 @@
+package dataloader
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+)
+
+type key int
+
+const loadersKey key = iota
+
+var NoLoadersError = apperrors.NewInternalError("cannot read dataloaders from context")
+
+// Fetchers fetch the children of multiple parent objects at once. The results are returned in the order of the parent objects.
+type Fetchers struct {
+	ApplicationBundles   func(ctx context.Context, applicationIDs []string, first int, after string) ([]*graphql.BundlePage, error)
+	ApplicationWebhooks  func(ctx context.Context, applications []*graphql.Application) ([][]*graphql.Webhook, error)
+	ApplicationLabels    func(ctx context.Context, applicationIDs []string) ([]graphql.Labels, error)
+	ApplicationAuths     func(ctx context.Context, applicationIDs []string) ([][]*graphql.SystemAuth, error)
+	BundleAPIDefinitions func(ctx context.Context, bundleIDs []string, first int, after string) ([]*graphql.APIDefinitionPage, error)
+	BundleEvents         func(ctx context.Context, bundleIDs []string, first int, after string) ([]*graphql.EventDefinitionPage, error)
+	BundleDocuments      func(ctx context.Context, bundleIDs []string, first int, after string) ([]*graphql.DocumentPage, error)
+	BundlePackages       func(ctx context.Context, bundleIDs []string) ([][]*graphql.Package, error)
+	Packages             func(ctx context.Context, packageIDs []string) ([]*graphql.Package, error)
+}
+
+// Loaders batch the lookups of the children of the parent objects resolved in a single GraphQL request.
+// They must not be shared between requests, as the batches are fetched with the context of the request.
+type Loaders struct {
+	applicationBundles   *loader
+	applicationWebhooks  *loader
+	applicationLabels    *loader
+	applicationAuths     *loader
+	bundleAPIDefinitions *loader
+	bundleEvents         *loader
+	bundleDocuments      *loader
+	bundlePackages       *loader
+	packages             *loader
+}
+
+// page is the group of the keys of paginated children, as only the children requesting the same page can be fetched together
+type page struct {
+	first int
+	after string
+}
+
+func NewLoaders(fetchers Fetchers, cfg Config) *Loaders {
+	return &Loaders{
+		applicationBundles: newLoader(func(ctx context.Context, group interface{}, keys []interface{}) ([]interface{}, error) {
+			p := group.(page)
+			pages, err := fetchers.ApplicationBundles(ctx, stringKeys(keys), p.first, p.after)
+			if err != nil {
+				return nil, err
+			}
+			return results(len(pages), func(i int) interface{} { return pages[i] }), nil
+		}, cfg),
+		applicationWebhooks: newLoader(func(ctx context.Context, _ interface{}, keys []interface{}) ([]interface{}, error) {
+			applications := make([]*graphql.Application, 0, len(keys))
+			for _, key := range keys {
+				applications = append(applications, key.(*graphql.Application))
+			}
+			webhooks, err := fetchers.ApplicationWebhooks(ctx, applications)
+			if err != nil {
+				return nil, err
+			}
+			return results(len(webhooks), func(i int) interface{} { return webhooks[i] }), nil
+		}, cfg),
+		applicationLabels: newLoader(func(ctx context.Context, _ interface{}, keys []interface{}) ([]interface{}, error) {
+			labels, err := fetchers.ApplicationLabels(ctx, stringKeys(keys))
+			if err != nil {
+				return nil, err
+			}
+			return results(len(labels), func(i int) interface{} { return labels[i] }), nil
+		}, cfg),
+		applicationAuths: newLoader(func(ctx context.Context, _ interface{}, keys []interface{}) ([]interface{}, error) {
+			auths, err := fetchers.ApplicationAuths(ctx, stringKeys(keys))
+			if err != nil {
+				return nil, err
+			}
+			return results(len(auths), func(i int) interface{} { return auths[i] }), nil
+		}, cfg),
+		bundleAPIDefinitions: newLoader(func(ctx context.Context, group interface{}, keys []interface{}) ([]interface{}, error) {
+			p := group.(page)
+			pages, err := fetchers.BundleAPIDefinitions(ctx, stringKeys(keys), p.first, p.after)
+			if err != nil {
+				return nil, err
+			}
+			return results(len(pages), func(i int) interface{} { return pages[i] }), nil
+		}, cfg),
+		bundleEvents: newLoader(func(ctx context.Context, group interface{}, keys []interface{}) ([]interface{}, error) {
+			p := group.(page)
+			pages, err := fetchers.BundleEvents(ctx, stringKeys(keys), p.first, p.after)
+			if err != nil {
+				return nil, err
+			}
+			return results(len(pages), func(i int) interface{} { return pages[i] }), nil
+		}, cfg),
+		bundleDocuments: newLoader(func(ctx context.Context, group interface{}, keys []interface{}) ([]interface{}, error) {
+			p := group.(page)
+			pages, err := fetchers.BundleDocuments(ctx, stringKeys(keys), p.first, p.after)
+			if err != nil {
+				return nil, err
+			}
+			return results(len(pages), func(i int) interface{} { return pages[i] }), nil
+		}, cfg),
+		bundlePackages: newLoader(func(ctx context.Context, _ interface{}, keys []interface{}) ([]interface{}, error) {
+			pkgs, err := fetchers.BundlePackages(ctx, stringKeys(keys))
+			if err != nil {
+				return nil, err
+			}
+			return results(len(pkgs), func(i int) interface{} { return pkgs[i] }), nil
+		}, cfg),
+		packages: newLoader(func(ctx context.Context, _ interface{}, keys []interface{}) ([]interface{}, error) {
+			pkgs, err := fetchers.Packages(ctx, stringKeys(keys))
+			if err != nil {
+				return nil, err
+			}
+			return results(len(pkgs), func(i int) interface{} { return pkgs[i] }), nil
+		}, cfg),
+	}
+}
+
+func (l *Loaders) ApplicationBundles(ctx context.Context, applicationID string, first int, after string) (*graphql.BundlePage, error) {
+	result, err := l.applicationBundles.load(ctx, page{first: first, after: after}, applicationID, applicationID)
+	if err != nil {
+		return nil, err
+	}
+	return result.(*graphql.BundlePage), nil
+}
+
+func (l *Loaders) ApplicationWebhooks(ctx context.Context, application *graphql.Application) ([]*graphql.Webhook, error) {
+	result, err := l.applicationWebhooks.load(ctx, nil, application.ID, application)
+	if err != nil {
+		return nil, err
+	}
+	return result.([]*graphql.Webhook), nil
+}
+
+func (l *Loaders) ApplicationLabels(ctx context.Context, applicationID string) (graphql.Labels, error) {
+	result, err := l.applicationLabels.load(ctx, nil, applicationID, applicationID)
+	if err != nil {
+		return nil, err
+	}
+	return result.(graphql.Labels), nil
+}
+
+func (l *Loaders) ApplicationAuths(ctx context.Context, applicationID string) ([]*graphql.SystemAuth, error) {
+	result, err := l.applicationAuths.load(ctx, nil, applicationID, applicationID)
+	if err != nil {
+		return nil, err
+	}
+	return result.([]*graphql.SystemAuth), nil
+}
+
+func (l *Loaders) BundleAPIDefinitions(ctx context.Context, bundleID string, first int, after string) (*graphql.APIDefinitionPage, error) {
+	result, err := l.bundleAPIDefinitions.load(ctx, page{first: first, after: after}, bundleID, bundleID)
+	if err != nil {
+		return nil, err
+	}
+	return result.(*graphql.APIDefinitionPage), nil
+}
+
+func (l *Loaders) BundleEvents(ctx context.Context, bundleID string, first int, after string) (*graphql.EventDefinitionPage, error) {
+	result, err := l.bundleEvents.load(ctx, page{first: first, after: after}, bundleID, bundleID)
+	if err != nil {
+		return nil, err
+	}
+	return result.(*graphql.EventDefinitionPage), nil
+}
+
+func (l *Loaders) BundleDocuments(ctx context.Context, bundleID string, first int, after string) (*graphql.DocumentPage, error) {
+	result, err := l.bundleDocuments.load(ctx, page{first: first, after: after}, bundleID, bundleID)
+	if err != nil {
+		return nil, err
+	}
+	return result.(*graphql.DocumentPage), nil
+}
+
+func (l *Loaders) BundlePackages(ctx context.Context, bundleID string) ([]*graphql.Package, error) {
+	result, err := l.bundlePackages.load(ctx, nil, bundleID, bundleID)
+	if err != nil {
+		return nil, err
+	}
+	return result.([]*graphql.Package), nil
+}
+
+// Package loads the package with the given ID. It returns nil if the package does not exist.
+func (l *Loaders) Package(ctx context.Context, id string) (*graphql.Package, error) {
+	result, err := l.packages.load(ctx, nil, id, id)
+	if err != nil {
+		return nil, err
+	}
+	return result.(*graphql.Package), nil
+}
+
+func LoadFromContext(ctx context.Context) (*Loaders, error) {
+	loaders, ok := ctx.Value(loadersKey).(*Loaders)
+	if !ok {
+		return nil, NoLoadersError
+	}
+
+	return loaders, nil
+}
+
+func SaveToContext(ctx context.Context, loaders *Loaders) context.Context {
+	return context.WithValue(ctx, loadersKey, loaders)
+}
+
+type Handler struct {
+	fetchers Fetchers
+	cfg      Config
+}
+
+func NewHandler(fetchers Fetchers, cfg Config) *Handler {
+	return &Handler{
+		fetchers: fetchers,
+		cfg:      cfg,
+	}
+}
+
+// Handler attaches new dataloaders to the context of every request
+func (h *Handler) Handler() func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := SaveToContext(r.Context(), NewLoaders(h.fetchers, h.cfg))
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+func results(count int, result func(i int) interface{}) []interface{} {
+	out := make([]interface{}, 0, count)
+	for i := 0; i < count; i++ {
+		out = append(out, result(i))
+	}
+	return out
+}
+
+func stringKeys(keys []interface{}) []string {
+	ids := make([]string, 0, len(keys))
+	for _, key := range keys {
+		ids = append(ids, key.(string))
+	}
+	return ids
+}
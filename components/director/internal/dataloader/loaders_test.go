@@ -0,0 +1,311 @@
+package dataloader_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/internal/dataloader"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoaders_ApplicationBundles(t *testing.T) {
+	cfg := dataloader.Config{MaxBatch: 100, Wait: 10 * time.Millisecond}
+
+	t.Run("fetches bundles of all applications requesting the same page with a single call", func(t *testing.T) {
+		// GIVEN
+		fetcher := &bundlesFetcher{}
+		loaders := dataloader.NewLoaders(dataloader.Fetchers{ApplicationBundles: fetcher.Fetch}, cfg)
+
+		// WHEN
+		results := loadBundles(t, loaders, []bundlesParams{
+			{appID: "app-1", first: 2},
+			{appID: "app-2", first: 2},
+			{appID: "app-1", first: 2},
+			{appID: "app-3", first: 5, after: "cursor"},
+		})
+
+		// THEN
+		assert.EqualValues(t, "app-1/2/", results[0].PageInfo.StartCursor)
+		assert.EqualValues(t, "app-2/2/", results[1].PageInfo.StartCursor)
+		assert.EqualValues(t, "app-1/2/", results[2].PageInfo.StartCursor)
+		assert.EqualValues(t, "app-3/5/cursor", results[3].PageInfo.StartCursor)
+		assert.ElementsMatch(t, [][]string{{"app-1", "app-2"}, {"app-3"}}, fetcher.Calls())
+	})
+
+	t.Run("splits batches exceeding the maximum size", func(t *testing.T) {
+		// GIVEN
+		fetcher := &bundlesFetcher{}
+		loaders := dataloader.NewLoaders(dataloader.Fetchers{ApplicationBundles: fetcher.Fetch}, dataloader.Config{MaxBatch: 2, Wait: time.Second})
+
+		// WHEN
+		loadBundles(t, loaders, []bundlesParams{
+			{appID: "app-1", first: 2},
+			{appID: "app-2", first: 2},
+			{appID: "app-3", first: 2},
+			{appID: "app-4", first: 2},
+		})
+
+		// THEN
+		calls := fetcher.Calls()
+		require.Len(t, calls, 2)
+		assert.Len(t, calls[0], 2)
+		assert.Len(t, calls[1], 2)
+	})
+
+	t.Run("returns error of the fetch to all applications of the batch", func(t *testing.T) {
+		// GIVEN
+		testErr := errors.New("test error")
+		loaders := dataloader.NewLoaders(dataloader.Fetchers{
+			ApplicationBundles: func(ctx context.Context, applicationIDs []string, first int, after string) ([]*graphql.BundlePage, error) {
+				return nil, testErr
+			},
+		}, cfg)
+
+		// WHEN
+		_, err := loaders.ApplicationBundles(context.TODO(), "app-1", 2, "")
+
+		// THEN
+		require.Equal(t, testErr, err)
+	})
+
+	t.Run("returns error when the fetch does not return result for every application", func(t *testing.T) {
+		// GIVEN
+		loaders := dataloader.NewLoaders(dataloader.Fetchers{
+			ApplicationBundles: func(ctx context.Context, applicationIDs []string, first int, after string) ([]*graphql.BundlePage, error) {
+				return nil, nil
+			},
+		}, cfg)
+
+		// WHEN
+		_, err := loaders.ApplicationBundles(context.TODO(), "app-1", 2, "")
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "fetched 0 results for 1 keys")
+	})
+
+	t.Run("returns error when the fetch panics", func(t *testing.T) {
+		// GIVEN
+		loaders := dataloader.NewLoaders(dataloader.Fetchers{
+			ApplicationBundles: func(ctx context.Context, applicationIDs []string, first int, after string) ([]*graphql.BundlePage, error) {
+				panic("test panic")
+			},
+		}, cfg)
+
+		// WHEN
+		_, err := loaders.ApplicationBundles(context.TODO(), "app-1", 2, "")
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "panic while fetching batch: test panic")
+	})
+
+	t.Run("returns error when the context is done before the batch is fetched", func(t *testing.T) {
+		// GIVEN
+		fetcher := &bundlesFetcher{}
+		loaders := dataloader.NewLoaders(dataloader.Fetchers{ApplicationBundles: fetcher.Fetch}, dataloader.Config{Wait: time.Second})
+		ctx, cancel := context.WithCancel(context.TODO())
+		cancel()
+
+		// WHEN
+		_, err := loaders.ApplicationBundles(ctx, "app-1", 2, "")
+
+		// THEN
+		require.Equal(t, context.Canceled, err)
+	})
+}
+
+func TestLoaders_ApplicationWebhooks(t *testing.T) {
+	// GIVEN
+	app := &graphql.Application{Name: "app", BaseEntity: &graphql.BaseEntity{ID: "app-1"}}
+	webhooks := []*graphql.Webhook{{ID: "webhook-1"}}
+	var fetchedApps []*graphql.Application
+	loaders := dataloader.NewLoaders(dataloader.Fetchers{
+		ApplicationWebhooks: func(ctx context.Context, applications []*graphql.Application) ([][]*graphql.Webhook, error) {
+			fetchedApps = applications
+			return [][]*graphql.Webhook{webhooks}, nil
+		},
+	}, dataloader.Config{Wait: time.Millisecond})
+
+	// WHEN
+	result, err := loaders.ApplicationWebhooks(context.TODO(), app)
+
+	// THEN
+	require.NoError(t, err)
+	assert.Equal(t, webhooks, result)
+	assert.Equal(t, []*graphql.Application{app}, fetchedApps)
+}
+
+func TestLoaders_Package(t *testing.T) {
+	// GIVEN
+	pkg := &graphql.Package{ID: "pkg-1"}
+	var mu sync.Mutex
+	var fetchedIDs [][]string
+	loaders := dataloader.NewLoaders(dataloader.Fetchers{
+		Packages: func(ctx context.Context, packageIDs []string) ([]*graphql.Package, error) {
+			mu.Lock()
+			defer mu.Unlock()
+			fetchedIDs = append(fetchedIDs, packageIDs)
+
+			out := make([]*graphql.Package, 0, len(packageIDs))
+			for _, id := range packageIDs {
+				if id == pkg.ID {
+					out = append(out, pkg)
+				} else {
+					out = append(out, nil)
+				}
+			}
+			return out, nil
+		},
+	}, dataloader.Config{Wait: 10 * time.Millisecond})
+
+	// WHEN
+	ids := []string{"pkg-1", "missing", "pkg-1"}
+	results := make([]*graphql.Package, len(ids))
+	var wg sync.WaitGroup
+	for i, id := range ids {
+		wg.Add(1)
+		go func(i int, id string) {
+			defer wg.Done()
+			result, err := loaders.Package(context.TODO(), id)
+			require.NoError(t, err)
+			results[i] = result
+		}(i, id)
+	}
+	wg.Wait()
+
+	// THEN
+	assert.Equal(t, []*graphql.Package{pkg, nil, pkg}, results)
+	require.Len(t, fetchedIDs, 1)
+	assert.ElementsMatch(t, []string{"pkg-1", "missing"}, fetchedIDs[0])
+}
+
+func TestLoaders_BundlePackages(t *testing.T) {
+	// GIVEN
+	pkg := &graphql.Package{ID: "pkg-1"}
+	var mu sync.Mutex
+	var fetchedIDs [][]string
+	loaders := dataloader.NewLoaders(dataloader.Fetchers{
+		BundlePackages: func(ctx context.Context, bundleIDs []string) ([][]*graphql.Package, error) {
+			mu.Lock()
+			defer mu.Unlock()
+			fetchedIDs = append(fetchedIDs, bundleIDs)
+
+			out := make([][]*graphql.Package, 0, len(bundleIDs))
+			for _, id := range bundleIDs {
+				if id == "bundle-1" {
+					out = append(out, []*graphql.Package{pkg})
+				} else {
+					out = append(out, nil)
+				}
+			}
+			return out, nil
+		},
+	}, dataloader.Config{Wait: 10 * time.Millisecond})
+
+	// WHEN
+	ids := []string{"bundle-1", "bundle-2"}
+	results := make([][]*graphql.Package, len(ids))
+	var wg sync.WaitGroup
+	for i, id := range ids {
+		wg.Add(1)
+		go func(i int, id string) {
+			defer wg.Done()
+			result, err := loaders.BundlePackages(context.TODO(), id)
+			require.NoError(t, err)
+			results[i] = result
+		}(i, id)
+	}
+	wg.Wait()
+
+	// THEN
+	assert.Equal(t, [][]*graphql.Package{{pkg}, nil}, results)
+	require.Len(t, fetchedIDs, 1)
+	assert.ElementsMatch(t, ids, fetchedIDs[0])
+}
+
+func TestHandler(t *testing.T) {
+	// GIVEN
+	handler := dataloader.NewHandler(dataloader.Fetchers{}, dataloader.Config{})
+	var loaders []*dataloader.Loaders
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		l, err := dataloader.LoadFromContext(r.Context())
+		require.NoError(t, err)
+		loaders = append(loaders, l)
+	})
+
+	// WHEN
+	handler.Handler()(next).ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, "/graphql", nil))
+	handler.Handler()(next).ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, "/graphql", nil))
+
+	// THEN
+	require.Len(t, loaders, 2)
+	assert.NotSame(t, loaders[0], loaders[1])
+}
+
+func TestLoadFromContext(t *testing.T) {
+	// WHEN
+	_, err := dataloader.LoadFromContext(context.TODO())
+
+	// THEN
+	require.Equal(t, dataloader.NoLoadersError, err)
+}
+
+type bundlesParams struct {
+	appID string
+	first int
+	after string
+}
+
+func loadBundles(t *testing.T, loaders *dataloader.Loaders, params []bundlesParams) []*graphql.BundlePage {
+	results := make([]*graphql.BundlePage, len(params))
+	wg := sync.WaitGroup{}
+	for i, p := range params {
+		wg.Add(1)
+		go func(i int, p bundlesParams) {
+			defer wg.Done()
+			result, err := loaders.ApplicationBundles(context.TODO(), p.appID, p.first, p.after)
+			assert.NoError(t, err)
+			results[i] = result
+		}(i, p)
+	}
+	wg.Wait()
+	return results
+}
+
+// bundlesFetcher returns pages whose start cursor identifies the application and the requested page
+type bundlesFetcher struct {
+	mu    sync.Mutex
+	calls [][]string
+}
+
+func (f *bundlesFetcher) Fetch(_ context.Context, applicationIDs []string, first int, after string) ([]*graphql.BundlePage, error) {
+	f.mu.Lock()
+	ids := append([]string{}, applicationIDs...)
+	sort.Strings(ids)
+	f.calls = append(f.calls, ids)
+	f.mu.Unlock()
+
+	pages := make([]*graphql.BundlePage, 0, len(applicationIDs))
+	for _, id := range applicationIDs {
+		pages = append(pages, &graphql.BundlePage{
+			PageInfo: &graphql.PageInfo{StartCursor: graphql.PageCursor(fmt.Sprintf("%s/%d/%s", id, first, after))},
+		})
+	}
+	return pages, nil
+}
+
+func (f *bundlesFetcher) Calls() [][]string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.calls
+}
@@ -137,6 +137,29 @@ func (_m *APIRepository) GetForBundle(ctx context.Context, tenant string, id str
 	return r0, r1
 }
 
+// List provides a mock function with given fields: ctx, tenantID, pageSize, cursor
+func (_m *APIRepository) List(ctx context.Context, tenantID string, pageSize int, cursor string) (*model.APIDefinitionPage, error) {
+	ret := _m.Called(ctx, tenantID, pageSize, cursor)
+
+	var r0 *model.APIDefinitionPage
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, string) *model.APIDefinitionPage); ok {
+		r0 = rf(ctx, tenantID, pageSize, cursor)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.APIDefinitionPage)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, int, string) error); ok {
+		r1 = rf(ctx, tenantID, pageSize, cursor)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ListByApplicationID provides a mock function with given fields: ctx, tenantID, appID
 func (_m *APIRepository) ListByApplicationID(ctx context.Context, tenantID string, appID string) ([]*model.APIDefinition, error) {
 	ret := _m.Called(ctx, tenantID, appID)
@@ -183,6 +206,29 @@ func (_m *APIRepository) ListForBundle(ctx context.Context, tenantID string, bun
 	return r0, r1
 }
 
+// ListForBundles provides a mock function with given fields: ctx, tenantID, bundleIDs, pageSize, cursor
+func (_m *APIRepository) ListForBundles(ctx context.Context, tenantID string, bundleIDs []string, pageSize int, cursor string) ([]*model.APIDefinitionPage, error) {
+	ret := _m.Called(ctx, tenantID, bundleIDs, pageSize, cursor)
+
+	var r0 []*model.APIDefinitionPage
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string, int, string) []*model.APIDefinitionPage); ok {
+		r0 = rf(ctx, tenantID, bundleIDs, pageSize, cursor)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.APIDefinitionPage)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, []string, int, string) error); ok {
+		r1 = rf(ctx, tenantID, bundleIDs, pageSize, cursor)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Update provides a mock function with given fields: ctx, item
 func (_m *APIRepository) Update(ctx context.Context, item *model.APIDefinition) error {
 	ret := _m.Called(ctx, item)
@@ -51,6 +51,7 @@ func (c *converter) ToGraphQL(in *model.APIDefinition, spec *model.Spec) (*graph
 
 	return &graphql.APIDefinition{
 		BundleID:    bundleID,
+		PackageID:   in.PackageID,
 		Name:        in.Name,
 		Description: in.Description,
 		Spec:        s,
@@ -123,6 +123,7 @@ func fixFullGQLAPIDefinition(placeholder string) *graphql.APIDefinition {
 
 	return &graphql.APIDefinition{
 		BundleID:    bundleID,
+		PackageID:   str.Ptr(packageID),
 		Name:        placeholder,
 		Description: str.Ptr("desc_" + placeholder),
 		Spec:        spec,
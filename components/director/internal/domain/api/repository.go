@@ -37,6 +37,7 @@ type pgRepository struct {
 	creator         repo.Creator
 	singleGetter    repo.SingleGetter
 	pageableQuerier repo.PageableQuerier
+	unionLister     repo.UnionLister
 	lister          repo.Lister
 	updater         repo.Updater
 	deleter         repo.Deleter
@@ -48,6 +49,7 @@ func NewRepository(conv APIDefinitionConverter) *pgRepository {
 	return &pgRepository{
 		singleGetter:    repo.NewSingleGetter(resource.API, apiDefTable, tenantColumn, apiDefColumns),
 		pageableQuerier: repo.NewPageableQuerier(resource.API, apiDefTable, tenantColumn, apiDefColumns),
+		unionLister:     repo.NewUnionLister(resource.API, apiDefTable, tenantColumn, apiDefColumns),
 		lister:          repo.NewLister(resource.API, apiDefTable, tenantColumn, apiDefColumns),
 		creator:         repo.NewCreator(resource.API, apiDefTable, apiDefColumns),
 		updater:         repo.NewUpdater(resource.API, apiDefTable, updatableColumns, tenantColumn, idColumns),
@@ -70,6 +72,35 @@ func (r *pgRepository) ListForBundle(ctx context.Context, tenantID string, bundl
 	return r.list(ctx, tenantID, pageSize, cursor, conditions)
 }
 
+// ListForBundles returns the same page of APIDefinitions for every bundle, in the order of the bundleIDs
+func (r *pgRepository) ListForBundles(ctx context.Context, tenantID string, bundleIDs []string, pageSize int, cursor string) ([]*model.APIDefinitionPage, error) {
+	var apiDefCollection APIDefCollection
+	pages, err := r.unionLister.List(ctx, tenantID, bundleIDs, "bundle_id", pageSize, cursor, "id", &apiDefCollection)
+	if err != nil {
+		return nil, err
+	}
+
+	apisByBundleID := make(map[string][]*model.APIDefinition, len(bundleIDs))
+	for _, apiDefEnt := range apiDefCollection {
+		m := r.conv.FromEntity(apiDefEnt)
+		if m.BundleID == nil {
+			continue
+		}
+		apisByBundleID[*m.BundleID] = append(apisByBundleID[*m.BundleID], &m)
+	}
+
+	apiPages := make([]*model.APIDefinitionPage, 0, len(bundleIDs))
+	for _, bundleID := range bundleIDs {
+		apiPages = append(apiPages, &model.APIDefinitionPage{
+			Data:       apisByBundleID[bundleID],
+			TotalCount: pages[bundleID].TotalCount,
+			PageInfo:   pages[bundleID].PageInfo,
+		})
+	}
+
+	return apiPages, nil
+}
+
 func (r *pgRepository) ListByApplicationID(ctx context.Context, tenantID, appID string) ([]*model.APIDefinition, error) {
 	apiCollection := APIDefCollection{}
 	if err := r.lister.List(ctx, tenantID, &apiCollection, repo.NewEqualCondition("app_id", appID)); err != nil {
@@ -83,6 +114,11 @@ func (r *pgRepository) ListByApplicationID(ctx context.Context, tenantID, appID
 	return apis, nil
 }
 
+// List returns a page of all APIs of the tenant
+func (r *pgRepository) List(ctx context.Context, tenantID string, pageSize int, cursor string) (*model.APIDefinitionPage, error) {
+	return r.list(ctx, tenantID, pageSize, cursor, nil)
+}
+
 func (r *pgRepository) list(ctx context.Context, tenant string, pageSize int, cursor string, conditions repo.Conditions) (*model.APIDefinitionPage, error) {
 	var apiDefCollection APIDefCollection
 	page, totalCount, err := r.pageableQuerier.List(ctx, tenant, pageSize, cursor, "id", &apiDefCollection, conditions...)
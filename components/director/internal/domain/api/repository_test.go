@@ -12,6 +12,7 @@ import (
 	"github.com/kyma-incubator/compass/components/director/internal/model"
 	"github.com/kyma-incubator/compass/components/director/internal/repo/testdb"
 	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+	"github.com/kyma-incubator/compass/components/director/pkg/str"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -102,6 +103,60 @@ func TestPgRepository_GetForBundle(t *testing.T) {
 	})
 }
 
+func TestPgRepository_List(t *testing.T) {
+	// GIVEN
+	ExpectedLimit := 3
+	ExpectedOffset := 0
+
+	inputPageSize := 3
+	inputCursor := ""
+	totalCount := 2
+	firstApiDefID := "111111111-1111-1111-1111-111111111111"
+	firstApiDefEntity := fixFullEntityAPIDefinition(firstApiDefID, "placeholder")
+	secondApiDefID := "222222222-2222-2222-2222-222222222222"
+	secondApiDefEntity := fixFullEntityAPIDefinition(secondApiDefID, "placeholder")
+
+	selectQuery := fmt.Sprintf(`^SELECT (.+) FROM "public"."api_definitions" 
+		WHERE tenant_id = \$1
+		ORDER BY id LIMIT %d OFFSET %d`, ExpectedLimit, ExpectedOffset)
+
+	rawCountQuery := `SELECT COUNT(*) FROM "public"."api_definitions" 
+		WHERE tenant_id = $1`
+	countQuery := regexp.QuoteMeta(rawCountQuery)
+
+	t.Run("success", func(t *testing.T) {
+		sqlxDB, sqlMock := testdb.MockDatabase(t)
+		rows := sqlmock.NewRows(fixAPIDefinitionColumns()).
+			AddRow(fixAPIDefinitionRow(firstApiDefID, "placeholder")...).
+			AddRow(fixAPIDefinitionRow(secondApiDefID, "placeholder")...)
+
+		sqlMock.ExpectQuery(selectQuery).
+			WithArgs(tenantID).
+			WillReturnRows(rows)
+
+		sqlMock.ExpectQuery(countQuery).
+			WithArgs(tenantID).
+			WillReturnRows(testdb.RowCount(2))
+
+		ctx := persistence.SaveToContext(context.TODO(), sqlxDB)
+		convMock := &automock.APIDefinitionConverter{}
+		convMock.On("FromEntity", firstApiDefEntity).Return(model.APIDefinition{BaseEntity: &model.BaseEntity{ID: firstApiDefID}}, nil)
+		convMock.On("FromEntity", secondApiDefEntity).Return(model.APIDefinition{BaseEntity: &model.BaseEntity{ID: secondApiDefID}}, nil)
+		pgRepository := api.NewRepository(convMock)
+		// WHEN
+		modelAPIDef, err := pgRepository.List(ctx, tenantID, inputPageSize, inputCursor)
+		//THEN
+		require.NoError(t, err)
+		require.Len(t, modelAPIDef.Data, 2)
+		assert.Equal(t, firstApiDefID, modelAPIDef.Data[0].ID)
+		assert.Equal(t, secondApiDefID, modelAPIDef.Data[1].ID)
+		assert.Equal(t, "", modelAPIDef.PageInfo.StartCursor)
+		assert.Equal(t, totalCount, modelAPIDef.TotalCount)
+		convMock.AssertExpectations(t)
+		sqlMock.AssertExpectations(t)
+	})
+}
+
 func TestPgRepository_ListForBundle(t *testing.T) {
 	// GIVEN
 	ExpectedLimit := 3
@@ -156,6 +211,56 @@ func TestPgRepository_ListForBundle(t *testing.T) {
 	})
 }
 
+func TestPgRepository_ListForBundles(t *testing.T) {
+	// GIVEN
+	inputPageSize := 3
+	inputCursor := ""
+	secondBundleID := "ccccccccc-cccc-cccc-cccc-cccccccccccc"
+	firstApiDefID := "111111111-1111-1111-1111-111111111111"
+	firstApiDefEntity := fixFullEntityAPIDefinition(firstApiDefID, "placeholder")
+	secondApiDefID := "222222222-2222-2222-2222-222222222222"
+	secondApiDefEntity := fixFullEntityAPIDefinition(secondApiDefID, "placeholder")
+
+	selectQuery := `^\(SELECT (.+) FROM "public"."api_definitions" WHERE bundle_id = \$1 AND tenant_id = \$2 ORDER BY id LIMIT 3 OFFSET 0\) UNION ALL \(SELECT (.+) FROM "public"."api_definitions" WHERE bundle_id = \$3 AND tenant_id = \$4 ORDER BY id LIMIT 3 OFFSET 0\)`
+
+	rawCountQuery := `SELECT bundle_id AS id, COUNT(*) AS total_count FROM "public"."api_definitions" WHERE bundle_id IN ($1, $2) AND tenant_id = $3 GROUP BY bundle_id`
+	countQuery := regexp.QuoteMeta(rawCountQuery)
+
+	t.Run("success", func(t *testing.T) {
+		sqlxDB, sqlMock := testdb.MockDatabase(t)
+		rows := sqlmock.NewRows(fixAPIDefinitionColumns()).
+			AddRow(fixAPIDefinitionRow(firstApiDefID, "placeholder")...).
+			AddRow(fixAPIDefinitionRow(secondApiDefID, "placeholder")...)
+
+		sqlMock.ExpectQuery(selectQuery).
+			WithArgs(bundleID, tenantID, secondBundleID, tenantID).
+			WillReturnRows(rows)
+
+		sqlMock.ExpectQuery(countQuery).
+			WithArgs(bundleID, secondBundleID, tenantID).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "total_count"}).AddRow(bundleID, 2))
+
+		ctx := persistence.SaveToContext(context.TODO(), sqlxDB)
+		convMock := &automock.APIDefinitionConverter{}
+		convMock.On("FromEntity", firstApiDefEntity).Return(model.APIDefinition{BundleID: str.Ptr(bundleID), BaseEntity: &model.BaseEntity{ID: firstApiDefID}}, nil)
+		convMock.On("FromEntity", secondApiDefEntity).Return(model.APIDefinition{BundleID: str.Ptr(bundleID), BaseEntity: &model.BaseEntity{ID: secondApiDefID}}, nil)
+		pgRepository := api.NewRepository(convMock)
+		// WHEN
+		modelAPIDefPages, err := pgRepository.ListForBundles(ctx, tenantID, []string{bundleID, secondBundleID}, inputPageSize, inputCursor)
+		//THEN
+		require.NoError(t, err)
+		require.Len(t, modelAPIDefPages, 2)
+		require.Len(t, modelAPIDefPages[0].Data, 2)
+		assert.Equal(t, firstApiDefID, modelAPIDefPages[0].Data[0].ID)
+		assert.Equal(t, secondApiDefID, modelAPIDefPages[0].Data[1].ID)
+		assert.Equal(t, 2, modelAPIDefPages[0].TotalCount)
+		assert.Empty(t, modelAPIDefPages[1].Data)
+		assert.Equal(t, 0, modelAPIDefPages[1].TotalCount)
+		convMock.AssertExpectations(t)
+		sqlMock.AssertExpectations(t)
+	})
+}
+
 func TestPgRepository_ListByApplicationID(t *testing.T) {
 	// GIVEN
 	totalCount := 2
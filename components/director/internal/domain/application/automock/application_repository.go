@@ -11,6 +11,8 @@ import (
 	model "github.com/kyma-incubator/compass/components/director/internal/model"
 
 	uuid "github.com/google/uuid"
+
+	pagination "github.com/kyma-incubator/compass/components/director/pkg/pagination"
 )
 
 // ApplicationRepository is an autogenerated mock type for the ApplicationRepository type
@@ -128,12 +130,12 @@ func (_m *ApplicationRepository) GetGlobalByID(ctx context.Context, id string) (
 }
 
 // List provides a mock function with given fields: ctx, tenant, filter, pageSize, cursor
-func (_m *ApplicationRepository) List(ctx context.Context, tenant string, filter []*labelfilter.LabelFilter, pageSize int, cursor string) (*model.ApplicationPage, error) {
-	ret := _m.Called(ctx, tenant, filter, pageSize, cursor)
+func (_m *ApplicationRepository) List(ctx context.Context, tenant string, filter []*labelfilter.LabelFilter, page pagination.Request, orderBy model.ApplicationOrderBy) (*model.ApplicationPage, error) {
+	ret := _m.Called(ctx, tenant, filter, page, orderBy)
 
 	var r0 *model.ApplicationPage
-	if rf, ok := ret.Get(0).(func(context.Context, string, []*labelfilter.LabelFilter, int, string) *model.ApplicationPage); ok {
-		r0 = rf(ctx, tenant, filter, pageSize, cursor)
+	if rf, ok := ret.Get(0).(func(context.Context, string, []*labelfilter.LabelFilter, pagination.Request, model.ApplicationOrderBy) *model.ApplicationPage); ok {
+		r0 = rf(ctx, tenant, filter, page, orderBy)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*model.ApplicationPage)
@@ -141,8 +143,8 @@ func (_m *ApplicationRepository) List(ctx context.Context, tenant string, filter
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, string, []*labelfilter.LabelFilter, int, string) error); ok {
-		r1 = rf(ctx, tenant, filter, pageSize, cursor)
+	if rf, ok := ret.Get(1).(func(context.Context, string, []*labelfilter.LabelFilter, pagination.Request, model.ApplicationOrderBy) error); ok {
+		r1 = rf(ctx, tenant, filter, page, orderBy)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -11,6 +11,8 @@ import (
 	model "github.com/kyma-incubator/compass/components/director/internal/model"
 
 	uuid "github.com/google/uuid"
+
+	pagination "github.com/kyma-incubator/compass/components/director/pkg/pagination"
 )
 
 // ApplicationService is an autogenerated mock type for the ApplicationService type
@@ -114,12 +116,12 @@ func (_m *ApplicationService) GetLabel(ctx context.Context, applicationID string
 }
 
 // List provides a mock function with given fields: ctx, filter, pageSize, cursor
-func (_m *ApplicationService) List(ctx context.Context, filter []*labelfilter.LabelFilter, pageSize int, cursor string) (*model.ApplicationPage, error) {
-	ret := _m.Called(ctx, filter, pageSize, cursor)
+func (_m *ApplicationService) List(ctx context.Context, filter []*labelfilter.LabelFilter, page pagination.Request, orderBy model.ApplicationOrderBy) (*model.ApplicationPage, error) {
+	ret := _m.Called(ctx, filter, page, orderBy)
 
 	var r0 *model.ApplicationPage
-	if rf, ok := ret.Get(0).(func(context.Context, []*labelfilter.LabelFilter, int, string) *model.ApplicationPage); ok {
-		r0 = rf(ctx, filter, pageSize, cursor)
+	if rf, ok := ret.Get(0).(func(context.Context, []*labelfilter.LabelFilter, pagination.Request, model.ApplicationOrderBy) *model.ApplicationPage); ok {
+		r0 = rf(ctx, filter, page, orderBy)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*model.ApplicationPage)
@@ -127,8 +129,8 @@ func (_m *ApplicationService) List(ctx context.Context, filter []*labelfilter.La
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, []*labelfilter.LabelFilter, int, string) error); ok {
-		r1 = rf(ctx, filter, pageSize, cursor)
+	if rf, ok := ret.Get(1).(func(context.Context, []*labelfilter.LabelFilter, pagination.Request, model.ApplicationOrderBy) error); ok {
+		r1 = rf(ctx, filter, page, orderBy)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -182,6 +184,29 @@ func (_m *ApplicationService) ListLabels(ctx context.Context, applicationID stri
 	return r0, r1
 }
 
+// ListLabelsForApplications provides a mock function with given fields: ctx, applicationIDs
+func (_m *ApplicationService) ListLabelsForApplications(ctx context.Context, applicationIDs []string) (map[string]map[string]*model.Label, error) {
+	ret := _m.Called(ctx, applicationIDs)
+
+	var r0 map[string]map[string]*model.Label
+	if rf, ok := ret.Get(0).(func(context.Context, []string) map[string]map[string]*model.Label); ok {
+		r0 = rf(ctx, applicationIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]map[string]*model.Label)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = rf(ctx, applicationIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // SetLabel provides a mock function with given fields: ctx, label
 func (_m *ApplicationService) SetLabel(ctx context.Context, label *model.LabelInput) error {
 	ret := _m.Called(ctx, label)
@@ -9,6 +9,8 @@ import (
 	mock "github.com/stretchr/testify/mock"
 
 	model "github.com/kyma-incubator/compass/components/director/internal/model"
+
+	pagination "github.com/kyma-incubator/compass/components/director/pkg/pagination"
 )
 
 // RuntimeService is an autogenerated mock type for the RuntimeService type
@@ -40,12 +42,12 @@ func (_m *RuntimeService) GetLabel(ctx context.Context, runtimeID string, key st
 }
 
 // List provides a mock function with given fields: ctx, filter, pageSize, cursor
-func (_m *RuntimeService) List(ctx context.Context, filter []*labelfilter.LabelFilter, pageSize int, cursor string) (*model.RuntimePage, error) {
-	ret := _m.Called(ctx, filter, pageSize, cursor)
+func (_m *RuntimeService) List(ctx context.Context, filter []*labelfilter.LabelFilter, page pagination.Request, orderBy model.RuntimeOrderBy) (*model.RuntimePage, error) {
+	ret := _m.Called(ctx, filter, page, orderBy)
 
 	var r0 *model.RuntimePage
-	if rf, ok := ret.Get(0).(func(context.Context, []*labelfilter.LabelFilter, int, string) *model.RuntimePage); ok {
-		r0 = rf(ctx, filter, pageSize, cursor)
+	if rf, ok := ret.Get(0).(func(context.Context, []*labelfilter.LabelFilter, pagination.Request, model.RuntimeOrderBy) *model.RuntimePage); ok {
+		r0 = rf(ctx, filter, page, orderBy)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*model.RuntimePage)
@@ -53,8 +55,8 @@ func (_m *RuntimeService) List(ctx context.Context, filter []*labelfilter.LabelF
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, []*labelfilter.LabelFilter, int, string) error); ok {
-		r1 = rf(ctx, filter, pageSize, cursor)
+	if rf, ok := ret.Get(1).(func(context.Context, []*labelfilter.LabelFilter, pagination.Request, model.RuntimeOrderBy) error); ok {
+		r1 = rf(ctx, filter, page, orderBy)
 	} else {
 		r1 = ret.Error(1)
 	}
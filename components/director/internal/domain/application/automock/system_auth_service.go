@@ -36,3 +36,26 @@ func (_m *SystemAuthService) ListForObject(ctx context.Context, objectType model
 
 	return r0, r1
 }
+
+// ListForObjects provides a mock function with given fields: ctx, objectType, objectIDs
+func (_m *SystemAuthService) ListForObjects(ctx context.Context, objectType model.SystemAuthReferenceObjectType, objectIDs []string) (map[string][]model.SystemAuth, error) {
+	ret := _m.Called(ctx, objectType, objectIDs)
+
+	var r0 map[string][]model.SystemAuth
+	if rf, ok := ret.Get(0).(func(context.Context, model.SystemAuthReferenceObjectType, []string) map[string][]model.SystemAuth); ok {
+		r0 = rf(ctx, objectType, objectIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string][]model.SystemAuth)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, model.SystemAuthReferenceObjectType, []string) error); ok {
+		r1 = rf(ctx, objectType, objectIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
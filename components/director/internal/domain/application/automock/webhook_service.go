@@ -72,22 +72,22 @@ func (_m *WebhookService) Get(ctx context.Context, id string) (*model.Webhook, e
 	return r0, r1
 }
 
-// ListAllApplicationWebhooks provides a mock function with given fields: ctx, applicationTemplateID
-func (_m *WebhookService) ListAllApplicationWebhooks(ctx context.Context, applicationTemplateID string) ([]*model.Webhook, error) {
-	ret := _m.Called(ctx, applicationTemplateID)
+// ListAllForApplications provides a mock function with given fields: ctx, applications
+func (_m *WebhookService) ListAllForApplications(ctx context.Context, applications []*model.Application) (map[string][]*model.Webhook, error) {
+	ret := _m.Called(ctx, applications)
 
-	var r0 []*model.Webhook
-	if rf, ok := ret.Get(0).(func(context.Context, string) []*model.Webhook); ok {
-		r0 = rf(ctx, applicationTemplateID)
+	var r0 map[string][]*model.Webhook
+	if rf, ok := ret.Get(0).(func(context.Context, []*model.Application) map[string][]*model.Webhook); ok {
+		r0 = rf(ctx, applications)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*model.Webhook)
+			r0 = ret.Get(0).(map[string][]*model.Webhook)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, applicationTemplateID)
+	if rf, ok := ret.Get(1).(func(context.Context, []*model.Application) error); ok {
+		r1 = rf(ctx, applications)
 	} else {
 		r1 = ret.Error(1)
 	}
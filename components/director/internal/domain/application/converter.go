@@ -242,3 +242,14 @@ func timePtrToTimestampPtr(time *time.Time) *graphql.Timestamp {
 	t := graphql.Timestamp(*time)
 	return &t
 }
+
+func orderByFromGraphQL(in *graphql.ApplicationOrderByInput) model.ApplicationOrderBy {
+	if in == nil {
+		return model.DefaultApplicationOrderBy
+	}
+
+	return model.ApplicationOrderBy{
+		Field:      model.ApplicationOrderField(in.Field),
+		Descending: in.Direction != nil && *in.Direction == graphql.OrderDirectionDesc,
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
 	"github.com/kyma-incubator/compass/components/director/pkg/log"
 	"github.com/kyma-incubator/compass/components/director/pkg/operation"
+	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
 
 	"github.com/google/uuid"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/label"
@@ -26,6 +27,10 @@ var (
 	applicationColumns = []string{"id", "app_template_id", "tenant_id", "name", "description", "status_condition", "status_timestamp", "healthcheck_url", "integration_system_id", "provider_name", "base_url", "labels", "ready", "created_at", "updated_at", "deleted_at", "error"}
 	updatableColumns   = []string{"name", "description", "status_condition", "status_timestamp", "healthcheck_url", "integration_system_id", "provider_name", "base_url", "labels", "ready", "created_at", "updated_at", "deleted_at", "error"}
 	tenantColumn       = "tenant_id"
+	orderByColumns     = map[model.ApplicationOrderField]string{
+		model.ApplicationOrderFieldID:   "id",
+		model.ApplicationOrderFieldName: "name",
+	}
 )
 
 //go:generate mockery -name=EntityConverter -output=automock -outpkg=automock -case=underscore
@@ -142,8 +147,13 @@ func (r *pgRepository) ListAll(ctx context.Context, tenantID string) ([]*model.A
 	return r.multipleFromEntities(entities)
 }
 
-func (r *pgRepository) List(ctx context.Context, tenant string, filter []*labelfilter.LabelFilter, pageSize int, cursor string) (*model.ApplicationPage, error) {
+func (r *pgRepository) List(ctx context.Context, tenant string, filter []*labelfilter.LabelFilter, page pagination.Request, orderBy model.ApplicationOrderBy) (*model.ApplicationPage, error) {
 	var appsCollection EntityCollection
+	orderByParam, err := toOrderByParam(orderBy)
+	if err != nil {
+		return nil, err
+	}
+
 	tenantID, err := uuid.Parse(tenant)
 	if err != nil {
 		return nil, errors.Wrap(err, "while parsing tenant as UUID")
@@ -158,7 +168,7 @@ func (r *pgRepository) List(ctx context.Context, tenant string, filter []*labelf
 		conditions = append(conditions, repo.NewInConditionForSubQuery("id", filterSubquery, args))
 	}
 
-	page, totalCount, err := r.pageableQuerier.List(ctx, tenant, pageSize, cursor, "id", &appsCollection, conditions...)
+	pageInfo, totalCount, err := r.pageableQuerier.ListByKeyset(ctx, tenant, page, orderByParam, &appsCollection, conditions...)
 
 	if err != nil {
 		return nil, err
@@ -173,13 +183,13 @@ func (r *pgRepository) List(ctx context.Context, tenant string, filter []*labelf
 	return &model.ApplicationPage{
 		Data:       items,
 		TotalCount: totalCount,
-		PageInfo:   page}, nil
+		PageInfo:   pageInfo}, nil
 }
 
 func (r *pgRepository) ListGlobal(ctx context.Context, pageSize int, cursor string) (*model.ApplicationPage, error) {
 	var appsCollection EntityCollection
 
-	page, totalCount, err := r.globalPageableQuerier.ListGlobal(ctx, pageSize, cursor, "id", &appsCollection)
+	page, totalCount, err := r.globalPageableQuerier.ListGlobalByKeyset(ctx, pagination.Request{PageSize: pageSize, Cursor: cursor}, repo.NewAscOrderBy("id"), &appsCollection)
 
 	if err != nil {
 		return nil, err
@@ -290,3 +300,15 @@ func (r *pgRepository) multipleFromEntities(entities EntityCollection) ([]*model
 	}
 	return items, nil
 }
+
+func toOrderByParam(orderBy model.ApplicationOrderBy) (repo.OrderBy, error) {
+	column, ok := orderByColumns[orderBy.Field]
+	if !ok {
+		return repo.OrderBy{}, apperrors.NewInvalidDataError("applications cannot be ordered by %s", orderBy.Field)
+	}
+
+	if orderBy.Descending {
+		return repo.NewDescOrderBy(column), nil
+	}
+	return repo.NewAscOrderBy(column), nil
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/kyma-incubator/compass/components/director/pkg/operation"
 	"github.com/kyma-incubator/compass/components/director/pkg/str"
 
+	"github.com/kyma-incubator/compass/components/director/internal/model"
 	"github.com/kyma-incubator/compass/components/director/internal/repo"
 
 	"github.com/pkg/errors"
@@ -23,6 +24,7 @@ import (
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/google/uuid"
+	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
 	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -531,7 +533,7 @@ func TestPgRepository_List(t *testing.T) {
 	inputCursor := ""
 	totalCount := 2
 
-	pageableQuery := `^SELECT (.+) FROM public\.applications WHERE tenant_id = \$1 ORDER BY id LIMIT %d OFFSET %d$`
+	pageableQuery := `^SELECT (.+) FROM public\.applications WHERE tenant_id = \$1 ORDER BY id ASC LIMIT %d$`
 	countQuery := `SELECT COUNT\(\*\) FROM public\.applications WHERE tenant_id = \$1`
 
 	t.Run("Success", func(t *testing.T) {
@@ -543,7 +545,7 @@ func TestPgRepository_List(t *testing.T) {
 		sqlxDB, sqlMock := testdb.MockDatabase(t)
 		defer sqlMock.AssertExpectations(t)
 
-		sqlMock.ExpectQuery(fmt.Sprintf(pageableQuery, inputPageSize, 0)).
+		sqlMock.ExpectQuery(fmt.Sprintf(pageableQuery, inputPageSize+1)).
 			WithArgs(givenTenant()).
 			WillReturnRows(rows)
 
@@ -560,14 +562,16 @@ func TestPgRepository_List(t *testing.T) {
 		pgRepository := application.NewRepository(conv)
 
 		// when
-		modelApp, err := pgRepository.List(ctx, givenTenant(), nil, inputPageSize, inputCursor)
+		modelApp, err := pgRepository.List(ctx, givenTenant(), nil, pagination.Request{PageSize: inputPageSize, Cursor: inputCursor}, model.DefaultApplicationOrderBy)
 
 		// then
 		require.NoError(t, err)
 		require.Len(t, modelApp.Data, 2)
 		assert.Equal(t, appEntity1.ID, modelApp.Data[0].ID)
 		assert.Equal(t, appEntity2.ID, modelApp.Data[1].ID)
-		assert.Equal(t, "", modelApp.PageInfo.StartCursor)
+		assertCursorPointsAt(t, app1ID, modelApp.PageInfo.StartCursor)
+		assertCursorPointsAt(t, app2ID, modelApp.PageInfo.EndCursor)
+		assert.False(t, modelApp.PageInfo.HasNextPage)
 		assert.Equal(t, totalCount, modelApp.TotalCount)
 	})
 
@@ -576,7 +580,7 @@ func TestPgRepository_List(t *testing.T) {
 		sqlxDB, sqlMock := testdb.MockDatabase(t)
 		defer sqlMock.AssertExpectations(t)
 
-		sqlMock.ExpectQuery(fmt.Sprintf(pageableQuery, inputPageSize, 0)).
+		sqlMock.ExpectQuery(fmt.Sprintf(pageableQuery, inputPageSize+1)).
 			WithArgs(givenTenant()).
 			WillReturnError(givenError())
 
@@ -587,12 +591,59 @@ func TestPgRepository_List(t *testing.T) {
 		pgRepository := application.NewRepository(conv)
 
 		// when
-		_, err := pgRepository.List(ctx, givenTenant(), nil, inputPageSize, inputCursor)
+		_, err := pgRepository.List(ctx, givenTenant(), nil, pagination.Request{PageSize: inputPageSize, Cursor: inputCursor}, model.DefaultApplicationOrderBy)
 
 		//then
 		require.Error(t, err)
 		require.Contains(t, err.Error(), "while fetching list of objects from DB: some error")
 	})
+
+	t.Run("Success when ordered by name descending", func(t *testing.T) {
+		// given
+		rows := sqlmock.NewRows([]string{"id", "tenant_id", "name", "description", "status_condition", "status_timestamp", "healthcheck_url", "integration_system_id", "provider_name", "base_url", "labels", "ready", "created_at", "updated_at", "deleted_at", "error"}).
+			AddRow(appEntity2.ID, appEntity2.TenantID, appEntity2.Name, appEntity2.Description, appEntity2.StatusCondition, appEntity2.StatusTimestamp, appEntity2.HealthCheckURL, appEntity2.IntegrationSystemID, appEntity2.ProviderName, appEntity2.BaseURL, appEntity2.Labels, appEntity2.Ready, appEntity2.CreatedAt, appEntity2.UpdatedAt, appEntity2.DeletedAt, appEntity2.Error)
+
+		sqlxDB, sqlMock := testdb.MockDatabase(t)
+		defer sqlMock.AssertExpectations(t)
+
+		sqlMock.ExpectQuery(`^SELECT (.+) FROM public\.applications WHERE tenant_id = \$1 ORDER BY name DESC, id DESC LIMIT 2$`).
+			WithArgs(givenTenant()).
+			WillReturnRows(rows)
+
+		sqlMock.ExpectQuery(countQuery).
+			WithArgs(givenTenant()).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(2))
+		ctx := persistence.SaveToContext(context.TODO(), sqlxDB)
+
+		conv := &automock.EntityConverter{}
+		conv.On("FromEntity", appEntity2).Return(appModel2).Once()
+		defer conv.AssertExpectations(t)
+
+		pgRepository := application.NewRepository(conv)
+		orderBy := model.ApplicationOrderBy{Field: model.ApplicationOrderFieldName, Descending: true}
+
+		// when
+		modelApp, err := pgRepository.List(ctx, givenTenant(), nil, pagination.Request{PageSize: 1}, orderBy)
+
+		// then
+		require.NoError(t, err)
+		require.Len(t, modelApp.Data, 1)
+		assert.Equal(t, appEntity2.ID, modelApp.Data[0].ID)
+		assertCursorPointsAt(t, app2ID, modelApp.PageInfo.EndCursor)
+		assert.False(t, modelApp.PageInfo.HasNextPage)
+		assert.Equal(t, totalCount, modelApp.TotalCount)
+	})
+
+	t.Run("Returns error when order field is not supported", func(t *testing.T) {
+		// given
+		pgRepository := application.NewRepository(nil)
+
+		// when
+		_, err := pgRepository.List(context.TODO(), givenTenant(), nil, pagination.Request{PageSize: inputPageSize}, model.ApplicationOrderBy{Field: "DESCRIPTION"})
+
+		//then
+		require.EqualError(t, err, "Invalid data [reason=applications cannot be ordered by DESCRIPTION]")
+	})
 }
 
 func TestPgRepository_ListGlobal(t *testing.T) {
@@ -608,7 +659,7 @@ func TestPgRepository_ListGlobal(t *testing.T) {
 	inputCursor := ""
 	totalCount := 2
 
-	pageableQuery := `^SELECT (.+) FROM public\.applications ORDER BY id LIMIT %d OFFSET %d$`
+	pageableQuery := `^SELECT (.+) FROM public\.applications ORDER BY id ASC LIMIT %d$`
 	countQuery := `SELECT COUNT\(\*\) FROM public\.applications`
 
 	t.Run("Success", func(t *testing.T) {
@@ -620,7 +671,7 @@ func TestPgRepository_ListGlobal(t *testing.T) {
 		sqlxDB, sqlMock := testdb.MockDatabase(t)
 		defer sqlMock.AssertExpectations(t)
 
-		sqlMock.ExpectQuery(fmt.Sprintf(pageableQuery, inputPageSize, 0)).
+		sqlMock.ExpectQuery(fmt.Sprintf(pageableQuery, inputPageSize+1)).
 			WithArgs().
 			WillReturnRows(rows)
 
@@ -644,7 +695,9 @@ func TestPgRepository_ListGlobal(t *testing.T) {
 		require.Len(t, modelApp.Data, 2)
 		assert.Equal(t, appEntity1.ID, modelApp.Data[0].ID)
 		assert.Equal(t, appEntity2.ID, modelApp.Data[1].ID)
-		assert.Equal(t, "", modelApp.PageInfo.StartCursor)
+		assertCursorPointsAt(t, app1ID, modelApp.PageInfo.StartCursor)
+		assertCursorPointsAt(t, app2ID, modelApp.PageInfo.EndCursor)
+		assert.False(t, modelApp.PageInfo.HasNextPage)
 		assert.Equal(t, totalCount, modelApp.TotalCount)
 	})
 
@@ -653,7 +706,7 @@ func TestPgRepository_ListGlobal(t *testing.T) {
 		sqlxDB, sqlMock := testdb.MockDatabase(t)
 		defer sqlMock.AssertExpectations(t)
 
-		sqlMock.ExpectQuery(fmt.Sprintf(pageableQuery, inputPageSize, 0)).
+		sqlMock.ExpectQuery(fmt.Sprintf(pageableQuery, inputPageSize+1)).
 			WithArgs().
 			WillReturnError(givenError())
 
@@ -868,3 +921,10 @@ func TestPgRepository_ListByRuntimeScenarios(t *testing.T) {
 func givenError() error {
 	return errors.New("some error")
 }
+
+func assertCursorPointsAt(t *testing.T, expectedID, cursor string) {
+	keyset, _, err := pagination.DecodeCursor(cursor)
+	require.NoError(t, err)
+	require.NotNil(t, keyset)
+	assert.Equal(t, expectedID, keyset.ID)
+}
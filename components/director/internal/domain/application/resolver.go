@@ -2,12 +2,12 @@ package application
 
 import (
 	"context"
-	"strings"
 
 	"github.com/kyma-incubator/compass/components/director/pkg/log"
 
 	"github.com/kyma-incubator/compass/components/director/pkg/inputvalidation"
 
+	"github.com/kyma-incubator/compass/components/director/internal/dataloader"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/tenant"
 
 	"github.com/kyma-incubator/compass/components/director/internal/domain/eventing"
@@ -20,6 +20,7 @@ import (
 	"github.com/kyma-incubator/compass/components/director/internal/labelfilter"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
 	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
 	"github.com/pkg/errors"
 )
 
@@ -29,11 +30,12 @@ type ApplicationService interface {
 	Update(ctx context.Context, id string, in model.ApplicationUpdateInput) error
 	Get(ctx context.Context, id string) (*model.Application, error)
 	Delete(ctx context.Context, id string) error
-	List(ctx context.Context, filter []*labelfilter.LabelFilter, pageSize int, cursor string) (*model.ApplicationPage, error)
+	List(ctx context.Context, filter []*labelfilter.LabelFilter, page pagination.Request, orderBy model.ApplicationOrderBy) (*model.ApplicationPage, error)
 	ListByRuntimeID(ctx context.Context, runtimeUUID uuid.UUID, pageSize int, cursor string) (*model.ApplicationPage, error)
 	SetLabel(ctx context.Context, label *model.LabelInput) error
 	GetLabel(ctx context.Context, applicationID string, key string) (*model.Label, error)
 	ListLabels(ctx context.Context, applicationID string) (map[string]*model.Label, error)
+	ListLabelsForApplications(ctx context.Context, applicationIDs []string) (map[string]map[string]*model.Label, error)
 	DeleteLabel(ctx context.Context, applicationID string, key string) error
 }
 
@@ -55,7 +57,7 @@ type EventingService interface {
 //go:generate mockery -name=WebhookService -output=automock -outpkg=automock -case=underscore
 type WebhookService interface {
 	Get(ctx context.Context, id string) (*model.Webhook, error)
-	ListAllApplicationWebhooks(ctx context.Context, applicationTemplateID string) ([]*model.Webhook, error)
+	ListAllForApplications(ctx context.Context, applications []*model.Application) (map[string][]*model.Webhook, error)
 	Create(ctx context.Context, resourceID string, in model.WebhookInput, converterFunc model.WebhookConverterFunc) (string, error)
 	Update(ctx context.Context, id string, in model.WebhookInput) error
 	Delete(ctx context.Context, id string) error
@@ -64,6 +66,7 @@ type WebhookService interface {
 //go:generate mockery -name=SystemAuthService -output=automock -outpkg=automock -case=underscore
 type SystemAuthService interface {
 	ListForObject(ctx context.Context, objectType model.SystemAuthReferenceObjectType, objectID string) ([]model.SystemAuth, error)
+	ListForObjects(ctx context.Context, objectType model.SystemAuthReferenceObjectType, objectIDs []string) (map[string][]model.SystemAuth, error)
 }
 
 //go:generate mockery -name=WebhookConverter -output=automock -outpkg=automock -case=underscore
@@ -86,14 +89,14 @@ type OAuth20Service interface {
 
 //go:generate mockery -name=RuntimeService -output=automock -outpkg=automock -case=underscore
 type RuntimeService interface {
-	List(ctx context.Context, filter []*labelfilter.LabelFilter, pageSize int, cursor string) (*model.RuntimePage, error)
+	List(ctx context.Context, filter []*labelfilter.LabelFilter, page pagination.Request, orderBy model.RuntimeOrderBy) (*model.RuntimePage, error)
 	GetLabel(ctx context.Context, runtimeID string, key string) (*model.Label, error)
 }
 
 //go:generate mockery -name=BundleService -output=automock -outpkg=automock -case=underscore
 type BundleService interface {
 	GetForApplication(ctx context.Context, id string, applicationID string) (*model.Bundle, error)
-	ListByApplicationID(ctx context.Context, applicationID string, pageSize int, cursor string) (*model.BundlePage, error)
+	ListByApplicationIDs(ctx context.Context, applicationIDs []string, pageSize int, cursor string) ([]*model.BundlePage, error)
 	CreateMultiple(ctx context.Context, applicationID string, in []*model.BundleCreateInput) error
 }
 
@@ -147,15 +150,19 @@ func NewResolver(transact persistence.Transactioner,
 	}
 }
 
-func (r *Resolver) Applications(ctx context.Context, filter []*graphql.LabelFilter, first *int, after *graphql.PageCursor) (*graphql.ApplicationPage, error) {
+func (r *Resolver) Applications(ctx context.Context, filter []*graphql.LabelFilter, first *int, after *graphql.PageCursor, last *int, before *graphql.PageCursor, orderBy *graphql.ApplicationOrderByInput) (*graphql.ApplicationPage, error) {
 	labelFilter := labelfilter.MultipleFromGraphQL(filter)
 
-	var cursor string
+	var afterCursor, beforeCursor string
 	if after != nil {
-		cursor = string(*after)
+		afterCursor = string(*after)
 	}
-	if first == nil {
-		return nil, apperrors.NewInvalidDataError("missing required parameter 'first'")
+	if before != nil {
+		beforeCursor = string(*before)
+	}
+	page, err := pagination.NewRequest(first, afterCursor, last, beforeCursor)
+	if err != nil {
+		return nil, err
 	}
 
 	tx, err := r.transact.Begin()
@@ -166,7 +173,7 @@ func (r *Resolver) Applications(ctx context.Context, filter []*graphql.LabelFilt
 
 	ctx = persistence.SaveToContext(ctx, tx)
 
-	appPage, err := r.appSvc.List(ctx, labelFilter, *first, cursor)
+	appPage, err := r.appSvc.List(ctx, labelFilter, page, orderByFromGraphQL(orderBy))
 	if err != nil {
 		return nil, err
 	}
@@ -182,9 +189,10 @@ func (r *Resolver) Applications(ctx context.Context, filter []*graphql.LabelFilt
 		Data:       gqlApps,
 		TotalCount: appPage.TotalCount,
 		PageInfo: &graphql.PageInfo{
-			StartCursor: graphql.PageCursor(appPage.PageInfo.StartCursor),
-			EndCursor:   graphql.PageCursor(appPage.PageInfo.EndCursor),
-			HasNextPage: appPage.PageInfo.HasNextPage,
+			StartCursor:     graphql.PageCursor(appPage.PageInfo.StartCursor),
+			EndCursor:       graphql.PageCursor(appPage.PageInfo.EndCursor),
+			HasNextPage:     appPage.PageInfo.HasNextPage,
+			HasPreviousPage: appPage.PageInfo.HasPreviousPage,
 		},
 	}, nil
 }
@@ -253,9 +261,10 @@ func (r *Resolver) ApplicationsForRuntime(ctx context.Context, runtimeID string,
 		Data:       gqlApps,
 		TotalCount: appPage.TotalCount,
 		PageInfo: &graphql.PageInfo{
-			StartCursor: graphql.PageCursor(appPage.PageInfo.StartCursor),
-			EndCursor:   graphql.PageCursor(appPage.PageInfo.EndCursor),
-			HasNextPage: appPage.PageInfo.HasNextPage,
+			StartCursor:     graphql.PageCursor(appPage.PageInfo.StartCursor),
+			EndCursor:       graphql.PageCursor(appPage.PageInfo.EndCursor),
+			HasNextPage:     appPage.PageInfo.HasNextPage,
+			HasPreviousPage: appPage.PageInfo.HasPreviousPage,
 		},
 	}, nil
 }
@@ -406,8 +415,26 @@ func (r *Resolver) DeleteApplicationLabel(ctx context.Context, applicationID str
 	}, nil
 }
 
-// TODO: Proper error handling
 func (r *Resolver) Webhooks(ctx context.Context, obj *graphql.Application) ([]*graphql.Webhook, error) {
+	if obj == nil {
+		return nil, apperrors.NewInternalError("Application cannot be empty")
+	}
+
+	loaders, err := dataloader.LoadFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return loaders.ApplicationWebhooks(ctx, obj)
+}
+
+// WebhooksDataLoader fetches the webhooks of all of the applications, including the webhooks inherited from their application templates
+func (r *Resolver) WebhooksDataLoader(ctx context.Context, applications []*graphql.Application) ([][]*graphql.Webhook, error) {
+	tenantID, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return nil, apperrors.NewCannotReadTenantError()
+	}
+
 	tx, err := r.transact.Begin()
 	if err != nil {
 		return nil, err
@@ -416,11 +443,13 @@ func (r *Resolver) Webhooks(ctx context.Context, obj *graphql.Application) ([]*g
 
 	ctx = persistence.SaveToContext(ctx, tx)
 
-	webhooks, err := r.webhookSvc.ListAllApplicationWebhooks(ctx, obj.ID)
+	apps := make([]*model.Application, 0, len(applications))
+	for _, application := range applications {
+		apps = append(apps, r.appConverter.GraphQLToModel(application, tenantID))
+	}
+
+	webhooksByAppID, err := r.webhookSvc.ListAllForApplications(ctx, apps)
 	if err != nil {
-		if apperrors.IsNotFoundError(err) {
-			return nil, tx.Commit()
-		}
 		return nil, err
 	}
 
@@ -428,7 +457,16 @@ func (r *Resolver) Webhooks(ctx context.Context, obj *graphql.Application) ([]*g
 		return nil, err
 	}
 
-	return r.webhookConverter.MultipleToGraphQL(webhooks)
+	out := make([][]*graphql.Webhook, 0, len(applications))
+	for _, application := range applications {
+		gqlWebhooks, err := r.webhookConverter.MultipleToGraphQL(webhooksByAppID[application.ID])
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, gqlWebhooks)
+	}
+
+	return out, nil
 }
 
 func (r *Resolver) Labels(ctx context.Context, obj *graphql.Application, key *string) (graphql.Labels, error) {
@@ -436,6 +474,16 @@ func (r *Resolver) Labels(ctx context.Context, obj *graphql.Application, key *st
 		return nil, apperrors.NewInternalError("Application cannot be empty")
 	}
 
+	loaders, err := dataloader.LoadFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return loaders.ApplicationLabels(ctx, obj.ID)
+}
+
+// LabelsDataLoader fetches the labels of all of the applications
+func (r *Resolver) LabelsDataLoader(ctx context.Context, applicationIDs []string) ([]graphql.Labels, error) {
 	tx, err := r.transact.Begin()
 	if err != nil {
 		return nil, err
@@ -444,11 +492,8 @@ func (r *Resolver) Labels(ctx context.Context, obj *graphql.Application, key *st
 
 	ctx = persistence.SaveToContext(ctx, tx)
 
-	itemMap, err := r.appSvc.ListLabels(ctx, obj.ID)
+	labelsByAppID, err := r.appSvc.ListLabelsForApplications(ctx, applicationIDs)
 	if err != nil {
-		if strings.Contains(err.Error(), "doesn't exist") {
-			return nil, tx.Commit()
-		}
 		return nil, err
 	}
 
@@ -457,14 +502,18 @@ func (r *Resolver) Labels(ctx context.Context, obj *graphql.Application, key *st
 		return nil, err
 	}
 
-	resultLabels := make(map[string]interface{})
+	out := make([]graphql.Labels, 0, len(applicationIDs))
+	for _, appID := range applicationIDs {
+		resultLabels := make(map[string]interface{})
+
+		for _, label := range labelsByAppID[appID] {
+			resultLabels[label.Key] = label.Value
+		}
 
-	for _, label := range itemMap {
-		resultLabels[label.Key] = label.Value
+		out = append(out, resultLabels)
 	}
 
-	var gqlLabels graphql.Labels = resultLabels
-	return gqlLabels, nil
+	return out, nil
 }
 
 func (r *Resolver) Auths(ctx context.Context, obj *graphql.Application) ([]*graphql.SystemAuth, error) {
@@ -472,6 +521,16 @@ func (r *Resolver) Auths(ctx context.Context, obj *graphql.Application) ([]*grap
 		return nil, apperrors.NewInternalError("Application cannot be empty")
 	}
 
+	loaders, err := dataloader.LoadFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return loaders.ApplicationAuths(ctx, obj.ID)
+}
+
+// AuthsDataLoader fetches the system auths of all of the applications
+func (r *Resolver) AuthsDataLoader(ctx context.Context, applicationIDs []string) ([][]*graphql.SystemAuth, error) {
 	tx, err := r.transact.Begin()
 	if err != nil {
 		return nil, err
@@ -479,7 +538,7 @@ func (r *Resolver) Auths(ctx context.Context, obj *graphql.Application) ([]*grap
 	defer r.transact.RollbackUnlessCommitted(ctx, tx)
 	ctx = persistence.SaveToContext(ctx, tx)
 
-	sysAuths, err := r.sysAuthSvc.ListForObject(ctx, model.ApplicationReference, obj.ID)
+	sysAuthsByAppID, err := r.sysAuthSvc.ListForObjects(ctx, model.ApplicationReference, applicationIDs)
 	if err != nil {
 		return nil, err
 	}
@@ -489,14 +548,18 @@ func (r *Resolver) Auths(ctx context.Context, obj *graphql.Application) ([]*grap
 		return nil, err
 	}
 
-	var out []*graphql.SystemAuth
-	for _, sa := range sysAuths {
-		c, err := r.sysAuthConv.ToGraphQL(&sa)
-		if err != nil {
-			return nil, err
-		}
+	out := make([][]*graphql.SystemAuth, 0, len(applicationIDs))
+	for _, appID := range applicationIDs {
+		var appAuths []*graphql.SystemAuth
+		for _, sa := range sysAuthsByAppID[appID] {
+			c, err := r.sysAuthConv.ToGraphQL(&sa)
+			if err != nil {
+				return nil, err
+			}
 
-		out = append(out, c)
+			appAuths = append(appAuths, c)
+		}
+		out = append(out, appAuths)
 	}
 
 	return out, nil
@@ -541,14 +604,6 @@ func (r *Resolver) Bundles(ctx context.Context, obj *graphql.Application, first
 		return nil, apperrors.NewInternalError("Application cannot be empty")
 	}
 
-	tx, err := r.transact.Begin()
-	if err != nil {
-		return nil, err
-	}
-	defer r.transact.RollbackUnlessCommitted(ctx, tx)
-
-	ctx = persistence.SaveToContext(ctx, tx)
-
 	var cursor string
 	if after != nil {
 		cursor = string(*after)
@@ -558,30 +613,54 @@ func (r *Resolver) Bundles(ctx context.Context, obj *graphql.Application, first
 		return nil, apperrors.NewInvalidDataError("missing required parameter 'first'")
 	}
 
-	bndlsPage, err := r.bndlSvc.ListByApplicationID(ctx, obj.ID, *first, cursor)
+	loaders, err := dataloader.LoadFromContext(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	err = tx.Commit()
+	return loaders.ApplicationBundles(ctx, obj.ID, *first, cursor)
+}
+
+// BundlesDataLoader fetches the same page of bundles of all of the applications
+func (r *Resolver) BundlesDataLoader(ctx context.Context, applicationIDs []string, first int, after string) ([]*graphql.BundlePage, error) {
+	tx, err := r.transact.Begin()
 	if err != nil {
 		return nil, err
 	}
+	defer r.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
 
-	gqlBndls, err := r.bndlConv.MultipleToGraphQL(bndlsPage.Data)
+	bndlsPages, err := r.bndlSvc.ListByApplicationIDs(ctx, applicationIDs, first, after)
 	if err != nil {
 		return nil, err
 	}
 
-	return &graphql.BundlePage{
-		Data:       gqlBndls,
-		TotalCount: bndlsPage.TotalCount,
-		PageInfo: &graphql.PageInfo{
-			StartCursor: graphql.PageCursor(bndlsPage.PageInfo.StartCursor),
-			EndCursor:   graphql.PageCursor(bndlsPage.PageInfo.EndCursor),
-			HasNextPage: bndlsPage.PageInfo.HasNextPage,
-		},
-	}, nil
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]*graphql.BundlePage, 0, len(bndlsPages))
+	for _, bndlsPage := range bndlsPages {
+		gqlBndls, err := r.bndlConv.MultipleToGraphQL(bndlsPage.Data)
+		if err != nil {
+			return nil, err
+		}
+
+		out = append(out, &graphql.BundlePage{
+			Data:       gqlBndls,
+			TotalCount: bndlsPage.TotalCount,
+			PageInfo: &graphql.PageInfo{
+				StartCursor:     graphql.PageCursor(bndlsPage.PageInfo.StartCursor),
+				EndCursor:       graphql.PageCursor(bndlsPage.PageInfo.EndCursor),
+				HasNextPage:     bndlsPage.PageInfo.HasNextPage,
+				HasPreviousPage: bndlsPage.PageInfo.HasPreviousPage,
+			},
+		})
+	}
+
+	return out, nil
 }
 
 func (r *Resolver) Bundle(ctx context.Context, obj *graphql.Application, id string) (*graphql.Bundle, error) {
@@ -10,6 +10,7 @@ import (
 
 	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
 
+	"github.com/kyma-incubator/compass/components/director/internal/dataloader"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/tenant"
 	"github.com/kyma-incubator/compass/components/director/pkg/persistence/txtest"
 
@@ -19,6 +20,7 @@ import (
 	"github.com/kyma-incubator/compass/components/director/internal/labelfilter"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
 	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
 	persistenceautomock "github.com/kyma-incubator/compass/components/director/pkg/persistence/automock"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
@@ -587,6 +589,10 @@ func TestResolver_Applications(t *testing.T) {
 	first := 2
 	gqlAfter := graphql.PageCursor("test")
 	after := "test"
+	last := 3
+	gqlBefore := graphql.PageCursor("before")
+	before := "before"
+	descending := graphql.OrderDirectionDesc
 	query := "foo"
 	filter := []*labelfilter.LabelFilter{
 		{Key: "", Query: &query},
@@ -603,6 +609,10 @@ func TestResolver_Applications(t *testing.T) {
 		ServiceFn         func() *automock.ApplicationService
 		ConverterFn       func() *automock.ApplicationConverter
 		InputLabelFilters []*graphql.LabelFilter
+		InputAfter        *graphql.PageCursor
+		InputLast         *int
+		InputBefore       *graphql.PageCursor
+		InputOrderBy      *graphql.ApplicationOrderByInput
 		ExpectedResult    *graphql.ApplicationPage
 		ExpectedErr       error
 	}{
@@ -612,7 +622,7 @@ func TestResolver_Applications(t *testing.T) {
 			TransactionerFn: txtest.TransactionerThatSucceeds,
 			ServiceFn: func() *automock.ApplicationService {
 				svc := &automock.ApplicationService{}
-				svc.On("List", contextParam, filter, first, after).Return(fixApplicationPage(modelApplications), nil).Once()
+				svc.On("List", contextParam, filter, pagination.Request{PageSize: first, Cursor: after}, model.DefaultApplicationOrderBy).Return(fixApplicationPage(modelApplications), nil).Once()
 				return svc
 			},
 			ConverterFn: func() *automock.ApplicationConverter {
@@ -621,6 +631,7 @@ func TestResolver_Applications(t *testing.T) {
 				return conv
 			},
 			InputLabelFilters: gqlFilter,
+			InputAfter:        &gqlAfter,
 			ExpectedResult:    fixGQLApplicationPage(gqlApplications),
 			ExpectedErr:       nil,
 		},
@@ -630,7 +641,7 @@ func TestResolver_Applications(t *testing.T) {
 			TransactionerFn: txtest.TransactionerThatSucceeds,
 			ServiceFn: func() *automock.ApplicationService {
 				svc := &automock.ApplicationService{}
-				svc.On("List", contextParam, filter, first, after).Return(nil, testErr).Once()
+				svc.On("List", contextParam, filter, pagination.Request{PageSize: first, Cursor: after}, model.DefaultApplicationOrderBy).Return(nil, testErr).Once()
 				return svc
 			},
 			ConverterFn: func() *automock.ApplicationConverter {
@@ -638,9 +649,51 @@ func TestResolver_Applications(t *testing.T) {
 				return conv
 			},
 			InputLabelFilters: gqlFilter,
+			InputAfter:        &gqlAfter,
 			ExpectedResult:    nil,
 			ExpectedErr:       testErr,
 		},
+		{
+			Name:            "Success when paging backwards with custom order",
+			PersistenceFn:   txtest.PersistenceContextThatExpectsCommit,
+			TransactionerFn: txtest.TransactionerThatSucceeds,
+			ServiceFn: func() *automock.ApplicationService {
+				svc := &automock.ApplicationService{}
+				page := pagination.Request{PageSize: last, Cursor: before, Backward: true}
+				orderBy := model.ApplicationOrderBy{Field: model.ApplicationOrderFieldName, Descending: true}
+				svc.On("List", contextParam, filter, page, orderBy).Return(fixApplicationPage(modelApplications), nil).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.ApplicationConverter {
+				conv := &automock.ApplicationConverter{}
+				conv.On("MultipleToGraphQL", modelApplications).Return(gqlApplications).Once()
+				return conv
+			},
+			InputLabelFilters: gqlFilter,
+			InputLast:         &last,
+			InputBefore:       &gqlBefore,
+			InputOrderBy:      &graphql.ApplicationOrderByInput{Field: graphql.ApplicationOrderFieldName, Direction: &descending},
+			ExpectedResult:    fixGQLApplicationPage(gqlApplications),
+			ExpectedErr:       nil,
+		},
+		{
+			Name:          "Returns error when both after and before are provided",
+			PersistenceFn: txtest.PersistenceContextThatDoesntExpectCommit,
+			TransactionerFn: func(persistTx *persistenceautomock.PersistenceTx) *persistenceautomock.Transactioner {
+				return &persistenceautomock.Transactioner{}
+			},
+			ServiceFn: func() *automock.ApplicationService {
+				return &automock.ApplicationService{}
+			},
+			ConverterFn: func() *automock.ApplicationConverter {
+				return &automock.ApplicationConverter{}
+			},
+			InputLabelFilters: gqlFilter,
+			InputAfter:        &gqlAfter,
+			InputBefore:       &gqlBefore,
+			ExpectedResult:    nil,
+			ExpectedErr:       apperrors.NewInvalidDataError("parameters 'after' and 'before' cannot be used together"),
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -654,7 +707,7 @@ func TestResolver_Applications(t *testing.T) {
 			resolver.SetConverter(converter)
 
 			// when
-			result, err := resolver.Applications(context.TODO(), testCase.InputLabelFilters, &first, &gqlAfter)
+			result, err := resolver.Applications(context.TODO(), testCase.InputLabelFilters, &first, testCase.InputAfter, testCase.InputLast, testCase.InputBefore, testCase.InputOrderBy)
 
 			// then
 			assert.Equal(t, testCase.ExpectedResult, result)
@@ -1029,7 +1082,20 @@ func TestResolver_DeleteApplicationLabel(t *testing.T) {
 
 func TestResolver_Webhooks(t *testing.T) {
 	// given
+	tnt := "tnt"
+	externalTnt := "ex-tnt"
+	ctx := tenant.SaveToContext(context.TODO(), tnt, externalTnt)
+
 	applicationID := "fooid"
+	secondApplicationID := "barid"
+	gqlApps := []*graphql.Application{
+		fixGQLApplication(applicationID, "foo", "bar"),
+		fixGQLApplication(secondApplicationID, "bar", "baz"),
+	}
+	modelApps := []*model.Application{
+		fixModelApplication(applicationID, tnt, "foo", "bar"),
+		fixModelApplication(secondApplicationID, tnt, "bar", "baz"),
+	}
 	modelWebhooks := []*model.Webhook{
 		fixModelWebhook(applicationID, "foo"),
 		fixModelWebhook(applicationID, "bar"),
@@ -1038,44 +1104,53 @@ func TestResolver_Webhooks(t *testing.T) {
 		fixGQLWebhook("foo"),
 		fixGQLWebhook("bar"),
 	}
-	app := fixGQLApplication(applicationID, "foo", "bar")
+	webhooksByAppID := map[string][]*model.Webhook{applicationID: modelWebhooks}
 	testErr := errors.New("Test error")
+	txGen := txtest.NewTransactionContextGenerator(testErr)
+
+	appConverterFn := func() *automock.ApplicationConverter {
+		conv := &automock.ApplicationConverter{}
+		conv.On("GraphQLToModel", gqlApps[0], tnt).Return(modelApps[0]).Once()
+		conv.On("GraphQLToModel", gqlApps[1], tnt).Return(modelApps[1]).Once()
+		return conv
+	}
 
 	testCases := []struct {
 		Name               string
-		PersistenceFn      func() *persistenceautomock.PersistenceTx
-		TransactionerFn    func(persistTx *persistenceautomock.PersistenceTx) *persistenceautomock.Transactioner
+		TransactionerFn    func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
 		ServiceFn          func() *automock.WebhookService
+		AppConverterFn     func() *automock.ApplicationConverter
 		WebhookConverterFn func() *automock.WebhookConverter
-		ExpectedResult     []*graphql.Webhook
+		ExpectedResult     [][]*graphql.Webhook
 		ExpectedErr        error
 	}{
 		{
 			Name:            "Success",
-			PersistenceFn:   txtest.PersistenceContextThatExpectsCommit,
-			TransactionerFn: txtest.TransactionerThatSucceeds,
+			TransactionerFn: txGen.ThatSucceeds,
 			ServiceFn: func() *automock.WebhookService {
 				svc := &automock.WebhookService{}
-				svc.On("ListAllApplicationWebhooks", contextParam, applicationID).Return(modelWebhooks, nil).Once()
+				svc.On("ListAllForApplications", contextParam, modelApps).Return(webhooksByAppID, nil).Once()
 				return svc
 			},
+			AppConverterFn: appConverterFn,
 			WebhookConverterFn: func() *automock.WebhookConverter {
 				conv := &automock.WebhookConverter{}
 				conv.On("MultipleToGraphQL", modelWebhooks).Return(gqlWebhooks, nil).Once()
+				conv.On("MultipleToGraphQL", []*model.Webhook(nil)).Return(nil, nil).Once()
 				return conv
 			},
-			ExpectedResult: gqlWebhooks,
+			ExpectedResult: [][]*graphql.Webhook{gqlWebhooks, nil},
 			ExpectedErr:    nil,
 		},
 		{
 			Name:            "Returns error when webhook listing failed",
-			PersistenceFn:   txtest.PersistenceContextThatDoesntExpectCommit,
-			TransactionerFn: txtest.TransactionerThatSucceeds,
+			TransactionerFn: txGen.ThatDoesntExpectCommit,
 			ServiceFn: func() *automock.WebhookService {
 				svc := &automock.WebhookService{}
-				svc.On("ListAllApplicationWebhooks", contextParam, applicationID).Return(nil, testErr).Once()
+				svc.On("ListAllForApplications", contextParam, modelApps).Return(nil, testErr).Once()
 				return svc
 			},
+			AppConverterFn: appConverterFn,
 			WebhookConverterFn: func() *automock.WebhookConverter {
 				return &automock.WebhookConverter{}
 			},
@@ -1083,89 +1158,127 @@ func TestResolver_Webhooks(t *testing.T) {
 			ExpectedErr:    testErr,
 		},
 		{
-			Name: "Returns error on starting transaction",
-			TransactionerFn: func(persistTx *persistenceautomock.PersistenceTx) *persistenceautomock.Transactioner {
-				transact := &persistenceautomock.Transactioner{}
-				transact.On("Begin").Return(nil, testErr).Once()
-				return transact
-			},
-			PersistenceFn: txtest.PersistenceContextThatDoesntExpectCommit,
+			Name:            "Returns error on starting transaction",
+			TransactionerFn: txGen.ThatFailsOnBegin,
 			ServiceFn: func() *automock.WebhookService {
 				return &automock.WebhookService{}
 			},
+			AppConverterFn: func() *automock.ApplicationConverter {
+				return &automock.ApplicationConverter{}
+			},
 			WebhookConverterFn: func() *automock.WebhookConverter {
 				return &automock.WebhookConverter{}
 			},
 			ExpectedErr: testErr,
 		},
 		{
-			Name: "Returns error on committing transaction",
-			PersistenceFn: func() *persistenceautomock.PersistenceTx {
-				persistTx := &persistenceautomock.PersistenceTx{}
-				persistTx.On("Commit").Return(testErr).Once()
-				return persistTx
-			},
-			TransactionerFn: txtest.TransactionerThatSucceeds,
+			Name:            "Returns error on committing transaction",
+			TransactionerFn: txGen.ThatFailsOnCommit,
 			ServiceFn: func() *automock.WebhookService {
 				svc := &automock.WebhookService{}
-				svc.On("ListAllApplicationWebhooks", contextParam, applicationID).Return(modelWebhooks, nil).Once()
+				svc.On("ListAllForApplications", contextParam, modelApps).Return(webhooksByAppID, nil).Once()
 				return svc
 			},
+			AppConverterFn: appConverterFn,
 			WebhookConverterFn: func() *automock.WebhookConverter {
 				return &automock.WebhookConverter{}
 			},
 			ExpectedErr: testErr,
 		},
 		{
-			Name: "Webhook service returns not found error",
-			PersistenceFn: func() *persistenceautomock.PersistenceTx {
-				persistTx := &persistenceautomock.PersistenceTx{}
-				persistTx.On("Commit").Return(nil).Once()
-				return persistTx
-			},
-			TransactionerFn: txtest.TransactionerThatSucceeds,
+			Name:            "Returns error when converting webhooks failed",
+			TransactionerFn: txGen.ThatSucceeds,
 			ServiceFn: func() *automock.WebhookService {
 				svc := &automock.WebhookService{}
-				svc.On("ListAllApplicationWebhooks", contextParam, applicationID).Return(nil, apperrors.NewNotFoundError(resource.Webhook, "foo")).Once()
+				svc.On("ListAllForApplications", contextParam, modelApps).Return(webhooksByAppID, nil).Once()
 				return svc
 			},
+			AppConverterFn: appConverterFn,
 			WebhookConverterFn: func() *automock.WebhookConverter {
-				return &automock.WebhookConverter{}
+				conv := &automock.WebhookConverter{}
+				conv.On("MultipleToGraphQL", modelWebhooks).Return(nil, testErr).Once()
+				return conv
 			},
-			ExpectedResult: nil,
-			ExpectedErr:    nil,
+			ExpectedErr: testErr,
 		},
 	}
 
 	for _, testCase := range testCases {
 		t.Run(testCase.Name, func(t *testing.T) {
 			svc := testCase.ServiceFn()
+			appConverter := testCase.AppConverterFn()
 			converter := testCase.WebhookConverterFn()
+			persist, transact := testCase.TransactionerFn()
 
-			mockPersistence := testCase.PersistenceFn()
-			mockTransactioner := testCase.TransactionerFn(mockPersistence)
-
-			resolver := application.NewResolver(mockTransactioner, nil, svc, nil, nil, nil, converter, nil, nil, nil, nil)
+			resolver := application.NewResolver(transact, nil, svc, nil, nil, appConverter, converter, nil, nil, nil, nil)
 
 			// when
-			result, err := resolver.Webhooks(context.TODO(), app)
+			result, err := resolver.WebhooksDataLoader(ctx, gqlApps)
 
 			// then
 			assert.Equal(t, testCase.ExpectedResult, result)
 			assert.Equal(t, testCase.ExpectedErr, err)
 
 			svc.AssertExpectations(t)
+			appConverter.AssertExpectations(t)
 			converter.AssertExpectations(t)
-			mockPersistence.AssertExpectations(t)
-			mockTransactioner.AssertExpectations(t)
+			persist.AssertExpectations(t)
+			transact.AssertExpectations(t)
 		})
 	}
+
+	t.Run("Returns webhooks of application fetched with dataloader", func(t *testing.T) {
+		persist, transact := txGen.ThatSucceeds()
+		svc := &automock.WebhookService{}
+		svc.On("ListAllForApplications", contextParam, modelApps[:1]).Return(webhooksByAppID, nil).Once()
+		appConverter := &automock.ApplicationConverter{}
+		appConverter.On("GraphQLToModel", gqlApps[0], tnt).Return(modelApps[0]).Once()
+		converter := &automock.WebhookConverter{}
+		converter.On("MultipleToGraphQL", modelWebhooks).Return(gqlWebhooks, nil).Once()
+		resolver := application.NewResolver(transact, nil, svc, nil, nil, appConverter, converter, nil, nil, nil, nil)
+		loaders := dataloader.NewLoaders(dataloader.Fetchers{ApplicationWebhooks: resolver.WebhooksDataLoader}, dataloader.Config{})
+
+		// when
+		result, err := resolver.Webhooks(dataloader.SaveToContext(ctx, loaders), gqlApps[0])
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, gqlWebhooks, result)
+		mock.AssertExpectationsForObjects(t, persist, transact, svc, appConverter, converter)
+	})
+
+	t.Run("Returns error when dataloaders are missing in context", func(t *testing.T) {
+		resolver := application.NewResolver(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+		// when
+		_, err := resolver.Webhooks(ctx, gqlApps[0])
+		// then
+		require.Equal(t, dataloader.NoLoadersError, err)
+	})
+
+	t.Run("Returns error when tenant is missing in context", func(t *testing.T) {
+		resolver := application.NewResolver(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+		// when
+		_, err := resolver.WebhooksDataLoader(context.TODO(), gqlApps)
+		// then
+		require.Error(t, err)
+		assert.EqualError(t, err, apperrors.NewCannotReadTenantError().Error())
+	})
+
+	t.Run("Returns error when application is nil", func(t *testing.T) {
+		resolver := application.NewResolver(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+		// when
+		_, err := resolver.Webhooks(ctx, nil)
+		// then
+		require.Error(t, err)
+		assert.EqualError(t, err, "Internal Server Error: Application cannot be empty")
+	})
 }
 
 func TestResolver_Labels(t *testing.T) {
 	// given
 
 	id := "foo"
+	secondID := "bar"
 	tenant := "tenant"
 	labelKey := "key"
 	labelValue := "val"
@@ -1203,9 +1316,7 @@ func TestResolver_Labels(t *testing.T) {
 		PersistenceFn   func() *persistenceautomock.PersistenceTx
 		TransactionerFn func(persistTx *persistenceautomock.PersistenceTx) *persistenceautomock.Transactioner
 		ServiceFn       func() *automock.ApplicationService
-		InputApp        *graphql.Application
-		InputKey        string
-		ExpectedResult  graphql.Labels
+		ExpectedResult  []graphql.Labels
 		ExpectedErr     error
 	}{
 		{
@@ -1214,11 +1325,10 @@ func TestResolver_Labels(t *testing.T) {
 			TransactionerFn: txtest.TransactionerThatSucceeds,
 			ServiceFn: func() *automock.ApplicationService {
 				svc := &automock.ApplicationService{}
-				svc.On("ListLabels", contextParam, id).Return(modelLabels, nil).Once()
+				svc.On("ListLabelsForApplications", contextParam, []string{id, secondID}).Return(map[string]map[string]*model.Label{id: modelLabels}, nil).Once()
 				return svc
 			},
-			InputKey:       labelKey,
-			ExpectedResult: gqlLabels,
+			ExpectedResult: []graphql.Labels{gqlLabels, {}},
 			ExpectedErr:    nil,
 		},
 		{
@@ -1227,10 +1337,9 @@ func TestResolver_Labels(t *testing.T) {
 			TransactionerFn: txtest.TransactionerThatSucceeds,
 			ServiceFn: func() *automock.ApplicationService {
 				svc := &automock.ApplicationService{}
-				svc.On("ListLabels", contextParam, id).Return(nil, testErr).Once()
+				svc.On("ListLabelsForApplications", contextParam, []string{id, secondID}).Return(nil, testErr).Once()
 				return svc
 			},
-			InputKey:       labelKey,
 			ExpectedResult: nil,
 			ExpectedErr:    testErr,
 		},
@@ -1245,7 +1354,7 @@ func TestResolver_Labels(t *testing.T) {
 			resolver := application.NewResolver(transact, svc, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 			// when
-			result, err := resolver.Labels(context.TODO(), gqlApp, &testCase.InputKey)
+			result, err := resolver.LabelsDataLoader(context.TODO(), []string{id, secondID})
 
 			// then
 			assert.Equal(t, testCase.ExpectedResult, result)
@@ -1256,16 +1365,44 @@ func TestResolver_Labels(t *testing.T) {
 			persistTx.AssertExpectations(t)
 		})
 	}
+
+	t.Run("Returns labels of application fetched with dataloader", func(t *testing.T) {
+		persistTx := txtest.PersistenceContextThatExpectsCommit()
+		transact := txtest.TransactionerThatSucceeds(persistTx)
+		svc := &automock.ApplicationService{}
+		svc.On("ListLabelsForApplications", contextParam, []string{id}).Return(map[string]map[string]*model.Label{id: modelLabels}, nil).Once()
+		resolver := application.NewResolver(transact, svc, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+		loaders := dataloader.NewLoaders(dataloader.Fetchers{ApplicationLabels: resolver.LabelsDataLoader}, dataloader.Config{})
+		key := labelKey
+
+		// when
+		result, err := resolver.Labels(dataloader.SaveToContext(context.TODO(), loaders), gqlApp, &key)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, gqlLabels, result)
+		mock.AssertExpectationsForObjects(t, svc, transact, persistTx)
+	})
+
+	t.Run("Returns error when dataloaders are missing in context", func(t *testing.T) {
+		resolver := application.NewResolver(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+		// when
+		_, err := resolver.Labels(context.TODO(), gqlApp, nil)
+		// then
+		require.Equal(t, dataloader.NoLoadersError, err)
+	})
 }
 
 func TestResolver_Auths(t *testing.T) {
 	// given
 	id := "foo"
+	secondID := "bar"
 	testError := errors.New("error")
 	gqlApp := fixGQLApplication(id, "name", "desc")
 	txGen := txtest.NewTransactionContextGenerator(testError)
 
 	sysAuthModels := []model.SystemAuth{{ID: "id1", AppID: &id}, {ID: "id2", AppID: &id}}
+	sysAuthModelsByAppID := map[string][]model.SystemAuth{id: sysAuthModels}
 	sysAuthGQL := []*graphql.SystemAuth{{ID: "id1"}, {ID: "id2"}}
 
 	testCases := []struct {
@@ -1273,8 +1410,7 @@ func TestResolver_Auths(t *testing.T) {
 		TransactionerFn func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
 		ServiceFn       func() *automock.SystemAuthService
 		SysAuthConvFn   func() *automock.SystemAuthConverter
-		InputApp        *graphql.Application
-		ExpectedResult  []*graphql.SystemAuth
+		ExpectedResult  [][]*graphql.SystemAuth
 		ExpectedErr     error
 	}{
 		{
@@ -1282,7 +1418,7 @@ func TestResolver_Auths(t *testing.T) {
 			TransactionerFn: txGen.ThatSucceeds,
 			ServiceFn: func() *automock.SystemAuthService {
 				svc := &automock.SystemAuthService{}
-				svc.On("ListForObject", txtest.CtxWithDBMatcher(), model.ApplicationReference, id).Return(sysAuthModels, nil).Once()
+				svc.On("ListForObjects", txtest.CtxWithDBMatcher(), model.ApplicationReference, []string{id, secondID}).Return(sysAuthModelsByAppID, nil).Once()
 				return svc
 			},
 			SysAuthConvFn: func() *automock.SystemAuthConverter {
@@ -1291,8 +1427,7 @@ func TestResolver_Auths(t *testing.T) {
 				sysAuthConv.On("ToGraphQL", &sysAuthModels[1]).Return(sysAuthGQL[1], nil).Once()
 				return sysAuthConv
 			},
-			InputApp:       gqlApp,
-			ExpectedResult: sysAuthGQL,
+			ExpectedResult: [][]*graphql.SystemAuth{sysAuthGQL, nil},
 			ExpectedErr:    nil,
 		},
 		{
@@ -1300,14 +1435,13 @@ func TestResolver_Auths(t *testing.T) {
 			TransactionerFn: txGen.ThatFailsOnCommit,
 			ServiceFn: func() *automock.SystemAuthService {
 				svc := &automock.SystemAuthService{}
-				svc.On("ListForObject", txtest.CtxWithDBMatcher(), model.ApplicationReference, id).Return(sysAuthModels, nil).Once()
+				svc.On("ListForObjects", txtest.CtxWithDBMatcher(), model.ApplicationReference, []string{id, secondID}).Return(sysAuthModelsByAppID, nil).Once()
 				return svc
 			},
 			SysAuthConvFn: func() *automock.SystemAuthConverter {
 				sysAuthConv := &automock.SystemAuthConverter{}
 				return sysAuthConv
 			},
-			InputApp:       gqlApp,
 			ExpectedResult: nil,
 			ExpectedErr:    testError,
 		},
@@ -1316,14 +1450,13 @@ func TestResolver_Auths(t *testing.T) {
 			TransactionerFn: txGen.ThatDoesntExpectCommit,
 			ServiceFn: func() *automock.SystemAuthService {
 				svc := &automock.SystemAuthService{}
-				svc.On("ListForObject", txtest.CtxWithDBMatcher(), model.ApplicationReference, id).Return([]model.SystemAuth{}, testError).Once()
+				svc.On("ListForObjects", txtest.CtxWithDBMatcher(), model.ApplicationReference, []string{id, secondID}).Return(nil, testError).Once()
 				return svc
 			},
 			SysAuthConvFn: func() *automock.SystemAuthConverter {
 				sysAuthConv := &automock.SystemAuthConverter{}
 				return sysAuthConv
 			},
-			InputApp:       gqlApp,
 			ExpectedResult: nil,
 			ExpectedErr:    testError,
 		},
@@ -1338,7 +1471,6 @@ func TestResolver_Auths(t *testing.T) {
 				sysAuthConv := &automock.SystemAuthConverter{}
 				return sysAuthConv
 			},
-			InputApp:       gqlApp,
 			ExpectedResult: nil,
 			ExpectedErr:    testError,
 		},
@@ -1353,7 +1485,7 @@ func TestResolver_Auths(t *testing.T) {
 			resolver := application.NewResolver(transact, nil, nil, nil, svc, nil, nil, conv, nil, nil, nil)
 
 			// when
-			result, err := resolver.Auths(context.TODO(), testCase.InputApp)
+			result, err := resolver.AuthsDataLoader(context.TODO(), []string{id, secondID})
 
 			// then
 			assert.Equal(t, testCase.ExpectedResult, result)
@@ -1366,6 +1498,33 @@ func TestResolver_Auths(t *testing.T) {
 		})
 	}
 
+	t.Run("Returns auths of application fetched with dataloader", func(t *testing.T) {
+		persist, transact := txGen.ThatSucceeds()
+		svc := &automock.SystemAuthService{}
+		svc.On("ListForObjects", txtest.CtxWithDBMatcher(), model.ApplicationReference, []string{id}).Return(sysAuthModelsByAppID, nil).Once()
+		conv := &automock.SystemAuthConverter{}
+		conv.On("ToGraphQL", &sysAuthModels[0]).Return(sysAuthGQL[0], nil).Once()
+		conv.On("ToGraphQL", &sysAuthModels[1]).Return(sysAuthGQL[1], nil).Once()
+		resolver := application.NewResolver(transact, nil, nil, nil, svc, nil, nil, conv, nil, nil, nil)
+		loaders := dataloader.NewLoaders(dataloader.Fetchers{ApplicationAuths: resolver.AuthsDataLoader}, dataloader.Config{})
+
+		//WHEN
+		result, err := resolver.Auths(dataloader.SaveToContext(context.TODO(), loaders), gqlApp)
+
+		//THEN
+		require.NoError(t, err)
+		assert.Equal(t, sysAuthGQL, result)
+		mock.AssertExpectationsForObjects(t, persist, transact, svc, conv)
+	})
+
+	t.Run("Returns error when dataloaders are missing in context", func(t *testing.T) {
+		resolver := application.NewResolver(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+		//WHEN
+		_, err := resolver.Auths(context.TODO(), gqlApp)
+		//THEN
+		require.Equal(t, dataloader.NoLoadersError, err)
+	})
+
 	t.Run("Returns error when application is nil", func(t *testing.T) {
 		resolver := application.NewResolver(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 		//WHEN
@@ -1511,6 +1670,7 @@ func TestResolver_Bundles(t *testing.T) {
 
 	tenantID := "1"
 	applicationID := "1"
+	secondApplicationID := "2"
 	app := fixGQLApplication(applicationID, "foo", "bar")
 	modelBundles := []*model.Bundle{
 
@@ -1523,6 +1683,8 @@ func TestResolver_Bundles(t *testing.T) {
 		fixGQLBundle("bar", applicationID, "Bar", "Lorem Ipsum"),
 	}
 
+	modelBundlePages := []*model.BundlePage{fixBundlePage(modelBundles), fixBundlePage([]*model.Bundle{})}
+
 	txGen := txtest.NewTransactionContextGenerator(testErr)
 
 	first := 2
@@ -1534,7 +1696,7 @@ func TestResolver_Bundles(t *testing.T) {
 		TransactionerFn func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
 		ServiceFn       func() *automock.BundleService
 		ConverterFn     func() *automock.BundleConverter
-		ExpectedResult  *graphql.BundlePage
+		ExpectedResult  []*graphql.BundlePage
 		ExpectedErr     error
 	}{
 		{
@@ -1542,15 +1704,16 @@ func TestResolver_Bundles(t *testing.T) {
 			TransactionerFn: txGen.ThatSucceeds,
 			ServiceFn: func() *automock.BundleService {
 				svc := &automock.BundleService{}
-				svc.On("ListByApplicationID", txtest.CtxWithDBMatcher(), applicationID, first, after).Return(fixBundlePage(modelBundles), nil).Once()
+				svc.On("ListByApplicationIDs", txtest.CtxWithDBMatcher(), []string{applicationID, secondApplicationID}, first, after).Return(modelBundlePages, nil).Once()
 				return svc
 			},
 			ConverterFn: func() *automock.BundleConverter {
 				conv := &automock.BundleConverter{}
 				conv.On("MultipleToGraphQL", modelBundles).Return(gqlBundles, nil).Once()
+				conv.On("MultipleToGraphQL", []*model.Bundle{}).Return([]*graphql.Bundle{}, nil).Once()
 				return conv
 			},
-			ExpectedResult: fixGQLBundlePage(gqlBundles),
+			ExpectedResult: []*graphql.BundlePage{fixGQLBundlePage(gqlBundles), fixGQLBundlePage([]*graphql.Bundle{})},
 			ExpectedErr:    nil,
 		},
 		{
@@ -1572,7 +1735,7 @@ func TestResolver_Bundles(t *testing.T) {
 			TransactionerFn: txGen.ThatDoesntExpectCommit,
 			ServiceFn: func() *automock.BundleService {
 				svc := &automock.BundleService{}
-				svc.On("ListByApplicationID", txtest.CtxWithDBMatcher(), applicationID, first, after).Return(nil, testErr).Once()
+				svc.On("ListByApplicationIDs", txtest.CtxWithDBMatcher(), []string{applicationID, secondApplicationID}, first, after).Return(nil, testErr).Once()
 				return svc
 			},
 			ConverterFn: func() *automock.BundleConverter {
@@ -1587,7 +1750,7 @@ func TestResolver_Bundles(t *testing.T) {
 			TransactionerFn: txGen.ThatFailsOnCommit,
 			ServiceFn: func() *automock.BundleService {
 				svc := &automock.BundleService{}
-				svc.On("ListByApplicationID", txtest.CtxWithDBMatcher(), applicationID, first, after).Return(fixBundlePage(modelBundles), nil).Once()
+				svc.On("ListByApplicationIDs", txtest.CtxWithDBMatcher(), []string{applicationID, secondApplicationID}, first, after).Return(modelBundlePages, nil).Once()
 				return svc
 			},
 			ConverterFn: func() *automock.BundleConverter {
@@ -1602,7 +1765,7 @@ func TestResolver_Bundles(t *testing.T) {
 			TransactionerFn: txGen.ThatSucceeds,
 			ServiceFn: func() *automock.BundleService {
 				svc := &automock.BundleService{}
-				svc.On("ListByApplicationID", txtest.CtxWithDBMatcher(), applicationID, first, after).Return(fixBundlePage(modelBundles), nil).Once()
+				svc.On("ListByApplicationIDs", txtest.CtxWithDBMatcher(), []string{applicationID, secondApplicationID}, first, after).Return(modelBundlePages, nil).Once()
 				return svc
 			},
 			ConverterFn: func() *automock.BundleConverter {
@@ -1624,7 +1787,7 @@ func TestResolver_Bundles(t *testing.T) {
 
 			resolver := application.NewResolver(transact, nil, nil, nil, nil, nil, nil, nil, nil, svc, converter)
 			// when
-			result, err := resolver.Bundles(context.TODO(), app, &first, &gqlAfter)
+			result, err := resolver.BundlesDataLoader(context.TODO(), []string{applicationID, secondApplicationID}, first, after)
 
 			// then
 			assert.Equal(t, testCase.ExpectedResult, result)
@@ -1637,6 +1800,42 @@ func TestResolver_Bundles(t *testing.T) {
 		})
 	}
 
+	t.Run("Returns bundles of application fetched with dataloader", func(t *testing.T) {
+		// given
+		persist, transact := txGen.ThatSucceeds()
+		svc := &automock.BundleService{}
+		svc.On("ListByApplicationIDs", txtest.CtxWithDBMatcher(), []string{applicationID}, first, after).Return(modelBundlePages[:1], nil).Once()
+		converter := &automock.BundleConverter{}
+		converter.On("MultipleToGraphQL", modelBundles).Return(gqlBundles, nil).Once()
+		resolver := application.NewResolver(transact, nil, nil, nil, nil, nil, nil, nil, nil, svc, converter)
+		loaders := dataloader.NewLoaders(dataloader.Fetchers{ApplicationBundles: resolver.BundlesDataLoader}, dataloader.Config{})
+
+		// when
+		result, err := resolver.Bundles(dataloader.SaveToContext(context.TODO(), loaders), app, &first, &gqlAfter)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, fixGQLBundlePage(gqlBundles), result)
+		mock.AssertExpectationsForObjects(t, persist, transact, svc, converter)
+	})
+
+	t.Run("Returns error when dataloaders are missing in context", func(t *testing.T) {
+		resolver := application.NewResolver(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+		//when
+		_, err := resolver.Bundles(context.TODO(), app, &first, &gqlAfter)
+		//then
+		require.Equal(t, dataloader.NoLoadersError, err)
+	})
+
+	t.Run("Returns error when first is missing", func(t *testing.T) {
+		resolver := application.NewResolver(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+		//when
+		_, err := resolver.Bundles(context.TODO(), app, nil, &gqlAfter)
+		//then
+		require.Error(t, err)
+		assert.EqualError(t, err, apperrors.NewInvalidDataError("missing required parameter 'first'").Error())
+	})
+
 	t.Run("Returns error when application is nil", func(t *testing.T) {
 		resolver := application.NewResolver(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 		//when
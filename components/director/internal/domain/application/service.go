@@ -35,7 +35,7 @@ type ApplicationRepository interface {
 	Exists(ctx context.Context, tenant, id string) (bool, error)
 	GetByID(ctx context.Context, tenant, id string) (*model.Application, error)
 	GetGlobalByID(ctx context.Context, id string) (*model.Application, error)
-	List(ctx context.Context, tenant string, filter []*labelfilter.LabelFilter, pageSize int, cursor string) (*model.ApplicationPage, error)
+	List(ctx context.Context, tenant string, filter []*labelfilter.LabelFilter, page pagination.Request, orderBy model.ApplicationOrderBy) (*model.ApplicationPage, error)
 	ListAll(ctx context.Context, tenant string) ([]*model.Application, error)
 	ListGlobal(ctx context.Context, pageSize int, cursor string) (*model.ApplicationPage, error)
 	ListByScenarios(ctx context.Context, tenantID uuid.UUID, scenarios []string, pageSize int, cursor string, hidingSelectors map[string][]string) (*model.ApplicationPage, error)
@@ -49,6 +49,7 @@ type ApplicationRepository interface {
 type LabelRepository interface {
 	GetByKey(ctx context.Context, tenant string, objectType model.LabelableObject, objectID, key string) (*model.Label, error)
 	ListForObject(ctx context.Context, tenant string, objectType model.LabelableObject, objectID string) (map[string]*model.Label, error)
+	ListForObjectIDs(ctx context.Context, tenant string, objectType model.LabelableObject, objectIDs []string) (map[string]map[string]*model.Label, error)
 	Delete(ctx context.Context, tenant string, objectType model.LabelableObject, objectID string, key string) error
 	DeleteAll(ctx context.Context, tenant string, objectType model.LabelableObject, objectID string) error
 }
@@ -124,17 +125,26 @@ func NewService(appNameNormalizer normalizer.Normalizator, appHideCfgProvider Ap
 	}
 }
 
-func (s *service) List(ctx context.Context, filter []*labelfilter.LabelFilter, pageSize int, cursor string) (*model.ApplicationPage, error) {
+func (s *service) List(ctx context.Context, filter []*labelfilter.LabelFilter, page pagination.Request, orderBy model.ApplicationOrderBy) (*model.ApplicationPage, error) {
 	appTenant, err := tenant.LoadFromContext(ctx)
 	if err != nil {
 		return nil, errors.Wrapf(err, "while loading tenant from context")
 	}
 
-	if pageSize < 1 || pageSize > 200 {
+	if page.PageSize < 1 || page.PageSize > 200 {
 		return nil, apperrors.NewInvalidDataError("page size must be between 1 and 200")
 	}
 
-	return s.appRepo.List(ctx, appTenant, filter, pageSize, cursor)
+	return s.appRepo.List(ctx, appTenant, filter, page, orderBy)
+}
+
+func (s *service) ListAll(ctx context.Context) ([]*model.Application, error) {
+	appTenant, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while loading tenant from context")
+	}
+
+	return s.appRepo.ListAll(ctx, appTenant)
 }
 
 func (s *service) ListGlobal(ctx context.Context, pageSize int, cursor string) (*model.ApplicationPage, error) {
@@ -376,6 +386,22 @@ func (s *service) ListLabels(ctx context.Context, applicationID string) (map[str
 	return labels, nil
 }
 
+// ListLabelsForApplications returns the labels of every application, grouped by the application ID and the label key.
+// Unlike ListLabels, it does not check whether the applications exist.
+func (s *service) ListLabelsForApplications(ctx context.Context, applicationIDs []string) (map[string]map[string]*model.Label, error) {
+	appTenant, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while loading tenant from context")
+	}
+
+	labels, err := s.labelRepo.ListForObjectIDs(ctx, appTenant, model.ApplicationLabelableObject, applicationIDs)
+	if err != nil {
+		return nil, errors.Wrap(err, "while getting labels for Applications")
+	}
+
+	return labels, nil
+}
+
 func (s *service) DeleteLabel(ctx context.Context, applicationID string, key string) error {
 	appTenant, err := tenant.LoadFromContext(ctx)
 	if err != nil {
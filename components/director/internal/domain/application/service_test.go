@@ -2107,7 +2107,7 @@ func TestService_List(t *testing.T) {
 			Name: "Success",
 			RepositoryFn: func() *automock.ApplicationRepository {
 				repo := &automock.ApplicationRepository{}
-				repo.On("List", ctx, tnt, filter, first, after).Return(applicationPage, nil).Once()
+				repo.On("List", ctx, tnt, filter, pagination.Request{PageSize: first, Cursor: after}, model.DefaultApplicationOrderBy).Return(applicationPage, nil).Once()
 				return repo
 			},
 			InputPageSize:      first,
@@ -2119,7 +2119,7 @@ func TestService_List(t *testing.T) {
 			Name: "Returns error when application listing failed",
 			RepositoryFn: func() *automock.ApplicationRepository {
 				repo := &automock.ApplicationRepository{}
-				repo.On("List", ctx, tnt, filter, first, after).Return(nil, testErr).Once()
+				repo.On("List", ctx, tnt, filter, pagination.Request{PageSize: first, Cursor: after}, model.DefaultApplicationOrderBy).Return(nil, testErr).Once()
 				return repo
 			},
 			InputPageSize:      first,
@@ -2158,7 +2158,7 @@ func TestService_List(t *testing.T) {
 			svc := application.NewService(nil, nil, repo, nil, nil, nil, nil, nil, nil, nil, nil)
 
 			// when
-			app, err := svc.List(ctx, testCase.InputLabelFilters, testCase.InputPageSize, after)
+			app, err := svc.List(ctx, testCase.InputLabelFilters, pagination.Request{PageSize: testCase.InputPageSize, Cursor: after}, model.DefaultApplicationOrderBy)
 
 			// then
 			if testCase.ExpectedErrMessage == "" {
@@ -2174,6 +2174,81 @@ func TestService_List(t *testing.T) {
 	}
 }
 
+func TestService_ListAll(t *testing.T) {
+	// given
+	testErr := errors.New("Test error")
+
+	modelApplications := []*model.Application{
+		fixModelApplication("foo", "tenant-foo", "foo", "Lorem Ipsum"),
+		fixModelApplication("bar", "tenant-bar", "bar", "Lorem Ipsum"),
+	}
+
+	tnt := "tenant"
+	externalTnt := "external-tnt"
+
+	ctx := context.TODO()
+	ctx = tenant.SaveToContext(ctx, tnt, externalTnt)
+
+	testCases := []struct {
+		Name               string
+		RepositoryFn       func() *automock.ApplicationRepository
+		ExpectedResult     []*model.Application
+		ExpectedErrMessage string
+	}{
+		{
+			Name: "Success",
+			RepositoryFn: func() *automock.ApplicationRepository {
+				repo := &automock.ApplicationRepository{}
+				repo.On("ListAll", ctx, tnt).Return(modelApplications, nil).Once()
+				return repo
+			},
+			ExpectedResult:     modelApplications,
+			ExpectedErrMessage: "",
+		},
+		{
+			Name: "Returns error when application listing failed",
+			RepositoryFn: func() *automock.ApplicationRepository {
+				repo := &automock.ApplicationRepository{}
+				repo.On("ListAll", ctx, tnt).Return(nil, testErr).Once()
+				return repo
+			},
+			ExpectedResult:     nil,
+			ExpectedErrMessage: testErr.Error(),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			repo := testCase.RepositoryFn()
+
+			svc := application.NewService(nil, nil, repo, nil, nil, nil, nil, nil, nil, nil, nil)
+
+			// when
+			apps, err := svc.ListAll(ctx)
+
+			// then
+			if testCase.ExpectedErrMessage == "" {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.ExpectedResult, apps)
+			} else {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErrMessage)
+			}
+
+			repo.AssertExpectations(t)
+		})
+	}
+
+	t.Run("Returns error on loading tenant", func(t *testing.T) {
+		svc := application.NewService(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+		// when
+		_, err := svc.ListAll(context.TODO())
+		// then
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "while loading tenant from context")
+	})
+}
+
 func TestService_ListGlobal(t *testing.T) {
 	// given
 	testErr := errors.New("Test error")
@@ -2972,6 +3047,88 @@ func TestService_ListLabel(t *testing.T) {
 	}
 }
 
+func TestService_ListLabelsForApplications(t *testing.T) {
+	// given
+	tnt := "tenant"
+	externalTnt := "external-tnt"
+
+	ctx := context.TODO()
+	ctx = tenant.SaveToContext(ctx, tnt, externalTnt)
+
+	testErr := errors.New("Test error")
+
+	firstAppID := "foo"
+	secondAppID := "bar"
+	applicationIDs := []string{firstAppID, secondAppID}
+
+	labels := map[string]map[string]*model.Label{
+		firstAppID: {
+			"key": {ID: "5d23d9d9-3d04-4fa9-95e6-d22e1ae62c11", Tenant: tnt, Key: "key", Value: "value1", ObjectID: firstAppID, ObjectType: model.ApplicationLabelableObject},
+		},
+		secondAppID: {
+			"key": {ID: "6d23d9d9-3d04-4fa9-95e6-d22e1ae62c12", Tenant: tnt, Key: "key", Value: "value2", ObjectID: secondAppID, ObjectType: model.ApplicationLabelableObject},
+		},
+	}
+
+	testCases := []struct {
+		Name               string
+		LabelRepositoryFn  func() *automock.LabelRepository
+		ExpectedOutput     map[string]map[string]*model.Label
+		ExpectedErrMessage string
+	}{
+		{
+			Name: "Success",
+			LabelRepositoryFn: func() *automock.LabelRepository {
+				repo := &automock.LabelRepository{}
+				repo.On("ListForObjectIDs", ctx, tnt, model.ApplicationLabelableObject, applicationIDs).Return(labels, nil).Once()
+				return repo
+			},
+			ExpectedOutput:     labels,
+			ExpectedErrMessage: "",
+		},
+		{
+			Name: "Returns error when labels receiving failed",
+			LabelRepositoryFn: func() *automock.LabelRepository {
+				repo := &automock.LabelRepository{}
+				repo.On("ListForObjectIDs", ctx, tnt, model.ApplicationLabelableObject, applicationIDs).Return(nil, testErr).Once()
+				return repo
+			},
+			ExpectedOutput:     nil,
+			ExpectedErrMessage: testErr.Error(),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			labelRepo := testCase.LabelRepositoryFn()
+			svc := application.NewService(nil, nil, nil, nil, nil, labelRepo, nil, nil, nil, nil, nil)
+
+			// when
+			l, err := svc.ListLabelsForApplications(ctx, applicationIDs)
+
+			// then
+			if testCase.ExpectedErrMessage == "" {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.ExpectedOutput, l)
+			} else {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErrMessage)
+			}
+
+			labelRepo.AssertExpectations(t)
+		})
+	}
+
+	t.Run("Returns error on loading tenant", func(t *testing.T) {
+		svc := application.NewService(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+		// when
+		_, err := svc.ListLabelsForApplications(context.TODO(), applicationIDs)
+		// then
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "while loading tenant from context")
+	})
+}
+
 func TestService_DeleteLabel(t *testing.T) {
 	// given
 	tnt := "tenant"
@@ -150,9 +150,10 @@ func (r *Resolver) ApplicationTemplates(ctx context.Context, first *int, after *
 		Data:       gqlAppTemplate,
 		TotalCount: appTemplatePage.TotalCount,
 		PageInfo: &graphql.PageInfo{
-			StartCursor: graphql.PageCursor(appTemplatePage.PageInfo.StartCursor),
-			EndCursor:   graphql.PageCursor(appTemplatePage.PageInfo.EndCursor),
-			HasNextPage: appTemplatePage.PageInfo.HasNextPage,
+			StartCursor:     graphql.PageCursor(appTemplatePage.PageInfo.StartCursor),
+			EndCursor:       graphql.PageCursor(appTemplatePage.PageInfo.EndCursor),
+			HasNextPage:     appTemplatePage.PageInfo.HasNextPage,
+			HasPreviousPage: appTemplatePage.PageInfo.HasPreviousPage,
 		},
 	}, nil
 }
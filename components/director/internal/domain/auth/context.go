@@ -0,0 +1,33 @@
+package auth
+
+import (
+	"context"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	httputil "github.com/kyma-incubator/compass/components/director/pkg/http"
+)
+
+// SaveToContext saves the request signing configuration and the client certificate of the auth in the context,
+// so that the signing and client certificate transports apply them to the requests executed with the context
+func SaveToContext(ctx context.Context, auth *model.Auth) context.Context {
+	if auth == nil {
+		return ctx
+	}
+
+	if auth.Signing != nil {
+		ctx = httputil.SaveRequestSigningToContext(ctx, httputil.RequestSigning{
+			Header:          auth.Signing.Header,
+			TimestampHeader: auth.Signing.TimestampHeader,
+			Secrets:         auth.Signing.Secrets,
+		})
+	}
+
+	if auth.Credential.Certificate != nil {
+		ctx = httputil.SaveClientCertificateToContext(ctx, httputil.ClientCertificate{
+			Certificate: auth.Credential.Certificate.Certificate,
+			PrivateKey:  auth.Credential.Certificate.PrivateKey,
+		})
+	}
+
+	return ctx
+}
@@ -0,0 +1,62 @@
+package auth_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/auth"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	httputil "github.com/kyma-incubator/compass/components/director/pkg/http"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSaveToContext(t *testing.T) {
+	t.Run("saves signing and client certificate", func(t *testing.T) {
+		// given
+		in := fixCertificateAuth()
+		in.Signing = &model.RequestSigning{
+			Header:          authSignatureHeader,
+			TimestampHeader: httputil.DefaultSignatureTimestampHeader,
+			Secrets:         authSigningSecrets,
+		}
+
+		// when
+		ctx := auth.SaveToContext(context.TODO(), in)
+
+		// then
+		signing, ok := httputil.LoadRequestSigningFromContext(ctx)
+		assert.True(t, ok)
+		assert.Equal(t, httputil.RequestSigning{
+			Header:          authSignatureHeader,
+			TimestampHeader: httputil.DefaultSignatureTimestampHeader,
+			Secrets:         authSigningSecrets,
+		}, signing)
+
+		certificate, ok := httputil.LoadClientCertificateFromContext(ctx)
+		assert.True(t, ok)
+		assert.Equal(t, httputil.ClientCertificate{Certificate: authCertificate, PrivateKey: authPrivateKey}, certificate)
+	})
+
+	t.Run("saves nothing for basic credentials", func(t *testing.T) {
+		// when
+		ctx := auth.SaveToContext(context.TODO(), &model.Auth{
+			Credential: model.CredentialData{
+				Basic: &model.BasicCredentialData{Username: authUsername, Password: authPassword},
+			},
+		})
+
+		// then
+		_, ok := httputil.LoadRequestSigningFromContext(ctx)
+		assert.False(t, ok)
+		_, ok = httputil.LoadClientCertificateFromContext(ctx)
+		assert.False(t, ok)
+	})
+
+	t.Run("returns the context for nil auth", func(t *testing.T) {
+		// given
+		ctx := context.TODO()
+
+		// then
+		assert.Equal(t, ctx, auth.SaveToContext(ctx, nil))
+	})
+}
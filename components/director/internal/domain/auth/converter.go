@@ -3,6 +3,7 @@ package auth
 import (
 	"github.com/kyma-incubator/compass/components/director/internal/model"
 	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	httputil "github.com/kyma-incubator/compass/components/director/pkg/http"
 	"github.com/pkg/errors"
 )
 
@@ -49,6 +50,7 @@ func (c *converter) ToGraphQL(in *model.Auth) (*graphql.Auth, error) {
 		AdditionalQueryParams:           params,
 		AdditionalQueryParamsSerialized: paramsSerialized,
 		RequestAuth:                     c.requestAuthToGraphQL(in.RequestAuth),
+		Signing:                         c.signingToGraphQL(in.Signing),
 	}, nil
 }
 
@@ -79,9 +81,44 @@ func (c *converter) InputFromGraphQL(in *graphql.AuthInput) (*model.AuthInput, e
 		AdditionalHeaders:     additionalHeaders,
 		AdditionalQueryParams: additionalQueryParams,
 		RequestAuth:           reqAuth,
+		Signing:               c.signingInputFromGraphQL(in.Signing),
 	}, nil
 }
 
+func (c *converter) signingToGraphQL(in *model.RequestSigning) *graphql.RequestSigning {
+	if in == nil {
+		return nil
+	}
+
+	return &graphql.RequestSigning{
+		Header:          in.Header,
+		TimestampHeader: in.TimestampHeader,
+		Secrets:         in.Secrets,
+	}
+}
+
+func (c *converter) signingInputFromGraphQL(in *graphql.RequestSigningInput) *model.RequestSigningInput {
+	if in == nil {
+		return nil
+	}
+
+	header := httputil.DefaultSignatureHeader
+	if in.Header != nil {
+		header = *in.Header
+	}
+
+	timestampHeader := httputil.DefaultSignatureTimestampHeader
+	if in.TimestampHeader != nil {
+		timestampHeader = *in.TimestampHeader
+	}
+
+	return &model.RequestSigningInput{
+		Header:          header,
+		TimestampHeader: timestampHeader,
+		Secrets:         in.Secrets,
+	}
+}
+
 func (c *converter) requestAuthToGraphQL(in *model.CredentialRequestAuth) *graphql.CredentialRequestAuth {
 	if in == nil {
 		return nil
@@ -173,6 +210,7 @@ func (c *converter) credentialInputFromGraphQL(in *graphql.CredentialDataInput)
 
 	var basic *model.BasicCredentialDataInput
 	var oauth *model.OAuthCredentialDataInput
+	var certificate *model.CertificateCredentialDataInput
 
 	if in.Basic != nil {
 		basic = &model.BasicCredentialDataInput{
@@ -185,11 +223,17 @@ func (c *converter) credentialInputFromGraphQL(in *graphql.CredentialDataInput)
 			ClientID:     in.Oauth.ClientID,
 			ClientSecret: in.Oauth.ClientSecret,
 		}
+	} else if in.Certificate != nil {
+		certificate = &model.CertificateCredentialDataInput{
+			Certificate: in.Certificate.Certificate,
+			PrivateKey:  in.Certificate.PrivateKey,
+		}
 	}
 
 	return &model.CredentialDataInput{
-		Basic: basic,
-		Oauth: oauth,
+		Basic:       basic,
+		Oauth:       oauth,
+		Certificate: certificate,
 	}
 }
 
@@ -206,6 +250,11 @@ func (c *converter) credentialToGraphQL(in model.CredentialData) graphql.Credent
 			ClientID:     in.Oauth.ClientID,
 			ClientSecret: in.Oauth.ClientSecret,
 		}
+	} else if in.Certificate != nil {
+		credential = graphql.CertificateCredentialData{
+			Certificate: in.Certificate.Certificate,
+			PrivateKey:  in.Certificate.PrivateKey,
+		}
 	}
 
 	return credential
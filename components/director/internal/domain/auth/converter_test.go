@@ -22,6 +22,11 @@ func TestConverter_ToGraphQL(t *testing.T) {
 			Input:    fixDetailedAuth(),
 			Expected: fixDetailedGQLAuth(),
 		},
+		{
+			Name:     "Certificate credential",
+			Input:    fixCertificateAuth(),
+			Expected: fixCertificateGQLAuth(),
+		},
 		{
 			Name:     "Empty",
 			Input:    &model.Auth{},
@@ -64,6 +69,11 @@ func TestConverter_InputFromGraphQL(t *testing.T) {
 			Input:    fixDetailedGQLAuthInputDeprecated(),
 			Expected: fixDetailedAuthInput(),
 		},
+		{
+			Name:     "Certificate credential",
+			Input:    fixCertificateGQLAuthInput(),
+			Expected: fixCertificateAuthInput(),
+		},
 		{
 			Name:     "Empty",
 			Input:    &graphql.AuthInput{},
@@ -132,6 +132,29 @@ func (_m *BundleRepository) GetForApplication(ctx context.Context, tenant string
 	return r0, r1
 }
 
+// List provides a mock function with given fields: ctx, tenantID, pageSize, cursor
+func (_m *BundleRepository) List(ctx context.Context, tenantID string, pageSize int, cursor string) (*model.BundlePage, error) {
+	ret := _m.Called(ctx, tenantID, pageSize, cursor)
+
+	var r0 *model.BundlePage
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, string) *model.BundlePage); ok {
+		r0 = rf(ctx, tenantID, pageSize, cursor)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.BundlePage)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, int, string) error); ok {
+		r1 = rf(ctx, tenantID, pageSize, cursor)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ListByApplicationID provides a mock function with given fields: ctx, tenantID, applicationID, pageSize, cursor
 func (_m *BundleRepository) ListByApplicationID(ctx context.Context, tenantID string, applicationID string, pageSize int, cursor string) (*model.BundlePage, error) {
 	ret := _m.Called(ctx, tenantID, applicationID, pageSize, cursor)
@@ -178,6 +201,29 @@ func (_m *BundleRepository) ListByApplicationIDNoPaging(ctx context.Context, ten
 	return r0, r1
 }
 
+// ListByApplicationIDs provides a mock function with given fields: ctx, tenantID, applicationIDs, pageSize, cursor
+func (_m *BundleRepository) ListByApplicationIDs(ctx context.Context, tenantID string, applicationIDs []string, pageSize int, cursor string) ([]*model.BundlePage, error) {
+	ret := _m.Called(ctx, tenantID, applicationIDs, pageSize, cursor)
+
+	var r0 []*model.BundlePage
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string, int, string) []*model.BundlePage); ok {
+		r0 = rf(ctx, tenantID, applicationIDs, pageSize, cursor)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.BundlePage)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, []string, int, string) error); ok {
+		r1 = rf(ctx, tenantID, applicationIDs, pageSize, cursor)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Update provides a mock function with given fields: ctx, item
 func (_m *BundleRepository) Update(ctx context.Context, item *model.Bundle) error {
 	ret := _m.Called(ctx, item)
@@ -58,6 +58,29 @@ func (_m *SpecService) GetByReferenceObjectID(ctx context.Context, objectType mo
 	return r0, r1
 }
 
+// GetByReferenceObjectIDs provides a mock function with given fields: ctx, objectType, objectIDs
+func (_m *SpecService) GetByReferenceObjectIDs(ctx context.Context, objectType model.SpecReferenceObjectType, objectIDs []string) (map[string]*model.Spec, error) {
+	ret := _m.Called(ctx, objectType, objectIDs)
+
+	var r0 map[string]*model.Spec
+	if rf, ok := ret.Get(0).(func(context.Context, model.SpecReferenceObjectType, []string) map[string]*model.Spec); ok {
+		r0 = rf(ctx, objectType, objectIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]*model.Spec)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, model.SpecReferenceObjectType, []string) error); ok {
+		r1 = rf(ctx, objectType, objectIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // RefetchSpec provides a mock function with given fields: ctx, id
 func (_m *SpecService) RefetchSpec(ctx context.Context, id string) (*model.Spec, error) {
 	ret := _m.Called(ctx, id)
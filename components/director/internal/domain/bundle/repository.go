@@ -41,6 +41,7 @@ type pgRepository struct {
 	singleGetter    repo.SingleGetter
 	deleter         repo.Deleter
 	pageableQuerier repo.PageableQuerier
+	unionLister     repo.UnionLister
 	lister          repo.Lister
 	creator         repo.Creator
 	updater         repo.Updater
@@ -53,6 +54,7 @@ func NewRepository(conv EntityConverter) *pgRepository {
 		singleGetter:    repo.NewSingleGetter(resource.Bundle, bundleTable, tenantColumn, bundleColumns),
 		deleter:         repo.NewDeleter(resource.Bundle, bundleTable, tenantColumn),
 		pageableQuerier: repo.NewPageableQuerier(resource.Bundle, bundleTable, tenantColumn, bundleColumns),
+		unionLister:     repo.NewUnionLister(resource.Bundle, bundleTable, tenantColumn, bundleColumns),
 		lister:          repo.NewLister(resource.Bundle, bundleTable, tenantColumn, bundleColumns),
 		creator:         repo.NewCreator(resource.Bundle, bundleTable, bundleColumns),
 		updater:         repo.NewUpdater(resource.Bundle, bundleTable, updatableColumns, tenantColumn, []string{"id"}),
@@ -165,10 +167,15 @@ func (r *pgRepository) GetByInstanceAuthID(ctx context.Context, tenant string, i
 }
 
 func (r *pgRepository) ListByApplicationID(ctx context.Context, tenantID string, applicationID string, pageSize int, cursor string) (*model.BundlePage, error) {
-	conditions := repo.Conditions{
-		repo.NewEqualCondition("app_id", applicationID),
-	}
+	return r.list(ctx, tenantID, pageSize, cursor, repo.NewEqualCondition("app_id", applicationID))
+}
+
+// List returns a page of all Bundles of the tenant
+func (r *pgRepository) List(ctx context.Context, tenantID string, pageSize int, cursor string) (*model.BundlePage, error) {
+	return r.list(ctx, tenantID, pageSize, cursor)
+}
 
+func (r *pgRepository) list(ctx context.Context, tenantID string, pageSize int, cursor string, conditions ...repo.Condition) (*model.BundlePage, error) {
 	var bundleCollection BundleCollection
 	page, totalCount, err := r.pageableQuerier.List(ctx, tenantID, pageSize, cursor, "id", &bundleCollection, conditions...)
 	if err != nil {
@@ -192,6 +199,35 @@ func (r *pgRepository) ListByApplicationID(ctx context.Context, tenantID string,
 	}, nil
 }
 
+// ListByApplicationIDs returns the same page of Bundles for every application, in the order of the applicationIDs
+func (r *pgRepository) ListByApplicationIDs(ctx context.Context, tenantID string, applicationIDs []string, pageSize int, cursor string) ([]*model.BundlePage, error) {
+	var bundleCollection BundleCollection
+	pages, err := r.unionLister.List(ctx, tenantID, applicationIDs, "app_id", pageSize, cursor, "id", &bundleCollection)
+	if err != nil {
+		return nil, err
+	}
+
+	bundlesByAppID := make(map[string][]*model.Bundle, len(applicationIDs))
+	for _, bndlEnt := range bundleCollection {
+		m, err := r.conv.FromEntity(&bndlEnt)
+		if err != nil {
+			return nil, errors.Wrap(err, "while creating Bundle model from entity")
+		}
+		bundlesByAppID[m.ApplicationID] = append(bundlesByAppID[m.ApplicationID], m)
+	}
+
+	bundlePages := make([]*model.BundlePage, 0, len(applicationIDs))
+	for _, appID := range applicationIDs {
+		bundlePages = append(bundlePages, &model.BundlePage{
+			Data:       bundlesByAppID[appID],
+			TotalCount: pages[appID].TotalCount,
+			PageInfo:   pages[appID].PageInfo,
+		})
+	}
+
+	return bundlePages, nil
+}
+
 func (r *pgRepository) ListByApplicationIDNoPaging(ctx context.Context, tenantID, appID string) ([]*model.Bundle, error) {
 	bundleCollection := BundleCollection{}
 	if err := r.lister.List(ctx, tenantID, &bundleCollection, repo.NewEqualCondition("app_id", appID)); err != nil {
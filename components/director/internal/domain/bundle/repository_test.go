@@ -388,6 +388,107 @@ func TestPgRepository_GetForApplication(t *testing.T) {
 	})
 }
 
+func TestPgRepository_List(t *testing.T) {
+	// GIVEN
+	ExpectedLimit := 3
+	ExpectedOffset := 0
+
+	inputPageSize := 3
+	inputCursor := ""
+	totalCount := 2
+	firstBndlID := "111111111-1111-1111-1111-111111111111"
+	firstBndlEntity := fixEntityBundle(firstBndlID, "foo", "bar")
+	secondBndlID := "222222222-2222-2222-2222-222222222222"
+	secondBndlEntity := fixEntityBundle(secondBndlID, "foo", "bar")
+
+	selectQuery := fmt.Sprintf(`^SELECT (.+) FROM public.bundles
+		WHERE tenant_id = \$1
+		ORDER BY id LIMIT %d OFFSET %d`, ExpectedLimit, ExpectedOffset)
+
+	rawCountQuery := `SELECT COUNT(*) FROM public.bundles
+		WHERE tenant_id = $1`
+	countQuery := regexp.QuoteMeta(rawCountQuery)
+
+	t.Run("success", func(t *testing.T) {
+		sqlxDB, sqlMock := testdb.MockDatabase(t)
+		rows := sqlmock.NewRows(fixBundleColumns()).
+			AddRow(fixBundleRow(firstBndlID, "placeholder")...).
+			AddRow(fixBundleRow(secondBndlID, "placeholder")...)
+
+		sqlMock.ExpectQuery(selectQuery).
+			WithArgs(tenantID).
+			WillReturnRows(rows)
+
+		sqlMock.ExpectQuery(countQuery).
+			WithArgs(tenantID).
+			WillReturnRows(testdb.RowCount(2))
+
+		ctx := persistence.SaveToContext(context.TODO(), sqlxDB)
+		convMock := &automock.EntityConverter{}
+		convMock.On("FromEntity", firstBndlEntity).Return(&model.Bundle{BaseEntity: &model.BaseEntity{ID: firstBndlID}}, nil)
+		convMock.On("FromEntity", secondBndlEntity).Return(&model.Bundle{BaseEntity: &model.BaseEntity{ID: secondBndlID}}, nil)
+		pgRepository := mp_bundle.NewRepository(convMock)
+		// WHEN
+		modelBndl, err := pgRepository.List(ctx, tenantID, inputPageSize, inputCursor)
+		//THEN
+		require.NoError(t, err)
+		require.Len(t, modelBndl.Data, 2)
+		assert.Equal(t, firstBndlID, modelBndl.Data[0].ID)
+		assert.Equal(t, secondBndlID, modelBndl.Data[1].ID)
+		assert.Equal(t, "", modelBndl.PageInfo.StartCursor)
+		assert.Equal(t, totalCount, modelBndl.TotalCount)
+		convMock.AssertExpectations(t)
+		sqlMock.AssertExpectations(t)
+	})
+
+	t.Run("DB Error", func(t *testing.T) {
+		// given
+		repo := mp_bundle.NewRepository(nil)
+		sqlxDB, sqlMock := testdb.MockDatabase(t)
+		testError := errors.New("test error")
+
+		sqlMock.ExpectQuery(selectQuery).
+			WithArgs(tenantID).
+			WillReturnError(testError)
+		ctx := persistence.SaveToContext(context.TODO(), sqlxDB)
+
+		// when
+		modelBndl, err := repo.List(ctx, tenantID, inputPageSize, inputCursor)
+
+		// then
+		sqlMock.AssertExpectations(t)
+		assert.Nil(t, modelBndl)
+		require.EqualError(t, err, fmt.Sprintf("while fetching list of objects from DB: %s", testError.Error()))
+	})
+
+	t.Run("returns error when conversion from entity to model failed", func(t *testing.T) {
+		sqlxDB, sqlMock := testdb.MockDatabase(t)
+		testErr := errors.New("test error")
+		rows := sqlmock.NewRows(fixBundleColumns()).
+			AddRow(fixBundleRow(firstBndlID, "foo")...)
+
+		sqlMock.ExpectQuery(selectQuery).
+			WithArgs(tenantID).
+			WillReturnRows(rows)
+
+		sqlMock.ExpectQuery(countQuery).
+			WithArgs(tenantID).
+			WillReturnRows(testdb.RowCount(1))
+		ctx := persistence.SaveToContext(context.TODO(), sqlxDB)
+
+		convMock := &automock.EntityConverter{}
+		convMock.On("FromEntity", firstBndlEntity).Return(&model.Bundle{}, testErr).Once()
+		pgRepository := mp_bundle.NewRepository(convMock)
+		//WHEN
+		_, err := pgRepository.List(ctx, tenantID, inputPageSize, inputCursor)
+		//THEN
+		require.Error(t, err)
+		require.Contains(t, err.Error(), testErr.Error())
+		convMock.AssertExpectations(t)
+		sqlMock.AssertExpectations(t)
+	})
+}
+
 func TestPgRepository_ListByApplicationID(t *testing.T) {
 	// GIVEN
 	ExpectedLimit := 3
@@ -489,6 +590,104 @@ func TestPgRepository_ListByApplicationID(t *testing.T) {
 	})
 }
 
+func TestPgRepository_ListByApplicationIDs(t *testing.T) {
+	// GIVEN
+	inputPageSize := 3
+	inputCursor := ""
+	secondAppID := "222222222-2222-2222-2222-222222222222"
+	firstBndlID := "111111111-1111-1111-1111-111111111111"
+	firstBndlEntity := fixEntityBundle(firstBndlID, "foo", "bar")
+	secondBndlID := "222222222-2222-2222-2222-222222222222"
+	secondBndlEntity := fixEntityBundle(secondBndlID, "foo", "bar")
+
+	selectQuery := `^\(SELECT (.+) FROM public.bundles WHERE app_id = \$1 AND tenant_id = \$2 ORDER BY id LIMIT 3 OFFSET 0\) UNION ALL \(SELECT (.+) FROM public.bundles WHERE app_id = \$3 AND tenant_id = \$4 ORDER BY id LIMIT 3 OFFSET 0\)`
+
+	rawCountQuery := `SELECT app_id AS id, COUNT(*) AS total_count FROM public.bundles WHERE app_id IN ($1, $2) AND tenant_id = $3 GROUP BY app_id`
+	countQuery := regexp.QuoteMeta(rawCountQuery)
+
+	t.Run("success", func(t *testing.T) {
+		sqlxDB, sqlMock := testdb.MockDatabase(t)
+		rows := sqlmock.NewRows(fixBundleColumns()).
+			AddRow(fixBundleRow(firstBndlID, "placeholder")...).
+			AddRow(fixBundleRow(secondBndlID, "placeholder")...)
+
+		sqlMock.ExpectQuery(selectQuery).
+			WithArgs(appID, tenantID, secondAppID, tenantID).
+			WillReturnRows(rows)
+
+		sqlMock.ExpectQuery(countQuery).
+			WithArgs(appID, secondAppID, tenantID).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "total_count"}).AddRow(appID, 2))
+
+		ctx := persistence.SaveToContext(context.TODO(), sqlxDB)
+		convMock := &automock.EntityConverter{}
+		convMock.On("FromEntity", firstBndlEntity).Return(&model.Bundle{ApplicationID: appID, BaseEntity: &model.BaseEntity{ID: firstBndlID}}, nil)
+		convMock.On("FromEntity", secondBndlEntity).Return(&model.Bundle{ApplicationID: appID, BaseEntity: &model.BaseEntity{ID: secondBndlID}}, nil)
+		pgRepository := mp_bundle.NewRepository(convMock)
+		// WHEN
+		modelBndlPages, err := pgRepository.ListByApplicationIDs(ctx, tenantID, []string{appID, secondAppID}, inputPageSize, inputCursor)
+		//THEN
+		require.NoError(t, err)
+		require.Len(t, modelBndlPages, 2)
+		require.Len(t, modelBndlPages[0].Data, 2)
+		assert.Equal(t, firstBndlID, modelBndlPages[0].Data[0].ID)
+		assert.Equal(t, secondBndlID, modelBndlPages[0].Data[1].ID)
+		assert.Equal(t, 2, modelBndlPages[0].TotalCount)
+		assert.False(t, modelBndlPages[0].PageInfo.HasNextPage)
+		assert.Empty(t, modelBndlPages[1].Data)
+		assert.Equal(t, 0, modelBndlPages[1].TotalCount)
+		convMock.AssertExpectations(t)
+		sqlMock.AssertExpectations(t)
+	})
+
+	t.Run("DB Error", func(t *testing.T) {
+		// given
+		repo := mp_bundle.NewRepository(nil)
+		sqlxDB, sqlMock := testdb.MockDatabase(t)
+		testError := errors.New("test error")
+
+		sqlMock.ExpectQuery(selectQuery).
+			WithArgs(appID, tenantID, secondAppID, tenantID).
+			WillReturnError(testError)
+		ctx := persistence.SaveToContext(context.TODO(), sqlxDB)
+
+		// when
+		modelBndlPages, err := repo.ListByApplicationIDs(ctx, tenantID, []string{appID, secondAppID}, inputPageSize, inputCursor)
+
+		// then
+		sqlMock.AssertExpectations(t)
+		assert.Nil(t, modelBndlPages)
+		require.EqualError(t, err, "Internal Server Error: Unexpected error while executing SQL query")
+	})
+
+	t.Run("returns error when conversion from entity to model failed", func(t *testing.T) {
+		sqlxDB, sqlMock := testdb.MockDatabase(t)
+		testErr := errors.New("test error")
+		rows := sqlmock.NewRows(fixBundleColumns()).
+			AddRow(fixBundleRow(firstBndlID, "foo")...)
+
+		sqlMock.ExpectQuery(selectQuery).
+			WithArgs(appID, tenantID, secondAppID, tenantID).
+			WillReturnRows(rows)
+
+		sqlMock.ExpectQuery(countQuery).
+			WithArgs(appID, secondAppID, tenantID).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "total_count"}).AddRow(appID, 1))
+		ctx := persistence.SaveToContext(context.TODO(), sqlxDB)
+
+		convMock := &automock.EntityConverter{}
+		convMock.On("FromEntity", firstBndlEntity).Return(&model.Bundle{}, testErr).Once()
+		pgRepository := mp_bundle.NewRepository(convMock)
+		//WHEN
+		_, err := pgRepository.ListByApplicationIDs(ctx, tenantID, []string{appID, secondAppID}, inputPageSize, inputCursor)
+		//THEN
+		require.Error(t, err)
+		require.Contains(t, err.Error(), testErr.Error())
+		convMock.AssertExpectations(t)
+		sqlMock.AssertExpectations(t)
+	})
+}
+
 func TestPgRepository_ListByApplicationIDNoPaging(t *testing.T) {
 	// GIVEN
 	totalCount := 2
@@ -5,6 +5,8 @@ import (
 
 	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
 
+	"github.com/kyma-incubator/compass/components/director/internal/dataloader"
+
 	"github.com/kyma-incubator/compass/components/director/internal/model"
 
 	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
@@ -44,7 +46,7 @@ type BundleInstanceAuthConverter interface {
 
 //go:generate mockery -name=APIService -output=automock -outpkg=automock -case=underscore
 type APIService interface {
-	ListForBundle(ctx context.Context, bundleID string, pageSize int, cursor string) (*model.APIDefinitionPage, error)
+	ListForBundles(ctx context.Context, bundleIDs []string, pageSize int, cursor string) ([]*model.APIDefinitionPage, error)
 	GetForBundle(ctx context.Context, id string, bundleID string) (*model.APIDefinition, error)
 	CreateInBundle(ctx context.Context, appID, bundleID string, in model.APIDefinitionInput, spec *model.SpecInput) (string, error)
 	DeleteAllByBundleID(ctx context.Context, bundleID string) error
@@ -59,7 +61,7 @@ type APIConverter interface {
 
 //go:generate mockery -name=EventService -output=automock -outpkg=automock -case=underscore
 type EventService interface {
-	ListForBundle(ctx context.Context, bundleID string, pageSize int, cursor string) (*model.EventDefinitionPage, error)
+	ListForBundles(ctx context.Context, bundleIDs []string, pageSize int, cursor string) ([]*model.EventDefinitionPage, error)
 	GetForBundle(ctx context.Context, id string, bundleID string) (*model.EventDefinition, error)
 	CreateInBundle(ctx context.Context, appID, bundleID string, in model.EventDefinitionInput, spec *model.SpecInput) (string, error)
 	DeleteAllByBundleID(ctx context.Context, bundleID string) error
@@ -74,7 +76,7 @@ type EventConverter interface {
 
 //go:generate mockery -name=DocumentService -output=automock -outpkg=automock -case=underscore
 type DocumentService interface {
-	ListForBundle(ctx context.Context, bundleID string, pageSize int, cursor string) (*model.DocumentPage, error)
+	ListForBundles(ctx context.Context, bundleIDs []string, pageSize int, cursor string) ([]*model.DocumentPage, error)
 	GetForBundle(ctx context.Context, id string, bundleID string) (*model.Document, error)
 	CreateInBundle(ctx context.Context, bundleID string, in model.DocumentInput) (string, error)
 }
@@ -91,6 +93,7 @@ type SpecService interface {
 	CreateByReferenceObjectID(ctx context.Context, in model.SpecInput, objectType model.SpecReferenceObjectType, objectID string) (string, error)
 	UpdateByReferenceObjectID(ctx context.Context, id string, in model.SpecInput, objectType model.SpecReferenceObjectType, objectID string) error
 	GetByReferenceObjectID(ctx context.Context, objectType model.SpecReferenceObjectType, objectID string) (*model.Spec, error)
+	GetByReferenceObjectIDs(ctx context.Context, objectType model.SpecReferenceObjectType, objectIDs []string) (map[string]*model.Spec, error)
 	RefetchSpec(ctx context.Context, id string) (*model.Spec, error)
 }
 
@@ -357,13 +360,9 @@ func (r *Resolver) APIDefinition(ctx context.Context, obj *graphql.Bundle, id st
 }
 
 func (r *Resolver) APIDefinitions(ctx context.Context, obj *graphql.Bundle, group *string, first *int, after *graphql.PageCursor) (*graphql.APIDefinitionPage, error) {
-	tx, err := r.transact.Begin()
-	if err != nil {
-		return nil, err
+	if obj == nil {
+		return nil, apperrors.NewInternalError("Bundle cannot be empty")
 	}
-	defer r.transact.RollbackUnlessCommitted(ctx, tx)
-
-	ctx = persistence.SaveToContext(ctx, tx)
 
 	var cursor string
 	if after != nil {
@@ -374,24 +373,39 @@ func (r *Resolver) APIDefinitions(ctx context.Context, obj *graphql.Bundle, grou
 		return nil, apperrors.NewInvalidDataError("missing required parameter 'first'")
 	}
 
-	apisPage, err := r.apiSvc.ListForBundle(ctx, obj.ID, *first, cursor)
+	loaders, err := dataloader.LoadFromContext(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	apiSpecs := make([]*model.Spec, 0, len(apisPage.Data))
-	for _, api := range apisPage.Data {
-		spec, err := r.specService.GetByReferenceObjectID(ctx, model.APISpecReference, api.ID)
-		if err != nil {
-			return nil, errors.Wrapf(err, "while getting spec for APIDefinition with id %q", api.ID)
-		}
+	return loaders.BundleAPIDefinitions(ctx, obj.ID, *first, cursor)
+}
+
+// APIDefinitionsDataLoader fetches the same page of API definitions of all of the bundles, together with their specs
+func (r *Resolver) APIDefinitionsDataLoader(ctx context.Context, bundleIDs []string, first int, after string) ([]*graphql.APIDefinitionPage, error) {
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer r.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	apisPages, err := r.apiSvc.ListForBundles(ctx, bundleIDs, first, after)
+	if err != nil {
+		return nil, err
+	}
 
-		apiSpecs = append(apiSpecs, spec)
+	var apiIDs []string
+	for _, apisPage := range apisPages {
+		for _, api := range apisPage.Data {
+			apiIDs = append(apiIDs, api.ID)
+		}
 	}
 
-	gqlApis, err := r.apiConverter.MultipleToGraphQL(apisPage.Data, apiSpecs)
+	specsByAPIID, err := r.specService.GetByReferenceObjectIDs(ctx, model.APISpecReference, apiIDs)
 	if err != nil {
-		return nil, errors.Wrapf(err, "while converting apis")
+		return nil, errors.Wrap(err, "while getting specs for APIDefinitions")
 	}
 
 	err = tx.Commit()
@@ -399,15 +413,31 @@ func (r *Resolver) APIDefinitions(ctx context.Context, obj *graphql.Bundle, grou
 		return nil, err
 	}
 
-	return &graphql.APIDefinitionPage{
-		Data:       gqlApis,
-		TotalCount: apisPage.TotalCount,
-		PageInfo: &graphql.PageInfo{
-			StartCursor: graphql.PageCursor(apisPage.PageInfo.StartCursor),
-			EndCursor:   graphql.PageCursor(apisPage.PageInfo.EndCursor),
-			HasNextPage: apisPage.PageInfo.HasNextPage,
-		},
-	}, nil
+	out := make([]*graphql.APIDefinitionPage, 0, len(apisPages))
+	for _, apisPage := range apisPages {
+		apiSpecs := make([]*model.Spec, 0, len(apisPage.Data))
+		for _, api := range apisPage.Data {
+			apiSpecs = append(apiSpecs, specsByAPIID[api.ID])
+		}
+
+		gqlApis, err := r.apiConverter.MultipleToGraphQL(apisPage.Data, apiSpecs)
+		if err != nil {
+			return nil, errors.Wrapf(err, "while converting apis")
+		}
+
+		out = append(out, &graphql.APIDefinitionPage{
+			Data:       gqlApis,
+			TotalCount: apisPage.TotalCount,
+			PageInfo: &graphql.PageInfo{
+				StartCursor:     graphql.PageCursor(apisPage.PageInfo.StartCursor),
+				EndCursor:       graphql.PageCursor(apisPage.PageInfo.EndCursor),
+				HasNextPage:     apisPage.PageInfo.HasNextPage,
+				HasPreviousPage: apisPage.PageInfo.HasPreviousPage,
+			},
+		})
+	}
+
+	return out, nil
 }
 
 func (r *Resolver) EventDefinition(ctx context.Context, obj *graphql.Bundle, id string) (*graphql.EventDefinition, error) {
@@ -446,12 +476,9 @@ func (r *Resolver) EventDefinition(ctx context.Context, obj *graphql.Bundle, id
 }
 
 func (r *Resolver) EventDefinitions(ctx context.Context, obj *graphql.Bundle, group *string, first *int, after *graphql.PageCursor) (*graphql.EventDefinitionPage, error) {
-	tx, err := r.transact.Begin()
-	if err != nil {
-		return nil, err
+	if obj == nil {
+		return nil, apperrors.NewInternalError("Bundle cannot be empty")
 	}
-	defer r.transact.RollbackUnlessCommitted(ctx, tx)
-	ctx = persistence.SaveToContext(ctx, tx)
 
 	var cursor string
 	if after != nil {
@@ -462,24 +489,38 @@ func (r *Resolver) EventDefinitions(ctx context.Context, obj *graphql.Bundle, gr
 		return nil, apperrors.NewInvalidDataError("missing required parameter 'first'")
 	}
 
-	eventAPIPage, err := r.eventSvc.ListForBundle(ctx, obj.ID, *first, cursor)
+	loaders, err := dataloader.LoadFromContext(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	eventSpecs := make([]*model.Spec, 0, len(eventAPIPage.Data))
-	for _, event := range eventAPIPage.Data {
-		spec, err := r.specService.GetByReferenceObjectID(ctx, model.EventSpecReference, event.ID)
-		if err != nil {
-			return nil, errors.Wrapf(err, "while getting spec for EventDefinition with id %q", event.ID)
-		}
+	return loaders.BundleEvents(ctx, obj.ID, *first, cursor)
+}
 
-		eventSpecs = append(eventSpecs, spec)
+// EventDefinitionsDataLoader fetches the same page of event definitions of all of the bundles, together with their specs
+func (r *Resolver) EventDefinitionsDataLoader(ctx context.Context, bundleIDs []string, first int, after string) ([]*graphql.EventDefinitionPage, error) {
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, err
 	}
+	defer r.transact.RollbackUnlessCommitted(ctx, tx)
+	ctx = persistence.SaveToContext(ctx, tx)
 
-	gqlEvents, err := r.eventConverter.MultipleToGraphQL(eventAPIPage.Data, eventSpecs)
+	eventAPIPages, err := r.eventSvc.ListForBundles(ctx, bundleIDs, first, after)
 	if err != nil {
-		return nil, errors.Wrapf(err, "while converting events")
+		return nil, err
+	}
+
+	var eventIDs []string
+	for _, eventAPIPage := range eventAPIPages {
+		for _, event := range eventAPIPage.Data {
+			eventIDs = append(eventIDs, event.ID)
+		}
+	}
+
+	specsByEventID, err := r.specService.GetByReferenceObjectIDs(ctx, model.EventSpecReference, eventIDs)
+	if err != nil {
+		return nil, errors.Wrap(err, "while getting specs for EventDefinitions")
 	}
 
 	err = tx.Commit()
@@ -487,15 +528,31 @@ func (r *Resolver) EventDefinitions(ctx context.Context, obj *graphql.Bundle, gr
 		return nil, err
 	}
 
-	return &graphql.EventDefinitionPage{
-		Data:       gqlEvents,
-		TotalCount: eventAPIPage.TotalCount,
-		PageInfo: &graphql.PageInfo{
-			StartCursor: graphql.PageCursor(eventAPIPage.PageInfo.StartCursor),
-			EndCursor:   graphql.PageCursor(eventAPIPage.PageInfo.EndCursor),
-			HasNextPage: eventAPIPage.PageInfo.HasNextPage,
-		},
-	}, nil
+	out := make([]*graphql.EventDefinitionPage, 0, len(eventAPIPages))
+	for _, eventAPIPage := range eventAPIPages {
+		eventSpecs := make([]*model.Spec, 0, len(eventAPIPage.Data))
+		for _, event := range eventAPIPage.Data {
+			eventSpecs = append(eventSpecs, specsByEventID[event.ID])
+		}
+
+		gqlEvents, err := r.eventConverter.MultipleToGraphQL(eventAPIPage.Data, eventSpecs)
+		if err != nil {
+			return nil, errors.Wrapf(err, "while converting events")
+		}
+
+		out = append(out, &graphql.EventDefinitionPage{
+			Data:       gqlEvents,
+			TotalCount: eventAPIPage.TotalCount,
+			PageInfo: &graphql.PageInfo{
+				StartCursor:     graphql.PageCursor(eventAPIPage.PageInfo.StartCursor),
+				EndCursor:       graphql.PageCursor(eventAPIPage.PageInfo.EndCursor),
+				HasNextPage:     eventAPIPage.PageInfo.HasNextPage,
+				HasPreviousPage: eventAPIPage.PageInfo.HasPreviousPage,
+			},
+		})
+	}
+
+	return out, nil
 }
 
 func (r *Resolver) Document(ctx context.Context, obj *graphql.Bundle, id string) (*graphql.Document, error) {
@@ -524,13 +581,9 @@ func (r *Resolver) Document(ctx context.Context, obj *graphql.Bundle, id string)
 }
 
 func (r *Resolver) Documents(ctx context.Context, obj *graphql.Bundle, first *int, after *graphql.PageCursor) (*graphql.DocumentPage, error) {
-	tx, err := r.transact.Begin()
-	if err != nil {
-		return nil, err
+	if obj == nil {
+		return nil, apperrors.NewInternalError("Bundle cannot be empty")
 	}
-	defer r.transact.RollbackUnlessCommitted(ctx, tx)
-
-	ctx = persistence.SaveToContext(ctx, tx)
 
 	var cursor string
 	if after != nil {
@@ -541,7 +594,25 @@ func (r *Resolver) Documents(ctx context.Context, obj *graphql.Bundle, first *in
 		return nil, apperrors.NewInvalidDataError("missing required parameter 'first'")
 	}
 
-	documentsPage, err := r.documentSvc.ListForBundle(ctx, obj.ID, *first, cursor)
+	loaders, err := dataloader.LoadFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return loaders.BundleDocuments(ctx, obj.ID, *first, cursor)
+}
+
+// DocumentsDataLoader fetches the same page of documents of all of the bundles
+func (r *Resolver) DocumentsDataLoader(ctx context.Context, bundleIDs []string, first int, after string) ([]*graphql.DocumentPage, error) {
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer r.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	documentsPages, err := r.documentSvc.ListForBundles(ctx, bundleIDs, first, after)
 	if err != nil {
 		return nil, err
 	}
@@ -551,15 +622,19 @@ func (r *Resolver) Documents(ctx context.Context, obj *graphql.Bundle, first *in
 		return nil, err
 	}
 
-	gqlDocuments := r.documentConverter.MultipleToGraphQL(documentsPage.Data)
+	out := make([]*graphql.DocumentPage, 0, len(documentsPages))
+	for _, documentsPage := range documentsPages {
+		out = append(out, &graphql.DocumentPage{
+			Data:       r.documentConverter.MultipleToGraphQL(documentsPage.Data),
+			TotalCount: documentsPage.TotalCount,
+			PageInfo: &graphql.PageInfo{
+				StartCursor:     graphql.PageCursor(documentsPage.PageInfo.StartCursor),
+				EndCursor:       graphql.PageCursor(documentsPage.PageInfo.EndCursor),
+				HasNextPage:     documentsPage.PageInfo.HasNextPage,
+				HasPreviousPage: documentsPage.PageInfo.HasPreviousPage,
+			},
+		})
+	}
 
-	return &graphql.DocumentPage{
-		Data:       gqlDocuments,
-		TotalCount: documentsPage.TotalCount,
-		PageInfo: &graphql.PageInfo{
-			StartCursor: graphql.PageCursor(documentsPage.PageInfo.StartCursor),
-			EndCursor:   graphql.PageCursor(documentsPage.PageInfo.EndCursor),
-			HasNextPage: documentsPage.PageInfo.HasNextPage,
-		},
-	}, nil
+	return out, nil
 }
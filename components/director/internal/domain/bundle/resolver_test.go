@@ -4,6 +4,7 @@ import (
 	"context"
 	"testing"
 
+	"github.com/kyma-incubator/compass/components/director/internal/dataloader"
 	mp_bundle "github.com/kyma-incubator/compass/components/director/internal/domain/bundle"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/bundle/automock"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
@@ -14,6 +15,7 @@ import (
 	"github.com/kyma-incubator/compass/components/director/pkg/resource"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
 
@@ -252,6 +254,8 @@ func TestResolver_Apis(t *testing.T) {
 		},
 	}
 
+	specsByObjectID := map[string]*model.Spec{"foo": modelSpecs[0], "bar": modelSpecs[1]}
+
 	gqlAPIDefinitions := []*graphql.APIDefinition{
 		fixGQLAPIDefinition("foo", bundleID, "Foo", "Lorem Ipsum", group),
 		fixGQLAPIDefinition("bar", bundleID, "Bar", "Lorem Ipsum", group),
@@ -269,7 +273,7 @@ func TestResolver_Apis(t *testing.T) {
 		ServiceFn       func() *automock.APIService
 		ConverterFn     func() *automock.APIConverter
 		SpecServiceFn   func() *automock.SpecService
-		ExpectedResult  *graphql.APIDefinitionPage
+		ExpectedResult  []*graphql.APIDefinitionPage
 		ExpectedErr     error
 	}{
 		{
@@ -277,13 +281,12 @@ func TestResolver_Apis(t *testing.T) {
 			TransactionerFn: txGen.ThatSucceeds,
 			ServiceFn: func() *automock.APIService {
 				svc := &automock.APIService{}
-				svc.On("ListForBundle", txtest.CtxWithDBMatcher(), bundleID, first, after).Return(fixAPIDefinitionPage(modelAPIDefinitions), nil).Once()
+				svc.On("ListForBundles", txtest.CtxWithDBMatcher(), []string{bundleID}, first, after).Return([]*model.APIDefinitionPage{fixAPIDefinitionPage(modelAPIDefinitions)}, nil).Once()
 				return svc
 			},
 			SpecServiceFn: func() *automock.SpecService {
 				svc := &automock.SpecService{}
-				svc.On("GetByReferenceObjectID", txtest.CtxWithDBMatcher(), model.APISpecReference, modelAPIDefinitions[0].ID).Return(modelSpecs[0], nil).Once()
-				svc.On("GetByReferenceObjectID", txtest.CtxWithDBMatcher(), model.APISpecReference, modelAPIDefinitions[1].ID).Return(modelSpecs[1], nil).Once()
+				svc.On("GetByReferenceObjectIDs", txtest.CtxWithDBMatcher(), model.APISpecReference, []string{modelAPIDefinitions[0].ID, modelAPIDefinitions[1].ID}).Return(specsByObjectID, nil).Once()
 				return svc
 			},
 			ConverterFn: func() *automock.APIConverter {
@@ -291,7 +294,7 @@ func TestResolver_Apis(t *testing.T) {
 				conv.On("MultipleToGraphQL", modelAPIDefinitions, modelSpecs).Return(gqlAPIDefinitions, nil).Once()
 				return conv
 			},
-			ExpectedResult: fixGQLAPIDefinitionPage(gqlAPIDefinitions),
+			ExpectedResult: []*graphql.APIDefinitionPage{fixGQLAPIDefinitionPage(gqlAPIDefinitions)},
 			ExpectedErr:    nil,
 		},
 		{
@@ -314,7 +317,7 @@ func TestResolver_Apis(t *testing.T) {
 			TransactionerFn: txGen.ThatDoesntExpectCommit,
 			ServiceFn: func() *automock.APIService {
 				svc := &automock.APIService{}
-				svc.On("ListForBundle", txtest.CtxWithDBMatcher(), bundleID, first, after).Return(nil, testErr).Once()
+				svc.On("ListForBundles", txtest.CtxWithDBMatcher(), []string{bundleID}, first, after).Return(nil, testErr).Once()
 				return svc
 			},
 			SpecServiceFn: func() *automock.SpecService {
@@ -331,12 +334,12 @@ func TestResolver_Apis(t *testing.T) {
 			TransactionerFn: txGen.ThatDoesntExpectCommit,
 			ServiceFn: func() *automock.APIService {
 				svc := &automock.APIService{}
-				svc.On("ListForBundle", txtest.CtxWithDBMatcher(), bundleID, first, after).Return(fixAPIDefinitionPage(modelAPIDefinitions), nil).Once()
+				svc.On("ListForBundles", txtest.CtxWithDBMatcher(), []string{bundleID}, first, after).Return([]*model.APIDefinitionPage{fixAPIDefinitionPage(modelAPIDefinitions)}, nil).Once()
 				return svc
 			},
 			SpecServiceFn: func() *automock.SpecService {
 				svc := &automock.SpecService{}
-				svc.On("GetByReferenceObjectID", txtest.CtxWithDBMatcher(), model.APISpecReference, modelAPIDefinitions[0].ID).Return(nil, testErr).Once()
+				svc.On("GetByReferenceObjectIDs", txtest.CtxWithDBMatcher(), model.APISpecReference, []string{modelAPIDefinitions[0].ID, modelAPIDefinitions[1].ID}).Return(nil, testErr).Once()
 				return svc
 			},
 			ConverterFn: func() *automock.APIConverter {
@@ -347,16 +350,15 @@ func TestResolver_Apis(t *testing.T) {
 		},
 		{
 			Name:            "Returns error when converting to GraphQL failed",
-			TransactionerFn: txGen.ThatDoesntExpectCommit,
+			TransactionerFn: txGen.ThatSucceeds,
 			ServiceFn: func() *automock.APIService {
 				svc := &automock.APIService{}
-				svc.On("ListForBundle", txtest.CtxWithDBMatcher(), bundleID, first, after).Return(fixAPIDefinitionPage(modelAPIDefinitions), nil).Once()
+				svc.On("ListForBundles", txtest.CtxWithDBMatcher(), []string{bundleID}, first, after).Return([]*model.APIDefinitionPage{fixAPIDefinitionPage(modelAPIDefinitions)}, nil).Once()
 				return svc
 			},
 			SpecServiceFn: func() *automock.SpecService {
 				svc := &automock.SpecService{}
-				svc.On("GetByReferenceObjectID", txtest.CtxWithDBMatcher(), model.APISpecReference, modelAPIDefinitions[0].ID).Return(modelSpecs[0], nil).Once()
-				svc.On("GetByReferenceObjectID", txtest.CtxWithDBMatcher(), model.APISpecReference, modelAPIDefinitions[1].ID).Return(modelSpecs[1], nil).Once()
+				svc.On("GetByReferenceObjectIDs", txtest.CtxWithDBMatcher(), model.APISpecReference, []string{modelAPIDefinitions[0].ID, modelAPIDefinitions[1].ID}).Return(specsByObjectID, nil).Once()
 				return svc
 			},
 			ConverterFn: func() *automock.APIConverter {
@@ -372,19 +374,16 @@ func TestResolver_Apis(t *testing.T) {
 			TransactionerFn: txGen.ThatFailsOnCommit,
 			ServiceFn: func() *automock.APIService {
 				svc := &automock.APIService{}
-				svc.On("ListForBundle", txtest.CtxWithDBMatcher(), bundleID, first, after).Return(fixAPIDefinitionPage(modelAPIDefinitions), nil).Once()
+				svc.On("ListForBundles", txtest.CtxWithDBMatcher(), []string{bundleID}, first, after).Return([]*model.APIDefinitionPage{fixAPIDefinitionPage(modelAPIDefinitions)}, nil).Once()
 				return svc
 			},
 			SpecServiceFn: func() *automock.SpecService {
 				svc := &automock.SpecService{}
-				svc.On("GetByReferenceObjectID", txtest.CtxWithDBMatcher(), model.APISpecReference, modelAPIDefinitions[0].ID).Return(modelSpecs[0], nil).Once()
-				svc.On("GetByReferenceObjectID", txtest.CtxWithDBMatcher(), model.APISpecReference, modelAPIDefinitions[1].ID).Return(modelSpecs[1], nil).Once()
+				svc.On("GetByReferenceObjectIDs", txtest.CtxWithDBMatcher(), model.APISpecReference, []string{modelAPIDefinitions[0].ID, modelAPIDefinitions[1].ID}).Return(specsByObjectID, nil).Once()
 				return svc
 			},
 			ConverterFn: func() *automock.APIConverter {
-				conv := &automock.APIConverter{}
-				conv.On("MultipleToGraphQL", modelAPIDefinitions, modelSpecs).Return(gqlAPIDefinitions, nil).Once()
-				return conv
+				return &automock.APIConverter{}
 			},
 			ExpectedResult: nil,
 			ExpectedErr:    testErr,
@@ -401,7 +400,7 @@ func TestResolver_Apis(t *testing.T) {
 
 			resolver := mp_bundle.NewResolver(transact, nil, nil, svc, nil, nil, nil, nil, converter, nil, nil, specService)
 			// when
-			result, err := resolver.APIDefinitions(context.TODO(), app, &group, &first, &gqlAfter)
+			result, err := resolver.APIDefinitionsDataLoader(context.TODO(), []string{bundleID}, first, after)
 
 			// then
 			assert.Equal(t, testCase.ExpectedResult, result)
@@ -419,6 +418,44 @@ func TestResolver_Apis(t *testing.T) {
 			specService.AssertExpectations(t)
 		})
 	}
+
+	t.Run("Returns page of bundle fetched with dataloader", func(t *testing.T) {
+		// given
+		persist, transact := txGen.ThatSucceeds()
+		svc := &automock.APIService{}
+		svc.On("ListForBundles", txtest.CtxWithDBMatcher(), []string{bundleID}, first, after).Return([]*model.APIDefinitionPage{fixAPIDefinitionPage(modelAPIDefinitions)}, nil).Once()
+		specService := &automock.SpecService{}
+		specService.On("GetByReferenceObjectIDs", txtest.CtxWithDBMatcher(), model.APISpecReference, []string{modelAPIDefinitions[0].ID, modelAPIDefinitions[1].ID}).Return(specsByObjectID, nil).Once()
+		converter := &automock.APIConverter{}
+		converter.On("MultipleToGraphQL", modelAPIDefinitions, modelSpecs).Return(gqlAPIDefinitions, nil).Once()
+		resolver := mp_bundle.NewResolver(transact, nil, nil, svc, nil, nil, nil, nil, converter, nil, nil, specService)
+		loaders := dataloader.NewLoaders(dataloader.Fetchers{BundleAPIDefinitions: resolver.APIDefinitionsDataLoader}, dataloader.Config{})
+
+		// when
+		result, err := resolver.APIDefinitions(dataloader.SaveToContext(context.TODO(), loaders), app, &group, &first, &gqlAfter)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, fixGQLAPIDefinitionPage(gqlAPIDefinitions), result)
+		mock.AssertExpectationsForObjects(t, persist, transact, svc, specService, converter)
+	})
+
+	t.Run("Returns error when dataloaders are missing in context", func(t *testing.T) {
+		resolver := mp_bundle.NewResolver(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+		// when
+		_, err := resolver.APIDefinitions(context.TODO(), app, &group, &first, &gqlAfter)
+		// then
+		require.Equal(t, dataloader.NoLoadersError, err)
+	})
+
+	t.Run("Returns error when bundle is nil", func(t *testing.T) {
+		resolver := mp_bundle.NewResolver(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+		// when
+		_, err := resolver.APIDefinitions(context.TODO(), nil, &group, &first, &gqlAfter)
+		// then
+		require.Error(t, err)
+		assert.EqualError(t, err, apperrors.NewInternalError("Bundle cannot be empty").Error())
+	})
 }
 
 func TestResolver_Event(t *testing.T) {
@@ -656,6 +693,8 @@ func TestResolver_Events(t *testing.T) {
 		},
 	}
 
+	specsByObjectID := map[string]*model.Spec{"foo": modelSpecs[0], "bar": modelSpecs[1]}
+
 	gqlEventDefinitions := []*graphql.EventDefinition{
 		fixGQLEventDefinition("foo", bundleID, "Foo", "Lorem Ipsum", group),
 		fixGQLEventDefinition("bar", bundleID, "Bar", "Lorem Ipsum", group),
@@ -673,7 +712,7 @@ func TestResolver_Events(t *testing.T) {
 		ServiceFn       func() *automock.EventService
 		ConverterFn     func() *automock.EventConverter
 		SpecServiceFn   func() *automock.SpecService
-		ExpectedResult  *graphql.EventDefinitionPage
+		ExpectedResult  []*graphql.EventDefinitionPage
 		ExpectedErr     error
 	}{
 		{
@@ -681,13 +720,12 @@ func TestResolver_Events(t *testing.T) {
 			TransactionerFn: txGen.ThatSucceeds,
 			ServiceFn: func() *automock.EventService {
 				svc := &automock.EventService{}
-				svc.On("ListForBundle", txtest.CtxWithDBMatcher(), bundleID, first, after).Return(fixEventAPIDefinitionPage(modelEventDefinitions), nil).Once()
+				svc.On("ListForBundles", txtest.CtxWithDBMatcher(), []string{bundleID}, first, after).Return([]*model.EventDefinitionPage{fixEventAPIDefinitionPage(modelEventDefinitions)}, nil).Once()
 				return svc
 			},
 			SpecServiceFn: func() *automock.SpecService {
 				svc := &automock.SpecService{}
-				svc.On("GetByReferenceObjectID", txtest.CtxWithDBMatcher(), model.EventSpecReference, modelEventDefinitions[0].ID).Return(modelSpecs[0], nil).Once()
-				svc.On("GetByReferenceObjectID", txtest.CtxWithDBMatcher(), model.EventSpecReference, modelEventDefinitions[1].ID).Return(modelSpecs[1], nil).Once()
+				svc.On("GetByReferenceObjectIDs", txtest.CtxWithDBMatcher(), model.EventSpecReference, []string{modelEventDefinitions[0].ID, modelEventDefinitions[1].ID}).Return(specsByObjectID, nil).Once()
 				return svc
 			},
 			ConverterFn: func() *automock.EventConverter {
@@ -695,7 +733,7 @@ func TestResolver_Events(t *testing.T) {
 				conv.On("MultipleToGraphQL", modelEventDefinitions, modelSpecs).Return(gqlEventDefinitions, nil).Once()
 				return conv
 			},
-			ExpectedResult: fixGQLEventDefinitionPage(gqlEventDefinitions),
+			ExpectedResult: []*graphql.EventDefinitionPage{fixGQLEventDefinitionPage(gqlEventDefinitions)},
 			ExpectedErr:    nil,
 		},
 		{
@@ -718,7 +756,7 @@ func TestResolver_Events(t *testing.T) {
 			TransactionerFn: txGen.ThatDoesntExpectCommit,
 			ServiceFn: func() *automock.EventService {
 				svc := &automock.EventService{}
-				svc.On("ListForBundle", txtest.CtxWithDBMatcher(), bundleID, first, after).Return(nil, testErr).Once()
+				svc.On("ListForBundles", txtest.CtxWithDBMatcher(), []string{bundleID}, first, after).Return(nil, testErr).Once()
 				return svc
 			},
 			SpecServiceFn: func() *automock.SpecService {
@@ -735,12 +773,12 @@ func TestResolver_Events(t *testing.T) {
 			TransactionerFn: txGen.ThatDoesntExpectCommit,
 			ServiceFn: func() *automock.EventService {
 				svc := &automock.EventService{}
-				svc.On("ListForBundle", txtest.CtxWithDBMatcher(), bundleID, first, after).Return(fixEventAPIDefinitionPage(modelEventDefinitions), nil).Once()
+				svc.On("ListForBundles", txtest.CtxWithDBMatcher(), []string{bundleID}, first, after).Return([]*model.EventDefinitionPage{fixEventAPIDefinitionPage(modelEventDefinitions)}, nil).Once()
 				return svc
 			},
 			SpecServiceFn: func() *automock.SpecService {
 				svc := &automock.SpecService{}
-				svc.On("GetByReferenceObjectID", txtest.CtxWithDBMatcher(), model.EventSpecReference, modelEventDefinitions[0].ID).Return(nil, testErr).Once()
+				svc.On("GetByReferenceObjectIDs", txtest.CtxWithDBMatcher(), model.EventSpecReference, []string{modelEventDefinitions[0].ID, modelEventDefinitions[1].ID}).Return(nil, testErr).Once()
 				return svc
 			},
 			ConverterFn: func() *automock.EventConverter {
@@ -751,16 +789,15 @@ func TestResolver_Events(t *testing.T) {
 		},
 		{
 			Name:            "Returns error when converting to GraphQL failed",
-			TransactionerFn: txGen.ThatDoesntExpectCommit,
+			TransactionerFn: txGen.ThatSucceeds,
 			ServiceFn: func() *automock.EventService {
 				svc := &automock.EventService{}
-				svc.On("ListForBundle", txtest.CtxWithDBMatcher(), bundleID, first, after).Return(fixEventAPIDefinitionPage(modelEventDefinitions), nil).Once()
+				svc.On("ListForBundles", txtest.CtxWithDBMatcher(), []string{bundleID}, first, after).Return([]*model.EventDefinitionPage{fixEventAPIDefinitionPage(modelEventDefinitions)}, nil).Once()
 				return svc
 			},
 			SpecServiceFn: func() *automock.SpecService {
 				svc := &automock.SpecService{}
-				svc.On("GetByReferenceObjectID", txtest.CtxWithDBMatcher(), model.EventSpecReference, modelEventDefinitions[0].ID).Return(modelSpecs[0], nil).Once()
-				svc.On("GetByReferenceObjectID", txtest.CtxWithDBMatcher(), model.EventSpecReference, modelEventDefinitions[1].ID).Return(modelSpecs[1], nil).Once()
+				svc.On("GetByReferenceObjectIDs", txtest.CtxWithDBMatcher(), model.EventSpecReference, []string{modelEventDefinitions[0].ID, modelEventDefinitions[1].ID}).Return(specsByObjectID, nil).Once()
 				return svc
 			},
 			ConverterFn: func() *automock.EventConverter {
@@ -776,19 +813,16 @@ func TestResolver_Events(t *testing.T) {
 			TransactionerFn: txGen.ThatFailsOnCommit,
 			ServiceFn: func() *automock.EventService {
 				svc := &automock.EventService{}
-				svc.On("ListForBundle", txtest.CtxWithDBMatcher(), bundleID, first, after).Return(fixEventAPIDefinitionPage(modelEventDefinitions), nil).Once()
+				svc.On("ListForBundles", txtest.CtxWithDBMatcher(), []string{bundleID}, first, after).Return([]*model.EventDefinitionPage{fixEventAPIDefinitionPage(modelEventDefinitions)}, nil).Once()
 				return svc
 			},
 			SpecServiceFn: func() *automock.SpecService {
 				svc := &automock.SpecService{}
-				svc.On("GetByReferenceObjectID", txtest.CtxWithDBMatcher(), model.EventSpecReference, modelEventDefinitions[0].ID).Return(modelSpecs[0], nil).Once()
-				svc.On("GetByReferenceObjectID", txtest.CtxWithDBMatcher(), model.EventSpecReference, modelEventDefinitions[1].ID).Return(modelSpecs[1], nil).Once()
+				svc.On("GetByReferenceObjectIDs", txtest.CtxWithDBMatcher(), model.EventSpecReference, []string{modelEventDefinitions[0].ID, modelEventDefinitions[1].ID}).Return(specsByObjectID, nil).Once()
 				return svc
 			},
 			ConverterFn: func() *automock.EventConverter {
-				conv := &automock.EventConverter{}
-				conv.On("MultipleToGraphQL", modelEventDefinitions, modelSpecs).Return(gqlEventDefinitions, nil).Once()
-				return conv
+				return &automock.EventConverter{}
 			},
 			ExpectedResult: nil,
 			ExpectedErr:    testErr,
@@ -805,7 +839,7 @@ func TestResolver_Events(t *testing.T) {
 
 			resolver := mp_bundle.NewResolver(transact, nil, nil, nil, svc, nil, nil, nil, nil, converter, nil, specService)
 			// when
-			result, err := resolver.EventDefinitions(context.TODO(), app, &group, &first, &gqlAfter)
+			result, err := resolver.EventDefinitionsDataLoader(context.TODO(), []string{bundleID}, first, after)
 
 			// then
 			assert.Equal(t, testCase.ExpectedResult, result)
@@ -823,6 +857,44 @@ func TestResolver_Events(t *testing.T) {
 			specService.AssertExpectations(t)
 		})
 	}
+
+	t.Run("Returns page of bundle fetched with dataloader", func(t *testing.T) {
+		// given
+		persist, transact := txGen.ThatSucceeds()
+		svc := &automock.EventService{}
+		svc.On("ListForBundles", txtest.CtxWithDBMatcher(), []string{bundleID}, first, after).Return([]*model.EventDefinitionPage{fixEventAPIDefinitionPage(modelEventDefinitions)}, nil).Once()
+		specService := &automock.SpecService{}
+		specService.On("GetByReferenceObjectIDs", txtest.CtxWithDBMatcher(), model.EventSpecReference, []string{modelEventDefinitions[0].ID, modelEventDefinitions[1].ID}).Return(specsByObjectID, nil).Once()
+		converter := &automock.EventConverter{}
+		converter.On("MultipleToGraphQL", modelEventDefinitions, modelSpecs).Return(gqlEventDefinitions, nil).Once()
+		resolver := mp_bundle.NewResolver(transact, nil, nil, nil, svc, nil, nil, nil, nil, converter, nil, specService)
+		loaders := dataloader.NewLoaders(dataloader.Fetchers{BundleEvents: resolver.EventDefinitionsDataLoader}, dataloader.Config{})
+
+		// when
+		result, err := resolver.EventDefinitions(dataloader.SaveToContext(context.TODO(), loaders), app, &group, &first, &gqlAfter)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, fixGQLEventDefinitionPage(gqlEventDefinitions), result)
+		mock.AssertExpectationsForObjects(t, persist, transact, svc, specService, converter)
+	})
+
+	t.Run("Returns error when dataloaders are missing in context", func(t *testing.T) {
+		resolver := mp_bundle.NewResolver(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+		// when
+		_, err := resolver.EventDefinitions(context.TODO(), app, &group, &first, &gqlAfter)
+		// then
+		require.Equal(t, dataloader.NoLoadersError, err)
+	})
+
+	t.Run("Returns error when bundle is nil", func(t *testing.T) {
+		resolver := mp_bundle.NewResolver(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+		// when
+		_, err := resolver.EventDefinitions(context.TODO(), nil, &group, &first, &gqlAfter)
+		// then
+		require.Error(t, err)
+		assert.EqualError(t, err, apperrors.NewInternalError("Bundle cannot be empty").Error())
+	})
 }
 
 func TestResolver_Document(t *testing.T) {
@@ -985,7 +1057,7 @@ func TestResolver_Documents(t *testing.T) {
 		ConverterFn     func() *automock.DocumentConverter
 		PersistenceFn   func() *persistenceautomock.PersistenceTx
 		TransactionerFn func(persistTx *persistenceautomock.PersistenceTx) *persistenceautomock.Transactioner
-		ExpectedResult  *graphql.DocumentPage
+		ExpectedResult  []*graphql.DocumentPage
 		ExpectedErr     error
 	}{
 		{
@@ -994,7 +1066,7 @@ func TestResolver_Documents(t *testing.T) {
 			TransactionerFn: txtest.TransactionerThatSucceeds,
 			ServiceFn: func() *automock.DocumentService {
 				svc := &automock.DocumentService{}
-				svc.On("ListForBundle", contextParam, bndlID, first, after).Return(fixModelDocumentPage(modelDocuments), nil).Once()
+				svc.On("ListForBundles", contextParam, []string{bndlID}, first, after).Return([]*model.DocumentPage{fixModelDocumentPage(modelDocuments)}, nil).Once()
 				return svc
 			},
 			ConverterFn: func() *automock.DocumentConverter {
@@ -1002,7 +1074,7 @@ func TestResolver_Documents(t *testing.T) {
 				conv.On("MultipleToGraphQL", modelDocuments).Return(gqlDocuments).Once()
 				return conv
 			},
-			ExpectedResult: fixGQLDocumentPage(gqlDocuments),
+			ExpectedResult: []*graphql.DocumentPage{fixGQLDocumentPage(gqlDocuments)},
 			ExpectedErr:    nil,
 		},
 		{
@@ -1011,7 +1083,7 @@ func TestResolver_Documents(t *testing.T) {
 			TransactionerFn: txtest.TransactionerThatSucceeds,
 			ServiceFn: func() *automock.DocumentService {
 				svc := &automock.DocumentService{}
-				svc.On("ListForBundle", contextParam, bndlID, first, after).Return(nil, testErr).Once()
+				svc.On("ListForBundles", contextParam, []string{bndlID}, first, after).Return(nil, testErr).Once()
 				return svc
 			},
 			ConverterFn: func() *automock.DocumentConverter {
@@ -1033,7 +1105,7 @@ func TestResolver_Documents(t *testing.T) {
 			resolver := mp_bundle.NewResolver(transact, nil, nil, nil, nil, svc, nil, nil, nil, nil, converter, nil)
 
 			// when
-			result, err := resolver.Documents(context.TODO(), bndl, &first, &gqlAfter)
+			result, err := resolver.DocumentsDataLoader(context.TODO(), []string{bndlID}, first, after)
 
 			// then
 			assert.Equal(t, testCase.ExpectedResult, result)
@@ -1045,6 +1117,42 @@ func TestResolver_Documents(t *testing.T) {
 			transact.AssertExpectations(t)
 		})
 	}
+	t.Run("Returns page of bundle fetched with dataloader", func(t *testing.T) {
+		// given
+		persistTx := txtest.PersistenceContextThatExpectsCommit()
+		transact := txtest.TransactionerThatSucceeds(persistTx)
+		svc := &automock.DocumentService{}
+		svc.On("ListForBundles", contextParam, []string{bndlID}, first, after).Return([]*model.DocumentPage{fixModelDocumentPage(modelDocuments)}, nil).Once()
+		converter := &automock.DocumentConverter{}
+		converter.On("MultipleToGraphQL", modelDocuments).Return(gqlDocuments).Once()
+		resolver := mp_bundle.NewResolver(transact, nil, nil, nil, nil, svc, nil, nil, nil, nil, converter, nil)
+		loaders := dataloader.NewLoaders(dataloader.Fetchers{BundleDocuments: resolver.DocumentsDataLoader}, dataloader.Config{})
+
+		// when
+		result, err := resolver.Documents(dataloader.SaveToContext(context.TODO(), loaders), bndl, &first, &gqlAfter)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, fixGQLDocumentPage(gqlDocuments), result)
+		mock.AssertExpectationsForObjects(t, persistTx, transact, svc, converter)
+	})
+
+	t.Run("Returns error when dataloaders are missing in context", func(t *testing.T) {
+		resolver := mp_bundle.NewResolver(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+		// when
+		_, err := resolver.Documents(context.TODO(), bndl, &first, &gqlAfter)
+		// then
+		require.Equal(t, dataloader.NoLoadersError, err)
+	})
+
+	t.Run("Returns error when first is missing", func(t *testing.T) {
+		resolver := mp_bundle.NewResolver(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+		// when
+		_, err := resolver.Documents(context.TODO(), bndl, nil, &gqlAfter)
+		// then
+		require.Error(t, err)
+		assert.EqualError(t, err, apperrors.NewInvalidDataError("missing required parameter 'first'").Error())
+	})
 }
 
 func TestResolver_AddBundle(t *testing.T) {
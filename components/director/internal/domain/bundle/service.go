@@ -22,7 +22,9 @@ type BundleRepository interface {
 	GetForApplication(ctx context.Context, tenant string, id string, applicationID string) (*model.Bundle, error)
 	GetByInstanceAuthID(ctx context.Context, tenant string, instanceAuthID string) (*model.Bundle, error)
 	ListByApplicationID(ctx context.Context, tenantID, applicationID string, pageSize int, cursor string) (*model.BundlePage, error)
+	ListByApplicationIDs(ctx context.Context, tenantID string, applicationIDs []string, pageSize int, cursor string) ([]*model.BundlePage, error)
 	ListByApplicationIDNoPaging(ctx context.Context, tenantID, appID string) ([]*model.Bundle, error)
+	List(ctx context.Context, tenantID string, pageSize int, cursor string) (*model.BundlePage, error)
 }
 
 //go:generate mockery -name=UIDService -output=automock -outpkg=automock -case=underscore
@@ -195,6 +197,19 @@ func (s *service) ListByApplicationID(ctx context.Context, applicationID string,
 	return s.bndlRepo.ListByApplicationID(ctx, tnt, applicationID, pageSize, cursor)
 }
 
+func (s *service) ListByApplicationIDs(ctx context.Context, applicationIDs []string, pageSize int, cursor string) ([]*model.BundlePage, error) {
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if pageSize < 1 || pageSize > 200 {
+		return nil, apperrors.NewInvalidDataError("page size must be between 1 and 200")
+	}
+
+	return s.bndlRepo.ListByApplicationIDs(ctx, tnt, applicationIDs, pageSize, cursor)
+}
+
 func (s *service) ListByApplicationIDNoPaging(ctx context.Context, appID string) ([]*model.Bundle, error) {
 	tnt, err := tenant.LoadFromContext(ctx)
 	if err != nil {
@@ -204,6 +219,20 @@ func (s *service) ListByApplicationIDNoPaging(ctx context.Context, appID string)
 	return s.bndlRepo.ListByApplicationIDNoPaging(ctx, tnt, appID)
 }
 
+// List returns a page of all Bundles of the tenant
+func (s *service) List(ctx context.Context, pageSize int, cursor string) (*model.BundlePage, error) {
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if pageSize < 1 || pageSize > 200 {
+		return nil, apperrors.NewInvalidDataError("page size must be between 1 and 200")
+	}
+
+	return s.bndlRepo.List(ctx, tnt, pageSize, cursor)
+}
+
 func (s *service) createRelatedResources(ctx context.Context, in model.BundleCreateInput, bundleID, appID string) error {
 	for i := range in.APIDefinitions {
 		_, err := s.apiSvc.CreateInBundle(ctx, appID, bundleID, *in.APIDefinitions[i], in.APISpecs[i])
@@ -742,6 +742,115 @@ func TestService_GetByInstanceAuthID(t *testing.T) {
 	})
 }
 
+func TestService_List(t *testing.T) {
+	// given
+	testErr := errors.New("Test error")
+
+	name := "foo"
+	desc := "bar"
+
+	bundles := []*model.Bundle{
+		fixBundleModel(name, desc),
+		fixBundleModel(name, desc),
+		fixBundleModel(name, desc),
+	}
+	bundlePage := &model.BundlePage{
+		Data:       bundles,
+		TotalCount: len(bundles),
+		PageInfo: &pagination.Page{
+			HasNextPage: false,
+			EndCursor:   "end",
+			StartCursor: "start",
+		},
+	}
+
+	after := "test"
+
+	ctx := context.TODO()
+	ctx = tenant.SaveToContext(ctx, tenantID, externalTenantID)
+
+	testCases := []struct {
+		Name               string
+		PageSize           int
+		RepositoryFn       func() *automock.BundleRepository
+		ExpectedResult     *model.BundlePage
+		ExpectedErrMessage string
+	}{
+		{
+			Name: "Success",
+			RepositoryFn: func() *automock.BundleRepository {
+				repo := &automock.BundleRepository{}
+				repo.On("List", ctx, tenantID, 2, after).Return(bundlePage, nil).Once()
+				return repo
+			},
+			PageSize:           2,
+			ExpectedResult:     bundlePage,
+			ExpectedErrMessage: "",
+		},
+		{
+			Name: "Return error when page size is less than 1",
+			RepositoryFn: func() *automock.BundleRepository {
+				repo := &automock.BundleRepository{}
+				return repo
+			},
+			PageSize:           0,
+			ExpectedResult:     bundlePage,
+			ExpectedErrMessage: "page size must be between 1 and 200",
+		},
+		{
+			Name: "Return error when page size is bigger than 200",
+			RepositoryFn: func() *automock.BundleRepository {
+				repo := &automock.BundleRepository{}
+				return repo
+			},
+			PageSize:           201,
+			ExpectedResult:     bundlePage,
+			ExpectedErrMessage: "page size must be between 1 and 200",
+		},
+		{
+			Name: "Returns error when Bundle listing failed",
+			RepositoryFn: func() *automock.BundleRepository {
+				repo := &automock.BundleRepository{}
+				repo.On("List", ctx, tenantID, 2, after).Return(nil, testErr).Once()
+				return repo
+			},
+			PageSize:           2,
+			ExpectedResult:     nil,
+			ExpectedErrMessage: testErr.Error(),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			repo := testCase.RepositoryFn()
+
+			svc := mp_bundle.NewService(repo, nil, nil, nil, nil)
+
+			// when
+			docs, err := svc.List(ctx, testCase.PageSize, after)
+
+			// then
+			if testCase.ExpectedErrMessage == "" {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.ExpectedResult, docs)
+			} else {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErrMessage)
+			}
+
+			repo.AssertExpectations(t)
+		})
+	}
+	t.Run("Error when tenant not in context", func(t *testing.T) {
+		svc := mp_bundle.NewService(nil, nil, nil, nil, nil)
+		// WHEN
+		_, err := svc.List(context.TODO(), 5, "")
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "cannot read tenant from context")
+	})
+}
+
 func TestService_ListByApplicationID(t *testing.T) {
 	// given
 	testErr := errors.New("Test error")
@@ -852,6 +961,115 @@ func TestService_ListByApplicationID(t *testing.T) {
 	})
 }
 
+func TestService_ListByApplicationIDs(t *testing.T) {
+	// given
+	testErr := errors.New("Test error")
+
+	applicationIDs := []string{"foo", "bar"}
+	name := "foo"
+	desc := "bar"
+
+	bundlePages := []*model.BundlePage{
+		{
+			Data:       []*model.Bundle{fixBundleModel(name, desc)},
+			TotalCount: 1,
+			PageInfo: &pagination.Page{
+				HasNextPage: false,
+				EndCursor:   "end",
+				StartCursor: "start",
+			},
+		},
+		{
+			Data:       nil,
+			TotalCount: 0,
+			PageInfo:   &pagination.Page{},
+		},
+	}
+
+	after := "test"
+
+	ctx := context.TODO()
+	ctx = tenant.SaveToContext(ctx, tenantID, externalTenantID)
+
+	testCases := []struct {
+		Name               string
+		PageSize           int
+		RepositoryFn       func() *automock.BundleRepository
+		ExpectedResult     []*model.BundlePage
+		ExpectedErrMessage string
+	}{
+		{
+			Name: "Success",
+			RepositoryFn: func() *automock.BundleRepository {
+				repo := &automock.BundleRepository{}
+				repo.On("ListByApplicationIDs", ctx, tenantID, applicationIDs, 2, after).Return(bundlePages, nil).Once()
+				return repo
+			},
+			PageSize:           2,
+			ExpectedResult:     bundlePages,
+			ExpectedErrMessage: "",
+		},
+		{
+			Name: "Return error when page size is less than 1",
+			RepositoryFn: func() *automock.BundleRepository {
+				repo := &automock.BundleRepository{}
+				return repo
+			},
+			PageSize:           0,
+			ExpectedErrMessage: "page size must be between 1 and 200",
+		},
+		{
+			Name: "Return error when page size is bigger than 200",
+			RepositoryFn: func() *automock.BundleRepository {
+				repo := &automock.BundleRepository{}
+				return repo
+			},
+			PageSize:           201,
+			ExpectedErrMessage: "page size must be between 1 and 200",
+		},
+		{
+			Name: "Returns error when Bundle listing failed",
+			RepositoryFn: func() *automock.BundleRepository {
+				repo := &automock.BundleRepository{}
+				repo.On("ListByApplicationIDs", ctx, tenantID, applicationIDs, 2, after).Return(nil, testErr).Once()
+				return repo
+			},
+			PageSize:           2,
+			ExpectedErrMessage: testErr.Error(),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			repo := testCase.RepositoryFn()
+
+			svc := mp_bundle.NewService(repo, nil, nil, nil, nil)
+
+			// when
+			pages, err := svc.ListByApplicationIDs(ctx, applicationIDs, testCase.PageSize, after)
+
+			// then
+			if testCase.ExpectedErrMessage == "" {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.ExpectedResult, pages)
+			} else {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErrMessage)
+			}
+
+			repo.AssertExpectations(t)
+		})
+	}
+	t.Run("Error when tenant not in context", func(t *testing.T) {
+		svc := mp_bundle.NewService(nil, nil, nil, nil, nil)
+		// WHEN
+		_, err := svc.ListByApplicationIDs(context.TODO(), applicationIDs, 5, "")
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "cannot read tenant from context")
+	})
+}
+
 func TestService_ListByApplicationIDNoPaging(t *testing.T) {
 	// given
 	testErr := errors.New("Test error")
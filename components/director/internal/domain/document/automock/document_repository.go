@@ -132,3 +132,26 @@ func (_m *DocumentRepository) ListForBundle(ctx context.Context, tenant string,
 
 	return r0, r1
 }
+
+// ListForBundles provides a mock function with given fields: ctx, tenant, bundleIDs, pageSize, cursor
+func (_m *DocumentRepository) ListForBundles(ctx context.Context, tenant string, bundleIDs []string, pageSize int, cursor string) ([]*model.DocumentPage, error) {
+	ret := _m.Called(ctx, tenant, bundleIDs, pageSize, cursor)
+
+	var r0 []*model.DocumentPage
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string, int, string) []*model.DocumentPage); ok {
+		r0 = rf(ctx, tenant, bundleIDs, pageSize, cursor)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.DocumentPage)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, []string, int, string) error); ok {
+		r1 = rf(ctx, tenant, bundleIDs, pageSize, cursor)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
@@ -33,6 +33,7 @@ type repository struct {
 	singleGetter    repo.SingleGetter
 	deleter         repo.Deleter
 	pageableQuerier repo.PageableQuerier
+	unionLister     repo.UnionLister
 	creator         repo.Creator
 
 	conv Converter
@@ -44,6 +45,7 @@ func NewRepository(conv Converter) *repository {
 		singleGetter:    repo.NewSingleGetter(resource.Document, documentTable, tenantColumn, documentColumns),
 		deleter:         repo.NewDeleter(resource.Document, documentTable, tenantColumn),
 		pageableQuerier: repo.NewPageableQuerier(resource.Document, documentTable, tenantColumn, documentColumns),
+		unionLister:     repo.NewUnionLister(resource.Document, documentTable, tenantColumn, documentColumns),
 		creator:         repo.NewCreator(resource.Document, documentTable, documentColumns),
 		conv:            conv,
 	}
@@ -125,6 +127,35 @@ func (r *repository) ListForBundle(ctx context.Context, tenantID string, bundleI
 	return r.list(ctx, tenantID, pageSize, cursor, conditions)
 }
 
+// ListForBundles returns the same page of Documents for every bundle, in the order of the bundleIDs
+func (r *repository) ListForBundles(ctx context.Context, tenantID string, bundleIDs []string, pageSize int, cursor string) ([]*model.DocumentPage, error) {
+	var documentCollection Collection
+	pages, err := r.unionLister.List(ctx, tenantID, bundleIDs, "bundle_id", pageSize, cursor, "id", &documentCollection)
+	if err != nil {
+		return nil, err
+	}
+
+	documentsByBundleID := make(map[string][]*model.Document, len(bundleIDs))
+	for _, documentEnt := range documentCollection {
+		m, err := r.conv.FromEntity(documentEnt)
+		if err != nil {
+			return nil, errors.Wrap(err, "while creating Document model from entity")
+		}
+		documentsByBundleID[m.BundleID] = append(documentsByBundleID[m.BundleID], &m)
+	}
+
+	documentPages := make([]*model.DocumentPage, 0, len(bundleIDs))
+	for _, bundleID := range bundleIDs {
+		documentPages = append(documentPages, &model.DocumentPage{
+			Data:       documentsByBundleID[bundleID],
+			TotalCount: pages[bundleID].TotalCount,
+			PageInfo:   pages[bundleID].PageInfo,
+		})
+	}
+
+	return documentPages, nil
+}
+
 func (r *repository) list(ctx context.Context, tenant string, pageSize int, cursor string, conditions repo.Conditions) (*model.DocumentPage, error) {
 	var documentCollection Collection
 	page, totalCount, err := r.pageableQuerier.List(ctx, tenant, pageSize, cursor, "id", &documentCollection, conditions...)
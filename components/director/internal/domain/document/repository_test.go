@@ -296,6 +296,109 @@ func TestRepository_ListForBundle(t *testing.T) {
 	})
 }
 
+func TestRepository_ListForBundles(t *testing.T) {
+	// GIVEN
+	tenantID := "tnt"
+	testErr := errors.New("Test error")
+	secondBndlID := "ccccccccc-cccc-cccc-cccc-cccccccccccc"
+
+	inputPageSize := 3
+	inputCursor := ""
+	docEntity1 := fixEntityDocument("1", bndlID())
+	docEntity2 := fixEntityDocument("2", bndlID())
+
+	selectQuery := regexp.QuoteMeta(`(SELECT id, tenant_id, bundle_id, title, display_name, description, format, kind, data, ready, created_at, updated_at, deleted_at, error FROM public.documents WHERE bundle_id = $1 AND tenant_id = $2 ORDER BY id LIMIT 3 OFFSET 0) UNION ALL (SELECT id, tenant_id, bundle_id, title, display_name, description, format, kind, data, ready, created_at, updated_at, deleted_at, error FROM public.documents WHERE bundle_id = $3 AND tenant_id = $4 ORDER BY id LIMIT 3 OFFSET 0)`)
+
+	rawCountQuery := "SELECT bundle_id AS id, COUNT(*) AS total_count FROM public.documents WHERE bundle_id IN ($1, $2) AND tenant_id = $3 GROUP BY bundle_id"
+	countQuery := regexp.QuoteMeta(rawCountQuery)
+
+	t.Run("Success", func(t *testing.T) {
+		rows := sqlmock.NewRows(columns).
+			AddRow(docEntity1.ID, docEntity1.TenantID, docEntity1.BndlID, docEntity1.Title, docEntity1.DisplayName, docEntity1.Description, docEntity1.Format, docEntity1.Kind, docEntity1.Data,
+				docEntity1.Ready, docEntity1.CreatedAt, docEntity1.UpdatedAt, docEntity1.DeletedAt, docEntity1.Error).
+			AddRow(docEntity2.ID, docEntity2.TenantID, docEntity2.BndlID, docEntity2.Title, docEntity2.DisplayName, docEntity2.Description, docEntity2.Format, docEntity2.Kind, docEntity2.Data,
+				docEntity2.Ready, docEntity2.CreatedAt, docEntity2.UpdatedAt, docEntity2.DeletedAt, docEntity2.Error)
+
+		sqlxDB, sqlMock := testdb.MockDatabase(t)
+		defer sqlMock.AssertExpectations(t)
+		sqlMock.ExpectQuery(selectQuery).
+			WithArgs(bndlID(), tenantID, secondBndlID, tenantID).
+			WillReturnRows(rows)
+
+		sqlMock.ExpectQuery(countQuery).
+			WithArgs(bndlID(), secondBndlID, tenantID).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "total_count"}).AddRow(bndlID(), 2))
+
+		ctx := persistence.SaveToContext(context.TODO(), sqlxDB)
+		conv := &automock.Converter{}
+		defer conv.AssertExpectations(t)
+
+		conv.On("FromEntity", *docEntity1).Return(model.Document{BundleID: bndlID(), BaseEntity: &model.BaseEntity{ID: docEntity1.ID}}, nil).Once()
+		conv.On("FromEntity", *docEntity2).Return(model.Document{BundleID: bndlID(), BaseEntity: &model.BaseEntity{ID: docEntity2.ID}}, nil).Once()
+
+		pgRepository := document.NewRepository(conv)
+		// WHEN
+		modelDocPages, err := pgRepository.ListForBundles(ctx, tenantID, []string{bndlID(), secondBndlID}, inputPageSize, inputCursor)
+		//THEN
+		require.NoError(t, err)
+		require.Len(t, modelDocPages, 2)
+		require.Len(t, modelDocPages[0].Data, 2)
+		assert.Equal(t, docEntity1.ID, modelDocPages[0].Data[0].ID)
+		assert.Equal(t, docEntity2.ID, modelDocPages[0].Data[1].ID)
+		assert.Equal(t, 2, modelDocPages[0].TotalCount)
+		assert.Empty(t, modelDocPages[1].Data)
+		assert.Equal(t, 0, modelDocPages[1].TotalCount)
+	})
+
+	t.Run("DB Error", func(t *testing.T) {
+		sqlxDB, sqlMock := testdb.MockDatabase(t)
+		defer sqlMock.AssertExpectations(t)
+		sqlMock.ExpectQuery(selectQuery).
+			WithArgs(bndlID(), tenantID, secondBndlID, tenantID).
+			WillReturnError(testErr)
+
+		ctx := persistence.SaveToContext(context.TODO(), sqlxDB)
+		conv := &automock.Converter{}
+		defer conv.AssertExpectations(t)
+
+		pgRepository := document.NewRepository(conv)
+		// WHEN
+		_, err := pgRepository.ListForBundles(ctx, tenantID, []string{bndlID(), secondBndlID}, 3, "")
+		//THEN
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "Unexpected error while executing SQL query")
+	})
+
+	t.Run("Converter Error", func(t *testing.T) {
+		testErr := errors.New("test error")
+		rows := sqlmock.NewRows(columns).
+			AddRow(docEntity1.ID, docEntity1.TenantID, docEntity1.BndlID, docEntity1.Title, docEntity1.DisplayName, docEntity1.Description, docEntity1.Format, docEntity1.Kind, docEntity1.Data,
+				docEntity1.Ready, docEntity1.CreatedAt, docEntity1.UpdatedAt, docEntity1.DeletedAt, docEntity1.Error)
+
+		conv := &automock.Converter{}
+		conv.On("FromEntity", *docEntity1).Return(model.Document{}, testErr).Once()
+		defer conv.AssertExpectations(t)
+
+		sqlxDB, sqlMock := testdb.MockDatabase(t)
+		defer sqlMock.AssertExpectations(t)
+		sqlMock.ExpectQuery(selectQuery).
+			WithArgs(bndlID(), tenantID, secondBndlID, tenantID).
+			WillReturnRows(rows)
+
+		sqlMock.ExpectQuery(countQuery).
+			WithArgs(bndlID(), secondBndlID, tenantID).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "total_count"}).AddRow(bndlID(), 1))
+		ctx := persistence.SaveToContext(context.TODO(), sqlxDB)
+
+		repo := document.NewRepository(conv)
+		//WHEN
+		_, err := repo.ListForBundles(ctx, tenantID, []string{bndlID(), secondBndlID}, inputPageSize, inputCursor)
+		//THEN
+		require.Error(t, err)
+		require.Contains(t, err.Error(), testErr.Error())
+	})
+}
+
 func TestRepository_Exists(t *testing.T) {
 	// given
 	sqlxDB, sqlMock := testdb.MockDatabase(t)
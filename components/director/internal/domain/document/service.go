@@ -19,6 +19,7 @@ type DocumentRepository interface {
 	GetByID(ctx context.Context, tenant, id string) (*model.Document, error)
 	GetForBundle(ctx context.Context, tenant string, id string, bundleID string) (*model.Document, error)
 	ListForBundle(ctx context.Context, tenant string, bundleID string, pageSize int, cursor string) (*model.DocumentPage, error)
+	ListForBundles(ctx context.Context, tenant string, bundleIDs []string, pageSize int, cursor string) ([]*model.DocumentPage, error)
 	Create(ctx context.Context, item *model.Document) error
 	Delete(ctx context.Context, tenant, id string) error
 }
@@ -88,6 +89,15 @@ func (s *service) ListForBundle(ctx context.Context, bundleID string, pageSize i
 	return s.repo.ListForBundle(ctx, tnt, bundleID, pageSize, cursor)
 }
 
+func (s *service) ListForBundles(ctx context.Context, bundleIDs []string, pageSize int, cursor string) ([]*model.DocumentPage, error) {
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while loading tenant from context")
+	}
+
+	return s.repo.ListForBundles(ctx, tnt, bundleIDs, pageSize, cursor)
+}
+
 func (s *service) CreateInBundle(ctx context.Context, bundleID string, in model.DocumentInput) (string, error) {
 	tnt, err := tenant.LoadFromContext(ctx)
 	if err != nil {
@@ -245,6 +245,92 @@ func TestService_ListForBundle(t *testing.T) {
 		})
 	}
 }
+func TestService_ListForBundles(t *testing.T) {
+	// given
+	testErr := errors.New("Test error")
+
+	bundleIDs := []string{"bar", "baz"}
+	modelDocuments := []*model.Document{
+		fixModelDocument("foo", bundleIDs[0]),
+		fixModelDocument("bar", bundleIDs[0]),
+	}
+	documentPages := []*model.DocumentPage{
+		{
+			Data:       modelDocuments,
+			TotalCount: len(modelDocuments),
+			PageInfo: &pagination.Page{
+				HasNextPage: false,
+				EndCursor:   "end",
+				StartCursor: "start",
+			},
+		},
+		{
+			Data:       nil,
+			TotalCount: 0,
+			PageInfo:   &pagination.Page{},
+		},
+	}
+
+	tnt := modelDocuments[0].Tenant
+
+	first := 2
+	after := "test"
+	externalTenantID := "external-tnt"
+
+	ctx := context.TODO()
+	ctx = tenant.SaveToContext(ctx, modelDocuments[0].Tenant, externalTenantID)
+
+	testCases := []struct {
+		Name               string
+		RepositoryFn       func() *automock.DocumentRepository
+		ExpectedResult     []*model.DocumentPage
+		ExpectedErrMessage string
+	}{
+		{
+			Name: "Success",
+			RepositoryFn: func() *automock.DocumentRepository {
+				repo := &automock.DocumentRepository{}
+				repo.On("ListForBundles", ctx, tnt, bundleIDs, first, after).Return(documentPages, nil).Once()
+				return repo
+			},
+			ExpectedResult:     documentPages,
+			ExpectedErrMessage: "",
+		},
+		{
+			Name: "Returns error when document listing failed",
+			RepositoryFn: func() *automock.DocumentRepository {
+				repo := &automock.DocumentRepository{}
+				repo.On("ListForBundles", ctx, tnt, bundleIDs, first, after).Return(nil, testErr).Once()
+				return repo
+			},
+			ExpectedResult:     nil,
+			ExpectedErrMessage: testErr.Error(),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			repo := testCase.RepositoryFn()
+
+			svc := document.NewService(repo, nil, nil)
+
+			// when
+			pages, err := svc.ListForBundles(ctx, bundleIDs, first, after)
+
+			// then
+			if testCase.ExpectedErrMessage == "" {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.ExpectedResult, pages)
+			} else {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErrMessage)
+			}
+
+			repo.AssertExpectations(t)
+		})
+	}
+}
+
 func TestService_CreateToBundle(t *testing.T) {
 	// given
 	testErr := errors.New("Test error")
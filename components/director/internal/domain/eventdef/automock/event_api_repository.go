@@ -138,6 +138,29 @@ func (_m *EventAPIRepository) GetForBundle(ctx context.Context, tenant string, i
 	return r0, r1
 }
 
+// List provides a mock function with given fields: ctx, tenantID, pageSize, cursor
+func (_m *EventAPIRepository) List(ctx context.Context, tenantID string, pageSize int, cursor string) (*model.EventDefinitionPage, error) {
+	ret := _m.Called(ctx, tenantID, pageSize, cursor)
+
+	var r0 *model.EventDefinitionPage
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, string) *model.EventDefinitionPage); ok {
+		r0 = rf(ctx, tenantID, pageSize, cursor)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.EventDefinitionPage)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, int, string) error); ok {
+		r1 = rf(ctx, tenantID, pageSize, cursor)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ListByApplicationID provides a mock function with given fields: ctx, tenantID, appID
 func (_m *EventAPIRepository) ListByApplicationID(ctx context.Context, tenantID string, appID string) ([]*model.EventDefinition, error) {
 	ret := _m.Called(ctx, tenantID, appID)
@@ -184,6 +207,29 @@ func (_m *EventAPIRepository) ListForBundle(ctx context.Context, tenantID string
 	return r0, r1
 }
 
+// ListForBundles provides a mock function with given fields: ctx, tenantID, bundleIDs, pageSize, cursor
+func (_m *EventAPIRepository) ListForBundles(ctx context.Context, tenantID string, bundleIDs []string, pageSize int, cursor string) ([]*model.EventDefinitionPage, error) {
+	ret := _m.Called(ctx, tenantID, bundleIDs, pageSize, cursor)
+
+	var r0 []*model.EventDefinitionPage
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string, int, string) []*model.EventDefinitionPage); ok {
+		r0 = rf(ctx, tenantID, bundleIDs, pageSize, cursor)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.EventDefinitionPage)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, []string, int, string) error); ok {
+		r1 = rf(ctx, tenantID, bundleIDs, pageSize, cursor)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Update provides a mock function with given fields: ctx, item
 func (_m *EventAPIRepository) Update(ctx context.Context, item *model.EventDefinition) error {
 	ret := _m.Called(ctx, item)
@@ -50,6 +50,7 @@ func (c *converter) ToGraphQL(in *model.EventDefinition, spec *model.Spec) (*gra
 
 	return &graphql.EventDefinition{
 		BundleID:    bundleID,
+		PackageID:   in.PackageID,
 		Name:        in.Name,
 		Description: in.Description,
 		Group:       in.Group,
@@ -117,6 +117,7 @@ func fixFullGQLEventDefinition(placeholder string) *graphql.EventDefinition {
 
 	return &graphql.EventDefinition{
 		BundleID:    bundleID,
+		PackageID:   str.Ptr(packageID),
 		Name:        placeholder,
 		Description: str.Ptr("desc_" + placeholder),
 		Spec:        spec,
@@ -41,6 +41,7 @@ type EventAPIDefinitionConverter interface {
 type pgRepository struct {
 	singleGetter    repo.SingleGetter
 	pageableQuerier repo.PageableQuerier
+	unionLister     repo.UnionLister
 	lister          repo.Lister
 	creator         repo.Creator
 	updater         repo.Updater
@@ -53,6 +54,7 @@ func NewRepository(conv EventAPIDefinitionConverter) *pgRepository {
 	return &pgRepository{
 		singleGetter:    repo.NewSingleGetter(resource.EventDefinition, eventAPIDefTable, tenantColumn, eventDefColumns),
 		pageableQuerier: repo.NewPageableQuerier(resource.EventDefinition, eventAPIDefTable, tenantColumn, eventDefColumns),
+		unionLister:     repo.NewUnionLister(resource.EventDefinition, eventAPIDefTable, tenantColumn, eventDefColumns),
 		lister:          repo.NewLister(resource.EventDefinition, eventAPIDefTable, tenantColumn, eventDefColumns),
 		creator:         repo.NewCreator(resource.EventDefinition, eventAPIDefTable, eventDefColumns),
 		updater:         repo.NewUpdater(resource.EventDefinition, eventAPIDefTable, updatableColumns, tenantColumn, idColumns),
@@ -102,6 +104,35 @@ func (r *pgRepository) ListForBundle(ctx context.Context, tenantID string, bundl
 	return r.list(ctx, tenantID, pageSize, cursor, conditions)
 }
 
+// ListForBundles returns the same page of EventDefinitions for every bundle, in the order of the bundleIDs
+func (r *pgRepository) ListForBundles(ctx context.Context, tenantID string, bundleIDs []string, pageSize int, cursor string) ([]*model.EventDefinitionPage, error) {
+	var eventCollection EventAPIDefCollection
+	pages, err := r.unionLister.List(ctx, tenantID, bundleIDs, bundleColumn, pageSize, cursor, idColumn, &eventCollection)
+	if err != nil {
+		return nil, err
+	}
+
+	eventsByBundleID := make(map[string][]*model.EventDefinition, len(bundleIDs))
+	for _, eventEnt := range eventCollection {
+		m := r.conv.FromEntity(eventEnt)
+		if m.BundleID == nil {
+			continue
+		}
+		eventsByBundleID[*m.BundleID] = append(eventsByBundleID[*m.BundleID], &m)
+	}
+
+	eventPages := make([]*model.EventDefinitionPage, 0, len(bundleIDs))
+	for _, bundleID := range bundleIDs {
+		eventPages = append(eventPages, &model.EventDefinitionPage{
+			Data:       eventsByBundleID[bundleID],
+			TotalCount: pages[bundleID].TotalCount,
+			PageInfo:   pages[bundleID].PageInfo,
+		})
+	}
+
+	return eventPages, nil
+}
+
 func (r *pgRepository) ListByApplicationID(ctx context.Context, tenantID, appID string) ([]*model.EventDefinition, error) {
 	eventCollection := EventAPIDefCollection{}
 	if err := r.lister.List(ctx, tenantID, &eventCollection, repo.NewEqualCondition("app_id", appID)); err != nil {
@@ -115,6 +146,11 @@ func (r *pgRepository) ListByApplicationID(ctx context.Context, tenantID, appID
 	return events, nil
 }
 
+// List returns a page of all Events of the tenant
+func (r *pgRepository) List(ctx context.Context, tenantID string, pageSize int, cursor string) (*model.EventDefinitionPage, error) {
+	return r.list(ctx, tenantID, pageSize, cursor, nil)
+}
+
 func (r *pgRepository) list(ctx context.Context, tenant string, pageSize int, cursor string, conditions repo.Conditions) (*model.EventDefinitionPage, error) {
 	var eventCollection EventAPIDefCollection
 	page, totalCount, err := r.pageableQuerier.List(ctx, tenant, pageSize, cursor, idColumn, &eventCollection, conditions...)
@@ -174,6 +174,116 @@ func TestService_GetForBundle(t *testing.T) {
 	})
 }
 
+func TestService_List(t *testing.T) {
+	// given
+	testErr := errors.New("Test error")
+
+	id := "foo"
+	bndlID := "foobar"
+	name := "foo"
+
+	eventDefinitions := []*model.EventDefinition{
+		fixEventDefinitionModel(id, bndlID, name),
+		fixEventDefinitionModel(id, bndlID, name),
+		fixEventDefinitionModel(id, bndlID, name),
+	}
+	eventDefinitionPage := &model.EventDefinitionPage{
+		Data:       eventDefinitions,
+		TotalCount: len(eventDefinitions),
+		PageInfo: &pagination.Page{
+			HasNextPage: false,
+			EndCursor:   "end",
+			StartCursor: "start",
+		},
+	}
+
+	after := "test"
+
+	ctx := context.TODO()
+	ctx = tenant.SaveToContext(ctx, tenantID, externalTenantID)
+
+	testCases := []struct {
+		Name               string
+		PageSize           int
+		RepositoryFn       func() *automock.EventAPIRepository
+		ExpectedResult     *model.EventDefinitionPage
+		ExpectedErrMessage string
+	}{
+		{
+			Name: "Success",
+			RepositoryFn: func() *automock.EventAPIRepository {
+				repo := &automock.EventAPIRepository{}
+				repo.On("List", ctx, tenantID, 2, after).Return(eventDefinitionPage, nil).Once()
+				return repo
+			},
+			PageSize:           2,
+			ExpectedResult:     eventDefinitionPage,
+			ExpectedErrMessage: "",
+		},
+		{
+			Name: "Return error when page size is less than 1",
+			RepositoryFn: func() *automock.EventAPIRepository {
+				repo := &automock.EventAPIRepository{}
+				return repo
+			},
+			PageSize:           0,
+			ExpectedResult:     eventDefinitionPage,
+			ExpectedErrMessage: "page size must be between 1 and 200",
+		},
+		{
+			Name: "Return error when page size is bigger than 200",
+			RepositoryFn: func() *automock.EventAPIRepository {
+				repo := &automock.EventAPIRepository{}
+				return repo
+			},
+			PageSize:           201,
+			ExpectedResult:     eventDefinitionPage,
+			ExpectedErrMessage: "page size must be between 1 and 200",
+		},
+		{
+			Name: "Returns error when EventDefinition listing failed",
+			RepositoryFn: func() *automock.EventAPIRepository {
+				repo := &automock.EventAPIRepository{}
+				repo.On("List", ctx, tenantID, 2, after).Return(nil, testErr).Once()
+				return repo
+			},
+			PageSize:           2,
+			ExpectedResult:     nil,
+			ExpectedErrMessage: testErr.Error(),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			repo := testCase.RepositoryFn()
+
+			svc := event.NewService(repo, nil, nil)
+
+			// when
+			docs, err := svc.List(ctx, testCase.PageSize, after)
+
+			// then
+			if testCase.ExpectedErrMessage == "" {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.ExpectedResult, docs)
+			} else {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErrMessage)
+			}
+
+			repo.AssertExpectations(t)
+		})
+	}
+	t.Run("Error when tenant not in context", func(t *testing.T) {
+		svc := event.NewService(nil, nil, nil)
+		// WHEN
+		_, err := svc.List(context.TODO(), 5, "")
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "cannot read tenant from context")
+	})
+}
+
 func TestService_ListForBundle(t *testing.T) {
 	// given
 	testErr := errors.New("Test error")
@@ -284,6 +394,116 @@ func TestService_ListForBundle(t *testing.T) {
 	})
 }
 
+func TestService_ListForBundles(t *testing.T) {
+	// given
+	testErr := errors.New("Test error")
+
+	id := "foo"
+	bndlID := "foobar"
+	name := "foo"
+	bundleIDs := []string{bundleID, bndlID}
+
+	eventDefinitionPages := []*model.EventDefinitionPage{
+		{
+			Data:       []*model.EventDefinition{fixEventDefinitionModel(id, bundleID, name)},
+			TotalCount: 1,
+			PageInfo: &pagination.Page{
+				HasNextPage: false,
+				EndCursor:   "end",
+				StartCursor: "start",
+			},
+		},
+		{
+			Data:       nil,
+			TotalCount: 0,
+			PageInfo:   &pagination.Page{},
+		},
+	}
+
+	after := "test"
+
+	ctx := context.TODO()
+	ctx = tenant.SaveToContext(ctx, tenantID, externalTenantID)
+
+	testCases := []struct {
+		Name               string
+		PageSize           int
+		RepositoryFn       func() *automock.EventAPIRepository
+		ExpectedResult     []*model.EventDefinitionPage
+		ExpectedErrMessage string
+	}{
+		{
+			Name: "Success",
+			RepositoryFn: func() *automock.EventAPIRepository {
+				repo := &automock.EventAPIRepository{}
+				repo.On("ListForBundles", ctx, tenantID, bundleIDs, 2, after).Return(eventDefinitionPages, nil).Once()
+				return repo
+			},
+			PageSize:           2,
+			ExpectedResult:     eventDefinitionPages,
+			ExpectedErrMessage: "",
+		},
+		{
+			Name: "Return error when page size is less than 1",
+			RepositoryFn: func() *automock.EventAPIRepository {
+				repo := &automock.EventAPIRepository{}
+				return repo
+			},
+			PageSize:           0,
+			ExpectedErrMessage: "page size must be between 1 and 200",
+		},
+		{
+			Name: "Return error when page size is bigger than 200",
+			RepositoryFn: func() *automock.EventAPIRepository {
+				repo := &automock.EventAPIRepository{}
+				return repo
+			},
+			PageSize:           201,
+			ExpectedErrMessage: "page size must be between 1 and 200",
+		},
+		{
+			Name: "Returns error when EventDefinition listing failed",
+			RepositoryFn: func() *automock.EventAPIRepository {
+				repo := &automock.EventAPIRepository{}
+				repo.On("ListForBundles", ctx, tenantID, bundleIDs, 2, after).Return(nil, testErr).Once()
+				return repo
+			},
+			PageSize:           2,
+			ExpectedErrMessage: testErr.Error(),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			repo := testCase.RepositoryFn()
+
+			svc := event.NewService(repo, nil, nil)
+
+			// when
+			pages, err := svc.ListForBundles(ctx, bundleIDs, testCase.PageSize, after)
+
+			// then
+			if testCase.ExpectedErrMessage == "" {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.ExpectedResult, pages)
+			} else {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErrMessage)
+			}
+
+			repo.AssertExpectations(t)
+		})
+	}
+	t.Run("Error when tenant not in context", func(t *testing.T) {
+		svc := event.NewService(nil, nil, nil)
+		// WHEN
+		_, err := svc.ListForBundles(context.TODO(), bundleIDs, 5, "")
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "cannot read tenant from context")
+	})
+}
+
 func TestService_ListByApplicationID(t *testing.T) {
 	// given
 	testErr := errors.New("Test error")
@@ -10,6 +10,8 @@ import (
 	mock "github.com/stretchr/testify/mock"
 
 	model "github.com/kyma-incubator/compass/components/director/internal/model"
+
+	pagination "github.com/kyma-incubator/compass/components/director/pkg/pagination"
 )
 
 // RuntimeRepository is an autogenerated mock type for the RuntimeRepository type
@@ -64,12 +66,12 @@ func (_m *RuntimeRepository) GetOldestForFilters(ctx context.Context, tenant str
 }
 
 // List provides a mock function with given fields: ctx, tenant, filter, pageSize, cursor
-func (_m *RuntimeRepository) List(ctx context.Context, tenant string, filter []*labelfilter.LabelFilter, pageSize int, cursor string) (*model.RuntimePage, error) {
-	ret := _m.Called(ctx, tenant, filter, pageSize, cursor)
+func (_m *RuntimeRepository) List(ctx context.Context, tenant string, filter []*labelfilter.LabelFilter, page pagination.Request, orderBy model.RuntimeOrderBy) (*model.RuntimePage, error) {
+	ret := _m.Called(ctx, tenant, filter, page, orderBy)
 
 	var r0 *model.RuntimePage
-	if rf, ok := ret.Get(0).(func(context.Context, string, []*labelfilter.LabelFilter, int, string) *model.RuntimePage); ok {
-		r0 = rf(ctx, tenant, filter, pageSize, cursor)
+	if rf, ok := ret.Get(0).(func(context.Context, string, []*labelfilter.LabelFilter, pagination.Request, model.RuntimeOrderBy) *model.RuntimePage); ok {
+		r0 = rf(ctx, tenant, filter, page, orderBy)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*model.RuntimePage)
@@ -77,8 +79,8 @@ func (_m *RuntimeRepository) List(ctx context.Context, tenant string, filter []*
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, string, []*labelfilter.LabelFilter, int, string) error); ok {
-		r1 = rf(ctx, tenant, filter, pageSize, cursor)
+	if rf, ok := ret.Get(1).(func(context.Context, string, []*labelfilter.LabelFilter, pagination.Request, model.RuntimeOrderBy) error); ok {
+		r1 = rf(ctx, tenant, filter, page, orderBy)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -16,6 +16,7 @@ import (
 	"github.com/kyma-incubator/compass/components/director/internal/domain/tenant"
 	"github.com/kyma-incubator/compass/components/director/internal/labelfilter"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
 	"github.com/pkg/errors"
 )
 
@@ -30,7 +31,7 @@ const (
 type RuntimeRepository interface {
 	GetByFiltersAndID(ctx context.Context, tenant, id string, filter []*labelfilter.LabelFilter) (*model.Runtime, error)
 	GetOldestForFilters(ctx context.Context, tenant string, filter []*labelfilter.LabelFilter) (*model.Runtime, error)
-	List(ctx context.Context, tenant string, filter []*labelfilter.LabelFilter, pageSize int, cursor string) (*model.RuntimePage, error)
+	List(ctx context.Context, tenant string, filter []*labelfilter.LabelFilter, page pagination.Request, orderBy model.RuntimeOrderBy) (*model.RuntimePage, error)
 }
 
 //go:generate mockery -name=LabelRepository -output=automock -outpkg=automock -case=underscore
@@ -287,8 +288,7 @@ func (s *service) getDefaultRuntimeForAppEventing(ctx context.Context, tenantID
 	labelKey := getDefaultEventingForAppLabelKey(appID)
 	labelFilterForRuntime := []*labelfilter.LabelFilter{labelfilter.NewForKey(labelKey)}
 
-	var cursor string
-	runtimesPage, err := s.runtimeRepo.List(ctx, tenantID, labelFilterForRuntime, 1, cursor)
+	runtimesPage, err := s.runtimeRepo.List(ctx, tenantID, labelFilterForRuntime, pagination.Request{PageSize: 1}, model.DefaultRuntimeOrderBy)
 	if err != nil {
 		return nil, false, errors.Wrap(err, fmt.Sprintf("while fetching runtimes with label [key=%s]", labelKey))
 	}
@@ -13,6 +13,7 @@ import (
 	"github.com/kyma-incubator/compass/components/director/internal/domain/eventing/automock"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
 	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -85,7 +86,7 @@ func Test_SetForApplication(t *testing.T) {
 		app := fixApplicationModel("test-app")
 		runtimeRepo := &automock.RuntimeRepository{}
 		runtimeRepo.On("List", ctx, tenantID.String(), fixLabelFilterForRuntimeDefaultEventingForApp(),
-			1, mock.Anything).Return(fixEmptyRuntimePage(), nil)
+			pagination.Request{PageSize: 1}, model.DefaultRuntimeOrderBy).Return(fixEmptyRuntimePage(), nil)
 		runtimeRepo.On("GetByFiltersAndID", ctx, tenantID.String(), runtimeID.String(),
 			fixLabelFilterForRuntimeScenarios()).Return(fixRuntimes()[0], nil)
 		labelRepo := &automock.LabelRepository{}
@@ -114,7 +115,7 @@ func Test_SetForApplication(t *testing.T) {
 		ctx := fixCtxWithTenant()
 		runtimeRepo := &automock.RuntimeRepository{}
 		runtimeRepo.On("List", ctx, tenantID.String(), fixLabelFilterForRuntimeDefaultEventingForApp(),
-			1, mock.Anything).Return(fixRuntimePageWithOne(), nil)
+			pagination.Request{PageSize: 1}, model.DefaultRuntimeOrderBy).Return(fixRuntimePageWithOne(), nil)
 		runtimeRepo.On("GetByFiltersAndID", ctx, tenantID.String(), runtimeID.String(),
 			fixLabelFilterForRuntimeScenarios()).Return(fixRuntimes()[0], nil)
 		labelRepo := &automock.LabelRepository{}
@@ -146,7 +147,7 @@ func Test_SetForApplication(t *testing.T) {
 		app := fixApplicationModel("test-app")
 		runtimeRepo := &automock.RuntimeRepository{}
 		runtimeRepo.On("List", ctx, tenantID.String(), fixLabelFilterForRuntimeDefaultEventingForApp(),
-			1, mock.Anything).Return(fixEmptyRuntimePage(), nil)
+			pagination.Request{PageSize: 1}, model.DefaultRuntimeOrderBy).Return(fixEmptyRuntimePage(), nil)
 		runtimeRepo.On("GetByFiltersAndID", ctx, tenantID.String(), runtimeID.String(),
 			fixLabelFilterForRuntimeScenarios()).Return(fixRuntimes()[0], nil)
 		labelRepo := &automock.LabelRepository{}
@@ -176,7 +177,7 @@ func Test_SetForApplication(t *testing.T) {
 		app := fixApplicationModel("test-app")
 		runtimeRepo := &automock.RuntimeRepository{}
 		runtimeRepo.On("List", ctx, tenantID.String(), fixLabelFilterForRuntimeDefaultEventingForApp(),
-			1, mock.Anything).Return(fixEmptyRuntimePage(), nil)
+			pagination.Request{PageSize: 1}, model.DefaultRuntimeOrderBy).Return(fixEmptyRuntimePage(), nil)
 		runtimeRepo.On("GetByFiltersAndID", ctx, tenantID.String(), runtimeID.String(),
 			fixLabelFilterForRuntimeScenarios()).Return(fixRuntimes()[0], nil)
 		labelRepo := &automock.LabelRepository{}
@@ -218,7 +219,7 @@ func Test_SetForApplication(t *testing.T) {
 		ctx := fixCtxWithTenant()
 		runtimeRepo := &automock.RuntimeRepository{}
 		runtimeRepo.On("List", ctx, tenantID.String(), fixLabelFilterForRuntimeDefaultEventingForApp(),
-			1, mock.Anything).Return(nil, errors.New("some-error"))
+			pagination.Request{PageSize: 1}, model.DefaultRuntimeOrderBy).Return(nil, errors.New("some-error"))
 		labelRepo := &automock.LabelRepository{}
 
 		svc := NewService(nil, runtimeRepo, labelRepo)
@@ -238,7 +239,7 @@ func Test_SetForApplication(t *testing.T) {
 		ctx := fixCtxWithTenant()
 		runtimeRepo := &automock.RuntimeRepository{}
 		runtimeRepo.On("List", ctx, tenantID.String(), fixLabelFilterForRuntimeDefaultEventingForApp(),
-			1, mock.Anything).Return(fixRuntimePage(), nil)
+			pagination.Request{PageSize: 1}, model.DefaultRuntimeOrderBy).Return(fixRuntimePage(), nil)
 		labelRepo := &automock.LabelRepository{}
 
 		svc := NewService(nil, runtimeRepo, labelRepo)
@@ -258,7 +259,7 @@ func Test_SetForApplication(t *testing.T) {
 		ctx := fixCtxWithTenant()
 		runtimeRepo := &automock.RuntimeRepository{}
 		runtimeRepo.On("List", ctx, tenantID.String(), fixLabelFilterForRuntimeDefaultEventingForApp(),
-			1, mock.Anything).Return(fixRuntimePageWithOne(), nil)
+			pagination.Request{PageSize: 1}, model.DefaultRuntimeOrderBy).Return(fixRuntimePageWithOne(), nil)
 		labelRepo := &automock.LabelRepository{}
 		labelRepo.On("Delete", ctx, tenantID.String(), model.RuntimeLabelableObject, runtimeID.String(),
 			getDefaultEventingForAppLabelKey(applicationID)).Return(errors.New("some-error"))
@@ -280,7 +281,7 @@ func Test_SetForApplication(t *testing.T) {
 		ctx := fixCtxWithTenant()
 		runtimeRepo := &automock.RuntimeRepository{}
 		runtimeRepo.On("List", ctx, tenantID.String(), fixLabelFilterForRuntimeDefaultEventingForApp(),
-			1, mock.Anything).Return(fixRuntimePageWithOne(), nil)
+			pagination.Request{PageSize: 1}, model.DefaultRuntimeOrderBy).Return(fixRuntimePageWithOne(), nil)
 		labelRepo := &automock.LabelRepository{}
 		labelRepo.On("Delete", ctx, tenantID.String(), model.RuntimeLabelableObject, runtimeID.String(),
 			getDefaultEventingForAppLabelKey(applicationID)).Return(nil)
@@ -304,7 +305,7 @@ func Test_SetForApplication(t *testing.T) {
 		ctx := fixCtxWithTenant()
 		runtimeRepo := &automock.RuntimeRepository{}
 		runtimeRepo.On("List", ctx, tenantID.String(), fixLabelFilterForRuntimeDefaultEventingForApp(),
-			1, mock.Anything).Return(fixRuntimePageWithOne(), nil)
+			pagination.Request{PageSize: 1}, model.DefaultRuntimeOrderBy).Return(fixRuntimePageWithOne(), nil)
 		labelRepo := &automock.LabelRepository{}
 		labelRepo.On("GetByKey", ctx, tenantID.String(), model.ApplicationLabelableObject,
 			applicationID.String(), model.ScenariosKey).Return(nil, apperrors.NewNotFoundError(resource.Label, ""))
@@ -328,7 +329,7 @@ func Test_SetForApplication(t *testing.T) {
 		ctx := fixCtxWithTenant()
 		runtimeRepo := &automock.RuntimeRepository{}
 		runtimeRepo.On("List", ctx, tenantID.String(), fixLabelFilterForRuntimeDefaultEventingForApp(),
-			1, mock.Anything).Return(fixRuntimePageWithOne(), nil)
+			pagination.Request{PageSize: 1}, model.DefaultRuntimeOrderBy).Return(fixRuntimePageWithOne(), nil)
 		runtimeRepo.On("GetByFiltersAndID", ctx, tenantID.String(), runtimeID.String(),
 			fixLabelFilterForRuntimeScenarios()).Return(nil, apperrors.NewNotFoundError(resource.Runtime, ""))
 		labelRepo := &automock.LabelRepository{}
@@ -354,7 +355,7 @@ func Test_SetForApplication(t *testing.T) {
 		ctx := fixCtxWithTenant()
 		runtimeRepo := &automock.RuntimeRepository{}
 		runtimeRepo.On("List", ctx, tenantID.String(), fixLabelFilterForRuntimeDefaultEventingForApp(),
-			1, mock.Anything).Return(fixRuntimePageWithOne(), nil)
+			pagination.Request{PageSize: 1}, model.DefaultRuntimeOrderBy).Return(fixRuntimePageWithOne(), nil)
 		runtimeRepo.On("GetByFiltersAndID", ctx, tenantID.String(), runtimeID.String(),
 			fixLabelFilterForRuntimeScenarios()).Return(nil, errors.New("some-error"))
 		labelRepo := &automock.LabelRepository{}
@@ -380,7 +381,7 @@ func Test_SetForApplication(t *testing.T) {
 		ctx := fixCtxWithTenant()
 		runtimeRepo := &automock.RuntimeRepository{}
 		runtimeRepo.On("List", ctx, tenantID.String(), fixLabelFilterForRuntimeDefaultEventingForApp(),
-			1, mock.Anything).Return(fixRuntimePageWithOne(), nil)
+			pagination.Request{PageSize: 1}, model.DefaultRuntimeOrderBy).Return(fixRuntimePageWithOne(), nil)
 		runtimeRepo.On("GetByFiltersAndID", ctx, tenantID.String(), runtimeID.String(),
 			fixLabelFilterForRuntimeScenarios()).Return(fixRuntimes()[0], nil)
 		labelRepo := &automock.LabelRepository{}
@@ -407,7 +408,7 @@ func Test_SetForApplication(t *testing.T) {
 		ctx := fixCtxWithTenant()
 		runtimeRepo := &automock.RuntimeRepository{}
 		runtimeRepo.On("List", ctx, tenantID.String(), fixLabelFilterForRuntimeDefaultEventingForApp(),
-			1, mock.Anything).Return(fixRuntimePageWithOne(), nil)
+			pagination.Request{PageSize: 1}, model.DefaultRuntimeOrderBy).Return(fixRuntimePageWithOne(), nil)
 		runtimeRepo.On("GetByFiltersAndID", ctx, tenantID.String(), runtimeID.String(),
 			fixLabelFilterForRuntimeScenarios()).Return(fixRuntimes()[0], nil)
 		labelRepo := &automock.LabelRepository{}
@@ -437,7 +438,7 @@ func Test_SetForApplication(t *testing.T) {
 		app := fixApplicationModel("test-app")
 		runtimeRepo := &automock.RuntimeRepository{}
 		runtimeRepo.On("List", ctx, tenantID.String(), fixLabelFilterForRuntimeDefaultEventingForApp(),
-			1, mock.Anything).Return(fixEmptyRuntimePage(), nil)
+			pagination.Request{PageSize: 1}, model.DefaultRuntimeOrderBy).Return(fixEmptyRuntimePage(), nil)
 		runtimeRepo.On("GetByFiltersAndID", ctx, tenantID.String(), runtimeID.String(),
 			fixLabelFilterForRuntimeScenarios()).Return(fixRuntimes()[0], nil)
 		labelRepo := &automock.LabelRepository{}
@@ -471,7 +472,7 @@ func Test_UnsetForApplication(t *testing.T) {
 		ctx := fixCtxWithTenant()
 		runtimeRepo := &automock.RuntimeRepository{}
 		runtimeRepo.On("List", ctx, tenantID.String(), fixLabelFilterForRuntimeDefaultEventingForApp(),
-			1, mock.Anything).Return(fixEmptyRuntimePage(), nil)
+			pagination.Request{PageSize: 1}, model.DefaultRuntimeOrderBy).Return(fixEmptyRuntimePage(), nil)
 
 		svc := NewService(nil, runtimeRepo, nil)
 
@@ -490,7 +491,7 @@ func Test_UnsetForApplication(t *testing.T) {
 		ctx := fixCtxWithTenant()
 		runtimeRepo := &automock.RuntimeRepository{}
 		runtimeRepo.On("List", ctx, tenantID.String(), fixLabelFilterForRuntimeDefaultEventingForApp(),
-			1, mock.Anything).Return(fixRuntimePageWithOne(), nil)
+			pagination.Request{PageSize: 1}, model.DefaultRuntimeOrderBy).Return(fixRuntimePageWithOne(), nil)
 		labelRepo := &automock.LabelRepository{}
 		labelRepo.On("GetByKey", ctx, tenantID.String(), model.RuntimeLabelableObject,
 			runtimeID.String(), RuntimeEventingURLLabel).Return(fixRuntimeEventingURLLabel(), nil)
@@ -516,7 +517,7 @@ func Test_UnsetForApplication(t *testing.T) {
 		ctx := fixCtxWithTenant()
 		runtimeRepo := &automock.RuntimeRepository{}
 		runtimeRepo.On("List", ctx, tenantID.String(), fixLabelFilterForRuntimeDefaultEventingForApp(),
-			1, mock.Anything).Return(fixRuntimePageWithOne(), nil)
+			pagination.Request{PageSize: 1}, model.DefaultRuntimeOrderBy).Return(fixRuntimePageWithOne(), nil)
 		labelRepo := &automock.LabelRepository{}
 		labelRepo.On("GetByKey", ctx, tenantID.String(), model.RuntimeLabelableObject,
 			runtimeID.String(), RuntimeEventingURLLabel).Return(fixRuntimeEventingURLLabel(), nil)
@@ -542,7 +543,7 @@ func Test_UnsetForApplication(t *testing.T) {
 		ctx := fixCtxWithTenant()
 		runtimeRepo := &automock.RuntimeRepository{}
 		runtimeRepo.On("List", ctx, tenantID.String(), fixLabelFilterForRuntimeDefaultEventingForApp(),
-			1, mock.Anything).Return(fixRuntimePageWithOne(), nil)
+			pagination.Request{PageSize: 1}, model.DefaultRuntimeOrderBy).Return(fixRuntimePageWithOne(), nil)
 		labelRepo := &automock.LabelRepository{}
 		labelRepo.On("GetByKey", ctx, tenantID.String(), model.RuntimeLabelableObject,
 			runtimeID.String(), RuntimeEventingURLLabel).Return(fixRuntimeEventingURLLabel(), nil)
@@ -581,7 +582,7 @@ func Test_UnsetForApplication(t *testing.T) {
 		ctx := fixCtxWithTenant()
 		runtimeRepo := &automock.RuntimeRepository{}
 		runtimeRepo.On("List", ctx, tenantID.String(), fixLabelFilterForRuntimeDefaultEventingForApp(),
-			1, mock.Anything).Return(nil, errors.New("some-error"))
+			pagination.Request{PageSize: 1}, model.DefaultRuntimeOrderBy).Return(nil, errors.New("some-error"))
 
 		svc := NewService(nil, runtimeRepo, nil)
 
@@ -600,7 +601,7 @@ func Test_UnsetForApplication(t *testing.T) {
 		ctx := fixCtxWithTenant()
 		runtimeRepo := &automock.RuntimeRepository{}
 		runtimeRepo.On("List", ctx, tenantID.String(), fixLabelFilterForRuntimeDefaultEventingForApp(),
-			1, mock.Anything).Return(fixRuntimePage(), nil)
+			pagination.Request{PageSize: 1}, model.DefaultRuntimeOrderBy).Return(fixRuntimePage(), nil)
 
 		svc := NewService(nil, runtimeRepo, nil)
 
@@ -619,7 +620,7 @@ func Test_UnsetForApplication(t *testing.T) {
 		ctx := fixCtxWithTenant()
 		runtimeRepo := &automock.RuntimeRepository{}
 		runtimeRepo.On("List", ctx, tenantID.String(), fixLabelFilterForRuntimeDefaultEventingForApp(),
-			1, mock.Anything).Return(fixRuntimePageWithOne(), nil)
+			pagination.Request{PageSize: 1}, model.DefaultRuntimeOrderBy).Return(fixRuntimePageWithOne(), nil)
 		labelRepo := &automock.LabelRepository{}
 		labelRepo.On("Delete", ctx, tenantID.String(), model.RuntimeLabelableObject, runtimeID.String(),
 			getDefaultEventingForAppLabelKey(applicationID)).Return(errors.New("some-error"))
@@ -641,7 +642,7 @@ func Test_UnsetForApplication(t *testing.T) {
 		ctx := fixCtxWithTenant()
 		runtimeRepo := &automock.RuntimeRepository{}
 		runtimeRepo.On("List", ctx, tenantID.String(), fixLabelFilterForRuntimeDefaultEventingForApp(),
-			1, mock.Anything).Return(fixRuntimePageWithOne(), nil)
+			pagination.Request{PageSize: 1}, model.DefaultRuntimeOrderBy).Return(fixRuntimePageWithOne(), nil)
 		labelRepo := &automock.LabelRepository{}
 		labelRepo.On("GetByKey", ctx, tenantID.String(), model.RuntimeLabelableObject,
 			runtimeID.String(), RuntimeEventingURLLabel).Return(nil, errors.New("some error"))
@@ -665,7 +666,7 @@ func Test_UnsetForApplication(t *testing.T) {
 		ctx := fixCtxWithTenant()
 		runtimeRepo := &automock.RuntimeRepository{}
 		runtimeRepo.On("List", ctx, tenantID.String(), fixLabelFilterForRuntimeDefaultEventingForApp(),
-			1, mock.Anything).Return(fixRuntimePageWithOne(), nil)
+			pagination.Request{PageSize: 1}, model.DefaultRuntimeOrderBy).Return(fixRuntimePageWithOne(), nil)
 		labelRepo := &automock.LabelRepository{}
 		labelRepo.On("GetByKey", ctx, tenantID.String(), model.RuntimeLabelableObject,
 			runtimeID.String(), RuntimeEventingURLLabel).Return(fixRuntimeEventingURLLabel(), nil)
@@ -695,7 +696,7 @@ func Test_GetForApplication(t *testing.T) {
 		ctx := fixCtxWithTenant()
 		runtimeRepo := &automock.RuntimeRepository{}
 		runtimeRepo.On("List", ctx, tenantID.String(), fixLabelFilterForRuntimeDefaultEventingForApp(),
-			1, mock.Anything).Return(fixRuntimePageWithOne(), nil)
+			pagination.Request{PageSize: 1}, model.DefaultRuntimeOrderBy).Return(fixRuntimePageWithOne(), nil)
 		runtimeRepo.On("GetByFiltersAndID", ctx, tenantID.String(), runtimeID.String(),
 			fixLabelFilterForRuntimeScenarios()).Return(fixRuntimes()[0], nil)
 		labelRepo := &automock.LabelRepository{}
@@ -723,7 +724,7 @@ func Test_GetForApplication(t *testing.T) {
 		ctx := fixCtxWithTenant()
 		runtimeRepo := &automock.RuntimeRepository{}
 		runtimeRepo.On("List", ctx, tenantID.String(), fixLabelFilterForRuntimeDefaultEventingForApp(),
-			1, mock.Anything).Return(fixEmptyRuntimePage(), nil)
+			pagination.Request{PageSize: 1}, model.DefaultRuntimeOrderBy).Return(fixEmptyRuntimePage(), nil)
 		runtimeRepo.On("GetOldestForFilters", ctx, tenantID.String(), fixLabelFilterForRuntimeScenarios()).
 			Return(fixRuntimes()[0], nil)
 		labelRepo := &automock.LabelRepository{}
@@ -751,7 +752,7 @@ func Test_GetForApplication(t *testing.T) {
 		ctx := fixCtxWithTenant()
 		runtimeRepo := &automock.RuntimeRepository{}
 		runtimeRepo.On("List", ctx, tenantID.String(), fixLabelFilterForRuntimeDefaultEventingForApp(),
-			1, mock.Anything).Return(fixRuntimePageWithOne(), nil)
+			pagination.Request{PageSize: 1}, model.DefaultRuntimeOrderBy).Return(fixRuntimePageWithOne(), nil)
 		runtimeRepo.On("GetByFiltersAndID", ctx, tenantID.String(), runtimeID.String(),
 			fixLabelFilterForRuntimeScenarios()).Return(fixRuntimes()[0], nil)
 		labelRepo := &automock.LabelRepository{}
@@ -779,7 +780,7 @@ func Test_GetForApplication(t *testing.T) {
 		ctx := fixCtxWithTenant()
 		runtimeRepo := &automock.RuntimeRepository{}
 		runtimeRepo.On("List", ctx, tenantID.String(), fixLabelFilterForRuntimeDefaultEventingForApp(),
-			1, mock.Anything).Return(fixRuntimePageWithOne(), nil)
+			pagination.Request{PageSize: 1}, model.DefaultRuntimeOrderBy).Return(fixRuntimePageWithOne(), nil)
 		runtimeRepo.On("GetByFiltersAndID", ctx, tenantID.String(), runtimeID.String(),
 			fixLabelFilterForRuntimeScenarios()).Return(fixRuntimes()[0], nil)
 		labelRepo := &automock.LabelRepository{}
@@ -807,7 +808,7 @@ func Test_GetForApplication(t *testing.T) {
 		ctx := fixCtxWithTenant()
 		runtimeRepo := &automock.RuntimeRepository{}
 		runtimeRepo.On("List", ctx, tenantID.String(), fixLabelFilterForRuntimeDefaultEventingForApp(),
-			1, mock.Anything).Return(fixEmptyRuntimePage(), nil)
+			pagination.Request{PageSize: 1}, model.DefaultRuntimeOrderBy).Return(fixEmptyRuntimePage(), nil)
 		runtimeRepo.On("GetOldestForFilters", ctx, tenantID.String(), fixLabelFilterForRuntimeScenarios()).
 			Return(nil, apperrors.NewNotFoundError(resource.Runtime, ""))
 		labelRepo := &automock.LabelRepository{}
@@ -831,7 +832,7 @@ func Test_GetForApplication(t *testing.T) {
 		ctx := fixCtxWithTenant()
 		runtimeRepo := &automock.RuntimeRepository{}
 		runtimeRepo.On("List", ctx, tenantID.String(), fixLabelFilterForRuntimeDefaultEventingForApp(),
-			1, mock.Anything).Return(fixEmptyRuntimePage(), nil)
+			pagination.Request{PageSize: 1}, model.DefaultRuntimeOrderBy).Return(fixEmptyRuntimePage(), nil)
 		labelRepo := &automock.LabelRepository{}
 		labelRepo.On("GetByKey", ctx, tenantID.String(), model.ApplicationLabelableObject,
 			applicationID.String(), model.ScenariosKey).Return(nil, apperrors.NewNotFoundError(resource.Label, ""))
@@ -852,7 +853,7 @@ func Test_GetForApplication(t *testing.T) {
 		ctx := fixCtxWithTenant()
 		runtimeRepo := &automock.RuntimeRepository{}
 		runtimeRepo.On("List", ctx, tenantID.String(), fixLabelFilterForRuntimeDefaultEventingForApp(),
-			1, mock.Anything).Return(fixRuntimePageWithOne(), nil)
+			pagination.Request{PageSize: 1}, model.DefaultRuntimeOrderBy).Return(fixRuntimePageWithOne(), nil)
 		runtimeRepo.On("GetByFiltersAndID", ctx, tenantID.String(), runtimeID.String(),
 			fixLabelFilterForRuntimeScenarios()).Return(nil, apperrors.NewNotFoundError(resource.Runtime, ""))
 		runtimeRepo.On("GetOldestForFilters", ctx, tenantID.String(), fixLabelFilterForRuntimeScenarios()).
@@ -893,7 +894,7 @@ func Test_GetForApplication(t *testing.T) {
 		ctx := fixCtxWithTenant()
 		runtimeRepo := &automock.RuntimeRepository{}
 		runtimeRepo.On("List", ctx, tenantID.String(), fixLabelFilterForRuntimeDefaultEventingForApp(),
-			1, mock.Anything).Return(fixEmptyRuntimePage(), nil)
+			pagination.Request{PageSize: 1}, model.DefaultRuntimeOrderBy).Return(fixEmptyRuntimePage(), nil)
 		runtimeRepo.On("GetOldestForFilters", ctx, tenantID.String(), fixLabelFilterForRuntimeScenarios()).
 			Return(fixRuntimes()[0], nil)
 		labelRepo := &automock.LabelRepository{}
@@ -919,7 +920,7 @@ func Test_GetForApplication(t *testing.T) {
 		ctx := fixCtxWithTenant()
 		runtimeRepo := &automock.RuntimeRepository{}
 		runtimeRepo.On("List", ctx, tenantID.String(), fixLabelFilterForRuntimeDefaultEventingForApp(),
-			1, mock.Anything).Return(fixEmptyRuntimePage(), nil)
+			pagination.Request{PageSize: 1}, model.DefaultRuntimeOrderBy).Return(fixEmptyRuntimePage(), nil)
 		runtimeRepo.On("GetOldestForFilters", ctx, tenantID.String(), fixLabelFilterForRuntimeScenarios()).
 			Return(nil, errors.New("some error"))
 		labelRepo := &automock.LabelRepository{}
@@ -942,7 +943,7 @@ func Test_GetForApplication(t *testing.T) {
 		ctx := fixCtxWithTenant()
 		runtimeRepo := &automock.RuntimeRepository{}
 		runtimeRepo.On("List", ctx, tenantID.String(), fixLabelFilterForRuntimeDefaultEventingForApp(),
-			1, mock.Anything).Return(fixEmptyRuntimePage(), nil)
+			pagination.Request{PageSize: 1}, model.DefaultRuntimeOrderBy).Return(fixEmptyRuntimePage(), nil)
 		labelRepo := &automock.LabelRepository{}
 		scenariosLabel := fixApplicationScenariosLabel()
 		scenariosLabel.Value = "abc"
@@ -965,7 +966,7 @@ func Test_GetForApplication(t *testing.T) {
 		ctx := fixCtxWithTenant()
 		runtimeRepo := &automock.RuntimeRepository{}
 		runtimeRepo.On("List", ctx, tenantID.String(), fixLabelFilterForRuntimeDefaultEventingForApp(),
-			1, mock.Anything).Return(fixEmptyRuntimePage(), nil)
+			pagination.Request{PageSize: 1}, model.DefaultRuntimeOrderBy).Return(fixEmptyRuntimePage(), nil)
 		labelRepo := &automock.LabelRepository{}
 		labelRepo.On("GetByKey", ctx, tenantID.String(), model.ApplicationLabelableObject,
 			applicationID.String(), model.ScenariosKey).Return(nil, errors.New("some error"))
@@ -986,7 +987,7 @@ func Test_GetForApplication(t *testing.T) {
 		ctx := fixCtxWithTenant()
 		runtimeRepo := &automock.RuntimeRepository{}
 		runtimeRepo.On("List", ctx, tenantID.String(), fixLabelFilterForRuntimeDefaultEventingForApp(),
-			1, mock.Anything).Return(nil, errors.New("some error"))
+			pagination.Request{PageSize: 1}, model.DefaultRuntimeOrderBy).Return(nil, errors.New("some error"))
 		svc := NewService(nil, runtimeRepo, nil)
 
 		// WHEN
@@ -1004,7 +1005,7 @@ func Test_GetForApplication(t *testing.T) {
 		ctx := fixCtxWithTenant()
 		runtimeRepo := &automock.RuntimeRepository{}
 		runtimeRepo.On("List", ctx, tenantID.String(), fixLabelFilterForRuntimeDefaultEventingForApp(),
-			1, mock.Anything).Return(fixRuntimePage(), nil)
+			pagination.Request{PageSize: 1}, model.DefaultRuntimeOrderBy).Return(fixRuntimePage(), nil)
 		labelRepo := &automock.LabelRepository{}
 
 		svc := NewService(nil, runtimeRepo, labelRepo)
@@ -1024,7 +1025,7 @@ func Test_GetForApplication(t *testing.T) {
 		ctx := fixCtxWithTenant()
 		runtimeRepo := &automock.RuntimeRepository{}
 		runtimeRepo.On("List", ctx, tenantID.String(), fixLabelFilterForRuntimeDefaultEventingForApp(),
-			1, mock.Anything).Return(fixRuntimePageWithOne(), nil)
+			pagination.Request{PageSize: 1}, model.DefaultRuntimeOrderBy).Return(fixRuntimePageWithOne(), nil)
 		labelRepo := &automock.LabelRepository{}
 		labelRepo.On("GetByKey", ctx, tenantID.String(), model.ApplicationLabelableObject,
 			applicationID.String(), model.ScenariosKey).Return(nil, errors.New("some error"))
@@ -1046,7 +1047,7 @@ func Test_GetForApplication(t *testing.T) {
 		ctx := fixCtxWithTenant()
 		runtimeRepo := &automock.RuntimeRepository{}
 		runtimeRepo.On("List", ctx, tenantID.String(), fixLabelFilterForRuntimeDefaultEventingForApp(),
-			1, mock.Anything).Return(fixRuntimePageWithOne(), nil)
+			pagination.Request{PageSize: 1}, model.DefaultRuntimeOrderBy).Return(fixRuntimePageWithOne(), nil)
 		labelRepo := &automock.LabelRepository{}
 		labelRepo.On("GetByKey", ctx, tenantID.String(), model.ApplicationLabelableObject,
 			applicationID.String(), model.ScenariosKey).Return(nil, apperrors.NewNotFoundError(resource.Label, ""))
@@ -1068,7 +1069,7 @@ func Test_GetForApplication(t *testing.T) {
 		ctx := fixCtxWithTenant()
 		runtimeRepo := &automock.RuntimeRepository{}
 		runtimeRepo.On("List", ctx, tenantID.String(), fixLabelFilterForRuntimeDefaultEventingForApp(),
-			1, mock.Anything).Return(fixRuntimePageWithOne(), nil)
+			pagination.Request{PageSize: 1}, model.DefaultRuntimeOrderBy).Return(fixRuntimePageWithOne(), nil)
 		runtimeRepo.On("GetByFiltersAndID", ctx, tenantID.String(), runtimeID.String(),
 			fixLabelFilterForRuntimeScenarios()).Return(nil, errors.New("some-error"))
 		labelRepo := &automock.LabelRepository{}
@@ -1092,7 +1093,7 @@ func Test_GetForApplication(t *testing.T) {
 		ctx := fixCtxWithTenant()
 		runtimeRepo := &automock.RuntimeRepository{}
 		runtimeRepo.On("List", ctx, tenantID.String(), fixLabelFilterForRuntimeDefaultEventingForApp(),
-			1, mock.Anything).Return(fixRuntimePageWithOne(), nil)
+			pagination.Request{PageSize: 1}, model.DefaultRuntimeOrderBy).Return(fixRuntimePageWithOne(), nil)
 		runtimeRepo.On("GetByFiltersAndID", ctx, tenantID.String(), runtimeID.String(),
 			fixLabelFilterForRuntimeScenarios()).Return(nil, apperrors.NewNotFoundError(resource.Runtime, ""))
 		labelRepo := &automock.LabelRepository{}
@@ -1118,7 +1119,7 @@ func Test_GetForApplication(t *testing.T) {
 		ctx := fixCtxWithTenant()
 		runtimeRepo := &automock.RuntimeRepository{}
 		runtimeRepo.On("List", ctx, tenantID.String(), fixLabelFilterForRuntimeDefaultEventingForApp(),
-			1, mock.Anything).Return(fixRuntimePageWithOne(), nil)
+			pagination.Request{PageSize: 1}, model.DefaultRuntimeOrderBy).Return(fixRuntimePageWithOne(), nil)
 		runtimeRepo.On("GetByFiltersAndID", ctx, tenantID.String(), runtimeID.String(),
 			fixLabelFilterForRuntimeScenarios()).Return(fixRuntimes()[0], nil)
 		labelRepo := &automock.LabelRepository{}
@@ -1144,7 +1145,7 @@ func Test_GetForApplication(t *testing.T) {
 		ctx := fixCtxWithTenant()
 		runtimeRepo := &automock.RuntimeRepository{}
 		runtimeRepo.On("List", ctx, tenantID.String(), fixLabelFilterForRuntimeDefaultEventingForApp(),
-			1, mock.Anything).Return(fixRuntimePageWithOne(), nil)
+			pagination.Request{PageSize: 1}, model.DefaultRuntimeOrderBy).Return(fixRuntimePageWithOne(), nil)
 		runtimeRepo.On("GetByFiltersAndID", ctx, tenantID.String(), runtimeID.String(),
 			fixLabelFilterForRuntimeScenarios()).Return(fixRuntimes()[0], nil)
 		labelRepo := &automock.LabelRepository{}
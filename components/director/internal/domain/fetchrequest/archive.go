@@ -0,0 +1,203 @@
+package fetchrequest
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"io"
+	"io/ioutil"
+	"path"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// maxSpecSize limits the size of a single Spec extracted from a bundle, so that a malicious archive cannot exhaust the memory
+	maxSpecSize = 10 << 20
+	// maxArchiveEntries and maxArchiveSize limit the number of entries and the uncompressed size of a bundle,
+	// so that a malicious archive cannot keep the director busy decompressing it
+	maxArchiveEntries = 1000
+	maxArchiveSize    = 100 << 20
+)
+
+var (
+	zipMagic  = []byte("PK\x03\x04")
+	gzipMagic = []byte{0x1f, 0x8b}
+)
+
+type archiveFile struct {
+	name string
+	open func() (io.ReadCloser, error)
+}
+
+// extractFromArchive returns the content of the file in the zip or tar.gz archive whose path matches the filter.
+// The filter is a path.Match pattern and can be omitted if the archive contains a single file.
+func extractFromArchive(archive []byte, filter *string) ([]byte, error) {
+	var files []archiveFile
+	var err error
+	switch {
+	case bytes.HasPrefix(archive, zipMagic):
+		files, err = zipFiles(archive)
+	case bytes.HasPrefix(archive, gzipMagic):
+		files, err = tarGzFiles(archive, filter)
+	default:
+		return nil, errors.New("unsupported bundle format, expected zip or tar.gz archive")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := selectFile(files, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	reader, err := file.open()
+	if err != nil {
+		return nil, errors.Wrapf(err, "while opening %q", file.name)
+	}
+	defer func() {
+		_ = reader.Close()
+	}()
+
+	return readLimited(reader, file.name)
+}
+
+func selectFile(files []archiveFile, filter *string) (archiveFile, error) {
+	if filter == nil {
+		if len(files) != 1 {
+			return archiveFile{}, errors.Errorf("bundle contains %d files, filter is required to select the Spec", len(files))
+		}
+		return files[0], nil
+	}
+
+	var matches []archiveFile
+	for _, file := range files {
+		matched, err := matchesFilter(file.name, filter)
+		if err != nil {
+			return archiveFile{}, err
+		}
+		if matched {
+			matches = append(matches, file)
+		}
+	}
+
+	if len(matches) != 1 {
+		return archiveFile{}, errors.Errorf("filter %q matches %d files in the bundle, expected exactly one", *filter, len(matches))
+	}
+	return matches[0], nil
+}
+
+func zipFiles(archive []byte) ([]archiveFile, error) {
+	reader, err := zip.NewReader(bytes.NewReader(archive), int64(len(archive)))
+	if err != nil {
+		return nil, errors.Wrap(err, "while reading zip archive")
+	}
+
+	if len(reader.File) > maxArchiveEntries {
+		return nil, errors.Errorf("bundle exceeds the maximum of %d entries", maxArchiveEntries)
+	}
+
+	var files []archiveFile
+	var size uint64
+	for _, f := range reader.File {
+		if f.FileInfo().IsDir() {
+			continue
+		}
+		size += f.UncompressedSize64
+		if size > maxArchiveSize {
+			return nil, errors.Errorf("bundle exceeds the maximum uncompressed size of %d bytes", maxArchiveSize)
+		}
+		files = append(files, archiveFile{name: cleanArchivePath(f.Name), open: f.Open})
+	}
+	return files, nil
+}
+
+// tarGzFiles lists the regular files of a tar.gz archive. Tar archives can only be read sequentially, so the content
+// of the files matching the filter is kept in memory while the other files are listed by name only.
+func tarGzFiles(archive []byte, filter *string) ([]archiveFile, error) {
+	gzipReader, err := gzip.NewReader(bytes.NewReader(archive))
+	if err != nil {
+		return nil, errors.Wrap(err, "while reading gzip archive")
+	}
+	defer func() {
+		_ = gzipReader.Close()
+	}()
+
+	// skipping an entry still decompresses it, so the limit applies to everything read from the archive
+	limitedReader := &io.LimitedReader{R: gzipReader, N: maxArchiveSize + 1}
+	tarReader := tar.NewReader(limitedReader)
+
+	var files []archiveFile
+	for entries := 0; ; entries++ {
+		header, err := tarReader.Next()
+		if limitedReader.N <= 0 {
+			return nil, errors.Errorf("bundle exceeds the maximum uncompressed size of %d bytes", maxArchiveSize)
+		}
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, "while reading tar archive")
+		}
+		if entries >= maxArchiveEntries {
+			return nil, errors.Errorf("bundle exceeds the maximum of %d entries", maxArchiveEntries)
+		}
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+
+		name := cleanArchivePath(header.Name)
+		file := archiveFile{
+			name: name,
+			open: func() (io.ReadCloser, error) {
+				return nil, errors.Errorf("content of %q was not read as it does not match the filter", name)
+			},
+		}
+
+		// without a filter the bundle must contain a single file, so only the first one needs to be read
+		read := filter == nil && len(files) == 0
+		if filter != nil {
+			if read, err = matchesFilter(name, filter); err != nil {
+				return nil, err
+			}
+		}
+		if read {
+			content, err := readLimited(tarReader, name)
+			if err != nil {
+				return nil, err
+			}
+			file.open = func() (io.ReadCloser, error) {
+				return ioutil.NopCloser(bytes.NewReader(content)), nil
+			}
+		}
+
+		files = append(files, file)
+	}
+	return files, nil
+}
+
+func matchesFilter(name string, filter *string) (bool, error) {
+	matched, err := path.Match(*filter, name)
+	if err != nil {
+		return false, errors.Wrapf(err, "invalid filter %q", *filter)
+	}
+	return matched, nil
+}
+
+func readLimited(reader io.Reader, name string) ([]byte, error) {
+	content, err := ioutil.ReadAll(io.LimitReader(reader, maxSpecSize+1))
+	if err != nil {
+		return nil, errors.Wrapf(err, "while reading %q", name)
+	}
+	if len(content) > maxSpecSize {
+		return nil, errors.Errorf("%q exceeds the maximum Spec size of %d bytes", name, maxSpecSize)
+	}
+	return content, nil
+}
+
+func cleanArchivePath(name string) string {
+	return strings.TrimPrefix(path.Clean("/"+name), "/")
+}
@@ -105,6 +105,8 @@ func (c *converter) ToEntity(in model.FetchRequest) (Entity, error) {
 		StatusCondition: string(in.Status.Condition),
 		StatusMessage:   message,
 		StatusTimestamp: in.Status.Timestamp,
+		SpecHash:        repo.NewNullableString(in.SpecHash),
+		SpecChangedAt:   repo.NewNullableTime(in.SpecChangedAt),
 	}, nil
 }
 
@@ -129,10 +131,12 @@ func (c *converter) FromEntity(in Entity) (model.FetchRequest, error) {
 			Message:   repo.StringPtrFromNullableString(in.StatusMessage),
 			Condition: model.FetchRequestStatusCondition(in.StatusCondition),
 		},
-		URL:    in.URL,
-		Mode:   model.FetchMode(in.Mode),
-		Filter: repo.StringPtrFromNullableString(in.Filter),
-		Auth:   auth,
+		URL:           in.URL,
+		Mode:          model.FetchMode(in.Mode),
+		Filter:        repo.StringPtrFromNullableString(in.Filter),
+		Auth:          auth,
+		SpecHash:      repo.StringPtrFromNullableString(in.SpecHash),
+		SpecChangedAt: repo.TimePtrFromNullableTime(in.SpecChangedAt),
 	}, nil
 }
 
@@ -17,4 +17,12 @@ type Entity struct {
 	StatusCondition string         `db:"status_condition"`
 	StatusMessage   sql.NullString `db:"status_message"`
 	StatusTimestamp time.Time      `db:"status_timestamp"`
+	SpecHash        sql.NullString `db:"spec_hash"`
+	SpecChangedAt   sql.NullTime   `db:"spec_changed_at"`
+}
+
+type EntityCollection []Entity
+
+func (r EntityCollection) Len() int {
+	return len(r)
 }
@@ -1,12 +1,17 @@
 package fetchrequest_test
 
 import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
 	"database/sql"
 	"encoding/json"
 	"testing"
 	"time"
 
 	"github.com/kyma-incubator/compass/components/director/internal/domain/fetchrequest"
+	"github.com/kyma-incubator/compass/components/director/pkg/str"
 
 	"github.com/kyma-incubator/compass/components/director/internal/model"
 	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
@@ -87,8 +92,10 @@ func fixFullFetchRequestModel(id string, timestamp time.Time) model.FetchRequest
 				},
 			},
 		},
-		ObjectType: model.DocumentFetchRequestReference,
-		ObjectID:   "documentID",
+		ObjectType:    model.DocumentFetchRequestReference,
+		ObjectID:      "documentID",
+		SpecHash:      str.Ptr("hash"),
+		SpecChangedAt: &timestamp,
 	}
 }
 
@@ -126,6 +133,14 @@ func fixFullFetchRequestEntity(t *testing.T, id string, timestamp time.Time) fet
 			Valid:  true,
 			String: "documentID",
 		},
+		SpecHash: sql.NullString{
+			Valid:  true,
+			String: "hash",
+		},
+		SpecChangedAt: sql.NullTime{
+			Valid: true,
+			Time:  timestamp,
+		},
 	}
 }
 
@@ -164,3 +179,36 @@ func fixFetchRequestEntityWithReferences(id string, timestamp time.Time, specID,
 		DocumentID:      documentID,
 	}
 }
+
+func fixZipArchive(t *testing.T, files map[string]string) []byte {
+	buf := &bytes.Buffer{}
+	writer := zip.NewWriter(buf)
+	for name, content := range files {
+		f, err := writer.Create(name)
+		require.NoError(t, err)
+		_, err = f.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, writer.Close())
+	return buf.Bytes()
+}
+
+func fixTarGzArchive(t *testing.T, files map[string]string) []byte {
+	buf := &bytes.Buffer{}
+	gzipWriter := gzip.NewWriter(buf)
+	tarWriter := tar.NewWriter(gzipWriter)
+	for name, content := range files {
+		err := tarWriter.WriteHeader(&tar.Header{
+			Name:     name,
+			Mode:     0600,
+			Size:     int64(len(content)),
+			Typeflag: tar.TypeReg,
+		})
+		require.NoError(t, err)
+		_, err = tarWriter.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, tarWriter.Close())
+	require.NoError(t, gzipWriter.Close())
+	return buf.Bytes()
+}
@@ -0,0 +1,63 @@
+package fetchrequest
+
+import (
+	"encoding/json"
+	"net/url"
+	"path"
+
+	"github.com/pkg/errors"
+)
+
+// index is the document fetched in INDEX mode. It lists the Specs provided under a single URL, e.g. the files of a multi-file Spec.
+type index struct {
+	Specs []indexEntry `json:"specs"`
+}
+
+// indexEntry references a single Spec from an index. The URL may be relative to the URL of the index.
+type indexEntry struct {
+	Name string `json:"name"`
+	URL  string `json:"url"`
+}
+
+// resolveIndexEntry parses the index and returns the absolute URL of the entry whose name matches the filter.
+// The filter is a path.Match pattern and can be omitted if the index lists a single Spec.
+func resolveIndexEntry(indexURL string, data []byte, filter *string) (*url.URL, error) {
+	idx := index{}
+	if err := json.Unmarshal(data, &idx); err != nil {
+		return nil, errors.Wrap(err, "while unmarshalling index")
+	}
+
+	var matches []indexEntry
+	for _, entry := range idx.Specs {
+		if filter == nil {
+			matches = append(matches, entry)
+			continue
+		}
+
+		matched, err := path.Match(*filter, entry.Name)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid filter %q", *filter)
+		}
+		if matched {
+			matches = append(matches, entry)
+		}
+	}
+
+	if len(matches) != 1 {
+		if filter == nil {
+			return nil, errors.Errorf("index lists %d specs, filter is required to select the Spec", len(matches))
+		}
+		return nil, errors.Errorf("filter %q matches %d specs in the index, expected exactly one", *filter, len(matches))
+	}
+
+	base, err := url.Parse(indexURL)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while parsing index URL %q", indexURL)
+	}
+	ref, err := url.Parse(matches[0].URL)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while parsing URL of spec %q", matches[0].Name)
+	}
+
+	return base.ResolveReference(ref), nil
+}
@@ -2,6 +2,12 @@ package fetchrequest
 
 import (
 	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
 
 	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
 
@@ -18,7 +24,7 @@ const documentIDColumn = "document_id"
 const specIDColumn = "spec_id"
 
 var (
-	fetchRequestColumns = []string{"id", "tenant_id", documentIDColumn, "url", "auth", "mode", "filter", "status_condition", "status_message", "status_timestamp", specIDColumn}
+	fetchRequestColumns = []string{"id", "tenant_id", documentIDColumn, "url", "auth", "mode", "filter", "status_condition", "status_message", "status_timestamp", specIDColumn, "spec_hash", "spec_changed_at"}
 	tenantColumn        = "tenant_id"
 )
 
@@ -31,6 +37,7 @@ type Converter interface {
 type repository struct {
 	creator      repo.Creator
 	singleGetter repo.SingleGetter
+	listerGlobal repo.ListerGlobal
 	deleter      repo.Deleter
 	updater      repo.Updater
 	conv         Converter
@@ -40,8 +47,9 @@ func NewRepository(conv Converter) *repository {
 	return &repository{
 		creator:      repo.NewCreator(resource.FetchRequest, fetchRequestTable, fetchRequestColumns),
 		singleGetter: repo.NewSingleGetter(resource.FetchRequest, fetchRequestTable, tenantColumn, fetchRequestColumns),
+		listerGlobal: repo.NewListerGlobal(resource.FetchRequest, fetchRequestTable, fetchRequestColumns),
 		deleter:      repo.NewDeleter(resource.FetchRequest, fetchRequestTable, tenantColumn),
-		updater:      repo.NewUpdater(resource.FetchRequest, fetchRequestTable, []string{"status_condition", "status_message", "status_timestamp"}, tenantColumn, []string{"id"}),
+		updater:      repo.NewUpdater(resource.FetchRequest, fetchRequestTable, []string{"status_condition", "status_message", "status_timestamp", "spec_hash", "spec_changed_at"}, tenantColumn, []string{"id"}),
 		conv:         conv,
 	}
 }
@@ -78,6 +86,55 @@ func (r *repository) GetByReferenceObjectID(ctx context.Context, tenant string,
 	return &frModel, nil
 }
 
+// ListGlobalSpecFetchRequestsFetchedBefore lists the Fetch Requests of Specifications in all tenants which were last fetched before the given time
+func (r *repository) ListGlobalSpecFetchRequestsFetchedBefore(ctx context.Context, before time.Time) ([]*model.FetchRequest, error) {
+	var entities EntityCollection
+	conditions := repo.Conditions{
+		repo.NewNotNullCondition(specIDColumn),
+		repo.NewLessThanCondition("status_timestamp", before),
+	}
+	if err := r.listerGlobal.ListGlobal(ctx, &entities, conditions...); err != nil {
+		return nil, err
+	}
+
+	items := make([]*model.FetchRequest, 0, len(entities))
+	for _, entity := range entities {
+		frModel, err := r.conv.FromEntity(entity)
+		if err != nil {
+			return nil, errors.Wrap(err, "while getting FetchRequest model from entity")
+		}
+		items = append(items, &frModel)
+	}
+
+	return items, nil
+}
+
+// LockGlobalDueSpecFetchRequest locks the Fetch Request of a Specification with the given ID if it was last fetched before the given time,
+// skipping it if it is locked by another transaction. The Fetch Request stays locked until the transaction in the context is either committed or rolled back.
+func (r *repository) LockGlobalDueSpecFetchRequest(ctx context.Context, id string, before time.Time) (*model.FetchRequest, error) {
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	query := fmt.Sprintf("SELECT %s FROM %s WHERE id = $1 AND %s IS NOT NULL AND status_timestamp < $2 FOR UPDATE SKIP LOCKED",
+		strings.Join(fetchRequestColumns, ", "), fetchRequestTable, specIDColumn)
+
+	log.C(ctx).Debugf("Executing DB query: %s", query)
+	var entity Entity
+	err = persist.Get(&entity, query, id, before)
+	if err = persistence.MapSQLError(ctx, err, resource.FetchRequest, resource.Get, "while locking object from '%s' table", fetchRequestTable); err != nil {
+		return nil, err
+	}
+
+	frModel, err := r.conv.FromEntity(entity)
+	if err != nil {
+		return nil, errors.Wrap(err, "while getting FetchRequest model from entity")
+	}
+
+	return &frModel, nil
+}
+
 func (r *repository) Delete(ctx context.Context, tenant, id string) error {
 	return r.deleter.DeleteOne(ctx, tenant, repo.Conditions{repo.NewEqualCondition("id", id)})
 }
@@ -36,8 +36,8 @@ func TestRepository_Create(t *testing.T) {
 		db, dbMock := testdb.MockDatabase(t)
 		defer dbMock.AssertExpectations(t)
 
-		dbMock.ExpectExec(regexp.QuoteMeta("INSERT INTO public.fetch_requests ( id, tenant_id, document_id, url, auth, mode, filter, status_condition, status_message, status_timestamp, spec_id ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ? )")).
-			WithArgs(givenID(), givenTenant(), "documentID", "foo.bar", frEntity.Auth, frEntity.Mode, frEntity.Filter, frEntity.StatusCondition, frEntity.StatusMessage, frEntity.StatusTimestamp, sql.NullString{}).
+		dbMock.ExpectExec(regexp.QuoteMeta("INSERT INTO public.fetch_requests ( id, tenant_id, document_id, url, auth, mode, filter, status_condition, status_message, status_timestamp, spec_id, spec_hash, spec_changed_at ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ? )")).
+			WithArgs(givenID(), givenTenant(), "documentID", "foo.bar", frEntity.Auth, frEntity.Mode, frEntity.Filter, frEntity.StatusCondition, frEntity.StatusMessage, frEntity.StatusTimestamp, sql.NullString{}, frEntity.SpecHash, frEntity.SpecChangedAt).
 			WillReturnResult(sqlmock.NewResult(-1, 1))
 
 		ctx := persistence.SaveToContext(context.TODO(), db)
@@ -112,10 +112,10 @@ func TestRepository_GetByReferenceObjectID(t *testing.T) {
 			repo := fetchrequest.NewRepository(mockConverter)
 			db, dbMock := testdb.MockDatabase(t)
 
-			rows := sqlmock.NewRows([]string{"id", "tenant_id", "document_id", "url", "auth", "mode", "filter", "status_condition", "status_message", "status_timestamp", "spec_id"}).
-				AddRow(givenID(), givenTenant(), testCase.DocumentID, "foo.bar", frEntity.Auth, frEntity.Mode, frEntity.Filter, frEntity.StatusCondition, frEntity.StatusMessage, frEntity.StatusTimestamp, testCase.SpecID)
+			rows := sqlmock.NewRows([]string{"id", "tenant_id", "document_id", "url", "auth", "mode", "filter", "status_condition", "status_message", "status_timestamp", "spec_id", "spec_hash", "spec_changed_at"}).
+				AddRow(givenID(), givenTenant(), testCase.DocumentID, "foo.bar", frEntity.Auth, frEntity.Mode, frEntity.Filter, frEntity.StatusCondition, frEntity.StatusMessage, frEntity.StatusTimestamp, testCase.SpecID, frEntity.SpecHash, frEntity.SpecChangedAt)
 
-			query := fmt.Sprintf("SELECT id, tenant_id, document_id, url, auth, mode, filter, status_condition, status_message, status_timestamp, spec_id FROM public.fetch_requests WHERE tenant_id = $1 AND %s = $2", testCase.FieldName)
+			query := fmt.Sprintf("SELECT id, tenant_id, document_id, url, auth, mode, filter, status_condition, status_message, status_timestamp, spec_id, spec_hash, spec_changed_at FROM public.fetch_requests WHERE tenant_id = $1 AND %s = $2", testCase.FieldName)
 			dbMock.ExpectQuery(regexp.QuoteMeta(query)).
 				WithArgs(givenTenant(), givenID()).WillReturnRows(rows)
 
@@ -145,8 +145,8 @@ func TestRepository_GetByReferenceObjectID(t *testing.T) {
 		db, dbMock := testdb.MockDatabase(t)
 		defer dbMock.AssertExpectations(t)
 
-		rows := sqlmock.NewRows([]string{"id", "tenant_id", "document_id", "url", "auth", "mode", "filter", "status_condition", "status_message", "status_timestamp", "spec_id"}).
-			AddRow(givenID(), givenTenant(), "documentID", "foo.bar", frEntity.Auth, frEntity.Mode, frEntity.Filter, frEntity.StatusCondition, frEntity.StatusMessage, frEntity.StatusTimestamp, sql.NullString{})
+		rows := sqlmock.NewRows([]string{"id", "tenant_id", "document_id", "url", "auth", "mode", "filter", "status_condition", "status_message", "status_timestamp", "spec_id", "spec_hash", "spec_changed_at"}).
+			AddRow(givenID(), givenTenant(), "documentID", "foo.bar", frEntity.Auth, frEntity.Mode, frEntity.Filter, frEntity.StatusCondition, frEntity.StatusMessage, frEntity.StatusTimestamp, sql.NullString{}, frEntity.SpecHash, frEntity.SpecChangedAt)
 
 		dbMock.ExpectQuery("SELECT .*").
 			WithArgs(givenTenant(), givenID()).WillReturnRows(rows)
@@ -189,6 +189,116 @@ func TestRepository_GetByReferenceObjectID(t *testing.T) {
 
 }
 
+func TestRepository_ListGlobalSpecFetchRequestsFetchedBefore(t *testing.T) {
+	before := time.Now()
+
+	t.Run("Success", func(t *testing.T) {
+		// GIVEN
+		timestamp := before.Add(-time.Hour)
+		frModel := fixFetchRequestModelWithReference(givenID(), timestamp, model.SpecFetchRequestReference, "foo")
+		frEntity := fixFetchRequestEntityWithReferences(givenID(), timestamp, sql.NullString{String: "foo", Valid: true}, sql.NullString{})
+
+		mockConverter := &automock.Converter{}
+		mockConverter.On("FromEntity", frEntity).Return(frModel, nil).Once()
+		defer mockConverter.AssertExpectations(t)
+
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		rows := sqlmock.NewRows([]string{"id", "tenant_id", "document_id", "url", "auth", "mode", "filter", "status_condition", "status_message", "status_timestamp", "spec_id", "spec_hash", "spec_changed_at"}).
+			AddRow(givenID(), givenTenant(), frEntity.DocumentID, "foo.bar", frEntity.Auth, frEntity.Mode, frEntity.Filter, frEntity.StatusCondition, frEntity.StatusMessage, frEntity.StatusTimestamp, frEntity.SpecID, frEntity.SpecHash, frEntity.SpecChangedAt)
+
+		query := "SELECT id, tenant_id, document_id, url, auth, mode, filter, status_condition, status_message, status_timestamp, spec_id, spec_hash, spec_changed_at FROM public.fetch_requests WHERE spec_id IS NOT NULL AND status_timestamp < $1"
+		dbMock.ExpectQuery(regexp.QuoteMeta(query)).WithArgs(before).WillReturnRows(rows)
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repo := fetchrequest.NewRepository(mockConverter)
+		// WHEN
+		actual, err := repo.ListGlobalSpecFetchRequestsFetchedBefore(ctx, before)
+		// THEN
+		require.NoError(t, err)
+		require.Len(t, actual, 1)
+		assert.Equal(t, frModel, *actual[0])
+	})
+
+	t.Run("Error - DB", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		dbMock.ExpectQuery("SELECT .*").WithArgs(before).WillReturnError(givenError())
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repo := fetchrequest.NewRepository(nil)
+		// WHEN
+		_, err := repo.ListGlobalSpecFetchRequestsFetchedBefore(ctx, before)
+		// THEN
+		require.EqualError(t, err, "Internal Server Error: Unexpected error while executing SQL query")
+	})
+}
+
+func TestRepository_LockGlobalDueSpecFetchRequest(t *testing.T) {
+	before := time.Now()
+	query := "SELECT id, tenant_id, document_id, url, auth, mode, filter, status_condition, status_message, status_timestamp, spec_id, spec_hash, spec_changed_at FROM public.fetch_requests WHERE id = $1 AND spec_id IS NOT NULL AND status_timestamp < $2 FOR UPDATE SKIP LOCKED"
+
+	t.Run("Success", func(t *testing.T) {
+		// GIVEN
+		timestamp := before.Add(-time.Hour)
+		frModel := fixFetchRequestModelWithReference(givenID(), timestamp, model.SpecFetchRequestReference, "foo")
+		frEntity := fixFetchRequestEntityWithReferences(givenID(), timestamp, sql.NullString{String: "foo", Valid: true}, sql.NullString{})
+
+		mockConverter := &automock.Converter{}
+		mockConverter.On("FromEntity", frEntity).Return(frModel, nil).Once()
+		defer mockConverter.AssertExpectations(t)
+
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		rows := sqlmock.NewRows([]string{"id", "tenant_id", "document_id", "url", "auth", "mode", "filter", "status_condition", "status_message", "status_timestamp", "spec_id", "spec_hash", "spec_changed_at"}).
+			AddRow(givenID(), givenTenant(), frEntity.DocumentID, "foo.bar", frEntity.Auth, frEntity.Mode, frEntity.Filter, frEntity.StatusCondition, frEntity.StatusMessage, frEntity.StatusTimestamp, frEntity.SpecID, frEntity.SpecHash, frEntity.SpecChangedAt)
+		dbMock.ExpectQuery(regexp.QuoteMeta(query)).WithArgs(givenID(), before).WillReturnRows(rows)
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repo := fetchrequest.NewRepository(mockConverter)
+		// WHEN
+		actual, err := repo.LockGlobalDueSpecFetchRequest(ctx, givenID(), before)
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, frModel, *actual)
+	})
+
+	t.Run("Error - Not Found when locked by another transaction or not due", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		dbMock.ExpectQuery(regexp.QuoteMeta(query)).WithArgs(givenID(), before).WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repo := fetchrequest.NewRepository(nil)
+		// WHEN
+		_, err := repo.LockGlobalDueSpecFetchRequest(ctx, givenID(), before)
+		// THEN
+		require.Error(t, err)
+		assert.True(t, apperrors.IsNotFoundError(err))
+	})
+
+	t.Run("Error - DB", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		dbMock.ExpectQuery(regexp.QuoteMeta(query)).WithArgs(givenID(), before).WillReturnError(givenError())
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repo := fetchrequest.NewRepository(nil)
+		// WHEN
+		_, err := repo.LockGlobalDueSpecFetchRequest(ctx, givenID(), before)
+		// THEN
+		require.EqualError(t, err, "Internal Server Error: Unexpected error while executing SQL query")
+	})
+}
+
 func TestRepository_Delete(t *testing.T) {
 	t.Run("Success", func(t *testing.T) {
 		// GIVEN
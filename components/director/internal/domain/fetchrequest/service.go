@@ -3,8 +3,11 @@ package fetchrequest
 import (
 	"context"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"net/http"
+	"net/url"
+	"path"
 	"time"
 
 	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
@@ -17,9 +20,13 @@ import (
 
 	"github.com/kyma-incubator/compass/components/director/pkg/log"
 
+	"github.com/kyma-incubator/compass/components/director/internal/domain/auth"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
 )
 
+// maxFetchSize limits the size of a fetched Spec, bundle or index, so that a misbehaving server cannot exhaust the memory
+const maxFetchSize = 50 << 20
+
 type service struct {
 	repo         FetchRequestRepository
 	client       *http.Client
@@ -59,7 +66,50 @@ func (s *service) fetchSpec(ctx context.Context, fr *model.FetchRequest) (*strin
 		return nil, FixStatus(model.FetchRequestStatusConditionInitial, str.Ptr(err.Error()), s.timestampGen())
 	}
 
+	body, status := s.fetch(ctx, fr)
+	if status != nil {
+		return nil, status
+	}
+
+	switch fr.Mode {
+	case model.FetchModeBundle:
+		body, err = extractFromArchive(body, fr.Filter)
+		if err != nil {
+			log.C(ctx).WithError(err).Errorf("An error has occurred while extracting Spec from bundle.")
+			return nil, FixStatus(model.FetchRequestStatusConditionFailed, str.Ptr(fmt.Sprintf("While extracting Spec from bundle: %s", err.Error())), s.timestampGen())
+		}
+	case model.FetchModeIndex:
+		body, status = s.fetchFromIndex(ctx, fr, body)
+		if status != nil {
+			return nil, status
+		}
+	}
+
+	spec := string(body)
+	return &spec, FixStatus(model.FetchRequestStatusConditionSucceeded, nil, s.timestampGen())
+}
+
+// fetchFromIndex fetches the Spec selected by the filter of the fetch request from the index.
+// The credentials of the fetch request are used only if the Spec is served by the same host as the index.
+func (s *service) fetchFromIndex(ctx context.Context, fr *model.FetchRequest, index []byte) ([]byte, *model.FetchRequestStatus) {
+	specURL, err := resolveIndexEntry(fr.URL, index, fr.Filter)
+	if err != nil {
+		log.C(ctx).WithError(err).Errorf("An error has occurred while resolving Spec from index.")
+		return nil, FixStatus(model.FetchRequestStatusConditionFailed, str.Ptr(fmt.Sprintf("While resolving Spec from index: %s", err.Error())), s.timestampGen())
+	}
+
+	specFr := *fr
+	specFr.URL = specURL.String()
+	if indexURL, err := url.Parse(fr.URL); err != nil || indexURL.Host != specURL.Host {
+		specFr.Auth = nil
+	}
+
+	return s.fetch(ctx, &specFr)
+}
+
+func (s *service) fetch(ctx context.Context, fr *model.FetchRequest) ([]byte, *model.FetchRequestStatus) {
 	var resp *http.Response
+	var err error
 	if fr.Auth != nil {
 		resp, err = s.requestWithCredentials(ctx, fr)
 	} else {
@@ -85,41 +135,52 @@ func (s *service) fetchSpec(ctx context.Context, fr *model.FetchRequest) (*strin
 		return nil, FixStatus(model.FetchRequestStatusConditionFailed, str.Ptr(fmt.Sprintf("While fetching Spec status code: %d", resp.StatusCode)), s.timestampGen())
 	}
 
-	body, err := ioutil.ReadAll(resp.Body)
+	body, err := ioutil.ReadAll(io.LimitReader(resp.Body, maxFetchSize+1))
 	if err != nil {
 		log.C(ctx).WithError(err).Errorf("An error has occurred while reading Spec.")
 		return nil, FixStatus(model.FetchRequestStatusConditionFailed, str.Ptr(fmt.Sprintf("While reading Spec: %s", err.Error())), s.timestampGen())
 	}
+	if len(body) > maxFetchSize {
+		log.C(ctx).Errorf("Fetched Spec for %s with id %q exceeds the maximum size of %d bytes", fr.ObjectType, fr.ObjectID, maxFetchSize)
+		return nil, FixStatus(model.FetchRequestStatusConditionFailed, str.Ptr(fmt.Sprintf("While reading Spec: response exceeds the maximum size of %d bytes", maxFetchSize)), s.timestampGen())
+	}
 
-	spec := string(body)
-	return &spec, FixStatus(model.FetchRequestStatusConditionSucceeded, nil, s.timestampGen())
+	return body, nil
 }
 
 func (s *service) validateFetchRequest(fr *model.FetchRequest) error {
-	if fr.Mode != model.FetchModeSingle {
+	switch fr.Mode {
+	case model.FetchModeSingle:
+		if fr.Filter != nil {
+			return apperrors.NewInvalidDataError("Filter for Fetch Request is supported only in %s and %s modes", model.FetchModeBundle, model.FetchModeIndex)
+		}
+	case model.FetchModeBundle, model.FetchModeIndex:
+		if fr.Filter != nil {
+			if _, err := path.Match(*fr.Filter, ""); err != nil {
+				return apperrors.NewInvalidDataError("Invalid filter %q for Fetch Request: %s", *fr.Filter, err.Error())
+			}
+		}
+	default:
 		return apperrors.NewInvalidDataError("Unsupported fetch mode: %s", fr.Mode)
 	}
 
-	if fr.Filter != nil {
-		return apperrors.NewInvalidDataError("Filter for Fetch Request was provided, currently it's unsupported")
-	}
-
 	return nil
 }
 
 func (s *service) requestWithCredentials(ctx context.Context, fr *model.FetchRequest) (*http.Response, error) {
-	if fr.Auth.Credential.Basic == nil && fr.Auth.Credential.Oauth == nil {
+	credential := fr.Auth.Credential
+	if credential.Basic == nil && credential.Oauth == nil && credential.Certificate == nil && fr.Auth.Signing == nil {
 		return nil, apperrors.NewInvalidDataError("Credentials not provided")
 	}
 
-	req, err := http.NewRequest(http.MethodGet, fr.URL, nil)
+	req, err := http.NewRequestWithContext(auth.SaveToContext(ctx, fr.Auth), http.MethodGet, fr.URL, nil)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp *http.Response
-	if fr.Auth.Credential.Basic != nil {
-		req.SetBasicAuth(fr.Auth.Credential.Basic.Username, fr.Auth.Credential.Basic.Password)
+	if credential.Basic != nil {
+		req.SetBasicAuth(credential.Basic.Username, credential.Basic.Password)
 
 		resp, err = s.client.Do(req)
 
@@ -128,8 +189,10 @@ func (s *service) requestWithCredentials(ctx context.Context, fr *model.FetchReq
 		}
 	}
 
-	if fr.Auth.Credential.Oauth != nil {
+	if credential.Oauth != nil {
 		resp, err = s.secureClient(ctx, fr).Do(req)
+	} else if credential.Basic == nil {
+		resp, err = s.client.Do(req)
 	}
 
 	return resp, err
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"io/ioutil"
 	"net/http"
+	"strings"
 	"testing"
 	"time"
 
@@ -18,6 +19,7 @@ import (
 	"github.com/kyma-incubator/compass/components/director/internal/domain/fetchrequest"
 
 	"github.com/kyma-incubator/compass/components/director/internal/model"
+	httputil "github.com/kyma-incubator/compass/components/director/pkg/http"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -52,11 +54,47 @@ func TestService_HandleSpec(t *testing.T) {
 		Mode: model.FetchModeSingle,
 	}
 
+	modelInputUnsupportedMode := model.FetchRequest{
+		ID:   "test",
+		Mode: model.FetchMode("UNKNOWN"),
+	}
+
 	modelInputBundle := model.FetchRequest{
+		ID:     "test",
+		URL:    "http://dummy.url.sth/specs.zip",
+		Mode:   model.FetchModeBundle,
+		Filter: str.Ptr("specs/*.yaml"),
+	}
+
+	modelInputBundleWithoutFilter := model.FetchRequest{
 		ID:   "test",
+		URL:  "http://dummy.url.sth/specs.zip",
 		Mode: model.FetchModeBundle,
 	}
 
+	modelInputIndex := model.FetchRequest{
+		ID:   "test",
+		URL:  "http://dummy.url.sth/specs/index.json",
+		Mode: model.FetchModeIndex,
+		Auth: &model.Auth{
+			Credential: model.CredentialData{
+				Basic: &model.BasicCredentialData{
+					Username: username,
+					Password: password,
+				},
+			},
+		},
+		Filter: str.Ptr("orders"),
+	}
+
+	modelInputIndexWithoutFilter := model.FetchRequest{
+		ID:   "test",
+		URL:  "http://dummy.url.sth/specs/index.json",
+		Mode: model.FetchModeIndex,
+	}
+
+	index := `{"specs":[{"name":"orders","url":"orders/openapi.yaml"},{"name":"customers","url":"http://other.url.sth/customers.yaml"}]}`
+
 	modelInputFilter := model.FetchRequest{
 		ID:     "test",
 		Mode:   model.FetchModeSingle,
@@ -87,6 +125,24 @@ func TestService_HandleSpec(t *testing.T) {
 		Mode: model.FetchModeSingle,
 	}
 
+	modelInputCertificate := model.FetchRequest{
+		ID: "test",
+		Auth: &model.Auth{
+			Credential: model.CredentialData{
+				Certificate: &model.CertificateCredentialData{
+					Certificate: "certificate",
+					PrivateKey:  "key",
+				},
+			},
+			Signing: &model.RequestSigning{
+				Header:          httputil.DefaultSignatureHeader,
+				TimestampHeader: httputil.DefaultSignatureTimestampHeader,
+				Secrets:         []string{"secret"},
+			},
+		},
+		Mode: model.FetchModeSingle,
+	}
+
 	modelInputOauth := model.FetchRequest{
 		ID:  "test",
 		URL: "http://dummy.url.sth",
@@ -126,19 +182,19 @@ func TestService_HandleSpec(t *testing.T) {
 			ExpectedStatus: fetchrequest.FixStatus(model.FetchRequestStatusConditionSucceeded, nil, timestamp),
 		},
 		{
-			Name: "Nil when fetch request validation fails due to mode Bundle",
+			Name: "Nil when fetch request validation fails due to unsupported mode",
 			Client: func(t *testing.T) *http.Client {
 				return NewTestClient(func(req *http.Request) *http.Response {
 					return &http.Response{}
 				})
 			},
 
-			InputFr:        modelInputBundle,
+			InputFr:        modelInputUnsupportedMode,
 			ExpectedResult: nil,
-			ExpectedStatus: fetchrequest.FixStatus(model.FetchRequestStatusConditionInitial, str.Ptr("Invalid data [reason=Unsupported fetch mode: BUNDLE]"), timestamp),
+			ExpectedStatus: fetchrequest.FixStatus(model.FetchRequestStatusConditionInitial, str.Ptr("Invalid data [reason=Unsupported fetch mode: UNKNOWN]"), timestamp),
 		},
 		{
-			Name: "Nil when fetch request validation fails due to provided filter",
+			Name: "Nil when fetch request validation fails due to filter provided in mode Single",
 			Client: func(t *testing.T) *http.Client {
 				return NewTestClient(func(req *http.Request) *http.Response {
 					return &http.Response{}
@@ -147,7 +203,265 @@ func TestService_HandleSpec(t *testing.T) {
 
 			InputFr:        modelInputFilter,
 			ExpectedResult: nil,
-			ExpectedStatus: fetchrequest.FixStatus(model.FetchRequestStatusConditionInitial, str.Ptr("Invalid data [reason=Filter for Fetch Request was provided, currently it's unsupported]"), timestamp),
+			ExpectedStatus: fetchrequest.FixStatus(model.FetchRequestStatusConditionInitial, str.Ptr("Invalid data [reason=Filter for Fetch Request is supported only in BUNDLE and INDEX modes]"), timestamp),
+		},
+		{
+			Name: "Nil when fetch request validation fails due to invalid filter",
+			Client: func(t *testing.T) *http.Client {
+				return NewTestClient(func(req *http.Request) *http.Response {
+					return &http.Response{}
+				})
+			},
+
+			InputFr: model.FetchRequest{
+				ID:     "test",
+				Mode:   model.FetchModeBundle,
+				Filter: str.Ptr("[specs"),
+			},
+			ExpectedResult: nil,
+			ExpectedStatus: fetchrequest.FixStatus(model.FetchRequestStatusConditionInitial, str.Ptr("Invalid data [reason=Invalid filter \"[specs\" for Fetch Request: syntax error in pattern]"), timestamp),
+		},
+		{
+			Name: "Success extracting Spec matching the filter from zip bundle",
+			Client: func(t *testing.T) *http.Client {
+				archive := fixZipArchive(t, map[string]string{"specs/api.yaml": mockSpec, "README.md": "readme"})
+				return NewTestClient(func(req *http.Request) *http.Response {
+					return &http.Response{
+						StatusCode: http.StatusOK,
+						Body:       ioutil.NopCloser(bytes.NewBuffer(archive)),
+					}
+				})
+			},
+			InputFr:        modelInputBundle,
+			ExpectedResult: &mockSpec,
+			ExpectedStatus: fetchrequest.FixStatus(model.FetchRequestStatusConditionSucceeded, nil, timestamp),
+		},
+		{
+			Name: "Success extracting Spec matching the filter from tar.gz bundle",
+			Client: func(t *testing.T) *http.Client {
+				archive := fixTarGzArchive(t, map[string]string{"./specs/api.yaml": mockSpec, "README.md": "readme"})
+				return NewTestClient(func(req *http.Request) *http.Response {
+					return &http.Response{
+						StatusCode: http.StatusOK,
+						Body:       ioutil.NopCloser(bytes.NewBuffer(archive)),
+					}
+				})
+			},
+			InputFr:        modelInputBundle,
+			ExpectedResult: &mockSpec,
+			ExpectedStatus: fetchrequest.FixStatus(model.FetchRequestStatusConditionSucceeded, nil, timestamp),
+		},
+		{
+			Name: "Success extracting the only Spec from bundle without filter",
+			Client: func(t *testing.T) *http.Client {
+				archive := fixZipArchive(t, map[string]string{"api.yaml": mockSpec})
+				return NewTestClient(func(req *http.Request) *http.Response {
+					return &http.Response{
+						StatusCode: http.StatusOK,
+						Body:       ioutil.NopCloser(bytes.NewBuffer(archive)),
+					}
+				})
+			},
+			InputFr:        modelInputBundleWithoutFilter,
+			ExpectedResult: &mockSpec,
+			ExpectedStatus: fetchrequest.FixStatus(model.FetchRequestStatusConditionSucceeded, nil, timestamp),
+		},
+		{
+			Name: "Fails when filter does not match any file in the bundle",
+			Client: func(t *testing.T) *http.Client {
+				archive := fixZipArchive(t, map[string]string{"api.yaml": mockSpec})
+				return NewTestClient(func(req *http.Request) *http.Response {
+					return &http.Response{
+						StatusCode: http.StatusOK,
+						Body:       ioutil.NopCloser(bytes.NewBuffer(archive)),
+					}
+				})
+			},
+			InputFr:        modelInputBundle,
+			ExpectedStatus: fetchrequest.FixStatus(model.FetchRequestStatusConditionFailed, str.Ptr("While extracting Spec from bundle: filter \"specs/*.yaml\" matches 0 files in the bundle, expected exactly one"), timestamp),
+		},
+		{
+			Name: "Fails when bundle without filter contains multiple files",
+			Client: func(t *testing.T) *http.Client {
+				archive := fixTarGzArchive(t, map[string]string{"api.yaml": mockSpec, "README.md": "readme"})
+				return NewTestClient(func(req *http.Request) *http.Response {
+					return &http.Response{
+						StatusCode: http.StatusOK,
+						Body:       ioutil.NopCloser(bytes.NewBuffer(archive)),
+					}
+				})
+			},
+			InputFr:        modelInputBundleWithoutFilter,
+			ExpectedStatus: fetchrequest.FixStatus(model.FetchRequestStatusConditionFailed, str.Ptr("While extracting Spec from bundle: bundle contains 2 files, filter is required to select the Spec"), timestamp),
+		},
+		{
+			Name: "Success extracting Spec from tar.gz bundle without reading files which do not match the filter",
+			Client: func(t *testing.T) *http.Client {
+				archive := fixTarGzArchive(t, map[string]string{"specs/api.yaml": mockSpec, "large.bin": strings.Repeat("a", 11<<20)})
+				return NewTestClient(func(req *http.Request) *http.Response {
+					return &http.Response{
+						StatusCode: http.StatusOK,
+						Body:       ioutil.NopCloser(bytes.NewBuffer(archive)),
+					}
+				})
+			},
+			InputFr:        modelInputBundle,
+			ExpectedResult: &mockSpec,
+			ExpectedStatus: fetchrequest.FixStatus(model.FetchRequestStatusConditionSucceeded, nil, timestamp),
+		},
+		{
+			Name: "Fails when tar.gz bundle exceeds the maximum number of entries",
+			Client: func(t *testing.T) *http.Client {
+				files := map[string]string{"specs/api.yaml": mockSpec}
+				for i := 0; i < 1000; i++ {
+					files[fmt.Sprintf("docs/%d.md", i)] = "doc"
+				}
+				archive := fixTarGzArchive(t, files)
+				return NewTestClient(func(req *http.Request) *http.Response {
+					return &http.Response{
+						StatusCode: http.StatusOK,
+						Body:       ioutil.NopCloser(bytes.NewBuffer(archive)),
+					}
+				})
+			},
+			InputFr:        modelInputBundle,
+			ExpectedStatus: fetchrequest.FixStatus(model.FetchRequestStatusConditionFailed, str.Ptr("While extracting Spec from bundle: bundle exceeds the maximum of 1000 entries"), timestamp),
+		},
+		{
+			Name: "Fails when tar.gz bundle exceeds the maximum uncompressed size",
+			Client: func(t *testing.T) *http.Client {
+				archive := fixTarGzArchive(t, map[string]string{"specs/api.yaml": mockSpec, "large.bin": strings.Repeat("a", 100<<20)})
+				return NewTestClient(func(req *http.Request) *http.Response {
+					return &http.Response{
+						StatusCode: http.StatusOK,
+						Body:       ioutil.NopCloser(bytes.NewBuffer(archive)),
+					}
+				})
+			},
+			InputFr:        modelInputBundle,
+			ExpectedStatus: fetchrequest.FixStatus(model.FetchRequestStatusConditionFailed, str.Ptr("While extracting Spec from bundle: bundle exceeds the maximum uncompressed size of 104857600 bytes"), timestamp),
+		},
+		{
+			Name: "Fails when zip bundle exceeds the maximum number of entries",
+			Client: func(t *testing.T) *http.Client {
+				files := map[string]string{"specs/api.yaml": mockSpec}
+				for i := 0; i < 1000; i++ {
+					files[fmt.Sprintf("docs/%d.md", i)] = "doc"
+				}
+				archive := fixZipArchive(t, files)
+				return NewTestClient(func(req *http.Request) *http.Response {
+					return &http.Response{
+						StatusCode: http.StatusOK,
+						Body:       ioutil.NopCloser(bytes.NewBuffer(archive)),
+					}
+				})
+			},
+			InputFr:        modelInputBundle,
+			ExpectedStatus: fetchrequest.FixStatus(model.FetchRequestStatusConditionFailed, str.Ptr("While extracting Spec from bundle: bundle exceeds the maximum of 1000 entries"), timestamp),
+		},
+		{
+			Name: "Fails when the response exceeds the maximum size",
+			Client: func(t *testing.T) *http.Client {
+				return NewTestClient(func(req *http.Request) *http.Response {
+					return &http.Response{
+						StatusCode: http.StatusOK,
+						Body:       ioutil.NopCloser(bytes.NewReader(make([]byte, 50<<20+1))),
+					}
+				})
+			},
+			InputFr:        modelInputBundle,
+			ExpectedStatus: fetchrequest.FixStatus(model.FetchRequestStatusConditionFailed, str.Ptr("While reading Spec: response exceeds the maximum size of 52428800 bytes"), timestamp),
+		},
+		{
+			Name: "Fails when bundle is not an archive",
+			Client: func(t *testing.T) *http.Client {
+				return NewTestClient(func(req *http.Request) *http.Response {
+					return &http.Response{
+						StatusCode: http.StatusOK,
+						Body:       ioutil.NopCloser(bytes.NewBufferString(mockSpec)),
+					}
+				})
+			},
+			InputFr:        modelInputBundle,
+			ExpectedStatus: fetchrequest.FixStatus(model.FetchRequestStatusConditionFailed, str.Ptr("While extracting Spec from bundle: unsupported bundle format, expected zip or tar.gz archive"), timestamp),
+		},
+		{
+			Name: "Success fetching Spec selected by the filter from index with the credentials of the index",
+			Client: func(t *testing.T) *http.Client {
+				return NewTestClient(func(req *http.Request) *http.Response {
+					actualUsername, actualPassword, ok := req.BasicAuth()
+					assert.True(t, ok)
+					assert.Equal(t, username, actualUsername)
+					assert.Equal(t, password, actualPassword)
+
+					body := index
+					if req.URL.String() == "http://dummy.url.sth/specs/orders/openapi.yaml" {
+						body = mockSpec
+					} else {
+						assert.Equal(t, modelInputIndex.URL, req.URL.String())
+					}
+					return &http.Response{
+						StatusCode: http.StatusOK,
+						Body:       ioutil.NopCloser(bytes.NewBufferString(body)),
+					}
+				})
+			},
+			InputFr:        modelInputIndex,
+			ExpectedResult: &mockSpec,
+			ExpectedStatus: fetchrequest.FixStatus(model.FetchRequestStatusConditionSucceeded, nil, timestamp),
+		},
+		{
+			Name: "Success fetching Spec from another host listed in index without the credentials of the index",
+			Client: func(t *testing.T) *http.Client {
+				return NewTestClient(func(req *http.Request) *http.Response {
+					_, _, hasCredentials := req.BasicAuth()
+					body := index
+					if req.URL.String() == "http://other.url.sth/customers.yaml" {
+						assert.False(t, hasCredentials)
+						body = mockSpec
+					}
+					return &http.Response{
+						StatusCode: http.StatusOK,
+						Body:       ioutil.NopCloser(bytes.NewBufferString(body)),
+					}
+				})
+			},
+			InputFr: func() model.FetchRequest {
+				fr := modelInputIndex
+				fr.Filter = str.Ptr("cust*")
+				return fr
+			}(),
+			ExpectedResult: &mockSpec,
+			ExpectedStatus: fetchrequest.FixStatus(model.FetchRequestStatusConditionSucceeded, nil, timestamp),
+		},
+		{
+			Name: "Fails when index without filter lists multiple specs",
+			Client: func(t *testing.T) *http.Client {
+				return NewTestClient(func(req *http.Request) *http.Response {
+					return &http.Response{
+						StatusCode: http.StatusOK,
+						Body:       ioutil.NopCloser(bytes.NewBufferString(index)),
+					}
+				})
+			},
+			InputFr:        modelInputIndexWithoutFilter,
+			ExpectedStatus: fetchrequest.FixStatus(model.FetchRequestStatusConditionFailed, str.Ptr("While resolving Spec from index: index lists 2 specs, filter is required to select the Spec"), timestamp),
+		},
+		{
+			Name: "Fails when Spec listed in index cannot be fetched",
+			Client: func(t *testing.T) *http.Client {
+				return NewTestClient(func(req *http.Request) *http.Response {
+					if req.URL.String() != modelInputIndex.URL {
+						return &http.Response{StatusCode: http.StatusNotFound}
+					}
+					return &http.Response{
+						StatusCode: http.StatusOK,
+						Body:       ioutil.NopCloser(bytes.NewBufferString(index)),
+					}
+				})
+			},
+			InputFr:        modelInputIndex,
+			ExpectedStatus: fetchrequest.FixStatus(model.FetchRequestStatusConditionFailed, str.Ptr("While fetching Spec status code: 404"), timestamp),
 		},
 		{
 			Name: "Success with basic authentication",
@@ -195,6 +509,30 @@ func TestService_HandleSpec(t *testing.T) {
 			ExpectedResult: nil,
 			ExpectedStatus: fetchrequest.FixStatus(model.FetchRequestStatusConditionFailed, str.Ptr("While fetching Spec: Invalid data [reason=Credentials not provided]"), timestamp),
 		},
+		{
+			Name: "Success with client certificate and request signing",
+			Client: func(t *testing.T) *http.Client {
+				return NewTestClient(func(req *http.Request) *http.Response {
+					_, _, ok := req.BasicAuth()
+					assert.False(t, ok)
+
+					certificate, ok := httputil.LoadClientCertificateFromContext(req.Context())
+					assert.True(t, ok)
+					assert.Equal(t, httputil.ClientCertificate{Certificate: "certificate", PrivateKey: "key"}, certificate)
+
+					signing, ok := httputil.LoadRequestSigningFromContext(req.Context())
+					assert.True(t, ok)
+					assert.Equal(t, []string{"secret"}, signing.Secrets)
+					return &http.Response{
+						StatusCode: http.StatusOK,
+						Body:       ioutil.NopCloser(bytes.NewBufferString(mockSpec)),
+					}
+				})
+			},
+			InputFr:        modelInputCertificate,
+			ExpectedResult: &mockSpec,
+			ExpectedStatus: fetchrequest.FixStatus(model.FetchRequestStatusConditionSucceeded, nil, timestamp),
+		},
 		{
 			Name: "Success with oauth authentication",
 			Client: func(t *testing.T) *http.Client {
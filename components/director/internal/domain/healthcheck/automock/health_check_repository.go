@@ -2,9 +2,51 @@
 
 package automock
 
-import mock "github.com/stretchr/testify/mock"
+import (
+	context "context"
+
+	model "github.com/kyma-incubator/compass/components/director/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
 
 // HealthCheckRepository is an autogenerated mock type for the HealthCheckRepository type
 type HealthCheckRepository struct {
 	mock.Mock
 }
+
+// List provides a mock function with given fields: ctx, tenant, types, origin, pageSize, cursor
+func (_m *HealthCheckRepository) List(ctx context.Context, tenant string, types []model.HealthCheckType, origin *string, pageSize int, cursor string) (*model.HealthCheckPage, error) {
+	ret := _m.Called(ctx, tenant, types, origin, pageSize, cursor)
+
+	var r0 *model.HealthCheckPage
+	if rf, ok := ret.Get(0).(func(context.Context, string, []model.HealthCheckType, *string, int, string) *model.HealthCheckPage); ok {
+		r0 = rf(ctx, tenant, types, origin, pageSize, cursor)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.HealthCheckPage)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, []model.HealthCheckType, *string, int, string) error); ok {
+		r1 = rf(ctx, tenant, types, origin, pageSize, cursor)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Upsert provides a mock function with given fields: ctx, item
+func (_m *HealthCheckRepository) Upsert(ctx context.Context, item *model.HealthCheck) error {
+	ret := _m.Called(ctx, item)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.HealthCheck) error); ok {
+		r0 = rf(ctx, item)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
@@ -1,4 +1,79 @@
 package healthcheck
 
+import (
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/repo"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+)
+
 type converter struct {
 }
+
+func NewConverter() *converter {
+	return &converter{}
+}
+
+func (c *converter) ToGraphQL(in *model.HealthCheck) *graphql.HealthCheck {
+	if in == nil {
+		return nil
+	}
+
+	origin := in.Origin
+	return &graphql.HealthCheck{
+		Type:      graphql.HealthCheckType(in.Type),
+		Condition: graphql.HealthCheckStatusCondition(in.Condition),
+		Origin:    &origin,
+		Message:   in.Message,
+		Timestamp: graphql.Timestamp(in.Timestamp),
+	}
+}
+
+func (c *converter) MultipleToGraphQL(in []*model.HealthCheck) []*graphql.HealthCheck {
+	healthChecks := make([]*graphql.HealthCheck, 0, len(in))
+	for _, hc := range in {
+		if hc == nil {
+			continue
+		}
+
+		healthChecks = append(healthChecks, c.ToGraphQL(hc))
+	}
+
+	return healthChecks
+}
+
+func (c *converter) TypesFromGraphQL(in []graphql.HealthCheckType) []model.HealthCheckType {
+	if in == nil {
+		return nil
+	}
+
+	types := make([]model.HealthCheckType, 0, len(in))
+	for _, t := range in {
+		types = append(types, model.HealthCheckType(t))
+	}
+
+	return types
+}
+
+func (c *converter) ToEntity(in *model.HealthCheck) *Entity {
+	return &Entity{
+		ID:        in.ID,
+		TenantID:  in.Tenant,
+		Type:      string(in.Type),
+		Origin:    in.Origin,
+		Condition: string(in.Condition),
+		Message:   repo.NewNullableString(in.Message),
+		Timestamp: in.Timestamp,
+	}
+}
+
+func (c *converter) FromEntity(in *Entity) *model.HealthCheck {
+	return &model.HealthCheck{
+		ID:        in.ID,
+		Tenant:    in.TenantID,
+		Type:      model.HealthCheckType(in.Type),
+		Origin:    in.Origin,
+		Condition: model.HealthCheckStatusCondition(in.Condition),
+		Message:   repo.StringPtrFromNullableString(in.Message),
+		Timestamp: in.Timestamp,
+	}
+}
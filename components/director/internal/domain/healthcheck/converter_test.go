@@ -0,0 +1,42 @@
+package healthcheck_test
+
+import (
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/healthcheck"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConverter_ToGraphQL(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		assert.Equal(t, fixGQLHealthCheck(), healthcheck.NewConverter().ToGraphQL(fixHealthCheckModel()))
+	})
+
+	t.Run("nil input", func(t *testing.T) {
+		assert.Nil(t, healthcheck.NewConverter().ToGraphQL(nil))
+	})
+}
+
+func TestConverter_MultipleToGraphQL(t *testing.T) {
+	result := healthcheck.NewConverter().MultipleToGraphQL([]*model.HealthCheck{fixHealthCheckModel(), nil})
+
+	assert.Equal(t, []*graphql.HealthCheck{fixGQLHealthCheck()}, result)
+}
+
+func TestConverter_TypesFromGraphQL(t *testing.T) {
+	conv := healthcheck.NewConverter()
+
+	assert.Nil(t, conv.TypesFromGraphQL(nil))
+	assert.Equal(t, []model.HealthCheckType{model.HealthCheckTypeManagementPlaneApplicationHealthCheck},
+		conv.TypesFromGraphQL([]graphql.HealthCheckType{graphql.HealthCheckTypeManagementPlaneApplicationHealthcheck}))
+}
+
+func TestConverter_EntityConversion(t *testing.T) {
+	conv := healthcheck.NewConverter()
+
+	entity := conv.ToEntity(fixHealthCheckModel())
+	assert.Equal(t, fixHealthCheckEntity(), entity)
+	assert.Equal(t, fixHealthCheckModel(), conv.FromEntity(entity))
+}
@@ -0,0 +1,22 @@
+package healthcheck
+
+import (
+	"database/sql"
+	"time"
+)
+
+type Entity struct {
+	ID        string         `db:"id"`
+	TenantID  string         `db:"tenant_id"`
+	Type      string         `db:"type"`
+	Origin    string         `db:"origin"`
+	Condition string         `db:"condition"`
+	Message   sql.NullString `db:"message"`
+	Timestamp time.Time      `db:"timestamp"`
+}
+
+type EntityCollection []Entity
+
+func (c EntityCollection) Len() int {
+	return len(c)
+}
@@ -0,0 +1,77 @@
+package healthcheck_test
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/healthcheck"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
+	"github.com/kyma-incubator/compass/components/director/pkg/str"
+)
+
+const (
+	healthCheckID = "dddddddd-dddd-dddd-dddd-dddddddddddd"
+	appID         = "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa"
+	tenantID      = "b91b59f7-2563-40b2-aba9-fef726037aa3"
+	extTenantID   = "eeeeeeee-eeee-eeee-eeee-eeeeeeeeeeee"
+	message       = "health check endpoint responded with status code 503"
+)
+
+var fixedTimestamp = time.Date(2021, 3, 30, 9, 0, 0, 0, time.UTC)
+
+func fixHealthCheckModel() *model.HealthCheck {
+	return &model.HealthCheck{
+		ID:        healthCheckID,
+		Tenant:    tenantID,
+		Type:      model.HealthCheckTypeManagementPlaneApplicationHealthCheck,
+		Origin:    appID,
+		Condition: model.HealthCheckStatusConditionFailed,
+		Message:   str.Ptr(message),
+		Timestamp: fixedTimestamp,
+	}
+}
+
+func fixHealthCheckEntity() *healthcheck.Entity {
+	return &healthcheck.Entity{
+		ID:        healthCheckID,
+		TenantID:  tenantID,
+		Type:      string(model.HealthCheckTypeManagementPlaneApplicationHealthCheck),
+		Origin:    appID,
+		Condition: string(model.HealthCheckStatusConditionFailed),
+		Message:   sql.NullString{String: message, Valid: true},
+		Timestamp: fixedTimestamp,
+	}
+}
+
+func fixGQLHealthCheck() *graphql.HealthCheck {
+	return &graphql.HealthCheck{
+		Type:      graphql.HealthCheckTypeManagementPlaneApplicationHealthcheck,
+		Condition: graphql.HealthCheckStatusConditionFailed,
+		Origin:    str.Ptr(appID),
+		Message:   str.Ptr(message),
+		Timestamp: graphql.Timestamp(fixedTimestamp),
+	}
+}
+
+func fixHealthCheckPage() *model.HealthCheckPage {
+	return &model.HealthCheckPage{
+		Data:       []*model.HealthCheck{fixHealthCheckModel()},
+		TotalCount: 1,
+		PageInfo: &pagination.Page{
+			StartCursor: "",
+			EndCursor:   "",
+			HasNextPage: false,
+		},
+	}
+}
+
+func fixHealthCheckColumns() []string {
+	return []string{"id", "tenant_id", "type", "origin", "condition", "message", "timestamp"}
+}
+
+func fixHealthCheckRow() []driver.Value {
+	return []driver.Value{healthCheckID, tenantID, string(model.HealthCheckTypeManagementPlaneApplicationHealthCheck), appID, string(model.HealthCheckStatusConditionFailed), message, fixedTimestamp}
+}
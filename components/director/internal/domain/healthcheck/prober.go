@@ -0,0 +1,251 @@
+package healthcheck
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/tenant"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/timestamp"
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+	"github.com/kyma-incubator/compass/components/director/pkg/str"
+	"github.com/pkg/errors"
+)
+
+const (
+	applicationsPageSize = 200
+
+	// proberLeaseName is the name of the job lease which ensures that the health checks are performed by a single director replica
+	proberLeaseName = "application-health-checks"
+)
+
+// ProberConfig configures the periodic health checks of the applications
+type ProberConfig struct {
+	// Interval between two rounds of health checks. Zero disables the health checks.
+	Interval time.Duration `envconfig:"default=5m,APP_HEALTH_CHECK_INTERVAL"`
+	Timeout  time.Duration `envconfig:"default=10s,APP_HEALTH_CHECK_TIMEOUT"`
+	// Workers is the number of applications which are checked concurrently
+	Workers int `envconfig:"default=10,APP_HEALTH_CHECK_WORKERS"`
+}
+
+//go:generate mockery -name=ApplicationRepository -output=automock -outpkg=automock -case=underscore
+type ApplicationRepository interface {
+	ListGlobal(ctx context.Context, pageSize int, cursor string) (*model.ApplicationPage, error)
+}
+
+//go:generate mockery -name=HealthCheckUpserter -output=automock -outpkg=automock -case=underscore
+type HealthCheckUpserter interface {
+	Upsert(ctx context.Context, in *model.HealthCheck) error
+}
+
+//go:generate mockery -name=LeaseRepository -output=automock -outpkg=automock -case=underscore
+type LeaseRepository interface {
+	TryAcquireGlobal(ctx context.Context, name, holder string, now, until time.Time) (bool, error)
+}
+
+// Prober performs the management plane health checks of the applications by calling their health check URL,
+// or their base URL if they do not have one, and stores the results
+type Prober struct {
+	cfg            ProberConfig
+	transact       persistence.Transactioner
+	appRepo        ApplicationRepository
+	healthCheckSvc HealthCheckUpserter
+	leaseRepo      LeaseRepository
+	holder         string
+	client         *http.Client
+	timestampGen   timestamp.Generator
+}
+
+func NewProber(cfg ProberConfig, transact persistence.Transactioner, appRepo ApplicationRepository, healthCheckSvc HealthCheckUpserter, leaseRepo LeaseRepository, client *http.Client) *Prober {
+	return &Prober{
+		cfg:            cfg,
+		transact:       transact,
+		appRepo:        appRepo,
+		healthCheckSvc: healthCheckSvc,
+		leaseRepo:      leaseRepo,
+		holder:         uuid.New().String(),
+		client:         client,
+		timestampGen:   timestamp.DefaultGenerator(),
+	}
+}
+
+// ProbeApplications checks the health of all applications of all tenants which expose a health check or base URL,
+// using up to the configured number of concurrent workers.
+// The checks are performed only by the replica which holds the lease of the job, the other replicas skip the round.
+// The lease is held for two intervals, so that it is renewed by its holder in the next round and taken over by another replica
+// only if the holder has stopped performing the checks.
+// A failure to store the result for one application does not stop the checks of the others.
+func (p *Prober) ProbeApplications(ctx context.Context) error {
+	acquired, err := p.acquireLease(ctx)
+	if err != nil {
+		return errors.Wrap(err, "while acquiring the lease of the health checks")
+	}
+	if !acquired {
+		log.C(ctx).Debug("Health checks are performed by another instance")
+		return nil
+	}
+
+	apps, err := p.listApps(ctx)
+	if err != nil {
+		return err
+	}
+
+	jobs := make(chan *model.Application)
+	wg := p.startWorkers(ctx, jobs)
+	for _, app := range apps {
+		if healthCheckURL(app) != "" {
+			jobs <- app
+		}
+	}
+	close(jobs)
+	wg.Wait()
+
+	return nil
+}
+
+// startWorkers starts the configured number of workers which check the health of the applications received on the jobs channel
+func (p *Prober) startWorkers(ctx context.Context, jobs <-chan *model.Application) *sync.WaitGroup {
+	workers := p.cfg.Workers
+	if workers < 1 {
+		workers = 1
+	}
+
+	wg := &sync.WaitGroup{}
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for app := range jobs {
+				result := p.probe(ctx, app, healthCheckURL(app))
+				if err := p.store(ctx, app, result); err != nil {
+					log.C(ctx).WithError(err).Errorf("An error has occurred while storing health check of Application with id %q", app.ID)
+				}
+			}
+		}()
+	}
+
+	return wg
+}
+
+func (p *Prober) acquireLease(ctx context.Context) (bool, error) {
+	tx, err := p.transact.Begin()
+	if err != nil {
+		return false, err
+	}
+	defer p.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+	now := time.Now()
+	acquired, err := p.leaseRepo.TryAcquireGlobal(ctx, proberLeaseName, p.holder, now, now.Add(2*p.cfg.Interval))
+	if err != nil {
+		return false, err
+	}
+
+	return acquired, tx.Commit()
+}
+
+func (p *Prober) probe(ctx context.Context, app *model.Application, url string) *model.HealthCheck {
+	result := &model.HealthCheck{
+		Type:      model.HealthCheckTypeManagementPlaneApplicationHealthCheck,
+		Origin:    app.ID,
+		Condition: model.HealthCheckStatusConditionSucceeded,
+	}
+
+	if err := p.call(ctx, url); err != nil {
+		log.C(ctx).WithError(err).Infof("Health check of Application with id %q failed", app.ID)
+		result.Condition = model.HealthCheckStatusConditionFailed
+		result.Message = str.Ptr(err.Error())
+	}
+
+	result.Timestamp = p.timestampGen()
+	return result
+}
+
+func (p *Prober) call(ctx context.Context, url string) error {
+	ctx, cancel := context.WithTimeout(ctx, p.cfg.Timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return errors.Wrap(err, "while creating health check request")
+	}
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "while calling health check endpoint")
+	}
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			log.C(ctx).WithError(err).Warn("An error has occurred while closing response body")
+		}
+	}()
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("health check endpoint responded with status code %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+func (p *Prober) store(ctx context.Context, app *model.Application, result *model.HealthCheck) error {
+	tx, err := p.transact.Begin()
+	if err != nil {
+		return err
+	}
+	defer p.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+	ctx = tenant.SaveToContext(ctx, app.Tenant, "")
+
+	if err := p.healthCheckSvc.Upsert(ctx, result); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (p *Prober) listApps(ctx context.Context) ([]*model.Application, error) {
+	pageCount := 1
+	pageCursor := ""
+	hasNextPage := true
+
+	var apps []*model.Application
+	for hasNextPage {
+		page, err := p.listAppPage(ctx, pageCursor)
+		if err != nil {
+			return nil, errors.Wrapf(err, "error while fetching application page number %d", pageCount)
+		}
+		apps = append(apps, page.Data...)
+		pageCursor = page.PageInfo.EndCursor
+		hasNextPage = page.PageInfo.HasNextPage
+		pageCount++
+	}
+	return apps, nil
+}
+
+func (p *Prober) listAppPage(ctx context.Context, cursor string) (*model.ApplicationPage, error) {
+	tx, err := p.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer p.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+	page, err := p.appRepo.ListGlobal(ctx, applicationsPageSize, cursor)
+	if err != nil {
+		return nil, err
+	}
+	return page, tx.Commit()
+}
+
+func healthCheckURL(app *model.Application) string {
+	if url := str.PtrStrToStr(app.HealthCheckURL); url != "" {
+		return url
+	}
+	return str.PtrStrToStr(app.BaseURL)
+}
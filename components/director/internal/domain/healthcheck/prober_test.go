@@ -0,0 +1,210 @@
+package healthcheck_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/healthcheck"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/healthcheck/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/tenant"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
+	persistenceautomock "github.com/kyma-incubator/compass/components/director/pkg/persistence/automock"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence/txtest"
+	"github.com/kyma-incubator/compass/components/director/pkg/str"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProber_ProbeApplications(t *testing.T) {
+	testErr := errors.New("test error")
+	cfg := healthcheck.ProberConfig{Interval: time.Minute, Timeout: time.Second, Workers: 2}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/healthz" {
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	healthyApp := &model.Application{BaseEntity: &model.BaseEntity{ID: "healthy"}, Tenant: tenantID, HealthCheckURL: str.Ptr(server.URL + "/healthz")}
+	unhealthyApp := &model.Application{BaseEntity: &model.BaseEntity{ID: "unhealthy"}, Tenant: tenantID, BaseURL: str.Ptr(server.URL)}
+	appWithoutURL := &model.Application{BaseEntity: &model.BaseEntity{ID: "without-url"}, Tenant: tenantID}
+	appPage := &model.ApplicationPage{
+		Data:     []*model.Application{healthyApp, unhealthyApp, appWithoutURL},
+		PageInfo: &pagination.Page{HasNextPage: false},
+	}
+
+	resultFor := func(origin string, condition model.HealthCheckStatusCondition) interface{} {
+		return mock.MatchedBy(func(hc *model.HealthCheck) bool {
+			return hc.Origin == origin && hc.Condition == condition && hc.Type == model.HealthCheckTypeManagementPlaneApplicationHealthCheck && !hc.Timestamp.IsZero()
+		})
+	}
+	tenantCtx := mock.MatchedBy(func(ctx context.Context) bool {
+		tnt, err := tenant.LoadFromContext(ctx)
+		return err == nil && tnt == tenantID
+	})
+	leaseFor := func(now time.Time) bool {
+		return time.Since(now) < time.Minute
+	}
+	leaseUntil := func(until time.Time) bool {
+		return time.Until(until) > time.Minute && time.Until(until) <= 2*time.Minute
+	}
+	acquiredLease := func(acquired bool) *automock.LeaseRepository {
+		leaseRepo := &automock.LeaseRepository{}
+		leaseRepo.On("TryAcquireGlobal", txtest.CtxWithDBMatcher(), "application-health-checks", mock.AnythingOfType("string"), mock.MatchedBy(leaseFor), mock.MatchedBy(leaseUntil)).Return(acquired, nil).Once()
+		return leaseRepo
+	}
+
+	t.Run("stores the result of each application", func(t *testing.T) {
+		persist, transact := txtest.NewTransactionContextGenerator(testErr).ThatSucceedsMultipleTimes(4)
+		appRepo := &automock.ApplicationRepository{}
+		appRepo.On("ListGlobal", txtest.CtxWithDBMatcher(), 200, "").Return(appPage, nil).Once()
+		healthCheckSvc := &automock.HealthCheckUpserter{}
+		healthCheckSvc.On("Upsert", tenantCtx, resultFor(healthyApp.ID, model.HealthCheckStatusConditionSucceeded)).Return(nil).Once()
+		healthCheckSvc.On("Upsert", tenantCtx, resultFor(unhealthyApp.ID, model.HealthCheckStatusConditionFailed)).Return(nil).Once()
+		prober := healthcheck.NewProber(cfg, transact, appRepo, healthCheckSvc, acquiredLease(true), server.Client())
+		//WHEN
+		err := prober.ProbeApplications(context.TODO())
+		//THEN
+		require.NoError(t, err)
+		persist.AssertExpectations(t)
+		transact.AssertExpectations(t)
+		appRepo.AssertExpectations(t)
+		healthCheckSvc.AssertExpectations(t)
+	})
+
+	t.Run("records the failure reason", func(t *testing.T) {
+		persist, transact := txtest.NewTransactionContextGenerator(testErr).ThatSucceedsMultipleTimes(3)
+		appRepo := &automock.ApplicationRepository{}
+		appRepo.On("ListGlobal", txtest.CtxWithDBMatcher(), 200, "").Return(&model.ApplicationPage{
+			Data:     []*model.Application{unhealthyApp},
+			PageInfo: &pagination.Page{},
+		}, nil).Once()
+		var stored *model.HealthCheck
+		healthCheckSvc := &automock.HealthCheckUpserter{}
+		healthCheckSvc.On("Upsert", tenantCtx, mock.Anything).Run(func(args mock.Arguments) {
+			stored = args.Get(1).(*model.HealthCheck)
+		}).Return(nil).Once()
+		prober := healthcheck.NewProber(cfg, transact, appRepo, healthCheckSvc, acquiredLease(true), server.Client())
+		//WHEN
+		err := prober.ProbeApplications(context.TODO())
+		//THEN
+		require.NoError(t, err)
+		require.NotNil(t, stored)
+		assert.Equal(t, "health check endpoint responded with status code 503", str.PtrStrToStr(stored.Message))
+		persist.AssertExpectations(t)
+		transact.AssertExpectations(t)
+	})
+
+	t.Run("continues when storing a result fails", func(t *testing.T) {
+		_, transact := txtest.NewTransactionContextGenerator(testErr).ThatSucceedsMultipleTimes(4)
+		appRepo := &automock.ApplicationRepository{}
+		appRepo.On("ListGlobal", txtest.CtxWithDBMatcher(), 200, "").Return(appPage, nil).Once()
+		healthCheckSvc := &automock.HealthCheckUpserter{}
+		healthCheckSvc.On("Upsert", tenantCtx, resultFor(healthyApp.ID, model.HealthCheckStatusConditionSucceeded)).Return(testErr).Once()
+		healthCheckSvc.On("Upsert", tenantCtx, resultFor(unhealthyApp.ID, model.HealthCheckStatusConditionFailed)).Return(nil).Once()
+		prober := healthcheck.NewProber(cfg, transact, appRepo, healthCheckSvc, acquiredLease(true), server.Client())
+		//WHEN
+		err := prober.ProbeApplications(context.TODO())
+		//THEN
+		require.NoError(t, err)
+		appRepo.AssertExpectations(t)
+		healthCheckSvc.AssertExpectations(t)
+	})
+
+	t.Run("returns error when listing applications fails", func(t *testing.T) {
+		persist := &persistenceautomock.PersistenceTx{}
+		persist.On("Commit").Return(nil).Once()
+		transact := &persistenceautomock.Transactioner{}
+		transact.On("Begin").Return(persist, nil).Twice()
+		transact.On("RollbackUnlessCommitted", mock.Anything, persist).Return().Twice()
+		appRepo := &automock.ApplicationRepository{}
+		appRepo.On("ListGlobal", txtest.CtxWithDBMatcher(), 200, "").Return(nil, testErr).Once()
+		prober := healthcheck.NewProber(cfg, transact, appRepo, &automock.HealthCheckUpserter{}, acquiredLease(true), server.Client())
+		//WHEN
+		err := prober.ProbeApplications(context.TODO())
+		//THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), testErr.Error())
+		persist.AssertExpectations(t)
+		transact.AssertExpectations(t)
+		appRepo.AssertExpectations(t)
+	})
+
+	t.Run("skips the round when the lease is held by another instance", func(t *testing.T) {
+		persist, transact := txtest.NewTransactionContextGenerator(testErr).ThatSucceeds()
+		appRepo := &automock.ApplicationRepository{}
+		healthCheckSvc := &automock.HealthCheckUpserter{}
+		leaseRepo := acquiredLease(false)
+		prober := healthcheck.NewProber(cfg, transact, appRepo, healthCheckSvc, leaseRepo, server.Client())
+		//WHEN
+		err := prober.ProbeApplications(context.TODO())
+		//THEN
+		require.NoError(t, err)
+		mock.AssertExpectationsForObjects(t, persist, transact, appRepo, healthCheckSvc, leaseRepo)
+	})
+
+	t.Run("returns error when acquiring the lease fails", func(t *testing.T) {
+		persist, transact := txtest.NewTransactionContextGenerator(testErr).ThatDoesntExpectCommit()
+		leaseRepo := &automock.LeaseRepository{}
+		leaseRepo.On("TryAcquireGlobal", txtest.CtxWithDBMatcher(), "application-health-checks", mock.AnythingOfType("string"), mock.Anything, mock.Anything).Return(false, testErr).Once()
+		prober := healthcheck.NewProber(cfg, transact, &automock.ApplicationRepository{}, &automock.HealthCheckUpserter{}, leaseRepo, server.Client())
+		//WHEN
+		err := prober.ProbeApplications(context.TODO())
+		//THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), testErr.Error())
+		mock.AssertExpectationsForObjects(t, persist, transact, leaseRepo)
+	})
+}
+
+func TestProber_ProbeApplications_BoundsConcurrency(t *testing.T) {
+	const workers = 2
+	const appCount = 6
+
+	var inFlight, maxInFlight int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		current := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+		for {
+			max := atomic.LoadInt32(&maxInFlight)
+			if current <= max || atomic.CompareAndSwapInt32(&maxInFlight, max, current) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	apps := make([]*model.Application, 0, appCount)
+	for i := 0; i < appCount; i++ {
+		apps = append(apps, &model.Application{BaseEntity: &model.BaseEntity{ID: fmt.Sprintf("app-%d", i)}, Tenant: tenantID, BaseURL: str.Ptr(server.URL)})
+	}
+
+	_, transact := txtest.NewTransactionContextGenerator(nil).ThatSucceedsMultipleTimes(appCount + 2)
+	appRepo := &automock.ApplicationRepository{}
+	appRepo.On("ListGlobal", txtest.CtxWithDBMatcher(), 200, "").Return(&model.ApplicationPage{Data: apps, PageInfo: &pagination.Page{}}, nil).Once()
+	healthCheckSvc := &automock.HealthCheckUpserter{}
+	healthCheckSvc.On("Upsert", mock.Anything, mock.Anything).Return(nil).Times(appCount)
+	leaseRepo := &automock.LeaseRepository{}
+	leaseRepo.On("TryAcquireGlobal", txtest.CtxWithDBMatcher(), "application-health-checks", mock.AnythingOfType("string"), mock.Anything, mock.Anything).Return(true, nil).Once()
+	cfg := healthcheck.ProberConfig{Interval: time.Minute, Timeout: time.Second, Workers: workers}
+	prober := healthcheck.NewProber(cfg, transact, appRepo, healthCheckSvc, leaseRepo, server.Client())
+	//WHEN
+	err := prober.ProbeApplications(context.TODO())
+	//THEN
+	require.NoError(t, err)
+	assert.LessOrEqual(t, atomic.LoadInt32(&maxInFlight), int32(workers))
+	mock.AssertExpectationsForObjects(t, transact, appRepo, healthCheckSvc, leaseRepo)
+}
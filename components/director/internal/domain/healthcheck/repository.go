@@ -1,13 +1,79 @@
 package healthcheck
 
 import (
+	"context"
+
 	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/repo"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+)
+
+const healthCheckTable string = `public.health_checks`
+
+var (
+	tenantColumn       = "tenant_id"
+	healthCheckColumns = []string{"id", tenantColumn, "type", "origin", "condition", "message", "timestamp"}
+	conflictColumns    = []string{"type", "origin"}
+	updatableColumns   = []string{"condition", "message", "timestamp"}
 )
 
-type inMemoryRepository struct {
-	store map[string]*model.Runtime
+//go:generate mockery -name=EntityConverter -output=automock -outpkg=automock -case=underscore
+type EntityConverter interface {
+	ToEntity(in *model.HealthCheck) *Entity
+	FromEntity(in *Entity) *model.HealthCheck
+}
+
+type pgRepository struct {
+	conv            EntityConverter
+	upserter        repo.Upserter
+	pageableQuerier repo.PageableQuerier
+}
+
+func NewRepository(conv EntityConverter) *pgRepository {
+	return &pgRepository{
+		conv:            conv,
+		upserter:        repo.NewUpserter(resource.HealthCheck, healthCheckTable, healthCheckColumns, conflictColumns, updatableColumns),
+		pageableQuerier: repo.NewPageableQuerier(resource.HealthCheck, healthCheckTable, tenantColumn, healthCheckColumns),
+	}
+}
+
+// Upsert stores the health check, replacing the previous result of the same type for the same origin
+func (r *pgRepository) Upsert(ctx context.Context, item *model.HealthCheck) error {
+	if item == nil {
+		return apperrors.NewInternalError("item can not be empty")
+	}
+
+	return r.upserter.Upsert(ctx, r.conv.ToEntity(item))
 }
 
-func NewRepository() *inMemoryRepository {
-	return &inMemoryRepository{store: make(map[string]*model.Runtime)}
+func (r *pgRepository) List(ctx context.Context, tenant string, types []model.HealthCheckType, origin *string, pageSize int, cursor string) (*model.HealthCheckPage, error) {
+	var conditions repo.Conditions
+	if len(types) > 0 {
+		values := make([]string, 0, len(types))
+		for _, t := range types {
+			values = append(values, string(t))
+		}
+		conditions = append(conditions, repo.NewInConditionForStringValues("type", values))
+	}
+	if origin != nil {
+		conditions = append(conditions, repo.NewEqualCondition("origin", *origin))
+	}
+
+	var entities EntityCollection
+	page, totalCount, err := r.pageableQuerier.List(ctx, tenant, pageSize, cursor, "id", &entities, conditions...)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*model.HealthCheck, 0, len(entities))
+	for i := range entities {
+		items = append(items, r.conv.FromEntity(&entities[i]))
+	}
+
+	return &model.HealthCheckPage{
+		Data:       items,
+		TotalCount: totalCount,
+		PageInfo:   page,
+	}, nil
 }
@@ -0,0 +1,122 @@
+package healthcheck_test
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/healthcheck"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/healthcheck/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/repo/testdb"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+	"github.com/kyma-incubator/compass/components/director/pkg/str"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPgRepository_Upsert(t *testing.T) {
+	upsertQuery := regexp.QuoteMeta(`INSERT INTO public.health_checks ( id, tenant_id, type, origin, condition, message, timestamp ) VALUES ( ?, ?, ?, ?, ?, ?, ? ) ON CONFLICT ( type, origin ) DO UPDATE SET condition=EXCLUDED.condition, message=EXCLUDED.message, timestamp=EXCLUDED.timestamp`)
+
+	t.Run("success", func(t *testing.T) {
+		sqlxDB, sqlMock := testdb.MockDatabase(t)
+		ctx := persistence.SaveToContext(context.TODO(), sqlxDB)
+		healthCheckModel := fixHealthCheckModel()
+
+		sqlMock.ExpectExec(upsertQuery).
+			WithArgs(fixHealthCheckRow()...).
+			WillReturnResult(sqlmock.NewResult(-1, 1))
+
+		convMock := &automock.EntityConverter{}
+		convMock.On("ToEntity", healthCheckModel).Return(fixHealthCheckEntity()).Once()
+		pgRepository := healthcheck.NewRepository(convMock)
+		//WHEN
+		err := pgRepository.Upsert(ctx, healthCheckModel)
+		//THEN
+		require.NoError(t, err)
+		sqlMock.AssertExpectations(t)
+		convMock.AssertExpectations(t)
+	})
+
+	t.Run("returns error when item is nil", func(t *testing.T) {
+		convMock := &automock.EntityConverter{}
+		pgRepository := healthcheck.NewRepository(convMock)
+		//WHEN
+		err := pgRepository.Upsert(context.TODO(), nil)
+		//THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "item can not be empty")
+		convMock.AssertExpectations(t)
+	})
+}
+
+func TestPgRepository_List(t *testing.T) {
+	types := []model.HealthCheckType{model.HealthCheckTypeManagementPlaneApplicationHealthCheck}
+	selectQuery := regexp.QuoteMeta(`SELECT id, tenant_id, type, origin, condition, message, timestamp FROM public.health_checks WHERE tenant_id = $1 AND type IN ($2) AND origin = $3 ORDER BY id LIMIT 2 OFFSET 0`)
+	countQuery := regexp.QuoteMeta(`SELECT COUNT(*) FROM public.health_checks WHERE tenant_id = $1 AND type IN ($2) AND origin = $3`)
+
+	t.Run("success with filters", func(t *testing.T) {
+		sqlxDB, sqlMock := testdb.MockDatabase(t)
+		ctx := persistence.SaveToContext(context.TODO(), sqlxDB)
+
+		sqlMock.ExpectQuery(selectQuery).
+			WithArgs(tenantID, string(types[0]), appID).
+			WillReturnRows(sqlmock.NewRows(fixHealthCheckColumns()).AddRow(fixHealthCheckRow()...))
+		sqlMock.ExpectQuery(countQuery).
+			WithArgs(tenantID, string(types[0]), appID).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+
+		convMock := &automock.EntityConverter{}
+		convMock.On("FromEntity", fixHealthCheckEntity()).Return(fixHealthCheckModel()).Once()
+		pgRepository := healthcheck.NewRepository(convMock)
+		//WHEN
+		page, err := pgRepository.List(ctx, tenantID, types, str.Ptr(appID), 2, "")
+		//THEN
+		require.NoError(t, err)
+		assert.Equal(t, 1, page.TotalCount)
+		assert.Equal(t, []*model.HealthCheck{fixHealthCheckModel()}, page.Data)
+		assert.False(t, page.PageInfo.HasNextPage)
+		sqlMock.AssertExpectations(t)
+		convMock.AssertExpectations(t)
+	})
+
+	t.Run("success without filters", func(t *testing.T) {
+		sqlxDB, sqlMock := testdb.MockDatabase(t)
+		ctx := persistence.SaveToContext(context.TODO(), sqlxDB)
+
+		sqlMock.ExpectQuery(regexp.QuoteMeta(`SELECT id, tenant_id, type, origin, condition, message, timestamp FROM public.health_checks WHERE tenant_id = $1 ORDER BY id LIMIT 2 OFFSET 0`)).
+			WithArgs(tenantID).
+			WillReturnRows(sqlmock.NewRows(fixHealthCheckColumns()))
+		sqlMock.ExpectQuery(regexp.QuoteMeta(`SELECT COUNT(*) FROM public.health_checks WHERE tenant_id = $1`)).
+			WithArgs(tenantID).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+
+		pgRepository := healthcheck.NewRepository(&automock.EntityConverter{})
+		//WHEN
+		page, err := pgRepository.List(ctx, tenantID, nil, nil, 2, "")
+		//THEN
+		require.NoError(t, err)
+		assert.Equal(t, 0, page.TotalCount)
+		assert.Empty(t, page.Data)
+		sqlMock.AssertExpectations(t)
+	})
+
+	t.Run("DB error", func(t *testing.T) {
+		sqlxDB, sqlMock := testdb.MockDatabase(t)
+		ctx := persistence.SaveToContext(context.TODO(), sqlxDB)
+		testErr := errors.New("test error")
+
+		sqlMock.ExpectQuery(selectQuery).
+			WithArgs(tenantID, string(types[0]), appID).
+			WillReturnError(testErr)
+
+		pgRepository := healthcheck.NewRepository(&automock.EntityConverter{})
+		//WHEN
+		_, err := pgRepository.List(ctx, tenantID, types, str.Ptr(appID), 2, "")
+		//THEN
+		require.Error(t, err)
+		sqlMock.AssertExpectations(t)
+	})
+}
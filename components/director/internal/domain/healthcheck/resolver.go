@@ -3,35 +3,79 @@ package healthcheck
 import (
 	"context"
 
+	"github.com/google/uuid"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
 	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
 )
 
 //go:generate mockery -name=HealthCheckService -output=automock -outpkg=automock -case=underscore
-type HealthCheckService interface{}
+type HealthCheckService interface {
+	List(ctx context.Context, types []model.HealthCheckType, origin *string, pageSize int, cursor string) (*model.HealthCheckPage, error)
+}
 
 //go:generate mockery -name=HealthCheckConverter -output=automock -outpkg=automock -case=underscore
-type HealthCheckConverter interface{}
+type HealthCheckConverter interface {
+	MultipleToGraphQL(in []*model.HealthCheck) []*graphql.HealthCheck
+	TypesFromGraphQL(in []graphql.HealthCheckType) []model.HealthCheckType
+}
 
 type Resolver struct {
+	transact  persistence.Transactioner
 	svc       HealthCheckService
 	converter HealthCheckConverter
 }
 
-func NewResolver(svc HealthCheckService) *Resolver {
+func NewResolver(transact persistence.Transactioner, svc HealthCheckService, converter HealthCheckConverter) *Resolver {
 	return &Resolver{
+		transact:  transact,
 		svc:       svc,
-		converter: &converter{},
+		converter: converter,
 	}
 }
 
 func (r *Resolver) HealthChecks(ctx context.Context, types []graphql.HealthCheckType, origin *string, first *int, after *graphql.PageCursor) (*graphql.HealthCheckPage, error) {
+	var cursor string
+	if after != nil {
+		cursor = string(*after)
+	}
+
+	if first == nil {
+		return nil, apperrors.NewInvalidDataError("missing required parameter 'first'")
+	}
+
+	if origin != nil {
+		if _, err := uuid.Parse(*origin); err != nil {
+			return nil, apperrors.NewInvalidDataError("parameter 'origin' must be a valid UUID")
+		}
+	}
+
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer r.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	healthChecksPage, err := r.svc.List(ctx, r.converter.TypesFromGraphQL(types), origin, *first, cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
 	return &graphql.HealthCheckPage{
-		Data: []*graphql.HealthCheck{},
+		Data:       r.converter.MultipleToGraphQL(healthChecksPage.Data),
+		TotalCount: healthChecksPage.TotalCount,
 		PageInfo: &graphql.PageInfo{
-			HasNextPage: false,
-			EndCursor:   "",
-			StartCursor: "",
+			StartCursor:     graphql.PageCursor(healthChecksPage.PageInfo.StartCursor),
+			EndCursor:       graphql.PageCursor(healthChecksPage.PageInfo.EndCursor),
+			HasNextPage:     healthChecksPage.PageInfo.HasNextPage,
+			HasPreviousPage: healthChecksPage.PageInfo.HasPreviousPage,
 		},
-		TotalCount: 0,
 	}, nil
 }
@@ -0,0 +1,92 @@
+package healthcheck_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/healthcheck"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/healthcheck/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence/txtest"
+	"github.com/kyma-incubator/compass/components/director/pkg/str"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolver_HealthChecks(t *testing.T) {
+	testErr := errors.New("test error")
+	first := 10
+	after := graphql.PageCursor("cursor")
+	gqlTypes := []graphql.HealthCheckType{graphql.HealthCheckTypeManagementPlaneApplicationHealthcheck}
+	types := []model.HealthCheckType{model.HealthCheckTypeManagementPlaneApplicationHealthCheck}
+	txGen := txtest.NewTransactionContextGenerator(testErr)
+
+	t.Run("success", func(t *testing.T) {
+		persist, transact := txGen.ThatSucceeds()
+		svc := &automock.HealthCheckService{}
+		svc.On("List", txtest.CtxWithDBMatcher(), types, str.Ptr(appID), first, string(after)).Return(fixHealthCheckPage(), nil).Once()
+		conv := &automock.HealthCheckConverter{}
+		conv.On("TypesFromGraphQL", gqlTypes).Return(types).Once()
+		conv.On("MultipleToGraphQL", fixHealthCheckPage().Data).Return([]*graphql.HealthCheck{fixGQLHealthCheck()}).Once()
+		resolver := healthcheck.NewResolver(transact, svc, conv)
+		//WHEN
+		page, err := resolver.HealthChecks(context.TODO(), gqlTypes, str.Ptr(appID), &first, &after)
+		//THEN
+		require.NoError(t, err)
+		assert.Equal(t, []*graphql.HealthCheck{fixGQLHealthCheck()}, page.Data)
+		assert.Equal(t, 1, page.TotalCount)
+		persist.AssertExpectations(t)
+		transact.AssertExpectations(t)
+		svc.AssertExpectations(t)
+		conv.AssertExpectations(t)
+	})
+
+	t.Run("returns error when service fails", func(t *testing.T) {
+		persist, transact := txGen.ThatDoesntExpectCommit()
+		svc := &automock.HealthCheckService{}
+		svc.On("List", txtest.CtxWithDBMatcher(), types, (*string)(nil), first, "").Return(nil, testErr).Once()
+		conv := &automock.HealthCheckConverter{}
+		conv.On("TypesFromGraphQL", gqlTypes).Return(types).Once()
+		resolver := healthcheck.NewResolver(transact, svc, conv)
+		//WHEN
+		_, err := resolver.HealthChecks(context.TODO(), gqlTypes, nil, &first, nil)
+		//THEN
+		require.EqualError(t, err, testErr.Error())
+		persist.AssertExpectations(t)
+		transact.AssertExpectations(t)
+		svc.AssertExpectations(t)
+	})
+
+	t.Run("returns error when transaction fails to begin", func(t *testing.T) {
+		_, transact := txGen.ThatFailsOnBegin()
+		resolver := healthcheck.NewResolver(transact, &automock.HealthCheckService{}, &automock.HealthCheckConverter{})
+		//WHEN
+		_, err := resolver.HealthChecks(context.TODO(), gqlTypes, nil, &first, nil)
+		//THEN
+		require.EqualError(t, err, testErr.Error())
+		transact.AssertExpectations(t)
+	})
+
+	t.Run("returns error when first is missing", func(t *testing.T) {
+		resolver := healthcheck.NewResolver(nil, &automock.HealthCheckService{}, &automock.HealthCheckConverter{})
+		//WHEN
+		_, err := resolver.HealthChecks(context.TODO(), gqlTypes, nil, nil, nil)
+		//THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "missing required parameter 'first'")
+	})
+
+	t.Run("returns error when origin is not a UUID", func(t *testing.T) {
+		resolver := healthcheck.NewResolver(nil, &automock.HealthCheckService{}, &automock.HealthCheckConverter{})
+		origin := "not-a-uuid"
+		//WHEN
+		_, err := resolver.HealthChecks(context.TODO(), gqlTypes, &origin, &first, nil)
+		//THEN
+		require.Error(t, err)
+		assert.Equal(t, apperrors.InvalidData, apperrors.ErrorCode(err))
+		assert.Contains(t, err.Error(), "parameter 'origin' must be a valid UUID")
+	})
+}
@@ -1,13 +1,62 @@
 package healthcheck
 
+import (
+	"context"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/tenant"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/pkg/errors"
+)
+
 //go:generate mockery -name=HealthCheckRepository -output=automock -outpkg=automock -case=underscore
 type HealthCheckRepository interface {
+	Upsert(ctx context.Context, item *model.HealthCheck) error
+	List(ctx context.Context, tenant string, types []model.HealthCheckType, origin *string, pageSize int, cursor string) (*model.HealthCheckPage, error)
+}
+
+//go:generate mockery -name=UIDService -output=automock -outpkg=automock -case=underscore
+type UIDService interface {
+	Generate() string
 }
 
 type service struct {
-	repo HealthCheckRepository
+	repo       HealthCheckRepository
+	uidService UIDService
+}
+
+func NewService(repo HealthCheckRepository, uidService UIDService) *service {
+	return &service{
+		repo:       repo,
+		uidService: uidService,
+	}
 }
 
-func NewService(repo HealthCheckRepository) *service {
-	return &service{repo: repo}
+// Upsert stores the result of a health check, replacing the previous result of the same type for the same origin
+func (s *service) Upsert(ctx context.Context, in *model.HealthCheck) error {
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	in.ID = s.uidService.Generate()
+	in.Tenant = tnt
+	if err := s.repo.Upsert(ctx, in); err != nil {
+		return errors.Wrapf(err, "while upserting %s health check for origin with id %s", in.Type, in.Origin)
+	}
+
+	return nil
+}
+
+func (s *service) List(ctx context.Context, types []model.HealthCheckType, origin *string, pageSize int, cursor string) (*model.HealthCheckPage, error) {
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if pageSize < 1 || pageSize > 200 {
+		return nil, apperrors.NewInvalidDataError("page size must be between 1 and 200")
+	}
+
+	return s.repo.List(ctx, tnt, types, origin, pageSize, cursor)
 }
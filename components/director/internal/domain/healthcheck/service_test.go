@@ -0,0 +1,97 @@
+package healthcheck_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/healthcheck"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/healthcheck/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/tenant"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/str"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_Upsert(t *testing.T) {
+	ctx := tenant.SaveToContext(context.TODO(), tenantID, extTenantID)
+	testErr := errors.New("test error")
+
+	t.Run("success", func(t *testing.T) {
+		in := fixHealthCheckModel()
+		in.ID = ""
+		in.Tenant = ""
+
+		repo := &automock.HealthCheckRepository{}
+		repo.On("Upsert", ctx, fixHealthCheckModel()).Return(nil).Once()
+		uidSvc := &automock.UIDService{}
+		uidSvc.On("Generate").Return(healthCheckID).Once()
+		svc := healthcheck.NewService(repo, uidSvc)
+		//WHEN
+		err := svc.Upsert(ctx, in)
+		//THEN
+		require.NoError(t, err)
+		repo.AssertExpectations(t)
+		uidSvc.AssertExpectations(t)
+	})
+
+	t.Run("returns error when repository fails", func(t *testing.T) {
+		repo := &automock.HealthCheckRepository{}
+		repo.On("Upsert", ctx, fixHealthCheckModel()).Return(testErr).Once()
+		uidSvc := &automock.UIDService{}
+		uidSvc.On("Generate").Return(healthCheckID).Once()
+		svc := healthcheck.NewService(repo, uidSvc)
+		//WHEN
+		err := svc.Upsert(ctx, fixHealthCheckModel())
+		//THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), testErr.Error())
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("returns error when tenant is missing", func(t *testing.T) {
+		svc := healthcheck.NewService(&automock.HealthCheckRepository{}, &automock.UIDService{})
+		//WHEN
+		err := svc.Upsert(context.TODO(), fixHealthCheckModel())
+		//THEN
+		require.Error(t, err)
+	})
+}
+
+func TestService_List(t *testing.T) {
+	ctx := tenant.SaveToContext(context.TODO(), tenantID, extTenantID)
+	types := []model.HealthCheckType{model.HealthCheckTypeManagementPlaneApplicationHealthCheck}
+
+	t.Run("success", func(t *testing.T) {
+		repo := &automock.HealthCheckRepository{}
+		repo.On("List", ctx, tenantID, types, str.Ptr(appID), 10, "cursor").Return(fixHealthCheckPage(), nil).Once()
+		svc := healthcheck.NewService(repo, &automock.UIDService{})
+		//WHEN
+		page, err := svc.List(ctx, types, str.Ptr(appID), 10, "cursor")
+		//THEN
+		require.NoError(t, err)
+		assert.Equal(t, fixHealthCheckPage(), page)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("returns error when page size is out of range", func(t *testing.T) {
+		svc := healthcheck.NewService(&automock.HealthCheckRepository{}, &automock.UIDService{})
+		for _, pageSize := range []int{0, 201} {
+			//WHEN
+			_, err := svc.List(ctx, types, nil, pageSize, "")
+			//THEN
+			require.Error(t, err)
+			assert.Equal(t, apperrors.InvalidData, apperrors.ErrorCode(err))
+		}
+	})
+
+	t.Run("returns error when tenant is missing", func(t *testing.T) {
+		svc := healthcheck.NewService(&automock.HealthCheckRepository{}, &automock.UIDService{})
+		//WHEN
+		_, err := svc.List(context.TODO(), types, nil, 10, "")
+		//THEN
+		require.Error(t, err)
+	})
+}
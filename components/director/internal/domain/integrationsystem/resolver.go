@@ -40,6 +40,17 @@ type SystemAuthConverter interface {
 type OAuth20Service interface {
 	DeleteMultipleClientCredentials(ctx context.Context, auths []model.SystemAuth) error
 }
+
+//go:generate mockery -name=WebhookService -output=automock -outpkg=automock -case=underscore
+type WebhookService interface {
+	ListForIntegrationSystem(ctx context.Context, integrationSystemID string) ([]*model.Webhook, error)
+}
+
+//go:generate mockery -name=WebhookConverter -output=automock -outpkg=automock -case=underscore
+type WebhookConverter interface {
+	MultipleToGraphQL(in []*model.Webhook) ([]*graphql.Webhook, error)
+}
+
 type Resolver struct {
 	transact persistence.Transactioner
 
@@ -48,9 +59,11 @@ type Resolver struct {
 	oAuth20Svc       OAuth20Service
 	intSysConverter  IntegrationSystemConverter
 	sysAuthConverter SystemAuthConverter
+	webhookSvc       WebhookService
+	webhookConverter WebhookConverter
 }
 
-func NewResolver(transact persistence.Transactioner, intSysSvc IntegrationSystemService, sysAuthSvc SystemAuthService, oAuth20Svc OAuth20Service, intSysConverter IntegrationSystemConverter, sysAuthConverter SystemAuthConverter) *Resolver {
+func NewResolver(transact persistence.Transactioner, intSysSvc IntegrationSystemService, sysAuthSvc SystemAuthService, oAuth20Svc OAuth20Service, intSysConverter IntegrationSystemConverter, sysAuthConverter SystemAuthConverter, webhookSvc WebhookService, webhookConverter WebhookConverter) *Resolver {
 	return &Resolver{
 		transact:         transact,
 		intSysSvc:        intSysSvc,
@@ -58,6 +71,8 @@ func NewResolver(transact persistence.Transactioner, intSysSvc IntegrationSystem
 		oAuth20Svc:       oAuth20Svc,
 		intSysConverter:  intSysConverter,
 		sysAuthConverter: sysAuthConverter,
+		webhookSvc:       webhookSvc,
+		webhookConverter: webhookConverter,
 	}
 }
 
@@ -119,9 +134,10 @@ func (r *Resolver) IntegrationSystems(ctx context.Context, first *int, after *gr
 		Data:       gqlIntSys,
 		TotalCount: intSysPage.TotalCount,
 		PageInfo: &graphql.PageInfo{
-			StartCursor: graphql.PageCursor(intSysPage.PageInfo.StartCursor),
-			EndCursor:   graphql.PageCursor(intSysPage.PageInfo.EndCursor),
-			HasNextPage: intSysPage.PageInfo.HasNextPage,
+			StartCursor:     graphql.PageCursor(intSysPage.PageInfo.StartCursor),
+			EndCursor:       graphql.PageCursor(intSysPage.PageInfo.EndCursor),
+			HasNextPage:     intSysPage.PageInfo.HasNextPage,
+			HasPreviousPage: intSysPage.PageInfo.HasPreviousPage,
 		},
 	}, nil
 }
@@ -260,3 +276,28 @@ func (r *Resolver) Auths(ctx context.Context, obj *graphql.IntegrationSystem) ([
 
 	return out, nil
 }
+
+func (r *Resolver) Webhooks(ctx context.Context, obj *graphql.IntegrationSystem) ([]*graphql.Webhook, error) {
+	if obj == nil {
+		return nil, apperrors.NewInternalError("Integration System cannot be empty")
+	}
+
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer r.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	webhooks, err := r.webhookSvc.ListForIntegrationSystem(ctx, obj.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return r.webhookConverter.MultipleToGraphQL(webhooks)
+}
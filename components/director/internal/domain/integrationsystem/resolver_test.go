@@ -2,8 +2,11 @@ package integrationsystem_test
 
 import (
 	"context"
+	"errors"
 	"testing"
 
+	"github.com/kyma-incubator/compass/components/director/pkg/str"
+
 	"github.com/kyma-incubator/compass/components/director/pkg/resource"
 
 	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
@@ -116,7 +119,7 @@ func TestResolver_IntegrationSystem(t *testing.T) {
 			intSysSvc := testCase.IntSysSvcFn()
 			intSysConv := testCase.IntSysConvFn()
 
-			resolver := integrationsystem.NewResolver(transact, intSysSvc, nil, nil, intSysConv, nil)
+			resolver := integrationsystem.NewResolver(transact, intSysSvc, nil, nil, intSysConv, nil, nil, nil)
 
 			// WHEN
 			result, err := resolver.IntegrationSystem(ctx, testID)
@@ -228,7 +231,7 @@ func TestResolver_IntegrationSystems(t *testing.T) {
 			intSysSvc := testCase.IntSysSvcFn()
 			intSysConv := testCase.IntSysConvFn()
 
-			resolver := integrationsystem.NewResolver(transact, intSysSvc, nil, nil, intSysConv, nil)
+			resolver := integrationsystem.NewResolver(transact, intSysSvc, nil, nil, intSysConv, nil, nil, nil)
 
 			// WHEN
 			result, err := resolver.IntegrationSystems(ctx, &first, &gqlAfter)
@@ -355,7 +358,7 @@ func TestResolver_CreateIntegrationSystem(t *testing.T) {
 			intSysSvc := testCase.IntSysSvcFn()
 			intSysConv := testCase.IntSysConvFn()
 
-			resolver := integrationsystem.NewResolver(transact, intSysSvc, nil, nil, intSysConv, nil)
+			resolver := integrationsystem.NewResolver(transact, intSysSvc, nil, nil, intSysConv, nil, nil, nil)
 
 			// WHEN
 			result, err := resolver.RegisterIntegrationSystem(ctx, gqlIntSysInput)
@@ -481,7 +484,7 @@ func TestResolver_UpdateIntegrationSystem(t *testing.T) {
 			intSysSvc := testCase.IntSysSvcFn()
 			intSysConv := testCase.IntSysConvFn()
 
-			resolver := integrationsystem.NewResolver(transact, intSysSvc, nil, nil, intSysConv, nil)
+			resolver := integrationsystem.NewResolver(transact, intSysSvc, nil, nil, intSysConv, nil, nil, nil)
 
 			// WHEN
 			result, err := resolver.UpdateIntegrationSystem(ctx, testID, gqlIntSysInput)
@@ -701,7 +704,7 @@ func TestResolver_UnregisterIntegrationSystem(t *testing.T) {
 			intSysConv := testCase.IntSysConvFn()
 			sysAuthSvc := testCase.SysAuthSvcFn()
 			oAuth20Svc := testCase.OAuth20SvcFn()
-			resolver := integrationsystem.NewResolver(transact, intSysSvc, sysAuthSvc, oAuth20Svc, intSysConv, nil)
+			resolver := integrationsystem.NewResolver(transact, intSysSvc, sysAuthSvc, oAuth20Svc, intSysConv, nil, nil, nil)
 
 			// WHEN
 			result, err := resolver.UnregisterIntegrationSystem(ctx, testID)
@@ -819,7 +822,7 @@ func TestResolver_Auths(t *testing.T) {
 			sysAuthSvc := testCase.SysAuthSvcFn()
 			sysAuthConv := testCase.SysAuthConvFn()
 
-			resolver := integrationsystem.NewResolver(transact, nil, sysAuthSvc, nil, nil, sysAuthConv)
+			resolver := integrationsystem.NewResolver(transact, nil, sysAuthSvc, nil, nil, sysAuthConv, nil, nil)
 
 			// WHEN
 			result, err := resolver.Auths(ctx, parentIntegrationSystem)
@@ -841,7 +844,7 @@ func TestResolver_Auths(t *testing.T) {
 	}
 
 	t.Run("Error when parent object is nil", func(t *testing.T) {
-		resolver := integrationsystem.NewResolver(nil, nil, nil, nil, nil, nil)
+		resolver := integrationsystem.NewResolver(nil, nil, nil, nil, nil, nil, nil, nil)
 
 		// WHEN
 		result, err := resolver.Auths(context.TODO(), nil)
@@ -889,3 +892,112 @@ func fixOAuths() []model.SystemAuth {
 		},
 	}
 }
+
+func TestResolver_Webhooks(t *testing.T) {
+	// GIVEN
+	testErr := errors.New("test error")
+	txGen := txtest.NewTransactionContextGenerator(testErr)
+	modelWebhooks := []*model.Webhook{{ID: "wh-1", IntegrationSystemID: str.Ptr(testID), Type: model.WebhookTypeConfigurationChanged}}
+	gqlWebhooks := []*graphql.Webhook{{ID: "wh-1", IntegrationSystemID: str.Ptr(testID), Type: graphql.WebhookTypeConfigurationChanged}}
+	gqlIntSys := fixGQLIntegrationSystem(testID, testName)
+
+	testCases := []struct {
+		Name               string
+		TxFn               func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		WebhookServiceFn   func() *automock.WebhookService
+		WebhookConverterFn func() *automock.WebhookConverter
+		ExpectedOutput     []*graphql.Webhook
+		ExpectedError      error
+	}{
+		{
+			Name: "Success",
+			TxFn: txGen.ThatSucceeds,
+			WebhookServiceFn: func() *automock.WebhookService {
+				svc := &automock.WebhookService{}
+				svc.On("ListForIntegrationSystem", txtest.CtxWithDBMatcher(), testID).Return(modelWebhooks, nil).Once()
+				return svc
+			},
+			WebhookConverterFn: func() *automock.WebhookConverter {
+				conv := &automock.WebhookConverter{}
+				conv.On("MultipleToGraphQL", modelWebhooks).Return(gqlWebhooks, nil).Once()
+				return conv
+			},
+			ExpectedOutput: gqlWebhooks,
+		},
+		{
+			Name: "Returns error when webhook listing failed",
+			TxFn: txGen.ThatDoesntExpectCommit,
+			WebhookServiceFn: func() *automock.WebhookService {
+				svc := &automock.WebhookService{}
+				svc.On("ListForIntegrationSystem", txtest.CtxWithDBMatcher(), testID).Return(nil, testErr).Once()
+				return svc
+			},
+			WebhookConverterFn: func() *automock.WebhookConverter {
+				return &automock.WebhookConverter{}
+			},
+			ExpectedError: testErr,
+		},
+		{
+			Name: "Returns error when beginning transaction failed",
+			TxFn: txGen.ThatFailsOnBegin,
+			WebhookServiceFn: func() *automock.WebhookService {
+				return &automock.WebhookService{}
+			},
+			WebhookConverterFn: func() *automock.WebhookConverter {
+				return &automock.WebhookConverter{}
+			},
+			ExpectedError: testErr,
+		},
+		{
+			Name: "Returns error when committing transaction failed",
+			TxFn: txGen.ThatFailsOnCommit,
+			WebhookServiceFn: func() *automock.WebhookService {
+				svc := &automock.WebhookService{}
+				svc.On("ListForIntegrationSystem", txtest.CtxWithDBMatcher(), testID).Return(modelWebhooks, nil).Once()
+				return svc
+			},
+			WebhookConverterFn: func() *automock.WebhookConverter {
+				return &automock.WebhookConverter{}
+			},
+			ExpectedError: testErr,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			persist, transact := testCase.TxFn()
+			webhookSvc := testCase.WebhookServiceFn()
+			webhookConv := testCase.WebhookConverterFn()
+
+			resolver := integrationsystem.NewResolver(transact, nil, nil, nil, nil, nil, webhookSvc, webhookConv)
+
+			// WHEN
+			result, err := resolver.Webhooks(context.TODO(), gqlIntSys)
+
+			// THEN
+			if testCase.ExpectedError != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, testCase.ExpectedOutput, result)
+
+			persist.AssertExpectations(t)
+			transact.AssertExpectations(t)
+			webhookSvc.AssertExpectations(t)
+			webhookConv.AssertExpectations(t)
+		})
+	}
+
+	t.Run("Returns error when integration system is nil", func(t *testing.T) {
+		resolver := integrationsystem.NewResolver(nil, nil, nil, nil, nil, nil, nil, nil)
+
+		// WHEN
+		_, err := resolver.Webhooks(context.TODO(), nil)
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "Integration System cannot be empty")
+	})
+}
@@ -9,20 +9,33 @@ import (
 	"github.com/google/uuid"
 	"github.com/kyma-incubator/compass/components/director/internal/labelfilter"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/repo"
 )
 
 // SetCombination type defines possible result set combination for querying
 type SetCombination string
 
 const (
-	IntersectSet           SetCombination = "INTERSECT"
-	ExceptSet              SetCombination = "EXCEPT"
-	UnionSet               SetCombination = "UNION"
-	scenariosLabelKey      string         = "SCENARIOS"
-	stmtPrefixFormat       string         = `SELECT "%s" FROM %s WHERE "%s" IS NOT NULL AND "tenant_id" = ?`
-	stmtPrefixGlobalFormat string         = `SELECT "%s" FROM %s WHERE "%s" IS NOT NULL`
+	IntersectSet              SetCombination = "INTERSECT"
+	ExceptSet                 SetCombination = "EXCEPT"
+	UnionSet                  SetCombination = "UNION"
+	scenariosLabelKey         string         = "SCENARIOS"
+	stmtPrefixFormat          string         = `SELECT "%s" FROM %s WHERE "%s" IS NOT NULL AND "tenant_id" = ?`
+	stmtPrefixGlobalFormat    string         = `SELECT "%s" FROM %s WHERE "%s" IS NOT NULL`
+	notExistsStmtFormat       string         = `SELECT "id" FROM %s WHERE "tenant_id" = ? AND "id" NOT IN (SELECT "%s" FROM %s WHERE "%s" IS NOT NULL AND "key" = ?)`
+	notExistsStmtGlobalFormat string         = `SELECT "id" FROM %s WHERE "id" NOT IN (SELECT "%s" FROM %s WHERE "%s" IS NOT NULL AND "key" = ?)`
 )
 
+// filterStatements holds the statements from which the filter query is built
+type filterStatements struct {
+	// prefix selects the objects which have labels, it is followed by the conditions on the label key and value
+	prefix string
+	// notExists selects the objects which do not have the label with the key given as the last argument
+	notExists string
+	// args are the arguments of both statements, apart from the label key
+	args []interface{}
+}
+
 // FilterQuery builds select query for given filters
 //
 // It supports querying defined by `queryFor` parameter. All queries are created
@@ -50,9 +63,12 @@ func FilterQueryGlobal(queryFor model.LabelableObject, setCombination SetCombina
 
 	objectField := labelableObjectField(queryFor)
 
-	stmtPrefix := fmt.Sprintf(stmtPrefixGlobalFormat, objectField, tableName, objectField)
+	stmts := filterStatements{
+		prefix:    fmt.Sprintf(stmtPrefixGlobalFormat, objectField, tableName, objectField),
+		notExists: fmt.Sprintf(notExistsStmtGlobalFormat, labelableObjectTable(queryFor), objectField, tableName, objectField),
+	}
 
-	return buildFilterQuery(stmtPrefix, nil, setCombination, filter, false)
+	return buildFilterQuery(stmts, setCombination, filter, false)
 }
 
 func filterQuery(queryFor model.LabelableObject, setCombination SetCombination, tenant uuid.UUID, filter []*labelfilter.LabelFilter, isSubQuery bool) (string, []interface{}, error) {
@@ -62,14 +78,16 @@ func filterQuery(queryFor model.LabelableObject, setCombination SetCombination,
 
 	objectField := labelableObjectField(queryFor)
 
-	stmtPrefix := fmt.Sprintf(stmtPrefixFormat, objectField, tableName, objectField)
-	var stmtPrefixArgs []interface{}
-	stmtPrefixArgs = append(stmtPrefixArgs, tenant)
+	stmts := filterStatements{
+		prefix:    fmt.Sprintf(stmtPrefixFormat, objectField, tableName, objectField),
+		notExists: fmt.Sprintf(notExistsStmtFormat, labelableObjectTable(queryFor), objectField, tableName, objectField),
+		args:      []interface{}{tenant},
+	}
 
-	return buildFilterQuery(stmtPrefix, stmtPrefixArgs, setCombination, filter, isSubQuery)
+	return buildFilterQuery(stmts, setCombination, filter, isSubQuery)
 }
 
-func buildFilterQuery(stmtPrefix string, stmtPrefixArgs []interface{}, setCombination SetCombination, filter []*labelfilter.LabelFilter, isSubQuery bool) (string, []interface{}, error) {
+func buildFilterQuery(stmts filterStatements, setCombination SetCombination, filter []*labelfilter.LabelFilter, isSubQuery bool) (string, []interface{}, error) {
 	var queryBuilder strings.Builder
 
 	var args []interface{}
@@ -78,44 +96,121 @@ func buildFilterQuery(stmtPrefix string, stmtPrefixArgs []interface{}, setCombin
 			queryBuilder.WriteString(fmt.Sprintf(` %s `, setCombination))
 		}
 
-		queryBuilder.WriteString(stmtPrefix)
-		if stmtPrefixArgs != nil && len(stmtPrefixArgs) > 0 {
-			args = append(args, stmtPrefixArgs...)
+		stmt, stmtArgs, err := buildFilterStatement(stmts, lblFilter)
+		if err != nil {
+			return "", nil, err
 		}
 
-		// TODO: for optimization it can be detected if the given Key was already added to the query
-		// if so, it can be omitted
-		queryBuilder.WriteString(` AND "key" = ?`)
-
-		args = append(args, lblFilter.Key)
-
-		if lblFilter.Query != nil {
-			queryValue := *lblFilter.Query
-			// Handling the Scenarios label case - we assume that Query is
-			// in SQL/JSON path format supported by PostgreSQL 12. Till it
-			// is not production ready, we need to transform the Query from
-			// SQL/JSON path to old JSON queries.
-			if strings.ToUpper(lblFilter.Key) == scenariosLabelKey {
-				extractedValues, err := ExtractValueFromJSONPath(queryValue)
-				if err != nil {
-					return "", nil, errors.Wrap(err, "while extracting value from JSON path")
-				}
-
-				args = append(args, extractedValues...)
-
-				queryValues := make([]string, len(extractedValues))
-				for idx := range extractedValues {
-					queryValues[idx] = "?"
-				}
-				queryValue = `array[` + strings.Join(queryValues, ",") + `]`
-
-				queryBuilder.WriteString(fmt.Sprintf(` AND "value" ?| %s`, queryValue))
-			} else {
-				args = append(args, queryValue)
-				queryBuilder.WriteString(` AND "value" @> ?`)
+		queryBuilder.WriteString(stmt)
+		args = append(args, stmtArgs...)
+	}
+
+	return queryBuilder.String(), args, nil
+}
+
+// buildFilterStatement selects the objects matching the filter or, if there are any, one of its alternatives
+func buildFilterStatement(stmts filterStatements, lblFilter *labelfilter.LabelFilter) (string, []interface{}, error) {
+	if len(lblFilter.Or) == 0 {
+		return buildLabelStatement(stmts, lblFilter)
+	}
+
+	alternatives := append([]*labelfilter.LabelFilter{{Key: lblFilter.Key, Query: lblFilter.Query}}, lblFilter.Or...)
+
+	var args []interface{}
+	statements := make([]string, 0, len(alternatives))
+	for _, alternative := range alternatives {
+		stmt, stmtArgs, err := buildFilterStatement(stmts, alternative)
+		if err != nil {
+			return "", nil, err
+		}
+
+		statements = append(statements, stmt)
+		args = append(args, stmtArgs...)
+	}
+
+	return fmt.Sprintf("(%s)", strings.Join(statements, fmt.Sprintf(` %s `, UnionSet))), args, nil
+}
+
+func buildLabelStatement(stmts filterStatements, lblFilter *labelfilter.LabelFilter) (string, []interface{}, error) {
+	args := append([]interface{}{}, stmts.args...)
+	args = append(args, lblFilter.Key)
+
+	if lblFilter.Query != nil && repo.IsLabelSelector(*lblFilter.Query) {
+		return buildLabelSelectorStatement(stmts, args, *lblFilter.Query)
+	}
+
+	var queryBuilder strings.Builder
+	queryBuilder.WriteString(stmts.prefix)
+
+	// TODO: for optimization it can be detected if the given Key was already added to the query
+	// if so, it can be omitted
+	queryBuilder.WriteString(` AND "key" = ?`)
+
+	if lblFilter.Query != nil {
+		queryValue := *lblFilter.Query
+		switch {
+		// Handling the Scenarios label case - we assume that Query is
+		// in SQL/JSON path format supported by PostgreSQL 12. Till it
+		// is not production ready, we need to transform the Query from
+		// SQL/JSON path to old JSON queries.
+		case strings.ToUpper(lblFilter.Key) == scenariosLabelKey:
+			extractedValues, err := ExtractValueFromJSONPath(queryValue)
+			if err != nil {
+				return "", nil, errors.Wrap(err, "while extracting value from JSON path")
+			}
+
+			args = append(args, extractedValues...)
+
+			queryValues := make([]string, len(extractedValues))
+			for idx := range extractedValues {
+				queryValues[idx] = "?"
 			}
+			queryValue = `array[` + strings.Join(queryValues, ",") + `]`
+
+			queryBuilder.WriteString(fmt.Sprintf(` AND "value" ?| %s`, queryValue))
+		default:
+			args = append(args, queryValue)
+			queryBuilder.WriteString(` AND "value" @> ?`)
 		}
 	}
 
 	return queryBuilder.String(), args, nil
 }
+
+// buildLabelSelectorStatement selects the objects whose label matches the selector and, if the selector matches missing labels,
+// the objects without the label
+func buildLabelSelectorStatement(stmts filterStatements, args []interface{}, query string) (string, []interface{}, error) {
+	selector, err := repo.ParseLabelSelector(`"value"`, query)
+	if err != nil {
+		return "", nil, err
+	}
+
+	if selector.Condition == nil {
+		return stmts.notExists, args, nil
+	}
+
+	stmt := fmt.Sprintf(`%s AND "key" = ? AND %s`, stmts.prefix, selector.Condition.GetQueryPart())
+	stmtArgs := append([]interface{}{}, args...)
+	if condArgs, ok := selector.Condition.GetQueryArgs(); ok {
+		stmtArgs = append(stmtArgs, condArgs...)
+	}
+
+	if !selector.MatchesMissing {
+		return stmt, stmtArgs, nil
+	}
+
+	return fmt.Sprintf(`(%s %s %s)`, stmt, UnionSet, stmts.notExists), append(stmtArgs, args...), nil
+}
+
+func labelableObjectTable(objectType model.LabelableObject) string {
+	switch objectType {
+	case model.ApplicationLabelableObject:
+		return "public.applications"
+	case model.RuntimeLabelableObject:
+		return "public.runtimes"
+	case model.RuntimeContextLabelableObject:
+		return "public.runtime_contexts"
+	}
+
+	return ""
+}
@@ -6,9 +6,11 @@ import (
 	"github.com/google/uuid"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/kyma-incubator/compass/components/director/internal/labelfilter"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/str"
 )
 
 func Test_FilterQuery(t *testing.T) {
@@ -462,3 +464,88 @@ func TestFilterSubquery(t *testing.T) {
 		})
 	}
 }
+
+func Test_FilterQueryWithLabelSelectors(t *testing.T) {
+	tenantID := uuid.New()
+
+	stmtPrefix := `SELECT "app_id" FROM public.labels ` +
+		`WHERE "app_id" IS NOT NULL AND "tenant_id" = ?`
+	notExistsStmt := `SELECT "id" FROM public.applications WHERE "tenant_id" = ? ` +
+		`AND "id" NOT IN (SELECT "app_id" FROM public.labels WHERE "app_id" IS NOT NULL AND "key" = ?)`
+	equalCondition := `EXISTS (SELECT 1 FROM jsonb_array_elements(CASE WHEN jsonb_typeof("value") = 'array' THEN "value" ELSE jsonb_build_array("value") END) AS elements(element) WHERE element = ?)`
+
+	testCases := []struct {
+		Name                string
+		FilterInput         []*labelfilter.LabelFilter
+		ExpectedQueryFilter string
+		ExpectedArgs        []interface{}
+		ExpectedErr         string
+	}{
+		{
+			Name:                "Query for label with selector",
+			FilterInput:         []*labelfilter.LabelFilter{labelfilter.NewForKeyWithQuery("Foo", `= "foo"`)},
+			ExpectedQueryFilter: stmtPrefix + ` AND "key" = ? AND ` + equalCondition,
+			ExpectedArgs:        []interface{}{tenantID, "Foo", `"foo"`},
+		},
+		{
+			Name:                "Query for scenarios with selector",
+			FilterInput:         []*labelfilter.LabelFilter{labelfilter.NewForKeyWithQuery("Scenarios", `= "foo"`)},
+			ExpectedQueryFilter: stmtPrefix + ` AND "key" = ? AND ` + equalCondition,
+			ExpectedArgs:        []interface{}{tenantID, "Scenarios", `"foo"`},
+		},
+		{
+			Name:                "Query for objects without label",
+			FilterInput:         []*labelfilter.LabelFilter{labelfilter.NewForKey("Foo"), labelfilter.NewForKeyWithQuery("Bar", ` !exists `)},
+			ExpectedQueryFilter: stmtPrefix + ` AND "key" = ? INTERSECT ` + notExistsStmt,
+			ExpectedArgs:        []interface{}{tenantID, "Foo", tenantID, "Bar"},
+		},
+		{
+			Name:                "Query for objects without label or with label matching selector",
+			FilterInput:         []*labelfilter.LabelFilter{labelfilter.NewForKeyWithQuery("Foo", `!exists || = "foo"`)},
+			ExpectedQueryFilter: `(` + stmtPrefix + ` AND "key" = ? AND ` + equalCondition + ` UNION ` + notExistsStmt + `)`,
+			ExpectedArgs:        []interface{}{tenantID, "Foo", `"foo"`, tenantID, "Foo"},
+		},
+		{
+			Name: "Query for alternative labels",
+			FilterInput: []*labelfilter.LabelFilter{
+				labelfilter.NewForKey("Foo"),
+				{
+					Key:   "Bar",
+					Query: str.Ptr(`= "foo"`),
+					Or:    []*labelfilter.LabelFilter{labelfilter.NewForKeyWithQuery("Baz", "!exists")},
+				},
+			},
+			ExpectedQueryFilter: stmtPrefix + ` AND "key" = ? INTERSECT (` + stmtPrefix + ` AND "key" = ? AND ` + equalCondition + ` UNION ` + notExistsStmt + `)`,
+			ExpectedArgs:        []interface{}{tenantID, "Foo", tenantID, "Bar", `"foo"`, tenantID, "Baz"},
+		},
+		{
+			Name:        "Returns error for invalid selector",
+			FilterInput: []*labelfilter.LabelFilter{labelfilter.NewForKeyWithQuery("Foo", `in ("foo"`)},
+			ExpectedErr: `invalid label selector`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			queryFilter, args, err := FilterQuery(model.ApplicationLabelableObject, IntersectSet, tenantID, testCase.FilterInput)
+
+			if testCase.ExpectedErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, testCase.ExpectedQueryFilter, queryFilter)
+			assert.Equal(t, testCase.ExpectedArgs, args)
+		})
+	}
+}
+
+func TestFilterQueryGlobalWithLabelSelectors(t *testing.T) {
+	queryFilter, args, err := FilterQueryGlobal(model.RuntimeLabelableObject, IntersectSet, []*labelfilter.LabelFilter{labelfilter.NewForKeyWithQuery("Foo", "!exists")})
+
+	require.NoError(t, err)
+	assert.Equal(t, `SELECT "id" FROM public.runtimes WHERE "id" NOT IN (SELECT "runtime_id" FROM public.labels WHERE "runtime_id" IS NOT NULL AND "key" = ?)`, queryFilter)
+	assert.Equal(t, []interface{}{"Foo"}, args)
+}
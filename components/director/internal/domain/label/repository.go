@@ -114,6 +114,33 @@ func (r *repository) ListForObject(ctx context.Context, tenant string, objectTyp
 	return labelsMap, nil
 }
 
+// ListForObjectIDs returns the labels of every object, grouped by the object ID and the label key
+func (r *repository) ListForObjectIDs(ctx context.Context, tenant string, objectType model.LabelableObject, objectIDs []string) (map[string]map[string]*model.Label, error) {
+	labelsByObjectID := make(map[string]map[string]*model.Label, len(objectIDs))
+	if len(objectIDs) == 0 {
+		return labelsByObjectID, nil
+	}
+
+	var entities Collection
+	if err := r.lister.List(ctx, tenant, &entities, repo.NewInConditionForStringValues(labelableObjectField(objectType), objectIDs)); err != nil {
+		return nil, errors.Wrap(err, "while fetching Labels from DB")
+	}
+
+	for _, entity := range entities {
+		m, err := r.conv.FromEntity(entity)
+		if err != nil {
+			return nil, errors.Wrap(err, "while converting Label entity to model")
+		}
+
+		if labelsByObjectID[m.ObjectID] == nil {
+			labelsByObjectID[m.ObjectID] = make(map[string]*model.Label)
+		}
+		labelsByObjectID[m.ObjectID][m.Key] = &m
+	}
+
+	return labelsByObjectID, nil
+}
+
 func (r *repository) ListByKey(ctx context.Context, tenant, key string) ([]*model.Label, error) {
 	persist, err := persistence.FromCtx(ctx)
 	if err != nil {
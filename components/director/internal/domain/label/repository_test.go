@@ -622,6 +622,83 @@ func TestRepository_ListForObject(t *testing.T) {
 	})
 }
 
+func TestRepository_ListForObjectIDs(t *testing.T) {
+	// GIVEN
+	objType := model.ApplicationLabelableObject
+	firstObjID := "foo"
+	secondObjID := "bar"
+	tnt := "tenant"
+
+	escapedQuery := regexp.QuoteMeta(`SELECT id, tenant_id, app_id, runtime_id, runtime_context_id, key, value FROM public.labels WHERE tenant_id = $1 AND app_id IN ($2, $3)`)
+
+	t.Run("Success", func(t *testing.T) {
+		inputItems := []label.Entity{
+			{ID: "1", TenantID: tnt, Key: "foo", Value: "test1", AppID: sql.NullString{Valid: true, String: firstObjID}},
+			{ID: "2", TenantID: tnt, Key: "bar", Value: "test2", AppID: sql.NullString{Valid: true, String: firstObjID}},
+			{ID: "3", TenantID: tnt, Key: "foo", Value: "test3", AppID: sql.NullString{Valid: true, String: secondObjID}},
+		}
+		expected := map[string]map[string]*model.Label{
+			firstObjID: {
+				"foo": {ID: "1", Tenant: tnt, Key: "foo", Value: "test1", ObjectType: objType, ObjectID: firstObjID},
+				"bar": {ID: "2", Tenant: tnt, Key: "bar", Value: "test2", ObjectType: objType, ObjectID: firstObjID},
+			},
+			secondObjID: {
+				"foo": {ID: "3", Tenant: tnt, Key: "foo", Value: "test3", ObjectType: objType, ObjectID: secondObjID},
+			},
+		}
+
+		mockConverter := &automock.Converter{}
+		defer mockConverter.AssertExpectations(t)
+		for _, entity := range inputItems {
+			mockConverter.On("FromEntity", entity).Return(*expected[entity.AppID.String][entity.Key], nil).Once()
+		}
+
+		labelRepo := label.NewRepository(mockConverter)
+
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		mockedRows := sqlmock.NewRows([]string{"id", "tenant_id", "key", "value", "app_id", "runtime_id", "runtime_context_id"}).
+			AddRow("1", tnt, "foo", "test1", firstObjID, nil, nil).
+			AddRow("2", tnt, "bar", "test2", firstObjID, nil, nil).
+			AddRow("3", tnt, "foo", "test3", secondObjID, nil, nil)
+		dbMock.ExpectQuery(escapedQuery).WithArgs(tnt, firstObjID, secondObjID).WillReturnRows(mockedRows)
+
+		ctx := context.TODO()
+		ctx = persistence.SaveToContext(ctx, db)
+		// WHEN
+		actual, err := labelRepo.ListForObjectIDs(ctx, tnt, objType, []string{firstObjID, secondObjID})
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("Success - no objects", func(t *testing.T) {
+		labelRepo := label.NewRepository(nil)
+		// WHEN
+		actual, err := labelRepo.ListForObjectIDs(context.TODO(), tnt, objType, []string{})
+		// THEN
+		require.NoError(t, err)
+		assert.Empty(t, actual)
+	})
+
+	t.Run("Error", func(t *testing.T) {
+		labelRepo := label.NewRepository(nil)
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		dbMock.ExpectQuery(escapedQuery).WithArgs(tnt, firstObjID, secondObjID).WillReturnError(errors.New("persistence error"))
+
+		ctx := context.TODO()
+		ctx = persistence.SaveToContext(ctx, db)
+		// WHEN
+		_, err := labelRepo.ListForObjectIDs(ctx, tnt, objType, []string{firstObjID, secondObjID})
+		// THEN
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "Unexpected error while executing SQL query")
+	})
+}
+
 func TestRepository_ListByKey(t *testing.T) {
 	t.Run("Success - Label for Application, Runtime and Runtime Context", func(t *testing.T) {
 		// GIVEN
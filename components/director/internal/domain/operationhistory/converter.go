@@ -0,0 +1,117 @@
+package operationhistory
+
+import (
+	"encoding/json"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/repo"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/pkg/errors"
+)
+
+type converter struct{}
+
+func NewConverter() *converter {
+	return &converter{}
+}
+
+func (c *converter) ToGraphQL(in *model.Operation) *graphql.Operation {
+	if in == nil {
+		return nil
+	}
+
+	webhookResults := make([]*graphql.OperationWebhookResult, 0, len(in.WebhookResults))
+	for _, result := range in.WebhookResults {
+		webhookResults = append(webhookResults, &graphql.OperationWebhookResult{
+			WebhookID:      result.WebhookID,
+			State:          result.State,
+			RetriesCount:   result.RetriesCount,
+			FailedAttempts: result.FailedAttempts,
+		})
+	}
+
+	var finishedAt *graphql.Timestamp
+	if in.FinishedAt != nil {
+		timestamp := graphql.Timestamp(*in.FinishedAt)
+		finishedAt = &timestamp
+	}
+
+	return &graphql.Operation{
+		ID:                in.ID,
+		ResourceType:      string(in.ResourceType),
+		ResourceID:        in.ResourceID,
+		OperationType:     graphql.OperationType(in.Type),
+		OperationCategory: in.Category,
+		CorrelationID:     in.CorrelationID,
+		Status:            graphql.OperationStatus(in.Status),
+		Error:             in.Error,
+		WebhookResults:    webhookResults,
+		CreatedAt:         graphql.Timestamp(in.CreatedAt),
+		FinishedAt:        finishedAt,
+	}
+}
+
+func (c *converter) MultipleToGraphQL(in []*model.Operation) []*graphql.Operation {
+	var operations []*graphql.Operation
+	for _, op := range in {
+		if op == nil {
+			continue
+		}
+		operations = append(operations, c.ToGraphQL(op))
+	}
+
+	return operations
+}
+
+func (c *converter) ToEntity(in *model.Operation) (*Entity, error) {
+	var webhookResults []byte
+	if len(in.WebhookResults) > 0 {
+		var err error
+		webhookResults, err = json.Marshal(in.WebhookResults)
+		if err != nil {
+			return nil, errors.Wrap(err, "while marshalling webhook results")
+		}
+	}
+
+	return &Entity{
+		ID:                in.ID,
+		OperationID:       in.OperationID,
+		TenantID:          in.Tenant,
+		ResourceType:      string(in.ResourceType),
+		ResourceID:        in.ResourceID,
+		OperationType:     string(in.Type),
+		OperationCategory: in.Category,
+		CorrelationID:     in.CorrelationID,
+		Status:            string(in.Status),
+		Error:             repo.NewNullableString(in.Error),
+		WebhookResults:    repo.NewNullableStringFromJSONRawMessage(webhookResults),
+		CreatedAt:         in.CreatedAt,
+		FinishedAt:        repo.NewNullableTime(in.FinishedAt),
+	}, nil
+}
+
+func (c *converter) FromEntity(in *Entity) (*model.Operation, error) {
+	var webhookResults []model.OperationWebhookResult
+	if in.WebhookResults.Valid {
+		if err := json.Unmarshal([]byte(in.WebhookResults.String), &webhookResults); err != nil {
+			return nil, errors.Wrap(err, "while unmarshalling webhook results")
+		}
+	}
+
+	return &model.Operation{
+		ID:             in.ID,
+		OperationID:    in.OperationID,
+		Tenant:         in.TenantID,
+		ResourceType:   resource.Type(in.ResourceType),
+		ResourceID:     in.ResourceID,
+		Type:           model.OperationType(in.OperationType),
+		Category:       in.OperationCategory,
+		CorrelationID:  in.CorrelationID,
+		Status:         model.OperationStatus(in.Status),
+		Error:          repo.StringPtrFromNullableString(in.Error),
+		WebhookResults: webhookResults,
+		CreatedAt:      in.CreatedAt,
+		FinishedAt:     repo.TimePtrFromNullableTime(in.FinishedAt),
+	}, nil
+}
@@ -0,0 +1,79 @@
+package operationhistory_test
+
+import (
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/operationhistory"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConverter_ToGraphQL(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		conv := operationhistory.NewConverter()
+
+		gqlOperation := conv.ToGraphQL(fixFailedOperationModel())
+
+		assert.Equal(t, fixFailedOperationGraphQL(), gqlOperation)
+	})
+
+	t.Run("Returns nil if operation model is nil", func(t *testing.T) {
+		conv := operationhistory.NewConverter()
+
+		gqlOperation := conv.ToGraphQL(nil)
+
+		require.Nil(t, gqlOperation)
+	})
+}
+
+func TestConverter_MultipleToGraphQL(t *testing.T) {
+	conv := operationhistory.NewConverter()
+
+	gqlOperations := conv.MultipleToGraphQL([]*model.Operation{fixFailedOperationModel(), nil})
+
+	assert.Equal(t, []*graphql.Operation{fixFailedOperationGraphQL()}, gqlOperations)
+}
+
+func TestConverter_ToEntity(t *testing.T) {
+	t.Run("Success for operation in progress", func(t *testing.T) {
+		conv := operationhistory.NewConverter()
+
+		entity, err := conv.ToEntity(fixInProgressOperationModel())
+
+		require.NoError(t, err)
+		assert.Equal(t, fixInProgressOperationEntity(), entity)
+	})
+
+	t.Run("Success for finished operation", func(t *testing.T) {
+		conv := operationhistory.NewConverter()
+
+		entity, err := conv.ToEntity(fixFailedOperationModel())
+
+		require.NoError(t, err)
+		assert.Equal(t, fixFailedOperationEntity(), entity)
+	})
+}
+
+func TestConverter_FromEntity(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		conv := operationhistory.NewConverter()
+
+		operationModel, err := conv.FromEntity(fixFailedOperationEntity())
+
+		require.NoError(t, err)
+		assert.Equal(t, fixFailedOperationModel(), operationModel)
+	})
+
+	t.Run("Returns error when webhook results are not valid JSON", func(t *testing.T) {
+		conv := operationhistory.NewConverter()
+		entity := fixFailedOperationEntity()
+		entity.WebhookResults.String = "{"
+
+		_, err := conv.FromEntity(entity)
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "while unmarshalling webhook results")
+	})
+}
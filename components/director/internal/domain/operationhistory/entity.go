@@ -0,0 +1,28 @@
+package operationhistory
+
+import (
+	"database/sql"
+	"time"
+)
+
+type Entity struct {
+	ID                string         `db:"id"`
+	OperationID       string         `db:"operation_id"`
+	TenantID          string         `db:"tenant_id"`
+	ResourceType      string         `db:"resource_type"`
+	ResourceID        string         `db:"resource_id"`
+	OperationType     string         `db:"operation_type"`
+	OperationCategory string         `db:"operation_category"`
+	CorrelationID     string         `db:"correlation_id"`
+	Status            string         `db:"status"`
+	Error             sql.NullString `db:"error"`
+	WebhookResults    sql.NullString `db:"webhook_results"`
+	CreatedAt         time.Time      `db:"created_at"`
+	FinishedAt        sql.NullTime   `db:"finished_at"`
+}
+
+type EntityCollection []Entity
+
+func (c EntityCollection) Len() int {
+	return len(c)
+}
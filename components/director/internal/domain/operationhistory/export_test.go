@@ -0,0 +1,7 @@
+package operationhistory
+
+import "time"
+
+func (s *service) SetTimestampGen(timestampGen func() time.Time) {
+	s.timestampGen = timestampGen
+}
@@ -0,0 +1,122 @@
+package operationhistory_test
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/operationhistory"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/operation"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+)
+
+const (
+	operationID      = "f6f0a6b0-0a8c-4a2a-8c4f-6d5c0f7a2c31"
+	schedulerOpID    = "k8s-operation-id"
+	tenantID         = "b91b59f7-2563-40b2-aba9-fef726037aa3"
+	externalTenantID = "externalTenantID"
+	resourceID       = "e8a6c4c2-1f8b-4e6a-9a84-1d0c6f0d2f7e"
+	webhookID        = "3d3b5a2e-8b3f-4b8f-9d53-2f4a8b3a1c22"
+	correlationID    = "correlationID"
+	operationErr     = "operation failed"
+	webhookResults   = `[{"WebhookID":"3d3b5a2e-8b3f-4b8f-9d53-2f4a8b3a1c22","State":"Failed","RetriesCount":1,"FailedAttempts":2}]`
+)
+
+var (
+	createdAt  = time.Date(2021, 4, 3, 9, 0, 0, 0, time.UTC)
+	finishedAt = time.Date(2021, 4, 3, 9, 5, 0, 0, time.UTC)
+)
+
+func fixInProgressOperationModel() *model.Operation {
+	return &model.Operation{
+		ID:            operationID,
+		OperationID:   schedulerOpID,
+		Tenant:        tenantID,
+		ResourceType:  resource.Application,
+		ResourceID:    resourceID,
+		Type:          model.OperationTypeCreate,
+		Category:      "registerApplication",
+		CorrelationID: correlationID,
+		Status:        model.OperationStatusInProgress,
+		CreatedAt:     createdAt,
+	}
+}
+
+func fixFailedOperationModel() *model.Operation {
+	op := fixInProgressOperationModel()
+	op.Status = model.OperationStatusFailed
+	op.Error = str(operationErr)
+	op.WebhookResults = []model.OperationWebhookResult{{WebhookID: webhookID, State: "Failed", RetriesCount: 1, FailedAttempts: 2}}
+	op.FinishedAt = &finishedAt
+	return op
+}
+
+func fixInProgressOperationEntity() *operationhistory.Entity {
+	return &operationhistory.Entity{
+		ID:                operationID,
+		OperationID:       schedulerOpID,
+		TenantID:          tenantID,
+		ResourceType:      string(resource.Application),
+		ResourceID:        resourceID,
+		OperationType:     string(model.OperationTypeCreate),
+		OperationCategory: "registerApplication",
+		CorrelationID:     correlationID,
+		Status:            string(model.OperationStatusInProgress),
+		CreatedAt:         createdAt,
+	}
+}
+
+func fixFailedOperationEntity() *operationhistory.Entity {
+	entity := fixInProgressOperationEntity()
+	entity.Status = string(model.OperationStatusFailed)
+	entity.Error = sql.NullString{String: operationErr, Valid: true}
+	entity.WebhookResults = sql.NullString{String: webhookResults, Valid: true}
+	entity.FinishedAt = sql.NullTime{Time: finishedAt, Valid: true}
+	return entity
+}
+
+func fixFailedOperationGraphQL() *graphql.Operation {
+	finished := graphql.Timestamp(finishedAt)
+	return &graphql.Operation{
+		ID:                operationID,
+		ResourceType:      string(resource.Application),
+		ResourceID:        resourceID,
+		OperationType:     graphql.OperationTypeCreate,
+		OperationCategory: "registerApplication",
+		CorrelationID:     correlationID,
+		Status:            graphql.OperationStatusFailed,
+		Error:             str(operationErr),
+		WebhookResults:    []*graphql.OperationWebhookResult{{WebhookID: webhookID, State: "Failed", RetriesCount: 1, FailedAttempts: 2}},
+		CreatedAt:         graphql.Timestamp(createdAt),
+		FinishedAt:        &finished,
+	}
+}
+
+func fixScheduledOperation() *operation.Operation {
+	return &operation.Operation{
+		OperationID:       schedulerOpID,
+		OperationType:     operation.OperationTypeCreate,
+		OperationCategory: "registerApplication",
+		ResourceID:        resourceID,
+		ResourceType:      resource.Application,
+		CorrelationID:     correlationID,
+	}
+}
+
+func fixOperationColumns() []string {
+	return []string{"id", "operation_id", "tenant_id", "resource_type", "resource_id", "operation_type", "operation_category", "correlation_id", "status", "error", "webhook_results", "created_at", "finished_at"}
+}
+
+func fixInProgressOperationRow() []driver.Value {
+	return []driver.Value{operationID, schedulerOpID, tenantID, string(resource.Application), resourceID, string(model.OperationTypeCreate), "registerApplication", correlationID, string(model.OperationStatusInProgress), nil, nil, createdAt, nil}
+}
+
+func fixFailedOperationUpdateArgs() []driver.Value {
+	return []driver.Value{string(model.OperationStatusFailed), operationErr, webhookResults, finishedAt}
+}
+
+func str(s string) *string {
+	return &s
+}
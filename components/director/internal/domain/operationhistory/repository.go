@@ -0,0 +1,138 @@
+package operationhistory
+
+import (
+	"context"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/repo"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/pkg/errors"
+)
+
+const operationTable string = `public.operations`
+
+var (
+	tenantColumn     = "tenant_id"
+	operationColumns = []string{"id", "operation_id", tenantColumn, "resource_type", "resource_id", "operation_type", "operation_category", "correlation_id", "status", "error", "webhook_results", "created_at", "finished_at"}
+	updatableColumns = []string{"status", "error", "webhook_results", "finished_at"}
+)
+
+//go:generate mockery -name=EntityConverter -output=automock -outpkg=automock -case=underscore
+type EntityConverter interface {
+	ToEntity(in *model.Operation) (*Entity, error)
+	FromEntity(in *Entity) (*model.Operation, error)
+}
+
+type pgRepository struct {
+	conv               EntityConverter
+	creator            repo.Creator
+	updaterGlobal      repo.UpdaterGlobal
+	singleGetterGlobal repo.SingleGetterGlobal
+	pageableQuerier    repo.PageableQuerier
+}
+
+func NewRepository(conv EntityConverter) *pgRepository {
+	return &pgRepository{
+		conv:               conv,
+		creator:            repo.NewCreator(resource.Operation, operationTable, operationColumns),
+		updaterGlobal:      repo.NewUpdaterGlobal(resource.Operation, operationTable, updatableColumns, []string{"id"}),
+		singleGetterGlobal: repo.NewSingleGetterGlobal(resource.Operation, operationTable, operationColumns),
+		pageableQuerier:    repo.NewPageableQuerier(resource.Operation, operationTable, tenantColumn, operationColumns),
+	}
+}
+
+func (r *pgRepository) Create(ctx context.Context, item *model.Operation) error {
+	if item == nil {
+		return apperrors.NewInternalError("item can not be empty")
+	}
+
+	entity, err := r.conv.ToEntity(item)
+	if err != nil {
+		return errors.Wrap(err, "while converting Operation to entity")
+	}
+
+	return r.creator.Create(ctx, entity)
+}
+
+func (r *pgRepository) UpdateGlobal(ctx context.Context, item *model.Operation) error {
+	if item == nil {
+		return apperrors.NewInternalError("item can not be empty")
+	}
+
+	entity, err := r.conv.ToEntity(item)
+	if err != nil {
+		return errors.Wrap(err, "while converting Operation to entity")
+	}
+
+	return r.updaterGlobal.UpdateSingleGlobal(ctx, entity)
+}
+
+// GetLatestInProgressGlobal returns the most recently created operation of the given resource which has not finished yet
+func (r *pgRepository) GetLatestInProgressGlobal(ctx context.Context, resourceType resource.Type, resourceID string) (*model.Operation, error) {
+	conditions := repo.Conditions{
+		repo.NewEqualCondition("resource_type", string(resourceType)),
+		repo.NewEqualCondition("resource_id", resourceID),
+		repo.NewEqualCondition("status", string(model.OperationStatusInProgress)),
+	}
+
+	var entity Entity
+	if err := r.singleGetterGlobal.GetGlobal(ctx, conditions, repo.OrderByParams{repo.NewDescOrderBy("created_at")}, &entity); err != nil {
+		return nil, err
+	}
+
+	return r.conv.FromEntity(&entity)
+}
+
+// GetLatestInProgressByOperationIDGlobal returns the most recently created operation with the given scheduler operation ID which has not finished yet
+func (r *pgRepository) GetLatestInProgressByOperationIDGlobal(ctx context.Context, operationID string) (*model.Operation, error) {
+	conditions := repo.Conditions{
+		repo.NewEqualCondition("operation_id", operationID),
+		repo.NewEqualCondition("status", string(model.OperationStatusInProgress)),
+	}
+
+	var entity Entity
+	if err := r.singleGetterGlobal.GetGlobal(ctx, conditions, repo.OrderByParams{repo.NewDescOrderBy("created_at")}, &entity); err != nil {
+		return nil, err
+	}
+
+	return r.conv.FromEntity(&entity)
+}
+
+// GetLatestByOperationIDGlobal returns the most recently created operation with the given scheduler operation ID regardless of its status
+func (r *pgRepository) GetLatestByOperationIDGlobal(ctx context.Context, operationID string) (*model.Operation, error) {
+	conditions := repo.Conditions{
+		repo.NewEqualCondition("operation_id", operationID),
+	}
+
+	var entity Entity
+	if err := r.singleGetterGlobal.GetGlobal(ctx, conditions, repo.OrderByParams{repo.NewDescOrderBy("created_at")}, &entity); err != nil {
+		return nil, err
+	}
+
+	return r.conv.FromEntity(&entity)
+}
+
+// ListByResourceID returns the operations of the given resource in the order in which they were created
+func (r *pgRepository) ListByResourceID(ctx context.Context, tenant, resourceID string, pageSize int, cursor string) (*model.OperationPage, error) {
+	var entities EntityCollection
+	page, totalCount, err := r.pageableQuerier.List(ctx, tenant, pageSize, cursor, "created_at", &entities, repo.NewEqualCondition("resource_id", resourceID))
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*model.Operation, 0, len(entities))
+	for i := range entities {
+		item, err := r.conv.FromEntity(&entities[i])
+		if err != nil {
+			return nil, errors.Wrap(err, "while creating Operation model from entity")
+		}
+		items = append(items, item)
+	}
+
+	return &model.OperationPage{
+		Data:       items,
+		TotalCount: totalCount,
+		PageInfo:   page,
+	}, nil
+}
@@ -0,0 +1,296 @@
+package operationhistory_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/operationhistory"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/operationhistory/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/repo/testdb"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPgRepository_Create(t *testing.T) {
+	// GIVEN
+	operationModel := fixInProgressOperationModel()
+	operationEntity := fixInProgressOperationEntity()
+	insertQuery := `^INSERT INTO public.operations \(.+\) VALUES \(.+\)$`
+
+	t.Run("success", func(t *testing.T) {
+		sqlxDB, sqlMock := testdb.MockDatabase(t)
+
+		sqlMock.ExpectExec(insertQuery).
+			WithArgs(fixInProgressOperationRow()...).
+			WillReturnResult(sqlmock.NewResult(-1, 1))
+
+		ctx := persistence.SaveToContext(context.TODO(), sqlxDB)
+		convMock := &automock.EntityConverter{}
+		convMock.On("ToEntity", operationModel).Return(operationEntity, nil).Once()
+		pgRepository := operationhistory.NewRepository(convMock)
+		// WHEN
+		err := pgRepository.Create(ctx, operationModel)
+		// THEN
+		require.NoError(t, err)
+		sqlMock.AssertExpectations(t)
+		convMock.AssertExpectations(t)
+	})
+
+	t.Run("returns error when conversion fails", func(t *testing.T) {
+		convMock := &automock.EntityConverter{}
+		convMock.On("ToEntity", operationModel).Return(nil, errors.New("test error")).Once()
+		pgRepository := operationhistory.NewRepository(convMock)
+		// WHEN
+		err := pgRepository.Create(context.TODO(), operationModel)
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "while converting Operation to entity")
+		convMock.AssertExpectations(t)
+	})
+
+	t.Run("returns error when item is nil", func(t *testing.T) {
+		convMock := &automock.EntityConverter{}
+		pgRepository := operationhistory.NewRepository(convMock)
+		// WHEN
+		err := pgRepository.Create(context.TODO(), nil)
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "item can not be empty")
+		convMock.AssertExpectations(t)
+	})
+}
+
+func TestPgRepository_UpdateGlobal(t *testing.T) {
+	updateQuery := regexp.QuoteMeta(`UPDATE public.operations SET status = ?, error = ?, webhook_results = ?, finished_at = ? WHERE id = ?`)
+
+	t.Run("success", func(t *testing.T) {
+		sqlxDB, sqlMock := testdb.MockDatabase(t)
+		ctx := persistence.SaveToContext(context.TODO(), sqlxDB)
+		operationModel := fixFailedOperationModel()
+
+		convMock := &automock.EntityConverter{}
+		convMock.On("ToEntity", operationModel).Return(fixFailedOperationEntity(), nil).Once()
+		sqlMock.ExpectExec(updateQuery).
+			WithArgs(append(fixFailedOperationUpdateArgs(), operationID)...).
+			WillReturnResult(sqlmock.NewResult(-1, 1))
+
+		pgRepository := operationhistory.NewRepository(convMock)
+		// WHEN
+		err := pgRepository.UpdateGlobal(ctx, operationModel)
+		// THEN
+		require.NoError(t, err)
+		convMock.AssertExpectations(t)
+		sqlMock.AssertExpectations(t)
+	})
+
+	t.Run("returns error when item is nil", func(t *testing.T) {
+		convMock := &automock.EntityConverter{}
+		pgRepository := operationhistory.NewRepository(convMock)
+		// WHEN
+		err := pgRepository.UpdateGlobal(context.TODO(), nil)
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "item can not be empty")
+		convMock.AssertExpectations(t)
+	})
+}
+
+func TestPgRepository_GetLatestInProgressGlobal(t *testing.T) {
+	selectQuery := regexp.QuoteMeta(`SELECT id, operation_id, tenant_id, resource_type, resource_id, operation_type, operation_category, correlation_id, status, error, webhook_results, created_at, finished_at FROM public.operations WHERE resource_type = $1 AND resource_id = $2 AND status = $3 ORDER BY created_at DESC`)
+
+	t.Run("success", func(t *testing.T) {
+		sqlxDB, sqlMock := testdb.MockDatabase(t)
+		rows := sqlmock.NewRows(fixOperationColumns()).AddRow(fixInProgressOperationRow()...)
+		sqlMock.ExpectQuery(selectQuery).
+			WithArgs(string(resource.Application), resourceID, "IN_PROGRESS").
+			WillReturnRows(rows)
+
+		ctx := persistence.SaveToContext(context.TODO(), sqlxDB)
+		convMock := &automock.EntityConverter{}
+		convMock.On("FromEntity", fixInProgressOperationEntity()).Return(fixInProgressOperationModel(), nil).Once()
+		pgRepository := operationhistory.NewRepository(convMock)
+		// WHEN
+		operationModel, err := pgRepository.GetLatestInProgressGlobal(ctx, resource.Application, resourceID)
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, fixInProgressOperationModel(), operationModel)
+		convMock.AssertExpectations(t)
+		sqlMock.AssertExpectations(t)
+	})
+
+	t.Run("returns not found error when there is no operation in progress", func(t *testing.T) {
+		sqlxDB, sqlMock := testdb.MockDatabase(t)
+		sqlMock.ExpectQuery(selectQuery).
+			WithArgs(string(resource.Application), resourceID, "IN_PROGRESS").
+			WillReturnRows(sqlmock.NewRows(fixOperationColumns()))
+
+		ctx := persistence.SaveToContext(context.TODO(), sqlxDB)
+		convMock := &automock.EntityConverter{}
+		pgRepository := operationhistory.NewRepository(convMock)
+		// WHEN
+		_, err := pgRepository.GetLatestInProgressGlobal(ctx, resource.Application, resourceID)
+		// THEN
+		require.Error(t, err)
+		assert.True(t, apperrors.IsNotFoundError(err))
+		convMock.AssertExpectations(t)
+		sqlMock.AssertExpectations(t)
+	})
+}
+
+func TestPgRepository_GetLatestInProgressByOperationIDGlobal(t *testing.T) {
+	selectQuery := regexp.QuoteMeta(`SELECT id, operation_id, tenant_id, resource_type, resource_id, operation_type, operation_category, correlation_id, status, error, webhook_results, created_at, finished_at FROM public.operations WHERE operation_id = $1 AND status = $2 ORDER BY created_at DESC`)
+
+	t.Run("success", func(t *testing.T) {
+		sqlxDB, sqlMock := testdb.MockDatabase(t)
+		rows := sqlmock.NewRows(fixOperationColumns()).AddRow(fixInProgressOperationRow()...)
+		sqlMock.ExpectQuery(selectQuery).
+			WithArgs(schedulerOpID, "IN_PROGRESS").
+			WillReturnRows(rows)
+
+		ctx := persistence.SaveToContext(context.TODO(), sqlxDB)
+		convMock := &automock.EntityConverter{}
+		convMock.On("FromEntity", fixInProgressOperationEntity()).Return(fixInProgressOperationModel(), nil).Once()
+		pgRepository := operationhistory.NewRepository(convMock)
+		// WHEN
+		operationModel, err := pgRepository.GetLatestInProgressByOperationIDGlobal(ctx, schedulerOpID)
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, fixInProgressOperationModel(), operationModel)
+		convMock.AssertExpectations(t)
+		sqlMock.AssertExpectations(t)
+	})
+
+	t.Run("returns not found error when there is no operation in progress", func(t *testing.T) {
+		sqlxDB, sqlMock := testdb.MockDatabase(t)
+		sqlMock.ExpectQuery(selectQuery).
+			WithArgs(schedulerOpID, "IN_PROGRESS").
+			WillReturnRows(sqlmock.NewRows(fixOperationColumns()))
+
+		ctx := persistence.SaveToContext(context.TODO(), sqlxDB)
+		convMock := &automock.EntityConverter{}
+		pgRepository := operationhistory.NewRepository(convMock)
+		// WHEN
+		_, err := pgRepository.GetLatestInProgressByOperationIDGlobal(ctx, schedulerOpID)
+		// THEN
+		require.Error(t, err)
+		assert.True(t, apperrors.IsNotFoundError(err))
+		convMock.AssertExpectations(t)
+		sqlMock.AssertExpectations(t)
+	})
+}
+
+func TestPgRepository_GetLatestByOperationIDGlobal(t *testing.T) {
+	selectQuery := regexp.QuoteMeta(`SELECT id, operation_id, tenant_id, resource_type, resource_id, operation_type, operation_category, correlation_id, status, error, webhook_results, created_at, finished_at FROM public.operations WHERE operation_id = $1 ORDER BY created_at DESC`)
+
+	t.Run("success", func(t *testing.T) {
+		sqlxDB, sqlMock := testdb.MockDatabase(t)
+		rows := sqlmock.NewRows(fixOperationColumns()).AddRow(fixInProgressOperationRow()...)
+		sqlMock.ExpectQuery(selectQuery).
+			WithArgs(schedulerOpID).
+			WillReturnRows(rows)
+
+		ctx := persistence.SaveToContext(context.TODO(), sqlxDB)
+		convMock := &automock.EntityConverter{}
+		convMock.On("FromEntity", fixInProgressOperationEntity()).Return(fixInProgressOperationModel(), nil).Once()
+		pgRepository := operationhistory.NewRepository(convMock)
+		// WHEN
+		operationModel, err := pgRepository.GetLatestByOperationIDGlobal(ctx, schedulerOpID)
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, fixInProgressOperationModel(), operationModel)
+		convMock.AssertExpectations(t)
+		sqlMock.AssertExpectations(t)
+	})
+
+	t.Run("returns not found error when there is no operation", func(t *testing.T) {
+		sqlxDB, sqlMock := testdb.MockDatabase(t)
+		sqlMock.ExpectQuery(selectQuery).
+			WithArgs(schedulerOpID).
+			WillReturnRows(sqlmock.NewRows(fixOperationColumns()))
+
+		ctx := persistence.SaveToContext(context.TODO(), sqlxDB)
+		convMock := &automock.EntityConverter{}
+		pgRepository := operationhistory.NewRepository(convMock)
+		// WHEN
+		_, err := pgRepository.GetLatestByOperationIDGlobal(ctx, schedulerOpID)
+		// THEN
+		require.Error(t, err)
+		assert.True(t, apperrors.IsNotFoundError(err))
+		convMock.AssertExpectations(t)
+		sqlMock.AssertExpectations(t)
+	})
+}
+
+func TestPgRepository_ListByResourceID(t *testing.T) {
+	// GIVEN
+	pageSize := 3
+	totalCount := 2
+
+	selectQuery := fmt.Sprintf(`^SELECT (.+) FROM public.operations
+		WHERE tenant_id = \$1 AND resource_id = \$2
+		ORDER BY created_at LIMIT %d OFFSET %d`, pageSize, 0)
+
+	countQuery := regexp.QuoteMeta(`SELECT COUNT(*) FROM public.operations
+		WHERE tenant_id = $1 AND resource_id = $2`)
+
+	t.Run("success", func(t *testing.T) {
+		sqlxDB, sqlMock := testdb.MockDatabase(t)
+		rows := sqlmock.NewRows(fixOperationColumns()).
+			AddRow(fixInProgressOperationRow()...).
+			AddRow(fixInProgressOperationRow()...)
+
+		sqlMock.ExpectQuery(selectQuery).
+			WithArgs(tenantID, resourceID).
+			WillReturnRows(rows)
+
+		sqlMock.ExpectQuery(countQuery).
+			WithArgs(tenantID, resourceID).
+			WillReturnRows(testdb.RowCount(totalCount))
+
+		ctx := persistence.SaveToContext(context.TODO(), sqlxDB)
+		convMock := &automock.EntityConverter{}
+		convMock.On("FromEntity", fixInProgressOperationEntity()).Return(fixInProgressOperationModel(), nil).Twice()
+		pgRepository := operationhistory.NewRepository(convMock)
+		// WHEN
+		operationPage, err := pgRepository.ListByResourceID(ctx, tenantID, resourceID, pageSize, "")
+		// THEN
+		require.NoError(t, err)
+		require.Len(t, operationPage.Data, totalCount)
+		assert.Equal(t, totalCount, operationPage.TotalCount)
+		assert.False(t, operationPage.PageInfo.HasNextPage)
+		convMock.AssertExpectations(t)
+		sqlMock.AssertExpectations(t)
+	})
+
+	t.Run("returns error when conversion fails", func(t *testing.T) {
+		sqlxDB, sqlMock := testdb.MockDatabase(t)
+		rows := sqlmock.NewRows(fixOperationColumns()).AddRow(fixInProgressOperationRow()...)
+
+		sqlMock.ExpectQuery(selectQuery).
+			WithArgs(tenantID, resourceID).
+			WillReturnRows(rows)
+
+		sqlMock.ExpectQuery(countQuery).
+			WithArgs(tenantID, resourceID).
+			WillReturnRows(testdb.RowCount(1))
+
+		ctx := persistence.SaveToContext(context.TODO(), sqlxDB)
+		convMock := &automock.EntityConverter{}
+		convMock.On("FromEntity", fixInProgressOperationEntity()).Return(nil, errors.New("test error")).Once()
+		pgRepository := operationhistory.NewRepository(convMock)
+		// WHEN
+		_, err := pgRepository.ListByResourceID(ctx, tenantID, resourceID, pageSize, "")
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "while creating Operation model from entity")
+		convMock.AssertExpectations(t)
+		sqlMock.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,88 @@
+package operationhistory
+
+import (
+	"context"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+	"github.com/pkg/errors"
+)
+
+//go:generate mockery -name=OperationService -output=automock -outpkg=automock -case=underscore
+type OperationService interface {
+	ListByResourceID(ctx context.Context, resourceID string, pageSize int, cursor string) (*model.OperationPage, error)
+}
+
+//go:generate mockery -name=OperationConverter -output=automock -outpkg=automock -case=underscore
+type OperationConverter interface {
+	ToGraphQL(in *model.Operation) *graphql.Operation
+	MultipleToGraphQL(in []*model.Operation) []*graphql.Operation
+}
+
+type Resolver struct {
+	transact persistence.Transactioner
+
+	operationSvc  OperationService
+	operationConv OperationConverter
+}
+
+func NewResolver(transact persistence.Transactioner, operationSvc OperationService, operationConv OperationConverter) *Resolver {
+	return &Resolver{
+		transact:      transact,
+		operationSvc:  operationSvc,
+		operationConv: operationConv,
+	}
+}
+
+func (r *Resolver) Operations(ctx context.Context, resourceID string, first *int, after *graphql.PageCursor) (*graphql.OperationPage, error) {
+	if first == nil {
+		return nil, apperrors.NewInvalidDataError("missing required parameter 'first'")
+	}
+
+	var cursor string
+	if after != nil {
+		cursor = string(*after)
+	}
+
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer r.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	operationPage, err := r.operationSvc.ListByResourceID(ctx, resourceID, *first, cursor)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while listing Operations for resource with id %s", resourceID)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return r.toGraphQLPage(operationPage), nil
+}
+
+func (r *Resolver) OperationsForApplication(ctx context.Context, obj *graphql.Application, first *int, after *graphql.PageCursor) (*graphql.OperationPage, error) {
+	if obj == nil {
+		return nil, apperrors.NewInternalError("Application cannot be empty")
+	}
+
+	return r.Operations(ctx, obj.ID, first, after)
+}
+
+func (r *Resolver) toGraphQLPage(operationPage *model.OperationPage) *graphql.OperationPage {
+	return &graphql.OperationPage{
+		Data:       r.operationConv.MultipleToGraphQL(operationPage.Data),
+		TotalCount: operationPage.TotalCount,
+		PageInfo: &graphql.PageInfo{
+			StartCursor:     graphql.PageCursor(operationPage.PageInfo.StartCursor),
+			EndCursor:       graphql.PageCursor(operationPage.PageInfo.EndCursor),
+			HasNextPage:     operationPage.PageInfo.HasNextPage,
+			HasPreviousPage: operationPage.PageInfo.HasPreviousPage,
+		},
+	}
+}
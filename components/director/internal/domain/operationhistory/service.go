@@ -0,0 +1,144 @@
+package operationhistory
+
+import (
+	"context"
+	"strings"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/tenant"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/timestamp"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/kyma-incubator/compass/components/director/pkg/operation"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/pkg/errors"
+)
+
+//go:generate mockery -name=OperationRepository -output=automock -outpkg=automock -case=underscore
+type OperationRepository interface {
+	Create(ctx context.Context, item *model.Operation) error
+	UpdateGlobal(ctx context.Context, item *model.Operation) error
+	GetLatestInProgressGlobal(ctx context.Context, resourceType resource.Type, resourceID string) (*model.Operation, error)
+	GetLatestInProgressByOperationIDGlobal(ctx context.Context, operationID string) (*model.Operation, error)
+	GetLatestByOperationIDGlobal(ctx context.Context, operationID string) (*model.Operation, error)
+	ListByResourceID(ctx context.Context, tenant, resourceID string, pageSize int, cursor string) (*model.OperationPage, error)
+}
+
+//go:generate mockery -name=UIDService -output=automock -outpkg=automock -case=underscore
+type UIDService interface {
+	Generate() string
+}
+
+// service keeps the history of the asynchronous operations of the applications and runtimes.
+// It implements operation.HistoryRecorder so that every scheduled, retried, cancelled and finished operation is recorded.
+type service struct {
+	repo         OperationRepository
+	uidService   UIDService
+	timestampGen timestamp.Generator
+}
+
+func NewService(repo OperationRepository, uidService UIDService) *service {
+	return &service{
+		repo:         repo,
+		uidService:   uidService,
+		timestampGen: timestamp.DefaultGenerator(),
+	}
+}
+
+// RecordScheduled records the scheduled operation as in progress in the history of its resource under the operation ID assigned by the scheduler
+func (s *service) RecordScheduled(ctx context.Context, op *operation.Operation) error {
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	item := &model.Operation{
+		ID:            s.uidService.Generate(),
+		OperationID:   op.OperationID,
+		Tenant:        tnt,
+		ResourceType:  op.ResourceType,
+		ResourceID:    op.ResourceID,
+		Type:          model.OperationType(strings.ToUpper(string(op.OperationType))),
+		Category:      op.OperationCategory,
+		CorrelationID: op.CorrelationID,
+		Status:        model.OperationStatusInProgress,
+		CreatedAt:     s.timestampGen(),
+	}
+
+	if err := s.repo.Create(ctx, item); err != nil {
+		return errors.Wrapf(err, "while creating Operation for %s with id %s", op.ResourceType, op.ResourceID)
+	}
+
+	return nil
+}
+
+// RecordFinished records the outcome of the operation in progress with the operation ID of the request.
+// Requests without an operation ID, sent by operations controllers which predate it, are matched with the latest operation in progress for their resource.
+func (s *service) RecordFinished(ctx context.Context, request *operation.OperationRequest) error {
+	item, err := s.getInProgress(ctx, request)
+	if err != nil {
+		if apperrors.IsNotFoundError(err) {
+			log.C(ctx).Warnf("No Operation in progress found with operation id %q for %s with id %s, the outcome of the operation will not be recorded", request.OperationID, request.ResourceType, request.ResourceID)
+			return nil
+		}
+		return errors.Wrapf(err, "while getting Operation in progress with operation id %q for %s with id %s", request.OperationID, request.ResourceType, request.ResourceID)
+	}
+
+	now := s.timestampGen()
+	item.Status = model.OperationStatusSucceeded
+	item.FinishedAt = &now
+	if request.Error != "" {
+		item.Status = model.OperationStatusFailed
+		item.Error = &request.Error
+	}
+
+	item.WebhookResults = make([]model.OperationWebhookResult, 0, len(request.WebhookResults))
+	for _, result := range request.WebhookResults {
+		item.WebhookResults = append(item.WebhookResults, model.OperationWebhookResult{
+			WebhookID:      result.WebhookID,
+			State:          result.State,
+			RetriesCount:   result.RetriesCount,
+			FailedAttempts: result.FailedAttempts,
+		})
+	}
+
+	if err := s.repo.UpdateGlobal(ctx, item); err != nil {
+		return errors.Wrapf(err, "while updating Operation with id %s", item.ID)
+	}
+
+	return nil
+}
+
+// IsCancelled reports whether the operation with the given operation ID has been cancelled through the Operations API
+func (s *service) IsCancelled(ctx context.Context, operationID string) (bool, error) {
+	item, err := s.repo.GetLatestByOperationIDGlobal(ctx, operationID)
+	if err != nil {
+		if apperrors.IsNotFoundError(err) {
+			return false, nil
+		}
+		return false, errors.Wrapf(err, "while getting Operation with operation id %q", operationID)
+	}
+
+	return item.Status == model.OperationStatusFailed && item.Error != nil && *item.Error == operation.CancelledOperationError, nil
+}
+
+func (s *service) getInProgress(ctx context.Context, request *operation.OperationRequest) (*model.Operation, error) {
+	if request.OperationID == "" {
+		return s.repo.GetLatestInProgressGlobal(ctx, request.ResourceType, request.ResourceID)
+	}
+
+	return s.repo.GetLatestInProgressByOperationIDGlobal(ctx, request.OperationID)
+}
+
+func (s *service) ListByResourceID(ctx context.Context, resourceID string, pageSize int, cursor string) (*model.OperationPage, error) {
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if pageSize < 1 || pageSize > 200 {
+		return nil, apperrors.NewInvalidDataError("page size must be between 1 and 200")
+	}
+
+	return s.repo.ListByResourceID(ctx, tnt, resourceID, pageSize, cursor)
+}
@@ -0,0 +1,358 @@
+package operationhistory_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/operationhistory"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/operationhistory/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/tenant"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/operation"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_RecordScheduled(t *testing.T) {
+	// given
+	testErr := errors.New("Test error")
+	ctx := tenant.SaveToContext(context.TODO(), tenantID, externalTenantID)
+
+	testCases := []struct {
+		Name         string
+		Context      context.Context
+		RepositoryFn func() *automock.OperationRepository
+		UIDServiceFn func() *automock.UIDService
+		ExpectedErr  string
+	}{
+		{
+			Name:    "Success",
+			Context: ctx,
+			RepositoryFn: func() *automock.OperationRepository {
+				repo := &automock.OperationRepository{}
+				repo.On("Create", ctx, fixInProgressOperationModel()).Return(nil).Once()
+				return repo
+			},
+			UIDServiceFn: func() *automock.UIDService {
+				svc := &automock.UIDService{}
+				svc.On("Generate").Return(operationID).Once()
+				return svc
+			},
+		},
+		{
+			Name:    "Returns error when operation creation fails",
+			Context: ctx,
+			RepositoryFn: func() *automock.OperationRepository {
+				repo := &automock.OperationRepository{}
+				repo.On("Create", ctx, fixInProgressOperationModel()).Return(testErr).Once()
+				return repo
+			},
+			UIDServiceFn: func() *automock.UIDService {
+				svc := &automock.UIDService{}
+				svc.On("Generate").Return(operationID).Once()
+				return svc
+			},
+			ExpectedErr: testErr.Error(),
+		},
+		{
+			Name:         "Returns error when tenant is missing in context",
+			Context:      context.TODO(),
+			RepositoryFn: func() *automock.OperationRepository { return &automock.OperationRepository{} },
+			UIDServiceFn: func() *automock.UIDService { return &automock.UIDService{} },
+			ExpectedErr:  "cannot read tenant from context",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			repo := testCase.RepositoryFn()
+			uidSvc := testCase.UIDServiceFn()
+
+			svc := operationhistory.NewService(repo, uidSvc)
+			svc.SetTimestampGen(func() time.Time { return createdAt })
+
+			// when
+			err := svc.RecordScheduled(testCase.Context, fixScheduledOperation())
+
+			// then
+			if testCase.ExpectedErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErr)
+			}
+
+			repo.AssertExpectations(t)
+			uidSvc.AssertExpectations(t)
+		})
+	}
+}
+
+func TestService_RecordFinished(t *testing.T) {
+	// given
+	testErr := errors.New("Test error")
+	ctx := context.TODO()
+
+	failedRequest := &operation.OperationRequest{
+		OperationID:    schedulerOpID,
+		OperationType:  operation.OperationTypeCreate,
+		ResourceType:   resource.Application,
+		ResourceID:     resourceID,
+		Error:          operationErr,
+		WebhookResults: []operation.WebhookResult{{WebhookID: webhookID, State: "Failed", RetriesCount: 1, FailedAttempts: 2}},
+	}
+
+	succeededOperation := fixInProgressOperationModel()
+	succeededOperation.Status = model.OperationStatusSucceeded
+	succeededOperation.WebhookResults = []model.OperationWebhookResult{}
+	succeededOperation.FinishedAt = &finishedAt
+
+	testCases := []struct {
+		Name         string
+		Request      *operation.OperationRequest
+		RepositoryFn func() *automock.OperationRepository
+		ExpectedErr  string
+	}{
+		{
+			Name:    "Success for succeeded operation",
+			Request: &operation.OperationRequest{OperationID: schedulerOpID, OperationType: operation.OperationTypeCreate, ResourceType: resource.Application, ResourceID: resourceID},
+			RepositoryFn: func() *automock.OperationRepository {
+				repo := &automock.OperationRepository{}
+				repo.On("GetLatestInProgressByOperationIDGlobal", ctx, schedulerOpID).Return(fixInProgressOperationModel(), nil).Once()
+				repo.On("UpdateGlobal", ctx, succeededOperation).Return(nil).Once()
+				return repo
+			},
+		},
+		{
+			Name:    "Success for failed operation",
+			Request: failedRequest,
+			RepositoryFn: func() *automock.OperationRepository {
+				repo := &automock.OperationRepository{}
+				repo.On("GetLatestInProgressByOperationIDGlobal", ctx, schedulerOpID).Return(fixInProgressOperationModel(), nil).Once()
+				repo.On("UpdateGlobal", ctx, fixFailedOperationModel()).Return(nil).Once()
+				return repo
+			},
+		},
+		{
+			Name:    "Success for request without operation ID matched by its resource",
+			Request: &operation.OperationRequest{OperationType: operation.OperationTypeCreate, ResourceType: resource.Application, ResourceID: resourceID},
+			RepositoryFn: func() *automock.OperationRepository {
+				repo := &automock.OperationRepository{}
+				repo.On("GetLatestInProgressGlobal", ctx, resource.Application, resourceID).Return(fixInProgressOperationModel(), nil).Once()
+				repo.On("UpdateGlobal", ctx, succeededOperation).Return(nil).Once()
+				return repo
+			},
+		},
+		{
+			Name:    "Success when there is no operation in progress",
+			Request: failedRequest,
+			RepositoryFn: func() *automock.OperationRepository {
+				repo := &automock.OperationRepository{}
+				repo.On("GetLatestInProgressByOperationIDGlobal", ctx, schedulerOpID).Return(nil, apperrors.NewNotFoundError(resource.Operation, "")).Once()
+				return repo
+			},
+		},
+		{
+			Name:    "Returns error when getting the operation in progress fails",
+			Request: failedRequest,
+			RepositoryFn: func() *automock.OperationRepository {
+				repo := &automock.OperationRepository{}
+				repo.On("GetLatestInProgressByOperationIDGlobal", ctx, schedulerOpID).Return(nil, testErr).Once()
+				return repo
+			},
+			ExpectedErr: testErr.Error(),
+		},
+		{
+			Name:    "Returns error when operation update fails",
+			Request: failedRequest,
+			RepositoryFn: func() *automock.OperationRepository {
+				repo := &automock.OperationRepository{}
+				repo.On("GetLatestInProgressByOperationIDGlobal", ctx, schedulerOpID).Return(fixInProgressOperationModel(), nil).Once()
+				repo.On("UpdateGlobal", ctx, fixFailedOperationModel()).Return(testErr).Once()
+				return repo
+			},
+			ExpectedErr: testErr.Error(),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			repo := testCase.RepositoryFn()
+
+			svc := operationhistory.NewService(repo, nil)
+			svc.SetTimestampGen(func() time.Time { return finishedAt })
+
+			// when
+			err := svc.RecordFinished(ctx, testCase.Request)
+
+			// then
+			if testCase.ExpectedErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErr)
+			}
+
+			repo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestService_IsCancelled(t *testing.T) {
+	// given
+	ctx := context.TODO()
+	testErr := errors.New("test error")
+
+	cancelledOperation := fixFailedOperationModel()
+	cancelledOperation.Error = str(operation.CancelledOperationError)
+
+	testCases := []struct {
+		Name              string
+		RepositoryFn      func() *automock.OperationRepository
+		ExpectedCancelled bool
+		ExpectedErr       string
+	}{
+		{
+			Name: "Returns true for cancelled operation",
+			RepositoryFn: func() *automock.OperationRepository {
+				repo := &automock.OperationRepository{}
+				repo.On("GetLatestByOperationIDGlobal", ctx, schedulerOpID).Return(cancelledOperation, nil).Once()
+				return repo
+			},
+			ExpectedCancelled: true,
+		},
+		{
+			Name: "Returns false for failed operation",
+			RepositoryFn: func() *automock.OperationRepository {
+				repo := &automock.OperationRepository{}
+				repo.On("GetLatestByOperationIDGlobal", ctx, schedulerOpID).Return(fixFailedOperationModel(), nil).Once()
+				return repo
+			},
+		},
+		{
+			Name: "Returns false for operation in progress",
+			RepositoryFn: func() *automock.OperationRepository {
+				repo := &automock.OperationRepository{}
+				repo.On("GetLatestByOperationIDGlobal", ctx, schedulerOpID).Return(fixInProgressOperationModel(), nil).Once()
+				return repo
+			},
+		},
+		{
+			Name: "Returns false when there is no operation",
+			RepositoryFn: func() *automock.OperationRepository {
+				repo := &automock.OperationRepository{}
+				repo.On("GetLatestByOperationIDGlobal", ctx, schedulerOpID).Return(nil, apperrors.NewNotFoundError(resource.Operation, "")).Once()
+				return repo
+			},
+		},
+		{
+			Name: "Returns error when getting the operation fails",
+			RepositoryFn: func() *automock.OperationRepository {
+				repo := &automock.OperationRepository{}
+				repo.On("GetLatestByOperationIDGlobal", ctx, schedulerOpID).Return(nil, testErr).Once()
+				return repo
+			},
+			ExpectedErr: testErr.Error(),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			repo := testCase.RepositoryFn()
+
+			svc := operationhistory.NewService(repo, nil)
+
+			// when
+			cancelled, err := svc.IsCancelled(ctx, schedulerOpID)
+
+			// then
+			if testCase.ExpectedErr == "" {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.ExpectedCancelled, cancelled)
+			} else {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErr)
+			}
+
+			repo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestService_ListByResourceID(t *testing.T) {
+	// given
+	testErr := errors.New("Test error")
+	ctx := tenant.SaveToContext(context.TODO(), tenantID, externalTenantID)
+
+	operationPage := &model.OperationPage{
+		Data:       []*model.Operation{fixInProgressOperationModel()},
+		TotalCount: 1,
+	}
+
+	testCases := []struct {
+		Name         string
+		PageSize     int
+		RepositoryFn func() *automock.OperationRepository
+		ExpectedPage *model.OperationPage
+		ExpectedErr  string
+	}{
+		{
+			Name:     "Success",
+			PageSize: 2,
+			RepositoryFn: func() *automock.OperationRepository {
+				repo := &automock.OperationRepository{}
+				repo.On("ListByResourceID", ctx, tenantID, resourceID, 2, "").Return(operationPage, nil).Once()
+				return repo
+			},
+			ExpectedPage: operationPage,
+		},
+		{
+			Name:     "Returns error when listing fails",
+			PageSize: 2,
+			RepositoryFn: func() *automock.OperationRepository {
+				repo := &automock.OperationRepository{}
+				repo.On("ListByResourceID", ctx, tenantID, resourceID, 2, "").Return(nil, testErr).Once()
+				return repo
+			},
+			ExpectedErr: testErr.Error(),
+		},
+		{
+			Name:         "Returns error when page size is less than 1",
+			PageSize:     0,
+			RepositoryFn: func() *automock.OperationRepository { return &automock.OperationRepository{} },
+			ExpectedErr:  "page size must be between 1 and 200",
+		},
+		{
+			Name:         "Returns error when page size is bigger than 200",
+			PageSize:     201,
+			RepositoryFn: func() *automock.OperationRepository { return &automock.OperationRepository{} },
+			ExpectedErr:  "page size must be between 1 and 200",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			repo := testCase.RepositoryFn()
+
+			svc := operationhistory.NewService(repo, nil)
+
+			// when
+			page, err := svc.ListByResourceID(ctx, resourceID, testCase.PageSize, "")
+
+			// then
+			if testCase.ExpectedErr == "" {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.ExpectedPage, page)
+			} else {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErr)
+			}
+
+			repo.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,133 @@
+package ordsyncstatus
+
+import (
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/repo"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/pkg/errors"
+)
+
+type converter struct {
+}
+
+func NewConverter() *converter {
+	return &converter{}
+}
+
+func (c *converter) ToEntity(in *model.ORDSyncStatus) (*Entity, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	documents, err := nullableJSON(in.Documents, in.Documents == nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "while marshalling documents")
+	}
+
+	resourceHashes, err := nullableJSON(in.ResourceHashes, in.ResourceHashes == nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "while marshalling resource hashes")
+	}
+
+	return &Entity{
+		ApplicationID:       in.ApplicationID,
+		TenantID:            in.TenantID,
+		LastSyncAt:          repo.NewNullableTime(in.LastSyncAt),
+		LastSuccessAt:       repo.NewNullableTime(in.LastSuccessAt),
+		LastError:           repo.NewNullableString(in.LastError),
+		ConsecutiveFailures: in.ConsecutiveFailures,
+		NextSyncAt:          repo.NewNullableTime(in.NextSyncAt),
+		Documents:           documents,
+		ResourceHashes:      resourceHashes,
+	}, nil
+}
+
+func (c *converter) FromEntity(entity *Entity) (*model.ORDSyncStatus, error) {
+	if entity == nil {
+		return nil, apperrors.NewInternalError("the ORD sync status entity is nil")
+	}
+
+	var documents []model.ORDDocumentStatus
+	if entity.Documents.Valid {
+		if err := json.Unmarshal([]byte(entity.Documents.String), &documents); err != nil {
+			return nil, errors.Wrap(err, "while unmarshalling documents")
+		}
+	}
+
+	var resourceHashes map[string]string
+	if entity.ResourceHashes.Valid {
+		if err := json.Unmarshal([]byte(entity.ResourceHashes.String), &resourceHashes); err != nil {
+			return nil, errors.Wrap(err, "while unmarshalling resource hashes")
+		}
+	}
+
+	return &model.ORDSyncStatus{
+		ApplicationID:       entity.ApplicationID,
+		TenantID:            entity.TenantID,
+		LastSyncAt:          repo.TimePtrFromNullableTime(entity.LastSyncAt),
+		LastSuccessAt:       repo.TimePtrFromNullableTime(entity.LastSuccessAt),
+		LastError:           repo.StringPtrFromNullableString(entity.LastError),
+		ConsecutiveFailures: entity.ConsecutiveFailures,
+		NextSyncAt:          repo.TimePtrFromNullableTime(entity.NextSyncAt),
+		Documents:           documents,
+		ResourceHashes:      resourceHashes,
+	}, nil
+}
+
+func (c *converter) ToGraphQL(in *model.ORDSyncStatus) *graphql.ORDSyncStatus {
+	if in == nil {
+		return nil
+	}
+
+	documents := make([]*graphql.ORDDocumentSyncStatus, 0, len(in.Documents))
+	for _, doc := range in.Documents {
+		documents = append(documents, &graphql.ORDDocumentSyncStatus{
+			URL:          doc.URL,
+			Hash:         doc.Hash,
+			Etag:         optionalString(doc.ETag),
+			LastModified: optionalString(doc.LastModified),
+			SkipReason:   optionalString(doc.SkipReason),
+		})
+	}
+
+	return &graphql.ORDSyncStatus{
+		LastSyncAt:          timestampPtr(in.LastSyncAt),
+		LastSuccessAt:       timestampPtr(in.LastSuccessAt),
+		LastError:           in.LastError,
+		ConsecutiveFailures: in.ConsecutiveFailures,
+		NextSyncAt:          timestampPtr(in.NextSyncAt),
+		Documents:           documents,
+	}
+}
+
+func nullableJSON(in interface{}, isNil bool) (sql.NullString, error) {
+	if isNil {
+		return sql.NullString{}, nil
+	}
+
+	marshalled, err := json.Marshal(in)
+	if err != nil {
+		return sql.NullString{}, err
+	}
+	return repo.NewValidNullableString(string(marshalled)), nil
+}
+
+func optionalString(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+func timestampPtr(t *time.Time) *graphql.Timestamp {
+	if t == nil {
+		return nil
+	}
+	ts := graphql.Timestamp(*t)
+	return &ts
+}
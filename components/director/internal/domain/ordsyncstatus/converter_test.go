@@ -0,0 +1,85 @@
+package ordsyncstatus_test
+
+import (
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/ordsyncstatus"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEntityConverter_ToEntity(t *testing.T) {
+	t.Run("success all nullable properties filled", func(t *testing.T) {
+		//GIVEN
+		syncStatusModel := fixSyncStatusModel()
+		conv := ordsyncstatus.NewConverter()
+		//WHEN
+		entity, err := conv.ToEntity(syncStatusModel)
+		//THEN
+		require.NoError(t, err)
+		assert.Equal(t, fixSyncStatusEntity(), entity)
+	})
+
+	t.Run("success all nullable properties empty", func(t *testing.T) {
+		//GIVEN
+		syncStatusModel := &model.ORDSyncStatus{ApplicationID: appID, TenantID: tenantID}
+		conv := ordsyncstatus.NewConverter()
+		//WHEN
+		entity, err := conv.ToEntity(syncStatusModel)
+		//THEN
+		require.NoError(t, err)
+		assert.Equal(t, &ordsyncstatus.Entity{ApplicationID: appID, TenantID: tenantID}, entity)
+	})
+}
+
+func TestEntityConverter_FromEntity(t *testing.T) {
+	t.Run("success all nullable properties filled", func(t *testing.T) {
+		//GIVEN
+		entity := fixSyncStatusEntity()
+		conv := ordsyncstatus.NewConverter()
+		//WHEN
+		syncStatusModel, err := conv.FromEntity(entity)
+		//THEN
+		require.NoError(t, err)
+		assert.Equal(t, fixSyncStatusModel(), syncStatusModel)
+	})
+
+	t.Run("error for invalid documents", func(t *testing.T) {
+		//GIVEN
+		entity := fixSyncStatusEntity()
+		entity.Documents.String = "not-json"
+		conv := ordsyncstatus.NewConverter()
+		//WHEN
+		_, err := conv.FromEntity(entity)
+		//THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "while unmarshalling documents")
+	})
+}
+
+func TestConverter_ToGraphQL(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		//GIVEN
+		conv := ordsyncstatus.NewConverter()
+		//WHEN
+		result := conv.ToGraphQL(fixSyncStatusModel())
+		//THEN
+		assert.Equal(t, fixGQLSyncStatus(), result)
+	})
+
+	t.Run("returns empty documents when there are none", func(t *testing.T) {
+		//GIVEN
+		conv := ordsyncstatus.NewConverter()
+		//WHEN
+		result := conv.ToGraphQL(&model.ORDSyncStatus{ApplicationID: appID})
+		//THEN
+		assert.Empty(t, result.Documents)
+		assert.NotNil(t, result.Documents)
+	})
+
+	t.Run("nil model", func(t *testing.T) {
+		conv := ordsyncstatus.NewConverter()
+		assert.Nil(t, conv.ToGraphQL(nil))
+	})
+}
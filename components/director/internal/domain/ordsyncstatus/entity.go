@@ -0,0 +1,17 @@
+package ordsyncstatus
+
+import (
+	"database/sql"
+)
+
+type Entity struct {
+	ApplicationID       string         `db:"app_id"`
+	TenantID            string         `db:"tenant_id"`
+	LastSyncAt          sql.NullTime   `db:"last_sync_at"`
+	LastSuccessAt       sql.NullTime   `db:"last_success_at"`
+	LastError           sql.NullString `db:"last_error"`
+	ConsecutiveFailures int            `db:"consecutive_failures"`
+	NextSyncAt          sql.NullTime   `db:"next_sync_at"`
+	Documents           sql.NullString `db:"documents"`
+	ResourceHashes      sql.NullString `db:"resource_hashes"`
+}
@@ -0,0 +1,87 @@
+package ordsyncstatus_test
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/ordsyncstatus"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/str"
+)
+
+const (
+	appID            = "aaaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa"
+	tenantID         = "b91b59f7-2563-40b2-aba9-fef726037aa3"
+	externalTenantID = "eeeeeeeee-eeee-eeee-eeee-eeeeeeeeeeee"
+	documentHash     = "5e884898da28047151d0e56f8dc6292773603d0d6aabbdd62a11ef721d1542d8"
+	lastError        = "error fetching ORD documents"
+	documentURL      = "http://localhost:8080/open-resource-discovery/v1/documents/example1"
+	skippedURL       = "http://localhost:8080/open-resource-discovery/v1/documents/example2"
+	skipReason       = `unsupported access strategies ["test"]`
+	etag             = `"33a64df5"`
+	resourceKey      = "package:ns:package:PACKAGE_ID:v1"
+
+	documentsJSON      = `[{"url":"` + documentURL + `","hash":"` + documentHash + `","etag":"\"33a64df5\""},{"url":"` + skippedURL + `","hash":"","skipReason":"unsupported access strategies [\"test\"]"}]`
+	resourceHashesJSON = `{"` + resourceKey + `":"` + documentHash + `"}`
+)
+
+var (
+	lastSyncAt    = time.Date(2021, 3, 23, 10, 0, 0, 0, time.UTC)
+	lastSuccessAt = time.Date(2021, 3, 22, 10, 0, 0, 0, time.UTC)
+	nextSyncAt    = time.Date(2021, 3, 23, 10, 4, 0, 0, time.UTC)
+)
+
+func fixSyncStatusModel() *model.ORDSyncStatus {
+	return &model.ORDSyncStatus{
+		ApplicationID:       appID,
+		TenantID:            tenantID,
+		LastSyncAt:          &lastSyncAt,
+		LastSuccessAt:       &lastSuccessAt,
+		LastError:           str.Ptr(lastError),
+		ConsecutiveFailures: 2,
+		NextSyncAt:          &nextSyncAt,
+		Documents:           []model.ORDDocumentStatus{{URL: documentURL, Hash: documentHash, ETag: etag}, {URL: skippedURL, SkipReason: skipReason}},
+		ResourceHashes:      map[string]string{resourceKey: documentHash},
+	}
+}
+
+func fixSyncStatusEntity() *ordsyncstatus.Entity {
+	return &ordsyncstatus.Entity{
+		ApplicationID:       appID,
+		TenantID:            tenantID,
+		LastSyncAt:          sql.NullTime{Time: lastSyncAt, Valid: true},
+		LastSuccessAt:       sql.NullTime{Time: lastSuccessAt, Valid: true},
+		LastError:           sql.NullString{String: lastError, Valid: true},
+		ConsecutiveFailures: 2,
+		NextSyncAt:          sql.NullTime{Time: nextSyncAt, Valid: true},
+		Documents:           sql.NullString{String: documentsJSON, Valid: true},
+		ResourceHashes:      sql.NullString{String: resourceHashesJSON, Valid: true},
+	}
+}
+
+func fixGQLSyncStatus() *graphql.ORDSyncStatus {
+	lastSync := graphql.Timestamp(lastSyncAt)
+	lastSuccess := graphql.Timestamp(lastSuccessAt)
+	nextSync := graphql.Timestamp(nextSyncAt)
+	return &graphql.ORDSyncStatus{
+		LastSyncAt:          &lastSync,
+		LastSuccessAt:       &lastSuccess,
+		LastError:           str.Ptr(lastError),
+		ConsecutiveFailures: 2,
+		NextSyncAt:          &nextSync,
+		Documents: []*graphql.ORDDocumentSyncStatus{
+			{URL: documentURL, Hash: documentHash, Etag: str.Ptr(etag)},
+			{URL: skippedURL, SkipReason: str.Ptr(skipReason)},
+		},
+	}
+}
+
+func fixSyncStatusColumns() []string {
+	return []string{"app_id", "tenant_id", "last_sync_at", "last_success_at", "last_error", "consecutive_failures", "next_sync_at", "documents", "resource_hashes"}
+}
+
+func fixSyncStatusRow() []driver.Value {
+	return []driver.Value{appID, tenantID, lastSyncAt, lastSuccessAt, lastError, 2, nextSyncAt, documentsJSON, resourceHashesJSON}
+}
@@ -0,0 +1,95 @@
+package ordsyncstatus
+
+import (
+	"context"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/repo"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/pkg/errors"
+)
+
+const syncStatusTable string = `public.ord_sync_statuses`
+
+var (
+	tenantColumn      = "tenant_id"
+	syncStatusColumns = []string{"app_id", tenantColumn, "last_sync_at", "last_success_at", "last_error", "consecutive_failures", "next_sync_at", "documents", "resource_hashes"}
+	conflictColumns   = []string{"app_id"}
+	updatableColumns  = []string{"last_sync_at", "last_success_at", "last_error", "consecutive_failures", "next_sync_at", "documents", "resource_hashes"}
+)
+
+//go:generate mockery -name=EntityConverter -output=automock -outpkg=automock -case=underscore
+type EntityConverter interface {
+	ToEntity(in *model.ORDSyncStatus) (*Entity, error)
+	FromEntity(entity *Entity) (*model.ORDSyncStatus, error)
+}
+
+type pgRepository struct {
+	conv         EntityConverter
+	singleGetter repo.SingleGetter
+	listerGlobal repo.ListerGlobal
+	upserter     repo.Upserter
+}
+
+func NewRepository(conv EntityConverter) *pgRepository {
+	return &pgRepository{
+		conv:         conv,
+		singleGetter: repo.NewSingleGetter(resource.ORDSyncStatus, syncStatusTable, tenantColumn, syncStatusColumns),
+		listerGlobal: repo.NewListerGlobal(resource.ORDSyncStatus, syncStatusTable, syncStatusColumns),
+		upserter:     repo.NewUpserter(resource.ORDSyncStatus, syncStatusTable, syncStatusColumns, conflictColumns, updatableColumns),
+	}
+}
+
+func (r *pgRepository) Upsert(ctx context.Context, model *model.ORDSyncStatus) error {
+	if model == nil {
+		return apperrors.NewInternalError("model can not be nil")
+	}
+
+	entity, err := r.conv.ToEntity(model)
+	if err != nil {
+		return errors.Wrap(err, "while converting ORD sync status to entity")
+	}
+
+	log.C(ctx).Debugf("Upserting ORD sync status for Application with id %q", model.ApplicationID)
+	return r.upserter.Upsert(ctx, entity)
+}
+
+func (r *pgRepository) GetByApplicationID(ctx context.Context, tenant, appID string) (*model.ORDSyncStatus, error) {
+	var entity Entity
+	if err := r.singleGetter.Get(ctx, tenant, repo.Conditions{repo.NewEqualCondition("app_id", appID)}, repo.NoOrderBy, &entity); err != nil {
+		return nil, err
+	}
+
+	syncStatus, err := r.conv.FromEntity(&entity)
+	if err != nil {
+		return nil, errors.Wrap(err, "while converting ORD sync status from entity")
+	}
+
+	return syncStatus, nil
+}
+
+func (r *pgRepository) ListGlobal(ctx context.Context) ([]*model.ORDSyncStatus, error) {
+	var entities syncStatusCollection
+	if err := r.listerGlobal.ListGlobal(ctx, &entities); err != nil {
+		return nil, err
+	}
+
+	items := make([]*model.ORDSyncStatus, 0, entities.Len())
+	for _, entity := range entities {
+		syncStatus, err := r.conv.FromEntity(&entity)
+		if err != nil {
+			return nil, errors.Wrap(err, "while converting ORD sync status from entity")
+		}
+		items = append(items, syncStatus)
+	}
+
+	return items, nil
+}
+
+type syncStatusCollection []Entity
+
+func (c syncStatusCollection) Len() int {
+	return len(c)
+}
@@ -0,0 +1,118 @@
+package ordsyncstatus_test
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/ordsyncstatus"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/ordsyncstatus/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/repo/testdb"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPgRepository_Upsert(t *testing.T) {
+	upsertQuery := regexp.QuoteMeta(`INSERT INTO public.ord_sync_statuses ( app_id, tenant_id, last_sync_at, last_success_at, last_error, consecutive_failures, next_sync_at, documents, resource_hashes ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ? ) ON CONFLICT ( app_id ) DO UPDATE SET last_sync_at=EXCLUDED.last_sync_at, last_success_at=EXCLUDED.last_success_at, last_error=EXCLUDED.last_error, consecutive_failures=EXCLUDED.consecutive_failures, next_sync_at=EXCLUDED.next_sync_at, documents=EXCLUDED.documents, resource_hashes=EXCLUDED.resource_hashes`)
+
+	t.Run("success", func(t *testing.T) {
+		sqlxDB, sqlMock := testdb.MockDatabase(t)
+		ctx := persistence.SaveToContext(context.TODO(), sqlxDB)
+		syncStatusModel := fixSyncStatusModel()
+
+		sqlMock.ExpectExec(upsertQuery).
+			WithArgs(fixSyncStatusRow()...).
+			WillReturnResult(sqlmock.NewResult(-1, 1))
+
+		convMock := &automock.EntityConverter{}
+		convMock.On("ToEntity", syncStatusModel).Return(fixSyncStatusEntity(), nil).Once()
+		pgRepository := ordsyncstatus.NewRepository(convMock)
+		//WHEN
+		err := pgRepository.Upsert(ctx, syncStatusModel)
+		//THEN
+		require.NoError(t, err)
+		sqlMock.AssertExpectations(t)
+		convMock.AssertExpectations(t)
+	})
+
+	t.Run("returns error when model is nil", func(t *testing.T) {
+		convMock := &automock.EntityConverter{}
+		pgRepository := ordsyncstatus.NewRepository(convMock)
+		//WHEN
+		err := pgRepository.Upsert(context.TODO(), nil)
+		//THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "model can not be nil")
+		convMock.AssertExpectations(t)
+	})
+}
+
+func TestPgRepository_GetByApplicationID(t *testing.T) {
+	selectQuery := `^SELECT (.+) FROM public.ord_sync_statuses WHERE tenant_id = \$1 AND app_id = \$2$`
+
+	t.Run("success", func(t *testing.T) {
+		sqlxDB, sqlMock := testdb.MockDatabase(t)
+		rows := sqlmock.NewRows(fixSyncStatusColumns()).
+			AddRow(fixSyncStatusRow()...)
+
+		sqlMock.ExpectQuery(selectQuery).
+			WithArgs(tenantID, appID).
+			WillReturnRows(rows)
+
+		ctx := persistence.SaveToContext(context.TODO(), sqlxDB)
+		convMock := &automock.EntityConverter{}
+		convMock.On("FromEntity", fixSyncStatusEntity()).Return(fixSyncStatusModel(), nil).Once()
+		pgRepository := ordsyncstatus.NewRepository(convMock)
+		//WHEN
+		syncStatus, err := pgRepository.GetByApplicationID(ctx, tenantID, appID)
+		//THEN
+		require.NoError(t, err)
+		assert.Equal(t, fixSyncStatusModel(), syncStatus)
+		convMock.AssertExpectations(t)
+		sqlMock.AssertExpectations(t)
+	})
+
+	t.Run("DB Error", func(t *testing.T) {
+		sqlxDB, sqlMock := testdb.MockDatabase(t)
+		testError := errors.New("test error")
+
+		sqlMock.ExpectQuery(selectQuery).
+			WithArgs(tenantID, appID).
+			WillReturnError(testError)
+
+		ctx := persistence.SaveToContext(context.TODO(), sqlxDB)
+		pgRepository := ordsyncstatus.NewRepository(nil)
+		//WHEN
+		_, err := pgRepository.GetByApplicationID(ctx, tenantID, appID)
+		//THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "Internal Server Error: Unexpected error while executing SQL query")
+		sqlMock.AssertExpectations(t)
+	})
+}
+
+func TestPgRepository_ListGlobal(t *testing.T) {
+	selectQuery := `^SELECT (.+) FROM public.ord_sync_statuses$`
+
+	sqlxDB, sqlMock := testdb.MockDatabase(t)
+	rows := sqlmock.NewRows(fixSyncStatusColumns()).
+		AddRow(fixSyncStatusRow()...)
+
+	sqlMock.ExpectQuery(selectQuery).WillReturnRows(rows)
+
+	ctx := persistence.SaveToContext(context.TODO(), sqlxDB)
+	convMock := &automock.EntityConverter{}
+	convMock.On("FromEntity", fixSyncStatusEntity()).Return(fixSyncStatusModel(), nil).Once()
+	pgRepository := ordsyncstatus.NewRepository(convMock)
+	//WHEN
+	syncStatuses, err := pgRepository.ListGlobal(ctx)
+	//THEN
+	require.NoError(t, err)
+	require.Len(t, syncStatuses, 1)
+	assert.Equal(t, fixSyncStatusModel(), syncStatuses[0])
+	convMock.AssertExpectations(t)
+	sqlMock.AssertExpectations(t)
+}
@@ -0,0 +1,64 @@
+package ordsyncstatus
+
+import (
+	"context"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+	"github.com/pkg/errors"
+)
+
+//go:generate mockery -name=SyncStatusService -output=automock -outpkg=automock -case=underscore
+type SyncStatusService interface {
+	GetByApplicationID(ctx context.Context, appID string) (*model.ORDSyncStatus, error)
+}
+
+//go:generate mockery -name=SyncStatusConverter -output=automock -outpkg=automock -case=underscore
+type SyncStatusConverter interface {
+	ToGraphQL(in *model.ORDSyncStatus) *graphql.ORDSyncStatus
+}
+
+type Resolver struct {
+	transact persistence.Transactioner
+
+	syncStatusSvc  SyncStatusService
+	syncStatusConv SyncStatusConverter
+}
+
+func NewResolver(transact persistence.Transactioner, syncStatusSvc SyncStatusService, syncStatusConv SyncStatusConverter) *Resolver {
+	return &Resolver{
+		transact:       transact,
+		syncStatusSvc:  syncStatusSvc,
+		syncStatusConv: syncStatusConv,
+	}
+}
+
+func (r *Resolver) SyncStatusForApplication(ctx context.Context, obj *graphql.Application) (*graphql.ORDSyncStatus, error) {
+	if obj == nil {
+		return nil, apperrors.NewInternalError("Application cannot be empty")
+	}
+
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer r.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	syncStatus, err := r.syncStatusSvc.GetByApplicationID(ctx, obj.ID)
+	if err != nil {
+		if apperrors.IsNotFoundError(err) {
+			return nil, tx.Commit()
+		}
+		return nil, errors.Wrapf(err, "while getting ORD sync status for Application with id %s", obj.ID)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return r.syncStatusConv.ToGraphQL(syncStatus), nil
+}
@@ -0,0 +1,64 @@
+package ordsyncstatus
+
+import (
+	"context"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/tenant"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/pkg/errors"
+)
+
+//go:generate mockery -name=SyncStatusRepository -output=automock -outpkg=automock -case=underscore
+type SyncStatusRepository interface {
+	Upsert(ctx context.Context, item *model.ORDSyncStatus) error
+	GetByApplicationID(ctx context.Context, tenant, appID string) (*model.ORDSyncStatus, error)
+	ListGlobal(ctx context.Context) ([]*model.ORDSyncStatus, error)
+}
+
+type service struct {
+	syncStatusRepo SyncStatusRepository
+}
+
+func NewService(syncStatusRepo SyncStatusRepository) *service {
+	return &service{
+		syncStatusRepo: syncStatusRepo,
+	}
+}
+
+func (s *service) Upsert(ctx context.Context, in *model.ORDSyncStatus) error {
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	in.TenantID = tnt
+	if err := s.syncStatusRepo.Upsert(ctx, in); err != nil {
+		return errors.Wrapf(err, "while upserting ORD sync status for Application with id %s", in.ApplicationID)
+	}
+
+	return nil
+}
+
+func (s *service) GetByApplicationID(ctx context.Context, appID string) (*model.ORDSyncStatus, error) {
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	syncStatus, err := s.syncStatusRepo.GetByApplicationID(ctx, tnt, appID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while getting ORD sync status for Application with id %s", appID)
+	}
+
+	return syncStatus, nil
+}
+
+// ListGlobal returns the ORD sync statuses of the applications of all tenants
+func (s *service) ListGlobal(ctx context.Context) ([]*model.ORDSyncStatus, error) {
+	syncStatuses, err := s.syncStatusRepo.ListGlobal(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "while listing ORD sync statuses")
+	}
+
+	return syncStatuses, nil
+}
@@ -0,0 +1,171 @@
+package ordsyncstatus_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/ordsyncstatus"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/ordsyncstatus/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/tenant"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_Upsert(t *testing.T) {
+	// given
+	testErr := errors.New("Test error")
+
+	ctx := context.TODO()
+	ctx = tenant.SaveToContext(ctx, tenantID, externalTenantID)
+
+	testCases := []struct {
+		Name         string
+		RepositoryFn func() *automock.SyncStatusRepository
+		Context      context.Context
+		ExpectedErr  error
+	}{
+		{
+			Name: "Success",
+			RepositoryFn: func() *automock.SyncStatusRepository {
+				repo := &automock.SyncStatusRepository{}
+				repo.On("Upsert", ctx, fixSyncStatusModel()).Return(nil).Once()
+				return repo
+			},
+			Context: ctx,
+		},
+		{
+			Name: "Error - upsert",
+			RepositoryFn: func() *automock.SyncStatusRepository {
+				repo := &automock.SyncStatusRepository{}
+				repo.On("Upsert", ctx, fixSyncStatusModel()).Return(testErr).Once()
+				return repo
+			},
+			Context:     ctx,
+			ExpectedErr: testErr,
+		},
+		{
+			Name: "Error - missing tenant",
+			RepositoryFn: func() *automock.SyncStatusRepository {
+				return &automock.SyncStatusRepository{}
+			},
+			Context:     context.TODO(),
+			ExpectedErr: errors.New("cannot read tenant from context"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// given
+			repo := testCase.RepositoryFn()
+			svc := ordsyncstatus.NewService(repo)
+			in := fixSyncStatusModel()
+			in.TenantID = ""
+
+			// when
+			err := svc.Upsert(testCase.Context, in)
+
+			// then
+			if testCase.ExpectedErr != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+
+			mock.AssertExpectationsForObjects(t, repo)
+		})
+	}
+}
+
+func TestService_GetByApplicationID(t *testing.T) {
+	// given
+	testErr := errors.New("Test error")
+
+	ctx := context.TODO()
+	ctx = tenant.SaveToContext(ctx, tenantID, externalTenantID)
+
+	testCases := []struct {
+		Name           string
+		RepositoryFn   func() *automock.SyncStatusRepository
+		ExpectedOutput *model.ORDSyncStatus
+		ExpectedErr    error
+	}{
+		{
+			Name: "Success",
+			RepositoryFn: func() *automock.SyncStatusRepository {
+				repo := &automock.SyncStatusRepository{}
+				repo.On("GetByApplicationID", ctx, tenantID, appID).Return(fixSyncStatusModel(), nil).Once()
+				return repo
+			},
+			ExpectedOutput: fixSyncStatusModel(),
+		},
+		{
+			Name: "Error - get",
+			RepositoryFn: func() *automock.SyncStatusRepository {
+				repo := &automock.SyncStatusRepository{}
+				repo.On("GetByApplicationID", ctx, tenantID, appID).Return(nil, testErr).Once()
+				return repo
+			},
+			ExpectedErr: testErr,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// given
+			repo := testCase.RepositoryFn()
+			svc := ordsyncstatus.NewService(repo)
+
+			// when
+			syncStatus, err := svc.GetByApplicationID(ctx, appID)
+
+			// then
+			if testCase.ExpectedErr != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErr.Error())
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.ExpectedOutput, syncStatus)
+			}
+
+			mock.AssertExpectationsForObjects(t, repo)
+		})
+	}
+}
+
+func TestService_ListGlobal(t *testing.T) {
+	// given
+	testErr := errors.New("Test error")
+	ctx := context.TODO()
+
+	t.Run("Success", func(t *testing.T) {
+		repo := &automock.SyncStatusRepository{}
+		repo.On("ListGlobal", ctx).Return([]*model.ORDSyncStatus{fixSyncStatusModel()}, nil).Once()
+		svc := ordsyncstatus.NewService(repo)
+
+		// when
+		syncStatuses, err := svc.ListGlobal(ctx)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, []*model.ORDSyncStatus{fixSyncStatusModel()}, syncStatuses)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("Error", func(t *testing.T) {
+		repo := &automock.SyncStatusRepository{}
+		repo.On("ListGlobal", ctx).Return(nil, testErr).Once()
+		svc := ordsyncstatus.NewService(repo)
+
+		// when
+		_, err := svc.ListGlobal(ctx)
+
+		// then
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), testErr.Error())
+		repo.AssertExpectations(t)
+	})
+}
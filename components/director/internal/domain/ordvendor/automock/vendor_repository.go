@@ -86,6 +86,29 @@ func (_m *VendorRepository) GetByID(ctx context.Context, tenant string, id strin
 	return r0, r1
 }
 
+// List provides a mock function with given fields: ctx, tenantID, pageSize, cursor
+func (_m *VendorRepository) List(ctx context.Context, tenantID string, pageSize int, cursor string) (*model.VendorPage, error) {
+	ret := _m.Called(ctx, tenantID, pageSize, cursor)
+
+	var r0 *model.VendorPage
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, string) *model.VendorPage); ok {
+		r0 = rf(ctx, tenantID, pageSize, cursor)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.VendorPage)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, int, string) error); ok {
+		r1 = rf(ctx, tenantID, pageSize, cursor)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ListByApplicationID provides a mock function with given fields: ctx, tenantID, appID
 func (_m *VendorRepository) ListByApplicationID(ctx context.Context, tenantID string, appID string) ([]*model.Vendor, error) {
 	ret := _m.Called(ctx, tenantID, appID)
@@ -109,6 +132,29 @@ func (_m *VendorRepository) ListByApplicationID(ctx context.Context, tenantID st
 	return r0, r1
 }
 
+// ListByApplicationIDPage provides a mock function with given fields: ctx, tenantID, appID, pageSize, cursor
+func (_m *VendorRepository) ListByApplicationIDPage(ctx context.Context, tenantID string, appID string, pageSize int, cursor string) (*model.VendorPage, error) {
+	ret := _m.Called(ctx, tenantID, appID, pageSize, cursor)
+
+	var r0 *model.VendorPage
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int, string) *model.VendorPage); ok {
+		r0 = rf(ctx, tenantID, appID, pageSize, cursor)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.VendorPage)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, int, string) error); ok {
+		r1 = rf(ctx, tenantID, appID, pageSize, cursor)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Update provides a mock function with given fields: ctx, item
 func (_m *VendorRepository) Update(ctx context.Context, item *model.Vendor) error {
 	ret := _m.Called(ctx, item)
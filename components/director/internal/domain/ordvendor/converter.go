@@ -1,8 +1,11 @@
 package ordvendor
 
 import (
+	"encoding/json"
+
 	"github.com/kyma-incubator/compass/components/director/internal/repo"
 	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
 
 	"github.com/kyma-incubator/compass/components/director/internal/model"
 )
@@ -47,3 +50,39 @@ func (c *converter) FromEntity(entity *Entity) (*model.Vendor, error) {
 
 	return output, nil
 }
+
+func (c *converter) ToGraphQL(in *model.Vendor) *graphql.Vendor {
+	if in == nil {
+		return nil
+	}
+
+	return &graphql.Vendor{
+		ID:            in.OrdID,
+		OrdID:         in.OrdID,
+		ApplicationID: in.ApplicationID,
+		Title:         in.Title,
+		Type:          in.Type,
+		Labels:        rawJSONToGraphQLJSON(in.Labels),
+	}
+}
+
+func (c *converter) MultipleToGraphQL(in []*model.Vendor) []*graphql.Vendor {
+	vendors := make([]*graphql.Vendor, 0, len(in))
+	for _, vendor := range in {
+		if vendor == nil {
+			continue
+		}
+		vendors = append(vendors, c.ToGraphQL(vendor))
+	}
+
+	return vendors
+}
+
+func rawJSONToGraphQLJSON(in json.RawMessage) *graphql.JSON {
+	if len(in) == 0 {
+		return nil
+	}
+
+	out := graphql.JSON(in)
+	return &out
+}
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/kyma-incubator/compass/components/director/internal/domain/ordvendor"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -48,3 +49,31 @@ func TestEntityConverter_FromEntity(t *testing.T) {
 		require.Error(t, err)
 	})
 }
+
+func TestConverter_ToGraphQL(t *testing.T) {
+	labels := graphql.JSON("{}")
+	expected := &graphql.Vendor{
+		ID:            ordID,
+		OrdID:         ordID,
+		ApplicationID: appID,
+		Title:         "title",
+		Type:          "type",
+		Labels:        &labels,
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		conv := ordvendor.NewConverter()
+
+		result := conv.ToGraphQL(fixVendorModel())
+
+		assert.Equal(t, expected, result)
+	})
+
+	t.Run("Returns nil if vendor model is nil", func(t *testing.T) {
+		conv := ordvendor.NewConverter()
+
+		result := conv.ToGraphQL(nil)
+
+		require.Nil(t, result)
+	})
+}
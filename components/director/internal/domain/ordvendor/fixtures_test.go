@@ -8,6 +8,8 @@ import (
 
 	"github.com/kyma-incubator/compass/components/director/internal/domain/ordvendor"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
 )
 
 const (
@@ -59,3 +61,37 @@ func fixVendorRow() []driver.Value {
 func fixVendorUpdateArgs() []driver.Value {
 	return []driver.Value{"title", "type", repo.NewValidNullableString("{}")}
 }
+
+func fixGQLVendor() *graphql.Vendor {
+	return &graphql.Vendor{
+		ID:            ordID,
+		OrdID:         ordID,
+		ApplicationID: appID,
+		Title:         "title",
+		Type:          "type",
+	}
+}
+
+func fixVendorPage(vendors []*model.Vendor) *model.VendorPage {
+	return &model.VendorPage{
+		Data:       vendors,
+		TotalCount: len(vendors),
+		PageInfo: &pagination.Page{
+			StartCursor: "start",
+			EndCursor:   "end",
+			HasNextPage: false,
+		},
+	}
+}
+
+func fixGQLVendorPage(vendors []*graphql.Vendor) *graphql.VendorPage {
+	return &graphql.VendorPage{
+		Data:       vendors,
+		TotalCount: len(vendors),
+		PageInfo: &graphql.PageInfo{
+			StartCursor: "start",
+			EndCursor:   "end",
+			HasNextPage: false,
+		},
+	}
+}
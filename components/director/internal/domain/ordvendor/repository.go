@@ -26,24 +26,26 @@ type EntityConverter interface {
 }
 
 type pgRepository struct {
-	conv         EntityConverter
-	existQuerier repo.ExistQuerier
-	singleGetter repo.SingleGetter
-	lister       repo.Lister
-	deleter      repo.Deleter
-	creator      repo.Creator
-	updater      repo.Updater
+	conv            EntityConverter
+	existQuerier    repo.ExistQuerier
+	singleGetter    repo.SingleGetter
+	lister          repo.Lister
+	deleter         repo.Deleter
+	creator         repo.Creator
+	updater         repo.Updater
+	pageableQuerier repo.PageableQuerier
 }
 
 func NewRepository(conv EntityConverter) *pgRepository {
 	return &pgRepository{
-		conv:         conv,
-		existQuerier: repo.NewExistQuerier(resource.Vendor, vendorTable, tenantColumn),
-		singleGetter: repo.NewSingleGetter(resource.Vendor, vendorTable, tenantColumn, vendorColumns),
-		lister:       repo.NewLister(resource.Vendor, vendorTable, tenantColumn, vendorColumns),
-		deleter:      repo.NewDeleter(resource.Vendor, vendorTable, tenantColumn),
-		creator:      repo.NewCreator(resource.Vendor, vendorTable, vendorColumns),
-		updater:      repo.NewUpdater(resource.Vendor, vendorTable, updatableColumns, tenantColumn, []string{"ord_id"}),
+		conv:            conv,
+		existQuerier:    repo.NewExistQuerier(resource.Vendor, vendorTable, tenantColumn),
+		singleGetter:    repo.NewSingleGetter(resource.Vendor, vendorTable, tenantColumn, vendorColumns),
+		lister:          repo.NewLister(resource.Vendor, vendorTable, tenantColumn, vendorColumns),
+		deleter:         repo.NewDeleter(resource.Vendor, vendorTable, tenantColumn),
+		creator:         repo.NewCreator(resource.Vendor, vendorTable, vendorColumns),
+		updater:         repo.NewUpdater(resource.Vendor, vendorTable, updatableColumns, tenantColumn, []string{"ord_id"}),
+		pageableQuerier: repo.NewPageableQuerier(resource.Vendor, vendorTable, tenantColumn, vendorColumns),
 	}
 }
 
@@ -104,6 +106,37 @@ func (r *pgRepository) ListByApplicationID(ctx context.Context, tenantID, appID
 	return vendors, nil
 }
 
+func (r *pgRepository) ListByApplicationIDPage(ctx context.Context, tenantID, appID string, pageSize int, cursor string) (*model.VendorPage, error) {
+	return r.list(ctx, tenantID, pageSize, cursor, repo.NewEqualCondition("app_id", appID))
+}
+
+func (r *pgRepository) List(ctx context.Context, tenantID string, pageSize int, cursor string) (*model.VendorPage, error) {
+	return r.list(ctx, tenantID, pageSize, cursor)
+}
+
+func (r *pgRepository) list(ctx context.Context, tenantID string, pageSize int, cursor string, conditions ...repo.Condition) (*model.VendorPage, error) {
+	var vendorCollection vendorCollection
+	page, totalCount, err := r.pageableQuerier.List(ctx, tenantID, pageSize, cursor, "ord_id", &vendorCollection, conditions...)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*model.Vendor, 0, vendorCollection.Len())
+	for _, vendorEnt := range vendorCollection {
+		vendorModel, err := r.conv.FromEntity(&vendorEnt)
+		if err != nil {
+			return nil, errors.Wrap(err, "while creating Vendor model from entity")
+		}
+		items = append(items, vendorModel)
+	}
+
+	return &model.VendorPage{
+		Data:       items,
+		TotalCount: totalCount,
+		PageInfo:   page,
+	}, nil
+}
+
 type vendorCollection []Entity
 
 func (pc vendorCollection) Len() int {
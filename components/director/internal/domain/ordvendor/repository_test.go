@@ -2,6 +2,7 @@ package ordvendor_test
 
 import (
 	"context"
+	"fmt"
 	"regexp"
 	"testing"
 
@@ -235,3 +236,67 @@ func TestPgRepository_ListByApplicationID(t *testing.T) {
 		sqlMock.AssertExpectations(t)
 	})
 }
+
+func TestPgRepository_ListByApplicationIDPage(t *testing.T) {
+	// GIVEN
+	pageSize := 3
+	totalCount := 2
+	firstVendorEntity := fixEntityVendor()
+	secondVendorEntity := fixEntityVendor()
+
+	selectQuery := fmt.Sprintf(`^SELECT (.+) FROM public.vendors
+		WHERE tenant_id = \$1 AND app_id = \$2
+		ORDER BY ord_id LIMIT %d OFFSET %d`, pageSize, 0)
+
+	countQuery := regexp.QuoteMeta(`SELECT COUNT(*) FROM public.vendors
+		WHERE tenant_id = $1 AND app_id = $2`)
+
+	t.Run("success", func(t *testing.T) {
+		sqlxDB, sqlMock := testdb.MockDatabase(t)
+		rows := sqlmock.NewRows(fixVendorColumns()).
+			AddRow(fixVendorRow()...).
+			AddRow(fixVendorRow()...)
+
+		sqlMock.ExpectQuery(selectQuery).
+			WithArgs(tenantID, appID).
+			WillReturnRows(rows)
+
+		sqlMock.ExpectQuery(countQuery).
+			WithArgs(tenantID, appID).
+			WillReturnRows(testdb.RowCount(totalCount))
+
+		ctx := persistence.SaveToContext(context.TODO(), sqlxDB)
+		convMock := &automock.EntityConverter{}
+		convMock.On("FromEntity", firstVendorEntity).Return(&model.Vendor{OrdID: firstVendorEntity.OrdID}, nil)
+		convMock.On("FromEntity", secondVendorEntity).Return(&model.Vendor{OrdID: secondVendorEntity.OrdID}, nil)
+		pgRepository := ordvendor.NewRepository(convMock)
+		// WHEN
+		page, err := pgRepository.ListByApplicationIDPage(ctx, tenantID, appID, pageSize, "")
+		//THEN
+		require.NoError(t, err)
+		require.Len(t, page.Data, totalCount)
+		assert.Equal(t, totalCount, page.TotalCount)
+		assert.False(t, page.PageInfo.HasNextPage)
+		convMock.AssertExpectations(t)
+		sqlMock.AssertExpectations(t)
+	})
+
+	t.Run("DB Error", func(t *testing.T) {
+		sqlxDB, sqlMock := testdb.MockDatabase(t)
+		testErr := errors.New("test error")
+
+		sqlMock.ExpectQuery(selectQuery).
+			WithArgs(tenantID, appID).
+			WillReturnError(testErr)
+
+		ctx := persistence.SaveToContext(context.TODO(), sqlxDB)
+		pgRepository := ordvendor.NewRepository(nil)
+		// WHEN
+		page, err := pgRepository.ListByApplicationIDPage(ctx, tenantID, appID, pageSize, "")
+		//THEN
+		require.Error(t, err)
+		assert.Nil(t, page)
+		assert.Contains(t, err.Error(), testErr.Error())
+		sqlMock.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,140 @@
+package ordvendor
+
+import (
+	"context"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+	"github.com/pkg/errors"
+)
+
+//go:generate mockery -name=VendorService -output=automock -outpkg=automock -case=underscore
+type VendorService interface {
+	Get(ctx context.Context, id string) (*model.Vendor, error)
+	List(ctx context.Context, pageSize int, cursor string) (*model.VendorPage, error)
+	ListByApplicationIDPage(ctx context.Context, appID string, pageSize int, cursor string) (*model.VendorPage, error)
+}
+
+//go:generate mockery -name=VendorConverter -output=automock -outpkg=automock -case=underscore
+type VendorConverter interface {
+	ToGraphQL(in *model.Vendor) *graphql.Vendor
+	MultipleToGraphQL(in []*model.Vendor) []*graphql.Vendor
+}
+
+type Resolver struct {
+	transact persistence.Transactioner
+
+	vendorSvc  VendorService
+	vendorConv VendorConverter
+}
+
+func NewResolver(transact persistence.Transactioner, vendorSvc VendorService, vendorConv VendorConverter) *Resolver {
+	return &Resolver{
+		transact:   transact,
+		vendorSvc:  vendorSvc,
+		vendorConv: vendorConv,
+	}
+}
+
+func (r *Resolver) Vendors(ctx context.Context, first *int, after *graphql.PageCursor) (*graphql.VendorPage, error) {
+	if first == nil {
+		return nil, apperrors.NewInvalidDataError("missing required parameter 'first'")
+	}
+
+	var cursor string
+	if after != nil {
+		cursor = string(*after)
+	}
+
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer r.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	vendorPage, err := r.vendorSvc.List(ctx, *first, cursor)
+	if err != nil {
+		return nil, errors.Wrap(err, "while listing Vendors")
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return r.toGraphQLPage(vendorPage), nil
+}
+
+func (r *Resolver) Vendor(ctx context.Context, id string) (*graphql.Vendor, error) {
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer r.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	vendor, err := r.vendorSvc.Get(ctx, id)
+	if err != nil {
+		if apperrors.IsNotFoundError(err) {
+			return nil, tx.Commit()
+		}
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return r.vendorConv.ToGraphQL(vendor), nil
+}
+
+func (r *Resolver) VendorsForApplication(ctx context.Context, obj *graphql.Application, first *int, after *graphql.PageCursor) (*graphql.VendorPage, error) {
+	if obj == nil {
+		return nil, apperrors.NewInternalError("Application cannot be empty")
+	}
+
+	if first == nil {
+		return nil, apperrors.NewInvalidDataError("missing required parameter 'first'")
+	}
+
+	var cursor string
+	if after != nil {
+		cursor = string(*after)
+	}
+
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer r.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	vendorPage, err := r.vendorSvc.ListByApplicationIDPage(ctx, obj.ID, *first, cursor)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while listing Vendors for Application with id %s", obj.ID)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return r.toGraphQLPage(vendorPage), nil
+}
+
+func (r *Resolver) toGraphQLPage(vendorPage *model.VendorPage) *graphql.VendorPage {
+	return &graphql.VendorPage{
+		Data:       r.vendorConv.MultipleToGraphQL(vendorPage.Data),
+		TotalCount: vendorPage.TotalCount,
+		PageInfo: &graphql.PageInfo{
+			StartCursor:     graphql.PageCursor(vendorPage.PageInfo.StartCursor),
+			EndCursor:       graphql.PageCursor(vendorPage.PageInfo.EndCursor),
+			HasNextPage:     vendorPage.PageInfo.HasNextPage,
+			HasPreviousPage: vendorPage.PageInfo.HasPreviousPage,
+		},
+	}
+}
@@ -0,0 +1,390 @@
+package ordvendor_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/ordvendor"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/ordvendor/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	persistenceautomock "github.com/kyma-incubator/compass/components/director/pkg/persistence/automock"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence/txtest"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolver_Vendor(t *testing.T) {
+	// given
+	testErr := errors.New("Test error")
+	txGen := txtest.NewTransactionContextGenerator(testErr)
+
+	modelVendor := fixVendorModel()
+	gqlVendor := fixGQLVendor()
+
+	testCases := []struct {
+		Name            string
+		TransactionerFn func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		ServiceFn       func() *automock.VendorService
+		ConverterFn     func() *automock.VendorConverter
+		ExpectedVendor  *graphql.Vendor
+		ExpectedErr     error
+	}{
+		{
+			Name:            "Success",
+			TransactionerFn: txGen.ThatSucceeds,
+			ServiceFn: func() *automock.VendorService {
+				svc := &automock.VendorService{}
+				svc.On("Get", txtest.CtxWithDBMatcher(), ordID).Return(modelVendor, nil).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.VendorConverter {
+				conv := &automock.VendorConverter{}
+				conv.On("ToGraphQL", modelVendor).Return(gqlVendor).Once()
+				return conv
+			},
+			ExpectedVendor: gqlVendor,
+		},
+		{
+			Name:            "Returns null when Vendor not found",
+			TransactionerFn: txGen.ThatSucceeds,
+			ServiceFn: func() *automock.VendorService {
+				svc := &automock.VendorService{}
+				svc.On("Get", txtest.CtxWithDBMatcher(), ordID).Return(nil, apperrors.NewNotFoundError(resource.Vendor, ordID)).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.VendorConverter {
+				return &automock.VendorConverter{}
+			},
+		},
+		{
+			Name:            "Returns error when Vendor retrieval failed",
+			TransactionerFn: txGen.ThatDoesntExpectCommit,
+			ServiceFn: func() *automock.VendorService {
+				svc := &automock.VendorService{}
+				svc.On("Get", txtest.CtxWithDBMatcher(), ordID).Return(nil, testErr).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.VendorConverter {
+				return &automock.VendorConverter{}
+			},
+			ExpectedErr: testErr,
+		},
+		{
+			Name:            "Returns error when commit failed",
+			TransactionerFn: txGen.ThatFailsOnCommit,
+			ServiceFn: func() *automock.VendorService {
+				svc := &automock.VendorService{}
+				svc.On("Get", txtest.CtxWithDBMatcher(), ordID).Return(modelVendor, nil).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.VendorConverter {
+				return &automock.VendorConverter{}
+			},
+			ExpectedErr: testErr,
+		},
+		{
+			Name:            "Returns error when transaction begin failed",
+			TransactionerFn: txGen.ThatFailsOnBegin,
+			ServiceFn: func() *automock.VendorService {
+				return &automock.VendorService{}
+			},
+			ConverterFn: func() *automock.VendorConverter {
+				return &automock.VendorConverter{}
+			},
+			ExpectedErr: testErr,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			persist, transact := testCase.TransactionerFn()
+			svc := testCase.ServiceFn()
+			conv := testCase.ConverterFn()
+
+			resolver := ordvendor.NewResolver(transact, svc, conv)
+
+			// when
+			result, err := resolver.Vendor(context.TODO(), ordID)
+
+			// then
+			assert.Equal(t, testCase.ExpectedVendor, result)
+			if testCase.ExpectedErr != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+
+			persist.AssertExpectations(t)
+			transact.AssertExpectations(t)
+			svc.AssertExpectations(t)
+			conv.AssertExpectations(t)
+		})
+	}
+}
+
+func TestResolver_Vendors(t *testing.T) {
+	// given
+	testErr := errors.New("Test error")
+	txGen := txtest.NewTransactionContextGenerator(testErr)
+
+	first := 2
+	after := "test"
+	gqlAfter := graphql.PageCursor(after)
+
+	modelVendors := []*model.Vendor{fixVendorModel()}
+	gqlVendors := []*graphql.Vendor{fixGQLVendor()}
+	modelPage := fixVendorPage(modelVendors)
+	gqlPage := fixGQLVendorPage(gqlVendors)
+
+	testCases := []struct {
+		Name            string
+		TransactionerFn func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		ServiceFn       func() *automock.VendorService
+		ConverterFn     func() *automock.VendorConverter
+		ExpectedResult  *graphql.VendorPage
+		ExpectedErr     error
+	}{
+		{
+			Name:            "Success",
+			TransactionerFn: txGen.ThatSucceeds,
+			ServiceFn: func() *automock.VendorService {
+				svc := &automock.VendorService{}
+				svc.On("List", txtest.CtxWithDBMatcher(), first, after).Return(modelPage, nil).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.VendorConverter {
+				conv := &automock.VendorConverter{}
+				conv.On("MultipleToGraphQL", modelVendors).Return(gqlVendors).Once()
+				return conv
+			},
+			ExpectedResult: gqlPage,
+		},
+		{
+			Name:            "Returns error when Vendors listing failed",
+			TransactionerFn: txGen.ThatDoesntExpectCommit,
+			ServiceFn: func() *automock.VendorService {
+				svc := &automock.VendorService{}
+				svc.On("List", txtest.CtxWithDBMatcher(), first, after).Return(nil, testErr).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.VendorConverter {
+				return &automock.VendorConverter{}
+			},
+			ExpectedErr: testErr,
+		},
+		{
+			Name:            "Returns error when commit failed",
+			TransactionerFn: txGen.ThatFailsOnCommit,
+			ServiceFn: func() *automock.VendorService {
+				svc := &automock.VendorService{}
+				svc.On("List", txtest.CtxWithDBMatcher(), first, after).Return(modelPage, nil).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.VendorConverter {
+				return &automock.VendorConverter{}
+			},
+			ExpectedErr: testErr,
+		},
+		{
+			Name:            "Returns error when transaction begin failed",
+			TransactionerFn: txGen.ThatFailsOnBegin,
+			ServiceFn: func() *automock.VendorService {
+				return &automock.VendorService{}
+			},
+			ConverterFn: func() *automock.VendorConverter {
+				return &automock.VendorConverter{}
+			},
+			ExpectedErr: testErr,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			persist, transact := testCase.TransactionerFn()
+			svc := testCase.ServiceFn()
+			conv := testCase.ConverterFn()
+
+			resolver := ordvendor.NewResolver(transact, svc, conv)
+
+			// when
+			result, err := resolver.Vendors(context.TODO(), &first, &gqlAfter)
+
+			// then
+			assert.Equal(t, testCase.ExpectedResult, result)
+			if testCase.ExpectedErr != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+
+			persist.AssertExpectations(t)
+			transact.AssertExpectations(t)
+			svc.AssertExpectations(t)
+			conv.AssertExpectations(t)
+		})
+	}
+
+	t.Run("Returns error when page size is invalid", func(t *testing.T) {
+		invalidFirst := 201
+		persist, transact := txGen.ThatDoesntExpectCommit()
+		svc := &automock.VendorService{}
+		svc.On("List", txtest.CtxWithDBMatcher(), invalidFirst, after).Return(nil, apperrors.NewInvalidDataError("page size must be between 1 and 200")).Once()
+
+		resolver := ordvendor.NewResolver(transact, svc, nil)
+
+		// when
+		_, err := resolver.Vendors(context.TODO(), &invalidFirst, &gqlAfter)
+
+		// then
+		require.Error(t, err)
+		assert.Equal(t, apperrors.InvalidData, apperrors.ErrorCode(err))
+
+		persist.AssertExpectations(t)
+		transact.AssertExpectations(t)
+		svc.AssertExpectations(t)
+	})
+
+	t.Run("Returns error when first is missing", func(t *testing.T) {
+		resolver := ordvendor.NewResolver(nil, nil, nil)
+
+		// when
+		_, err := resolver.Vendors(context.TODO(), nil, nil)
+
+		// then
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "missing required parameter 'first'")
+	})
+}
+
+func TestResolver_VendorsForApplication(t *testing.T) {
+	// given
+	testErr := errors.New("Test error")
+	txGen := txtest.NewTransactionContextGenerator(testErr)
+
+	first := 2
+	after := "test"
+	gqlAfter := graphql.PageCursor(after)
+
+	modelVendors := []*model.Vendor{fixVendorModel()}
+	gqlVendors := []*graphql.Vendor{fixGQLVendor()}
+	modelPage := fixVendorPage(modelVendors)
+	gqlPage := fixGQLVendorPage(gqlVendors)
+	app := &graphql.Application{BaseEntity: &graphql.BaseEntity{ID: appID}}
+
+	testCases := []struct {
+		Name            string
+		TransactionerFn func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		ServiceFn       func() *automock.VendorService
+		ConverterFn     func() *automock.VendorConverter
+		ExpectedResult  *graphql.VendorPage
+		ExpectedErr     error
+	}{
+		{
+			Name:            "Success",
+			TransactionerFn: txGen.ThatSucceeds,
+			ServiceFn: func() *automock.VendorService {
+				svc := &automock.VendorService{}
+				svc.On("ListByApplicationIDPage", txtest.CtxWithDBMatcher(), appID, first, after).Return(modelPage, nil).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.VendorConverter {
+				conv := &automock.VendorConverter{}
+				conv.On("MultipleToGraphQL", modelVendors).Return(gqlVendors).Once()
+				return conv
+			},
+			ExpectedResult: gqlPage,
+		},
+		{
+			Name:            "Returns error when Vendors listing failed",
+			TransactionerFn: txGen.ThatDoesntExpectCommit,
+			ServiceFn: func() *automock.VendorService {
+				svc := &automock.VendorService{}
+				svc.On("ListByApplicationIDPage", txtest.CtxWithDBMatcher(), appID, first, after).Return(nil, testErr).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.VendorConverter {
+				return &automock.VendorConverter{}
+			},
+			ExpectedErr: testErr,
+		},
+		{
+			Name:            "Returns error when commit failed",
+			TransactionerFn: txGen.ThatFailsOnCommit,
+			ServiceFn: func() *automock.VendorService {
+				svc := &automock.VendorService{}
+				svc.On("ListByApplicationIDPage", txtest.CtxWithDBMatcher(), appID, first, after).Return(modelPage, nil).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.VendorConverter {
+				return &automock.VendorConverter{}
+			},
+			ExpectedErr: testErr,
+		},
+		{
+			Name:            "Returns error when transaction begin failed",
+			TransactionerFn: txGen.ThatFailsOnBegin,
+			ServiceFn: func() *automock.VendorService {
+				return &automock.VendorService{}
+			},
+			ConverterFn: func() *automock.VendorConverter {
+				return &automock.VendorConverter{}
+			},
+			ExpectedErr: testErr,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			persist, transact := testCase.TransactionerFn()
+			svc := testCase.ServiceFn()
+			conv := testCase.ConverterFn()
+
+			resolver := ordvendor.NewResolver(transact, svc, conv)
+
+			// when
+			result, err := resolver.VendorsForApplication(context.TODO(), app, &first, &gqlAfter)
+
+			// then
+			assert.Equal(t, testCase.ExpectedResult, result)
+			if testCase.ExpectedErr != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+
+			persist.AssertExpectations(t)
+			transact.AssertExpectations(t)
+			svc.AssertExpectations(t)
+			conv.AssertExpectations(t)
+		})
+	}
+
+	t.Run("Returns error when Application is nil", func(t *testing.T) {
+		resolver := ordvendor.NewResolver(nil, nil, nil)
+
+		// when
+		_, err := resolver.VendorsForApplication(context.TODO(), nil, &first, &gqlAfter)
+
+		// then
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "Application cannot be empty")
+	})
+
+	t.Run("Returns error when first is missing", func(t *testing.T) {
+		resolver := ordvendor.NewResolver(nil, nil, nil)
+
+		// when
+		_, err := resolver.VendorsForApplication(context.TODO(), app, nil, nil)
+
+		// then
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "missing required parameter 'first'")
+	})
+}
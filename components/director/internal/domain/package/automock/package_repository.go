@@ -86,6 +86,29 @@ func (_m *PackageRepository) GetByID(ctx context.Context, tenant string, id stri
 	return r0, r1
 }
 
+// List provides a mock function with given fields: ctx, tenantID, pageSize, cursor
+func (_m *PackageRepository) List(ctx context.Context, tenantID string, pageSize int, cursor string) (*model.PackagePage, error) {
+	ret := _m.Called(ctx, tenantID, pageSize, cursor)
+
+	var r0 *model.PackagePage
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, string) *model.PackagePage); ok {
+		r0 = rf(ctx, tenantID, pageSize, cursor)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.PackagePage)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, int, string) error); ok {
+		r1 = rf(ctx, tenantID, pageSize, cursor)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ListByApplicationID provides a mock function with given fields: ctx, tenantID, appID
 func (_m *PackageRepository) ListByApplicationID(ctx context.Context, tenantID string, appID string) ([]*model.Package, error) {
 	ret := _m.Called(ctx, tenantID, appID)
@@ -109,6 +132,75 @@ func (_m *PackageRepository) ListByApplicationID(ctx context.Context, tenantID s
 	return r0, r1
 }
 
+// ListByApplicationIDPage provides a mock function with given fields: ctx, tenantID, appID, pageSize, cursor
+func (_m *PackageRepository) ListByApplicationIDPage(ctx context.Context, tenantID string, appID string, pageSize int, cursor string) (*model.PackagePage, error) {
+	ret := _m.Called(ctx, tenantID, appID, pageSize, cursor)
+
+	var r0 *model.PackagePage
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int, string) *model.PackagePage); ok {
+		r0 = rf(ctx, tenantID, appID, pageSize, cursor)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.PackagePage)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, int, string) error); ok {
+		r1 = rf(ctx, tenantID, appID, pageSize, cursor)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListByBundleIDs provides a mock function with given fields: ctx, tenantID, bundleIDs
+func (_m *PackageRepository) ListByBundleIDs(ctx context.Context, tenantID string, bundleIDs []string) ([][]*model.Package, error) {
+	ret := _m.Called(ctx, tenantID, bundleIDs)
+
+	var r0 [][]*model.Package
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string) [][]*model.Package); ok {
+		r0 = rf(ctx, tenantID, bundleIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([][]*model.Package)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, []string) error); ok {
+		r1 = rf(ctx, tenantID, bundleIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListByIDs provides a mock function with given fields: ctx, tenantID, ids
+func (_m *PackageRepository) ListByIDs(ctx context.Context, tenantID string, ids []string) ([]*model.Package, error) {
+	ret := _m.Called(ctx, tenantID, ids)
+
+	var r0 []*model.Package
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string) []*model.Package); ok {
+		r0 = rf(ctx, tenantID, ids)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Package)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, []string) error); ok {
+		r1 = rf(ctx, tenantID, ids)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Update provides a mock function with given fields: ctx, item
 func (_m *PackageRepository) Update(ctx context.Context, item *model.Package) error {
 	ret := _m.Called(ctx, item)
@@ -1,8 +1,11 @@
 package mp_package
 
 import (
+	"encoding/json"
+
 	"github.com/kyma-incubator/compass/components/director/internal/repo"
 	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
 
 	"github.com/kyma-incubator/compass/components/director/internal/model"
 )
@@ -75,3 +78,52 @@ func (c *converter) FromEntity(entity *Entity) (*model.Package, error) {
 
 	return output, nil
 }
+
+func (c *converter) ToGraphQL(in *model.Package) *graphql.Package {
+	if in == nil {
+		return nil
+	}
+
+	return &graphql.Package{
+		ID:                in.ID,
+		ApplicationID:     in.ApplicationID,
+		OrdID:             in.OrdID,
+		Vendor:            in.Vendor,
+		Title:             in.Title,
+		ShortDescription:  in.ShortDescription,
+		Description:       in.Description,
+		Version:           in.Version,
+		PackageLinks:      rawJSONToGraphQLJSON(in.PackageLinks),
+		Links:             rawJSONToGraphQLJSON(in.Links),
+		LicenseType:       in.LicenseType,
+		Tags:              rawJSONToGraphQLJSON(in.Tags),
+		Countries:         rawJSONToGraphQLJSON(in.Countries),
+		Labels:            rawJSONToGraphQLJSON(in.Labels),
+		PolicyLevel:       in.PolicyLevel,
+		CustomPolicyLevel: in.CustomPolicyLevel,
+		PartOfProducts:    rawJSONToGraphQLJSON(in.PartOfProducts),
+		LineOfBusiness:    rawJSONToGraphQLJSON(in.LineOfBusiness),
+		Industry:          rawJSONToGraphQLJSON(in.Industry),
+	}
+}
+
+func (c *converter) MultipleToGraphQL(in []*model.Package) []*graphql.Package {
+	packages := make([]*graphql.Package, 0, len(in))
+	for _, pkg := range in {
+		if pkg == nil {
+			continue
+		}
+		packages = append(packages, c.ToGraphQL(pkg))
+	}
+
+	return packages
+}
+
+func rawJSONToGraphQLJSON(in json.RawMessage) *graphql.JSON {
+	if len(in) == 0 {
+		return nil
+	}
+
+	out := graphql.JSON(in)
+	return &out
+}
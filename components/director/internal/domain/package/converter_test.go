@@ -4,6 +4,8 @@ import (
 	"testing"
 
 	mp_package "github.com/kyma-incubator/compass/components/director/internal/domain/package"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -48,3 +50,29 @@ func TestEntityConverter_FromEntity(t *testing.T) {
 		require.Error(t, err)
 	})
 }
+
+func TestConverter_ToGraphQL(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		conv := mp_package.NewConverter()
+
+		gqlPkg := conv.ToGraphQL(fixPackageModel())
+
+		assert.Equal(t, fixGQLPackage(), gqlPkg)
+	})
+
+	t.Run("Returns nil if package model is nil", func(t *testing.T) {
+		conv := mp_package.NewConverter()
+
+		gqlPkg := conv.ToGraphQL(nil)
+
+		require.Nil(t, gqlPkg)
+	})
+}
+
+func TestConverter_MultipleToGraphQL(t *testing.T) {
+	conv := mp_package.NewConverter()
+
+	gqlPkgs := conv.MultipleToGraphQL([]*model.Package{fixPackageModel(), nil, fixPackageModel()})
+
+	assert.Equal(t, []*graphql.Package{fixGQLPackage(), fixGQLPackage()}, gqlPkgs)
+}
@@ -9,6 +9,7 @@ import (
 
 	mp_package "github.com/kyma-incubator/compass/components/director/internal/domain/package"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
 )
 
 const (
@@ -71,6 +72,37 @@ func fixPackageModel() *model.Package {
 	}
 }
 
+func fixGQLPackage() *graphql.Package {
+	vendorID := "vendorID"
+	licenceType := "test"
+	return &graphql.Package{
+		ID:                packageID,
+		ApplicationID:     appID,
+		OrdID:             ordID,
+		Vendor:            &vendorID,
+		Title:             "title",
+		ShortDescription:  "short desc",
+		Description:       "desc",
+		Version:           "v1.0.5",
+		PackageLinks:      fixGQLJSON("{}"),
+		Links:             fixGQLJSON("[]"),
+		LicenseType:       &licenceType,
+		Tags:              fixGQLJSON("[]"),
+		Countries:         fixGQLJSON("[]"),
+		Labels:            fixGQLJSON("{}"),
+		PolicyLevel:       "test",
+		CustomPolicyLevel: nil,
+		PartOfProducts:    fixGQLJSON("[\"test\"]"),
+		LineOfBusiness:    fixGQLJSON("[]"),
+		Industry:          fixGQLJSON("[]"),
+	}
+}
+
+func fixGQLJSON(in string) *graphql.JSON {
+	out := graphql.JSON(in)
+	return &out
+}
+
 func fixPackageModelInput() *model.PackageInput {
 	vendorID := "vendorID"
 	licenceType := "test"
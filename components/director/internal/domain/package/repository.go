@@ -2,16 +2,25 @@ package mp_package
 
 import (
 	"context"
+	"fmt"
 
+	"github.com/jmoiron/sqlx"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
 	"github.com/kyma-incubator/compass/components/director/internal/repo"
 	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
 	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
 	"github.com/kyma-incubator/compass/components/director/pkg/resource"
 	"github.com/pkg/errors"
 )
 
-const packageTable string = `public.packages`
+const (
+	packageTable     string = `public.packages`
+	apiDefTable      string = `public.api_definitions`
+	eventAPIDefTable string = `public.event_api_definitions`
+	// packagesByBundleIDs selects the packages of the API and event definitions of the bundles matched by the IN conditions
+	packagesByBundleIDs string = `SELECT bundle_id, package_id FROM ` + apiDefTable + ` WHERE tenant_id = ? AND %s AND package_id IS NOT NULL UNION SELECT bundle_id, package_id FROM ` + eventAPIDefTable + ` WHERE tenant_id = ? AND %s AND package_id IS NOT NULL ORDER BY bundle_id, package_id`
+)
 
 var (
 	tenantColumn   = "tenant_id"
@@ -29,24 +38,26 @@ type EntityConverter interface {
 }
 
 type pgRepository struct {
-	conv         EntityConverter
-	existQuerier repo.ExistQuerier
-	lister       repo.Lister
-	singleGetter repo.SingleGetter
-	deleter      repo.Deleter
-	creator      repo.Creator
-	updater      repo.Updater
+	conv            EntityConverter
+	existQuerier    repo.ExistQuerier
+	lister          repo.Lister
+	pageableQuerier repo.PageableQuerier
+	singleGetter    repo.SingleGetter
+	deleter         repo.Deleter
+	creator         repo.Creator
+	updater         repo.Updater
 }
 
 func NewRepository(conv EntityConverter) *pgRepository {
 	return &pgRepository{
-		conv:         conv,
-		existQuerier: repo.NewExistQuerier(resource.Package, packageTable, tenantColumn),
-		lister:       repo.NewLister(resource.Package, packageTable, tenantColumn, packageColumns),
-		singleGetter: repo.NewSingleGetter(resource.Package, packageTable, tenantColumn, packageColumns),
-		deleter:      repo.NewDeleter(resource.Package, packageTable, tenantColumn),
-		creator:      repo.NewCreator(resource.Package, packageTable, packageColumns),
-		updater:      repo.NewUpdater(resource.Package, packageTable, updatableColumns, tenantColumn, []string{"id"}),
+		conv:            conv,
+		existQuerier:    repo.NewExistQuerier(resource.Package, packageTable, tenantColumn),
+		lister:          repo.NewLister(resource.Package, packageTable, tenantColumn, packageColumns),
+		pageableQuerier: repo.NewPageableQuerier(resource.Package, packageTable, tenantColumn, packageColumns),
+		singleGetter:    repo.NewSingleGetter(resource.Package, packageTable, tenantColumn, packageColumns),
+		deleter:         repo.NewDeleter(resource.Package, packageTable, tenantColumn),
+		creator:         repo.NewCreator(resource.Package, packageTable, packageColumns),
+		updater:         repo.NewUpdater(resource.Package, packageTable, updatableColumns, tenantColumn, []string{"id"}),
 	}
 }
 
@@ -91,6 +102,26 @@ func (r *pgRepository) GetByID(ctx context.Context, tenant, id string) (*model.P
 	return pkgModel, nil
 }
 
+func (r *pgRepository) ListByIDs(ctx context.Context, tenantID string, ids []string) ([]*model.Package, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	pkgCollection := pkgCollection{}
+	if err := r.lister.List(ctx, tenantID, &pkgCollection, repo.NewInConditionForStringValues("id", ids)); err != nil {
+		return nil, err
+	}
+	pkgs := make([]*model.Package, 0, pkgCollection.Len())
+	for _, pkg := range pkgCollection {
+		pkgModel, err := r.conv.FromEntity(&pkg)
+		if err != nil {
+			return nil, err
+		}
+		pkgs = append(pkgs, pkgModel)
+	}
+	return pkgs, nil
+}
+
 func (r *pgRepository) ListByApplicationID(ctx context.Context, tenantID, appID string) ([]*model.Package, error) {
 	pkgCollection := pkgCollection{}
 	if err := r.lister.List(ctx, tenantID, &pkgCollection, repo.NewEqualCondition("app_id", appID)); err != nil {
@@ -107,6 +138,96 @@ func (r *pgRepository) ListByApplicationID(ctx context.Context, tenantID, appID
 	return pkgs, nil
 }
 
+func (r *pgRepository) ListByApplicationIDPage(ctx context.Context, tenantID, appID string, pageSize int, cursor string) (*model.PackagePage, error) {
+	return r.list(ctx, tenantID, pageSize, cursor, repo.NewEqualCondition("app_id", appID))
+}
+
+func (r *pgRepository) List(ctx context.Context, tenantID string, pageSize int, cursor string) (*model.PackagePage, error) {
+	return r.list(ctx, tenantID, pageSize, cursor)
+}
+
+type bundlePackage struct {
+	BundleID  string `db:"bundle_id"`
+	PackageID string `db:"package_id"`
+}
+
+// ListByBundleIDs returns the packages of the definitions of every bundle, in the order of the bundleIDs
+func (r *pgRepository) ListByBundleIDs(ctx context.Context, tenantID string, bundleIDs []string) ([][]*model.Package, error) {
+	if len(bundleIDs) == 0 {
+		return [][]*model.Package{}, nil
+	}
+
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	inBundles := repo.NewInConditionForStringValues("bundle_id", bundleIDs)
+	inBundlesArgs, _ := inBundles.GetQueryArgs()
+	query := sqlx.Rebind(sqlx.DOLLAR, fmt.Sprintf(packagesByBundleIDs, inBundles.GetQueryPart(), inBundles.GetQueryPart()))
+	args := append(append([]interface{}{tenantID}, inBundlesArgs...), append([]interface{}{tenantID}, inBundlesArgs...)...)
+
+	var bundlePackages []bundlePackage
+	if err := persist.Select(&bundlePackages, query, args...); err != nil {
+		return nil, persistence.MapSQLError(ctx, err, resource.Package, resource.List, "while listing packages of bundles")
+	}
+
+	pkgIDs := make([]string, 0, len(bundlePackages))
+	seen := make(map[string]bool, len(bundlePackages))
+	for _, bundlePkg := range bundlePackages {
+		if !seen[bundlePkg.PackageID] {
+			seen[bundlePkg.PackageID] = true
+			pkgIDs = append(pkgIDs, bundlePkg.PackageID)
+		}
+	}
+
+	pkgs, err := r.ListByIDs(ctx, tenantID, pkgIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	pkgsByID := make(map[string]*model.Package, len(pkgs))
+	for _, pkg := range pkgs {
+		pkgsByID[pkg.ID] = pkg
+	}
+
+	pkgsByBundleID := make(map[string][]*model.Package, len(bundleIDs))
+	for _, bundlePkg := range bundlePackages {
+		if pkg, ok := pkgsByID[bundlePkg.PackageID]; ok {
+			pkgsByBundleID[bundlePkg.BundleID] = append(pkgsByBundleID[bundlePkg.BundleID], pkg)
+		}
+	}
+
+	out := make([][]*model.Package, 0, len(bundleIDs))
+	for _, bundleID := range bundleIDs {
+		out = append(out, pkgsByBundleID[bundleID])
+	}
+	return out, nil
+}
+
+func (r *pgRepository) list(ctx context.Context, tenantID string, pageSize int, cursor string, conditions ...repo.Condition) (*model.PackagePage, error) {
+	var pkgCollection pkgCollection
+	page, totalCount, err := r.pageableQuerier.List(ctx, tenantID, pageSize, cursor, "id", &pkgCollection, conditions...)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*model.Package, 0, pkgCollection.Len())
+	for _, pkgEnt := range pkgCollection {
+		pkgModel, err := r.conv.FromEntity(&pkgEnt)
+		if err != nil {
+			return nil, errors.Wrap(err, "while creating Package model from entity")
+		}
+		items = append(items, pkgModel)
+	}
+
+	return &model.PackagePage{
+		Data:       items,
+		TotalCount: totalCount,
+		PageInfo:   page,
+	}, nil
+}
+
 type pkgCollection []Entity
 
 func (pc pkgCollection) Len() int {
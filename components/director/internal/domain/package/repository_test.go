@@ -2,6 +2,7 @@ package mp_package_test
 
 import (
 	"context"
+	"fmt"
 	"regexp"
 	"testing"
 
@@ -201,6 +202,49 @@ func TestPgRepository_GetByID(t *testing.T) {
 	})
 }
 
+func TestPgRepository_ListByIDs(t *testing.T) {
+	// GIVEN
+	pkgEntity := fixEntityPackage()
+
+	selectQuery := `^SELECT (.+) FROM public.packages 
+		WHERE tenant_id = \$1 AND id IN \(\$2\)`
+
+	t.Run("success", func(t *testing.T) {
+		sqlxDB, sqlMock := testdb.MockDatabase(t)
+		rows := sqlmock.NewRows(fixPackageColumns()).
+			AddRow(fixPackageRow()...)
+
+		sqlMock.ExpectQuery(selectQuery).
+			WithArgs(tenantID, packageID).
+			WillReturnRows(rows)
+
+		ctx := persistence.SaveToContext(context.TODO(), sqlxDB)
+		convMock := &automock.EntityConverter{}
+		convMock.On("FromEntity", pkgEntity).Return(&model.Package{ID: pkgEntity.ID}, nil)
+		pgRepository := mp_package.NewRepository(convMock)
+		// WHEN
+		modelPkgs, err := pgRepository.ListByIDs(ctx, tenantID, []string{packageID})
+		//THEN
+		require.NoError(t, err)
+		require.Len(t, modelPkgs, 1)
+		assert.Equal(t, pkgEntity.ID, modelPkgs[0].ID)
+		convMock.AssertExpectations(t)
+		sqlMock.AssertExpectations(t)
+	})
+
+	t.Run("returns nothing without querying when there are no IDs", func(t *testing.T) {
+		sqlxDB, sqlMock := testdb.MockDatabase(t)
+		ctx := persistence.SaveToContext(context.TODO(), sqlxDB)
+		pgRepository := mp_package.NewRepository(nil)
+		// WHEN
+		modelPkgs, err := pgRepository.ListByIDs(ctx, tenantID, nil)
+		//THEN
+		require.NoError(t, err)
+		assert.Empty(t, modelPkgs)
+		sqlMock.AssertExpectations(t)
+	})
+}
+
 func TestPgRepository_ListByApplicationID(t *testing.T) {
 	// GIVEN
 	totalCount := 2
@@ -236,3 +280,115 @@ func TestPgRepository_ListByApplicationID(t *testing.T) {
 		sqlMock.AssertExpectations(t)
 	})
 }
+
+func TestPgRepository_ListByApplicationIDPage(t *testing.T) {
+	// GIVEN
+	pageSize := 3
+	totalCount := 2
+	firstPkgEntity := fixEntityPackage()
+	secondPkgEntity := fixEntityPackage()
+
+	selectQuery := fmt.Sprintf(`^SELECT (.+) FROM public.packages
+		WHERE tenant_id = \$1 AND app_id = \$2
+		ORDER BY id LIMIT %d OFFSET %d`, pageSize, 0)
+
+	countQuery := regexp.QuoteMeta(`SELECT COUNT(*) FROM public.packages
+		WHERE tenant_id = $1 AND app_id = $2`)
+
+	t.Run("success", func(t *testing.T) {
+		sqlxDB, sqlMock := testdb.MockDatabase(t)
+		rows := sqlmock.NewRows(fixPackageColumns()).
+			AddRow(fixPackageRow()...).
+			AddRow(fixPackageRow()...)
+
+		sqlMock.ExpectQuery(selectQuery).
+			WithArgs(tenantID, appID).
+			WillReturnRows(rows)
+
+		sqlMock.ExpectQuery(countQuery).
+			WithArgs(tenantID, appID).
+			WillReturnRows(testdb.RowCount(totalCount))
+
+		ctx := persistence.SaveToContext(context.TODO(), sqlxDB)
+		convMock := &automock.EntityConverter{}
+		convMock.On("FromEntity", firstPkgEntity).Return(&model.Package{ID: firstPkgEntity.ID}, nil)
+		convMock.On("FromEntity", secondPkgEntity).Return(&model.Package{ID: secondPkgEntity.ID}, nil)
+		pgRepository := mp_package.NewRepository(convMock)
+		// WHEN
+		pkgPage, err := pgRepository.ListByApplicationIDPage(ctx, tenantID, appID, pageSize, "")
+		//THEN
+		require.NoError(t, err)
+		require.Len(t, pkgPage.Data, totalCount)
+		assert.Equal(t, totalCount, pkgPage.TotalCount)
+		assert.False(t, pkgPage.PageInfo.HasNextPage)
+		convMock.AssertExpectations(t)
+		sqlMock.AssertExpectations(t)
+	})
+
+	t.Run("DB Error", func(t *testing.T) {
+		sqlxDB, sqlMock := testdb.MockDatabase(t)
+		testErr := errors.New("test error")
+
+		sqlMock.ExpectQuery(selectQuery).
+			WithArgs(tenantID, appID).
+			WillReturnError(testErr)
+
+		ctx := persistence.SaveToContext(context.TODO(), sqlxDB)
+		pgRepository := mp_package.NewRepository(nil)
+		// WHEN
+		pkgPage, err := pgRepository.ListByApplicationIDPage(ctx, tenantID, appID, pageSize, "")
+		//THEN
+		require.Error(t, err)
+		assert.Nil(t, pkgPage)
+		assert.Contains(t, err.Error(), testErr.Error())
+		sqlMock.AssertExpectations(t)
+	})
+}
+
+func TestPgRepository_ListByBundleIDs(t *testing.T) {
+	// GIVEN
+	bundleID := "bundleID"
+	emptyBundleID := "emptyBundleID"
+	bundlePackagesQuery := regexp.QuoteMeta(`SELECT bundle_id, package_id FROM public.api_definitions WHERE tenant_id = $1 AND bundle_id IN ($2, $3) AND package_id IS NOT NULL UNION SELECT bundle_id, package_id FROM public.event_api_definitions WHERE tenant_id = $4 AND bundle_id IN ($5, $6) AND package_id IS NOT NULL ORDER BY bundle_id, package_id`)
+	packagesQuery := `^SELECT (.+) FROM public.packages 
+		WHERE tenant_id = \$1 AND id IN \(\$2\)`
+
+	t.Run("success", func(t *testing.T) {
+		sqlxDB, sqlMock := testdb.MockDatabase(t)
+		sqlMock.ExpectQuery(bundlePackagesQuery).
+			WithArgs(tenantID, bundleID, emptyBundleID, tenantID, bundleID, emptyBundleID).
+			WillReturnRows(sqlmock.NewRows([]string{"bundle_id", "package_id"}).AddRow(bundleID, packageID))
+		sqlMock.ExpectQuery(packagesQuery).
+			WithArgs(tenantID, packageID).
+			WillReturnRows(sqlmock.NewRows(fixPackageColumns()).AddRow(fixPackageRow()...))
+
+		ctx := persistence.SaveToContext(context.TODO(), sqlxDB)
+		convMock := &automock.EntityConverter{}
+		convMock.On("FromEntity", fixEntityPackage()).Return(fixPackageModel(), nil).Once()
+		pgRepository := mp_package.NewRepository(convMock)
+		// WHEN
+		pkgs, err := pgRepository.ListByBundleIDs(ctx, tenantID, []string{bundleID, emptyBundleID})
+		//THEN
+		require.NoError(t, err)
+		require.Len(t, pkgs, 2)
+		assert.Equal(t, []*model.Package{fixPackageModel()}, pkgs[0])
+		assert.Empty(t, pkgs[1])
+		convMock.AssertExpectations(t)
+		sqlMock.AssertExpectations(t)
+	})
+
+	t.Run("returns error when listing the packages of the bundles failed", func(t *testing.T) {
+		sqlxDB, sqlMock := testdb.MockDatabase(t)
+		sqlMock.ExpectQuery(bundlePackagesQuery).
+			WithArgs(tenantID, bundleID, emptyBundleID, tenantID, bundleID, emptyBundleID).
+			WillReturnError(errors.New("test error"))
+
+		ctx := persistence.SaveToContext(context.TODO(), sqlxDB)
+		pgRepository := mp_package.NewRepository(nil)
+		// WHEN
+		_, err := pgRepository.ListByBundleIDs(ctx, tenantID, []string{bundleID, emptyBundleID})
+		//THEN
+		require.Error(t, err)
+		sqlMock.AssertExpectations(t)
+	})
+}
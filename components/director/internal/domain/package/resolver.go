@@ -0,0 +1,253 @@
+package mp_package
+
+import (
+	"context"
+
+	"github.com/kyma-incubator/compass/components/director/internal/dataloader"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+	"github.com/pkg/errors"
+)
+
+//go:generate mockery -name=PackageService -output=automock -outpkg=automock -case=underscore
+type PackageService interface {
+	Get(ctx context.Context, id string) (*model.Package, error)
+	ListByIDs(ctx context.Context, ids []string) ([]*model.Package, error)
+	List(ctx context.Context, pageSize int, cursor string) (*model.PackagePage, error)
+	ListByApplicationIDPage(ctx context.Context, appID string, pageSize int, cursor string) (*model.PackagePage, error)
+	ListByBundleIDs(ctx context.Context, bundleIDs []string) ([][]*model.Package, error)
+}
+
+//go:generate mockery -name=PackageConverter -output=automock -outpkg=automock -case=underscore
+type PackageConverter interface {
+	ToGraphQL(in *model.Package) *graphql.Package
+	MultipleToGraphQL(in []*model.Package) []*graphql.Package
+}
+
+type Resolver struct {
+	transact persistence.Transactioner
+
+	pkgSvc  PackageService
+	pkgConv PackageConverter
+}
+
+func NewResolver(transact persistence.Transactioner, pkgSvc PackageService, pkgConv PackageConverter) *Resolver {
+	return &Resolver{
+		transact: transact,
+		pkgSvc:   pkgSvc,
+		pkgConv:  pkgConv,
+	}
+}
+
+func (r *Resolver) Packages(ctx context.Context, first *int, after *graphql.PageCursor) (*graphql.PackagePage, error) {
+	if first == nil {
+		return nil, apperrors.NewInvalidDataError("missing required parameter 'first'")
+	}
+
+	var cursor string
+	if after != nil {
+		cursor = string(*after)
+	}
+
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer r.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	pkgPage, err := r.pkgSvc.List(ctx, *first, cursor)
+	if err != nil {
+		return nil, errors.Wrap(err, "while listing Packages")
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return r.toGraphQLPage(pkgPage), nil
+}
+
+func (r *Resolver) Package(ctx context.Context, id string) (*graphql.Package, error) {
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer r.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	pkg, err := r.pkgSvc.Get(ctx, id)
+	if err != nil {
+		if apperrors.IsNotFoundError(err) {
+			return nil, tx.Commit()
+		}
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return r.pkgConv.ToGraphQL(pkg), nil
+}
+
+func (r *Resolver) PackagesForApplication(ctx context.Context, obj *graphql.Application, first *int, after *graphql.PageCursor) (*graphql.PackagePage, error) {
+	if obj == nil {
+		return nil, apperrors.NewInternalError("Application cannot be empty")
+	}
+
+	if first == nil {
+		return nil, apperrors.NewInvalidDataError("missing required parameter 'first'")
+	}
+
+	var cursor string
+	if after != nil {
+		cursor = string(*after)
+	}
+
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer r.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	pkgPage, err := r.pkgSvc.ListByApplicationIDPage(ctx, obj.ID, *first, cursor)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while listing Packages for Application with id %s", obj.ID)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return r.toGraphQLPage(pkgPage), nil
+}
+
+func (r *Resolver) PackagesForBundle(ctx context.Context, obj *graphql.Bundle) ([]*graphql.Package, error) {
+	if obj == nil {
+		return nil, apperrors.NewInternalError("Bundle cannot be empty")
+	}
+
+	loaders, err := dataloader.LoadFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return loaders.BundlePackages(ctx, obj.ID)
+}
+
+// BundlePackagesDataLoader fetches the packages of all of the bundles at once
+func (r *Resolver) BundlePackagesDataLoader(ctx context.Context, bundleIDs []string) ([][]*graphql.Package, error) {
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer r.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	pkgsByBundle, err := r.pkgSvc.ListByBundleIDs(ctx, bundleIDs)
+	if err != nil {
+		return nil, errors.Wrap(err, "while listing Packages for Bundles")
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	out := make([][]*graphql.Package, 0, len(pkgsByBundle))
+	for _, pkgs := range pkgsByBundle {
+		out = append(out, r.pkgConv.MultipleToGraphQL(pkgs))
+	}
+
+	return out, nil
+}
+
+func (r *Resolver) PackageForAPIDefinition(ctx context.Context, obj *graphql.APIDefinition) (*graphql.Package, error) {
+	if obj == nil {
+		return nil, apperrors.NewInternalError("API Definition cannot be empty")
+	}
+
+	if obj.PackageID == nil {
+		return nil, nil
+	}
+
+	return r.loadPackage(ctx, *obj.PackageID)
+}
+
+func (r *Resolver) PackageForEventDefinition(ctx context.Context, obj *graphql.EventDefinition) (*graphql.Package, error) {
+	if obj == nil {
+		return nil, apperrors.NewInternalError("Event Definition cannot be empty")
+	}
+
+	if obj.PackageID == nil {
+		return nil, nil
+	}
+
+	return r.loadPackage(ctx, *obj.PackageID)
+}
+
+// PackagesDataLoader fetches all of the packages at once. The result is nil for the IDs of the packages which do not exist.
+func (r *Resolver) PackagesDataLoader(ctx context.Context, ids []string) ([]*graphql.Package, error) {
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer r.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	pkgs, err := r.pkgSvc.ListByIDs(ctx, ids)
+	if err != nil {
+		return nil, errors.Wrap(err, "while listing Packages by IDs")
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	pkgsByID := make(map[string]*model.Package, len(pkgs))
+	for _, pkg := range pkgs {
+		pkgsByID[pkg.ID] = pkg
+	}
+
+	out := make([]*graphql.Package, 0, len(ids))
+	for _, id := range ids {
+		pkg, ok := pkgsByID[id]
+		if !ok {
+			out = append(out, nil)
+			continue
+		}
+		out = append(out, r.pkgConv.ToGraphQL(pkg))
+	}
+
+	return out, nil
+}
+
+func (r *Resolver) loadPackage(ctx context.Context, id string) (*graphql.Package, error) {
+	loaders, err := dataloader.LoadFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return loaders.Package(ctx, id)
+}
+
+func (r *Resolver) toGraphQLPage(pkgPage *model.PackagePage) *graphql.PackagePage {
+	return &graphql.PackagePage{
+		Data:       r.pkgConv.MultipleToGraphQL(pkgPage.Data),
+		TotalCount: pkgPage.TotalCount,
+		PageInfo: &graphql.PageInfo{
+			StartCursor:     graphql.PageCursor(pkgPage.PageInfo.StartCursor),
+			EndCursor:       graphql.PageCursor(pkgPage.PageInfo.EndCursor),
+			HasNextPage:     pkgPage.PageInfo.HasNextPage,
+			HasPreviousPage: pkgPage.PageInfo.HasPreviousPage,
+		},
+	}
+}
@@ -0,0 +1,425 @@
+package mp_package_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/dataloader"
+	mp_package "github.com/kyma-incubator/compass/components/director/internal/domain/package"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/package/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
+	persistenceautomock "github.com/kyma-incubator/compass/components/director/pkg/persistence/automock"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence/txtest"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolver_Package(t *testing.T) {
+	// given
+	testErr := errors.New("Test error")
+	txGen := txtest.NewTransactionContextGenerator(testErr)
+
+	modelPkg := fixPackageModel()
+	gqlPkg := fixGQLPackage()
+
+	testCases := []struct {
+		Name            string
+		TransactionerFn func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		ServiceFn       func() *automock.PackageService
+		ConverterFn     func() *automock.PackageConverter
+		ExpectedPackage *graphql.Package
+		ExpectedErr     error
+	}{
+		{
+			Name:            "Success",
+			TransactionerFn: txGen.ThatSucceeds,
+			ServiceFn: func() *automock.PackageService {
+				svc := &automock.PackageService{}
+				svc.On("Get", txtest.CtxWithDBMatcher(), packageID).Return(modelPkg, nil).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.PackageConverter {
+				conv := &automock.PackageConverter{}
+				conv.On("ToGraphQL", modelPkg).Return(gqlPkg).Once()
+				return conv
+			},
+			ExpectedPackage: gqlPkg,
+		},
+		{
+			Name:            "Returns null when Package not found",
+			TransactionerFn: txGen.ThatSucceeds,
+			ServiceFn: func() *automock.PackageService {
+				svc := &automock.PackageService{}
+				svc.On("Get", txtest.CtxWithDBMatcher(), packageID).Return(nil, apperrors.NewNotFoundError(resource.Package, packageID)).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.PackageConverter {
+				return &automock.PackageConverter{}
+			},
+		},
+		{
+			Name:            "Returns error when Package retrieval failed",
+			TransactionerFn: txGen.ThatDoesntExpectCommit,
+			ServiceFn: func() *automock.PackageService {
+				svc := &automock.PackageService{}
+				svc.On("Get", txtest.CtxWithDBMatcher(), packageID).Return(nil, testErr).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.PackageConverter {
+				return &automock.PackageConverter{}
+			},
+			ExpectedErr: testErr,
+		},
+		{
+			Name:            "Returns error when commit failed",
+			TransactionerFn: txGen.ThatFailsOnCommit,
+			ServiceFn: func() *automock.PackageService {
+				svc := &automock.PackageService{}
+				svc.On("Get", txtest.CtxWithDBMatcher(), packageID).Return(modelPkg, nil).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.PackageConverter {
+				return &automock.PackageConverter{}
+			},
+			ExpectedErr: testErr,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			persist, transact := testCase.TransactionerFn()
+			svc := testCase.ServiceFn()
+			conv := testCase.ConverterFn()
+
+			resolver := mp_package.NewResolver(transact, svc, conv)
+
+			// when
+			result, err := resolver.Package(context.TODO(), packageID)
+
+			// then
+			assert.Equal(t, testCase.ExpectedPackage, result)
+			if testCase.ExpectedErr != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+
+			persist.AssertExpectations(t)
+			transact.AssertExpectations(t)
+			svc.AssertExpectations(t)
+			conv.AssertExpectations(t)
+		})
+	}
+}
+
+func TestResolver_PackagesForApplication(t *testing.T) {
+	// given
+	testErr := errors.New("Test error")
+	txGen := txtest.NewTransactionContextGenerator(testErr)
+
+	first := 2
+	after := "test"
+	gqlAfter := graphql.PageCursor(after)
+
+	modelPkgs := []*model.Package{fixPackageModel()}
+	gqlPkgs := []*graphql.Package{fixGQLPackage()}
+	modelPage := &model.PackagePage{
+		Data:       modelPkgs,
+		TotalCount: 1,
+		PageInfo: &pagination.Page{
+			StartCursor: "start",
+			EndCursor:   "end",
+			HasNextPage: false,
+		},
+	}
+	gqlPage := &graphql.PackagePage{
+		Data:       gqlPkgs,
+		TotalCount: 1,
+		PageInfo: &graphql.PageInfo{
+			StartCursor: "start",
+			EndCursor:   "end",
+			HasNextPage: false,
+		},
+	}
+	app := &graphql.Application{BaseEntity: &graphql.BaseEntity{ID: appID}}
+
+	testCases := []struct {
+		Name            string
+		TransactionerFn func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		ServiceFn       func() *automock.PackageService
+		ConverterFn     func() *automock.PackageConverter
+		ExpectedResult  *graphql.PackagePage
+		ExpectedErr     error
+	}{
+		{
+			Name:            "Success",
+			TransactionerFn: txGen.ThatSucceeds,
+			ServiceFn: func() *automock.PackageService {
+				svc := &automock.PackageService{}
+				svc.On("ListByApplicationIDPage", txtest.CtxWithDBMatcher(), appID, first, after).Return(modelPage, nil).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.PackageConverter {
+				conv := &automock.PackageConverter{}
+				conv.On("MultipleToGraphQL", modelPkgs).Return(gqlPkgs).Once()
+				return conv
+			},
+			ExpectedResult: gqlPage,
+		},
+		{
+			Name:            "Returns error when Packages listing failed",
+			TransactionerFn: txGen.ThatDoesntExpectCommit,
+			ServiceFn: func() *automock.PackageService {
+				svc := &automock.PackageService{}
+				svc.On("ListByApplicationIDPage", txtest.CtxWithDBMatcher(), appID, first, after).Return(nil, testErr).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.PackageConverter {
+				return &automock.PackageConverter{}
+			},
+			ExpectedErr: testErr,
+		},
+		{
+			Name:            "Returns error when transaction begin failed",
+			TransactionerFn: txGen.ThatFailsOnBegin,
+			ServiceFn: func() *automock.PackageService {
+				return &automock.PackageService{}
+			},
+			ConverterFn: func() *automock.PackageConverter {
+				return &automock.PackageConverter{}
+			},
+			ExpectedErr: testErr,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			persist, transact := testCase.TransactionerFn()
+			svc := testCase.ServiceFn()
+			conv := testCase.ConverterFn()
+
+			resolver := mp_package.NewResolver(transact, svc, conv)
+
+			// when
+			result, err := resolver.PackagesForApplication(context.TODO(), app, &first, &gqlAfter)
+
+			// then
+			assert.Equal(t, testCase.ExpectedResult, result)
+			if testCase.ExpectedErr != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+
+			persist.AssertExpectations(t)
+			transact.AssertExpectations(t)
+			svc.AssertExpectations(t)
+			conv.AssertExpectations(t)
+		})
+	}
+
+	t.Run("Returns error when first is missing", func(t *testing.T) {
+		resolver := mp_package.NewResolver(nil, nil, nil)
+
+		// when
+		_, err := resolver.PackagesForApplication(context.TODO(), app, nil, nil)
+
+		// then
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "missing required parameter 'first'")
+	})
+}
+
+func TestResolver_PackagesForBundle(t *testing.T) {
+	bundleID := "bundleID"
+	bndl := &graphql.Bundle{BaseEntity: &graphql.BaseEntity{ID: bundleID}}
+
+	t.Run("Success", func(t *testing.T) {
+		persist, transact := txtest.NewTransactionContextGenerator(nil).ThatSucceeds()
+		modelPkgs := []*model.Package{fixPackageModel()}
+		gqlPkgs := []*graphql.Package{fixGQLPackage()}
+
+		svc := &automock.PackageService{}
+		svc.On("ListByBundleIDs", txtest.CtxWithDBMatcher(), []string{bundleID}).Return([][]*model.Package{modelPkgs}, nil).Once()
+		conv := &automock.PackageConverter{}
+		conv.On("MultipleToGraphQL", modelPkgs).Return(gqlPkgs).Once()
+
+		resolver := mp_package.NewResolver(transact, svc, conv)
+		loaders := dataloader.NewLoaders(dataloader.Fetchers{BundlePackages: resolver.BundlePackagesDataLoader}, dataloader.Config{})
+
+		// when
+		result, err := resolver.PackagesForBundle(dataloader.SaveToContext(context.TODO(), loaders), bndl)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, gqlPkgs, result)
+		persist.AssertExpectations(t)
+		transact.AssertExpectations(t)
+		svc.AssertExpectations(t)
+		conv.AssertExpectations(t)
+	})
+
+	t.Run("Returns error when dataloaders are missing in context", func(t *testing.T) {
+		resolver := mp_package.NewResolver(nil, nil, nil)
+
+		// when
+		_, err := resolver.PackagesForBundle(context.TODO(), bndl)
+
+		// then
+		require.Equal(t, dataloader.NoLoadersError, err)
+	})
+}
+
+func TestResolver_BundlePackagesDataLoader(t *testing.T) {
+	testErr := errors.New("Test error")
+	bundleIDs := []string{"bundleID", "emptyBundleID"}
+
+	t.Run("Returns the packages in the order of the bundle IDs", func(t *testing.T) {
+		persist, transact := txtest.NewTransactionContextGenerator(nil).ThatSucceeds()
+		modelPkgs := []*model.Package{fixPackageModel()}
+		gqlPkgs := []*graphql.Package{fixGQLPackage()}
+
+		svc := &automock.PackageService{}
+		svc.On("ListByBundleIDs", txtest.CtxWithDBMatcher(), bundleIDs).Return([][]*model.Package{modelPkgs, nil}, nil).Once()
+		conv := &automock.PackageConverter{}
+		conv.On("MultipleToGraphQL", modelPkgs).Return(gqlPkgs).Once()
+		conv.On("MultipleToGraphQL", []*model.Package(nil)).Return([]*graphql.Package{}).Once()
+
+		resolver := mp_package.NewResolver(transact, svc, conv)
+
+		// when
+		result, err := resolver.BundlePackagesDataLoader(context.TODO(), bundleIDs)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, [][]*graphql.Package{gqlPkgs, {}}, result)
+		persist.AssertExpectations(t)
+		transact.AssertExpectations(t)
+		svc.AssertExpectations(t)
+		conv.AssertExpectations(t)
+	})
+
+	t.Run("Returns error when listing the packages failed", func(t *testing.T) {
+		persist, transact := txtest.NewTransactionContextGenerator(nil).ThatDoesntExpectCommit()
+
+		svc := &automock.PackageService{}
+		svc.On("ListByBundleIDs", txtest.CtxWithDBMatcher(), bundleIDs).Return(nil, testErr).Once()
+
+		resolver := mp_package.NewResolver(transact, svc, nil)
+
+		// when
+		_, err := resolver.BundlePackagesDataLoader(context.TODO(), bundleIDs)
+
+		// then
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), testErr.Error())
+		persist.AssertExpectations(t)
+		transact.AssertExpectations(t)
+		svc.AssertExpectations(t)
+	})
+}
+
+func TestResolver_PackageForAPIDefinition(t *testing.T) {
+	t.Run("Returns nil when API Definition is not part of a Package", func(t *testing.T) {
+		resolver := mp_package.NewResolver(nil, nil, nil)
+
+		// when
+		result, err := resolver.PackageForAPIDefinition(context.TODO(), &graphql.APIDefinition{})
+
+		// then
+		require.NoError(t, err)
+		assert.Nil(t, result)
+	})
+
+	t.Run("Success", func(t *testing.T) {
+		persist, transact := txtest.NewTransactionContextGenerator(nil).ThatSucceeds()
+		modelPkg := fixPackageModel()
+		gqlPkg := fixGQLPackage()
+		pkgID := packageID
+
+		svc := &automock.PackageService{}
+		svc.On("ListByIDs", txtest.CtxWithDBMatcher(), []string{packageID}).Return([]*model.Package{modelPkg}, nil).Once()
+		conv := &automock.PackageConverter{}
+		conv.On("ToGraphQL", modelPkg).Return(gqlPkg).Once()
+
+		resolver := mp_package.NewResolver(transact, svc, conv)
+		loaders := dataloader.NewLoaders(dataloader.Fetchers{Packages: resolver.PackagesDataLoader}, dataloader.Config{})
+
+		// when
+		result, err := resolver.PackageForAPIDefinition(dataloader.SaveToContext(context.TODO(), loaders), &graphql.APIDefinition{PackageID: &pkgID})
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, gqlPkg, result)
+		persist.AssertExpectations(t)
+		transact.AssertExpectations(t)
+		svc.AssertExpectations(t)
+		conv.AssertExpectations(t)
+	})
+
+	t.Run("Returns error when dataloaders are missing in context", func(t *testing.T) {
+		pkgID := packageID
+		resolver := mp_package.NewResolver(nil, nil, nil)
+
+		// when
+		_, err := resolver.PackageForAPIDefinition(context.TODO(), &graphql.APIDefinition{PackageID: &pkgID})
+
+		// then
+		require.Equal(t, dataloader.NoLoadersError, err)
+	})
+}
+
+func TestResolver_PackagesDataLoader(t *testing.T) {
+	testErr := errors.New("Test error")
+	missingPkgID := "missing"
+
+	t.Run("Returns the packages in the order of the IDs", func(t *testing.T) {
+		persist, transact := txtest.NewTransactionContextGenerator(nil).ThatSucceeds()
+		modelPkg := fixPackageModel()
+		gqlPkg := fixGQLPackage()
+
+		svc := &automock.PackageService{}
+		svc.On("ListByIDs", txtest.CtxWithDBMatcher(), []string{missingPkgID, packageID}).Return([]*model.Package{modelPkg}, nil).Once()
+		conv := &automock.PackageConverter{}
+		conv.On("ToGraphQL", modelPkg).Return(gqlPkg).Once()
+
+		resolver := mp_package.NewResolver(transact, svc, conv)
+
+		// when
+		result, err := resolver.PackagesDataLoader(context.TODO(), []string{missingPkgID, packageID})
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, []*graphql.Package{nil, gqlPkg}, result)
+		persist.AssertExpectations(t)
+		transact.AssertExpectations(t)
+		svc.AssertExpectations(t)
+		conv.AssertExpectations(t)
+	})
+
+	t.Run("Returns error when listing the packages failed", func(t *testing.T) {
+		persist, transact := txtest.NewTransactionContextGenerator(nil).ThatDoesntExpectCommit()
+
+		svc := &automock.PackageService{}
+		svc.On("ListByIDs", txtest.CtxWithDBMatcher(), []string{packageID}).Return(nil, testErr).Once()
+
+		resolver := mp_package.NewResolver(transact, svc, nil)
+
+		// when
+		_, err := resolver.PackagesDataLoader(context.TODO(), []string{packageID})
+
+		// then
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), testErr.Error())
+		persist.AssertExpectations(t)
+		transact.AssertExpectations(t)
+		svc.AssertExpectations(t)
+	})
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/kyma-incubator/compass/components/director/internal/domain/tenant"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
 	"github.com/kyma-incubator/compass/components/director/pkg/log"
 	"github.com/pkg/errors"
 )
@@ -16,7 +17,11 @@ type PackageRepository interface {
 	Delete(ctx context.Context, tenant, id string) error
 	Exists(ctx context.Context, tenant, id string) (bool, error)
 	GetByID(ctx context.Context, tenant, id string) (*model.Package, error)
+	ListByIDs(ctx context.Context, tenantID string, ids []string) ([]*model.Package, error)
 	ListByApplicationID(ctx context.Context, tenantID, appID string) ([]*model.Package, error)
+	ListByApplicationIDPage(ctx context.Context, tenantID, appID string, pageSize int, cursor string) (*model.PackagePage, error)
+	ListByBundleIDs(ctx context.Context, tenantID string, bundleIDs []string) ([][]*model.Package, error)
+	List(ctx context.Context, tenantID string, pageSize int, cursor string) (*model.PackagePage, error)
 }
 
 //go:generate mockery -name=UIDService -output=automock -outpkg=automock -case=underscore
@@ -117,6 +122,15 @@ func (s *service) Get(ctx context.Context, id string) (*model.Package, error) {
 	return pkg, nil
 }
 
+func (s *service) ListByIDs(ctx context.Context, ids []string) ([]*model.Package, error) {
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "while loading tenant from context")
+	}
+
+	return s.pkgRepo.ListByIDs(ctx, tnt, ids)
+}
+
 func (s *service) ListByApplicationID(ctx context.Context, appID string) ([]*model.Package, error) {
 	tnt, err := tenant.LoadFromContext(ctx)
 	if err != nil {
@@ -125,3 +139,39 @@ func (s *service) ListByApplicationID(ctx context.Context, appID string) ([]*mod
 
 	return s.pkgRepo.ListByApplicationID(ctx, tnt, appID)
 }
+
+func (s *service) ListByApplicationIDPage(ctx context.Context, appID string, pageSize int, cursor string) (*model.PackagePage, error) {
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if pageSize < 1 || pageSize > 200 {
+		return nil, apperrors.NewInvalidDataError("page size must be between 1 and 200")
+	}
+
+	return s.pkgRepo.ListByApplicationIDPage(ctx, tnt, appID, pageSize, cursor)
+}
+
+// ListByBundleIDs returns the packages of the definitions of every bundle, in the order of the bundleIDs
+func (s *service) ListByBundleIDs(ctx context.Context, bundleIDs []string) ([][]*model.Package, error) {
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.pkgRepo.ListByBundleIDs(ctx, tnt, bundleIDs)
+}
+
+func (s *service) List(ctx context.Context, pageSize int, cursor string) (*model.PackagePage, error) {
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if pageSize < 1 || pageSize > 200 {
+		return nil, apperrors.NewInvalidDataError("page size must be between 1 and 200")
+	}
+
+	return s.pkgRepo.List(ctx, tnt, pageSize, cursor)
+}
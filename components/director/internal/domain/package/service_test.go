@@ -389,6 +389,54 @@ func TestService_Get(t *testing.T) {
 	})
 }
 
+func TestService_ListByIDs(t *testing.T) {
+	// given
+	testErr := errors.New("Test error")
+
+	pkgs := []*model.Package{fixPackageModel()}
+	ids := []string{packageID}
+
+	ctx := context.TODO()
+	ctx = tenant.SaveToContext(ctx, tenantID, externalTenantID)
+
+	t.Run("Success", func(t *testing.T) {
+		repo := &automock.PackageRepository{}
+		repo.On("ListByIDs", ctx, tenantID, ids).Return(pkgs, nil).Once()
+		svc := mp_package.NewService(repo, nil)
+
+		// when
+		result, err := svc.ListByIDs(ctx, ids)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, pkgs, result)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("Returns error when Package listing failed", func(t *testing.T) {
+		repo := &automock.PackageRepository{}
+		repo.On("ListByIDs", ctx, tenantID, ids).Return(nil, testErr).Once()
+		svc := mp_package.NewService(repo, nil)
+
+		// when
+		_, err := svc.ListByIDs(ctx, ids)
+
+		// then
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), testErr.Error())
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("Error when tenant not in context", func(t *testing.T) {
+		svc := mp_package.NewService(nil, nil)
+		// WHEN
+		_, err := svc.ListByIDs(context.TODO(), ids)
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "cannot read tenant from context")
+	})
+}
+
 func TestService_ListByApplicationID(t *testing.T) {
 	// given
 	testErr := errors.New("Test error")
@@ -463,3 +511,136 @@ func TestService_ListByApplicationID(t *testing.T) {
 		assert.Contains(t, err.Error(), "cannot read tenant from context")
 	})
 }
+
+func TestService_ListByApplicationIDPage(t *testing.T) {
+	// given
+	testErr := errors.New("Test error")
+
+	pageSize := 2
+	cursor := "cursor"
+	pkgPage := &model.PackagePage{
+		Data:       []*model.Package{fixPackageModel()},
+		TotalCount: 1,
+	}
+
+	ctx := context.TODO()
+	ctx = tenant.SaveToContext(ctx, tenantID, externalTenantID)
+
+	testCases := []struct {
+		Name               string
+		PageSize           int
+		RepositoryFn       func() *automock.PackageRepository
+		ExpectedResult     *model.PackagePage
+		ExpectedErrMessage string
+	}{
+		{
+			Name: "Success",
+			RepositoryFn: func() *automock.PackageRepository {
+				repo := &automock.PackageRepository{}
+				repo.On("ListByApplicationIDPage", ctx, tenantID, appID, pageSize, cursor).Return(pkgPage, nil).Once()
+				return repo
+			},
+			PageSize:       pageSize,
+			ExpectedResult: pkgPage,
+		},
+		{
+			Name: "Returns error when Package listing failed",
+			RepositoryFn: func() *automock.PackageRepository {
+				repo := &automock.PackageRepository{}
+				repo.On("ListByApplicationIDPage", ctx, tenantID, appID, pageSize, cursor).Return(nil, testErr).Once()
+				return repo
+			},
+			PageSize:           pageSize,
+			ExpectedErrMessage: testErr.Error(),
+		},
+		{
+			Name: "Returns error when page size is less than 1",
+			RepositoryFn: func() *automock.PackageRepository {
+				return &automock.PackageRepository{}
+			},
+			PageSize:           0,
+			ExpectedErrMessage: "page size must be between 1 and 200",
+		},
+		{
+			Name: "Returns error when page size is bigger than 200",
+			RepositoryFn: func() *automock.PackageRepository {
+				return &automock.PackageRepository{}
+			},
+			PageSize:           201,
+			ExpectedErrMessage: "page size must be between 1 and 200",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			repo := testCase.RepositoryFn()
+
+			svc := mp_package.NewService(repo, nil)
+
+			// when
+			result, err := svc.ListByApplicationIDPage(ctx, appID, testCase.PageSize, cursor)
+
+			// then
+			if testCase.ExpectedErrMessage == "" {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.ExpectedResult, result)
+			} else {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErrMessage)
+			}
+
+			repo.AssertExpectations(t)
+		})
+	}
+	t.Run("Error when tenant not in context", func(t *testing.T) {
+		svc := mp_package.NewService(nil, nil)
+		// WHEN
+		_, err := svc.ListByApplicationIDPage(context.TODO(), "", pageSize, cursor)
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "cannot read tenant from context")
+	})
+}
+
+func TestService_ListByBundleIDs(t *testing.T) {
+	// given
+	testErr := errors.New("Test error")
+	bundleIDs := []string{"bundleID"}
+	pkgs := [][]*model.Package{{fixPackageModel()}}
+
+	ctx := context.TODO()
+	ctx = tenant.SaveToContext(ctx, tenantID, externalTenantID)
+
+	t.Run("Success", func(t *testing.T) {
+		repo := &automock.PackageRepository{}
+		repo.On("ListByBundleIDs", ctx, tenantID, bundleIDs).Return(pkgs, nil).Once()
+		svc := mp_package.NewService(repo, nil)
+		// WHEN
+		result, err := svc.ListByBundleIDs(ctx, bundleIDs)
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, pkgs, result)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("Returns error when Package listing failed", func(t *testing.T) {
+		repo := &automock.PackageRepository{}
+		repo.On("ListByBundleIDs", ctx, tenantID, bundleIDs).Return(nil, testErr).Once()
+		svc := mp_package.NewService(repo, nil)
+		// WHEN
+		_, err := svc.ListByBundleIDs(ctx, bundleIDs)
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), testErr.Error())
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("Returns error when tenant is missing in context", func(t *testing.T) {
+		svc := mp_package.NewService(nil, nil)
+		// WHEN
+		_, err := svc.ListByBundleIDs(context.TODO(), bundleIDs)
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "cannot read tenant from context")
+	})
+}
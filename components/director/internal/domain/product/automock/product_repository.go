@@ -86,6 +86,29 @@ func (_m *ProductRepository) GetByID(ctx context.Context, tenant string, id stri
 	return r0, r1
 }
 
+// List provides a mock function with given fields: ctx, tenantID, pageSize, cursor
+func (_m *ProductRepository) List(ctx context.Context, tenantID string, pageSize int, cursor string) (*model.ProductPage, error) {
+	ret := _m.Called(ctx, tenantID, pageSize, cursor)
+
+	var r0 *model.ProductPage
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, string) *model.ProductPage); ok {
+		r0 = rf(ctx, tenantID, pageSize, cursor)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.ProductPage)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, int, string) error); ok {
+		r1 = rf(ctx, tenantID, pageSize, cursor)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ListByApplicationID provides a mock function with given fields: ctx, tenantID, appID
 func (_m *ProductRepository) ListByApplicationID(ctx context.Context, tenantID string, appID string) ([]*model.Product, error) {
 	ret := _m.Called(ctx, tenantID, appID)
@@ -109,6 +132,29 @@ func (_m *ProductRepository) ListByApplicationID(ctx context.Context, tenantID s
 	return r0, r1
 }
 
+// ListByApplicationIDPage provides a mock function with given fields: ctx, tenantID, appID, pageSize, cursor
+func (_m *ProductRepository) ListByApplicationIDPage(ctx context.Context, tenantID string, appID string, pageSize int, cursor string) (*model.ProductPage, error) {
+	ret := _m.Called(ctx, tenantID, appID, pageSize, cursor)
+
+	var r0 *model.ProductPage
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int, string) *model.ProductPage); ok {
+		r0 = rf(ctx, tenantID, appID, pageSize, cursor)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.ProductPage)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, int, string) error); ok {
+		r1 = rf(ctx, tenantID, appID, pageSize, cursor)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Update provides a mock function with given fields: ctx, item
 func (_m *ProductRepository) Update(ctx context.Context, item *model.Product) error {
 	ret := _m.Called(ctx, item)
@@ -1,8 +1,11 @@
 package product
 
 import (
+	"encoding/json"
+
 	"github.com/kyma-incubator/compass/components/director/internal/repo"
 	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
 
 	"github.com/kyma-incubator/compass/components/director/internal/model"
 )
@@ -53,3 +56,42 @@ func (c *converter) FromEntity(entity *Entity) (*model.Product, error) {
 
 	return output, nil
 }
+
+func (c *converter) ToGraphQL(in *model.Product) *graphql.Product {
+	if in == nil {
+		return nil
+	}
+
+	return &graphql.Product{
+		ID:               in.OrdID,
+		OrdID:            in.OrdID,
+		ApplicationID:    in.ApplicationID,
+		Title:            in.Title,
+		ShortDescription: in.ShortDescription,
+		Vendor:           in.Vendor,
+		Parent:           in.Parent,
+		SapPpmsObjectID:  in.PPMSObjectID,
+		Labels:           rawJSONToGraphQLJSON(in.Labels),
+	}
+}
+
+func (c *converter) MultipleToGraphQL(in []*model.Product) []*graphql.Product {
+	products := make([]*graphql.Product, 0, len(in))
+	for _, product := range in {
+		if product == nil {
+			continue
+		}
+		products = append(products, c.ToGraphQL(product))
+	}
+
+	return products
+}
+
+func rawJSONToGraphQLJSON(in json.RawMessage) *graphql.JSON {
+	if len(in) == 0 {
+		return nil
+	}
+
+	out := graphql.JSON(in)
+	return &out
+}
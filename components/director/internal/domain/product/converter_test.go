@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/kyma-incubator/compass/components/director/internal/domain/product"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -48,3 +49,36 @@ func TestEntityConverter_FromEntity(t *testing.T) {
 		require.Error(t, err)
 	})
 }
+
+func TestConverter_ToGraphQL(t *testing.T) {
+	parent := "parent"
+	ppmsID := "ppms_id"
+	labels := graphql.JSON("{}")
+	expected := &graphql.Product{
+		ID:               ordID,
+		OrdID:            ordID,
+		ApplicationID:    appID,
+		Title:            "title",
+		ShortDescription: "short desc",
+		Vendor:           "vendorID",
+		Parent:           &parent,
+		SapPpmsObjectID:  &ppmsID,
+		Labels:           &labels,
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		conv := product.NewConverter()
+
+		result := conv.ToGraphQL(fixProductModel())
+
+		assert.Equal(t, expected, result)
+	})
+
+	t.Run("Returns nil if product model is nil", func(t *testing.T) {
+		conv := product.NewConverter()
+
+		result := conv.ToGraphQL(nil)
+
+		require.Nil(t, result)
+	})
+}
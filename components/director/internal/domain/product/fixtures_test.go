@@ -9,6 +9,8 @@ import (
 
 	"github.com/kyma-incubator/compass/components/director/internal/domain/product"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
 )
 
 const (
@@ -80,3 +82,38 @@ func fixProductRow() []driver.Value {
 func fixProductUpdateArgs() []driver.Value {
 	return []driver.Value{"title", "short desc", "vendorID", "parent", "ppms_id", repo.NewValidNullableString("{}")}
 }
+
+func fixGQLProduct() *graphql.Product {
+	return &graphql.Product{
+		ID:               ordID,
+		OrdID:            ordID,
+		ApplicationID:    appID,
+		Title:            "title",
+		ShortDescription: "short desc",
+		Vendor:           "vendorID",
+	}
+}
+
+func fixProductPage(products []*model.Product) *model.ProductPage {
+	return &model.ProductPage{
+		Data:       products,
+		TotalCount: len(products),
+		PageInfo: &pagination.Page{
+			StartCursor: "start",
+			EndCursor:   "end",
+			HasNextPage: false,
+		},
+	}
+}
+
+func fixGQLProductPage(products []*graphql.Product) *graphql.ProductPage {
+	return &graphql.ProductPage{
+		Data:       products,
+		TotalCount: len(products),
+		PageInfo: &graphql.PageInfo{
+			StartCursor: "start",
+			EndCursor:   "end",
+			HasNextPage: false,
+		},
+	}
+}
@@ -26,24 +26,26 @@ type EntityConverter interface {
 }
 
 type pgRepository struct {
-	conv         EntityConverter
-	existQuerier repo.ExistQuerier
-	lister       repo.Lister
-	singleGetter repo.SingleGetter
-	deleter      repo.Deleter
-	creator      repo.Creator
-	updater      repo.Updater
+	conv            EntityConverter
+	existQuerier    repo.ExistQuerier
+	lister          repo.Lister
+	singleGetter    repo.SingleGetter
+	deleter         repo.Deleter
+	creator         repo.Creator
+	updater         repo.Updater
+	pageableQuerier repo.PageableQuerier
 }
 
 func NewRepository(conv EntityConverter) *pgRepository {
 	return &pgRepository{
-		conv:         conv,
-		existQuerier: repo.NewExistQuerier(resource.Product, productTable, tenantColumn),
-		singleGetter: repo.NewSingleGetter(resource.Product, productTable, tenantColumn, productColumns),
-		lister:       repo.NewLister(resource.Product, productTable, tenantColumn, productColumns),
-		deleter:      repo.NewDeleter(resource.Product, productTable, tenantColumn),
-		creator:      repo.NewCreator(resource.Product, productTable, productColumns),
-		updater:      repo.NewUpdater(resource.Product, productTable, updatableColumns, tenantColumn, []string{"ord_id"}),
+		conv:            conv,
+		existQuerier:    repo.NewExistQuerier(resource.Product, productTable, tenantColumn),
+		singleGetter:    repo.NewSingleGetter(resource.Product, productTable, tenantColumn, productColumns),
+		lister:          repo.NewLister(resource.Product, productTable, tenantColumn, productColumns),
+		deleter:         repo.NewDeleter(resource.Product, productTable, tenantColumn),
+		creator:         repo.NewCreator(resource.Product, productTable, productColumns),
+		updater:         repo.NewUpdater(resource.Product, productTable, updatableColumns, tenantColumn, []string{"ord_id"}),
+		pageableQuerier: repo.NewPageableQuerier(resource.Product, productTable, tenantColumn, productColumns),
 	}
 }
 
@@ -104,6 +106,37 @@ func (r *pgRepository) ListByApplicationID(ctx context.Context, tenantID, appID
 	return products, nil
 }
 
+func (r *pgRepository) ListByApplicationIDPage(ctx context.Context, tenantID, appID string, pageSize int, cursor string) (*model.ProductPage, error) {
+	return r.list(ctx, tenantID, pageSize, cursor, repo.NewEqualCondition("app_id", appID))
+}
+
+func (r *pgRepository) List(ctx context.Context, tenantID string, pageSize int, cursor string) (*model.ProductPage, error) {
+	return r.list(ctx, tenantID, pageSize, cursor)
+}
+
+func (r *pgRepository) list(ctx context.Context, tenantID string, pageSize int, cursor string, conditions ...repo.Condition) (*model.ProductPage, error) {
+	var productCollection productCollection
+	page, totalCount, err := r.pageableQuerier.List(ctx, tenantID, pageSize, cursor, "ord_id", &productCollection, conditions...)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*model.Product, 0, productCollection.Len())
+	for _, productEnt := range productCollection {
+		productModel, err := r.conv.FromEntity(&productEnt)
+		if err != nil {
+			return nil, errors.Wrap(err, "while creating Product model from entity")
+		}
+		items = append(items, productModel)
+	}
+
+	return &model.ProductPage{
+		Data:       items,
+		TotalCount: totalCount,
+		PageInfo:   page,
+	}, nil
+}
+
 type productCollection []Entity
 
 func (pc productCollection) Len() int {
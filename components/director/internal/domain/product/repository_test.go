@@ -2,6 +2,7 @@ package product_test
 
 import (
 	"context"
+	"fmt"
 	"regexp"
 	"testing"
 
@@ -235,3 +236,67 @@ func TestPgRepository_ListByApplicationID(t *testing.T) {
 		sqlMock.AssertExpectations(t)
 	})
 }
+
+func TestPgRepository_ListByApplicationIDPage(t *testing.T) {
+	// GIVEN
+	pageSize := 3
+	totalCount := 2
+	firstProductEntity := fixEntityProduct()
+	secondProductEntity := fixEntityProduct()
+
+	selectQuery := fmt.Sprintf(`^SELECT (.+) FROM public.products
+		WHERE tenant_id = \$1 AND app_id = \$2
+		ORDER BY ord_id LIMIT %d OFFSET %d`, pageSize, 0)
+
+	countQuery := regexp.QuoteMeta(`SELECT COUNT(*) FROM public.products
+		WHERE tenant_id = $1 AND app_id = $2`)
+
+	t.Run("success", func(t *testing.T) {
+		sqlxDB, sqlMock := testdb.MockDatabase(t)
+		rows := sqlmock.NewRows(fixProductColumns()).
+			AddRow(fixProductRow()...).
+			AddRow(fixProductRow()...)
+
+		sqlMock.ExpectQuery(selectQuery).
+			WithArgs(tenantID, appID).
+			WillReturnRows(rows)
+
+		sqlMock.ExpectQuery(countQuery).
+			WithArgs(tenantID, appID).
+			WillReturnRows(testdb.RowCount(totalCount))
+
+		ctx := persistence.SaveToContext(context.TODO(), sqlxDB)
+		convMock := &automock.EntityConverter{}
+		convMock.On("FromEntity", firstProductEntity).Return(&model.Product{OrdID: firstProductEntity.OrdID}, nil)
+		convMock.On("FromEntity", secondProductEntity).Return(&model.Product{OrdID: secondProductEntity.OrdID}, nil)
+		pgRepository := product.NewRepository(convMock)
+		// WHEN
+		page, err := pgRepository.ListByApplicationIDPage(ctx, tenantID, appID, pageSize, "")
+		//THEN
+		require.NoError(t, err)
+		require.Len(t, page.Data, totalCount)
+		assert.Equal(t, totalCount, page.TotalCount)
+		assert.False(t, page.PageInfo.HasNextPage)
+		convMock.AssertExpectations(t)
+		sqlMock.AssertExpectations(t)
+	})
+
+	t.Run("DB Error", func(t *testing.T) {
+		sqlxDB, sqlMock := testdb.MockDatabase(t)
+		testErr := errors.New("test error")
+
+		sqlMock.ExpectQuery(selectQuery).
+			WithArgs(tenantID, appID).
+			WillReturnError(testErr)
+
+		ctx := persistence.SaveToContext(context.TODO(), sqlxDB)
+		pgRepository := product.NewRepository(nil)
+		// WHEN
+		page, err := pgRepository.ListByApplicationIDPage(ctx, tenantID, appID, pageSize, "")
+		//THEN
+		require.Error(t, err)
+		assert.Nil(t, page)
+		assert.Contains(t, err.Error(), testErr.Error())
+		sqlMock.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,140 @@
+package product
+
+import (
+	"context"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+	"github.com/pkg/errors"
+)
+
+//go:generate mockery -name=ProductService -output=automock -outpkg=automock -case=underscore
+type ProductService interface {
+	Get(ctx context.Context, id string) (*model.Product, error)
+	List(ctx context.Context, pageSize int, cursor string) (*model.ProductPage, error)
+	ListByApplicationIDPage(ctx context.Context, appID string, pageSize int, cursor string) (*model.ProductPage, error)
+}
+
+//go:generate mockery -name=ProductConverter -output=automock -outpkg=automock -case=underscore
+type ProductConverter interface {
+	ToGraphQL(in *model.Product) *graphql.Product
+	MultipleToGraphQL(in []*model.Product) []*graphql.Product
+}
+
+type Resolver struct {
+	transact persistence.Transactioner
+
+	productSvc  ProductService
+	productConv ProductConverter
+}
+
+func NewResolver(transact persistence.Transactioner, productSvc ProductService, productConv ProductConverter) *Resolver {
+	return &Resolver{
+		transact:    transact,
+		productSvc:  productSvc,
+		productConv: productConv,
+	}
+}
+
+func (r *Resolver) Products(ctx context.Context, first *int, after *graphql.PageCursor) (*graphql.ProductPage, error) {
+	if first == nil {
+		return nil, apperrors.NewInvalidDataError("missing required parameter 'first'")
+	}
+
+	var cursor string
+	if after != nil {
+		cursor = string(*after)
+	}
+
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer r.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	productPage, err := r.productSvc.List(ctx, *first, cursor)
+	if err != nil {
+		return nil, errors.Wrap(err, "while listing Products")
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return r.toGraphQLPage(productPage), nil
+}
+
+func (r *Resolver) Product(ctx context.Context, id string) (*graphql.Product, error) {
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer r.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	product, err := r.productSvc.Get(ctx, id)
+	if err != nil {
+		if apperrors.IsNotFoundError(err) {
+			return nil, tx.Commit()
+		}
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return r.productConv.ToGraphQL(product), nil
+}
+
+func (r *Resolver) ProductsForApplication(ctx context.Context, obj *graphql.Application, first *int, after *graphql.PageCursor) (*graphql.ProductPage, error) {
+	if obj == nil {
+		return nil, apperrors.NewInternalError("Application cannot be empty")
+	}
+
+	if first == nil {
+		return nil, apperrors.NewInvalidDataError("missing required parameter 'first'")
+	}
+
+	var cursor string
+	if after != nil {
+		cursor = string(*after)
+	}
+
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer r.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	productPage, err := r.productSvc.ListByApplicationIDPage(ctx, obj.ID, *first, cursor)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while listing Products for Application with id %s", obj.ID)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return r.toGraphQLPage(productPage), nil
+}
+
+func (r *Resolver) toGraphQLPage(productPage *model.ProductPage) *graphql.ProductPage {
+	return &graphql.ProductPage{
+		Data:       r.productConv.MultipleToGraphQL(productPage.Data),
+		TotalCount: productPage.TotalCount,
+		PageInfo: &graphql.PageInfo{
+			StartCursor:     graphql.PageCursor(productPage.PageInfo.StartCursor),
+			EndCursor:       graphql.PageCursor(productPage.PageInfo.EndCursor),
+			HasNextPage:     productPage.PageInfo.HasNextPage,
+			HasPreviousPage: productPage.PageInfo.HasPreviousPage,
+		},
+	}
+}
@@ -0,0 +1,390 @@
+package product_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/product"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/product/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	persistenceautomock "github.com/kyma-incubator/compass/components/director/pkg/persistence/automock"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence/txtest"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolver_Product(t *testing.T) {
+	// given
+	testErr := errors.New("Test error")
+	txGen := txtest.NewTransactionContextGenerator(testErr)
+
+	modelProduct := fixProductModel()
+	gqlProduct := fixGQLProduct()
+
+	testCases := []struct {
+		Name            string
+		TransactionerFn func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		ServiceFn       func() *automock.ProductService
+		ConverterFn     func() *automock.ProductConverter
+		ExpectedProduct *graphql.Product
+		ExpectedErr     error
+	}{
+		{
+			Name:            "Success",
+			TransactionerFn: txGen.ThatSucceeds,
+			ServiceFn: func() *automock.ProductService {
+				svc := &automock.ProductService{}
+				svc.On("Get", txtest.CtxWithDBMatcher(), ordID).Return(modelProduct, nil).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.ProductConverter {
+				conv := &automock.ProductConverter{}
+				conv.On("ToGraphQL", modelProduct).Return(gqlProduct).Once()
+				return conv
+			},
+			ExpectedProduct: gqlProduct,
+		},
+		{
+			Name:            "Returns null when Product not found",
+			TransactionerFn: txGen.ThatSucceeds,
+			ServiceFn: func() *automock.ProductService {
+				svc := &automock.ProductService{}
+				svc.On("Get", txtest.CtxWithDBMatcher(), ordID).Return(nil, apperrors.NewNotFoundError(resource.Product, ordID)).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.ProductConverter {
+				return &automock.ProductConverter{}
+			},
+		},
+		{
+			Name:            "Returns error when Product retrieval failed",
+			TransactionerFn: txGen.ThatDoesntExpectCommit,
+			ServiceFn: func() *automock.ProductService {
+				svc := &automock.ProductService{}
+				svc.On("Get", txtest.CtxWithDBMatcher(), ordID).Return(nil, testErr).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.ProductConverter {
+				return &automock.ProductConverter{}
+			},
+			ExpectedErr: testErr,
+		},
+		{
+			Name:            "Returns error when commit failed",
+			TransactionerFn: txGen.ThatFailsOnCommit,
+			ServiceFn: func() *automock.ProductService {
+				svc := &automock.ProductService{}
+				svc.On("Get", txtest.CtxWithDBMatcher(), ordID).Return(modelProduct, nil).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.ProductConverter {
+				return &automock.ProductConverter{}
+			},
+			ExpectedErr: testErr,
+		},
+		{
+			Name:            "Returns error when transaction begin failed",
+			TransactionerFn: txGen.ThatFailsOnBegin,
+			ServiceFn: func() *automock.ProductService {
+				return &automock.ProductService{}
+			},
+			ConverterFn: func() *automock.ProductConverter {
+				return &automock.ProductConverter{}
+			},
+			ExpectedErr: testErr,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			persist, transact := testCase.TransactionerFn()
+			svc := testCase.ServiceFn()
+			conv := testCase.ConverterFn()
+
+			resolver := product.NewResolver(transact, svc, conv)
+
+			// when
+			result, err := resolver.Product(context.TODO(), ordID)
+
+			// then
+			assert.Equal(t, testCase.ExpectedProduct, result)
+			if testCase.ExpectedErr != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+
+			persist.AssertExpectations(t)
+			transact.AssertExpectations(t)
+			svc.AssertExpectations(t)
+			conv.AssertExpectations(t)
+		})
+	}
+}
+
+func TestResolver_Products(t *testing.T) {
+	// given
+	testErr := errors.New("Test error")
+	txGen := txtest.NewTransactionContextGenerator(testErr)
+
+	first := 2
+	after := "test"
+	gqlAfter := graphql.PageCursor(after)
+
+	modelProducts := []*model.Product{fixProductModel()}
+	gqlProducts := []*graphql.Product{fixGQLProduct()}
+	modelPage := fixProductPage(modelProducts)
+	gqlPage := fixGQLProductPage(gqlProducts)
+
+	testCases := []struct {
+		Name            string
+		TransactionerFn func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		ServiceFn       func() *automock.ProductService
+		ConverterFn     func() *automock.ProductConverter
+		ExpectedResult  *graphql.ProductPage
+		ExpectedErr     error
+	}{
+		{
+			Name:            "Success",
+			TransactionerFn: txGen.ThatSucceeds,
+			ServiceFn: func() *automock.ProductService {
+				svc := &automock.ProductService{}
+				svc.On("List", txtest.CtxWithDBMatcher(), first, after).Return(modelPage, nil).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.ProductConverter {
+				conv := &automock.ProductConverter{}
+				conv.On("MultipleToGraphQL", modelProducts).Return(gqlProducts).Once()
+				return conv
+			},
+			ExpectedResult: gqlPage,
+		},
+		{
+			Name:            "Returns error when Products listing failed",
+			TransactionerFn: txGen.ThatDoesntExpectCommit,
+			ServiceFn: func() *automock.ProductService {
+				svc := &automock.ProductService{}
+				svc.On("List", txtest.CtxWithDBMatcher(), first, after).Return(nil, testErr).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.ProductConverter {
+				return &automock.ProductConverter{}
+			},
+			ExpectedErr: testErr,
+		},
+		{
+			Name:            "Returns error when commit failed",
+			TransactionerFn: txGen.ThatFailsOnCommit,
+			ServiceFn: func() *automock.ProductService {
+				svc := &automock.ProductService{}
+				svc.On("List", txtest.CtxWithDBMatcher(), first, after).Return(modelPage, nil).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.ProductConverter {
+				return &automock.ProductConverter{}
+			},
+			ExpectedErr: testErr,
+		},
+		{
+			Name:            "Returns error when transaction begin failed",
+			TransactionerFn: txGen.ThatFailsOnBegin,
+			ServiceFn: func() *automock.ProductService {
+				return &automock.ProductService{}
+			},
+			ConverterFn: func() *automock.ProductConverter {
+				return &automock.ProductConverter{}
+			},
+			ExpectedErr: testErr,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			persist, transact := testCase.TransactionerFn()
+			svc := testCase.ServiceFn()
+			conv := testCase.ConverterFn()
+
+			resolver := product.NewResolver(transact, svc, conv)
+
+			// when
+			result, err := resolver.Products(context.TODO(), &first, &gqlAfter)
+
+			// then
+			assert.Equal(t, testCase.ExpectedResult, result)
+			if testCase.ExpectedErr != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+
+			persist.AssertExpectations(t)
+			transact.AssertExpectations(t)
+			svc.AssertExpectations(t)
+			conv.AssertExpectations(t)
+		})
+	}
+
+	t.Run("Returns error when page size is invalid", func(t *testing.T) {
+		invalidFirst := 201
+		persist, transact := txGen.ThatDoesntExpectCommit()
+		svc := &automock.ProductService{}
+		svc.On("List", txtest.CtxWithDBMatcher(), invalidFirst, after).Return(nil, apperrors.NewInvalidDataError("page size must be between 1 and 200")).Once()
+
+		resolver := product.NewResolver(transact, svc, nil)
+
+		// when
+		_, err := resolver.Products(context.TODO(), &invalidFirst, &gqlAfter)
+
+		// then
+		require.Error(t, err)
+		assert.Equal(t, apperrors.InvalidData, apperrors.ErrorCode(err))
+
+		persist.AssertExpectations(t)
+		transact.AssertExpectations(t)
+		svc.AssertExpectations(t)
+	})
+
+	t.Run("Returns error when first is missing", func(t *testing.T) {
+		resolver := product.NewResolver(nil, nil, nil)
+
+		// when
+		_, err := resolver.Products(context.TODO(), nil, nil)
+
+		// then
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "missing required parameter 'first'")
+	})
+}
+
+func TestResolver_ProductsForApplication(t *testing.T) {
+	// given
+	testErr := errors.New("Test error")
+	txGen := txtest.NewTransactionContextGenerator(testErr)
+
+	first := 2
+	after := "test"
+	gqlAfter := graphql.PageCursor(after)
+
+	modelProducts := []*model.Product{fixProductModel()}
+	gqlProducts := []*graphql.Product{fixGQLProduct()}
+	modelPage := fixProductPage(modelProducts)
+	gqlPage := fixGQLProductPage(gqlProducts)
+	app := &graphql.Application{BaseEntity: &graphql.BaseEntity{ID: appID}}
+
+	testCases := []struct {
+		Name            string
+		TransactionerFn func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		ServiceFn       func() *automock.ProductService
+		ConverterFn     func() *automock.ProductConverter
+		ExpectedResult  *graphql.ProductPage
+		ExpectedErr     error
+	}{
+		{
+			Name:            "Success",
+			TransactionerFn: txGen.ThatSucceeds,
+			ServiceFn: func() *automock.ProductService {
+				svc := &automock.ProductService{}
+				svc.On("ListByApplicationIDPage", txtest.CtxWithDBMatcher(), appID, first, after).Return(modelPage, nil).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.ProductConverter {
+				conv := &automock.ProductConverter{}
+				conv.On("MultipleToGraphQL", modelProducts).Return(gqlProducts).Once()
+				return conv
+			},
+			ExpectedResult: gqlPage,
+		},
+		{
+			Name:            "Returns error when Products listing failed",
+			TransactionerFn: txGen.ThatDoesntExpectCommit,
+			ServiceFn: func() *automock.ProductService {
+				svc := &automock.ProductService{}
+				svc.On("ListByApplicationIDPage", txtest.CtxWithDBMatcher(), appID, first, after).Return(nil, testErr).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.ProductConverter {
+				return &automock.ProductConverter{}
+			},
+			ExpectedErr: testErr,
+		},
+		{
+			Name:            "Returns error when commit failed",
+			TransactionerFn: txGen.ThatFailsOnCommit,
+			ServiceFn: func() *automock.ProductService {
+				svc := &automock.ProductService{}
+				svc.On("ListByApplicationIDPage", txtest.CtxWithDBMatcher(), appID, first, after).Return(modelPage, nil).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.ProductConverter {
+				return &automock.ProductConverter{}
+			},
+			ExpectedErr: testErr,
+		},
+		{
+			Name:            "Returns error when transaction begin failed",
+			TransactionerFn: txGen.ThatFailsOnBegin,
+			ServiceFn: func() *automock.ProductService {
+				return &automock.ProductService{}
+			},
+			ConverterFn: func() *automock.ProductConverter {
+				return &automock.ProductConverter{}
+			},
+			ExpectedErr: testErr,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			persist, transact := testCase.TransactionerFn()
+			svc := testCase.ServiceFn()
+			conv := testCase.ConverterFn()
+
+			resolver := product.NewResolver(transact, svc, conv)
+
+			// when
+			result, err := resolver.ProductsForApplication(context.TODO(), app, &first, &gqlAfter)
+
+			// then
+			assert.Equal(t, testCase.ExpectedResult, result)
+			if testCase.ExpectedErr != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+
+			persist.AssertExpectations(t)
+			transact.AssertExpectations(t)
+			svc.AssertExpectations(t)
+			conv.AssertExpectations(t)
+		})
+	}
+
+	t.Run("Returns error when Application is nil", func(t *testing.T) {
+		resolver := product.NewResolver(nil, nil, nil)
+
+		// when
+		_, err := resolver.ProductsForApplication(context.TODO(), nil, &first, &gqlAfter)
+
+		// then
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "Application cannot be empty")
+	})
+
+	t.Run("Returns error when first is missing", func(t *testing.T) {
+		resolver := product.NewResolver(nil, nil, nil)
+
+		// when
+		_, err := resolver.ProductsForApplication(context.TODO(), app, nil, nil)
+
+		// then
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "missing required parameter 'first'")
+	})
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/kyma-incubator/compass/components/director/internal/domain/spec"
 
 	"github.com/kyma-incubator/compass/components/director/internal/consumer"
+	"github.com/kyma-incubator/compass/components/director/internal/dataloader"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/api"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/application"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/apptemplate"
@@ -23,11 +24,17 @@ import (
 	"github.com/kyma-incubator/compass/components/director/internal/domain/labeldef"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/oauth20"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/onetimetoken"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/operationhistory"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/ordsyncstatus"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/ordvendor"
+	mp_package "github.com/kyma-incubator/compass/components/director/internal/domain/package"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/product"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/runtime"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/runtime_context"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/scenarioassignment"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/systemauth"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/tenant"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/tombstone"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/version"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/viewer"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/webhook"
@@ -35,6 +42,7 @@ import (
 	"github.com/kyma-incubator/compass/components/director/internal/metrics"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
 	"github.com/kyma-incubator/compass/components/director/internal/uid"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
 	configprovider "github.com/kyma-incubator/compass/components/director/pkg/config"
 	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
 	httputil "github.com/kyma-incubator/compass/components/director/pkg/http"
@@ -69,6 +77,12 @@ type RootResolver struct {
 	mpBundle           *bundleutil.Resolver
 	bundleInstanceAuth *bundleinstanceauth.Resolver
 	scenarioAssignment *scenarioassignment.Resolver
+	mpPackage          *mp_package.Resolver
+	product            *product.Resolver
+	vendor             *ordvendor.Resolver
+	tombstone          *tombstone.Resolver
+	ordSyncStatus      *ordsyncstatus.Resolver
+	operation          *operationhistory.Resolver
 }
 
 func NewRootResolver(
@@ -111,8 +125,15 @@ func NewRootResolver(
 	appTemplateConverter := apptemplate.NewConverter(appConverter, webhookConverter)
 	bundleInstanceAuthConv := bundleinstanceauth.NewConverter(authConverter)
 	assignmentConv := scenarioassignment.NewConverter()
-
-	healthcheckRepo := healthcheck.NewRepository()
+	pkgConverter := mp_package.NewConverter()
+	productConverter := product.NewConverter()
+	vendorConverter := ordvendor.NewConverter()
+	tombstoneConverter := tombstone.NewConverter()
+	ordSyncStatusConverter := ordsyncstatus.NewConverter()
+	healthCheckConverter := healthcheck.NewConverter()
+	operationConverter := operationhistory.NewConverter()
+
+	healthcheckRepo := healthcheck.NewRepository(healthCheckConverter)
 	runtimeRepo := runtime.NewRepository()
 	runtimeContextRepo := runtime_context.NewRepository()
 	applicationRepo := application.NewRepository(appConverter)
@@ -131,6 +152,12 @@ func NewRootResolver(
 	bundleRepo := bundleutil.NewRepository(bundleConverter)
 	bundleInstanceAuthRepo := bundleinstanceauth.NewRepository(bundleInstanceAuthConv)
 	scenarioAssignmentRepo := scenarioassignment.NewRepository(assignmentConv)
+	pkgRepo := mp_package.NewRepository(pkgConverter)
+	productRepo := product.NewRepository(productConverter)
+	vendorRepo := ordvendor.NewRepository(vendorConverter)
+	tombstoneRepo := tombstone.NewRepository(tombstoneConverter)
+	ordSyncStatusRepo := ordsyncstatus.NewRepository(ordSyncStatusConverter)
+	operationRepo := operationhistory.NewRepository(operationConverter)
 
 	uidSvc := uid.NewService()
 	labelUpsertSvc := label.NewLabelUpsertService(labelRepo, labelDefRepo, uidSvc)
@@ -138,7 +165,7 @@ func NewRootResolver(
 	appTemplateSvc := apptemplate.NewService(appTemplateRepo, webhookRepo, uidSvc)
 
 	fetchRequestSvc := fetchrequest.NewService(fetchRequestRepo, httpClient)
-	specSvc := spec.NewService(specRepo, fetchRequestRepo, uidSvc, fetchRequestSvc)
+	specSvc := spec.NewService(specRepo, fetchRequestRepo, uidSvc, fetchRequestSvc, featuresConfig.ValidateSpecs, featuresConfig.ConvertSpecsToJSON)
 	apiSvc := api.NewService(apiRepo, uidSvc, specSvc)
 	eventAPISvc := eventdef.NewService(eventAPIRepo, uidSvc, specSvc)
 	webhookSvc := webhook.NewService(webhookRepo, applicationRepo, uidSvc)
@@ -147,7 +174,7 @@ func NewRootResolver(
 	scenarioAssignmentSvc := scenarioassignment.NewService(scenarioAssignmentRepo, scenariosSvc, scenarioAssignmentEngine)
 	runtimeSvc := runtime.NewService(runtimeRepo, labelRepo, scenariosSvc, labelUpsertSvc, uidSvc, scenarioAssignmentEngine, protectedLabelPattern)
 	runtimeCtxSvc := runtime_context.NewService(runtimeContextRepo, labelRepo, labelUpsertSvc, uidSvc)
-	healthCheckSvc := healthcheck.NewService(healthcheckRepo)
+	healthCheckSvc := healthcheck.NewService(healthcheckRepo, uidSvc)
 	labelDefSvc := labeldef.NewService(labelDefRepo, labelRepo, scenarioAssignmentRepo, scenariosSvc, uidSvc)
 	systemAuthSvc := systemauth.NewService(systemAuthRepo, uidSvc)
 	tenantSvc := tenant.NewService(tenantRepo, uidSvc)
@@ -159,6 +186,12 @@ func NewRootResolver(
 	timeService := time.NewService()
 	tokenSvc := onetimetoken.NewTokenService(systemAuthSvc, appSvc, appConverter, tenantSvc, httpClient, onetimetoken.NewTokenGenerator(tokenLength), oneTimeTokenCfg.ConnectorURL, pairingAdaptersMapping, timeService)
 	bundleInstanceAuthSvc := bundleinstanceauth.NewService(bundleInstanceAuthRepo, uidSvc)
+	pkgSvc := mp_package.NewService(pkgRepo, uidSvc)
+	productSvc := product.NewService(productRepo)
+	vendorSvc := ordvendor.NewService(vendorRepo)
+	tombstoneSvc := tombstone.NewService(tombstoneRepo)
+	ordSyncStatusSvc := ordsyncstatus.NewService(ordSyncStatusRepo)
+	operationSvc := operationhistory.NewService(operationRepo, uidSvc)
 
 	return &RootResolver{
 		appNameNormalizer:  appNameNormalizer,
@@ -168,20 +201,41 @@ func NewRootResolver(
 		eventAPI:           eventdef.NewResolver(transact, eventAPISvc, bundleSvc, eventAPIConverter, frConverter, specSvc, specConverter),
 		eventing:           eventing.NewResolver(transact, eventingSvc, appSvc),
 		doc:                document.NewResolver(transact, docSvc, appSvc, bundleSvc, frConverter),
-		runtime:            runtime.NewResolver(transact, runtimeSvc, scenarioAssignmentSvc, systemAuthSvc, oAuth20Svc, runtimeConverter, systemAuthConverter, eventingSvc),
+		runtime:            runtime.NewResolver(transact, runtimeSvc, scenarioAssignmentSvc, systemAuthSvc, oAuth20Svc, runtimeConverter, systemAuthConverter, eventingSvc, webhookSvc, webhookConverter),
 		runtimeContext:     runtime_context.NewResolver(transact, runtimeCtxSvc, runtimeContextConverter),
-		healthCheck:        healthcheck.NewResolver(healthCheckSvc),
-		webhook:            webhook.NewResolver(transact, webhookSvc, appSvc, appTemplateSvc, webhookConverter),
+		healthCheck:        healthcheck.NewResolver(transact, healthCheckSvc, healthCheckConverter),
+		webhook:            webhook.NewResolver(transact, webhookSvc, appSvc, appTemplateSvc, runtimeSvc, intSysSvc, webhookConverter),
 		labelDef:           labeldef.NewResolver(transact, labelDefSvc, labelDefConverter),
 		token:              onetimetoken.NewTokenResolver(transact, tokenSvc, tokenConverter),
 		systemAuth:         systemauth.NewResolver(transact, systemAuthSvc, oAuth20Svc, systemAuthConverter),
 		oAuth20:            oauth20.NewResolver(transact, oAuth20Svc, appSvc, runtimeSvc, intSysSvc, systemAuthSvc, systemAuthConverter),
-		intSys:             integrationsystem.NewResolver(transact, intSysSvc, systemAuthSvc, oAuth20Svc, intSysConverter, systemAuthConverter),
+		intSys:             integrationsystem.NewResolver(transact, intSysSvc, systemAuthSvc, oAuth20Svc, intSysConverter, systemAuthConverter, webhookSvc, webhookConverter),
 		viewer:             viewer.NewViewerResolver(),
 		tenant:             tenant.NewResolver(transact, tenantSvc, tenantConverter),
 		mpBundle:           bundleutil.NewResolver(transact, bundleSvc, bundleInstanceAuthSvc, apiSvc, eventAPISvc, docSvc, bundleConverter, bundleInstanceAuthConv, apiConverter, eventAPIConverter, docConverter, specSvc),
 		bundleInstanceAuth: bundleinstanceauth.NewResolver(transact, bundleInstanceAuthSvc, bundleSvc, bundleInstanceAuthConv, bundleConverter),
 		scenarioAssignment: scenarioassignment.NewResolver(transact, scenarioAssignmentSvc, assignmentConv),
+		mpPackage:          mp_package.NewResolver(transact, pkgSvc, pkgConverter),
+		product:            product.NewResolver(transact, productSvc, productConverter),
+		vendor:             ordvendor.NewResolver(transact, vendorSvc, vendorConverter),
+		tombstone:          tombstone.NewResolver(transact, tombstoneSvc, tombstoneConverter),
+		ordSyncStatus:      ordsyncstatus.NewResolver(transact, ordSyncStatusSvc, ordSyncStatusConverter),
+		operation:          operationhistory.NewResolver(transact, operationSvc, operationConverter),
+	}
+}
+
+// DataloaderFetchers returns the fetchers used by the dataloaders to batch the lookups of the children of applications and bundles, and of packages
+func (r *RootResolver) DataloaderFetchers() dataloader.Fetchers {
+	return dataloader.Fetchers{
+		ApplicationBundles:   r.app.BundlesDataLoader,
+		ApplicationWebhooks:  r.app.WebhooksDataLoader,
+		ApplicationLabels:    r.app.LabelsDataLoader,
+		ApplicationAuths:     r.app.AuthsDataLoader,
+		BundleAPIDefinitions: r.mpBundle.APIDefinitionsDataLoader,
+		BundleEvents:         r.mpBundle.EventDefinitionsDataLoader,
+		BundleDocuments:      r.mpBundle.DocumentsDataLoader,
+		BundlePackages:       r.mpPackage.BundlePackagesDataLoader,
+		Packages:             r.mpPackage.PackagesDataLoader,
 	}
 }
 
@@ -219,6 +273,14 @@ func (r *RootResolver) Bundle() graphql.BundleResolver {
 	return &BundleResolver{r}
 }
 
+func (r *RootResolver) APIDefinition() graphql.APIDefinitionResolver {
+	return &apiDefinitionResolver{r}
+}
+
+func (r *RootResolver) EventDefinition() graphql.EventDefinitionResolver {
+	return &eventDefinitionResolver{r}
+}
+
 func (r *RootResolver) IntegrationSystem() graphql.IntegrationSystemResolver {
 	return &integrationSystemResolver{r}
 }
@@ -239,7 +301,7 @@ func (r *queryResolver) Viewer(ctx context.Context) (*graphql.Viewer, error) {
 	return r.viewer.Viewer(ctx)
 }
 
-func (r *queryResolver) Applications(ctx context.Context, filter []*graphql.LabelFilter, first *int, after *graphql.PageCursor) (*graphql.ApplicationPage, error) {
+func (r *queryResolver) Applications(ctx context.Context, filter []*graphql.LabelFilter, first *int, after *graphql.PageCursor, last *int, before *graphql.PageCursor, orderBy *graphql.ApplicationOrderByInput) (*graphql.ApplicationPage, error) {
 	consumerInfo, err := consumer.LoadFromContext(ctx)
 	if err != nil {
 		return nil, err
@@ -247,10 +309,13 @@ func (r *queryResolver) Applications(ctx context.Context, filter []*graphql.Labe
 
 	if consumerInfo.ConsumerType == consumer.Runtime {
 		log.C(ctx).Debugf("Consumer type is of type %v. Filtering response based on scenarios...", consumer.Runtime)
+		if last != nil || before != nil || orderBy != nil {
+			return nil, apperrors.NewInvalidDataError("parameters 'last', 'before' and 'orderBy' are not supported for runtime consumers")
+		}
 		return r.app.ApplicationsForRuntime(ctx, consumerInfo.ConsumerID, first, after)
 	}
 
-	return r.app.Applications(ctx, filter, first, after)
+	return r.app.Applications(ctx, filter, first, after, last, before, orderBy)
 }
 
 func (r *queryResolver) Application(ctx context.Context, id string) (*graphql.Application, error) {
@@ -287,8 +352,8 @@ func (r *queryResolver) ApplicationsForRuntime(ctx context.Context, runtimeID st
 
 	return apps, nil
 }
-func (r *queryResolver) Runtimes(ctx context.Context, filter []*graphql.LabelFilter, first *int, after *graphql.PageCursor) (*graphql.RuntimePage, error) {
-	return r.runtime.Runtimes(ctx, filter, first, after)
+func (r *queryResolver) Runtimes(ctx context.Context, filter []*graphql.LabelFilter, first *int, after *graphql.PageCursor, last *int, before *graphql.PageCursor, orderBy *graphql.RuntimeOrderByInput) (*graphql.RuntimePage, error) {
+	return r.runtime.Runtimes(ctx, filter, first, after, last, before, orderBy)
 }
 func (r *queryResolver) Runtime(ctx context.Context, id string) (*graphql.Runtime, error) {
 	return r.runtime.Runtime(ctx, id)
@@ -333,6 +398,42 @@ func (r *queryResolver) AutomaticScenarioAssignmentsForSelector(ctx context.Cont
 	return r.scenarioAssignment.AutomaticScenarioAssignmentsForSelector(ctx, selector)
 }
 
+func (r *queryResolver) Packages(ctx context.Context, first *int, after *graphql.PageCursor) (*graphql.PackagePage, error) {
+	return r.mpPackage.Packages(ctx, first, after)
+}
+
+func (r *queryResolver) Package(ctx context.Context, id string) (*graphql.Package, error) {
+	return r.mpPackage.Package(ctx, id)
+}
+
+func (r *queryResolver) Products(ctx context.Context, first *int, after *graphql.PageCursor) (*graphql.ProductPage, error) {
+	return r.product.Products(ctx, first, after)
+}
+
+func (r *queryResolver) Product(ctx context.Context, id string) (*graphql.Product, error) {
+	return r.product.Product(ctx, id)
+}
+
+func (r *queryResolver) Vendors(ctx context.Context, first *int, after *graphql.PageCursor) (*graphql.VendorPage, error) {
+	return r.vendor.Vendors(ctx, first, after)
+}
+
+func (r *queryResolver) Vendor(ctx context.Context, id string) (*graphql.Vendor, error) {
+	return r.vendor.Vendor(ctx, id)
+}
+
+func (r *queryResolver) Tombstones(ctx context.Context, first *int, after *graphql.PageCursor) (*graphql.TombstonePage, error) {
+	return r.tombstone.Tombstones(ctx, first, after)
+}
+
+func (r *queryResolver) Tombstone(ctx context.Context, id string) (*graphql.Tombstone, error) {
+	return r.tombstone.Tombstone(ctx, id)
+}
+
+func (r *queryResolver) Operations(ctx context.Context, resourceID string, first *int, after *graphql.PageCursor) (*graphql.OperationPage, error) {
+	return r.operation.Operations(ctx, resourceID, first, after)
+}
+
 func (r *queryResolver) AutomaticScenarioAssignments(ctx context.Context, first *int, after *graphql.PageCursor) (*graphql.AutomaticScenarioAssignmentPage, error) {
 	return r.scenarioAssignment.AutomaticScenarioAssignments(ctx, first, after)
 }
@@ -362,8 +463,8 @@ func (r *mutationResolver) UpdateApplicationTemplate(ctx context.Context, id str
 func (r *mutationResolver) DeleteApplicationTemplate(ctx context.Context, id string) (*graphql.ApplicationTemplate, error) {
 	return r.appTemplate.DeleteApplicationTemplate(ctx, id)
 }
-func (r *mutationResolver) AddWebhook(ctx context.Context, applicationID *string, applicationTemplateID *string, in graphql.WebhookInput) (*graphql.Webhook, error) {
-	return r.webhook.AddWebhook(ctx, applicationID, applicationTemplateID, in)
+func (r *mutationResolver) AddWebhook(ctx context.Context, applicationID *string, applicationTemplateID *string, runtimeID *string, integrationSystemID *string, in graphql.WebhookInput) (*graphql.Webhook, error) {
+	return r.webhook.AddWebhook(ctx, applicationID, applicationTemplateID, runtimeID, integrationSystemID, in)
 }
 func (r *mutationResolver) UpdateWebhook(ctx context.Context, webhookID string, in graphql.WebhookInput) (*graphql.Webhook, error) {
 	return r.webhook.UpdateWebhook(ctx, webhookID, in)
@@ -391,13 +492,13 @@ func (r *mutationResolver) DeleteEventDefinition(ctx context.Context, id string)
 func (r *mutationResolver) RefetchEventDefinitionSpec(ctx context.Context, eventID string) (*graphql.EventSpec, error) {
 	return r.eventAPI.RefetchEventDefinitionSpec(ctx, eventID)
 }
-func (r *mutationResolver) RegisterRuntime(ctx context.Context, in graphql.RuntimeInput) (*graphql.Runtime, error) {
+func (r *mutationResolver) RegisterRuntime(ctx context.Context, in graphql.RuntimeInput, _ *graphql.OperationMode) (*graphql.Runtime, error) {
 	return r.runtime.RegisterRuntime(ctx, in)
 }
 func (r *mutationResolver) UpdateRuntime(ctx context.Context, id string, in graphql.RuntimeInput) (*graphql.Runtime, error) {
 	return r.runtime.UpdateRuntime(ctx, id, in)
 }
-func (r *mutationResolver) UnregisterRuntime(ctx context.Context, id string) (*graphql.Runtime, error) {
+func (r *mutationResolver) UnregisterRuntime(ctx context.Context, id string, _ *graphql.OperationMode) (*graphql.Runtime, error) {
 	return r.runtime.DeleteRuntime(ctx, id)
 }
 func (r *mutationResolver) RegisterRuntimeContext(ctx context.Context, in graphql.RuntimeContextInput) (*graphql.RuntimeContext, error) {
@@ -544,6 +645,25 @@ func (r *applicationResolver) Bundles(ctx context.Context, obj *graphql.Applicat
 func (r *applicationResolver) Bundle(ctx context.Context, obj *graphql.Application, id string) (*graphql.Bundle, error) {
 	return r.app.Bundle(ctx, obj, id)
 }
+func (r *applicationResolver) Packages(ctx context.Context, obj *graphql.Application, first *int, after *graphql.PageCursor) (*graphql.PackagePage, error) {
+	return r.mpPackage.PackagesForApplication(ctx, obj, first, after)
+}
+func (r *applicationResolver) Products(ctx context.Context, obj *graphql.Application, first *int, after *graphql.PageCursor) (*graphql.ProductPage, error) {
+	return r.product.ProductsForApplication(ctx, obj, first, after)
+}
+func (r *applicationResolver) Vendors(ctx context.Context, obj *graphql.Application, first *int, after *graphql.PageCursor) (*graphql.VendorPage, error) {
+	return r.vendor.VendorsForApplication(ctx, obj, first, after)
+}
+func (r *applicationResolver) Tombstones(ctx context.Context, obj *graphql.Application, first *int, after *graphql.PageCursor) (*graphql.TombstonePage, error) {
+	return r.tombstone.TombstonesForApplication(ctx, obj, first, after)
+}
+
+func (r *applicationResolver) Operations(ctx context.Context, obj *graphql.Application, first *int, after *graphql.PageCursor) (*graphql.OperationPage, error) {
+	return r.operation.OperationsForApplication(ctx, obj, first, after)
+}
+func (r *applicationResolver) OrdSyncStatus(ctx context.Context, obj *graphql.Application) (*graphql.ORDSyncStatus, error) {
+	return r.ordSyncStatus.SyncStatusForApplication(ctx, obj)
+}
 
 type applicationTemplateResolver struct {
 	*RootResolver
@@ -569,6 +689,10 @@ func (r *runtimeResolver) EventingConfiguration(ctx context.Context, obj *graphq
 	return r.runtime.EventingConfiguration(ctx, obj)
 }
 
+func (r *runtimeResolver) Webhooks(ctx context.Context, obj *graphql.Runtime) ([]*graphql.Webhook, error) {
+	return r.runtime.Webhooks(ctx, obj)
+}
+
 type apiSpecResolver struct{ *RootResolver }
 
 func (r *apiSpecResolver) FetchRequest(ctx context.Context, obj *graphql.APISpec) (*graphql.FetchRequest, error) {
@@ -593,6 +717,10 @@ func (r *integrationSystemResolver) Auths(ctx context.Context, obj *graphql.Inte
 	return r.intSys.Auths(ctx, obj)
 }
 
+func (r *integrationSystemResolver) Webhooks(ctx context.Context, obj *graphql.IntegrationSystem) ([]*graphql.Webhook, error) {
+	return r.intSys.Webhooks(ctx, obj)
+}
+
 type oneTimeTokenForApplicationResolver struct{ *RootResolver }
 
 func (r *oneTimeTokenForApplicationResolver) RawEncoded(ctx context.Context, obj *graphql.OneTimeTokenForApplication) (*string, error) {
@@ -647,3 +775,22 @@ func (r *BundleResolver) EventDefinition(ctx context.Context, obj *graphql.Bundl
 func (r *BundleResolver) Document(ctx context.Context, obj *graphql.Bundle, id string) (*graphql.Document, error) {
 	return r.mpBundle.Document(ctx, obj, id)
 }
+func (r *BundleResolver) Packages(ctx context.Context, obj *graphql.Bundle) ([]*graphql.Package, error) {
+	return r.mpPackage.PackagesForBundle(ctx, obj)
+}
+
+type apiDefinitionResolver struct {
+	*RootResolver
+}
+
+func (r *apiDefinitionResolver) Package(ctx context.Context, obj *graphql.APIDefinition) (*graphql.Package, error) {
+	return r.mpPackage.PackageForAPIDefinition(ctx, obj)
+}
+
+type eventDefinitionResolver struct {
+	*RootResolver
+}
+
+func (r *eventDefinitionResolver) Package(ctx context.Context, obj *graphql.EventDefinition) (*graphql.Package, error) {
+	return r.mpPackage.PackageForEventDefinition(ctx, obj)
+}
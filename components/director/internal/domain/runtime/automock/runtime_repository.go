@@ -6,9 +6,10 @@ import (
 	context "context"
 
 	labelfilter "github.com/kyma-incubator/compass/components/director/internal/labelfilter"
+	model "github.com/kyma-incubator/compass/components/director/internal/model"
 	mock "github.com/stretchr/testify/mock"
 
-	model "github.com/kyma-incubator/compass/components/director/internal/model"
+	pagination "github.com/kyma-incubator/compass/components/director/pkg/pagination"
 )
 
 // RuntimeRepository is an autogenerated mock type for the RuntimeRepository type
@@ -44,6 +45,20 @@ func (_m *RuntimeRepository) Delete(ctx context.Context, tenant string, id strin
 	return r0
 }
 
+// DeleteGlobal provides a mock function with given fields: ctx, id
+func (_m *RuntimeRepository) DeleteGlobal(ctx context.Context, id string) error {
+	ret := _m.Called(ctx, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Exists provides a mock function with given fields: ctx, tenant, id
 func (_m *RuntimeRepository) Exists(ctx context.Context, tenant string, id string) (bool, error) {
 	ret := _m.Called(ctx, tenant, id)
@@ -111,13 +126,36 @@ func (_m *RuntimeRepository) GetByID(ctx context.Context, tenant string, id stri
 	return r0, r1
 }
 
+// GetGlobalByID provides a mock function with given fields: ctx, id
+func (_m *RuntimeRepository) GetGlobalByID(ctx context.Context, id string) (*model.Runtime, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *model.Runtime
+	if rf, ok := ret.Get(0).(func(context.Context, string) *model.Runtime); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Runtime)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // List provides a mock function with given fields: ctx, tenant, filter, pageSize, cursor
-func (_m *RuntimeRepository) List(ctx context.Context, tenant string, filter []*labelfilter.LabelFilter, pageSize int, cursor string) (*model.RuntimePage, error) {
-	ret := _m.Called(ctx, tenant, filter, pageSize, cursor)
+func (_m *RuntimeRepository) List(ctx context.Context, tenant string, filter []*labelfilter.LabelFilter, page pagination.Request, orderBy model.RuntimeOrderBy) (*model.RuntimePage, error) {
+	ret := _m.Called(ctx, tenant, filter, page, orderBy)
 
 	var r0 *model.RuntimePage
-	if rf, ok := ret.Get(0).(func(context.Context, string, []*labelfilter.LabelFilter, int, string) *model.RuntimePage); ok {
-		r0 = rf(ctx, tenant, filter, pageSize, cursor)
+	if rf, ok := ret.Get(0).(func(context.Context, string, []*labelfilter.LabelFilter, pagination.Request, model.RuntimeOrderBy) *model.RuntimePage); ok {
+		r0 = rf(ctx, tenant, filter, page, orderBy)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*model.RuntimePage)
@@ -125,8 +163,8 @@ func (_m *RuntimeRepository) List(ctx context.Context, tenant string, filter []*
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, string, []*labelfilter.LabelFilter, int, string) error); ok {
-		r1 = rf(ctx, tenant, filter, pageSize, cursor)
+	if rf, ok := ret.Get(1).(func(context.Context, string, []*labelfilter.LabelFilter, pagination.Request, model.RuntimeOrderBy) error); ok {
+		r1 = rf(ctx, tenant, filter, page, orderBy)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -24,6 +24,9 @@ func (c *converter) ToGraphQL(in *model.Runtime) *graphql.Runtime {
 		Name:        in.Name,
 		Description: in.Description,
 		Metadata:    c.metadataToGraphQL(in.CreationTimestamp),
+		UpdatedAt:   timePtrToTimestampPtr(in.UpdatedAt),
+		DeletedAt:   timePtrToTimestampPtr(in.DeletedAt),
+		Error:       in.Error,
 	}
 }
 
@@ -109,3 +112,23 @@ func (c *converter) statusConditionToModel(in *graphql.RuntimeStatusCondition) *
 
 	return &condition
 }
+
+func timePtrToTimestampPtr(time *time.Time) *graphql.Timestamp {
+	if time == nil {
+		return nil
+	}
+
+	t := graphql.Timestamp(*time)
+	return &t
+}
+
+func orderByFromGraphQL(in *graphql.RuntimeOrderByInput) model.RuntimeOrderBy {
+	if in == nil {
+		return model.DefaultRuntimeOrderBy
+	}
+
+	return model.RuntimeOrderBy{
+		Field:      model.RuntimeOrderField(in.Field),
+		Descending: in.Direction != nil && *in.Direction == graphql.OrderDirectionDesc,
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/repo"
 )
 
 type runtimeStatusCondition string
@@ -18,6 +19,10 @@ type Runtime struct {
 	StatusCondition   string         `db:"status_condition"`
 	StatusTimestamp   time.Time      `db:"status_timestamp"`
 	CreationTimestamp time.Time      `db:"creation_timestamp"`
+	Ready             bool           `db:"ready"`
+	UpdatedAt         *time.Time     `db:"updated_at"`
+	DeletedAt         *time.Time     `db:"deleted_at"`
+	Error             sql.NullString `db:"error"`
 }
 
 // EntityFromRuntimeModel converts Runtime model to Runtime entity
@@ -38,6 +43,10 @@ func EntityFromRuntimeModel(model *model.Runtime) (*Runtime, error) {
 		StatusCondition:   string(model.Status.Condition),
 		StatusTimestamp:   model.Status.Timestamp,
 		CreationTimestamp: model.CreationTimestamp,
+		Ready:             model.Ready,
+		UpdatedAt:         model.UpdatedAt,
+		DeletedAt:         model.DeletedAt,
+		Error:             repo.NewNullableString(model.Error),
 	}, nil
 }
 
@@ -59,5 +68,9 @@ func (e Runtime) ToModel() (*model.Runtime, error) {
 			Timestamp: e.StatusTimestamp,
 		},
 		CreationTimestamp: e.CreationTimestamp,
+		Ready:             e.Ready,
+		UpdatedAt:         e.UpdatedAt,
+		DeletedAt:         e.DeletedAt,
+		Error:             repo.StringPtrFromNullableString(e.Error),
 	}, nil
 }
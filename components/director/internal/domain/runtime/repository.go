@@ -2,8 +2,12 @@ package runtime
 
 import (
 	"context"
+	"time"
 
 	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/operation"
+	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
 
 	"github.com/kyma-incubator/compass/components/director/pkg/resource"
 
@@ -19,8 +23,13 @@ import (
 const runtimeTable string = `public.runtimes`
 
 var (
-	runtimeColumns = []string{"id", "tenant_id", "name", "description", "status_condition", "status_timestamp", "creation_timestamp"}
+	runtimeColumns = []string{"id", "tenant_id", "name", "description", "status_condition", "status_timestamp", "creation_timestamp", "ready", "updated_at", "deleted_at", "error"}
 	tenantColumn   = "tenant_id"
+	orderByColumns = map[model.RuntimeOrderField]string{
+		model.RuntimeOrderFieldID:                "id",
+		model.RuntimeOrderFieldName:              "name",
+		model.RuntimeOrderFieldCreationTimestamp: "creation_timestamp",
+	}
 )
 
 type pgRepository struct {
@@ -28,6 +37,7 @@ type pgRepository struct {
 	singleGetter       repo.SingleGetter
 	singleGetterGlobal repo.SingleGetterGlobal
 	deleter            repo.Deleter
+	deleterGlobal      repo.DeleterGlobal
 	pageableQuerier    repo.PageableQuerier
 	creator            repo.Creator
 	updater            repo.Updater
@@ -39,9 +49,10 @@ func NewRepository() *pgRepository {
 		singleGetter:       repo.NewSingleGetter(resource.Runtime, runtimeTable, tenantColumn, runtimeColumns),
 		singleGetterGlobal: repo.NewSingleGetterGlobal(resource.Runtime, runtimeTable, runtimeColumns),
 		deleter:            repo.NewDeleter(resource.Runtime, runtimeTable, tenantColumn),
+		deleterGlobal:      repo.NewDeleterGlobal(resource.Runtime, runtimeTable),
 		pageableQuerier:    repo.NewPageableQuerier(resource.Runtime, runtimeTable, tenantColumn, runtimeColumns),
 		creator:            repo.NewCreator(resource.Runtime, runtimeTable, runtimeColumns),
-		updater:            repo.NewUpdater(resource.Runtime, runtimeTable, []string{"name", "description", "status_condition", "status_timestamp"}, tenantColumn, []string{"id"}),
+		updater:            repo.NewUpdater(resource.Runtime, runtimeTable, []string{"name", "description", "status_condition", "status_timestamp", "ready", "updated_at", "deleted_at", "error"}, tenantColumn, []string{"id"}),
 	}
 }
 
@@ -49,10 +60,31 @@ func (r *pgRepository) Exists(ctx context.Context, tenant, id string) (bool, err
 	return r.existQuerier.Exists(ctx, tenant, repo.Conditions{repo.NewEqualCondition("id", id)})
 }
 
+// Delete removes the runtime. In async mode the runtime is only marked as being deleted
+// and is removed once the operation completes, see DeleteGlobal.
 func (r *pgRepository) Delete(ctx context.Context, tenant string, id string) error {
+	if operation.ModeFromCtx(ctx) == graphql.OperationModeAsync {
+		runtime, err := r.GetByID(ctx, tenant, id)
+		if err != nil {
+			return err
+		}
+
+		runtime.SetReady(false)
+		runtime.SetError("")
+		if runtime.GetDeletedAt().IsZero() {
+			runtime.SetDeletedAt(time.Now())
+		}
+
+		return r.Update(ctx, runtime)
+	}
+
 	return r.deleter.DeleteOne(ctx, tenant, repo.Conditions{repo.NewEqualCondition("id", id)})
 }
 
+func (r *pgRepository) DeleteGlobal(ctx context.Context, id string) error {
+	return r.deleterGlobal.DeleteOneGlobal(ctx, repo.Conditions{repo.NewEqualCondition("id", id)})
+}
+
 func (r *pgRepository) GetByID(ctx context.Context, tenant, id string) (*model.Runtime, error) {
 	var runtimeEnt Runtime
 	if err := r.singleGetter.Get(ctx, tenant, repo.Conditions{repo.NewEqualCondition("id", id)}, repo.NoOrderBy, &runtimeEnt); err != nil {
@@ -67,6 +99,20 @@ func (r *pgRepository) GetByID(ctx context.Context, tenant, id string) (*model.R
 	return runtimeModel, nil
 }
 
+func (r *pgRepository) GetGlobalByID(ctx context.Context, id string) (*model.Runtime, error) {
+	var runtimeEnt Runtime
+	if err := r.singleGetterGlobal.GetGlobal(ctx, repo.Conditions{repo.NewEqualCondition("id", id)}, repo.NoOrderBy, &runtimeEnt); err != nil {
+		return nil, err
+	}
+
+	runtimeModel, err := runtimeEnt.ToModel()
+	if err != nil {
+		return nil, errors.Wrap(err, "while creating runtime model from entity")
+	}
+
+	return runtimeModel, nil
+}
+
 func (r *pgRepository) GetByFiltersAndID(ctx context.Context, tenant, id string, filter []*labelfilter.LabelFilter) (*model.Runtime, error) {
 	tenantID, err := uuid.Parse(tenant)
 	if err != nil {
@@ -126,8 +172,13 @@ func (r RuntimeCollection) Len() int {
 	return len(r)
 }
 
-func (r *pgRepository) List(ctx context.Context, tenant string, filter []*labelfilter.LabelFilter, pageSize int, cursor string) (*model.RuntimePage, error) {
+func (r *pgRepository) List(ctx context.Context, tenant string, filter []*labelfilter.LabelFilter, page pagination.Request, orderBy model.RuntimeOrderBy) (*model.RuntimePage, error) {
 	var runtimesCollection RuntimeCollection
+	orderByParam, err := toOrderByParam(orderBy)
+	if err != nil {
+		return nil, err
+	}
+
 	tenantID, err := uuid.Parse(tenant)
 	if err != nil {
 		return nil, errors.Wrap(err, "while parsing tenant as UUID")
@@ -142,7 +193,7 @@ func (r *pgRepository) List(ctx context.Context, tenant string, filter []*labelf
 		conditions = append(conditions, repo.NewInConditionForSubQuery("id", filterSubquery, args))
 	}
 
-	page, totalCount, err := r.pageableQuerier.List(ctx, tenant, pageSize, cursor, "name", &runtimesCollection, conditions...)
+	pageInfo, totalCount, err := r.pageableQuerier.ListByKeyset(ctx, tenant, page, orderByParam, &runtimesCollection, conditions...)
 
 	if err != nil {
 		return nil, err
@@ -161,7 +212,7 @@ func (r *pgRepository) List(ctx context.Context, tenant string, filter []*labelf
 	return &model.RuntimePage{
 		Data:       items,
 		TotalCount: totalCount,
-		PageInfo:   page}, nil
+		PageInfo:   pageInfo}, nil
 }
 
 func (r *pgRepository) Create(ctx context.Context, item *model.Runtime) error {
@@ -214,3 +265,15 @@ func (r *pgRepository) GetOldestForFilters(ctx context.Context, tenant string, f
 
 	return runtimeModel, nil
 }
+
+func toOrderByParam(orderBy model.RuntimeOrderBy) (repo.OrderBy, error) {
+	column, ok := orderByColumns[orderBy.Field]
+	if !ok {
+		return repo.OrderBy{}, apperrors.NewInvalidDataError("runtimes cannot be ordered by %s", orderBy.Field)
+	}
+
+	if orderBy.Descending {
+		return repo.NewDescOrderBy(column), nil
+	}
+	return repo.NewAscOrderBy(column), nil
+}
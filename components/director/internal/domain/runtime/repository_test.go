@@ -2,6 +2,7 @@ package runtime_test
 
 import (
 	"context"
+	"database/sql/driver"
 	"encoding/base64"
 	"fmt"
 	"regexp"
@@ -13,9 +14,12 @@ import (
 
 	"github.com/kyma-incubator/compass/components/director/internal/labelfilter"
 
+	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
 	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
 
 	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/operation"
 	"github.com/kyma-incubator/compass/components/director/pkg/str"
 
 	"github.com/DATA-DOG/go-sqlmock"
@@ -57,6 +61,40 @@ func TestPgRepository_GetByID_ShouldReturnRuntimeModelForRuntimeEntity(t *testin
 	assert.Equal(t, tenantID, modelRuntime.Tenant)
 }
 
+func TestPgRepository_GetGlobalByID_ShouldReturnRuntimeModelForRuntimeEntity(t *testing.T) {
+	// given
+	tenantID := uuid.New().String()
+	runtimeID := uuid.New().String()
+	errMsg := "error"
+
+	timestamp, err := time.Parse(time.RFC3339, "2002-10-02T10:00:00-05:00")
+	require.NoError(t, err)
+
+	sqlxDB, sqlMock := testdb.MockDatabase(t)
+	defer sqlMock.AssertExpectations(t)
+
+	rows := sqlmock.NewRows([]string{"id", "tenant_id", "name", "description", "status_condition", "status_timestamp", "creation_timestamp", "ready", "error"}).
+		AddRow(runtimeID, tenantID, "Runtime ABC", "Description for runtime ABC", "FAILED", timestamp, timestamp, true, errMsg)
+
+	sqlMock.ExpectQuery(`^SELECT (.+) FROM public.runtimes WHERE id = \$1$`).
+		WithArgs(runtimeID).
+		WillReturnRows(rows)
+
+	ctx := persistence.SaveToContext(context.TODO(), sqlxDB)
+
+	pgRepository := runtime.NewRepository()
+
+	// when
+	modelRuntime, err := pgRepository.GetGlobalByID(ctx, runtimeID)
+
+	//then
+	require.NoError(t, err)
+	assert.Equal(t, runtimeID, modelRuntime.ID)
+	assert.Equal(t, tenantID, modelRuntime.Tenant)
+	assert.True(t, modelRuntime.Ready)
+	assert.Equal(t, &errMsg, modelRuntime.Error)
+}
+
 func TestPgRepository_GetByFiltersAndID_WithoutAdditionalFiltersShouldReturnRuntimeModelForRuntimeEntity(t *testing.T) {
 	// given
 	tenantID := uuid.New().String()
@@ -231,38 +269,61 @@ func TestPgRepository_List(t *testing.T) {
 	runtime1ID := uuid.New().String()
 	runtime2ID := uuid.New().String()
 
-	limit := 2
 	offset := 3
 
-	pageableQuery := `^SELECT (.+) FROM public.runtimes WHERE tenant_id = \$1 ORDER BY name LIMIT %d OFFSET %d$`
+	keysetCursor, err := pagination.EncodeKeysetCursor(pagination.Keyset{OrderBy: "name", SortValue: "Runtime 123", ID: runtime1ID})
+	require.NoError(t, err)
+
 	countQuery := regexp.QuoteMeta(`SELECT COUNT(*) FROM public.runtimes WHERE tenant_id = $1`)
 
 	testCases := []struct {
-		Name           string
-		InputCursor    string
-		InputPageSize  int
-		ExpectedOffset int
-		ExpectedLimit  int
-		Rows           *sqlmock.Rows
-		TotalCount     int
+		Name          string
+		InputPage     pagination.Request
+		InputOrderBy  model.RuntimeOrderBy
+		ExpectedQuery string
+		ExpectedArgs  []driver.Value
+		Rows          *sqlmock.Rows
+		TotalCount    int
 	}{
 		{
-			Name:           "Success getting first page",
-			InputPageSize:  2,
-			InputCursor:    "",
-			ExpectedOffset: 0,
-			ExpectedLimit:  limit,
+			Name:          "Success getting first page",
+			InputPage:     pagination.Request{PageSize: 2},
+			InputOrderBy:  model.DefaultRuntimeOrderBy,
+			ExpectedQuery: `^SELECT (.+) FROM public.runtimes WHERE tenant_id = \$1 ORDER BY name ASC, id ASC LIMIT 3$`,
+			ExpectedArgs:  []driver.Value{tenantID},
+			Rows: sqlmock.NewRows([]string{"id", "tenant_id", "name", "description", "status_condition", "status_timestamp", "creation_timestamp"}).
+				AddRow(runtime1ID, tenantID, "Runtime ABC", "Description for runtime ABC", "INITIAL", timestamp, timestamp).
+				AddRow(runtime2ID, tenantID, "Runtime XYZ", "Description for runtime XYZ", "INITIAL", timestamp, timestamp),
+			TotalCount: 2,
+		},
+		{
+			Name:          "Success getting next page with keyset cursor",
+			InputPage:     pagination.Request{PageSize: 2, Cursor: keysetCursor},
+			InputOrderBy:  model.DefaultRuntimeOrderBy,
+			ExpectedQuery: `^SELECT (.+) FROM public.runtimes WHERE tenant_id = \$1 AND \(name, id\) > \(\$2, \$3\) ORDER BY name ASC, id ASC LIMIT 3$`,
+			ExpectedArgs:  []driver.Value{tenantID, "Runtime 123", runtime1ID},
 			Rows: sqlmock.NewRows([]string{"id", "tenant_id", "name", "description", "status_condition", "status_timestamp", "creation_timestamp"}).
 				AddRow(runtime1ID, tenantID, "Runtime ABC", "Description for runtime ABC", "INITIAL", timestamp, timestamp).
 				AddRow(runtime2ID, tenantID, "Runtime XYZ", "Description for runtime XYZ", "INITIAL", timestamp, timestamp),
 			TotalCount: 2,
 		},
 		{
-			Name:           "Success getting next page",
-			InputPageSize:  2,
-			InputCursor:    convertIntToBase64String(offset),
-			ExpectedOffset: offset,
-			ExpectedLimit:  limit,
+			Name:          "Success getting next page with offset cursor",
+			InputPage:     pagination.Request{PageSize: 2, Cursor: convertIntToBase64String(offset)},
+			InputOrderBy:  model.DefaultRuntimeOrderBy,
+			ExpectedQuery: fmt.Sprintf(`^SELECT (.+) FROM public.runtimes WHERE tenant_id = \$1 ORDER BY name ASC, id ASC LIMIT 3 OFFSET %d$`, offset),
+			ExpectedArgs:  []driver.Value{tenantID},
+			Rows: sqlmock.NewRows([]string{"id", "tenant_id", "name", "description", "status_condition", "status_timestamp", "creation_timestamp"}).
+				AddRow(runtime1ID, tenantID, "Runtime ABC", "Description for runtime ABC", "INITIAL", timestamp, timestamp).
+				AddRow(runtime2ID, tenantID, "Runtime XYZ", "Description for runtime XYZ", "INITIAL", timestamp, timestamp),
+			TotalCount: 2,
+		},
+		{
+			Name:          "Success getting first page ordered by creation timestamp descending",
+			InputPage:     pagination.Request{PageSize: 2},
+			InputOrderBy:  model.RuntimeOrderBy{Field: model.RuntimeOrderFieldCreationTimestamp, Descending: true},
+			ExpectedQuery: `^SELECT (.+) FROM public.runtimes WHERE tenant_id = \$1 ORDER BY creation_timestamp DESC, id DESC LIMIT 3$`,
+			ExpectedArgs:  []driver.Value{tenantID},
 			Rows: sqlmock.NewRows([]string{"id", "tenant_id", "name", "description", "status_condition", "status_timestamp", "creation_timestamp"}).
 				AddRow(runtime1ID, tenantID, "Runtime ABC", "Description for runtime ABC", "INITIAL", timestamp, timestamp).
 				AddRow(runtime2ID, tenantID, "Runtime XYZ", "Description for runtime XYZ", "INITIAL", timestamp, timestamp),
@@ -275,10 +336,8 @@ func TestPgRepository_List(t *testing.T) {
 			defer sqlMock.AssertExpectations(t)
 			ctx := persistence.SaveToContext(context.TODO(), sqlxDB)
 			pgRepository := runtime.NewRepository()
-			expectedQuery := fmt.Sprintf(pageableQuery, testCase.ExpectedLimit, testCase.ExpectedOffset)
-
-			sqlMock.ExpectQuery(expectedQuery).
-				WithArgs(tenantID).
+			sqlMock.ExpectQuery(testCase.ExpectedQuery).
+				WithArgs(testCase.ExpectedArgs...).
 				WillReturnRows(testCase.Rows)
 			countRow := sqlMock.NewRows([]string{"count"}).AddRow(testCase.TotalCount)
 
@@ -287,11 +346,12 @@ func TestPgRepository_List(t *testing.T) {
 				WillReturnRows(countRow)
 
 			//THEN
-			modelRuntimePage, err := pgRepository.List(ctx, tenantID, nil, testCase.InputPageSize, testCase.InputCursor)
+			modelRuntimePage, err := pgRepository.List(ctx, tenantID, nil, testCase.InputPage, testCase.InputOrderBy)
 
 			//THEN
 			require.NoError(t, err)
-			assert.Equal(t, testCase.ExpectedLimit, modelRuntimePage.TotalCount)
+			assert.Equal(t, testCase.TotalCount, modelRuntimePage.TotalCount)
+			assert.False(t, modelRuntimePage.PageInfo.HasNextPage)
 			require.NoError(t, sqlMock.ExpectationsWereMet())
 
 			assert.Equal(t, runtime1ID, modelRuntimePage.Data[0].ID)
@@ -310,11 +370,24 @@ func TestPgRepository_List(t *testing.T) {
 		ctx := persistence.SaveToContext(context.TODO(), sqlxDB)
 		pgRepository := runtime.NewRepository()
 		//THEN
-		_, err := pgRepository.List(ctx, tenantID, nil, 2, convertIntToBase64String(-3))
+		_, err := pgRepository.List(ctx, tenantID, nil, pagination.Request{PageSize: 2, Cursor: convertIntToBase64String(-3)}, model.DefaultRuntimeOrderBy)
 
 		//THEN
 		require.EqualError(t, err, "while decoding page cursor: Invalid data [reason=cursor is not correct]")
 	})
+
+	t.Run("Returns error when order field is not supported", func(t *testing.T) {
+		//GIVEN
+		sqlxDB, sqlMock := testdb.MockDatabase(t)
+		defer sqlMock.AssertExpectations(t)
+		ctx := persistence.SaveToContext(context.TODO(), sqlxDB)
+		pgRepository := runtime.NewRepository()
+		//THEN
+		_, err := pgRepository.List(ctx, tenantID, nil, pagination.Request{PageSize: 2}, model.RuntimeOrderBy{Field: "DESCRIPTION"})
+
+		//THEN
+		require.EqualError(t, err, "Invalid data [reason=runtimes cannot be ordered by DESCRIPTION]")
+	})
 }
 
 func TestPgRepository_List_WithFiltersShouldReturnRuntimeModelsForRuntimeEntities(t *testing.T) {
@@ -340,7 +413,7 @@ func TestPgRepository_List_WithFiltersShouldReturnRuntimeModelsForRuntimeEntitie
 							AND "tenant_id" = \$2 
 							AND "key" = \$3\)`
 	sqlQuery := fmt.Sprintf(`^SELECT (.+) FROM public.runtimes 
-								WHERE tenant_id = \$1 %s ORDER BY name LIMIT %d OFFSET 0`, filterQuery, rowSize)
+								WHERE tenant_id = \$1 %s ORDER BY name ASC, id ASC LIMIT %d$`, filterQuery, rowSize+1)
 
 	sqlMock.ExpectQuery(sqlQuery).
 		WithArgs(tenantID, tenantID, "foo").
@@ -363,7 +436,7 @@ func TestPgRepository_List_WithFiltersShouldReturnRuntimeModelsForRuntimeEntitie
 	pgRepository := runtime.NewRepository()
 
 	// when
-	modelRuntimePage, err := pgRepository.List(ctx, tenantID, filter, rowSize, "")
+	modelRuntimePage, err := pgRepository.List(ctx, tenantID, filter, pagination.Request{PageSize: rowSize}, model.DefaultRuntimeOrderBy)
 
 	//then
 	assert.NoError(t, err)
@@ -396,13 +469,14 @@ func TestPgRepository_Create_ShouldCreateRuntimeEntityFromValidModel(t *testing.
 			Timestamp: timestamp,
 		},
 		CreationTimestamp: timestamp,
+		Ready:             true,
 	}
 
 	sqlxDB, sqlMock := testdb.MockDatabase(t)
 	defer sqlMock.AssertExpectations(t)
 
 	sqlMock.ExpectExec(`^INSERT INTO public.runtimes \(.+\) VALUES \(.+\)$`).
-		WithArgs(modelRuntime.ID, modelRuntime.Tenant, modelRuntime.Name, modelRuntime.Description, modelRuntime.Status.Condition, modelRuntime.Status.Timestamp, modelRuntime.CreationTimestamp).
+		WithArgs(modelRuntime.ID, modelRuntime.Tenant, modelRuntime.Name, modelRuntime.Description, modelRuntime.Status.Condition, modelRuntime.Status.Timestamp, modelRuntime.CreationTimestamp, modelRuntime.Ready, nil, nil, nil).
 		WillReturnResult(sqlmock.NewResult(-1, 1))
 
 	ctx := persistence.SaveToContext(context.TODO(), sqlxDB)
@@ -434,13 +508,14 @@ func TestPgRepository_Update_ShouldUpdateRuntimeEntityFromValidModel(t *testing.
 			Timestamp: timestamp,
 		},
 		CreationTimestamp: timestamp,
+		Ready:             true,
 	}
 
 	sqlxDB, sqlMock := testdb.MockDatabase(t)
 	defer sqlMock.AssertExpectations(t)
 
-	sqlMock.ExpectExec(regexp.QuoteMeta(`UPDATE public.runtimes SET name = ?, description = ?, status_condition = ?, status_timestamp = ? WHERE tenant_id = ? AND id = ?`)).
-		WithArgs(modelRuntime.Name, modelRuntime.Description, modelRuntime.Status.Condition, modelRuntime.Status.Timestamp, modelRuntime.Tenant, modelRuntime.ID).
+	sqlMock.ExpectExec(regexp.QuoteMeta(`UPDATE public.runtimes SET name = ?, description = ?, status_condition = ?, status_timestamp = ?, ready = ?, updated_at = ?, deleted_at = ?, error = ? WHERE tenant_id = ? AND id = ?`)).
+		WithArgs(modelRuntime.Name, modelRuntime.Description, modelRuntime.Status.Condition, modelRuntime.Status.Timestamp, modelRuntime.Ready, nil, nil, nil, modelRuntime.Tenant, modelRuntime.ID).
 		WillReturnResult(sqlmock.NewResult(-1, 1))
 
 	ctx := persistence.SaveToContext(context.TODO(), sqlxDB)
@@ -478,6 +553,59 @@ func TestPgRepository_Delete_ShouldDeleteRuntimeEntityUsingValidModel(t *testing
 	assert.NoError(t, err)
 }
 
+func TestPgRepository_Delete_InAsyncModeShouldMarkRuntimeAsDeleted(t *testing.T) {
+	// given
+	runtimeID := uuid.New().String()
+	tenantID := uuid.New().String()
+	timestamp, err := time.Parse(time.RFC3339, "2002-10-02T10:00:00-05:00")
+	require.NoError(t, err)
+
+	sqlxDB, sqlMock := testdb.MockDatabase(t)
+	defer sqlMock.AssertExpectations(t)
+
+	rows := sqlmock.NewRows([]string{"id", "tenant_id", "name", "description", "status_condition", "status_timestamp", "creation_timestamp", "ready"}).
+		AddRow(runtimeID, tenantID, "Runtime ABC", "Description for runtime ABC", "CONNECTED", timestamp, timestamp, true)
+	sqlMock.ExpectQuery(`^SELECT (.+) FROM public.runtimes WHERE tenant_id = \$1 AND id = \$2$`).
+		WithArgs(tenantID, runtimeID).
+		WillReturnRows(rows)
+	sqlMock.ExpectExec(regexp.QuoteMeta(`UPDATE public.runtimes SET name = ?, description = ?, status_condition = ?, status_timestamp = ?, ready = ?, updated_at = ?, deleted_at = ?, error = ? WHERE tenant_id = ? AND id = ?`)).
+		WithArgs("Runtime ABC", "Description for runtime ABC", "CONNECTED", timestamp, false, nil, sqlmock.AnyArg(), nil, tenantID, runtimeID).
+		WillReturnResult(sqlmock.NewResult(-1, 1))
+
+	ctx := persistence.SaveToContext(context.TODO(), sqlxDB)
+	ctx = operation.SaveModeToContext(ctx, graphql.OperationModeAsync)
+
+	pgRepository := runtime.NewRepository()
+
+	// when
+	err = pgRepository.Delete(ctx, tenantID, runtimeID)
+
+	// then
+	assert.NoError(t, err)
+}
+
+func TestPgRepository_DeleteGlobal(t *testing.T) {
+	// given
+	runtimeID := uuid.New().String()
+
+	sqlxDB, sqlMock := testdb.MockDatabase(t)
+	defer sqlMock.AssertExpectations(t)
+
+	sqlMock.ExpectExec(`^DELETE FROM public.runtimes WHERE id = \$1$`).
+		WithArgs(runtimeID).
+		WillReturnResult(sqlmock.NewResult(-1, 1))
+
+	ctx := persistence.SaveToContext(context.TODO(), sqlxDB)
+
+	pgRepository := runtime.NewRepository()
+
+	// when
+	err := pgRepository.DeleteGlobal(ctx, runtimeID)
+
+	// then
+	assert.NoError(t, err)
+}
+
 func TestPgRepository_Exist(t *testing.T) {
 	// given
 	runtimeID := uuid.New().String()
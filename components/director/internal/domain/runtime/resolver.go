@@ -15,6 +15,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
 
+	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
 	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
 
 	"github.com/kyma-incubator/compass/components/director/internal/model"
@@ -40,7 +41,7 @@ type RuntimeService interface {
 	Update(ctx context.Context, id string, in model.RuntimeInput) error
 	Get(ctx context.Context, id string) (*model.Runtime, error)
 	Delete(ctx context.Context, id string) error
-	List(ctx context.Context, filter []*labelfilter.LabelFilter, pageSize int, cursor string) (*model.RuntimePage, error)
+	List(ctx context.Context, filter []*labelfilter.LabelFilter, page pagination.Request, orderBy model.RuntimeOrderBy) (*model.RuntimePage, error)
 	SetLabel(ctx context.Context, label *model.LabelInput) error
 	GetLabel(ctx context.Context, runtimeID string, key string) (*model.Label, error)
 	ListLabels(ctx context.Context, runtimeID string) (map[string]*model.Label, error)
@@ -70,6 +71,16 @@ type SystemAuthService interface {
 	ListForObject(ctx context.Context, objectType model.SystemAuthReferenceObjectType, objectID string) ([]model.SystemAuth, error)
 }
 
+//go:generate mockery -name=WebhookService -output=automock -outpkg=automock -case=underscore
+type WebhookService interface {
+	ListForRuntime(ctx context.Context, runtimeID string) ([]*model.Webhook, error)
+}
+
+//go:generate mockery -name=WebhookConverter -output=automock -outpkg=automock -case=underscore
+type WebhookConverter interface {
+	MultipleToGraphQL(in []*model.Webhook) ([]*graphql.Webhook, error)
+}
+
 type Resolver struct {
 	transact                  persistence.Transactioner
 	runtimeService            RuntimeService
@@ -79,9 +90,11 @@ type Resolver struct {
 	sysAuthConv               SystemAuthConverter
 	oAuth20Svc                OAuth20Service
 	eventingSvc               EventingService
+	webhookSvc                WebhookService
+	webhookConverter          WebhookConverter
 }
 
-func NewResolver(transact persistence.Transactioner, runtimeService RuntimeService, scenarioAssignmentService ScenarioAssignmentService, sysAuthSvc SystemAuthService, oAuthSvc OAuth20Service, conv RuntimeConverter, sysAuthConv SystemAuthConverter, eventingSvc EventingService) *Resolver {
+func NewResolver(transact persistence.Transactioner, runtimeService RuntimeService, scenarioAssignmentService ScenarioAssignmentService, sysAuthSvc SystemAuthService, oAuthSvc OAuth20Service, conv RuntimeConverter, sysAuthConv SystemAuthConverter, eventingSvc EventingService, webhookSvc WebhookService, webhookConverter WebhookConverter) *Resolver {
 	return &Resolver{
 		transact:                  transact,
 		runtimeService:            runtimeService,
@@ -91,16 +104,25 @@ func NewResolver(transact persistence.Transactioner, runtimeService RuntimeServi
 		converter:                 conv,
 		sysAuthConv:               sysAuthConv,
 		eventingSvc:               eventingSvc,
+		webhookSvc:                webhookSvc,
+		webhookConverter:          webhookConverter,
 	}
 }
 
 // TODO: Proper error handling
-func (r *Resolver) Runtimes(ctx context.Context, filter []*graphql.LabelFilter, first *int, after *graphql.PageCursor) (*graphql.RuntimePage, error) {
+func (r *Resolver) Runtimes(ctx context.Context, filter []*graphql.LabelFilter, first *int, after *graphql.PageCursor, last *int, before *graphql.PageCursor, orderBy *graphql.RuntimeOrderByInput) (*graphql.RuntimePage, error) {
 	labelFilter := labelfilter.MultipleFromGraphQL(filter)
 
-	var cursor string
+	var afterCursor, beforeCursor string
 	if after != nil {
-		cursor = string(*after)
+		afterCursor = string(*after)
+	}
+	if before != nil {
+		beforeCursor = string(*before)
+	}
+	page, err := pagination.NewRequest(first, afterCursor, last, beforeCursor)
+	if err != nil {
+		return nil, err
 	}
 
 	tx, err := r.transact.Begin()
@@ -111,11 +133,7 @@ func (r *Resolver) Runtimes(ctx context.Context, filter []*graphql.LabelFilter,
 
 	ctx = persistence.SaveToContext(ctx, tx)
 
-	if first == nil {
-		return nil, apperrors.NewInvalidDataError("missing required parameter 'first'")
-	}
-
-	runtimesPage, err := r.runtimeService.List(ctx, labelFilter, *first, cursor)
+	runtimesPage, err := r.runtimeService.List(ctx, labelFilter, page, orderByFromGraphQL(orderBy))
 	if err != nil {
 		return nil, err
 	}
@@ -131,9 +149,10 @@ func (r *Resolver) Runtimes(ctx context.Context, filter []*graphql.LabelFilter,
 		Data:       gqlRuntimes,
 		TotalCount: runtimesPage.TotalCount,
 		PageInfo: &graphql.PageInfo{
-			StartCursor: graphql.PageCursor(runtimesPage.PageInfo.StartCursor),
-			EndCursor:   graphql.PageCursor(runtimesPage.PageInfo.EndCursor),
-			HasNextPage: runtimesPage.PageInfo.HasNextPage,
+			StartCursor:     graphql.PageCursor(runtimesPage.PageInfo.StartCursor),
+			EndCursor:       graphql.PageCursor(runtimesPage.PageInfo.EndCursor),
+			HasNextPage:     runtimesPage.PageInfo.HasNextPage,
+			HasPreviousPage: runtimesPage.PageInfo.HasPreviousPage,
 		},
 	}, nil
 }
@@ -166,14 +185,6 @@ func (r *Resolver) Runtime(ctx context.Context, id string) (*graphql.Runtime, er
 func (r *Resolver) RegisterRuntime(ctx context.Context, in graphql.RuntimeInput) (*graphql.Runtime, error) {
 	convertedIn := r.converter.InputFromGraphQL(in)
 
-	tx, err := r.transact.Begin()
-	if err != nil {
-		return nil, err
-	}
-	defer r.transact.RollbackUnlessCommitted(ctx, tx)
-
-	ctx = persistence.SaveToContext(ctx, tx)
-
 	id, err := r.runtimeService.Create(ctx, convertedIn)
 	if err != nil {
 		return nil, err
@@ -184,11 +195,6 @@ func (r *Resolver) RegisterRuntime(ctx context.Context, in graphql.RuntimeInput)
 		return nil, err
 	}
 
-	err = tx.Commit()
-	if err != nil {
-		return nil, err
-	}
-
 	gqlRuntime := r.converter.ToGraphQL(runtime)
 
 	return gqlRuntime, nil
@@ -225,14 +231,6 @@ func (r *Resolver) UpdateRuntime(ctx context.Context, id string, in graphql.Runt
 }
 
 func (r *Resolver) DeleteRuntime(ctx context.Context, id string) (*graphql.Runtime, error) {
-	tx, err := r.transact.Begin()
-	if err != nil {
-		return nil, err
-	}
-	defer r.transact.RollbackUnlessCommitted(ctx, tx)
-
-	ctx = persistence.SaveToContext(ctx, tx)
-
 	runtime, err := r.runtimeService.Get(ctx, id)
 	if err != nil {
 		return nil, err
@@ -260,11 +258,6 @@ func (r *Resolver) DeleteRuntime(ctx context.Context, id string) (*graphql.Runti
 		return nil, err
 	}
 
-	err = tx.Commit()
-	if err != nil {
-		return nil, err
-	}
-
 	return deletedRuntime, nil
 }
 
@@ -446,6 +439,31 @@ func (r *Resolver) Auths(ctx context.Context, obj *graphql.Runtime) ([]*graphql.
 	return out, nil
 }
 
+func (r *Resolver) Webhooks(ctx context.Context, obj *graphql.Runtime) ([]*graphql.Webhook, error) {
+	if obj == nil {
+		return nil, apperrors.NewInternalError("Runtime cannot be empty")
+	}
+
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer r.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	webhooks, err := r.webhookSvc.ListForRuntime(ctx, obj.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return r.webhookConverter.MultipleToGraphQL(webhooks)
+}
+
 func (r *Resolver) EventingConfiguration(ctx context.Context, obj *graphql.Runtime) (*graphql.RuntimeEventingConfiguration, error) {
 	if obj == nil {
 		return nil, apperrors.NewInternalError("Runtime cannot be empty")
@@ -19,6 +19,7 @@ import (
 
 	"github.com/kyma-incubator/compass/components/director/pkg/persistence/txtest"
 
+	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
 	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
 
 	"github.com/stretchr/testify/mock"
@@ -44,6 +45,7 @@ func TestResolver_CreateRuntime(t *testing.T) {
 	modelRuntime := fixModelRuntime(t, "foo", "tenant-foo", "Foo", "Lorem ipsum")
 	gqlRuntime := fixGQLRuntime(t, "foo", "Foo", "Lorem ipsum")
 	testErr := errors.New("Test error")
+	txGen := txtest.NewTransactionContextGenerator(testErr)
 
 	desc := "Lorem ipsum"
 	gqlInput := graphql.RuntimeInput{
@@ -57,8 +59,7 @@ func TestResolver_CreateRuntime(t *testing.T) {
 
 	testCases := []struct {
 		Name            string
-		PersistenceFn   func() *persistenceautomock.PersistenceTx
-		TransactionerFn func(persistTx *persistenceautomock.PersistenceTx) *persistenceautomock.Transactioner
+		TransactionerFn func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
 		ServiceFn       func() *automock.RuntimeService
 		ConverterFn     func() *automock.RuntimeConverter
 
@@ -67,19 +68,8 @@ func TestResolver_CreateRuntime(t *testing.T) {
 		ExpectedErr     error
 	}{
 		{
-			Name: "Success",
-			PersistenceFn: func() *persistenceautomock.PersistenceTx {
-				persistTx := &persistenceautomock.PersistenceTx{}
-				persistTx.On("Commit").Return(nil).Once()
-				return persistTx
-			},
-			TransactionerFn: func(persistTx *persistenceautomock.PersistenceTx) *persistenceautomock.Transactioner {
-				transact := &persistenceautomock.Transactioner{}
-				transact.On("Begin").Return(persistTx, nil).Once()
-				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return().Once()
-
-				return transact
-			},
+			Name:            "Success",
+			TransactionerFn: txGen.ThatDoesntStartTransaction,
 			ServiceFn: func() *automock.RuntimeService {
 				svc := &automock.RuntimeService{}
 				svc.On("Get", contextParam, "foo").Return(modelRuntime, nil).Once()
@@ -97,18 +87,8 @@ func TestResolver_CreateRuntime(t *testing.T) {
 			ExpectedErr:     nil,
 		},
 		{
-			Name: "Returns error when runtime creation failed",
-			PersistenceFn: func() *persistenceautomock.PersistenceTx {
-				persistTx := &persistenceautomock.PersistenceTx{}
-				return persistTx
-			},
-			TransactionerFn: func(persistTx *persistenceautomock.PersistenceTx) *persistenceautomock.Transactioner {
-				transact := &persistenceautomock.Transactioner{}
-				transact.On("Begin").Return(persistTx, nil).Once()
-				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return().Once()
-
-				return transact
-			},
+			Name:            "Returns error when runtime creation failed",
+			TransactionerFn: txGen.ThatDoesntStartTransaction,
 			ServiceFn: func() *automock.RuntimeService {
 				svc := &automock.RuntimeService{}
 				svc.On("Create", contextParam, modelInput).Return("", testErr).Once()
@@ -124,18 +104,8 @@ func TestResolver_CreateRuntime(t *testing.T) {
 			ExpectedErr:     testErr,
 		},
 		{
-			Name: "Returns error when runtime retrieval failed",
-			PersistenceFn: func() *persistenceautomock.PersistenceTx {
-				persistTx := &persistenceautomock.PersistenceTx{}
-				return persistTx
-			},
-			TransactionerFn: func(persistTx *persistenceautomock.PersistenceTx) *persistenceautomock.Transactioner {
-				transact := &persistenceautomock.Transactioner{}
-				transact.On("Begin").Return(persistTx, nil).Once()
-				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return().Once()
-
-				return transact
-			},
+			Name:            "Returns error when runtime retrieval failed",
+			TransactionerFn: txGen.ThatDoesntStartTransaction,
 			ServiceFn: func() *automock.RuntimeService {
 				svc := &automock.RuntimeService{}
 				svc.On("Create", contextParam, modelInput).Return("foo", nil).Once()
@@ -155,15 +125,14 @@ func TestResolver_CreateRuntime(t *testing.T) {
 
 	for _, testCase := range testCases {
 		t.Run(testCase.Name, func(t *testing.T) {
-			persistTx := testCase.PersistenceFn()
-			transact := testCase.TransactionerFn(persistTx)
+			persistTx, transact := testCase.TransactionerFn()
 			svc := testCase.ServiceFn()
 			converter := testCase.ConverterFn()
 
-			resolver := runtime.NewResolver(transact, svc, nil, nil, nil, converter, nil, nil)
+			resolver := runtime.NewResolver(transact, svc, nil, nil, nil, converter, nil, nil, nil, nil)
 
 			// when
-			result, err := resolver.RegisterRuntime(context.TODO(), testCase.Input)
+			result, err := resolver.RegisterRuntime(persistence.SaveToContext(context.TODO(), persistTx), testCase.Input)
 
 			// then
 			assert.Equal(t, testCase.ExpectedRuntime, result)
@@ -299,7 +268,7 @@ func TestResolver_UpdateRuntime(t *testing.T) {
 			svc := testCase.ServiceFn()
 			converter := testCase.ConverterFn()
 
-			resolver := runtime.NewResolver(transact, svc, nil, nil, nil, converter, nil, nil)
+			resolver := runtime.NewResolver(transact, svc, nil, nil, nil, converter, nil, nil, nil, nil)
 
 			// when
 			result, err := resolver.UpdateRuntime(context.TODO(), testCase.RuntimeID, testCase.Input)
@@ -340,7 +309,7 @@ func TestResolver_DeleteRuntime(t *testing.T) {
 	}{
 		{
 			Name:            "Success",
-			TransactionerFn: txGen.ThatSucceeds,
+			TransactionerFn: txGen.ThatDoesntStartTransaction,
 			ServiceFn: func() *automock.RuntimeService {
 				svc := &automock.RuntimeService{}
 				svc.On("Get", contextParam, "foo").Return(modelRuntime, nil).Once()
@@ -374,7 +343,7 @@ func TestResolver_DeleteRuntime(t *testing.T) {
 		},
 		{
 			Name:            "Returns error when runtime deletion failed",
-			TransactionerFn: txGen.ThatDoesntExpectCommit,
+			TransactionerFn: txGen.ThatDoesntStartTransaction,
 			ServiceFn: func() *automock.RuntimeService {
 				svc := &automock.RuntimeService{}
 				svc.On("Get", contextParam, "foo").Return(modelRuntime, nil).Once()
@@ -408,7 +377,7 @@ func TestResolver_DeleteRuntime(t *testing.T) {
 		},
 		{
 			Name:            "Returns error when runtime retrieval failed",
-			TransactionerFn: txGen.ThatDoesntExpectCommit,
+			TransactionerFn: txGen.ThatDoesntStartTransaction,
 			ServiceFn: func() *automock.RuntimeService {
 				svc := &automock.RuntimeService{}
 				svc.On("Get", contextParam, "foo").Return(nil, testErr).Once()
@@ -434,69 +403,9 @@ func TestResolver_DeleteRuntime(t *testing.T) {
 			ExpectedRuntime: nil,
 			ExpectedErr:     testErr,
 		},
-		{
-			Name:            "Returns error when transaction starting failed",
-			TransactionerFn: txGen.ThatFailsOnBegin,
-			ServiceFn: func() *automock.RuntimeService {
-				svc := &automock.RuntimeService{}
-				return svc
-			},
-			ScenarioAssignmentFn: func() *automock.ScenarioAssignmentService {
-				svc := &automock.ScenarioAssignmentService{}
-				return svc
-			},
-			ConverterFn: func() *automock.RuntimeConverter {
-				conv := &automock.RuntimeConverter{}
-				return conv
-			},
-			SysAuthServiceFn: func() *automock.SystemAuthService {
-				svc := &automock.SystemAuthService{}
-				return svc
-			},
-			OAuth20ServiceFn: func() *automock.OAuth20Service {
-				svc := &automock.OAuth20Service{}
-				return svc
-			},
-			InputID:         "foo",
-			ExpectedRuntime: nil,
-			ExpectedErr:     testErr,
-		},
-		{
-			Name:            "Returns error when transaction commit failed",
-			TransactionerFn: txGen.ThatFailsOnCommit,
-			ServiceFn: func() *automock.RuntimeService {
-				svc := &automock.RuntimeService{}
-				svc.On("Get", contextParam, "foo").Return(modelRuntime, nil).Once()
-				svc.On("Delete", contextParam, modelRuntime.ID).Return(nil)
-				svc.On("GetLabel", contextParam, "foo", model.ScenariosKey).Return(nil, scenariosNotFoundErr).Once()
-				return svc
-			},
-			ScenarioAssignmentFn: func() *automock.ScenarioAssignmentService {
-				svc := &automock.ScenarioAssignmentService{}
-				return svc
-			},
-			ConverterFn: func() *automock.RuntimeConverter {
-				conv := &automock.RuntimeConverter{}
-				conv.On("ToGraphQL", modelRuntime).Return(gqlRuntime).Once()
-				return conv
-			},
-			SysAuthServiceFn: func() *automock.SystemAuthService {
-				svc := &automock.SystemAuthService{}
-				svc.On("ListForObject", contextParam, model.RuntimeReference, modelRuntime.ID).Return(testAuths, nil)
-				return svc
-			},
-			OAuth20ServiceFn: func() *automock.OAuth20Service {
-				svc := &automock.OAuth20Service{}
-				svc.On("DeleteMultipleClientCredentials", contextParam, testAuths).Return(nil)
-				return svc
-			},
-			InputID:         "foo",
-			ExpectedRuntime: nil,
-			ExpectedErr:     testErr,
-		},
 		{
 			Name:            "Return error when listing all auths failed",
-			TransactionerFn: txGen.ThatDoesntExpectCommit,
+			TransactionerFn: txGen.ThatDoesntStartTransaction,
 			ServiceFn: func() *automock.RuntimeService {
 				svc := &automock.RuntimeService{}
 				svc.On("Get", contextParam, "foo").Return(modelRuntime, nil).Once()
@@ -525,7 +434,7 @@ func TestResolver_DeleteRuntime(t *testing.T) {
 		},
 		{
 			Name:            "Return error when removing oauth from hydra",
-			TransactionerFn: txGen.ThatDoesntExpectCommit,
+			TransactionerFn: txGen.ThatDoesntStartTransaction,
 			ServiceFn: func() *automock.RuntimeService {
 				svc := &automock.RuntimeService{}
 				svc.On("Get", contextParam, "foo").Return(modelRuntime, nil).Once()
@@ -556,7 +465,7 @@ func TestResolver_DeleteRuntime(t *testing.T) {
 		},
 		{
 			Name:            "Returns error when listing scenarios label",
-			TransactionerFn: txGen.ThatDoesntExpectCommit,
+			TransactionerFn: txGen.ThatDoesntStartTransaction,
 			ServiceFn: func() *automock.RuntimeService {
 				svc := &automock.RuntimeService{}
 				svc.On("Get", contextParam, "foo").Return(modelRuntime, nil).Once()
@@ -588,7 +497,7 @@ func TestResolver_DeleteRuntime(t *testing.T) {
 		},
 		{
 			Name:            "Returns empty scenarios when listing scenarios label should succeed",
-			TransactionerFn: txGen.ThatSucceeds,
+			TransactionerFn: txGen.ThatDoesntStartTransaction,
 			ServiceFn: func() *automock.RuntimeService {
 				svc := &automock.RuntimeService{}
 				svc.On("Get", contextParam, "foo").Return(modelRuntime, nil).Once()
@@ -623,7 +532,7 @@ func TestResolver_DeleteRuntime(t *testing.T) {
 		},
 		{
 			Name:            "Returns scenario when listing scenarios label and error when listing scenario assignments should fail",
-			TransactionerFn: txGen.ThatDoesntExpectCommit,
+			TransactionerFn: txGen.ThatDoesntStartTransaction,
 			ServiceFn: func() *automock.RuntimeService {
 				svc := &automock.RuntimeService{}
 				svc.On("Get", contextParam, "foo").Return(modelRuntime, nil).Once()
@@ -659,7 +568,7 @@ func TestResolver_DeleteRuntime(t *testing.T) {
 		},
 		{
 			Name:            "Returns scenario when listing scenarios label and not found when listing scenario assignments should succeed",
-			TransactionerFn: txGen.ThatSucceeds,
+			TransactionerFn: txGen.ThatDoesntStartTransaction,
 			ServiceFn: func() *automock.RuntimeService {
 				svc := &automock.RuntimeService{}
 				svc.On("Get", contextParam, "foo").Return(modelRuntime, nil).Once()
@@ -696,7 +605,7 @@ func TestResolver_DeleteRuntime(t *testing.T) {
 		},
 		{
 			Name:            "Returns scenario when listing scenarios label and scenario assignment when listing scenario assignments but fails on deletion of scenario assignment should fail",
-			TransactionerFn: txGen.ThatDoesntExpectCommit,
+			TransactionerFn: txGen.ThatDoesntStartTransaction,
 			ServiceFn: func() *automock.RuntimeService {
 				svc := &automock.RuntimeService{}
 				svc.On("Get", contextParam, "foo").Return(modelRuntime, nil).Once()
@@ -733,7 +642,7 @@ func TestResolver_DeleteRuntime(t *testing.T) {
 		},
 		{
 			Name:            "Returns scenario when listing scenarios label and scenario assignment when listing scenario assignments and succeeds on deletion of scenario assignment should succeed",
-			TransactionerFn: txGen.ThatSucceeds,
+			TransactionerFn: txGen.ThatDoesntStartTransaction,
 			ServiceFn: func() *automock.RuntimeService {
 				svc := &automock.RuntimeService{}
 				svc.On("Get", contextParam, "foo").Return(modelRuntime, nil).Once()
@@ -772,7 +681,7 @@ func TestResolver_DeleteRuntime(t *testing.T) {
 		},
 		{
 			Name:            "Returns multiple scenarios when listing scenarios label and only some are created by a scenario assignment should succeed",
-			TransactionerFn: txGen.ThatSucceeds,
+			TransactionerFn: txGen.ThatDoesntStartTransaction,
 			ServiceFn: func() *automock.RuntimeService {
 				svc := &automock.RuntimeService{}
 				svc.On("Get", contextParam, "foo").Return(modelRuntime, nil).Once()
@@ -821,7 +730,7 @@ func TestResolver_DeleteRuntime(t *testing.T) {
 		},
 		{
 			Name:            "Returns multiple scenarios when listing scenarios label and all are created by a scenario assignment should succeed",
-			TransactionerFn: txGen.ThatSucceeds,
+			TransactionerFn: txGen.ThatDoesntStartTransaction,
 			ServiceFn: func() *automock.RuntimeService {
 				svc := &automock.RuntimeService{}
 				svc.On("Get", contextParam, "foo").Return(modelRuntime, nil).Once()
@@ -873,7 +782,7 @@ func TestResolver_DeleteRuntime(t *testing.T) {
 		},
 		{
 			Name:            "Returns multiple scenarios when listing scenarios label and none are created by a scenario assignment should succeed",
-			TransactionerFn: txGen.ThatSucceeds,
+			TransactionerFn: txGen.ThatDoesntStartTransaction,
 			ServiceFn: func() *automock.RuntimeService {
 				svc := &automock.RuntimeService{}
 				svc.On("Get", contextParam, "foo").Return(modelRuntime, nil).Once()
@@ -926,10 +835,10 @@ func TestResolver_DeleteRuntime(t *testing.T) {
 			sysAuthSvc := testCase.SysAuthServiceFn()
 			oAuth20Svc := testCase.OAuth20ServiceFn()
 
-			resolver := runtime.NewResolver(transact, svc, scenarioAssignmentSvc, sysAuthSvc, oAuth20Svc, converter, nil, nil)
+			resolver := runtime.NewResolver(transact, svc, scenarioAssignmentSvc, sysAuthSvc, oAuth20Svc, converter, nil, nil, nil, nil)
 
 			// when
-			result, err := resolver.DeleteRuntime(context.TODO(), testCase.InputID)
+			result, err := resolver.DeleteRuntime(persistence.SaveToContext(context.TODO(), persistTx), testCase.InputID)
 
 			// then
 			assert.Equal(t, testCase.ExpectedRuntime, result)
@@ -1053,7 +962,7 @@ func TestResolver_Runtime(t *testing.T) {
 			svc := testCase.ServiceFn()
 			converter := testCase.ConverterFn()
 
-			resolver := runtime.NewResolver(transact, svc, nil, nil, nil, converter, nil, nil)
+			resolver := runtime.NewResolver(transact, svc, nil, nil, nil, converter, nil, nil, nil, nil)
 
 			// when
 			result, err := resolver.Runtime(context.TODO(), testCase.InputID)
@@ -1082,6 +991,10 @@ func TestResolver_Runtimes(t *testing.T) {
 	first := 2
 	gqlAfter := graphql.PageCursor("test")
 	after := "test"
+	last := 3
+	gqlBefore := graphql.PageCursor("before")
+	before := "before"
+	descending := graphql.OrderDirectionDesc
 	filter := []*labelfilter.LabelFilter{{Key: ""}}
 	gqlFilter := []*graphql.LabelFilter{{Key: ""}}
 	testErr := errors.New("Test error")
@@ -1095,6 +1008,9 @@ func TestResolver_Runtimes(t *testing.T) {
 		InputLabelFilters []*graphql.LabelFilter
 		InputFirst        *int
 		InputAfter        *graphql.PageCursor
+		InputLast         *int
+		InputBefore       *graphql.PageCursor
+		InputOrderBy      *graphql.RuntimeOrderByInput
 		ExpectedResult    *graphql.RuntimePage
 		ExpectedErr       error
 	}{
@@ -1114,7 +1030,7 @@ func TestResolver_Runtimes(t *testing.T) {
 			},
 			ServiceFn: func() *automock.RuntimeService {
 				svc := &automock.RuntimeService{}
-				svc.On("List", contextParam, filter, first, after).Return(fixRuntimePage(modelRuntimes), nil).Once()
+				svc.On("List", contextParam, filter, pagination.Request{PageSize: first, Cursor: after}, model.DefaultRuntimeOrderBy).Return(fixRuntimePage(modelRuntimes), nil).Once()
 				return svc
 			},
 			ConverterFn: func() *automock.RuntimeConverter {
@@ -1143,7 +1059,7 @@ func TestResolver_Runtimes(t *testing.T) {
 			},
 			ServiceFn: func() *automock.RuntimeService {
 				svc := &automock.RuntimeService{}
-				svc.On("List", contextParam, filter, first, after).Return(nil, testErr).Once()
+				svc.On("List", contextParam, filter, pagination.Request{PageSize: first, Cursor: after}, model.DefaultRuntimeOrderBy).Return(nil, testErr).Once()
 				return svc
 			},
 			ConverterFn: func() *automock.RuntimeConverter {
@@ -1156,6 +1072,61 @@ func TestResolver_Runtimes(t *testing.T) {
 			ExpectedResult:    nil,
 			ExpectedErr:       testErr,
 		},
+		{
+			Name: "Success when paging backwards with custom order",
+			PersistenceFn: func() *persistenceautomock.PersistenceTx {
+				persistTx := &persistenceautomock.PersistenceTx{}
+				persistTx.On("Commit").Return(nil).Once()
+				return persistTx
+			},
+			TransactionerFn: func(persistTx *persistenceautomock.PersistenceTx) *persistenceautomock.Transactioner {
+				transact := &persistenceautomock.Transactioner{}
+				transact.On("Begin").Return(persistTx, nil).Once()
+				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return().Once()
+
+				return transact
+			},
+			ServiceFn: func() *automock.RuntimeService {
+				svc := &automock.RuntimeService{}
+				page := pagination.Request{PageSize: last, Cursor: before, Backward: true}
+				orderBy := model.RuntimeOrderBy{Field: model.RuntimeOrderFieldCreationTimestamp, Descending: true}
+				svc.On("List", contextParam, filter, page, orderBy).Return(fixRuntimePage(modelRuntimes), nil).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.RuntimeConverter {
+				conv := &automock.RuntimeConverter{}
+				conv.On("MultipleToGraphQL", modelRuntimes).Return(gqlRuntimes).Once()
+				return conv
+			},
+			InputFirst:        &first,
+			InputLast:         &last,
+			InputBefore:       &gqlBefore,
+			InputOrderBy:      &graphql.RuntimeOrderByInput{Field: graphql.RuntimeOrderFieldCreationTimestamp, Direction: &descending},
+			InputLabelFilters: gqlFilter,
+			ExpectedResult:    fixGQLRuntimePage(gqlRuntimes),
+			ExpectedErr:       nil,
+		},
+		{
+			Name: "Returns error when both after and before are provided",
+			PersistenceFn: func() *persistenceautomock.PersistenceTx {
+				return &persistenceautomock.PersistenceTx{}
+			},
+			TransactionerFn: func(persistTx *persistenceautomock.PersistenceTx) *persistenceautomock.Transactioner {
+				return &persistenceautomock.Transactioner{}
+			},
+			ServiceFn: func() *automock.RuntimeService {
+				return &automock.RuntimeService{}
+			},
+			ConverterFn: func() *automock.RuntimeConverter {
+				return &automock.RuntimeConverter{}
+			},
+			InputFirst:        &first,
+			InputAfter:        &gqlAfter,
+			InputBefore:       &gqlBefore,
+			InputLabelFilters: gqlFilter,
+			ExpectedResult:    nil,
+			ExpectedErr:       apperrors.NewInvalidDataError("parameters 'after' and 'before' cannot be used together"),
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -1165,10 +1136,10 @@ func TestResolver_Runtimes(t *testing.T) {
 			svc := testCase.ServiceFn()
 			converter := testCase.ConverterFn()
 
-			resolver := runtime.NewResolver(transact, svc, nil, nil, nil, converter, nil, nil)
+			resolver := runtime.NewResolver(transact, svc, nil, nil, nil, converter, nil, nil, nil, nil)
 
 			// when
-			result, err := resolver.Runtimes(context.TODO(), testCase.InputLabelFilters, testCase.InputFirst, testCase.InputAfter)
+			result, err := resolver.Runtimes(context.TODO(), testCase.InputLabelFilters, testCase.InputFirst, testCase.InputAfter, testCase.InputLast, testCase.InputBefore, testCase.InputOrderBy)
 
 			// then
 			assert.Equal(t, testCase.ExpectedResult, result)
@@ -1285,7 +1256,7 @@ func TestResolver_SetRuntimeLabel(t *testing.T) {
 			svc := testCase.ServiceFn()
 			converter := testCase.ConverterFn()
 
-			resolver := runtime.NewResolver(transact, svc, nil, nil, nil, converter, nil, nil)
+			resolver := runtime.NewResolver(transact, svc, nil, nil, nil, converter, nil, nil, nil, nil)
 
 			// when
 			result, err := resolver.SetRuntimeLabel(context.TODO(), testCase.InputRuntimeID, testCase.InputKey, testCase.InputValue)
@@ -1299,7 +1270,7 @@ func TestResolver_SetRuntimeLabel(t *testing.T) {
 	}
 
 	t.Run("Returns error when Label input validation failed", func(t *testing.T) {
-		resolver := runtime.NewResolver(nil, nil, nil, nil, nil, nil, nil, nil)
+		resolver := runtime.NewResolver(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 		// when
 		result, err := resolver.SetRuntimeLabel(context.TODO(), "", "", "")
@@ -1431,7 +1402,7 @@ func TestResolver_DeleteRuntimeLabel(t *testing.T) {
 			svc := testCase.ServiceFn()
 			converter := testCase.ConverterFn()
 
-			resolver := runtime.NewResolver(transact, svc, nil, nil, nil, converter, nil, nil)
+			resolver := runtime.NewResolver(transact, svc, nil, nil, nil, converter, nil, nil, nil, nil)
 
 			// when
 			result, err := resolver.DeleteRuntimeLabel(context.TODO(), testCase.InputRuntimeID, testCase.InputKey)
@@ -1563,7 +1534,7 @@ func TestResolver_Labels(t *testing.T) {
 			svc := testCase.ServiceFn()
 			transact := testCase.TransactionerFn(persistTx)
 
-			resolver := runtime.NewResolver(transact, svc, nil, nil, nil, nil, nil, nil)
+			resolver := runtime.NewResolver(transact, svc, nil, nil, nil, nil, nil, nil, nil, nil)
 
 			// when
 			result, err := resolver.Labels(context.TODO(), gqlRuntime, &testCase.InputKey)
@@ -1682,7 +1653,7 @@ func TestResolver_GetLabel(t *testing.T) {
 			svc := testCase.ServiceFn()
 			transact := testCase.TransactionerFn(persistTx)
 
-			resolver := runtime.NewResolver(transact, svc, nil, nil, nil, nil, nil, nil)
+			resolver := runtime.NewResolver(transact, svc, nil, nil, nil, nil, nil, nil, nil, nil)
 
 			// when
 			result, err := resolver.GetLabel(context.TODO(), runtimeID, labelKey)
@@ -1798,7 +1769,7 @@ func TestResolver_Auths(t *testing.T) {
 			sysAuthSvc := testCase.SysAuthSvcFn()
 			sysAuthConv := testCase.SysAuthConvFn()
 
-			resolver := runtime.NewResolver(transact, nil, nil, sysAuthSvc, nil, nil, sysAuthConv, nil)
+			resolver := runtime.NewResolver(transact, nil, nil, sysAuthSvc, nil, nil, sysAuthConv, nil, nil, nil)
 
 			// WHEN
 			result, err := resolver.Auths(ctx, parentRuntime)
@@ -1817,7 +1788,7 @@ func TestResolver_Auths(t *testing.T) {
 	}
 
 	t.Run("Error when parent object is nil", func(t *testing.T) {
-		resolver := runtime.NewResolver(nil, nil, nil, nil, nil, nil, nil, nil)
+		resolver := runtime.NewResolver(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 		// WHEN
 		result, err := resolver.Auths(context.TODO(), nil)
@@ -1903,7 +1874,7 @@ func TestResolver_EventingConfiguration(t *testing.T) {
 			persist, transact := testCase.TransactionerFn()
 			eventingSvc := testCase.EventingSvcFn()
 
-			resolver := runtime.NewResolver(transact, nil, nil, nil, nil, nil, nil, eventingSvc)
+			resolver := runtime.NewResolver(transact, nil, nil, nil, nil, nil, nil, eventingSvc, nil, nil)
 
 			// WHEN
 			result, err := resolver.EventingConfiguration(ctx, gqlRuntime)
@@ -1923,7 +1894,7 @@ func TestResolver_EventingConfiguration(t *testing.T) {
 
 	t.Run("Error when parent object ID is not a valid UUID", func(t *testing.T) {
 		// GIVEN
-		resolver := runtime.NewResolver(nil, nil, nil, nil, nil, nil, nil, nil)
+		resolver := runtime.NewResolver(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 		// WHEN
 		result, err := resolver.EventingConfiguration(ctx, &graphql.Runtime{ID: "abc"})
@@ -1936,7 +1907,7 @@ func TestResolver_EventingConfiguration(t *testing.T) {
 
 	t.Run("Error when parent object is nil", func(t *testing.T) {
 		// GIVEN
-		resolver := runtime.NewResolver(nil, nil, nil, nil, nil, nil, nil, nil)
+		resolver := runtime.NewResolver(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 		// WHEN
 		result, err := resolver.EventingConfiguration(context.TODO(), nil)
@@ -1984,3 +1955,105 @@ func fixOAuths() []model.SystemAuth {
 		},
 	}
 }
+
+func TestResolver_Webhooks(t *testing.T) {
+	// given
+	runtimeID := "foo"
+	modelWebhooks := []*model.Webhook{{ID: "wh-1", RuntimeID: &runtimeID, Type: model.WebhookTypeRegisterRuntime}}
+	gqlWebhooks := []*graphql.Webhook{{ID: "wh-1", RuntimeID: &runtimeID, Type: graphql.WebhookTypeRegisterRuntime}}
+	gqlRuntime := fixGQLRuntime(t, runtimeID, "Foo", "Bar")
+	testErr := errors.New("Test error")
+	txGen := txtest.NewTransactionContextGenerator(testErr)
+
+	testCases := []struct {
+		Name               string
+		TransactionerFn    func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		WebhookServiceFn   func() *automock.WebhookService
+		WebhookConverterFn func() *automock.WebhookConverter
+		ExpectedResult     []*graphql.Webhook
+		ExpectedErr        error
+	}{
+		{
+			Name:            "Success",
+			TransactionerFn: txGen.ThatSucceeds,
+			WebhookServiceFn: func() *automock.WebhookService {
+				svc := &automock.WebhookService{}
+				svc.On("ListForRuntime", contextParam, runtimeID).Return(modelWebhooks, nil).Once()
+				return svc
+			},
+			WebhookConverterFn: func() *automock.WebhookConverter {
+				conv := &automock.WebhookConverter{}
+				conv.On("MultipleToGraphQL", modelWebhooks).Return(gqlWebhooks, nil).Once()
+				return conv
+			},
+			ExpectedResult: gqlWebhooks,
+		},
+		{
+			Name:            "Returns error when webhook listing failed",
+			TransactionerFn: txGen.ThatDoesntExpectCommit,
+			WebhookServiceFn: func() *automock.WebhookService {
+				svc := &automock.WebhookService{}
+				svc.On("ListForRuntime", contextParam, runtimeID).Return(nil, testErr).Once()
+				return svc
+			},
+			WebhookConverterFn: func() *automock.WebhookConverter {
+				return &automock.WebhookConverter{}
+			},
+			ExpectedErr: testErr,
+		},
+		{
+			Name:            "Returns error when transaction starting failed",
+			TransactionerFn: txGen.ThatFailsOnBegin,
+			WebhookServiceFn: func() *automock.WebhookService {
+				return &automock.WebhookService{}
+			},
+			WebhookConverterFn: func() *automock.WebhookConverter {
+				return &automock.WebhookConverter{}
+			},
+			ExpectedErr: testErr,
+		},
+		{
+			Name:            "Returns error when transaction commit failed",
+			TransactionerFn: txGen.ThatFailsOnCommit,
+			WebhookServiceFn: func() *automock.WebhookService {
+				svc := &automock.WebhookService{}
+				svc.On("ListForRuntime", contextParam, runtimeID).Return(modelWebhooks, nil).Once()
+				return svc
+			},
+			WebhookConverterFn: func() *automock.WebhookConverter {
+				return &automock.WebhookConverter{}
+			},
+			ExpectedErr: testErr,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			persistTx, transact := testCase.TransactionerFn()
+			webhookSvc := testCase.WebhookServiceFn()
+			webhookConverter := testCase.WebhookConverterFn()
+
+			resolver := runtime.NewResolver(transact, nil, nil, nil, nil, nil, nil, nil, webhookSvc, webhookConverter)
+
+			// when
+			result, err := resolver.Webhooks(context.TODO(), gqlRuntime)
+
+			// then
+			assert.Equal(t, testCase.ExpectedResult, result)
+			assert.Equal(t, testCase.ExpectedErr, err)
+
+			mock.AssertExpectationsForObjects(t, transact, persistTx, webhookSvc, webhookConverter)
+		})
+	}
+
+	t.Run("Returns error when runtime is nil", func(t *testing.T) {
+		resolver := runtime.NewResolver(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+		// when
+		_, err := resolver.Webhooks(context.TODO(), nil)
+
+		// then
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "Runtime cannot be empty")
+	})
+}
@@ -968,7 +968,7 @@ func TestService_List(t *testing.T) {
 			Name: "Success",
 			RepositoryFn: func() *automock.RuntimeRepository {
 				repo := &automock.RuntimeRepository{}
-				repo.On("List", ctx, tnt, filter, first, after).Return(runtimePage, nil).Once()
+				repo.On("List", ctx, tnt, filter, pagination.Request{PageSize: first, Cursor: after}, model.DefaultRuntimeOrderBy).Return(runtimePage, nil).Once()
 				return repo
 			},
 			InputLabelFilters:  filter,
@@ -981,7 +981,7 @@ func TestService_List(t *testing.T) {
 			Name: "Returns error when runtime listing failed",
 			RepositoryFn: func() *automock.RuntimeRepository {
 				repo := &automock.RuntimeRepository{}
-				repo.On("List", ctx, tnt, filter, first, after).Return(nil, testErr).Once()
+				repo.On("List", ctx, tnt, filter, pagination.Request{PageSize: first, Cursor: after}, model.DefaultRuntimeOrderBy).Return(nil, testErr).Once()
 				return repo
 			},
 			InputLabelFilters:  filter,
@@ -1023,7 +1023,7 @@ func TestService_List(t *testing.T) {
 			svc := runtime.NewService(repo, nil, nil, nil, nil, nil, "")
 
 			// when
-			rtm, err := svc.List(ctx, testCase.InputLabelFilters, testCase.InputPageSize, testCase.InputCursor)
+			rtm, err := svc.List(ctx, testCase.InputLabelFilters, pagination.Request{PageSize: testCase.InputPageSize, Cursor: testCase.InputCursor}, model.DefaultRuntimeOrderBy)
 
 			// then
 			if testCase.ExpectedErrMessage == "" {
@@ -1041,7 +1041,7 @@ func TestService_List(t *testing.T) {
 		// given
 		svc := runtime.NewService(nil, nil, nil, nil, nil, nil, "")
 		// when
-		_, err := svc.List(context.TODO(), nil, 1, "")
+		_, err := svc.List(context.TODO(), nil, pagination.Request{PageSize: 1}, model.DefaultRuntimeOrderBy)
 		// then
 		require.Error(t, err)
 		assert.EqualError(t, err, "while loading tenant from context: cannot read tenant from context")
@@ -13,6 +13,7 @@ import (
 
 	"github.com/kyma-incubator/compass/components/director/internal/labelfilter"
 
+	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
 	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
 
 	"github.com/DATA-DOG/go-sqlmock"
@@ -235,7 +236,7 @@ func TestPgRepository_List(t *testing.T) {
 		ctx := persistence.SaveToContext(context.TODO(), sqlxDB)
 		pgRepository := runtime.NewRepository()
 		//THEN
-		_, err := pgRepository.List(ctx, tenantID, nil, 2, convertIntToBase64String(-3))
+		_, err := pgRepository.List(ctx, tenantID, nil, pagination.Request{PageSize: 2, Cursor: convertIntToBase64String(-3)}, model.DefaultRuntimeOrderBy)
 
 		//THEN
 		require.EqualError(t, err, "while decoding page cursor: Invalid data [reason=cursor is not correct]")
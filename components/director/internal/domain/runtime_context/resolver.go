@@ -90,9 +90,10 @@ func (r *Resolver) RuntimeContexts(ctx context.Context, filter []*graphql.LabelF
 		Data:       gqlRuntimeContexts,
 		TotalCount: runtimeContextsPage.TotalCount,
 		PageInfo: &graphql.PageInfo{
-			StartCursor: graphql.PageCursor(runtimeContextsPage.PageInfo.StartCursor),
-			EndCursor:   graphql.PageCursor(runtimeContextsPage.PageInfo.EndCursor),
-			HasNextPage: runtimeContextsPage.PageInfo.HasNextPage,
+			StartCursor:     graphql.PageCursor(runtimeContextsPage.PageInfo.StartCursor),
+			EndCursor:       graphql.PageCursor(runtimeContextsPage.PageInfo.EndCursor),
+			HasNextPage:     runtimeContextsPage.PageInfo.HasNextPage,
+			HasPreviousPage: runtimeContextsPage.PageInfo.HasPreviousPage,
 		},
 	}, nil
 }
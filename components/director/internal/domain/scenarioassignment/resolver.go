@@ -151,9 +151,10 @@ func (r *Resolver) AutomaticScenarioAssignments(ctx context.Context, first *int,
 		Data:       gqlApps,
 		TotalCount: page.TotalCount,
 		PageInfo: &graphql.PageInfo{
-			StartCursor: graphql.PageCursor(page.PageInfo.StartCursor),
-			EndCursor:   graphql.PageCursor(page.PageInfo.EndCursor),
-			HasNextPage: page.PageInfo.HasNextPage,
+			StartCursor:     graphql.PageCursor(page.PageInfo.StartCursor),
+			EndCursor:       graphql.PageCursor(page.PageInfo.EndCursor),
+			HasNextPage:     page.PageInfo.HasNextPage,
+			HasPreviousPage: page.PageInfo.HasPreviousPage,
 		},
 	}, nil
 }
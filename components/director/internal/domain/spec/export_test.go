@@ -1,7 +1,19 @@
 package spec
 
-import "time"
+import (
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+)
 
 func (s *service) SetTimestampGen(timestampGen func() time.Time) {
 	s.timestampGen = timestampGen
 }
+
+func ValidateSpec(spec *model.Spec) error {
+	return validateSpec(spec)
+}
+
+func ConvertToJSON(spec *model.Spec) error {
+	return convertToJSON(spec)
+}
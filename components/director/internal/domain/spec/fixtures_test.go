@@ -1,7 +1,10 @@
 package spec_test
 
 import (
+	"crypto/sha256"
 	"database/sql/driver"
+	"encoding/hex"
+	"strings"
 
 	"github.com/kyma-incubator/compass/components/director/internal/domain/spec"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
@@ -15,10 +18,13 @@ const (
 	apiID          = "apiID"
 	eventID        = "eventID"
 	externalTenant = "externalTenant"
+
+	apiSpecData   = `<edmx:Edmx xmlns:edmx="http://docs.oasis-open.org/odata/ns/edmx" Version="4.0"><edmx:DataServices></edmx:DataServices></edmx:Edmx>`
+	eventSpecData = `{"asyncapi":"2.0.0","info":{"title":"Events","version":"1.0.0"},"channels":{}}`
 )
 
 func fixModelAPISpec() *model.Spec {
-	var specData = "specData"
+	var specData = apiSpecData
 	var apiType = model.APISpecTypeOdata
 	return &model.Spec{
 		ID:         specID,
@@ -32,7 +38,7 @@ func fixModelAPISpec() *model.Spec {
 }
 
 func fixModelAPISpecWithID(id string) *model.Spec {
-	var specData = "specData"
+	var specData = apiSpecData
 	var apiType = model.APISpecTypeOdata
 	return &model.Spec{
 		ID:         id,
@@ -46,7 +52,7 @@ func fixModelAPISpecWithID(id string) *model.Spec {
 }
 
 func fixModelEventSpec() *model.Spec {
-	var specData = "specData"
+	var specData = eventSpecData
 	var eventType = model.EventSpecTypeAsyncAPI
 	return &model.Spec{
 		ID:         specID,
@@ -60,7 +66,7 @@ func fixModelEventSpec() *model.Spec {
 }
 
 func fixModelEventSpecWithID(id string) *model.Spec {
-	var specData = "specData"
+	var specData = eventSpecData
 	var eventType = model.EventSpecTypeAsyncAPI
 	return &model.Spec{
 		ID:         id,
@@ -74,7 +80,7 @@ func fixModelEventSpecWithID(id string) *model.Spec {
 }
 
 func fixGQLAPISpec() *graphql.APISpec {
-	var specData = "specData"
+	var specData = apiSpecData
 	clob := graphql.CLOB(specData)
 	return &graphql.APISpec{
 		ID:           specID,
@@ -86,7 +92,7 @@ func fixGQLAPISpec() *graphql.APISpec {
 }
 
 func fixGQLEventSpec() *graphql.EventSpec {
-	var specData = "specData"
+	var specData = eventSpecData
 	clob := graphql.CLOB(specData)
 	return &graphql.EventSpec{
 		ID:           specID,
@@ -98,7 +104,7 @@ func fixGQLEventSpec() *graphql.EventSpec {
 }
 
 func fixModelAPISpecInput() *model.SpecInput {
-	var specData = "specData"
+	var specData = apiSpecData
 	var apiType = model.APISpecTypeOdata
 	return &model.SpecInput{
 		Data:    &specData,
@@ -108,7 +114,7 @@ func fixModelAPISpecInput() *model.SpecInput {
 }
 
 func fixModelEventSpecInput() *model.SpecInput {
-	var specData = "specData"
+	var specData = eventSpecData
 	var eventType = model.EventSpecTypeAsyncAPI
 	return &model.SpecInput{
 		Data:      &specData,
@@ -118,7 +124,7 @@ func fixModelEventSpecInput() *model.SpecInput {
 }
 
 func fixModelAPISpecInputWithFetchRequest() *model.SpecInput {
-	var specData = "specData"
+	var specData = apiSpecData
 	var apiType = model.APISpecTypeOdata
 	return &model.SpecInput{
 		Data: &specData,
@@ -131,7 +137,7 @@ func fixModelAPISpecInputWithFetchRequest() *model.SpecInput {
 }
 
 func fixModelEventSpecInputWithFetchRequest() *model.SpecInput {
-	var specData = "specData"
+	var specData = eventSpecData
 	var eventType = model.EventSpecTypeAsyncAPI
 	return &model.SpecInput{
 		Data: &specData,
@@ -144,7 +150,7 @@ func fixModelEventSpecInputWithFetchRequest() *model.SpecInput {
 }
 
 func fixGQLAPISpecInput() *graphql.APISpecInput {
-	var specData = "specData"
+	var specData = apiSpecData
 	clob := graphql.CLOB(specData)
 	return &graphql.APISpecInput{
 		Data:   &clob,
@@ -154,7 +160,7 @@ func fixGQLAPISpecInput() *graphql.APISpecInput {
 }
 
 func fixGQLAPISpecInputWithFetchRequest() *graphql.APISpecInput {
-	var specData = "specData"
+	var specData = apiSpecData
 	clob := graphql.CLOB(specData)
 	return &graphql.APISpecInput{
 		Data: &clob,
@@ -167,7 +173,7 @@ func fixGQLAPISpecInputWithFetchRequest() *graphql.APISpecInput {
 }
 
 func fixGQLEventSpecInput() *graphql.EventSpecInput {
-	var specData = "specData"
+	var specData = eventSpecData
 	clob := graphql.CLOB(specData)
 	return &graphql.EventSpecInput{
 		Data:   &clob,
@@ -177,7 +183,7 @@ func fixGQLEventSpecInput() *graphql.EventSpecInput {
 }
 
 func fixGQLEventSpecInputWithFetchRequest() *graphql.EventSpecInput {
-	var specData = "specData"
+	var specData = eventSpecData
 	clob := graphql.CLOB(specData)
 	return &graphql.EventSpecInput{
 		Data: &clob,
@@ -194,19 +200,19 @@ func fixSpecColumns() []string {
 }
 
 func fixAPISpecRow() []driver.Value {
-	return []driver.Value{specID, tenant, apiID, nil, "specData", "XML", "ODATA", nil, nil, nil}
+	return []driver.Value{specID, tenant, apiID, nil, apiSpecData, "XML", "ODATA", nil, nil, nil}
 }
 
 func fixAPISpecRowWithID(id string) []driver.Value {
-	return []driver.Value{id, tenant, apiID, nil, "specData", "XML", "ODATA", nil, nil, nil}
+	return []driver.Value{id, tenant, apiID, nil, apiSpecData, "XML", "ODATA", nil, nil, nil}
 }
 
 func fixEventSpecRow() []driver.Value {
-	return []driver.Value{specID, tenant, nil, eventID, "specData", nil, nil, "JSON", "ASYNC_API", nil}
+	return []driver.Value{specID, tenant, nil, eventID, eventSpecData, nil, nil, "JSON", "ASYNC_API", nil}
 }
 
 func fixEventSpecRowWithID(id string) []driver.Value {
-	return []driver.Value{id, tenant, nil, eventID, "specData", nil, nil, "JSON", "ASYNC_API", nil}
+	return []driver.Value{id, tenant, nil, eventID, eventSpecData, nil, nil, "JSON", "ASYNC_API", nil}
 }
 
 func fixAPISpecCreateArgs(spec *model.Spec) []driver.Value {
@@ -222,7 +228,7 @@ func fixAPISpecEntity() spec.Entity {
 		ID:            specID,
 		TenantID:      tenant,
 		APIDefID:      repo.NewValidNullableString(apiID),
-		SpecData:      repo.NewValidNullableString("specData"),
+		SpecData:      repo.NewValidNullableString(apiSpecData),
 		APISpecFormat: repo.NewValidNullableString("XML"),
 		APISpecType:   repo.NewValidNullableString(string(model.APISpecTypeOdata)),
 	}
@@ -233,7 +239,7 @@ func fixAPISpecEntityWithID(id string) spec.Entity {
 		ID:            id,
 		TenantID:      tenant,
 		APIDefID:      repo.NewValidNullableString(apiID),
-		SpecData:      repo.NewValidNullableString("specData"),
+		SpecData:      repo.NewValidNullableString(apiSpecData),
 		APISpecFormat: repo.NewValidNullableString("XML"),
 		APISpecType:   repo.NewValidNullableString(string(model.APISpecTypeOdata)),
 	}
@@ -244,7 +250,7 @@ func fixEventSpecEntity() spec.Entity {
 		ID:              specID,
 		TenantID:        tenant,
 		EventAPIDefID:   repo.NewValidNullableString(eventID),
-		SpecData:        repo.NewValidNullableString("specData"),
+		SpecData:        repo.NewValidNullableString(eventSpecData),
 		EventSpecType:   repo.NewValidNullableString(string(model.EventSpecTypeAsyncAPI)),
 		EventSpecFormat: repo.NewValidNullableString("JSON"),
 	}
@@ -255,8 +261,24 @@ func fixEventSpecEntityWithID(id string) spec.Entity {
 		ID:              id,
 		TenantID:        tenant,
 		EventAPIDefID:   repo.NewValidNullableString(eventID),
-		SpecData:        repo.NewValidNullableString("specData"),
+		SpecData:        repo.NewValidNullableString(eventSpecData),
 		EventSpecType:   repo.NewValidNullableString(string(model.EventSpecTypeAsyncAPI)),
 		EventSpecFormat: repo.NewValidNullableString("JSON"),
 	}
 }
+
+func isInvalidSpecStatus(fr *model.FetchRequest) bool {
+	return fr.Status != nil && fr.Status.Condition == model.FetchRequestStatusConditionFailed &&
+		fr.Status.Message != nil && strings.HasPrefix(*fr.Status.Message, "Invalid Spec:")
+}
+
+func fixSpecHash(spec string) string {
+	sum := sha256.Sum256([]byte(spec))
+	return hex.EncodeToString(sum[:])
+}
+
+func hasSpecHashOf(data string) func(fr *model.FetchRequest) bool {
+	return func(fr *model.FetchRequest) bool {
+		return fr.SpecHash != nil && *fr.SpecHash == fixSpecHash(data)
+	}
+}
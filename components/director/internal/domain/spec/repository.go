@@ -109,6 +109,40 @@ func (r *repository) ListByReferenceObjectID(ctx context.Context, tenant string,
 	return items, nil
 }
 
+// ListByReferenceObjectIDs returns the specifications of all of the objects of the given type, in the order in which they were created
+func (r *repository) ListByReferenceObjectIDs(ctx context.Context, tenant string, objectType model.SpecReferenceObjectType, objectIDs []string) ([]*model.Spec, error) {
+	if len(objectIDs) == 0 {
+		return nil, nil
+	}
+
+	fieldName, err := r.referenceObjectFieldName(objectType)
+	if err != nil {
+		return nil, err
+	}
+	conditions := repo.Conditions{
+		repo.NewInConditionForStringValues(fieldName, objectIDs),
+	}
+
+	var specCollection specCollection
+	err = r.lister.List(ctx, tenant, &specCollection, conditions...)
+	if err != nil {
+		return nil, err
+	}
+
+	var items []*model.Spec
+
+	for _, specEnt := range specCollection {
+		m, err := r.conv.FromEntity(specEnt)
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, &m)
+	}
+
+	return items, nil
+}
+
 func (r *repository) Delete(ctx context.Context, tenant, id string) error {
 	return r.deleter.DeleteOne(ctx, tenant, repo.Conditions{repo.NewEqualCondition("id", id)})
 }
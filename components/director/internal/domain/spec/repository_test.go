@@ -11,6 +11,7 @@ import (
 	"github.com/kyma-incubator/compass/components/director/internal/model"
 	"github.com/kyma-incubator/compass/components/director/internal/repo/testdb"
 	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -202,6 +203,71 @@ func TestRepository_ListByReferenceObjectID(t *testing.T) {
 	})
 }
 
+func TestRepository_ListByReferenceObjectIDs(t *testing.T) {
+	// GIVEN
+	anotherAPIID := "ccccccccc-cccc-cccc-cccc-cccccccccccc"
+
+	t.Run("Success for APIs", func(t *testing.T) {
+		firstSpecID := "111111111-1111-1111-1111-111111111111"
+		firstSpecEntity := fixAPISpecEntityWithID(firstSpecID)
+		secondSpecID := "222222222-2222-2222-2222-222222222222"
+		secondSpecEntity := fixAPISpecEntityWithID(secondSpecID)
+
+		selectQuery := `^SELECT (.+) FROM public.specifications 
+		WHERE tenant_id = \$1 AND api_def_id IN \(\$2, \$3\)
+		ORDER BY created_at`
+
+		sqlxDB, sqlMock := testdb.MockDatabase(t)
+		rows := sqlmock.NewRows(fixSpecColumns()).
+			AddRow(fixAPISpecRowWithID(firstSpecID)...).
+			AddRow(fixAPISpecRowWithID(secondSpecID)...)
+
+		sqlMock.ExpectQuery(selectQuery).
+			WithArgs(tenant, apiID, anotherAPIID).
+			WillReturnRows(rows)
+
+		ctx := persistence.SaveToContext(context.TODO(), sqlxDB)
+		convMock := &automock.Converter{}
+		convMock.On("FromEntity", firstSpecEntity).Return(*fixModelAPISpecWithID(firstSpecID), nil)
+		convMock.On("FromEntity", secondSpecEntity).Return(*fixModelAPISpecWithID(secondSpecID), nil)
+		pgRepository := spec.NewRepository(convMock)
+		// WHEN
+		modelSpecs, err := pgRepository.ListByReferenceObjectIDs(ctx, tenant, model.APISpecReference, []string{apiID, anotherAPIID})
+		//THEN
+		require.NoError(t, err)
+		require.Len(t, modelSpecs, 2)
+		assert.Equal(t, firstSpecID, modelSpecs[0].ID)
+		assert.Equal(t, secondSpecID, modelSpecs[1].ID)
+		convMock.AssertExpectations(t)
+		sqlMock.AssertExpectations(t)
+	})
+
+	t.Run("Success when no object IDs are given", func(t *testing.T) {
+		pgRepository := spec.NewRepository(nil)
+		// WHEN
+		modelSpecs, err := pgRepository.ListByReferenceObjectIDs(context.TODO(), tenant, model.EventSpecReference, nil)
+		//THEN
+		require.NoError(t, err)
+		require.Empty(t, modelSpecs)
+	})
+
+	t.Run("Error when listing fails", func(t *testing.T) {
+		sqlxDB, sqlMock := testdb.MockDatabase(t)
+		sqlMock.ExpectQuery(`^SELECT (.+) FROM public.specifications`).
+			WithArgs(tenant, eventID).
+			WillReturnError(errors.New("persistence error"))
+
+		ctx := persistence.SaveToContext(context.TODO(), sqlxDB)
+		pgRepository := spec.NewRepository(nil)
+		// WHEN
+		_, err := pgRepository.ListByReferenceObjectIDs(ctx, tenant, model.EventSpecReference, []string{eventID})
+		//THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "Unexpected error while executing SQL query")
+		sqlMock.AssertExpectations(t)
+	})
+}
+
 func TestRepository_Delete(t *testing.T) {
 	sqlxDB, sqlMock := testdb.MockDatabase(t)
 	ctx := persistence.SaveToContext(context.TODO(), sqlxDB)
@@ -2,11 +2,15 @@ package spec
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 
 	"github.com/kyma-incubator/compass/components/director/internal/domain/tenant"
 	"github.com/kyma-incubator/compass/components/director/internal/timestamp"
 	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/kyma-incubator/compass/components/director/pkg/str"
 	"github.com/pkg/errors"
 
 	"github.com/kyma-incubator/compass/components/director/internal/model"
@@ -17,6 +21,7 @@ type SpecRepository interface {
 	Create(ctx context.Context, item *model.Spec) error
 	GetByID(ctx context.Context, tenantID string, id string) (*model.Spec, error)
 	ListByReferenceObjectID(ctx context.Context, tenant string, objectType model.SpecReferenceObjectType, objectID string) ([]*model.Spec, error)
+	ListByReferenceObjectIDs(ctx context.Context, tenant string, objectType model.SpecReferenceObjectType, objectIDs []string) ([]*model.Spec, error)
 	Delete(ctx context.Context, tenant, id string) error
 	DeleteByReferenceObjectID(ctx context.Context, tenant string, objectType model.SpecReferenceObjectType, objectID string) error
 	Update(ctx context.Context, item *model.Spec) error
@@ -28,6 +33,7 @@ type FetchRequestRepository interface {
 	Create(ctx context.Context, item *model.FetchRequest) error
 	GetByReferenceObjectID(ctx context.Context, tenant string, objectType model.FetchRequestReferenceObjectType, objectID string) (*model.FetchRequest, error)
 	DeleteByReferenceObjectID(ctx context.Context, tenant string, objectType model.FetchRequestReferenceObjectType, objectID string) error
+	Update(ctx context.Context, item *model.FetchRequest) error
 }
 
 //go:generate mockery -name=UIDService -output=automock -outpkg=automock -case=underscore
@@ -46,15 +52,19 @@ type service struct {
 	uidService          UIDService
 	fetchRequestService FetchRequestService
 	timestampGen        timestamp.Generator
+	validate            bool
+	convertToJSON       bool
 }
 
-func NewService(repo SpecRepository, fetchRequestRepo FetchRequestRepository, uidService UIDService, fetchRequestService FetchRequestService) *service {
+func NewService(repo SpecRepository, fetchRequestRepo FetchRequestRepository, uidService UIDService, fetchRequestService FetchRequestService, validate, convertToJSON bool) *service {
 	return &service{
 		repo:                repo,
 		fetchRequestRepo:    fetchRequestRepo,
 		uidService:          uidService,
 		fetchRequestService: fetchRequestService,
 		timestampGen:        timestamp.DefaultGenerator(),
+		validate:            validate,
+		convertToJSON:       convertToJSON,
 	}
 }
 
@@ -67,6 +77,27 @@ func (s *service) ListByReferenceObjectID(ctx context.Context, objectType model.
 	return s.repo.ListByReferenceObjectID(ctx, tnt, objectType, objectID)
 }
 
+// ListByReferenceObjectIDs returns the Specifications of every object, in the order in which they were created, grouped by the ID of the object.
+// Objects without a Specification are not included in the result.
+func (s *service) ListByReferenceObjectIDs(ctx context.Context, objectType model.SpecReferenceObjectType, objectIDs []string) (map[string][]*model.Spec, error) {
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	specs, err := s.repo.ListByReferenceObjectIDs(ctx, tnt, objectType, objectIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	specsByObjectID := make(map[string][]*model.Spec, len(objectIDs))
+	for _, spec := range specs {
+		specsByObjectID[spec.ObjectID] = append(specsByObjectID[spec.ObjectID], spec)
+	}
+
+	return specsByObjectID, nil
+}
+
 // Until now APIs and Events had embedded specification in them, we will model this behavior by relying that the first created spec is the one which GraphQL expects
 func (s *service) GetByReferenceObjectID(ctx context.Context, objectType model.SpecReferenceObjectType, objectID string) (*model.Spec, error) {
 	tnt, err := tenant.LoadFromContext(ctx)
@@ -86,6 +117,29 @@ func (s *service) GetByReferenceObjectID(ctx context.Context, objectType model.S
 	return nil, nil
 }
 
+// GetByReferenceObjectIDs returns the first created Specification of every object, the same way as GetByReferenceObjectID, grouped by the ID of the object.
+// Objects without a Specification are not included in the result.
+func (s *service) GetByReferenceObjectIDs(ctx context.Context, objectType model.SpecReferenceObjectType, objectIDs []string) (map[string]*model.Spec, error) {
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	specs, err := s.repo.ListByReferenceObjectIDs(ctx, tnt, objectType, objectIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	specsByObjectID := make(map[string]*model.Spec, len(objectIDs))
+	for _, spec := range specs {
+		if _, ok := specsByObjectID[spec.ObjectID]; !ok {
+			specsByObjectID[spec.ObjectID] = spec
+		}
+	}
+
+	return specsByObjectID, nil
+}
+
 func (s *service) CreateByReferenceObjectID(ctx context.Context, in model.SpecInput, objectType model.SpecReferenceObjectType, objectID string) (string, error) {
 	tnt, err := tenant.LoadFromContext(ctx)
 	if err != nil {
@@ -98,6 +152,10 @@ func (s *service) CreateByReferenceObjectID(ctx context.Context, in model.SpecIn
 		return "", err
 	}
 
+	if err := s.normalize(spec); err != nil {
+		return "", err
+	}
+
 	err = s.repo.Create(ctx, spec)
 	if err != nil {
 		return "", errors.Wrapf(err, "while creating spec for %q with id %q", objectType, objectID)
@@ -109,7 +167,7 @@ func (s *service) CreateByReferenceObjectID(ctx context.Context, in model.SpecIn
 			return "", errors.Wrapf(err, "while creating FetchRequest for %s Specification with id %q", objectType, id)
 		}
 
-		spec.Data = s.fetchRequestService.HandleSpec(ctx, fr)
+		s.handleFetchedSpec(ctx, spec, fr)
 
 		err = s.repo.Update(ctx, spec)
 		if err != nil {
@@ -141,13 +199,17 @@ func (s *service) UpdateByReferenceObjectID(ctx context.Context, id string, in m
 		return err
 	}
 
+	if err := s.normalize(spec); err != nil {
+		return err
+	}
+
 	if in.Data == nil && in.FetchRequest != nil {
 		fr, err := s.createFetchRequest(ctx, tnt, *in.FetchRequest, id)
 		if err != nil {
 			return errors.Wrapf(err, "while creating FetchRequest for %s Specification with id %q", objectType, id)
 		}
 
-		spec.Data = s.fetchRequestService.HandleSpec(ctx, fr)
+		s.handleFetchedSpec(ctx, spec, fr)
 	}
 
 	err = s.repo.Update(ctx, spec)
@@ -198,7 +260,7 @@ func (s *service) RefetchSpec(ctx context.Context, id string) (*model.Spec, erro
 	}
 
 	if fetchRequest != nil {
-		spec.Data = s.fetchRequestService.HandleSpec(ctx, fetchRequest)
+		s.handleFetchedSpec(ctx, spec, fetchRequest)
 	}
 
 	err = s.repo.Update(ctx, spec)
@@ -209,6 +271,45 @@ func (s *service) RefetchSpec(ctx context.Context, id string) (*model.Spec, erro
 	return spec, nil
 }
 
+// RefreshSpec refetches the Specification and stores it only if its content has changed since the last fetch.
+// Unlike RefetchSpec, the previously fetched data is kept if the Specification cannot be fetched or is invalid.
+// The returned flag reports whether the content differs from the one fetched previously.
+func (s *service) RefreshSpec(ctx context.Context, id string) (*model.Spec, bool, error) {
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+
+	spec, err := s.repo.GetByID(ctx, tnt, id)
+	if err != nil {
+		return nil, false, err
+	}
+
+	fetchRequest, err := s.fetchRequestRepo.GetByReferenceObjectID(ctx, tnt, model.SpecFetchRequestReference, id)
+	if err != nil {
+		return nil, false, errors.Wrapf(err, "while getting FetchRequest for Specification with id %q", id)
+	}
+
+	previousData := spec.Data
+	previousHash := fetchRequest.SpecHash
+	s.handleFetchedSpec(ctx, spec, fetchRequest)
+	if spec.Data == nil {
+		spec.Data = previousData
+		return spec, false, nil
+	}
+
+	if previousHash != nil && fetchRequest.SpecHash != nil && *previousHash == *fetchRequest.SpecHash {
+		return spec, false, nil
+	}
+
+	err = s.repo.Update(ctx, spec)
+	if err != nil {
+		return nil, false, errors.Wrapf(err, "while updating Specification with id %q", id)
+	}
+
+	return spec, previousHash != nil, nil
+}
+
 func (s *service) GetFetchRequest(ctx context.Context, specID string) (*model.FetchRequest, error) {
 	tnt, err := tenant.LoadFromContext(ctx)
 	if err != nil {
@@ -241,3 +342,60 @@ func (s *service) createFetchRequest(ctx context.Context, tenant string, in mode
 
 	return fr, nil
 }
+
+// normalize validates the specification data and converts it to the canonical format, each if enabled.
+// Conversion needs to parse the data, so data which is not well-formed is rejected even if validation is disabled.
+func (s *service) normalize(spec *model.Spec) error {
+	if s.validate {
+		if err := validateSpec(spec); err != nil {
+			return err
+		}
+	}
+
+	if s.convertToJSON {
+		if err := convertToJSON(spec); err != nil {
+			return apperrors.NewInvalidDataError("invalid %s specification: %s", specTypeName(spec), err.Error())
+		}
+	}
+
+	return nil
+}
+
+// handleFetchedSpec fetches the specification data and validates it. Invalid data is not stored and the reason is reported in the FetchRequest status.
+func (s *service) handleFetchedSpec(ctx context.Context, spec *model.Spec, fr *model.FetchRequest) {
+	spec.Data = s.fetchRequestService.HandleSpec(ctx, fr)
+	if spec.Data == nil {
+		return
+	}
+
+	if err := s.normalize(spec); err != nil {
+		log.C(ctx).WithError(err).Errorf("Fetched Specification with id %q is invalid", spec.ID)
+		spec.Data = nil
+		fr.Status = &model.FetchRequestStatus{
+			Condition: model.FetchRequestStatusConditionFailed,
+			Message:   str.Ptr(fmt.Sprintf("Invalid Spec: %s", err.Error())),
+			Timestamp: s.timestampGen(),
+		}
+	} else if !trackSpecHash(fr, *spec.Data) {
+		return
+	}
+
+	if err := s.fetchRequestRepo.Update(ctx, fr); err != nil {
+		log.C(ctx).WithError(err).Errorf("An error has occurred while updating fetch request status.")
+	}
+}
+
+// trackSpecHash stores the hash of the valid Spec in the fetch request and records the time of the change if the hash differs from the previous one.
+// It reports whether the fetch request has been modified.
+func trackSpecHash(fr *model.FetchRequest, data string) bool {
+	sum := sha256.Sum256([]byte(data))
+	hash := hex.EncodeToString(sum[:])
+	if fr.SpecHash != nil && *fr.SpecHash == hash {
+		return false
+	}
+
+	changedAt := fr.Status.Timestamp
+	fr.SpecHash = &hash
+	fr.SpecChangedAt = &changedAt
+	return true
+}
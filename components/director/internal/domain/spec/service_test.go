@@ -12,8 +12,10 @@ import (
 	"github.com/kyma-incubator/compass/components/director/internal/model"
 	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
 	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/kyma-incubator/compass/components/director/pkg/str"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
 
@@ -62,7 +64,7 @@ func TestService_ListByReferenceObjectID(t *testing.T) {
 		t.Run(testCase.Name, func(t *testing.T) {
 			repo := testCase.RepositoryFn()
 
-			svc := spec.NewService(repo, nil, nil, nil)
+			svc := spec.NewService(repo, nil, nil, nil, true, false)
 
 			// when
 			docs, err := svc.ListByReferenceObjectID(ctx, model.APISpecReference, apiID)
@@ -80,7 +82,7 @@ func TestService_ListByReferenceObjectID(t *testing.T) {
 		})
 	}
 	t.Run("Error when tenant not in context", func(t *testing.T) {
-		svc := spec.NewService(nil, nil, nil, nil)
+		svc := spec.NewService(nil, nil, nil, nil, true, false)
 		// WHEN
 		_, err := svc.ListByReferenceObjectID(context.TODO(), model.APISpecReference, apiID)
 		// THEN
@@ -134,7 +136,7 @@ func TestService_DeleteByReferenceObjectID(t *testing.T) {
 		t.Run(testCase.Name, func(t *testing.T) {
 			repo := testCase.RepositoryFn()
 
-			svc := spec.NewService(repo, nil, nil, nil)
+			svc := spec.NewService(repo, nil, nil, nil, true, false)
 
 			// when
 			err := svc.DeleteByReferenceObjectID(ctx, model.APISpecReference, apiID)
@@ -151,7 +153,7 @@ func TestService_DeleteByReferenceObjectID(t *testing.T) {
 		})
 	}
 	t.Run("Error when tenant not in context", func(t *testing.T) {
-		svc := spec.NewService(nil, nil, nil, nil)
+		svc := spec.NewService(nil, nil, nil, nil, true, false)
 		// WHEN
 		err := svc.DeleteByReferenceObjectID(context.TODO(), model.APISpecReference, apiID)
 		// THEN
@@ -214,7 +216,7 @@ func TestService_GetByReferenceObjectID(t *testing.T) {
 		t.Run(testCase.Name, func(t *testing.T) {
 			repo := testCase.RepositoryFn()
 
-			svc := spec.NewService(repo, nil, nil, nil)
+			svc := spec.NewService(repo, nil, nil, nil, true, false)
 
 			// when
 			docs, err := svc.GetByReferenceObjectID(ctx, model.APISpecReference, apiID)
@@ -232,7 +234,7 @@ func TestService_GetByReferenceObjectID(t *testing.T) {
 		})
 	}
 	t.Run("Error when tenant not in context", func(t *testing.T) {
-		svc := spec.NewService(nil, nil, nil, nil)
+		svc := spec.NewService(nil, nil, nil, nil, true, false)
 		// WHEN
 		_, err := svc.GetByReferenceObjectID(context.TODO(), model.APISpecReference, apiID)
 		// THEN
@@ -241,6 +243,186 @@ func TestService_GetByReferenceObjectID(t *testing.T) {
 	})
 }
 
+func TestService_ListByReferenceObjectIDs(t *testing.T) {
+	// given
+	testErr := errors.New("Test error")
+
+	anotherAPIID := "ccccccccc-cccc-cccc-cccc-cccccccccccc"
+	anotherAPISpec := fixModelAPISpecWithID("333333333-3333-3333-3333-333333333333")
+	anotherAPISpec.ObjectID = anotherAPIID
+
+	specs := []*model.Spec{
+		fixModelAPISpecWithID("111111111-1111-1111-1111-111111111111"),
+		anotherAPISpec,
+		fixModelAPISpecWithID("222222222-2222-2222-2222-222222222222"),
+	}
+
+	ctx := context.TODO()
+	ctx = tnt.SaveToContext(ctx, tenant, externalTenant)
+
+	objectIDs := []string{apiID, anotherAPIID}
+
+	testCases := []struct {
+		Name               string
+		RepositoryFn       func() *automock.SpecRepository
+		ExpectedResult     map[string][]*model.Spec
+		ExpectedErrMessage string
+	}{
+		{
+			Name: "Success",
+			RepositoryFn: func() *automock.SpecRepository {
+				repo := &automock.SpecRepository{}
+				repo.On("ListByReferenceObjectIDs", ctx, tenant, model.APISpecReference, objectIDs).Return(specs, nil).Once()
+				return repo
+			},
+			ExpectedResult: map[string][]*model.Spec{
+				apiID:        {specs[0], specs[2]},
+				anotherAPIID: {specs[1]},
+			},
+			ExpectedErrMessage: "",
+		},
+		{
+			Name: "Returns error when Specification listing failed",
+			RepositoryFn: func() *automock.SpecRepository {
+				repo := &automock.SpecRepository{}
+				repo.On("ListByReferenceObjectIDs", ctx, tenant, model.APISpecReference, objectIDs).Return(nil, testErr).Once()
+				return repo
+			},
+			ExpectedResult:     nil,
+			ExpectedErrMessage: testErr.Error(),
+		},
+		{
+			Name: "Returns empty map when no specs are found",
+			RepositoryFn: func() *automock.SpecRepository {
+				repo := &automock.SpecRepository{}
+				repo.On("ListByReferenceObjectIDs", ctx, tenant, model.APISpecReference, objectIDs).Return([]*model.Spec{}, nil).Once()
+				return repo
+			},
+			ExpectedResult: map[string][]*model.Spec{},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			repo := testCase.RepositoryFn()
+
+			svc := spec.NewService(repo, nil, nil, nil, true, false)
+
+			// when
+			result, err := svc.ListByReferenceObjectIDs(ctx, model.APISpecReference, objectIDs)
+
+			// then
+			if testCase.ExpectedErrMessage == "" {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.ExpectedResult, result)
+			} else {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErrMessage)
+			}
+
+			repo.AssertExpectations(t)
+		})
+	}
+	t.Run("Error when tenant not in context", func(t *testing.T) {
+		svc := spec.NewService(nil, nil, nil, nil, true, false)
+		// WHEN
+		_, err := svc.ListByReferenceObjectIDs(context.TODO(), model.APISpecReference, objectIDs)
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "cannot read tenant from context")
+	})
+}
+
+func TestService_GetByReferenceObjectIDs(t *testing.T) {
+	// given
+	testErr := errors.New("Test error")
+
+	anotherAPIID := "ccccccccc-cccc-cccc-cccc-cccccccccccc"
+	anotherAPISpec := fixModelAPISpecWithID("333333333-3333-3333-3333-333333333333")
+	anotherAPISpec.ObjectID = anotherAPIID
+
+	specs := []*model.Spec{
+		fixModelAPISpecWithID("111111111-1111-1111-1111-111111111111"),
+		anotherAPISpec,
+		fixModelAPISpecWithID("222222222-2222-2222-2222-222222222222"),
+	}
+
+	ctx := context.TODO()
+	ctx = tnt.SaveToContext(ctx, tenant, externalTenant)
+
+	objectIDs := []string{apiID, anotherAPIID}
+
+	testCases := []struct {
+		Name               string
+		RepositoryFn       func() *automock.SpecRepository
+		ExpectedResult     map[string]*model.Spec
+		ExpectedErrMessage string
+	}{
+		{
+			Name: "Success",
+			RepositoryFn: func() *automock.SpecRepository {
+				repo := &automock.SpecRepository{}
+				repo.On("ListByReferenceObjectIDs", ctx, tenant, model.APISpecReference, objectIDs).Return(specs, nil).Once()
+				return repo
+			},
+			ExpectedResult: map[string]*model.Spec{
+				apiID:        specs[0],
+				anotherAPIID: specs[1],
+			},
+			ExpectedErrMessage: "",
+		},
+		{
+			Name: "Returns error when Specification listing failed",
+			RepositoryFn: func() *automock.SpecRepository {
+				repo := &automock.SpecRepository{}
+				repo.On("ListByReferenceObjectIDs", ctx, tenant, model.APISpecReference, objectIDs).Return(nil, testErr).Once()
+				return repo
+			},
+			ExpectedResult:     nil,
+			ExpectedErrMessage: testErr.Error(),
+		},
+		{
+			Name: "Returns empty map when no specs are found",
+			RepositoryFn: func() *automock.SpecRepository {
+				repo := &automock.SpecRepository{}
+				repo.On("ListByReferenceObjectIDs", ctx, tenant, model.APISpecReference, objectIDs).Return([]*model.Spec{}, nil).Once()
+				return repo
+			},
+			ExpectedResult: map[string]*model.Spec{},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			repo := testCase.RepositoryFn()
+
+			svc := spec.NewService(repo, nil, nil, nil, true, false)
+
+			// when
+			result, err := svc.GetByReferenceObjectIDs(ctx, model.APISpecReference, objectIDs)
+
+			// then
+			if testCase.ExpectedErrMessage == "" {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.ExpectedResult, result)
+			} else {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErrMessage)
+			}
+
+			repo.AssertExpectations(t)
+		})
+	}
+	t.Run("Error when tenant not in context", func(t *testing.T) {
+		svc := spec.NewService(nil, nil, nil, nil, true, false)
+		// WHEN
+		_, err := svc.GetByReferenceObjectIDs(context.TODO(), model.APISpecReference, objectIDs)
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "cannot read tenant from context")
+	})
+}
+
 func TestService_CreateByReferenceObjectID(t *testing.T) {
 	// given
 	testErr := errors.New("Test error")
@@ -248,7 +430,7 @@ func TestService_CreateByReferenceObjectID(t *testing.T) {
 	ctx := context.TODO()
 	ctx = tnt.SaveToContext(ctx, tenant, externalTenant)
 
-	specData := "specData"
+	specData := apiSpecData
 
 	specInputWithFR := fixModelAPISpecInputWithFetchRequest()
 	specInputWithFR.Data = nil
@@ -270,6 +452,10 @@ func TestService_CreateByReferenceObjectID(t *testing.T) {
 		ObjectType: model.SpecFetchRequestReference,
 		ObjectID:   specID,
 	}
+	invalidFR := *fr
+
+	invalidSpecInput := fixModelAPISpecInput()
+	invalidSpecInput.Data = str.Ptr("specData")
 
 	testCases := []struct {
 		Name                  string
@@ -319,6 +505,7 @@ func TestService_CreateByReferenceObjectID(t *testing.T) {
 			FetchRequestRepoFn: func() *automock.FetchRequestRepository {
 				repo := &automock.FetchRequestRepository{}
 				repo.On("Create", ctx, fr).Return(nil).Once()
+				repo.On("Update", ctx, mock.MatchedBy(hasSpecHashOf(specData))).Return(nil).Once()
 
 				return repo
 			},
@@ -406,6 +593,52 @@ func TestService_CreateByReferenceObjectID(t *testing.T) {
 			Input:       *specInputWithFR,
 			ExpectedErr: testErr,
 		},
+		{
+			Name: "Error - invalid Spec",
+			RepositoryFn: func() *automock.SpecRepository {
+				return &automock.SpecRepository{}
+			},
+			FetchRequestRepoFn: func() *automock.FetchRequestRepository {
+				return &automock.FetchRequestRepository{}
+			},
+			UIDServiceFn: func() *automock.UIDService {
+				svc := &automock.UIDService{}
+				svc.On("Generate").Return(specID).Once()
+				return svc
+			},
+			FetchRequestServiceFn: func() *automock.FetchRequestService {
+				return &automock.FetchRequestService{}
+			},
+			Input:       *invalidSpecInput,
+			ExpectedErr: errors.New("invalid ODATA specification"),
+		},
+		{
+			Name: "Success - invalid fetched Spec is not stored",
+			RepositoryFn: func() *automock.SpecRepository {
+				repo := &automock.SpecRepository{}
+				repo.On("Create", ctx, specModel).Return(nil).Once()
+				repo.On("Update", ctx, specModel).Return(nil).Once()
+				return repo
+			},
+			FetchRequestRepoFn: func() *automock.FetchRequestRepository {
+				repo := &automock.FetchRequestRepository{}
+				repo.On("Create", ctx, &invalidFR).Return(nil).Once()
+				repo.On("Update", ctx, mock.MatchedBy(isInvalidSpecStatus)).Return(nil).Once()
+				return repo
+			},
+			UIDServiceFn: func() *automock.UIDService {
+				svc := &automock.UIDService{}
+				svc.On("Generate").Return(specID).Twice()
+				return svc
+			},
+			FetchRequestServiceFn: func() *automock.FetchRequestService {
+				svc := &automock.FetchRequestService{}
+				svc.On("HandleSpec", ctx, &invalidFR).Return(str.Ptr("<invalid"))
+				return svc
+			},
+			Input:       *specInputWithFR,
+			ExpectedErr: nil,
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -416,7 +649,7 @@ func TestService_CreateByReferenceObjectID(t *testing.T) {
 			uidService := testCase.UIDServiceFn()
 			fetchRequestService := testCase.FetchRequestServiceFn()
 
-			svc := spec.NewService(repo, fetchRequestRepo, uidService, fetchRequestService)
+			svc := spec.NewService(repo, fetchRequestRepo, uidService, fetchRequestService, true, false)
 			svc.SetTimestampGen(func() time.Time {
 				return timestamp
 			})
@@ -437,8 +670,26 @@ func TestService_CreateByReferenceObjectID(t *testing.T) {
 			uidService.AssertExpectations(t)
 		})
 	}
+	t.Run("Success - invalid Spec is stored when validation is disabled", func(t *testing.T) {
+		repo := &automock.SpecRepository{}
+		repo.On("Create", ctx, mock.MatchedBy(func(spec *model.Spec) bool {
+			return spec.ID == specID && spec.Data != nil && *spec.Data == "specData"
+		})).Return(nil).Once()
+		uidService := &automock.UIDService{}
+		uidService.On("Generate").Return(specID).Once()
+
+		svc := spec.NewService(repo, nil, uidService, nil, false, false)
+
+		// WHEN
+		result, err := svc.CreateByReferenceObjectID(ctx, *invalidSpecInput, model.APISpecReference, apiID)
+
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, specID, result)
+		mock.AssertExpectationsForObjects(t, repo, uidService)
+	})
 	t.Run("Error when tenant not in context", func(t *testing.T) {
-		svc := spec.NewService(nil, nil, nil, nil)
+		svc := spec.NewService(nil, nil, nil, nil, true, false)
 		// WHEN
 		_, err := svc.CreateByReferenceObjectID(context.TODO(), model.SpecInput{}, model.APISpecReference, apiID)
 		// THEN
@@ -454,7 +705,7 @@ func TestService_UpdateByReferenceObjectID(t *testing.T) {
 	ctx := context.TODO()
 	ctx = tnt.SaveToContext(ctx, tenant, externalTenant)
 
-	specData := "specData"
+	specData := apiSpecData
 
 	specInputWithFR := fixModelAPISpecInputWithFetchRequest()
 	specInputWithFR.Data = nil
@@ -499,6 +750,7 @@ func TestService_UpdateByReferenceObjectID(t *testing.T) {
 				repo := &automock.FetchRequestRepository{}
 				repo.On("DeleteByReferenceObjectID", ctx, tenant, model.SpecFetchRequestReference, specID).Return(nil).Once()
 				repo.On("Create", ctx, fr).Return(nil).Once()
+				repo.On("Update", ctx, mock.MatchedBy(hasSpecHashOf(specData))).Return(nil).Once()
 
 				return repo
 			},
@@ -628,7 +880,7 @@ func TestService_UpdateByReferenceObjectID(t *testing.T) {
 			uidSvc := testCase.UIDServiceFn()
 			fetchRequestSvc := testCase.FetchRequestServiceFn()
 
-			svc := spec.NewService(repo, fetchRequestRepo, uidSvc, fetchRequestSvc)
+			svc := spec.NewService(repo, fetchRequestRepo, uidSvc, fetchRequestSvc, true, false)
 			svc.SetTimestampGen(func() time.Time { return timestamp })
 
 			// when
@@ -648,7 +900,7 @@ func TestService_UpdateByReferenceObjectID(t *testing.T) {
 		})
 	}
 	t.Run("Error when tenant not in context", func(t *testing.T) {
-		svc := spec.NewService(nil, nil, nil, nil)
+		svc := spec.NewService(nil, nil, nil, nil, true, false)
 		// WHEN
 		err := svc.UpdateByReferenceObjectID(context.TODO(), "", model.SpecInput{}, model.APISpecReference, apiID)
 		// THEN
@@ -698,7 +950,7 @@ func TestService_Delete(t *testing.T) {
 			// given
 			repo := testCase.RepositoryFn()
 
-			svc := spec.NewService(repo, nil, nil, nil)
+			svc := spec.NewService(repo, nil, nil, nil, true, false)
 
 			// when
 			err := svc.Delete(ctx, testCase.InputID)
@@ -716,7 +968,7 @@ func TestService_Delete(t *testing.T) {
 	}
 
 	t.Run("Error when tenant not in context", func(t *testing.T) {
-		svc := spec.NewService(nil, nil, nil, nil)
+		svc := spec.NewService(nil, nil, nil, nil, true, false)
 		// WHEN
 		err := svc.Delete(context.TODO(), "")
 		// THEN
@@ -783,6 +1035,7 @@ func TestService_RefetchSpec(t *testing.T) {
 			FetchRequestRepoFn: func() *automock.FetchRequestRepository {
 				repo := &automock.FetchRequestRepository{}
 				repo.On("GetByReferenceObjectID", ctx, tenant, model.SpecFetchRequestReference, specID).Return(fr, nil)
+				repo.On("Update", ctx, mock.MatchedBy(hasSpecHashOf(dataBytes))).Return(nil).Once()
 				return repo
 			},
 			FetchRequestSvcFn: func() *automock.FetchRequestService {
@@ -856,7 +1109,7 @@ func TestService_RefetchSpec(t *testing.T) {
 			frRepo := testCase.FetchRequestRepoFn()
 			frSvc := testCase.FetchRequestSvcFn()
 
-			svc := spec.NewService(repo, frRepo, nil, frSvc)
+			svc := spec.NewService(repo, frRepo, nil, frSvc, true, false)
 
 			// when
 			result, err := svc.RefetchSpec(ctx, specID)
@@ -874,7 +1127,7 @@ func TestService_RefetchSpec(t *testing.T) {
 		})
 	}
 	t.Run("Error when tenant not in context", func(t *testing.T) {
-		svc := spec.NewService(nil, nil, nil, nil)
+		svc := spec.NewService(nil, nil, nil, nil, true, false)
 		// WHEN
 		_, err := svc.RefetchSpec(context.TODO(), "")
 		// THEN
@@ -883,6 +1136,204 @@ func TestService_RefetchSpec(t *testing.T) {
 	})
 }
 
+func TestService_RefreshSpec(t *testing.T) {
+	// given
+	testErr := errors.New("Test error")
+
+	ctx := context.TODO()
+	ctx = tnt.SaveToContext(ctx, tenant, externalTenant)
+
+	previousData := "<edmx:Edmx/>"
+	fetchedData := apiSpecData
+
+	fixSpecWithData := func(data string) *model.Spec {
+		spec := fixModelAPISpec()
+		spec.Data = &data
+		return spec
+	}
+
+	fixFetchRequest := func(hash *string) *model.FetchRequest {
+		return &model.FetchRequest{
+			ID: "frID",
+			Status: &model.FetchRequestStatus{
+				Condition: model.FetchRequestStatusConditionSucceeded,
+				Timestamp: time.Now(),
+			},
+			SpecHash: hash,
+		}
+	}
+
+	fetchedSpec := func(data *string) func() *automock.FetchRequestService {
+		return func() *automock.FetchRequestService {
+			svc := &automock.FetchRequestService{}
+			svc.On("HandleSpec", ctx, mock.Anything).Return(data).Once()
+			return svc
+		}
+	}
+
+	testCases := []struct {
+		Name               string
+		RepositoryFn       func() *automock.SpecRepository
+		FetchRequestRepoFn func() *automock.FetchRequestRepository
+		FetchRequestSvcFn  func() *automock.FetchRequestService
+		ExpectedSpec       *model.Spec
+		ExpectedChanged    bool
+		ExpectedErr        error
+	}{
+		{
+			Name: "Success - changed Spec is stored",
+			RepositoryFn: func() *automock.SpecRepository {
+				repo := &automock.SpecRepository{}
+				repo.On("GetByID", ctx, tenant, specID).Return(fixSpecWithData(previousData), nil).Once()
+				repo.On("Update", ctx, fixSpecWithData(fetchedData)).Return(nil).Once()
+				return repo
+			},
+			FetchRequestRepoFn: func() *automock.FetchRequestRepository {
+				repo := &automock.FetchRequestRepository{}
+				repo.On("GetByReferenceObjectID", ctx, tenant, model.SpecFetchRequestReference, specID).Return(fixFetchRequest(str.Ptr("previous")), nil).Once()
+				repo.On("Update", ctx, mock.MatchedBy(hasSpecHashOf(fetchedData))).Return(nil).Once()
+				return repo
+			},
+			FetchRequestSvcFn: fetchedSpec(&fetchedData),
+			ExpectedSpec:      fixSpecWithData(fetchedData),
+			ExpectedChanged:   true,
+		},
+		{
+			Name: "Success - unchanged Spec is not stored",
+			RepositoryFn: func() *automock.SpecRepository {
+				repo := &automock.SpecRepository{}
+				repo.On("GetByID", ctx, tenant, specID).Return(fixSpecWithData(fetchedData), nil).Once()
+				return repo
+			},
+			FetchRequestRepoFn: func() *automock.FetchRequestRepository {
+				repo := &automock.FetchRequestRepository{}
+				repo.On("GetByReferenceObjectID", ctx, tenant, model.SpecFetchRequestReference, specID).Return(fixFetchRequest(str.Ptr(fixSpecHash(fetchedData))), nil).Once()
+				return repo
+			},
+			FetchRequestSvcFn: fetchedSpec(&fetchedData),
+			ExpectedSpec:      fixSpecWithData(fetchedData),
+		},
+		{
+			Name: "Success - Spec without previous hash is stored but not reported as changed",
+			RepositoryFn: func() *automock.SpecRepository {
+				repo := &automock.SpecRepository{}
+				repo.On("GetByID", ctx, tenant, specID).Return(fixSpecWithData(previousData), nil).Once()
+				repo.On("Update", ctx, fixSpecWithData(fetchedData)).Return(nil).Once()
+				return repo
+			},
+			FetchRequestRepoFn: func() *automock.FetchRequestRepository {
+				repo := &automock.FetchRequestRepository{}
+				repo.On("GetByReferenceObjectID", ctx, tenant, model.SpecFetchRequestReference, specID).Return(fixFetchRequest(nil), nil).Once()
+				repo.On("Update", ctx, mock.MatchedBy(hasSpecHashOf(fetchedData))).Return(nil).Once()
+				return repo
+			},
+			FetchRequestSvcFn: fetchedSpec(&fetchedData),
+			ExpectedSpec:      fixSpecWithData(fetchedData),
+		},
+		{
+			Name: "Success - previous data is kept when Spec cannot be fetched",
+			RepositoryFn: func() *automock.SpecRepository {
+				repo := &automock.SpecRepository{}
+				repo.On("GetByID", ctx, tenant, specID).Return(fixSpecWithData(previousData), nil).Once()
+				return repo
+			},
+			FetchRequestRepoFn: func() *automock.FetchRequestRepository {
+				repo := &automock.FetchRequestRepository{}
+				repo.On("GetByReferenceObjectID", ctx, tenant, model.SpecFetchRequestReference, specID).Return(fixFetchRequest(str.Ptr("previous")), nil).Once()
+				return repo
+			},
+			FetchRequestSvcFn: fetchedSpec(nil),
+			ExpectedSpec:      fixSpecWithData(previousData),
+		},
+		{
+			Name: "Success - previous data and hash are kept when fetched Spec is invalid",
+			RepositoryFn: func() *automock.SpecRepository {
+				repo := &automock.SpecRepository{}
+				repo.On("GetByID", ctx, tenant, specID).Return(fixSpecWithData(previousData), nil).Once()
+				return repo
+			},
+			FetchRequestRepoFn: func() *automock.FetchRequestRepository {
+				repo := &automock.FetchRequestRepository{}
+				repo.On("GetByReferenceObjectID", ctx, tenant, model.SpecFetchRequestReference, specID).Return(fixFetchRequest(str.Ptr("previous")), nil).Once()
+				repo.On("Update", ctx, mock.MatchedBy(func(fr *model.FetchRequest) bool {
+					return isInvalidSpecStatus(fr) && *fr.SpecHash == "previous"
+				})).Return(nil).Once()
+				return repo
+			},
+			FetchRequestSvcFn: fetchedSpec(str.Ptr("<invalid")),
+			ExpectedSpec:      fixSpecWithData(previousData),
+		},
+		{
+			Name: "Get fetch request error",
+			RepositoryFn: func() *automock.SpecRepository {
+				repo := &automock.SpecRepository{}
+				repo.On("GetByID", ctx, tenant, specID).Return(fixSpecWithData(previousData), nil).Once()
+				return repo
+			},
+			FetchRequestRepoFn: func() *automock.FetchRequestRepository {
+				repo := &automock.FetchRequestRepository{}
+				repo.On("GetByReferenceObjectID", ctx, tenant, model.SpecFetchRequestReference, specID).Return(nil, testErr).Once()
+				return repo
+			},
+			FetchRequestSvcFn: func() *automock.FetchRequestService {
+				return &automock.FetchRequestService{}
+			},
+			ExpectedErr: errors.Wrapf(testErr, "while getting FetchRequest for Specification with id %q", specID),
+		},
+		{
+			Name: "Error when updating Spec failed",
+			RepositoryFn: func() *automock.SpecRepository {
+				repo := &automock.SpecRepository{}
+				repo.On("GetByID", ctx, tenant, specID).Return(fixSpecWithData(previousData), nil).Once()
+				repo.On("Update", ctx, fixSpecWithData(fetchedData)).Return(testErr).Once()
+				return repo
+			},
+			FetchRequestRepoFn: func() *automock.FetchRequestRepository {
+				repo := &automock.FetchRequestRepository{}
+				repo.On("GetByReferenceObjectID", ctx, tenant, model.SpecFetchRequestReference, specID).Return(fixFetchRequest(str.Ptr("previous")), nil).Once()
+				repo.On("Update", ctx, mock.MatchedBy(hasSpecHashOf(fetchedData))).Return(nil).Once()
+				return repo
+			},
+			FetchRequestSvcFn: fetchedSpec(&fetchedData),
+			ExpectedErr:       errors.Wrapf(testErr, "while updating Specification with id %q", specID),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// given
+			repo := testCase.RepositoryFn()
+			frRepo := testCase.FetchRequestRepoFn()
+			frSvc := testCase.FetchRequestSvcFn()
+
+			svc := spec.NewService(repo, frRepo, nil, frSvc, true, false)
+
+			// when
+			result, changed, err := svc.RefreshSpec(ctx, specID)
+
+			// then
+			if testCase.ExpectedErr != nil {
+				require.Error(t, err)
+				assert.Equal(t, testCase.ExpectedErr.Error(), err.Error())
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.ExpectedSpec, result)
+				assert.Equal(t, testCase.ExpectedChanged, changed)
+			}
+			mock.AssertExpectationsForObjects(t, repo, frRepo, frSvc)
+		})
+	}
+
+	t.Run("Error when tenant not in context", func(t *testing.T) {
+		svc := spec.NewService(nil, nil, nil, nil, true, false)
+		// WHEN
+		_, _, err := svc.RefreshSpec(context.TODO(), "")
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "cannot read tenant from context")
+	})
+}
+
 func TestService_GetFetchRequest(t *testing.T) {
 	// given
 	ctx := context.TODO()
@@ -992,7 +1443,7 @@ func TestService_GetFetchRequest(t *testing.T) {
 		t.Run(testCase.Name, func(t *testing.T) {
 			repo := testCase.RepositoryFn()
 			fetchRequestRepo := testCase.FetchRequestRepoFn()
-			svc := spec.NewService(repo, fetchRequestRepo, nil, nil)
+			svc := spec.NewService(repo, fetchRequestRepo, nil, nil, true, false)
 
 			// when
 			l, err := svc.GetFetchRequest(ctx, testCase.InputAPIDefID)
@@ -1011,7 +1462,7 @@ func TestService_GetFetchRequest(t *testing.T) {
 		})
 	}
 	t.Run("Returns error on loading tenant", func(t *testing.T) {
-		svc := spec.NewService(nil, nil, nil, nil)
+		svc := spec.NewService(nil, nil, nil, nil, true, false)
 		// when
 		_, err := svc.GetFetchRequest(context.TODO(), "dd")
 		assert.True(t, apperrors.IsCannotReadTenant(err))
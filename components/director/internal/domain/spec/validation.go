@@ -0,0 +1,299 @@
+package spec
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"io"
+	"strings"
+
+	"github.com/ghodss/yaml"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/pkg/errors"
+)
+
+const (
+	edmxNamespaceV4 = "http://docs.oasis-open.org/odata/ns/edmx"
+	edmxNamespaceV2 = "http://schemas.microsoft.com/ado/2007/06/edmx"
+)
+
+// validateSpec checks that the data of the spec is well-formed in its declared format and, for OpenAPI, OData EDMX and AsyncAPI specs,
+// that its structure and version match the declared type. Specs of other types are only checked to be well-formed.
+func validateSpec(spec *model.Spec) error {
+	if spec.Data == nil {
+		return nil
+	}
+
+	var err error
+	switch {
+	case spec.APIType != nil:
+		err = validateAPISpec(*spec.APIType, spec.Format, *spec.Data)
+	case spec.EventType != nil:
+		err = validateEventSpec(*spec.EventType, spec.Format, *spec.Data)
+	default:
+		_, err = parseDocument(spec.Format, *spec.Data)
+	}
+
+	if err != nil {
+		return apperrors.NewInvalidDataError("invalid %s specification: %s", specTypeName(spec), err.Error())
+	}
+	return nil
+}
+
+func validateAPISpec(specType model.APISpecType, format model.SpecFormat, data string) error {
+	switch specType {
+	case model.APISpecTypeOpenAPI, model.APISpecTypeOpenAPIV2, model.APISpecTypeOpenAPIV3:
+		doc, err := parseObject(format, data)
+		if err != nil {
+			return err
+		}
+		return validateOpenAPI(specType, doc)
+	case model.APISpecTypeOdata, model.APISpecTypeEDMX:
+		if !isXML(format) {
+			if specType == model.APISpecTypeEDMX {
+				return errors.Errorf("EDMX must be in XML format, got %q", format)
+			}
+			_, err := parseDocument(format, data)
+			return err
+		}
+		return validateEDMX(data)
+	}
+
+	_, err := parseDocument(format, data)
+	return err
+}
+
+func validateEventSpec(specType model.EventSpecType, format model.SpecFormat, data string) error {
+	switch specType {
+	case model.EventSpecTypeAsyncAPI, model.EventSpecTypeAsyncAPIV2:
+		doc, err := parseObject(format, data)
+		if err != nil {
+			return err
+		}
+		return validateAsyncAPI(doc)
+	}
+
+	_, err := parseDocument(format, data)
+	return err
+}
+
+func validateOpenAPI(specType model.APISpecType, doc map[string]interface{}) error {
+	var major string
+	switch {
+	case doc["swagger"] != nil:
+		if version, _ := doc["swagger"].(string); version != "2.0" {
+			return errors.Errorf("unsupported swagger version %v", doc["swagger"])
+		}
+		major = "2"
+	case doc["openapi"] != nil:
+		version, _ := doc["openapi"].(string)
+		if !strings.HasPrefix(version, "3.") {
+			return errors.Errorf("unsupported openapi version %v", doc["openapi"])
+		}
+		major = "3"
+	default:
+		return errors.New("missing swagger or openapi version field")
+	}
+
+	if specType == model.APISpecTypeOpenAPIV2 && major != "2" {
+		return errors.New("expected OpenAPI version 2")
+	}
+	if specType == model.APISpecTypeOpenAPIV3 && major != "3" {
+		return errors.New("expected OpenAPI version 3")
+	}
+
+	if err := validateInfo(doc); err != nil {
+		return err
+	}
+
+	// paths became optional in OpenAPI 3.1 in favour of webhooks and components
+	version, _ := doc["openapi"].(string)
+	if _, ok := doc["paths"].(map[string]interface{}); !ok && !strings.HasPrefix(version, "3.1") {
+		return errors.New("missing paths object")
+	}
+
+	return nil
+}
+
+func validateAsyncAPI(doc map[string]interface{}) error {
+	version, _ := doc["asyncapi"].(string)
+	if version == "" {
+		return errors.New("missing asyncapi version field")
+	}
+	if !strings.HasPrefix(version, "2.") {
+		return errors.Errorf("unsupported asyncapi version %q", version)
+	}
+
+	if err := validateInfo(doc); err != nil {
+		return err
+	}
+
+	if _, ok := doc["channels"].(map[string]interface{}); !ok {
+		return errors.New("missing channels object")
+	}
+
+	return nil
+}
+
+func validateInfo(doc map[string]interface{}) error {
+	info, ok := doc["info"].(map[string]interface{})
+	if !ok {
+		return errors.New("missing info object")
+	}
+	if title, _ := info["title"].(string); title == "" {
+		return errors.New("missing info.title")
+	}
+	if version, _ := info["version"].(string); version == "" {
+		return errors.New("missing info.version")
+	}
+	return nil
+}
+
+func validateEDMX(data string) error {
+	decoder := xml.NewDecoder(strings.NewReader(data))
+
+	var root *xml.StartElement
+	hasDataServices := false
+	depth := 0
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return errors.Wrap(err, "malformed XML")
+		}
+
+		switch element := token.(type) {
+		case xml.StartElement:
+			depth++
+			if root == nil {
+				element := element.Copy()
+				root = &element
+				continue
+			}
+			if depth == 2 && element.Name.Local == "DataServices" {
+				hasDataServices = true
+			}
+		case xml.EndElement:
+			depth--
+		}
+	}
+
+	if root == nil {
+		return errors.New("empty XML document")
+	}
+	if root.Name.Local != "Edmx" || (root.Name.Space != edmxNamespaceV4 && root.Name.Space != edmxNamespaceV2) {
+		return errors.Errorf("expected Edmx root element, got %q", root.Name.Local)
+	}
+
+	version := ""
+	for _, attr := range root.Attr {
+		if attr.Name.Local == "Version" {
+			version = attr.Value
+		}
+	}
+	if version == "" {
+		return errors.New("missing Edmx version")
+	}
+	if root.Name.Space == edmxNamespaceV4 && !strings.HasPrefix(version, "4.") {
+		return errors.Errorf("unsupported Edmx version %q for namespace %q", version, root.Name.Space)
+	}
+
+	if !hasDataServices {
+		return errors.New("missing DataServices element")
+	}
+
+	return nil
+}
+
+// parseDocument checks that the data is well-formed in the given format. Data in formats without a known structure is not checked.
+func parseDocument(format model.SpecFormat, data string) (interface{}, error) {
+	switch {
+	case isJSON(format):
+		var doc interface{}
+		if err := json.Unmarshal([]byte(data), &doc); err != nil {
+			return nil, errors.Wrap(err, "malformed JSON")
+		}
+		return doc, nil
+	case isYAML(format):
+		var doc interface{}
+		if err := yaml.Unmarshal([]byte(data), &doc); err != nil {
+			return nil, errors.Wrap(err, "malformed YAML")
+		}
+		return doc, nil
+	case isXML(format):
+		decoder := xml.NewDecoder(strings.NewReader(data))
+		for {
+			_, err := decoder.Token()
+			if err == io.EOF {
+				return nil, nil
+			}
+			if err != nil {
+				return nil, errors.Wrap(err, "malformed XML")
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+func parseObject(format model.SpecFormat, data string) (map[string]interface{}, error) {
+	if !isJSON(format) && !isYAML(format) {
+		return nil, errors.Errorf("expected JSON or YAML format, got %q", format)
+	}
+
+	doc, err := parseDocument(format, data)
+	if err != nil {
+		return nil, err
+	}
+
+	object, ok := doc.(map[string]interface{})
+	if !ok {
+		return nil, errors.New("expected an object at the top level")
+	}
+	return object, nil
+}
+
+// convertToJSON converts specs in YAML format to JSON, so that consumers receive specs in a canonical format
+func convertToJSON(spec *model.Spec) error {
+	if spec.Data == nil || !isYAML(spec.Format) {
+		return nil
+	}
+
+	converted, err := yaml.YAMLToJSON([]byte(*spec.Data))
+	if err != nil {
+		return errors.Wrap(err, "while converting specification from YAML to JSON")
+	}
+
+	data := string(converted)
+	spec.Data = &data
+	if spec.Format == model.SpecFormatTextYAML {
+		spec.Format = model.SpecFormatApplicationJSON
+	} else {
+		spec.Format = model.SpecFormatJSON
+	}
+	return nil
+}
+
+func specTypeName(spec *model.Spec) string {
+	switch {
+	case spec.APIType != nil:
+		return string(*spec.APIType)
+	case spec.EventType != nil:
+		return string(*spec.EventType)
+	}
+	return string(spec.ObjectType)
+}
+
+func isJSON(format model.SpecFormat) bool {
+	return format == model.SpecFormatJSON || format == model.SpecFormatApplicationJSON
+}
+
+func isYAML(format model.SpecFormat) bool {
+	return format == model.SpecFormatYaml || format == model.SpecFormatTextYAML
+}
+
+func isXML(format model.SpecFormat) bool {
+	return format == model.SpecFormatXML || format == model.SpecFormatApplicationXML
+}
@@ -0,0 +1,174 @@
+package spec_test
+
+import (
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/spec"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/str"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateSpec(t *testing.T) {
+	openAPIV2 := `{"swagger":"2.0","info":{"title":"API","version":"1.0"},"paths":{}}`
+	openAPIV3 := "openapi: 3.0.0\ninfo:\n  title: API\n  version: '1.0'\npaths: {}\n"
+	edmxV2 := `<edmx:Edmx xmlns:edmx="http://schemas.microsoft.com/ado/2007/06/edmx" Version="1.0"><edmx:DataServices/></edmx:Edmx>`
+
+	testCases := []struct {
+		Name        string
+		Spec        *model.Spec
+		ExpectedErr string
+	}{
+		{
+			Name: "Valid OpenAPI v2",
+			Spec: fixSpec(model.APISpecTypeOpenAPIV2, model.SpecFormatJSON, openAPIV2),
+		},
+		{
+			Name: "Valid OpenAPI v3 in YAML",
+			Spec: fixSpec(model.APISpecTypeOpenAPIV3, model.SpecFormatYaml, openAPIV3),
+		},
+		{
+			Name: "Legacy OpenAPI type accepts both versions",
+			Spec: fixSpec(model.APISpecTypeOpenAPI, model.SpecFormatTextYAML, openAPIV3),
+		},
+		{
+			Name:        "OpenAPI version does not match the type",
+			Spec:        fixSpec(model.APISpecTypeOpenAPIV3, model.SpecFormatJSON, openAPIV2),
+			ExpectedErr: "expected OpenAPI version 3",
+		},
+		{
+			Name:        "OpenAPI without info",
+			Spec:        fixSpec(model.APISpecTypeOpenAPIV2, model.SpecFormatJSON, `{"swagger":"2.0","paths":{}}`),
+			ExpectedErr: "missing info object",
+		},
+		{
+			Name:        "OpenAPI without paths",
+			Spec:        fixSpec(model.APISpecTypeOpenAPIV2, model.SpecFormatJSON, `{"swagger":"2.0","info":{"title":"API","version":"1.0"}}`),
+			ExpectedErr: "missing paths object",
+		},
+		{
+			Name:        "OpenAPI in XML format",
+			Spec:        fixSpec(model.APISpecTypeOpenAPIV2, model.SpecFormatXML, edmxV2),
+			ExpectedErr: "expected JSON or YAML format",
+		},
+		{
+			Name:        "Malformed JSON",
+			Spec:        fixSpec(model.APISpecTypeOpenAPIV2, model.SpecFormatJSON, `{"swagger":`),
+			ExpectedErr: "malformed JSON",
+		},
+		{
+			Name: "Valid EDMX v4",
+			Spec: fixSpec(model.APISpecTypeEDMX, model.SpecFormatXML, apiSpecData),
+		},
+		{
+			Name: "Valid OData v2 EDMX",
+			Spec: fixSpec(model.APISpecTypeOdata, model.SpecFormatApplicationXML, edmxV2),
+		},
+		{
+			Name:        "EDMX without DataServices",
+			Spec:        fixSpec(model.APISpecTypeEDMX, model.SpecFormatXML, `<edmx:Edmx xmlns:edmx="http://docs.oasis-open.org/odata/ns/edmx" Version="4.0"/>`),
+			ExpectedErr: "missing DataServices element",
+		},
+		{
+			Name:        "EDMX with wrong root element",
+			Spec:        fixSpec(model.APISpecTypeEDMX, model.SpecFormatXML, `<definitions/>`),
+			ExpectedErr: "expected Edmx root element",
+		},
+		{
+			Name:        "EDMX in JSON format",
+			Spec:        fixSpec(model.APISpecTypeEDMX, model.SpecFormatJSON, `{}`),
+			ExpectedErr: "EDMX must be in XML format",
+		},
+		{
+			Name: "Other API types are only checked to be well-formed",
+			Spec: fixSpec(model.APISpecTypeWsdlV1, model.SpecFormatXML, `<definitions/>`),
+		},
+		{
+			Name:        "Malformed XML",
+			Spec:        fixSpec(model.APISpecTypeWsdlV1, model.SpecFormatXML, `<definitions>`),
+			ExpectedErr: "malformed XML",
+		},
+		{
+			Name: "Valid AsyncAPI",
+			Spec: fixEventSpec(model.EventSpecTypeAsyncAPIV2, model.SpecFormatJSON, eventSpecData),
+		},
+		{
+			Name:        "AsyncAPI with unsupported version",
+			Spec:        fixEventSpec(model.EventSpecTypeAsyncAPI, model.SpecFormatJSON, `{"asyncapi":"1.2.0","info":{"title":"Events","version":"1.0.0"},"topics":{}}`),
+			ExpectedErr: `unsupported asyncapi version "1.2.0"`,
+		},
+		{
+			Name:        "AsyncAPI without channels",
+			Spec:        fixEventSpec(model.EventSpecTypeAsyncAPIV2, model.SpecFormatJSON, `{"asyncapi":"2.0.0","info":{"title":"Events","version":"1.0.0"}}`),
+			ExpectedErr: "missing channels object",
+		},
+		{
+			Name: "Spec without data",
+			Spec: &model.Spec{APIType: apiSpecTypePtr(model.APISpecTypeOpenAPIV2), Format: model.SpecFormatJSON},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// when
+			err := spec.ValidateSpec(testCase.Spec)
+
+			// then
+			if testCase.ExpectedErr != "" {
+				require.Error(t, err)
+				assert.Equal(t, apperrors.InvalidData, apperrors.ErrorCode(err))
+				assert.Contains(t, err.Error(), testCase.ExpectedErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestConvertToJSON(t *testing.T) {
+	t.Run("Converts YAML to JSON", func(t *testing.T) {
+		in := fixSpec(model.APISpecTypeOpenAPIV3, model.SpecFormatTextYAML, "openapi: 3.0.0\npaths: {}\n")
+
+		err := spec.ConvertToJSON(in)
+
+		require.NoError(t, err)
+		assert.Equal(t, model.SpecFormatApplicationJSON, in.Format)
+		assert.JSONEq(t, `{"openapi":"3.0.0","paths":{}}`, *in.Data)
+	})
+
+	t.Run("Leaves other formats untouched", func(t *testing.T) {
+		in := fixSpec(model.APISpecTypeEDMX, model.SpecFormatXML, apiSpecData)
+
+		err := spec.ConvertToJSON(in)
+
+		require.NoError(t, err)
+		assert.Equal(t, model.SpecFormatXML, in.Format)
+		assert.Equal(t, apiSpecData, *in.Data)
+	})
+}
+
+func fixSpec(specType model.APISpecType, format model.SpecFormat, data string) *model.Spec {
+	return &model.Spec{
+		ID:         specID,
+		ObjectType: model.APISpecReference,
+		APIType:    &specType,
+		Format:     format,
+		Data:       str.Ptr(data),
+	}
+}
+
+func fixEventSpec(specType model.EventSpecType, format model.SpecFormat, data string) *model.Spec {
+	return &model.Spec{
+		ID:         specID,
+		ObjectType: model.EventSpecReference,
+		EventType:  &specType,
+		Format:     format,
+		Data:       str.Ptr(data),
+	}
+}
+
+func apiSpecTypePtr(specType model.APISpecType) *model.APISpecType {
+	return &specType
+}
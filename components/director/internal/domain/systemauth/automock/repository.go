@@ -171,6 +171,29 @@ func (_m *Repository) ListForObjectGlobal(ctx context.Context, objectType model.
 	return r0, r1
 }
 
+// ListForObjectIDs provides a mock function with given fields: ctx, tenant, objectType, objectIDs
+func (_m *Repository) ListForObjectIDs(ctx context.Context, tenant string, objectType model.SystemAuthReferenceObjectType, objectIDs []string) ([]model.SystemAuth, error) {
+	ret := _m.Called(ctx, tenant, objectType, objectIDs)
+
+	var r0 []model.SystemAuth
+	if rf, ok := ret.Get(0).(func(context.Context, string, model.SystemAuthReferenceObjectType, []string) []model.SystemAuth); ok {
+		r0 = rf(ctx, tenant, objectType, objectIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.SystemAuth)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, model.SystemAuthReferenceObjectType, []string) error); ok {
+		r1 = rf(ctx, tenant, objectType, objectIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Update provides a mock function with given fields: ctx, item
 func (_m *Repository) Update(ctx context.Context, item *model.SystemAuth) error {
 	ret := _m.Called(ctx, item)
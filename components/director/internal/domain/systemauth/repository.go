@@ -129,6 +129,31 @@ func (r *repository) ListForObject(ctx context.Context, tenant string, objectTyp
 	return r.multipleFromEntities(entities)
 }
 
+// ListForObjectIDs returns the system auths of all of the objects of the given type
+func (r *repository) ListForObjectIDs(ctx context.Context, tenant string, objectType model.SystemAuthReferenceObjectType, objectIDs []string) ([]model.SystemAuth, error) {
+	if len(objectIDs) == 0 {
+		return nil, nil
+	}
+
+	objTypeFieldName, err := referenceObjectField(objectType)
+	if err != nil {
+		return nil, err
+	}
+
+	var entities Collection
+
+	conditions := repo.Conditions{
+		repo.NewInConditionForStringValues(objTypeFieldName, objectIDs),
+	}
+
+	err = r.lister.List(ctx, tenant, &entities, conditions...)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.multipleFromEntities(entities)
+}
+
 func (r *repository) ListForObjectGlobal(ctx context.Context, objectType model.SystemAuthReferenceObjectType, objectID string) ([]model.SystemAuth, error) {
 	objTypeFieldName, err := referenceObjectField(objectType)
 	if err != nil {
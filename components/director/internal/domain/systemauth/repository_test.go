@@ -529,6 +529,107 @@ func TestRepository_ListForObject(t *testing.T) {
 	})
 }
 
+func TestRepository_ListForObjectIDs(t *testing.T) {
+	//GIVEN
+	firstObjID := "bar"
+	secondObjID := "baz"
+
+	modelAuth := fixModelAuth()
+
+	t.Run("Success listing auths for Applications", func(t *testing.T) {
+		db, dbMock := testdb.MockDatabase(t)
+		ctx := persistence.SaveToContext(context.TODO(), db)
+
+		modelSysAuths := []*model.SystemAuth{
+			fixModelSystemAuth("foo", model.ApplicationReference, firstObjID, modelAuth),
+			fixModelSystemAuth("foo2", model.ApplicationReference, secondObjID, modelAuth),
+		}
+		entSysAuths := []systemauth.Entity{
+			fixEntity("foo", model.ApplicationReference, firstObjID, true),
+			fixEntity("foo2", model.ApplicationReference, secondObjID, true),
+		}
+
+		query := `SELECT id, tenant_id, app_id, runtime_id, integration_system_id, value FROM public.system_auths WHERE tenant_id = $1 AND app_id IN ($2, $3)`
+		dbMock.ExpectQuery(regexp.QuoteMeta(query)).
+			WithArgs(testTenant, firstObjID, secondObjID).
+			WillReturnRows(fixSQLRows([]sqlRow{
+				{
+					id:       modelSysAuths[0].ID,
+					tenant:   &testTenant,
+					appID:    modelSysAuths[0].AppID,
+					rtmID:    modelSysAuths[0].RuntimeID,
+					intSysID: modelSysAuths[0].IntegrationSystemID,
+				},
+				{
+					id:       modelSysAuths[1].ID,
+					tenant:   &testTenant,
+					appID:    modelSysAuths[1].AppID,
+					rtmID:    modelSysAuths[1].RuntimeID,
+					intSysID: modelSysAuths[1].IntegrationSystemID,
+				},
+			}))
+
+		convMock := automock.Converter{}
+		convMock.On("FromEntity", entSysAuths[0]).Return(*modelSysAuths[0], nil).Once()
+		convMock.On("FromEntity", entSysAuths[1]).Return(*modelSysAuths[1], nil).Once()
+		pgRepository := systemauth.NewRepository(&convMock)
+
+		//WHEN
+		result, err := pgRepository.ListForObjectIDs(ctx, testTenant, model.ApplicationReference, []string{firstObjID, secondObjID})
+
+		//THEN
+		require.NoError(t, err)
+		assert.Equal(t, []model.SystemAuth{*modelSysAuths[0], *modelSysAuths[1]}, result)
+		dbMock.AssertExpectations(t)
+		convMock.AssertExpectations(t)
+	})
+
+	t.Run("Success when no object IDs are given", func(t *testing.T) {
+		pgRepository := systemauth.NewRepository(nil)
+
+		//WHEN
+		result, err := pgRepository.ListForObjectIDs(context.TODO(), testTenant, model.ApplicationReference, []string{})
+
+		//THEN
+		require.NoError(t, err)
+		require.Empty(t, result)
+	})
+
+	t.Run("Error listing auths for unsupported reference object type", func(t *testing.T) {
+		pgRepository := systemauth.NewRepository(nil)
+		errorMsg := "unsupported reference object type"
+
+		//WHEN
+		result, err := pgRepository.ListForObjectIDs(context.TODO(), testTenant, "unsupported", []string{firstObjID})
+
+		//THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), errorMsg)
+		require.Nil(t, result)
+	})
+
+	t.Run("Error listing auths", func(t *testing.T) {
+		db, dbMock := testdb.MockDatabase(t)
+		ctx := persistence.SaveToContext(context.TODO(), db)
+
+		query := `SELECT id, tenant_id, app_id, runtime_id, integration_system_id, value FROM public.system_auths WHERE tenant_id = $1 AND runtime_id IN ($2, $3)`
+		dbMock.ExpectQuery(regexp.QuoteMeta(query)).
+			WithArgs(testTenant, firstObjID, secondObjID).
+			WillReturnError(testErr)
+
+		pgRepository := systemauth.NewRepository(nil)
+
+		//WHEN
+		result, err := pgRepository.ListForObjectIDs(ctx, testTenant, model.RuntimeReference, []string{firstObjID, secondObjID})
+
+		//THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "Internal Server Error: Unexpected error while executing SQL query")
+		require.Nil(t, result)
+		dbMock.AssertExpectations(t)
+	})
+}
+
 func TestRepository_DeleteAllForObject(t *testing.T) {
 	// GIVEN
 	sysAuthID := "foo"
@@ -8,6 +8,7 @@ import (
 	"github.com/kyma-incubator/compass/components/director/internal/model"
 	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
 	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/kyma-incubator/compass/components/director/pkg/str"
 	"github.com/pkg/errors"
 )
 
@@ -17,6 +18,7 @@ type Repository interface {
 	GetByID(ctx context.Context, tenant, id string) (*model.SystemAuth, error)
 	GetByIDGlobal(ctx context.Context, id string) (*model.SystemAuth, error)
 	ListForObject(ctx context.Context, tenant string, objectType model.SystemAuthReferenceObjectType, objectID string) ([]model.SystemAuth, error)
+	ListForObjectIDs(ctx context.Context, tenant string, objectType model.SystemAuthReferenceObjectType, objectIDs []string) ([]model.SystemAuth, error)
 	ListForObjectGlobal(ctx context.Context, objectType model.SystemAuthReferenceObjectType, objectID string) ([]model.SystemAuth, error)
 	DeleteByIDForObject(ctx context.Context, tenant, id string, objType model.SystemAuthReferenceObjectType) error
 	DeleteByIDForObjectGlobal(ctx context.Context, id string, objType model.SystemAuthReferenceObjectType) error
@@ -158,6 +160,37 @@ func (s *service) ListForObject(ctx context.Context, objectType model.SystemAuth
 	return systemAuths, nil
 }
 
+// ListForObjects returns the System Auths of every Application or Runtime, grouped by the ID of the object
+func (s *service) ListForObjects(ctx context.Context, objectType model.SystemAuthReferenceObjectType, objectIDs []string) (map[string][]model.SystemAuth, error) {
+	if objectType == model.IntegrationSystemReference {
+		return nil, apperrors.NewInternalError("listing System Auths of multiple Integration Systems is not supported")
+	}
+
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	systemAuths, err := s.repo.ListForObjectIDs(ctx, tnt, objectType, objectIDs)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while listing System Auths for %s", objectType)
+	}
+
+	systemAuthsByObjectID := make(map[string][]model.SystemAuth, len(objectIDs))
+	for _, systemAuth := range systemAuths {
+		var objectID string
+		switch objectType {
+		case model.ApplicationReference:
+			objectID = str.PtrStrToStr(systemAuth.AppID)
+		case model.RuntimeReference:
+			objectID = str.PtrStrToStr(systemAuth.RuntimeID)
+		}
+		systemAuthsByObjectID[objectID] = append(systemAuthsByObjectID[objectID], systemAuth)
+	}
+
+	return systemAuthsByObjectID, nil
+}
+
 func (s *service) DeleteByIDForObject(ctx context.Context, objectType model.SystemAuthReferenceObjectType, authID string) error {
 	tnt, err := tenant.LoadFromContext(ctx)
 	if err != nil {
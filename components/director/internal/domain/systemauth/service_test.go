@@ -315,6 +315,114 @@ func TestService_ListForObject(t *testing.T) {
 	})
 }
 
+func TestService_ListForObjects(t *testing.T) {
+	// GIVEN
+	ctx := tenant.SaveToContext(context.TODO(), testTenant, testExternalTenant)
+
+	objIDs := []string{"bar", "bar2"}
+
+	modelAuth := fixModelAuth()
+
+	rtmSysAuths := []model.SystemAuth{
+		{ID: "foo", TenantID: &testTenant, RuntimeID: str.Ptr("bar"), Value: modelAuth},
+		{ID: "foo2", TenantID: &testTenant, RuntimeID: str.Ptr("bar2"), Value: modelAuth},
+		{ID: "foo3", TenantID: &testTenant, RuntimeID: str.Ptr("bar2"), Value: modelAuth},
+	}
+	appSysAuths := []model.SystemAuth{
+		{ID: "foo", TenantID: &testTenant, AppID: str.Ptr("bar"), Value: modelAuth},
+		{ID: "foo2", TenantID: &testTenant, AppID: str.Ptr("bar2"), Value: modelAuth},
+	}
+
+	testCases := []struct {
+		Name            string
+		sysAuthRepoFn   func() *automock.Repository
+		InputObjectType model.SystemAuthReferenceObjectType
+		ExpectedOutput  map[string][]model.SystemAuth
+		ExpectedError   error
+	}{
+		{
+			Name: "Success listing Auths for Runtimes",
+			sysAuthRepoFn: func() *automock.Repository {
+				sysAuthRepo := &automock.Repository{}
+				sysAuthRepo.On("ListForObjectIDs", contextThatHasTenant(testTenant), testTenant, model.RuntimeReference, objIDs).Return(rtmSysAuths, nil)
+				return sysAuthRepo
+			},
+			InputObjectType: model.RuntimeReference,
+			ExpectedOutput: map[string][]model.SystemAuth{
+				"bar":  {rtmSysAuths[0]},
+				"bar2": {rtmSysAuths[1], rtmSysAuths[2]},
+			},
+			ExpectedError: nil,
+		},
+		{
+			Name: "Success listing Auths for Applications",
+			sysAuthRepoFn: func() *automock.Repository {
+				sysAuthRepo := &automock.Repository{}
+				sysAuthRepo.On("ListForObjectIDs", contextThatHasTenant(testTenant), testTenant, model.ApplicationReference, objIDs).Return(appSysAuths, nil)
+				return sysAuthRepo
+			},
+			InputObjectType: model.ApplicationReference,
+			ExpectedOutput: map[string][]model.SystemAuth{
+				"bar":  {appSysAuths[0]},
+				"bar2": {appSysAuths[1]},
+			},
+			ExpectedError: nil,
+		},
+		{
+			Name: "Error listing Auths for Integration Systems",
+			sysAuthRepoFn: func() *automock.Repository {
+				return &automock.Repository{}
+			},
+			InputObjectType: model.IntegrationSystemReference,
+			ExpectedOutput:  nil,
+			ExpectedError:   errors.New("listing System Auths of multiple Integration Systems is not supported"),
+		},
+		{
+			Name: "Error listing System Auths",
+			sysAuthRepoFn: func() *automock.Repository {
+				sysAuthRepo := &automock.Repository{}
+				sysAuthRepo.On("ListForObjectIDs", contextThatHasTenant(testTenant), testTenant, model.RuntimeReference, objIDs).Return(nil, testErr)
+				return sysAuthRepo
+			},
+			InputObjectType: model.RuntimeReference,
+			ExpectedOutput:  nil,
+			ExpectedError:   testErr,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			sysAuthRepo := testCase.sysAuthRepoFn()
+			svc := systemauth.NewService(sysAuthRepo, nil)
+
+			// WHEN
+			result, err := svc.ListForObjects(ctx, testCase.InputObjectType, objIDs)
+
+			// THEN
+			if testCase.ExpectedError != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedError.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, testCase.ExpectedOutput, result)
+
+			sysAuthRepo.AssertExpectations(t)
+		})
+	}
+
+	t.Run("Error when tenant not in context", func(t *testing.T) {
+		svc := systemauth.NewService(nil, nil)
+
+		// WHEN
+		_, err := svc.ListForObjects(context.TODO(), model.ApplicationReference, objIDs)
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "cannot read tenant from context")
+	})
+}
+
 func TestService_GetByIDForObject(t *testing.T) {
 	// GIVEN
 	ctx := tenant.SaveToContext(context.TODO(), testTenant, testExternalTenant)
@@ -2,6 +2,7 @@ package tombstone
 
 import (
 	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
 
 	"github.com/kyma-incubator/compass/components/director/internal/model"
 )
@@ -42,3 +43,28 @@ func (c *converter) FromEntity(entity *Entity) (*model.Tombstone, error) {
 
 	return output, nil
 }
+
+func (c *converter) ToGraphQL(in *model.Tombstone) *graphql.Tombstone {
+	if in == nil {
+		return nil
+	}
+
+	return &graphql.Tombstone{
+		ID:            in.OrdID,
+		OrdID:         in.OrdID,
+		ApplicationID: in.ApplicationID,
+		RemovalDate:   in.RemovalDate,
+	}
+}
+
+func (c *converter) MultipleToGraphQL(in []*model.Tombstone) []*graphql.Tombstone {
+	tombstones := make([]*graphql.Tombstone, 0, len(in))
+	for _, tombstone := range in {
+		if tombstone == nil {
+			continue
+		}
+		tombstones = append(tombstones, c.ToGraphQL(tombstone))
+	}
+
+	return tombstones
+}
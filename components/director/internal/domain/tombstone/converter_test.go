@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/kyma-incubator/compass/components/director/internal/domain/tombstone"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -48,3 +49,28 @@ func TestEntityConverter_FromEntity(t *testing.T) {
 		require.Error(t, err)
 	})
 }
+
+func TestConverter_ToGraphQL(t *testing.T) {
+	expected := &graphql.Tombstone{
+		ID:            ordID,
+		OrdID:         ordID,
+		ApplicationID: appID,
+		RemovalDate:   "removalDate",
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		conv := tombstone.NewConverter()
+
+		result := conv.ToGraphQL(fixTombstoneModel())
+
+		assert.Equal(t, expected, result)
+	})
+
+	t.Run("Returns nil if tombstone model is nil", func(t *testing.T) {
+		conv := tombstone.NewConverter()
+
+		result := conv.ToGraphQL(nil)
+
+		require.Nil(t, result)
+	})
+}
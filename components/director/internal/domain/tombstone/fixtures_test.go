@@ -5,6 +5,8 @@ import (
 
 	"github.com/kyma-incubator/compass/components/director/internal/domain/tombstone"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
 )
 
 const (
@@ -50,3 +52,36 @@ func fixTombstoneRow() []driver.Value {
 func fixTombstoneUpdateArgs() []driver.Value {
 	return []driver.Value{"removalDate"}
 }
+
+func fixGQLTombstone() *graphql.Tombstone {
+	return &graphql.Tombstone{
+		ID:            ordID,
+		OrdID:         ordID,
+		ApplicationID: appID,
+		RemovalDate:   "removalDate",
+	}
+}
+
+func fixTombstonePage(tombstones []*model.Tombstone) *model.TombstonePage {
+	return &model.TombstonePage{
+		Data:       tombstones,
+		TotalCount: len(tombstones),
+		PageInfo: &pagination.Page{
+			StartCursor: "start",
+			EndCursor:   "end",
+			HasNextPage: false,
+		},
+	}
+}
+
+func fixGQLTombstonePage(tombstones []*graphql.Tombstone) *graphql.TombstonePage {
+	return &graphql.TombstonePage{
+		Data:       tombstones,
+		TotalCount: len(tombstones),
+		PageInfo: &graphql.PageInfo{
+			StartCursor: "start",
+			EndCursor:   "end",
+			HasNextPage: false,
+		},
+	}
+}
@@ -26,24 +26,26 @@ type EntityConverter interface {
 }
 
 type pgRepository struct {
-	conv         EntityConverter
-	existQuerier repo.ExistQuerier
-	singleGetter repo.SingleGetter
-	lister       repo.Lister
-	deleter      repo.Deleter
-	creator      repo.Creator
-	updater      repo.Updater
+	conv            EntityConverter
+	existQuerier    repo.ExistQuerier
+	singleGetter    repo.SingleGetter
+	lister          repo.Lister
+	deleter         repo.Deleter
+	creator         repo.Creator
+	updater         repo.Updater
+	pageableQuerier repo.PageableQuerier
 }
 
 func NewRepository(conv EntityConverter) *pgRepository {
 	return &pgRepository{
-		conv:         conv,
-		existQuerier: repo.NewExistQuerier(resource.Tombstone, tombstoneTable, tenantColumn),
-		singleGetter: repo.NewSingleGetter(resource.Tombstone, tombstoneTable, tenantColumn, tombstoneColumns),
-		lister:       repo.NewLister(resource.Tombstone, tombstoneTable, tenantColumn, tombstoneColumns),
-		deleter:      repo.NewDeleter(resource.Tombstone, tombstoneTable, tenantColumn),
-		creator:      repo.NewCreator(resource.Tombstone, tombstoneTable, tombstoneColumns),
-		updater:      repo.NewUpdater(resource.Tombstone, tombstoneTable, updatableColumns, tenantColumn, []string{"ord_id"}),
+		conv:            conv,
+		existQuerier:    repo.NewExistQuerier(resource.Tombstone, tombstoneTable, tenantColumn),
+		singleGetter:    repo.NewSingleGetter(resource.Tombstone, tombstoneTable, tenantColumn, tombstoneColumns),
+		lister:          repo.NewLister(resource.Tombstone, tombstoneTable, tenantColumn, tombstoneColumns),
+		deleter:         repo.NewDeleter(resource.Tombstone, tombstoneTable, tenantColumn),
+		creator:         repo.NewCreator(resource.Tombstone, tombstoneTable, tombstoneColumns),
+		updater:         repo.NewUpdater(resource.Tombstone, tombstoneTable, updatableColumns, tenantColumn, []string{"ord_id"}),
+		pageableQuerier: repo.NewPageableQuerier(resource.Tombstone, tombstoneTable, tenantColumn, tombstoneColumns),
 	}
 }
 
@@ -104,6 +106,37 @@ func (r *pgRepository) ListByApplicationID(ctx context.Context, tenantID, appID
 	return tombstones, nil
 }
 
+func (r *pgRepository) ListByApplicationIDPage(ctx context.Context, tenantID, appID string, pageSize int, cursor string) (*model.TombstonePage, error) {
+	return r.list(ctx, tenantID, pageSize, cursor, repo.NewEqualCondition("app_id", appID))
+}
+
+func (r *pgRepository) List(ctx context.Context, tenantID string, pageSize int, cursor string) (*model.TombstonePage, error) {
+	return r.list(ctx, tenantID, pageSize, cursor)
+}
+
+func (r *pgRepository) list(ctx context.Context, tenantID string, pageSize int, cursor string, conditions ...repo.Condition) (*model.TombstonePage, error) {
+	var tombstoneCollection tombstoneCollection
+	page, totalCount, err := r.pageableQuerier.List(ctx, tenantID, pageSize, cursor, "ord_id", &tombstoneCollection, conditions...)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*model.Tombstone, 0, tombstoneCollection.Len())
+	for _, tombstoneEnt := range tombstoneCollection {
+		tombstoneModel, err := r.conv.FromEntity(&tombstoneEnt)
+		if err != nil {
+			return nil, errors.Wrap(err, "while creating Tombstone model from entity")
+		}
+		items = append(items, tombstoneModel)
+	}
+
+	return &model.TombstonePage{
+		Data:       items,
+		TotalCount: totalCount,
+		PageInfo:   page,
+	}, nil
+}
+
 type tombstoneCollection []Entity
 
 func (pc tombstoneCollection) Len() int {
@@ -0,0 +1,140 @@
+package tombstone
+
+import (
+	"context"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+	"github.com/pkg/errors"
+)
+
+//go:generate mockery -name=TombstoneService -output=automock -outpkg=automock -case=underscore
+type TombstoneService interface {
+	Get(ctx context.Context, id string) (*model.Tombstone, error)
+	List(ctx context.Context, pageSize int, cursor string) (*model.TombstonePage, error)
+	ListByApplicationIDPage(ctx context.Context, appID string, pageSize int, cursor string) (*model.TombstonePage, error)
+}
+
+//go:generate mockery -name=TombstoneConverter -output=automock -outpkg=automock -case=underscore
+type TombstoneConverter interface {
+	ToGraphQL(in *model.Tombstone) *graphql.Tombstone
+	MultipleToGraphQL(in []*model.Tombstone) []*graphql.Tombstone
+}
+
+type Resolver struct {
+	transact persistence.Transactioner
+
+	tombstoneSvc  TombstoneService
+	tombstoneConv TombstoneConverter
+}
+
+func NewResolver(transact persistence.Transactioner, tombstoneSvc TombstoneService, tombstoneConv TombstoneConverter) *Resolver {
+	return &Resolver{
+		transact:      transact,
+		tombstoneSvc:  tombstoneSvc,
+		tombstoneConv: tombstoneConv,
+	}
+}
+
+func (r *Resolver) Tombstones(ctx context.Context, first *int, after *graphql.PageCursor) (*graphql.TombstonePage, error) {
+	if first == nil {
+		return nil, apperrors.NewInvalidDataError("missing required parameter 'first'")
+	}
+
+	var cursor string
+	if after != nil {
+		cursor = string(*after)
+	}
+
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer r.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	tombstonePage, err := r.tombstoneSvc.List(ctx, *first, cursor)
+	if err != nil {
+		return nil, errors.Wrap(err, "while listing Tombstones")
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return r.toGraphQLPage(tombstonePage), nil
+}
+
+func (r *Resolver) Tombstone(ctx context.Context, id string) (*graphql.Tombstone, error) {
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer r.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	tombstone, err := r.tombstoneSvc.Get(ctx, id)
+	if err != nil {
+		if apperrors.IsNotFoundError(err) {
+			return nil, tx.Commit()
+		}
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return r.tombstoneConv.ToGraphQL(tombstone), nil
+}
+
+func (r *Resolver) TombstonesForApplication(ctx context.Context, obj *graphql.Application, first *int, after *graphql.PageCursor) (*graphql.TombstonePage, error) {
+	if obj == nil {
+		return nil, apperrors.NewInternalError("Application cannot be empty")
+	}
+
+	if first == nil {
+		return nil, apperrors.NewInvalidDataError("missing required parameter 'first'")
+	}
+
+	var cursor string
+	if after != nil {
+		cursor = string(*after)
+	}
+
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer r.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	tombstonePage, err := r.tombstoneSvc.ListByApplicationIDPage(ctx, obj.ID, *first, cursor)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while listing Tombstones for Application with id %s", obj.ID)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return r.toGraphQLPage(tombstonePage), nil
+}
+
+func (r *Resolver) toGraphQLPage(tombstonePage *model.TombstonePage) *graphql.TombstonePage {
+	return &graphql.TombstonePage{
+		Data:       r.tombstoneConv.MultipleToGraphQL(tombstonePage.Data),
+		TotalCount: tombstonePage.TotalCount,
+		PageInfo: &graphql.PageInfo{
+			StartCursor:     graphql.PageCursor(tombstonePage.PageInfo.StartCursor),
+			EndCursor:       graphql.PageCursor(tombstonePage.PageInfo.EndCursor),
+			HasNextPage:     tombstonePage.PageInfo.HasNextPage,
+			HasPreviousPage: tombstonePage.PageInfo.HasPreviousPage,
+		},
+	}
+}
@@ -0,0 +1,390 @@
+package tombstone_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/tombstone"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/tombstone/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	persistenceautomock "github.com/kyma-incubator/compass/components/director/pkg/persistence/automock"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence/txtest"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolver_Tombstone(t *testing.T) {
+	// given
+	testErr := errors.New("Test error")
+	txGen := txtest.NewTransactionContextGenerator(testErr)
+
+	modelTombstone := fixTombstoneModel()
+	gqlTombstone := fixGQLTombstone()
+
+	testCases := []struct {
+		Name              string
+		TransactionerFn   func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		ServiceFn         func() *automock.TombstoneService
+		ConverterFn       func() *automock.TombstoneConverter
+		ExpectedTombstone *graphql.Tombstone
+		ExpectedErr       error
+	}{
+		{
+			Name:            "Success",
+			TransactionerFn: txGen.ThatSucceeds,
+			ServiceFn: func() *automock.TombstoneService {
+				svc := &automock.TombstoneService{}
+				svc.On("Get", txtest.CtxWithDBMatcher(), ordID).Return(modelTombstone, nil).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.TombstoneConverter {
+				conv := &automock.TombstoneConverter{}
+				conv.On("ToGraphQL", modelTombstone).Return(gqlTombstone).Once()
+				return conv
+			},
+			ExpectedTombstone: gqlTombstone,
+		},
+		{
+			Name:            "Returns null when Tombstone not found",
+			TransactionerFn: txGen.ThatSucceeds,
+			ServiceFn: func() *automock.TombstoneService {
+				svc := &automock.TombstoneService{}
+				svc.On("Get", txtest.CtxWithDBMatcher(), ordID).Return(nil, apperrors.NewNotFoundError(resource.Tombstone, ordID)).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.TombstoneConverter {
+				return &automock.TombstoneConverter{}
+			},
+		},
+		{
+			Name:            "Returns error when Tombstone retrieval failed",
+			TransactionerFn: txGen.ThatDoesntExpectCommit,
+			ServiceFn: func() *automock.TombstoneService {
+				svc := &automock.TombstoneService{}
+				svc.On("Get", txtest.CtxWithDBMatcher(), ordID).Return(nil, testErr).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.TombstoneConverter {
+				return &automock.TombstoneConverter{}
+			},
+			ExpectedErr: testErr,
+		},
+		{
+			Name:            "Returns error when commit failed",
+			TransactionerFn: txGen.ThatFailsOnCommit,
+			ServiceFn: func() *automock.TombstoneService {
+				svc := &automock.TombstoneService{}
+				svc.On("Get", txtest.CtxWithDBMatcher(), ordID).Return(modelTombstone, nil).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.TombstoneConverter {
+				return &automock.TombstoneConverter{}
+			},
+			ExpectedErr: testErr,
+		},
+		{
+			Name:            "Returns error when transaction begin failed",
+			TransactionerFn: txGen.ThatFailsOnBegin,
+			ServiceFn: func() *automock.TombstoneService {
+				return &automock.TombstoneService{}
+			},
+			ConverterFn: func() *automock.TombstoneConverter {
+				return &automock.TombstoneConverter{}
+			},
+			ExpectedErr: testErr,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			persist, transact := testCase.TransactionerFn()
+			svc := testCase.ServiceFn()
+			conv := testCase.ConverterFn()
+
+			resolver := tombstone.NewResolver(transact, svc, conv)
+
+			// when
+			result, err := resolver.Tombstone(context.TODO(), ordID)
+
+			// then
+			assert.Equal(t, testCase.ExpectedTombstone, result)
+			if testCase.ExpectedErr != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+
+			persist.AssertExpectations(t)
+			transact.AssertExpectations(t)
+			svc.AssertExpectations(t)
+			conv.AssertExpectations(t)
+		})
+	}
+}
+
+func TestResolver_Tombstones(t *testing.T) {
+	// given
+	testErr := errors.New("Test error")
+	txGen := txtest.NewTransactionContextGenerator(testErr)
+
+	first := 2
+	after := "test"
+	gqlAfter := graphql.PageCursor(after)
+
+	modelTombstones := []*model.Tombstone{fixTombstoneModel()}
+	gqlTombstones := []*graphql.Tombstone{fixGQLTombstone()}
+	modelPage := fixTombstonePage(modelTombstones)
+	gqlPage := fixGQLTombstonePage(gqlTombstones)
+
+	testCases := []struct {
+		Name            string
+		TransactionerFn func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		ServiceFn       func() *automock.TombstoneService
+		ConverterFn     func() *automock.TombstoneConverter
+		ExpectedResult  *graphql.TombstonePage
+		ExpectedErr     error
+	}{
+		{
+			Name:            "Success",
+			TransactionerFn: txGen.ThatSucceeds,
+			ServiceFn: func() *automock.TombstoneService {
+				svc := &automock.TombstoneService{}
+				svc.On("List", txtest.CtxWithDBMatcher(), first, after).Return(modelPage, nil).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.TombstoneConverter {
+				conv := &automock.TombstoneConverter{}
+				conv.On("MultipleToGraphQL", modelTombstones).Return(gqlTombstones).Once()
+				return conv
+			},
+			ExpectedResult: gqlPage,
+		},
+		{
+			Name:            "Returns error when Tombstones listing failed",
+			TransactionerFn: txGen.ThatDoesntExpectCommit,
+			ServiceFn: func() *automock.TombstoneService {
+				svc := &automock.TombstoneService{}
+				svc.On("List", txtest.CtxWithDBMatcher(), first, after).Return(nil, testErr).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.TombstoneConverter {
+				return &automock.TombstoneConverter{}
+			},
+			ExpectedErr: testErr,
+		},
+		{
+			Name:            "Returns error when commit failed",
+			TransactionerFn: txGen.ThatFailsOnCommit,
+			ServiceFn: func() *automock.TombstoneService {
+				svc := &automock.TombstoneService{}
+				svc.On("List", txtest.CtxWithDBMatcher(), first, after).Return(modelPage, nil).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.TombstoneConverter {
+				return &automock.TombstoneConverter{}
+			},
+			ExpectedErr: testErr,
+		},
+		{
+			Name:            "Returns error when transaction begin failed",
+			TransactionerFn: txGen.ThatFailsOnBegin,
+			ServiceFn: func() *automock.TombstoneService {
+				return &automock.TombstoneService{}
+			},
+			ConverterFn: func() *automock.TombstoneConverter {
+				return &automock.TombstoneConverter{}
+			},
+			ExpectedErr: testErr,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			persist, transact := testCase.TransactionerFn()
+			svc := testCase.ServiceFn()
+			conv := testCase.ConverterFn()
+
+			resolver := tombstone.NewResolver(transact, svc, conv)
+
+			// when
+			result, err := resolver.Tombstones(context.TODO(), &first, &gqlAfter)
+
+			// then
+			assert.Equal(t, testCase.ExpectedResult, result)
+			if testCase.ExpectedErr != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+
+			persist.AssertExpectations(t)
+			transact.AssertExpectations(t)
+			svc.AssertExpectations(t)
+			conv.AssertExpectations(t)
+		})
+	}
+
+	t.Run("Returns error when page size is invalid", func(t *testing.T) {
+		invalidFirst := 201
+		persist, transact := txGen.ThatDoesntExpectCommit()
+		svc := &automock.TombstoneService{}
+		svc.On("List", txtest.CtxWithDBMatcher(), invalidFirst, after).Return(nil, apperrors.NewInvalidDataError("page size must be between 1 and 200")).Once()
+
+		resolver := tombstone.NewResolver(transact, svc, nil)
+
+		// when
+		_, err := resolver.Tombstones(context.TODO(), &invalidFirst, &gqlAfter)
+
+		// then
+		require.Error(t, err)
+		assert.Equal(t, apperrors.InvalidData, apperrors.ErrorCode(err))
+
+		persist.AssertExpectations(t)
+		transact.AssertExpectations(t)
+		svc.AssertExpectations(t)
+	})
+
+	t.Run("Returns error when first is missing", func(t *testing.T) {
+		resolver := tombstone.NewResolver(nil, nil, nil)
+
+		// when
+		_, err := resolver.Tombstones(context.TODO(), nil, nil)
+
+		// then
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "missing required parameter 'first'")
+	})
+}
+
+func TestResolver_TombstonesForApplication(t *testing.T) {
+	// given
+	testErr := errors.New("Test error")
+	txGen := txtest.NewTransactionContextGenerator(testErr)
+
+	first := 2
+	after := "test"
+	gqlAfter := graphql.PageCursor(after)
+
+	modelTombstones := []*model.Tombstone{fixTombstoneModel()}
+	gqlTombstones := []*graphql.Tombstone{fixGQLTombstone()}
+	modelPage := fixTombstonePage(modelTombstones)
+	gqlPage := fixGQLTombstonePage(gqlTombstones)
+	app := &graphql.Application{BaseEntity: &graphql.BaseEntity{ID: appID}}
+
+	testCases := []struct {
+		Name            string
+		TransactionerFn func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		ServiceFn       func() *automock.TombstoneService
+		ConverterFn     func() *automock.TombstoneConverter
+		ExpectedResult  *graphql.TombstonePage
+		ExpectedErr     error
+	}{
+		{
+			Name:            "Success",
+			TransactionerFn: txGen.ThatSucceeds,
+			ServiceFn: func() *automock.TombstoneService {
+				svc := &automock.TombstoneService{}
+				svc.On("ListByApplicationIDPage", txtest.CtxWithDBMatcher(), appID, first, after).Return(modelPage, nil).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.TombstoneConverter {
+				conv := &automock.TombstoneConverter{}
+				conv.On("MultipleToGraphQL", modelTombstones).Return(gqlTombstones).Once()
+				return conv
+			},
+			ExpectedResult: gqlPage,
+		},
+		{
+			Name:            "Returns error when Tombstones listing failed",
+			TransactionerFn: txGen.ThatDoesntExpectCommit,
+			ServiceFn: func() *automock.TombstoneService {
+				svc := &automock.TombstoneService{}
+				svc.On("ListByApplicationIDPage", txtest.CtxWithDBMatcher(), appID, first, after).Return(nil, testErr).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.TombstoneConverter {
+				return &automock.TombstoneConverter{}
+			},
+			ExpectedErr: testErr,
+		},
+		{
+			Name:            "Returns error when commit failed",
+			TransactionerFn: txGen.ThatFailsOnCommit,
+			ServiceFn: func() *automock.TombstoneService {
+				svc := &automock.TombstoneService{}
+				svc.On("ListByApplicationIDPage", txtest.CtxWithDBMatcher(), appID, first, after).Return(modelPage, nil).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.TombstoneConverter {
+				return &automock.TombstoneConverter{}
+			},
+			ExpectedErr: testErr,
+		},
+		{
+			Name:            "Returns error when transaction begin failed",
+			TransactionerFn: txGen.ThatFailsOnBegin,
+			ServiceFn: func() *automock.TombstoneService {
+				return &automock.TombstoneService{}
+			},
+			ConverterFn: func() *automock.TombstoneConverter {
+				return &automock.TombstoneConverter{}
+			},
+			ExpectedErr: testErr,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			persist, transact := testCase.TransactionerFn()
+			svc := testCase.ServiceFn()
+			conv := testCase.ConverterFn()
+
+			resolver := tombstone.NewResolver(transact, svc, conv)
+
+			// when
+			result, err := resolver.TombstonesForApplication(context.TODO(), app, &first, &gqlAfter)
+
+			// then
+			assert.Equal(t, testCase.ExpectedResult, result)
+			if testCase.ExpectedErr != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+
+			persist.AssertExpectations(t)
+			transact.AssertExpectations(t)
+			svc.AssertExpectations(t)
+			conv.AssertExpectations(t)
+		})
+	}
+
+	t.Run("Returns error when Application is nil", func(t *testing.T) {
+		resolver := tombstone.NewResolver(nil, nil, nil)
+
+		// when
+		_, err := resolver.TombstonesForApplication(context.TODO(), nil, &first, &gqlAfter)
+
+		// then
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "Application cannot be empty")
+	})
+
+	t.Run("Returns error when first is missing", func(t *testing.T) {
+		resolver := tombstone.NewResolver(nil, nil, nil)
+
+		// when
+		_, err := resolver.TombstonesForApplication(context.TODO(), app, nil, nil)
+
+		// then
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "missing required parameter 'first'")
+	})
+}
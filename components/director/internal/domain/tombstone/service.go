@@ -5,6 +5,7 @@ import (
 
 	"github.com/kyma-incubator/compass/components/director/internal/domain/tenant"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
 	"github.com/kyma-incubator/compass/components/director/pkg/log"
 	"github.com/pkg/errors"
 )
@@ -17,6 +18,8 @@ type TombstoneRepository interface {
 	Exists(ctx context.Context, tenant, id string) (bool, error)
 	GetByID(ctx context.Context, tenant, id string) (*model.Tombstone, error)
 	ListByApplicationID(ctx context.Context, tenantID, appID string) ([]*model.Tombstone, error)
+	ListByApplicationIDPage(ctx context.Context, tenantID, appID string, pageSize int, cursor string) (*model.TombstonePage, error)
+	List(ctx context.Context, tenantID string, pageSize int, cursor string) (*model.TombstonePage, error)
 }
 
 type service struct {
@@ -116,3 +119,29 @@ func (s *service) ListByApplicationID(ctx context.Context, appID string) ([]*mod
 
 	return s.tombstoneRepo.ListByApplicationID(ctx, tnt, appID)
 }
+
+func (s *service) ListByApplicationIDPage(ctx context.Context, appID string, pageSize int, cursor string) (*model.TombstonePage, error) {
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if pageSize < 1 || pageSize > 200 {
+		return nil, apperrors.NewInvalidDataError("page size must be between 1 and 200")
+	}
+
+	return s.tombstoneRepo.ListByApplicationIDPage(ctx, tnt, appID, pageSize, cursor)
+}
+
+func (s *service) List(ctx context.Context, pageSize int, cursor string) (*model.TombstonePage, error) {
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if pageSize < 1 || pageSize > 200 {
+		return nil, apperrors.NewInvalidDataError("page size must be between 1 and 200")
+	}
+
+	return s.tombstoneRepo.List(ctx, tnt, pageSize, cursor)
+}
@@ -111,6 +111,29 @@ func (_m *WebhookRepository) ListByApplicationID(ctx context.Context, tenant str
 	return r0, r1
 }
 
+// ListByApplicationIDs provides a mock function with given fields: ctx, tenant, applicationIDs
+func (_m *WebhookRepository) ListByApplicationIDs(ctx context.Context, tenant string, applicationIDs []string) ([]*model.Webhook, error) {
+	ret := _m.Called(ctx, tenant, applicationIDs)
+
+	var r0 []*model.Webhook
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string) []*model.Webhook); ok {
+		r0 = rf(ctx, tenant, applicationIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Webhook)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, []string) error); ok {
+		r1 = rf(ctx, tenant, applicationIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ListByApplicationTemplateID provides a mock function with given fields: ctx, applicationTemplateID
 func (_m *WebhookRepository) ListByApplicationTemplateID(ctx context.Context, applicationTemplateID string) ([]*model.Webhook, error) {
 	ret := _m.Called(ctx, applicationTemplateID)
@@ -134,6 +157,75 @@ func (_m *WebhookRepository) ListByApplicationTemplateID(ctx context.Context, ap
 	return r0, r1
 }
 
+// ListByApplicationTemplateIDs provides a mock function with given fields: ctx, applicationTemplateIDs
+func (_m *WebhookRepository) ListByApplicationTemplateIDs(ctx context.Context, applicationTemplateIDs []string) ([]*model.Webhook, error) {
+	ret := _m.Called(ctx, applicationTemplateIDs)
+
+	var r0 []*model.Webhook
+	if rf, ok := ret.Get(0).(func(context.Context, []string) []*model.Webhook); ok {
+		r0 = rf(ctx, applicationTemplateIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Webhook)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = rf(ctx, applicationTemplateIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListByIntegrationSystemID provides a mock function with given fields: ctx, integrationSystemID
+func (_m *WebhookRepository) ListByIntegrationSystemID(ctx context.Context, integrationSystemID string) ([]*model.Webhook, error) {
+	ret := _m.Called(ctx, integrationSystemID)
+
+	var r0 []*model.Webhook
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*model.Webhook); ok {
+		r0 = rf(ctx, integrationSystemID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Webhook)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, integrationSystemID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListByRuntimeID provides a mock function with given fields: ctx, tenant, runtimeID
+func (_m *WebhookRepository) ListByRuntimeID(ctx context.Context, tenant string, runtimeID string) ([]*model.Webhook, error) {
+	ret := _m.Called(ctx, tenant, runtimeID)
+
+	var r0 []*model.Webhook
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) []*model.Webhook); ok {
+		r0 = rf(ctx, tenant, runtimeID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Webhook)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, tenant, runtimeID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Update provides a mock function with given fields: ctx, item
 func (_m *WebhookRepository) Update(ctx context.Context, item *model.Webhook) error {
 	ret := _m.Called(ctx, item)
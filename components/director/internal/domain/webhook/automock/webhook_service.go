@@ -95,6 +95,29 @@ func (_m *WebhookService) ListAllApplicationWebhooks(ctx context.Context, applic
 	return r0, r1
 }
 
+// ListForRuntime provides a mock function with given fields: ctx, runtimeID
+func (_m *WebhookService) ListForRuntime(ctx context.Context, runtimeID string) ([]*model.Webhook, error) {
+	ret := _m.Called(ctx, runtimeID)
+
+	var r0 []*model.Webhook
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*model.Webhook); ok {
+		r0 = rf(ctx, runtimeID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Webhook)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, runtimeID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Update provides a mock function with given fields: ctx, id, in
 func (_m *WebhookService) Update(ctx context.Context, id string, in model.WebhookInput) error {
 	ret := _m.Called(ctx, id, in)
@@ -55,6 +55,7 @@ func (c *converter) ToGraphQL(in *model.Webhook) (*graphql.Webhook, error) {
 		Auth:                  auth,
 		CorrelationIDKey:      in.CorrelationIDKey,
 		RetryInterval:         in.RetryInterval,
+		RetryPolicy:           retryPolicyToGraphQL(in.RetryPolicy),
 		Timeout:               in.Timeout,
 		URLTemplate:           in.URLTemplate,
 		InputTemplate:         in.InputTemplate,
@@ -105,6 +106,7 @@ func (c *converter) InputFromGraphQL(in *graphql.WebhookInput) (*model.WebhookIn
 		Mode:             webhookMode,
 		CorrelationIDKey: in.CorrelationIDKey,
 		RetryInterval:    in.RetryInterval,
+		RetryPolicy:      retryPolicyInputFromGraphQL(in.RetryPolicy),
 		Timeout:          in.Timeout,
 		URLTemplate:      in.URLTemplate,
 		InputTemplate:    in.InputTemplate,
@@ -114,6 +116,36 @@ func (c *converter) InputFromGraphQL(in *graphql.WebhookInput) (*model.WebhookIn
 	}, nil
 }
 
+func retryPolicyToGraphQL(in *model.WebhookRetryPolicy) *graphql.WebhookRetryPolicy {
+	if in == nil {
+		return nil
+	}
+
+	return &graphql.WebhookRetryPolicy{
+		MaxAttempts:          in.MaxAttempts,
+		InitialInterval:      in.InitialInterval,
+		MaxInterval:          in.MaxInterval,
+		Multiplier:           in.Multiplier,
+		Jitter:               in.Jitter,
+		RetryableStatusCodes: in.RetryableStatusCodes,
+	}
+}
+
+func retryPolicyInputFromGraphQL(in *graphql.WebhookRetryPolicyInput) *model.WebhookRetryPolicyInput {
+	if in == nil {
+		return nil
+	}
+
+	return &model.WebhookRetryPolicyInput{
+		MaxAttempts:          in.MaxAttempts,
+		InitialInterval:      in.InitialInterval,
+		MaxInterval:          in.MaxInterval,
+		Multiplier:           in.Multiplier,
+		Jitter:               in.Jitter,
+		RetryableStatusCodes: in.RetryableStatusCodes,
+	}
+}
+
 func (c *converter) MultipleInputFromGraphQL(in []*graphql.WebhookInput) ([]*model.WebhookInput, error) {
 	var inputs []*model.WebhookInput
 	for _, r := range in {
@@ -137,6 +169,11 @@ func (c *converter) ToEntity(in model.Webhook) (Entity, error) {
 		return Entity{}, err
 	}
 
+	optionalRetryPolicy, err := c.toRetryPolicyEntity(in)
+	if err != nil {
+		return Entity{}, err
+	}
+
 	var webhookMode sql.NullString
 	if in.Mode != nil {
 		webhookMode.String = string(*in.Mode)
@@ -156,6 +193,7 @@ func (c *converter) ToEntity(in model.Webhook) (Entity, error) {
 		Auth:                  optionalAuth,
 		Mode:                  webhookMode,
 		RetryInterval:         repo.NewNullableInt(in.RetryInterval),
+		RetryPolicy:           optionalRetryPolicy,
 		Timeout:               repo.NewNullableInt(in.Timeout),
 		URLTemplate:           repo.NewNullableString(in.URLTemplate),
 		InputTemplate:         repo.NewNullableString(in.InputTemplate),
@@ -182,12 +220,34 @@ func (c *converter) toAuthEntity(in model.Webhook) (sql.NullString, error) {
 	return optionalAuth, nil
 }
 
+func (c *converter) toRetryPolicyEntity(in model.Webhook) (sql.NullString, error) {
+	var optionalRetryPolicy sql.NullString
+	if in.RetryPolicy == nil {
+		return optionalRetryPolicy, nil
+	}
+
+	b, err := json.Marshal(in.RetryPolicy)
+	if err != nil {
+		return sql.NullString{}, errors.Wrap(err, "while marshalling RetryPolicy")
+	}
+
+	if err := optionalRetryPolicy.Scan(b); err != nil {
+		return sql.NullString{}, errors.Wrap(err, "while scanning optional RetryPolicy")
+	}
+	return optionalRetryPolicy, nil
+}
+
 func (c *converter) FromEntity(in Entity) (model.Webhook, error) {
 	auth, err := c.fromEntityAuth(in)
 	if err != nil {
 		return model.Webhook{}, err
 	}
 
+	retryPolicy, err := c.fromEntityRetryPolicy(in)
+	if err != nil {
+		return model.Webhook{}, err
+	}
+
 	var webhookMode *model.WebhookMode
 	if in.Mode.Valid {
 		webhookModeStr := model.WebhookMode(in.Mode.String)
@@ -207,6 +267,7 @@ func (c *converter) FromEntity(in Entity) (model.Webhook, error) {
 		Auth:                  auth,
 		Mode:                  webhookMode,
 		RetryInterval:         repo.IntPtrFromNullableInt(in.RetryInterval),
+		RetryPolicy:           retryPolicy,
 		Timeout:               repo.IntPtrFromNullableInt(in.Timeout),
 		URLTemplate:           repo.StringPtrFromNullableString(in.URLTemplate),
 		InputTemplate:         repo.StringPtrFromNullableString(in.InputTemplate),
@@ -238,6 +299,19 @@ func (c *converter) fromEntityAuth(in Entity) (*model.Auth, error) {
 	return auth, nil
 }
 
+func (c *converter) fromEntityRetryPolicy(in Entity) (*model.WebhookRetryPolicy, error) {
+	if !in.RetryPolicy.Valid {
+		return nil, nil
+	}
+
+	retryPolicy := &model.WebhookRetryPolicy{}
+	if err := json.Unmarshal([]byte(in.RetryPolicy.String), retryPolicy); err != nil {
+		return nil, errors.Wrap(err, "while unmarshaling RetryPolicy")
+	}
+
+	return retryPolicy, nil
+}
+
 func nullableInt(n *int) int {
 	if n != nil {
 		return *n
@@ -175,6 +175,10 @@ func TestConverter_ToEntity(t *testing.T) {
 	require.NoError(t, err)
 	expectedBasicAuthAsString := string(b)
 
+	b, err = json.Marshal(fixModelRetryPolicy())
+	require.NoError(t, err)
+	expectedRetryPolicyAsString := string(b)
+
 	testCases := map[string]struct {
 		in       model.Webhook
 		expected webhook.Entity
@@ -214,6 +218,14 @@ func TestConverter_ToEntity(t *testing.T) {
 				Auth: sql.NullString{Valid: true, String: expectedBasicAuthAsString},
 			},
 		},
+		"success when RetryPolicy provided": {
+			in: model.Webhook{
+				RetryPolicy: fixModelRetryPolicy(),
+			},
+			expected: webhook.Entity{
+				RetryPolicy: sql.NullString{Valid: true, String: expectedRetryPolicyAsString},
+			},
+		},
 	}
 
 	for tn, tc := range testCases {
@@ -233,6 +245,8 @@ func TestConverter_FromEntity(t *testing.T) {
 	sut := webhook.NewConverter(nil)
 	b, err := json.Marshal(givenBasicAuth())
 	require.NoError(t, err)
+	retryPolicy, err := json.Marshal(fixModelRetryPolicy())
+	require.NoError(t, err)
 
 	testCases := map[string]struct {
 		inEntity      webhook.Entity
@@ -279,6 +293,19 @@ func TestConverter_FromEntity(t *testing.T) {
 				Auth: givenBasicAuth(),
 			},
 		},
+		"success when RetryPolicy provided": {
+			inEntity: webhook.Entity{
+				ID: "givenID",
+				RetryPolicy: sql.NullString{
+					Valid:  true,
+					String: string(retryPolicy),
+				},
+			},
+			expectedModel: model.Webhook{
+				ID:          "givenID",
+				RetryPolicy: fixModelRetryPolicy(),
+			},
+		},
 		"got error on unmarshaling JSON": {
 			inEntity: webhook.Entity{
 				Auth: sql.NullString{
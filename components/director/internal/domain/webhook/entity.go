@@ -15,6 +15,7 @@ type Entity struct {
 	URL                   sql.NullString `db:"url"`
 	Auth                  sql.NullString `db:"auth"`
 	RetryInterval         sql.NullInt32  `db:"retry_interval"`
+	RetryPolicy           sql.NullString `db:"retry_policy"`
 	Timeout               sql.NullInt32  `db:"timeout"`
 	URLTemplate           sql.NullString `db:"url_template"`
 	InputTemplate         sql.NullString `db:"input_template"`
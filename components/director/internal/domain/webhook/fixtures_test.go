@@ -11,6 +11,14 @@ func stringPtr(s string) *string {
 	return &s
 }
 
+func intPtr(i int) *int {
+	return &i
+}
+
+func floatPtr(f float64) *float64 {
+	return &f
+}
+
 func fixApplicationModelWebhook(id, appID, tenant, url string) *model.Webhook {
 	return &model.Webhook{
 		ID:             id,
@@ -20,6 +28,7 @@ func fixApplicationModelWebhook(id, appID, tenant, url string) *model.Webhook {
 		URL:            &url,
 		Auth:           &model.Auth{},
 		Mode:           &modelWebhookMode,
+		RetryPolicy:    fixModelRetryPolicy(),
 		URLTemplate:    &emptyTemplate,
 		InputTemplate:  &emptyTemplate,
 		HeaderTemplate: &emptyTemplate,
@@ -42,6 +51,37 @@ func fixApplicationTemplateModelWebhook(id, appTemplateID, url string) *model.We
 	}
 }
 
+func fixRuntimeModelWebhook(id, runtimeID, tenant, url string) *model.Webhook {
+	return &model.Webhook{
+		ID:             id,
+		RuntimeID:      &runtimeID,
+		TenantID:       &tenant,
+		Type:           model.WebhookTypeRegisterRuntime,
+		URL:            &url,
+		Auth:           &model.Auth{},
+		Mode:           &modelWebhookMode,
+		URLTemplate:    &emptyTemplate,
+		InputTemplate:  &emptyTemplate,
+		HeaderTemplate: &emptyTemplate,
+		OutputTemplate: &emptyTemplate,
+	}
+}
+
+func fixIntegrationSystemModelWebhook(id, intSysID, url string) *model.Webhook {
+	return &model.Webhook{
+		ID:                  id,
+		IntegrationSystemID: &intSysID,
+		Type:                model.WebhookTypeConfigurationChanged,
+		URL:                 &url,
+		Auth:                &model.Auth{},
+		Mode:                &modelWebhookMode,
+		URLTemplate:         &emptyTemplate,
+		InputTemplate:       &emptyTemplate,
+		HeaderTemplate:      &emptyTemplate,
+		OutputTemplate:      &emptyTemplate,
+	}
+}
+
 func fixGQLWebhook(id, appID, url string) *graphql.Webhook {
 	return &graphql.Webhook{
 		ID:             id,
@@ -50,6 +90,7 @@ func fixGQLWebhook(id, appID, url string) *graphql.Webhook {
 		URL:            &url,
 		Auth:           &graphql.Auth{},
 		Mode:           &graphqlWebhookMode,
+		RetryPolicy:    fixGQLRetryPolicy(),
 		URLTemplate:    &emptyTemplate,
 		InputTemplate:  &emptyTemplate,
 		HeaderTemplate: &emptyTemplate,
@@ -63,6 +104,7 @@ func fixModelWebhookInput(url string) *model.WebhookInput {
 		URL:            &url,
 		Auth:           &model.AuthInput{},
 		Mode:           &modelWebhookMode,
+		RetryPolicy:    fixModelRetryPolicyInput(),
 		URLTemplate:    &emptyTemplate,
 		InputTemplate:  &emptyTemplate,
 		HeaderTemplate: &emptyTemplate,
@@ -76,6 +118,7 @@ func fixGQLWebhookInput(url string) *graphql.WebhookInput {
 		URL:            &url,
 		Auth:           &graphql.AuthInput{},
 		Mode:           &graphqlWebhookMode,
+		RetryPolicy:    fixGQLRetryPolicyInput(),
 		URLTemplate:    &emptyTemplate,
 		InputTemplate:  &emptyTemplate,
 		HeaderTemplate: &emptyTemplate,
@@ -83,6 +126,50 @@ func fixGQLWebhookInput(url string) *graphql.WebhookInput {
 	}
 }
 
+func fixModelRetryPolicy() *model.WebhookRetryPolicy {
+	return &model.WebhookRetryPolicy{
+		MaxAttempts:          intPtr(5),
+		InitialInterval:      intPtr(1),
+		MaxInterval:          intPtr(60),
+		Multiplier:           floatPtr(2),
+		Jitter:               floatPtr(0.1),
+		RetryableStatusCodes: []int{429, 503},
+	}
+}
+
+func fixModelRetryPolicyInput() *model.WebhookRetryPolicyInput {
+	return &model.WebhookRetryPolicyInput{
+		MaxAttempts:          intPtr(5),
+		InitialInterval:      intPtr(1),
+		MaxInterval:          intPtr(60),
+		Multiplier:           floatPtr(2),
+		Jitter:               floatPtr(0.1),
+		RetryableStatusCodes: []int{429, 503},
+	}
+}
+
+func fixGQLRetryPolicy() *graphql.WebhookRetryPolicy {
+	return &graphql.WebhookRetryPolicy{
+		MaxAttempts:          intPtr(5),
+		InitialInterval:      intPtr(1),
+		MaxInterval:          intPtr(60),
+		Multiplier:           floatPtr(2),
+		Jitter:               floatPtr(0.1),
+		RetryableStatusCodes: []int{429, 503},
+	}
+}
+
+func fixGQLRetryPolicyInput() *graphql.WebhookRetryPolicyInput {
+	return &graphql.WebhookRetryPolicyInput{
+		MaxAttempts:          intPtr(5),
+		InitialInterval:      intPtr(1),
+		MaxInterval:          intPtr(60),
+		Multiplier:           floatPtr(2),
+		Jitter:               floatPtr(0.1),
+		RetryableStatusCodes: []int{429, 503},
+	}
+}
+
 func fixApplicationModelWebhookWithType(id, appID, tenant, url string, webhookType model.WebhookType) (w *model.Webhook) {
 	w = fixApplicationModelWebhook(id, appID, tenant, url)
 	w.Type = webhookType
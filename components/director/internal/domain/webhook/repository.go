@@ -21,8 +21,8 @@ const (
 )
 
 var (
-	webhookColumns         = []string{"id", "tenant_id", "app_id", "app_template_id", "type", "url", "auth", "runtime_id", "integration_system_id", "mode", "correlation_id_key", "retry_interval", "timeout", "url_template", "input_template", "header_template", "output_template", "status_template"}
-	updatableColumns       = []string{"type", "url", "auth", "mode", "retry_interval", "timeout", "url_template", "input_template", "header_template", "output_template", "status_template"}
+	webhookColumns         = []string{"id", "tenant_id", "app_id", "app_template_id", "type", "url", "auth", "runtime_id", "integration_system_id", "mode", "correlation_id_key", "retry_interval", "timeout", "url_template", "input_template", "header_template", "output_template", "status_template", "retry_policy"}
+	updatableColumns       = []string{"type", "url", "auth", "mode", "retry_interval", "timeout", "url_template", "input_template", "header_template", "output_template", "status_template", "retry_policy"}
 	missingInputModelError = apperrors.NewInternalError("model has to be provided")
 	tenantColumn           = "tenant_id"
 )
@@ -37,6 +37,7 @@ type repository struct {
 	singleGetter       repo.SingleGetter
 	singleGetterGlobal repo.SingleGetterGlobal
 	updater            repo.Updater
+	runtimeUpdater     repo.Updater
 	updaterGlobal      repo.UpdaterGlobal
 	creator            repo.Creator
 	deleterGlobal      repo.DeleterGlobal
@@ -52,6 +53,7 @@ func NewRepository(conv EntityConverter) *repository {
 		singleGetterGlobal: repo.NewSingleGetterGlobal(resource.Webhook, tableName, webhookColumns),
 		creator:            repo.NewCreator(resource.Webhook, tableName, webhookColumns),
 		updater:            repo.NewUpdater(resource.Webhook, tableName, updatableColumns, tenantColumn, []string{"id", "app_id"}),
+		runtimeUpdater:     repo.NewUpdater(resource.Webhook, tableName, updatableColumns, tenantColumn, []string{"id", "runtime_id"}),
 		updaterGlobal:      repo.NewUpdaterGlobal(resource.Webhook, tableName, updatableColumns, []string{"id"}),
 		deleterGlobal:      repo.NewDeleterGlobal(resource.Webhook, tableName),
 		deleter:            repo.NewDeleter(resource.Webhook, tableName, tenantColumn),
@@ -131,6 +133,83 @@ func (r *repository) ListByApplicationTemplateID(ctx context.Context, applicatio
 	return out, nil
 }
 
+func (r *repository) ListByApplicationIDs(ctx context.Context, tenant string, applicationIDs []string) ([]*model.Webhook, error) {
+	if len(applicationIDs) == 0 {
+		return nil, nil
+	}
+
+	var entities Collection
+
+	conditions := repo.Conditions{
+		repo.NewInConditionForStringValues("app_id", applicationIDs),
+	}
+
+	if err := r.lister.List(ctx, tenant, &entities, conditions...); err != nil {
+		return nil, err
+	}
+
+	return r.multipleFromEntities(entities)
+}
+
+func (r *repository) ListByApplicationTemplateIDs(ctx context.Context, applicationTemplateIDs []string) ([]*model.Webhook, error) {
+	if len(applicationTemplateIDs) == 0 {
+		return nil, nil
+	}
+
+	var entities Collection
+
+	conditions := repo.Conditions{
+		repo.NewInConditionForStringValues("app_template_id", applicationTemplateIDs),
+	}
+
+	if err := r.listerGlobal.ListGlobal(ctx, &entities, conditions...); err != nil {
+		return nil, err
+	}
+
+	return r.multipleFromEntities(entities)
+}
+
+func (r *repository) ListByRuntimeID(ctx context.Context, tenant, runtimeID string) ([]*model.Webhook, error) {
+	var entities Collection
+
+	conditions := repo.Conditions{
+		repo.NewEqualCondition("runtime_id", runtimeID),
+	}
+
+	if err := r.lister.List(ctx, tenant, &entities, conditions...); err != nil {
+		return nil, err
+	}
+
+	return r.multipleFromEntities(entities)
+}
+
+func (r *repository) ListByIntegrationSystemID(ctx context.Context, integrationSystemID string) ([]*model.Webhook, error) {
+	var entities Collection
+
+	conditions := repo.Conditions{
+		repo.NewEqualCondition("integration_system_id", integrationSystemID),
+	}
+
+	if err := r.listerGlobal.ListGlobal(ctx, &entities, conditions...); err != nil {
+		return nil, err
+	}
+
+	return r.multipleFromEntities(entities)
+}
+
+func (r *repository) multipleFromEntities(entities Collection) ([]*model.Webhook, error) {
+	var out []*model.Webhook
+	for _, ent := range entities {
+		w, err := r.conv.FromEntity(ent)
+		if err != nil {
+			return nil, errors.Wrap(err, "while converting Webhook to model")
+		}
+		out = append(out, &w)
+	}
+
+	return out, nil
+}
+
 func (r *repository) Create(ctx context.Context, item *model.Webhook) error {
 	if item == nil {
 		return missingInputModelError
@@ -165,6 +244,9 @@ func (r *repository) Update(ctx context.Context, item *model.Webhook) error {
 	if item.TenantID == nil {
 		return r.updaterGlobal.UpdateSingleGlobal(ctx, entity)
 	}
+	if item.RuntimeID != nil {
+		return r.runtimeUpdater.UpdateSingle(ctx, entity)
+	}
 	return r.updater.UpdateSingle(ctx, entity)
 }
 
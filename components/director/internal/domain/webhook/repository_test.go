@@ -44,10 +44,10 @@ func TestRepositoryGetByID(t *testing.T) {
 		defer dbMock.AssertExpectations(t)
 
 		rows := sqlmock.NewRows([]string{"id", "tenant_id", "app_id", "app_template_id", "type", "url", "auth",
-			"runtime_id", "integration_system_id", "mode", "correlation_id_key", "retry_interval", "timeout", "url_template", "input_template", "header_template", "output_template", "status_template"}).AddRow(
-			givenID(), givenTenant(), givenApplicationID(), givenApplicationTemplateID(), model.WebhookTypeConfigurationChanged, "http://kyma.io", nil, nil, nil, model.WebhookModeSync, nil, nil, nil, "{}", "{}", "{}", "{}", nil)
+			"runtime_id", "integration_system_id", "mode", "correlation_id_key", "retry_interval", "timeout", "url_template", "input_template", "header_template", "output_template", "status_template", "retry_policy"}).AddRow(
+			givenID(), givenTenant(), givenApplicationID(), givenApplicationTemplateID(), model.WebhookTypeConfigurationChanged, "http://kyma.io", nil, nil, nil, model.WebhookModeSync, nil, nil, nil, "{}", "{}", "{}", "{}", nil, nil)
 
-		dbMock.ExpectQuery(regexp.QuoteMeta("SELECT id, tenant_id, app_id, app_template_id, type, url, auth, runtime_id, integration_system_id, mode, correlation_id_key, retry_interval, timeout, url_template, input_template, header_template, output_template, status_template FROM public.webhooks WHERE tenant_id = $1 AND id = $2")).
+		dbMock.ExpectQuery(regexp.QuoteMeta("SELECT id, tenant_id, app_id, app_template_id, type, url, auth, runtime_id, integration_system_id, mode, correlation_id_key, retry_interval, timeout, url_template, input_template, header_template, output_template, status_template, retry_policy FROM public.webhooks WHERE tenant_id = $1 AND id = $2")).
 			WithArgs(givenTenant(), givenID()).WillReturnRows(rows)
 
 		ctx := persistence.SaveToContext(context.TODO(), db)
@@ -70,10 +70,10 @@ func TestRepositoryGetByID(t *testing.T) {
 		defer dbMock.AssertExpectations(t)
 
 		rows := sqlmock.NewRows([]string{"id", "tenant_id", "app_id", "app_template_id", "type", "url", "auth",
-			"runtime_id", "integration_system_id", "mode", "correlation_id_key", "retry_interval", "timeout", "url_template", "input_template", "header_template", "output_template", "status_template"}).AddRow(
-			givenID(), givenTenant(), givenApplicationID(), givenApplicationTemplateID(), model.WebhookTypeConfigurationChanged, "http://kyma.io", givenAuthAsAString(t), nil, nil, model.WebhookModeSync, nil, nil, nil, "{}", "{}", "{}", "{}", nil)
+			"runtime_id", "integration_system_id", "mode", "correlation_id_key", "retry_interval", "timeout", "url_template", "input_template", "header_template", "output_template", "status_template", "retry_policy"}).AddRow(
+			givenID(), givenTenant(), givenApplicationID(), givenApplicationTemplateID(), model.WebhookTypeConfigurationChanged, "http://kyma.io", givenAuthAsAString(t), nil, nil, model.WebhookModeSync, nil, nil, nil, "{}", "{}", "{}", "{}", nil, nil)
 
-		dbMock.ExpectQuery(regexp.QuoteMeta("SELECT id, tenant_id, app_id, app_template_id, type, url, auth, runtime_id, integration_system_id, mode, correlation_id_key, retry_interval, timeout, url_template, input_template, header_template, output_template, status_template FROM public.webhooks WHERE tenant_id = $1 AND id = $2")).
+		dbMock.ExpectQuery(regexp.QuoteMeta("SELECT id, tenant_id, app_id, app_template_id, type, url, auth, runtime_id, integration_system_id, mode, correlation_id_key, retry_interval, timeout, url_template, input_template, header_template, output_template, status_template, retry_policy FROM public.webhooks WHERE tenant_id = $1 AND id = $2")).
 			WithArgs(givenTenant(), givenID()).WillReturnRows(rows)
 
 		ctx := persistence.SaveToContext(context.TODO(), db)
@@ -96,8 +96,8 @@ func TestRepositoryGetByID(t *testing.T) {
 		defer dbMock.AssertExpectations(t)
 
 		rows := sqlmock.NewRows([]string{"id", "tenant_id", "app_id", "app_template_id", "type", "url", "auth",
-			"runtime_id", "integration_system_id", "mode", "correlation_id_key", "retry_interval", "timeout", "url_template", "input_template", "header_template", "output_template", "status_template"}).AddRow(
-			givenID(), givenTenant(), givenApplicationID(), givenApplicationTemplateID(), model.WebhookTypeConfigurationChanged, "http://kyma.io", nil, nil, nil, model.WebhookModeSync, nil, nil, nil, "{}", "{}", "{}", "{}", nil)
+			"runtime_id", "integration_system_id", "mode", "correlation_id_key", "retry_interval", "timeout", "url_template", "input_template", "header_template", "output_template", "status_template", "retry_policy"}).AddRow(
+			givenID(), givenTenant(), givenApplicationID(), givenApplicationTemplateID(), model.WebhookTypeConfigurationChanged, "http://kyma.io", nil, nil, nil, model.WebhookModeSync, nil, nil, nil, "{}", "{}", "{}", "{}", nil, nil)
 
 		dbMock.ExpectQuery("SELECT .*").
 			WithArgs(givenTenant(), givenID()).WillReturnRows(rows)
@@ -137,8 +137,8 @@ func TestRepositoryCreate(t *testing.T) {
 		db, dbMock := testdb.MockDatabase(t)
 		defer dbMock.AssertExpectations(t)
 
-		dbMock.ExpectExec(regexp.QuoteMeta("INSERT INTO public.webhooks ( id, tenant_id, app_id, app_template_id, type, url, auth, runtime_id, integration_system_id, mode, correlation_id_key, retry_interval, timeout, url_template, input_template, header_template, output_template, status_template ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ? )")).WithArgs(
-			givenID(), givenTenant(), givenApplicationID(), givenApplicationTemplateID(), string(model.WebhookTypeConfigurationChanged), "http://kyma.io", nil, nil, nil, model.WebhookModeSync, nil, nil, nil, "{}", "{}", "{}", "{}", nil).WillReturnResult(sqlmock.NewResult(-1, 1))
+		dbMock.ExpectExec(regexp.QuoteMeta("INSERT INTO public.webhooks ( id, tenant_id, app_id, app_template_id, type, url, auth, runtime_id, integration_system_id, mode, correlation_id_key, retry_interval, timeout, url_template, input_template, header_template, output_template, status_template, retry_policy ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ? )")).WithArgs(
+			givenID(), givenTenant(), givenApplicationID(), givenApplicationTemplateID(), string(model.WebhookTypeConfigurationChanged), "http://kyma.io", nil, nil, nil, model.WebhookModeSync, nil, nil, nil, "{}", "{}", "{}", "{}", nil, nil).WillReturnResult(sqlmock.NewResult(-1, 1))
 
 		ctx := persistence.SaveToContext(context.TODO(), db)
 		sut := webhook.NewRepository(mockConverter)
@@ -157,8 +157,8 @@ func TestRepositoryCreate(t *testing.T) {
 		db, dbMock := testdb.MockDatabase(t)
 		defer dbMock.AssertExpectations(t)
 
-		dbMock.ExpectExec(regexp.QuoteMeta("INSERT INTO public.webhooks ( id, tenant_id, app_id, app_template_id, type, url, auth, runtime_id, integration_system_id, mode, correlation_id_key, retry_interval, timeout, url_template, input_template, header_template, output_template, status_template ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ? )")).WithArgs(
-			givenID(), givenTenant(), givenApplicationID(), givenApplicationTemplateID(), string(model.WebhookTypeConfigurationChanged), "http://kyma.io", givenAuthAsAString(t), nil, nil, model.WebhookModeSync, nil, nil, nil, "{}", "{}", "{}", "{}", nil).WillReturnResult(sqlmock.NewResult(-1, 1))
+		dbMock.ExpectExec(regexp.QuoteMeta("INSERT INTO public.webhooks ( id, tenant_id, app_id, app_template_id, type, url, auth, runtime_id, integration_system_id, mode, correlation_id_key, retry_interval, timeout, url_template, input_template, header_template, output_template, status_template, retry_policy ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ? )")).WithArgs(
+			givenID(), givenTenant(), givenApplicationID(), givenApplicationTemplateID(), string(model.WebhookTypeConfigurationChanged), "http://kyma.io", givenAuthAsAString(t), nil, nil, model.WebhookModeSync, nil, nil, nil, "{}", "{}", "{}", "{}", nil, nil).WillReturnResult(sqlmock.NewResult(-1, 1))
 
 		ctx := persistence.SaveToContext(context.TODO(), db)
 		sut := webhook.NewRepository(mockConverter)
@@ -202,7 +202,7 @@ func TestRepositoryCreate(t *testing.T) {
 }
 
 func TestRepositoryCreateMany(t *testing.T) {
-	const expectedInsert = "INSERT INTO public.webhooks ( id, tenant_id, app_id, app_template_id, type, url, auth, runtime_id, integration_system_id, mode, correlation_id_key, retry_interval, timeout, url_template, input_template, header_template, output_template, status_template ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ? )"
+	const expectedInsert = "INSERT INTO public.webhooks ( id, tenant_id, app_id, app_template_id, type, url, auth, runtime_id, integration_system_id, mode, correlation_id_key, retry_interval, timeout, url_template, input_template, header_template, output_template, status_template, retry_policy ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ? )"
 	t.Run(testCaseSuccess, func(t *testing.T) {
 		// GIVEN
 		mockConverter := &automock.EntityConverter{}
@@ -217,11 +217,11 @@ func TestRepositoryCreateMany(t *testing.T) {
 		defer dbMock.AssertExpectations(t)
 
 		dbMock.ExpectExec(regexp.QuoteMeta(expectedInsert)).WithArgs(
-			"one", nil, nil, nil, "", nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil).WillReturnResult(sqlmock.NewResult(-1, 1))
+			"one", nil, nil, nil, "", nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil).WillReturnResult(sqlmock.NewResult(-1, 1))
 		dbMock.ExpectExec(regexp.QuoteMeta(expectedInsert)).WithArgs(
-			"two", nil, nil, nil, "", nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil).WillReturnResult(sqlmock.NewResult(-1, 1))
+			"two", nil, nil, nil, "", nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil).WillReturnResult(sqlmock.NewResult(-1, 1))
 		dbMock.ExpectExec(regexp.QuoteMeta(expectedInsert)).WithArgs(
-			"three", nil, nil, nil, "", nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil).WillReturnResult(sqlmock.NewResult(-1, 1))
+			"three", nil, nil, nil, "", nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil).WillReturnResult(sqlmock.NewResult(-1, 1))
 
 		ctx := persistence.SaveToContext(context.TODO(), db)
 		sut := webhook.NewRepository(mockConverter)
@@ -284,6 +284,15 @@ func TestRepositoryUpdate(t *testing.T) {
 	applicaitonTemplateModel.TenantID = nil
 	applicaitonTemplatEntity.TenantID = repo.NewValidNullableString("")
 
+	runtimeModel := givenModel()
+	runtimeEntity := givenEntity()
+	runtimeModel.ApplicationID = nil
+	runtimeModel.ApplicationTemplateID = nil
+	runtimeModel.RuntimeID = stringPtr(givenRuntimeID())
+	runtimeEntity.ApplicationID = repo.NewValidNullableString("")
+	runtimeEntity.ApplicationTemplateID = repo.NewValidNullableString("")
+	runtimeEntity.RuntimeID = repo.NewValidNullableString(givenRuntimeID())
+
 	tests := []struct {
 		name                string
 		mockConverterSetter func(*automock.EntityConverter)
@@ -298,8 +307,8 @@ func TestRepositoryUpdate(t *testing.T) {
 			},
 			dbMockSetter: func() (*sqlx.DB, testdb.DBMock) {
 				db, dbMock := testdb.MockDatabase(t)
-				dbMock.ExpectExec(regexp.QuoteMeta("UPDATE public.webhooks SET type = ?, url = ?, auth = ?, mode = ?, retry_interval = ?, timeout = ?, url_template = ?, input_template = ?, header_template = ?, output_template = ?, status_template = ? WHERE tenant_id = ? AND id = ? AND app_id = ?")).WithArgs(
-					string(model.WebhookTypeConfigurationChanged), "http://kyma.io", nil, model.WebhookModeSync, nil, nil, "{}", "{}", "{}", "{}", nil, givenTenant(), givenID(), givenApplicationID()).WillReturnResult(sqlmock.NewResult(-1, 1))
+				dbMock.ExpectExec(regexp.QuoteMeta("UPDATE public.webhooks SET type = ?, url = ?, auth = ?, mode = ?, retry_interval = ?, timeout = ?, url_template = ?, input_template = ?, header_template = ?, output_template = ?, status_template = ?, retry_policy = ? WHERE tenant_id = ? AND id = ? AND app_id = ?")).WithArgs(
+					string(model.WebhookTypeConfigurationChanged), "http://kyma.io", nil, model.WebhookModeSync, nil, nil, "{}", "{}", "{}", "{}", nil, nil, givenTenant(), givenID(), givenApplicationID()).WillReturnResult(sqlmock.NewResult(-1, 1))
 				return db, dbMock
 			},
 			model:         applicaitonModel,
@@ -312,13 +321,27 @@ func TestRepositoryUpdate(t *testing.T) {
 			},
 			dbMockSetter: func() (*sqlx.DB, testdb.DBMock) {
 				db, dbMock := testdb.MockDatabase(t)
-				dbMock.ExpectExec(regexp.QuoteMeta("UPDATE public.webhooks SET type = ?, url = ?, auth = ?, mode = ?, retry_interval = ?, timeout = ?, url_template = ?, input_template = ?, header_template = ?, output_template = ?, status_template = ? WHERE id = ?")).WithArgs(
-					string(model.WebhookTypeConfigurationChanged), "http://kyma.io", nil, model.WebhookModeSync, nil, nil, "{}", "{}", "{}", "{}", nil, givenID()).WillReturnResult(sqlmock.NewResult(-1, 1))
+				dbMock.ExpectExec(regexp.QuoteMeta("UPDATE public.webhooks SET type = ?, url = ?, auth = ?, mode = ?, retry_interval = ?, timeout = ?, url_template = ?, input_template = ?, header_template = ?, output_template = ?, status_template = ?, retry_policy = ? WHERE id = ?")).WithArgs(
+					string(model.WebhookTypeConfigurationChanged), "http://kyma.io", nil, model.WebhookModeSync, nil, nil, "{}", "{}", "{}", "{}", nil, nil, givenID()).WillReturnResult(sqlmock.NewResult(-1, 1))
 				return db, dbMock
 			},
 			model:         applicaitonTemplateModel,
 			expectedError: nil,
 		},
+		{
+			name: "Success for runtime Webhook",
+			mockConverterSetter: func(converter *automock.EntityConverter) {
+				converter.On("ToEntity", runtimeModel).Return(runtimeEntity, nil)
+			},
+			dbMockSetter: func() (*sqlx.DB, testdb.DBMock) {
+				db, dbMock := testdb.MockDatabase(t)
+				dbMock.ExpectExec(regexp.QuoteMeta("UPDATE public.webhooks SET type = ?, url = ?, auth = ?, mode = ?, retry_interval = ?, timeout = ?, url_template = ?, input_template = ?, header_template = ?, output_template = ?, status_template = ?, retry_policy = ? WHERE tenant_id = ? AND id = ? AND runtime_id = ?")).WithArgs(
+					string(model.WebhookTypeConfigurationChanged), "http://kyma.io", nil, model.WebhookModeSync, nil, nil, "{}", "{}", "{}", "{}", nil, nil, givenTenant(), givenID(), givenRuntimeID()).WillReturnResult(sqlmock.NewResult(-1, 1))
+				return db, dbMock
+			},
+			model:         runtimeModel,
+			expectedError: nil,
+		},
 		{
 			name: testCaseErrorOnConvertingObjects,
 			mockConverterSetter: func(converter *automock.EntityConverter) {
@@ -471,7 +494,7 @@ func TestRepositoryListByApplicationID(t *testing.T) {
 			AddRow(givenID(), givenTenant(), givenApplicationID(), nil, model.WebhookTypeConfigurationChanged, "http://kyma.io", nil).
 			AddRow(anotherID(), givenTenant(), givenApplicationID(), nil, model.WebhookTypeConfigurationChanged, "http://kyma2.io", nil)
 
-		dbMock.ExpectQuery(regexp.QuoteMeta("SELECT id, tenant_id, app_id, app_template_id, type, url, auth, runtime_id, integration_system_id, mode, correlation_id_key, retry_interval, timeout, url_template, input_template, header_template, output_template, status_template FROM public.webhooks WHERE tenant_id = $1 AND app_id = $2")).
+		dbMock.ExpectQuery(regexp.QuoteMeta("SELECT id, tenant_id, app_id, app_template_id, type, url, auth, runtime_id, integration_system_id, mode, correlation_id_key, retry_interval, timeout, url_template, input_template, header_template, output_template, status_template, retry_policy FROM public.webhooks WHERE tenant_id = $1 AND app_id = $2")).
 			WithArgs(givenTenant(), givenApplicationID()).
 			WillReturnRows(rows)
 		ctx := persistence.SaveToContext(context.TODO(), db)
@@ -569,7 +592,7 @@ func TestRepositoryListByApplicationTemplateID(t *testing.T) {
 			AddRow(givenID(), givenApplicationTemplateID(), model.WebhookTypeConfigurationChanged, "http://kyma.io", nil).
 			AddRow(anotherID(), givenApplicationTemplateID(), model.WebhookTypeConfigurationChanged, "http://kyma2.io", nil)
 
-		dbMock.ExpectQuery(regexp.QuoteMeta("SELECT id, tenant_id, app_id, app_template_id, type, url, auth, runtime_id, integration_system_id, mode, correlation_id_key, retry_interval, timeout, url_template, input_template, header_template, output_template, status_template FROM public.webhooks WHERE app_template_id = $1")).
+		dbMock.ExpectQuery(regexp.QuoteMeta("SELECT id, tenant_id, app_id, app_template_id, type, url, auth, runtime_id, integration_system_id, mode, correlation_id_key, retry_interval, timeout, url_template, input_template, header_template, output_template, status_template, retry_policy FROM public.webhooks WHERE app_template_id = $1")).
 			WithArgs(givenApplicationTemplateID()).
 			WillReturnRows(rows)
 		ctx := persistence.SaveToContext(context.TODO(), db)
@@ -658,6 +681,10 @@ func givenApplicationID() string {
 	return "cccccccc-cccc-cccc-cccc-cccccccccccc"
 }
 
+func givenRuntimeID() string {
+	return "dddddddd-dddd-dddd-dddd-dddddddddddd"
+}
+
 func givenApplicationTemplateID() string {
 	return "ffffffff-ffff-ffff-ffff-ffffffffffff"
 }
@@ -723,3 +750,238 @@ func ptr(in model.Webhook) *model.Webhook {
 func givenError() error {
 	return errors.New("some error")
 }
+
+func TestRepositoryListByApplicationIDs(t *testing.T) {
+	anotherApplicationID := "ccccccccc-cccc-cccc-cccc-cccccccccccc"
+
+	t.Run(testCaseSuccess, func(t *testing.T) {
+		// GIVEN
+		mockConv := &automock.EntityConverter{}
+		defer mockConv.AssertExpectations(t)
+		mockConv.On("FromEntity",
+			webhook.Entity{ID: givenID(),
+				TenantID:      repo.NewValidNullableString(givenTenant()),
+				ApplicationID: repo.NewValidNullableString(givenApplicationID()),
+				Type:          string(model.WebhookTypeConfigurationChanged),
+				URL:           repo.NewValidNullableString("http://kyma.io")}).
+			Return(model.Webhook{ID: givenID()}, nil)
+
+		mockConv.On("FromEntity",
+			webhook.Entity{ID: anotherID(),
+				TenantID:      repo.NewValidNullableString(givenTenant()),
+				ApplicationID: repo.NewValidNullableString(anotherApplicationID),
+				Type:          string(model.WebhookTypeConfigurationChanged),
+				URL:           repo.NewValidNullableString("http://kyma2.io")}).
+			Return(model.Webhook{ID: anotherID()}, nil)
+
+		sut := webhook.NewRepository(mockConv)
+
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		rows := sqlmock.NewRows([]string{"id", "tenant_id", "app_id", "app_template_id", "type", "url", "auth"}).
+			AddRow(givenID(), givenTenant(), givenApplicationID(), nil, model.WebhookTypeConfigurationChanged, "http://kyma.io", nil).
+			AddRow(anotherID(), givenTenant(), anotherApplicationID, nil, model.WebhookTypeConfigurationChanged, "http://kyma2.io", nil)
+
+		dbMock.ExpectQuery(regexp.QuoteMeta("SELECT id, tenant_id, app_id, app_template_id, type, url, auth, runtime_id, integration_system_id, mode, correlation_id_key, retry_interval, timeout, url_template, input_template, header_template, output_template, status_template, retry_policy FROM public.webhooks WHERE tenant_id = $1 AND app_id IN ($2, $3)")).
+			WithArgs(givenTenant(), givenApplicationID(), anotherApplicationID).
+			WillReturnRows(rows)
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		// WHEN
+		actual, err := sut.ListByApplicationIDs(ctx, givenTenant(), []string{givenApplicationID(), anotherApplicationID})
+		// THEN
+		require.NoError(t, err)
+		require.Len(t, actual, 2)
+		assert.Equal(t, givenID(), actual[0].ID)
+		assert.Equal(t, anotherID(), actual[1].ID)
+	})
+
+	t.Run("success if no application IDs", func(t *testing.T) {
+		// GIVEN
+		sut := webhook.NewRepository(nil)
+		// WHEN
+		actual, err := sut.ListByApplicationIDs(context.TODO(), givenTenant(), []string{})
+		// THEN
+		require.NoError(t, err)
+		require.Empty(t, actual)
+	})
+
+	t.Run(testCaseErrorOnDBCommunication, func(t *testing.T) {
+		// GIVEN
+		sut := webhook.NewRepository(nil)
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		dbMock.ExpectQuery("SELECT").WillReturnError(givenError())
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		// WHEN
+		_, err := sut.ListByApplicationIDs(ctx, givenTenant(), []string{givenApplicationID()})
+		// THEN
+		require.EqualError(t, err, "Internal Server Error: Unexpected error while executing SQL query")
+	})
+}
+
+func TestRepositoryListByApplicationTemplateIDs(t *testing.T) {
+	anotherApplicationTemplateID := "ccccccccc-cccc-cccc-cccc-cccccccccccc"
+
+	t.Run(testCaseSuccess, func(t *testing.T) {
+		// GIVEN
+		mockConv := &automock.EntityConverter{}
+		defer mockConv.AssertExpectations(t)
+		mockConv.On("FromEntity",
+			webhook.Entity{ID: givenID(),
+				ApplicationTemplateID: repo.NewValidNullableString(givenApplicationTemplateID()),
+				Type:                  string(model.WebhookTypeConfigurationChanged),
+				URL:                   repo.NewValidNullableString("http://kyma.io")}).
+			Return(model.Webhook{ID: givenID()}, nil)
+
+		mockConv.On("FromEntity",
+			webhook.Entity{ID: anotherID(),
+				ApplicationTemplateID: repo.NewValidNullableString(anotherApplicationTemplateID),
+				Type:                  string(model.WebhookTypeConfigurationChanged),
+				URL:                   repo.NewValidNullableString("http://kyma2.io")}).
+			Return(model.Webhook{ID: anotherID()}, nil)
+
+		sut := webhook.NewRepository(mockConv)
+
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		rows := sqlmock.NewRows([]string{"id", "app_template_id", "type", "url", "auth"}).
+			AddRow(givenID(), givenApplicationTemplateID(), model.WebhookTypeConfigurationChanged, "http://kyma.io", nil).
+			AddRow(anotherID(), anotherApplicationTemplateID, model.WebhookTypeConfigurationChanged, "http://kyma2.io", nil)
+
+		dbMock.ExpectQuery(regexp.QuoteMeta("SELECT id, tenant_id, app_id, app_template_id, type, url, auth, runtime_id, integration_system_id, mode, correlation_id_key, retry_interval, timeout, url_template, input_template, header_template, output_template, status_template, retry_policy FROM public.webhooks WHERE app_template_id IN ($1, $2)")).
+			WithArgs(givenApplicationTemplateID(), anotherApplicationTemplateID).
+			WillReturnRows(rows)
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		// WHEN
+		actual, err := sut.ListByApplicationTemplateIDs(ctx, []string{givenApplicationTemplateID(), anotherApplicationTemplateID})
+		// THEN
+		require.NoError(t, err)
+		require.Len(t, actual, 2)
+		assert.Equal(t, givenID(), actual[0].ID)
+		assert.Equal(t, anotherID(), actual[1].ID)
+	})
+
+	t.Run("success if no application template IDs", func(t *testing.T) {
+		// GIVEN
+		sut := webhook.NewRepository(nil)
+		// WHEN
+		actual, err := sut.ListByApplicationTemplateIDs(context.TODO(), nil)
+		// THEN
+		require.NoError(t, err)
+		require.Empty(t, actual)
+	})
+
+	t.Run(testCaseErrorOnDBCommunication, func(t *testing.T) {
+		// GIVEN
+		sut := webhook.NewRepository(nil)
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		dbMock.ExpectQuery("SELECT").WillReturnError(givenError())
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		// WHEN
+		_, err := sut.ListByApplicationTemplateIDs(ctx, []string{givenApplicationTemplateID()})
+		// THEN
+		require.EqualError(t, err, "Internal Server Error: Unexpected error while executing SQL query")
+	})
+}
+
+func TestRepositoryListByRuntimeID(t *testing.T) {
+	t.Run(testCaseSuccess, func(t *testing.T) {
+		// GIVEN
+		mockConv := &automock.EntityConverter{}
+		defer mockConv.AssertExpectations(t)
+		mockConv.On("FromEntity",
+			webhook.Entity{ID: givenID(),
+				TenantID:  repo.NewValidNullableString(givenTenant()),
+				RuntimeID: repo.NewValidNullableString(givenRuntimeID()),
+				Type:      string(model.WebhookTypeRegisterRuntime),
+				URL:       repo.NewValidNullableString("http://kyma.io")}).
+			Return(model.Webhook{ID: givenID()}, nil)
+
+		sut := webhook.NewRepository(mockConv)
+
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		rows := sqlmock.NewRows([]string{"id", "tenant_id", "runtime_id", "type", "url"}).
+			AddRow(givenID(), givenTenant(), givenRuntimeID(), model.WebhookTypeRegisterRuntime, "http://kyma.io")
+
+		dbMock.ExpectQuery(regexp.QuoteMeta("SELECT id, tenant_id, app_id, app_template_id, type, url, auth, runtime_id, integration_system_id, mode, correlation_id_key, retry_interval, timeout, url_template, input_template, header_template, output_template, status_template, retry_policy FROM public.webhooks WHERE tenant_id = $1 AND runtime_id = $2")).
+			WithArgs(givenTenant(), givenRuntimeID()).
+			WillReturnRows(rows)
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		// WHEN
+		actual, err := sut.ListByRuntimeID(ctx, givenTenant(), givenRuntimeID())
+		// THEN
+		require.NoError(t, err)
+		require.Len(t, actual, 1)
+		assert.Equal(t, givenID(), actual[0].ID)
+	})
+
+	t.Run(testCaseErrorOnDBCommunication, func(t *testing.T) {
+		// GIVEN
+		sut := webhook.NewRepository(nil)
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		dbMock.ExpectQuery("SELECT").WillReturnError(givenError())
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		// WHEN
+		_, err := sut.ListByRuntimeID(ctx, givenTenant(), givenRuntimeID())
+		// THEN
+		require.EqualError(t, err, "Internal Server Error: Unexpected error while executing SQL query")
+	})
+}
+
+func TestRepositoryListByIntegrationSystemID(t *testing.T) {
+	integrationSystemID := "int-sys-id"
+
+	t.Run(testCaseSuccess, func(t *testing.T) {
+		// GIVEN
+		mockConv := &automock.EntityConverter{}
+		defer mockConv.AssertExpectations(t)
+		mockConv.On("FromEntity",
+			webhook.Entity{ID: givenID(),
+				IntegrationSystemID: repo.NewValidNullableString(integrationSystemID),
+				Type:                string(model.WebhookTypeConfigurationChanged),
+				URL:                 repo.NewValidNullableString("http://kyma.io")}).
+			Return(model.Webhook{ID: givenID()}, nil)
+
+		sut := webhook.NewRepository(mockConv)
+
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		rows := sqlmock.NewRows([]string{"id", "integration_system_id", "type", "url"}).
+			AddRow(givenID(), integrationSystemID, model.WebhookTypeConfigurationChanged, "http://kyma.io")
+
+		dbMock.ExpectQuery(regexp.QuoteMeta("SELECT id, tenant_id, app_id, app_template_id, type, url, auth, runtime_id, integration_system_id, mode, correlation_id_key, retry_interval, timeout, url_template, input_template, header_template, output_template, status_template, retry_policy FROM public.webhooks WHERE integration_system_id = $1")).
+			WithArgs(integrationSystemID).
+			WillReturnRows(rows)
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		// WHEN
+		actual, err := sut.ListByIntegrationSystemID(ctx, integrationSystemID)
+		// THEN
+		require.NoError(t, err)
+		require.Len(t, actual, 1)
+		assert.Equal(t, givenID(), actual[0].ID)
+	})
+
+	t.Run(testCaseErrorOnDBCommunication, func(t *testing.T) {
+		// GIVEN
+		sut := webhook.NewRepository(nil)
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		dbMock.ExpectQuery("SELECT").WillReturnError(givenError())
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		// WHEN
+		_, err := sut.ListByIntegrationSystemID(ctx, integrationSystemID)
+		// THEN
+		require.EqualError(t, err, "Internal Server Error: Unexpected error while executing SQL query")
+	})
+}
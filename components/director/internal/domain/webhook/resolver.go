@@ -20,6 +20,7 @@ import (
 type WebhookService interface {
 	Get(ctx context.Context, id string) (*model.Webhook, error)
 	ListAllApplicationWebhooks(ctx context.Context, applicationID string) ([]*model.Webhook, error)
+	ListForRuntime(ctx context.Context, runtimeID string) ([]*model.Webhook, error)
 	Create(ctx context.Context, resourceID string, in model.WebhookInput, converterFunc model.WebhookConverterFunc) (string, error)
 	Update(ctx context.Context, id string, in model.WebhookInput) error
 	Delete(ctx context.Context, id string) error
@@ -35,6 +36,16 @@ type ApplicationTemplateService interface {
 	Exists(ctx context.Context, id string) (bool, error)
 }
 
+//go:generate mockery -name=RuntimeService -output=automock -outpkg=automock -case=underscore
+type RuntimeService interface {
+	Exist(ctx context.Context, id string) (bool, error)
+}
+
+//go:generate mockery -name=IntegrationSystemService -output=automock -outpkg=automock -case=underscore
+type IntegrationSystemService interface {
+	Exists(ctx context.Context, id string) (bool, error)
+}
+
 //go:generate mockery -name=WebhookConverter -output=automock -outpkg=automock -case=underscore
 type WebhookConverter interface {
 	ToGraphQL(in *model.Webhook) (*graphql.Webhook, error)
@@ -54,21 +65,25 @@ type Resolver struct {
 	webhookSvc       WebhookService
 	appSvc           ApplicationService
 	appTemplateSvc   ApplicationTemplateService
+	runtimeSvc       RuntimeService
+	intSysSvc        IntegrationSystemService
 	webhookConverter WebhookConverter
 	transact         persistence.Transactioner
 }
 
-func NewResolver(transact persistence.Transactioner, webhookSvc WebhookService, applicationService ApplicationService, appTemplateService ApplicationTemplateService, webhookConverter WebhookConverter) *Resolver {
+func NewResolver(transact persistence.Transactioner, webhookSvc WebhookService, applicationService ApplicationService, appTemplateService ApplicationTemplateService, runtimeService RuntimeService, intSysService IntegrationSystemService, webhookConverter WebhookConverter) *Resolver {
 	return &Resolver{
 		webhookSvc:       webhookSvc,
 		appSvc:           applicationService,
 		appTemplateSvc:   appTemplateService,
+		runtimeSvc:       runtimeService,
+		intSysSvc:        intSysService,
 		webhookConverter: webhookConverter,
 		transact:         transact,
 	}
 }
 
-func (r *Resolver) AddWebhook(ctx context.Context, applicationID *string, applicationTemplateID *string, in graphql.WebhookInput) (*graphql.Webhook, error) {
+func (r *Resolver) AddWebhook(ctx context.Context, applicationID *string, applicationTemplateID *string, runtimeID *string, integrationSystemID *string, in graphql.WebhookInput) (*graphql.Webhook, error) {
 	tx, err := r.transact.Begin()
 	if err != nil {
 		return nil, err
@@ -76,25 +91,30 @@ func (r *Resolver) AddWebhook(ctx context.Context, applicationID *string, applic
 	defer r.transact.RollbackUnlessCommitted(ctx, tx)
 	ctx = persistence.SaveToContext(ctx, tx)
 
-	appSpecified := applicationID != nil && applicationTemplateID == nil
-	appTemplateSpecified := applicationID == nil && applicationTemplateID != nil
+	var owners []webhookOwner
+	if applicationID != nil {
+		owners = append(owners, webhookOwner{Type: resource.Application, id: *applicationID})
+	}
+	if applicationTemplateID != nil {
+		owners = append(owners, webhookOwner{Type: resource.ApplicationTemplate, id: *applicationTemplateID})
+	}
+	if runtimeID != nil {
+		owners = append(owners, webhookOwner{Type: resource.Runtime, id: *runtimeID})
+	}
+	if integrationSystemID != nil {
+		owners = append(owners, webhookOwner{Type: resource.IntegrationSystem, id: *integrationSystemID})
+	}
 
-	if !(appSpecified || appTemplateSpecified) {
-		return nil, apperrors.NewInvalidDataError("exactly one of applicationId and applicationTemplateID should be specified")
+	if len(owners) != 1 {
+		return nil, apperrors.NewInvalidDataError("exactly one of applicationID, applicationTemplateID, runtimeID and integrationSystemID should be specified")
 	}
+	owner := owners[0]
 
 	convertedIn, err := r.webhookConverter.InputFromGraphQL(&in)
 	if err != nil {
 		return nil, errors.Wrap(err, "while converting the WebhookInput")
 	}
 
-	var owner webhookOwner
-	if appSpecified {
-		owner = webhookOwner{Type: resource.Application, id: *applicationID}
-	} else if appTemplateSpecified {
-		owner = webhookOwner{Type: resource.ApplicationTemplate, id: *applicationTemplateID}
-	}
-
 	id, err := r.checkForExistenceAndCreate(ctx, owner, *convertedIn)
 	if err != nil {
 		return nil, err
@@ -185,6 +205,12 @@ func (r *Resolver) checkForExistenceAndCreate(ctx context.Context, owningResourc
 	case resource.ApplicationTemplate:
 		converterFunc = (*model.WebhookInput).ToApplicationTemplateWebhook
 		existsFunc = r.appTemplateSvc.Exists
+	case resource.Runtime:
+		converterFunc = (*model.WebhookInput).ToRuntimeWebhook
+		existsFunc = r.runtimeSvc.Exist
+	case resource.IntegrationSystem:
+		converterFunc = (*model.WebhookInput).ToIntegrationSystemWebhook
+		existsFunc = r.intSysSvc.Exists
 	}
 	err := r.genericCheckExistence(ctx, owningResource.id, string(owningResource.Type), existsFunc)
 	if err != nil {
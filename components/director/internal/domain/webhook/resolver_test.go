@@ -245,16 +245,16 @@ func TestResolver_AddWebhook(t *testing.T) {
 			persistTxMock := testCase.PersistenceFn()
 			transactionerMock := testCase.TransactionerFn(persistTxMock)
 
-			resolver := webhook.NewResolver(transactionerMock, svc, appSvc, appTemplateSvc, converter)
+			resolver := webhook.NewResolver(transactionerMock, svc, appSvc, appTemplateSvc, nil, nil, converter)
 
 			// when
 			var err error
 			var result *graphql.Webhook
 			if testCase.AppServiceFn != nil {
-				result, err = resolver.AddWebhook(context.TODO(), stringPtr(givenAppID), nil, *gqlWebhookInput)
+				result, err = resolver.AddWebhook(context.TODO(), stringPtr(givenAppID), nil, nil, nil, *gqlWebhookInput)
 			}
 			if testCase.AppTemplateServiceFn != nil {
-				result, err = resolver.AddWebhook(context.TODO(), nil, stringPtr(givenAppTemplateID), *gqlWebhookInput)
+				result, err = resolver.AddWebhook(context.TODO(), nil, stringPtr(givenAppTemplateID), nil, nil, *gqlWebhookInput)
 			}
 
 			// then
@@ -279,6 +279,77 @@ func TestResolver_AddWebhook(t *testing.T) {
 	}
 }
 
+func TestResolver_AddWebhook_RuntimeAndIntegrationSystem(t *testing.T) {
+	// given
+	id := "bar"
+	givenRuntimeID := "runtime-id"
+	givenIntSysID := "int-sys-id"
+	gqlWebhookInput := fixGQLWebhookInput("foo")
+	modelWebhookInput := fixModelWebhookInput("foo")
+	gqlWebhook := fixGQLWebhook(id, "", "")
+	modelWebhook := fixRuntimeModelWebhook(id, givenRuntimeID, givenTenant(), "foo")
+
+	t.Run("Success for runtime", func(t *testing.T) {
+		persistTx := txtest.PersistenceContextThatExpectsCommit()
+		transact := txtest.TransactionerThatSucceeds(persistTx)
+		svc := &automock.WebhookService{}
+		svc.On("Create", txtest.CtxWithDBMatcher(), givenRuntimeID, *modelWebhookInput, mock.AnythingOfType("model.WebhookConverterFunc")).Return(id, nil).Once()
+		svc.On("Get", txtest.CtxWithDBMatcher(), id).Return(modelWebhook, nil).Once()
+		runtimeSvc := &automock.RuntimeService{}
+		runtimeSvc.On("Exist", txtest.CtxWithDBMatcher(), givenRuntimeID).Return(true, nil).Once()
+		converter := &automock.WebhookConverter{}
+		converter.On("InputFromGraphQL", gqlWebhookInput).Return(modelWebhookInput, nil).Once()
+		converter.On("ToGraphQL", modelWebhook).Return(gqlWebhook, nil).Once()
+
+		resolver := webhook.NewResolver(transact, svc, nil, nil, runtimeSvc, nil, converter)
+
+		// when
+		result, err := resolver.AddWebhook(context.TODO(), nil, nil, stringPtr(givenRuntimeID), nil, *gqlWebhookInput)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, gqlWebhook, result)
+		mock.AssertExpectationsForObjects(t, persistTx, transact, svc, runtimeSvc, converter)
+	})
+
+	t.Run("Returns error when integration system does not exist", func(t *testing.T) {
+		persistTx := txtest.PersistenceContextThatDoesntExpectCommit()
+		transact := txtest.TransactionerThatSucceeds(persistTx)
+		svc := &automock.WebhookService{}
+		intSysSvc := &automock.IntegrationSystemService{}
+		intSysSvc.On("Exists", txtest.CtxWithDBMatcher(), givenIntSysID).Return(false, nil).Once()
+		converter := &automock.WebhookConverter{}
+		converter.On("InputFromGraphQL", gqlWebhookInput).Return(modelWebhookInput, nil).Once()
+
+		resolver := webhook.NewResolver(transact, svc, nil, nil, nil, intSysSvc, converter)
+
+		// when
+		result, err := resolver.AddWebhook(context.TODO(), nil, nil, nil, stringPtr(givenIntSysID), *gqlWebhookInput)
+
+		// then
+		require.Error(t, err)
+		assert.Nil(t, result)
+		assert.Contains(t, err.Error(), "cannot add Webhook to not existing integrationSystem")
+		mock.AssertExpectationsForObjects(t, persistTx, transact, svc, intSysSvc, converter)
+	})
+
+	t.Run("Returns error when more than one owner is specified", func(t *testing.T) {
+		persistTx := txtest.PersistenceContextThatDoesntExpectCommit()
+		transact := txtest.TransactionerThatSucceeds(persistTx)
+
+		resolver := webhook.NewResolver(transact, nil, nil, nil, nil, nil, nil)
+
+		// when
+		result, err := resolver.AddWebhook(context.TODO(), stringPtr("app-id"), nil, stringPtr(givenRuntimeID), nil, *gqlWebhookInput)
+
+		// then
+		require.Error(t, err)
+		assert.Nil(t, result)
+		assert.Contains(t, err.Error(), "exactly one of applicationID, applicationTemplateID, runtimeID and integrationSystemID should be specified")
+		mock.AssertExpectationsForObjects(t, persistTx, transact)
+	})
+}
+
 func TestResolver_UpdateWebhook(t *testing.T) {
 	// given
 	testErr := errors.New("Test error")
@@ -400,7 +471,7 @@ func TestResolver_UpdateWebhook(t *testing.T) {
 			persistTxMock := testCase.PersistenceFn()
 			transactionerMock := testCase.TransactionerFn(persistTxMock)
 
-			resolver := webhook.NewResolver(transactionerMock, svc, nil, nil, converter)
+			resolver := webhook.NewResolver(transactionerMock, svc, nil, nil, nil, nil, converter)
 
 			// when
 			result, err := resolver.UpdateWebhook(context.TODO(), givenWebhookID, *gqlWebhookInput)
@@ -535,7 +606,7 @@ func TestResolver_DeleteWebhook(t *testing.T) {
 			persistTxMock := testCase.PersistenceFn()
 			transactionerMock := testCase.TransactionerFn(persistTxMock)
 
-			resolver := webhook.NewResolver(transactionerMock, svc, nil, nil, converter)
+			resolver := webhook.NewResolver(transactionerMock, svc, nil, nil, nil, nil, converter)
 
 			// when
 			result, err := resolver.DeleteWebhook(context.TODO(), givenWebhookID)
@@ -19,6 +19,10 @@ type WebhookRepository interface {
 	GetByIDGlobal(ctx context.Context, id string) (*model.Webhook, error)
 	ListByApplicationID(ctx context.Context, tenant, applicationID string) ([]*model.Webhook, error)
 	ListByApplicationTemplateID(ctx context.Context, applicationTemplateID string) ([]*model.Webhook, error)
+	ListByApplicationIDs(ctx context.Context, tenant string, applicationIDs []string) ([]*model.Webhook, error)
+	ListByApplicationTemplateIDs(ctx context.Context, applicationTemplateIDs []string) ([]*model.Webhook, error)
+	ListByRuntimeID(ctx context.Context, tenant, runtimeID string) ([]*model.Webhook, error)
+	ListByIntegrationSystemID(ctx context.Context, integrationSystemID string) ([]*model.Webhook, error)
 	Create(ctx context.Context, item *model.Webhook) error
 	Update(ctx context.Context, item *model.Webhook) error
 	Delete(ctx context.Context, id string) error
@@ -76,6 +80,18 @@ func (s *service) ListForApplicationTemplate(ctx context.Context, applicationTem
 	return s.webhookRepo.ListByApplicationTemplateID(ctx, applicationTemplateID)
 }
 
+func (s *service) ListForRuntime(ctx context.Context, runtimeID string) ([]*model.Webhook, error) {
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.webhookRepo.ListByRuntimeID(ctx, tnt, runtimeID)
+}
+
+func (s *service) ListForIntegrationSystem(ctx context.Context, integrationSystemID string) ([]*model.Webhook, error) {
+	return s.webhookRepo.ListByIntegrationSystemID(ctx, integrationSystemID)
+}
+
 func (s *service) ListAllApplicationWebhooks(ctx context.Context, applicationID string) ([]*model.Webhook, error) {
 	application, err := s.appRepo.GetGlobalByID(ctx, applicationID)
 	if err != nil {
@@ -85,6 +101,56 @@ func (s *service) ListAllApplicationWebhooks(ctx context.Context, applicationID
 	return s.retrieveWebhooks(ctx, application)
 }
 
+// ListAllForApplications returns the webhooks of every application, including the webhooks inherited from its application template, grouped by the application ID
+func (s *service) ListAllForApplications(ctx context.Context, applications []*model.Application) (map[string][]*model.Webhook, error) {
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	appIDs := make([]string, 0, len(applications))
+	var appTemplateIDs []string
+	seenAppTemplateIDs := make(map[string]bool)
+	for _, application := range applications {
+		appIDs = append(appIDs, application.ID)
+		if application.ApplicationTemplateID != nil && !seenAppTemplateIDs[*application.ApplicationTemplateID] {
+			seenAppTemplateIDs[*application.ApplicationTemplateID] = true
+			appTemplateIDs = append(appTemplateIDs, *application.ApplicationTemplateID)
+		}
+	}
+
+	appWebhooks, err := s.webhookRepo.ListByApplicationIDs(ctx, tnt, appIDs)
+	if err != nil {
+		return nil, errors.Wrap(err, "while listing Webhooks for Applications")
+	}
+
+	appTemplateWebhooks, err := s.webhookRepo.ListByApplicationTemplateIDs(ctx, appTemplateIDs)
+	if err != nil {
+		return nil, errors.Wrap(err, "while listing Webhooks for Application Templates")
+	}
+
+	webhooksByAppID := make(map[string][]*model.Webhook, len(applications))
+	for _, webhook := range appWebhooks {
+		webhooksByAppID[*webhook.ApplicationID] = append(webhooksByAppID[*webhook.ApplicationID], webhook)
+	}
+
+	webhooksByAppTemplateID := make(map[string][]*model.Webhook)
+	for _, webhook := range appTemplateWebhooks {
+		webhooksByAppTemplateID[*webhook.ApplicationTemplateID] = append(webhooksByAppTemplateID[*webhook.ApplicationTemplateID], webhook)
+	}
+
+	result := make(map[string][]*model.Webhook, len(applications))
+	for _, application := range applications {
+		var templateWebhooks []*model.Webhook
+		if application.ApplicationTemplateID != nil {
+			templateWebhooks = webhooksByAppTemplateID[*application.ApplicationTemplateID]
+		}
+		result[application.ID] = mergeWebhooks(webhooksByAppID[application.ID], templateWebhooks)
+	}
+
+	return result, nil
+}
+
 func (s *service) Create(ctx context.Context, owningResourceID string, in model.WebhookInput, converterFunc model.WebhookConverterFunc) (string, error) {
 	tnt, err := tenant.LoadFromContext(ctx)
 	if apperrors.IsTenantRequired(err) {
@@ -96,9 +162,9 @@ func (s *service) Create(ctx context.Context, owningResourceID string, in model.
 	webhook := converterFunc(&in, id, &tnt, owningResourceID)
 
 	if err = s.webhookRepo.Create(ctx, webhook); err != nil {
-		return "", errors.Wrapf(err, "while creating Webhook with type %s and id %s for Application with id %s", id, webhook.Type, owningResourceID)
+		return "", errors.Wrapf(err, "while creating Webhook with type %s and id %s for %s", webhook.Type, id, PrintOwnerInfo(webhook))
 	}
-	log.C(ctx).Infof("Successfully created Webhook with type %s and id %s for Application with id %s", id, webhook.Type, owningResourceID)
+	log.C(ctx).Infof("Successfully created Webhook with type %s and id %s for %s", webhook.Type, id, PrintOwnerInfo(webhook))
 
 	return webhook.ID, nil
 }
@@ -113,8 +179,12 @@ func (s *service) Update(ctx context.Context, id string, in model.WebhookInput)
 		webhook = in.ToApplicationWebhook(id, webhook.TenantID, *webhook.ApplicationID)
 	} else if webhook.ApplicationTemplateID != nil {
 		webhook = in.ToApplicationTemplateWebhook(id, webhook.TenantID, *webhook.ApplicationTemplateID)
+	} else if webhook.RuntimeID != nil {
+		webhook = in.ToRuntimeWebhook(id, webhook.TenantID, *webhook.RuntimeID)
+	} else if webhook.IntegrationSystemID != nil {
+		webhook = in.ToIntegrationSystemWebhook(id, webhook.TenantID, *webhook.IntegrationSystemID)
 	} else {
-		return errors.New("while updating Webhook: webhook doesn't have neither of application_id and application_template_id")
+		return errors.New("while updating Webhook: webhook doesn't have any of application_id, application_template_id, runtime_id and integration_system_id")
 	}
 
 	err = s.webhookRepo.Update(ctx, webhook)
@@ -148,6 +218,11 @@ func (s *service) retrieveWebhooks(ctx context.Context, application *model.Appli
 		}
 	}
 
+	return mergeWebhooks(appWebhooks, appTemplateWebhooks), nil
+}
+
+// mergeWebhooks overrides the webhooks derived from the application template with the application webhooks of the same type
+func mergeWebhooks(appWebhooks, appTemplateWebhooks []*model.Webhook) []*model.Webhook {
 	webhooksMap := make(map[model.WebhookType]*model.Webhook)
 	for i, webhook := range appTemplateWebhooks {
 		webhooksMap[webhook.Type] = appTemplateWebhooks[i]
@@ -162,5 +237,5 @@ func (s *service) retrieveWebhooks(ctx context.Context, application *model.Appli
 		webhooks = append(webhooks, webhooksMap[key])
 	}
 
-	return webhooks, nil
+	return webhooks
 }
@@ -314,6 +314,136 @@ func TestService_ListForApplicationTemplate(t *testing.T) {
 	}
 }
 
+func TestService_ListForRuntime(t *testing.T) {
+	// given
+	testErr := errors.New("Test error")
+
+	modelWebhooks := []*model.Webhook{
+		fixRuntimeModelWebhook("1", "foo", givenTenant(), "Foo"),
+		fixRuntimeModelWebhook("2", "bar", givenTenant(), "Bar"),
+	}
+	runtimeID := "foo"
+
+	ctx := context.TODO()
+	ctx = tenant.SaveToContext(ctx, givenTenant(), givenExternalTenant())
+
+	testCases := []struct {
+		Name               string
+		RepositoryFn       func() *automock.WebhookRepository
+		ExpectedResult     []*model.Webhook
+		ExpectedErrMessage string
+	}{
+		{
+			Name: "Success",
+			RepositoryFn: func() *automock.WebhookRepository {
+				repo := &automock.WebhookRepository{}
+				repo.On("ListByRuntimeID", ctx, givenTenant(), runtimeID).Return(modelWebhooks, nil).Once()
+				return repo
+			},
+			ExpectedResult:     modelWebhooks,
+			ExpectedErrMessage: "",
+		},
+		{
+			Name: "Returns error when webhook listing failed",
+			RepositoryFn: func() *automock.WebhookRepository {
+				repo := &automock.WebhookRepository{}
+				repo.On("ListByRuntimeID", ctx, givenTenant(), runtimeID).Return(nil, testErr).Once()
+				return repo
+			},
+			ExpectedResult:     nil,
+			ExpectedErrMessage: testErr.Error(),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			repo := testCase.RepositoryFn()
+			svc := webhook.NewService(repo, nil, nil)
+
+			// when
+			webhooks, err := svc.ListForRuntime(ctx, runtimeID)
+
+			// then
+			if testCase.ExpectedErrMessage == "" {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.ExpectedResult, webhooks)
+			} else {
+				assert.Contains(t, err.Error(), testCase.ExpectedErrMessage)
+			}
+
+			repo.AssertExpectations(t)
+		})
+	}
+
+	t.Run(testCaseErrorOnLoadingTenant, func(t *testing.T) {
+		svc := webhook.NewService(nil, nil, nil)
+		// when
+		_, err := svc.ListForRuntime(context.TODO(), "foo")
+		assert.True(t, apperrors.IsCannotReadTenant(err))
+	})
+}
+
+func TestService_ListForIntegrationSystem(t *testing.T) {
+	// given
+	testErr := errors.New("Test error")
+
+	modelWebhooks := []*model.Webhook{
+		fixIntegrationSystemModelWebhook("1", "foo", "Foo"),
+		fixIntegrationSystemModelWebhook("2", "bar", "Bar"),
+	}
+	integrationSystemID := "foo"
+
+	ctx := context.TODO()
+
+	testCases := []struct {
+		Name               string
+		RepositoryFn       func() *automock.WebhookRepository
+		ExpectedResult     []*model.Webhook
+		ExpectedErrMessage string
+	}{
+		{
+			Name: "Success",
+			RepositoryFn: func() *automock.WebhookRepository {
+				repo := &automock.WebhookRepository{}
+				repo.On("ListByIntegrationSystemID", ctx, integrationSystemID).Return(modelWebhooks, nil).Once()
+				return repo
+			},
+			ExpectedResult:     modelWebhooks,
+			ExpectedErrMessage: "",
+		},
+		{
+			Name: "Returns error when webhook listing failed",
+			RepositoryFn: func() *automock.WebhookRepository {
+				repo := &automock.WebhookRepository{}
+				repo.On("ListByIntegrationSystemID", ctx, integrationSystemID).Return(nil, testErr).Once()
+				return repo
+			},
+			ExpectedResult:     nil,
+			ExpectedErrMessage: testErr.Error(),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			repo := testCase.RepositoryFn()
+			svc := webhook.NewService(repo, nil, nil)
+
+			// when
+			webhooks, err := svc.ListForIntegrationSystem(ctx, integrationSystemID)
+
+			// then
+			if testCase.ExpectedErrMessage == "" {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.ExpectedResult, webhooks)
+			} else {
+				assert.Contains(t, err.Error(), testCase.ExpectedErrMessage)
+			}
+
+			repo.AssertExpectations(t)
+		})
+	}
+}
+
 func TestService_ListAllApplicationWebhooks(t *testing.T) {
 	// given
 	testErr := errors.New("Test error")
@@ -498,6 +628,119 @@ func TestService_ListAllApplicationWebhooks(t *testing.T) {
 	})
 }
 
+func TestService_ListAllForApplications(t *testing.T) {
+	// given
+	testErr := errors.New("Test error")
+
+	appTemplateID := "app-template-1"
+	application := &model.Application{
+		Tenant:     givenTenant(),
+		Name:       "app-1",
+		BaseEntity: &model.BaseEntity{ID: "app-1"},
+	}
+	firstApplicationFromTemplate := &model.Application{
+		Tenant:                givenTenant(),
+		Name:                  "app-2",
+		ApplicationTemplateID: str.Ptr(appTemplateID),
+		BaseEntity:            &model.BaseEntity{ID: "app-2"},
+	}
+	secondApplicationFromTemplate := &model.Application{
+		Tenant:                givenTenant(),
+		Name:                  "app-3",
+		ApplicationTemplateID: str.Ptr(appTemplateID),
+		BaseEntity:            &model.BaseEntity{ID: "app-3"},
+	}
+	applications := []*model.Application{application, firstApplicationFromTemplate, secondApplicationFromTemplate}
+	applicationIDs := []string{application.ID, firstApplicationFromTemplate.ID, secondApplicationFromTemplate.ID}
+
+	appModelWebhooks := []*model.Webhook{
+		fixApplicationModelWebhookWithType("app-webhook-1", application.ID, givenTenant(), "test-url-1.com", model.WebhookTypeRegisterApplication),
+		fixApplicationModelWebhookWithType("app-webhook-2", firstApplicationFromTemplate.ID, givenTenant(), "test-url-2.com", model.WebhookTypeDeleteApplication),
+	}
+	appTemplateModelWebhooks := []*model.Webhook{
+		fixApplicationTemplateModelWebhookWithType("app-template-webhook-1", appTemplateID, "test-url-3.com", model.WebhookTypeRegisterApplication),
+		fixApplicationTemplateModelWebhookWithType("app-template-webhook-2", appTemplateID, "test-url-4.com", model.WebhookTypeDeleteApplication),
+	}
+
+	ctx := context.TODO()
+	ctx = tenant.SaveToContext(ctx, givenTenant(), givenExternalTenant())
+
+	testCases := []struct {
+		Name                string
+		WebhookRepositoryFn func() *automock.WebhookRepository
+		ExpectedResult      map[string][]*model.Webhook
+		ExpectedErrMessage  string
+	}{
+		{
+			Name: "Success",
+			WebhookRepositoryFn: func() *automock.WebhookRepository {
+				webhookRepo := &automock.WebhookRepository{}
+				webhookRepo.On("ListByApplicationIDs", ctx, givenTenant(), applicationIDs).Return(appModelWebhooks, nil).Once()
+				webhookRepo.On("ListByApplicationTemplateIDs", ctx, []string{appTemplateID}).Return(appTemplateModelWebhooks, nil).Once()
+				return webhookRepo
+			},
+			ExpectedResult: map[string][]*model.Webhook{
+				application.ID:                   {appModelWebhooks[0]},
+				firstApplicationFromTemplate.ID:  {appTemplateModelWebhooks[0], appModelWebhooks[1]},
+				secondApplicationFromTemplate.ID: {appTemplateModelWebhooks[0], appTemplateModelWebhooks[1]},
+			},
+			ExpectedErrMessage: "",
+		},
+		{
+			Name: "Returns error when webhook listing for applications failed",
+			WebhookRepositoryFn: func() *automock.WebhookRepository {
+				webhookRepo := &automock.WebhookRepository{}
+				webhookRepo.On("ListByApplicationIDs", ctx, givenTenant(), applicationIDs).Return(nil, testErr).Once()
+				return webhookRepo
+			},
+			ExpectedResult:     nil,
+			ExpectedErrMessage: testErr.Error(),
+		},
+		{
+			Name: "Returns error when webhook listing for application templates failed",
+			WebhookRepositoryFn: func() *automock.WebhookRepository {
+				webhookRepo := &automock.WebhookRepository{}
+				webhookRepo.On("ListByApplicationIDs", ctx, givenTenant(), applicationIDs).Return(appModelWebhooks, nil).Once()
+				webhookRepo.On("ListByApplicationTemplateIDs", ctx, []string{appTemplateID}).Return(nil, testErr).Once()
+				return webhookRepo
+			},
+			ExpectedResult:     nil,
+			ExpectedErrMessage: testErr.Error(),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			webhookRepo := testCase.WebhookRepositoryFn()
+			svc := webhook.NewService(webhookRepo, nil, nil)
+
+			// when
+			webhooks, err := svc.ListAllForApplications(ctx, applications)
+
+			// then
+			if testCase.ExpectedErrMessage == "" {
+				require.NoError(t, err)
+				require.Len(t, webhooks, len(testCase.ExpectedResult))
+				for appID, expected := range testCase.ExpectedResult {
+					assert.ElementsMatch(t, expected, webhooks[appID])
+				}
+			} else {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErrMessage)
+			}
+
+			webhookRepo.AssertExpectations(t)
+		})
+	}
+
+	t.Run(testCaseErrorOnLoadingTenant, func(t *testing.T) {
+		svc := webhook.NewService(nil, nil, nil)
+		// when
+		_, err := svc.ListAllForApplications(context.TODO(), applications)
+		assert.True(t, apperrors.IsCannotReadTenant(err))
+	})
+}
+
 func TestService_Update(t *testing.T) {
 	// given
 	testErr := errors.New("Test error")
@@ -576,7 +819,7 @@ func TestService_Update(t *testing.T) {
 				repo.On("GetByID", tenantCtx, givenTenant(), id).Return(noIDWebhookModel, nil).Once()
 				return repo
 			},
-			ExpectedErrMessage: "webhook doesn't have neither of application_id and application_template_id",
+			ExpectedErrMessage: "webhook doesn't have any of application_id, application_template_id, runtime_id and integration_system_id",
 			Context:            tenantCtx,
 		},
 	}
@@ -0,0 +1,52 @@
+package webhookdelivery
+
+import (
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/repo"
+)
+
+type converter struct{}
+
+func NewConverter() *converter {
+	return &converter{}
+}
+
+func (c *converter) ToEntity(in *model.WebhookDelivery) *Entity {
+	return &Entity{
+		ID:            in.ID,
+		TenantID:      in.Tenant,
+		WebhookID:     in.WebhookID,
+		ApplicationID: in.ApplicationID,
+		Status:        string(in.Status),
+		Attempts:      in.Attempts,
+		LastError:     repo.NewNullableString(in.LastError),
+		ResponseCode:  repo.NewNullableInt(in.ResponseCode),
+		CreatedAt:     in.CreatedAt,
+		NextAttemptAt: repo.NewNullableTime(in.NextAttemptAt),
+		FinishedAt:    repo.NewNullableTime(in.FinishedAt),
+	}
+}
+
+func (c *converter) FromEntity(in *Entity) *model.WebhookDelivery {
+	return &model.WebhookDelivery{
+		ID:            in.ID,
+		Tenant:        in.TenantID,
+		WebhookID:     in.WebhookID,
+		ApplicationID: in.ApplicationID,
+		Status:        model.WebhookDeliveryStatus(in.Status),
+		Attempts:      in.Attempts,
+		LastError:     repo.StringPtrFromNullableString(in.LastError),
+		ResponseCode:  repo.IntPtrFromNullableInt(in.ResponseCode),
+		CreatedAt:     in.CreatedAt,
+		NextAttemptAt: repo.TimePtrFromNullableTime(in.NextAttemptAt),
+		FinishedAt:    repo.TimePtrFromNullableTime(in.FinishedAt),
+	}
+}
+
+func (c *converter) ChangeFromEntity(in *ChangeEntity) *model.AppConfigurationChange {
+	return &model.AppConfigurationChange{
+		ApplicationID: in.ApplicationID,
+		Tenant:        in.TenantID,
+		ChangedAt:     in.ChangedAt,
+	}
+}
@@ -0,0 +1,32 @@
+package webhookdelivery_test
+
+import (
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/webhookdelivery"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConverter_ToEntity(t *testing.T) {
+	conv := webhookdelivery.NewConverter()
+	//WHEN
+	entity := conv.ToEntity(fixDeliveryModel())
+	//THEN
+	assert.Equal(t, fixDeliveryEntity(), entity)
+}
+
+func TestConverter_FromEntity(t *testing.T) {
+	conv := webhookdelivery.NewConverter()
+	//WHEN
+	delivery := conv.FromEntity(fixDeliveryEntity())
+	//THEN
+	assert.Equal(t, fixDeliveryModel(), delivery)
+}
+
+func TestConverter_ChangeFromEntity(t *testing.T) {
+	conv := webhookdelivery.NewConverter()
+	//WHEN
+	change := conv.ChangeFromEntity(fixChangeEntity())
+	//THEN
+	assert.Equal(t, fixChangeModel(), change)
+}
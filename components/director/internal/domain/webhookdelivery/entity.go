@@ -0,0 +1,38 @@
+package webhookdelivery
+
+import (
+	"database/sql"
+	"time"
+)
+
+type Entity struct {
+	ID            string         `db:"id"`
+	TenantID      string         `db:"tenant_id"`
+	WebhookID     string         `db:"webhook_id"`
+	ApplicationID string         `db:"app_id"`
+	Status        string         `db:"status"`
+	Attempts      int            `db:"attempts"`
+	LastError     sql.NullString `db:"last_error"`
+	ResponseCode  sql.NullInt32  `db:"response_code"`
+	CreatedAt     time.Time      `db:"created_at"`
+	NextAttemptAt sql.NullTime   `db:"next_attempt_at"`
+	FinishedAt    sql.NullTime   `db:"finished_at"`
+}
+
+type EntityCollection []Entity
+
+func (c EntityCollection) Len() int {
+	return len(c)
+}
+
+type ChangeEntity struct {
+	ApplicationID string    `db:"app_id"`
+	TenantID      string    `db:"tenant_id"`
+	ChangedAt     time.Time `db:"changed_at"`
+}
+
+type ChangeEntityCollection []ChangeEntity
+
+func (c ChangeEntityCollection) Len() int {
+	return len(c)
+}
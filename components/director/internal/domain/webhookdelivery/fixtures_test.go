@@ -0,0 +1,76 @@
+package webhookdelivery_test
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/webhookdelivery"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/str"
+)
+
+const (
+	deliveryID = "dddddddd-dddd-dddd-dddd-dddddddddddd"
+	webhookID  = "wwwwwwww-wwww-wwww-wwww-wwwwwwwwwwww"
+	appID      = "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa"
+	tenantID   = "b91b59f7-2563-40b2-aba9-fef726037aa3"
+	lastError  = "webhook responded with status code 503"
+)
+
+var fixedTimestamp = time.Date(2021, 3, 31, 9, 0, 0, 0, time.UTC)
+
+func fixDeliveryModel() *model.WebhookDelivery {
+	responseCode := 503
+	return &model.WebhookDelivery{
+		ID:            deliveryID,
+		Tenant:        tenantID,
+		WebhookID:     webhookID,
+		ApplicationID: appID,
+		Status:        model.WebhookDeliveryStatusPending,
+		Attempts:      1,
+		LastError:     str.Ptr(lastError),
+		ResponseCode:  &responseCode,
+		CreatedAt:     fixedTimestamp,
+		NextAttemptAt: &fixedTimestamp,
+	}
+}
+
+func fixDeliveryEntity() *webhookdelivery.Entity {
+	return &webhookdelivery.Entity{
+		ID:            deliveryID,
+		TenantID:      tenantID,
+		WebhookID:     webhookID,
+		ApplicationID: appID,
+		Status:        string(model.WebhookDeliveryStatusPending),
+		Attempts:      1,
+		LastError:     sql.NullString{String: lastError, Valid: true},
+		ResponseCode:  sql.NullInt32{Int32: 503, Valid: true},
+		CreatedAt:     fixedTimestamp,
+		NextAttemptAt: sql.NullTime{Time: fixedTimestamp, Valid: true},
+	}
+}
+
+func fixDeliveryColumns() []string {
+	return []string{"id", "tenant_id", "webhook_id", "app_id", "status", "attempts", "last_error", "response_code", "created_at", "next_attempt_at", "finished_at"}
+}
+
+func fixDeliveryRow() []driver.Value {
+	return []driver.Value{deliveryID, tenantID, webhookID, appID, string(model.WebhookDeliveryStatusPending), 1, lastError, 503, fixedTimestamp, fixedTimestamp, nil}
+}
+
+func fixChangeModel() *model.AppConfigurationChange {
+	return &model.AppConfigurationChange{
+		ApplicationID: appID,
+		Tenant:        tenantID,
+		ChangedAt:     fixedTimestamp,
+	}
+}
+
+func fixChangeEntity() *webhookdelivery.ChangeEntity {
+	return &webhookdelivery.ChangeEntity{
+		ApplicationID: appID,
+		TenantID:      tenantID,
+		ChangedAt:     fixedTimestamp,
+	}
+}
@@ -0,0 +1,146 @@
+package webhookdelivery
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/repo"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+)
+
+const (
+	deliveryTable string = `public.webhook_deliveries`
+	changeTable   string = `public.app_configuration_changes`
+)
+
+var (
+	tenantColumn             = "tenant_id"
+	deliveryColumns          = []string{"id", tenantColumn, "webhook_id", "app_id", "status", "attempts", "last_error", "response_code", "created_at", "next_attempt_at", "finished_at"}
+	updatableDeliveryColumns = []string{"status", "attempts", "last_error", "response_code", "next_attempt_at", "finished_at"}
+	changeColumns            = []string{"app_id", tenantColumn, "changed_at"}
+)
+
+//go:generate mockery -name=EntityConverter -output=automock -outpkg=automock -case=underscore
+type EntityConverter interface {
+	ToEntity(in *model.WebhookDelivery) *Entity
+	FromEntity(in *Entity) *model.WebhookDelivery
+	ChangeFromEntity(in *ChangeEntity) *model.AppConfigurationChange
+}
+
+type pgRepository struct {
+	conv                EntityConverter
+	creator             repo.Creator
+	updater             repo.Updater
+	deleterGlobal       repo.DeleterGlobal
+	changeListerGlobal  repo.ListerGlobal
+	changeDeleterGlobal repo.DeleterGlobal
+}
+
+func NewRepository(conv EntityConverter) *pgRepository {
+	return &pgRepository{
+		conv:                conv,
+		creator:             repo.NewCreator(resource.WebhookDelivery, deliveryTable, deliveryColumns),
+		updater:             repo.NewUpdater(resource.WebhookDelivery, deliveryTable, updatableDeliveryColumns, tenantColumn, []string{"id"}),
+		deleterGlobal:       repo.NewDeleterGlobal(resource.WebhookDelivery, deliveryTable),
+		changeListerGlobal:  repo.NewListerGlobal(resource.AppConfigurationChange, changeTable, changeColumns),
+		changeDeleterGlobal: repo.NewDeleterGlobal(resource.AppConfigurationChange, changeTable),
+	}
+}
+
+func (r *pgRepository) Create(ctx context.Context, item *model.WebhookDelivery) error {
+	if item == nil {
+		return apperrors.NewInternalError("item can not be empty")
+	}
+
+	return r.creator.Create(ctx, r.conv.ToEntity(item))
+}
+
+func (r *pgRepository) Update(ctx context.Context, item *model.WebhookDelivery) error {
+	if item == nil {
+		return apperrors.NewInternalError("item can not be empty")
+	}
+
+	return r.updater.UpdateSingle(ctx, r.conv.ToEntity(item))
+}
+
+// ClaimDueGlobal claims up to limit pending deliveries of all tenants whose next attempt is due before the given time, the longest waiting first.
+// The next attempt of the claimed deliveries is postponed until leaseUntil, so that they are not delivered by other replicas
+// once the transaction is committed. Deliveries locked by another transaction are skipped.
+func (r *pgRepository) ClaimDueGlobal(ctx context.Context, before, leaseUntil time.Time, limit int) ([]*model.WebhookDelivery, error) {
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	query := fmt.Sprintf("UPDATE %[1]s SET next_attempt_at = $1 WHERE id IN "+
+		"(SELECT id FROM %[1]s WHERE status = $2 AND next_attempt_at < $3 ORDER BY next_attempt_at LIMIT $4 FOR UPDATE SKIP LOCKED) "+
+		"RETURNING %[2]s", deliveryTable, strings.Join(deliveryColumns, ", "))
+
+	log.C(ctx).Debugf("Executing DB query: %s", query)
+	var entities EntityCollection
+	err = persist.Select(&entities, query, leaseUntil, string(model.WebhookDeliveryStatusPending), before, limit)
+	if err = persistence.MapSQLError(ctx, err, resource.WebhookDelivery, resource.Update, "while claiming rows from '%s' table", deliveryTable); err != nil {
+		return nil, err
+	}
+
+	items := make([]*model.WebhookDelivery, 0, len(entities))
+	for i := range entities {
+		items = append(items, r.conv.FromEntity(&entities[i]))
+	}
+
+	return items, nil
+}
+
+// DeleteFinishedBeforeGlobal removes the log of the deliveries of all tenants which have finished before the given time
+func (r *pgRepository) DeleteFinishedBeforeGlobal(ctx context.Context, before time.Time) error {
+	return r.deleterGlobal.DeleteManyGlobal(ctx, repo.Conditions{repo.NewLessThanCondition("finished_at", before)})
+}
+
+// ListChangesGlobal returns the configuration changes of the applications of all tenants which have not been notified yet
+func (r *pgRepository) ListChangesGlobal(ctx context.Context) ([]*model.AppConfigurationChange, error) {
+	var entities ChangeEntityCollection
+	if err := r.changeListerGlobal.ListGlobal(ctx, &entities); err != nil {
+		return nil, err
+	}
+
+	items := make([]*model.AppConfigurationChange, 0, len(entities))
+	for i := range entities {
+		items = append(items, r.conv.ChangeFromEntity(&entities[i]))
+	}
+
+	return items, nil
+}
+
+// LockChangeGlobal locks the configuration change of the application until the end of the transaction, so that it is not notified
+// by other replicas at the same time. A not found error is returned when the change has already been notified or it is locked by another transaction.
+func (r *pgRepository) LockChangeGlobal(ctx context.Context, appID string) (*model.AppConfigurationChange, error) {
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	query := fmt.Sprintf("SELECT %s FROM %s WHERE app_id = $1 FOR UPDATE SKIP LOCKED", strings.Join(changeColumns, ", "), changeTable)
+
+	log.C(ctx).Debugf("Executing DB query: %s", query)
+	var entity ChangeEntity
+	err = persist.Get(&entity, query, appID)
+	if err = persistence.MapSQLError(ctx, err, resource.AppConfigurationChange, resource.Get, "while locking row from '%s' table", changeTable); err != nil {
+		return nil, err
+	}
+
+	return r.conv.ChangeFromEntity(&entity), nil
+}
+
+// DeleteChangeGlobal marks the configuration change as notified. Changes made to the application since it was listed are kept.
+func (r *pgRepository) DeleteChangeGlobal(ctx context.Context, change *model.AppConfigurationChange) error {
+	return r.changeDeleterGlobal.DeleteManyGlobal(ctx, repo.Conditions{
+		repo.NewEqualCondition("app_id", change.ApplicationID),
+		repo.NewEqualCondition("changed_at", change.ChangedAt),
+	})
+}
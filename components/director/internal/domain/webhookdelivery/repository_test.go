@@ -0,0 +1,222 @@
+package webhookdelivery_test
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/webhookdelivery"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/webhookdelivery/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/repo/testdb"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPgRepository_Create(t *testing.T) {
+	insertQuery := regexp.QuoteMeta(`INSERT INTO public.webhook_deliveries ( id, tenant_id, webhook_id, app_id, status, attempts, last_error, response_code, created_at, next_attempt_at, finished_at ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ? )`)
+
+	t.Run("success", func(t *testing.T) {
+		sqlxDB, sqlMock := testdb.MockDatabase(t)
+		ctx := persistence.SaveToContext(context.TODO(), sqlxDB)
+		deliveryModel := fixDeliveryModel()
+
+		sqlMock.ExpectExec(insertQuery).
+			WithArgs(fixDeliveryRow()...).
+			WillReturnResult(sqlmock.NewResult(-1, 1))
+
+		convMock := &automock.EntityConverter{}
+		convMock.On("ToEntity", deliveryModel).Return(fixDeliveryEntity()).Once()
+		pgRepository := webhookdelivery.NewRepository(convMock)
+		//WHEN
+		err := pgRepository.Create(ctx, deliveryModel)
+		//THEN
+		require.NoError(t, err)
+		sqlMock.AssertExpectations(t)
+		convMock.AssertExpectations(t)
+	})
+
+	t.Run("returns error when item is nil", func(t *testing.T) {
+		convMock := &automock.EntityConverter{}
+		pgRepository := webhookdelivery.NewRepository(convMock)
+		//WHEN
+		err := pgRepository.Create(context.TODO(), nil)
+		//THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "item can not be empty")
+		convMock.AssertExpectations(t)
+	})
+}
+
+func TestPgRepository_Update(t *testing.T) {
+	updateQuery := regexp.QuoteMeta(`UPDATE public.webhook_deliveries SET status = ?, attempts = ?, last_error = ?, response_code = ?, next_attempt_at = ?, finished_at = ? WHERE tenant_id = ? AND id = ?`)
+
+	t.Run("success", func(t *testing.T) {
+		sqlxDB, sqlMock := testdb.MockDatabase(t)
+		ctx := persistence.SaveToContext(context.TODO(), sqlxDB)
+		deliveryModel := fixDeliveryModel()
+
+		sqlMock.ExpectExec(updateQuery).
+			WithArgs(string(model.WebhookDeliveryStatusPending), 1, lastError, 503, fixedTimestamp, nil, tenantID, deliveryID).
+			WillReturnResult(sqlmock.NewResult(-1, 1))
+
+		convMock := &automock.EntityConverter{}
+		convMock.On("ToEntity", deliveryModel).Return(fixDeliveryEntity()).Once()
+		pgRepository := webhookdelivery.NewRepository(convMock)
+		//WHEN
+		err := pgRepository.Update(ctx, deliveryModel)
+		//THEN
+		require.NoError(t, err)
+		sqlMock.AssertExpectations(t)
+		convMock.AssertExpectations(t)
+	})
+
+	t.Run("returns error when item is nil", func(t *testing.T) {
+		convMock := &automock.EntityConverter{}
+		pgRepository := webhookdelivery.NewRepository(convMock)
+		//WHEN
+		err := pgRepository.Update(context.TODO(), nil)
+		//THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "item can not be empty")
+		convMock.AssertExpectations(t)
+	})
+}
+
+func TestPgRepository_ClaimDueGlobal(t *testing.T) {
+	claimQuery := regexp.QuoteMeta(`UPDATE public.webhook_deliveries SET next_attempt_at = $1 WHERE id IN ` +
+		`(SELECT id FROM public.webhook_deliveries WHERE status = $2 AND next_attempt_at < $3 ORDER BY next_attempt_at LIMIT $4 FOR UPDATE SKIP LOCKED) ` +
+		`RETURNING id, tenant_id, webhook_id, app_id, status, attempts, last_error, response_code, created_at, next_attempt_at, finished_at`)
+	leaseUntil := fixedTimestamp.Add(time.Minute)
+
+	t.Run("success", func(t *testing.T) {
+		sqlxDB, sqlMock := testdb.MockDatabase(t)
+		ctx := persistence.SaveToContext(context.TODO(), sqlxDB)
+
+		sqlMock.ExpectQuery(claimQuery).
+			WithArgs(leaseUntil, string(model.WebhookDeliveryStatusPending), fixedTimestamp, 100).
+			WillReturnRows(sqlmock.NewRows(fixDeliveryColumns()).AddRow(fixDeliveryRow()...))
+
+		convMock := &automock.EntityConverter{}
+		convMock.On("FromEntity", fixDeliveryEntity()).Return(fixDeliveryModel()).Once()
+		pgRepository := webhookdelivery.NewRepository(convMock)
+		//WHEN
+		deliveries, err := pgRepository.ClaimDueGlobal(ctx, fixedTimestamp, leaseUntil, 100)
+		//THEN
+		require.NoError(t, err)
+		assert.Equal(t, []*model.WebhookDelivery{fixDeliveryModel()}, deliveries)
+		sqlMock.AssertExpectations(t)
+		convMock.AssertExpectations(t)
+	})
+
+	t.Run("returns error when the query fails", func(t *testing.T) {
+		sqlxDB, sqlMock := testdb.MockDatabase(t)
+		ctx := persistence.SaveToContext(context.TODO(), sqlxDB)
+
+		sqlMock.ExpectQuery(claimQuery).
+			WithArgs(leaseUntil, string(model.WebhookDeliveryStatusPending), fixedTimestamp, 100).
+			WillReturnError(errors.New("test error"))
+
+		pgRepository := webhookdelivery.NewRepository(&automock.EntityConverter{})
+		//WHEN
+		_, err := pgRepository.ClaimDueGlobal(ctx, fixedTimestamp, leaseUntil, 100)
+		//THEN
+		require.EqualError(t, err, "Internal Server Error: Unexpected error while executing SQL query")
+		sqlMock.AssertExpectations(t)
+	})
+}
+
+func TestPgRepository_DeleteFinishedBeforeGlobal(t *testing.T) {
+	sqlxDB, sqlMock := testdb.MockDatabase(t)
+	ctx := persistence.SaveToContext(context.TODO(), sqlxDB)
+
+	sqlMock.ExpectExec(regexp.QuoteMeta(`DELETE FROM public.webhook_deliveries WHERE finished_at < $1`)).
+		WithArgs(fixedTimestamp).
+		WillReturnResult(sqlmock.NewResult(-1, 3))
+
+	pgRepository := webhookdelivery.NewRepository(&automock.EntityConverter{})
+	//WHEN
+	err := pgRepository.DeleteFinishedBeforeGlobal(ctx, fixedTimestamp)
+	//THEN
+	require.NoError(t, err)
+	sqlMock.AssertExpectations(t)
+}
+
+func TestPgRepository_ListChangesGlobal(t *testing.T) {
+	sqlxDB, sqlMock := testdb.MockDatabase(t)
+	ctx := persistence.SaveToContext(context.TODO(), sqlxDB)
+
+	sqlMock.ExpectQuery(regexp.QuoteMeta(`SELECT app_id, tenant_id, changed_at FROM public.app_configuration_changes`)).
+		WillReturnRows(sqlmock.NewRows([]string{"app_id", "tenant_id", "changed_at"}).AddRow(appID, tenantID, fixedTimestamp))
+
+	convMock := &automock.EntityConverter{}
+	convMock.On("ChangeFromEntity", fixChangeEntity()).Return(fixChangeModel()).Once()
+	pgRepository := webhookdelivery.NewRepository(convMock)
+	//WHEN
+	changes, err := pgRepository.ListChangesGlobal(ctx)
+	//THEN
+	require.NoError(t, err)
+	assert.Equal(t, []*model.AppConfigurationChange{fixChangeModel()}, changes)
+	sqlMock.AssertExpectations(t)
+	convMock.AssertExpectations(t)
+}
+
+func TestPgRepository_LockChangeGlobal(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		sqlxDB, sqlMock := testdb.MockDatabase(t)
+		ctx := persistence.SaveToContext(context.TODO(), sqlxDB)
+
+		sqlMock.ExpectQuery(regexp.QuoteMeta(`SELECT app_id, tenant_id, changed_at FROM public.app_configuration_changes WHERE app_id = $1 FOR UPDATE SKIP LOCKED`)).
+			WithArgs(appID).
+			WillReturnRows(sqlmock.NewRows([]string{"app_id", "tenant_id", "changed_at"}).AddRow(appID, tenantID, fixedTimestamp))
+
+		convMock := &automock.EntityConverter{}
+		convMock.On("ChangeFromEntity", fixChangeEntity()).Return(fixChangeModel()).Once()
+		pgRepository := webhookdelivery.NewRepository(convMock)
+		//WHEN
+		change, err := pgRepository.LockChangeGlobal(ctx, appID)
+		//THEN
+		require.NoError(t, err)
+		assert.Equal(t, fixChangeModel(), change)
+		sqlMock.AssertExpectations(t)
+		convMock.AssertExpectations(t)
+	})
+
+	t.Run("returns not found error when change is locked by another transaction", func(t *testing.T) {
+		sqlxDB, sqlMock := testdb.MockDatabase(t)
+		ctx := persistence.SaveToContext(context.TODO(), sqlxDB)
+
+		sqlMock.ExpectQuery(regexp.QuoteMeta(`SELECT app_id, tenant_id, changed_at FROM public.app_configuration_changes WHERE app_id = $1 FOR UPDATE SKIP LOCKED`)).
+			WithArgs(appID).
+			WillReturnRows(sqlmock.NewRows([]string{"app_id", "tenant_id", "changed_at"}))
+
+		pgRepository := webhookdelivery.NewRepository(&automock.EntityConverter{})
+		//WHEN
+		_, err := pgRepository.LockChangeGlobal(ctx, appID)
+		//THEN
+		require.Error(t, err)
+		assert.True(t, apperrors.IsNotFoundError(err))
+		sqlMock.AssertExpectations(t)
+	})
+}
+
+func TestPgRepository_DeleteChangeGlobal(t *testing.T) {
+	sqlxDB, sqlMock := testdb.MockDatabase(t)
+	ctx := persistence.SaveToContext(context.TODO(), sqlxDB)
+
+	sqlMock.ExpectExec(regexp.QuoteMeta(`DELETE FROM public.app_configuration_changes WHERE app_id = $1 AND changed_at = $2`)).
+		WithArgs(appID, fixedTimestamp).
+		WillReturnResult(sqlmock.NewResult(-1, 1))
+
+	pgRepository := webhookdelivery.NewRepository(&automock.EntityConverter{})
+	//WHEN
+	err := pgRepository.DeleteChangeGlobal(ctx, fixChangeModel())
+	//THEN
+	require.NoError(t, err)
+	sqlMock.AssertExpectations(t)
+}
@@ -0,0 +1,389 @@
+package webhookdelivery
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/timestamp"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+	web_hook "github.com/kyma-incubator/compass/components/director/pkg/webhook"
+	"github.com/kyma-incubator/compass/components/director/pkg/webhook_client"
+	"github.com/pkg/errors"
+)
+
+// Config configures the delivery of the CONFIGURATION_CHANGED webhooks of the applications
+type Config struct {
+	// Interval between two rounds of deliveries. Zero disables the deliveries.
+	Interval time.Duration `envconfig:"default=10s,APP_WEBHOOK_DELIVERY_INTERVAL"`
+	// RetryInterval is the delay before the first retry of a failed delivery, unless the webhook defines its own. It doubles with every further attempt.
+	RetryInterval    time.Duration `envconfig:"default=30s,APP_WEBHOOK_DELIVERY_RETRY_INTERVAL"`
+	MaxRetryInterval time.Duration `envconfig:"default=1h,APP_WEBHOOK_DELIVERY_MAX_RETRY_INTERVAL"`
+	MaxAttempts      int           `envconfig:"default=5,APP_WEBHOOK_DELIVERY_MAX_ATTEMPTS"`
+	Timeout          time.Duration `envconfig:"default=10s,APP_WEBHOOK_DELIVERY_TIMEOUT"`
+	BatchSize        int           `envconfig:"default=100,APP_WEBHOOK_DELIVERY_BATCH_SIZE"`
+	// LeaseDuration is how long the claimed deliveries are reserved for the replica which claimed them. It must exceed the time needed to deliver a whole batch.
+	LeaseDuration time.Duration `envconfig:"default=30m,APP_WEBHOOK_DELIVERY_LEASE_DURATION"`
+	// LogRetention is how long finished deliveries are kept in the delivery log
+	LogRetention time.Duration `envconfig:"default=168h,APP_WEBHOOK_DELIVERY_LOG_RETENTION"`
+}
+
+//go:generate mockery -name=DeliveryRepository -output=automock -outpkg=automock -case=underscore
+type DeliveryRepository interface {
+	Create(ctx context.Context, item *model.WebhookDelivery) error
+	Update(ctx context.Context, item *model.WebhookDelivery) error
+	ClaimDueGlobal(ctx context.Context, before, leaseUntil time.Time, limit int) ([]*model.WebhookDelivery, error)
+	DeleteFinishedBeforeGlobal(ctx context.Context, before time.Time) error
+	ListChangesGlobal(ctx context.Context) ([]*model.AppConfigurationChange, error)
+	LockChangeGlobal(ctx context.Context, appID string) (*model.AppConfigurationChange, error)
+	DeleteChangeGlobal(ctx context.Context, change *model.AppConfigurationChange) error
+}
+
+//go:generate mockery -name=WebhookRepository -output=automock -outpkg=automock -case=underscore
+type WebhookRepository interface {
+	GetByID(ctx context.Context, tenant, id string) (*model.Webhook, error)
+	ListByApplicationID(ctx context.Context, tenant, applicationID string) ([]*model.Webhook, error)
+}
+
+//go:generate mockery -name=ApplicationRepository -output=automock -outpkg=automock -case=underscore
+type ApplicationRepository interface {
+	GetByID(ctx context.Context, tenant, id string) (*model.Application, error)
+}
+
+//go:generate mockery -name=ApplicationConverter -output=automock -outpkg=automock -case=underscore
+type ApplicationConverter interface {
+	ToGraphQL(in *model.Application) *graphql.Application
+}
+
+//go:generate mockery -name=WebhookConverter -output=automock -outpkg=automock -case=underscore
+type WebhookConverter interface {
+	ToGraphQL(in *model.Webhook) (*graphql.Webhook, error)
+}
+
+//go:generate mockery -name=UIDService -output=automock -outpkg=automock -case=underscore
+type UIDService interface {
+	Generate() string
+}
+
+// Service notifies the CONFIGURATION_CHANGED webhooks of the applications whose bundles, APIs, events, their specifications or labels have changed.
+// The changes are recorded by the database in the same transaction in which they are made, so every change is delivered at least once.
+type Service struct {
+	cfg          Config
+	transact     persistence.Transactioner
+	repo         DeliveryRepository
+	webhookRepo  WebhookRepository
+	appRepo      ApplicationRepository
+	appConverter ApplicationConverter
+	webhookConv  WebhookConverter
+	uidService   UIDService
+	client       *webhook_client.Client
+	timestampGen timestamp.Generator
+}
+
+func NewService(cfg Config, transact persistence.Transactioner, repo DeliveryRepository, webhookRepo WebhookRepository, appRepo ApplicationRepository, appConverter ApplicationConverter, webhookConv WebhookConverter, uidService UIDService, httpClient *http.Client) *Service {
+	return &Service{
+		cfg:          cfg,
+		transact:     transact,
+		repo:         repo,
+		webhookRepo:  webhookRepo,
+		appRepo:      appRepo,
+		appConverter: appConverter,
+		webhookConv:  webhookConv,
+		uidService:   uidService,
+		client:       webhook_client.NewClient(httpClient),
+		timestampGen: timestamp.DefaultGenerator(),
+	}
+}
+
+// Dispatch schedules a delivery for every CONFIGURATION_CHANGED webhook of the changed applications, performs the deliveries which are due
+// and removes the old entries of the delivery log. A failure of a single delivery does not stop the others.
+func (s *Service) Dispatch(ctx context.Context) error {
+	if err := s.scheduleDeliveries(ctx); err != nil {
+		return errors.Wrap(err, "while scheduling webhook deliveries")
+	}
+
+	deliveries, err := s.claimDueDeliveries(ctx)
+	if err != nil {
+		return errors.Wrap(err, "while claiming due webhook deliveries")
+	}
+
+	for _, delivery := range deliveries {
+		if err := s.deliver(ctx, delivery); err != nil {
+			log.C(ctx).WithError(err).Errorf("An error has occurred while delivering webhook with id %q", delivery.WebhookID)
+		}
+	}
+
+	if err := s.deleteFinishedDeliveries(ctx); err != nil {
+		return errors.Wrap(err, "while deleting finished webhook deliveries")
+	}
+
+	return nil
+}
+
+// scheduleDeliveries schedules the deliveries of every change in its own transaction, so that a failure of a single change
+// does not stop the others. The failed changes are kept and scheduled again on the next dispatch.
+func (s *Service) scheduleDeliveries(ctx context.Context) error {
+	changes, err := s.listChanges(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, change := range changes {
+		if err := s.scheduleDeliveriesForChange(ctx, change.ApplicationID); err != nil {
+			log.C(ctx).WithError(err).Errorf("An error has occurred while scheduling webhook deliveries for Application with id %q", change.ApplicationID)
+		}
+	}
+
+	return nil
+}
+
+func (s *Service) listChanges(ctx context.Context) ([]*model.AppConfigurationChange, error) {
+	tx, err := s.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer s.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+	changes, err := s.repo.ListChangesGlobal(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return changes, tx.Commit()
+}
+
+func (s *Service) scheduleDeliveriesForChange(ctx context.Context, appID string) error {
+	tx, err := s.transact.Begin()
+	if err != nil {
+		return err
+	}
+	defer s.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	change, err := s.repo.LockChangeGlobal(ctx, appID)
+	if err != nil {
+		// the change has been notified since it was listed or it is being notified by another replica
+		if apperrors.IsNotFoundError(err) {
+			return nil
+		}
+		return errors.Wrap(err, "while locking configuration change")
+	}
+
+	webhooks, err := s.webhookRepo.ListByApplicationID(ctx, change.Tenant, change.ApplicationID)
+	if err != nil {
+		return err
+	}
+
+	now := s.timestampGen()
+	for _, webhook := range webhooks {
+		if webhook.Type != model.WebhookTypeConfigurationChanged {
+			continue
+		}
+
+		delivery := &model.WebhookDelivery{
+			ID:            s.uidService.Generate(),
+			Tenant:        change.Tenant,
+			WebhookID:     webhook.ID,
+			ApplicationID: change.ApplicationID,
+			Status:        model.WebhookDeliveryStatusPending,
+			CreatedAt:     now,
+			NextAttemptAt: &now,
+		}
+		if err := s.repo.Create(ctx, delivery); err != nil {
+			return errors.Wrapf(err, "while creating delivery for webhook with id %q", webhook.ID)
+		}
+	}
+
+	if err := s.repo.DeleteChangeGlobal(ctx, change); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// claimDueDeliveries reserves the due deliveries for this replica, so that they are not delivered concurrently by the other replicas.
+// The claim is committed before the webhooks are called, so that no transaction is held open during the HTTP calls.
+func (s *Service) claimDueDeliveries(ctx context.Context) ([]*model.WebhookDelivery, error) {
+	tx, err := s.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer s.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+	now := s.timestampGen()
+	deliveries, err := s.repo.ClaimDueGlobal(ctx, now, now.Add(s.cfg.LeaseDuration), s.cfg.BatchSize)
+	if err != nil {
+		return nil, err
+	}
+
+	return deliveries, tx.Commit()
+}
+
+// deliver calls the webhook outside of any transaction and records the outcome of the attempt
+func (s *Service) deliver(ctx context.Context, delivery *model.WebhookDelivery) error {
+	webhook, app, err := s.loadTarget(ctx, delivery)
+	if err != nil {
+		// the deliveries of deleted webhooks and Applications are deleted along with them
+		if apperrors.IsNotFoundError(err) {
+			return errors.Wrap(err, "while loading webhook and Application")
+		}
+
+		log.C(ctx).WithError(err).Errorf("An error has occurred while loading webhook with id %q and Application with id %q", delivery.WebhookID, delivery.ApplicationID)
+		s.recordAttempt(delivery, nil, nil, errors.Wrap(err, "while loading webhook and Application"))
+		return s.update(ctx, delivery)
+	}
+
+	gqlWebhook, err := s.webhookConv.ToGraphQL(webhook)
+	if err != nil {
+		return errors.Wrapf(err, "while converting webhook with id %q", webhook.ID)
+	}
+
+	object := web_hook.RequestObject{
+		Application: s.appConverter.ToGraphQL(app),
+		TenantID:    delivery.Tenant,
+	}
+
+	callCtx, cancel := context.WithTimeout(ctx, s.determineTimeout(webhook))
+	defer cancel()
+
+	response, callErr := s.client.Do(callCtx, webhook_client.NewRequest(*gqlWebhook, object, delivery.ID))
+	if callErr != nil {
+		log.C(ctx).WithError(callErr).Infof("Delivery of webhook with id %q for Application with id %q failed", webhook.ID, app.ID)
+	}
+	responseCode := receivedStatusCode(response, callErr)
+
+	s.recordAttempt(delivery, webhook, responseCode, callErr)
+
+	return s.update(ctx, delivery)
+}
+
+func (s *Service) determineTimeout(webhook *model.Webhook) time.Duration {
+	if webhook.Timeout == nil {
+		return s.cfg.Timeout
+	}
+
+	return time.Duration(*webhook.Timeout) * time.Second
+}
+
+// receivedStatusCode returns the status code of the webhook response, if any was received
+func receivedStatusCode(response *web_hook.Response, callErr error) *int {
+	if statusCode, ok := webhook_client.StatusCode(callErr); ok {
+		return &statusCode
+	}
+
+	if response == nil {
+		return nil
+	}
+
+	if webhook_client.IsStatusGoneErr(callErr) {
+		return response.GoneStatusCode
+	}
+
+	return response.SuccessStatusCode
+}
+
+func (s *Service) loadTarget(ctx context.Context, delivery *model.WebhookDelivery) (*model.Webhook, *model.Application, error) {
+	tx, err := s.transact.Begin()
+	if err != nil {
+		return nil, nil, err
+	}
+	defer s.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	webhook, err := s.webhookRepo.GetByID(ctx, delivery.Tenant, delivery.WebhookID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	app, err := s.appRepo.GetByID(ctx, delivery.Tenant, delivery.ApplicationID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return webhook, app, tx.Commit()
+}
+
+func (s *Service) recordAttempt(delivery *model.WebhookDelivery, webhook *model.Webhook, responseCode *int, callErr error) {
+	now := s.timestampGen()
+
+	delivery.Attempts++
+	delivery.ResponseCode = responseCode
+
+	if callErr == nil {
+		delivery.Status = model.WebhookDeliveryStatusSucceeded
+		delivery.LastError = nil
+		delivery.NextAttemptAt = nil
+		delivery.FinishedAt = &now
+		return
+	}
+
+	errMsg := callErr.Error()
+	delivery.LastError = &errMsg
+
+	if delivery.Attempts >= s.cfg.MaxAttempts {
+		delivery.Status = model.WebhookDeliveryStatusFailed
+		delivery.NextAttemptAt = nil
+		delivery.FinishedAt = &now
+		return
+	}
+
+	nextAttemptAt := now.Add(s.retryInterval(webhook, delivery.Attempts))
+	delivery.NextAttemptAt = &nextAttemptAt
+}
+
+// retryInterval returns the exponentially growing delay before the next attempt, capped by the maximum retry interval.
+// The webhook is nil if it could not be loaded.
+func (s *Service) retryInterval(webhook *model.Webhook, attempts int) time.Duration {
+	interval := s.cfg.RetryInterval
+	if webhook != nil && webhook.RetryInterval != nil {
+		interval = time.Duration(*webhook.RetryInterval) * time.Second
+	}
+
+	for i := 1; i < attempts && interval < s.cfg.MaxRetryInterval; i++ {
+		interval *= 2
+	}
+
+	if interval > s.cfg.MaxRetryInterval {
+		return s.cfg.MaxRetryInterval
+	}
+	return interval
+}
+
+func (s *Service) update(ctx context.Context, delivery *model.WebhookDelivery) error {
+	tx, err := s.transact.Begin()
+	if err != nil {
+		return err
+	}
+	defer s.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	if err := s.repo.Update(ctx, delivery); err != nil {
+		return errors.Wrapf(err, "while updating delivery with id %q", delivery.ID)
+	}
+
+	return tx.Commit()
+}
+
+func (s *Service) deleteFinishedDeliveries(ctx context.Context) error {
+	tx, err := s.transact.Begin()
+	if err != nil {
+		return err
+	}
+	defer s.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+	if err := s.repo.DeleteFinishedBeforeGlobal(ctx, s.timestampGen().Add(-s.cfg.LogRetention)); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
@@ -0,0 +1,288 @@
+package webhookdelivery_test
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/webhookdelivery"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/webhookdelivery/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	persistenceautomock "github.com/kyma-incubator/compass/components/director/pkg/persistence/automock"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence/txtest"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/kyma-incubator/compass/components/director/pkg/str"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_Dispatch(t *testing.T) {
+	testErr := errors.New("test error")
+	cfg := webhookdelivery.Config{
+		RetryInterval:    time.Minute,
+		MaxRetryInterval: 3 * time.Minute,
+		MaxAttempts:      3,
+		Timeout:          time.Second,
+		BatchSize:        100,
+		LeaseDuration:    time.Minute,
+		LogRetention:     time.Hour,
+	}
+
+	var receivedBody, receivedHeader string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := ioutil.ReadAll(r.Body)
+		require.NoError(t, err)
+		receivedBody = string(body)
+		receivedHeader = r.Header.Get("X-App-Name")
+
+		switch r.URL.Path {
+		case "/ok":
+			w.WriteHeader(http.StatusOK)
+		case "/error":
+			w.WriteHeader(http.StatusOK)
+			_, err := w.Write([]byte(`{"error": "application is not ready"}`))
+			require.NoError(t, err)
+		default:
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	defer server.Close()
+
+	app := &model.Application{BaseEntity: &model.BaseEntity{ID: appID}, Tenant: tenantID, Name: "foo"}
+	gqlApp := &graphql.Application{BaseEntity: &graphql.BaseEntity{ID: appID}, Name: "foo"}
+	webhookFor := func(path string) *model.Webhook {
+		return &model.Webhook{
+			ID:             webhookID,
+			Type:           model.WebhookTypeConfigurationChanged,
+			URL:            str.Ptr(server.URL + path),
+			InputTemplate:  str.Ptr(`{"application_id": "{{.Application.ID}}", "tenant": "{{.TenantID}}"}`),
+			HeaderTemplate: str.Ptr(`{"X-App-Name": ["{{.Application.Name}}"]}`),
+		}
+	}
+	pendingDelivery := func(attempts int) *model.WebhookDelivery {
+		return &model.WebhookDelivery{
+			ID:            deliveryID,
+			Tenant:        tenantID,
+			WebhookID:     webhookID,
+			ApplicationID: appID,
+			Status:        model.WebhookDeliveryStatusPending,
+			Attempts:      attempts,
+			CreatedAt:     fixedTimestamp,
+			NextAttemptAt: &fixedTimestamp,
+		}
+	}
+	noChanges := func(repo *automock.DeliveryRepository) {
+		repo.On("ListChangesGlobal", txtest.CtxWithDBMatcher()).Return(nil, nil).Once()
+		repo.On("DeleteFinishedBeforeGlobal", txtest.CtxWithDBMatcher(), mock.AnythingOfType("time.Time")).Return(nil).Once()
+	}
+	targetOf := func(webhook *model.Webhook) (*automock.WebhookRepository, *automock.ApplicationRepository, *automock.ApplicationConverter, *automock.WebhookConverter) {
+		webhookRepo := &automock.WebhookRepository{}
+		webhookRepo.On("GetByID", txtest.CtxWithDBMatcher(), tenantID, webhookID).Return(webhook, nil).Once()
+		appRepo := &automock.ApplicationRepository{}
+		appRepo.On("GetByID", txtest.CtxWithDBMatcher(), tenantID, appID).Return(app, nil).Once()
+		appConv := &automock.ApplicationConverter{}
+		appConv.On("ToGraphQL", app).Return(gqlApp).Once()
+		webhookConv := &automock.WebhookConverter{}
+		webhookConv.On("ToGraphQL", webhook).Return(&graphql.Webhook{
+			ID:             webhook.ID,
+			Type:           graphql.WebhookTypeConfigurationChanged,
+			URL:            webhook.URL,
+			InputTemplate:  webhook.InputTemplate,
+			HeaderTemplate: webhook.HeaderTemplate,
+			OutputTemplate: webhook.OutputTemplate,
+		}, nil).Once()
+		return webhookRepo, appRepo, appConv, webhookConv
+	}
+
+	t.Run("schedules deliveries only for configuration changed webhooks", func(t *testing.T) {
+		persist, transact := txtest.NewTransactionContextGenerator(testErr).ThatSucceedsMultipleTimes(4)
+		repo := &automock.DeliveryRepository{}
+		repo.On("ListChangesGlobal", txtest.CtxWithDBMatcher()).Return([]*model.AppConfigurationChange{fixChangeModel()}, nil).Once()
+		repo.On("LockChangeGlobal", txtest.CtxWithDBMatcher(), appID).Return(fixChangeModel(), nil).Once()
+		repo.On("Create", txtest.CtxWithDBMatcher(), mock.MatchedBy(func(d *model.WebhookDelivery) bool {
+			return d.ID == deliveryID && d.WebhookID == webhookID && d.ApplicationID == appID && d.Tenant == tenantID &&
+				d.Status == model.WebhookDeliveryStatusPending && d.Attempts == 0 && d.NextAttemptAt != nil
+		})).Return(nil).Once()
+		repo.On("DeleteChangeGlobal", txtest.CtxWithDBMatcher(), fixChangeModel()).Return(nil).Once()
+		repo.On("ClaimDueGlobal", txtest.CtxWithDBMatcher(), mock.AnythingOfType("time.Time"), mock.AnythingOfType("time.Time"), 100).Return(nil, nil).Once()
+		repo.On("DeleteFinishedBeforeGlobal", txtest.CtxWithDBMatcher(), mock.AnythingOfType("time.Time")).Return(nil).Once()
+		webhookRepo := &automock.WebhookRepository{}
+		webhookRepo.On("ListByApplicationID", txtest.CtxWithDBMatcher(), tenantID, appID).Return([]*model.Webhook{
+			webhookFor("/ok"),
+			{ID: "other", Type: model.WebhookTypeRegisterApplication},
+		}, nil).Once()
+		uidSvc := &automock.UIDService{}
+		uidSvc.On("Generate").Return(deliveryID).Once()
+		svc := webhookdelivery.NewService(cfg, transact, repo, webhookRepo, &automock.ApplicationRepository{}, &automock.ApplicationConverter{}, &automock.WebhookConverter{}, uidSvc, server.Client())
+		//WHEN
+		err := svc.Dispatch(context.TODO())
+		//THEN
+		require.NoError(t, err)
+		mock.AssertExpectationsForObjects(t, persist, transact, repo, webhookRepo, uidSvc)
+	})
+
+	t.Run("continues with other changes when scheduling deliveries for a change fails", func(t *testing.T) {
+		otherChange := &model.AppConfigurationChange{ApplicationID: "other-app", Tenant: tenantID, ChangedAt: fixedTimestamp}
+		persist := &persistenceautomock.PersistenceTx{}
+		persist.On("Commit").Return(nil).Times(3)
+		transact := &persistenceautomock.Transactioner{}
+		transact.On("Begin").Return(persist, nil).Times(5)
+		transact.On("RollbackUnlessCommitted", mock.Anything, persist).Return().Times(5)
+		repo := &automock.DeliveryRepository{}
+		repo.On("ListChangesGlobal", txtest.CtxWithDBMatcher()).Return([]*model.AppConfigurationChange{fixChangeModel(), otherChange}, nil).Once()
+		repo.On("LockChangeGlobal", txtest.CtxWithDBMatcher(), appID).Return(fixChangeModel(), nil).Once()
+		repo.On("LockChangeGlobal", txtest.CtxWithDBMatcher(), otherChange.ApplicationID).Return(nil, apperrors.NewNotFoundErrorWithType(resource.AppConfigurationChange)).Once()
+		repo.On("ClaimDueGlobal", txtest.CtxWithDBMatcher(), mock.AnythingOfType("time.Time"), mock.AnythingOfType("time.Time"), 100).Return(nil, nil).Once()
+		repo.On("DeleteFinishedBeforeGlobal", txtest.CtxWithDBMatcher(), mock.AnythingOfType("time.Time")).Return(nil).Once()
+		webhookRepo := &automock.WebhookRepository{}
+		webhookRepo.On("ListByApplicationID", txtest.CtxWithDBMatcher(), tenantID, appID).Return(nil, testErr).Once()
+		svc := webhookdelivery.NewService(cfg, transact, repo, webhookRepo, &automock.ApplicationRepository{}, &automock.ApplicationConverter{}, &automock.WebhookConverter{}, &automock.UIDService{}, server.Client())
+		//WHEN
+		err := svc.Dispatch(context.TODO())
+		//THEN
+		require.NoError(t, err)
+		mock.AssertExpectationsForObjects(t, persist, transact, repo, webhookRepo)
+	})
+
+	t.Run("records a successful delivery", func(t *testing.T) {
+		persist, transact := txtest.NewTransactionContextGenerator(testErr).ThatSucceedsMultipleTimes(5)
+		var updated *model.WebhookDelivery
+		repo := &automock.DeliveryRepository{}
+		noChanges(repo)
+		repo.On("ClaimDueGlobal", txtest.CtxWithDBMatcher(), mock.AnythingOfType("time.Time"), mock.AnythingOfType("time.Time"), 100).Return([]*model.WebhookDelivery{pendingDelivery(0)}, nil).Once()
+		repo.On("Update", txtest.CtxWithDBMatcher(), mock.Anything).Run(func(args mock.Arguments) {
+			updated = args.Get(1).(*model.WebhookDelivery)
+		}).Return(nil).Once()
+		webhookRepo, appRepo, appConv, webhookConv := targetOf(webhookFor("/ok"))
+		svc := webhookdelivery.NewService(cfg, transact, repo, webhookRepo, appRepo, appConv, webhookConv, &automock.UIDService{}, server.Client())
+		//WHEN
+		err := svc.Dispatch(context.TODO())
+		//THEN
+		require.NoError(t, err)
+		require.NotNil(t, updated)
+		assert.Equal(t, model.WebhookDeliveryStatusSucceeded, updated.Status)
+		assert.Equal(t, 1, updated.Attempts)
+		assert.Equal(t, http.StatusOK, *updated.ResponseCode)
+		assert.Nil(t, updated.LastError)
+		assert.Nil(t, updated.NextAttemptAt)
+		assert.NotNil(t, updated.FinishedAt)
+		assert.JSONEq(t, `{"application_id": "`+appID+`", "tenant": "`+tenantID+`"}`, receivedBody)
+		assert.Equal(t, "foo", receivedHeader)
+		mock.AssertExpectationsForObjects(t, persist, transact, repo, webhookRepo, appRepo, appConv, webhookConv)
+	})
+
+	t.Run("retries a failed delivery with growing interval", func(t *testing.T) {
+		persist, transact := txtest.NewTransactionContextGenerator(testErr).ThatSucceedsMultipleTimes(5)
+		var updated *model.WebhookDelivery
+		repo := &automock.DeliveryRepository{}
+		noChanges(repo)
+		repo.On("ClaimDueGlobal", txtest.CtxWithDBMatcher(), mock.AnythingOfType("time.Time"), mock.AnythingOfType("time.Time"), 100).Return([]*model.WebhookDelivery{pendingDelivery(1)}, nil).Once()
+		repo.On("Update", txtest.CtxWithDBMatcher(), mock.Anything).Run(func(args mock.Arguments) {
+			updated = args.Get(1).(*model.WebhookDelivery)
+		}).Return(nil).Once()
+		webhookRepo, appRepo, appConv, webhookConv := targetOf(webhookFor("/unavailable"))
+		svc := webhookdelivery.NewService(cfg, transact, repo, webhookRepo, appRepo, appConv, webhookConv, &automock.UIDService{}, server.Client())
+		before := time.Now()
+		//WHEN
+		err := svc.Dispatch(context.TODO())
+		//THEN
+		require.NoError(t, err)
+		require.NotNil(t, updated)
+		assert.Equal(t, model.WebhookDeliveryStatusPending, updated.Status)
+		assert.Equal(t, 2, updated.Attempts)
+		assert.Equal(t, http.StatusServiceUnavailable, *updated.ResponseCode)
+		assert.Equal(t, "webhook responded with status code 503", str.PtrStrToStr(updated.LastError))
+		require.NotNil(t, updated.NextAttemptAt)
+		assert.WithinDuration(t, before.Add(2*time.Minute), *updated.NextAttemptAt, 5*time.Second)
+		assert.Nil(t, updated.FinishedAt)
+		mock.AssertExpectationsForObjects(t, persist, transact, repo, webhookRepo, appRepo, appConv, webhookConv)
+	})
+
+	t.Run("gives up after the maximum number of attempts", func(t *testing.T) {
+		persist, transact := txtest.NewTransactionContextGenerator(testErr).ThatSucceedsMultipleTimes(5)
+		var updated *model.WebhookDelivery
+		repo := &automock.DeliveryRepository{}
+		noChanges(repo)
+		repo.On("ClaimDueGlobal", txtest.CtxWithDBMatcher(), mock.AnythingOfType("time.Time"), mock.AnythingOfType("time.Time"), 100).Return([]*model.WebhookDelivery{pendingDelivery(2)}, nil).Once()
+		repo.On("Update", txtest.CtxWithDBMatcher(), mock.Anything).Run(func(args mock.Arguments) {
+			updated = args.Get(1).(*model.WebhookDelivery)
+		}).Return(nil).Once()
+		webhook := webhookFor("/error")
+		webhook.OutputTemplate = str.Ptr(`{"location": "", "success_status_code": 200, "error": "{{.Body.error}}"}`)
+		webhookRepo, appRepo, appConv, webhookConv := targetOf(webhook)
+		svc := webhookdelivery.NewService(cfg, transact, repo, webhookRepo, appRepo, appConv, webhookConv, &automock.UIDService{}, server.Client())
+		//WHEN
+		err := svc.Dispatch(context.TODO())
+		//THEN
+		require.NoError(t, err)
+		require.NotNil(t, updated)
+		assert.Equal(t, model.WebhookDeliveryStatusFailed, updated.Status)
+		assert.Equal(t, 3, updated.Attempts)
+		assert.Equal(t, "received error from webhook: application is not ready", str.PtrStrToStr(updated.LastError))
+		assert.Nil(t, updated.NextAttemptAt)
+		assert.NotNil(t, updated.FinishedAt)
+		mock.AssertExpectationsForObjects(t, persist, transact, repo, webhookRepo, appRepo, appConv, webhookConv)
+	})
+
+	t.Run("returns error when scheduling deliveries fails", func(t *testing.T) {
+		persist, transact := txtest.NewTransactionContextGenerator(testErr).ThatDoesntExpectCommit()
+		repo := &automock.DeliveryRepository{}
+		repo.On("ListChangesGlobal", txtest.CtxWithDBMatcher()).Return(nil, testErr).Once()
+		svc := webhookdelivery.NewService(cfg, transact, repo, &automock.WebhookRepository{}, &automock.ApplicationRepository{}, &automock.ApplicationConverter{}, &automock.WebhookConverter{}, &automock.UIDService{}, server.Client())
+		//WHEN
+		err := svc.Dispatch(context.TODO())
+		//THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), testErr.Error())
+		mock.AssertExpectationsForObjects(t, persist, transact, repo)
+	})
+
+	t.Run("retries the delivery when loading the webhook fails", func(t *testing.T) {
+		_, transact := txtest.NewTransactionContextGenerator(testErr).ThatSucceedsMultipleTimes(5)
+		var updated *model.WebhookDelivery
+		repo := &automock.DeliveryRepository{}
+		noChanges(repo)
+		repo.On("ClaimDueGlobal", txtest.CtxWithDBMatcher(), mock.AnythingOfType("time.Time"), mock.AnythingOfType("time.Time"), 100).Return([]*model.WebhookDelivery{pendingDelivery(0)}, nil).Once()
+		repo.On("Update", txtest.CtxWithDBMatcher(), mock.Anything).Run(func(args mock.Arguments) {
+			updated = args.Get(1).(*model.WebhookDelivery)
+		}).Return(nil).Once()
+		webhookRepo := &automock.WebhookRepository{}
+		webhookRepo.On("GetByID", txtest.CtxWithDBMatcher(), tenantID, webhookID).Return(nil, testErr).Once()
+		svc := webhookdelivery.NewService(cfg, transact, repo, webhookRepo, &automock.ApplicationRepository{}, &automock.ApplicationConverter{}, &automock.WebhookConverter{}, &automock.UIDService{}, server.Client())
+		before := time.Now()
+		//WHEN
+		err := svc.Dispatch(context.TODO())
+		//THEN
+		require.NoError(t, err)
+		require.NotNil(t, updated)
+		assert.Equal(t, model.WebhookDeliveryStatusPending, updated.Status)
+		assert.Equal(t, 1, updated.Attempts)
+		assert.Nil(t, updated.ResponseCode)
+		assert.Contains(t, str.PtrStrToStr(updated.LastError), testErr.Error())
+		require.NotNil(t, updated.NextAttemptAt)
+		assert.WithinDuration(t, before.Add(time.Minute), *updated.NextAttemptAt, 5*time.Second)
+		mock.AssertExpectationsForObjects(t, transact, repo, webhookRepo)
+	})
+
+	t.Run("continues with other deliveries when the webhook does not exist", func(t *testing.T) {
+		_, transact := txtest.NewTransactionContextGenerator(testErr).ThatSucceedsMultipleTimes(4)
+		repo := &automock.DeliveryRepository{}
+		noChanges(repo)
+		repo.On("ClaimDueGlobal", txtest.CtxWithDBMatcher(), mock.AnythingOfType("time.Time"), mock.AnythingOfType("time.Time"), 100).Return([]*model.WebhookDelivery{pendingDelivery(0)}, nil).Once()
+		webhookRepo := &automock.WebhookRepository{}
+		webhookRepo.On("GetByID", txtest.CtxWithDBMatcher(), tenantID, webhookID).Return(nil, apperrors.NewNotFoundError(resource.Webhook, webhookID)).Once()
+		svc := webhookdelivery.NewService(cfg, transact, repo, webhookRepo, &automock.ApplicationRepository{}, &automock.ApplicationConverter{}, &automock.WebhookConverter{}, &automock.UIDService{}, server.Client())
+		//WHEN
+		err := svc.Dispatch(context.TODO())
+		//THEN
+		require.NoError(t, err)
+		mock.AssertExpectationsForObjects(t, transact, repo, webhookRepo)
+	})
+}
@@ -2,4 +2,6 @@ package features
 
 type Config struct {
 	DefaultScenarioEnabled bool `envconfig:"default=true,APP_DEFAULT_SCENARIO_ENABLED"`
+	ValidateSpecs          bool `envconfig:"default=false,APP_VALIDATE_SPECS"`
+	ConvertSpecsToJSON     bool `envconfig:"default=false,APP_CONVERT_SPECS_TO_JSON"`
 }
@@ -0,0 +1,47 @@
+package joblease
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/pkg/errors"
+)
+
+const jobLeaseTable string = `public.job_leases`
+
+type pgRepository struct{}
+
+func NewRepository() *pgRepository {
+	return &pgRepository{}
+}
+
+// TryAcquireGlobal acquires or renews the lease of the job with the given name for the holder until the given time.
+// The lease is acquired only if it is not held by another holder, or if the lease of the other holder has expired before now.
+// It reports whether the lease has been acquired.
+func (r *pgRepository) TryAcquireGlobal(ctx context.Context, name, holder string, now, until time.Time) (bool, error) {
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	query := fmt.Sprintf("INSERT INTO %[1]s (name, locked_by, locked_until) VALUES ($1, $2, $3) "+
+		"ON CONFLICT (name) DO UPDATE SET locked_by = EXCLUDED.locked_by, locked_until = EXCLUDED.locked_until "+
+		"WHERE %[1]s.locked_by = EXCLUDED.locked_by OR %[1]s.locked_until < $4", jobLeaseTable)
+
+	log.C(ctx).Debugf("Executing DB query: %s", query)
+	result, err := persist.Exec(query, name, holder, until, now)
+	if err = persistence.MapSQLError(ctx, err, resource.JobLease, resource.Upsert, "while upserting row to '%s' table", jobLeaseTable); err != nil {
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, errors.Wrap(err, "while checking affected rows")
+	}
+
+	return affected == 1, nil
+}
@@ -0,0 +1,68 @@
+package joblease_test
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/kyma-incubator/compass/components/director/internal/joblease"
+	"github.com/kyma-incubator/compass/components/director/internal/repo/testdb"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPgRepository_TryAcquireGlobal(t *testing.T) {
+	upsertQuery := regexp.QuoteMeta(`INSERT INTO public.job_leases (name, locked_by, locked_until) VALUES ($1, $2, $3) ` +
+		`ON CONFLICT (name) DO UPDATE SET locked_by = EXCLUDED.locked_by, locked_until = EXCLUDED.locked_until ` +
+		`WHERE public.job_leases.locked_by = EXCLUDED.locked_by OR public.job_leases.locked_until < $4`)
+	now := time.Date(2021, 4, 16, 9, 0, 0, 0, time.UTC)
+	until := now.Add(time.Minute)
+
+	t.Run("acquires the lease", func(t *testing.T) {
+		sqlxDB, sqlMock := testdb.MockDatabase(t)
+		sqlMock.ExpectExec(upsertQuery).
+			WithArgs("job", "holder", until, now).
+			WillReturnResult(sqlmock.NewResult(-1, 1))
+
+		ctx := persistence.SaveToContext(context.TODO(), sqlxDB)
+		// WHEN
+		acquired, err := joblease.NewRepository().TryAcquireGlobal(ctx, "job", "holder", now, until)
+		// THEN
+		require.NoError(t, err)
+		assert.True(t, acquired)
+		sqlMock.AssertExpectations(t)
+	})
+
+	t.Run("does not acquire the lease held by another holder", func(t *testing.T) {
+		sqlxDB, sqlMock := testdb.MockDatabase(t)
+		sqlMock.ExpectExec(upsertQuery).
+			WithArgs("job", "holder", until, now).
+			WillReturnResult(sqlmock.NewResult(-1, 0))
+
+		ctx := persistence.SaveToContext(context.TODO(), sqlxDB)
+		// WHEN
+		acquired, err := joblease.NewRepository().TryAcquireGlobal(ctx, "job", "holder", now, until)
+		// THEN
+		require.NoError(t, err)
+		assert.False(t, acquired)
+		sqlMock.AssertExpectations(t)
+	})
+
+	t.Run("returns error when the query fails", func(t *testing.T) {
+		sqlxDB, sqlMock := testdb.MockDatabase(t)
+		sqlMock.ExpectExec(upsertQuery).
+			WithArgs("job", "holder", until, now).
+			WillReturnError(errors.New("test error"))
+
+		ctx := persistence.SaveToContext(context.TODO(), sqlxDB)
+		// WHEN
+		_, err := joblease.NewRepository().TryAcquireGlobal(ctx, "job", "holder", now, until)
+		// THEN
+		require.EqualError(t, err, "Internal Server Error: Unexpected error while executing SQL query")
+		sqlMock.AssertExpectations(t)
+	})
+}
@@ -5,12 +5,15 @@ import "github.com/kyma-incubator/compass/components/director/pkg/graphql"
 type LabelFilter struct {
 	Key   string
 	Query *string
+	// Or holds alternative filters, the object matches if it matches the filter itself or any of them
+	Or []*LabelFilter
 }
 
 func FromGraphQL(in *graphql.LabelFilter) *LabelFilter {
 	return &LabelFilter{
 		Key:   in.Key,
 		Query: in.Query,
+		Or:    MultipleFromGraphQL(in.Or),
 	}
 }
 
@@ -25,9 +28,9 @@ func MultipleFromGraphQL(in []*graphql.LabelFilter) []*LabelFilter {
 }
 
 func NewForKey(key string) *LabelFilter {
-	return &LabelFilter{key, nil}
+	return &LabelFilter{Key: key}
 }
 
 func NewForKeyWithQuery(key, query string) *LabelFilter {
-	return &LabelFilter{key, &query}
+	return &LabelFilter{Key: key, Query: &query}
 }
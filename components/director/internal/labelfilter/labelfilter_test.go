@@ -41,6 +41,27 @@ func TestFromGraphQL(t *testing.T) {
 
 		assert.Equal(t, expected, result)
 	})
+
+	t.Run("With alternatives", func(t *testing.T) {
+		query := `= "foo"`
+		in := &graphql.LabelFilter{
+			Key: "label",
+			Or: []*graphql.LabelFilter{
+				{Key: "label2", Query: &query},
+			},
+		}
+
+		expected := &labelfilter.LabelFilter{
+			Key: "label",
+			Or: []*labelfilter.LabelFilter{
+				{Key: "label2", Query: &query},
+			},
+		}
+
+		result := labelfilter.FromGraphQL(in)
+
+		assert.Equal(t, expected, result)
+	})
 }
 
 func TestMultipleFromGraphQL(t *testing.T) {
@@ -12,6 +12,8 @@ type Collector struct {
 	graphQLRequestDuration *prometheus.HistogramVec
 	hydraRequestTotal      *prometheus.CounterVec
 	hydraRequestDuration   *prometheus.HistogramVec
+	graphQLQueryComplexity prometheus.Histogram
+	graphQLRejectedQueries *prometheus.CounterVec
 }
 
 func NewCollector() *Collector {
@@ -40,6 +42,19 @@ func NewCollector() *Collector {
 			Name:      "hydra_request_total",
 			Help:      "Total HTTP Requests to Hydra",
 		}, []string{"code", "method"}),
+		graphQLQueryComplexity: prometheus.NewHistogram(prometheus.HistogramOpts{
+			Namespace: Namespace,
+			Subsystem: DirectorSubsystem,
+			Name:      "graphql_query_complexity",
+			Help:      "Complexity of GraphQL operations",
+			Buckets:   prometheus.ExponentialBuckets(10, 10, 7),
+		}),
+		graphQLRejectedQueries: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: Namespace,
+			Subsystem: DirectorSubsystem,
+			Name:      "graphql_rejected_queries_total",
+			Help:      "Total GraphQL operations rejected because of their depth, complexity or the rate limit of their consumer",
+		}, []string{"reason"}),
 	}
 }
 
@@ -48,6 +63,8 @@ func (c *Collector) Describe(ch chan<- *prometheus.Desc) {
 	c.graphQLRequestDuration.Describe(ch)
 	c.hydraRequestTotal.Describe(ch)
 	c.hydraRequestDuration.Describe(ch)
+	c.graphQLQueryComplexity.Describe(ch)
+	c.graphQLRejectedQueries.Describe(ch)
 }
 
 func (c *Collector) Collect(ch chan<- prometheus.Metric) {
@@ -55,6 +72,8 @@ func (c *Collector) Collect(ch chan<- prometheus.Metric) {
 	c.graphQLRequestDuration.Collect(ch)
 	c.hydraRequestTotal.Collect(ch)
 	c.hydraRequestDuration.Collect(ch)
+	c.graphQLQueryComplexity.Collect(ch)
+	c.graphQLRejectedQueries.Collect(ch)
 }
 
 func (c *Collector) GraphQLHandlerWithInstrumentation(handler http.Handler) http.HandlerFunc {
@@ -63,6 +82,14 @@ func (c *Collector) GraphQLHandlerWithInstrumentation(handler http.Handler) http
 	)
 }
 
+func (c *Collector) ObserveQueryComplexity(complexity int) {
+	c.graphQLQueryComplexity.Observe(float64(complexity))
+}
+
+func (c *Collector) RecordRejectedQuery(reason string) {
+	c.graphQLRejectedQueries.WithLabelValues(reason).Inc()
+}
+
 func (c *Collector) InstrumentOAuth20HTTPClient(client *http.Client) {
 	client.Transport = promhttp.InstrumentRoundTripperCounter(c.hydraRequestTotal,
 		promhttp.InstrumentRoundTripperDuration(c.hydraRequestDuration, http.DefaultTransport),
@@ -82,6 +82,23 @@ type ApplicationPage struct {
 	TotalCount int
 }
 
+// ApplicationOrderField is a field by which the applications can be ordered
+type ApplicationOrderField string
+
+const (
+	ApplicationOrderFieldID   ApplicationOrderField = "ID"
+	ApplicationOrderFieldName ApplicationOrderField = "NAME"
+)
+
+// ApplicationOrderBy is the order in which the applications are listed
+type ApplicationOrderBy struct {
+	Field      ApplicationOrderField
+	Descending bool
+}
+
+// DefaultApplicationOrderBy orders the applications by their IDs
+var DefaultApplicationOrderBy = ApplicationOrderBy{Field: ApplicationOrderFieldID}
+
 type ApplicationRegisterInput struct {
 	Name                string
 	ProviderName        *string
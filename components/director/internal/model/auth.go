@@ -6,6 +6,7 @@ type Auth struct {
 	AdditionalQueryParams map[string][]string
 	RequestAuth           *CredentialRequestAuth
 	OneTimeToken          *OneTimeToken
+	Signing               *RequestSigning `json:",omitempty"`
 }
 
 type CredentialRequestAuth struct {
@@ -20,8 +21,9 @@ type CSRFTokenCredentialRequestAuth struct {
 }
 
 type CredentialData struct {
-	Basic *BasicCredentialData
-	Oauth *OAuthCredentialData
+	Basic       *BasicCredentialData
+	Oauth       *OAuthCredentialData
+	Certificate *CertificateCredentialData `json:",omitempty"`
 }
 
 type BasicCredentialData struct {
@@ -34,12 +36,26 @@ type OAuthCredentialData struct {
 	URL          string
 }
 
+// CertificateCredentialData is a PEM encoded client certificate together with its private key
+type CertificateCredentialData struct {
+	Certificate string
+	PrivateKey  string
+}
+
+// RequestSigning configures the HMAC-SHA256 signing of the requests sent with the Auth
+type RequestSigning struct {
+	Header          string
+	TimestampHeader string
+	Secrets         []string
+}
+
 type AuthInput struct {
 	Credential            *CredentialDataInput
 	AdditionalHeaders     map[string][]string
 	AdditionalQueryParams map[string][]string
 	RequestAuth           *CredentialRequestAuthInput
 	OneTimeToken          *OneTimeToken
+	Signing               *RequestSigningInput
 }
 
 func (i *AuthInput) ToAuth() *Auth {
@@ -63,12 +79,14 @@ func (i *AuthInput) ToAuth() *Auth {
 		AdditionalHeaders:     i.AdditionalHeaders,
 		RequestAuth:           requestAuth,
 		OneTimeToken:          i.OneTimeToken,
+		Signing:               i.Signing.ToRequestSigning(),
 	}
 }
 
 type CredentialDataInput struct {
-	Basic *BasicCredentialDataInput
-	Oauth *OAuthCredentialDataInput
+	Basic       *BasicCredentialDataInput
+	Oauth       *OAuthCredentialDataInput
+	Certificate *CertificateCredentialDataInput
 }
 
 func (i *CredentialDataInput) ToCredentialData() *CredentialData {
@@ -88,8 +106,9 @@ func (i *CredentialDataInput) ToCredentialData() *CredentialData {
 	}
 
 	return &CredentialData{
-		Basic: basic,
-		Oauth: oauth,
+		Basic:       basic,
+		Oauth:       oauth,
+		Certificate: i.Certificate.ToCertificateCredentialData(),
 	}
 }
 
@@ -127,6 +146,40 @@ func (i *OAuthCredentialDataInput) ToOAuthCredentialData() *OAuthCredentialData
 	}
 }
 
+type CertificateCredentialDataInput struct {
+	Certificate string
+	PrivateKey  string
+}
+
+func (i *CertificateCredentialDataInput) ToCertificateCredentialData() *CertificateCredentialData {
+	if i == nil {
+		return nil
+	}
+
+	return &CertificateCredentialData{
+		Certificate: i.Certificate,
+		PrivateKey:  i.PrivateKey,
+	}
+}
+
+type RequestSigningInput struct {
+	Header          string
+	TimestampHeader string
+	Secrets         []string
+}
+
+func (i *RequestSigningInput) ToRequestSigning() *RequestSigning {
+	if i == nil {
+		return nil
+	}
+
+	return &RequestSigning{
+		Header:          i.Header,
+		TimestampHeader: i.TimestampHeader,
+		Secrets:         i.Secrets,
+	}
+}
+
 type CredentialRequestAuthInput struct {
 	Csrf *CSRFTokenCredentialRequestAuthInput
 }
@@ -34,6 +34,10 @@ func TestAuthInput_ToAuth(t *testing.T) {
 						TokenEndpointURL: "test",
 					},
 				},
+				Signing: &model.RequestSigningInput{
+					Header:  "X-Signature",
+					Secrets: []string{"secret"},
+				},
 			},
 			Expected: &model.Auth{
 				Credential: model.CredentialData{
@@ -52,6 +56,10 @@ func TestAuthInput_ToAuth(t *testing.T) {
 						TokenEndpointURL: "test",
 					},
 				},
+				Signing: &model.RequestSigning{
+					Header:  "X-Signature",
+					Secrets: []string{"secret"},
+				},
 			},
 		},
 		{
@@ -94,6 +102,10 @@ func TestCredentialDataInput_ToCredentialData(t *testing.T) {
 				Oauth: &model.OAuthCredentialDataInput{
 					URL: "test",
 				},
+				Certificate: &model.CertificateCredentialDataInput{
+					Certificate: "cert",
+					PrivateKey:  "key",
+				},
 			},
 			Expected: &model.CredentialData{
 				Basic: &model.BasicCredentialData{
@@ -102,6 +114,10 @@ func TestCredentialDataInput_ToCredentialData(t *testing.T) {
 				Oauth: &model.OAuthCredentialData{
 					URL: "test",
 				},
+				Certificate: &model.CertificateCredentialData{
+					Certificate: "cert",
+					PrivateKey:  "key",
+				},
 			},
 		},
 		{
@@ -214,6 +230,92 @@ func TestOAuthCredentialDataInput_ToOAuthCredentialData(t *testing.T) {
 	}
 }
 
+func TestCertificateCredentialDataInput_ToCertificateCredentialData(t *testing.T) {
+	// given
+	testCases := []struct {
+		Name     string
+		Input    *model.CertificateCredentialDataInput
+		Expected *model.CertificateCredentialData
+	}{
+		{
+			Name: "All properties given",
+			Input: &model.CertificateCredentialDataInput{
+				Certificate: "cert",
+				PrivateKey:  "key",
+			},
+			Expected: &model.CertificateCredentialData{
+				Certificate: "cert",
+				PrivateKey:  "key",
+			},
+		},
+		{
+			Name:     "Empty",
+			Input:    &model.CertificateCredentialDataInput{},
+			Expected: &model.CertificateCredentialData{},
+		},
+		{
+			Name:     "Nil",
+			Input:    nil,
+			Expected: nil,
+		},
+	}
+
+	for i, testCase := range testCases {
+		t.Run(fmt.Sprintf("%d: %s", i, testCase.Name), func(t *testing.T) {
+
+			// when
+			result := testCase.Input.ToCertificateCredentialData()
+
+			// then
+			assert.Equal(t, testCase.Expected, result)
+		})
+	}
+}
+
+func TestRequestSigningInput_ToRequestSigning(t *testing.T) {
+	// given
+	testCases := []struct {
+		Name     string
+		Input    *model.RequestSigningInput
+		Expected *model.RequestSigning
+	}{
+		{
+			Name: "All properties given",
+			Input: &model.RequestSigningInput{
+				Header:          "X-Signature",
+				TimestampHeader: "X-Timestamp",
+				Secrets:         []string{"old", "new"},
+			},
+			Expected: &model.RequestSigning{
+				Header:          "X-Signature",
+				TimestampHeader: "X-Timestamp",
+				Secrets:         []string{"old", "new"},
+			},
+		},
+		{
+			Name:     "Empty",
+			Input:    &model.RequestSigningInput{},
+			Expected: &model.RequestSigning{},
+		},
+		{
+			Name:     "Nil",
+			Input:    nil,
+			Expected: nil,
+		},
+	}
+
+	for i, testCase := range testCases {
+		t.Run(fmt.Sprintf("%d: %s", i, testCase.Name), func(t *testing.T) {
+
+			// when
+			result := testCase.Input.ToRequestSigning()
+
+			// then
+			assert.Equal(t, testCase.Expected, result)
+		})
+	}
+}
+
 func TestCredentialRequestAuthInput_ToCredentialRequestAuth(t *testing.T) {
 	// given
 	testCases := []struct {
@@ -15,6 +15,10 @@ type FetchRequest struct {
 	Status     *FetchRequestStatus
 	ObjectType FetchRequestReferenceObjectType
 	ObjectID   string
+	// SpecHash is the SHA-256 hash of the last successfully fetched specification
+	SpecHash *string
+	// SpecChangedAt is the time when a fetch last returned a specification with a different hash
+	SpecChangedAt *time.Time
 }
 
 type FetchRequestReferenceObjectType string
@@ -0,0 +1,39 @@
+package model
+
+import (
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
+)
+
+// HealthCheck is the result of the last health check of the given type performed for the origin object
+type HealthCheck struct {
+	ID        string
+	Tenant    string
+	Type      HealthCheckType
+	Origin    string
+	Condition HealthCheckStatusCondition
+	Message   *string
+	Timestamp time.Time
+}
+
+type HealthCheckType string
+
+const (
+	HealthCheckTypeManagementPlaneApplicationHealthCheck HealthCheckType = "MANAGEMENT_PLANE_APPLICATION_HEALTHCHECK"
+)
+
+type HealthCheckStatusCondition string
+
+const (
+	HealthCheckStatusConditionSucceeded HealthCheckStatusCondition = "SUCCEEDED"
+	HealthCheckStatusConditionFailed    HealthCheckStatusCondition = "FAILED"
+)
+
+type HealthCheckPage struct {
+	Data       []*HealthCheck
+	PageInfo   *pagination.Page
+	TotalCount int
+}
+
+func (HealthCheckPage) IsPageable() {}
@@ -0,0 +1,57 @@
+package model
+
+import (
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+)
+
+// Operation is the record of an asynchronous operation scheduled for an application or a runtime
+type Operation struct {
+	ID             string
+	OperationID    string
+	Tenant         string
+	ResourceType   resource.Type
+	ResourceID     string
+	Type           OperationType
+	Category       string
+	CorrelationID  string
+	Status         OperationStatus
+	Error          *string
+	WebhookResults []OperationWebhookResult
+	CreatedAt      time.Time
+	FinishedAt     *time.Time
+}
+
+type OperationType string
+
+const (
+	OperationTypeCreate OperationType = "CREATE"
+	OperationTypeUpdate OperationType = "UPDATE"
+	OperationTypeDelete OperationType = "DELETE"
+)
+
+type OperationStatus string
+
+const (
+	OperationStatusInProgress OperationStatus = "IN_PROGRESS"
+	OperationStatusSucceeded  OperationStatus = "SUCCEEDED"
+	OperationStatusFailed     OperationStatus = "FAILED"
+)
+
+// OperationWebhookResult is the outcome of the execution of a single webhook of an operation
+type OperationWebhookResult struct {
+	WebhookID      string
+	State          string
+	RetriesCount   int
+	FailedAttempts int
+}
+
+type OperationPage struct {
+	Data       []*Operation
+	PageInfo   *pagination.Page
+	TotalCount int
+}
+
+func (OperationPage) IsPageable() {}
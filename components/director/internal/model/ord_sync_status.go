@@ -0,0 +1,29 @@
+package model
+
+import "time"
+
+// ORDSyncStatus tracks the outcome of the Open Resource Discovery synchronization of a single application
+type ORDSyncStatus struct {
+	ApplicationID       string
+	TenantID            string
+	LastSyncAt          *time.Time
+	LastSuccessAt       *time.Time
+	LastError           *string
+	ConsecutiveFailures int
+	NextSyncAt          *time.Time
+	// Documents holds the state of the ORD documents as of the last successful synchronization
+	Documents []ORDDocumentStatus
+	// ResourceHashes holds the content hashes of the synchronized ORD resources keyed by resource kind and ORD ID
+	ResourceHashes map[string]string
+}
+
+// ORDDocumentStatus describes a single ORD document as of the last successful synchronization.
+// The ETag and LastModified validators are used to fetch the document conditionally.
+// SkipReason is set for documents which were not synchronized, e.g. because none of their access strategies is supported.
+type ORDDocumentStatus struct {
+	URL          string `json:"url"`
+	Hash         string `json:"hash"`
+	ETag         string `json:"etag,omitempty"`
+	LastModified string `json:"lastModified,omitempty"`
+	SkipReason   string `json:"skipReason,omitempty"`
+}
@@ -2,6 +2,8 @@ package model
 
 import (
 	"encoding/json"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
 )
 
 type Package struct {
@@ -27,6 +29,14 @@ type Package struct {
 	Industry          json.RawMessage
 }
 
+type PackagePage struct {
+	Data       []*Package
+	PageInfo   *pagination.Page
+	TotalCount int
+}
+
+func (PackagePage) IsPageable() {}
+
 type PackageInput struct {
 	OrdID             string          `json:"ordId"`
 	Vendor            *string         `json:"vendor"`
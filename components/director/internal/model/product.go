@@ -2,6 +2,8 @@ package model
 
 import (
 	"encoding/json"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
 )
 
 type Product struct {
@@ -16,6 +18,14 @@ type Product struct {
 	Labels           json.RawMessage
 }
 
+type ProductPage struct {
+	Data       []*Product
+	PageInfo   *pagination.Page
+	TotalCount int
+}
+
+func (ProductPage) IsPageable() {}
+
 type ProductInput struct {
 	OrdID            string          `json:"id"`
 	Title            string          `json:"title"`
@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
 )
 
 type Runtime struct {
@@ -13,6 +14,68 @@ type Runtime struct {
 	Tenant            string
 	Status            *RuntimeStatus
 	CreationTimestamp time.Time
+	Ready             bool
+	UpdatedAt         *time.Time
+	DeletedAt         *time.Time
+	Error             *string
+}
+
+func (r *Runtime) GetID() string {
+	return r.ID
+}
+
+func (r *Runtime) GetType() resource.Type {
+	return resource.Runtime
+}
+
+func (r *Runtime) GetReady() bool {
+	return r.Ready
+}
+
+func (r *Runtime) SetReady(ready bool) {
+	r.Ready = ready
+}
+
+func (r *Runtime) GetCreatedAt() time.Time {
+	return r.CreationTimestamp
+}
+
+func (r *Runtime) SetCreatedAt(t time.Time) {
+	r.CreationTimestamp = t
+}
+
+func (r *Runtime) GetUpdatedAt() time.Time {
+	if r.UpdatedAt == nil {
+		return time.Time{}
+	}
+	return *r.UpdatedAt
+}
+
+func (r *Runtime) SetUpdatedAt(t time.Time) {
+	r.UpdatedAt = &t
+}
+
+func (r *Runtime) GetDeletedAt() time.Time {
+	if r.DeletedAt == nil {
+		return time.Time{}
+	}
+	return *r.DeletedAt
+}
+
+func (r *Runtime) SetDeletedAt(t time.Time) {
+	r.DeletedAt = &t
+}
+
+func (r *Runtime) GetError() *string {
+	return r.Error
+}
+
+func (r *Runtime) SetError(err string) {
+	if err == "" {
+		r.Error = nil
+	} else {
+		r.Error = &err
+	}
 }
 
 type RuntimeStatus struct {
@@ -51,6 +114,7 @@ func (i *RuntimeInput) ToRuntime(id string, tenant string, creationTimestamp, co
 			Timestamp: conditionTimestamp,
 		},
 		CreationTimestamp: creationTimestamp,
+		Ready:             true,
 	}
 }
 
@@ -68,3 +132,21 @@ type RuntimePage struct {
 	PageInfo   *pagination.Page
 	TotalCount int
 }
+
+// RuntimeOrderField is a field by which the runtimes can be ordered
+type RuntimeOrderField string
+
+const (
+	RuntimeOrderFieldID                RuntimeOrderField = "ID"
+	RuntimeOrderFieldName              RuntimeOrderField = "NAME"
+	RuntimeOrderFieldCreationTimestamp RuntimeOrderField = "CREATION_TIMESTAMP"
+)
+
+// RuntimeOrderBy is the order in which the runtimes are listed
+type RuntimeOrderBy struct {
+	Field      RuntimeOrderField
+	Descending bool
+}
+
+// DefaultRuntimeOrderBy orders the runtimes by their names
+var DefaultRuntimeOrderBy = RuntimeOrderBy{Field: RuntimeOrderFieldName}
@@ -42,6 +42,7 @@ func TestRuntimeInput_ToRuntime(t *testing.T) {
 					Timestamp: conditionTimestamp,
 				},
 				CreationTimestamp: creationTimestamp,
+				Ready:             true,
 			},
 		},
 		{
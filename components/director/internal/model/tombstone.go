@@ -1,5 +1,7 @@
 package model
 
+import "github.com/kyma-incubator/compass/components/director/pkg/pagination"
+
 type Tombstone struct {
 	OrdID         string
 	TenantID      string
@@ -7,6 +9,14 @@ type Tombstone struct {
 	RemovalDate   string
 }
 
+type TombstonePage struct {
+	Data       []*Tombstone
+	PageInfo   *pagination.Page
+	TotalCount int
+}
+
+func (TombstonePage) IsPageable() {}
+
 type TombstoneInput struct {
 	OrdID       string `json:"ordId"`
 	RemovalDate string `json:"removalDate"`
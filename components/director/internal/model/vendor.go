@@ -2,6 +2,8 @@ package model
 
 import (
 	"encoding/json"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
 )
 
 type Vendor struct {
@@ -13,6 +15,14 @@ type Vendor struct {
 	Labels        json.RawMessage
 }
 
+type VendorPage struct {
+	Data       []*Vendor
+	PageInfo   *pagination.Page
+	TotalCount int
+}
+
+func (VendorPage) IsPageable() {}
+
 type VendorInput struct {
 	OrdID         string `json:"id"`
 	Title         string `json:"title"`
@@ -13,6 +13,7 @@ type Webhook struct {
 	Auth                  *Auth
 	Mode                  *WebhookMode
 	RetryInterval         *int
+	RetryPolicy           *WebhookRetryPolicy
 	Timeout               *int
 	URLTemplate           *string
 	InputTemplate         *string
@@ -28,6 +29,7 @@ type WebhookInput struct {
 	Auth             *AuthInput
 	Mode             *WebhookMode
 	RetryInterval    *int
+	RetryPolicy      *WebhookRetryPolicyInput
 	Timeout          *int
 	URLTemplate      *string
 	InputTemplate    *string
@@ -36,6 +38,40 @@ type WebhookInput struct {
 	StatusTemplate   *string
 }
 
+// WebhookRetryPolicy defines how failed executions of a webhook are retried
+type WebhookRetryPolicy struct {
+	MaxAttempts          *int
+	InitialInterval      *int
+	MaxInterval          *int
+	Multiplier           *float64
+	Jitter               *float64
+	RetryableStatusCodes []int
+}
+
+type WebhookRetryPolicyInput struct {
+	MaxAttempts          *int
+	InitialInterval      *int
+	MaxInterval          *int
+	Multiplier           *float64
+	Jitter               *float64
+	RetryableStatusCodes []int
+}
+
+func (i *WebhookRetryPolicyInput) ToRetryPolicy() *WebhookRetryPolicy {
+	if i == nil {
+		return nil
+	}
+
+	return &WebhookRetryPolicy{
+		MaxAttempts:          i.MaxAttempts,
+		InitialInterval:      i.InitialInterval,
+		MaxInterval:          i.MaxInterval,
+		Multiplier:           i.Multiplier,
+		Jitter:               i.Jitter,
+		RetryableStatusCodes: i.RetryableStatusCodes,
+	}
+}
+
 type WebhookType string
 
 const (
@@ -43,6 +79,8 @@ const (
 	WebhookTypeRegisterApplication   WebhookType = "REGISTER_APPLICATION"
 	WebhookTypeDeleteApplication     WebhookType = "UNREGISTER_APPLICATION"
 	WebhookTypeOpenResourceDiscovery WebhookType = "OPEN_RESOURCE_DISCOVERY"
+	WebhookTypeRegisterRuntime       WebhookType = "REGISTER_RUNTIME"
+	WebhookTypeDeleteRuntime         WebhookType = "UNREGISTER_RUNTIME"
 )
 
 type WebhookMode string
@@ -75,6 +113,27 @@ func (i *WebhookInput) ToApplicationTemplateWebhook(id string, tenant *string, a
 	return webhook
 }
 
+func (i *WebhookInput) ToRuntimeWebhook(id string, tenant *string, runtimeID string) *Webhook {
+	if i == nil {
+		return nil
+	}
+
+	webhook := i.toGenericWebhook(id, tenant)
+	webhook.RuntimeID = &runtimeID
+	webhook.TenantID = tenant
+	return webhook
+}
+
+func (i *WebhookInput) ToIntegrationSystemWebhook(id string, tenant *string, integrationSystemID string) *Webhook {
+	if i == nil {
+		return nil
+	}
+
+	webhook := i.toGenericWebhook(id, tenant)
+	webhook.IntegrationSystemID = &integrationSystemID
+	return webhook
+}
+
 func (i *WebhookInput) toGenericWebhook(id string, tenant *string) *Webhook {
 	return &Webhook{
 		ID:               id,
@@ -84,6 +143,7 @@ func (i *WebhookInput) toGenericWebhook(id string, tenant *string) *Webhook {
 		Auth:             i.Auth.ToAuth(),
 		Mode:             i.Mode,
 		RetryInterval:    i.RetryInterval,
+		RetryPolicy:      i.RetryPolicy.ToRetryPolicy(),
 		Timeout:          i.Timeout,
 		URLTemplate:      i.URLTemplate,
 		InputTemplate:    i.InputTemplate,
@@ -0,0 +1,34 @@
+package model
+
+import "time"
+
+// WebhookDelivery is a single notification sent, or to be sent, by calling a webhook of an application
+type WebhookDelivery struct {
+	ID            string
+	Tenant        string
+	WebhookID     string
+	ApplicationID string
+	Status        WebhookDeliveryStatus
+	Attempts      int
+	LastError     *string
+	ResponseCode  *int
+	CreatedAt     time.Time
+	NextAttemptAt *time.Time
+	FinishedAt    *time.Time
+}
+
+type WebhookDeliveryStatus string
+
+const (
+	WebhookDeliveryStatusPending   WebhookDeliveryStatus = "PENDING"
+	WebhookDeliveryStatusSucceeded WebhookDeliveryStatus = "SUCCEEDED"
+	WebhookDeliveryStatusFailed    WebhookDeliveryStatus = "FAILED"
+)
+
+// AppConfigurationChange records that the bundles, APIs, events, their specifications or labels of an application have changed
+// and its CONFIGURATION_CHANGED webhooks have not been notified yet
+type AppConfigurationChange struct {
+	ApplicationID string
+	Tenant        string
+	ChangedAt     time.Time
+}
@@ -160,3 +160,87 @@ func TestWebhookInput_ToApplicationTemplateWebhook(t *testing.T) {
 		})
 	}
 }
+
+func TestWebhookInput_ToRuntimeWebhook(t *testing.T) {
+	// given
+	runtimeID := "foo"
+	id := "bar"
+	tenant := "baz"
+	webhookURL := "foourl"
+	testCases := []struct {
+		Name     string
+		Input    *model.WebhookInput
+		Expected *model.Webhook
+	}{
+		{
+			Name: "All properties given",
+			Input: &model.WebhookInput{
+				Type: model.WebhookTypeRegisterRuntime,
+				URL:  &webhookURL,
+			},
+			Expected: &model.Webhook{
+				RuntimeID: &runtimeID,
+				ID:        id,
+				TenantID:  str.Ptr(tenant),
+				Type:      model.WebhookTypeRegisterRuntime,
+				URL:       &webhookURL,
+			},
+		},
+		{
+			Name:     "Nil",
+			Input:    nil,
+			Expected: nil,
+		},
+	}
+
+	for i, testCase := range testCases {
+		t.Run(fmt.Sprintf("%d: %s", i, testCase.Name), func(t *testing.T) {
+			// when
+			result := testCase.Input.ToRuntimeWebhook(id, str.Ptr(tenant), runtimeID)
+
+			// then
+			assert.Equal(t, testCase.Expected, result)
+		})
+	}
+}
+
+func TestWebhookInput_ToIntegrationSystemWebhook(t *testing.T) {
+	// given
+	integrationSystemID := "foo"
+	id := "bar"
+	webhookURL := "foourl"
+	testCases := []struct {
+		Name     string
+		Input    *model.WebhookInput
+		Expected *model.Webhook
+	}{
+		{
+			Name: "All properties given",
+			Input: &model.WebhookInput{
+				Type: model.WebhookTypeConfigurationChanged,
+				URL:  &webhookURL,
+			},
+			Expected: &model.Webhook{
+				IntegrationSystemID: &integrationSystemID,
+				ID:                  id,
+				Type:                model.WebhookTypeConfigurationChanged,
+				URL:                 &webhookURL,
+			},
+		},
+		{
+			Name:     "Nil",
+			Input:    nil,
+			Expected: nil,
+		},
+	}
+
+	for i, testCase := range testCases {
+		t.Run(fmt.Sprintf("%d: %s", i, testCase.Name), func(t *testing.T) {
+			// when
+			result := testCase.Input.ToIntegrationSystemWebhook(id, nil, integrationSystemID)
+
+			// then
+			assert.Equal(t, testCase.Expected, result)
+		})
+	}
+}
@@ -16,6 +16,10 @@ import (
 	"github.com/pkg/errors"
 )
 
+// clientTypeFromTokenHeader carries the type of the object to which the one-time token was issued, so that the
+// connector can select the certificate profile of the client. It matches oathkeeper.ClientTypeFromTokenHeader of the connector.
+const clientTypeFromTokenHeader = "Client-Type-From-Token"
+
 type ValidationHydrator interface {
 	ResolveConnectorTokenHeader(w http.ResponseWriter, r *http.Request)
 }
@@ -110,6 +114,9 @@ func (vh *validationHydrator) ResolveConnectorTokenHeader(w http.ResponseWriter,
 	}
 
 	authSession.Header.Add(oathkeeper.ClientIdFromTokenHeader, systemAuth.ID)
+	if clientType, err := systemAuth.GetReferenceObjectType(); err == nil {
+		authSession.Header.Add(clientTypeFromTokenHeader, string(clientType))
+	}
 
 	if err := vh.tokenService.InvalidateToken(ctx, systemAuth); err != nil {
 		log.C(ctx).WithError(err).Error("Failed to invalidate token")
@@ -295,8 +295,10 @@ func TestValidationHydrator_ResolveConnectorTokenHeader(t *testing.T) {
 		defer mockedTx.AssertExpectations(t)
 		defer transact.AssertExpectations(t)
 		beforeOneDay := time.Now().AddDate(0, 0, -1)
+		runtimeID := "runtimeID"
 		systemAuth := &model.SystemAuth{
-			ID: clientID,
+			ID:        clientID,
+			RuntimeID: &runtimeID,
 			Value: &model.Auth{
 				OneTimeToken: &model.OneTimeToken{
 					CreatedAt: time.Now(),
@@ -322,6 +324,7 @@ func TestValidationHydrator_ResolveConnectorTokenHeader(t *testing.T) {
 		require.NoError(t, err)
 
 		assert.Equal(t, []string{clientID}, authSession.Header[connector.ClientIdFromTokenHeader])
+		assert.Equal(t, []string{string(model.RuntimeReference)}, authSession.Header[clientTypeFromTokenHeader])
 	})
 }
 
@@ -1,5 +1,18 @@
 package open_resource_discovery
 
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/pkg/errors"
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/clientcredentials"
+)
+
 type AccessStrategy struct {
 	Type              AccessStrategyType `json:"type"`
 	CustomType        AccessStrategyType `json:"customType"`
@@ -8,30 +21,184 @@ type AccessStrategy struct {
 
 type AccessStrategyType string
 
-func (a AccessStrategyType) IsSupported() bool {
-	return supportedAccessStrategies[a]
-}
-
 const (
-	OpenAccessStrategy   AccessStrategyType = "open"
-	CustomAccessStrategy AccessStrategyType = "custom"
+	OpenAccessStrategy                   AccessStrategyType = "open"
+	CMPmTLSAccessStrategy                AccessStrategyType = "sap:cmp-mtls:v1"
+	OAuthClientCredentialsAccessStrategy AccessStrategyType = "sap:oauth-client-credentials:v1"
+	BasicAuthAccessStrategy              AccessStrategyType = "sap.businesshub:basic-auth:v1"
+	CustomAccessStrategy                 AccessStrategyType = "custom"
 )
 
-var supportedAccessStrategies = map[AccessStrategyType]bool{
-	OpenAccessStrategy: true,
+type AccessStrategies []AccessStrategy
+
+// String lists the types of the access strategies, resolving the custom ones to their custom types
+func (as AccessStrategies) String() string {
+	types := make([]string, 0, len(as))
+	for _, v := range as {
+		strategyType := v.Type
+		if strategyType == CustomAccessStrategy {
+			strategyType = v.CustomType
+		}
+		types = append(types, fmt.Sprintf("%q", strategyType))
+	}
+	return "[" + strings.Join(types, ", ") + "]"
 }
 
-type AccessStrategies []AccessStrategy
+// AccessStrategyExecutor performs a request for a resource protected by a given access strategy.
+// The auth is taken from the ORD webhook of the application and may be nil.
+type AccessStrategyExecutor interface {
+	Execute(client *http.Client, req *http.Request, auth *model.Auth) (*http.Response, error)
+}
+
+// AccessStrategyExecutors maps the access strategies supported by CMP to their executors
+type AccessStrategyExecutors map[AccessStrategyType]AccessStrategyExecutor
 
-// GetSupported returns the first AccessStrategy in the slice that is supported by CMP
-func (as AccessStrategies) GetSupported() (AccessStrategyType, bool) {
+// DefaultAccessStrategyExecutors returns the executors which do not need any additional configuration
+func DefaultAccessStrategyExecutors() AccessStrategyExecutors {
+	return AccessStrategyExecutors{
+		OpenAccessStrategy:                   &openAccessStrategyExecutor{},
+		OAuthClientCredentialsAccessStrategy: newOAuthClientCredentialsAccessStrategyExecutor(),
+		BasicAuthAccessStrategy:              &basicAuthAccessStrategyExecutor{},
+	}
+}
+
+// Select returns the first AccessStrategy in the slice that is supported by CMP together with its executor
+func (e AccessStrategyExecutors) Select(as AccessStrategies) (AccessStrategyType, AccessStrategyExecutor, bool) {
 	for _, v := range as {
-		if supportedAccessStrategies[v.Type] {
-			return v.Type, true
+		strategyType := v.Type
+		if strategyType == CustomAccessStrategy {
+			strategyType = v.CustomType
 		}
-		if v.Type == CustomAccessStrategy && supportedAccessStrategies[v.CustomType] {
-			return v.CustomType, true
+		if executor, ok := e[strategyType]; ok {
+			return strategyType, executor, true
 		}
 	}
-	return "", false
+	return "", nil, false
+}
+
+type openAccessStrategyExecutor struct{}
+
+// Execute performs an unauthenticated request
+func (*openAccessStrategyExecutor) Execute(client *http.Client, req *http.Request, _ *model.Auth) (*http.Response, error) {
+	return client.Do(req)
+}
+
+type basicAuthAccessStrategyExecutor struct{}
+
+// Execute performs a request authenticated with the basic credentials of the webhook
+func (*basicAuthAccessStrategyExecutor) Execute(client *http.Client, req *http.Request, auth *model.Auth) (*http.Response, error) {
+	if auth == nil || auth.Credential.Basic == nil {
+		return nil, errors.Errorf("access strategy %q requires basic credentials", BasicAuthAccessStrategy)
+	}
+
+	req.SetBasicAuth(auth.Credential.Basic.Username, auth.Credential.Basic.Password)
+	return client.Do(req)
+}
+
+// oauthClientCredentialsAccessStrategyExecutor keeps a token source for every client of every token endpoint,
+// so that a token is requested only when the previous one of the client expires rather than for every request
+type oauthClientCredentialsAccessStrategyExecutor struct {
+	mutex        sync.Mutex
+	tokenSources map[oauthClientKey]*oauthTokenSource
+}
+
+type oauthClientKey struct {
+	clientID string
+	tokenURL string
+}
+
+type oauthTokenSource struct {
+	clientSecret string
+	tokenSource  oauth2.TokenSource
+}
+
+func newOAuthClientCredentialsAccessStrategyExecutor() *oauthClientCredentialsAccessStrategyExecutor {
+	return &oauthClientCredentialsAccessStrategyExecutor{
+		tokenSources: make(map[oauthClientKey]*oauthTokenSource),
+	}
+}
+
+// Execute performs a request authenticated with a token obtained via the OAuth2 client credentials flow
+func (e *oauthClientCredentialsAccessStrategyExecutor) Execute(client *http.Client, req *http.Request, auth *model.Auth) (*http.Response, error) {
+	if auth == nil || auth.Credential.Oauth == nil {
+		return nil, errors.Errorf("access strategy %q requires oauth credentials", OAuthClientCredentialsAccessStrategy)
+	}
+
+	oauthClient := &http.Client{
+		Transport: &oauth2.Transport{
+			Source: e.tokenSource(client, auth.Credential.Oauth),
+			Base:   client.Transport,
+		},
+		Timeout: client.Timeout,
+	}
+
+	return oauthClient.Do(req)
+}
+
+// tokenSource returns the cached token source of the client, replacing it if the client secret has changed since it was created.
+// Tokens are requested with the given http.Client outside of the context of any request, as the token source outlives it.
+func (e *oauthClientCredentialsAccessStrategyExecutor) tokenSource(client *http.Client, credentials *model.OAuthCredentialData) oauth2.TokenSource {
+	key := oauthClientKey{clientID: credentials.ClientID, tokenURL: credentials.URL}
+
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	if cached, ok := e.tokenSources[key]; ok && cached.clientSecret == credentials.ClientSecret {
+		return cached.tokenSource
+	}
+
+	cfg := clientcredentials.Config{
+		ClientID:     credentials.ClientID,
+		ClientSecret: credentials.ClientSecret,
+		TokenURL:     credentials.URL,
+	}
+	tokenSource := cfg.TokenSource(context.WithValue(context.Background(), oauth2.HTTPClient, client))
+	e.tokenSources[key] = &oauthTokenSource{clientSecret: credentials.ClientSecret, tokenSource: tokenSource}
+
+	return tokenSource
+}
+
+type mTLSAccessStrategyExecutor struct {
+	client *http.Client
+}
+
+// NewCMPmTLSAccessStrategyExecutor creates an executor which authenticates with the client certificate configured in the provided http.Client
+func NewCMPmTLSAccessStrategyExecutor(mtlsClient *http.Client) *mTLSAccessStrategyExecutor {
+	return &mTLSAccessStrategyExecutor{
+		client: mtlsClient,
+	}
+}
+
+// Execute performs a request with the CMP client certificate, ignoring the provided http.Client
+func (e *mTLSAccessStrategyExecutor) Execute(_ *http.Client, req *http.Request, _ *model.Auth) (*http.Response, error) {
+	return e.client.Do(req)
+}
+
+// newRequest creates a GET request enriched with the additional headers and query parameters of the webhook auth
+func newRequest(ctx context.Context, url string, auth *model.Auth) (*http.Request, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while creating request to %q", url)
+	}
+	if auth == nil {
+		return req, nil
+	}
+
+	for header, values := range auth.AdditionalHeaders {
+		for _, value := range values {
+			req.Header.Add(header, value)
+		}
+	}
+
+	if len(auth.AdditionalQueryParams) > 0 {
+		query := req.URL.Query()
+		for param, values := range auth.AdditionalQueryParams {
+			for _, value := range values {
+				query.Add(param, value)
+			}
+		}
+		req.URL.RawQuery = query.Encode()
+	}
+
+	return req, nil
 }
@@ -5,6 +5,7 @@ package automock
 import (
 	context "context"
 
+	model "github.com/kyma-incubator/compass/components/director/internal/model"
 	open_resource_discovery "github.com/kyma-incubator/compass/components/director/internal/open_resource_discovery"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -14,13 +15,13 @@ type Client struct {
 	mock.Mock
 }
 
-// FetchOpenResourceDiscoveryDocuments provides a mock function with given fields: ctx, url
-func (_m *Client) FetchOpenResourceDiscoveryDocuments(ctx context.Context, url string) (open_resource_discovery.Documents, error) {
-	ret := _m.Called(ctx, url)
+// FetchOpenResourceDiscoveryDocuments provides a mock function with given fields: ctx, webhook, previous
+func (_m *Client) FetchOpenResourceDiscoveryDocuments(ctx context.Context, webhook *model.Webhook, previous []model.ORDDocumentStatus) (open_resource_discovery.Documents, error) {
+	ret := _m.Called(ctx, webhook, previous)
 
 	var r0 open_resource_discovery.Documents
-	if rf, ok := ret.Get(0).(func(context.Context, string) open_resource_discovery.Documents); ok {
-		r0 = rf(ctx, url)
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Webhook, []model.ORDDocumentStatus) open_resource_discovery.Documents); ok {
+		r0 = rf(ctx, webhook, previous)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(open_resource_discovery.Documents)
@@ -28,8 +29,8 @@ func (_m *Client) FetchOpenResourceDiscoveryDocuments(ctx context.Context, url s
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, url)
+	if rf, ok := ret.Get(1).(func(context.Context, *model.Webhook, []model.ORDDocumentStatus) error); ok {
+		r1 = rf(ctx, webhook, previous)
 	} else {
 		r1 = ret.Error(1)
 	}
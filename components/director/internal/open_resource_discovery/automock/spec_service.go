@@ -35,13 +35,50 @@ func (_m *SpecService) CreateByReferenceObjectID(ctx context.Context, in model.S
 	return r0, r1
 }
 
-// DeleteByReferenceObjectID provides a mock function with given fields: ctx, objectType, objectID
-func (_m *SpecService) DeleteByReferenceObjectID(ctx context.Context, objectType model.SpecReferenceObjectType, objectID string) error {
-	ret := _m.Called(ctx, objectType, objectID)
+// Delete provides a mock function with given fields: ctx, id
+func (_m *SpecService) Delete(ctx context.Context, id string) error {
+	ret := _m.Called(ctx, id)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, model.SpecReferenceObjectType, string) error); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListByReferenceObjectID provides a mock function with given fields: ctx, objectType, objectID
+func (_m *SpecService) ListByReferenceObjectID(ctx context.Context, objectType model.SpecReferenceObjectType, objectID string) ([]*model.Spec, error) {
+	ret := _m.Called(ctx, objectType, objectID)
+
+	var r0 []*model.Spec
+	if rf, ok := ret.Get(0).(func(context.Context, model.SpecReferenceObjectType, string) []*model.Spec); ok {
 		r0 = rf(ctx, objectType, objectID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Spec)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, model.SpecReferenceObjectType, string) error); ok {
+		r1 = rf(ctx, objectType, objectID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateByReferenceObjectID provides a mock function with given fields: ctx, id, in, objectType, objectID
+func (_m *SpecService) UpdateByReferenceObjectID(ctx context.Context, id string, in model.SpecInput, objectType model.SpecReferenceObjectType, objectID string) error {
+	ret := _m.Called(ctx, id, in, objectType, objectID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, model.SpecInput, model.SpecReferenceObjectType, string) error); ok {
+		r0 = rf(ctx, id, in, objectType, objectID)
 	} else {
 		r0 = ret.Error(0)
 	}
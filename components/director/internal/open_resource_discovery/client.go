@@ -2,11 +2,17 @@ package open_resource_discovery
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
+	"fmt"
 	"io"
 	"io/ioutil"
 	"net/http"
+	"strings"
 
+	"github.com/kyma-incubator/compass/components/director/internal/domain/auth"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
 	"github.com/kyma-incubator/compass/components/director/pkg/log"
 	"github.com/pkg/errors"
 )
@@ -14,57 +20,125 @@ import (
 // Client represents ORD documents client
 //go:generate mockery -name=Client -output=automock -outpkg=automock -case=underscore
 type Client interface {
-	FetchOpenResourceDiscoveryDocuments(ctx context.Context, url string) (Documents, error)
+	FetchOpenResourceDiscoveryDocuments(ctx context.Context, webhook *model.Webhook, previous []model.ORDDocumentStatus) (Documents, error)
+}
+
+// UnsupportedAccessStrategiesError is reported for a document none of whose access strategies is supported by CMP
+type UnsupportedAccessStrategiesError struct {
+	AccessStrategies AccessStrategies
+}
+
+func (e *UnsupportedAccessStrategiesError) Error() string {
+	return fmt.Sprintf("unsupported access strategies %s", e.AccessStrategies)
+}
+
+// DocumentFetchError records why a single ORD document could not be fetched
+type DocumentFetchError struct {
+	URL string
+	Err error
+}
+
+// DocumentFetchErrors is returned together with the successfully fetched documents when some of the documents could not be fetched
+type DocumentFetchErrors []*DocumentFetchError
+
+func (e DocumentFetchErrors) Error() string {
+	msgs := make([]string, 0, len(e))
+	for _, failure := range e {
+		msgs = append(msgs, fmt.Sprintf("%s: %s", failure.URL, failure.Err))
+	}
+	return fmt.Sprintf("failed to fetch %d ORD document(s): %s", len(e), strings.Join(msgs, "; "))
 }
 
 type client struct {
 	*http.Client
+	executors AccessStrategyExecutors
 }
 
-// NewClient creates new ORD Client via a provided http.Client
-func NewClient(httpClient *http.Client) *client {
+// NewClient creates new ORD Client via a provided http.Client and the executors of the supported access strategies
+func NewClient(httpClient *http.Client, executors AccessStrategyExecutors) *client {
 	return &client{
-		Client: httpClient,
+		Client:    httpClient,
+		executors: executors,
 	}
 }
 
-// FetchOpenResourceDiscoveryDocuments fetches all the documents for a single ORD .well-known endpoint
-func (c *client) FetchOpenResourceDiscoveryDocuments(ctx context.Context, url string) (Documents, error) {
-	config, err := c.fetchConfig(ctx, url)
+// FetchOpenResourceDiscoveryDocuments fetches all the documents for a single ORD .well-known endpoint.
+// Documents which cannot be fetched are reported as DocumentFetchErrors along with the ones that were fetched successfully.
+// Documents present in previous are requested conditionally and are returned with NotModified set if the server reports them unchanged.
+func (c *client) FetchOpenResourceDiscoveryDocuments(ctx context.Context, webhook *model.Webhook, previous []model.ORDDocumentStatus) (Documents, error) {
+	if webhook.URL == nil {
+		return nil, errors.Errorf("webhook with id %q has no URL", webhook.ID)
+	}
+	url := *webhook.URL
+	ctx = auth.SaveToContext(ctx, webhook.Auth)
+
+	config, err := c.fetchConfig(ctx, url, webhook.Auth)
 	if err != nil {
 		return nil, err
 	}
 
 	docs := make([]*Document, 0, 0)
+	var failures DocumentFetchErrors
 	for _, docDetails := range config.OpenResourceDiscoveryV1.Documents {
-		strategy, ok := docDetails.AccessStrategies.GetSupported()
+		documentURL := url + docDetails.URL
+		strategy, executor, ok := c.executors.Select(docDetails.AccessStrategies)
 		if !ok {
-			log.C(ctx).Warnf("Unsupported access strategies for ORD Document %q", url+docDetails.URL)
+			log.C(ctx).Warnf("Unsupported access strategies for ORD Document %q", documentURL)
+			failures = append(failures, &DocumentFetchError{URL: documentURL, Err: &UnsupportedAccessStrategiesError{AccessStrategies: docDetails.AccessStrategies}})
 			continue
 		}
-		doc, err := c.fetchOpenDiscoveryDocumentWithAccessStrategy(ctx, url+docDetails.URL, strategy)
+		doc, err := c.fetchOpenDiscoveryDocumentWithAccessStrategy(ctx, documentURL, strategy, executor, webhook.Auth, previousDocumentStatus(previous, documentURL))
 		if err != nil {
-			return nil, errors.Wrapf(err, "error fetching ORD document from: %s", url+docDetails.URL)
+			log.C(ctx).WithError(err).Warnf("Error fetching ORD Document %q", documentURL)
+			failures = append(failures, &DocumentFetchError{URL: documentURL, Err: err})
+			continue
 		}
 
 		docs = append(docs, doc)
 	}
 
+	if len(failures) > 0 {
+		return docs, failures
+	}
 	return docs, nil
 }
 
-func (c *client) fetchOpenDiscoveryDocumentWithAccessStrategy(ctx context.Context, documentURL string, accessStrategy AccessStrategyType) (*Document, error) {
-	log.C(ctx).Infof("Fetching ORD Document %q", documentURL)
-	resp, err := c.Get(documentURL)
+func (c *client) fetchOpenDiscoveryDocumentWithAccessStrategy(ctx context.Context, documentURL string, accessStrategy AccessStrategyType, executor AccessStrategyExecutor, auth *model.Auth, previous *model.ORDDocumentStatus) (*Document, error) {
+	log.C(ctx).Infof("Fetching ORD Document %q with access strategy %q", documentURL, accessStrategy)
+	req, err := newRequest(ctx, documentURL, auth)
+	if err != nil {
+		return nil, err
+	}
+	if previous != nil {
+		if previous.ETag != "" {
+			req.Header.Set("If-None-Match", previous.ETag)
+		}
+		if previous.LastModified != "" {
+			req.Header.Set("If-Modified-Since", previous.LastModified)
+		}
+	}
+
+	resp, err := executor.Execute(c.Client, req, auth)
 	if err != nil {
 		return nil, err
 	}
+	defer closeBody(ctx, resp.Body)
+
+	if resp.StatusCode == http.StatusNotModified && previous != nil {
+		log.C(ctx).Infof("ORD Document %q has not been modified since the last fetch", documentURL)
+		return &Document{
+			URL:          documentURL,
+			Hash:         previous.Hash,
+			ETag:         previous.ETag,
+			LastModified: previous.LastModified,
+			NotModified:  true,
+		}, nil
+	}
 
 	if resp.StatusCode != http.StatusOK {
 		return nil, errors.Errorf("error while fetching open resource discovery document %q: status code %d", documentURL, resp.StatusCode)
 	}
 
-	defer closeBody(ctx, resp.Body)
 	bodyBytes, err := ioutil.ReadAll(resp.Body)
 	if err != nil {
 		return nil, errors.Wrap(err, "error reading document body")
@@ -73,9 +147,24 @@ func (c *client) fetchOpenDiscoveryDocumentWithAccessStrategy(ctx context.Contex
 	if err := json.Unmarshal(bodyBytes, &result); err != nil {
 		return nil, errors.Wrap(err, "error unmarshaling document")
 	}
+
+	hash := sha256.Sum256(bodyBytes)
+	result.URL = documentURL
+	result.Hash = hex.EncodeToString(hash[:])
+	result.ETag = resp.Header.Get("ETag")
+	result.LastModified = resp.Header.Get("Last-Modified")
 	return result, nil
 }
 
+func previousDocumentStatus(previous []model.ORDDocumentStatus, documentURL string) *model.ORDDocumentStatus {
+	for i := range previous {
+		if previous[i].URL == documentURL {
+			return &previous[i]
+		}
+	}
+	return nil
+}
+
 func closeBody(ctx context.Context, body io.ReadCloser) {
 	err := body.Close()
 	if err != nil {
@@ -83,18 +172,23 @@ func closeBody(ctx context.Context, body io.ReadCloser) {
 	}
 }
 
-func (c *client) fetchConfig(ctx context.Context, url string) (*WellKnownConfig, error) {
-	resp, err := c.Get(url + WellKnownEndpoint)
+// fetchConfig fetches the well-known configuration with the credentials, headers and query parameters of the webhook auth
+func (c *client) fetchConfig(ctx context.Context, url string, auth *model.Auth) (*WellKnownConfig, error) {
+	req, err := newRequest(ctx, url+WellKnownEndpoint, auth)
+	if err != nil {
+		return nil, errors.Wrap(err, "while creating open resource discovery well-known configuration request")
+	}
+
+	resp, err := c.configExecutor(auth).Execute(c.Client, req, auth)
 	if err != nil {
 		return nil, errors.Wrap(err, "error while fetching open resource discovery well-known configuration")
 	}
+	defer closeBody(ctx, resp.Body)
 
 	if resp.StatusCode != http.StatusOK {
 		return nil, errors.Errorf("error while fetching open resource discovery well-known configuration: status code %d", resp.StatusCode)
 	}
 
-	defer closeBody(ctx, resp.Body)
-
 	bodyBytes, err := ioutil.ReadAll(resp.Body)
 	if err != nil {
 		return nil, errors.Wrap(err, "error reading response body")
@@ -107,3 +201,22 @@ func (c *client) fetchConfig(ctx context.Context, url string) (*WellKnownConfig,
 
 	return &config, nil
 }
+
+// configExecutor returns the executor matching the credentials of the webhook auth.
+// The well-known configuration does not declare access strategies, so the configured credentials decide how to authenticate.
+func (c *client) configExecutor(auth *model.Auth) AccessStrategyExecutor {
+	strategy := OpenAccessStrategy
+	if auth != nil {
+		switch {
+		case auth.Credential.Basic != nil:
+			strategy = BasicAuthAccessStrategy
+		case auth.Credential.Oauth != nil:
+			strategy = OAuthClientCredentialsAccessStrategy
+		}
+	}
+
+	if executor, ok := c.executors[strategy]; ok {
+		return executor
+	}
+	return &openAccessStrategyExecutor{}
+}
@@ -9,7 +9,9 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/kyma-incubator/compass/components/director/internal/model"
 	"github.com/kyma-incubator/compass/components/director/internal/open_resource_discovery"
+	httputil "github.com/kyma-incubator/compass/components/director/pkg/http"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
 )
@@ -31,6 +33,8 @@ func TestClient_FetchOpenResourceDiscoveryDocuments(t *testing.T) {
 	testCases := []struct {
 		Name           string
 		RoundTripFunc  func(req *http.Request) *http.Response
+		Webhook        *model.Webhook
+		Previous       []model.ORDDocumentStatus
 		ExpectedResult open_resource_discovery.Documents
 		ExpectedErr    error
 	}{
@@ -55,7 +59,67 @@ func TestClient_FetchOpenResourceDiscoveryDocuments(t *testing.T) {
 				}
 			},
 			ExpectedResult: open_resource_discovery.Documents{
-				fixORDDocument(),
+				fixFetchedORDDocument("", ""),
+			},
+		},
+		{
+			Name: "Success records ETag and Last-Modified of the document",
+			RoundTripFunc: func(req *http.Request) *http.Response {
+				var data []byte
+				var err error
+				statusCode := http.StatusOK
+				header := http.Header{}
+				if strings.Contains(req.URL.String(), open_resource_discovery.WellKnownEndpoint) {
+					data, err = json.Marshal(fixWellKnownConfig())
+					require.NoError(t, err)
+				} else if strings.Contains(req.URL.String(), ordDocURI) {
+					header.Set("ETag", etag)
+					header.Set("Last-Modified", lastModified)
+					data, err = json.Marshal(fixORDDocument())
+					require.NoError(t, err)
+				} else {
+					statusCode = http.StatusNotFound
+				}
+				return &http.Response{
+					StatusCode: statusCode,
+					Header:     header,
+					Body:       ioutil.NopCloser(bytes.NewBuffer(data)),
+				}
+			},
+			ExpectedResult: open_resource_discovery.Documents{
+				fixFetchedORDDocument(etag, lastModified),
+			},
+		},
+		{
+			Name: "Document which was not modified since the previous fetch is returned without content",
+			RoundTripFunc: func(req *http.Request) *http.Response {
+				var data []byte
+				var err error
+				statusCode := http.StatusOK
+				if strings.Contains(req.URL.String(), open_resource_discovery.WellKnownEndpoint) {
+					data, err = json.Marshal(fixWellKnownConfig())
+					require.NoError(t, err)
+				} else if strings.Contains(req.URL.String(), ordDocURI) {
+					require.Equal(t, etag, req.Header.Get("If-None-Match"))
+					require.Equal(t, lastModified, req.Header.Get("If-Modified-Since"))
+					statusCode = http.StatusNotModified
+				} else {
+					statusCode = http.StatusNotFound
+				}
+				return &http.Response{
+					StatusCode: statusCode,
+					Body:       ioutil.NopCloser(bytes.NewBuffer(data)),
+				}
+			},
+			Previous: fixDocumentStatuses(),
+			ExpectedResult: open_resource_discovery.Documents{
+				{
+					URL:          baseURL + ordDocURI,
+					Hash:         documentHash,
+					ETag:         etag,
+					LastModified: lastModified,
+					NotModified:  true,
+				},
 			},
 		},
 		{
@@ -112,6 +176,172 @@ func TestClient_FetchOpenResourceDiscoveryDocuments(t *testing.T) {
 				}
 			},
 			ExpectedResult: open_resource_discovery.Documents{},
+			ExpectedErr:    errors.Errorf(`%s: unsupported access strategies ["test"]`, baseURL+ordDocURI),
+		},
+		{
+			Name: "Success with basic auth access strategy",
+			RoundTripFunc: func(req *http.Request) *http.Response {
+				var data []byte
+				var err error
+				statusCode := http.StatusOK
+				if strings.Contains(req.URL.String(), open_resource_discovery.WellKnownEndpoint) {
+					config := fixWellKnownConfig()
+					config.OpenResourceDiscoveryV1.Documents[0].AccessStrategies[0].Type = open_resource_discovery.BasicAuthAccessStrategy
+					data, err = json.Marshal(config)
+					require.NoError(t, err)
+				} else if strings.Contains(req.URL.String(), ordDocURI) {
+					username, password, ok := req.BasicAuth()
+					require.True(t, ok)
+					require.Equal(t, "user", username)
+					require.Equal(t, "pass", password)
+					require.Equal(t, "value", req.Header.Get("X-Custom"))
+					data, err = json.Marshal(fixORDDocument())
+					require.NoError(t, err)
+				} else {
+					statusCode = http.StatusNotFound
+				}
+				return &http.Response{
+					StatusCode: statusCode,
+					Body:       ioutil.NopCloser(bytes.NewBuffer(data)),
+				}
+			},
+			Webhook: fixWebhookWithAuth(&model.Auth{
+				Credential: model.CredentialData{
+					Basic: &model.BasicCredentialData{Username: "user", Password: "pass"},
+				},
+				AdditionalHeaders: map[string][]string{"X-Custom": {"value"}},
+			}),
+			ExpectedResult: open_resource_discovery.Documents{fixFetchedORDDocument("", "")},
+		},
+		{
+			Name: "Well-known configuration is fetched with the webhook auth",
+			RoundTripFunc: func(req *http.Request) *http.Response {
+				var data []byte
+				var err error
+				statusCode := http.StatusOK
+				if strings.Contains(req.URL.String(), open_resource_discovery.WellKnownEndpoint) {
+					username, password, ok := req.BasicAuth()
+					require.True(t, ok)
+					require.Equal(t, "user", username)
+					require.Equal(t, "pass", password)
+					require.Equal(t, "value", req.Header.Get("X-Custom"))
+					require.Equal(t, "value", req.URL.Query().Get("param"))
+					data, err = json.Marshal(fixWellKnownConfig())
+					require.NoError(t, err)
+				} else if strings.Contains(req.URL.String(), ordDocURI) {
+					data, err = json.Marshal(fixORDDocument())
+					require.NoError(t, err)
+				} else {
+					statusCode = http.StatusNotFound
+				}
+				return &http.Response{
+					StatusCode: statusCode,
+					Body:       ioutil.NopCloser(bytes.NewBuffer(data)),
+				}
+			},
+			Webhook: fixWebhookWithAuth(&model.Auth{
+				Credential: model.CredentialData{
+					Basic: &model.BasicCredentialData{Username: "user", Password: "pass"},
+				},
+				AdditionalHeaders:     map[string][]string{"X-Custom": {"value"}},
+				AdditionalQueryParams: map[string][]string{"param": {"value"}},
+			}),
+			ExpectedResult: open_resource_discovery.Documents{fixFetchedORDDocument("", "")},
+		},
+		{
+			Name: "Success with client certificate and request signing",
+			RoundTripFunc: func(req *http.Request) *http.Response {
+				certificate, ok := httputil.LoadClientCertificateFromContext(req.Context())
+				require.True(t, ok)
+				require.Equal(t, httputil.ClientCertificate{Certificate: "certificate", PrivateKey: "key"}, certificate)
+				signing, ok := httputil.LoadRequestSigningFromContext(req.Context())
+				require.True(t, ok)
+				require.Equal(t, []string{"secret"}, signing.Secrets)
+
+				var data []byte
+				var err error
+				statusCode := http.StatusOK
+				if strings.Contains(req.URL.String(), open_resource_discovery.WellKnownEndpoint) {
+					data, err = json.Marshal(fixWellKnownConfig())
+					require.NoError(t, err)
+				} else if strings.Contains(req.URL.String(), ordDocURI) {
+					data, err = json.Marshal(fixORDDocument())
+					require.NoError(t, err)
+				} else {
+					statusCode = http.StatusNotFound
+				}
+				return &http.Response{
+					StatusCode: statusCode,
+					Body:       ioutil.NopCloser(bytes.NewBuffer(data)),
+				}
+			},
+			Webhook: fixWebhookWithAuth(&model.Auth{
+				Credential: model.CredentialData{
+					Certificate: &model.CertificateCredentialData{Certificate: "certificate", PrivateKey: "key"},
+				},
+				Signing: &model.RequestSigning{Secrets: []string{"secret"}},
+			}),
+			ExpectedResult: open_resource_discovery.Documents{fixFetchedORDDocument("", "")},
+		},
+		{
+			Name: "Success with oauth client credentials access strategy",
+			RoundTripFunc: func(req *http.Request) *http.Response {
+				var data []byte
+				var err error
+				statusCode := http.StatusOK
+				header := http.Header{}
+				if strings.Contains(req.URL.String(), open_resource_discovery.WellKnownEndpoint) {
+					config := fixWellKnownConfig()
+					config.OpenResourceDiscoveryV1.Documents[0].AccessStrategies[0].Type = open_resource_discovery.CustomAccessStrategy
+					config.OpenResourceDiscoveryV1.Documents[0].AccessStrategies[0].CustomType = open_resource_discovery.OAuthClientCredentialsAccessStrategy
+					data, err = json.Marshal(config)
+					require.NoError(t, err)
+				} else if strings.Contains(req.URL.String(), tokenURL) {
+					header.Set("Content-Type", "application/json")
+					data = []byte(`{"access_token":"token","token_type":"bearer"}`)
+				} else if strings.Contains(req.URL.String(), ordDocURI) {
+					require.Equal(t, "Bearer token", req.Header.Get("Authorization"))
+					data, err = json.Marshal(fixORDDocument())
+					require.NoError(t, err)
+				} else {
+					statusCode = http.StatusNotFound
+				}
+				return &http.Response{
+					StatusCode: statusCode,
+					Header:     header,
+					Body:       ioutil.NopCloser(bytes.NewBuffer(data)),
+				}
+			},
+			Webhook: fixWebhookWithAuth(&model.Auth{
+				Credential: model.CredentialData{
+					Oauth: &model.OAuthCredentialData{ClientID: "id", ClientSecret: "secret", URL: tokenURL},
+				},
+			}),
+			ExpectedResult: open_resource_discovery.Documents{fixFetchedORDDocument("", "")},
+		},
+		{
+			Name: "Error when basic auth access strategy is used without credentials",
+			RoundTripFunc: func(req *http.Request) *http.Response {
+				var data []byte
+				var err error
+				statusCode := http.StatusOK
+				if strings.Contains(req.URL.String(), open_resource_discovery.WellKnownEndpoint) {
+					config := fixWellKnownConfig()
+					config.OpenResourceDiscoveryV1.Documents[0].AccessStrategies[0].Type = open_resource_discovery.BasicAuthAccessStrategy
+					data, err = json.Marshal(config)
+					require.NoError(t, err)
+				} else if strings.Contains(req.URL.String(), ordDocURI) {
+					require.FailNow(t, "document should not be fetched without credentials")
+				} else {
+					statusCode = http.StatusNotFound
+				}
+				return &http.Response{
+					StatusCode: statusCode,
+					Body:       ioutil.NopCloser(bytes.NewBuffer(data)),
+				}
+			},
+			ExpectedResult: open_resource_discovery.Documents{},
+			ExpectedErr:    errors.Errorf("access strategy %q requires basic credentials", open_resource_discovery.BasicAuthAccessStrategy),
 		},
 		{
 			Name: "Error fetching document",
@@ -163,12 +393,18 @@ func TestClient_FetchOpenResourceDiscoveryDocuments(t *testing.T) {
 		t.Run(test.Name, func(t *testing.T) {
 			testHttpClient := NewTestClient(test.RoundTripFunc)
 
-			client := open_resource_discovery.NewClient(testHttpClient)
-			docs, err := client.FetchOpenResourceDiscoveryDocuments(context.TODO(), baseURL)
+			webhook := test.Webhook
+			if webhook == nil {
+				webhook = fixWebhooks()[0]
+			}
+
+			client := open_resource_discovery.NewClient(testHttpClient, open_resource_discovery.DefaultAccessStrategyExecutors())
+			docs, err := client.FetchOpenResourceDiscoveryDocuments(context.TODO(), webhook, test.Previous)
 
 			if test.ExpectedErr != nil {
 				require.Error(t, err)
 				require.Contains(t, err.Error(), test.ExpectedErr.Error())
+				require.Equal(t, test.ExpectedResult, docs)
 			} else {
 				require.NoError(t, err)
 				require.Len(t, docs, len(test.ExpectedResult))
@@ -177,3 +413,48 @@ func TestClient_FetchOpenResourceDiscoveryDocuments(t *testing.T) {
 		})
 	}
 }
+
+func TestClient_FetchOpenResourceDiscoveryDocumentsReusesOAuthToken(t *testing.T) {
+	// GIVEN
+	tokenRequests := 0
+	testHttpClient := NewTestClient(func(req *http.Request) *http.Response {
+		var data []byte
+		var err error
+		header := http.Header{}
+		if strings.Contains(req.URL.String(), open_resource_discovery.WellKnownEndpoint) {
+			config := fixWellKnownConfig()
+			config.OpenResourceDiscoveryV1.Documents[0].AccessStrategies[0].Type = open_resource_discovery.OAuthClientCredentialsAccessStrategy
+			data, err = json.Marshal(config)
+			require.NoError(t, err)
+		} else if strings.Contains(req.URL.String(), tokenURL) {
+			tokenRequests++
+			header.Set("Content-Type", "application/json")
+			data = []byte(`{"access_token":"token","token_type":"bearer","expires_in":3600}`)
+		} else {
+			require.Equal(t, "Bearer token", req.Header.Get("Authorization"))
+			data, err = json.Marshal(fixORDDocument())
+			require.NoError(t, err)
+		}
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     header,
+			Body:       ioutil.NopCloser(bytes.NewBuffer(data)),
+		}
+	})
+	webhook := fixWebhookWithAuth(&model.Auth{
+		Credential: model.CredentialData{
+			Oauth: &model.OAuthCredentialData{ClientID: "id", ClientSecret: "secret", URL: tokenURL},
+		},
+	})
+
+	client := open_resource_discovery.NewClient(testHttpClient, open_resource_discovery.DefaultAccessStrategyExecutors())
+
+	// WHEN
+	for i := 0; i < 3; i++ {
+		_, err := client.FetchOpenResourceDiscoveryDocuments(context.TODO(), webhook, nil)
+		require.NoError(t, err)
+	}
+
+	// THEN
+	require.Equal(t, 1, tokenRequests)
+}
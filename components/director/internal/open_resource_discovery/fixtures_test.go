@@ -1,9 +1,12 @@
 package open_resource_discovery_test
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/kyma-incubator/compass/components/director/internal/model"
 	"github.com/kyma-incubator/compass/components/director/internal/open_resource_discovery"
@@ -14,6 +17,10 @@ import (
 const (
 	ordDocURI     = "/open-resource-discovery/v1/documents/example1"
 	baseURL       = "http://localhost:8080"
+	tokenURL      = "http://localhost:8081/oauth/token"
+	etag          = "W/\"etag\""
+	lastModified  = "Wed, 24 Mar 2021 10:00:00 GMT"
+	documentHash  = "hash"
 	packageORDID  = "ns:package:PACKAGE_ID:v1"
 	productORDID  = "ns:PRODUCT_ID"
 	product2ORDID = "ns:PRODUCT_ID2"
@@ -33,6 +40,8 @@ const (
 	api2ID    = "testApi2"
 	event1ID  = "testEvent1"
 	event2ID  = "testEvent2"
+	specID    = "testSpec1"
+	spec2ID   = "testSpec2"
 
 	cursor      = "cursor"
 	policyLevel = "sap"
@@ -148,6 +157,69 @@ func fixORDDocument() *open_resource_discovery.Document {
 	return fixORDDocumentWithBaseURL("")
 }
 
+// fixFetchedORDDocument returns the document as returned by the client, i.e. with the metadata of the fetch
+func fixFetchedORDDocument(etag, lastModified string) *open_resource_discovery.Document {
+	doc := fixORDDocument()
+	data, err := json.Marshal(doc)
+	if err != nil {
+		panic(err)
+	}
+	hash := sha256.Sum256(data)
+
+	doc.URL = baseURL + ordDocURI
+	doc.Hash = hex.EncodeToString(hash[:])
+	doc.ETag = etag
+	doc.LastModified = lastModified
+	return doc
+}
+
+func fixDocumentStatuses() []model.ORDDocumentStatus {
+	return []model.ORDDocumentStatus{
+		{
+			URL:          baseURL + ordDocURI,
+			Hash:         documentHash,
+			ETag:         etag,
+			LastModified: lastModified,
+		},
+	}
+}
+
+// fixResourceHashes returns the hashes of the resources of the document as recorded in the sync status of the application
+func fixResourceHashes(doc *open_resource_discovery.Document) map[string]string {
+	hashes := make(map[string]string)
+	track := func(kind, ordID string, resource interface{}) {
+		data, err := json.Marshal(resource)
+		if err != nil {
+			panic(err)
+		}
+		hash := sha256.Sum256(data)
+		hashes[kind+":"+ordID] = hex.EncodeToString(hash[:])
+	}
+
+	for _, vendor := range doc.Vendors {
+		track("vendor", vendor.OrdID, vendor)
+	}
+	for _, product := range doc.Products {
+		track("product", product.OrdID, product)
+	}
+	for _, pkg := range doc.Packages {
+		track("package", pkg.OrdID, pkg)
+	}
+	for _, bndl := range doc.ConsumptionBundles {
+		track("bundle", *bndl.OrdID, bndl)
+	}
+	for _, api := range doc.APIResources {
+		track("api", *api.OrdID, api)
+	}
+	for _, event := range doc.EventResources {
+		track("event", *event.OrdID, event)
+	}
+	for _, tombstone := range doc.Tombstones {
+		track("tombstone", tombstone.OrdID, tombstone)
+	}
+	return hashes
+}
+
 func fixSanitizedORDDocument() *open_resource_discovery.Document {
 	sanitizedDoc := fixORDDocumentWithBaseURL(baseURL)
 
@@ -449,6 +521,22 @@ func fixWebhooks() []*model.Webhook {
 	}
 }
 
+func fixSyncConfig() open_resource_discovery.SyncConfig {
+	return open_resource_discovery.SyncConfig{
+		Workers:        1,
+		PollInterval:   time.Hour,
+		ResyncInterval: time.Hour,
+		MinBackoff:     time.Minute,
+		MaxBackoff:     time.Hour,
+	}
+}
+
+func fixWebhookWithAuth(auth *model.Auth) *model.Webhook {
+	webhook := fixWebhooks()[0]
+	webhook.Auth = auth
+	return webhook
+}
+
 func fixVendors() []*model.Vendor {
 	return []*model.Vendor{
 		{
@@ -678,6 +766,28 @@ func fixApi1SpecInputs() []*model.SpecInput {
 	}
 }
 
+func fixApi1Specs() []*model.Spec {
+	apiType := model.APISpecTypeOpenAPIV3
+	return []*model.Spec{
+		{
+			ID:         specID,
+			Tenant:     tenantID,
+			ObjectType: model.APISpecReference,
+			ObjectID:   api1ID,
+			Format:     "application/json",
+			APIType:    &apiType,
+		},
+		{
+			ID:         spec2ID,
+			Tenant:     tenantID,
+			ObjectType: model.APISpecReference,
+			ObjectID:   api1ID,
+			Format:     "application/xml",
+			APIType:    &apiType,
+		},
+	}
+}
+
 func fixApi2SpecInputs() []*model.SpecInput {
 	apiType := model.APISpecTypeEDMX
 	return []*model.SpecInput{
@@ -2,6 +2,7 @@ package open_resource_discovery
 
 import (
 	"context"
+	"time"
 
 	"github.com/kyma-incubator/compass/components/director/internal/model"
 )
@@ -42,8 +43,10 @@ type EventService interface {
 
 //go:generate mockery -name=SpecService -output=automock -outpkg=automock -case=underscore
 type SpecService interface {
+	ListByReferenceObjectID(ctx context.Context, objectType model.SpecReferenceObjectType, objectID string) ([]*model.Spec, error)
 	CreateByReferenceObjectID(ctx context.Context, in model.SpecInput, objectType model.SpecReferenceObjectType, objectID string) (string, error)
-	DeleteByReferenceObjectID(ctx context.Context, objectType model.SpecReferenceObjectType, objectID string) error
+	UpdateByReferenceObjectID(ctx context.Context, id string, in model.SpecInput, objectType model.SpecReferenceObjectType, objectID string) error
+	Delete(ctx context.Context, id string) error
 }
 
 //go:generate mockery -name=PackageService -output=automock -outpkg=automock -case=underscore
@@ -77,3 +80,20 @@ type TombstoneService interface {
 	Delete(ctx context.Context, id string) error
 	ListByApplicationID(ctx context.Context, appID string) ([]*model.Tombstone, error)
 }
+
+//go:generate mockery -name=SyncStatusService -output=automock -outpkg=automock -case=underscore
+type SyncStatusService interface {
+	Upsert(ctx context.Context, in *model.ORDSyncStatus) error
+	GetByApplicationID(ctx context.Context, appID string) (*model.ORDSyncStatus, error)
+	ListGlobal(ctx context.Context) ([]*model.ORDSyncStatus, error)
+}
+
+//go:generate mockery -name=LabelRepository -output=automock -outpkg=automock -case=underscore
+type LabelRepository interface {
+	GetByKey(ctx context.Context, tenant string, objectType model.LabelableObject, objectID, key string) (*model.Label, error)
+}
+
+//go:generate mockery -name=LeaseRepository -output=automock -outpkg=automock -case=underscore
+type LeaseRepository interface {
+	TryAcquireGlobal(ctx context.Context, name, holder string, now, until time.Time) (bool, error)
+}
@@ -1,6 +1,8 @@
 package open_resource_discovery
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"net/url"
 
@@ -50,10 +52,76 @@ type Document struct {
 	EventResources     []*model.EventDefinitionInput `json:"eventResources"`
 	Tombstones         []*model.TombstoneInput       `json:"tombstones"`
 	Vendors            []*model.VendorInput          `json:"vendors"`
+
+	// URL, Hash, ETag and LastModified describe the fetched document and are used for the conditional fetches of the next resync.
+	// NotModified is set when the server reported that the document did not change since the previous fetch, in which case the document has no content.
+	URL          string `json:"-"`
+	Hash         string `json:"-"`
+	ETag         string `json:"-"`
+	LastModified string `json:"-"`
+	NotModified  bool   `json:"-"`
 }
 
 type Documents []*Document
 
+// Statuses returns the fetch metadata of the documents in the order of the documents
+func (docs Documents) Statuses() []model.ORDDocumentStatus {
+	statuses := make([]model.ORDDocumentStatus, 0, len(docs))
+	for _, doc := range docs {
+		statuses = append(statuses, model.ORDDocumentStatus{
+			URL:          doc.URL,
+			Hash:         doc.Hash,
+			ETag:         doc.ETag,
+			LastModified: doc.LastModified,
+		})
+	}
+	return statuses
+}
+
+// Unchanged reports whether all the documents are the same as the previously fetched ones, either because the server
+// reported them as not modified or because their content hash did not change
+func (docs Documents) Unchanged(previous []model.ORDDocumentStatus) bool {
+	synced := 0
+	for _, prev := range previous {
+		if prev.SkipReason == "" {
+			synced++
+		}
+	}
+	if len(docs) != synced {
+		return false
+	}
+	for _, doc := range docs {
+		if doc.NotModified {
+			continue
+		}
+		prev := previousDocumentStatus(previous, doc.URL)
+		if prev == nil || prev.Hash != doc.Hash {
+			return false
+		}
+	}
+	return true
+}
+
+// AnyNotModified reports whether any of the documents was reported as not modified, in which case it has no content
+func (docs Documents) AnyNotModified() bool {
+	for _, doc := range docs {
+		if doc.NotModified {
+			return true
+		}
+	}
+	return false
+}
+
+// hashResource returns the SHA-256 hash of the JSON representation of an ORD resource
+func hashResource(resource interface{}) (string, error) {
+	marshalled, err := json.Marshal(resource)
+	if err != nil {
+		return "", errors.Wrap(err, "while marshalling ORD resource")
+	}
+	sum := sha256.Sum256(marshalled)
+	return hex.EncodeToString(sum[:]), nil
+}
+
 // Validate validates all the documents for a system instance
 func (docs Documents) Validate(webhookURL string) error {
 	// TODO: Revisit after DescribedSystemInstance vs. ProviderSystemInstance is aligned. Currently we rely on that described system instance is identical with the provider system instance. See TODO above.
@@ -0,0 +1,40 @@
+package open_resource_discovery
+
+import "fmt"
+
+const (
+	resourceKindVendor    = "vendor"
+	resourceKindProduct   = "product"
+	resourceKindPackage   = "package"
+	resourceKindBundle    = "bundle"
+	resourceKindAPI       = "api"
+	resourceKindEvent     = "event"
+	resourceKindTombstone = "tombstone"
+)
+
+// resourceHashes keeps track of the hashes of the ORD resources from the previous and the current sync of an application
+type resourceHashes struct {
+	previous map[string]string
+	current  map[string]string
+}
+
+func newResourceHashes(previous map[string]string) *resourceHashes {
+	return &resourceHashes{
+		previous: previous,
+		current:  make(map[string]string),
+	}
+}
+
+// track records the hash of the resource and reports whether it differs from the one recorded during the previous sync
+func (h *resourceHashes) track(kind, ordID string, resource interface{}) (bool, error) {
+	hash, err := hashResource(resource)
+	if err != nil {
+		return false, err
+	}
+
+	key := fmt.Sprintf("%s:%s", kind, ordID)
+	h.current[key] = hash
+
+	previous, ok := h.previous[key]
+	return !ok || previous != hash, nil
+}
@@ -2,15 +2,23 @@ package open_resource_discovery
 
 import (
 	"context"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/go-multierror"
 
 	"github.com/kyma-incubator/compass/components/director/internal/domain/tenant"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
 	"github.com/kyma-incubator/compass/components/director/pkg/log"
 	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+	"github.com/kyma-incubator/compass/components/director/pkg/str"
 	"github.com/pkg/errors"
 )
 
 type Service struct {
+	cfg      SyncConfig
 	transact persistence.Transactioner
 
 	appSvc       ApplicationService
@@ -24,49 +32,84 @@ type Service struct {
 	vendorSvc    VendorService
 	tombstoneSvc TombstoneService
 
+	syncStatusSvc SyncStatusService
+	labelRepo     LabelRepository
+	leaseRepo     LeaseRepository
+	holder        string
+
 	ordClient Client
 }
 
-func NewAggregatorService(transact persistence.Transactioner, appSvc ApplicationService, webhookSvc WebhookService, bundleSvc BundleService, apiSvc APIService, eventSvc EventService, specSvc SpecService, packageSvc PackageService, productSvc ProductService, vendorSvc VendorService, tombstoneSvc TombstoneService, client Client) *Service {
+func NewAggregatorService(cfg SyncConfig, transact persistence.Transactioner, appSvc ApplicationService, webhookSvc WebhookService, bundleSvc BundleService, apiSvc APIService, eventSvc EventService, specSvc SpecService, packageSvc PackageService, productSvc ProductService, vendorSvc VendorService, tombstoneSvc TombstoneService, syncStatusSvc SyncStatusService, labelRepo LabelRepository, leaseRepo LeaseRepository, client Client) *Service {
 	return &Service{
-		transact:     transact,
-		appSvc:       appSvc,
-		webhookSvc:   webhookSvc,
-		bundleSvc:    bundleSvc,
-		apiSvc:       apiSvc,
-		eventSvc:     eventSvc,
-		specSvc:      specSvc,
-		packageSvc:   packageSvc,
-		productSvc:   productSvc,
-		vendorSvc:    vendorSvc,
-		tombstoneSvc: tombstoneSvc,
-		ordClient:    client,
+		cfg:           cfg,
+		transact:      transact,
+		appSvc:        appSvc,
+		webhookSvc:    webhookSvc,
+		bundleSvc:     bundleSvc,
+		apiSvc:        apiSvc,
+		eventSvc:      eventSvc,
+		specSvc:       specSvc,
+		packageSvc:    packageSvc,
+		productSvc:    productSvc,
+		vendorSvc:     vendorSvc,
+		tombstoneSvc:  tombstoneSvc,
+		syncStatusSvc: syncStatusSvc,
+		labelRepo:     labelRepo,
+		leaseRepo:     leaseRepo,
+		holder:        uuid.New().String(),
+		ordClient:     client,
 	}
 }
 
-// SyncORDDocuments performs resync of ORD information provided via ORD documents for each application
+// SyncORDDocuments performs a single resync of ORD information provided via ORD documents for each application.
+// Applications are processed concurrently and a failing application does not prevent the others from being synchronized;
+// the errors of all failing applications are returned aggregated once every application has been processed.
 func (s *Service) SyncORDDocuments(ctx context.Context) error {
+	apps, err := s.listApps(ctx)
+	if err != nil {
+		return err
+	}
+
+	var mu sync.Mutex
+	var errs *multierror.Error
+	jobs := make(chan *model.Application)
+	wg := s.startWorkers(ctx, jobs, func(_ *model.Application, _ time.Time, err error) {
+		if err == nil {
+			return
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		errs = multierror.Append(errs, err)
+	})
+	for _, app := range apps {
+		jobs <- app
+	}
+	close(jobs)
+	wg.Wait()
+
+	return errs.ErrorOrNil()
+}
+
+func (s *Service) listApps(ctx context.Context) ([]*model.Application, error) {
 	pageCount := 1
 	pageSize := 200
 
 	pageCursor := ""
 	hasNextPage := true
 
+	var apps []*model.Application
 	for hasNextPage {
 		page, err := s.listAppPage(ctx, pageSize, pageCursor)
 		if err != nil {
-			return errors.Wrapf(err, "error while fetching application page number %d", pageCount)
-		}
-		for _, app := range page.Data {
-			if err := s.processApp(ctx, app); err != nil {
-				return errors.Wrapf(err, "error while processing app %q", app.ID)
-			}
+			return nil, errors.Wrapf(err, "error while fetching application page number %d", pageCount)
 		}
+		apps = append(apps, page.Data...)
 		pageCursor = page.PageInfo.EndCursor
 		hasNextPage = page.PageInfo.HasNextPage
 		pageCount++
 	}
-	return nil
+	return apps, nil
 }
 
 func (s *Service) listAppPage(ctx context.Context, pageSize int, cursor string) (*model.ApplicationPage, error) {
@@ -83,10 +126,20 @@ func (s *Service) listAppPage(ctx context.Context, pageSize int, cursor string)
 	return page, tx.Commit()
 }
 
-func (s *Service) processApp(ctx context.Context, app *model.Application) error {
+// syncResult describes the state of an application after its ORD documents were processed. It is persisted in the
+// sync status of the application so that the next resync can skip unchanged documents and resources.
+type syncResult struct {
+	documents      []model.ORDDocumentStatus
+	resourceHashes map[string]string
+}
+
+// processApp fetches and processes the ORD documents of the application.
+// Documents and resources which did not change since the previous successful sync are not processed again.
+// It returns errMissingORDWebhook if the application does not expose any ORD documents.
+func (s *Service) processApp(ctx context.Context, app *model.Application) (*syncResult, error) {
 	tx, err := s.transact.Begin()
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	defer s.transact.RollbackUnlessCommitted(ctx, tx)
@@ -97,42 +150,112 @@ func (s *Service) processApp(ctx context.Context, app *model.Application) error
 
 	webhooks, err := s.webhookSvc.ListForApplication(ctx, app.ID)
 	if err != nil {
-		return errors.Wrapf(err, "error fetching webhooks for app with id %q", app.ID)
+		return nil, errors.Wrapf(err, "error fetching webhooks for app with id %q", app.ID)
 	}
-	var documents Documents
-	var baseURL string
+
+	var ordWebhook *model.Webhook
 	for _, wh := range webhooks {
 		if wh.Type == model.WebhookTypeOpenResourceDiscovery && wh.URL != nil {
-			ctx = addFieldToLogger(ctx, "app_id", app.ID)
-			documents, err = s.ordClient.FetchOpenResourceDiscoveryDocuments(ctx, *wh.URL)
-			if err != nil {
-				log.C(ctx).WithError(err).Errorf("error fetching ORD document for webhook with id %q", wh.ID)
-			}
-			baseURL = *wh.URL
+			ordWebhook = wh
 			break
 		}
 	}
-	if len(documents) > 0 {
-		log.C(ctx).Info("Processing ORD documents")
-		if err := s.processDocuments(ctx, app.ID, baseURL, documents); err != nil {
-			log.C(ctx).WithError(err).Error("error processing ORD documents")
-		} else {
-			log.C(ctx).Info("Successfully processed ORD documents")
-			return tx.Commit()
+	if ordWebhook == nil {
+		return nil, errMissingORDWebhook
+	}
+
+	previous, err := s.syncStatusSvc.GetByApplicationID(ctx, app.ID)
+	if err != nil && !apperrors.IsNotFoundError(err) {
+		return nil, errors.Wrapf(err, "error while getting ORD sync status for app with id %q", app.ID)
+	}
+	var previousDocuments []model.ORDDocumentStatus
+	var previousHashes map[string]string
+	if previous != nil {
+		previousDocuments = previous.Documents
+		previousHashes = previous.ResourceHashes
+	}
+
+	ctx = addFieldToLogger(ctx, "app_id", app.ID)
+	documents, skipped, err := s.fetchDocuments(ctx, ordWebhook, previousDocuments)
+	if err != nil {
+		return nil, err
+	}
+
+	if documents.AnyNotModified() && !documents.Unchanged(previousDocuments) {
+		// resources may reference each other across documents, so all of them are needed once one of them changes,
+		// or once the set of documents differs from the previous sync
+		log.C(ctx).Info("Some of the ORD documents were modified, fetching all of them")
+		if documents, skipped, err = s.fetchDocuments(ctx, ordWebhook, nil); err != nil {
+			return nil, err
 		}
 	}
-	return nil
+
+	result := &syncResult{
+		documents:      append(documents.Statuses(), skipped...),
+		resourceHashes: previousHashes,
+	}
+
+	if len(documents) == 0 {
+		return result, nil
+	}
+
+	if documents.Unchanged(previousDocuments) {
+		log.C(ctx).Info("ORD documents have not changed since the last sync")
+		return result, tx.Commit()
+	}
+
+	log.C(ctx).Info("Processing ORD documents")
+	if result.resourceHashes, err = s.processDocuments(ctx, app.ID, *ordWebhook.URL, documents, previousHashes); err != nil {
+		return nil, errors.Wrap(err, "error processing ORD documents")
+	}
+	log.C(ctx).Info("Successfully processed ORD documents")
+
+	return result, tx.Commit()
 }
 
-func (s *Service) processDocuments(ctx context.Context, appID string, baseURL string, documents Documents) error {
+// fetchDocuments fetches the ORD documents of the webhook. Documents none of whose access strategies is supported are
+// skipped and returned as statuses recording the reason, while any other fetch failure fails the whole fetch.
+func (s *Service) fetchDocuments(ctx context.Context, ordWebhook *model.Webhook, previous []model.ORDDocumentStatus) (Documents, []model.ORDDocumentStatus, error) {
+	documents, err := s.ordClient.FetchOpenResourceDiscoveryDocuments(ctx, ordWebhook, previous)
+	if err == nil {
+		return documents, nil, nil
+	}
+
+	failures, ok := err.(DocumentFetchErrors)
+	if !ok {
+		return nil, nil, errors.Wrapf(err, "error fetching ORD documents for webhook with id %q", ordWebhook.ID)
+	}
+
+	skipped := make([]model.ORDDocumentStatus, 0, len(failures))
+	for _, failure := range failures {
+		if _, unsupported := failure.Err.(*UnsupportedAccessStrategiesError); !unsupported {
+			// a partial set of documents cannot be processed safely as it may reference resources from the missing ones
+			for _, failure := range failures {
+				log.C(ctx).WithError(failure.Err).Errorf("error fetching ORD document %q for webhook with id %q", failure.URL, ordWebhook.ID)
+			}
+			return nil, nil, errors.Wrapf(err, "error fetching ORD documents for webhook with id %q", ordWebhook.ID)
+		}
+
+		log.C(ctx).Warnf("Skipping ORD document %q for webhook with id %q: %v", failure.URL, ordWebhook.ID, failure.Err)
+		skipped = append(skipped, model.ORDDocumentStatus{URL: failure.URL, SkipReason: failure.Err.Error()})
+	}
+
+	return documents, skipped, nil
+}
+
+// processDocuments resyncs the resources from the documents and returns the hashes of all of them.
+// Resources which already exist and whose hash did not change since the previous sync are not updated.
+func (s *Service) processDocuments(ctx context.Context, appID string, baseURL string, documents Documents, previousHashes map[string]string) (map[string]string, error) {
 	if err := documents.Validate(baseURL); err != nil {
-		return errors.Wrap(err, "invalid documents")
+		return nil, errors.Wrap(err, "invalid documents")
 	}
 
 	if err := documents.Sanitize(baseURL); err != nil {
-		return errors.Wrap(err, "while sanitizing ORD documents")
+		return nil, errors.Wrap(err, "while sanitizing ORD documents")
 	}
 
+	hashes := newResourceHashes(previousHashes)
+
 	vendorsInput := make([]*model.VendorInput, 0, 0)
 	productsInput := make([]*model.ProductInput, 0, 0)
 	packagesInput := make([]*model.PackageInput, 0, 0)
@@ -150,39 +273,39 @@ func (s *Service) processDocuments(ctx context.Context, appID string, baseURL st
 		tombstonesInput = append(tombstonesInput, doc.Tombstones...)
 	}
 
-	vendorsFromDB, err := s.processVendors(ctx, appID, vendorsInput)
+	vendorsFromDB, err := s.processVendors(ctx, appID, vendorsInput, hashes)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	productsFromDB, err := s.processProducts(ctx, appID, productsInput)
+	productsFromDB, err := s.processProducts(ctx, appID, productsInput, hashes)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	packagesFromDB, err := s.processPackages(ctx, appID, packagesInput)
+	packagesFromDB, err := s.processPackages(ctx, appID, packagesInput, hashes)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	bundlesFromDB, err := s.processBundles(ctx, appID, bundlesInput)
+	bundlesFromDB, err := s.processBundles(ctx, appID, bundlesInput, hashes)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	apisFromDB, err := s.processAPIs(ctx, appID, bundlesFromDB, packagesFromDB, apisInput)
+	apisFromDB, err := s.processAPIs(ctx, appID, bundlesFromDB, packagesFromDB, apisInput, hashes)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	eventsFromDB, err := s.processEvents(ctx, appID, bundlesFromDB, packagesFromDB, eventsInput)
+	eventsFromDB, err := s.processEvents(ctx, appID, bundlesFromDB, packagesFromDB, eventsInput, hashes)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	tombstonesFromDB, err := s.processTombstones(ctx, appID, tombstonesInput)
+	tombstonesFromDB, err := s.processTombstones(ctx, appID, tombstonesInput, hashes)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	for _, ts := range tombstonesFromDB {
@@ -190,57 +313,61 @@ func (s *Service) processDocuments(ctx context.Context, appID string, baseURL st
 			return packagesFromDB[i].OrdID == ts.OrdID
 		}); found {
 			if err := s.packageSvc.Delete(ctx, packagesFromDB[i].ID); err != nil {
-				return errors.Wrapf(err, "error while deleting resource with ORD ID %q based on its tombstone", ts.OrdID)
+				return nil, errors.Wrapf(err, "error while deleting resource with ORD ID %q based on its tombstone", ts.OrdID)
 			}
 		}
 		if i, found := searchInSlice(len(apisFromDB), func(i int) bool {
 			return equalStrings(apisFromDB[i].OrdID, &ts.OrdID)
 		}); found {
 			if err := s.apiSvc.Delete(ctx, apisFromDB[i].ID); err != nil {
-				return errors.Wrapf(err, "error while deleting resource with ORD ID %q based on its tombstone", ts.OrdID)
+				return nil, errors.Wrapf(err, "error while deleting resource with ORD ID %q based on its tombstone", ts.OrdID)
 			}
 		}
 		if i, found := searchInSlice(len(eventsFromDB), func(i int) bool {
 			return equalStrings(eventsFromDB[i].OrdID, &ts.OrdID)
 		}); found {
 			if err := s.eventSvc.Delete(ctx, eventsFromDB[i].ID); err != nil {
-				return errors.Wrapf(err, "error while deleting resource with ORD ID %q based on its tombstone", ts.OrdID)
+				return nil, errors.Wrapf(err, "error while deleting resource with ORD ID %q based on its tombstone", ts.OrdID)
 			}
 		}
 		if i, found := searchInSlice(len(bundlesFromDB), func(i int) bool {
 			return equalStrings(bundlesFromDB[i].OrdID, &ts.OrdID)
 		}); found {
 			if err := s.bundleSvc.Delete(ctx, bundlesFromDB[i].ID); err != nil {
-				return errors.Wrapf(err, "error while deleting resource with ORD ID %q based on its tombstone", ts.OrdID)
+				return nil, errors.Wrapf(err, "error while deleting resource with ORD ID %q based on its tombstone", ts.OrdID)
 			}
 		}
 		if i, found := searchInSlice(len(vendorsFromDB), func(i int) bool {
 			return vendorsFromDB[i].OrdID == ts.OrdID
 		}); found {
 			if err := s.vendorSvc.Delete(ctx, vendorsFromDB[i].OrdID); err != nil {
-				return errors.Wrapf(err, "error while deleting resource with ORD ID %q based on its tombstone", ts.OrdID)
+				return nil, errors.Wrapf(err, "error while deleting resource with ORD ID %q based on its tombstone", ts.OrdID)
 			}
 		}
 		if i, found := searchInSlice(len(productsFromDB), func(i int) bool {
 			return productsFromDB[i].OrdID == ts.OrdID
 		}); found {
 			if err := s.productSvc.Delete(ctx, productsFromDB[i].OrdID); err != nil {
-				return errors.Wrapf(err, "error while deleting resource with ORD ID %q based on its tombstone", ts.OrdID)
+				return nil, errors.Wrapf(err, "error while deleting resource with ORD ID %q based on its tombstone", ts.OrdID)
 			}
 		}
 	}
 
-	return nil
+	return hashes.current, nil
 }
 
-func (s *Service) processVendors(ctx context.Context, appID string, vendors []*model.VendorInput) ([]*model.Vendor, error) {
+func (s *Service) processVendors(ctx context.Context, appID string, vendors []*model.VendorInput, hashes *resourceHashes) ([]*model.Vendor, error) {
 	vendorsFromDB, err := s.vendorSvc.ListByApplicationID(ctx, appID)
 	if err != nil {
 		return nil, errors.Wrapf(err, "error while listing vendors for app with id %q", appID)
 	}
 
 	for _, vendor := range vendors {
-		if err := s.resyncVendor(ctx, appID, vendorsFromDB, *vendor); err != nil {
+		changed, err := hashes.track(resourceKindVendor, vendor.OrdID, vendor)
+		if err != nil {
+			return nil, errors.Wrapf(err, "error while hashing vendor with ORD ID %q", vendor.OrdID)
+		}
+		if err := s.resyncVendor(ctx, appID, vendorsFromDB, *vendor, changed); err != nil {
 			return nil, errors.Wrapf(err, "error while resyncing vendor with ORD ID %q", vendor.OrdID)
 		}
 	}
@@ -248,28 +375,36 @@ func (s *Service) processVendors(ctx context.Context, appID string, vendors []*m
 	return s.vendorSvc.ListByApplicationID(ctx, appID)
 }
 
-func (s *Service) processProducts(ctx context.Context, appID string, products []*model.ProductInput) ([]*model.Product, error) {
+func (s *Service) processProducts(ctx context.Context, appID string, products []*model.ProductInput, hashes *resourceHashes) ([]*model.Product, error) {
 	productsFromDB, err := s.productSvc.ListByApplicationID(ctx, appID)
 	if err != nil {
 		return nil, errors.Wrapf(err, "error while listing products for app with id %q", appID)
 	}
 
 	for _, product := range products {
-		if err := s.resyncProduct(ctx, appID, productsFromDB, *product); err != nil {
+		changed, err := hashes.track(resourceKindProduct, product.OrdID, product)
+		if err != nil {
+			return nil, errors.Wrapf(err, "error while hashing product with ORD ID %q", product.OrdID)
+		}
+		if err := s.resyncProduct(ctx, appID, productsFromDB, *product, changed); err != nil {
 			return nil, errors.Wrapf(err, "error while resyncing product with ORD ID %q", product.OrdID)
 		}
 	}
 	return s.productSvc.ListByApplicationID(ctx, appID)
 }
 
-func (s *Service) processPackages(ctx context.Context, appID string, packages []*model.PackageInput) ([]*model.Package, error) {
+func (s *Service) processPackages(ctx context.Context, appID string, packages []*model.PackageInput, hashes *resourceHashes) ([]*model.Package, error) {
 	packagesFromDB, err := s.packageSvc.ListByApplicationID(ctx, appID)
 	if err != nil {
 		return nil, errors.Wrapf(err, "error while listing packages for app with id %q", appID)
 	}
 
 	for _, pkg := range packages {
-		if err := s.resyncPackage(ctx, appID, packagesFromDB, *pkg); err != nil {
+		changed, err := hashes.track(resourceKindPackage, pkg.OrdID, pkg)
+		if err != nil {
+			return nil, errors.Wrapf(err, "error while hashing package with ORD ID %q", pkg.OrdID)
+		}
+		if err := s.resyncPackage(ctx, appID, packagesFromDB, *pkg, changed); err != nil {
 			return nil, errors.Wrapf(err, "error while resyncing package with ORD ID %q", pkg.OrdID)
 		}
 	}
@@ -277,14 +412,18 @@ func (s *Service) processPackages(ctx context.Context, appID string, packages []
 	return s.packageSvc.ListByApplicationID(ctx, appID)
 }
 
-func (s *Service) processBundles(ctx context.Context, appID string, bundles []*model.BundleCreateInput) ([]*model.Bundle, error) {
+func (s *Service) processBundles(ctx context.Context, appID string, bundles []*model.BundleCreateInput, hashes *resourceHashes) ([]*model.Bundle, error) {
 	bundlesFromDB, err := s.bundleSvc.ListByApplicationIDNoPaging(ctx, appID)
 	if err != nil {
 		return nil, errors.Wrapf(err, "error while listing bundles for app with id %q", appID)
 	}
 
 	for _, bndl := range bundles {
-		if err := s.resyncBundle(ctx, appID, bundlesFromDB, *bndl); err != nil {
+		changed, err := hashes.track(resourceKindBundle, *bndl.OrdID, bndl)
+		if err != nil {
+			return nil, errors.Wrapf(err, "error while hashing bundle with ORD ID %q", *bndl.OrdID)
+		}
+		if err := s.resyncBundle(ctx, appID, bundlesFromDB, *bndl, changed); err != nil {
 			return nil, errors.Wrapf(err, "error while resyncing bundle with ORD ID %q", *bndl.OrdID)
 		}
 	}
@@ -292,14 +431,18 @@ func (s *Service) processBundles(ctx context.Context, appID string, bundles []*m
 	return s.bundleSvc.ListByApplicationIDNoPaging(ctx, appID)
 }
 
-func (s *Service) processAPIs(ctx context.Context, appID string, bundlesFromDB []*model.Bundle, packagesFromDB []*model.Package, apis []*model.APIDefinitionInput) ([]*model.APIDefinition, error) {
+func (s *Service) processAPIs(ctx context.Context, appID string, bundlesFromDB []*model.Bundle, packagesFromDB []*model.Package, apis []*model.APIDefinitionInput, hashes *resourceHashes) ([]*model.APIDefinition, error) {
 	apisFromDB, err := s.apiSvc.ListByApplicationID(ctx, appID)
 	if err != nil {
 		return nil, errors.Wrapf(err, "error while listing apis for app with id %q", appID)
 	}
 
 	for _, api := range apis {
-		if err := s.resyncAPI(ctx, appID, apisFromDB, bundlesFromDB, packagesFromDB, *api); err != nil {
+		changed, err := hashes.track(resourceKindAPI, *api.OrdID, api)
+		if err != nil {
+			return nil, errors.Wrapf(err, "error while hashing api with ORD ID %q", *api.OrdID)
+		}
+		if err := s.resyncAPI(ctx, appID, apisFromDB, bundlesFromDB, packagesFromDB, *api, changed); err != nil {
 			return nil, errors.Wrapf(err, "error while resyncing api with ORD ID %q", *api.OrdID)
 		}
 	}
@@ -307,14 +450,18 @@ func (s *Service) processAPIs(ctx context.Context, appID string, bundlesFromDB [
 	return s.apiSvc.ListByApplicationID(ctx, appID)
 }
 
-func (s *Service) processEvents(ctx context.Context, appID string, bundlesFromDB []*model.Bundle, packagesFromDB []*model.Package, events []*model.EventDefinitionInput) ([]*model.EventDefinition, error) {
+func (s *Service) processEvents(ctx context.Context, appID string, bundlesFromDB []*model.Bundle, packagesFromDB []*model.Package, events []*model.EventDefinitionInput, hashes *resourceHashes) ([]*model.EventDefinition, error) {
 	eventsFromDB, err := s.eventSvc.ListByApplicationID(ctx, appID)
 	if err != nil {
 		return nil, errors.Wrapf(err, "error while listing events for app with id %q", appID)
 	}
 
 	for _, event := range events {
-		if err := s.resyncEvent(ctx, appID, eventsFromDB, bundlesFromDB, packagesFromDB, *event); err != nil {
+		changed, err := hashes.track(resourceKindEvent, *event.OrdID, event)
+		if err != nil {
+			return nil, errors.Wrapf(err, "error while hashing event with ORD ID %q", *event.OrdID)
+		}
+		if err := s.resyncEvent(ctx, appID, eventsFromDB, bundlesFromDB, packagesFromDB, *event, changed); err != nil {
 			return nil, errors.Wrapf(err, "error while resyncing event with ORD ID %q", *event.OrdID)
 		}
 	}
@@ -322,14 +469,18 @@ func (s *Service) processEvents(ctx context.Context, appID string, bundlesFromDB
 	return s.eventSvc.ListByApplicationID(ctx, appID)
 }
 
-func (s *Service) processTombstones(ctx context.Context, appID string, tombstones []*model.TombstoneInput) ([]*model.Tombstone, error) {
+func (s *Service) processTombstones(ctx context.Context, appID string, tombstones []*model.TombstoneInput, hashes *resourceHashes) ([]*model.Tombstone, error) {
 	tombstonesFromDB, err := s.tombstoneSvc.ListByApplicationID(ctx, appID)
 	if err != nil {
 		return nil, errors.Wrapf(err, "error while listing tombstones for app with id %q", appID)
 	}
 
 	for _, tombstone := range tombstones {
-		if err := s.resyncTombstone(ctx, appID, tombstonesFromDB, *tombstone); err != nil {
+		changed, err := hashes.track(resourceKindTombstone, tombstone.OrdID, tombstone)
+		if err != nil {
+			return nil, errors.Wrapf(err, "error while hashing tombstone for resource with ORD ID %q", tombstone.OrdID)
+		}
+		if err := s.resyncTombstone(ctx, appID, tombstonesFromDB, *tombstone, changed); err != nil {
 			return nil, errors.Wrapf(err, "error while resyncing tombstone for resource with ORD ID %q", tombstone.OrdID)
 		}
 	}
@@ -337,51 +488,63 @@ func (s *Service) processTombstones(ctx context.Context, appID string, tombstone
 	return s.tombstoneSvc.ListByApplicationID(ctx, appID)
 }
 
-func (s *Service) resyncPackage(ctx context.Context, appID string, packagesFromDB []*model.Package, pkg model.PackageInput) error {
+func (s *Service) resyncPackage(ctx context.Context, appID string, packagesFromDB []*model.Package, pkg model.PackageInput, changed bool) error {
 	ctx = addFieldToLogger(ctx, "package_ord_id", pkg.OrdID)
 	if i, found := searchInSlice(len(packagesFromDB), func(i int) bool {
 		return packagesFromDB[i].OrdID == pkg.OrdID
 	}); found {
+		if !changed {
+			return nil
+		}
 		return s.packageSvc.Update(ctx, packagesFromDB[i].ID, pkg)
 	}
 	_, err := s.packageSvc.Create(ctx, appID, pkg)
 	return err
 }
 
-func (s *Service) resyncBundle(ctx context.Context, appID string, bundlesFromDB []*model.Bundle, bndl model.BundleCreateInput) error {
+func (s *Service) resyncBundle(ctx context.Context, appID string, bundlesFromDB []*model.Bundle, bndl model.BundleCreateInput, changed bool) error {
 	ctx = addFieldToLogger(ctx, "bundle_ord_id", *bndl.OrdID)
 	if i, found := searchInSlice(len(bundlesFromDB), func(i int) bool {
 		return equalStrings(bundlesFromDB[i].OrdID, bndl.OrdID)
 	}); found {
+		if !changed {
+			return nil
+		}
 		return s.bundleSvc.Update(ctx, bundlesFromDB[i].ID, bundleUpdateInputFromCreateInput(bndl))
 	}
 	_, err := s.bundleSvc.Create(ctx, appID, bndl)
 	return err
 }
 
-func (s *Service) resyncProduct(ctx context.Context, appID string, productsFromDB []*model.Product, product model.ProductInput) error {
+func (s *Service) resyncProduct(ctx context.Context, appID string, productsFromDB []*model.Product, product model.ProductInput, changed bool) error {
 	ctx = addFieldToLogger(ctx, "product_ord_id", product.OrdID)
 	if i, found := searchInSlice(len(productsFromDB), func(i int) bool {
 		return productsFromDB[i].OrdID == product.OrdID
 	}); found {
+		if !changed {
+			return nil
+		}
 		return s.productSvc.Update(ctx, productsFromDB[i].OrdID, product)
 	}
 	_, err := s.productSvc.Create(ctx, appID, product)
 	return err
 }
 
-func (s *Service) resyncVendor(ctx context.Context, appID string, vendorsFromDB []*model.Vendor, vendor model.VendorInput) error {
+func (s *Service) resyncVendor(ctx context.Context, appID string, vendorsFromDB []*model.Vendor, vendor model.VendorInput, changed bool) error {
 	ctx = addFieldToLogger(ctx, "vendor_ord_id", vendor.OrdID)
 	if i, found := searchInSlice(len(vendorsFromDB), func(i int) bool {
 		return vendorsFromDB[i].OrdID == vendor.OrdID
 	}); found {
+		if !changed {
+			return nil
+		}
 		return s.vendorSvc.Update(ctx, vendorsFromDB[i].OrdID, vendor)
 	}
 	_, err := s.vendorSvc.Create(ctx, appID, vendor)
 	return err
 }
 
-func (s *Service) resyncAPI(ctx context.Context, appID string, apisFromDB []*model.APIDefinition, bundlesFromDB []*model.Bundle, packagesFromDB []*model.Package, api model.APIDefinitionInput) error {
+func (s *Service) resyncAPI(ctx context.Context, appID string, apisFromDB []*model.APIDefinition, bundlesFromDB []*model.Bundle, packagesFromDB []*model.Package, api model.APIDefinitionInput, changed bool) error {
 	ctx = addFieldToLogger(ctx, "api_ord_id", *api.OrdID)
 	i, isAPIFound := searchInSlice(len(apisFromDB), func(i int) bool {
 		return equalStrings(apisFromDB[i].OrdID, api.OrdID)
@@ -412,6 +575,10 @@ func (s *Service) resyncAPI(ctx context.Context, appID string, apisFromDB []*mod
 		return err
 	}
 
+	if !changed {
+		return nil
+	}
+
 	if err := s.apiSvc.Update(ctx, apisFromDB[i].ID, api, nil); err != nil {
 		return err
 	}
@@ -423,7 +590,7 @@ func (s *Service) resyncAPI(ctx context.Context, appID string, apisFromDB []*mod
 	return nil
 }
 
-func (s *Service) resyncEvent(ctx context.Context, appID string, eventsFromDB []*model.EventDefinition, bundlesFromDB []*model.Bundle, packagesFromDB []*model.Package, event model.EventDefinitionInput) error {
+func (s *Service) resyncEvent(ctx context.Context, appID string, eventsFromDB []*model.EventDefinition, bundlesFromDB []*model.Bundle, packagesFromDB []*model.Package, event model.EventDefinitionInput, changed bool) error {
 	ctx = addFieldToLogger(ctx, "event_ord_id", *event.OrdID)
 	i, found := searchInSlice(len(eventsFromDB), func(i int) bool {
 		return equalStrings(eventsFromDB[i].OrdID, event.OrdID)
@@ -454,6 +621,10 @@ func (s *Service) resyncEvent(ctx context.Context, appID string, eventsFromDB []
 		return err
 	}
 
+	if !changed {
+		return nil
+	}
+
 	if err := s.eventSvc.Update(ctx, eventsFromDB[i].ID, event, nil); err != nil {
 		return err
 	}
@@ -465,25 +636,51 @@ func (s *Service) resyncEvent(ctx context.Context, appID string, eventsFromDB []
 	return nil
 }
 
+// resyncSpecs updates the existing specs which match the new ones by type and format, so that their IDs stay stable,
+// deletes the ones which are no longer present and creates the rest
 func (s *Service) resyncSpecs(ctx context.Context, objectType model.SpecReferenceObjectType, objectID string, specs []*model.SpecInput) error {
-	if err := s.specSvc.DeleteByReferenceObjectID(ctx, objectType, objectID); err != nil {
+	specsFromDB, err := s.specSvc.ListByReferenceObjectID(ctx, objectType, objectID)
+	if err != nil {
 		return err
 	}
+
+	matched := make(map[string]bool, len(specsFromDB))
 	for _, spec := range specs {
 		if spec == nil {
 			continue
 		}
+		if i, found := searchInSlice(len(specsFromDB), func(i int) bool {
+			return !matched[specsFromDB[i].ID] && sameSpecKind(specsFromDB[i], spec)
+		}); found {
+			matched[specsFromDB[i].ID] = true
+			if err := s.specSvc.UpdateByReferenceObjectID(ctx, specsFromDB[i].ID, *spec, objectType, objectID); err != nil {
+				return err
+			}
+			continue
+		}
 		if _, err := s.specSvc.CreateByReferenceObjectID(ctx, *spec, objectType, objectID); err != nil {
 			return err
 		}
 	}
+
+	for _, spec := range specsFromDB {
+		if matched[spec.ID] {
+			continue
+		}
+		if err := s.specSvc.Delete(ctx, spec.ID); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
-func (s *Service) resyncTombstone(ctx context.Context, appID string, tombstonesFromDB []*model.Tombstone, tombstone model.TombstoneInput) error {
+func (s *Service) resyncTombstone(ctx context.Context, appID string, tombstonesFromDB []*model.Tombstone, tombstone model.TombstoneInput, changed bool) error {
 	if i, found := searchInSlice(len(tombstonesFromDB), func(i int) bool {
 		return tombstonesFromDB[i].OrdID == tombstone.OrdID
 	}); found {
+		if !changed {
+			return nil
+		}
 		return s.tombstoneSvc.Update(ctx, tombstonesFromDB[i].OrdID, tombstone)
 	}
 	_, err := s.tombstoneSvc.Create(ctx, appID, tombstone)
@@ -504,6 +701,25 @@ func bundleUpdateInputFromCreateInput(in model.BundleCreateInput) model.BundleUp
 	}
 }
 
+func sameSpecKind(spec *model.Spec, in *model.SpecInput) bool {
+	var specAPIType, inAPIType, specEventType, inEventType string
+	if spec.APIType != nil {
+		specAPIType = string(*spec.APIType)
+	}
+	if in.APIType != nil {
+		inAPIType = string(*in.APIType)
+	}
+	if spec.EventType != nil {
+		specEventType = string(*spec.EventType)
+	}
+	if in.EventType != nil {
+		inEventType = string(*in.EventType)
+	}
+
+	return spec.Format == in.Format && specAPIType == inAPIType && specEventType == inEventType &&
+		str.PtrStrToStr(spec.CustomType) == str.PtrStrToStr(in.CustomType)
+}
+
 func equalStrings(first, second *string) bool {
 	return first != nil && second != nil && *first == *second
 }
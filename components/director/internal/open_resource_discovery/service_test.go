@@ -2,13 +2,16 @@ package open_resource_discovery_test
 
 import (
 	"context"
+	"reflect"
 	"testing"
 
 	"github.com/kyma-incubator/compass/components/director/internal/model"
 	"github.com/kyma-incubator/compass/components/director/internal/open_resource_discovery"
 	"github.com/kyma-incubator/compass/components/director/internal/open_resource_discovery/automock"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
 	persistenceautomock "github.com/kyma-incubator/compass/components/director/pkg/persistence/automock"
 	"github.com/kyma-incubator/compass/components/director/pkg/persistence/txtest"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
 	"github.com/kyma-incubator/compass/components/director/pkg/str"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/mock"
@@ -21,14 +24,16 @@ func TestService_SyncORDDocuments(t *testing.T) {
 
 	sanitizedDoc := fixSanitizedORDDocument()
 	var nilSpecInput *model.SpecInput
+	var noPreviousDocuments []model.ORDDocumentStatus
+	syncErr := errors.Errorf("error while synchronizing ORD documents for app %q", appID)
 
 	secondTransactionNotCommited := func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
 		persistTx := &persistenceautomock.PersistenceTx{}
-		persistTx.On("Commit").Return(nil).Once()
+		persistTx.On("Commit").Return(nil).Twice()
 
 		transact := &persistenceautomock.Transactioner{}
-		transact.On("Begin").Return(persistTx, nil).Twice()
-		transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return().Twice()
+		transact.On("Begin").Return(persistTx, nil).Times(3)
+		transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return().Times(3)
 		return persistTx, transact
 	}
 
@@ -110,25 +115,25 @@ func TestService_SyncORDDocuments(t *testing.T) {
 
 	successfulSpecUpdate := func() *automock.SpecService {
 		specSvc := &automock.SpecService{}
-		specSvc.On("DeleteByReferenceObjectID", txtest.CtxWithDBMatcher(), model.APISpecReference, api1ID).Return(nil).Once()
+		specSvc.On("ListByReferenceObjectID", txtest.CtxWithDBMatcher(), model.APISpecReference, api1ID).Return(nil, nil).Once()
 		specSvc.On("CreateByReferenceObjectID", txtest.CtxWithDBMatcher(), *fixApi1SpecInputs()[0], model.APISpecReference, api1ID).Return("", nil).Once()
 		specSvc.On("CreateByReferenceObjectID", txtest.CtxWithDBMatcher(), *fixApi1SpecInputs()[1], model.APISpecReference, api1ID).Return("", nil).Once()
-		specSvc.On("DeleteByReferenceObjectID", txtest.CtxWithDBMatcher(), model.APISpecReference, api2ID).Return(nil).Once()
+		specSvc.On("ListByReferenceObjectID", txtest.CtxWithDBMatcher(), model.APISpecReference, api2ID).Return(nil, nil).Once()
 		specSvc.On("CreateByReferenceObjectID", txtest.CtxWithDBMatcher(), *fixApi2SpecInputs()[0], model.APISpecReference, api2ID).Return("", nil).Once()
 
-		specSvc.On("DeleteByReferenceObjectID", txtest.CtxWithDBMatcher(), model.EventSpecReference, event1ID).Return(nil).Once()
+		specSvc.On("ListByReferenceObjectID", txtest.CtxWithDBMatcher(), model.EventSpecReference, event1ID).Return(nil, nil).Once()
 		specSvc.On("CreateByReferenceObjectID", txtest.CtxWithDBMatcher(), *fixEvent1SpecInputs()[0], model.EventSpecReference, event1ID).Return("", nil).Once()
-		specSvc.On("DeleteByReferenceObjectID", txtest.CtxWithDBMatcher(), model.EventSpecReference, event2ID).Return(nil).Once()
+		specSvc.On("ListByReferenceObjectID", txtest.CtxWithDBMatcher(), model.EventSpecReference, event2ID).Return(nil, nil).Once()
 		specSvc.On("CreateByReferenceObjectID", txtest.CtxWithDBMatcher(), *fixEvent2SpecInputs()[0], model.EventSpecReference, event2ID).Return("", nil).Once()
 		return specSvc
 	}
 
 	successfulAPISpecUpdate := func() *automock.SpecService {
 		specSvc := &automock.SpecService{}
-		specSvc.On("DeleteByReferenceObjectID", txtest.CtxWithDBMatcher(), model.APISpecReference, api1ID).Return(nil).Once()
+		specSvc.On("ListByReferenceObjectID", txtest.CtxWithDBMatcher(), model.APISpecReference, api1ID).Return(nil, nil).Once()
 		specSvc.On("CreateByReferenceObjectID", txtest.CtxWithDBMatcher(), *fixApi1SpecInputs()[0], model.APISpecReference, api1ID).Return("", nil).Once()
 		specSvc.On("CreateByReferenceObjectID", txtest.CtxWithDBMatcher(), *fixApi1SpecInputs()[1], model.APISpecReference, api1ID).Return("", nil).Once()
-		specSvc.On("DeleteByReferenceObjectID", txtest.CtxWithDBMatcher(), model.APISpecReference, api2ID).Return(nil).Once()
+		specSvc.On("ListByReferenceObjectID", txtest.CtxWithDBMatcher(), model.APISpecReference, api2ID).Return(nil, nil).Once()
 		specSvc.On("CreateByReferenceObjectID", txtest.CtxWithDBMatcher(), *fixApi2SpecInputs()[0], model.APISpecReference, api2ID).Return("", nil).Once()
 		return specSvc
 	}
@@ -169,9 +174,33 @@ func TestService_SyncORDDocuments(t *testing.T) {
 		return eventSvc
 	}
 
+	successfulSyncStatusSave := func() *automock.SyncStatusService {
+		syncStatusSvc := &automock.SyncStatusService{}
+		syncStatusSvc.On("GetByApplicationID", txtest.CtxWithDBMatcher(), appID).Return(nil, apperrors.NewNotFoundError(resource.ORDSyncStatus, appID)).Twice()
+		syncStatusSvc.On("Upsert", txtest.CtxWithDBMatcher(), mock.MatchedBy(func(status *model.ORDSyncStatus) bool {
+			return status.ApplicationID == appID && status.LastSuccessAt != nil && status.LastError == nil && status.ConsecutiveFailures == 0 && len(status.Documents) == 1 && len(status.ResourceHashes) > 0
+		})).Return(nil).Once()
+		return syncStatusSvc
+	}
+
+	failedSyncStatusSave := func() *automock.SyncStatusService {
+		syncStatusSvc := &automock.SyncStatusService{}
+		syncStatusSvc.On("GetByApplicationID", txtest.CtxWithDBMatcher(), appID).Return(nil, apperrors.NewNotFoundError(resource.ORDSyncStatus, appID)).Twice()
+		syncStatusSvc.On("Upsert", txtest.CtxWithDBMatcher(), mock.MatchedBy(func(status *model.ORDSyncStatus) bool {
+			return status.ApplicationID == appID && status.LastSuccessAt == nil && status.LastError != nil && status.ConsecutiveFailures == 1 && status.NextSyncAt != nil
+		})).Return(nil).Once()
+		return syncStatusSvc
+	}
+
+	defaultResyncInterval := func() *automock.LabelRepository {
+		labelRepo := &automock.LabelRepository{}
+		labelRepo.On("GetByKey", txtest.CtxWithDBMatcher(), tenantID, model.ApplicationLabelableObject, appID, open_resource_discovery.ResyncIntervalLabelKey).Return(nil, apperrors.NewNotFoundError(resource.Label, appID)).Once()
+		return labelRepo
+	}
+
 	successfulClientFetch := func() *automock.Client {
 		client := &automock.Client{}
-		client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), baseURL).Return(open_resource_discovery.Documents{fixORDDocument()}, nil)
+		client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), fixWebhooks()[0], noPreviousDocuments).Return(open_resource_discovery.Documents{fixORDDocument()}, nil)
 		return client
 	}
 
@@ -188,13 +217,15 @@ func TestService_SyncORDDocuments(t *testing.T) {
 		productSvcFn    func() *automock.ProductService
 		vendorSvcFn     func() *automock.VendorService
 		tombstoneSvcFn  func() *automock.TombstoneService
+		syncStatusSvcFn func() *automock.SyncStatusService
+		labelRepoFn     func() *automock.LabelRepository
 		clientFn        func() *automock.Client
 		ExpectedErr     error
 	}{
 		{
 			Name: "Success when resources are already in db should Update them",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
-				return txGen.ThatSucceedsMultipleTimes(2)
+				return txGen.ThatSucceedsMultipleTimes(3)
 			},
 			appSvcFn:     successfulAppList,
 			webhookSvcFn: successfulWebhookList,
@@ -220,12 +251,14 @@ func TestService_SyncORDDocuments(t *testing.T) {
 				tombstoneSvc.On("ListByApplicationID", txtest.CtxWithDBMatcher(), appID).Return(fixTombstones(), nil).Once()
 				return tombstoneSvc
 			},
-			clientFn: successfulClientFetch,
+			syncStatusSvcFn: successfulSyncStatusSave,
+			labelRepoFn:     defaultResyncInterval,
+			clientFn:        successfulClientFetch,
 		},
 		{
 			Name: "Success when resources are not in db should Create them",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
-				return txGen.ThatSucceedsMultipleTimes(2)
+				return txGen.ThatSucceedsMultipleTimes(3)
 			},
 			appSvcFn:     successfulAppList,
 			webhookSvcFn: successfulWebhookList,
@@ -250,7 +283,197 @@ func TestService_SyncORDDocuments(t *testing.T) {
 				tombstoneSvc.On("ListByApplicationID", txtest.CtxWithDBMatcher(), appID).Return(fixTombstones(), nil).Once()
 				return tombstoneSvc
 			},
-			clientFn: successfulClientFetch,
+			syncStatusSvcFn: successfulSyncStatusSave,
+			labelRepoFn:     defaultResyncInterval,
+			clientFn:        successfulClientFetch,
+		},
+		{
+			Name: "Success when specs of updated resources already exist should Update the matching ones and Delete the rest",
+			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txGen.ThatSucceedsMultipleTimes(3)
+			},
+			appSvcFn:     successfulAppList,
+			webhookSvcFn: successfulWebhookList,
+			bundleSvcFn:  successfulBundleUpdate,
+			apiSvcFn: func() *automock.APIService {
+				apiSvc := successfulAPIUpdate()
+				apiSvc.On("Delete", txtest.CtxWithDBMatcher(), api2ID).Return(nil).Once()
+				return apiSvc
+			},
+			eventSvcFn: successfulEventUpdate,
+			specSvcFn: func() *automock.SpecService {
+				specSvc := &automock.SpecService{}
+				specSvc.On("ListByReferenceObjectID", txtest.CtxWithDBMatcher(), model.APISpecReference, api1ID).Return(fixApi1Specs(), nil).Once()
+				specSvc.On("UpdateByReferenceObjectID", txtest.CtxWithDBMatcher(), specID, *fixApi1SpecInputs()[0], model.APISpecReference, api1ID).Return(nil).Once()
+				specSvc.On("CreateByReferenceObjectID", txtest.CtxWithDBMatcher(), *fixApi1SpecInputs()[1], model.APISpecReference, api1ID).Return("", nil).Once()
+				specSvc.On("Delete", txtest.CtxWithDBMatcher(), spec2ID).Return(nil).Once()
+				specSvc.On("ListByReferenceObjectID", txtest.CtxWithDBMatcher(), model.APISpecReference, api2ID).Return(nil, nil).Once()
+				specSvc.On("CreateByReferenceObjectID", txtest.CtxWithDBMatcher(), *fixApi2SpecInputs()[0], model.APISpecReference, api2ID).Return("", nil).Once()
+
+				specSvc.On("ListByReferenceObjectID", txtest.CtxWithDBMatcher(), model.EventSpecReference, event1ID).Return(nil, nil).Once()
+				specSvc.On("CreateByReferenceObjectID", txtest.CtxWithDBMatcher(), *fixEvent1SpecInputs()[0], model.EventSpecReference, event1ID).Return("", nil).Once()
+				specSvc.On("ListByReferenceObjectID", txtest.CtxWithDBMatcher(), model.EventSpecReference, event2ID).Return(nil, nil).Once()
+				specSvc.On("CreateByReferenceObjectID", txtest.CtxWithDBMatcher(), *fixEvent2SpecInputs()[0], model.EventSpecReference, event2ID).Return("", nil).Once()
+				return specSvc
+			},
+			packageSvcFn: successfulPackageUpdate,
+			productSvcFn: successfulProductUpdate,
+			vendorSvcFn:  successfulVendorUpdate,
+			tombstoneSvcFn: func() *automock.TombstoneService {
+				tombstoneSvc := &automock.TombstoneService{}
+				tombstoneSvc.On("ListByApplicationID", txtest.CtxWithDBMatcher(), appID).Return(fixTombstones(), nil).Once()
+				tombstoneSvc.On("Update", txtest.CtxWithDBMatcher(), sanitizedDoc.Tombstones[0].OrdID, *sanitizedDoc.Tombstones[0]).Return(nil).Once()
+				tombstoneSvc.On("ListByApplicationID", txtest.CtxWithDBMatcher(), appID).Return(fixTombstones(), nil).Once()
+				return tombstoneSvc
+			},
+			syncStatusSvcFn: successfulSyncStatusSave,
+			labelRepoFn:     defaultResyncInterval,
+			clientFn:        successfulClientFetch,
+		},
+		{
+			Name: "Success when resources have not changed since the previous sync should not Update them",
+			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txGen.ThatSucceedsMultipleTimes(3)
+			},
+			appSvcFn:     successfulAppList,
+			webhookSvcFn: successfulWebhookList,
+			bundleSvcFn: func() *automock.BundleService {
+				bundlesSvc := &automock.BundleService{}
+				bundlesSvc.On("ListByApplicationIDNoPaging", txtest.CtxWithDBMatcher(), appID).Return(fixBundles(), nil).Twice()
+				return bundlesSvc
+			},
+			apiSvcFn: func() *automock.APIService {
+				apiSvc := &automock.APIService{}
+				apiSvc.On("ListByApplicationID", txtest.CtxWithDBMatcher(), appID).Return(fixAPIs(), nil).Twice()
+				apiSvc.On("Delete", txtest.CtxWithDBMatcher(), api2ID).Return(nil).Once()
+				return apiSvc
+			},
+			eventSvcFn: func() *automock.EventService {
+				eventSvc := &automock.EventService{}
+				eventSvc.On("ListByApplicationID", txtest.CtxWithDBMatcher(), appID).Return(fixEvents(), nil).Twice()
+				return eventSvc
+			},
+			packageSvcFn: func() *automock.PackageService {
+				packagesSvc := &automock.PackageService{}
+				packagesSvc.On("ListByApplicationID", txtest.CtxWithDBMatcher(), appID).Return(fixPackages(), nil).Twice()
+				return packagesSvc
+			},
+			productSvcFn: func() *automock.ProductService {
+				productSvc := &automock.ProductService{}
+				productSvc.On("ListByApplicationID", txtest.CtxWithDBMatcher(), appID).Return(fixProducts(), nil).Twice()
+				return productSvc
+			},
+			vendorSvcFn: func() *automock.VendorService {
+				vendorSvc := &automock.VendorService{}
+				vendorSvc.On("ListByApplicationID", txtest.CtxWithDBMatcher(), appID).Return(fixVendors(), nil).Twice()
+				return vendorSvc
+			},
+			tombstoneSvcFn: func() *automock.TombstoneService {
+				tombstoneSvc := &automock.TombstoneService{}
+				tombstoneSvc.On("ListByApplicationID", txtest.CtxWithDBMatcher(), appID).Return(fixTombstones(), nil).Twice()
+				return tombstoneSvc
+			},
+			syncStatusSvcFn: func() *automock.SyncStatusService {
+				previous := &model.ORDSyncStatus{
+					ApplicationID:  appID,
+					Documents:      fixDocumentStatuses(),
+					ResourceHashes: fixResourceHashes(sanitizedDoc),
+				}
+				syncStatusSvc := &automock.SyncStatusService{}
+				syncStatusSvc.On("GetByApplicationID", txtest.CtxWithDBMatcher(), appID).Return(previous, nil).Twice()
+				syncStatusSvc.On("Upsert", txtest.CtxWithDBMatcher(), mock.MatchedBy(func(status *model.ORDSyncStatus) bool {
+					return status.LastSuccessAt != nil && reflect.DeepEqual(status.ResourceHashes, fixResourceHashes(sanitizedDoc))
+				})).Return(nil).Once()
+				return syncStatusSvc
+			},
+			labelRepoFn: defaultResyncInterval,
+			clientFn: func() *automock.Client {
+				client := &automock.Client{}
+				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), fixWebhooks()[0], fixDocumentStatuses()).Return(open_resource_discovery.Documents{fixFetchedORDDocument(etag, lastModified)}, nil).Once()
+				return client
+			},
+		},
+		{
+			Name: "Skips processing when the ORD documents were not modified since the previous sync",
+			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txGen.ThatSucceedsMultipleTimes(3)
+			},
+			appSvcFn:     successfulAppList,
+			webhookSvcFn: successfulWebhookList,
+			syncStatusSvcFn: func() *automock.SyncStatusService {
+				previous := &model.ORDSyncStatus{
+					ApplicationID:  appID,
+					Documents:      fixDocumentStatuses(),
+					ResourceHashes: map[string]string{"api:" + api1ORDID: documentHash},
+				}
+				syncStatusSvc := &automock.SyncStatusService{}
+				syncStatusSvc.On("GetByApplicationID", txtest.CtxWithDBMatcher(), appID).Return(previous, nil).Twice()
+				syncStatusSvc.On("Upsert", txtest.CtxWithDBMatcher(), mock.MatchedBy(func(status *model.ORDSyncStatus) bool {
+					return status.LastSuccessAt != nil && reflect.DeepEqual(status.Documents, fixDocumentStatuses()) && status.ResourceHashes["api:"+api1ORDID] == documentHash
+				})).Return(nil).Once()
+				return syncStatusSvc
+			},
+			labelRepoFn: defaultResyncInterval,
+			clientFn: func() *automock.Client {
+				doc := &open_resource_discovery.Document{
+					URL:          baseURL + ordDocURI,
+					Hash:         documentHash,
+					ETag:         etag,
+					LastModified: lastModified,
+					NotModified:  true,
+				}
+				client := &automock.Client{}
+				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), fixWebhooks()[0], fixDocumentStatuses()).Return(open_resource_discovery.Documents{doc}, nil).Once()
+				return client
+			},
+		},
+		{
+			Name:            "Fetches all ORD documents again when only some of them were modified",
+			ExpectedErr:     syncErr,
+			TransactionerFn: secondTransactionNotCommited,
+			appSvcFn:        successfulAppList,
+			webhookSvcFn:    successfulWebhookList,
+			syncStatusSvcFn: func() *automock.SyncStatusService {
+				previous := &model.ORDSyncStatus{ApplicationID: appID, Documents: fixDocumentStatuses()}
+				syncStatusSvc := &automock.SyncStatusService{}
+				syncStatusSvc.On("GetByApplicationID", txtest.CtxWithDBMatcher(), appID).Return(previous, nil).Twice()
+				syncStatusSvc.On("Upsert", txtest.CtxWithDBMatcher(), mock.MatchedBy(func(status *model.ORDSyncStatus) bool {
+					return status.LastError != nil && reflect.DeepEqual(status.Documents, fixDocumentStatuses())
+				})).Return(nil).Once()
+				return syncStatusSvc
+			},
+			clientFn: func() *automock.Client {
+				notModified := &open_resource_discovery.Document{URL: baseURL + ordDocURI, Hash: documentHash, NotModified: true}
+				client := &automock.Client{}
+				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), fixWebhooks()[0], fixDocumentStatuses()).Return(open_resource_discovery.Documents{notModified, fixORDDocument()}, nil).Once()
+				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), fixWebhooks()[0], noPreviousDocuments).Return(nil, testErr).Once()
+				return client
+			},
+		},
+		{
+			Name:            "Fetches all ORD documents again when none of them were modified but the set of documents changed",
+			ExpectedErr:     syncErr,
+			TransactionerFn: secondTransactionNotCommited,
+			appSvcFn:        successfulAppList,
+			webhookSvcFn:    successfulWebhookList,
+			syncStatusSvcFn: func() *automock.SyncStatusService {
+				previousDocuments := append(fixDocumentStatuses(), model.ORDDocumentStatus{URL: baseURL + "/removed", Hash: documentHash, ETag: etag})
+				previous := &model.ORDSyncStatus{ApplicationID: appID, Documents: previousDocuments}
+				syncStatusSvc := &automock.SyncStatusService{}
+				syncStatusSvc.On("GetByApplicationID", txtest.CtxWithDBMatcher(), appID).Return(previous, nil).Twice()
+				syncStatusSvc.On("Upsert", txtest.CtxWithDBMatcher(), mock.MatchedBy(func(status *model.ORDSyncStatus) bool {
+					return status.LastError != nil && reflect.DeepEqual(status.Documents, previousDocuments)
+				})).Return(nil).Once()
+				return syncStatusSvc
+			},
+			clientFn: func() *automock.Client {
+				previousDocuments := append(fixDocumentStatuses(), model.ORDDocumentStatus{URL: baseURL + "/removed", Hash: documentHash, ETag: etag})
+				notModified := &open_resource_discovery.Document{URL: baseURL + ordDocURI, Hash: documentHash, NotModified: true}
+				client := &automock.Client{}
+				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), fixWebhooks()[0], previousDocuments).Return(open_resource_discovery.Documents{notModified}, nil).Once()
+				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), fixWebhooks()[0], noPreviousDocuments).Return(nil, testErr).Once()
+				return client
+			},
 		},
 		{
 			Name:            "Returns error when transaction opening fails",
@@ -268,15 +491,16 @@ func TestService_SyncORDDocuments(t *testing.T) {
 			ExpectedErr: testErr,
 		},
 		{
-			Name: "Returns error when webhook list fails",
-			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
-				persistTx := &persistenceautomock.PersistenceTx{}
-				persistTx.On("Commit").Return(nil).Once()
-
-				transact := &persistenceautomock.Transactioner{}
-				transact.On("Begin").Return(persistTx, nil).Twice()
-				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return().Twice()
-				return persistTx, transact
+			Name:            "Records failure when webhook list fails",
+			ExpectedErr:     syncErr,
+			TransactionerFn: secondTransactionNotCommited,
+			syncStatusSvcFn: func() *automock.SyncStatusService {
+				syncStatusSvc := &automock.SyncStatusService{}
+				syncStatusSvc.On("GetByApplicationID", txtest.CtxWithDBMatcher(), appID).Return(nil, apperrors.NewNotFoundError(resource.ORDSyncStatus, appID)).Once()
+				syncStatusSvc.On("Upsert", txtest.CtxWithDBMatcher(), mock.MatchedBy(func(status *model.ORDSyncStatus) bool {
+					return status.ApplicationID == appID && status.LastError != nil && status.ConsecutiveFailures == 1
+				})).Return(nil).Once()
+				return syncStatusSvc
 			},
 			appSvcFn: successfulAppList,
 			webhookSvcFn: func() *automock.WebhookService {
@@ -284,35 +508,81 @@ func TestService_SyncORDDocuments(t *testing.T) {
 				whSvc.On("ListForApplication", txtest.CtxWithDBMatcher(), appID).Return(nil, testErr).Once()
 				return whSvc
 			},
-			ExpectedErr: testErr,
 		},
 		{
 			Name:            "Skips app when ORD documents fetch fails",
+			ExpectedErr:     syncErr,
 			TransactionerFn: secondTransactionNotCommited,
+			syncStatusSvcFn: failedSyncStatusSave,
 			appSvcFn:        successfulAppList,
 			webhookSvcFn:    successfulWebhookList,
 			clientFn: func() *automock.Client {
 				client := &automock.Client{}
-				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), baseURL).Return(nil, testErr)
+				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), fixWebhooks()[0], noPreviousDocuments).Return(nil, testErr)
+				return client
+			},
+		},
+		{
+			Name:            "Skips app when some of the ORD documents could not be fetched",
+			ExpectedErr:     syncErr,
+			TransactionerFn: secondTransactionNotCommited,
+			syncStatusSvcFn: failedSyncStatusSave,
+			appSvcFn:        successfulAppList,
+			webhookSvcFn:    successfulWebhookList,
+			clientFn: func() *automock.Client {
+				client := &automock.Client{}
+				failures := open_resource_discovery.DocumentFetchErrors{{URL: baseURL + ordDocURI, Err: testErr}}
+				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), fixWebhooks()[0], noPreviousDocuments).Return(open_resource_discovery.Documents{fixORDDocument()}, failures)
+				return client
+			},
+		},
+		{
+			Name: "Skips only the ORD documents with unsupported access strategies and records why",
+			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txGen.ThatSucceedsMultipleTimes(3)
+			},
+			appSvcFn:     successfulAppList,
+			webhookSvcFn: successfulWebhookList,
+			syncStatusSvcFn: func() *automock.SyncStatusService {
+				skipped := []model.ORDDocumentStatus{{URL: baseURL + ordDocURI, SkipReason: `unsupported access strategies ["test"]`}}
+				syncStatusSvc := &automock.SyncStatusService{}
+				syncStatusSvc.On("GetByApplicationID", txtest.CtxWithDBMatcher(), appID).Return(nil, apperrors.NewNotFoundError(resource.ORDSyncStatus, appID)).Twice()
+				syncStatusSvc.On("Upsert", txtest.CtxWithDBMatcher(), mock.MatchedBy(func(status *model.ORDSyncStatus) bool {
+					return status.LastSuccessAt != nil && status.LastError == nil && reflect.DeepEqual(status.Documents, skipped)
+				})).Return(nil).Once()
+				return syncStatusSvc
+			},
+			labelRepoFn: defaultResyncInterval,
+			clientFn: func() *automock.Client {
+				client := &automock.Client{}
+				unsupported := &open_resource_discovery.UnsupportedAccessStrategiesError{
+					AccessStrategies: open_resource_discovery.AccessStrategies{{Type: open_resource_discovery.CustomAccessStrategy, CustomType: "test"}},
+				}
+				failures := open_resource_discovery.DocumentFetchErrors{{URL: baseURL + ordDocURI, Err: unsupported}}
+				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), fixWebhooks()[0], noPreviousDocuments).Return(open_resource_discovery.Documents{}, failures)
 				return client
 			},
 		},
 		{
 			Name:            "Does not resync resources for invalid ORD documents",
+			ExpectedErr:     syncErr,
 			TransactionerFn: secondTransactionNotCommited,
+			syncStatusSvcFn: failedSyncStatusSave,
 			appSvcFn:        successfulAppList,
 			webhookSvcFn:    successfulWebhookList,
 			clientFn: func() *automock.Client {
 				client := &automock.Client{}
 				doc := fixORDDocument()
 				doc.Vendors[0].OrdID = "" // invalid document
-				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), baseURL).Return(open_resource_discovery.Documents{doc}, nil)
+				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), fixWebhooks()[0], noPreviousDocuments).Return(open_resource_discovery.Documents{doc}, nil)
 				return client
 			},
 		},
 		{
 			Name:            "Does not resync resources if vendor list fails",
+			ExpectedErr:     syncErr,
 			TransactionerFn: secondTransactionNotCommited,
+			syncStatusSvcFn: failedSyncStatusSave,
 			appSvcFn:        successfulAppList,
 			webhookSvcFn:    successfulWebhookList,
 			vendorSvcFn: func() *automock.VendorService {
@@ -324,7 +594,9 @@ func TestService_SyncORDDocuments(t *testing.T) {
 		},
 		{
 			Name:            "Does not resync resources if vendor update fails",
+			ExpectedErr:     syncErr,
 			TransactionerFn: secondTransactionNotCommited,
+			syncStatusSvcFn: failedSyncStatusSave,
 			appSvcFn:        successfulAppList,
 			webhookSvcFn:    successfulWebhookList,
 			vendorSvcFn: func() *automock.VendorService {
@@ -337,7 +609,9 @@ func TestService_SyncORDDocuments(t *testing.T) {
 		},
 		{
 			Name:            "Does not resync resources if vendor create fails",
+			ExpectedErr:     syncErr,
 			TransactionerFn: secondTransactionNotCommited,
+			syncStatusSvcFn: failedSyncStatusSave,
 			appSvcFn:        successfulAppList,
 			webhookSvcFn:    successfulWebhookList,
 			vendorSvcFn: func() *automock.VendorService {
@@ -350,7 +624,9 @@ func TestService_SyncORDDocuments(t *testing.T) {
 		},
 		{
 			Name:            "Does not resync resources if product list fails",
+			ExpectedErr:     syncErr,
 			TransactionerFn: secondTransactionNotCommited,
+			syncStatusSvcFn: failedSyncStatusSave,
 			appSvcFn:        successfulAppList,
 			webhookSvcFn:    successfulWebhookList,
 			vendorSvcFn:     successfulVendorUpdate,
@@ -363,7 +639,9 @@ func TestService_SyncORDDocuments(t *testing.T) {
 		},
 		{
 			Name:            "Does not resync resources if product update fails",
+			ExpectedErr:     syncErr,
 			TransactionerFn: secondTransactionNotCommited,
+			syncStatusSvcFn: failedSyncStatusSave,
 			appSvcFn:        successfulAppList,
 			webhookSvcFn:    successfulWebhookList,
 			vendorSvcFn:     successfulVendorUpdate,
@@ -377,7 +655,9 @@ func TestService_SyncORDDocuments(t *testing.T) {
 		},
 		{
 			Name:            "Does not resync resources if product create fails",
+			ExpectedErr:     syncErr,
 			TransactionerFn: secondTransactionNotCommited,
+			syncStatusSvcFn: failedSyncStatusSave,
 			appSvcFn:        successfulAppList,
 			webhookSvcFn:    successfulWebhookList,
 			vendorSvcFn:     successfulVendorUpdate,
@@ -391,7 +671,9 @@ func TestService_SyncORDDocuments(t *testing.T) {
 		},
 		{
 			Name:            "Does not resync resources if package list fails",
+			ExpectedErr:     syncErr,
 			TransactionerFn: secondTransactionNotCommited,
+			syncStatusSvcFn: failedSyncStatusSave,
 			appSvcFn:        successfulAppList,
 			webhookSvcFn:    successfulWebhookList,
 			productSvcFn:    successfulProductUpdate,
@@ -405,7 +687,9 @@ func TestService_SyncORDDocuments(t *testing.T) {
 		},
 		{
 			Name:            "Does not resync resources if package update fails",
+			ExpectedErr:     syncErr,
 			TransactionerFn: secondTransactionNotCommited,
+			syncStatusSvcFn: failedSyncStatusSave,
 			appSvcFn:        successfulAppList,
 			webhookSvcFn:    successfulWebhookList,
 			productSvcFn:    successfulProductUpdate,
@@ -420,7 +704,9 @@ func TestService_SyncORDDocuments(t *testing.T) {
 		},
 		{
 			Name:            "Does not resync resources if package create fails",
+			ExpectedErr:     syncErr,
 			TransactionerFn: secondTransactionNotCommited,
+			syncStatusSvcFn: failedSyncStatusSave,
 			appSvcFn:        successfulAppList,
 			webhookSvcFn:    successfulWebhookList,
 			productSvcFn:    successfulProductCreate,
@@ -435,7 +721,9 @@ func TestService_SyncORDDocuments(t *testing.T) {
 		},
 		{
 			Name:            "Does not resync resources if bundle list fails",
+			ExpectedErr:     syncErr,
 			TransactionerFn: secondTransactionNotCommited,
+			syncStatusSvcFn: failedSyncStatusSave,
 			appSvcFn:        successfulAppList,
 			webhookSvcFn:    successfulWebhookList,
 			productSvcFn:    successfulProductUpdate,
@@ -450,7 +738,9 @@ func TestService_SyncORDDocuments(t *testing.T) {
 		},
 		{
 			Name:            "Does not resync resources if bundle update fails",
+			ExpectedErr:     syncErr,
 			TransactionerFn: secondTransactionNotCommited,
+			syncStatusSvcFn: failedSyncStatusSave,
 			appSvcFn:        successfulAppList,
 			webhookSvcFn:    successfulWebhookList,
 			productSvcFn:    successfulProductUpdate,
@@ -466,7 +756,9 @@ func TestService_SyncORDDocuments(t *testing.T) {
 		},
 		{
 			Name:            "Does not resync resources if bundle create fails",
+			ExpectedErr:     syncErr,
 			TransactionerFn: secondTransactionNotCommited,
+			syncStatusSvcFn: failedSyncStatusSave,
 			appSvcFn:        successfulAppList,
 			webhookSvcFn:    successfulWebhookList,
 			productSvcFn:    successfulProductCreate,
@@ -482,7 +774,9 @@ func TestService_SyncORDDocuments(t *testing.T) {
 		},
 		{
 			Name:            "Does not resync resources if api list fails",
+			ExpectedErr:     syncErr,
 			TransactionerFn: secondTransactionNotCommited,
+			syncStatusSvcFn: failedSyncStatusSave,
 			appSvcFn:        successfulAppList,
 			webhookSvcFn:    successfulWebhookList,
 			productSvcFn:    successfulProductUpdate,
@@ -498,7 +792,9 @@ func TestService_SyncORDDocuments(t *testing.T) {
 		},
 		{
 			Name:            "Does not resync resources if api update fails",
+			ExpectedErr:     syncErr,
 			TransactionerFn: secondTransactionNotCommited,
+			syncStatusSvcFn: failedSyncStatusSave,
 			appSvcFn:        successfulAppList,
 			webhookSvcFn:    successfulWebhookList,
 			productSvcFn:    successfulProductUpdate,
@@ -515,7 +811,9 @@ func TestService_SyncORDDocuments(t *testing.T) {
 		},
 		{
 			Name:            "Does not resync resources if api create fails",
+			ExpectedErr:     syncErr,
 			TransactionerFn: secondTransactionNotCommited,
+			syncStatusSvcFn: failedSyncStatusSave,
 			appSvcFn:        successfulAppList,
 			webhookSvcFn:    successfulWebhookList,
 			productSvcFn:    successfulProductCreate,
@@ -531,8 +829,10 @@ func TestService_SyncORDDocuments(t *testing.T) {
 			clientFn: successfulClientFetch,
 		},
 		{
-			Name:            "Does not resync resources if api spec delete fails",
+			Name:            "Does not resync resources if api spec list fails",
+			ExpectedErr:     syncErr,
 			TransactionerFn: secondTransactionNotCommited,
+			syncStatusSvcFn: failedSyncStatusSave,
 			appSvcFn:        successfulAppList,
 			webhookSvcFn:    successfulWebhookList,
 			productSvcFn:    successfulProductUpdate,
@@ -547,14 +847,16 @@ func TestService_SyncORDDocuments(t *testing.T) {
 			},
 			specSvcFn: func() *automock.SpecService {
 				specSvc := &automock.SpecService{}
-				specSvc.On("DeleteByReferenceObjectID", txtest.CtxWithDBMatcher(), model.APISpecReference, api1ID).Return(testErr).Once()
+				specSvc.On("ListByReferenceObjectID", txtest.CtxWithDBMatcher(), model.APISpecReference, api1ID).Return(nil, testErr).Once()
 				return specSvc
 			},
 			clientFn: successfulClientFetch,
 		},
 		{
 			Name:            "Does not resync resources if api spec create fails",
+			ExpectedErr:     syncErr,
 			TransactionerFn: secondTransactionNotCommited,
+			syncStatusSvcFn: failedSyncStatusSave,
 			appSvcFn:        successfulAppList,
 			webhookSvcFn:    successfulWebhookList,
 			productSvcFn:    successfulProductUpdate,
@@ -569,7 +871,7 @@ func TestService_SyncORDDocuments(t *testing.T) {
 			},
 			specSvcFn: func() *automock.SpecService {
 				specSvc := &automock.SpecService{}
-				specSvc.On("DeleteByReferenceObjectID", txtest.CtxWithDBMatcher(), model.APISpecReference, api1ID).Return(nil).Once()
+				specSvc.On("ListByReferenceObjectID", txtest.CtxWithDBMatcher(), model.APISpecReference, api1ID).Return(nil, nil).Once()
 				specSvc.On("CreateByReferenceObjectID", txtest.CtxWithDBMatcher(), *fixApi1SpecInputs()[0], model.APISpecReference, api1ID).Return("", testErr).Once()
 				return specSvc
 			},
@@ -577,7 +879,9 @@ func TestService_SyncORDDocuments(t *testing.T) {
 		},
 		{
 			Name:            "Does not resync resources if event list fails",
+			ExpectedErr:     syncErr,
 			TransactionerFn: secondTransactionNotCommited,
+			syncStatusSvcFn: failedSyncStatusSave,
 			appSvcFn:        successfulAppList,
 			webhookSvcFn:    successfulWebhookList,
 			productSvcFn:    successfulProductUpdate,
@@ -595,7 +899,9 @@ func TestService_SyncORDDocuments(t *testing.T) {
 		},
 		{
 			Name:            "Does not resync resources if event update fails",
+			ExpectedErr:     syncErr,
 			TransactionerFn: secondTransactionNotCommited,
+			syncStatusSvcFn: failedSyncStatusSave,
 			appSvcFn:        successfulAppList,
 			webhookSvcFn:    successfulWebhookList,
 			productSvcFn:    successfulProductUpdate,
@@ -614,7 +920,9 @@ func TestService_SyncORDDocuments(t *testing.T) {
 		},
 		{
 			Name:            "Does not resync resources if event create fails",
+			ExpectedErr:     syncErr,
 			TransactionerFn: secondTransactionNotCommited,
+			syncStatusSvcFn: failedSyncStatusSave,
 			appSvcFn:        successfulAppList,
 			webhookSvcFn:    successfulWebhookList,
 			productSvcFn:    successfulProductCreate,
@@ -631,8 +939,10 @@ func TestService_SyncORDDocuments(t *testing.T) {
 			clientFn: successfulClientFetch,
 		},
 		{
-			Name:            "Does not resync resources if event spec delete fails",
+			Name:            "Does not resync resources if event spec list fails",
+			ExpectedErr:     syncErr,
 			TransactionerFn: secondTransactionNotCommited,
+			syncStatusSvcFn: failedSyncStatusSave,
 			appSvcFn:        successfulAppList,
 			webhookSvcFn:    successfulWebhookList,
 			productSvcFn:    successfulProductUpdate,
@@ -642,13 +952,13 @@ func TestService_SyncORDDocuments(t *testing.T) {
 			apiSvcFn:        successfulAPIUpdate,
 			specSvcFn: func() *automock.SpecService {
 				specSvc := &automock.SpecService{}
-				specSvc.On("DeleteByReferenceObjectID", txtest.CtxWithDBMatcher(), model.APISpecReference, api1ID).Return(nil).Once()
+				specSvc.On("ListByReferenceObjectID", txtest.CtxWithDBMatcher(), model.APISpecReference, api1ID).Return(nil, nil).Once()
 				specSvc.On("CreateByReferenceObjectID", txtest.CtxWithDBMatcher(), *fixApi1SpecInputs()[0], model.APISpecReference, api1ID).Return("", nil).Once()
 				specSvc.On("CreateByReferenceObjectID", txtest.CtxWithDBMatcher(), *fixApi1SpecInputs()[1], model.APISpecReference, api1ID).Return("", nil).Once()
-				specSvc.On("DeleteByReferenceObjectID", txtest.CtxWithDBMatcher(), model.APISpecReference, api2ID).Return(nil).Once()
+				specSvc.On("ListByReferenceObjectID", txtest.CtxWithDBMatcher(), model.APISpecReference, api2ID).Return(nil, nil).Once()
 				specSvc.On("CreateByReferenceObjectID", txtest.CtxWithDBMatcher(), *fixApi2SpecInputs()[0], model.APISpecReference, api2ID).Return("", nil).Once()
 
-				specSvc.On("DeleteByReferenceObjectID", txtest.CtxWithDBMatcher(), model.EventSpecReference, event1ID).Return(testErr).Once()
+				specSvc.On("ListByReferenceObjectID", txtest.CtxWithDBMatcher(), model.EventSpecReference, event1ID).Return(nil, testErr).Once()
 				return specSvc
 			},
 			eventSvcFn: func() *automock.EventService {
@@ -661,7 +971,9 @@ func TestService_SyncORDDocuments(t *testing.T) {
 		},
 		{
 			Name:            "Does not resync resources if event spec create fails",
+			ExpectedErr:     syncErr,
 			TransactionerFn: secondTransactionNotCommited,
+			syncStatusSvcFn: failedSyncStatusSave,
 			appSvcFn:        successfulAppList,
 			webhookSvcFn:    successfulWebhookList,
 			productSvcFn:    successfulProductUpdate,
@@ -671,13 +983,13 @@ func TestService_SyncORDDocuments(t *testing.T) {
 			apiSvcFn:        successfulAPIUpdate,
 			specSvcFn: func() *automock.SpecService {
 				specSvc := &automock.SpecService{}
-				specSvc.On("DeleteByReferenceObjectID", txtest.CtxWithDBMatcher(), model.APISpecReference, api1ID).Return(nil).Once()
+				specSvc.On("ListByReferenceObjectID", txtest.CtxWithDBMatcher(), model.APISpecReference, api1ID).Return(nil, nil).Once()
 				specSvc.On("CreateByReferenceObjectID", txtest.CtxWithDBMatcher(), *fixApi1SpecInputs()[0], model.APISpecReference, api1ID).Return("", nil).Once()
 				specSvc.On("CreateByReferenceObjectID", txtest.CtxWithDBMatcher(), *fixApi1SpecInputs()[1], model.APISpecReference, api1ID).Return("", nil).Once()
-				specSvc.On("DeleteByReferenceObjectID", txtest.CtxWithDBMatcher(), model.APISpecReference, api2ID).Return(nil).Once()
+				specSvc.On("ListByReferenceObjectID", txtest.CtxWithDBMatcher(), model.APISpecReference, api2ID).Return(nil, nil).Once()
 				specSvc.On("CreateByReferenceObjectID", txtest.CtxWithDBMatcher(), *fixApi2SpecInputs()[0], model.APISpecReference, api2ID).Return("", nil).Once()
 
-				specSvc.On("DeleteByReferenceObjectID", txtest.CtxWithDBMatcher(), model.EventSpecReference, event1ID).Return(nil).Once()
+				specSvc.On("ListByReferenceObjectID", txtest.CtxWithDBMatcher(), model.EventSpecReference, event1ID).Return(nil, nil).Once()
 				specSvc.On("CreateByReferenceObjectID", txtest.CtxWithDBMatcher(), *fixEvent1SpecInputs()[0], model.EventSpecReference, event1ID).Return("", testErr).Once()
 				return specSvc
 			},
@@ -691,7 +1003,9 @@ func TestService_SyncORDDocuments(t *testing.T) {
 		},
 		{
 			Name:            "Does not resync resources if tombstone list fails",
+			ExpectedErr:     syncErr,
 			TransactionerFn: secondTransactionNotCommited,
+			syncStatusSvcFn: failedSyncStatusSave,
 			appSvcFn:        successfulAppList,
 			webhookSvcFn:    successfulWebhookList,
 			productSvcFn:    successfulProductUpdate,
@@ -710,7 +1024,9 @@ func TestService_SyncORDDocuments(t *testing.T) {
 		},
 		{
 			Name:            "Does not resync resources if tombstone update fails",
+			ExpectedErr:     syncErr,
 			TransactionerFn: secondTransactionNotCommited,
+			syncStatusSvcFn: failedSyncStatusSave,
 			appSvcFn:        successfulAppList,
 			webhookSvcFn:    successfulWebhookList,
 			productSvcFn:    successfulProductUpdate,
@@ -730,7 +1046,9 @@ func TestService_SyncORDDocuments(t *testing.T) {
 		},
 		{
 			Name:            "Does not resync resources if tombstone create fails",
+			ExpectedErr:     syncErr,
 			TransactionerFn: secondTransactionNotCommited,
+			syncStatusSvcFn: failedSyncStatusSave,
 			appSvcFn:        successfulAppList,
 			webhookSvcFn:    successfulWebhookList,
 			productSvcFn:    successfulProductCreate,
@@ -749,7 +1067,9 @@ func TestService_SyncORDDocuments(t *testing.T) {
 		},
 		{
 			Name:            "Does not resync resources if api resource deletion due to tombstone fails",
+			ExpectedErr:     syncErr,
 			TransactionerFn: secondTransactionNotCommited,
+			syncStatusSvcFn: failedSyncStatusSave,
 			appSvcFn:        successfulAppList,
 			webhookSvcFn:    successfulWebhookList,
 			bundleSvcFn:     successfulBundleCreate,
@@ -777,7 +1097,9 @@ func TestService_SyncORDDocuments(t *testing.T) {
 		},
 		{
 			Name:            "Does not resync resources if package resource deletion due to tombstone fails",
+			ExpectedErr:     syncErr,
 			TransactionerFn: secondTransactionNotCommited,
+			syncStatusSvcFn: failedSyncStatusSave,
 			appSvcFn:        successfulAppList,
 			webhookSvcFn:    successfulWebhookList,
 			bundleSvcFn:     successfulBundleCreate,
@@ -808,13 +1130,15 @@ func TestService_SyncORDDocuments(t *testing.T) {
 				client := &automock.Client{}
 				doc := fixORDDocument()
 				doc.Tombstones[0].OrdID = packageORDID
-				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), baseURL).Return(open_resource_discovery.Documents{doc}, nil)
+				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), fixWebhooks()[0], noPreviousDocuments).Return(open_resource_discovery.Documents{doc}, nil)
 				return client
 			},
 		},
 		{
 			Name:            "Does not resync resources if event resource deletion due to tombstone fails",
+			ExpectedErr:     syncErr,
 			TransactionerFn: secondTransactionNotCommited,
+			syncStatusSvcFn: failedSyncStatusSave,
 			appSvcFn:        successfulAppList,
 			webhookSvcFn:    successfulWebhookList,
 			bundleSvcFn:     successfulBundleCreate,
@@ -846,13 +1170,15 @@ func TestService_SyncORDDocuments(t *testing.T) {
 				client := &automock.Client{}
 				doc := fixORDDocument()
 				doc.Tombstones[0].OrdID = event1ORDID
-				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), baseURL).Return(open_resource_discovery.Documents{doc}, nil)
+				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), fixWebhooks()[0], noPreviousDocuments).Return(open_resource_discovery.Documents{doc}, nil)
 				return client
 			},
 		},
 		{
 			Name:            "Does not resync resources if vendor resource deletion due to tombstone fails",
+			ExpectedErr:     syncErr,
 			TransactionerFn: secondTransactionNotCommited,
+			syncStatusSvcFn: failedSyncStatusSave,
 			appSvcFn:        successfulAppList,
 			webhookSvcFn:    successfulWebhookList,
 			bundleSvcFn:     successfulBundleCreate,
@@ -883,13 +1209,15 @@ func TestService_SyncORDDocuments(t *testing.T) {
 				client := &automock.Client{}
 				doc := fixORDDocument()
 				doc.Tombstones[0].OrdID = vendorORDID
-				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), baseURL).Return(open_resource_discovery.Documents{doc}, nil)
+				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), fixWebhooks()[0], noPreviousDocuments).Return(open_resource_discovery.Documents{doc}, nil)
 				return client
 			},
 		},
 		{
 			Name:            "Does not resync resources if product resource deletion due to tombstone fails",
+			ExpectedErr:     syncErr,
 			TransactionerFn: secondTransactionNotCommited,
+			syncStatusSvcFn: failedSyncStatusSave,
 			appSvcFn:        successfulAppList,
 			webhookSvcFn:    successfulWebhookList,
 			bundleSvcFn:     successfulBundleCreate,
@@ -920,13 +1248,15 @@ func TestService_SyncORDDocuments(t *testing.T) {
 				client := &automock.Client{}
 				doc := fixORDDocument()
 				doc.Tombstones[0].OrdID = productORDID
-				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), baseURL).Return(open_resource_discovery.Documents{doc}, nil)
+				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), fixWebhooks()[0], noPreviousDocuments).Return(open_resource_discovery.Documents{doc}, nil)
 				return client
 			},
 		},
 		{
 			Name:            "Does not resync resources if bundle resource deletion due to tombstone fails",
+			ExpectedErr:     syncErr,
 			TransactionerFn: secondTransactionNotCommited,
+			syncStatusSvcFn: failedSyncStatusSave,
 			appSvcFn:        successfulAppList,
 			webhookSvcFn:    successfulWebhookList,
 			bundleSvcFn: func() *automock.BundleService {
@@ -957,7 +1287,7 @@ func TestService_SyncORDDocuments(t *testing.T) {
 				client := &automock.Client{}
 				doc := fixORDDocument()
 				doc.Tombstones[0].OrdID = bundleORDID
-				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), baseURL).Return(open_resource_discovery.Documents{doc}, nil)
+				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), fixWebhooks()[0], noPreviousDocuments).Return(open_resource_discovery.Documents{doc}, nil)
 				return client
 			},
 		},
@@ -1006,12 +1336,20 @@ func TestService_SyncORDDocuments(t *testing.T) {
 			if test.tombstoneSvcFn != nil {
 				tombstoneSvc = test.tombstoneSvcFn()
 			}
+			syncStatusSvc := &automock.SyncStatusService{}
+			if test.syncStatusSvcFn != nil {
+				syncStatusSvc = test.syncStatusSvcFn()
+			}
+			labelRepo := &automock.LabelRepository{}
+			if test.labelRepoFn != nil {
+				labelRepo = test.labelRepoFn()
+			}
 			client := &automock.Client{}
 			if test.clientFn != nil {
 				client = test.clientFn()
 			}
 
-			svc := open_resource_discovery.NewAggregatorService(tx, appSvc, whSvc, bndlSvc, apiSvc, eventSvc, specSvc, packageSvc, productSvc, vendorSvc, tombstoneSvc, client)
+			svc := open_resource_discovery.NewAggregatorService(fixSyncConfig(), tx, appSvc, whSvc, bndlSvc, apiSvc, eventSvc, specSvc, packageSvc, productSvc, vendorSvc, tombstoneSvc, syncStatusSvc, labelRepo, &automock.LeaseRepository{}, client)
 			err := svc.SyncORDDocuments(context.TODO())
 			if test.ExpectedErr != nil {
 				require.Error(t, err)
@@ -1020,7 +1358,7 @@ func TestService_SyncORDDocuments(t *testing.T) {
 				require.NoError(t, err)
 			}
 
-			mock.AssertExpectationsForObjects(t, tx, appSvc, whSvc, bndlSvc, apiSvc, eventSvc, specSvc, packageSvc, productSvc, vendorSvc, tombstoneSvc, client)
+			mock.AssertExpectationsForObjects(t, tx, appSvc, whSvc, bndlSvc, apiSvc, eventSvc, specSvc, packageSvc, productSvc, vendorSvc, tombstoneSvc, syncStatusSvc, labelRepo, &automock.LeaseRepository{}, client)
 		})
 	}
 }
@@ -0,0 +1,345 @@
+package open_resource_discovery
+
+import (
+	"context"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/tenant"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+	"github.com/pkg/errors"
+)
+
+const (
+	// ResyncIntervalLabelKey is the application label which overrides the default resync interval, e.g. "30m"
+	ResyncIntervalLabelKey = "ordResyncInterval"
+
+	// syncLeaseName is the name of the job lease which ensures that the ORD documents are synchronized by a single aggregator replica
+	syncLeaseName = "ord-synchronization"
+)
+
+var errMissingORDWebhook = errors.New("application has no ORD webhook")
+
+// SyncConfig configures how often and how concurrently the ORD documents of the applications are synchronized
+type SyncConfig struct {
+	Workers        int           `envconfig:"default=5"`
+	PollInterval   time.Duration `envconfig:"default=30s"`
+	ResyncInterval time.Duration `envconfig:"default=1h"`
+	Jitter         time.Duration `envconfig:"default=5m"`
+	MinBackoff     time.Duration `envconfig:"default=1m"`
+	MaxBackoff     time.Duration `envconfig:"default=1h"`
+}
+
+// Run synchronizes the ORD documents of the applications until the context is cancelled.
+// Every PollInterval the applications which are due are handed to the worker pool. Successfully synchronized
+// applications are scheduled again after their resync interval, failing ones are retried with exponential backoff.
+// The applications are synchronized only by the replica which holds the lease of the job, the other replicas wait for it to expire.
+// The lease is held for two poll intervals and renewed every poll interval, so that it is taken over only if its holder has stopped.
+func (s *Service) Run(ctx context.Context) error {
+	jobs := make(chan *model.Application)
+	sched := newSchedule()
+	leading := false
+	wg := s.startWorkers(ctx, jobs, func(app *model.Application, next time.Time, _ error) {
+		sched.release(app.ID, next)
+	})
+	defer func() {
+		close(jobs)
+		wg.Wait()
+	}()
+
+	ticker := time.NewTicker(s.cfg.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		if s.holdLease(ctx) {
+			if !leading {
+				// The schedule is loaded whenever the lease is taken over, as the previous holder has synchronized the applications since
+				if err := s.loadSchedule(ctx, sched); err != nil {
+					return err
+				}
+				leading = true
+			}
+			s.scheduleDueApps(ctx, sched, jobs)
+		} else if leading {
+			log.C(ctx).Info("The lease of ORD synchronization has been lost, the applications are synchronized by another instance")
+			leading = false
+		}
+
+		select {
+		case <-ctx.Done():
+			log.C(ctx).Info("Stopping ORD synchronization")
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// scheduleDueApps hands the applications which are due to the workers, as long as the lease of the synchronization is held
+func (s *Service) scheduleDueApps(ctx context.Context, sched *schedule, jobs chan<- *model.Application) {
+	apps, err := s.listApps(ctx)
+	if err != nil {
+		log.C(ctx).WithError(err).Error("Error while listing applications for ORD synchronization")
+		return
+	}
+
+	renewal := time.NewTicker(s.cfg.PollInterval)
+	defer renewal.Stop()
+
+	now := time.Now()
+	for _, app := range apps {
+		if !sched.acquireIfDue(app.ID, now) {
+			continue
+		}
+
+		for scheduled := false; !scheduled; {
+			select {
+			case jobs <- app:
+				scheduled = true
+			case <-renewal.C:
+				if !s.holdLease(ctx) {
+					sched.release(app.ID, now)
+					return
+				}
+			case <-ctx.Done():
+				sched.release(app.ID, now)
+				return
+			}
+		}
+	}
+}
+
+// holdLease acquires or renews the lease of the synchronization and reports whether it is held
+func (s *Service) holdLease(ctx context.Context) bool {
+	tx, err := s.transact.Begin()
+	if err != nil {
+		log.C(ctx).WithError(err).Error("Error while opening transaction to acquire the lease of ORD synchronization")
+		return false
+	}
+	defer s.transact.RollbackUnlessCommitted(ctx, tx)
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	now := time.Now()
+	acquired, err := s.leaseRepo.TryAcquireGlobal(ctx, syncLeaseName, s.holder, now, now.Add(2*s.cfg.PollInterval))
+	if err != nil {
+		log.C(ctx).WithError(err).Error("Error while acquiring the lease of ORD synchronization")
+		return false
+	}
+
+	if err := tx.Commit(); err != nil {
+		log.C(ctx).WithError(err).Error("Error while committing the lease of ORD synchronization")
+		return false
+	}
+
+	return acquired
+}
+
+// loadSchedule replaces the times at which the applications should be synchronized next with the ones persisted in their sync statuses
+func (s *Service) loadSchedule(ctx context.Context, sched *schedule) error {
+	tx, err := s.transact.Begin()
+	if err != nil {
+		return err
+	}
+	defer s.transact.RollbackUnlessCommitted(ctx, tx)
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	statuses, err := s.syncStatusSvc.ListGlobal(ctx)
+	if err != nil {
+		return errors.Wrap(err, "error while loading ORD sync statuses")
+	}
+
+	next := make(map[string]time.Time, len(statuses))
+	for _, status := range statuses {
+		if status.NextSyncAt != nil {
+			next[status.ApplicationID] = *status.NextSyncAt
+		}
+	}
+	sched.reset(next)
+
+	return tx.Commit()
+}
+
+// startWorkers starts the configured number of workers which synchronize the applications received on the jobs channel.
+// The onDone callback is invoked with the time at which each application should be synchronized next and the error of its synchronization.
+func (s *Service) startWorkers(ctx context.Context, jobs <-chan *model.Application, onDone func(app *model.Application, next time.Time, err error)) *sync.WaitGroup {
+	workers := s.cfg.Workers
+	if workers < 1 {
+		workers = 1
+	}
+
+	wg := &sync.WaitGroup{}
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for app := range jobs {
+				next, err := s.syncApp(ctx, app)
+				onDone(app, next, err)
+			}
+		}()
+	}
+
+	return wg
+}
+
+// syncApp synchronizes a single application, persists its sync status and returns the time of its next synchronization
+// together with the error of the synchronization or of persisting its status
+func (s *Service) syncApp(ctx context.Context, app *model.Application) (time.Time, error) {
+	result, err := s.processApp(ctx, app)
+	if err == errMissingORDWebhook {
+		return time.Now().Add(s.cfg.ResyncInterval + s.jitter()), nil
+	}
+	if err != nil {
+		log.C(ctx).WithError(err).Errorf("Error while synchronizing ORD documents for app %q", app.ID)
+		err = errors.Wrapf(err, "error while synchronizing ORD documents for app %q", app.ID)
+	}
+
+	status, statusErr := s.saveSyncStatus(ctx, app, result, err)
+	if statusErr != nil {
+		log.C(ctx).WithError(statusErr).Errorf("Error while saving ORD sync status for app %q", app.ID)
+		return time.Now().Add(s.cfg.MinBackoff), multierror.Append(err, errors.Wrapf(statusErr, "error while saving ORD sync status for app %q", app.ID))
+	}
+
+	return *status.NextSyncAt, err
+}
+
+func (s *Service) saveSyncStatus(ctx context.Context, app *model.Application, result *syncResult, syncErr error) (*model.ORDSyncStatus, error) {
+	tx, err := s.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer s.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+	ctx = tenant.SaveToContext(ctx, app.Tenant, "")
+
+	status, err := s.syncStatusSvc.GetByApplicationID(ctx, app.ID)
+	if err != nil {
+		if !apperrors.IsNotFoundError(err) {
+			return nil, err
+		}
+		status = &model.ORDSyncStatus{ApplicationID: app.ID}
+	}
+
+	now := time.Now()
+	status.LastSyncAt = &now
+
+	var next time.Time
+	if syncErr == nil {
+		interval, err := s.resyncInterval(ctx, app)
+		if err != nil {
+			return nil, err
+		}
+
+		status.LastSuccessAt = &now
+		status.LastError = nil
+		status.ConsecutiveFailures = 0
+		status.Documents = result.documents
+		status.ResourceHashes = result.resourceHashes
+		next = now.Add(interval + s.jitter())
+	} else {
+		errMsg := syncErr.Error()
+		status.LastError = &errMsg
+		status.ConsecutiveFailures++
+		next = now.Add(s.backoff(status.ConsecutiveFailures) + s.jitter())
+	}
+	status.NextSyncAt = &next
+
+	if err := s.syncStatusSvc.Upsert(ctx, status); err != nil {
+		return nil, err
+	}
+
+	return status, tx.Commit()
+}
+
+// resyncInterval returns the interval from the ResyncIntervalLabelKey label of the application or the default one
+func (s *Service) resyncInterval(ctx context.Context, app *model.Application) (time.Duration, error) {
+	label, err := s.labelRepo.GetByKey(ctx, app.Tenant, model.ApplicationLabelableObject, app.ID, ResyncIntervalLabelKey)
+	if err != nil {
+		if apperrors.IsNotFoundError(err) {
+			return s.cfg.ResyncInterval, nil
+		}
+		return 0, errors.Wrapf(err, "while getting label %q for app %q", ResyncIntervalLabelKey, app.ID)
+	}
+
+	value, ok := label.Value.(string)
+	if !ok {
+		log.C(ctx).Warnf("Label %q of app %q is not a string, using the default resync interval", ResyncIntervalLabelKey, app.ID)
+		return s.cfg.ResyncInterval, nil
+	}
+
+	interval, err := time.ParseDuration(value)
+	if err != nil || interval <= 0 {
+		log.C(ctx).Warnf("Label %q of app %q is not a valid duration, using the default resync interval", ResyncIntervalLabelKey, app.ID)
+		return s.cfg.ResyncInterval, nil
+	}
+
+	return interval, nil
+}
+
+func (s *Service) backoff(failures int) time.Duration {
+	backoff := s.cfg.MinBackoff
+	for i := 1; i < failures && backoff < s.cfg.MaxBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > s.cfg.MaxBackoff {
+		return s.cfg.MaxBackoff
+	}
+	return backoff
+}
+
+func (s *Service) jitter() time.Duration {
+	if s.cfg.Jitter <= 0 {
+		return 0
+	}
+	return time.Duration(rand.Int63n(int64(s.cfg.Jitter)))
+}
+
+// schedule keeps track of when each application should be synchronized next and which ones are being synchronized
+type schedule struct {
+	mu       sync.Mutex
+	next     map[string]time.Time
+	inFlight map[string]bool
+}
+
+func newSchedule() *schedule {
+	return &schedule{
+		next:     make(map[string]time.Time),
+		inFlight: make(map[string]bool),
+	}
+}
+
+func (s *schedule) acquireIfDue(appID string, now time.Time) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.inFlight[appID] {
+		return false
+	}
+	if next, ok := s.next[appID]; ok && next.After(now) {
+		return false
+	}
+
+	s.inFlight[appID] = true
+	return true
+}
+
+// reset replaces the times at which the applications should be synchronized next, keeping track of the ones being synchronized
+func (s *schedule) reset(next map[string]time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.next = next
+}
+
+func (s *schedule) release(appID string, next time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.inFlight, appID)
+	s.next[appID] = next
+}
@@ -0,0 +1,194 @@
+package open_resource_discovery_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/open_resource_discovery"
+	"github.com/kyma-incubator/compass/components/director/internal/open_resource_discovery/automock"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	persistenceautomock "github.com/kyma-incubator/compass/components/director/pkg/persistence/automock"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence/txtest"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_Run(t *testing.T) {
+	testErr := errors.New("Test error")
+	var noPreviousDocuments []model.ORDDocumentStatus
+
+	transactioner := func(begins, commits int) func() *persistenceautomock.Transactioner {
+		return func() *persistenceautomock.Transactioner {
+			persistTx := &persistenceautomock.PersistenceTx{}
+			persistTx.On("Commit").Return(nil).Times(commits)
+
+			transact := &persistenceautomock.Transactioner{}
+			transact.On("Begin").Return(persistTx, nil).Times(begins)
+			transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return().Times(begins)
+			return transact
+		}
+	}
+
+	successfulAppList := func() *automock.ApplicationService {
+		appSvc := &automock.ApplicationService{}
+		appSvc.On("ListGlobal", txtest.CtxWithDBMatcher(), 200, "").Return(fixApplicationPage(), nil).Once()
+		return appSvc
+	}
+
+	successfulWebhookList := func() *automock.WebhookService {
+		whSvc := &automock.WebhookService{}
+		whSvc.On("ListForApplication", txtest.CtxWithDBMatcher(), appID).Return(fixWebhooks(), nil).Once()
+		return whSvc
+	}
+
+	acquiredLease := func(context.CancelFunc) *automock.LeaseRepository {
+		leaseRepo := &automock.LeaseRepository{}
+		leaseRepo.On("TryAcquireGlobal", txtest.CtxWithDBMatcher(), "ord-synchronization", mock.AnythingOfType("string"), mock.AnythingOfType("time.Time"), mock.AnythingOfType("time.Time")).Return(true, nil).Once()
+		return leaseRepo
+	}
+
+	nextSyncWithin := func(status *model.ORDSyncStatus, from, to time.Duration) bool {
+		if status.NextSyncAt == nil {
+			return false
+		}
+		now := time.Now()
+		return status.NextSyncAt.After(now.Add(from-time.Minute)) && status.NextSyncAt.Before(now.Add(to))
+	}
+
+	testCases := []struct {
+		Name            string
+		TransactionerFn func() *persistenceautomock.Transactioner
+		appSvcFn        func() *automock.ApplicationService
+		webhookSvcFn    func() *automock.WebhookService
+		syncStatusSvcFn func(cancel context.CancelFunc) *automock.SyncStatusService
+		leaseRepoFn     func(cancel context.CancelFunc) *automock.LeaseRepository
+		labelRepoFn     func() *automock.LabelRepository
+		clientFn        func() *automock.Client
+		ExpectedErr     error
+	}{
+		{
+			Name:            "Synchronizes due application and schedules it after the resync interval from its label",
+			TransactionerFn: transactioner(5, 4),
+			leaseRepoFn:     acquiredLease,
+			appSvcFn:        successfulAppList,
+			webhookSvcFn:    successfulWebhookList,
+			syncStatusSvcFn: func(cancel context.CancelFunc) *automock.SyncStatusService {
+				syncStatusSvc := &automock.SyncStatusService{}
+				syncStatusSvc.On("ListGlobal", txtest.CtxWithDBMatcher()).Return(nil, nil).Once()
+				syncStatusSvc.On("GetByApplicationID", txtest.CtxWithDBMatcher(), appID).Return(nil, apperrors.NewNotFoundError(resource.ORDSyncStatus, appID)).Twice()
+				syncStatusSvc.On("Upsert", txtest.CtxWithDBMatcher(), mock.MatchedBy(func(status *model.ORDSyncStatus) bool {
+					return status.LastSuccessAt != nil && status.ConsecutiveFailures == 0 && nextSyncWithin(status, 30*time.Minute, 31*time.Minute)
+				})).Return(nil).Run(func(mock.Arguments) { cancel() }).Once()
+				return syncStatusSvc
+			},
+			labelRepoFn: func() *automock.LabelRepository {
+				labelRepo := &automock.LabelRepository{}
+				labelRepo.On("GetByKey", txtest.CtxWithDBMatcher(), tenantID, model.ApplicationLabelableObject, appID, open_resource_discovery.ResyncIntervalLabelKey).Return(&model.Label{Value: "30m"}, nil).Once()
+				return labelRepo
+			},
+			clientFn: func() *automock.Client {
+				client := &automock.Client{}
+				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), fixWebhooks()[0], noPreviousDocuments).Return(open_resource_discovery.Documents{}, nil).Once()
+				return client
+			},
+		},
+		{
+			Name:            "Backs off exponentially when the application keeps failing",
+			TransactionerFn: transactioner(5, 4),
+			leaseRepoFn:     acquiredLease,
+			appSvcFn:        successfulAppList,
+			webhookSvcFn:    successfulWebhookList,
+			syncStatusSvcFn: func(cancel context.CancelFunc) *automock.SyncStatusService {
+				syncStatusSvc := &automock.SyncStatusService{}
+				syncStatusSvc.On("ListGlobal", txtest.CtxWithDBMatcher()).Return(nil, nil).Once()
+				syncStatusSvc.On("GetByApplicationID", txtest.CtxWithDBMatcher(), appID).Return(&model.ORDSyncStatus{ApplicationID: appID, ConsecutiveFailures: 2}, nil).Twice()
+				syncStatusSvc.On("Upsert", txtest.CtxWithDBMatcher(), mock.MatchedBy(func(status *model.ORDSyncStatus) bool {
+					return status.LastError != nil && status.ConsecutiveFailures == 3 && nextSyncWithin(status, 4*time.Minute, 5*time.Minute)
+				})).Return(nil).Run(func(mock.Arguments) { cancel() }).Once()
+				return syncStatusSvc
+			},
+			clientFn: func() *automock.Client {
+				client := &automock.Client{}
+				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), fixWebhooks()[0], noPreviousDocuments).Return(nil, testErr).Once()
+				return client
+			},
+		},
+		{
+			Name:            "Skips application which is not due",
+			TransactionerFn: transactioner(3, 3),
+			leaseRepoFn:     acquiredLease,
+			appSvcFn:        successfulAppList,
+			syncStatusSvcFn: func(context.CancelFunc) *automock.SyncStatusService {
+				next := time.Now().Add(time.Hour)
+				syncStatusSvc := &automock.SyncStatusService{}
+				syncStatusSvc.On("ListGlobal", txtest.CtxWithDBMatcher()).Return([]*model.ORDSyncStatus{{ApplicationID: appID, NextSyncAt: &next}}, nil).Once()
+				return syncStatusSvc
+			},
+		},
+		{
+			Name:            "Returns error when sync statuses cannot be loaded",
+			TransactionerFn: transactioner(2, 1),
+			leaseRepoFn:     acquiredLease,
+			syncStatusSvcFn: func(context.CancelFunc) *automock.SyncStatusService {
+				syncStatusSvc := &automock.SyncStatusService{}
+				syncStatusSvc.On("ListGlobal", txtest.CtxWithDBMatcher()).Return(nil, testErr).Once()
+				return syncStatusSvc
+			},
+			ExpectedErr: testErr,
+		},
+		{
+			Name:            "Does not synchronize applications when the lease is held by another instance",
+			TransactionerFn: transactioner(1, 1),
+			syncStatusSvcFn: func(context.CancelFunc) *automock.SyncStatusService {
+				return &automock.SyncStatusService{}
+			},
+			leaseRepoFn: func(cancel context.CancelFunc) *automock.LeaseRepository {
+				leaseRepo := &automock.LeaseRepository{}
+				leaseRepo.On("TryAcquireGlobal", txtest.CtxWithDBMatcher(), "ord-synchronization", mock.AnythingOfType("string"), mock.AnythingOfType("time.Time"), mock.AnythingOfType("time.Time")).Return(false, nil).Run(func(mock.Arguments) { cancel() }).Once()
+				return leaseRepo
+			},
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.Name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.TODO(), time.Second)
+			defer cancel()
+
+			tx := test.TransactionerFn()
+			appSvc := &automock.ApplicationService{}
+			if test.appSvcFn != nil {
+				appSvc = test.appSvcFn()
+			}
+			whSvc := &automock.WebhookService{}
+			if test.webhookSvcFn != nil {
+				whSvc = test.webhookSvcFn()
+			}
+			syncStatusSvc := test.syncStatusSvcFn(cancel)
+			leaseRepo := test.leaseRepoFn(cancel)
+			labelRepo := &automock.LabelRepository{}
+			if test.labelRepoFn != nil {
+				labelRepo = test.labelRepoFn()
+			}
+			client := &automock.Client{}
+			if test.clientFn != nil {
+				client = test.clientFn()
+			}
+
+			svc := open_resource_discovery.NewAggregatorService(fixSyncConfig(), tx, appSvc, whSvc, &automock.BundleService{}, &automock.APIService{}, &automock.EventService{}, &automock.SpecService{}, &automock.PackageService{}, &automock.ProductService{}, &automock.VendorService{}, &automock.TombstoneService{}, syncStatusSvc, labelRepo, leaseRepo, client)
+			err := svc.Run(ctx)
+			if test.ExpectedErr != nil {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), test.ExpectedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+
+			mock.AssertExpectationsForObjects(t, tx, appSvc, whSvc, syncStatusSvc, leaseRepo, labelRepo, client)
+		})
+	}
+}
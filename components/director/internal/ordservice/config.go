@@ -0,0 +1,12 @@
+package ordservice
+
+// Config holds the paths on which the ORD service and the specifications it references are served
+type Config struct {
+	APIEndpoint    string `envconfig:"default=/open-resource-discovery-service/v0"`
+	StaticEndpoint string `envconfig:"default=/open-resource-discovery-static/v0"`
+
+	// PageSize is the number of entities fetched from the database at once while building a collection; it may not exceed 200
+	PageSize int `envconfig:"default=100"`
+	// MaxPageSize is the maximum number of entities returned in a single collection response; larger results are paged with $top and $skip
+	MaxPageSize int `envconfig:"default=1000"`
+}
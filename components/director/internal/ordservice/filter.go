@@ -0,0 +1,148 @@
+package ordservice
+
+import (
+	"encoding/json"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/pkg/errors"
+)
+
+const (
+	operatorEqual    = "eq"
+	operatorNotEqual = "ne"
+	conjunctionAnd   = "and"
+)
+
+type condition struct {
+	field    string
+	operator string
+	value    interface{}
+}
+
+// Filter is a conjunction of OData-style comparisons, e.g. "title eq 'foo' and disabled ne true".
+// Only the eq and ne operators are supported. Values can be single-quoted strings, booleans, numbers or null.
+type Filter []condition
+
+// ParseFilter parses the value of a $filter query parameter
+func ParseFilter(raw string) (Filter, error) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return nil, nil
+	}
+
+	tokens, err := tokenize(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	if (len(tokens)+1)%4 != 0 {
+		return nil, apperrors.NewInvalidDataError("incomplete $filter expression %q", raw)
+	}
+
+	var filter Filter
+	for i := 0; i < len(tokens); i += 4 {
+		if i > 0 && strings.ToLower(tokens[i-1]) != conjunctionAnd {
+			return nil, apperrors.NewInvalidDataError("unsupported $filter conjunction %q", tokens[i-1])
+		}
+
+		operator := strings.ToLower(tokens[i+1])
+		if operator != operatorEqual && operator != operatorNotEqual {
+			return nil, apperrors.NewInvalidDataError("unsupported $filter operator %q", tokens[i+1])
+		}
+
+		value, err := parseValue(tokens[i+2])
+		if err != nil {
+			return nil, err
+		}
+
+		filter = append(filter, condition{field: tokens[i], operator: operator, value: value})
+	}
+
+	return filter, nil
+}
+
+// Match reports whether the JSON representation of the given item satisfies all the conditions of the filter
+func (f Filter) Match(item interface{}) (bool, error) {
+	if len(f) == 0 {
+		return true, nil
+	}
+
+	marshalled, err := json.Marshal(item)
+	if err != nil {
+		return false, errors.Wrap(err, "while marshalling item")
+	}
+
+	fields := make(map[string]interface{})
+	if err := json.Unmarshal(marshalled, &fields); err != nil {
+		return false, errors.Wrap(err, "while unmarshalling item")
+	}
+
+	for _, cond := range f {
+		equal := reflect.DeepEqual(fields[cond.field], cond.value)
+		if (cond.operator == operatorEqual) != equal {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+func tokenize(raw string) ([]string, error) {
+	var tokens []string
+	var current strings.Builder
+	inQuotes := false
+
+	for i := 0; i < len(raw); i++ {
+		c := raw[i]
+		switch {
+		case c == '\'' && inQuotes && i+1 < len(raw) && raw[i+1] == '\'':
+			// two single quotes inside a string literal represent an escaped single quote
+			current.WriteString("''")
+			i++
+		case c == '\'':
+			inQuotes = !inQuotes
+			current.WriteByte(c)
+		case c == ' ' && !inQuotes:
+			if current.Len() > 0 {
+				tokens = append(tokens, current.String())
+				current.Reset()
+			}
+		default:
+			current.WriteByte(c)
+		}
+	}
+
+	if inQuotes {
+		return nil, apperrors.NewInvalidDataError("unterminated string literal in $filter expression %q", raw)
+	}
+	if current.Len() > 0 {
+		tokens = append(tokens, current.String())
+	}
+
+	return tokens, nil
+}
+
+func parseValue(token string) (interface{}, error) {
+	if strings.HasPrefix(token, "'") && strings.HasSuffix(token, "'") && len(token) >= 2 {
+		return strings.ReplaceAll(token[1:len(token)-1], "''", "'"), nil
+	}
+
+	switch token {
+	case "true":
+		return true, nil
+	case "false":
+		return false, nil
+	case "null":
+		return nil, nil
+	}
+
+	number, err := strconv.ParseFloat(token, 64)
+	if err != nil {
+		return nil, apperrors.NewInvalidDataError("invalid $filter value %q", token)
+	}
+
+	return number, nil
+}
@@ -0,0 +1,134 @@
+package ordservice_test
+
+import (
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/ordservice"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseFilter(t *testing.T) {
+	testCases := []struct {
+		Name               string
+		Input              string
+		ExpectedErrMessage string
+	}{
+		{
+			Name:  "Empty filter",
+			Input: "",
+		},
+		{
+			Name:  "Single condition",
+			Input: "title eq 'foo'",
+		},
+		{
+			Name:  "Multiple conditions",
+			Input: "title eq 'foo bar' and disabled ne true and version eq null",
+		},
+		{
+			Name:               "Unsupported operator",
+			Input:              "title gt 'foo'",
+			ExpectedErrMessage: "unsupported $filter operator",
+		},
+		{
+			Name:               "Unsupported conjunction",
+			Input:              "title eq 'foo' or title eq 'bar'",
+			ExpectedErrMessage: "unsupported $filter conjunction",
+		},
+		{
+			Name:               "Incomplete expression",
+			Input:              "title eq 'foo' and",
+			ExpectedErrMessage: "incomplete $filter expression",
+		},
+		{
+			Name:               "Unterminated string literal",
+			Input:              "title eq 'foo",
+			ExpectedErrMessage: "unterminated string literal",
+		},
+		{
+			Name:               "Invalid value",
+			Input:              "title eq foo",
+			ExpectedErrMessage: "invalid $filter value",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// when
+			_, err := ordservice.ParseFilter(testCase.Input)
+
+			// then
+			if testCase.ExpectedErrMessage == "" {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErrMessage)
+			}
+		})
+	}
+}
+
+func TestFilter_Match(t *testing.T) {
+	item := struct {
+		Title    string  `json:"title"`
+		Disabled bool    `json:"disabled"`
+		Count    int     `json:"count"`
+		Version  *string `json:"version"`
+	}{
+		Title:    "it's foo",
+		Disabled: false,
+		Count:    3,
+	}
+
+	testCases := []struct {
+		Name     string
+		Input    string
+		Expected bool
+	}{
+		{
+			Name:     "Matches empty filter",
+			Input:    "",
+			Expected: true,
+		},
+		{
+			Name:     "Matches escaped string",
+			Input:    "title eq 'it''s foo'",
+			Expected: true,
+		},
+		{
+			Name:     "Matches all conditions",
+			Input:    "disabled eq false and count eq 3 and version eq null",
+			Expected: true,
+		},
+		{
+			Name:     "Does not match not equal condition",
+			Input:    "title ne 'it''s foo'",
+			Expected: false,
+		},
+		{
+			Name:     "Does not match when one condition fails",
+			Input:    "disabled eq false and count eq 4",
+			Expected: false,
+		},
+		{
+			Name:     "Does not match unknown field",
+			Input:    "unknown eq 'foo'",
+			Expected: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			filter, err := ordservice.ParseFilter(testCase.Input)
+			require.NoError(t, err)
+
+			// when
+			matches, err := filter.Match(item)
+
+			// then
+			require.NoError(t, err)
+			assert.Equal(t, testCase.Expected, matches)
+		})
+	}
+}
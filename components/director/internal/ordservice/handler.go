@@ -0,0 +1,586 @@
+package ordservice
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/gorilla/mux"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/httputils"
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/pkg/errors"
+)
+
+const (
+	IDParam     = "id"
+	SpecIDParam = "spec_id"
+
+	filterParam = "$filter"
+	expandParam = "$expand"
+	topParam    = "$top"
+	skipParam   = "$skip"
+
+	// expandResourceDefinitions inlines the specification content in the resourceDefinitions of APIs and Events
+	expandResourceDefinitions = "resourceDefinitions"
+
+	apiResourceType   = "api"
+	eventResourceType = "event"
+)
+
+// listFunc returns the page of a collection which starts at the cursor, along with the information about the following page
+type listFunc func(ctx context.Context, pageSize int, cursor string, expand bool) ([]interface{}, *pagination.Page, error)
+type getFunc func(ctx context.Context, id string, expand bool) (interface{}, error)
+
+// Handler serves the aggregated Open Resource Discovery information of the tenant in the request context
+type Handler struct {
+	transact persistence.Transactioner
+
+	appSvc     ApplicationService
+	bundleSvc  BundleService
+	apiSvc     APIService
+	eventSvc   EventService
+	specSvc    SpecService
+	packageSvc PackageService
+	productSvc ProductService
+	vendorSvc  VendorService
+
+	cfg Config
+}
+
+// NewHandler creates a new ORD service handler
+func NewHandler(transact persistence.Transactioner, appSvc ApplicationService, bundleSvc BundleService, apiSvc APIService, eventSvc EventService, specSvc SpecService, packageSvc PackageService, productSvc ProductService, vendorSvc VendorService, cfg Config) *Handler {
+	return &Handler{
+		transact:   transact,
+		appSvc:     appSvc,
+		bundleSvc:  bundleSvc,
+		apiSvc:     apiSvc,
+		eventSvc:   eventSvc,
+		specSvc:    specSvc,
+		packageSvc: packageSvc,
+		productSvc: productSvc,
+		vendorSvc:  vendorSvc,
+		cfg:        cfg,
+	}
+}
+
+// RegisterRoutes registers the ORD service resources on the given router
+func (h *Handler) RegisterRoutes(router *mux.Router) {
+	router.HandleFunc("/systemInstances", h.ListSystemInstances).Methods(http.MethodGet)
+	router.HandleFunc("/systemInstances/{id}", h.GetSystemInstance).Methods(http.MethodGet)
+	router.HandleFunc("/apis", h.ListAPIs).Methods(http.MethodGet)
+	router.HandleFunc("/apis/{id}", h.GetAPI).Methods(http.MethodGet)
+	router.HandleFunc("/events", h.ListEvents).Methods(http.MethodGet)
+	router.HandleFunc("/events/{id}", h.GetEvent).Methods(http.MethodGet)
+	router.HandleFunc("/consumptionBundles", h.ListConsumptionBundles).Methods(http.MethodGet)
+	router.HandleFunc("/consumptionBundles/{id}", h.GetConsumptionBundle).Methods(http.MethodGet)
+	router.HandleFunc("/packages", h.ListPackages).Methods(http.MethodGet)
+	router.HandleFunc("/packages/{id}", h.GetPackage).Methods(http.MethodGet)
+	router.HandleFunc("/products", h.ListProducts).Methods(http.MethodGet)
+	router.HandleFunc("/products/{id}", h.GetProduct).Methods(http.MethodGet)
+	router.HandleFunc("/vendors", h.ListVendors).Methods(http.MethodGet)
+	router.HandleFunc("/vendors/{id}", h.GetVendor).Methods(http.MethodGet)
+}
+
+// RegisterStaticRoutes registers the specification endpoints referenced by the resourceDefinitions of APIs and Events
+func (h *Handler) RegisterStaticRoutes(router *mux.Router) {
+	router.HandleFunc("/api/{id}/specification/{spec_id}", h.GetAPISpecification).Methods(http.MethodGet)
+	router.HandleFunc("/event/{id}/specification/{spec_id}", h.GetEventSpecification).Methods(http.MethodGet)
+}
+
+func (h *Handler) ListSystemInstances(writer http.ResponseWriter, request *http.Request) {
+	h.serveCollection(writer, request, func(ctx context.Context, pageSize int, cursor string, _ bool) ([]interface{}, *pagination.Page, error) {
+		appPage, err := h.appSvc.List(ctx, nil, pagination.Request{PageSize: pageSize, Cursor: cursor}, model.DefaultApplicationOrderBy)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "while listing Applications")
+		}
+
+		items := make([]interface{}, 0, len(appPage.Data))
+		for _, app := range appPage.Data {
+			items = append(items, systemInstanceFromModel(app))
+		}
+		return items, appPage.PageInfo, nil
+	})
+}
+
+func (h *Handler) GetSystemInstance(writer http.ResponseWriter, request *http.Request) {
+	h.serveEntity(writer, request, func(ctx context.Context, id string, _ bool) (interface{}, error) {
+		app, err := h.appSvc.Get(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		return systemInstanceFromModel(app), nil
+	})
+}
+
+func (h *Handler) ListAPIs(writer http.ResponseWriter, request *http.Request) {
+	h.serveCollection(writer, request, func(ctx context.Context, pageSize int, cursor string, expand bool) ([]interface{}, *pagination.Page, error) {
+		apiPage, err := h.apiSvc.List(ctx, pageSize, cursor)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "while listing APIs")
+		}
+
+		apiIDs := make([]string, 0, len(apiPage.Data))
+		for _, api := range apiPage.Data {
+			apiIDs = append(apiIDs, api.ID)
+		}
+
+		specs, err := h.listSpecs(ctx, model.APISpecReference, apiIDs)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "while listing specifications for APIs")
+		}
+
+		items := make([]interface{}, 0, len(apiPage.Data))
+		for _, api := range apiPage.Data {
+			items = append(items, h.apiResource(api, specs[api.ID], expand))
+		}
+		return items, apiPage.PageInfo, nil
+	})
+}
+
+func (h *Handler) GetAPI(writer http.ResponseWriter, request *http.Request) {
+	h.serveEntity(writer, request, func(ctx context.Context, id string, expand bool) (interface{}, error) {
+		api, err := h.apiSvc.Get(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+
+		specs, err := h.specSvc.ListByReferenceObjectID(ctx, model.APISpecReference, api.ID)
+		if err != nil {
+			return nil, errors.Wrapf(err, "while listing specifications for API with id %s", api.ID)
+		}
+		return h.apiResource(api, specs, expand), nil
+	})
+}
+
+func (h *Handler) ListEvents(writer http.ResponseWriter, request *http.Request) {
+	h.serveCollection(writer, request, func(ctx context.Context, pageSize int, cursor string, expand bool) ([]interface{}, *pagination.Page, error) {
+		eventPage, err := h.eventSvc.List(ctx, pageSize, cursor)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "while listing Events")
+		}
+
+		eventIDs := make([]string, 0, len(eventPage.Data))
+		for _, event := range eventPage.Data {
+			eventIDs = append(eventIDs, event.ID)
+		}
+
+		specs, err := h.listSpecs(ctx, model.EventSpecReference, eventIDs)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "while listing specifications for Events")
+		}
+
+		items := make([]interface{}, 0, len(eventPage.Data))
+		for _, event := range eventPage.Data {
+			items = append(items, h.eventResource(event, specs[event.ID], expand))
+		}
+		return items, eventPage.PageInfo, nil
+	})
+}
+
+func (h *Handler) GetEvent(writer http.ResponseWriter, request *http.Request) {
+	h.serveEntity(writer, request, func(ctx context.Context, id string, expand bool) (interface{}, error) {
+		event, err := h.eventSvc.Get(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+
+		specs, err := h.specSvc.ListByReferenceObjectID(ctx, model.EventSpecReference, event.ID)
+		if err != nil {
+			return nil, errors.Wrapf(err, "while listing specifications for Event with id %s", event.ID)
+		}
+		return h.eventResource(event, specs, expand), nil
+	})
+}
+
+func (h *Handler) ListConsumptionBundles(writer http.ResponseWriter, request *http.Request) {
+	h.serveCollection(writer, request, func(ctx context.Context, pageSize int, cursor string, _ bool) ([]interface{}, *pagination.Page, error) {
+		bundlePage, err := h.bundleSvc.List(ctx, pageSize, cursor)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "while listing Bundles")
+		}
+
+		items := make([]interface{}, 0, len(bundlePage.Data))
+		for _, bndl := range bundlePage.Data {
+			items = append(items, consumptionBundleFromModel(bndl))
+		}
+		return items, bundlePage.PageInfo, nil
+	})
+}
+
+func (h *Handler) GetConsumptionBundle(writer http.ResponseWriter, request *http.Request) {
+	h.serveEntity(writer, request, func(ctx context.Context, id string, _ bool) (interface{}, error) {
+		bndl, err := h.bundleSvc.Get(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		return consumptionBundleFromModel(bndl), nil
+	})
+}
+
+func (h *Handler) ListPackages(writer http.ResponseWriter, request *http.Request) {
+	h.serveCollection(writer, request, func(ctx context.Context, pageSize int, cursor string, _ bool) ([]interface{}, *pagination.Page, error) {
+		packagePage, err := h.packageSvc.List(ctx, pageSize, cursor)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "while listing Packages")
+		}
+
+		items := make([]interface{}, 0, len(packagePage.Data))
+		for _, pkg := range packagePage.Data {
+			items = append(items, packageFromModel(pkg))
+		}
+		return items, packagePage.PageInfo, nil
+	})
+}
+
+func (h *Handler) GetPackage(writer http.ResponseWriter, request *http.Request) {
+	h.serveEntity(writer, request, func(ctx context.Context, id string, _ bool) (interface{}, error) {
+		pkg, err := h.packageSvc.Get(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		return packageFromModel(pkg), nil
+	})
+}
+
+func (h *Handler) ListProducts(writer http.ResponseWriter, request *http.Request) {
+	h.serveCollection(writer, request, func(ctx context.Context, pageSize int, cursor string, _ bool) ([]interface{}, *pagination.Page, error) {
+		productPage, err := h.productSvc.List(ctx, pageSize, cursor)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "while listing Products")
+		}
+
+		items := make([]interface{}, 0, len(productPage.Data))
+		for _, product := range productPage.Data {
+			items = append(items, productFromModel(product))
+		}
+		return items, productPage.PageInfo, nil
+	})
+}
+
+func (h *Handler) GetProduct(writer http.ResponseWriter, request *http.Request) {
+	h.serveEntity(writer, request, func(ctx context.Context, id string, _ bool) (interface{}, error) {
+		product, err := h.productSvc.Get(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		return productFromModel(product), nil
+	})
+}
+
+func (h *Handler) ListVendors(writer http.ResponseWriter, request *http.Request) {
+	h.serveCollection(writer, request, func(ctx context.Context, pageSize int, cursor string, _ bool) ([]interface{}, *pagination.Page, error) {
+		vendorPage, err := h.vendorSvc.List(ctx, pageSize, cursor)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "while listing Vendors")
+		}
+
+		items := make([]interface{}, 0, len(vendorPage.Data))
+		for _, vendor := range vendorPage.Data {
+			items = append(items, vendorFromModel(vendor))
+		}
+		return items, vendorPage.PageInfo, nil
+	})
+}
+
+func (h *Handler) GetVendor(writer http.ResponseWriter, request *http.Request) {
+	h.serveEntity(writer, request, func(ctx context.Context, id string, _ bool) (interface{}, error) {
+		vendor, err := h.vendorSvc.Get(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		return vendorFromModel(vendor), nil
+	})
+}
+
+func (h *Handler) GetAPISpecification(writer http.ResponseWriter, request *http.Request) {
+	h.serveSpecification(writer, request, model.APISpecReference)
+}
+
+func (h *Handler) GetEventSpecification(writer http.ResponseWriter, request *http.Request) {
+	h.serveSpecification(writer, request, model.EventSpecReference)
+}
+
+func (h *Handler) serveCollection(writer http.ResponseWriter, request *http.Request, list listFunc) {
+	ctx := request.Context()
+
+	filter, expand, err := parseQuery(request)
+	if err != nil {
+		apperrors.WriteAppError(ctx, writer, err, http.StatusBadRequest)
+		return
+	}
+
+	top, skip, err := parsePaging(request, h.cfg.MaxPageSize)
+	if err != nil {
+		apperrors.WriteAppError(ctx, writer, err, http.StatusBadRequest)
+		return
+	}
+
+	tx, err := h.transact.Begin()
+	if err != nil {
+		log.C(ctx).WithError(err).Errorf("An error occurred while opening db transaction: %v", err)
+		apperrors.WriteAppError(ctx, writer, apperrors.NewInternalError("Unable to establish connection with database"), http.StatusInternalServerError)
+		return
+	}
+	defer h.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	// The filter is evaluated on the served resources, so only an unfiltered collection is read starting at $skip.
+	// A filtered collection is read from its beginning and $skip counts the resources which match the filter.
+	cursor := offsetCursor(skip)
+	toSkip := 0
+	if len(filter) > 0 {
+		cursor = ""
+		toSkip = skip
+	}
+
+	items := make([]interface{}, 0)
+	truncated := false
+	for {
+		pageSize := h.cfg.PageSize
+		if len(filter) == 0 && top-len(items) < pageSize {
+			// One resource more than requested tells whether the collection continues past $top
+			pageSize = top - len(items) + 1
+		}
+
+		pageItems, page, err := list(ctx, pageSize, cursor, expand)
+		if err != nil {
+			writeServiceError(ctx, writer, err)
+			return
+		}
+
+		for _, item := range pageItems {
+			matches, err := filter.Match(item)
+			if err != nil {
+				writeServiceError(ctx, writer, err)
+				return
+			}
+			if !matches {
+				continue
+			}
+
+			if toSkip > 0 {
+				toSkip--
+				continue
+			}
+			if len(items) == top {
+				truncated = true
+				break
+			}
+			items = append(items, item)
+		}
+
+		if truncated || page == nil || !page.HasNextPage {
+			break
+		}
+		cursor = page.EndCursor
+	}
+
+	if err := tx.Commit(); err != nil {
+		log.C(ctx).WithError(err).Errorf("An error occurred while closing database transaction: %v", err)
+		apperrors.WriteAppError(ctx, writer, apperrors.NewInternalError("Unable to finalize database operation"), http.StatusInternalServerError)
+		return
+	}
+
+	collection := Collection{Value: items}
+	if truncated {
+		collection.NextLink = nextLink(request, top, skip+top)
+	}
+
+	httputils.RespondWithBody(ctx, writer, http.StatusOK, collection)
+}
+
+func (h *Handler) serveEntity(writer http.ResponseWriter, request *http.Request, get getFunc) {
+	ctx := request.Context()
+
+	_, expand, err := parseQuery(request)
+	if err != nil {
+		apperrors.WriteAppError(ctx, writer, err, http.StatusBadRequest)
+		return
+	}
+
+	tx, err := h.transact.Begin()
+	if err != nil {
+		log.C(ctx).WithError(err).Errorf("An error occurred while opening db transaction: %v", err)
+		apperrors.WriteAppError(ctx, writer, apperrors.NewInternalError("Unable to establish connection with database"), http.StatusInternalServerError)
+		return
+	}
+	defer h.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	item, err := get(ctx, mux.Vars(request)[IDParam], expand)
+	if err != nil {
+		writeServiceError(ctx, writer, err)
+		return
+	}
+
+	if err := tx.Commit(); err != nil {
+		log.C(ctx).WithError(err).Errorf("An error occurred while closing database transaction: %v", err)
+		apperrors.WriteAppError(ctx, writer, apperrors.NewInternalError("Unable to finalize database operation"), http.StatusInternalServerError)
+		return
+	}
+
+	httputils.RespondWithBody(ctx, writer, http.StatusOK, item)
+}
+
+func (h *Handler) serveSpecification(writer http.ResponseWriter, request *http.Request, objectType model.SpecReferenceObjectType) {
+	ctx := request.Context()
+	routeVariables := mux.Vars(request)
+	objectID := routeVariables[IDParam]
+	specID := routeVariables[SpecIDParam]
+
+	tx, err := h.transact.Begin()
+	if err != nil {
+		log.C(ctx).WithError(err).Errorf("An error occurred while opening db transaction: %v", err)
+		apperrors.WriteAppError(ctx, writer, apperrors.NewInternalError("Unable to establish connection with database"), http.StatusInternalServerError)
+		return
+	}
+	defer h.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	specs, err := h.specSvc.ListByReferenceObjectID(ctx, objectType, objectID)
+	if err != nil {
+		writeServiceError(ctx, writer, errors.Wrapf(err, "while listing specifications for %s with id %s", objectType, objectID))
+		return
+	}
+
+	if err := tx.Commit(); err != nil {
+		log.C(ctx).WithError(err).Errorf("An error occurred while closing database transaction: %v", err)
+		apperrors.WriteAppError(ctx, writer, apperrors.NewInternalError("Unable to finalize database operation"), http.StatusInternalServerError)
+		return
+	}
+
+	for _, spec := range specs {
+		if spec.ID != specID {
+			continue
+		}
+
+		writer.Header().Set(httputils.HeaderContentType, mediaType(spec.Format))
+		writer.WriteHeader(http.StatusOK)
+		if spec.Data == nil {
+			return
+		}
+		if _, err := writer.Write([]byte(*spec.Data)); err != nil {
+			log.C(ctx).WithError(err).Error("An error occurred while writing specification data")
+		}
+		return
+	}
+
+	apperrors.WriteAppError(ctx, writer, apperrors.NewNotFoundErrorWithMessage(resource.Specification, specID,
+		fmt.Sprintf("specification with id %s for %s with id %s not found", specID, objectType, objectID)), http.StatusNotFound)
+}
+
+// listSpecs returns the specifications of all of the given objects, grouped by the ID of the object
+func (h *Handler) listSpecs(ctx context.Context, objectType model.SpecReferenceObjectType, objectIDs []string) (map[string][]*model.Spec, error) {
+	if len(objectIDs) == 0 {
+		return nil, nil
+	}
+	return h.specSvc.ListByReferenceObjectIDs(ctx, objectType, objectIDs)
+}
+
+func (h *Handler) apiResource(api *model.APIDefinition, specs []*model.Spec, expand bool) *APIResource {
+	resourceDefinitions := make([]*ResourceDefinition, 0, len(specs))
+	for _, spec := range specs {
+		resourceDefinitions = append(resourceDefinitions, resourceDefinitionFromModel(h.cfg.StaticEndpoint, apiResourceType, api.ID, spec, expand))
+	}
+
+	return apiResourceFromModel(api, resourceDefinitions)
+}
+
+func (h *Handler) eventResource(event *model.EventDefinition, specs []*model.Spec, expand bool) *EventResource {
+	resourceDefinitions := make([]*ResourceDefinition, 0, len(specs))
+	for _, spec := range specs {
+		resourceDefinitions = append(resourceDefinitions, resourceDefinitionFromModel(h.cfg.StaticEndpoint, eventResourceType, event.ID, spec, expand))
+	}
+
+	return eventResourceFromModel(event, resourceDefinitions)
+}
+
+func parseQuery(request *http.Request) (Filter, bool, error) {
+	query := request.URL.Query()
+
+	filter, err := ParseFilter(query.Get(filterParam))
+	if err != nil {
+		return nil, false, err
+	}
+
+	expand := false
+	for _, value := range strings.Split(query.Get(expandParam), ",") {
+		value = strings.TrimSpace(value)
+		if value == "" {
+			continue
+		}
+		if value != expandResourceDefinitions {
+			return nil, false, apperrors.NewInvalidDataError("unsupported $expand value %q", value)
+		}
+		expand = true
+	}
+
+	return filter, expand, nil
+}
+
+// parsePaging reads the $top and $skip query parameters; $top defaults to and may not exceed maxPageSize
+func parsePaging(request *http.Request, maxPageSize int) (int, int, error) {
+	query := request.URL.Query()
+
+	top := maxPageSize
+	if value := query.Get(topParam); value != "" {
+		parsed, err := strconv.Atoi(value)
+		if err != nil || parsed < 1 || parsed > maxPageSize {
+			return 0, 0, apperrors.NewInvalidDataError("%s must be an integer between 1 and %d", topParam, maxPageSize)
+		}
+		top = parsed
+	}
+
+	skip := 0
+	if value := query.Get(skipParam); value != "" {
+		parsed, err := strconv.Atoi(value)
+		if err != nil || parsed < 0 {
+			return 0, 0, apperrors.NewInvalidDataError("%s must be a non-negative integer", skipParam)
+		}
+		skip = parsed
+	}
+
+	return top, skip, nil
+}
+
+// offsetCursor returns the page cursor pointing at the given offset of a collection
+func offsetCursor(offset int) string {
+	if offset == 0 {
+		return ""
+	}
+	return pagination.EncodeNextOffsetCursor(offset, 0)
+}
+
+// nextLink returns the request URL with $top and $skip pointing to the following page of the collection
+func nextLink(request *http.Request, top, skip int) string {
+	query := request.URL.Query()
+	query.Set(topParam, strconv.Itoa(top))
+	query.Set(skipParam, strconv.Itoa(skip))
+
+	next := url.URL{Path: request.URL.Path, RawQuery: query.Encode()}
+	return next.String()
+}
+
+func writeServiceError(ctx context.Context, writer http.ResponseWriter, err error) {
+	log.C(ctx).WithError(err).Errorf("An error occurred while serving ORD request: %v", err)
+
+	switch {
+	case apperrors.IsNotFoundError(err):
+		apperrors.WriteAppError(ctx, writer, err, http.StatusNotFound)
+	case apperrors.IsCannotReadTenant(errors.Cause(err)), apperrors.IsTenantRequired(err):
+		apperrors.WriteAppError(ctx, writer, apperrors.NewUnauthorizedError("Unable to determine tenant for request"), http.StatusUnauthorized)
+	default:
+		apperrors.WriteAppError(ctx, writer, apperrors.NewInternalError("Unable to execute database operation"), http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,546 @@
+package ordservice_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/kyma-incubator/compass/components/director/internal/labelfilter"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/ordservice"
+	"github.com/kyma-incubator/compass/components/director/internal/ordservice/automock"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence/txtest"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	appID          = "appID"
+	apiID          = "apiID"
+	specID         = "specID"
+	staticEndpoint = "/open-resource-discovery-static/v0"
+)
+
+var (
+	cfg      = ordservice.Config{StaticEndpoint: staticEndpoint, PageSize: 2, MaxPageSize: 1000}
+	noFilter = ([]*labelfilter.LabelFilter)(nil)
+)
+
+func TestHandler_ListAPIs(t *testing.T) {
+	// given
+	testErr := errors.New("test error")
+	txGen := txtest.NewTransactionContextGenerator(testErr)
+
+	t.Run("Success", func(t *testing.T) {
+		persist, transact := txGen.ThatSucceeds()
+
+		apiSvc := &automock.APIService{}
+		apiSvc.On("List", txtest.CtxWithDBMatcher(), cfg.PageSize, "").Return(fixAPIPage(nil, fixAPI(apiID, "foo"), fixAPI("otherAPI", "bar")), nil).Once()
+		specSvc := &automock.SpecService{}
+		specSvc.On("ListByReferenceObjectIDs", txtest.CtxWithDBMatcher(), model.APISpecReference, []string{apiID, "otherAPI"}).Return(map[string][]*model.Spec{apiID: {fixSpec()}}, nil).Once()
+
+		handler := ordservice.NewHandler(transact, nil, nil, apiSvc, nil, specSvc, nil, nil, nil, cfg)
+
+		// when
+		writer := serve(t, handler, "/apis?$filter=title%20eq%20'foo'")
+
+		// then
+		require.Equal(t, http.StatusOK, writer.Code)
+
+		var collection struct {
+			Value []ordservice.APIResource `json:"value"`
+		}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), &collection))
+		require.Len(t, collection.Value, 1)
+		assert.Equal(t, apiID, collection.Value[0].ID)
+		assert.Equal(t, appID, collection.Value[0].SystemInstanceID)
+		require.Len(t, collection.Value[0].ResourceDefinitions, 1)
+		assert.Equal(t, staticEndpoint+"/api/apiID/specification/specID", collection.Value[0].ResourceDefinitions[0].URL)
+		assert.Equal(t, "application/json", collection.Value[0].ResourceDefinitions[0].MediaType)
+		assert.Equal(t, "openapi-v3", collection.Value[0].ResourceDefinitions[0].Type)
+		assert.Nil(t, collection.Value[0].ResourceDefinitions[0].Content)
+
+		persist.AssertExpectations(t)
+		transact.AssertExpectations(t)
+		apiSvc.AssertExpectations(t)
+		specSvc.AssertExpectations(t)
+	})
+
+	t.Run("Success with expanded resource definitions", func(t *testing.T) {
+		persist, transact := txGen.ThatSucceeds()
+
+		apiSvc := &automock.APIService{}
+		apiSvc.On("List", txtest.CtxWithDBMatcher(), cfg.PageSize, "").Return(fixAPIPage(nil, fixAPI(apiID, "foo")), nil).Once()
+		specSvc := &automock.SpecService{}
+		specSvc.On("ListByReferenceObjectIDs", txtest.CtxWithDBMatcher(), model.APISpecReference, []string{apiID}).Return(map[string][]*model.Spec{apiID: {fixSpec()}}, nil).Once()
+
+		handler := ordservice.NewHandler(transact, nil, nil, apiSvc, nil, specSvc, nil, nil, nil, cfg)
+
+		// when
+		writer := serve(t, handler, "/apis?$expand=resourceDefinitions")
+
+		// then
+		require.Equal(t, http.StatusOK, writer.Code)
+
+		var collection struct {
+			Value []ordservice.APIResource `json:"value"`
+		}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), &collection))
+		require.Len(t, collection.Value, 1)
+		require.Len(t, collection.Value[0].ResourceDefinitions, 1)
+		require.NotNil(t, collection.Value[0].ResourceDefinitions[0].Content)
+		assert.Equal(t, *fixSpec().Data, *collection.Value[0].ResourceDefinitions[0].Content)
+
+		persist.AssertExpectations(t)
+		transact.AssertExpectations(t)
+		apiSvc.AssertExpectations(t)
+		specSvc.AssertExpectations(t)
+	})
+
+	t.Run("Returns bad request on invalid filter", func(t *testing.T) {
+		handler := ordservice.NewHandler(nil, nil, nil, nil, nil, nil, nil, nil, nil, cfg)
+
+		// when
+		writer := serve(t, handler, "/apis?$filter=title%20gt%201")
+
+		// then
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+		assert.Contains(t, writer.Body.String(), "unsupported $filter operator")
+	})
+
+	t.Run("Returns bad request on unsupported expand", func(t *testing.T) {
+		handler := ordservice.NewHandler(nil, nil, nil, nil, nil, nil, nil, nil, nil, cfg)
+
+		// when
+		writer := serve(t, handler, "/apis?$expand=packages")
+
+		// then
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+		assert.Contains(t, writer.Body.String(), "unsupported $expand value")
+	})
+
+	t.Run("Returns unauthorized when tenant is missing", func(t *testing.T) {
+		persist, transact := txGen.ThatDoesntExpectCommit()
+
+		apiSvc := &automock.APIService{}
+		apiSvc.On("List", txtest.CtxWithDBMatcher(), cfg.PageSize, "").Return(nil, apperrors.NewCannotReadTenantError()).Once()
+
+		handler := ordservice.NewHandler(transact, nil, nil, apiSvc, nil, nil, nil, nil, nil, cfg)
+
+		// when
+		writer := serve(t, handler, "/apis")
+
+		// then
+		assert.Equal(t, http.StatusUnauthorized, writer.Code)
+
+		persist.AssertExpectations(t)
+		transact.AssertExpectations(t)
+		apiSvc.AssertExpectations(t)
+	})
+
+	t.Run("Returns internal server error when listing APIs fails", func(t *testing.T) {
+		persist, transact := txGen.ThatDoesntExpectCommit()
+
+		apiSvc := &automock.APIService{}
+		apiSvc.On("List", txtest.CtxWithDBMatcher(), cfg.PageSize, "").Return(nil, testErr).Once()
+
+		handler := ordservice.NewHandler(transact, nil, nil, apiSvc, nil, nil, nil, nil, nil, cfg)
+
+		// when
+		writer := serve(t, handler, "/apis")
+
+		// then
+		assert.Equal(t, http.StatusInternalServerError, writer.Code)
+
+		persist.AssertExpectations(t)
+		transact.AssertExpectations(t)
+		apiSvc.AssertExpectations(t)
+	})
+
+	t.Run("Returns internal server error when listing specifications fails", func(t *testing.T) {
+		persist, transact := txGen.ThatDoesntExpectCommit()
+
+		apiSvc := &automock.APIService{}
+		apiSvc.On("List", txtest.CtxWithDBMatcher(), cfg.PageSize, "").Return(fixAPIPage(nil, fixAPI(apiID, "foo")), nil).Once()
+		specSvc := &automock.SpecService{}
+		specSvc.On("ListByReferenceObjectIDs", txtest.CtxWithDBMatcher(), model.APISpecReference, []string{apiID}).Return(nil, testErr).Once()
+
+		handler := ordservice.NewHandler(transact, nil, nil, apiSvc, nil, specSvc, nil, nil, nil, cfg)
+
+		// when
+		writer := serve(t, handler, "/apis")
+
+		// then
+		assert.Equal(t, http.StatusInternalServerError, writer.Code)
+
+		persist.AssertExpectations(t)
+		transact.AssertExpectations(t)
+		apiSvc.AssertExpectations(t)
+		specSvc.AssertExpectations(t)
+	})
+
+	t.Run("Returns internal server error when transaction begin fails", func(t *testing.T) {
+		persist, transact := txGen.ThatFailsOnBegin()
+
+		handler := ordservice.NewHandler(transact, nil, nil, nil, nil, nil, nil, nil, nil, cfg)
+
+		// when
+		writer := serve(t, handler, "/apis")
+
+		// then
+		assert.Equal(t, http.StatusInternalServerError, writer.Code)
+		assert.Contains(t, writer.Body.String(), "Unable to establish connection with database")
+
+		persist.AssertExpectations(t)
+		transact.AssertExpectations(t)
+	})
+}
+
+func TestHandler_GetAPI(t *testing.T) {
+	// given
+	testErr := errors.New("test error")
+	txGen := txtest.NewTransactionContextGenerator(testErr)
+
+	t.Run("Success", func(t *testing.T) {
+		persist, transact := txGen.ThatSucceeds()
+
+		apiSvc := &automock.APIService{}
+		apiSvc.On("Get", txtest.CtxWithDBMatcher(), apiID).Return(fixAPI(apiID, "foo"), nil).Once()
+		specSvc := &automock.SpecService{}
+		specSvc.On("ListByReferenceObjectID", txtest.CtxWithDBMatcher(), model.APISpecReference, apiID).Return([]*model.Spec{fixSpec()}, nil).Once()
+
+		handler := ordservice.NewHandler(transact, nil, nil, apiSvc, nil, specSvc, nil, nil, nil, cfg)
+
+		// when
+		writer := serve(t, handler, "/apis/"+apiID)
+
+		// then
+		require.Equal(t, http.StatusOK, writer.Code)
+
+		var api ordservice.APIResource
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), &api))
+		assert.Equal(t, apiID, api.ID)
+		assert.Equal(t, "foo", api.Title)
+
+		persist.AssertExpectations(t)
+		transact.AssertExpectations(t)
+		apiSvc.AssertExpectations(t)
+		specSvc.AssertExpectations(t)
+	})
+
+	t.Run("Returns not found", func(t *testing.T) {
+		persist, transact := txGen.ThatDoesntExpectCommit()
+
+		apiSvc := &automock.APIService{}
+		apiSvc.On("Get", txtest.CtxWithDBMatcher(), apiID).Return(nil, apperrors.NewNotFoundError(resource.API, apiID)).Once()
+
+		handler := ordservice.NewHandler(transact, nil, nil, apiSvc, nil, nil, nil, nil, nil, cfg)
+
+		// when
+		writer := serve(t, handler, "/apis/"+apiID)
+
+		// then
+		assert.Equal(t, http.StatusNotFound, writer.Code)
+
+		persist.AssertExpectations(t)
+		transact.AssertExpectations(t)
+		apiSvc.AssertExpectations(t)
+	})
+}
+
+func TestHandler_ListAPIs_Paging(t *testing.T) {
+	// given
+	txGen := txtest.NewTransactionContextGenerator(errors.New("test error"))
+
+	t.Run("Pages through the collection", func(t *testing.T) {
+		persist, transact := txGen.ThatSucceeds()
+
+		apiSvc := &automock.APIService{}
+		apiSvc.On("List", txtest.CtxWithDBMatcher(), cfg.PageSize, "").Return(fixAPIPage(&pagination.Page{EndCursor: "cursor", HasNextPage: true}, fixAPI(apiID, "foo"), fixAPI("otherAPI", "bar")), nil).Once()
+		apiSvc.On("List", txtest.CtxWithDBMatcher(), cfg.PageSize, "cursor").Return(fixAPIPage(nil, fixAPI("lastAPI", "bar")), nil).Once()
+		specSvc := &automock.SpecService{}
+		specSvc.On("ListByReferenceObjectIDs", txtest.CtxWithDBMatcher(), model.APISpecReference, []string{apiID, "otherAPI"}).Return(nil, nil).Once()
+		specSvc.On("ListByReferenceObjectIDs", txtest.CtxWithDBMatcher(), model.APISpecReference, []string{"lastAPI"}).Return(nil, nil).Once()
+
+		handler := ordservice.NewHandler(transact, nil, nil, apiSvc, nil, specSvc, nil, nil, nil, cfg)
+
+		// when
+		writer := serve(t, handler, "/apis")
+
+		// then
+		require.Equal(t, http.StatusOK, writer.Code)
+
+		var collection struct {
+			Value    []ordservice.APIResource `json:"value"`
+			NextLink string                   `json:"@odata.nextLink"`
+		}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), &collection))
+		require.Len(t, collection.Value, 3)
+		assert.Equal(t, apiID, collection.Value[0].ID)
+		assert.Equal(t, "otherAPI", collection.Value[1].ID)
+		assert.Equal(t, "lastAPI", collection.Value[2].ID)
+		assert.Empty(t, collection.NextLink)
+
+		mock.AssertExpectationsForObjects(t, persist, transact, apiSvc, specSvc)
+	})
+
+	t.Run("Reads the collection from $skip and links the next page", func(t *testing.T) {
+		persist, transact := txGen.ThatSucceeds()
+
+		apiSvc := &automock.APIService{}
+		apiSvc.On("List", txtest.CtxWithDBMatcher(), 2, pagination.EncodeNextOffsetCursor(5, 0)).Return(fixAPIPage(&pagination.Page{EndCursor: "cursor", HasNextPage: true}, fixAPI("firstAPI", "foo"), fixAPI("secondAPI", "foo")), nil).Once()
+		specSvc := &automock.SpecService{}
+		specSvc.On("ListByReferenceObjectIDs", txtest.CtxWithDBMatcher(), model.APISpecReference, []string{"firstAPI", "secondAPI"}).Return(nil, nil).Once()
+
+		handler := ordservice.NewHandler(transact, nil, nil, apiSvc, nil, specSvc, nil, nil, nil, cfg)
+
+		// when
+		writer := serve(t, handler, "/apis?$top=1&$skip=5")
+
+		// then
+		require.Equal(t, http.StatusOK, writer.Code)
+
+		var collection struct {
+			Value    []ordservice.APIResource `json:"value"`
+			NextLink string                   `json:"@odata.nextLink"`
+		}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), &collection))
+		require.Len(t, collection.Value, 1)
+		assert.Equal(t, "firstAPI", collection.Value[0].ID)
+		assert.Equal(t, "/apis?%24skip=6&%24top=1", collection.NextLink)
+
+		mock.AssertExpectationsForObjects(t, persist, transact, apiSvc, specSvc)
+	})
+
+	t.Run("Skips the resources matching the filter", func(t *testing.T) {
+		persist, transact := txGen.ThatSucceeds()
+
+		apiSvc := &automock.APIService{}
+		apiSvc.On("List", txtest.CtxWithDBMatcher(), cfg.PageSize, "").Return(fixAPIPage(&pagination.Page{EndCursor: "cursor", HasNextPage: true}, fixAPI("skippedAPI", "foo"), fixAPI("otherAPI", "bar")), nil).Once()
+		apiSvc.On("List", txtest.CtxWithDBMatcher(), cfg.PageSize, "cursor").Return(fixAPIPage(&pagination.Page{EndCursor: "next", HasNextPage: true}, fixAPI(apiID, "foo"), fixAPI("nextAPI", "foo")), nil).Once()
+		specSvc := &automock.SpecService{}
+		specSvc.On("ListByReferenceObjectIDs", txtest.CtxWithDBMatcher(), model.APISpecReference, mock.Anything).Return(nil, nil).Twice()
+
+		handler := ordservice.NewHandler(transact, nil, nil, apiSvc, nil, specSvc, nil, nil, nil, cfg)
+
+		// when
+		writer := serve(t, handler, "/apis?$filter=title%20eq%20'foo'&$top=1&$skip=1")
+
+		// then
+		require.Equal(t, http.StatusOK, writer.Code)
+
+		var collection struct {
+			Value    []ordservice.APIResource `json:"value"`
+			NextLink string                   `json:"@odata.nextLink"`
+		}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), &collection))
+		require.Len(t, collection.Value, 1)
+		assert.Equal(t, apiID, collection.Value[0].ID)
+		assert.Contains(t, collection.NextLink, "%24skip=2&%24top=1")
+
+		mock.AssertExpectationsForObjects(t, persist, transact, apiSvc, specSvc)
+	})
+
+	t.Run("Returns bad request when $top exceeds the maximum page size", func(t *testing.T) {
+		handler := ordservice.NewHandler(nil, nil, nil, nil, nil, nil, nil, nil, nil, cfg)
+
+		// when
+		writer := serve(t, handler, "/apis?$top=1001")
+
+		// then
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+		assert.Contains(t, writer.Body.String(), "$top must be an integer between 1 and 1000")
+	})
+
+	t.Run("Returns bad request when $skip is negative", func(t *testing.T) {
+		handler := ordservice.NewHandler(nil, nil, nil, nil, nil, nil, nil, nil, nil, cfg)
+
+		// when
+		writer := serve(t, handler, "/apis?$skip=-1")
+
+		// then
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+		assert.Contains(t, writer.Body.String(), "$skip must be a non-negative integer")
+	})
+}
+
+func TestHandler_ListPackages(t *testing.T) {
+	// given
+	txGen := txtest.NewTransactionContextGenerator(errors.New("test error"))
+	persist, transact := txGen.ThatSucceeds()
+
+	pkgSvc := &automock.PackageService{}
+	pkgSvc.On("List", txtest.CtxWithDBMatcher(), cfg.PageSize, "").Return(&model.PackagePage{
+		Data:     []*model.Package{{ID: "pkgID", OrdID: "ns:package:PKG:v1", ApplicationID: appID}},
+		PageInfo: &pagination.Page{},
+	}, nil).Once()
+
+	handler := ordservice.NewHandler(transact, nil, nil, nil, nil, nil, pkgSvc, nil, nil, cfg)
+
+	// when
+	writer := serve(t, handler, "/packages?$filter=ordId%20eq%20'ns:package:PKG:v1'")
+
+	// then
+	require.Equal(t, http.StatusOK, writer.Code)
+
+	var collection struct {
+		Value []ordservice.Package `json:"value"`
+	}
+	require.NoError(t, json.Unmarshal(writer.Body.Bytes(), &collection))
+	require.Len(t, collection.Value, 1)
+	assert.Equal(t, "pkgID", collection.Value[0].ID)
+
+	persist.AssertExpectations(t)
+	transact.AssertExpectations(t)
+	pkgSvc.AssertExpectations(t)
+}
+
+func TestHandler_ListSystemInstances(t *testing.T) {
+	// given
+	txGen := txtest.NewTransactionContextGenerator(errors.New("test error"))
+	persist, transact := txGen.ThatSucceeds()
+
+	appSvc := &automock.ApplicationService{}
+	appSvc.On("List", txtest.CtxWithDBMatcher(), noFilter, pagination.Request{PageSize: cfg.PageSize}, model.DefaultApplicationOrderBy).Return(fixApplicationPage([]*model.Application{fixApplication()}), nil).Once()
+
+	handler := ordservice.NewHandler(transact, appSvc, nil, nil, nil, nil, nil, nil, nil, cfg)
+
+	// when
+	writer := serve(t, handler, "/systemInstances")
+
+	// then
+	require.Equal(t, http.StatusOK, writer.Code)
+
+	var collection struct {
+		Value []ordservice.SystemInstance `json:"value"`
+	}
+	require.NoError(t, json.Unmarshal(writer.Body.Bytes(), &collection))
+	require.Len(t, collection.Value, 1)
+	assert.Equal(t, appID, collection.Value[0].ID)
+
+	persist.AssertExpectations(t)
+	transact.AssertExpectations(t)
+	appSvc.AssertExpectations(t)
+}
+
+func TestHandler_GetAPISpecification(t *testing.T) {
+	// given
+	txGen := txtest.NewTransactionContextGenerator(errors.New("test error"))
+
+	t.Run("Success", func(t *testing.T) {
+		persist, transact := txGen.ThatSucceeds()
+
+		specSvc := &automock.SpecService{}
+		specSvc.On("ListByReferenceObjectID", txtest.CtxWithDBMatcher(), model.APISpecReference, apiID).Return([]*model.Spec{fixSpec()}, nil).Once()
+
+		handler := ordservice.NewHandler(transact, nil, nil, nil, nil, specSvc, nil, nil, nil, cfg)
+
+		// when
+		writer := serveStatic(t, handler, "/api/"+apiID+"/specification/"+specID)
+
+		// then
+		require.Equal(t, http.StatusOK, writer.Code)
+		assert.Equal(t, "application/json", writer.Header().Get("Content-Type"))
+		assert.Equal(t, *fixSpec().Data, writer.Body.String())
+
+		persist.AssertExpectations(t)
+		transact.AssertExpectations(t)
+		specSvc.AssertExpectations(t)
+	})
+
+	t.Run("Returns not found for unknown specification", func(t *testing.T) {
+		persist, transact := txGen.ThatSucceeds()
+
+		specSvc := &automock.SpecService{}
+		specSvc.On("ListByReferenceObjectID", txtest.CtxWithDBMatcher(), model.APISpecReference, apiID).Return([]*model.Spec{fixSpec()}, nil).Once()
+
+		handler := ordservice.NewHandler(transact, nil, nil, nil, nil, specSvc, nil, nil, nil, cfg)
+
+		// when
+		writer := serveStatic(t, handler, "/api/"+apiID+"/specification/unknown")
+
+		// then
+		assert.Equal(t, http.StatusNotFound, writer.Code)
+
+		persist.AssertExpectations(t)
+		transact.AssertExpectations(t)
+		specSvc.AssertExpectations(t)
+	})
+}
+
+func serve(t *testing.T, handler *ordservice.Handler, target string) *httptest.ResponseRecorder {
+	router := mux.NewRouter()
+	handler.RegisterRoutes(router)
+	return doRequest(t, router, target)
+}
+
+func serveStatic(t *testing.T, handler *ordservice.Handler, target string) *httptest.ResponseRecorder {
+	router := mux.NewRouter()
+	handler.RegisterStaticRoutes(router)
+	return doRequest(t, router, target)
+}
+
+func doRequest(t *testing.T, router *mux.Router, target string) *httptest.ResponseRecorder {
+	req, err := http.NewRequestWithContext(context.TODO(), http.MethodGet, target, nil)
+	require.NoError(t, err)
+
+	writer := httptest.NewRecorder()
+	router.ServeHTTP(writer, req)
+	return writer
+}
+
+func fixApplication() *model.Application {
+	return &model.Application{
+		Name:       "app",
+		BaseEntity: &model.BaseEntity{ID: appID},
+	}
+}
+
+func fixApplicationPage(apps []*model.Application) *model.ApplicationPage {
+	return &model.ApplicationPage{
+		Data:       apps,
+		PageInfo:   &pagination.Page{},
+		TotalCount: len(apps),
+	}
+}
+
+func fixAPIPage(pageInfo *pagination.Page, apis ...*model.APIDefinition) *model.APIDefinitionPage {
+	if pageInfo == nil {
+		pageInfo = &pagination.Page{}
+	}
+	return &model.APIDefinitionPage{
+		Data:       apis,
+		PageInfo:   pageInfo,
+		TotalCount: len(apis),
+	}
+}
+
+func fixAPI(id, name string) *model.APIDefinition {
+	return &model.APIDefinition{
+		ApplicationID: appID,
+		Name:          name,
+		TargetURL:     "https://target.url",
+		BaseEntity:    &model.BaseEntity{ID: id},
+	}
+}
+
+func fixSpec() *model.Spec {
+	data := `{"openapi":"3.0.0"}`
+	apiType := model.APISpecTypeOpenAPIV3
+	return &model.Spec{
+		ID:         specID,
+		ObjectType: model.APISpecReference,
+		ObjectID:   apiID,
+		Data:       &data,
+		Format:     model.SpecFormatApplicationJSON,
+		APIType:    &apiType,
+	}
+}
@@ -0,0 +1,57 @@
+package ordservice
+
+import (
+	"context"
+
+	"github.com/kyma-incubator/compass/components/director/internal/labelfilter"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
+)
+
+//go:generate mockery -name=ApplicationService -output=automock -outpkg=automock -case=underscore
+type ApplicationService interface {
+	List(ctx context.Context, filter []*labelfilter.LabelFilter, pageRequest pagination.Request, orderBy model.ApplicationOrderBy) (*model.ApplicationPage, error)
+	Get(ctx context.Context, id string) (*model.Application, error)
+}
+
+//go:generate mockery -name=BundleService -output=automock -outpkg=automock -case=underscore
+type BundleService interface {
+	Get(ctx context.Context, id string) (*model.Bundle, error)
+	List(ctx context.Context, pageSize int, cursor string) (*model.BundlePage, error)
+}
+
+//go:generate mockery -name=APIService -output=automock -outpkg=automock -case=underscore
+type APIService interface {
+	Get(ctx context.Context, id string) (*model.APIDefinition, error)
+	List(ctx context.Context, pageSize int, cursor string) (*model.APIDefinitionPage, error)
+}
+
+//go:generate mockery -name=EventService -output=automock -outpkg=automock -case=underscore
+type EventService interface {
+	Get(ctx context.Context, id string) (*model.EventDefinition, error)
+	List(ctx context.Context, pageSize int, cursor string) (*model.EventDefinitionPage, error)
+}
+
+//go:generate mockery -name=SpecService -output=automock -outpkg=automock -case=underscore
+type SpecService interface {
+	ListByReferenceObjectID(ctx context.Context, objectType model.SpecReferenceObjectType, objectID string) ([]*model.Spec, error)
+	ListByReferenceObjectIDs(ctx context.Context, objectType model.SpecReferenceObjectType, objectIDs []string) (map[string][]*model.Spec, error)
+}
+
+//go:generate mockery -name=PackageService -output=automock -outpkg=automock -case=underscore
+type PackageService interface {
+	Get(ctx context.Context, id string) (*model.Package, error)
+	List(ctx context.Context, pageSize int, cursor string) (*model.PackagePage, error)
+}
+
+//go:generate mockery -name=ProductService -output=automock -outpkg=automock -case=underscore
+type ProductService interface {
+	Get(ctx context.Context, id string) (*model.Product, error)
+	List(ctx context.Context, pageSize int, cursor string) (*model.ProductPage, error)
+}
+
+//go:generate mockery -name=VendorService -output=automock -outpkg=automock -case=underscore
+type VendorService interface {
+	Get(ctx context.Context, id string) (*model.Vendor, error)
+	List(ctx context.Context, pageSize int, cursor string) (*model.VendorPage, error)
+}
@@ -0,0 +1,61 @@
+package ordservice
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/kyma-incubator/compass/components/director/pkg/scope"
+	"github.com/pkg/errors"
+)
+
+// ScopesDefinition is the path of the scopes required for reading the ORD service resources in the scopes configuration
+const ScopesDefinition = "ordService"
+
+// ScopesMiddleware rejects the requests of callers which do not have all scopes configured under ScopesDefinition
+func ScopesMiddleware(scopesGetter scope.ScopesGetter) mux.MiddlewareFunc {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+			ctx := request.Context()
+
+			if err := verifyScopes(ctx, scopesGetter); err != nil {
+				log.C(ctx).WithError(err).Errorf("An error occurred while verifying scopes: %s", err.Error())
+				if apperrors.ErrorCode(err) == apperrors.InsufficientScopes {
+					apperrors.WriteAppError(ctx, writer, err, http.StatusForbidden)
+					return
+				}
+				apperrors.WriteAppError(ctx, writer, apperrors.NewInternalError("Unable to verify scopes for request"), http.StatusInternalServerError)
+				return
+			}
+
+			next.ServeHTTP(writer, request)
+		})
+	}
+}
+
+func verifyScopes(ctx context.Context, scopesGetter scope.ScopesGetter) error {
+	actualScopes, err := scope.LoadFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	requiredScopes, err := scopesGetter.GetRequiredScopes(ScopesDefinition)
+	if err != nil {
+		return errors.Wrap(err, "while getting required scopes")
+	}
+
+	actual := make(map[string]bool, len(actualScopes))
+	for _, s := range actualScopes {
+		actual[s] = true
+	}
+
+	for _, s := range requiredScopes {
+		if !actual[s] {
+			return apperrors.NewInsufficientScopesError(requiredScopes, actualScopes)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,77 @@
+package ordservice_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/kyma-incubator/compass/components/director/internal/ordservice"
+	"github.com/kyma-incubator/compass/components/director/pkg/scope"
+	scopeautomock "github.com/kyma-incubator/compass/components/director/pkg/scope/automock"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScopesMiddleware(t *testing.T) {
+	requiredScopes := []string{"application:read"}
+
+	testCases := []struct {
+		Name               string
+		Scopes             []string
+		ScopesGetterErr    error
+		ExpectedStatusCode int
+	}{
+		{
+			Name:               "Passes the request when caller has required scopes",
+			Scopes:             []string{"application:read", "runtime:read"},
+			ExpectedStatusCode: http.StatusOK,
+		},
+		{
+			Name:               "Returns forbidden when caller does not have required scopes",
+			Scopes:             []string{"runtime:read"},
+			ExpectedStatusCode: http.StatusForbidden,
+		},
+		{
+			Name:               "Returns internal server error when there are no scopes in context",
+			ExpectedStatusCode: http.StatusInternalServerError,
+		},
+		{
+			Name:               "Returns internal server error when required scopes cannot be loaded",
+			Scopes:             []string{"application:read"},
+			ScopesGetterErr:    errors.New("test error"),
+			ExpectedStatusCode: http.StatusInternalServerError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// given
+			scopesGetter := &scopeautomock.ScopesGetter{}
+			scopesGetter.On("GetRequiredScopes", ordservice.ScopesDefinition).Return(requiredScopes, testCase.ScopesGetterErr).Maybe()
+
+			router := mux.NewRouter()
+			router.Use(ordservice.ScopesMiddleware(scopesGetter))
+			router.HandleFunc("/apis", func(writer http.ResponseWriter, request *http.Request) {
+				writer.WriteHeader(http.StatusOK)
+			})
+
+			ctx := context.TODO()
+			if testCase.Scopes != nil {
+				ctx = scope.SaveToContext(ctx, testCase.Scopes)
+			}
+			req, err := http.NewRequestWithContext(ctx, http.MethodGet, "/apis", nil)
+			require.NoError(t, err)
+			writer := httptest.NewRecorder()
+
+			// when
+			router.ServeHTTP(writer, req)
+
+			// then
+			assert.Equal(t, testCase.ExpectedStatusCode, writer.Code)
+			scopesGetter.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,307 @@
+package ordservice
+
+import (
+	"encoding/json"
+	"path"
+	"strings"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+)
+
+// Collection is the OData-style envelope in which lists of ORD entities are returned.
+// NextLink is set when the collection was cut at $top and more entities may follow.
+type Collection struct {
+	Value    []interface{} `json:"value"`
+	NextLink string        `json:"@odata.nextLink,omitempty"`
+}
+
+type SystemInstance struct {
+	ID           string          `json:"id"`
+	Name         string          `json:"name"`
+	Description  *string         `json:"description,omitempty"`
+	ProviderName *string         `json:"providerName,omitempty"`
+	BaseURL      *string         `json:"baseUrl,omitempty"`
+	Labels       json.RawMessage `json:"labels,omitempty"`
+}
+
+type APIResource struct {
+	ID                      string                `json:"id"`
+	OrdID                   *string               `json:"ordId,omitempty"`
+	SystemInstanceID        string                `json:"systemInstanceId"`
+	PartOfPackage           *string               `json:"partOfPackage,omitempty"`
+	PartOfConsumptionBundle *string               `json:"partOfConsumptionBundle,omitempty"`
+	Title                   string                `json:"title"`
+	ShortDescription        *string               `json:"shortDescription,omitempty"`
+	Description             *string               `json:"description,omitempty"`
+	Version                 *string               `json:"version,omitempty"`
+	EntryPoint              string                `json:"entryPoint"`
+	APIProtocol             *string               `json:"apiProtocol,omitempty"`
+	ReleaseStatus           *string               `json:"releaseStatus,omitempty"`
+	Visibility              *string               `json:"visibility,omitempty"`
+	Disabled                *bool                 `json:"disabled,omitempty"`
+	Tags                    json.RawMessage       `json:"tags,omitempty"`
+	Countries               json.RawMessage       `json:"countries,omitempty"`
+	Links                   json.RawMessage       `json:"links,omitempty"`
+	Labels                  json.RawMessage       `json:"labels,omitempty"`
+	PartOfProducts          json.RawMessage       `json:"partOfProducts,omitempty"`
+	LineOfBusiness          json.RawMessage       `json:"lineOfBusiness,omitempty"`
+	Industry                json.RawMessage       `json:"industry,omitempty"`
+	ResourceDefinitions     []*ResourceDefinition `json:"resourceDefinitions"`
+}
+
+type EventResource struct {
+	ID                      string                `json:"id"`
+	OrdID                   *string               `json:"ordId,omitempty"`
+	SystemInstanceID        string                `json:"systemInstanceId"`
+	PartOfPackage           *string               `json:"partOfPackage,omitempty"`
+	PartOfConsumptionBundle *string               `json:"partOfConsumptionBundle,omitempty"`
+	Title                   string                `json:"title"`
+	ShortDescription        *string               `json:"shortDescription,omitempty"`
+	Description             *string               `json:"description,omitempty"`
+	Version                 *string               `json:"version,omitempty"`
+	ReleaseStatus           *string               `json:"releaseStatus,omitempty"`
+	Visibility              *string               `json:"visibility,omitempty"`
+	Disabled                *bool                 `json:"disabled,omitempty"`
+	Tags                    json.RawMessage       `json:"tags,omitempty"`
+	Countries               json.RawMessage       `json:"countries,omitempty"`
+	Links                   json.RawMessage       `json:"links,omitempty"`
+	Labels                  json.RawMessage       `json:"labels,omitempty"`
+	PartOfProducts          json.RawMessage       `json:"partOfProducts,omitempty"`
+	LineOfBusiness          json.RawMessage       `json:"lineOfBusiness,omitempty"`
+	Industry                json.RawMessage       `json:"industry,omitempty"`
+	ResourceDefinitions     []*ResourceDefinition `json:"resourceDefinitions"`
+}
+
+// ResourceDefinition describes a single specification of an API or Event resource.
+// Content is only populated when the specifications are explicitly expanded.
+type ResourceDefinition struct {
+	ID        string  `json:"id"`
+	Type      string  `json:"type"`
+	MediaType string  `json:"mediaType"`
+	URL       string  `json:"url"`
+	Content   *string `json:"content,omitempty"`
+}
+
+type ConsumptionBundle struct {
+	ID                           string          `json:"id"`
+	OrdID                        *string         `json:"ordId,omitempty"`
+	SystemInstanceID             string          `json:"systemInstanceId"`
+	Title                        string          `json:"title"`
+	ShortDescription             *string         `json:"shortDescription,omitempty"`
+	Description                  *string         `json:"description,omitempty"`
+	Links                        json.RawMessage `json:"links,omitempty"`
+	Labels                       json.RawMessage `json:"labels,omitempty"`
+	CredentialExchangeStrategies json.RawMessage `json:"credentialExchangeStrategies,omitempty"`
+}
+
+type Package struct {
+	ID                string          `json:"id"`
+	OrdID             string          `json:"ordId"`
+	SystemInstanceID  string          `json:"systemInstanceId"`
+	Vendor            *string         `json:"vendor,omitempty"`
+	Title             string          `json:"title"`
+	ShortDescription  string          `json:"shortDescription"`
+	Description       string          `json:"description"`
+	Version           string          `json:"version"`
+	PackageLinks      json.RawMessage `json:"packageLinks,omitempty"`
+	Links             json.RawMessage `json:"links,omitempty"`
+	LicenseType       *string         `json:"licenseType,omitempty"`
+	Tags              json.RawMessage `json:"tags,omitempty"`
+	Countries         json.RawMessage `json:"countries,omitempty"`
+	Labels            json.RawMessage `json:"labels,omitempty"`
+	PolicyLevel       string          `json:"policyLevel"`
+	CustomPolicyLevel *string         `json:"customPolicyLevel,omitempty"`
+	PartOfProducts    json.RawMessage `json:"partOfProducts,omitempty"`
+	LineOfBusiness    json.RawMessage `json:"lineOfBusiness,omitempty"`
+	Industry          json.RawMessage `json:"industry,omitempty"`
+}
+
+type Product struct {
+	OrdID            string          `json:"ordId"`
+	SystemInstanceID string          `json:"systemInstanceId"`
+	Title            string          `json:"title"`
+	ShortDescription string          `json:"shortDescription"`
+	Vendor           string          `json:"vendor"`
+	Parent           *string         `json:"parent,omitempty"`
+	PPMSObjectID     *string         `json:"sapPpmsObjectId,omitempty"`
+	Labels           json.RawMessage `json:"labels,omitempty"`
+}
+
+type Vendor struct {
+	OrdID            string          `json:"ordId"`
+	SystemInstanceID string          `json:"systemInstanceId"`
+	Title            string          `json:"title"`
+	Type             string          `json:"type"`
+	Labels           json.RawMessage `json:"labels,omitempty"`
+}
+
+func systemInstanceFromModel(in *model.Application) *SystemInstance {
+	return &SystemInstance{
+		ID:           in.ID,
+		Name:         in.Name,
+		Description:  in.Description,
+		ProviderName: in.ProviderName,
+		BaseURL:      in.BaseURL,
+		Labels:       in.Labels,
+	}
+}
+
+func apiResourceFromModel(in *model.APIDefinition, resourceDefinitions []*ResourceDefinition) *APIResource {
+	return &APIResource{
+		ID:                      in.ID,
+		OrdID:                   in.OrdID,
+		SystemInstanceID:        in.ApplicationID,
+		PartOfPackage:           in.PackageID,
+		PartOfConsumptionBundle: in.BundleID,
+		Title:                   in.Name,
+		ShortDescription:        in.ShortDescription,
+		Description:             in.Description,
+		Version:                 versionValue(in.Version),
+		EntryPoint:              in.TargetURL,
+		APIProtocol:             in.ApiProtocol,
+		ReleaseStatus:           in.ReleaseStatus,
+		Visibility:              in.Visibility,
+		Disabled:                in.Disabled,
+		Tags:                    in.Tags,
+		Countries:               in.Countries,
+		Links:                   in.Links,
+		Labels:                  in.Labels,
+		PartOfProducts:          in.PartOfProducts,
+		LineOfBusiness:          in.LineOfBusiness,
+		Industry:                in.Industry,
+		ResourceDefinitions:     resourceDefinitions,
+	}
+}
+
+func eventResourceFromModel(in *model.EventDefinition, resourceDefinitions []*ResourceDefinition) *EventResource {
+	return &EventResource{
+		ID:                      in.ID,
+		OrdID:                   in.OrdID,
+		SystemInstanceID:        in.ApplicationID,
+		PartOfPackage:           in.PackageID,
+		PartOfConsumptionBundle: in.BundleID,
+		Title:                   in.Name,
+		ShortDescription:        in.ShortDescription,
+		Description:             in.Description,
+		Version:                 versionValue(in.Version),
+		ReleaseStatus:           in.ReleaseStatus,
+		Visibility:              in.Visibility,
+		Disabled:                in.Disabled,
+		Tags:                    in.Tags,
+		Countries:               in.Countries,
+		Links:                   in.Links,
+		Labels:                  in.Labels,
+		PartOfProducts:          in.PartOfProducts,
+		LineOfBusiness:          in.LineOfBusiness,
+		Industry:                in.Industry,
+		ResourceDefinitions:     resourceDefinitions,
+	}
+}
+
+func consumptionBundleFromModel(in *model.Bundle) *ConsumptionBundle {
+	return &ConsumptionBundle{
+		ID:                           in.ID,
+		OrdID:                        in.OrdID,
+		SystemInstanceID:             in.ApplicationID,
+		Title:                        in.Name,
+		ShortDescription:             in.ShortDescription,
+		Description:                  in.Description,
+		Links:                        in.Links,
+		Labels:                       in.Labels,
+		CredentialExchangeStrategies: in.CredentialExchangeStrategies,
+	}
+}
+
+func packageFromModel(in *model.Package) *Package {
+	return &Package{
+		ID:                in.ID,
+		OrdID:             in.OrdID,
+		SystemInstanceID:  in.ApplicationID,
+		Vendor:            in.Vendor,
+		Title:             in.Title,
+		ShortDescription:  in.ShortDescription,
+		Description:       in.Description,
+		Version:           in.Version,
+		PackageLinks:      in.PackageLinks,
+		Links:             in.Links,
+		LicenseType:       in.LicenseType,
+		Tags:              in.Tags,
+		Countries:         in.Countries,
+		Labels:            in.Labels,
+		PolicyLevel:       in.PolicyLevel,
+		CustomPolicyLevel: in.CustomPolicyLevel,
+		PartOfProducts:    in.PartOfProducts,
+		LineOfBusiness:    in.LineOfBusiness,
+		Industry:          in.Industry,
+	}
+}
+
+func productFromModel(in *model.Product) *Product {
+	return &Product{
+		OrdID:            in.OrdID,
+		SystemInstanceID: in.ApplicationID,
+		Title:            in.Title,
+		ShortDescription: in.ShortDescription,
+		Vendor:           in.Vendor,
+		Parent:           in.Parent,
+		PPMSObjectID:     in.PPMSObjectID,
+		Labels:           in.Labels,
+	}
+}
+
+func vendorFromModel(in *model.Vendor) *Vendor {
+	return &Vendor{
+		OrdID:            in.OrdID,
+		SystemInstanceID: in.ApplicationID,
+		Title:            in.Title,
+		Type:             in.Type,
+		Labels:           in.Labels,
+	}
+}
+
+func resourceDefinitionFromModel(staticEndpoint, resourceType, resourceID string, spec *model.Spec, expand bool) *ResourceDefinition {
+	def := &ResourceDefinition{
+		ID:        spec.ID,
+		Type:      specType(spec),
+		MediaType: mediaType(spec.Format),
+		URL:       path.Join(staticEndpoint, resourceType, resourceID, "specification", spec.ID),
+	}
+	if expand {
+		def.Content = spec.Data
+	}
+
+	return def
+}
+
+func specType(spec *model.Spec) string {
+	switch {
+	case spec.CustomType != nil && *spec.CustomType != "":
+		return *spec.CustomType
+	case spec.APIType != nil:
+		return string(*spec.APIType)
+	case spec.EventType != nil:
+		return string(*spec.EventType)
+	}
+	return ""
+}
+
+func mediaType(format model.SpecFormat) string {
+	switch format {
+	case model.SpecFormatJSON, model.SpecFormatApplicationJSON:
+		return string(model.SpecFormatApplicationJSON)
+	case model.SpecFormatYaml, model.SpecFormatTextYAML:
+		return string(model.SpecFormatTextYAML)
+	case model.SpecFormatXML, model.SpecFormatApplicationXML:
+		return string(model.SpecFormatApplicationXML)
+	case "":
+		return string(model.SpecFormatOctetStream)
+	}
+	return strings.ToLower(string(format))
+}
+
+func versionValue(in *model.Version) *string {
+	if in == nil {
+		return nil
+	}
+	return &in.Value
+}
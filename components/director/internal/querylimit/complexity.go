@@ -0,0 +1,196 @@
+package querylimit
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+const (
+	defaultWeight           = 1
+	multiplierParam         = "first"
+	backwardMultiplierParam = "last"
+)
+
+// Complexity scores the operation by summing up the weights of its fields. The score of the fields nested in a field with the `first`
+// argument, which is either provided or defaulted in the schema, is multiplied by its value, as that many items can be returned.
+// When the `last` argument is provided, its value is used instead, as the page is then read backwards.
+// Fragments are scored as if all of them applied, and introspection fields are not scored.
+func Complexity(op *ast.OperationDefinition, vars map[string]interface{}, weights map[string]int) int {
+	return newComplexityCalculator(vars, weights).selectionSetComplexity(op.SelectionSet)
+}
+
+// Depth returns the maximum nesting of fields in the operation, not counting the fragments which group them
+func Depth(op *ast.OperationDefinition) int {
+	return newDepthCalculator().selectionSetDepth(op.SelectionSet)
+}
+
+// complexityCalculator scores the fields of a single operation.
+// The complexity of every fragment is computed once, so that fragments spread many times do not make the computation expensive.
+type complexityCalculator struct {
+	vars         map[string]interface{}
+	weights      map[string]int
+	complexities map[string]int
+	visiting     map[string]bool
+}
+
+func newComplexityCalculator(vars map[string]interface{}, weights map[string]int) *complexityCalculator {
+	return &complexityCalculator{
+		vars:         vars,
+		weights:      weights,
+		complexities: make(map[string]int),
+		visiting:     make(map[string]bool),
+	}
+}
+
+func (c *complexityCalculator) selectionSetComplexity(selectionSet ast.SelectionSet) int {
+	complexity := 0
+	for _, selection := range selectionSet {
+		switch typed := selection.(type) {
+		case *ast.Field:
+			complexity = safeAdd(complexity, c.fieldComplexity(typed))
+		case *ast.InlineFragment:
+			complexity = safeAdd(complexity, c.selectionSetComplexity(typed.SelectionSet))
+		case *ast.FragmentSpread:
+			complexity = safeAdd(complexity, c.fragmentComplexity(typed.Definition))
+		}
+	}
+	return complexity
+}
+
+func (c *complexityCalculator) fieldComplexity(field *ast.Field) int {
+	if strings.HasPrefix(field.Name, "__") {
+		return 0
+	}
+
+	weight := defaultWeight
+	if field.ObjectDefinition != nil {
+		if w, ok := c.weights[field.ObjectDefinition.Name+"."+field.Name]; ok {
+			weight = w
+		}
+	}
+
+	complexity := safeAdd(weight, c.selectionSetComplexity(field.SelectionSet))
+	return safeMultiply(complexity, multiplier(field, c.vars))
+}
+
+func (c *complexityCalculator) fragmentComplexity(fragment *ast.FragmentDefinition) int {
+	if fragment == nil || c.visiting[fragment.Name] {
+		// Undefined and cyclic fragments are rejected by the query validation
+		return 0
+	}
+	if complexity, ok := c.complexities[fragment.Name]; ok {
+		return complexity
+	}
+
+	c.visiting[fragment.Name] = true
+	complexity := c.selectionSetComplexity(fragment.SelectionSet)
+	delete(c.visiting, fragment.Name)
+
+	c.complexities[fragment.Name] = complexity
+	return complexity
+}
+
+func multiplier(field *ast.Field, vars map[string]interface{}) int {
+	if field.Definition == nil || field.Definition.Arguments.ForName(multiplierParam) == nil {
+		return 1
+	}
+
+	args := field.ArgumentMap(vars)
+	param := multiplierParam
+	if field.Definition.Arguments.ForName(backwardMultiplierParam) != nil && args[backwardMultiplierParam] != nil {
+		param = backwardMultiplierParam
+	}
+
+	switch value := args[param].(type) {
+	case int:
+		return atLeastOne(value)
+	case int64:
+		return atLeastOne(int(value))
+	case json.Number:
+		parsed, err := value.Int64()
+		if err != nil {
+			return 1
+		}
+		return atLeastOne(int(parsed))
+	default:
+		return 1
+	}
+}
+
+// depthCalculator computes the nesting of the fields of a single operation.
+// The depth of every fragment is computed once, so that fragments spread many times do not make the computation expensive.
+type depthCalculator struct {
+	depths   map[string]int
+	visiting map[string]bool
+}
+
+func newDepthCalculator() *depthCalculator {
+	return &depthCalculator{
+		depths:   make(map[string]int),
+		visiting: make(map[string]bool),
+	}
+}
+
+func (c *depthCalculator) selectionSetDepth(selectionSet ast.SelectionSet) int {
+	depth := 0
+	for _, selection := range selectionSet {
+		var selectionDepth int
+		switch typed := selection.(type) {
+		case *ast.Field:
+			if strings.HasPrefix(typed.Name, "__") {
+				continue
+			}
+			selectionDepth = 1 + c.selectionSetDepth(typed.SelectionSet)
+		case *ast.InlineFragment:
+			selectionDepth = c.selectionSetDepth(typed.SelectionSet)
+		case *ast.FragmentSpread:
+			selectionDepth = c.fragmentDepth(typed.Definition)
+		}
+		if selectionDepth > depth {
+			depth = selectionDepth
+		}
+	}
+	return depth
+}
+
+func (c *depthCalculator) fragmentDepth(fragment *ast.FragmentDefinition) int {
+	if fragment == nil || c.visiting[fragment.Name] {
+		// Undefined and cyclic fragments are rejected by the query validation
+		return 0
+	}
+	if depth, ok := c.depths[fragment.Name]; ok {
+		return depth
+	}
+
+	c.visiting[fragment.Name] = true
+	depth := c.selectionSetDepth(fragment.SelectionSet)
+	delete(c.visiting, fragment.Name)
+
+	c.depths[fragment.Name] = depth
+	return depth
+}
+
+func atLeastOne(value int) int {
+	if value < 1 {
+		return 1
+	}
+	return value
+}
+
+const maxComplexity = int(^uint(0) >> 1)
+
+func safeAdd(a, b int) int {
+	if a > maxComplexity-b {
+		return maxComplexity
+	}
+	return a + b
+}
+
+func safeMultiply(a, b int) int {
+	if a != 0 && b > maxComplexity/a {
+		return maxComplexity
+	}
+	return a * b
+}
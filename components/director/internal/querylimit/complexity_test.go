@@ -0,0 +1,157 @@
+package querylimit_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/querylimit"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestComplexity(t *testing.T) {
+	testCases := []struct {
+		Name               string
+		Query              string
+		Variables          map[string]interface{}
+		Weights            map[string]int
+		ExpectedComplexity int
+	}{
+		{
+			Name:               "Multiplies nested fields by first argument",
+			Query:              `{ applications(first: 10) { data { id name } } }`,
+			ExpectedComplexity: 40,
+		},
+		{
+			Name:               "Multiplies nested fields by default of first argument",
+			Query:              `{ applications { totalCount } }`,
+			ExpectedComplexity: 400,
+		},
+		{
+			Name:               "Multiplies nested fields by last argument",
+			Query:              `{ applications(last: 5) { data { id name } } }`,
+			ExpectedComplexity: 20,
+		},
+		{
+			Name:               "Multiplies nested lists",
+			Query:              `{ applications(first: 2) { data { bundles(first: 3) { data { id } } } } }`,
+			ExpectedComplexity: 22,
+		},
+		{
+			Name:               "Uses field weights",
+			Query:              `{ applications(first: 2) { data { bundles(first: 3) { data { id } } } } }`,
+			Weights:            map[string]int{"Application.bundles": 5},
+			ExpectedComplexity: 46,
+		},
+		{
+			Name:               "Resolves first argument from variables",
+			Query:              `query($n: Int) { applications(first: $n) { totalCount } }`,
+			Variables:          map[string]interface{}{"n": json.Number("5")},
+			ExpectedComplexity: 10,
+		},
+		{
+			Name:               "Counts fields of fragments",
+			Query:              `{ viewer { ...viewer } } fragment viewer on Viewer { id }`,
+			ExpectedComplexity: 2,
+		},
+		{
+			Name:               "Counts fragments spread many times",
+			Query:              fixNestedFragmentsQuery(25),
+			ExpectedComplexity: 1 << 25,
+		},
+		{
+			Name:               "Ignores introspection",
+			Query:              `{ __schema { types { name } } }`,
+			ExpectedComplexity: 0,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// GIVEN
+			op := fixOperation(t, testCase.Query)
+
+			// WHEN
+			complexity := querylimit.Complexity(op, testCase.Variables, testCase.Weights)
+
+			// THEN
+			assert.Equal(t, testCase.ExpectedComplexity, complexity)
+		})
+	}
+}
+
+func TestDepth(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		Query         string
+		ExpectedDepth int
+	}{
+		{
+			Name:          "Counts nested fields",
+			Query:         `{ applications { data { bundles { data { id } } } } }`,
+			ExpectedDepth: 5,
+		},
+		{
+			Name:          "Does not count fragments",
+			Query:         `{ viewer { ... on Viewer { ...viewer } } } fragment viewer on Viewer { id }`,
+			ExpectedDepth: 2,
+		},
+		{
+			Name:          "Does not count fragments spread many times",
+			Query:         fixNestedFragmentsQuery(25),
+			ExpectedDepth: 2,
+		},
+		{
+			Name:          "Ignores introspection",
+			Query:         `{ viewer { id } __schema { types { fields { type { name } } } } }`,
+			ExpectedDepth: 2,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// GIVEN
+			op := fixOperation(t, testCase.Query)
+
+			// WHEN
+			depth := querylimit.Depth(op)
+
+			// THEN
+			assert.Equal(t, testCase.ExpectedDepth, depth)
+		})
+	}
+}
+
+func TestParseFieldWeights(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		// WHEN
+		weights, err := querylimit.ParseFieldWeights([]string{"Application.bundles=5", " Query.applications = 2 ", ""}, fixSchema())
+
+		// THEN
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]int{"Application.bundles": 5, "Query.applications": 2}, weights)
+	})
+
+	t.Run("Error when format is invalid", func(t *testing.T) {
+		// WHEN
+		_, err := querylimit.ParseFieldWeights([]string{"Application.bundles"}, fixSchema())
+
+		// THEN
+		assert.EqualError(t, err, `invalid field weight "Application.bundles", expected format is Type.field=weight`)
+	})
+
+	t.Run("Error when field is not defined", func(t *testing.T) {
+		// WHEN
+		_, err := querylimit.ParseFieldWeights([]string{"Application.packages=5"}, fixSchema())
+
+		// THEN
+		assert.EqualError(t, err, "field Application.packages is not defined in the schema")
+	})
+
+	t.Run("Error when weight is invalid", func(t *testing.T) {
+		// WHEN
+		_, err := querylimit.ParseFieldWeights([]string{"Application.bundles=-1"}, fixSchema())
+
+		// THEN
+		assert.EqualError(t, err, "invalid weight of field Application.bundles, expected a non-negative integer")
+	})
+}
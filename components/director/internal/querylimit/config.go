@@ -0,0 +1,57 @@
+package querylimit
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+type Config struct {
+	// MaxDepth is the maximum nesting of fields in an operation. Zero disables the check.
+	MaxDepth int `envconfig:"default=15,APP_QUERY_MAX_DEPTH"`
+	// MaxComplexity is the maximum complexity score of an operation. Zero disables the check.
+	MaxComplexity int `envconfig:"default=1000000,APP_QUERY_MAX_COMPLEXITY"`
+	// FieldWeights override the default weight of 1 for the given fields, in the `Type.field=weight` format
+	FieldWeights []string `envconfig:"optional,APP_QUERY_FIELD_WEIGHTS"`
+	// RateLimit is the number of operations per second which a single consumer of a tenant can execute on every replica. Zero disables rate limiting.
+	RateLimit float64 `envconfig:"default=0,APP_QUERY_RATE_LIMIT"`
+	// RateLimitBurst is the number of operations which a consumer can execute at once, before being limited to RateLimit
+	RateLimitBurst int `envconfig:"default=50,APP_QUERY_RATE_LIMIT_BURST"`
+}
+
+// ParseFieldWeights parses the field weights and checks that the fields are defined in the schema
+func ParseFieldWeights(fieldWeights []string, schema *ast.Schema) (map[string]int, error) {
+	weights := make(map[string]int, len(fieldWeights))
+	for _, fieldWeight := range fieldWeights {
+		fieldWeight = strings.TrimSpace(fieldWeight)
+		if fieldWeight == "" {
+			continue
+		}
+
+		parts := strings.Split(fieldWeight, "=")
+		if len(parts) != 2 {
+			return nil, errors.Errorf("invalid field weight %q, expected format is Type.field=weight", fieldWeight)
+		}
+
+		field := strings.TrimSpace(parts[0])
+		typeAndField := strings.Split(field, ".")
+		if len(typeAndField) != 2 {
+			return nil, errors.Errorf("invalid field weight %q, expected format is Type.field=weight", fieldWeight)
+		}
+		def := schema.Types[typeAndField[0]]
+		if def == nil || def.Fields.ForName(typeAndField[1]) == nil {
+			return nil, errors.Errorf("field %s is not defined in the schema", field)
+		}
+
+		weight, err := strconv.Atoi(strings.TrimSpace(parts[1]))
+		if err != nil || weight < 0 {
+			return nil, errors.Errorf("invalid weight of field %s, expected a non-negative integer", field)
+		}
+
+		weights[field] = weight
+	}
+
+	return weights, nil
+}
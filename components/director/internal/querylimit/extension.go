@@ -0,0 +1,130 @@
+package querylimit
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	gqlgen "github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/errcode"
+	"github.com/kyma-incubator/compass/components/director/internal/consumer"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/tenant"
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/kyma-incubator/compass/components/director/pkg/ratelimit"
+	directorTime "github.com/kyma-incubator/compass/components/director/pkg/time"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+const (
+	DepthLimitExceededCode      = "DEPTH_LIMIT_EXCEEDED"
+	ComplexityLimitExceededCode = "COMPLEXITY_LIMIT_EXCEEDED"
+	RateLimitExceededCode       = "RATE_LIMIT_EXCEEDED"
+
+	RejectedByDepth      = "depth"
+	RejectedByComplexity = "complexity"
+	RejectedByRateLimit  = "rate_limit"
+)
+
+//go:generate mockery -name=MetricCollector -output=automock -outpkg=automock -case=underscore
+type MetricCollector interface {
+	ObserveQueryComplexity(complexity int)
+	RecordRejectedQuery(reason string)
+}
+
+type extension struct {
+	cfg         Config
+	weights     map[string]int
+	rateLimiter *ratelimit.RateLimiter
+	collector   MetricCollector
+}
+
+// NewExtension creates a GraphQL server extension which rejects operations exceeding the configured depth or complexity,
+// and limits the rate of operations of every consumer
+func NewExtension(cfg Config, schema *ast.Schema, collector MetricCollector, timeSvc directorTime.Service) (*extension, error) {
+	weights, err := ParseFieldWeights(cfg.FieldWeights, schema)
+	if err != nil {
+		return nil, err
+	}
+
+	var rateLimiter *ratelimit.RateLimiter
+	if cfg.RateLimit > 0 {
+		rateLimiter = ratelimit.NewRateLimiter(cfg.RateLimit, cfg.RateLimitBurst, timeSvc)
+	}
+
+	return &extension{
+		cfg:         cfg,
+		weights:     weights,
+		rateLimiter: rateLimiter,
+		collector:   collector,
+	}, nil
+}
+
+// ExtensionName should be a CamelCase string version of the extension which may be shown in stats and logging.
+func (e *extension) ExtensionName() string {
+	return "QueryLimitExtension"
+}
+
+// Validate is called when adding an extension to the server, it allows validation against the servers schema.
+func (e *extension) Validate(_ gqlgen.ExecutableSchema) error {
+	return nil
+}
+
+// MutateOperationContext rejects the operation before it is executed, if the consumer exceeded its rate limit or the operation is too deep or too complex
+func (e *extension) MutateOperationContext(ctx context.Context, rc *gqlgen.OperationContext) *gqlerror.Error {
+	if err := e.limitRate(ctx); err != nil {
+		return err
+	}
+
+	if rc.Operation == nil {
+		return nil
+	}
+
+	if e.cfg.MaxDepth > 0 {
+		if depth := Depth(rc.Operation); depth > e.cfg.MaxDepth {
+			return e.reject(ctx, RejectedByDepth, DepthLimitExceededCode, "operation has depth %d, which exceeds the limit of %d", depth, e.cfg.MaxDepth)
+		}
+	}
+
+	complexity := Complexity(rc.Operation, rc.Variables, e.weights)
+	e.collector.ObserveQueryComplexity(complexity)
+	if e.cfg.MaxComplexity > 0 && complexity > e.cfg.MaxComplexity {
+		return e.reject(ctx, RejectedByComplexity, ComplexityLimitExceededCode, "operation has complexity %d, which exceeds the limit of %d", complexity, e.cfg.MaxComplexity)
+	}
+
+	return nil
+}
+
+func (e *extension) limitRate(ctx context.Context) *gqlerror.Error {
+	if e.rateLimiter == nil {
+		return nil
+	}
+
+	consumerInfo, err := consumer.LoadFromContext(ctx)
+	if err != nil {
+		log.C(ctx).WithError(err).Debug("Consumer not found in context, the operation is not rate limited")
+		return nil
+	}
+	tenantID, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		tenantID = ""
+	}
+
+	allowed, retryAfter := e.rateLimiter.Allow(fmt.Sprintf("%s/%s", tenantID, consumerInfo.ConsumerID))
+	if allowed {
+		return nil
+	}
+
+	gqlErr := e.reject(ctx, RejectedByRateLimit, RateLimitExceededCode, "rate limit of %g operations per second exceeded for consumer %s", e.cfg.RateLimit, consumerInfo.ConsumerID)
+	gqlErr.Extensions["retryAfterSeconds"] = int(math.Ceil(retryAfter.Seconds()))
+	return gqlErr
+}
+
+func (e *extension) reject(ctx context.Context, reason, code string, format string, args ...interface{}) *gqlerror.Error {
+	e.collector.RecordRejectedQuery(reason)
+
+	gqlErr := gqlerror.Errorf(format, args...)
+	errcode.Set(gqlErr, code)
+	log.C(ctx).Infof("Rejecting GraphQL operation: %s", gqlErr.Message)
+	return gqlErr
+}
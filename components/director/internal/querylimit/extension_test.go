@@ -0,0 +1,120 @@
+package querylimit_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	gqlgen "github.com/99designs/gqlgen/graphql"
+	"github.com/kyma-incubator/compass/components/director/internal/consumer"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/tenant"
+	"github.com/kyma-incubator/compass/components/director/internal/querylimit"
+	"github.com/kyma-incubator/compass/components/director/internal/querylimit/automock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExtension_MutateOperationContext(t *testing.T) {
+	query := `{ applications(first: 10) { data { bundles(first: 10) { data { id } } } } }`
+	clock := &fakeClock{now: time.Date(2021, 3, 1, 12, 0, 0, 0, time.UTC)}
+
+	t.Run("Accepts operation within the limits", func(t *testing.T) {
+		// GIVEN
+		collector := &automock.MetricCollector{}
+		collector.On("ObserveQueryComplexity", 320).Once()
+		ext, err := querylimit.NewExtension(querylimit.Config{MaxDepth: 5, MaxComplexity: 320}, fixSchema(), collector, clock)
+		require.NoError(t, err)
+
+		// WHEN
+		gqlErr := ext.MutateOperationContext(context.TODO(), fixOperationContext(t, query))
+
+		// THEN
+		assert.Nil(t, gqlErr)
+		collector.AssertExpectations(t)
+	})
+
+	t.Run("Rejects too deep operation", func(t *testing.T) {
+		// GIVEN
+		collector := &automock.MetricCollector{}
+		collector.On("RecordRejectedQuery", querylimit.RejectedByDepth).Once()
+		ext, err := querylimit.NewExtension(querylimit.Config{MaxDepth: 4}, fixSchema(), collector, clock)
+		require.NoError(t, err)
+
+		// WHEN
+		gqlErr := ext.MutateOperationContext(context.TODO(), fixOperationContext(t, query))
+
+		// THEN
+		require.NotNil(t, gqlErr)
+		assert.Equal(t, "operation has depth 5, which exceeds the limit of 4", gqlErr.Message)
+		assert.Equal(t, querylimit.DepthLimitExceededCode, gqlErr.Extensions["code"])
+		collector.AssertExpectations(t)
+	})
+
+	t.Run("Rejects too complex operation", func(t *testing.T) {
+		// GIVEN
+		collector := &automock.MetricCollector{}
+		collector.On("ObserveQueryComplexity", 20220).Once()
+		collector.On("RecordRejectedQuery", querylimit.RejectedByComplexity).Once()
+		cfg := querylimit.Config{MaxComplexity: 10000, FieldWeights: []string{"Application.bundles=200"}}
+		ext, err := querylimit.NewExtension(cfg, fixSchema(), collector, clock)
+		require.NoError(t, err)
+
+		// WHEN
+		gqlErr := ext.MutateOperationContext(context.TODO(), fixOperationContext(t, query))
+
+		// THEN
+		require.NotNil(t, gqlErr)
+		assert.Equal(t, "operation has complexity 20220, which exceeds the limit of 10000", gqlErr.Message)
+		assert.Equal(t, querylimit.ComplexityLimitExceededCode, gqlErr.Extensions["code"])
+		collector.AssertExpectations(t)
+	})
+
+	t.Run("Rejects operation of consumer exceeding the rate limit", func(t *testing.T) {
+		// GIVEN
+		collector := &automock.MetricCollector{}
+		collector.On("ObserveQueryComplexity", mock.Anything)
+		collector.On("RecordRejectedQuery", querylimit.RejectedByRateLimit).Once()
+		ext, err := querylimit.NewExtension(querylimit.Config{RateLimit: 0.5, RateLimitBurst: 1}, fixSchema(), collector, clock)
+		require.NoError(t, err)
+
+		ctx := fixConsumerContext("tenant", "runtime")
+
+		// WHEN
+		first := ext.MutateOperationContext(ctx, fixOperationContext(t, query))
+		second := ext.MutateOperationContext(ctx, fixOperationContext(t, query))
+		otherConsumer := ext.MutateOperationContext(fixConsumerContext("tenant", "application"), fixOperationContext(t, query))
+		otherTenant := ext.MutateOperationContext(fixConsumerContext("other-tenant", "runtime"), fixOperationContext(t, query))
+
+		// THEN
+		assert.Nil(t, first)
+		require.NotNil(t, second)
+		assert.Equal(t, "rate limit of 0.5 operations per second exceeded for consumer runtime", second.Message)
+		assert.Equal(t, querylimit.RateLimitExceededCode, second.Extensions["code"])
+		assert.Equal(t, 2, second.Extensions["retryAfterSeconds"])
+		assert.Nil(t, otherConsumer)
+		assert.Nil(t, otherTenant)
+		collector.AssertExpectations(t)
+	})
+
+	t.Run("Returns error when field weights are invalid", func(t *testing.T) {
+		// WHEN
+		_, err := querylimit.NewExtension(querylimit.Config{FieldWeights: []string{"Bundle.name=1"}}, fixSchema(), &automock.MetricCollector{}, clock)
+
+		// THEN
+		require.EqualError(t, err, "field Bundle.name is not defined in the schema")
+	})
+}
+
+func fixOperationContext(t *testing.T, query string) *gqlgen.OperationContext {
+	return &gqlgen.OperationContext{
+		RawQuery:  query,
+		Operation: fixOperation(t, query),
+		Variables: map[string]interface{}{},
+	}
+}
+
+func fixConsumerContext(tenantID, consumerID string) context.Context {
+	ctx := tenant.SaveToContext(context.TODO(), tenantID, tenantID)
+	return consumer.SaveToContext(ctx, consumer.Consumer{ConsumerID: consumerID, ConsumerType: consumer.Runtime})
+}
@@ -0,0 +1,70 @@
+package querylimit_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+const testSchema = `
+type Query {
+	applications(first: Int = 200, last: Int): ApplicationPage
+	viewer: Viewer
+}
+
+type ApplicationPage {
+	data: [Application]
+	totalCount: Int
+}
+
+type Application {
+	id: ID
+	name: String
+	bundles(first: Int = 200): BundlePage
+}
+
+type BundlePage {
+	data: [Bundle]
+}
+
+type Bundle {
+	id: ID
+}
+
+type Viewer {
+	id: ID
+}
+`
+
+func fixSchema() *ast.Schema {
+	return gqlparser.MustLoadSchema(&ast.Source{Name: "schema.graphql", Input: testSchema})
+}
+
+func fixOperation(t *testing.T, query string) *ast.OperationDefinition {
+	doc, err := gqlparser.LoadQuery(fixSchema(), query)
+	require.Nil(t, err)
+	require.Len(t, doc.Operations, 1)
+	return doc.Operations[0]
+}
+
+// fixNestedFragmentsQuery returns a query with the given number of fragments, each spreading the next one twice,
+// so that the fields of the last fragment are selected 2^(count-1) times
+func fixNestedFragmentsQuery(count int) string {
+	query := "{ viewer { ...f0 } }"
+	for i := 0; i < count-1; i++ {
+		query += fmt.Sprintf(" fragment f%d on Viewer { id ...f%d ...f%d }", i, i+1, i+1)
+	}
+	return query + fmt.Sprintf(" fragment f%d on Viewer { id }", count-1)
+}
+
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.now
+}
@@ -58,6 +58,26 @@ func (c *notEqualCondition) GetQueryArgs() ([]interface{}, bool) {
 	return []interface{}{c.val}, true
 }
 
+func NewLessThanCondition(field string, val interface{}) Condition {
+	return &lessThanCondition{
+		field: field,
+		val:   val,
+	}
+}
+
+type lessThanCondition struct {
+	field string
+	val   interface{}
+}
+
+func (c *lessThanCondition) GetQueryPart() string {
+	return fmt.Sprintf("%s < ?", c.field)
+}
+
+func (c *lessThanCondition) GetQueryArgs() ([]interface{}, bool) {
+	return []interface{}{c.val}, true
+}
+
 func NewNotNullCondition(field string) Condition {
 	return &notNullCondition{
 		field: field,
@@ -0,0 +1,421 @@
+package repo
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp/syntax"
+	"strings"
+	"unicode"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+)
+
+// maxRegexRepeat is the largest repetition count PostgreSQL accepts in a regular expression bound
+const maxRegexRepeat = 255
+
+var selectorOperators = []string{"==", "!=", ">=", "<=", "||", "=", ">", "<", "(", ")", ",", "!"}
+
+var selectorKeywords = map[string]bool{
+	"exists": true,
+	"in":     true,
+	"notin":  true,
+	"prefix": true,
+	"regex":  true,
+}
+
+// IsLabelSelector reports whether the label filter query is written in the label selector grammar
+// rather than as a JSON value or an SQL/JSON path expression.
+func IsLabelSelector(query string) bool {
+	query = strings.TrimSpace(query)
+	for _, op := range []string{"=", "!", ">", "<"} {
+		if strings.HasPrefix(query, op) {
+			return true
+		}
+	}
+
+	word := strings.FieldsFunc(query, func(r rune) bool {
+		return !unicode.IsLetter(r)
+	})
+	return len(word) > 0 && strings.HasPrefix(query, word[0]) && selectorKeywords[word[0]]
+}
+
+// LabelSelector is a parsed label selector
+type LabelSelector struct {
+	// Condition on the label value, it is nil if the selector matches only objects without the label
+	Condition Condition
+	// MatchesMissing reports whether the selector matches objects without the label as well. They cannot be selected
+	// by a condition on the label value, so they have to be handled by the caller.
+	MatchesMissing bool
+}
+
+// ParseLabelSelector parses the label selector and returns a condition on the given JSONB column.
+//
+// The selector consists of one or more expressions joined with "||", each of which is one of:
+//
+//	exists                    - the label is set
+//	= VALUE, == VALUE         - the value is equal to VALUE
+//	!= VALUE                  - the value is not equal to VALUE
+//	in (VALUE, ...)           - the value is equal to one of the listed values
+//	notin (VALUE, ...)        - the value is equal to none of the listed values
+//	>, >=, <, <= NUMBER       - the value is a number which compares to NUMBER
+//	prefix "STRING"           - the value is a string starting with STRING
+//	regex "PATTERN"           - the value is a string matching the POSIX extended regular expression PATTERN
+//	! EXPRESSION              - the label is set and its value does not match EXPRESSION, !exists matches objects without the label
+//
+// VALUE is a JSON string, number, boolean or null. If the label value is an array, an expression matches if any of its elements matches,
+// and != and notin match if none of its elements is equal to the given values.
+func ParseLabelSelector(field, selector string) (*LabelSelector, error) {
+	tokens, err := tokenizeSelector(selector)
+	if err != nil {
+		return nil, apperrors.NewInvalidDataError("invalid label selector %q: %s", selector, err.Error())
+	}
+
+	p := &selectorParser{field: field, tokens: tokens}
+	parsed, err := p.parse()
+	if err != nil {
+		return nil, apperrors.NewInvalidDataError("invalid label selector %q: %s", selector, err.Error())
+	}
+
+	return parsed, nil
+}
+
+type selectorTokenType int
+
+const (
+	operatorToken selectorTokenType = iota
+	keywordToken
+	valueToken
+)
+
+type selectorToken struct {
+	typ   selectorTokenType
+	text  string
+	value interface{}
+}
+
+func tokenizeSelector(selector string) ([]selectorToken, error) {
+	var tokens []selectorToken
+
+	input := strings.TrimSpace(selector)
+	for len(input) > 0 {
+		token, rest, err := nextSelectorToken(input)
+		if err != nil {
+			return nil, err
+		}
+		tokens = append(tokens, token)
+		input = strings.TrimLeftFunc(rest, unicode.IsSpace)
+	}
+
+	return tokens, nil
+}
+
+func nextSelectorToken(input string) (selectorToken, string, error) {
+	for _, op := range selectorOperators {
+		if strings.HasPrefix(input, op) {
+			return selectorToken{typ: operatorToken, text: op}, input[len(op):], nil
+		}
+	}
+
+	end := strings.IndexFunc(input, func(r rune) bool {
+		return unicode.IsSpace(r) || strings.ContainsRune("=!<>|(),", r)
+	})
+	if input[0] == '"' {
+		end = closingQuoteIndex(input) + 1
+		if end == 0 {
+			return selectorToken{}, "", fmt.Errorf("unterminated string %s", input)
+		}
+	}
+	if end == -1 {
+		end = len(input)
+	}
+
+	text := input[:end]
+	if selectorKeywords[text] {
+		return selectorToken{typ: keywordToken, text: text}, input[end:], nil
+	}
+
+	decoder := json.NewDecoder(strings.NewReader(text))
+	decoder.UseNumber()
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil || decoder.More() {
+		return selectorToken{}, "", fmt.Errorf("unexpected %s", text)
+	}
+	if _, isObject := value.(map[string]interface{}); isObject {
+		return selectorToken{}, "", fmt.Errorf("unexpected %s", text)
+	}
+	if _, isArray := value.([]interface{}); isArray {
+		return selectorToken{}, "", fmt.Errorf("unexpected %s", text)
+	}
+
+	return selectorToken{typ: valueToken, text: text, value: value}, input[end:], nil
+}
+
+func closingQuoteIndex(input string) int {
+	escaped := false
+	for i := 1; i < len(input); i++ {
+		switch {
+		case escaped:
+			escaped = false
+		case input[i] == '\\':
+			escaped = true
+		case input[i] == '"':
+			return i
+		}
+	}
+	return -1
+}
+
+type selectorParser struct {
+	field  string
+	tokens []selectorToken
+	pos    int
+}
+
+func (p *selectorParser) parse() (*LabelSelector, error) {
+	selector := &LabelSelector{}
+
+	var conditions []*selectorCondition
+	for {
+		cond, err := p.parseExpression()
+		if err != nil {
+			return nil, err
+		}
+		if cond.missing {
+			selector.MatchesMissing = true
+		} else {
+			conditions = append(conditions, cond)
+		}
+
+		if p.done() {
+			break
+		}
+		if err := p.expectOperator("||"); err != nil {
+			return nil, err
+		}
+	}
+
+	switch len(conditions) {
+	case 0:
+	case 1:
+		selector.Condition = conditions[0]
+	default:
+		selector.Condition = &orCondition{conditions: conditions}
+	}
+	return selector, nil
+}
+
+func (p *selectorParser) parseExpression() (*selectorCondition, error) {
+	token, err := p.next()
+	if err != nil {
+		return nil, err
+	}
+
+	switch token.text {
+	case "!":
+		cond, err := p.parseExpression()
+		if err != nil {
+			return nil, err
+		}
+		return p.negate(cond), nil
+	case "exists":
+		return p.existsCondition(), nil
+	case "=", "==", "!=":
+		value, err := p.expectValue()
+		if err != nil {
+			return nil, err
+		}
+		return p.elementsCondition(token.text != "!=", "element = ?", value.text), nil
+	case "in", "notin":
+		values, err := p.parseValueList()
+		if err != nil {
+			return nil, err
+		}
+		placeholders := make([]string, 0, len(values))
+		args := make([]interface{}, 0, len(values))
+		for _, value := range values {
+			placeholders = append(placeholders, "?")
+			args = append(args, value.text)
+		}
+		return p.elementsCondition(token.text == "in", fmt.Sprintf("element IN (%s)", strings.Join(placeholders, ", ")), args...), nil
+	case ">", ">=", "<", "<=":
+		value, err := p.expectValue()
+		if err != nil {
+			return nil, err
+		}
+		number, ok := value.value.(json.Number)
+		if !ok {
+			return nil, fmt.Errorf("operator %s requires a number, got %s", token.text, value.text)
+		}
+		return p.elementsCondition(true, fmt.Sprintf("CASE WHEN jsonb_typeof(element) = 'number' THEN (element #>> '{}')::numeric END %s ?", token.text), number.String()), nil
+	case "prefix", "regex":
+		value, err := p.expectValue()
+		if err != nil {
+			return nil, err
+		}
+		str, ok := value.value.(string)
+		if !ok {
+			return nil, fmt.Errorf("operator %s requires a string, got %s", token.text, value.text)
+		}
+		if token.text == "prefix" {
+			return p.elementsCondition(true, "CASE WHEN jsonb_typeof(element) = 'string' THEN element #>> '{}' END LIKE ?", escapeLikePattern(str)+"%"), nil
+		}
+		if err := validateRegex(str); err != nil {
+			return nil, fmt.Errorf("invalid regular expression %s: %s", value.text, err.Error())
+		}
+		return p.elementsCondition(true, "CASE WHEN jsonb_typeof(element) = 'string' THEN element #>> '{}' END ~ ?", str), nil
+	}
+
+	return nil, fmt.Errorf("unexpected %s", token.text)
+}
+
+func (p *selectorParser) existsCondition() *selectorCondition {
+	return &selectorCondition{queryPart: fmt.Sprintf("%s IS NOT NULL", p.field), exists: true}
+}
+
+// negate builds a condition matching the labels which do not match the given condition, except for exists and !exists,
+// which are negated to each other
+func (p *selectorParser) negate(cond *selectorCondition) *selectorCondition {
+	switch {
+	case cond.exists:
+		return &selectorCondition{missing: true}
+	case cond.missing:
+		return p.existsCondition()
+	}
+	return &selectorCondition{queryPart: fmt.Sprintf("NOT (%s)", cond.queryPart), args: cond.args}
+}
+
+// elementsCondition builds a condition which checks the given predicate against the value, or against the elements of the value if it is an array
+func (p *selectorParser) elementsCondition(anyMatches bool, predicate string, args ...interface{}) *selectorCondition {
+	exists := "EXISTS"
+	if !anyMatches {
+		exists = "NOT EXISTS"
+	}
+
+	queryPart := fmt.Sprintf("%s (SELECT 1 FROM jsonb_array_elements(CASE WHEN jsonb_typeof(%[2]s) = 'array' THEN %[2]s ELSE jsonb_build_array(%[2]s) END) AS elements(element) WHERE %s)", exists, p.field, predicate)
+	return &selectorCondition{queryPart: queryPart, args: args}
+}
+
+func (p *selectorParser) parseValueList() ([]selectorToken, error) {
+	if err := p.expectOperator("("); err != nil {
+		return nil, err
+	}
+
+	var values []selectorToken
+	for {
+		value, err := p.expectValue()
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, value)
+
+		token, err := p.next()
+		if err != nil {
+			return nil, err
+		}
+		if token.text == ")" {
+			return values, nil
+		}
+		if token.text != "," {
+			return nil, fmt.Errorf("expected , or ) but got %s", token.text)
+		}
+	}
+}
+
+func (p *selectorParser) expectValue() (selectorToken, error) {
+	token, err := p.next()
+	if err != nil {
+		return selectorToken{}, err
+	}
+	if token.typ != valueToken {
+		return selectorToken{}, fmt.Errorf("expected a value but got %s", token.text)
+	}
+	return token, nil
+}
+
+func (p *selectorParser) expectOperator(op string) error {
+	token, err := p.next()
+	if err != nil {
+		return err
+	}
+	if token.typ != operatorToken || token.text != op {
+		return fmt.Errorf("expected %s but got %s", op, token.text)
+	}
+	return nil
+}
+
+func (p *selectorParser) next() (selectorToken, error) {
+	if p.done() {
+		return selectorToken{}, fmt.Errorf("unexpected end of selector")
+	}
+	token := p.tokens[p.pos]
+	p.pos++
+	return token, nil
+}
+
+func (p *selectorParser) done() bool {
+	return p.pos >= len(p.tokens)
+}
+
+// validateRegex makes sure the pattern is a POSIX extended regular expression. Such patterns have the same meaning
+// for PostgreSQL, which matches them as advanced regular expressions, while Perl extensions like \d or (?i) either
+// fail or mean something else there.
+func validateRegex(pattern string) error {
+	re, err := syntax.Parse(pattern, syntax.POSIX)
+	if err != nil {
+		return err
+	}
+	return validateRegexRepeats(re)
+}
+
+func validateRegexRepeats(re *syntax.Regexp) error {
+	if re.Op == syntax.OpRepeat && (re.Min > maxRegexRepeat || re.Max > maxRegexRepeat) {
+		return fmt.Errorf("repetition count exceeds %d", maxRegexRepeat)
+	}
+	for _, sub := range re.Sub {
+		if err := validateRegexRepeats(sub); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func escapeLikePattern(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
+}
+
+type selectorCondition struct {
+	queryPart string
+	args      []interface{}
+	// exists marks the condition of the exists expression
+	exists bool
+	// missing marks the condition of the !exists expression, which has no query part as it matches objects without the label
+	missing bool
+}
+
+func (c *selectorCondition) GetQueryPart() string {
+	return c.queryPart
+}
+
+func (c *selectorCondition) GetQueryArgs() ([]interface{}, bool) {
+	return c.args, len(c.args) > 0
+}
+
+type orCondition struct {
+	conditions []*selectorCondition
+}
+
+func (c *orCondition) GetQueryPart() string {
+	parts := make([]string, 0, len(c.conditions))
+	for _, cond := range c.conditions {
+		parts = append(parts, cond.GetQueryPart())
+	}
+	return fmt.Sprintf("(%s)", strings.Join(parts, " OR "))
+}
+
+func (c *orCondition) GetQueryArgs() ([]interface{}, bool) {
+	var args []interface{}
+	for _, cond := range c.conditions {
+		args = append(args, cond.args...)
+	}
+	return args, len(args) > 0
+}
@@ -0,0 +1,219 @@
+package repo_test
+
+import (
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/repo"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsLabelSelector(t *testing.T) {
+	for query, expected := range map[string]bool{
+		`= "foo"`:             true,
+		` != "foo"`:           true,
+		`in ("foo")`:          true,
+		`notin("foo")`:        true,
+		`exists`:              true,
+		`!exists`:             true,
+		`>= 5`:                true,
+		`prefix "foo"`:        true,
+		`regex "^foo"`:        true,
+		`"foo"`:               false,
+		`["foo"]`:             false,
+		`{"foo": "bar"}`:      false,
+		`true`:                false,
+		`$[*] ? (@ == "foo")`: false,
+		`inactive`:            false,
+	} {
+		t.Run(query, func(t *testing.T) {
+			assert.Equal(t, expected, repo.IsLabelSelector(query))
+		})
+	}
+}
+
+func TestParseLabelSelector(t *testing.T) {
+	elements := func(predicate string) string {
+		return `EXISTS (SELECT 1 FROM jsonb_array_elements(CASE WHEN jsonb_typeof("value") = 'array' THEN "value" ELSE jsonb_build_array("value") END) AS elements(element) WHERE ` + predicate + `)`
+	}
+
+	testCases := []struct {
+		Name                   string
+		Selector               string
+		ExpectedQueryPart      string
+		ExpectedArgs           []interface{}
+		ExpectedMatchesMissing bool
+		ExpectedErr            string
+	}{
+		{
+			Name:              "Existence",
+			Selector:          "exists",
+			ExpectedQueryPart: `"value" IS NOT NULL`,
+		},
+		{
+			Name:              "Equality",
+			Selector:          `= "foo"`,
+			ExpectedQueryPart: elements(`element = ?`),
+			ExpectedArgs:      []interface{}{`"foo"`},
+		},
+		{
+			Name:              "Equality with double equals sign and number",
+			Selector:          `==5`,
+			ExpectedQueryPart: elements(`element = ?`),
+			ExpectedArgs:      []interface{}{`5`},
+		},
+		{
+			Name:              "Inequality",
+			Selector:          `!= "foo"`,
+			ExpectedQueryPart: "NOT " + elements(`element = ?`),
+			ExpectedArgs:      []interface{}{`"foo"`},
+		},
+		{
+			Name:              "In",
+			Selector:          `in ("foo", "bar baz", true)`,
+			ExpectedQueryPart: elements(`element IN (?, ?, ?)`),
+			ExpectedArgs:      []interface{}{`"foo"`, `"bar baz"`, `true`},
+		},
+		{
+			Name:              "Not in",
+			Selector:          `notin ("foo")`,
+			ExpectedQueryPart: "NOT " + elements(`element IN (?)`),
+			ExpectedArgs:      []interface{}{`"foo"`},
+		},
+		{
+			Name:              "Numeric comparison",
+			Selector:          `>= -1.5`,
+			ExpectedQueryPart: elements(`CASE WHEN jsonb_typeof(element) = 'number' THEN (element #>> '{}')::numeric END >= ?`),
+			ExpectedArgs:      []interface{}{`-1.5`},
+		},
+		{
+			Name:              "Prefix",
+			Selector:          `prefix "foo_100%"`,
+			ExpectedQueryPart: elements(`CASE WHEN jsonb_typeof(element) = 'string' THEN element #>> '{}' END LIKE ?`),
+			ExpectedArgs:      []interface{}{`foo\_100\%%`},
+		},
+		{
+			Name:              "Regex",
+			Selector:          `regex "^foo-[0-9]+$"`,
+			ExpectedQueryPart: elements(`CASE WHEN jsonb_typeof(element) = 'string' THEN element #>> '{}' END ~ ?`),
+			ExpectedArgs:      []interface{}{`^foo-[0-9]+$`},
+		},
+		{
+			Name:              "Alternatives",
+			Selector:          `= "foo" || < 3`,
+			ExpectedQueryPart: "(" + elements(`element = ?`) + " OR " + elements(`CASE WHEN jsonb_typeof(element) = 'number' THEN (element #>> '{}')::numeric END < ?`) + ")",
+			ExpectedArgs:      []interface{}{`"foo"`, `3`},
+		},
+		{
+			Name:                   "Negated existence",
+			Selector:               `!exists`,
+			ExpectedMatchesMissing: true,
+		},
+		{
+			Name:                   "Negated existence as an alternative",
+			Selector:               `= "foo" || ! exists`,
+			ExpectedQueryPart:      elements(`element = ?`),
+			ExpectedArgs:           []interface{}{`"foo"`},
+			ExpectedMatchesMissing: true,
+		},
+		{
+			Name:              "Double negated existence",
+			Selector:          `!!exists`,
+			ExpectedQueryPart: `"value" IS NOT NULL`,
+		},
+		{
+			Name:              "Negation",
+			Selector:          `!regex "^foo"`,
+			ExpectedQueryPart: "NOT (" + elements(`CASE WHEN jsonb_typeof(element) = 'string' THEN element #>> '{}' END ~ ?`) + ")",
+			ExpectedArgs:      []interface{}{`^foo`},
+		},
+		{
+			Name:        "Negation without expression",
+			Selector:    `exists || !`,
+			ExpectedErr: `unexpected end of selector`,
+		},
+		{
+			Name:        "Numeric comparison with string",
+			Selector:    `> "5"`,
+			ExpectedErr: `operator > requires a number`,
+		},
+		{
+			Name:        "Prefix with number",
+			Selector:    `prefix 5`,
+			ExpectedErr: `operator prefix requires a string`,
+		},
+		{
+			Name:        "Invalid regex",
+			Selector:    `regex "(foo"`,
+			ExpectedErr: `invalid regular expression`,
+		},
+		{
+			Name:        "Regex with Perl character class",
+			Selector:    `regex "^\\d+$"`,
+			ExpectedErr: `invalid escape sequence`,
+		},
+		{
+			Name:        "Regex with flags",
+			Selector:    `regex "(?i)foo"`,
+			ExpectedErr: `invalid regular expression`,
+		},
+		{
+			Name:        "Regex with too large repetition count",
+			Selector:    `regex "^a{1,300}$"`,
+			ExpectedErr: `repetition count exceeds 255`,
+		},
+		{
+			Name:        "Unterminated string",
+			Selector:    `= "foo`,
+			ExpectedErr: `unterminated string`,
+		},
+		{
+			Name:        "Missing value",
+			Selector:    `=`,
+			ExpectedErr: `unexpected end of selector`,
+		},
+		{
+			Name:        "Unclosed list",
+			Selector:    `in ("foo" "bar")`,
+			ExpectedErr: `expected , or ) but got "bar"`,
+		},
+		{
+			Name:        "Object values are not supported",
+			Selector:    `= {}`,
+			ExpectedErr: `unexpected {}`,
+		},
+		{
+			Name:        "Missing alternative operator",
+			Selector:    `= "foo" = "bar"`,
+			ExpectedErr: `expected || but got =`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// WHEN
+			selector, err := repo.ParseLabelSelector(`"value"`, testCase.Selector)
+
+			// THEN
+			if testCase.ExpectedErr != "" {
+				require.Error(t, err)
+				assert.Equal(t, apperrors.InvalidData, apperrors.ErrorCode(err))
+				assert.Contains(t, err.Error(), testCase.ExpectedErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, testCase.ExpectedMatchesMissing, selector.MatchesMissing)
+			if testCase.ExpectedQueryPart == "" {
+				assert.Nil(t, selector.Condition)
+				return
+			}
+			require.NotNil(t, selector.Condition)
+			assert.Equal(t, testCase.ExpectedQueryPart, selector.Condition.GetQueryPart())
+			args, hasArgs := selector.Condition.GetQueryArgs()
+			assert.Equal(t, testCase.ExpectedArgs, args)
+			assert.Equal(t, len(testCase.ExpectedArgs) > 0, hasArgs)
+		})
+	}
+}
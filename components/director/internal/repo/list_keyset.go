@@ -0,0 +1,241 @@
+package repo
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jmoiron/sqlx/reflectx"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+	"github.com/pkg/errors"
+)
+
+// keysetIDColumn is the column used as a tie-breaker between the rows with equal values of the sort column
+const keysetIDColumn = "id"
+
+var keysetMapper = reflectx.NewMapperFunc("db", strings.ToLower)
+
+// ListByKeyset returns a page of objects ordered by the given column and the ID, seeking to the position stored in the page cursor
+// instead of skipping the preceding rows. Offset cursors issued before are still accepted when paging forwards.
+func (g *universalPageableQuerier) ListByKeyset(ctx context.Context, tenant string, page pagination.Request, orderBy OrderBy, dest Collection, additionalConditions ...Condition) (*pagination.Page, int, error) {
+	if tenant == "" {
+		return nil, -1, apperrors.NewTenantRequiredError()
+	}
+
+	additionalConditions = append(Conditions{NewEqualCondition(*g.tenantColumn, tenant)}, additionalConditions...)
+	return g.unsafeListByKeyset(ctx, page, orderBy, dest, additionalConditions...)
+}
+
+func (g *universalPageableQuerier) ListGlobalByKeyset(ctx context.Context, page pagination.Request, orderBy OrderBy, dest Collection, additionalConditions ...Condition) (*pagination.Page, int, error) {
+	return g.unsafeListByKeyset(ctx, page, orderBy, dest, additionalConditions...)
+}
+
+func (g *universalPageableQuerier) unsafeListByKeyset(ctx context.Context, page pagination.Request, orderBy OrderBy, dest Collection, conditions ...Condition) (*pagination.Page, int, error) {
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		return nil, -1, err
+	}
+
+	if orderBy.Field == "" {
+		return nil, -1, apperrors.NewInvalidDataError("to use pagination you must provide column to order by")
+	}
+
+	if page.PageSize < 1 {
+		return nil, -1, apperrors.NewInvalidDataError("page size cannot be smaller than 1")
+	}
+
+	keyset, offset, err := pagination.DecodeCursor(page.Cursor)
+	if err != nil {
+		return nil, -1, errors.Wrap(err, "while decoding page cursor")
+	}
+
+	descending := orderBy.Dir == DescOrderBy
+	if keyset != nil && (keyset.OrderBy != orderBy.Field || keyset.Descending != descending) {
+		return nil, -1, apperrors.NewInvalidDataError("cursor does not match the requested order")
+	}
+
+	if keyset == nil && offset > 0 && page.Backward {
+		return nil, -1, apperrors.NewInvalidDataError("offset cursor cannot be used to page backwards")
+	}
+
+	countQuery, countArgs, err := buildSelectQuery(g.tableName, g.selectedColumns, conditions, NoOrderBy)
+	if err != nil {
+		return nil, -1, errors.Wrap(err, "while building count query")
+	}
+
+	// Backward pages are read in the reversed order and reversed back once fetched
+	scanDir := orderBy.Dir
+	if page.Backward {
+		scanDir = reverseOrderByDir(orderBy.Dir)
+	}
+
+	pageConditions := append(Conditions{}, conditions...)
+	if keyset != nil {
+		pageConditions = append(pageConditions, newKeysetCondition(orderBy.Field, scanDir, keyset))
+	}
+
+	orderByParams := OrderByParams{{Field: orderBy.Field, Dir: scanDir}}
+	if orderBy.Field != keysetIDColumn {
+		orderByParams = append(orderByParams, OrderBy{Field: keysetIDColumn, Dir: scanDir})
+	}
+
+	query, args, err := buildSelectQuery(g.tableName, g.selectedColumns, pageConditions, orderByParams)
+	if err != nil {
+		return nil, -1, errors.Wrap(err, "while building list query")
+	}
+
+	// One more row than requested is fetched to find out whether there is another page in the paging direction
+	stmtWithPagination := fmt.Sprintf("%s LIMIT %d", query, page.PageSize+1)
+	if offset > 0 {
+		stmtWithPagination = fmt.Sprintf("%s OFFSET %d", stmtWithPagination, offset)
+	}
+
+	err = persist.Select(dest, stmtWithPagination, args...)
+	if err != nil {
+		return nil, -1, errors.Wrap(err, "while fetching list of objects from DB")
+	}
+
+	rows := reflect.ValueOf(dest).Elem()
+	hasMore := rows.Len() > page.PageSize
+	if hasMore {
+		rows.Set(rows.Slice(0, page.PageSize))
+	}
+	if page.Backward {
+		reverseRows(rows)
+	}
+
+	totalCount, err := g.getTotalCount(persist, countQuery, countArgs)
+	if err != nil {
+		return nil, -1, err
+	}
+
+	pageInfo := &pagination.Page{
+		HasNextPage:     hasMore,
+		HasPreviousPage: page.Cursor != "",
+	}
+	if page.Backward {
+		pageInfo.HasNextPage = page.Cursor != ""
+		pageInfo.HasPreviousPage = hasMore
+	}
+
+	if rows.Len() > 0 {
+		if pageInfo.StartCursor, err = encodeKeysetCursor(rows.Index(0), orderBy); err != nil {
+			return nil, -1, err
+		}
+		if pageInfo.EndCursor, err = encodeKeysetCursor(rows.Index(rows.Len()-1), orderBy); err != nil {
+			return nil, -1, err
+		}
+	}
+
+	return pageInfo, totalCount, nil
+}
+
+func reverseOrderByDir(dir OrderByDir) OrderByDir {
+	if dir == DescOrderBy {
+		return AscOrderBy
+	}
+	return DescOrderBy
+}
+
+func reverseRows(rows reflect.Value) {
+	swap := reflect.Swapper(rows.Interface())
+	for i, j := 0, rows.Len()-1; i < j; i, j = i+1, j-1 {
+		swap(i, j)
+	}
+}
+
+func encodeKeysetCursor(row reflect.Value, orderBy OrderBy) (string, error) {
+	sortValue, err := keysetColumnValue(row, orderBy.Field)
+	if err != nil {
+		return "", err
+	}
+
+	id, err := keysetColumnValue(row, keysetIDColumn)
+	if err != nil {
+		return "", err
+	}
+
+	return pagination.EncodeKeysetCursor(pagination.Keyset{
+		OrderBy:    orderBy.Field,
+		Descending: orderBy.Dir == DescOrderBy,
+		SortValue:  sortValue,
+		ID:         id,
+	})
+}
+
+// keysetColumnValue returns the value of the struct field mapped to the given column, formatted so that it can be compared with the column in the query
+func keysetColumnValue(row reflect.Value, column string) (string, error) {
+	field := keysetMapper.FieldByName(reflect.Indirect(row), column)
+	if !field.IsValid() {
+		return "", apperrors.NewInternalError("column %s used for keyset pagination is not mapped", column)
+	}
+
+	value := field.Interface()
+	if valuer, ok := value.(driver.Valuer); ok {
+		v, err := valuer.Value()
+		if err != nil {
+			return "", errors.Wrapf(err, "while getting value of column %s", column)
+		}
+		value = v
+	}
+
+	switch v := value.(type) {
+	case string:
+		return v, nil
+	case time.Time:
+		return v.Format(time.RFC3339Nano), nil
+	case *time.Time:
+		if v != nil {
+			return v.Format(time.RFC3339Nano), nil
+		}
+	case int:
+		return strconv.Itoa(v), nil
+	case int64:
+		return strconv.FormatInt(v, 10), nil
+	default:
+		if value != nil {
+			return "", apperrors.NewInternalError("column %s of type %T cannot be used for keyset pagination", column, value)
+		}
+	}
+
+	return "", apperrors.NewInternalError("column %s used for keyset pagination cannot be null", column)
+}
+
+// newKeysetCondition returns a condition matching the rows placed after the keyset when reading in the given direction
+func newKeysetCondition(field string, dir OrderByDir, keyset *pagination.Keyset) Condition {
+	comparator := ">"
+	if dir == DescOrderBy {
+		comparator = "<"
+	}
+
+	if field == keysetIDColumn {
+		return &keysetCondition{
+			queryPart: fmt.Sprintf("%s %s ?", keysetIDColumn, comparator),
+			args:      []interface{}{keyset.ID},
+		}
+	}
+
+	return &keysetCondition{
+		queryPart: fmt.Sprintf("(%s, %s) %s (?, ?)", field, keysetIDColumn, comparator),
+		args:      []interface{}{keyset.SortValue, keyset.ID},
+	}
+}
+
+type keysetCondition struct {
+	queryPart string
+	args      []interface{}
+}
+
+func (c *keysetCondition) GetQueryPart() string {
+	return c.queryPart
+}
+
+func (c *keysetCondition) GetQueryArgs() ([]interface{}, bool) {
+	return c.args, true
+}
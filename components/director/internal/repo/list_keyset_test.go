@@ -0,0 +1,243 @@
+package repo_test
+
+import (
+	"context"
+	"database/sql/driver"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jmoiron/sqlx"
+	"github.com/kyma-incubator/compass/components/director/internal/repo"
+	"github.com/kyma-incubator/compass/components/director/internal/repo/testdb"
+	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Pet is an exemplary type to test keyset pagination, which requires an id column
+type Pet struct {
+	ID     string `db:"id"`
+	Tenant string `db:"tenant_id"`
+	Name   string `db:"name"`
+}
+
+type PetCollection []Pet
+
+func (p PetCollection) Len() int {
+	return len(p)
+}
+
+func TestListByKeyset(t *testing.T) {
+	givenTenant := uuidB()
+	brian := Pet{ID: uuidA(), Tenant: givenTenant, Name: "Brian"}
+	brianRow := []driver.Value{brian.ID, givenTenant, brian.Name}
+	garfield := Pet{ID: uuidC(), Tenant: givenTenant, Name: "Garfield"}
+	garfieldRow := []driver.Value{garfield.ID, givenTenant, garfield.Name}
+	odie := Pet{ID: "dddddddd-dddd-dddd-dddd-dddddddddddd", Tenant: givenTenant, Name: "Odie"}
+	odieRow := []driver.Value{odie.ID, givenTenant, odie.Name}
+
+	columns := []string{"id", "tenant_id", "name"}
+	countQuery := regexp.QuoteMeta("SELECT COUNT(*) FROM pets WHERE tenant_id = $1")
+
+	sut := repo.NewPageableQuerier("PetType", "pets", "tenant_id", columns)
+
+	t.Run("returns first page and has next page", func(t *testing.T) {
+		db, mock := testdb.MockDatabase(t)
+		defer mock.AssertExpectations(t)
+
+		rows := sqlmock.NewRows(columns).AddRow(brianRow...).AddRow(garfieldRow...).AddRow(odieRow...)
+		mock.ExpectQuery(regexp.QuoteMeta("SELECT id, tenant_id, name FROM pets WHERE tenant_id = $1 ORDER BY name ASC, id ASC LIMIT 3")).WithArgs(givenTenant).WillReturnRows(rows)
+		mock.ExpectQuery(countQuery).WithArgs(givenTenant).WillReturnRows(sqlmock.NewRows([]string{""}).AddRow(3))
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		var dest PetCollection
+
+		actualPage, actualTotal, err := sut.ListByKeyset(ctx, givenTenant, pagination.Request{PageSize: 2}, repo.NewAscOrderBy("name"), &dest)
+		require.NoError(t, err)
+		assert.Equal(t, 3, actualTotal)
+		assert.Equal(t, PetCollection{brian, garfield}, dest)
+		assert.True(t, actualPage.HasNextPage)
+		assert.False(t, actualPage.HasPreviousPage)
+		assertKeysetCursor(t, pagination.Keyset{OrderBy: "name", SortValue: brian.Name, ID: brian.ID}, actualPage.StartCursor)
+		assertKeysetCursor(t, pagination.Keyset{OrderBy: "name", SortValue: garfield.Name, ID: garfield.ID}, actualPage.EndCursor)
+	})
+
+	t.Run("returns next page using the cursor", func(t *testing.T) {
+		db, mock := testdb.MockDatabase(t)
+		defer mock.AssertExpectations(t)
+
+		cursor, err := pagination.EncodeKeysetCursor(pagination.Keyset{OrderBy: "name", SortValue: garfield.Name, ID: garfield.ID})
+		require.NoError(t, err)
+
+		rows := sqlmock.NewRows(columns).AddRow(odieRow...)
+		mock.ExpectQuery(regexp.QuoteMeta("SELECT id, tenant_id, name FROM pets WHERE tenant_id = $1 AND (name, id) > ($2, $3) ORDER BY name ASC, id ASC LIMIT 3")).
+			WithArgs(givenTenant, garfield.Name, garfield.ID).WillReturnRows(rows)
+		mock.ExpectQuery(countQuery).WithArgs(givenTenant).WillReturnRows(sqlmock.NewRows([]string{""}).AddRow(3))
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		var dest PetCollection
+
+		actualPage, actualTotal, err := sut.ListByKeyset(ctx, givenTenant, pagination.Request{PageSize: 2, Cursor: cursor}, repo.NewAscOrderBy("name"), &dest)
+		require.NoError(t, err)
+		assert.Equal(t, 3, actualTotal)
+		assert.Equal(t, PetCollection{odie}, dest)
+		assert.False(t, actualPage.HasNextPage)
+		assert.True(t, actualPage.HasPreviousPage)
+		assertKeysetCursor(t, pagination.Keyset{OrderBy: "name", SortValue: odie.Name, ID: odie.ID}, actualPage.EndCursor)
+	})
+
+	t.Run("returns previous page in descending order using the cursor", func(t *testing.T) {
+		db, mock := testdb.MockDatabase(t)
+		defer mock.AssertExpectations(t)
+
+		cursor, err := pagination.EncodeKeysetCursor(pagination.Keyset{OrderBy: "id", Descending: true, SortValue: brian.ID, ID: brian.ID})
+		require.NoError(t, err)
+
+		rows := sqlmock.NewRows(columns).AddRow(garfieldRow...).AddRow(odieRow...)
+		mock.ExpectQuery(regexp.QuoteMeta("SELECT id, tenant_id, name FROM pets WHERE tenant_id = $1 AND id > $2 ORDER BY id ASC LIMIT 3")).
+			WithArgs(givenTenant, brian.ID).WillReturnRows(rows)
+		mock.ExpectQuery(countQuery).WithArgs(givenTenant).WillReturnRows(sqlmock.NewRows([]string{""}).AddRow(3))
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		var dest PetCollection
+
+		actualPage, actualTotal, err := sut.ListByKeyset(ctx, givenTenant, pagination.Request{PageSize: 2, Cursor: cursor, Backward: true}, repo.NewDescOrderBy("id"), &dest)
+		require.NoError(t, err)
+		assert.Equal(t, 3, actualTotal)
+		assert.Equal(t, PetCollection{odie, garfield}, dest)
+		assert.True(t, actualPage.HasNextPage)
+		assert.False(t, actualPage.HasPreviousPage)
+		assertKeysetCursor(t, pagination.Keyset{OrderBy: "id", Descending: true, SortValue: odie.ID, ID: odie.ID}, actualPage.StartCursor)
+		assertKeysetCursor(t, pagination.Keyset{OrderBy: "id", Descending: true, SortValue: garfield.ID, ID: garfield.ID}, actualPage.EndCursor)
+	})
+
+	t.Run("returns last page when paging backwards without cursor", func(t *testing.T) {
+		db, mock := testdb.MockDatabase(t)
+		defer mock.AssertExpectations(t)
+
+		rows := sqlmock.NewRows(columns).AddRow(odieRow...).AddRow(garfieldRow...).AddRow(brianRow...)
+		mock.ExpectQuery(regexp.QuoteMeta("SELECT id, tenant_id, name FROM pets WHERE tenant_id = $1 ORDER BY name DESC, id DESC LIMIT 3")).WithArgs(givenTenant).WillReturnRows(rows)
+		mock.ExpectQuery(countQuery).WithArgs(givenTenant).WillReturnRows(sqlmock.NewRows([]string{""}).AddRow(3))
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		var dest PetCollection
+
+		actualPage, _, err := sut.ListByKeyset(ctx, givenTenant, pagination.Request{PageSize: 2, Backward: true}, repo.NewAscOrderBy("name"), &dest)
+		require.NoError(t, err)
+		assert.Equal(t, PetCollection{garfield, odie}, dest)
+		assert.False(t, actualPage.HasNextPage)
+		assert.True(t, actualPage.HasPreviousPage)
+	})
+
+	t.Run("returns next page using the offset cursor", func(t *testing.T) {
+		db, mock := testdb.MockDatabase(t)
+		defer mock.AssertExpectations(t)
+
+		rows := sqlmock.NewRows(columns).AddRow(odieRow...)
+		mock.ExpectQuery(regexp.QuoteMeta("SELECT id, tenant_id, name FROM pets WHERE tenant_id = $1 ORDER BY name ASC, id ASC LIMIT 3 OFFSET 2")).WithArgs(givenTenant).WillReturnRows(rows)
+		mock.ExpectQuery(countQuery).WithArgs(givenTenant).WillReturnRows(sqlmock.NewRows([]string{""}).AddRow(3))
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		var dest PetCollection
+
+		actualPage, _, err := sut.ListByKeyset(ctx, givenTenant, pagination.Request{PageSize: 2, Cursor: pagination.EncodeNextOffsetCursor(0, 2)}, repo.NewAscOrderBy("name"), &dest)
+		require.NoError(t, err)
+		assert.Equal(t, PetCollection{odie}, dest)
+		assert.False(t, actualPage.HasNextPage)
+		assert.True(t, actualPage.HasPreviousPage)
+		assertKeysetCursor(t, pagination.Keyset{OrderBy: "name", SortValue: odie.Name, ID: odie.ID}, actualPage.EndCursor)
+	})
+
+	t.Run("returns empty page", func(t *testing.T) {
+		db, mock := testdb.MockDatabase(t)
+		defer mock.AssertExpectations(t)
+
+		mock.ExpectQuery(regexp.QuoteMeta("SELECT id, tenant_id, name FROM pets WHERE tenant_id = $1 AND name = $2 ORDER BY name ASC, id ASC LIMIT 3")).
+			WithArgs(givenTenant, "Lassie").WillReturnRows(sqlmock.NewRows(columns))
+		mock.ExpectQuery(regexp.QuoteMeta("SELECT COUNT(*) FROM pets WHERE tenant_id = $1 AND name = $2")).WithArgs(givenTenant, "Lassie").WillReturnRows(sqlmock.NewRows([]string{""}).AddRow(0))
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		var dest PetCollection
+
+		actualPage, actualTotal, err := sut.ListByKeyset(ctx, givenTenant, pagination.Request{PageSize: 2}, repo.NewAscOrderBy("name"), &dest, repo.NewEqualCondition("name", "Lassie"))
+		require.NoError(t, err)
+		assert.Equal(t, 0, actualTotal)
+		assert.Empty(t, dest)
+		assert.Equal(t, &pagination.Page{}, actualPage)
+	})
+
+	t.Run("returns error if cursor does not match the order", func(t *testing.T) {
+		ctx := persistence.SaveToContext(context.TODO(), &sqlx.Tx{})
+		cursor, err := pagination.EncodeKeysetCursor(pagination.Keyset{OrderBy: "name", SortValue: garfield.Name, ID: garfield.ID})
+		require.NoError(t, err)
+
+		_, _, err = sut.ListByKeyset(ctx, givenTenant, pagination.Request{PageSize: 2, Cursor: cursor}, repo.NewDescOrderBy("name"), nil)
+		require.EqualError(t, err, "Invalid data [reason=cursor does not match the requested order]")
+	})
+
+	t.Run("returns error if offset cursor is used to page backwards", func(t *testing.T) {
+		ctx := persistence.SaveToContext(context.TODO(), &sqlx.Tx{})
+		_, _, err := sut.ListByKeyset(ctx, givenTenant, pagination.Request{PageSize: 2, Cursor: pagination.EncodeNextOffsetCursor(0, 2), Backward: true}, repo.NewAscOrderBy("name"), nil)
+		require.EqualError(t, err, "Invalid data [reason=offset cursor cannot be used to page backwards]")
+	})
+
+	t.Run("returns error if wrong cursor", func(t *testing.T) {
+		ctx := persistence.SaveToContext(context.TODO(), &sqlx.Tx{})
+		_, _, err := sut.ListByKeyset(ctx, givenTenant, pagination.Request{PageSize: 2, Cursor: "zzz"}, repo.NewAscOrderBy("name"), nil)
+		require.EqualError(t, err, "while decoding page cursor: cursor is not correct: illegal base64 data at input byte 0")
+	})
+
+	t.Run("returns error if wrong pagination attributes", func(t *testing.T) {
+		ctx := persistence.SaveToContext(context.TODO(), &sqlx.Tx{})
+		_, _, err := sut.ListByKeyset(ctx, givenTenant, pagination.Request{PageSize: -3}, repo.NewAscOrderBy("name"), nil)
+		require.EqualError(t, err, "Invalid data [reason=page size cannot be smaller than 1]")
+	})
+
+	t.Run("returns error if tenant is empty", func(t *testing.T) {
+		_, _, err := sut.ListByKeyset(context.TODO(), "", pagination.Request{PageSize: 2}, repo.NewAscOrderBy("name"), nil)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "Tenant is required")
+	})
+
+	t.Run("returns error on db operation", func(t *testing.T) {
+		db, mock := testdb.MockDatabase(t)
+		defer mock.AssertExpectations(t)
+
+		mock.ExpectQuery(`SELECT .*`).WillReturnError(someError())
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		var dest PetCollection
+
+		_, _, err := sut.ListByKeyset(ctx, givenTenant, pagination.Request{PageSize: 2}, repo.NewAscOrderBy("name"), &dest)
+		require.EqualError(t, err, "while fetching list of objects from DB: some error")
+	})
+}
+
+func TestListGlobalByKeyset(t *testing.T) {
+	brian := Pet{ID: uuidA(), Name: "Brian"}
+	garfield := Pet{ID: uuidC(), Name: "Garfield"}
+
+	columns := []string{"id", "name"}
+	sut := repo.NewPageableQuerierGlobal("PetType", "pets", columns)
+
+	t.Run("returns first page and there are no more pages", func(t *testing.T) {
+		db, mock := testdb.MockDatabase(t)
+		defer mock.AssertExpectations(t)
+
+		rows := sqlmock.NewRows(columns).AddRow(brian.ID, brian.Name).AddRow(garfield.ID, garfield.Name)
+		mock.ExpectQuery(regexp.QuoteMeta("SELECT id, name FROM pets ORDER BY id ASC LIMIT 3")).WillReturnRows(rows)
+		mock.ExpectQuery(regexp.QuoteMeta("SELECT COUNT(*) FROM pets")).WillReturnRows(sqlmock.NewRows([]string{""}).AddRow(2))
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		var dest PetCollection
+
+		actualPage, actualTotal, err := sut.ListGlobalByKeyset(ctx, pagination.Request{PageSize: 2}, repo.NewAscOrderBy("id"), &dest)
+		require.NoError(t, err)
+		assert.Equal(t, 2, actualTotal)
+		assert.Equal(t, PetCollection{brian, garfield}, dest)
+		assert.False(t, actualPage.HasNextPage)
+		assert.False(t, actualPage.HasPreviousPage)
+		assertKeysetCursor(t, pagination.Keyset{OrderBy: "id", SortValue: garfield.ID, ID: garfield.ID}, actualPage.EndCursor)
+	})
+}
+
+func assertKeysetCursor(t *testing.T, expected pagination.Keyset, cursor string) {
+	keyset, _, err := pagination.DecodeCursor(cursor)
+	require.NoError(t, err)
+	require.NotNil(t, keyset)
+	assert.Equal(t, expected, *keyset)
+}
@@ -16,10 +16,12 @@ import (
 
 type PageableQuerier interface {
 	List(ctx context.Context, tenant string, pageSize int, cursor string, orderByColumn string, dest Collection, additionalConditions ...Condition) (*pagination.Page, int, error)
+	ListByKeyset(ctx context.Context, tenant string, page pagination.Request, orderBy OrderBy, dest Collection, additionalConditions ...Condition) (*pagination.Page, int, error)
 }
 
 type PageableQuerierGlobal interface {
 	ListGlobal(ctx context.Context, pageSize int, cursor string, orderByColumn string, dest Collection, additionalConditions ...Condition) (*pagination.Page, int, error)
+	ListGlobalByKeyset(ctx context.Context, page pagination.Request, orderBy OrderBy, dest Collection, additionalConditions ...Condition) (*pagination.Page, int, error)
 }
 
 type universalPageableQuerier struct {
@@ -105,9 +107,10 @@ func (g *universalPageableQuerier) unsafeList(ctx context.Context, pageSize int,
 		endCursor = pagination.EncodeNextOffsetCursor(offset, pageSize)
 	}
 	return &pagination.Page{
-		StartCursor: cursor,
-		EndCursor:   endCursor,
-		HasNextPage: hasNextPage,
+		StartCursor:     cursor,
+		EndCursor:       endCursor,
+		HasNextPage:     hasNextPage,
+		HasPreviousPage: offset > 0,
 	}, totalCount, nil
 }
 
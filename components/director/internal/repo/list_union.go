@@ -0,0 +1,126 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/pkg/errors"
+)
+
+// UnionLister lists a page of objects for each of multiple parent objects with a single query,
+// by combining the page queries of the parents with UNION ALL
+type UnionLister interface {
+	List(ctx context.Context, tenant string, ids []string, idsColumn string, pageSize int, cursor string, orderByColumn string, dest Collection, additionalConditions ...Condition) (map[string]ParentPage, error)
+}
+
+// ParentPage describes the page of objects listed for a single parent object
+type ParentPage struct {
+	PageInfo   *pagination.Page
+	TotalCount int
+}
+
+type universalUnionLister struct {
+	tableName       string
+	selectedColumns string
+	tenantColumn    string
+	resourceType    resource.Type
+}
+
+func NewUnionLister(resourceType resource.Type, tableName string, tenantColumn string, selectedColumns []string) UnionLister {
+	return &universalUnionLister{
+		tableName:       tableName,
+		selectedColumns: strings.Join(selectedColumns, ", "),
+		tenantColumn:    tenantColumn,
+		resourceType:    resourceType,
+	}
+}
+
+type parentCount struct {
+	ID         string `db:"id"`
+	TotalCount int    `db:"total_count"`
+}
+
+// List fetches the same page of objects for every parent from the ids into dest, and returns the page info of every parent.
+// The objects of all parents are stored in dest, so the caller has to group them by the idsColumn.
+func (l *universalUnionLister) List(ctx context.Context, tenant string, ids []string, idsColumn string, pageSize int, cursor string, orderByColumn string, dest Collection, additionalConditions ...Condition) (map[string]ParentPage, error) {
+	if tenant == "" {
+		return nil, apperrors.NewTenantRequiredError()
+	}
+	if len(ids) == 0 {
+		return map[string]ParentPage{}, nil
+	}
+
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	offset, err := pagination.DecodeOffsetCursor(cursor)
+	if err != nil {
+		return nil, errors.Wrap(err, "while decoding page cursor")
+	}
+
+	paginationSQL, err := pagination.ConvertOffsetLimitAndOrderedColumnToSQL(pageSize, offset, orderByColumn)
+	if err != nil {
+		return nil, errors.Wrap(err, "while converting offset and limit to cursor")
+	}
+
+	conditions := append(Conditions{NewEqualCondition(l.tenantColumn, tenant)}, additionalConditions...)
+
+	query, args, err := buildUnionQuery(l.tableName, l.selectedColumns, ids, idsColumn, conditions, paginationSQL)
+	if err != nil {
+		return nil, errors.Wrap(err, "while building list query")
+	}
+
+	log.C(ctx).Debugf("Executing DB query: %s", query)
+	if err := persist.Select(dest, query, args...); err != nil {
+		return nil, persistence.MapSQLError(ctx, err, l.resourceType, resource.List, "while fetching list of objects from '%s' table", l.tableName)
+	}
+
+	countConditions := append(Conditions{NewInConditionForStringValues(idsColumn, ids)}, conditions...)
+	countQuery, countArgs, err := buildSelectQuery(l.tableName, fmt.Sprintf("%s AS id, COUNT(*) AS total_count", idsColumn), countConditions, NoOrderBy)
+	if err != nil {
+		return nil, errors.Wrap(err, "while building count query")
+	}
+	countQuery = fmt.Sprintf("%s GROUP BY %s", countQuery, idsColumn)
+
+	var counts []parentCount
+	if err := persist.Select(&counts, countQuery, countArgs...); err != nil {
+		return nil, errors.Wrap(err, "while counting objects")
+	}
+
+	totalCounts := make(map[string]int, len(counts))
+	for _, count := range counts {
+		totalCounts[count.ID] = count.TotalCount
+	}
+
+	pages := make(map[string]ParentPage, len(ids))
+	for _, id := range ids {
+		totalCount := totalCounts[id]
+
+		hasNextPage := false
+		endCursor := ""
+		if totalCount > offset+pageSize {
+			hasNextPage = true
+			endCursor = pagination.EncodeNextOffsetCursor(offset, pageSize)
+		}
+
+		pages[id] = ParentPage{
+			PageInfo: &pagination.Page{
+				StartCursor:     cursor,
+				EndCursor:       endCursor,
+				HasNextPage:     hasNextPage,
+				HasPreviousPage: offset > 0,
+			},
+			TotalCount: totalCount,
+		}
+	}
+
+	return pages, nil
+}
@@ -0,0 +1,100 @@
+package repo_test
+
+import (
+	"context"
+	"database/sql/driver"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/kyma-incubator/compass/components/director/internal/repo"
+	"github.com/kyma-incubator/compass/components/director/internal/repo/testdb"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnionList(t *testing.T) {
+	givenTenant := uuidB()
+	peterID := uuidA()
+	homerID := uuidC()
+	peter := User{FirstName: "Peter", LastName: "Griffin", Age: 40, Tenant: givenTenant, ID: peterID}
+	peterRow := []driver.Value{peterID, givenTenant, "Peter", "Griffin", 40}
+	homer := User{FirstName: "Homer", LastName: "Simpson", Age: 55, Tenant: givenTenant, ID: homerID}
+	homerRow := []driver.Value{homerID, givenTenant, "Homer", "Simpson", 55}
+
+	sut := repo.NewUnionLister(UserType, "users", "tenant_id",
+		[]string{"id_col", "tenant_id", "first_name", "last_name", "age"})
+
+	expectedQuery := regexp.QuoteMeta(`(SELECT id_col, tenant_id, first_name, last_name, age FROM users WHERE last_name = $1 AND tenant_id = $2 ORDER BY id_col LIMIT 1 OFFSET 0) UNION ALL (SELECT id_col, tenant_id, first_name, last_name, age FROM users WHERE last_name = $3 AND tenant_id = $4 ORDER BY id_col LIMIT 1 OFFSET 0) UNION ALL (SELECT id_col, tenant_id, first_name, last_name, age FROM users WHERE last_name = $5 AND tenant_id = $6 ORDER BY id_col LIMIT 1 OFFSET 0)`)
+	expectedCountQuery := regexp.QuoteMeta(`SELECT last_name AS id, COUNT(*) AS total_count FROM users WHERE last_name IN ($1, $2, $3) AND tenant_id = $4 GROUP BY last_name`)
+
+	t.Run("returns page of every parent", func(t *testing.T) {
+		db, mock := testdb.MockDatabase(t)
+		defer mock.AssertExpectations(t)
+
+		rows := sqlmock.NewRows([]string{"id_col", "tenant_id", "first_name", "last_name", "age"}).
+			AddRow(peterRow...).
+			AddRow(homerRow...)
+		mock.ExpectQuery(expectedQuery).
+			WithArgs("Griffin", givenTenant, "Simpson", givenTenant, "Flanders", givenTenant).
+			WillReturnRows(rows)
+		mock.ExpectQuery(expectedCountQuery).
+			WithArgs("Griffin", "Simpson", "Flanders", givenTenant).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "total_count"}).AddRow("Griffin", 1).AddRow("Simpson", 3))
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		var dest UserCollection
+
+		pages, err := sut.List(ctx, givenTenant, []string{"Griffin", "Simpson", "Flanders"}, "last_name", 1, "", "id_col", &dest)
+		require.NoError(t, err)
+		assert.Equal(t, UserCollection{peter, homer}, dest)
+		assert.Equal(t, repo.ParentPage{PageInfo: &pagination.Page{}, TotalCount: 1}, pages["Griffin"])
+		assert.Equal(t, repo.ParentPage{PageInfo: &pagination.Page{}, TotalCount: 0}, pages["Flanders"])
+		assert.Equal(t, 3, pages["Simpson"].TotalCount)
+		assert.True(t, pages["Simpson"].PageInfo.HasNextPage)
+		assert.Equal(t, pagination.EncodeNextOffsetCursor(0, 1), pages["Simpson"].PageInfo.EndCursor)
+	})
+
+	t.Run("returns empty pages without querying the database when there are no parents", func(t *testing.T) {
+		var dest UserCollection
+
+		pages, err := sut.List(context.TODO(), givenTenant, []string{}, "last_name", 1, "", "id_col", &dest)
+		require.NoError(t, err)
+		assert.Empty(t, pages)
+	})
+
+	t.Run("returns error if tenant is missing", func(t *testing.T) {
+		var dest UserCollection
+
+		_, err := sut.List(context.TODO(), "", []string{"Griffin"}, "last_name", 1, "", "id_col", &dest)
+		require.EqualError(t, err, apperrors.NewTenantRequiredError().Error())
+	})
+
+	t.Run("returns error if listing fails", func(t *testing.T) {
+		db, mock := testdb.MockDatabase(t)
+		defer mock.AssertExpectations(t)
+
+		mock.ExpectQuery(expectedQuery).WillReturnError(errors.New("some error"))
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		var dest UserCollection
+
+		_, err := sut.List(ctx, givenTenant, []string{"Griffin", "Simpson", "Flanders"}, "last_name", 1, "", "id_col", &dest)
+		require.EqualError(t, err, "Internal Server Error: Unexpected error while executing SQL query")
+	})
+
+	t.Run("returns error if counting fails", func(t *testing.T) {
+		db, mock := testdb.MockDatabase(t)
+		defer mock.AssertExpectations(t)
+
+		mock.ExpectQuery(expectedQuery).WillReturnRows(sqlmock.NewRows([]string{"id_col", "tenant_id", "first_name", "last_name", "age"}))
+		mock.ExpectQuery(expectedCountQuery).WillReturnError(errors.New("some error"))
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		var dest UserCollection
+
+		_, err := sut.List(ctx, givenTenant, []string{"Griffin", "Simpson", "Flanders"}, "last_name", 1, "", "id_col", &dest)
+		require.EqualError(t, err, "while counting objects: some error")
+	})
+}
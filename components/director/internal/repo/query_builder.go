@@ -111,3 +111,28 @@ func writeOrderByPart(builder *strings.Builder, orderByParams OrderByParams) err
 
 	return nil
 }
+
+// buildUnionQuery builds a paginated select query for every id and combines them with UNION ALL.
+// The placeholders are rebound once the whole query is built, as they have to be numbered across all of the selects.
+func buildUnionQuery(tableName string, selectedColumns string, ids []string, idsColumn string, conditions Conditions, paginationSQL string) (string, []interface{}, error) {
+	var stmtBuilder strings.Builder
+	var allArgs []interface{}
+
+	for idx, id := range ids {
+		if idx > 0 {
+			stmtBuilder.WriteString(" UNION ALL ")
+		}
+
+		idConditions := append(Conditions{NewEqualCondition(idsColumn, id)}, conditions...)
+
+		stmtBuilder.WriteString(fmt.Sprintf("(SELECT %s FROM %s WHERE", selectedColumns, tableName))
+		if err := writeEnumeratedConditions(&stmtBuilder, idConditions); err != nil {
+			return "", nil, errors.Wrap(err, "while writing enumerated conditions.")
+		}
+		stmtBuilder.WriteString(fmt.Sprintf(" %s)", paginationSQL))
+
+		allArgs = append(allArgs, getAllArgs(idConditions)...)
+	}
+
+	return getQueryFromBuilder(stmtBuilder), allArgs, nil
+}
@@ -122,6 +122,14 @@ func NewNullableStringFromJSONRawMessage(json json.RawMessage) sql.NullString {
 	return nullString
 }
 
+func NewNullableTime(t *time.Time) sql.NullTime {
+	if t == nil {
+		return sql.NullTime{}
+	}
+
+	return sql.NullTime{Valid: true, Time: *t}
+}
+
 func NewNullableBool(boolean *bool) sql.NullBool {
 	var sqlBool sql.NullBool
 	if boolean != nil {
@@ -168,3 +176,10 @@ func BoolPtrFromNullableBool(sqlBool sql.NullBool) *bool {
 	}
 	return nil
 }
+
+func TimePtrFromNullableTime(sqlTime sql.NullTime) *time.Time {
+	if sqlTime.Valid {
+		return &sqlTime.Time
+	}
+	return nil
+}
@@ -2,6 +2,7 @@ package repo
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -53,3 +54,24 @@ func TestNewNullableString(t *testing.T) {
 		assert.False(t, result.Valid)
 	})
 }
+
+func TestNewNullableTime(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		//GIVEN
+		input := time.Now()
+		//WHEN
+		result := NewNullableTime(&input)
+		//THEN
+		assert.True(t, result.Valid)
+		assert.Equal(t, input, result.Time)
+		assert.Equal(t, &input, TimePtrFromNullableTime(result))
+	})
+
+	t.Run("return not valid when nil time", func(t *testing.T) {
+		//WHEN
+		result := NewNullableTime(nil)
+		//THEN
+		assert.False(t, result.Valid)
+		assert.Nil(t, TimePtrFromNullableTime(result))
+	})
+}
@@ -0,0 +1,79 @@
+package specrefetch_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/tenant"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/specrefetch"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+	persistenceautomock "github.com/kyma-incubator/compass/components/director/pkg/persistence/automock"
+	"github.com/stretchr/testify/mock"
+)
+
+const (
+	tenantID    = "tenant"
+	specID      = "specID"
+	spec2ID     = "spec2ID"
+	eventSpecID = "eventSpecID"
+	apiID       = "apiID"
+	eventID     = "eventID"
+)
+
+func fixConfig() specrefetch.Config {
+	return specrefetch.Config{
+		JobInterval:     time.Minute,
+		RefetchInterval: time.Hour,
+	}
+}
+
+func fixFetchRequest(specID string) *model.FetchRequest {
+	return &model.FetchRequest{
+		ID:         "fr-" + specID,
+		Tenant:     tenantID,
+		URL:        "http://test.com/" + specID,
+		Mode:       model.FetchModeSingle,
+		ObjectType: model.SpecFetchRequestReference,
+		ObjectID:   specID,
+	}
+}
+
+func fixSpec(id string) *model.Spec {
+	return &model.Spec{
+		ID:         id,
+		Tenant:     tenantID,
+		ObjectType: model.APISpecReference,
+		ObjectID:   apiID,
+	}
+}
+
+func fixEventSpec(id string) *model.Spec {
+	return &model.Spec{
+		ID:         id,
+		Tenant:     tenantID,
+		ObjectType: model.EventSpecReference,
+		ObjectID:   eventID,
+	}
+}
+
+func ctxWithTenantMatcher() interface{} {
+	return mock.MatchedBy(func(ctx context.Context) bool {
+		tnt, err := tenant.LoadFromContext(ctx)
+		if err != nil || tnt != tenantID {
+			return false
+		}
+		persistenceOp, err := persistence.FromCtx(ctx)
+		return err == nil && persistenceOp != nil
+	})
+}
+
+func transactioner(begins, commits int) (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+	persistTx := &persistenceautomock.PersistenceTx{}
+	persistTx.On("Commit").Return(nil).Times(commits)
+
+	transact := &persistenceautomock.Transactioner{}
+	transact.On("Begin").Return(persistTx, nil).Times(begins)
+	transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return().Times(begins)
+	return persistTx, transact
+}
@@ -0,0 +1,134 @@
+package specrefetch
+
+import (
+	"context"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/tenant"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// SpecChangedEvent is the name of the audit event logged when a refetched Specification has changed
+const SpecChangedEvent = "SpecificationChanged"
+
+// Config configures how often the Specifications with a Fetch Request are refetched
+type Config struct {
+	// JobInterval is the interval in which the job looks for Fetch Requests which are due
+	JobInterval time.Duration `envconfig:"default=1m,APP_SPEC_REFETCH_JOB_INTERVAL"`
+	// RefetchInterval is the time after the last fetch of a Fetch Request after which its Specification is fetched again. Zero disables the job.
+	RefetchInterval time.Duration `envconfig:"default=1h,APP_SPEC_REFETCH_INTERVAL"`
+}
+
+//go:generate mockery -name=FetchRequestRepository -output=automock -outpkg=automock -case=underscore
+type FetchRequestRepository interface {
+	ListGlobalSpecFetchRequestsFetchedBefore(ctx context.Context, before time.Time) ([]*model.FetchRequest, error)
+	LockGlobalDueSpecFetchRequest(ctx context.Context, id string, before time.Time) (*model.FetchRequest, error)
+}
+
+//go:generate mockery -name=SpecService -output=automock -outpkg=automock -case=underscore
+type SpecService interface {
+	RefreshSpec(ctx context.Context, id string) (*model.Spec, bool, error)
+}
+
+// Service periodically refetches the Specifications which have a Fetch Request
+type Service struct {
+	cfg              Config
+	transact         persistence.Transactioner
+	fetchRequestRepo FetchRequestRepository
+	specSvc          SpecService
+}
+
+// NewService creates a service which refetches the Specifications whose Fetch Requests are due
+func NewService(cfg Config, transact persistence.Transactioner, fetchRequestRepo FetchRequestRepository, specSvc SpecService) *Service {
+	return &Service{
+		cfg:              cfg,
+		transact:         transact,
+		fetchRequestRepo: fetchRequestRepo,
+		specSvc:          specSvc,
+	}
+}
+
+// RefetchDueSpecs refetches every Specification whose Fetch Request was last fetched more than RefetchInterval ago.
+// Each Specification is refetched in its own transaction, so that a failure of one does not affect the others.
+// The Fetch Request is locked for the time of the refetch, so that the Specification is refetched by only one of the replicas.
+func (s *Service) RefetchDueSpecs(ctx context.Context) error {
+	before := time.Now().Add(-s.cfg.RefetchInterval)
+	fetchRequests, err := s.listDueFetchRequests(ctx, before)
+	if err != nil {
+		return err
+	}
+
+	log.C(ctx).Infof("Refetching %d Specification(s)", len(fetchRequests))
+	for _, fr := range fetchRequests {
+		if err := s.refetch(ctx, fr, before); err != nil {
+			log.C(ctx).WithError(err).Errorf("An error has occurred while refetching Specification with id %q", fr.ObjectID)
+		}
+	}
+
+	return nil
+}
+
+func (s *Service) listDueFetchRequests(ctx context.Context, before time.Time) ([]*model.FetchRequest, error) {
+	tx, err := s.transact.Begin()
+	if err != nil {
+		return nil, errors.Wrap(err, "while opening transaction")
+	}
+	defer s.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+	fetchRequests, err := s.fetchRequestRepo.ListGlobalSpecFetchRequestsFetchedBefore(ctx, before)
+	if err != nil {
+		return nil, errors.Wrap(err, "while listing Fetch Requests which are due")
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, errors.Wrap(err, "while committing transaction")
+	}
+
+	return fetchRequests, nil
+}
+
+func (s *Service) refetch(ctx context.Context, fr *model.FetchRequest, before time.Time) error {
+	tx, err := s.transact.Begin()
+	if err != nil {
+		return errors.Wrap(err, "while opening transaction")
+	}
+	defer s.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+	if _, err := s.fetchRequestRepo.LockGlobalDueSpecFetchRequest(ctx, fr.ID, before); err != nil {
+		if apperrors.IsNotFoundError(err) {
+			log.C(ctx).Debugf("Specification with id %q is being refetched by another instance or has already been refetched", fr.ObjectID)
+			return nil
+		}
+		return errors.Wrapf(err, "while locking Fetch Request with id %q", fr.ID)
+	}
+
+	ctx = tenant.SaveToContext(ctx, fr.Tenant, "")
+	spec, changed, err := s.specSvc.RefreshSpec(ctx, fr.ObjectID)
+	if err != nil {
+		return errors.Wrapf(err, "while refreshing Specification with id %q", fr.ObjectID)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return errors.Wrap(err, "while committing transaction")
+	}
+
+	if changed {
+		log.C(ctx).WithFields(logrus.Fields{
+			"event":       SpecChangedEvent,
+			"tenant":      fr.Tenant,
+			"spec_id":     spec.ID,
+			"object_type": spec.ObjectType,
+			"object_id":   spec.ObjectID,
+			"url":         fr.URL,
+		}).Infof("Specification with id %q has changed", spec.ID)
+	}
+
+	return nil
+}
@@ -0,0 +1,140 @@
+package specrefetch_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/specrefetch"
+	"github.com/kyma-incubator/compass/components/director/internal/specrefetch/automock"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	persistenceautomock "github.com/kyma-incubator/compass/components/director/pkg/persistence/automock"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence/txtest"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_RefetchDueSpecs(t *testing.T) {
+	testErr := errors.New("Test error")
+
+	dueBefore := func(before time.Time) bool {
+		expected := time.Now().Add(-fixConfig().RefetchInterval)
+		return before.After(expected.Add(-time.Minute)) && !before.After(expected)
+	}
+
+	testCases := []struct {
+		Name               string
+		TransactionerFn    func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		FetchRequestRepoFn func() *automock.FetchRequestRepository
+		SpecSvcFn          func() *automock.SpecService
+		ExpectedErr        error
+	}{
+		{
+			Name: "Refetches the Specifications which are due",
+			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return transactioner(4, 4)
+			},
+			FetchRequestRepoFn: func() *automock.FetchRequestRepository {
+				repo := &automock.FetchRequestRepository{}
+				repo.On("ListGlobalSpecFetchRequestsFetchedBefore", txtest.CtxWithDBMatcher(), mock.MatchedBy(dueBefore)).Return([]*model.FetchRequest{fixFetchRequest(specID), fixFetchRequest(spec2ID), fixFetchRequest(eventSpecID)}, nil).Once()
+				repo.On("LockGlobalDueSpecFetchRequest", txtest.CtxWithDBMatcher(), "fr-"+specID, mock.MatchedBy(dueBefore)).Return(fixFetchRequest(specID), nil).Once()
+				repo.On("LockGlobalDueSpecFetchRequest", txtest.CtxWithDBMatcher(), "fr-"+spec2ID, mock.MatchedBy(dueBefore)).Return(fixFetchRequest(spec2ID), nil).Once()
+				repo.On("LockGlobalDueSpecFetchRequest", txtest.CtxWithDBMatcher(), "fr-"+eventSpecID, mock.MatchedBy(dueBefore)).Return(fixFetchRequest(eventSpecID), nil).Once()
+				return repo
+			},
+			SpecSvcFn: func() *automock.SpecService {
+				svc := &automock.SpecService{}
+				svc.On("RefreshSpec", ctxWithTenantMatcher(), specID).Return(fixSpec(specID), true, nil).Once()
+				svc.On("RefreshSpec", ctxWithTenantMatcher(), spec2ID).Return(fixSpec(spec2ID), false, nil).Once()
+				svc.On("RefreshSpec", ctxWithTenantMatcher(), eventSpecID).Return(fixEventSpec(eventSpecID), true, nil).Once()
+				return svc
+			},
+		},
+		{
+			Name: "Skips the Specifications which are refetched by another instance",
+			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return transactioner(3, 2)
+			},
+			FetchRequestRepoFn: func() *automock.FetchRequestRepository {
+				repo := &automock.FetchRequestRepository{}
+				repo.On("ListGlobalSpecFetchRequestsFetchedBefore", txtest.CtxWithDBMatcher(), mock.MatchedBy(dueBefore)).Return([]*model.FetchRequest{fixFetchRequest(specID), fixFetchRequest(spec2ID)}, nil).Once()
+				repo.On("LockGlobalDueSpecFetchRequest", txtest.CtxWithDBMatcher(), "fr-"+specID, mock.MatchedBy(dueBefore)).Return(nil, apperrors.NewNotFoundErrorWithType(resource.FetchRequest)).Once()
+				repo.On("LockGlobalDueSpecFetchRequest", txtest.CtxWithDBMatcher(), "fr-"+spec2ID, mock.MatchedBy(dueBefore)).Return(fixFetchRequest(spec2ID), nil).Once()
+				return repo
+			},
+			SpecSvcFn: func() *automock.SpecService {
+				svc := &automock.SpecService{}
+				svc.On("RefreshSpec", ctxWithTenantMatcher(), spec2ID).Return(fixSpec(spec2ID), false, nil).Once()
+				return svc
+			},
+		},
+		{
+			Name: "Continues with the next Specification when refetching fails",
+			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return transactioner(4, 2)
+			},
+			FetchRequestRepoFn: func() *automock.FetchRequestRepository {
+				repo := &automock.FetchRequestRepository{}
+				repo.On("ListGlobalSpecFetchRequestsFetchedBefore", txtest.CtxWithDBMatcher(), mock.MatchedBy(dueBefore)).Return([]*model.FetchRequest{fixFetchRequest(specID), fixFetchRequest(spec2ID), fixFetchRequest(eventSpecID)}, nil).Once()
+				repo.On("LockGlobalDueSpecFetchRequest", txtest.CtxWithDBMatcher(), "fr-"+specID, mock.MatchedBy(dueBefore)).Return(nil, testErr).Once()
+				repo.On("LockGlobalDueSpecFetchRequest", txtest.CtxWithDBMatcher(), "fr-"+spec2ID, mock.MatchedBy(dueBefore)).Return(fixFetchRequest(spec2ID), nil).Once()
+				repo.On("LockGlobalDueSpecFetchRequest", txtest.CtxWithDBMatcher(), "fr-"+eventSpecID, mock.MatchedBy(dueBefore)).Return(fixFetchRequest(eventSpecID), nil).Once()
+				return repo
+			},
+			SpecSvcFn: func() *automock.SpecService {
+				svc := &automock.SpecService{}
+				svc.On("RefreshSpec", ctxWithTenantMatcher(), spec2ID).Return(nil, false, testErr).Once()
+				svc.On("RefreshSpec", ctxWithTenantMatcher(), eventSpecID).Return(fixEventSpec(eventSpecID), false, nil).Once()
+				return svc
+			},
+		},
+		{
+			Name:            "Returns error when Fetch Requests cannot be listed",
+			TransactionerFn: txtest.NewTransactionContextGenerator(testErr).ThatDoesntExpectCommit,
+			FetchRequestRepoFn: func() *automock.FetchRequestRepository {
+				repo := &automock.FetchRequestRepository{}
+				repo.On("ListGlobalSpecFetchRequestsFetchedBefore", txtest.CtxWithDBMatcher(), mock.MatchedBy(dueBefore)).Return(nil, testErr).Once()
+				return repo
+			},
+			ExpectedErr: testErr,
+		},
+		{
+			Name:            "Returns error when transaction cannot be opened",
+			TransactionerFn: txtest.NewTransactionContextGenerator(testErr).ThatFailsOnBegin,
+			ExpectedErr:     testErr,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// given
+			persistTx, transact := testCase.TransactionerFn()
+			frRepo := &automock.FetchRequestRepository{}
+			if testCase.FetchRequestRepoFn != nil {
+				frRepo = testCase.FetchRequestRepoFn()
+			}
+			specSvc := &automock.SpecService{}
+			if testCase.SpecSvcFn != nil {
+				specSvc = testCase.SpecSvcFn()
+			}
+
+			svc := specrefetch.NewService(fixConfig(), transact, frRepo, specSvc)
+
+			// when
+			err := svc.RefetchDueSpecs(context.TODO())
+
+			// then
+			if testCase.ExpectedErr != nil {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), testCase.ExpectedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+
+			mock.AssertExpectationsForObjects(t, persistTx, transact, frRepo, specSvc)
+		})
+	}
+}
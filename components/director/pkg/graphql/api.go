@@ -4,6 +4,7 @@ import "github.com/kyma-incubator/compass/components/director/pkg/resource"
 
 type APIDefinition struct {
 	BundleID    string   `json:"bundleID"`
+	PackageID   *string  `json:"packageID"`
 	Name        string   `json:"name"`
 	Description *string  `json:"description"`
 	Spec        *APISpec `json:"spec"`
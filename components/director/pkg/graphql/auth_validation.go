@@ -1,11 +1,20 @@
 package graphql
 
 import (
+	"crypto/tls"
+	"regexp"
+
 	validation "github.com/go-ozzo/ozzo-validation"
 	"github.com/go-ozzo/ozzo-validation/is"
 	"github.com/kyma-incubator/compass/components/director/pkg/inputvalidation"
+	"github.com/pkg/errors"
 )
 
+const maxSigningSecrets = 10
+
+// headerNameRegex matches the token characters allowed in an HTTP header name, as defined in RFC 7230
+var headerNameRegex = regexp.MustCompile("^[!#$%&'*+\\-.^_`|~0-9A-Za-z]+$")
+
 func (i AuthInput) Validate() error {
 	return validation.ValidateStruct(&i,
 		validation.Field(&i.AdditionalHeaders,
@@ -18,6 +27,7 @@ func (i AuthInput) Validate() error {
 		),
 		validation.Field(&i.Credential, validation.NilOrNotEmpty),
 		validation.Field(&i.RequestAuth),
+		validation.Field(&i.Signing),
 	)
 }
 
@@ -25,10 +35,11 @@ func (i CredentialDataInput) Validate() error {
 	return validation.Errors{
 		"Rule.ExactlyOneNotNil": inputvalidation.ValidateExactlyOneNotNil(
 			"exactly one credential input has to be specified",
-			i.Basic, i.Oauth,
+			i.Basic, i.Oauth, i.Certificate,
 		),
-		"Basic": validation.Validate(i.Basic),
-		"Oauth": validation.Validate(i.Oauth),
+		"Basic":       validation.Validate(i.Basic),
+		"Oauth":       validation.Validate(i.Oauth),
+		"Certificate": validation.Validate(i.Certificate),
 	}.Filter()
 }
 
@@ -47,6 +58,30 @@ func (i OAuthCredentialDataInput) Validate() error {
 	)
 }
 
+func (i CertificateCredentialDataInput) Validate() error {
+	if err := validation.ValidateStruct(&i,
+		validation.Field(&i.Certificate, validation.Required),
+		validation.Field(&i.PrivateKey, validation.Required),
+	); err != nil {
+		return err
+	}
+
+	if _, err := tls.X509KeyPair([]byte(i.Certificate), []byte(i.PrivateKey)); err != nil {
+		return validation.Errors{
+			"Rule.ValidKeyPair": errors.New("must be a valid PEM encoded certificate and private key pair"),
+		}
+	}
+	return nil
+}
+
+func (i RequestSigningInput) Validate() error {
+	return validation.ValidateStruct(&i,
+		validation.Field(&i.Header, validation.NilOrNotEmpty, validation.Match(headerNameRegex)),
+		validation.Field(&i.TimestampHeader, validation.NilOrNotEmpty, validation.Match(headerNameRegex)),
+		validation.Field(&i.Secrets, validation.Required, validation.Length(1, maxSigningSecrets), inputvalidation.Each(validation.Required)),
+	)
+}
+
 func (i CredentialRequestAuthInput) Validate() error {
 	return validation.ValidateStruct(&i,
 		validation.Field(&i.Csrf, validation.Required),
@@ -1,7 +1,15 @@
 package graphql_test
 
 import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
 	"testing"
+	"time"
 
 	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
 	"github.com/kyma-incubator/compass/components/director/pkg/inputvalidation/inputvalidationtest"
@@ -222,6 +230,7 @@ func TestCredentialDataInput_Validate(t *testing.T) {
 	credential := fixValidCredentialDataInput()
 	basic := fixValidBasicCredentialDataInput()
 	oauth := fixValidOAuthCredentialDataInput()
+	certificate := fixValidCertificateCredentialDataInput()
 
 	testCases := []struct {
 		Name          string
@@ -263,6 +272,28 @@ func TestCredentialDataInput_Validate(t *testing.T) {
 			},
 			ExpectedValid: false,
 		},
+		{
+			Name: "ExpectedValid - certificate",
+			Value: &graphql.CredentialDataInput{
+				Certificate: &certificate,
+			},
+			ExpectedValid: true,
+		},
+		{
+			Name: "Invalid - certificate and basic provided",
+			Value: &graphql.CredentialDataInput{
+				Basic:       &basic,
+				Certificate: &certificate,
+			},
+			ExpectedValid: false,
+		},
+		{
+			Name: "Invalid - nested validation error in Certificate",
+			Value: &graphql.CredentialDataInput{
+				Certificate: &graphql.CertificateCredentialDataInput{},
+			},
+			ExpectedValid: false,
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -282,6 +313,48 @@ func TestCredentialDataInput_Validate(t *testing.T) {
 	}
 }
 
+func TestAuthInput_Validate_Signing(t *testing.T) {
+	signing := fixValidRequestSigningInput()
+
+	testCases := []struct {
+		Name          string
+		Value         *graphql.RequestSigningInput
+		ExpectedValid bool
+	}{
+		{
+			Name:          "ExpectedValid",
+			Value:         &signing,
+			ExpectedValid: true,
+		},
+		{
+			Name:          "Empty",
+			Value:         nil,
+			ExpectedValid: true,
+		},
+		{
+			Name:          "Invalid - nested validation error",
+			Value:         &graphql.RequestSigningInput{},
+			ExpectedValid: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			//GIVEN
+			sut := fixValidAuthInput()
+			sut.Signing = testCase.Value
+			//WHEN
+			err := sut.Validate()
+			//THEN
+			if testCase.ExpectedValid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
 func TestBasicCredentialDataInput_Validate_Username(t *testing.T) {
 	testCases := []struct {
 		Name          string
@@ -462,6 +535,161 @@ func TestOAuthCredentialDataInput_Validate_URL(t *testing.T) {
 	}
 }
 
+func TestCertificateCredentialDataInput_Validate(t *testing.T) {
+	certificate := fixValidCertificateCredentialDataInput()
+	otherCertificate := fixValidCertificateCredentialDataInput()
+
+	testCases := []struct {
+		Name          string
+		Value         graphql.CertificateCredentialDataInput
+		ExpectedValid bool
+	}{
+		{
+			Name:          "ExpectedValid",
+			Value:         certificate,
+			ExpectedValid: true,
+		},
+		{
+			Name: "Invalid - Empty certificate",
+			Value: graphql.CertificateCredentialDataInput{
+				Certificate: inputvalidationtest.EmptyString,
+				PrivateKey:  certificate.PrivateKey,
+			},
+			ExpectedValid: false,
+		},
+		{
+			Name: "Invalid - Empty private key",
+			Value: graphql.CertificateCredentialDataInput{
+				Certificate: certificate.Certificate,
+				PrivateKey:  inputvalidationtest.EmptyString,
+			},
+			ExpectedValid: false,
+		},
+		{
+			Name: "Invalid - Not PEM encoded",
+			Value: graphql.CertificateCredentialDataInput{
+				Certificate: "certificate",
+				PrivateKey:  "key",
+			},
+			ExpectedValid: false,
+		},
+		{
+			Name: "Invalid - Private key does not match certificate",
+			Value: graphql.CertificateCredentialDataInput{
+				Certificate: certificate.Certificate,
+				PrivateKey:  otherCertificate.PrivateKey,
+			},
+			ExpectedValid: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			//GIVEN
+			sut := testCase.Value
+			//WHEN
+			err := sut.Validate()
+			//THEN
+			if testCase.ExpectedValid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestRequestSigningInput_Validate_Header(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		Value         *string
+		ExpectedValid bool
+	}{
+		{
+			Name:          "ExpectedValid",
+			Value:         stringPtr("X-Signature"),
+			ExpectedValid: true,
+		},
+		{
+			Name:          "ExpectedValid - Nil",
+			Value:         nil,
+			ExpectedValid: true,
+		},
+		{
+			Name:          "Invalid - Empty string",
+			Value:         stringPtr(inputvalidationtest.EmptyString),
+			ExpectedValid: false,
+		},
+		{
+			Name:          "Invalid - Not a valid header name",
+			Value:         stringPtr("X Signature:"),
+			ExpectedValid: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			//GIVEN
+			sut := fixValidRequestSigningInput()
+			sut.Header = testCase.Value
+			sut.TimestampHeader = testCase.Value
+			//WHEN
+			err := sut.Validate()
+			//THEN
+			if testCase.ExpectedValid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestRequestSigningInput_Validate_Secrets(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		Value         []string
+		ExpectedValid bool
+	}{
+		{
+			Name:          "ExpectedValid",
+			Value:         []string{"old-secret", "new-secret"},
+			ExpectedValid: true,
+		},
+		{
+			Name:          "Invalid - Nil",
+			Value:         nil,
+			ExpectedValid: false,
+		},
+		{
+			Name:          "Invalid - Empty secret",
+			Value:         []string{"secret", inputvalidationtest.EmptyString},
+			ExpectedValid: false,
+		},
+		{
+			Name:          "Invalid - Too many secrets",
+			Value:         []string{"1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11"},
+			ExpectedValid: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			//GIVEN
+			sut := fixValidRequestSigningInput()
+			sut.Secrets = testCase.Value
+			//WHEN
+			err := sut.Validate()
+			//THEN
+			if testCase.ExpectedValid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
 func TestCredentialRequestAuthInput_Validate(t *testing.T) {
 	csrf := fixValidCSRFTokenCredentialRequestAuthInput()
 	testCases := []struct {
@@ -741,6 +969,41 @@ func fixValidOAuthCredentialDataInput() graphql.OAuthCredentialDataInput {
 	}
 }
 
+func fixValidCertificateCredentialDataInput() graphql.CertificateCredentialDataInput {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		panic(err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "compass"},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	certificate, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		panic(err)
+	}
+
+	privateKey, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		panic(err)
+	}
+
+	return graphql.CertificateCredentialDataInput{
+		Certificate: string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certificate})),
+		PrivateKey:  string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: privateKey})),
+	}
+}
+
+func fixValidRequestSigningInput() graphql.RequestSigningInput {
+	return graphql.RequestSigningInput{
+		Header:  stringPtr("X-Signature"),
+		Secrets: []string{"secret"},
+	}
+}
+
 func fixValidCredentialRequestAuthInput() graphql.CredentialRequestAuthInput {
 	csrf := fixValidCSRFTokenCredentialRequestAuthInput()
 	return graphql.CredentialRequestAuthInput{
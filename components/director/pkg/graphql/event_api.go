@@ -4,6 +4,7 @@ import "github.com/kyma-incubator/compass/components/director/pkg/resource"
 
 type EventDefinition struct {
 	BundleID    string  `json:"bundleID"`
+	PackageID   *string `json:"packageID"`
 	Name        string  `json:"name"`
 	Description *string `json:"description"`
 	// group allows you to find the same API but in different version
@@ -1,6 +1,9 @@
 package graphql
 
 import (
+	"errors"
+	"path"
+
 	validation "github.com/go-ozzo/ozzo-validation"
 	"github.com/go-ozzo/ozzo-validation/is"
 )
@@ -10,6 +13,21 @@ func (i FetchRequestInput) Validate() error {
 		validation.Field(&i.URL, validation.Required, is.URL, validation.RuneLength(1, longStringLengthLimit)),
 		validation.Field(&i.Auth, validation.NilOrNotEmpty),
 		validation.Field(&i.Mode, validation.NilOrNotEmpty, validation.In(FetchModeSingle, FetchModeBundle, FetchModeIndex)),
-		validation.Field(&i.Filter, validation.NilOrNotEmpty, validation.RuneLength(1, longStringLengthLimit)),
+		validation.Field(&i.Filter, validation.NilOrNotEmpty, validation.RuneLength(1, longStringLengthLimit), validation.By(filterRuleFunc)),
 	)
 }
+
+func filterRuleFunc(value interface{}) error {
+	filter, ok := value.(*string)
+	if !ok {
+		return errors.New("value could not be cast to string pointer")
+	}
+	if filter == nil {
+		return nil
+	}
+
+	if _, err := path.Match(*filter, ""); err != nil {
+		return errors.New("must be a valid glob pattern")
+	}
+	return nil
+}
@@ -158,6 +158,16 @@ func TestFetchRequestInput_Validate_Filter(t *testing.T) {
 			Value:         str.Ptr(inputvalidationtest.String257Long),
 			ExpectedValid: false,
 		},
+		{
+			Name:          "Valid glob pattern",
+			Value:         str.Ptr("specs/*.yaml"),
+			ExpectedValid: true,
+		},
+		{
+			Name:          "Invalid glob pattern",
+			Value:         str.Ptr("[specs"),
+			ExpectedValid: false,
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -134,6 +134,7 @@ func (fp *GqlFieldsProvider) OmitForWebhooks(omittedProperties []string) string
 		"outputTemplate":   "outputTemplate",
 		"statusTemplate":   "statusTemplate",
 		"auth":             fmt.Sprintf("auth {%s}", fp.ForAuth()),
+		"retryPolicy":      fmt.Sprintf("retryPolicy {%s}", fp.ForWebhookRetryPolicy()),
 	}, omittedProperties)
 }
 
@@ -155,7 +156,19 @@ func (fp *GqlFieldsProvider) ForWebhooks() string {
 		statusTemplate
 		auth {
 		  %s
-		}`, fp.ForAuth())
+		}
+		retryPolicy {
+		  %s
+		}`, fp.ForAuth(), fp.ForWebhookRetryPolicy())
+}
+
+func (fp *GqlFieldsProvider) ForWebhookRetryPolicy() string {
+	return `maxAttempts
+		initialInterval
+		maxInterval
+		multiplier
+		jitter
+		retryableStatusCodes`
 }
 
 func (fp *GqlFieldsProvider) OmitForAPIDefinition(omittedProperties []string) string {
@@ -344,9 +357,18 @@ func (fp *GqlFieldsProvider) ForAuth() string {
 					url
 					
 				}
+				... on CertificateCredentialData {
+					certificate
+					privateKey
+				}
 			}
 			additionalHeaders
 			additionalQueryParams
+			signing {
+				header
+				timestampHeader
+				secrets
+			}
 			requestAuth { 
 			  csrf {
 				tokenEndpointURL
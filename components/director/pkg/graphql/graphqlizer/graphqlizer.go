@@ -167,6 +167,12 @@ func (g *Graphqlizer) CredentialDataInputToGQL(in *graphql.CredentialDataInput)
 				url: "{{ .Oauth.URL }}",
 			},
 			{{- end }}
+			{{- if .Certificate }}
+			certificate: {
+				certificate: {{ marshal .Certificate.Certificate }},
+				privateKey: {{ marshal .Certificate.PrivateKey }},
+			},
+			{{- end }}
 	}`)
 }
 
@@ -217,6 +223,21 @@ func (g *Graphqlizer) AuthInputToGQL(in *graphql.AuthInput) (string, error) {
 		{{- if .RequestAuth }}
 		requestAuth: {{ CredentialRequestAuthInputToGQL .RequestAuth }},
 		{{- end }}
+		{{- if .Signing }}
+		signing: {{ RequestSigningInputToGQL .Signing }},
+		{{- end }}
+	}`)
+}
+
+func (g *Graphqlizer) RequestSigningInputToGQL(in *graphql.RequestSigningInput) (string, error) {
+	return g.genericToGQL(in, `{
+		{{- if .Header }}
+		header: "{{ .Header }}",
+		{{- end }}
+		{{- if .TimestampHeader }}
+		timestampHeader: "{{ .TimestampHeader }}",
+		{{- end }}
+		secrets: {{ marshal .Secrets }},
 	}`)
 }
 
@@ -264,6 +285,9 @@ func (g *Graphqlizer) WebhookInputToGQL(in *graphql.WebhookInput) (string, error
 		{{- if .RetryInterval }} 
 		retryInterval: "{{.RetryInterval }}",
 		{{- end }}
+		{{- if .RetryPolicy }} 
+		retryPolicy: {{- WebhookRetryPolicyInputToGQL .RetryPolicy }},
+		{{- end }}
 		{{- if .Timeout }} 
 		timeout: "{{.Timeout }}",
 		{{- end }}
@@ -285,6 +309,32 @@ func (g *Graphqlizer) WebhookInputToGQL(in *graphql.WebhookInput) (string, error
 	}`)
 }
 
+func (g *Graphqlizer) WebhookRetryPolicyInputToGQL(in *graphql.WebhookRetryPolicyInput) (string, error) {
+	return g.genericToGQL(in, `{
+		{{- if .MaxAttempts }}
+		maxAttempts: {{ .MaxAttempts }},
+		{{- end }}
+		{{- if .InitialInterval }}
+		initialInterval: {{ .InitialInterval }},
+		{{- end }}
+		{{- if .MaxInterval }}
+		maxInterval: {{ .MaxInterval }},
+		{{- end }}
+		{{- if .Multiplier }}
+		multiplier: {{ .Multiplier }},
+		{{- end }}
+		{{- if .Jitter }}
+		jitter: {{ .Jitter }},
+		{{- end }}
+		{{- if .RetryableStatusCodes }}
+		retryableStatusCodes: [
+			{{- range $i, $code := .RetryableStatusCodes }}
+				{{- if $i }}, {{ end }}{{ $code }}
+			{{- end }} ],
+		{{- end }}
+	}`)
+}
+
 func (g *Graphqlizer) APIDefinitionInputToGQL(in graphql.APIDefinitionInput) (string, error) {
 	return g.genericToGQL(in, `{
 		name: "{{ .Name}}",
@@ -572,6 +622,7 @@ func (g *Graphqlizer) genericToGQL(obj interface{}, tmpl string) (string, error)
 	fm["AuthInputToGQL"] = g.AuthInputToGQL
 	fm["LabelsToGQL"] = g.LabelsToGQL
 	fm["WebhookInputToGQL"] = g.WebhookInputToGQL
+	fm["WebhookRetryPolicyInputToGQL"] = g.WebhookRetryPolicyInputToGQL
 	fm["APIDefinitionInputToGQL"] = g.APIDefinitionInputToGQL
 	fm["EventDefinitionInputToGQL"] = g.EventDefinitionInputToGQL
 	fm["ApiSpecInputToGQL"] = g.ApiSpecInputToGQL
@@ -582,6 +633,7 @@ func (g *Graphqlizer) genericToGQL(obj interface{}, tmpl string) (string, error)
 	fm["CredentialDataInputToGQL"] = g.CredentialDataInputToGQL
 	fm["CSRFTokenCredentialRequestAuthInputToGQL"] = g.CSRFTokenCredentialRequestAuthInputToGQL
 	fm["CredentialRequestAuthInputToGQL"] = g.CredentialRequestAuthInputToGQL
+	fm["RequestSigningInputToGQL"] = g.RequestSigningInputToGQL
 	fm["PlaceholderDefinitionInputToGQL"] = g.PlaceholderDefinitionInputToGQL
 	fm["TemplateValueInput"] = g.TemplateValueInputToGQL
 	fm["BundleInstanceAuthStatusInputToGQL"] = g.BundleInstanceAuthStatusInputToGQL
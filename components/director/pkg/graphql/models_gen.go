@@ -66,6 +66,11 @@ type ApplicationFromTemplateInput struct {
 	Values       []*TemplateValueInput `json:"values"`
 }
 
+type ApplicationOrderByInput struct {
+	Field     ApplicationOrderField `json:"field"`
+	Direction *OrderDirection       `json:"direction"`
+}
+
 type ApplicationPage struct {
 	Data       []*Application `json:"data"`
 	PageInfo   *PageInfo      `json:"pageInfo"`
@@ -144,6 +149,7 @@ type Auth struct {
 	AdditionalQueryParams           QueryParams            `json:"additionalQueryParams"`
 	AdditionalQueryParamsSerialized *QueryParamsSerialized `json:"additionalQueryParamsSerialized"`
 	RequestAuth                     *CredentialRequestAuth `json:"requestAuth"`
+	Signing                         *RequestSigning        `json:"signing"`
 }
 
 type AuthInput struct {
@@ -155,6 +161,8 @@ type AuthInput struct {
 	AdditionalQueryParams           QueryParams                 `json:"additionalQueryParams"`
 	AdditionalQueryParamsSerialized *QueryParamsSerialized      `json:"additionalQueryParamsSerialized"`
 	RequestAuth                     *CredentialRequestAuthInput `json:"requestAuth"`
+	// **Validation:** if provided, at least one secret required
+	Signing *RequestSigningInput `json:"signing"`
 }
 
 type AutomaticScenarioAssignment struct {
@@ -295,10 +303,27 @@ type CSRFTokenCredentialRequestAuthInput struct {
 	AdditionalQueryParamsSerialized *QueryParamsSerialized `json:"additionalQueryParamsSerialized"`
 }
 
-// **Validation:** basic or oauth field required
+// Client certificate presented by Compass when calling the system
+type CertificateCredentialData struct {
+	// PEM encoded certificate chain
+	Certificate string `json:"certificate"`
+	// PEM encoded private key of the certificate
+	PrivateKey string `json:"privateKey"`
+}
+
+func (CertificateCredentialData) IsCredentialData() {}
+
+// **Validation:** certificate and private key must form a valid PEM encoded key pair
+type CertificateCredentialDataInput struct {
+	Certificate string `json:"certificate"`
+	PrivateKey  string `json:"privateKey"`
+}
+
+// **Validation:** exactly one of basic, oauth or certificate field required
 type CredentialDataInput struct {
-	Basic *BasicCredentialDataInput `json:"basic"`
-	Oauth *OAuthCredentialDataInput `json:"oauth"`
+	Basic       *BasicCredentialDataInput       `json:"basic"`
+	Oauth       *OAuthCredentialDataInput       `json:"oauth"`
+	Certificate *CertificateCredentialDataInput `json:"certificate"`
 }
 
 type CredentialRequestAuth struct {
@@ -375,10 +400,11 @@ type FetchRequestInput struct {
 	URL string `json:"url"`
 	// Currently unsupported, providing it will result in a failure
 	Auth *AuthInput `json:"auth"`
-	// Currently unsupported, providing it will result in a failure
+	// SINGLE fetches the Spec from the URL, BUNDLE fetches a zip or tar.gz archive and extracts the Spec matching the filter,
+	// INDEX fetches a JSON index in the form {"specs": [{"name": "...", "url": "..."}]} and fetches the Spec whose name matches the filter
 	Mode *FetchMode `json:"mode"`
-	// **Validation:** max=256
-	// Currently unsupported, providing it will result in a failure
+	// **Validation:** max=256, valid glob pattern
+	// Selects the Spec from the bundle or index. Required when the bundle or index contains more than one Spec and not supported for SINGLE mode
 	Filter *string `json:"filter"`
 }
 
@@ -438,9 +464,13 @@ type LabelDefinitionInput struct {
 type LabelFilter struct {
 	// Label key. If query for the filter is not provided, returns every object with given label key regardless of its value.
 	Key string `json:"key"`
-	// Optional SQL/JSON Path expression. If query is not provided, returns every object with given label key regardless of its value.
-	// Currently only a limited subset of expressions is supported.
+	// Optional query for the label value. If query is not provided, returns every object with given label key regardless of its value.
+	// It can be a JSON value which the label value must contain, a limited subset of SQL/JSON Path expressions,
+	// or a label selector such as `!= "foo"`, `in ("foo", "bar")`, `notin ("foo")`, `exists`, `!exists`, `>= 5`, `prefix "foo"` or `regex "^foo"`.
+	// Label selector expressions can be combined with `||`.
 	Query *string `json:"query"`
+	// Alternative filters. An object matches the filter if it matches either the filter itself or any of the alternatives.
+	Or []*LabelFilter `json:"or"`
 }
 
 type LabelInput struct {
@@ -470,10 +500,88 @@ type OAuthCredentialDataInput struct {
 	URL string `json:"url"`
 }
 
+type ORDDocumentSyncStatus struct {
+	URL          string  `json:"url"`
+	Hash         string  `json:"hash"`
+	Etag         *string `json:"etag"`
+	LastModified *string `json:"lastModified"`
+	// Set when the document was not synchronized, e.g. because none of its access strategies is supported
+	SkipReason *string `json:"skipReason"`
+}
+
+type ORDSyncStatus struct {
+	LastSyncAt          *Timestamp               `json:"lastSyncAt"`
+	LastSuccessAt       *Timestamp               `json:"lastSuccessAt"`
+	LastError           *string                  `json:"lastError"`
+	ConsecutiveFailures int                      `json:"consecutiveFailures"`
+	NextSyncAt          *Timestamp               `json:"nextSyncAt"`
+	Documents           []*ORDDocumentSyncStatus `json:"documents"`
+}
+
+type Operation struct {
+	ID                string                    `json:"id"`
+	ResourceType      string                    `json:"resourceType"`
+	ResourceID        string                    `json:"resourceID"`
+	OperationType     OperationType             `json:"operationType"`
+	OperationCategory string                    `json:"operationCategory"`
+	CorrelationID     string                    `json:"correlationID"`
+	Status            OperationStatus           `json:"status"`
+	Error             *string                   `json:"error"`
+	WebhookResults    []*OperationWebhookResult `json:"webhookResults"`
+	CreatedAt         Timestamp                 `json:"createdAt"`
+	FinishedAt        *Timestamp                `json:"finishedAt"`
+}
+
+type OperationPage struct {
+	Data       []*Operation `json:"data"`
+	PageInfo   *PageInfo    `json:"pageInfo"`
+	TotalCount int          `json:"totalCount"`
+}
+
+func (OperationPage) IsPageable() {}
+
+type OperationWebhookResult struct {
+	WebhookID      string `json:"webhookID"`
+	State          string `json:"state"`
+	RetriesCount   int    `json:"retriesCount"`
+	FailedAttempts int    `json:"failedAttempts"`
+}
+
+type Package struct {
+	ID                string  `json:"id"`
+	ApplicationID     string  `json:"applicationID"`
+	OrdID             string  `json:"ordID"`
+	Vendor            *string `json:"vendor"`
+	Title             string  `json:"title"`
+	ShortDescription  string  `json:"shortDescription"`
+	Description       string  `json:"description"`
+	Version           string  `json:"version"`
+	PackageLinks      *JSON   `json:"packageLinks"`
+	Links             *JSON   `json:"links"`
+	LicenseType       *string `json:"licenseType"`
+	Tags              *JSON   `json:"tags"`
+	Countries         *JSON   `json:"countries"`
+	Labels            *JSON   `json:"labels"`
+	PolicyLevel       string  `json:"policyLevel"`
+	CustomPolicyLevel *string `json:"customPolicyLevel"`
+	PartOfProducts    *JSON   `json:"partOfProducts"`
+	LineOfBusiness    *JSON   `json:"lineOfBusiness"`
+	Industry          *JSON   `json:"industry"`
+}
+
+type PackagePage struct {
+	Data       []*Package `json:"data"`
+	PageInfo   *PageInfo  `json:"pageInfo"`
+	TotalCount int        `json:"totalCount"`
+}
+
+func (PackagePage) IsPageable() {}
+
 type PageInfo struct {
-	StartCursor PageCursor `json:"startCursor"`
-	EndCursor   PageCursor `json:"endCursor"`
-	HasNextPage bool       `json:"hasNextPage"`
+	StartCursor     PageCursor `json:"startCursor"`
+	EndCursor       PageCursor `json:"endCursor"`
+	HasNextPage     bool       `json:"hasNextPage"`
+	HasPreviousPage bool       `json:"hasPreviousPage"`
 }
 
 type PlaceholderDefinition struct {
@@ -488,6 +596,46 @@ type PlaceholderDefinitionInput struct {
 	Description *string `json:"description"`
 }
 
+type Product struct {
+	// Same as the ordID, which identifies the Product. Use it to query the product by ID
+	ID               string  `json:"id"`
+	OrdID            string  `json:"ordID"`
+	ApplicationID    string  `json:"applicationID"`
+	Title            string  `json:"title"`
+	ShortDescription string  `json:"shortDescription"`
+	Vendor           string  `json:"vendor"`
+	Parent           *string `json:"parent"`
+	SapPpmsObjectID  *string `json:"sapPpmsObjectID"`
+	Labels           *JSON   `json:"labels"`
+}
+
+type ProductPage struct {
+	Data       []*Product `json:"data"`
+	PageInfo   *PageInfo  `json:"pageInfo"`
+	TotalCount int        `json:"totalCount"`
+}
+
+func (ProductPage) IsPageable() {}
+
+// HMAC-SHA256 signing of the requests sent by Compass. The signatures are computed over the timestamp and the body of the request as "<timestamp>.<body>"
+type RequestSigning struct {
+	// Header carrying the comma separated signatures of the request, one for each of the secrets, in the form "sha256=<hex encoded signature>"
+	Header string `json:"header"`
+	// Header carrying the Unix time at which the request has been signed
+	TimestampHeader string `json:"timestampHeader"`
+	// Every request is signed with each of the secrets so that secrets can be rotated without downtime
+	Secrets []string `json:"secrets"`
+}
+
+type RequestSigningInput struct {
+	// **Validation:** valid HTTP header name. Defaults to X-Compass-Signature
+	Header *string `json:"header"`
+	// **Validation:** valid HTTP header name. Defaults to X-Compass-Signature-Timestamp
+	TimestampHeader *string `json:"timestampHeader"`
+	// **Validation:** at least one secret required, max=10
+	Secrets []string `json:"secrets"`
+}
+
 type RuntimeContextInput struct {
 	// **Validation:** required max=512, alphanumeric chartacters and underscore
 	Key   string `json:"key"`
@@ -522,6 +670,11 @@ type RuntimeMetadata struct {
 	CreationTimestamp Timestamp `json:"creationTimestamp"`
 }
 
+type RuntimeOrderByInput struct {
+	Field     RuntimeOrderField `json:"field"`
+	Direction *OrderDirection   `json:"direction"`
+}
+
 type RuntimePage struct {
 	Data       []*Runtime `json:"data"`
 	PageInfo   *PageInfo  `json:"pageInfo"`
@@ -553,6 +706,40 @@ type Tenant struct {
 	Initialized *bool   `json:"initialized"`
 }
 
+type Tombstone struct {
+	// Same as the ordID, which identifies the Tombstone. Use it to query the tombstone by ID
+	ID            string `json:"id"`
+	OrdID         string `json:"ordID"`
+	ApplicationID string `json:"applicationID"`
+	RemovalDate   string `json:"removalDate"`
+}
+
+type TombstonePage struct {
+	Data       []*Tombstone `json:"data"`
+	PageInfo   *PageInfo    `json:"pageInfo"`
+	TotalCount int          `json:"totalCount"`
+}
+
+func (TombstonePage) IsPageable() {}
+
+type Vendor struct {
+	// Same as the ordID, which identifies the Vendor. Use it to query the vendor by ID
+	ID            string `json:"id"`
+	OrdID         string `json:"ordID"`
+	ApplicationID string `json:"applicationID"`
+	Title         string `json:"title"`
+	Type          string `json:"type"`
+	Labels        *JSON  `json:"labels"`
+}
+
+type VendorPage struct {
+	Data       []*Vendor `json:"data"`
+	PageInfo   *PageInfo `json:"pageInfo"`
+	TotalCount int       `json:"totalCount"`
+}
+
+func (VendorPage) IsPageable() {}
+
 type Version struct {
 	// for example 4.6
 	Value      string `json:"value"`
@@ -578,39 +765,65 @@ type Viewer struct {
 }
 
 type Webhook struct {
-	ID                    string       `json:"id"`
-	ApplicationID         *string      `json:"applicationID"`
-	ApplicationTemplateID *string      `json:"applicationTemplateID"`
-	RuntimeID             *string      `json:"runtimeID"`
-	IntegrationSystemID   *string      `json:"integrationSystemID"`
-	Type                  WebhookType  `json:"type"`
-	Mode                  *WebhookMode `json:"mode"`
-	CorrelationIDKey      *string      `json:"correlationIdKey"`
-	RetryInterval         *int         `json:"retryInterval"`
-	Timeout               *int         `json:"timeout"`
-	URL                   *string      `json:"url"`
-	Auth                  *Auth        `json:"auth"`
-	URLTemplate           *string      `json:"urlTemplate"`
-	InputTemplate         *string      `json:"inputTemplate"`
-	HeaderTemplate        *string      `json:"headerTemplate"`
-	OutputTemplate        *string      `json:"outputTemplate"`
-	StatusTemplate        *string      `json:"statusTemplate"`
+	ID                    string              `json:"id"`
+	ApplicationID         *string             `json:"applicationID"`
+	ApplicationTemplateID *string             `json:"applicationTemplateID"`
+	RuntimeID             *string             `json:"runtimeID"`
+	IntegrationSystemID   *string             `json:"integrationSystemID"`
+	Type                  WebhookType         `json:"type"`
+	Mode                  *WebhookMode        `json:"mode"`
+	CorrelationIDKey      *string             `json:"correlationIdKey"`
+	RetryInterval         *int                `json:"retryInterval"`
+	RetryPolicy           *WebhookRetryPolicy `json:"retryPolicy"`
+	Timeout               *int                `json:"timeout"`
+	URL                   *string             `json:"url"`
+	Auth                  *Auth               `json:"auth"`
+	URLTemplate           *string             `json:"urlTemplate"`
+	InputTemplate         *string             `json:"inputTemplate"`
+	HeaderTemplate        *string             `json:"headerTemplate"`
+	OutputTemplate        *string             `json:"outputTemplate"`
+	StatusTemplate        *string             `json:"statusTemplate"`
 }
 
 type WebhookInput struct {
 	Type WebhookType `json:"type"`
 	// **Validation:** valid URL, max=256
-	URL              *string      `json:"url"`
-	Auth             *AuthInput   `json:"auth"`
-	Mode             *WebhookMode `json:"mode"`
-	CorrelationIDKey *string      `json:"correlationIdKey"`
-	RetryInterval    *int         `json:"retryInterval"`
-	Timeout          *int         `json:"timeout"`
-	URLTemplate      *string      `json:"urlTemplate"`
-	InputTemplate    *string      `json:"inputTemplate"`
-	HeaderTemplate   *string      `json:"headerTemplate"`
-	OutputTemplate   *string      `json:"outputTemplate"`
-	StatusTemplate   *string      `json:"statusTemplate"`
+	URL              *string                  `json:"url"`
+	Auth             *AuthInput               `json:"auth"`
+	Mode             *WebhookMode             `json:"mode"`
+	CorrelationIDKey *string                  `json:"correlationIdKey"`
+	RetryInterval    *int                     `json:"retryInterval"`
+	RetryPolicy      *WebhookRetryPolicyInput `json:"retryPolicy"`
+	Timeout          *int                     `json:"timeout"`
+	URLTemplate      *string                  `json:"urlTemplate"`
+	InputTemplate    *string                  `json:"inputTemplate"`
+	HeaderTemplate   *string                  `json:"headerTemplate"`
+	OutputTemplate   *string                  `json:"outputTemplate"`
+	StatusTemplate   *string                  `json:"statusTemplate"`
+}
+
+type WebhookRetryPolicy struct {
+	MaxAttempts          *int     `json:"maxAttempts"`
+	InitialInterval      *int     `json:"initialInterval"`
+	MaxInterval          *int     `json:"maxInterval"`
+	Multiplier           *float64 `json:"multiplier"`
+	Jitter               *float64 `json:"jitter"`
+	RetryableStatusCodes []int    `json:"retryableStatusCodes"`
+}
+
+type WebhookRetryPolicyInput struct {
+	// **Validation:** min=1
+	MaxAttempts *int `json:"maxAttempts"`
+	// Interval in seconds before the first retry. **Validation:** min=0
+	InitialInterval *int `json:"initialInterval"`
+	// Upper bound in seconds of the interval between retries. **Validation:** min=0, not lower than initialInterval
+	MaxInterval *int `json:"maxInterval"`
+	// Factor by which the interval grows after each retry. **Validation:** min=1
+	Multiplier *float64 `json:"multiplier"`
+	// Fraction of the interval by which it is randomized. **Validation:** min=0, max=1
+	Jitter *float64 `json:"jitter"`
+	// Response status codes for which a failed execution is retried. All failures are retried if not provided. **Validation:** valid HTTP status codes
+	RetryableStatusCodes []int `json:"retryableStatusCodes"`
 }
 
 type APISpecType string
@@ -654,6 +867,47 @@ func (e APISpecType) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
+type ApplicationOrderField string
+
+const (
+	ApplicationOrderFieldID   ApplicationOrderField = "ID"
+	ApplicationOrderFieldName ApplicationOrderField = "NAME"
+)
+
+var AllApplicationOrderField = []ApplicationOrderField{
+	ApplicationOrderFieldID,
+	ApplicationOrderFieldName,
+}
+
+func (e ApplicationOrderField) IsValid() bool {
+	switch e {
+	case ApplicationOrderFieldID, ApplicationOrderFieldName:
+		return true
+	}
+	return false
+}
+
+func (e ApplicationOrderField) String() string {
+	return string(e)
+}
+
+func (e *ApplicationOrderField) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ApplicationOrderField(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ApplicationOrderField", str)
+	}
+	return nil
+}
+
+func (e ApplicationOrderField) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 type ApplicationStatusCondition string
 
 const (
@@ -1127,6 +1381,49 @@ func (e OperationMode) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
+type OperationStatus string
+
+const (
+	OperationStatusSucceeded  OperationStatus = "SUCCEEDED"
+	OperationStatusFailed     OperationStatus = "FAILED"
+	OperationStatusInProgress OperationStatus = "IN_PROGRESS"
+)
+
+var AllOperationStatus = []OperationStatus{
+	OperationStatusSucceeded,
+	OperationStatusFailed,
+	OperationStatusInProgress,
+}
+
+func (e OperationStatus) IsValid() bool {
+	switch e {
+	case OperationStatusSucceeded, OperationStatusFailed, OperationStatusInProgress:
+		return true
+	}
+	return false
+}
+
+func (e OperationStatus) String() string {
+	return string(e)
+}
+
+func (e *OperationStatus) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = OperationStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid OperationStatus", str)
+	}
+	return nil
+}
+
+func (e OperationStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 type OperationType string
 
 const (
@@ -1170,6 +1467,90 @@ func (e OperationType) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
+type OrderDirection string
+
+const (
+	OrderDirectionAsc  OrderDirection = "ASC"
+	OrderDirectionDesc OrderDirection = "DESC"
+)
+
+var AllOrderDirection = []OrderDirection{
+	OrderDirectionAsc,
+	OrderDirectionDesc,
+}
+
+func (e OrderDirection) IsValid() bool {
+	switch e {
+	case OrderDirectionAsc, OrderDirectionDesc:
+		return true
+	}
+	return false
+}
+
+func (e OrderDirection) String() string {
+	return string(e)
+}
+
+func (e *OrderDirection) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = OrderDirection(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid OrderDirection", str)
+	}
+	return nil
+}
+
+func (e OrderDirection) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type RuntimeOrderField string
+
+const (
+	RuntimeOrderFieldID                RuntimeOrderField = "ID"
+	RuntimeOrderFieldName              RuntimeOrderField = "NAME"
+	RuntimeOrderFieldCreationTimestamp RuntimeOrderField = "CREATION_TIMESTAMP"
+)
+
+var AllRuntimeOrderField = []RuntimeOrderField{
+	RuntimeOrderFieldID,
+	RuntimeOrderFieldName,
+	RuntimeOrderFieldCreationTimestamp,
+}
+
+func (e RuntimeOrderField) IsValid() bool {
+	switch e {
+	case RuntimeOrderFieldID, RuntimeOrderFieldName, RuntimeOrderFieldCreationTimestamp:
+		return true
+	}
+	return false
+}
+
+func (e RuntimeOrderField) String() string {
+	return string(e)
+}
+
+func (e *RuntimeOrderField) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = RuntimeOrderField(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid RuntimeOrderField", str)
+	}
+	return nil
+}
+
+func (e RuntimeOrderField) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 type RuntimeStatusCondition string
 
 const (
@@ -1351,6 +1732,8 @@ const (
 	WebhookTypeRegisterApplication   WebhookType = "REGISTER_APPLICATION"
 	WebhookTypeUnregisterApplication WebhookType = "UNREGISTER_APPLICATION"
 	WebhookTypeOpenResourceDiscovery WebhookType = "OPEN_RESOURCE_DISCOVERY"
+	WebhookTypeRegisterRuntime       WebhookType = "REGISTER_RUNTIME"
+	WebhookTypeUnregisterRuntime     WebhookType = "UNREGISTER_RUNTIME"
 )
 
 var AllWebhookType = []WebhookType{
@@ -1358,11 +1741,13 @@ var AllWebhookType = []WebhookType{
 	WebhookTypeRegisterApplication,
 	WebhookTypeUnregisterApplication,
 	WebhookTypeOpenResourceDiscovery,
+	WebhookTypeRegisterRuntime,
+	WebhookTypeUnregisterRuntime,
 }
 
 func (e WebhookType) IsValid() bool {
 	switch e {
-	case WebhookTypeConfigurationChanged, WebhookTypeRegisterApplication, WebhookTypeUnregisterApplication, WebhookTypeOpenResourceDiscovery:
+	case WebhookTypeConfigurationChanged, WebhookTypeRegisterApplication, WebhookTypeUnregisterApplication, WebhookTypeOpenResourceDiscovery, WebhookTypeRegisterRuntime, WebhookTypeUnregisterRuntime:
 		return true
 	}
 	return false
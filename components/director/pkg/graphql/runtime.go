@@ -1,5 +1,7 @@
 package graphql
 
+import "github.com/kyma-incubator/compass/components/director/pkg/resource"
+
 type Runtime struct {
 	ID                    string                        `json:"id"`
 	Name                  string                        `json:"name"`
@@ -7,8 +9,21 @@ type Runtime struct {
 	Status                *RuntimeStatus                `json:"status"`
 	Metadata              *RuntimeMetadata              `json:"metadata"`
 	EventingConfiguration *RuntimeEventingConfiguration `json:"eventingConfiguration"`
+	UpdatedAt             *Timestamp                    `json:"updatedAt"`
+	DeletedAt             *Timestamp                    `json:"deletedAt"`
+	Error                 *string                       `json:"error"`
+}
+
+func (e *Runtime) GetID() string {
+	return e.ID
 }
 
+func (e *Runtime) GetType() resource.Type {
+	return resource.Runtime
+}
+
+func (e *Runtime) Sentinel() {}
+
 // Extended types used by external API
 
 type RuntimePageExt struct {
@@ -20,5 +35,6 @@ type RuntimeExt struct {
 	Runtime
 	Labels Labels `json:"labels"`
 	// Returns array of authentication details for Runtime. For now at most one element in array will be returned.
-	Auths []*SystemAuth `json:"auths"`
+	Auths    []*SystemAuth `json:"auths"`
+	Webhooks []Webhook     `json:"webhooks"`
 }
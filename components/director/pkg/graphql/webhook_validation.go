@@ -8,6 +8,7 @@ import (
 	validation "github.com/go-ozzo/ozzo-validation"
 	"github.com/go-ozzo/ozzo-validation/is"
 	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/inputvalidation"
 	"github.com/kyma-incubator/compass/components/director/pkg/webhook"
 )
 
@@ -28,7 +29,7 @@ func (i WebhookInput) Validate() error {
 		}
 	}
 
-	requestObject := webhook.RequestObject{Application: &Application{BaseEntity: &BaseEntity{}}}
+	requestObject := webhook.RequestObject{Application: &Application{BaseEntity: &BaseEntity{}}, Runtime: &Runtime{}}
 	if i.URLTemplate != nil {
 		if _, err := requestObject.ParseURLTemplate(i.URLTemplate); err != nil {
 			log.D().Errorf("failed to parse URL Template: %s", err.Error())
@@ -70,12 +71,28 @@ func (i WebhookInput) Validate() error {
 	}
 
 	return validation.ValidateStruct(&i,
-		validation.Field(&i.Type, validation.Required, validation.In(WebhookTypeConfigurationChanged, WebhookTypeRegisterApplication, WebhookTypeUnregisterApplication, WebhookTypeOpenResourceDiscovery)),
+		validation.Field(&i.Type, validation.Required, validation.In(WebhookTypeConfigurationChanged, WebhookTypeRegisterApplication, WebhookTypeUnregisterApplication, WebhookTypeRegisterRuntime, WebhookTypeUnregisterRuntime, WebhookTypeOpenResourceDiscovery)),
 		validation.Field(&i.URL, is.URL, validation.RuneLength(0, longStringLengthLimit)),
 		validation.Field(&i.CorrelationIDKey, validation.RuneLength(0, longStringLengthLimit)),
 		validation.Field(&i.Mode, validation.In(WebhookModeSync, WebhookModeAsync)),
 		validation.Field(&i.RetryInterval, validation.Min(0)),
 		validation.Field(&i.Timeout, validation.Min(0)),
 		validation.Field(&i.Auth),
+		validation.Field(&i.RetryPolicy),
+	)
+}
+
+func (i WebhookRetryPolicyInput) Validate() error {
+	if i.InitialInterval != nil && i.MaxInterval != nil && *i.MaxInterval < *i.InitialInterval {
+		return apperrors.NewInvalidDataError("webhook retry policy max interval cannot be lower than initial interval")
+	}
+
+	return validation.ValidateStruct(&i,
+		validation.Field(&i.MaxAttempts, validation.NilOrNotEmpty, validation.Min(1)),
+		validation.Field(&i.InitialInterval, validation.Min(0)),
+		validation.Field(&i.MaxInterval, validation.Min(0)),
+		validation.Field(&i.Multiplier, validation.NilOrNotEmpty, validation.Min(1.0)),
+		validation.Field(&i.Jitter, validation.Min(0.0), validation.Max(1.0)),
+		validation.Field(&i.RetryableStatusCodes, inputvalidation.Each(validation.Min(100), validation.Max(599))),
 	)
 }
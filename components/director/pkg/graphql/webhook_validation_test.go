@@ -21,6 +21,16 @@ func TestWebhookInput_Validate_Type(t *testing.T) {
 			Value:         graphql.WebhookTypeConfigurationChanged,
 			ExpectedValid: true,
 		},
+		{
+			Name:          "ExpectedValid - register runtime",
+			Value:         graphql.WebhookTypeRegisterRuntime,
+			ExpectedValid: true,
+		},
+		{
+			Name:          "ExpectedValid - unregister runtime",
+			Value:         graphql.WebhookTypeUnregisterRuntime,
+			ExpectedValid: true,
+		},
 		{
 			Name:          "Invalid - Empty",
 			Value:         inputvalidationtest.EmptyString,
@@ -266,6 +276,101 @@ func TestWebhookInput_Validate_RetryInterval(t *testing.T) {
 	}
 }
 
+func TestWebhookInput_Validate_RetryPolicy(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		Value         *graphql.WebhookRetryPolicyInput
+		ExpectedValid bool
+	}{
+		{
+			Name:          "ExpectedValid",
+			Value:         fixValidWebhookRetryPolicyInput(),
+			ExpectedValid: true,
+		},
+		{
+			Name:          "Empty",
+			Value:         &graphql.WebhookRetryPolicyInput{},
+			ExpectedValid: true,
+		},
+		{
+			Name:          "Nil",
+			Value:         nil,
+			ExpectedValid: true,
+		},
+		{
+			Name: "Invalid - max attempts lower than one",
+			Value: func() *graphql.WebhookRetryPolicyInput {
+				policy := fixValidWebhookRetryPolicyInput()
+				policy.MaxAttempts = intPtr(0)
+				return policy
+			}(),
+			ExpectedValid: false,
+		},
+		{
+			Name: "Invalid - negative initial interval",
+			Value: func() *graphql.WebhookRetryPolicyInput {
+				policy := fixValidWebhookRetryPolicyInput()
+				policy.InitialInterval = intPtr(-1)
+				return policy
+			}(),
+			ExpectedValid: false,
+		},
+		{
+			Name: "Invalid - max interval lower than initial interval",
+			Value: func() *graphql.WebhookRetryPolicyInput {
+				policy := fixValidWebhookRetryPolicyInput()
+				policy.InitialInterval = intPtr(30)
+				policy.MaxInterval = intPtr(10)
+				return policy
+			}(),
+			ExpectedValid: false,
+		},
+		{
+			Name: "Invalid - multiplier lower than one",
+			Value: func() *graphql.WebhookRetryPolicyInput {
+				policy := fixValidWebhookRetryPolicyInput()
+				policy.Multiplier = floatPtr(0.5)
+				return policy
+			}(),
+			ExpectedValid: false,
+		},
+		{
+			Name: "Invalid - jitter greater than one",
+			Value: func() *graphql.WebhookRetryPolicyInput {
+				policy := fixValidWebhookRetryPolicyInput()
+				policy.Jitter = floatPtr(1.5)
+				return policy
+			}(),
+			ExpectedValid: false,
+		},
+		{
+			Name: "Invalid - unknown status code",
+			Value: func() *graphql.WebhookRetryPolicyInput {
+				policy := fixValidWebhookRetryPolicyInput()
+				policy.RetryableStatusCodes = []int{503, 42}
+				return policy
+			}(),
+			ExpectedValid: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			//GIVEN
+			sut := fixValidWebhookInput(inputvalidationtest.ValidURL)
+			sut.RetryPolicy = testCase.Value
+			//WHEN
+			err := sut.Validate()
+			//THEN
+			if testCase.ExpectedValid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
 func TestWebhookInput_Validate_Timeout(t *testing.T) {
 	testCases := []struct {
 		Name          string
@@ -712,6 +817,17 @@ func fixValidWebhookInput(url string) graphql.WebhookInput {
 	return webhookInput
 }
 
+func fixValidWebhookRetryPolicyInput() *graphql.WebhookRetryPolicyInput {
+	return &graphql.WebhookRetryPolicyInput{
+		MaxAttempts:          intPtr(5),
+		InitialInterval:      intPtr(1),
+		MaxInterval:          intPtr(60),
+		Multiplier:           floatPtr(2),
+		Jitter:               floatPtr(0.2),
+		RetryableStatusCodes: []int{429, 502, 503},
+	}
+}
+
 func stringPtr(s string) *string {
 	return &s
 }
@@ -720,6 +836,10 @@ func intPtr(n int) *int {
 	return &n
 }
 
+func floatPtr(f float64) *float64 {
+	return &f
+}
+
 func webhookModePtr(mode graphql.WebhookMode) *graphql.WebhookMode {
 	return &mode
 }
@@ -0,0 +1,117 @@
+/*
+ * Copyright 2020 The Compass Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package http
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"encoding/hex"
+	"net/http"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// maxClientCertificateTransports is the number of transports kept for distinct client certificates,
+// after which the transport created the earliest is discarded so that rotated certificates do not accumulate
+const maxClientCertificateTransports = 100
+
+// ClientCertificate is a PEM encoded client certificate together with its private key
+type ClientCertificate struct {
+	Certificate string
+	PrivateKey  string
+}
+
+// SaveClientCertificateToContext saves the client certificate which the ClientCertificateTransport presents for the requests executed with the context
+func SaveClientCertificateToContext(ctx context.Context, certificate ClientCertificate) context.Context {
+	return context.WithValue(ctx, clientCertificateContextKey, certificate)
+}
+
+// LoadClientCertificateFromContext retrieves the client certificate from the context, if any
+func LoadClientCertificateFromContext(ctx context.Context) (ClientCertificate, bool) {
+	certificate, ok := ctx.Value(clientCertificateContextKey).(ClientCertificate)
+	return certificate, ok
+}
+
+// NewClientCertificateTransport returns a transport that presents the client certificate carried by the request context during the TLS handshake.
+func NewClientCertificateTransport(base *http.Transport) *ClientCertificateTransport {
+	return &ClientCertificateTransport{
+		base:       base,
+		transports: make(map[string]*http.Transport),
+	}
+}
+
+// ClientCertificateTransport is a transport that presents the client certificate carried by the request context during the TLS handshake.
+// Requests without a client certificate are executed with the base transport. For every distinct client certificate
+// a copy of the base transport is created and kept, so that the connections authenticated with the certificate are reused.
+// At most maxClientCertificateTransports transports are kept, the idle connections of the discarded ones being closed.
+type ClientCertificateTransport struct {
+	base *http.Transport
+
+	mutex      sync.Mutex
+	transports map[string]*http.Transport
+	keys       []string
+}
+
+// RoundTrip executes the request with a transport configured with the client certificate from the request context, if any.
+func (c *ClientCertificateTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	certificate, ok := LoadClientCertificateFromContext(r.Context())
+	if !ok {
+		return c.base.RoundTrip(r)
+	}
+
+	transport, err := c.transportFor(certificate)
+	if err != nil {
+		return nil, err
+	}
+
+	return transport.RoundTrip(r)
+}
+
+func (c *ClientCertificateTransport) transportFor(certificate ClientCertificate) (*http.Transport, error) {
+	sum := sha256.Sum256([]byte(certificate.Certificate + certificate.PrivateKey))
+	key := hex.EncodeToString(sum[:])
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if transport, ok := c.transports[key]; ok {
+		return transport, nil
+	}
+
+	keyPair, err := tls.X509KeyPair([]byte(certificate.Certificate), []byte(certificate.PrivateKey))
+	if err != nil {
+		return nil, errors.Wrap(err, "while parsing client certificate")
+	}
+
+	transport := c.base.Clone()
+	if transport.TLSClientConfig == nil {
+		transport.TLSClientConfig = &tls.Config{}
+	}
+	transport.TLSClientConfig.Certificates = []tls.Certificate{keyPair}
+
+	if len(c.keys) >= maxClientCertificateTransports {
+		c.transports[c.keys[0]].CloseIdleConnections()
+		delete(c.transports, c.keys[0])
+		c.keys = c.keys[1:]
+	}
+
+	c.transports[key] = transport
+	c.keys = append(c.keys, key)
+	return transport, nil
+}
@@ -0,0 +1,134 @@
+package http_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	httputil "github.com/kyma-incubator/compass/components/director/pkg/http"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClientCertificateTransport_RoundTrip(t *testing.T) {
+	// GIVEN
+	certificate, parsed := fixClientCertificate(t)
+
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if len(r.TLS.PeerCertificates) == 0 {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		assert.Equal(t, parsed.Raw, r.TLS.PeerCertificates[0].Raw)
+		w.WriteHeader(http.StatusOK)
+	}))
+	server.TLS = &tls.Config{ClientAuth: tls.RequestClientCert}
+	server.StartTLS()
+	defer server.Close()
+
+	base := server.Client().Transport.(*http.Transport)
+
+	t.Run("presents the client certificate from the context", func(t *testing.T) {
+		ctx := httputil.SaveClientCertificateToContext(context.TODO(), certificate)
+		request, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+		require.NoError(t, err)
+
+		// WHEN
+		resp, err := httputil.NewClientCertificateTransport(base).RoundTrip(request)
+
+		// THEN
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+	})
+
+	t.Run("does not present a client certificate without one in the context", func(t *testing.T) {
+		request, err := http.NewRequest(http.MethodGet, server.URL, nil)
+		require.NoError(t, err)
+
+		// WHEN
+		resp, err := httputil.NewClientCertificateTransport(base).RoundTrip(request)
+
+		// THEN
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+	})
+
+	t.Run("fails for an invalid client certificate", func(t *testing.T) {
+		ctx := httputil.SaveClientCertificateToContext(context.TODO(), httputil.ClientCertificate{Certificate: "certificate", PrivateKey: "key"})
+		request, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+		require.NoError(t, err)
+
+		// WHEN
+		_, err = httputil.NewClientCertificateTransport(base).RoundTrip(request)
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "while parsing client certificate")
+	})
+
+}
+
+func TestClientCertificateTransport_RoundTrip_KeepsBoundedNumberOfTransports(t *testing.T) {
+	// GIVEN
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	server.TLS = &tls.Config{ClientAuth: tls.RequireAnyClientCert}
+	server.StartTLS()
+	defer server.Close()
+
+	transport := httputil.NewClientCertificateTransport(server.Client().Transport.(*http.Transport))
+
+	for i := 0; i <= httputil.MaxClientCertificateTransports; i++ {
+		certificate, _ := fixClientCertificate(t)
+		ctx := httputil.SaveClientCertificateToContext(context.TODO(), certificate)
+		request, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+		require.NoError(t, err)
+
+		// WHEN
+		resp, err := transport.RoundTrip(request)
+
+		// THEN
+		require.NoError(t, err)
+		require.NoError(t, resp.Body.Close())
+	}
+
+	assert.Equal(t, httputil.MaxClientCertificateTransports, transport.TransportsCount())
+}
+
+func fixClientCertificate(t *testing.T) (httputil.ClientCertificate, *x509.Certificate) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "compass"},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	parsed, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	privateKey, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+
+	return httputil.ClientCertificate{
+		Certificate: string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})),
+		PrivateKey:  string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: privateKey})),
+	}, parsed
+}
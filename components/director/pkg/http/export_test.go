@@ -0,0 +1,9 @@
+package http
+
+const MaxClientCertificateTransports = maxClientCertificateTransports
+
+func (c *ClientCertificateTransport) TransportsCount() int {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return len(c.transports)
+}
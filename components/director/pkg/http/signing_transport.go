@@ -0,0 +1,155 @@
+/*
+ * Copyright 2020 The Compass Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package http
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// DefaultSignatureHeader is the header carrying the signatures of a request, unless configured otherwise
+	DefaultSignatureHeader = "X-Compass-Signature"
+	// DefaultSignatureTimestampHeader is the header carrying the time at which a request has been signed, unless configured otherwise
+	DefaultSignatureTimestampHeader = "X-Compass-Signature-Timestamp"
+
+	signaturePrefix = "sha256="
+)
+
+type contextKey int
+
+const (
+	requestSigningContextKey contextKey = iota
+	clientCertificateContextKey
+)
+
+// RequestSigning configures the HMAC-SHA256 signing of a request.
+// The request is signed with each of the secrets, so that the receiver can verify the request while a secret is being rotated.
+type RequestSigning struct {
+	Header          string
+	TimestampHeader string
+	Secrets         []string
+}
+
+// SaveRequestSigningToContext saves the signing configuration which the SigningTransport applies to the requests executed with the context
+func SaveRequestSigningToContext(ctx context.Context, signing RequestSigning) context.Context {
+	return context.WithValue(ctx, requestSigningContextKey, signing)
+}
+
+// LoadRequestSigningFromContext retrieves the signing configuration from the context, if any
+func LoadRequestSigningFromContext(ctx context.Context) (RequestSigning, bool) {
+	signing, ok := ctx.Value(requestSigningContextKey).(RequestSigning)
+	return signing, ok
+}
+
+// ComputeSignature computes the hex encoded HMAC-SHA256 signature of the request body signed at the given Unix timestamp.
+// Receivers can use it to verify the signatures of the requests.
+func ComputeSignature(secret, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// NewSigningTransport returns a transport that signs the requests whose context carries a signing configuration.
+func NewSigningTransport(roundTripper HTTPRoundTripper) *SigningTransport {
+	return &SigningTransport{
+		roundTripper: roundTripper,
+		now:          time.Now,
+	}
+}
+
+// SigningTransport is a transport that signs the requests whose context carries a signing configuration.
+// The signatures are set as "sha256=<signature>" values, separated by commas, in the signature header,
+// and the Unix time at which the request has been signed is set in the timestamp header.
+type SigningTransport struct {
+	roundTripper HTTPRoundTripper
+	now          func() time.Time
+}
+
+// RoundTrip signs the request if a signing configuration is present in the request context.
+func (c *SigningTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	signing, ok := LoadRequestSigningFromContext(r.Context())
+	if !ok || len(signing.Secrets) == 0 {
+		return c.roundTripper.RoundTrip(r)
+	}
+
+	body, err := readBody(r)
+	if err != nil {
+		return nil, errors.Wrap(err, "while reading request body for signing")
+	}
+
+	header := signing.Header
+	if header == "" {
+		header = DefaultSignatureHeader
+	}
+	timestampHeader := signing.TimestampHeader
+	if timestampHeader == "" {
+		timestampHeader = DefaultSignatureTimestampHeader
+	}
+
+	timestamp := strconv.FormatInt(c.now().Unix(), 10)
+	signatures := make([]string, 0, len(signing.Secrets))
+	for _, secret := range signing.Secrets {
+		signatures = append(signatures, signaturePrefix+ComputeSignature(secret, timestamp, body))
+	}
+
+	signed := r.Clone(r.Context())
+	signed.Body = ioutil.NopCloser(bytes.NewReader(body))
+	signed.Header.Set(timestampHeader, timestamp)
+	signed.Header.Set(header, strings.Join(signatures, ","))
+
+	return c.roundTripper.RoundTrip(signed)
+}
+
+// readBody reads the request body without consuming it, so that the request can still be sent
+func readBody(r *http.Request) ([]byte, error) {
+	if r.Body == nil || r.Body == http.NoBody {
+		return []byte{}, nil
+	}
+
+	if r.GetBody != nil {
+		body, err := r.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		defer body.Close()
+		return ioutil.ReadAll(body)
+	}
+
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.Body.Close(); err != nil {
+		return nil, err
+	}
+	r.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	return body, nil
+}
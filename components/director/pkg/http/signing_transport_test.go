@@ -0,0 +1,102 @@
+package http_test
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	httputil "github.com/kyma-incubator/compass/components/director/pkg/http"
+	"github.com/kyma-incubator/compass/components/director/pkg/http/automock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSigningTransport_RoundTrip(t *testing.T) {
+	const (
+		testUrl = "http://localhost:8080"
+		body    = `{"foo":"bar"}`
+	)
+
+	t.Run("signs the request with each of the secrets", func(t *testing.T) {
+		// GIVEN
+		rt := &automock.HTTPRoundTripper{}
+		rt.On("RoundTrip", mock.Anything).Return(nil, nil).Run(func(args mock.Arguments) {
+			req, ok := args.Get(0).(*http.Request)
+			require.True(t, ok)
+
+			timestamp := req.Header.Get("X-Timestamp")
+			require.NotEmpty(t, timestamp)
+			expected := "sha256=" + httputil.ComputeSignature("old", timestamp, []byte(body)) +
+				",sha256=" + httputil.ComputeSignature("new", timestamp, []byte(body))
+			assert.Equal(t, expected, req.Header.Get("X-Signature"))
+
+			sent, err := ioutil.ReadAll(req.Body)
+			require.NoError(t, err)
+			assert.Equal(t, body, string(sent))
+		})
+
+		ctx := httputil.SaveRequestSigningToContext(context.TODO(), httputil.RequestSigning{
+			Header:          "X-Signature",
+			TimestampHeader: "X-Timestamp",
+			Secrets:         []string{"old", "new"},
+		})
+		request, err := http.NewRequestWithContext(ctx, http.MethodPost, testUrl, bytes.NewBufferString(body))
+		require.NoError(t, err)
+
+		// WHEN
+		_, err = httputil.NewSigningTransport(rt).RoundTrip(request)
+
+		// THEN
+		require.NoError(t, err)
+		rt.AssertExpectations(t)
+	})
+
+	t.Run("uses the default headers and signs requests without body", func(t *testing.T) {
+		// GIVEN
+		rt := &automock.HTTPRoundTripper{}
+		rt.On("RoundTrip", mock.Anything).Return(nil, nil).Run(func(args mock.Arguments) {
+			req, ok := args.Get(0).(*http.Request)
+			require.True(t, ok)
+
+			timestamp := req.Header.Get(httputil.DefaultSignatureTimestampHeader)
+			require.NotEmpty(t, timestamp)
+			assert.Equal(t, "sha256="+httputil.ComputeSignature("secret", timestamp, []byte{}), req.Header.Get(httputil.DefaultSignatureHeader))
+		})
+
+		ctx := httputil.SaveRequestSigningToContext(context.TODO(), httputil.RequestSigning{Secrets: []string{"secret"}})
+		request, err := http.NewRequestWithContext(ctx, http.MethodGet, testUrl, nil)
+		require.NoError(t, err)
+
+		// WHEN
+		_, err = httputil.NewSigningTransport(rt).RoundTrip(request)
+
+		// THEN
+		require.NoError(t, err)
+		rt.AssertExpectations(t)
+	})
+
+	t.Run("does not sign the request without signing configuration in the context", func(t *testing.T) {
+		// GIVEN
+		request, err := http.NewRequest(http.MethodPost, testUrl, bytes.NewBufferString(body))
+		require.NoError(t, err)
+
+		rt := &automock.HTTPRoundTripper{}
+		rt.On("RoundTrip", request).Return(nil, nil)
+
+		// WHEN
+		_, err = httputil.NewSigningTransport(rt).RoundTrip(request)
+
+		// THEN
+		require.NoError(t, err)
+		assert.Empty(t, request.Header.Get(httputil.DefaultSignatureHeader))
+		rt.AssertExpectations(t)
+	})
+}
+
+func TestComputeSignature(t *testing.T) {
+	// echo -n "1600000000.body" | openssl dgst -sha256 -hmac "secret"
+	assert.Equal(t, "244993394588ac85981b4505c45730b0a3a72b224b1365e89b5b3c231b84c7f6", httputil.ComputeSignature("secret", "1600000000", []byte("body")))
+}
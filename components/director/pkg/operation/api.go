@@ -44,17 +44,17 @@ type ResourceFetcherFunc func(ctx context.Context, tenantID, resourceID string)
 type TenantLoaderFunc func(ctx context.Context) (string, error)
 
 type handler struct {
-	transact            persistence.Transactioner
-	resourceFetcherFunc ResourceFetcherFunc
-	tenantLoaderFunc    TenantLoaderFunc
+	transact             persistence.Transactioner
+	resourceFetcherFuncs map[resource.Type]ResourceFetcherFunc
+	tenantLoaderFunc     TenantLoaderFunc
 }
 
 // NewHandler creates a new handler struct associated with the Operations API
-func NewHandler(transact persistence.Transactioner, resourceFetcherFunc ResourceFetcherFunc, tenantLoaderFunc TenantLoaderFunc) *handler {
+func NewHandler(transact persistence.Transactioner, resourceFetcherFuncs map[resource.Type]ResourceFetcherFunc, tenantLoaderFunc TenantLoaderFunc) *handler {
 	return &handler{
-		transact:            transact,
-		resourceFetcherFunc: resourceFetcherFunc,
-		tenantLoaderFunc:    tenantLoaderFunc,
+		transact:             transact,
+		resourceFetcherFuncs: resourceFetcherFuncs,
+		tenantLoaderFunc:     tenantLoaderFunc,
 	}
 }
 
@@ -85,6 +85,12 @@ func (h *handler) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
 		return
 	}
 
+	resourceFetcherFunc, ok := h.resourceFetcherFuncs[op.ResourceType]
+	if !ok {
+		apperrors.WriteAppError(ctx, writer, apperrors.NewInvalidDataError("Unsupported resource type %s", op.ResourceType), http.StatusBadRequest)
+		return
+	}
+
 	tx, err := h.transact.Begin()
 	if err != nil {
 		log.C(ctx).WithError(err).Errorf("An error occurred while opening db transaction: %s", err.Error())
@@ -95,13 +101,13 @@ func (h *handler) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
 
 	ctx = persistence.SaveToContext(ctx, tx)
 
-	res, err := h.resourceFetcherFunc(ctx, tenantID, op.ResourceID)
+	res, err := resourceFetcherFunc(ctx, tenantID, op.ResourceID)
 	if err != nil {
 		log.C(ctx).WithError(err).Errorf("An error occurred while fetching resource from database: %s", err.Error())
 
 		if apperrors.IsNotFoundError(err) {
-			apperrors.WriteAppError(ctx, writer, apperrors.NewNotFoundErrorWithMessage(resource.Application, op.ResourceID,
-				fmt.Sprintf("Operation for application with id %s not found", op.ResourceID)), http.StatusNotFound)
+			apperrors.WriteAppError(ctx, writer, apperrors.NewNotFoundErrorWithMessage(op.ResourceType, op.ResourceID,
+				fmt.Sprintf("Operation for %s with id %s not found", op.ResourceType, op.ResourceID)), http.StatusNotFound)
 			return
 		}
 
@@ -84,14 +84,14 @@ func TestServeHTTP(t *testing.T) {
 		require.Equal(t, http.StatusBadRequest, writer.Code)
 	})
 
-	t.Run("when resource type is not application it should return bad request", func(t *testing.T) {
+	t.Run("when resource type is not supported it should return bad request", func(t *testing.T) {
 		ctx := tenant.SaveToContext(context.Background(), tenantID, tenantID)
 
 		writer := httptest.NewRecorder()
-		req := fixEmptyRequest(t, ctx, string(resource.Runtime), resourceID)
+		req := fixEmptyRequest(t, ctx, string(resource.Bundle), resourceID)
 
 		queryValues := req.URL.Query()
-		queryValues.Add(operation.ResourceTypeParam, "runtime")
+		queryValues.Add(operation.ResourceTypeParam, string(resource.Bundle))
 
 		req.URL.RawQuery = queryValues.Encode()
 
@@ -112,7 +112,9 @@ func TestServeHTTP(t *testing.T) {
 		defer mockedTx.AssertExpectations(t)
 		defer mockedTransactioner.AssertExpectations(t)
 
-		handler := operation.NewHandler(mockedTransactioner, nil, loadTenantFunc)
+		handler := operation.NewHandler(mockedTransactioner, map[resource.Type]operation.ResourceFetcherFunc{
+			resource.Application: nil,
+		}, loadTenantFunc)
 		handler.ServeHTTP(writer, req)
 
 		require.Equal(t, http.StatusInternalServerError, writer.Code)
@@ -135,8 +137,10 @@ func TestServeHTTP(t *testing.T) {
 		defer mockedTx.AssertExpectations(t)
 		defer mockedTransactioner.AssertExpectations(t)
 
-		handler := operation.NewHandler(mockedTransactioner, func(_ context.Context, _, _ string) (model.Entity, error) {
-			return nil, apperrors.NewNotFoundError(resource.Application, resourceID)
+		handler := operation.NewHandler(mockedTransactioner, map[resource.Type]operation.ResourceFetcherFunc{
+			resource.Application: func(_ context.Context, _, _ string) (model.Entity, error) {
+				return nil, apperrors.NewNotFoundError(resource.Application, resourceID)
+			},
 		}, loadTenantFunc)
 		handler.ServeHTTP(writer, req)
 
@@ -160,8 +164,10 @@ func TestServeHTTP(t *testing.T) {
 		defer mockedTx.AssertExpectations(t)
 		defer mockedTransactioner.AssertExpectations(t)
 
-		handler := operation.NewHandler(mockedTransactioner, func(_ context.Context, _, _ string) (model.Entity, error) {
-			return nil, mockedError()
+		handler := operation.NewHandler(mockedTransactioner, map[resource.Type]operation.ResourceFetcherFunc{
+			resource.Application: func(_ context.Context, _, _ string) (model.Entity, error) {
+				return nil, mockedError()
+			},
 		}, loadTenantFunc)
 		handler.ServeHTTP(writer, req)
 
@@ -185,8 +191,10 @@ func TestServeHTTP(t *testing.T) {
 		defer mockedTx.AssertExpectations(t)
 		defer mockedTransactioner.AssertExpectations(t)
 
-		handler := operation.NewHandler(mockedTransactioner, func(_ context.Context, _, _ string) (model.Entity, error) {
-			return nil, nil
+		handler := operation.NewHandler(mockedTransactioner, map[resource.Type]operation.ResourceFetcherFunc{
+			resource.Application: func(_ context.Context, _, _ string) (model.Entity, error) {
+				return nil, nil
+			},
 		}, loadTenantFunc)
 		handler.ServeHTTP(writer, req)
 
@@ -336,8 +344,10 @@ func TestServeHTTP(t *testing.T) {
 
 		for _, testCase := range cases {
 			t.Run(testCase.Name, func(t *testing.T) {
-				handler := operation.NewHandler(mockedTransactioner, func(_ context.Context, _, _ string) (model.Entity, error) {
-					return testCase.Application, nil
+				handler := operation.NewHandler(mockedTransactioner, map[resource.Type]operation.ResourceFetcherFunc{
+					resource.Application: func(_ context.Context, _, _ string) (model.Entity, error) {
+						return testCase.Application, nil
+					},
 				}, loadTenantFunc)
 
 				writer := httptest.NewRecorder()
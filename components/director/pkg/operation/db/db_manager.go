@@ -0,0 +1,94 @@
+package db
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kyma-incubator/compass/components/director/internal/timestamp"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/operation"
+	"github.com/kyma-incubator/compass/components/director/pkg/str"
+)
+
+type Manager struct {
+	repo         OperationRepository
+	uidService   UIDService
+	timestampGen timestamp.Generator
+}
+
+func NewManager(repo OperationRepository, uidService UIDService) *Manager {
+	return &Manager{
+		repo:         repo,
+		uidService:   uidService,
+		timestampGen: timestamp.DefaultGenerator(),
+	}
+}
+
+// Retry schedules the failed operation of the given resource anew so that its webhooks are executed again.
+// The failed operation is kept and a new one is scheduled so that the timeout is measured from the retry onwards.
+func (m *Manager) Retry(ctx context.Context, op *operation.Operation) (*operation.Operation, operation.ApplyFunc, error) {
+	latestOp, err := m.getOperation(ctx, op)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if latestOp.Status != operation.OperationStatusFailed {
+		return nil, nil, apperrors.NewInvalidOperationError(fmt.Sprintf("operation for resource with ID %q has not failed", op.ResourceID))
+	}
+
+	now := m.timestampGen()
+	retriedOp := &ScheduledOperation{
+		Operation:     latestOp.Operation,
+		Status:        operation.OperationStatusInProgress,
+		NextAttemptAt: now,
+	}
+	retriedOp.OperationID = m.uidService.Generate()
+	retriedOp.CorrelationID = op.CorrelationID
+	retriedOp.CreationTime = now
+
+	if err := m.repo.Create(ctx, retriedOp); err != nil {
+		if apperrors.IsNotUniqueError(err) {
+			return nil, nil, apperrors.NewInvalidOperationError(fmt.Sprintf("another operation is in progress for resource with ID %q", op.ResourceID))
+		}
+		return nil, nil, err
+	}
+
+	return &retriedOp.Operation, operation.NoopApply, nil
+}
+
+// Cancel marks the in-progress operation of the given resource as failed so that no more of its webhooks are executed.
+// The lease of a Worker processing the operation at the moment is released, so that the outcome of the processing is discarded.
+func (m *Manager) Cancel(ctx context.Context, op *operation.Operation) (*operation.Operation, operation.ApplyFunc, error) {
+	latestOp, err := m.getOperation(ctx, op)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if latestOp.IsFinished() {
+		return nil, nil, apperrors.NewInvalidOperationError(fmt.Sprintf("operation for resource with ID %q is not in progress", op.ResourceID))
+	}
+
+	now := m.timestampGen()
+	latestOp.Status = operation.OperationStatusFailed
+	latestOp.Error = str.Ptr(operation.CancelledOperationError)
+	latestOp.FinishedAt = &now
+	latestOp.LockedBy = nil
+	latestOp.LockedUntil = nil
+
+	if err := m.repo.UpdateGlobal(ctx, latestOp); err != nil {
+		return nil, nil, err
+	}
+
+	return &latestOp.Operation, operation.NoopApply, nil
+}
+
+func (m *Manager) getOperation(ctx context.Context, op *operation.Operation) (*ScheduledOperation, error) {
+	latestOp, err := m.repo.LockLatestGlobal(ctx, op.ResourceType, op.ResourceID)
+	if err != nil {
+		if apperrors.IsNotFoundError(err) {
+			return nil, apperrors.NewNotFoundError(op.ResourceType, op.ResourceID)
+		}
+		return nil, err
+	}
+	return latestOp, nil
+}
@@ -0,0 +1,215 @@
+package db_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/operation"
+	"github.com/kyma-incubator/compass/components/director/pkg/operation/db"
+	"github.com/kyma-incubator/compass/components/director/pkg/operation/db/automock"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestManager_Retry(t *testing.T) {
+	ctx := context.TODO()
+	testErr := errors.New("test error")
+	op := &operation.Operation{ResourceType: resource.Application, ResourceID: resourceID, CorrelationID: newCorrelationID}
+
+	retriedOp := fixInProgressOperation()
+	retriedOp.OperationID = retriedID
+	retriedOp.CorrelationID = newCorrelationID
+	retriedOp.CreationTime = finishedAt
+	retriedOp.NextAttemptAt = finishedAt
+
+	testCases := []struct {
+		Name              string
+		RepoFn            func() *automock.OperationRepository
+		ExpectedOperation *operation.Operation
+		ExpectedErrorFn   func(error) bool
+		ExpectedErrorMsg  string
+	}{
+		{
+			Name: "when the operation has failed it should schedule it again",
+			RepoFn: func() *automock.OperationRepository {
+				repo := &automock.OperationRepository{}
+				repo.On("LockLatestGlobal", ctx, resource.Application, resourceID).Return(fixFailedOperation(), nil).Once()
+				repo.On("Create", ctx, retriedOp).Return(nil).Once()
+				return repo
+			},
+			ExpectedOperation: &retriedOp.Operation,
+		},
+		{
+			Name: "when the resource has no operation it should return not found error",
+			RepoFn: func() *automock.OperationRepository {
+				repo := &automock.OperationRepository{}
+				repo.On("LockLatestGlobal", ctx, resource.Application, resourceID).Return(nil, apperrors.NewNotFoundErrorWithType(resource.ScheduledOperation)).Once()
+				return repo
+			},
+			ExpectedErrorFn: apperrors.IsNotFoundError,
+		},
+		{
+			Name: "when the operation has not failed it should return invalid operation error",
+			RepoFn: func() *automock.OperationRepository {
+				repo := &automock.OperationRepository{}
+				repo.On("LockLatestGlobal", ctx, resource.Application, resourceID).Return(fixInProgressOperation(), nil).Once()
+				return repo
+			},
+			ExpectedErrorFn:  apperrors.IsNewInvalidOperationError,
+			ExpectedErrorMsg: "has not failed",
+		},
+		{
+			Name: "when another operation has been scheduled concurrently it should return invalid operation error",
+			RepoFn: func() *automock.OperationRepository {
+				repo := &automock.OperationRepository{}
+				repo.On("LockLatestGlobal", ctx, resource.Application, resourceID).Return(fixFailedOperation(), nil).Once()
+				repo.On("Create", ctx, retriedOp).Return(apperrors.NewNotUniqueError(resource.ScheduledOperation)).Once()
+				return repo
+			},
+			ExpectedErrorFn:  apperrors.IsNewInvalidOperationError,
+			ExpectedErrorMsg: "another operation is in progress",
+		},
+		{
+			Name: "when creating the operation fails it should fail",
+			RepoFn: func() *automock.OperationRepository {
+				repo := &automock.OperationRepository{}
+				repo.On("LockLatestGlobal", ctx, resource.Application, resourceID).Return(fixFailedOperation(), nil).Once()
+				repo.On("Create", ctx, retriedOp).Return(testErr).Once()
+				return repo
+			},
+			ExpectedErrorMsg: testErr.Error(),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			repo := testCase.RepoFn()
+			uidSvc := &automock.UIDService{}
+			uidSvc.On("Generate").Return(retriedID).Maybe()
+
+			manager := db.NewManager(repo, uidSvc)
+			manager.SetTimestampGen(func() time.Time { return finishedAt })
+
+			// WHEN
+			result, apply, err := manager.Retry(ctx, op)
+
+			// THEN
+			if testCase.ExpectedErrorFn != nil || testCase.ExpectedErrorMsg != "" {
+				require.Error(t, err)
+				if testCase.ExpectedErrorFn != nil {
+					require.True(t, testCase.ExpectedErrorFn(err))
+				}
+				require.Contains(t, err.Error(), testCase.ExpectedErrorMsg)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, testCase.ExpectedOperation, result)
+				require.NoError(t, apply(ctx))
+			}
+
+			repo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestManager_Cancel(t *testing.T) {
+	ctx := context.TODO()
+	testErr := errors.New("test error")
+	op := &operation.Operation{ResourceType: resource.Application, ResourceID: resourceID}
+
+	cancelledOp := fixInProgressOperation()
+	cancelledOp.Status = operation.OperationStatusFailed
+	cancelledOp.Error = str("operation has been cancelled")
+	cancelledOp.FinishedAt = &finishedAt
+
+	testCases := []struct {
+		Name              string
+		RepoFn            func() *automock.OperationRepository
+		ExpectedOperation *operation.Operation
+		ExpectedErrorFn   func(error) bool
+		ExpectedErrorMsg  string
+	}{
+		{
+			Name: "when the operation is in progress it should mark it as failed",
+			RepoFn: func() *automock.OperationRepository {
+				repo := &automock.OperationRepository{}
+				repo.On("LockLatestGlobal", ctx, resource.Application, resourceID).Return(fixInProgressOperation(), nil).Once()
+				repo.On("UpdateGlobal", ctx, cancelledOp).Return(nil).Once()
+				return repo
+			},
+			ExpectedOperation: &cancelledOp.Operation,
+		},
+		{
+			Name: "when the operation is being processed by a worker it should release the lease of the worker",
+			RepoFn: func() *automock.OperationRepository {
+				leasedOp := fixInProgressOperation()
+				leasedOp.LockedBy = str(workerID)
+				leasedOp.LockedUntil = &lockedUntil
+
+				repo := &automock.OperationRepository{}
+				repo.On("LockLatestGlobal", ctx, resource.Application, resourceID).Return(leasedOp, nil).Once()
+				repo.On("UpdateGlobal", ctx, cancelledOp).Return(nil).Once()
+				return repo
+			},
+			ExpectedOperation: &cancelledOp.Operation,
+		},
+		{
+			Name: "when the resource has no operation it should return not found error",
+			RepoFn: func() *automock.OperationRepository {
+				repo := &automock.OperationRepository{}
+				repo.On("LockLatestGlobal", ctx, resource.Application, resourceID).Return(nil, apperrors.NewNotFoundErrorWithType(resource.ScheduledOperation)).Once()
+				return repo
+			},
+			ExpectedErrorFn: apperrors.IsNotFoundError,
+		},
+		{
+			Name: "when the operation has finished it should return invalid operation error",
+			RepoFn: func() *automock.OperationRepository {
+				repo := &automock.OperationRepository{}
+				repo.On("LockLatestGlobal", ctx, resource.Application, resourceID).Return(fixFailedOperation(), nil).Once()
+				return repo
+			},
+			ExpectedErrorFn:  apperrors.IsNewInvalidOperationError,
+			ExpectedErrorMsg: "is not in progress",
+		},
+		{
+			Name: "when updating the operation fails it should fail",
+			RepoFn: func() *automock.OperationRepository {
+				repo := &automock.OperationRepository{}
+				repo.On("LockLatestGlobal", ctx, resource.Application, resourceID).Return(fixInProgressOperation(), nil).Once()
+				repo.On("UpdateGlobal", ctx, cancelledOp).Return(testErr).Once()
+				return repo
+			},
+			ExpectedErrorMsg: testErr.Error(),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			repo := testCase.RepoFn()
+
+			manager := db.NewManager(repo, &automock.UIDService{})
+			manager.SetTimestampGen(func() time.Time { return finishedAt })
+
+			// WHEN
+			result, apply, err := manager.Cancel(ctx, op)
+
+			// THEN
+			if testCase.ExpectedErrorFn != nil || testCase.ExpectedErrorMsg != "" {
+				require.Error(t, err)
+				if testCase.ExpectedErrorFn != nil {
+					require.True(t, testCase.ExpectedErrorFn(err))
+				}
+				require.Contains(t, err.Error(), testCase.ExpectedErrorMsg)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, testCase.ExpectedOperation, result)
+				require.NoError(t, apply(ctx))
+			}
+
+			repo.AssertExpectations(t)
+		})
+	}
+}
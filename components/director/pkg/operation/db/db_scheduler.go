@@ -0,0 +1,80 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/internal/timestamp"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/operation"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+)
+
+//go:generate mockery -name=OperationRepository -output=automock -outpkg=automock -case=underscore
+type OperationRepository interface {
+	Create(ctx context.Context, item *ScheduledOperation) error
+	UpdateGlobal(ctx context.Context, item *ScheduledOperation) error
+	GetLatestGlobal(ctx context.Context, resourceType resource.Type, resourceID string) (*ScheduledOperation, error)
+	LockLatestGlobal(ctx context.Context, resourceType resource.Type, resourceID string) (*ScheduledOperation, error)
+	ClaimNextDueGlobal(ctx context.Context, now time.Time, lockedBy string, lockedUntil time.Time) (*ScheduledOperation, error)
+	UpdateLeasedGlobal(ctx context.Context, item *ScheduledOperation, lockedBy string) (bool, error)
+	PostponeGlobal(ctx context.Context, id string, lockedBy string, nextAttemptAt time.Time) error
+	DeleteFinishedBeforeGlobal(ctx context.Context, before time.Time) error
+}
+
+//go:generate mockery -name=UIDService -output=automock -outpkg=automock -case=underscore
+type UIDService interface {
+	Generate() string
+}
+
+// Scheduler stores the scheduled operations in the database, from which they are processed by the Worker.
+// Operations are stored within the transaction of the mutation which scheduled them, so they are processed only if the mutation succeeds.
+type Scheduler struct {
+	repo         OperationRepository
+	uidService   UIDService
+	timestampGen timestamp.Generator
+}
+
+func NewScheduler(repo OperationRepository, uidService UIDService) *Scheduler {
+	return &Scheduler{
+		repo:         repo,
+		uidService:   uidService,
+		timestampGen: timestamp.DefaultGenerator(),
+	}
+}
+
+func (s *Scheduler) Schedule(ctx context.Context, op *operation.Operation) (string, error) {
+	latestOp, err := s.repo.GetLatestGlobal(ctx, op.ResourceType, op.ResourceID)
+	if err != nil && !apperrors.IsNotFoundError(err) {
+		return "", err
+	}
+
+	if latestOp != nil && !latestOp.IsFinished() {
+		return "", fmt.Errorf("another operation is in progress for resource with ID %q", op.ResourceID)
+	}
+
+	scheduledOp := s.newScheduledOperation(op)
+	if err := s.repo.Create(ctx, scheduledOp); err != nil {
+		if apperrors.IsNotUniqueError(err) {
+			return "", fmt.Errorf("another operation is in progress for resource with ID %q", op.ResourceID)
+		}
+		return "", err
+	}
+
+	return scheduledOp.OperationID, nil
+}
+
+func (s *Scheduler) newScheduledOperation(op *operation.Operation) *ScheduledOperation {
+	now := s.timestampGen()
+
+	scheduledOp := &ScheduledOperation{
+		Operation:     *op,
+		Status:        operation.OperationStatusInProgress,
+		NextAttemptAt: now,
+	}
+	scheduledOp.OperationID = s.uidService.Generate()
+	scheduledOp.CreationTime = now
+
+	return scheduledOp
+}
@@ -0,0 +1,123 @@
+package db_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/operation"
+	"github.com/kyma-incubator/compass/components/director/pkg/operation/db"
+	"github.com/kyma-incubator/compass/components/director/pkg/operation/db/automock"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScheduler_Schedule(t *testing.T) {
+	ctx := context.TODO()
+	testErr := errors.New("test error")
+
+	op := &fixInProgressOperation().Operation
+	op.OperationID = ""
+	op.CreationTime = time.Time{}
+
+	testCases := []struct {
+		Name             string
+		RepoFn           func() *automock.OperationRepository
+		ExpectedID       string
+		ExpectedErrorMsg string
+	}{
+		{
+			Name: "when no previous operation exists it should create a new one",
+			RepoFn: func() *automock.OperationRepository {
+				repo := &automock.OperationRepository{}
+				repo.On("GetLatestGlobal", ctx, resource.Application, resourceID).Return(nil, apperrors.NewNotFoundErrorWithType(resource.ScheduledOperation)).Once()
+				repo.On("Create", ctx, fixInProgressOperation()).Return(nil).Once()
+				return repo
+			},
+			ExpectedID: operationID,
+		},
+		{
+			Name: "when the previous operation has finished it should create a new one",
+			RepoFn: func() *automock.OperationRepository {
+				repo := &automock.OperationRepository{}
+				repo.On("GetLatestGlobal", ctx, resource.Application, resourceID).Return(fixFailedOperation(), nil).Once()
+				repo.On("Create", ctx, fixInProgressOperation()).Return(nil).Once()
+				return repo
+			},
+			ExpectedID: operationID,
+		},
+		{
+			Name: "when another operation is in progress it should fail",
+			RepoFn: func() *automock.OperationRepository {
+				repo := &automock.OperationRepository{}
+				repo.On("GetLatestGlobal", ctx, resource.Application, resourceID).Return(fixInProgressOperation(), nil).Once()
+				return repo
+			},
+			ExpectedErrorMsg: "another operation is in progress for resource with ID",
+		},
+		{
+			Name: "when another operation has been scheduled concurrently it should fail",
+			RepoFn: func() *automock.OperationRepository {
+				repo := &automock.OperationRepository{}
+				repo.On("GetLatestGlobal", ctx, resource.Application, resourceID).Return(nil, apperrors.NewNotFoundErrorWithType(resource.ScheduledOperation)).Once()
+				repo.On("Create", ctx, fixInProgressOperation()).Return(apperrors.NewNotUniqueError(resource.ScheduledOperation)).Once()
+				return repo
+			},
+			ExpectedErrorMsg: "another operation is in progress for resource with ID",
+		},
+		{
+			Name: "when getting the previous operation fails it should fail",
+			RepoFn: func() *automock.OperationRepository {
+				repo := &automock.OperationRepository{}
+				repo.On("GetLatestGlobal", ctx, resource.Application, resourceID).Return(nil, testErr).Once()
+				return repo
+			},
+			ExpectedErrorMsg: testErr.Error(),
+		},
+		{
+			Name: "when creating the operation fails it should fail",
+			RepoFn: func() *automock.OperationRepository {
+				repo := &automock.OperationRepository{}
+				repo.On("GetLatestGlobal", ctx, resource.Application, resourceID).Return(nil, apperrors.NewNotFoundErrorWithType(resource.ScheduledOperation)).Once()
+				repo.On("Create", ctx, fixInProgressOperation()).Return(testErr).Once()
+				return repo
+			},
+			ExpectedErrorMsg: testErr.Error(),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			repo := testCase.RepoFn()
+			uidSvc := &automock.UIDService{}
+			uidSvc.On("Generate").Return(operationID).Maybe()
+
+			scheduler := db.NewScheduler(repo, uidSvc)
+			scheduler.SetTimestampGen(func() time.Time { return createdAt })
+
+			// WHEN
+			id, err := scheduler.Schedule(ctx, &operation.Operation{
+				OperationType:     op.OperationType,
+				OperationCategory: op.OperationCategory,
+				ResourceID:        op.ResourceID,
+				ResourceType:      op.ResourceType,
+				CorrelationID:     op.CorrelationID,
+				WebhookIDs:        op.WebhookIDs,
+				RequestObject:     op.RequestObject,
+			})
+
+			// THEN
+			if testCase.ExpectedErrorMsg != "" {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), testCase.ExpectedErrorMsg)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, testCase.ExpectedID, id)
+			}
+
+			repo.AssertExpectations(t)
+		})
+	}
+}
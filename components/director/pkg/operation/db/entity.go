@@ -0,0 +1,106 @@
+package db
+
+import (
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/internal/repo"
+	"github.com/kyma-incubator/compass/components/director/pkg/operation"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/pkg/errors"
+)
+
+type Entity struct {
+	ID                string         `db:"id"`
+	ResourceType      string         `db:"resource_type"`
+	ResourceID        string         `db:"resource_id"`
+	OperationType     string         `db:"operation_type"`
+	OperationCategory string         `db:"operation_category"`
+	CorrelationID     string         `db:"correlation_id"`
+	WebhookIDs        sql.NullString `db:"webhook_ids"`
+	RequestObject     string         `db:"request_object"`
+	Status            string         `db:"status"`
+	Error             sql.NullString `db:"error"`
+	Webhooks          sql.NullString `db:"webhooks"`
+	CreatedAt         time.Time      `db:"created_at"`
+	NextAttemptAt     time.Time      `db:"next_attempt_at"`
+	FinishedAt        sql.NullTime   `db:"finished_at"`
+	LockedBy          sql.NullString `db:"locked_by"`
+	LockedUntil       sql.NullTime   `db:"locked_until"`
+}
+
+func toEntity(in *ScheduledOperation) (*Entity, error) {
+	webhookIDs, err := marshalIfNotEmpty(len(in.WebhookIDs), in.WebhookIDs)
+	if err != nil {
+		return nil, errors.Wrap(err, "while marshalling webhook IDs")
+	}
+
+	webhooks, err := marshalIfNotEmpty(len(in.Webhooks), in.Webhooks)
+	if err != nil {
+		return nil, errors.Wrap(err, "while marshalling webhook statuses")
+	}
+
+	return &Entity{
+		ID:                in.OperationID,
+		ResourceType:      string(in.ResourceType),
+		ResourceID:        in.ResourceID,
+		OperationType:     string(in.OperationType),
+		OperationCategory: in.OperationCategory,
+		CorrelationID:     in.CorrelationID,
+		WebhookIDs:        repo.NewNullableStringFromJSONRawMessage(webhookIDs),
+		RequestObject:     in.RequestObject,
+		Status:            string(in.Status),
+		Error:             repo.NewNullableString(in.Error),
+		Webhooks:          repo.NewNullableStringFromJSONRawMessage(webhooks),
+		CreatedAt:         in.CreationTime,
+		NextAttemptAt:     in.NextAttemptAt,
+		FinishedAt:        repo.NewNullableTime(in.FinishedAt),
+		LockedBy:          repo.NewNullableString(in.LockedBy),
+		LockedUntil:       repo.NewNullableTime(in.LockedUntil),
+	}, nil
+}
+
+func fromEntity(in *Entity) (*ScheduledOperation, error) {
+	var webhookIDs []string
+	if in.WebhookIDs.Valid {
+		if err := json.Unmarshal([]byte(in.WebhookIDs.String), &webhookIDs); err != nil {
+			return nil, errors.Wrap(err, "while unmarshalling webhook IDs")
+		}
+	}
+
+	var webhooks []WebhookStatus
+	if in.Webhooks.Valid {
+		if err := json.Unmarshal([]byte(in.Webhooks.String), &webhooks); err != nil {
+			return nil, errors.Wrap(err, "while unmarshalling webhook statuses")
+		}
+	}
+
+	return &ScheduledOperation{
+		Operation: operation.Operation{
+			OperationID:       in.ID,
+			OperationType:     operation.OperationType(in.OperationType),
+			OperationCategory: in.OperationCategory,
+			ResourceID:        in.ResourceID,
+			ResourceType:      resource.Type(in.ResourceType),
+			CreationTime:      in.CreatedAt,
+			CorrelationID:     in.CorrelationID,
+			WebhookIDs:        webhookIDs,
+			RequestObject:     in.RequestObject,
+		},
+		Status:        operation.OperationStatus(in.Status),
+		Error:         repo.StringPtrFromNullableString(in.Error),
+		Webhooks:      webhooks,
+		NextAttemptAt: in.NextAttemptAt,
+		FinishedAt:    repo.TimePtrFromNullableTime(in.FinishedAt),
+		LockedBy:      repo.StringPtrFromNullableString(in.LockedBy),
+		LockedUntil:   repo.TimePtrFromNullableTime(in.LockedUntil),
+	}, nil
+}
+
+func marshalIfNotEmpty(length int, v interface{}) (json.RawMessage, error) {
+	if length == 0 {
+		return nil, nil
+	}
+	return json.Marshal(v)
+}
@@ -0,0 +1,16 @@
+package db
+
+import (
+	"github.com/pkg/errors"
+)
+
+var (
+	errWebhookTimeoutReached    = errors.New("webhook timeout reached")
+	errWebhookPollTimeExpired   = errors.New("polling time has expired")
+	errFailedWebhookStatus      = errors.New("webhook operation has finished with failed status")
+	errWebhookNotRetryable      = errors.New("webhook failure is not retryable")
+	errWebhookAttemptsExhausted = errors.New("webhook retry attempts have been exhausted")
+	errMissingOutputTemplate    = errors.New("missing output template")
+	errMissingLocation          = errors.New("missing location url after executing async webhook")
+	errLeaseLost                = errors.New("lease on the operation has been lost")
+)
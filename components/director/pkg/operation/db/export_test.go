@@ -0,0 +1,21 @@
+package db
+
+import (
+	"time"
+)
+
+func (s *Scheduler) SetTimestampGen(timestampGen func() time.Time) {
+	s.timestampGen = timestampGen
+}
+
+func (m *Manager) SetTimestampGen(timestampGen func() time.Time) {
+	m.timestampGen = timestampGen
+}
+
+func (w *Worker) SetTimestampGen(timestampGen func() time.Time) {
+	w.timestampGen = timestampGen
+}
+
+func (w *Worker) SetWebhookClient(webhookClient WebhookClient) {
+	w.webhookClient = webhookClient
+}
@@ -0,0 +1,126 @@
+package db_test
+
+import (
+	"database/sql/driver"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/operation"
+	"github.com/kyma-incubator/compass/components/director/pkg/operation/db"
+	"github.com/kyma-incubator/compass/components/director/pkg/operation/db/automock"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	web_hook "github.com/kyma-incubator/compass/components/director/pkg/webhook"
+)
+
+const (
+	operationID      = "0b4fc816-da70-4505-961e-db346388fdb7"
+	retriedID        = "5e3a2f3d-3c34-4a6e-9f4b-0f8bd4b1e8a1"
+	resourceID       = "c7092c57-7a5c-4ebe-8c58-03c0f85ade6c"
+	tenantID         = "b91b59f7-2563-40b2-aba9-fef726037aa3"
+	webhookID        = "3d3b5a2e-8b3f-4b8f-9d53-2f4a8b3a1c22"
+	secondWebhookID  = "9c1d4a5b-7f2e-4c3a-8b6d-1e0f2a3b4c5d"
+	correlationID    = "correlationID"
+	newCorrelationID = "newCorrelationID"
+	pollURL          = "https://test-domain.com/poll"
+	requestObject    = `{"Application":{"id":"c7092c57-7a5c-4ebe-8c58-03c0f85ade6c","name":"test-app"},"Runtime":null,"TenantID":"b91b59f7-2563-40b2-aba9-fef726037aa3","Headers":{"Client_user":"test"}}`
+	webhookIDs       = `["3d3b5a2e-8b3f-4b8f-9d53-2f4a8b3a1c22"]`
+	webhookStatuses  = `[{"webhook_id":"3d3b5a2e-8b3f-4b8f-9d53-2f4a8b3a1c22","state":"Failed","retries_count":0,"failed_attempts":1}]`
+	operationErr     = "webhook operation has finished with failed status"
+	workerID         = "6f1c2e9a-4b7d-4e3f-a2c8-9d0b1e2f3a4b"
+)
+
+var (
+	createdAt   = time.Date(2021, 4, 5, 9, 0, 0, 0, time.UTC)
+	finishedAt  = time.Date(2021, 4, 5, 9, 5, 0, 0, time.UTC)
+	lockedUntil = time.Date(2021, 4, 5, 9, 15, 0, 0, time.UTC)
+)
+
+func fixInProgressOperation() *db.ScheduledOperation {
+	return &db.ScheduledOperation{
+		Operation: operation.Operation{
+			OperationID:       operationID,
+			OperationType:     operation.OperationTypeCreate,
+			OperationCategory: "registerApplication",
+			ResourceID:        resourceID,
+			ResourceType:      resource.Application,
+			CreationTime:      createdAt,
+			CorrelationID:     correlationID,
+			WebhookIDs:        []string{webhookID},
+			RequestObject:     requestObject,
+		},
+		Status:        operation.OperationStatusInProgress,
+		NextAttemptAt: createdAt,
+	}
+}
+
+func fixFailedOperation() *db.ScheduledOperation {
+	op := fixInProgressOperation()
+	op.Status = operation.OperationStatusFailed
+	op.Error = str(operationErr)
+	op.Webhooks = []db.WebhookStatus{{WebhookID: webhookID, State: db.WebhookStateFailed, FailedAttempts: 1}}
+	op.FinishedAt = &finishedAt
+	return op
+}
+
+func fixOperationColumns() []string {
+	return []string{"id", "resource_type", "resource_id", "operation_type", "operation_category", "correlation_id", "webhook_ids", "request_object", "status", "error", "webhooks", "created_at", "next_attempt_at", "finished_at", "locked_by", "locked_until"}
+}
+
+func fixInProgressOperationRow() []driver.Value {
+	return []driver.Value{operationID, string(resource.Application), resourceID, string(operation.OperationTypeCreate), "registerApplication", correlationID, webhookIDs, requestObject, string(operation.OperationStatusInProgress), nil, nil, createdAt, createdAt, nil, nil, nil}
+}
+
+func fixFailedOperationRow() []driver.Value {
+	return []driver.Value{operationID, string(resource.Application), resourceID, string(operation.OperationTypeCreate), "registerApplication", correlationID, webhookIDs, requestObject, string(operation.OperationStatusFailed), operationErr, webhookStatuses, createdAt, createdAt, finishedAt, nil, nil}
+}
+
+func fixFailedOperationUpdateArgs() []driver.Value {
+	return []driver.Value{string(operation.OperationStatusFailed), operationErr, webhookStatuses, createdAt, finishedAt, nil, nil, operationID}
+}
+
+func fixApplication(ready bool) *model.Application {
+	return &model.Application{
+		Name: "test-app",
+		BaseEntity: &model.BaseEntity{
+			ID:    resourceID,
+			Ready: ready,
+		},
+	}
+}
+
+func fixModelWebhook(id string) *model.Webhook {
+	return &model.Webhook{ID: id}
+}
+
+func fixWebhook(id string, mode graphql.WebhookMode) *graphql.Webhook {
+	return &graphql.Webhook{
+		ID:             id,
+		Mode:           &mode,
+		URL:            str("https://test-domain.com/operation"),
+		OutputTemplate: str(`{"location":"{{.Headers.Location}}","success_status_code":200,"error":"{{.Body.error}}"}`),
+		StatusTemplate: str(`{"status":"{{.Body.status}}","success_status_code":200,"success_status_identifier":"SUCCEEDED","in_progress_status_identifier":"IN_PROGRESS","failed_status_identifier":"FAILED","error":"{{.Body.error}}"}`),
+	}
+}
+
+func str(s string) *string {
+	return &s
+}
+
+func intPtr(i int) *int {
+	return &i
+}
+
+func fixWebhookRequestObject() web_hook.RequestObject {
+	return web_hook.RequestObject{
+		Application: &graphql.Application{BaseEntity: &graphql.BaseEntity{ID: resourceID}, Name: "test-app"},
+		TenantID:    tenantID,
+		Headers:     map[string]string{"Client_user": "test"},
+	}
+}
+
+func fixUIDService() *automock.UIDService {
+	uidService := &automock.UIDService{}
+	uidService.On("Generate").Return(workerID).Once()
+	return uidService
+}
@@ -0,0 +1,174 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/internal/repo"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/kyma-incubator/compass/components/director/pkg/operation"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/pkg/errors"
+)
+
+const scheduledOperationTable string = `public.scheduled_operations`
+
+var (
+	scheduledOperationColumns = []string{"id", "resource_type", "resource_id", "operation_type", "operation_category", "correlation_id", "webhook_ids", "request_object", "status", "error", "webhooks", "created_at", "next_attempt_at", "finished_at", "locked_by", "locked_until"}
+	updatableColumns          = []string{"status", "error", "webhooks", "next_attempt_at", "finished_at", "locked_by", "locked_until"}
+)
+
+type pgRepository struct {
+	creator            repo.Creator
+	updaterGlobal      repo.UpdaterGlobal
+	singleGetterGlobal repo.SingleGetterGlobal
+	deleterGlobal      repo.DeleterGlobal
+}
+
+func NewRepository() *pgRepository {
+	return &pgRepository{
+		creator:            repo.NewCreator(resource.ScheduledOperation, scheduledOperationTable, scheduledOperationColumns),
+		updaterGlobal:      repo.NewUpdaterGlobal(resource.ScheduledOperation, scheduledOperationTable, updatableColumns, []string{"id"}),
+		singleGetterGlobal: repo.NewSingleGetterGlobal(resource.ScheduledOperation, scheduledOperationTable, scheduledOperationColumns),
+		deleterGlobal:      repo.NewDeleterGlobal(resource.ScheduledOperation, scheduledOperationTable),
+	}
+}
+
+func (r *pgRepository) Create(ctx context.Context, item *ScheduledOperation) error {
+	if item == nil {
+		return apperrors.NewInternalError("item can not be empty")
+	}
+
+	entity, err := toEntity(item)
+	if err != nil {
+		return errors.Wrap(err, "while converting ScheduledOperation to entity")
+	}
+
+	return r.creator.Create(ctx, entity)
+}
+
+func (r *pgRepository) UpdateGlobal(ctx context.Context, item *ScheduledOperation) error {
+	if item == nil {
+		return apperrors.NewInternalError("item can not be empty")
+	}
+
+	entity, err := toEntity(item)
+	if err != nil {
+		return errors.Wrap(err, "while converting ScheduledOperation to entity")
+	}
+
+	return r.updaterGlobal.UpdateSingleGlobal(ctx, entity)
+}
+
+// GetLatestGlobal returns the most recently scheduled operation of the given resource
+func (r *pgRepository) GetLatestGlobal(ctx context.Context, resourceType resource.Type, resourceID string) (*ScheduledOperation, error) {
+	conditions := repo.Conditions{
+		repo.NewEqualCondition("resource_type", string(resourceType)),
+		repo.NewEqualCondition("resource_id", resourceID),
+	}
+
+	var entity Entity
+	if err := r.singleGetterGlobal.GetGlobal(ctx, conditions, repo.OrderByParams{repo.NewDescOrderBy("created_at")}, &entity); err != nil {
+		return nil, err
+	}
+
+	return fromEntity(&entity)
+}
+
+// LockLatestGlobal returns the most recently scheduled operation of the given resource and locks it until the transaction in the context
+// is either committed or rolled back. The lease held by a Worker processing the operation is not taken into account.
+func (r *pgRepository) LockLatestGlobal(ctx context.Context, resourceType resource.Type, resourceID string) (*ScheduledOperation, error) {
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	query := fmt.Sprintf("SELECT %s FROM %s WHERE resource_type = $1 AND resource_id = $2 ORDER BY created_at DESC LIMIT 1 FOR UPDATE",
+		strings.Join(scheduledOperationColumns, ", "), scheduledOperationTable)
+
+	log.C(ctx).Debugf("Executing DB query: %s", query)
+	var entity Entity
+	err = persist.Get(&entity, query, string(resourceType), resourceID)
+	if err = persistence.MapSQLError(ctx, err, resource.ScheduledOperation, resource.Get, "while locking object from '%s' table", scheduledOperationTable); err != nil {
+		return nil, err
+	}
+
+	return fromEntity(&entity)
+}
+
+// ClaimNextDueGlobal leases the in-progress operation which has been waiting the longest to be processed to the given worker until lockedUntil.
+// Operations leased by other workers are skipped until their lease expires, so that the operation can be processed without holding a transaction open.
+func (r *pgRepository) ClaimNextDueGlobal(ctx context.Context, now time.Time, lockedBy string, lockedUntil time.Time) (*ScheduledOperation, error) {
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	query := fmt.Sprintf(`UPDATE %[1]s SET locked_by = $1, locked_until = $2 WHERE id = (SELECT id FROM %[1]s WHERE status = $3 AND next_attempt_at <= $4 AND (locked_until IS NULL OR locked_until <= $4) ORDER BY next_attempt_at LIMIT 1 FOR UPDATE SKIP LOCKED) RETURNING %[2]s`,
+		scheduledOperationTable, strings.Join(scheduledOperationColumns, ", "))
+
+	log.C(ctx).Debugf("Executing DB query: %s", query)
+	var entity Entity
+	err = persist.Get(&entity, query, lockedBy, lockedUntil, string(operation.OperationStatusInProgress), now)
+	if err = persistence.MapSQLError(ctx, err, resource.ScheduledOperation, resource.Update, "while claiming object from '%s' table", scheduledOperationTable); err != nil {
+		return nil, err
+	}
+
+	return fromEntity(&entity)
+}
+
+// UpdateLeasedGlobal updates the operation and releases the lease on it, provided that the lease is still held by the given worker.
+// It reports whether the operation has been updated, which is not the case if the lease has expired or has been released in the meantime.
+func (r *pgRepository) UpdateLeasedGlobal(ctx context.Context, item *ScheduledOperation, lockedBy string) (bool, error) {
+	if item == nil {
+		return false, apperrors.NewInternalError("item can not be empty")
+	}
+
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	entity, err := toEntity(item)
+	if err != nil {
+		return false, errors.Wrap(err, "while converting ScheduledOperation to entity")
+	}
+
+	query := fmt.Sprintf("UPDATE %s SET status = $1, error = $2, webhooks = $3, next_attempt_at = $4, finished_at = $5, locked_by = NULL, locked_until = NULL WHERE id = $6 AND locked_by = $7", scheduledOperationTable)
+
+	log.C(ctx).Debugf("Executing DB query: %s", query)
+	res, err := persist.Exec(query, entity.Status, entity.Error, entity.Webhooks, entity.NextAttemptAt, entity.FinishedAt, entity.ID, lockedBy)
+	if err = persistence.MapSQLError(ctx, err, resource.ScheduledOperation, resource.Update, "while updating object from '%s' table", scheduledOperationTable); err != nil {
+		return false, err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return false, errors.Wrap(err, "while checking affected rows")
+	}
+
+	return affected > 0, nil
+}
+
+// PostponeGlobal delays the next processing of the operation with the given ID and releases the lease of the given worker on it, unless it has already finished
+func (r *pgRepository) PostponeGlobal(ctx context.Context, id string, lockedBy string, nextAttemptAt time.Time) error {
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		return err
+	}
+
+	query := fmt.Sprintf("UPDATE %s SET next_attempt_at = $1, locked_by = NULL, locked_until = NULL WHERE id = $2 AND status = $3 AND locked_by = $4", scheduledOperationTable)
+
+	log.C(ctx).Debugf("Executing DB query: %s", query)
+	_, err = persist.Exec(query, nextAttemptAt, id, string(operation.OperationStatusInProgress), lockedBy)
+	return persistence.MapSQLError(ctx, err, resource.ScheduledOperation, resource.Update, "while updating object from '%s' table", scheduledOperationTable)
+}
+
+// DeleteFinishedBeforeGlobal removes the operations which have finished before the given time
+func (r *pgRepository) DeleteFinishedBeforeGlobal(ctx context.Context, before time.Time) error {
+	return r.deleterGlobal.DeleteManyGlobal(ctx, repo.Conditions{repo.NewLessThanCondition("finished_at", before)})
+}
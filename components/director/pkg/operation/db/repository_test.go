@@ -0,0 +1,273 @@
+package db_test
+
+import (
+	"context"
+	"database/sql/driver"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/kyma-incubator/compass/components/director/internal/repo/testdb"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/operation/db"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const selectedColumns = "id, resource_type, resource_id, operation_type, operation_category, correlation_id, webhook_ids, request_object, status, error, webhooks, created_at, next_attempt_at, finished_at, locked_by, locked_until"
+
+func TestPgRepository_Create(t *testing.T) {
+	insertQuery := `^INSERT INTO public.scheduled_operations \(.+\) VALUES \(.+\)$`
+
+	t.Run("success", func(t *testing.T) {
+		sqlxDB, sqlMock := testdb.MockDatabase(t)
+		sqlMock.ExpectExec(insertQuery).
+			WithArgs(fixInProgressOperationRow()...).
+			WillReturnResult(sqlmock.NewResult(-1, 1))
+
+		ctx := persistence.SaveToContext(context.TODO(), sqlxDB)
+		// WHEN
+		err := db.NewRepository().Create(ctx, fixInProgressOperation())
+		// THEN
+		require.NoError(t, err)
+		sqlMock.AssertExpectations(t)
+	})
+
+	t.Run("returns not unique error when another operation is in progress", func(t *testing.T) {
+		sqlxDB, sqlMock := testdb.MockDatabase(t)
+		sqlMock.ExpectExec(insertQuery).
+			WithArgs(fixInProgressOperationRow()...).
+			WillReturnError(&pq.Error{Code: persistence.UniqueViolation})
+
+		ctx := persistence.SaveToContext(context.TODO(), sqlxDB)
+		// WHEN
+		err := db.NewRepository().Create(ctx, fixInProgressOperation())
+		// THEN
+		require.Error(t, err)
+		assert.True(t, apperrors.IsNotUniqueError(err))
+		sqlMock.AssertExpectations(t)
+	})
+
+	t.Run("returns error when item is nil", func(t *testing.T) {
+		// WHEN
+		err := db.NewRepository().Create(context.TODO(), nil)
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "item can not be empty")
+	})
+}
+
+func TestPgRepository_UpdateGlobal(t *testing.T) {
+	updateQuery := regexp.QuoteMeta(`UPDATE public.scheduled_operations SET status = ?, error = ?, webhooks = ?, next_attempt_at = ?, finished_at = ?, locked_by = ?, locked_until = ? WHERE id = ?`)
+
+	t.Run("success", func(t *testing.T) {
+		sqlxDB, sqlMock := testdb.MockDatabase(t)
+		sqlMock.ExpectExec(updateQuery).
+			WithArgs(fixFailedOperationUpdateArgs()...).
+			WillReturnResult(sqlmock.NewResult(-1, 1))
+
+		ctx := persistence.SaveToContext(context.TODO(), sqlxDB)
+		// WHEN
+		err := db.NewRepository().UpdateGlobal(ctx, fixFailedOperation())
+		// THEN
+		require.NoError(t, err)
+		sqlMock.AssertExpectations(t)
+	})
+
+	t.Run("returns error when item is nil", func(t *testing.T) {
+		// WHEN
+		err := db.NewRepository().UpdateGlobal(context.TODO(), nil)
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "item can not be empty")
+	})
+}
+
+func TestPgRepository_GetLatestGlobal(t *testing.T) {
+	selectQuery := regexp.QuoteMeta(`SELECT ` + selectedColumns + ` FROM public.scheduled_operations WHERE resource_type = $1 AND resource_id = $2 ORDER BY created_at DESC`)
+
+	t.Run("success", func(t *testing.T) {
+		sqlxDB, sqlMock := testdb.MockDatabase(t)
+		rows := sqlmock.NewRows(fixOperationColumns()).AddRow(fixFailedOperationRow()...)
+		sqlMock.ExpectQuery(selectQuery).
+			WithArgs(string(resource.Application), resourceID).
+			WillReturnRows(rows)
+
+		ctx := persistence.SaveToContext(context.TODO(), sqlxDB)
+		// WHEN
+		op, err := db.NewRepository().GetLatestGlobal(ctx, resource.Application, resourceID)
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, fixFailedOperation(), op)
+		sqlMock.AssertExpectations(t)
+	})
+
+	t.Run("returns not found error when the resource has no operations", func(t *testing.T) {
+		sqlxDB, sqlMock := testdb.MockDatabase(t)
+		sqlMock.ExpectQuery(selectQuery).
+			WithArgs(string(resource.Application), resourceID).
+			WillReturnRows(sqlmock.NewRows(fixOperationColumns()))
+
+		ctx := persistence.SaveToContext(context.TODO(), sqlxDB)
+		// WHEN
+		_, err := db.NewRepository().GetLatestGlobal(ctx, resource.Application, resourceID)
+		// THEN
+		require.Error(t, err)
+		assert.True(t, apperrors.IsNotFoundError(err))
+		sqlMock.AssertExpectations(t)
+	})
+}
+
+func TestPgRepository_LockLatestGlobal(t *testing.T) {
+	selectQuery := regexp.QuoteMeta(`SELECT ` + selectedColumns + ` FROM public.scheduled_operations WHERE resource_type = $1 AND resource_id = $2 ORDER BY created_at DESC LIMIT 1 FOR UPDATE`)
+
+	t.Run("success", func(t *testing.T) {
+		sqlxDB, sqlMock := testdb.MockDatabase(t)
+		rows := sqlmock.NewRows(fixOperationColumns()).AddRow(fixInProgressOperationRow()...)
+		sqlMock.ExpectQuery(selectQuery).
+			WithArgs(string(resource.Application), resourceID).
+			WillReturnRows(rows)
+
+		ctx := persistence.SaveToContext(context.TODO(), sqlxDB)
+		// WHEN
+		op, err := db.NewRepository().LockLatestGlobal(ctx, resource.Application, resourceID)
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, fixInProgressOperation(), op)
+		sqlMock.AssertExpectations(t)
+	})
+
+	t.Run("returns error when the query fails", func(t *testing.T) {
+		sqlxDB, sqlMock := testdb.MockDatabase(t)
+		sqlMock.ExpectQuery(selectQuery).
+			WithArgs(string(resource.Application), resourceID).
+			WillReturnError(errors.New("test error"))
+
+		ctx := persistence.SaveToContext(context.TODO(), sqlxDB)
+		// WHEN
+		_, err := db.NewRepository().LockLatestGlobal(ctx, resource.Application, resourceID)
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "Unexpected error while executing SQL query")
+		sqlMock.AssertExpectations(t)
+	})
+}
+
+func TestPgRepository_ClaimNextDueGlobal(t *testing.T) {
+	claimQuery := regexp.QuoteMeta(`UPDATE public.scheduled_operations SET locked_by = $1, locked_until = $2 WHERE id = (SELECT id FROM public.scheduled_operations WHERE status = $3 AND next_attempt_at <= $4 AND (locked_until IS NULL OR locked_until <= $4) ORDER BY next_attempt_at LIMIT 1 FOR UPDATE SKIP LOCKED) RETURNING ` + selectedColumns)
+
+	t.Run("success", func(t *testing.T) {
+		sqlxDB, sqlMock := testdb.MockDatabase(t)
+		row := fixInProgressOperationRow()
+		row[14], row[15] = workerID, lockedUntil
+		sqlMock.ExpectQuery(claimQuery).
+			WithArgs(workerID, lockedUntil, "IN_PROGRESS", finishedAt).
+			WillReturnRows(sqlmock.NewRows(fixOperationColumns()).AddRow(row...))
+
+		expected := fixInProgressOperation()
+		expected.LockedBy = str(workerID)
+		expected.LockedUntil = &lockedUntil
+
+		ctx := persistence.SaveToContext(context.TODO(), sqlxDB)
+		// WHEN
+		op, err := db.NewRepository().ClaimNextDueGlobal(ctx, finishedAt, workerID, lockedUntil)
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, expected, op)
+		sqlMock.AssertExpectations(t)
+	})
+
+	t.Run("returns not found error when no operation is due", func(t *testing.T) {
+		sqlxDB, sqlMock := testdb.MockDatabase(t)
+		sqlMock.ExpectQuery(claimQuery).
+			WithArgs(workerID, lockedUntil, "IN_PROGRESS", finishedAt).
+			WillReturnRows(sqlmock.NewRows(fixOperationColumns()))
+
+		ctx := persistence.SaveToContext(context.TODO(), sqlxDB)
+		// WHEN
+		_, err := db.NewRepository().ClaimNextDueGlobal(ctx, finishedAt, workerID, lockedUntil)
+		// THEN
+		require.Error(t, err)
+		assert.True(t, apperrors.IsNotFoundError(err))
+		sqlMock.AssertExpectations(t)
+	})
+}
+
+func TestPgRepository_UpdateLeasedGlobal(t *testing.T) {
+	updateQuery := regexp.QuoteMeta(`UPDATE public.scheduled_operations SET status = $1, error = $2, webhooks = $3, next_attempt_at = $4, finished_at = $5, locked_by = NULL, locked_until = NULL WHERE id = $6 AND locked_by = $7`)
+	updateArgs := []driver.Value{"FAILED", operationErr, webhookStatuses, createdAt, finishedAt, operationID, workerID}
+
+	t.Run("success", func(t *testing.T) {
+		sqlxDB, sqlMock := testdb.MockDatabase(t)
+		sqlMock.ExpectExec(updateQuery).
+			WithArgs(updateArgs...).
+			WillReturnResult(sqlmock.NewResult(-1, 1))
+
+		ctx := persistence.SaveToContext(context.TODO(), sqlxDB)
+		// WHEN
+		updated, err := db.NewRepository().UpdateLeasedGlobal(ctx, fixFailedOperation(), workerID)
+		// THEN
+		require.NoError(t, err)
+		assert.True(t, updated)
+		sqlMock.AssertExpectations(t)
+	})
+
+	t.Run("does not update the operation when the lease has been lost", func(t *testing.T) {
+		sqlxDB, sqlMock := testdb.MockDatabase(t)
+		sqlMock.ExpectExec(updateQuery).
+			WithArgs(updateArgs...).
+			WillReturnResult(sqlmock.NewResult(-1, 0))
+
+		ctx := persistence.SaveToContext(context.TODO(), sqlxDB)
+		// WHEN
+		updated, err := db.NewRepository().UpdateLeasedGlobal(ctx, fixFailedOperation(), workerID)
+		// THEN
+		require.NoError(t, err)
+		assert.False(t, updated)
+		sqlMock.AssertExpectations(t)
+	})
+
+	t.Run("returns error when item is nil", func(t *testing.T) {
+		// WHEN
+		_, err := db.NewRepository().UpdateLeasedGlobal(context.TODO(), nil, workerID)
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "item can not be empty")
+	})
+}
+
+func TestPgRepository_PostponeGlobal(t *testing.T) {
+	updateQuery := regexp.QuoteMeta(`UPDATE public.scheduled_operations SET next_attempt_at = $1, locked_by = NULL, locked_until = NULL WHERE id = $2 AND status = $3 AND locked_by = $4`)
+
+	sqlxDB, sqlMock := testdb.MockDatabase(t)
+	sqlMock.ExpectExec(updateQuery).
+		WithArgs(finishedAt, operationID, "IN_PROGRESS", workerID).
+		WillReturnResult(sqlmock.NewResult(-1, 1))
+
+	ctx := persistence.SaveToContext(context.TODO(), sqlxDB)
+	// WHEN
+	err := db.NewRepository().PostponeGlobal(ctx, operationID, workerID, finishedAt)
+	// THEN
+	require.NoError(t, err)
+	sqlMock.AssertExpectations(t)
+}
+
+func TestPgRepository_DeleteFinishedBeforeGlobal(t *testing.T) {
+	deleteQuery := regexp.QuoteMeta(`DELETE FROM public.scheduled_operations WHERE finished_at < $1`)
+
+	sqlxDB, sqlMock := testdb.MockDatabase(t)
+	sqlMock.ExpectExec(deleteQuery).
+		WithArgs(finishedAt).
+		WillReturnResult(sqlmock.NewResult(-1, 3))
+
+	ctx := persistence.SaveToContext(context.TODO(), sqlxDB)
+	// WHEN
+	err := db.NewRepository().DeleteFinishedBeforeGlobal(ctx, finishedAt)
+	// THEN
+	require.NoError(t, err)
+	sqlMock.AssertExpectations(t)
+}
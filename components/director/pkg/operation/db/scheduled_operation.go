@@ -0,0 +1,91 @@
+package db
+
+import (
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/operation"
+)
+
+// WebhookState denotes the state of the execution of a single webhook of a ScheduledOperation
+type WebhookState string
+
+const (
+	WebhookStateSuccess    WebhookState = "Success"
+	WebhookStateFailed     WebhookState = "Failed"
+	WebhookStateInProgress WebhookState = "In Progress"
+)
+
+// WebhookStatus holds the progress of the execution of a single webhook of a ScheduledOperation
+type WebhookStatus struct {
+	WebhookID      string       `json:"webhook_id"`
+	State          WebhookState `json:"state"`
+	RetriesCount   int          `json:"retries_count"`
+	FailedAttempts int          `json:"failed_attempts"`
+	PollURL        string       `json:"poll_url,omitempty"`
+	// NextAttemptAt is the earliest time at which the webhook is executed or polled again
+	NextAttemptAt *time.Time `json:"next_attempt_at,omitempty"`
+}
+
+// ScheduledOperation is an Operation stored in the database together with the progress of the execution of its webhooks
+type ScheduledOperation struct {
+	operation.Operation
+	Status   operation.OperationStatus
+	Error    *string
+	Webhooks []WebhookStatus
+	// NextAttemptAt is the time at which the operation is due to be processed again
+	NextAttemptAt time.Time
+	FinishedAt    *time.Time
+	// LockedBy is the ID of the Worker holding a lease on the operation while processing it
+	LockedBy *string
+	// LockedUntil is the time at which the lease on the operation expires
+	LockedUntil *time.Time
+}
+
+// TimeoutReached checks whether the given timeout has passed since the operation was scheduled
+func (op *ScheduledOperation) TimeoutReached(timeout time.Duration, now time.Time) bool {
+	return now.After(op.CreationTime.Add(timeout))
+}
+
+// WebhookStatus returns the status of the webhook with the given ID, adding a new one if the webhook has not been executed yet
+func (op *ScheduledOperation) WebhookStatus(webhookID string) *WebhookStatus {
+	for i := range op.Webhooks {
+		if op.Webhooks[i].WebhookID == webhookID {
+			return &op.Webhooks[i]
+		}
+	}
+
+	op.Webhooks = append(op.Webhooks, WebhookStatus{
+		WebhookID: webhookID,
+		State:     WebhookStateInProgress,
+	})
+	return &op.Webhooks[len(op.Webhooks)-1]
+}
+
+// WebhookState returns the state of the webhook with the given ID
+func (op *ScheduledOperation) WebhookState(webhookID string) WebhookState {
+	for _, webhook := range op.Webhooks {
+		if webhook.WebhookID == webhookID {
+			return webhook.State
+		}
+	}
+	return WebhookStateInProgress
+}
+
+// IsFinished checks whether the operation has either succeeded or failed
+func (op *ScheduledOperation) IsFinished() bool {
+	return op.Status != operation.OperationStatusInProgress
+}
+
+// WebhookResults returns the outcomes of the executions of the webhooks of the operation
+func (op *ScheduledOperation) WebhookResults() []operation.WebhookResult {
+	var results []operation.WebhookResult
+	for _, webhook := range op.Webhooks {
+		results = append(results, operation.WebhookResult{
+			WebhookID:      webhook.WebhookID,
+			State:          string(webhook.State),
+			RetriesCount:   webhook.RetriesCount,
+			FailedAttempts: webhook.FailedAttempts,
+		})
+	}
+	return results
+}
@@ -0,0 +1,754 @@
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/tenant"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/timestamp"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/kyma-incubator/compass/components/director/pkg/operation"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	web_hook "github.com/kyma-incubator/compass/components/director/pkg/webhook"
+	"github.com/kyma-incubator/compass/components/director/pkg/webhook_client"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// ExecutionPolicy defines how the webhooks of a single operation are executed
+type ExecutionPolicy string
+
+const (
+	// ExecutionPolicySequential executes the webhooks of an operation one after another in the order in which they were scheduled
+	ExecutionPolicySequential ExecutionPolicy = "Sequential"
+	// ExecutionPolicyParallel executes the webhooks of an operation concurrently, at most MaxParallelWebhooks at a time,
+	// without waiting for the previous ones to complete
+	ExecutionPolicyParallel ExecutionPolicy = "Parallel"
+)
+
+// Config configures the processing of the operations scheduled in the database
+type Config struct {
+	// Interval between two rounds of processing of the due operations
+	Interval time.Duration `envconfig:"default=5s,APP_OPERATIONS_WORKER_INTERVAL"`
+	// BatchSize is the maximum number of operations processed in a single round
+	BatchSize int `envconfig:"default=50,APP_OPERATIONS_WORKER_BATCH_SIZE"`
+	// WebhookTimeout is the maximum time to process a webhook, unless the webhook defines its own
+	WebhookTimeout time.Duration `envconfig:"default=2h,APP_OPERATIONS_WORKER_WEBHOOK_TIMEOUT"`
+	// RequeueInterval is the interval between two executions of a webhook, unless the webhook defines its own retry interval
+	RequeueInterval time.Duration `envconfig:"default=2m,APP_OPERATIONS_WORKER_REQUEUE_INTERVAL"`
+	// TimeoutFactor multiplies the webhook timeout after which an operation whose resource cannot be fetched is abandoned
+	TimeoutFactor   int             `envconfig:"default=2,APP_OPERATIONS_WORKER_TIMEOUT_FACTOR"`
+	ExecutionPolicy ExecutionPolicy `envconfig:"default=Sequential,APP_OPERATIONS_WORKER_EXECUTION_POLICY"`
+	// MaxParallelWebhooks is the maximum number of webhooks of an operation executed concurrently with the Parallel execution policy.
+	// Zero does not limit the number.
+	MaxParallelWebhooks int `envconfig:"default=5,APP_OPERATIONS_WORKER_MAX_PARALLEL_WEBHOOKS"`
+	// Retention is how long finished operations are kept. Zero keeps them forever.
+	Retention time.Duration `envconfig:"default=168h,APP_OPERATIONS_WORKER_RETENTION"`
+	// LeaseDuration is how long an operation is leased to the worker processing it, after which another worker may process it.
+	// It should exceed the time needed to execute all webhooks of an operation once.
+	LeaseDuration time.Duration `envconfig:"default=10m,APP_OPERATIONS_WORKER_LEASE_DURATION"`
+}
+
+//go:generate mockery -name=WebhookClient -output=automock -outpkg=automock -case=underscore
+type WebhookClient interface {
+	Do(ctx context.Context, request *webhook_client.Request) (*web_hook.Response, error)
+	Poll(ctx context.Context, request *webhook_client.PollRequest) (*web_hook.ResponseStatus, error)
+}
+
+//go:generate mockery -name=WebhookConverter -output=automock -outpkg=automock -case=underscore
+type WebhookConverter interface {
+	ToGraphQL(in *model.Webhook) (*graphql.Webhook, error)
+}
+
+//go:generate mockery -name=OperationFinisher -output=automock -outpkg=automock -case=underscore
+type OperationFinisher interface {
+	Finish(ctx context.Context, request *operation.OperationRequest) error
+}
+
+// Worker processes the operations scheduled in the database by executing their webhooks in the same way as the operations controller does.
+// Every operation is leased to the worker for the time of its processing so that multiple workers can process the operations concurrently
+// without holding a transaction open while the webhooks are executed. Once all webhooks of an operation complete, the operation is finished
+// within the same transaction in which its progress is stored, provided that the worker still holds the lease on it.
+type Worker struct {
+	id                   string
+	cfg                  Config
+	transact             persistence.Transactioner
+	repo                 OperationRepository
+	resourceFetcherFuncs map[resource.Type]operation.ResourceFetcherFunc
+	webhookFetcherFuncs  map[resource.Type]operation.WebhookFetcherFunc
+	webhookConverter     WebhookConverter
+	finisher             OperationFinisher
+	webhookClient        WebhookClient
+	timestampGen         timestamp.Generator
+}
+
+func NewWorker(cfg Config, transact persistence.Transactioner, repo OperationRepository, resourceFetcherFuncs map[resource.Type]operation.ResourceFetcherFunc,
+	webhookFetcherFuncs map[resource.Type]operation.WebhookFetcherFunc, webhookConverter WebhookConverter, finisher OperationFinisher, uidService UIDService, httpClient *http.Client) *Worker {
+	return &Worker{
+		id:                   uidService.Generate(),
+		cfg:                  cfg,
+		transact:             transact,
+		repo:                 repo,
+		resourceFetcherFuncs: resourceFetcherFuncs,
+		webhookFetcherFuncs:  webhookFetcherFuncs,
+		webhookConverter:     webhookConverter,
+		finisher:             finisher,
+		webhookClient:        webhook_client.NewClient(httpClient),
+		timestampGen:         timestamp.DefaultGenerator(),
+	}
+}
+
+// requestObject is the request object of an operation as it is stored by the operation directive
+type requestObject struct {
+	Application *graphql.Application
+	Runtime     *graphql.Runtime
+	TenantID    string
+	Headers     map[string]string
+}
+
+// webhookResult holds the outcome of a single processing step over one of the webhooks of an operation.
+// For failed webhooks err holds the reason for the failure, while for webhooks which are still in progress
+// requeueAfter is the time after which the webhook should be processed again.
+type webhookResult struct {
+	state        WebhookState
+	requeueAfter time.Duration
+	err          error
+}
+
+func webhookSucceeded() webhookResult {
+	return webhookResult{state: WebhookStateSuccess}
+}
+
+func webhookFailed(err error) webhookResult {
+	return webhookResult{state: WebhookStateFailed, err: err}
+}
+
+func webhookInProgress(requeueAfter time.Duration) webhookResult {
+	return webhookResult{state: WebhookStateInProgress, requeueAfter: requeueAfter}
+}
+
+// Process processes up to BatchSize due operations and removes the operations which have finished before the retention period
+func (w *Worker) Process(ctx context.Context) error {
+	if w.cfg.Retention > 0 {
+		if err := w.deleteFinished(ctx); err != nil {
+			log.C(ctx).WithError(err).Error("An error has occurred while deleting finished operations")
+		}
+	}
+
+	for i := 0; i < w.cfg.BatchSize; i++ {
+		processed, err := w.processNext(ctx)
+		if err != nil {
+			return err
+		}
+		if !processed {
+			return nil
+		}
+	}
+
+	return nil
+}
+
+// processNext claims and processes the next due operation and reports whether there was any. Operations which cannot be processed
+// due to an error are postponed by the requeue interval so that they do not hold back the processing of the other operations.
+func (w *Worker) processNext(ctx context.Context) (bool, error) {
+	op, err := w.claimNext(ctx)
+	if err != nil {
+		if apperrors.IsNotFoundError(err) {
+			return false, nil
+		}
+		return false, errors.Wrap(err, "while claiming next due operation")
+	}
+
+	logger := log.C(ctx).WithFields(logrus.Fields{
+		"operation":     op.OperationID,
+		"resource_type": op.ResourceType,
+		"resource_id":   op.ResourceID,
+	})
+	ctx = log.ContextWithLogger(ctx, logger)
+
+	err = w.process(ctx, op)
+	if err == nil {
+		return true, nil
+	}
+
+	log.C(ctx).WithError(err).Errorf("An error has occurred while processing operation with ID %s. It will be retried after %s", op.OperationID, w.cfg.RequeueInterval)
+	if err := w.postpone(ctx, op.OperationID); err != nil {
+		return false, errors.Wrapf(err, "while postponing operation with ID %s", op.OperationID)
+	}
+
+	return true, nil
+}
+
+func (w *Worker) claimNext(ctx context.Context) (*ScheduledOperation, error) {
+	var op *ScheduledOperation
+	err := w.inTransaction(ctx, func(ctx context.Context) error {
+		now := w.timestampGen()
+
+		var err error
+		op, err = w.repo.ClaimNextDueGlobal(ctx, now, w.id, now.Add(w.cfg.LeaseDuration))
+		return err
+	})
+
+	return op, err
+}
+
+func (w *Worker) postpone(ctx context.Context, operationID string) error {
+	return w.inTransaction(ctx, func(ctx context.Context) error {
+		return w.repo.PostponeGlobal(ctx, operationID, w.id, w.timestampGen().Add(w.cfg.RequeueInterval))
+	})
+}
+
+func (w *Worker) deleteFinished(ctx context.Context) error {
+	return w.inTransaction(ctx, func(ctx context.Context) error {
+		return w.repo.DeleteFinishedBeforeGlobal(ctx, w.timestampGen().Add(-w.cfg.Retention))
+	})
+}
+
+// process performs a single processing step over the claimed operation and stores its progress.
+// It returns an error only if the step should be retried.
+func (w *Worker) process(ctx context.Context, op *ScheduledOperation) error {
+	request, err := w.step(ctx, op)
+	if err != nil {
+		return err
+	}
+
+	return w.complete(ctx, op, request)
+}
+
+// complete finishes the operation if it has completed and stores its progress within a single transaction. The outcome of the processing
+// is discarded if the lease on the operation has been lost in the meantime, for example because the operation has been cancelled.
+func (w *Worker) complete(ctx context.Context, op *ScheduledOperation, request *operation.OperationRequest) error {
+	err := w.inTransaction(ctx, func(ctx context.Context) error {
+		if request != nil {
+			if err := w.finisher.Finish(ctx, request); err != nil {
+				return errors.Wrap(err, "while finishing operation")
+			}
+		}
+
+		updated, err := w.repo.UpdateLeasedGlobal(ctx, op, w.id)
+		if err != nil {
+			return errors.Wrap(err, "while updating operation")
+		}
+		if !updated {
+			return errLeaseLost
+		}
+
+		return nil
+	})
+	if err == errLeaseLost {
+		log.C(ctx).Warn("The lease on the operation has been lost. The outcome of its processing is discarded")
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if request != nil {
+		log.C(ctx).Infof("Successfully finished operation with status %s", op.Status)
+	}
+
+	return nil
+}
+
+// step performs a single processing step over the operation without holding a transaction open while its webhooks are executed.
+// It returns the request with which the operation is to be finished once it has completed, and an error only if the step should be retried.
+func (w *Worker) step(ctx context.Context, op *ScheduledOperation) (*operation.OperationRequest, error) {
+	reqObject, err := parseRequestObject(op.RequestObject)
+	if err != nil {
+		log.C(ctx).WithError(err).Error("Unable to parse request object")
+		return w.finishWithError(op, err)
+	}
+
+	resourceFetcherFunc, ok := w.resourceFetcherFuncs[op.ResourceType]
+	if !ok {
+		return w.finishWithError(op, fmt.Errorf("unsupported resource type %s", op.ResourceType))
+	}
+
+	ctx = tenant.SaveToContext(ctx, reqObject.TenantID, "")
+	var res model.Entity
+	err = w.inTransaction(ctx, func(ctx context.Context) error {
+		res, err = resourceFetcherFunc(ctx, reqObject.TenantID, op.ResourceID)
+		return err
+	})
+	if err != nil {
+		return nil, w.handleFetchResourceError(ctx, op, err)
+	}
+
+	if res.GetReady() {
+		w.finalizeStatus(op, res.GetError())
+		return nil, nil
+	}
+
+	if len(op.WebhookIDs) == 0 {
+		log.C(ctx).Info("No webhook defined. Operation executed successfully")
+		return w.finishSuccess(op)
+	}
+
+	webhooks, err := w.fetchWebhooks(ctx, op)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while fetching webhooks of %s with ID %s", op.ResourceType, op.ResourceID)
+	}
+
+	webhookEntities, err := extractWebhooks(webhooks, op.WebhookIDs)
+	if err != nil {
+		log.C(ctx).WithError(err).Error("Unable to retrieve webhooks")
+		return w.finishWithError(op, err)
+	}
+
+	if w.cfg.ExecutionPolicy == ExecutionPolicyParallel {
+		return w.executeWebhooksInParallel(ctx, op, webhookEntities, reqObject.toWebhookRequestObject())
+	}
+
+	return w.executeWebhooksSequentially(ctx, op, webhookEntities, reqObject.toWebhookRequestObject())
+}
+
+func (w *Worker) fetchWebhooks(ctx context.Context, op *ScheduledOperation) ([]*graphql.Webhook, error) {
+	var webhooks []*model.Webhook
+	err := w.inTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		webhooks, err = w.webhookFetcherFuncs[op.ResourceType](ctx, op.ResourceID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	gqlWebhooks := make([]*graphql.Webhook, 0, len(webhooks))
+	for _, webhook := range webhooks {
+		gqlWebhook, err := w.webhookConverter.ToGraphQL(webhook)
+		if err != nil {
+			return nil, errors.Wrapf(err, "while converting webhook with ID %s", webhook.ID)
+		}
+		gqlWebhooks = append(gqlWebhooks, gqlWebhook)
+	}
+
+	return gqlWebhooks, nil
+}
+
+// inTransaction executes fn within a new transaction which is committed only if fn succeeds
+func (w *Worker) inTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	tx, err := w.transact.Begin()
+	if err != nil {
+		return errors.Wrap(err, "while opening transaction")
+	}
+	defer w.transact.RollbackUnlessCommitted(ctx, tx)
+
+	if err := fn(persistence.SaveToContext(ctx, tx)); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (w *Worker) handleFetchResourceError(ctx context.Context, op *ScheduledOperation, err error) error {
+	log.C(ctx).WithError(err).Errorf("Unable to fetch %s", op.ResourceType)
+	if op.TimeoutReached(time.Duration(w.cfg.TimeoutFactor)*w.cfg.WebhookTimeout, w.timestampGen()) {
+		log.C(ctx).Info("Operation timeout reached. The operation will be abandoned")
+		errMsg := fmt.Sprintf("unable to fetch %s: %s", op.ResourceType, err)
+		w.finalizeStatus(op, &errMsg)
+		return nil
+	}
+
+	return errors.Wrapf(err, "while fetching %s with ID %s", op.ResourceType, op.ResourceID)
+}
+
+// executeWebhooksSequentially executes the webhooks of the operation one after another and moves to the next webhook
+// only after the previous one has succeeded. The operation is finished as failed with the first failing webhook.
+func (w *Worker) executeWebhooksSequentially(ctx context.Context, op *ScheduledOperation, webhooks []*graphql.Webhook, reqObject web_hook.RequestObject) (*operation.OperationRequest, error) {
+	for _, webhook := range webhooks {
+		if op.WebhookState(webhook.ID) == WebhookStateSuccess {
+			continue
+		}
+
+		result := w.executeWebhook(ctx, op, webhook, reqObject)
+		switch result.state {
+		case WebhookStateFailed:
+			op.WebhookStatus(webhook.ID).State = WebhookStateFailed
+			return w.finishWithError(op, result.err)
+		case WebhookStateInProgress:
+			op.NextAttemptAt = w.timestampGen().Add(result.requeueAfter)
+			return nil, nil
+		}
+
+		op.WebhookStatus(webhook.ID).State = WebhookStateSuccess
+		log.C(ctx).Infof("Webhook with ID %s has been executed successfully", webhook.ID)
+	}
+
+	return w.finishSuccess(op)
+}
+
+// executeWebhooksInParallel executes all webhooks of the operation which have not completed yet concurrently, without waiting
+// for the previous ones to complete, and merges their outcomes. The operation is finished only after all of its webhooks have
+// either succeeded or failed.
+func (w *Worker) executeWebhooksInParallel(ctx context.Context, op *ScheduledOperation, webhooks []*graphql.Webhook, reqObject web_hook.RequestObject) (*operation.OperationRequest, error) {
+	var (
+		inProgress       bool
+		requeueAfter     time.Duration
+		failedWebhookIDs []string
+		webhookErr       error
+		pending          []*graphql.Webhook
+	)
+
+	for _, webhook := range webhooks {
+		switch op.WebhookState(webhook.ID) {
+		case WebhookStateSuccess:
+			continue
+		case WebhookStateFailed:
+			failedWebhookIDs = append(failedWebhookIDs, webhook.ID)
+			continue
+		}
+		// the statuses are added before the webhooks are executed, so that they keep the order of the webhooks
+		// and are not reallocated while the webhooks update them
+		op.WebhookStatus(webhook.ID)
+		pending = append(pending, webhook)
+	}
+
+	results := w.executeWebhooksConcurrently(ctx, op, pending, reqObject)
+	for i, webhook := range pending {
+		result := results[i]
+		switch result.state {
+		case WebhookStateSuccess:
+			op.WebhookStatus(webhook.ID).State = WebhookStateSuccess
+			log.C(ctx).Infof("Webhook with ID %s has been executed successfully", webhook.ID)
+		case WebhookStateFailed:
+			log.C(ctx).WithError(result.err).Errorf("Webhook with ID %s has failed", webhook.ID)
+			op.WebhookStatus(webhook.ID).State = WebhookStateFailed
+			failedWebhookIDs = append(failedWebhookIDs, webhook.ID)
+			if webhookErr == nil {
+				webhookErr = result.err
+			}
+		default:
+			if !inProgress || result.requeueAfter < requeueAfter {
+				requeueAfter = result.requeueAfter
+			}
+			inProgress = true
+		}
+	}
+
+	if inProgress {
+		op.NextAttemptAt = w.timestampGen().Add(requeueAfter)
+		return nil, nil
+	}
+
+	if len(failedWebhookIDs) > 0 {
+		if webhookErr == nil {
+			webhookErr = errFailedWebhookStatus
+		}
+		return w.finishWithError(op, fmt.Errorf("webhooks with IDs %s have failed: %s", strings.Join(failedWebhookIDs, ", "), webhookErr))
+	}
+
+	return w.finishSuccess(op)
+}
+
+// executeWebhooksConcurrently executes the given webhooks of the operation, at most MaxParallelWebhooks at a time, and returns
+// their results in the same order. Only the webhook requests are made concurrently, while the rest of the execution, which reads
+// and updates the shared operation, is serialized.
+func (w *Worker) executeWebhooksConcurrently(ctx context.Context, op *ScheduledOperation, webhooks []*graphql.Webhook, reqObject web_hook.RequestObject) []webhookResult {
+	limit := w.cfg.MaxParallelWebhooks
+	if limit <= 0 {
+		limit = len(webhooks)
+	}
+
+	var (
+		mu      sync.Mutex
+		wg      sync.WaitGroup
+		limiter = make(chan struct{}, limit)
+	)
+
+	concurrent := *w
+	concurrent.webhookClient = &unlockingWebhookClient{client: w.webhookClient, mu: &mu}
+
+	results := make([]webhookResult, len(webhooks))
+	for i, webhook := range webhooks {
+		wg.Add(1)
+		go func(i int, webhook *graphql.Webhook) {
+			defer wg.Done()
+
+			limiter <- struct{}{}
+			defer func() { <-limiter }()
+
+			mu.Lock()
+			defer mu.Unlock()
+			results[i] = concurrent.executeWebhook(ctx, op, webhook, reqObject)
+		}(i, webhook)
+	}
+	wg.Wait()
+
+	return results
+}
+
+// executeWebhook performs a single processing step for the given webhook of the operation - it either executes
+// the initial webhook request or polls for the webhook status if a Poll URL has already been provided for the webhook.
+func (w *Worker) executeWebhook(ctx context.Context, op *ScheduledOperation, webhook *graphql.Webhook, reqObject web_hook.RequestObject) webhookResult {
+	ctx = log.ContextWithLogger(ctx, log.C(ctx).WithField("webhook", webhook.ID))
+	now := w.timestampGen()
+
+	if op.TimeoutReached(w.determineTimeout(webhook), now) {
+		log.C(ctx).Info("Webhook timeout reached")
+		return webhookFailed(errWebhookTimeoutReached)
+	}
+
+	status := op.WebhookStatus(webhook.ID)
+	if status.NextAttemptAt != nil && status.NextAttemptAt.After(now) {
+		return webhookInProgress(status.NextAttemptAt.Sub(now))
+	}
+
+	if status.PollURL == "" {
+		if webhook.OutputTemplate == nil {
+			log.C(ctx).Error("Unable to execute Webhook request: missing output template")
+			return webhookFailed(errMissingOutputTemplate)
+		}
+
+		log.C(ctx).Info("Webhook Poll URL is not found. Will attempt to execute the webhook")
+		request := webhook_client.NewRequest(*webhook, reqObject, op.CorrelationID)
+
+		response, err := w.webhookClient.Do(ctx, request)
+		if webhook_client.IsStatusGoneErr(err) && op.OperationType == operation.OperationTypeDelete {
+			log.C(ctx).Infof("Webhook initial request returned gone status %d", *response.GoneStatusCode)
+			return webhookSucceeded()
+		}
+		if err != nil {
+			log.C(ctx).WithError(err).Error("Unable to execute Webhook request")
+			return w.retryUnlessTimeoutOrFatalError(ctx, op, webhook, err)
+		}
+
+		return w.handleWebhookResponse(ctx, op, webhook, response)
+	}
+
+	request := webhook_client.NewPollRequest(*webhook, reqObject, op.CorrelationID, status.PollURL)
+	response, err := w.webhookClient.Poll(ctx, request)
+	if err != nil {
+		log.C(ctx).WithError(err).Error("Unable to execute Webhook Poll request")
+		return w.retryUnlessTimeoutOrFatalError(ctx, op, webhook, err)
+	}
+
+	return w.handleWebhookPollResponse(ctx, op, webhook, response)
+}
+
+func (w *Worker) handleWebhookResponse(ctx context.Context, op *ScheduledOperation, webhook *graphql.Webhook, response *web_hook.Response) webhookResult {
+	mode := graphql.WebhookModeSync
+	if webhook.Mode != nil {
+		mode = *webhook.Mode
+	}
+
+	switch mode {
+	case graphql.WebhookModeAsync:
+		if response.Location == nil {
+			log.C(ctx).Error("Unable to post-process Webhook response: missing location url")
+			return webhookFailed(webhook_client.NewFatalErrorFromExisting(errMissingLocation))
+		}
+		log.C(ctx).Infof("Asynchronous webhook initial request has been executed successfully. Poll URL: %s", *response.Location)
+		op.WebhookStatus(webhook.ID).PollURL = *response.Location
+		return webhookInProgress(0)
+	case graphql.WebhookModeSync:
+		log.C(ctx).Info("Synchronous webhook has been executed successfully")
+		return webhookSucceeded()
+	default:
+		log.C(ctx).Errorf("Unable to post-process Webhook response: unsupported webhook mode %s", mode)
+		return webhookFailed(fmt.Errorf("unsupported webhook mode %s", mode))
+	}
+}
+
+func (w *Worker) handleWebhookPollResponse(ctx context.Context, op *ScheduledOperation, webhook *graphql.Webhook, response *web_hook.ResponseStatus) webhookResult {
+	log.C(ctx).Infof("Asynchronous webhook polling request has been executed successfully with response status: %s", *response.Status)
+	switch *response.Status {
+	case *response.InProgressStatusIdentifier:
+		op.WebhookStatus(webhook.ID).RetriesCount++
+		return w.requeueUnlessTimeoutOrFatalError(op, webhook, errWebhookPollTimeExpired)
+	case *response.SuccessStatusIdentifier:
+		return webhookSucceeded()
+	case *response.FailedStatusIdentifier:
+		return webhookFailed(errFailedWebhookStatus)
+	default:
+		log.C(ctx).Errorf("Unexpected poll status response: %s. Polling will be retried", *response.Status)
+		return w.requeueUnlessTimeoutOrFatalError(op, webhook, fmt.Errorf("unexpected poll status response: %s", *response.Status))
+	}
+}
+
+func (w *Worker) requeueUnlessTimeoutOrFatalError(op *ScheduledOperation, webhook *graphql.Webhook, webhookErr error) webhookResult {
+	isFatalErr := webhook_client.IsFatalErr(webhookErr)
+	if !op.TimeoutReached(w.determineTimeout(webhook), w.timestampGen()) && !isFatalErr {
+		return w.requeueAfter(op, webhook, w.determineRetryInterval(webhook))
+	}
+
+	if !isFatalErr {
+		webhookErr = fmt.Errorf("%s: %s", errWebhookTimeoutReached, webhookErr)
+	}
+
+	return webhookFailed(webhookErr)
+}
+
+// retryUnlessTimeoutOrFatalError handles a failed execution of the webhook according to its retry policy.
+// Webhooks without a retry policy are requeued with a fixed interval until they time out.
+func (w *Worker) retryUnlessTimeoutOrFatalError(ctx context.Context, op *ScheduledOperation, webhook *graphql.Webhook, webhookErr error) webhookResult {
+	if webhook.RetryPolicy == nil {
+		return w.requeueUnlessTimeoutOrFatalError(op, webhook, webhookErr)
+	}
+
+	if webhook_client.IsFatalErr(webhookErr) {
+		return webhookFailed(webhookErr)
+	}
+
+	if op.TimeoutReached(w.determineTimeout(webhook), w.timestampGen()) {
+		return webhookFailed(fmt.Errorf("%s: %s", errWebhookTimeoutReached, webhookErr))
+	}
+
+	policy := webhook_client.NewRetryPolicy(webhook.RetryPolicy, w.determineRetryInterval(webhook))
+	if !policy.IsRetryable(webhookErr) {
+		log.C(ctx).Info("Webhook failure is not retryable according to the webhook retry policy")
+		return webhookFailed(fmt.Errorf("%s: %s", errWebhookNotRetryable, webhookErr))
+	}
+
+	status := op.WebhookStatus(webhook.ID)
+	failedAttempts := status.FailedAttempts + 1
+	if policy.AttemptsExhausted(failedAttempts) {
+		log.C(ctx).Infof("Webhook has failed %d times and will not be retried", failedAttempts)
+		return webhookFailed(fmt.Errorf("%s: %s", errWebhookAttemptsExhausted, webhookErr))
+	}
+	status.FailedAttempts = failedAttempts
+
+	backoff := policy.Backoff(failedAttempts)
+	log.C(ctx).Infof("Webhook has failed %d times. Will retry after %s", failedAttempts, backoff)
+	return w.requeueAfter(op, webhook, backoff)
+}
+
+// requeueAfter records when the webhook should be processed again, so that the webhook is not executed earlier
+// when the operation is processed due to its other webhooks
+func (w *Worker) requeueAfter(op *ScheduledOperation, webhook *graphql.Webhook, interval time.Duration) webhookResult {
+	nextAttemptAt := w.timestampGen().Add(interval)
+	op.WebhookStatus(webhook.ID).NextAttemptAt = &nextAttemptAt
+	return webhookInProgress(interval)
+}
+
+func (w *Worker) finalizeStatus(op *ScheduledOperation, errorMsg *string) {
+	now := w.timestampGen()
+	op.Status = operation.OperationStatusSucceeded
+	op.Error = nil
+	if errorMsg != nil && *errorMsg != "" {
+		op.Status = operation.OperationStatusFailed
+		op.Error = errorMsg
+	}
+	op.FinishedAt = &now
+}
+
+func (w *Worker) finishSuccess(op *ScheduledOperation) (*operation.OperationRequest, error) {
+	return w.finish(op, nil)
+}
+
+func (w *Worker) finishWithError(op *ScheduledOperation, opErr error) (*operation.OperationRequest, error) {
+	return w.finish(op, opErr)
+}
+
+// finish marks the operation as finished and returns the request with which its outcome is applied to its resource
+// in the same way as the operations controller does through the Operations API
+func (w *Worker) finish(op *ScheduledOperation, opErr error) (*operation.OperationRequest, error) {
+	request := &operation.OperationRequest{
+		OperationID:    op.OperationID,
+		OperationType:  op.OperationType,
+		ResourceType:   op.ResourceType,
+		ResourceID:     op.ResourceID,
+		WebhookResults: op.WebhookResults(),
+	}
+
+	var errorMsg *string
+	if opErr != nil {
+		request.Error = opErr.Error()
+		errorMsg = &request.Error
+	}
+
+	w.finalizeStatus(op, errorMsg)
+	return request, nil
+}
+
+func (w *Worker) determineTimeout(webhook *graphql.Webhook) time.Duration {
+	if webhook.Timeout == nil {
+		return w.cfg.WebhookTimeout
+	}
+
+	return time.Duration(*webhook.Timeout) * time.Second
+}
+
+func (w *Worker) determineRetryInterval(webhook *graphql.Webhook) time.Duration {
+	if webhook.RetryInterval == nil {
+		return w.cfg.RequeueInterval
+	}
+
+	return time.Duration(*webhook.RetryInterval) * time.Second
+}
+
+func parseRequestObject(data string) (*requestObject, error) {
+	var reqObject requestObject
+	if err := json.Unmarshal([]byte(data), &reqObject); err != nil {
+		return nil, errors.Wrap(err, "while unmarshalling request object")
+	}
+
+	return &reqObject, nil
+}
+
+func (r *requestObject) toWebhookRequestObject() web_hook.RequestObject {
+	webhookRequestObject := web_hook.RequestObject{
+		TenantID: r.TenantID,
+		Headers:  r.Headers,
+	}
+
+	// typed nil pointers are not assigned so that the templates can check which resource is present
+	if r.Application != nil {
+		webhookRequestObject.Application = r.Application
+	}
+	if r.Runtime != nil {
+		webhookRequestObject.Runtime = r.Runtime
+	}
+
+	return webhookRequestObject
+}
+
+func extractWebhooks(webhooks []*graphql.Webhook, operationWebhookIDs []string) ([]*graphql.Webhook, error) {
+	webhookEntities := make([]*graphql.Webhook, 0, len(operationWebhookIDs))
+	for _, operationWebhookID := range operationWebhookIDs {
+		webhookEntity, err := extractWebhook(webhooks, operationWebhookID)
+		if err != nil {
+			return nil, err
+		}
+		webhookEntities = append(webhookEntities, webhookEntity)
+	}
+
+	return webhookEntities, nil
+}
+
+func extractWebhook(webhooks []*graphql.Webhook, operationWebhookID string) (*graphql.Webhook, error) {
+	for _, webhook := range webhooks {
+		if webhook.ID == operationWebhookID {
+			return webhook, nil
+		}
+	}
+
+	return nil, fmt.Errorf("missing webhook with ID: %s", operationWebhookID)
+}
+
+// unlockingWebhookClient releases the lock held by the executed webhook for the duration of its request,
+// so that the other webhooks of the operation can proceed in the meantime
+type unlockingWebhookClient struct {
+	client WebhookClient
+	mu     *sync.Mutex
+}
+
+func (c *unlockingWebhookClient) Do(ctx context.Context, request *webhook_client.Request) (*web_hook.Response, error) {
+	c.mu.Unlock()
+	defer c.mu.Lock()
+	return c.client.Do(ctx, request)
+}
+
+func (c *unlockingWebhookClient) Poll(ctx context.Context, request *webhook_client.PollRequest) (*web_hook.ResponseStatus, error) {
+	c.mu.Unlock()
+	defer c.mu.Lock()
+	return c.client.Poll(ctx, request)
+}
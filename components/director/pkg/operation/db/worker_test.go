@@ -0,0 +1,580 @@
+package db_test
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/operation"
+	"github.com/kyma-incubator/compass/components/director/pkg/operation/db"
+	"github.com/kyma-incubator/compass/components/director/pkg/operation/db/automock"
+	persistenceautomock "github.com/kyma-incubator/compass/components/director/pkg/persistence/automock"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence/txtest"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	web_hook "github.com/kyma-incubator/compass/components/director/pkg/webhook"
+	"github.com/kyma-incubator/compass/components/director/pkg/webhook_client"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWorker_Process(t *testing.T) {
+	testErr := errors.New("test error")
+	requeueInterval := 2 * time.Minute
+	nextAttemptAt := finishedAt.Add(requeueInterval)
+
+	cfg := db.Config{
+		BatchSize:       1,
+		WebhookTimeout:  time.Hour,
+		RequeueInterval: requeueInterval,
+		TimeoutFactor:   2,
+		ExecutionPolicy: db.ExecutionPolicySequential,
+		LeaseDuration:   lockedUntil.Sub(finishedAt),
+	}
+
+	succeededOperation := func(webhooks ...db.WebhookStatus) *db.ScheduledOperation {
+		op := fixInProgressOperation()
+		op.Status = operation.OperationStatusSucceeded
+		op.Webhooks = webhooks
+		op.FinishedAt = &finishedAt
+		return op
+	}
+
+	failedOperation := func(errMsg string, webhooks ...db.WebhookStatus) *db.ScheduledOperation {
+		op := fixInProgressOperation()
+		op.Status = operation.OperationStatusFailed
+		op.Error = str(errMsg)
+		op.Webhooks = webhooks
+		op.FinishedAt = &finishedAt
+		return op
+	}
+
+	inProgressOperation := func(nextAttemptAt time.Time, webhooks ...db.WebhookStatus) *db.ScheduledOperation {
+		op := fixInProgressOperation()
+		op.Webhooks = webhooks
+		op.NextAttemptAt = nextAttemptAt
+		return op
+	}
+
+	operationRequest := func(errMsg string, results ...operation.WebhookResult) *operation.OperationRequest {
+		return &operation.OperationRequest{
+			OperationID:    operationID,
+			OperationType:  operation.OperationTypeCreate,
+			ResourceType:   resource.Application,
+			ResourceID:     resourceID,
+			Error:          errMsg,
+			WebhookResults: results,
+		}
+	}
+
+	claimedRepo := func(op *db.ScheduledOperation, expectedUpdate *db.ScheduledOperation) func() *automock.OperationRepository {
+		return func() *automock.OperationRepository {
+			repo := &automock.OperationRepository{}
+			repo.On("ClaimNextDueGlobal", txtest.CtxWithDBMatcher(), finishedAt, workerID, lockedUntil).Return(op, nil).Once()
+			if expectedUpdate != nil {
+				repo.On("UpdateLeasedGlobal", txtest.CtxWithDBMatcher(), expectedUpdate, workerID).Return(true, nil).Once()
+			}
+			return repo
+		}
+	}
+
+	postponedRepo := func(op *db.ScheduledOperation) func() *automock.OperationRepository {
+		return func() *automock.OperationRepository {
+			repo := &automock.OperationRepository{}
+			repo.On("ClaimNextDueGlobal", txtest.CtxWithDBMatcher(), finishedAt, workerID, lockedUntil).Return(op, nil).Once()
+			repo.On("PostponeGlobal", txtest.CtxWithDBMatcher(), operationID, workerID, nextAttemptAt).Return(nil).Once()
+			return repo
+		}
+	}
+
+	// transactions expects the given number of transactions to be opened, of which the given number are committed
+	transactions := func(opened, committed int) func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+		return func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+			persistTx := &persistenceautomock.PersistenceTx{}
+			persistTx.On("Commit").Return(nil).Times(committed)
+
+			transact := &persistenceautomock.Transactioner{}
+			transact.On("Begin").Return(persistTx, nil).Times(opened)
+			transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return().Times(opened)
+
+			return persistTx, transact
+		}
+	}
+
+	asyncStatus := func(status string) *web_hook.ResponseStatus {
+		return &web_hook.ResponseStatus{
+			Status:                     str(status),
+			SuccessStatusIdentifier:    str("SUCCEEDED"),
+			InProgressStatusIdentifier: str("IN_PROGRESS"),
+			FailedStatusIdentifier:     str("FAILED"),
+		}
+	}
+
+	fixWebhookWithTimeout := func(id string, timeout int) *graphql.Webhook {
+		webhook := fixWebhook(id, graphql.WebhookModeSync)
+		webhook.Timeout = &timeout
+		return webhook
+	}
+
+	fixWebhookWithRetryPolicy := func(id string, maxAttempts int) *graphql.Webhook {
+		webhook := fixWebhook(id, graphql.WebhookModeSync)
+		webhook.RetryPolicy = &graphql.WebhookRetryPolicy{MaxAttempts: &maxAttempts}
+		return webhook
+	}
+
+	twoWebhooksOperation := func(webhooks ...db.WebhookStatus) *db.ScheduledOperation {
+		op := fixInProgressOperation()
+		op.WebhookIDs = []string{webhookID, secondWebhookID}
+		op.Webhooks = webhooks
+		return op
+	}
+
+	timedOutOperation := func() *db.ScheduledOperation {
+		op := fixInProgressOperation()
+		op.CreationTime = finishedAt.Add(-3 * time.Hour)
+		return op
+	}
+
+	testCases := []struct {
+		Name             string
+		ExecutionPolicy  db.ExecutionPolicy
+		TransactFn       func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		RepoFn           func() *automock.OperationRepository
+		ResourceFetchErr error
+		ResourceReady    bool
+		Webhooks         []*graphql.Webhook
+		WebhookClientFn  func() *automock.WebhookClient
+		FinisherFn       func() *automock.OperationFinisher
+		ExpectedErrorMsg string
+	}{
+		{
+			Name:       "when there are no due operations it should do nothing",
+			TransactFn: txtest.NewTransactionContextGenerator(nil).ThatDoesntExpectCommit,
+			RepoFn: func() *automock.OperationRepository {
+				repo := &automock.OperationRepository{}
+				repo.On("ClaimNextDueGlobal", txtest.CtxWithDBMatcher(), finishedAt, workerID, lockedUntil).Return(nil, apperrors.NewNotFoundErrorWithType(resource.ScheduledOperation)).Once()
+				return repo
+			},
+		},
+		{
+			Name:       "when claiming the next operation fails it should fail",
+			TransactFn: txtest.NewTransactionContextGenerator(nil).ThatDoesntExpectCommit,
+			RepoFn: func() *automock.OperationRepository {
+				repo := &automock.OperationRepository{}
+				repo.On("ClaimNextDueGlobal", txtest.CtxWithDBMatcher(), finishedAt, workerID, lockedUntil).Return(nil, testErr).Once()
+				return repo
+			},
+			ExpectedErrorMsg: testErr.Error(),
+		},
+		{
+			Name:             "when opening the transaction fails it should fail",
+			TransactFn:       txtest.NewTransactionContextGenerator(testErr).ThatFailsOnBegin,
+			RepoFn:           func() *automock.OperationRepository { return &automock.OperationRepository{} },
+			ExpectedErrorMsg: testErr.Error(),
+		},
+		{
+			Name:          "when the resource is already ready it should mark the operation as succeeded",
+			TransactFn:    transactions(3, 3),
+			RepoFn:        claimedRepo(fixInProgressOperation(), succeededOperation()),
+			ResourceReady: true,
+		},
+		{
+			Name:       "when a synchronous webhook succeeds it should finish the operation",
+			TransactFn: transactions(4, 4),
+			RepoFn:     claimedRepo(fixInProgressOperation(), succeededOperation(db.WebhookStatus{WebhookID: webhookID, State: db.WebhookStateSuccess})),
+			Webhooks:   []*graphql.Webhook{fixWebhook(webhookID, graphql.WebhookModeSync)},
+			WebhookClientFn: func() *automock.WebhookClient {
+				client := &automock.WebhookClient{}
+				client.On("Do", mock.Anything, mock.Anything).Return(&web_hook.Response{}, nil).Once()
+				return client
+			},
+			FinisherFn: func() *automock.OperationFinisher {
+				finisher := &automock.OperationFinisher{}
+				finisher.On("Finish", txtest.CtxWithDBMatcher(), operationRequest("", operation.WebhookResult{WebhookID: webhookID, State: "Success"})).Return(nil).Once()
+				return finisher
+			},
+		},
+		{
+			Name:       "when an asynchronous webhook request succeeds it should store the poll URL",
+			TransactFn: transactions(4, 4),
+			RepoFn:     claimedRepo(fixInProgressOperation(), inProgressOperation(finishedAt, db.WebhookStatus{WebhookID: webhookID, State: db.WebhookStateInProgress, PollURL: pollURL})),
+			Webhooks:   []*graphql.Webhook{fixWebhook(webhookID, graphql.WebhookModeAsync)},
+			WebhookClientFn: func() *automock.WebhookClient {
+				client := &automock.WebhookClient{}
+				client.On("Do", mock.Anything, mock.Anything).Return(&web_hook.Response{Location: str(pollURL)}, nil).Once()
+				return client
+			},
+		},
+		{
+			Name:       "when an asynchronous webhook is still in progress it should requeue the operation",
+			TransactFn: transactions(4, 4),
+			RepoFn: claimedRepo(
+				inProgressOperation(createdAt, db.WebhookStatus{WebhookID: webhookID, State: db.WebhookStateInProgress, PollURL: pollURL}),
+				inProgressOperation(nextAttemptAt, db.WebhookStatus{WebhookID: webhookID, State: db.WebhookStateInProgress, PollURL: pollURL, RetriesCount: 1, NextAttemptAt: &nextAttemptAt}),
+			),
+			Webhooks: []*graphql.Webhook{fixWebhook(webhookID, graphql.WebhookModeAsync)},
+			WebhookClientFn: func() *automock.WebhookClient {
+				client := &automock.WebhookClient{}
+				client.On("Poll", mock.Anything, webhook_client.NewPollRequest(*fixWebhook(webhookID, graphql.WebhookModeAsync), fixWebhookRequestObject(), correlationID, pollURL)).Return(asyncStatus("IN_PROGRESS"), nil).Once()
+				return client
+			},
+		},
+		{
+			Name:       "when an asynchronous webhook has failed it should finish the operation with error",
+			TransactFn: transactions(4, 4),
+			RepoFn: claimedRepo(
+				inProgressOperation(createdAt, db.WebhookStatus{WebhookID: webhookID, State: db.WebhookStateInProgress, PollURL: pollURL}),
+				failedOperation(operationErr, db.WebhookStatus{WebhookID: webhookID, State: db.WebhookStateFailed, PollURL: pollURL}),
+			),
+			Webhooks: []*graphql.Webhook{fixWebhook(webhookID, graphql.WebhookModeAsync)},
+			WebhookClientFn: func() *automock.WebhookClient {
+				client := &automock.WebhookClient{}
+				client.On("Poll", mock.Anything, mock.Anything).Return(asyncStatus("FAILED"), nil).Once()
+				return client
+			},
+			FinisherFn: func() *automock.OperationFinisher {
+				finisher := &automock.OperationFinisher{}
+				finisher.On("Finish", txtest.CtxWithDBMatcher(), operationRequest(operationErr, operation.WebhookResult{WebhookID: webhookID, State: "Failed"})).Return(nil).Once()
+				return finisher
+			},
+		},
+		{
+			Name:       "when a webhook request fails it should requeue the webhook",
+			TransactFn: transactions(4, 4),
+			RepoFn:     claimedRepo(fixInProgressOperation(), inProgressOperation(nextAttemptAt, db.WebhookStatus{WebhookID: webhookID, State: db.WebhookStateInProgress, NextAttemptAt: &nextAttemptAt})),
+			Webhooks:   []*graphql.Webhook{fixWebhook(webhookID, graphql.WebhookModeSync)},
+			WebhookClientFn: func() *automock.WebhookClient {
+				client := &automock.WebhookClient{}
+				client.On("Do", mock.Anything, mock.Anything).Return(nil, testErr).Once()
+				return client
+			},
+		},
+		{
+			Name:       "when the next attempt of a webhook is not due yet it should not execute the webhook",
+			TransactFn: transactions(4, 4),
+			RepoFn: claimedRepo(
+				inProgressOperation(createdAt, db.WebhookStatus{WebhookID: webhookID, State: db.WebhookStateInProgress, NextAttemptAt: &nextAttemptAt}),
+				inProgressOperation(nextAttemptAt, db.WebhookStatus{WebhookID: webhookID, State: db.WebhookStateInProgress, NextAttemptAt: &nextAttemptAt}),
+			),
+			Webhooks: []*graphql.Webhook{fixWebhook(webhookID, graphql.WebhookModeSync)},
+		},
+		{
+			Name:       "when the webhook retry attempts are exhausted it should finish the operation with error",
+			TransactFn: transactions(4, 4),
+			RepoFn: claimedRepo(
+				fixInProgressOperation(),
+				failedOperation(fmt.Sprintf("webhook retry attempts have been exhausted: %s", testErr), db.WebhookStatus{WebhookID: webhookID, State: db.WebhookStateFailed}),
+			),
+			Webhooks: []*graphql.Webhook{fixWebhookWithRetryPolicy(webhookID, 1)},
+			WebhookClientFn: func() *automock.WebhookClient {
+				client := &automock.WebhookClient{}
+				client.On("Do", mock.Anything, mock.Anything).Return(nil, testErr).Once()
+				return client
+			},
+			FinisherFn: func() *automock.OperationFinisher {
+				finisher := &automock.OperationFinisher{}
+				finisher.On("Finish", txtest.CtxWithDBMatcher(), mock.Anything).Return(nil).Once()
+				return finisher
+			},
+		},
+		{
+			Name:       "when the webhook timeout is reached it should finish the operation with error",
+			TransactFn: transactions(4, 4),
+			RepoFn:     claimedRepo(fixInProgressOperation(), failedOperation("webhook timeout reached", db.WebhookStatus{WebhookID: webhookID, State: db.WebhookStateFailed})),
+			Webhooks:   []*graphql.Webhook{fixWebhookWithTimeout(webhookID, 60)},
+			FinisherFn: func() *automock.OperationFinisher {
+				finisher := &automock.OperationFinisher{}
+				finisher.On("Finish", txtest.CtxWithDBMatcher(), operationRequest("webhook timeout reached", operation.WebhookResult{WebhookID: webhookID, State: "Failed"})).Return(nil).Once()
+				return finisher
+			},
+		},
+		{
+			Name:       "when sequential webhooks are executed it should not execute the next webhook until the previous one succeeds",
+			TransactFn: transactions(4, 4),
+			RepoFn: func() *automock.OperationRepository {
+				expected := twoWebhooksOperation(
+					db.WebhookStatus{WebhookID: webhookID, State: db.WebhookStateSuccess},
+					db.WebhookStatus{WebhookID: secondWebhookID, State: db.WebhookStateInProgress, PollURL: pollURL},
+				)
+				expected.NextAttemptAt = finishedAt
+				return claimedRepo(twoWebhooksOperation(db.WebhookStatus{WebhookID: webhookID, State: db.WebhookStateSuccess}), expected)()
+			},
+			Webhooks: []*graphql.Webhook{fixWebhook(webhookID, graphql.WebhookModeSync), fixWebhook(secondWebhookID, graphql.WebhookModeAsync)},
+			WebhookClientFn: func() *automock.WebhookClient {
+				client := &automock.WebhookClient{}
+				client.On("Do", mock.Anything, mock.MatchedBy(func(request *webhook_client.Request) bool {
+					return request.Webhook.ID == secondWebhookID
+				})).Return(&web_hook.Response{Location: str(pollURL)}, nil).Once()
+				return client
+			},
+		},
+		{
+			Name:            "when parallel webhooks are executed it should finish the operation only after all webhooks complete",
+			ExecutionPolicy: db.ExecutionPolicyParallel,
+			TransactFn:      transactions(4, 4),
+			RepoFn: func() *automock.OperationRepository {
+				expected := twoWebhooksOperation(
+					db.WebhookStatus{WebhookID: webhookID, State: db.WebhookStateFailed},
+					db.WebhookStatus{WebhookID: secondWebhookID, State: db.WebhookStateSuccess},
+				)
+				expected.Status = operation.OperationStatusFailed
+				expected.Error = str(fmt.Sprintf("webhooks with IDs %s have failed: %s", webhookID, operationErr))
+				expected.FinishedAt = &finishedAt
+				return claimedRepo(twoWebhooksOperation(db.WebhookStatus{WebhookID: webhookID, State: db.WebhookStateFailed}), expected)()
+			},
+			Webhooks: []*graphql.Webhook{fixWebhook(webhookID, graphql.WebhookModeSync), fixWebhook(secondWebhookID, graphql.WebhookModeSync)},
+			WebhookClientFn: func() *automock.WebhookClient {
+				client := &automock.WebhookClient{}
+				client.On("Do", mock.Anything, mock.MatchedBy(func(request *webhook_client.Request) bool {
+					return request.Webhook.ID == secondWebhookID
+				})).Return(&web_hook.Response{}, nil).Once()
+				return client
+			},
+			FinisherFn: func() *automock.OperationFinisher {
+				finisher := &automock.OperationFinisher{}
+				finisher.On("Finish", txtest.CtxWithDBMatcher(), mock.Anything).Return(nil).Once()
+				return finisher
+			},
+		},
+		{
+			Name:            "when parallel webhooks are executed it should execute them concurrently",
+			ExecutionPolicy: db.ExecutionPolicyParallel,
+			TransactFn:      transactions(4, 4),
+			RepoFn: func() *automock.OperationRepository {
+				expected := twoWebhooksOperation(
+					db.WebhookStatus{WebhookID: webhookID, State: db.WebhookStateSuccess},
+					db.WebhookStatus{WebhookID: secondWebhookID, State: db.WebhookStateSuccess},
+				)
+				expected.Status = operation.OperationStatusSucceeded
+				expected.FinishedAt = &finishedAt
+				return claimedRepo(twoWebhooksOperation(), expected)()
+			},
+			Webhooks: []*graphql.Webhook{fixWebhook(webhookID, graphql.WebhookModeSync), fixWebhook(secondWebhookID, graphql.WebhookModeSync)},
+			WebhookClientFn: func() *automock.WebhookClient {
+				// each request waits for the other one, so that they succeed only if they are made concurrently
+				var requests sync.WaitGroup
+				requests.Add(2)
+				allRequestsMade := make(chan struct{})
+				go func() {
+					requests.Wait()
+					close(allRequestsMade)
+				}()
+
+				client := &automock.WebhookClient{}
+				client.On("Do", mock.Anything, mock.Anything).Return(func(context.Context, *webhook_client.Request) *web_hook.Response {
+					requests.Done()
+					select {
+					case <-allRequestsMade:
+					case <-time.After(5 * time.Second):
+					}
+					return &web_hook.Response{}
+				}, func(context.Context, *webhook_client.Request) error {
+					select {
+					case <-allRequestsMade:
+						return nil
+					default:
+						return webhook_client.NewFatalError("webhooks were not executed concurrently")
+					}
+				}).Twice()
+				return client
+			},
+			FinisherFn: func() *automock.OperationFinisher {
+				finisher := &automock.OperationFinisher{}
+				finisher.On("Finish", txtest.CtxWithDBMatcher(), operationRequest("",
+					operation.WebhookResult{WebhookID: webhookID, State: "Success"},
+					operation.WebhookResult{WebhookID: secondWebhookID, State: "Success"})).Return(nil).Once()
+				return finisher
+			},
+		},
+		{
+			Name:       "when an async webhook responds without location it should finish the operation with error",
+			TransactFn: transactions(4, 4),
+			RepoFn:     claimedRepo(fixInProgressOperation(), failedOperation("missing location url after executing async webhook", db.WebhookStatus{WebhookID: webhookID, State: db.WebhookStateFailed})),
+			Webhooks:   []*graphql.Webhook{fixWebhook(webhookID, graphql.WebhookModeAsync)},
+			WebhookClientFn: func() *automock.WebhookClient {
+				client := &automock.WebhookClient{}
+				client.On("Do", mock.Anything, mock.Anything).Return(&web_hook.Response{}, nil).Once()
+				return client
+			},
+			FinisherFn: func() *automock.OperationFinisher {
+				finisher := &automock.OperationFinisher{}
+				finisher.On("Finish", txtest.CtxWithDBMatcher(), operationRequest("missing location url after executing async webhook", operation.WebhookResult{WebhookID: webhookID, State: "Failed"})).Return(nil).Once()
+				return finisher
+			},
+		},
+		{
+			Name:             "when the resource cannot be fetched it should postpone the operation",
+			TransactFn:       transactions(3, 2),
+			RepoFn:           postponedRepo(fixInProgressOperation()),
+			ResourceFetchErr: testErr,
+		},
+		{
+			Name:             "when the resource cannot be fetched after the operation timeout it should mark the operation as failed",
+			TransactFn:       transactions(3, 2),
+			ResourceFetchErr: testErr,
+			RepoFn: func() *automock.OperationRepository {
+				expected := timedOutOperation()
+				expected.Status = operation.OperationStatusFailed
+				expected.Error = str(fmt.Sprintf("unable to fetch application: %s", testErr))
+				expected.FinishedAt = &finishedAt
+				return claimedRepo(timedOutOperation(), expected)()
+			},
+		},
+		{
+			Name:       "when finishing the operation fails it should postpone the operation",
+			TransactFn: transactions(5, 4),
+			RepoFn:     postponedRepo(fixInProgressOperation()),
+			Webhooks:   []*graphql.Webhook{fixWebhook(webhookID, graphql.WebhookModeSync)},
+			WebhookClientFn: func() *automock.WebhookClient {
+				client := &automock.WebhookClient{}
+				client.On("Do", mock.Anything, mock.Anything).Return(&web_hook.Response{}, nil).Once()
+				return client
+			},
+			FinisherFn: func() *automock.OperationFinisher {
+				finisher := &automock.OperationFinisher{}
+				finisher.On("Finish", txtest.CtxWithDBMatcher(), mock.Anything).Return(testErr).Once()
+				return finisher
+			},
+		},
+		{
+			Name:       "when the lease on the operation has been lost it should discard the outcome",
+			TransactFn: transactions(4, 3),
+			RepoFn: func() *automock.OperationRepository {
+				repo := &automock.OperationRepository{}
+				repo.On("ClaimNextDueGlobal", txtest.CtxWithDBMatcher(), finishedAt, workerID, lockedUntil).Return(fixInProgressOperation(), nil).Once()
+				repo.On("UpdateLeasedGlobal", txtest.CtxWithDBMatcher(), succeededOperation(db.WebhookStatus{WebhookID: webhookID, State: db.WebhookStateSuccess}), workerID).Return(false, nil).Once()
+				return repo
+			},
+			Webhooks: []*graphql.Webhook{fixWebhook(webhookID, graphql.WebhookModeSync)},
+			WebhookClientFn: func() *automock.WebhookClient {
+				client := &automock.WebhookClient{}
+				client.On("Do", mock.Anything, mock.Anything).Return(&web_hook.Response{}, nil).Once()
+				return client
+			},
+			FinisherFn: func() *automock.OperationFinisher {
+				finisher := &automock.OperationFinisher{}
+				finisher.On("Finish", txtest.CtxWithDBMatcher(), mock.Anything).Return(nil).Once()
+				return finisher
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			ctx := context.TODO()
+			persistTx, transact := testCase.TransactFn()
+			repo := testCase.RepoFn()
+
+			webhookClient := &automock.WebhookClient{}
+			if testCase.WebhookClientFn != nil {
+				webhookClient = testCase.WebhookClientFn()
+			}
+			finisher := &automock.OperationFinisher{}
+			if testCase.FinisherFn != nil {
+				finisher = testCase.FinisherFn()
+			}
+
+			resourceFetcherFuncs := map[resource.Type]operation.ResourceFetcherFunc{
+				resource.Application: func(ctx context.Context, tenant, id string) (model.Entity, error) {
+					require.Equal(t, tenantID, tenant)
+					require.Equal(t, resourceID, id)
+					if testCase.ResourceFetchErr != nil {
+						return nil, testCase.ResourceFetchErr
+					}
+					return fixApplication(testCase.ResourceReady), nil
+				},
+			}
+			webhookConverter := &automock.WebhookConverter{}
+			modelWebhooks := make([]*model.Webhook, 0, len(testCase.Webhooks))
+			for _, webhook := range testCase.Webhooks {
+				modelWebhook := fixModelWebhook(webhook.ID)
+				webhookConverter.On("ToGraphQL", modelWebhook).Return(webhook, nil).Once()
+				modelWebhooks = append(modelWebhooks, modelWebhook)
+			}
+			webhookFetcherFuncs := map[resource.Type]operation.WebhookFetcherFunc{
+				resource.Application: func(ctx context.Context, id string) ([]*model.Webhook, error) {
+					require.Equal(t, resourceID, id)
+					return modelWebhooks, nil
+				},
+			}
+
+			workerCfg := cfg
+			if testCase.ExecutionPolicy != "" {
+				workerCfg.ExecutionPolicy = testCase.ExecutionPolicy
+			}
+
+			worker := db.NewWorker(workerCfg, transact, repo, resourceFetcherFuncs, webhookFetcherFuncs, webhookConverter, finisher, fixUIDService(), nil)
+			worker.SetTimestampGen(func() time.Time { return finishedAt })
+			worker.SetWebhookClient(webhookClient)
+
+			// WHEN
+			err := worker.Process(ctx)
+
+			// THEN
+			if testCase.ExpectedErrorMsg != "" {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), testCase.ExpectedErrorMsg)
+			} else {
+				require.NoError(t, err)
+			}
+
+			mock.AssertExpectationsForObjects(t, persistTx, transact, repo, webhookClient, webhookConverter, finisher)
+		})
+	}
+}
+
+func TestWorker_Process_DeletesFinishedOperations(t *testing.T) {
+	ctx := context.TODO()
+	testErr := errors.New("test error")
+	retention := 24 * time.Hour
+
+	t.Run("success", func(t *testing.T) {
+		persistTx := &persistenceautomock.PersistenceTx{}
+		persistTx.On("Commit").Return(nil).Once()
+
+		transact := &persistenceautomock.Transactioner{}
+		transact.On("Begin").Return(persistTx, nil).Twice()
+		transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return().Twice()
+
+		repo := &automock.OperationRepository{}
+		repo.On("DeleteFinishedBeforeGlobal", txtest.CtxWithDBMatcher(), finishedAt.Add(-retention)).Return(nil).Once()
+		repo.On("ClaimNextDueGlobal", txtest.CtxWithDBMatcher(), finishedAt, workerID, finishedAt).Return(nil, apperrors.NewNotFoundErrorWithType(resource.ScheduledOperation)).Once()
+
+		worker := db.NewWorker(db.Config{BatchSize: 1, Retention: retention}, transact, repo, nil, nil, &automock.WebhookConverter{}, &automock.OperationFinisher{}, fixUIDService(), nil)
+		worker.SetTimestampGen(func() time.Time { return finishedAt })
+
+		// WHEN
+		err := worker.Process(ctx)
+
+		// THEN
+		require.NoError(t, err)
+		mock.AssertExpectationsForObjects(t, persistTx, transact, repo)
+	})
+
+	t.Run("continues processing when deleting fails", func(t *testing.T) {
+		persistTx := &persistenceautomock.PersistenceTx{}
+
+		transact := &persistenceautomock.Transactioner{}
+		transact.On("Begin").Return(persistTx, nil).Twice()
+		transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return().Twice()
+
+		repo := &automock.OperationRepository{}
+		repo.On("DeleteFinishedBeforeGlobal", txtest.CtxWithDBMatcher(), finishedAt.Add(-retention)).Return(testErr).Once()
+		repo.On("ClaimNextDueGlobal", txtest.CtxWithDBMatcher(), finishedAt, workerID, finishedAt).Return(nil, apperrors.NewNotFoundErrorWithType(resource.ScheduledOperation)).Once()
+
+		worker := db.NewWorker(db.Config{BatchSize: 1, Retention: retention}, transact, repo, nil, nil, &automock.WebhookConverter{}, &automock.OperationFinisher{}, fixUIDService(), nil)
+		worker.SetTimestampGen(func() time.Time { return finishedAt })
+
+		// WHEN
+		err := worker.Process(ctx)
+
+		// THEN
+		require.NoError(t, err)
+		mock.AssertExpectationsForObjects(t, persistTx, transact, repo)
+	})
+}
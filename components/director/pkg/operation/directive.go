@@ -43,27 +43,27 @@ import (
 
 const ModeParam = "mode"
 
-// ResourceFetcherFunc defines a function which fetches the webhooks for a specific resource ID
+// WebhookFetcherFunc defines a function which fetches the webhooks for a specific resource ID
 type WebhookFetcherFunc func(ctx context.Context, resourceID string) ([]*model.Webhook, error)
 
 type directive struct {
-	transact            persistence.Transactioner
-	webhookFetcherFunc  WebhookFetcherFunc
-	resourceFetcherFunc ResourceFetcherFunc
-	resourceUpdaterFunc ResourceUpdaterFunc
-	tenantLoaderFunc    TenantLoaderFunc
-	scheduler           Scheduler
+	transact             persistence.Transactioner
+	webhookFetcherFuncs  map[resource.Type]WebhookFetcherFunc
+	resourceFetcherFuncs map[resource.Type]ResourceFetcherFunc
+	resourceUpdaterFuncs map[resource.Type]ResourceUpdaterFunc
+	tenantLoaderFunc     TenantLoaderFunc
+	scheduler            Scheduler
 }
 
 // NewDirective creates a new handler struct responsible for the Async directive business logic
-func NewDirective(transact persistence.Transactioner, webhookFetcherFunc WebhookFetcherFunc, resourceFetcherFunc ResourceFetcherFunc, resourceUpdaterFunc ResourceUpdaterFunc, tenantLoaderFunc TenantLoaderFunc, scheduler Scheduler) *directive {
+func NewDirective(transact persistence.Transactioner, webhookFetcherFuncs map[resource.Type]WebhookFetcherFunc, resourceFetcherFuncs map[resource.Type]ResourceFetcherFunc, resourceUpdaterFuncs map[resource.Type]ResourceUpdaterFunc, tenantLoaderFunc TenantLoaderFunc, scheduler Scheduler) *directive {
 	return &directive{
-		transact:            transact,
-		webhookFetcherFunc:  webhookFetcherFunc,
-		resourceFetcherFunc: resourceFetcherFunc,
-		resourceUpdaterFunc: resourceUpdaterFunc,
-		tenantLoaderFunc:    tenantLoaderFunc,
-		scheduler:           scheduler,
+		transact:             transact,
+		webhookFetcherFuncs:  webhookFetcherFuncs,
+		resourceFetcherFuncs: resourceFetcherFuncs,
+		resourceUpdaterFuncs: resourceUpdaterFuncs,
+		tenantLoaderFunc:     tenantLoaderFunc,
+		scheduler:            scheduler,
 	}
 }
 
@@ -129,7 +129,13 @@ func (d *directive) HandleOperation(ctx context.Context, _ interface{}, next gql
 		return nil, err
 	}
 
-	if err := d.resourceUpdaterFunc(ctx, entity.GetID(), false, nil, *appConditionStatus); err != nil {
+	resourceUpdaterFunc, ok := d.resourceUpdaterFuncs[entity.GetType()]
+	if !ok {
+		log.C(ctx).Errorf("No resource updater registered for resource type %s", entity.GetType())
+		return nil, apperrors.NewInternalError("Failed to process operation")
+	}
+
+	if err := resourceUpdaterFunc(ctx, entity.GetID(), false, nil, *appConditionStatus); err != nil {
 		log.C(ctx).WithError(err).Errorf("While updating resource %s with id %s and status condition %v", entity.GetType(), entity.GetID(), appConditionStatus)
 		return nil, apperrors.NewInternalError("Unable to update resource %s with id %s", entity.GetType(), entity.GetID())
 	}
@@ -147,7 +153,7 @@ func (d *directive) HandleOperation(ctx context.Context, _ interface{}, next gql
 		operation.WebhookIDs = webhookIDs
 	}
 
-	requestObject, err := d.prepareRequestObject(ctx, err, resp)
+	requestObject, err := d.prepareRequestObject(ctx, err, entity)
 	if err != nil {
 		log.C(ctx).WithError(err).Errorf("An error occurred while preparing request data: %s", err.Error())
 		return nil, apperrors.NewInternalError("Unable to prepare webhook request data")
@@ -187,12 +193,22 @@ func (d *directive) concurrencyCheck(ctx context.Context, op graphql.OperationTy
 		return apperrors.NewInternalError(fmt.Sprintf("could not get idField: %q from request context", *idField))
 	}
 
+	resourceType, err := getResourceType(resCtx)
+	if err != nil {
+		return err
+	}
+
+	resourceFetcherFunc, ok := d.resourceFetcherFuncs[resourceType]
+	if !ok {
+		return apperrors.NewInternalError(fmt.Sprintf("no resource fetcher registered for resource type %s", resourceType))
+	}
+
 	tenant, err := d.tenantLoaderFunc(ctx)
 	if err != nil {
 		return apperrors.NewTenantRequiredError()
 	}
 
-	app, err := d.resourceFetcherFunc(ctx, tenant, resourceID)
+	app, err := resourceFetcherFunc(ctx, tenant, resourceID)
 	if err != nil {
 		if apperrors.IsNotFoundError(err) {
 			return err
@@ -215,13 +231,13 @@ func (d *directive) concurrencyCheck(ctx context.Context, op graphql.OperationTy
 	return nil
 }
 
-func (d *directive) prepareRequestObject(ctx context.Context, err error, res interface{}) (string, error) {
+func (d *directive) prepareRequestObject(ctx context.Context, err error, entity graphql.Entity) (string, error) {
 	tenantID, err := d.tenantLoaderFunc(ctx)
 	if err != nil {
 		return "", errors.Wrap(err, "failed to retrieve tenant from request")
 	}
 
-	resource, ok := res.(webhook.Resource)
+	res, ok := entity.(webhook.Resource)
 	if !ok {
 		return "", errors.New("entity is not a webhook provider")
 	}
@@ -237,9 +253,17 @@ func (d *directive) prepareRequestObject(ctx context.Context, err error, res int
 	}
 
 	requestObject := &webhook.RequestObject{
-		Application: resource,
-		TenantID:    tenantID,
-		Headers:     headers,
+		TenantID: tenantID,
+		Headers:  headers,
+	}
+
+	switch entity.GetType() {
+	case resource.Application:
+		requestObject.Application = res
+	case resource.Runtime:
+		requestObject.Runtime = res
+	default:
+		return "", errors.Errorf("unsupported resource type %s", entity.GetType())
 	}
 
 	data, err := json.Marshal(requestObject)
@@ -251,7 +275,12 @@ func (d *directive) prepareRequestObject(ctx context.Context, err error, res int
 }
 
 func (d *directive) prepareWebhookIDs(ctx context.Context, err error, operation *Operation, webhookType graphql.WebhookType) ([]string, error) {
-	webhooks, err := d.webhookFetcherFunc(ctx, operation.ResourceID)
+	webhookFetcherFunc, ok := d.webhookFetcherFuncs[operation.ResourceType]
+	if !ok {
+		return nil, errors.Errorf("no webhook fetcher registered for resource type %s", operation.ResourceType)
+	}
+
+	webhooks, err := webhookFetcherFunc(ctx, operation.ResourceID)
 	if err != nil {
 		return nil, err
 	}
@@ -285,6 +314,21 @@ func getOperationMode(resCtx *gqlgen.ResolverContext) (*graphql.OperationMode, e
 	return &mode, nil
 }
 
+func getResourceType(resCtx *gqlgen.ResolverContext) (resource.Type, error) {
+	if resCtx.Field.Definition == nil || resCtx.Field.Definition.Type == nil {
+		return "", apperrors.NewInternalError("could not determine the resource type of the operation")
+	}
+
+	switch typeName := resCtx.Field.Definition.Type.Name(); typeName {
+	case "Application":
+		return resource.Application, nil
+	case "Runtime":
+		return resource.Runtime, nil
+	default:
+		return "", apperrors.NewInternalError(fmt.Sprintf("unsupported resource type %s for async operation", typeName))
+	}
+}
+
 func executeSyncOperation(ctx context.Context, next gqlgen.Resolver, tx persistence.PersistenceTx) (interface{}, error) {
 	resp, err := next(ctx)
 	if err != nil {
@@ -53,6 +53,7 @@ var (
 	resourceIdField             = "id"
 	whTypeApplicationRegister   = graphql.WebhookTypeRegisterApplication
 	whTypeApplicationUnregister = graphql.WebhookTypeUnregisterApplication
+	whTypeRuntimeRegister       = graphql.WebhookTypeRegisterRuntime
 
 	mockedHeaders = http.Header{
 		"key": []string{"value"},
@@ -199,7 +200,8 @@ func TestHandleOperation(t *testing.T) {
 			Object: "RegisterApplication",
 			Field: gqlgen.CollectedField{
 				Field: &ast.Field{
-					Name: "registerApplication",
+					Name:       "registerApplication",
+					Definition: &ast.FieldDefinition{Type: ast.NamedType("Application", nil)},
 				},
 			},
 			Args:     map[string]interface{}{operation.ModeParam: &operationMode},
@@ -234,7 +236,8 @@ func TestHandleOperation(t *testing.T) {
 			Object: "RegisterApplication",
 			Field: gqlgen.CollectedField{
 				Field: &ast.Field{
-					Name: "registerApplication",
+					Name:       "registerApplication",
+					Definition: &ast.FieldDefinition{Type: ast.NamedType("Application", nil)},
 				},
 			},
 			Args:     map[string]interface{}{operation.ModeParam: &operationMode},
@@ -269,7 +272,8 @@ func TestHandleOperation(t *testing.T) {
 			Object: "RegisterApplication",
 			Field: gqlgen.CollectedField{
 				Field: &ast.Field{
-					Name: "registerApplication",
+					Name:       "registerApplication",
+					Definition: &ast.FieldDefinition{Type: ast.NamedType("Application", nil)},
 				},
 			},
 			Args:     map[string]interface{}{operation.ModeParam: &operationMode},
@@ -281,9 +285,9 @@ func TestHandleOperation(t *testing.T) {
 		defer mockedTx.AssertExpectations(t)
 		defer mockedTransactioner.AssertExpectations(t)
 
-		directive := operation.NewDirective(mockedTransactioner, func(_ context.Context, _ string) ([]*model.Webhook, error) {
+		directive := operation.NewDirective(mockedTransactioner, map[resource.Type]operation.WebhookFetcherFunc{resource.Application: func(_ context.Context, _ string) ([]*model.Webhook, error) {
 			return nil, mockedError()
-		}, nil, nil, nil, nil)
+		}}, nil, nil, nil, nil)
 
 		dummyResolver := &dummyResolver{}
 
@@ -306,7 +310,8 @@ func TestHandleOperation(t *testing.T) {
 			Object: "RegisterApplication",
 			Field: gqlgen.CollectedField{
 				Field: &ast.Field{
-					Name: "registerApplication",
+					Name:       "registerApplication",
+					Definition: &ast.FieldDefinition{Type: ast.NamedType("Application", nil)},
 				},
 			},
 			Args:     map[string]interface{}{operation.ModeParam: &operationMode},
@@ -318,7 +323,7 @@ func TestHandleOperation(t *testing.T) {
 		defer mockedTx.AssertExpectations(t)
 		defer mockedTransactioner.AssertExpectations(t)
 
-		directive := operation.NewDirective(mockedTransactioner, mockedWebhooksResponse, nil, mockedEmptyResourceUpdaterFunc, func(_ context.Context) (string, error) {
+		directive := operation.NewDirective(mockedTransactioner, map[resource.Type]operation.WebhookFetcherFunc{resource.Application: mockedWebhooksResponse}, nil, map[resource.Type]operation.ResourceUpdaterFunc{resource.Application: mockedEmptyResourceUpdaterFunc}, func(_ context.Context) (string, error) {
 			return "", mockedError()
 		}, nil)
 
@@ -343,7 +348,8 @@ func TestHandleOperation(t *testing.T) {
 			Object: "RegisterApplication",
 			Field: gqlgen.CollectedField{
 				Field: &ast.Field{
-					Name: "registerApplication",
+					Name:       "registerApplication",
+					Definition: &ast.FieldDefinition{Type: ast.NamedType("Application", nil)},
 				},
 			},
 			Args:     map[string]interface{}{operation.ModeParam: &operationMode},
@@ -355,7 +361,7 @@ func TestHandleOperation(t *testing.T) {
 		defer mockedTx.AssertExpectations(t)
 		defer mockedTransactioner.AssertExpectations(t)
 
-		directive := operation.NewDirective(mockedTransactioner, mockedWebhooksResponse, nil, mockedEmptyResourceUpdaterFunc, mockedTenantLoaderFunc, nil)
+		directive := operation.NewDirective(mockedTransactioner, map[resource.Type]operation.WebhookFetcherFunc{resource.Bundle: mockedWebhooksResponse}, nil, map[resource.Type]operation.ResourceUpdaterFunc{resource.Bundle: mockedEmptyResourceUpdaterFunc}, mockedTenantLoaderFunc, nil)
 
 		dummyResolver := &dummyResolver{}
 
@@ -378,7 +384,8 @@ func TestHandleOperation(t *testing.T) {
 			Object: "RegisterApplication",
 			Field: gqlgen.CollectedField{
 				Field: &ast.Field{
-					Name: "registerApplication",
+					Name:       "registerApplication",
+					Definition: &ast.FieldDefinition{Type: ast.NamedType("Application", nil)},
 				},
 			},
 			Args:     map[string]interface{}{operation.ModeParam: &operationMode},
@@ -390,7 +397,7 @@ func TestHandleOperation(t *testing.T) {
 		defer mockedTx.AssertExpectations(t)
 		defer mockedTransactioner.AssertExpectations(t)
 
-		directive := operation.NewDirective(mockedTransactioner, mockedWebhooksResponse, nil, mockedEmptyResourceUpdaterFunc, mockedTenantLoaderFunc, nil)
+		directive := operation.NewDirective(mockedTransactioner, map[resource.Type]operation.WebhookFetcherFunc{resource.Application: mockedWebhooksResponse}, nil, map[resource.Type]operation.ResourceUpdaterFunc{resource.Application: mockedEmptyResourceUpdaterFunc}, mockedTenantLoaderFunc, nil)
 
 		dummyResolver := &dummyResolver{}
 
@@ -413,7 +420,8 @@ func TestHandleOperation(t *testing.T) {
 			Object: "RegisterApplication",
 			Field: gqlgen.CollectedField{
 				Field: &ast.Field{
-					Name: "registerApplication",
+					Name:       "registerApplication",
+					Definition: &ast.FieldDefinition{Type: ast.NamedType("Application", nil)},
 				},
 			},
 			Args:     map[string]interface{}{operation.ModeParam: &operationMode},
@@ -425,9 +433,9 @@ func TestHandleOperation(t *testing.T) {
 		defer mockedTx.AssertExpectations(t)
 		defer mockedTransactioner.AssertExpectations(t)
 
-		directive := operation.NewDirective(mockedTransactioner, func(_ context.Context, _ string) ([]*model.Webhook, error) {
+		directive := operation.NewDirective(mockedTransactioner, map[resource.Type]operation.WebhookFetcherFunc{resource.Application: func(_ context.Context, _ string) ([]*model.Webhook, error) {
 			return nil, mockedError()
-		}, nil, mockedEmptyResourceUpdaterFunc, mockedTenantLoaderFunc, nil)
+		}}, nil, map[resource.Type]operation.ResourceUpdaterFunc{resource.Application: mockedEmptyResourceUpdaterFunc}, mockedTenantLoaderFunc, nil)
 
 		dummyResolver := &dummyResolver{}
 
@@ -447,7 +455,8 @@ func TestHandleOperation(t *testing.T) {
 			Object: "RegisterApplication",
 			Field: gqlgen.CollectedField{
 				Field: &ast.Field{
-					Name: "registerApplication",
+					Name:       "registerApplication",
+					Definition: &ast.FieldDefinition{Type: ast.NamedType("Application", nil)},
 				},
 			},
 			Args:     map[string]interface{}{operation.ModeParam: &operationMode},
@@ -459,13 +468,13 @@ func TestHandleOperation(t *testing.T) {
 		defer mockedTx.AssertExpectations(t)
 		defer mockedTransactioner.AssertExpectations(t)
 
-		directive := operation.NewDirective(mockedTransactioner, func(_ context.Context, _ string) ([]*model.Webhook, error) {
+		directive := operation.NewDirective(mockedTransactioner, map[resource.Type]operation.WebhookFetcherFunc{resource.Application: func(_ context.Context, _ string) ([]*model.Webhook, error) {
 			return []*model.Webhook{
 				{ID: webhookID1, Type: model.WebhookTypeRegisterApplication},
 				{ID: webhookID2, Type: model.WebhookTypeRegisterApplication},
 				{ID: webhookID3, Type: model.WebhookTypeRegisterApplication},
 			}, nil
-		}, nil, mockedEmptyResourceUpdaterFunc, mockedTenantLoaderFunc, nil)
+		}}, nil, map[resource.Type]operation.ResourceUpdaterFunc{resource.Application: mockedEmptyResourceUpdaterFunc}, mockedTenantLoaderFunc, nil)
 
 		dummyResolver := &dummyResolver{}
 
@@ -485,7 +494,8 @@ func TestHandleOperation(t *testing.T) {
 			Object: "RegisterApplication",
 			Field: gqlgen.CollectedField{
 				Field: &ast.Field{
-					Name: "registerApplication",
+					Name:       "registerApplication",
+					Definition: &ast.FieldDefinition{Type: ast.NamedType("Application", nil)},
 				},
 			},
 			Args:     map[string]interface{}{operation.ModeParam: &operationMode},
@@ -501,7 +511,7 @@ func TestHandleOperation(t *testing.T) {
 		mockedScheduler.On("Schedule", mock.Anything, mock.Anything).Return("", mockedError())
 		defer mockedScheduler.AssertExpectations(t)
 
-		directive := operation.NewDirective(mockedTransactioner, mockedWebhooksResponse, nil, mockedEmptyResourceUpdaterFunc, mockedTenantLoaderFunc, mockedScheduler)
+		directive := operation.NewDirective(mockedTransactioner, map[resource.Type]operation.WebhookFetcherFunc{resource.Application: mockedWebhooksResponse}, nil, map[resource.Type]operation.ResourceUpdaterFunc{resource.Application: mockedEmptyResourceUpdaterFunc}, mockedTenantLoaderFunc, mockedScheduler)
 
 		dummyResolver := &dummyResolver{}
 
@@ -524,7 +534,8 @@ func TestHandleOperation(t *testing.T) {
 			Object: "RegisterApplication",
 			Field: gqlgen.CollectedField{
 				Field: &ast.Field{
-					Name: "registerApplication",
+					Name:       "registerApplication",
+					Definition: &ast.FieldDefinition{Type: ast.NamedType("Application", nil)},
 				},
 			},
 			Args:     map[string]interface{}{operation.ModeParam: &operationMode},
@@ -542,7 +553,7 @@ func TestHandleOperation(t *testing.T) {
 		mockedScheduler.On("Schedule", mock.Anything, mock.Anything).Return(testID, nil)
 		defer mockedScheduler.AssertExpectations(t)
 
-		directive := operation.NewDirective(mockedTransactioner, mockedWebhooksResponse, nil, mockedEmptyResourceUpdaterFunc, mockedTenantLoaderFunc, mockedScheduler)
+		directive := operation.NewDirective(mockedTransactioner, map[resource.Type]operation.WebhookFetcherFunc{resource.Application: mockedWebhooksResponse}, nil, map[resource.Type]operation.ResourceUpdaterFunc{resource.Application: mockedEmptyResourceUpdaterFunc}, mockedTenantLoaderFunc, mockedScheduler)
 
 		dummyResolver := &dummyResolver{}
 
@@ -568,7 +579,8 @@ func TestHandleOperation(t *testing.T) {
 			Object: "RegisterApplication",
 			Field: gqlgen.CollectedField{
 				Field: &ast.Field{
-					Name: operationCategory,
+					Name:       operationCategory,
+					Definition: &ast.FieldDefinition{Type: ast.NamedType("Application", nil)},
 				},
 			},
 			Args:     map[string]interface{}{operation.ModeParam: &operationMode},
@@ -582,7 +594,7 @@ func TestHandleOperation(t *testing.T) {
 		defer mockedTransactioner.AssertExpectations(t)
 		dummyResolver := &dummyResolver{}
 		scheduler := &operation.DisabledScheduler{}
-		directive := operation.NewDirective(mockedTransactioner, mockedWebhooksResponse, nil, mockedEmptyResourceUpdaterFunc, mockedTenantLoaderFunc, scheduler)
+		directive := operation.NewDirective(mockedTransactioner, map[resource.Type]operation.WebhookFetcherFunc{resource.Application: mockedWebhooksResponse}, nil, map[resource.Type]operation.ResourceUpdaterFunc{resource.Application: mockedEmptyResourceUpdaterFunc}, mockedTenantLoaderFunc, scheduler)
 
 		// WHEN
 		_, err := directive.HandleOperation(ctx, nil, dummyResolver.SuccessResolve, operationType, nil, nil)
@@ -603,7 +615,8 @@ func TestHandleOperation(t *testing.T) {
 			Object: "RegisterApplication",
 			Field: gqlgen.CollectedField{
 				Field: &ast.Field{
-					Name: operationCategory,
+					Name:       operationCategory,
+					Definition: &ast.FieldDefinition{Type: ast.NamedType("Application", nil)},
 				},
 			},
 			Args:     map[string]interface{}{operation.ModeParam: &operationMode},
@@ -625,7 +638,7 @@ func TestHandleOperation(t *testing.T) {
 		mockedScheduler := &automock.Scheduler{}
 		defer mockedScheduler.AssertExpectations(t)
 
-		directive := operation.NewDirective(mockedTransactioner, errorWebhooksResponse, nil, mockedEmptyResourceUpdaterFunc, mockedTenantLoaderFunc, mockedScheduler)
+		directive := operation.NewDirective(mockedTransactioner, map[resource.Type]operation.WebhookFetcherFunc{resource.Application: errorWebhooksResponse}, nil, map[resource.Type]operation.ResourceUpdaterFunc{resource.Application: mockedEmptyResourceUpdaterFunc}, mockedTenantLoaderFunc, mockedScheduler)
 
 		// WHEN
 		_, err := directive.HandleOperation(ctx, nil, dummyResolver.SuccessResolve, operationType, &whTypeApplicationRegister, nil)
@@ -645,7 +658,8 @@ func TestHandleOperation(t *testing.T) {
 			Object: "RegisterApplication",
 			Field: gqlgen.CollectedField{
 				Field: &ast.Field{
-					Name: operationCategory,
+					Name:       operationCategory,
+					Definition: &ast.FieldDefinition{Type: ast.NamedType("Application", nil)},
 				},
 			},
 			Args:     map[string]interface{}{operation.ModeParam: &operationMode},
@@ -700,9 +714,9 @@ func TestHandleOperation(t *testing.T) {
 
 		for _, testCase := range testCases {
 			t.Run(testCase.Name, func(t *testing.T) {
-				directive := operation.NewDirective(mockedTransactioner, func(_ context.Context, _ string) ([]*model.Webhook, error) {
+				directive := operation.NewDirective(mockedTransactioner, map[resource.Type]operation.WebhookFetcherFunc{resource.Application: func(_ context.Context, _ string) ([]*model.Webhook, error) {
 					return testCase.Webhooks, nil
-				}, nil, mockedEmptyResourceUpdaterFunc, mockedTenantLoaderFunc, mockedScheduler)
+				}}, nil, map[resource.Type]operation.ResourceUpdaterFunc{resource.Application: mockedEmptyResourceUpdaterFunc}, mockedTenantLoaderFunc, mockedScheduler)
 
 				dummyResolver := &dummyResolver{}
 
@@ -754,7 +768,8 @@ func TestHandleOperation(t *testing.T) {
 			Object: "RegisterApplication",
 			Field: gqlgen.CollectedField{
 				Field: &ast.Field{
-					Name: "registerApplication",
+					Name:       "registerApplication",
+					Definition: &ast.FieldDefinition{Type: ast.NamedType("Application", nil)},
 				},
 			},
 			Args:     map[string]interface{}{operation.ModeParam: &operationMode},
@@ -766,7 +781,7 @@ func TestHandleOperation(t *testing.T) {
 		defer mockedTx.AssertExpectations(t)
 		defer mockedTransactioner.AssertExpectations(t)
 
-		directive := operation.NewDirective(mockedTransactioner, mockedWebhooksResponse, nil, mockedResourceUpdaterFuncWithError, mockedTenantLoaderFunc, nil)
+		directive := operation.NewDirective(mockedTransactioner, map[resource.Type]operation.WebhookFetcherFunc{resource.Application: mockedWebhooksResponse}, nil, map[resource.Type]operation.ResourceUpdaterFunc{resource.Application: mockedResourceUpdaterFuncWithError}, mockedTenantLoaderFunc, nil)
 
 		dummyResolver := &dummyResolver{}
 
@@ -791,7 +806,8 @@ func TestHandleOperation(t *testing.T) {
 			Object: "RegisterApplication",
 			Field: gqlgen.CollectedField{
 				Field: &ast.Field{
-					Name: operationCategory,
+					Name:       operationCategory,
+					Definition: &ast.FieldDefinition{Type: ast.NamedType("Application", nil)},
 				},
 			},
 			Args:     map[string]interface{}{operation.ModeParam: &operationMode, resourceIdField: resourceID},
@@ -809,14 +825,14 @@ func TestHandleOperation(t *testing.T) {
 		defer mockedTx.AssertExpectations(t)
 		defer mockedTransactioner.AssertExpectations(t)
 
-		directive := operation.NewDirective(mockedTransactioner, mockedWebhooksResponse, mockedResourceFetcherFunc, func(ctx context.Context, id string, ready bool, errorMsg *string, appStatusCondition model.ApplicationStatusCondition) error {
+		directive := operation.NewDirective(mockedTransactioner, map[resource.Type]operation.WebhookFetcherFunc{resource.Application: mockedWebhooksResponse}, map[resource.Type]operation.ResourceFetcherFunc{resource.Application: mockedResourceFetcherFunc}, map[resource.Type]operation.ResourceUpdaterFunc{resource.Application: func(ctx context.Context, id string, ready bool, errorMsg *string, appStatusCondition model.ApplicationStatusCondition) error {
 			require.NotNil(t, ctx)
 			require.Equal(t, resourceID, id)
 			require.Equal(t, false, ready)
 			require.Nil(t, errorMsg)
 			require.Equal(t, model.ApplicationStatusConditionCreating, appStatusCondition)
 			return nil
-		}, mockedTenantLoaderFunc, mockedScheduler)
+		}}, mockedTenantLoaderFunc, mockedScheduler)
 
 		dummyResolver := &dummyResolver{}
 
@@ -858,6 +874,85 @@ func TestHandleOperation(t *testing.T) {
 		require.Equal(t, webhookID1, op.WebhookIDs[0])
 	})
 
+	t.Run("when mutation is in ASYNC mode and the resource is a runtime it should schedule a runtime operation", func(t *testing.T) {
+		// GIVEN
+		ctx := context.Background()
+		operationMode := graphql.OperationModeAsync
+		operationCategory := "registerRuntime"
+		rCtx := &gqlgen.FieldContext{
+			Object: "RegisterRuntime",
+			Field: gqlgen.CollectedField{
+				Field: &ast.Field{
+					Name:       operationCategory,
+					Definition: &ast.FieldDefinition{Type: ast.NamedType("Runtime", nil)},
+				},
+			},
+			Args:     map[string]interface{}{operation.ModeParam: &operationMode},
+			IsMethod: false,
+		}
+
+		ctx = gqlgen.WithFieldContext(ctx, rCtx)
+		ctx = context.WithValue(ctx, header.ContextKey, mockedHeaders)
+
+		mockedScheduler := &automock.Scheduler{}
+		mockedScheduler.On("Schedule", mock.Anything, mock.Anything).Return(operationID, nil)
+		defer mockedScheduler.AssertExpectations(t)
+
+		mockedTx, mockedTransactioner := txtest.NewTransactionContextGenerator(nil).ThatSucceeds()
+		defer mockedTx.AssertExpectations(t)
+		defer mockedTransactioner.AssertExpectations(t)
+
+		webhookFetchers := map[resource.Type]operation.WebhookFetcherFunc{
+			resource.Application: mockedWebhooksResponse,
+			resource.Runtime: func(_ context.Context, id string) ([]*model.Webhook, error) {
+				require.Equal(t, resourceID, id)
+				return []*model.Webhook{{ID: webhookID2, Type: model.WebhookTypeRegisterRuntime}}, nil
+			},
+		}
+		resourceUpdaters := map[resource.Type]operation.ResourceUpdaterFunc{
+			resource.Application: mockedResourceUpdaterFuncWithError,
+			resource.Runtime: func(ctx context.Context, id string, ready bool, errorMsg *string, appStatusCondition model.ApplicationStatusCondition) error {
+				require.Equal(t, resourceID, id)
+				require.False(t, ready)
+				require.Equal(t, model.ApplicationStatusConditionCreating, appStatusCondition)
+				return nil
+			},
+		}
+		directive := operation.NewDirective(mockedTransactioner, webhookFetchers, nil, resourceUpdaters, mockedTenantLoaderFunc, mockedScheduler)
+
+		dummyResolver := &dummyResolver{}
+
+		// WHEN
+		res, err := directive.HandleOperation(ctx, nil, dummyResolver.RuntimeResolve, graphql.OperationTypeCreate, &whTypeRuntimeRegister, nil)
+
+		// THEN
+		require.NoError(t, err)
+		require.Equal(t, mockedRuntimeResponse(), res)
+
+		opsFromCtx := dummyResolver.finalCtx.Value(operation.OpCtxKey)
+		operations, ok := opsFromCtx.(*[]*operation.Operation)
+		require.True(t, ok)
+		require.Len(t, *operations, 1)
+
+		op := (*operations)[0]
+		require.Equal(t, resource.Runtime, op.ResourceType)
+		require.Equal(t, resourceID, op.ResourceID)
+		require.Equal(t, []string{webhookID2}, op.WebhookIDs)
+
+		headers := make(map[string]string, 0)
+		for key, value := range mockedHeaders {
+			headers[key] = value[0]
+		}
+
+		expectedObj, err := json.Marshal(&webhook.RequestObject{
+			Runtime:  mockedRuntimeResponse().(webhook.Resource),
+			TenantID: tenantID,
+			Headers:  headers,
+		})
+		require.NoError(t, err)
+		require.Equal(t, string(expectedObj), op.RequestObject)
+	})
+
 	t.Run("when mutation is in ASYNC mode, and no webhooks are provided operation should finish successfully and update application status to CREATING", func(t *testing.T) {
 		// GIVEN
 		ctx := context.Background()
@@ -867,7 +962,8 @@ func TestHandleOperation(t *testing.T) {
 			Object: "RegisterApplication",
 			Field: gqlgen.CollectedField{
 				Field: &ast.Field{
-					Name: operationCategory,
+					Name:       operationCategory,
+					Definition: &ast.FieldDefinition{Type: ast.NamedType("Application", nil)},
 				},
 			},
 			Args:     map[string]interface{}{operation.ModeParam: &operationMode, resourceIdField: resourceID},
@@ -885,14 +981,14 @@ func TestHandleOperation(t *testing.T) {
 		defer mockedTx.AssertExpectations(t)
 		defer mockedTransactioner.AssertExpectations(t)
 
-		directive := operation.NewDirective(mockedTransactioner, mockedEmptyWebhooksResponse, mockedResourceFetcherFunc, func(ctx context.Context, id string, ready bool, errorMsg *string, appStatusCondition model.ApplicationStatusCondition) error {
+		directive := operation.NewDirective(mockedTransactioner, map[resource.Type]operation.WebhookFetcherFunc{resource.Application: mockedEmptyWebhooksResponse}, map[resource.Type]operation.ResourceFetcherFunc{resource.Application: mockedResourceFetcherFunc}, map[resource.Type]operation.ResourceUpdaterFunc{resource.Application: func(ctx context.Context, id string, ready bool, errorMsg *string, appStatusCondition model.ApplicationStatusCondition) error {
 			require.NotNil(t, ctx)
 			require.Equal(t, resourceID, id)
 			require.Equal(t, false, ready)
 			require.Nil(t, errorMsg)
 			require.Equal(t, model.ApplicationStatusConditionCreating, appStatusCondition)
 			return nil
-		}, mockedTenantLoaderFunc, mockedScheduler)
+		}}, mockedTenantLoaderFunc, mockedScheduler)
 
 		dummyResolver := &dummyResolver{}
 
@@ -940,7 +1036,8 @@ func TestHandleOperation(t *testing.T) {
 			Object: "RegisterApplication",
 			Field: gqlgen.CollectedField{
 				Field: &ast.Field{
-					Name: operationCategory,
+					Name:       operationCategory,
+					Definition: &ast.FieldDefinition{Type: ast.NamedType("Application", nil)},
 				},
 			},
 			Args:     map[string]interface{}{operation.ModeParam: &operationMode, resourceIdField: resourceID},
@@ -958,14 +1055,14 @@ func TestHandleOperation(t *testing.T) {
 		defer mockedTx.AssertExpectations(t)
 		defer mockedTransactioner.AssertExpectations(t)
 
-		directive := operation.NewDirective(mockedTransactioner, mockedWebhooksResponse, mockedResourceFetcherFunc, func(ctx context.Context, id string, ready bool, errorMsg *string, appStatusCondition model.ApplicationStatusCondition) error {
+		directive := operation.NewDirective(mockedTransactioner, map[resource.Type]operation.WebhookFetcherFunc{resource.Application: mockedWebhooksResponse}, map[resource.Type]operation.ResourceFetcherFunc{resource.Application: mockedResourceFetcherFunc}, map[resource.Type]operation.ResourceUpdaterFunc{resource.Application: func(ctx context.Context, id string, ready bool, errorMsg *string, appStatusCondition model.ApplicationStatusCondition) error {
 			require.NotNil(t, ctx)
 			require.Equal(t, resourceID, id)
 			require.Equal(t, false, ready)
 			require.Nil(t, errorMsg)
 			require.Equal(t, model.ApplicationStatusConditionUpdating, appStatusCondition)
 			return nil
-		}, mockedTenantLoaderFunc, mockedScheduler)
+		}}, mockedTenantLoaderFunc, mockedScheduler)
 
 		dummyResolver := &dummyResolver{}
 
@@ -1016,7 +1113,8 @@ func TestHandleOperation(t *testing.T) {
 			Object: "RegisterApplication",
 			Field: gqlgen.CollectedField{
 				Field: &ast.Field{
-					Name: operationCategory,
+					Name:       operationCategory,
+					Definition: &ast.FieldDefinition{Type: ast.NamedType("Application", nil)},
 				},
 			},
 			Args:     map[string]interface{}{operation.ModeParam: &operationMode, resourceIdField: resourceID},
@@ -1034,14 +1132,14 @@ func TestHandleOperation(t *testing.T) {
 		defer mockedTx.AssertExpectations(t)
 		defer mockedTransactioner.AssertExpectations(t)
 
-		directive := operation.NewDirective(mockedTransactioner, mockedWebhooksResponse, mockedResourceFetcherFunc, func(ctx context.Context, id string, ready bool, errorMsg *string, appStatusCondition model.ApplicationStatusCondition) error {
+		directive := operation.NewDirective(mockedTransactioner, map[resource.Type]operation.WebhookFetcherFunc{resource.Application: mockedWebhooksResponse}, map[resource.Type]operation.ResourceFetcherFunc{resource.Application: mockedResourceFetcherFunc}, map[resource.Type]operation.ResourceUpdaterFunc{resource.Application: func(ctx context.Context, id string, ready bool, errorMsg *string, appStatusCondition model.ApplicationStatusCondition) error {
 			require.NotNil(t, ctx)
 			require.Equal(t, resourceID, id)
 			require.Equal(t, false, ready)
 			require.Nil(t, errorMsg)
 			require.Equal(t, model.ApplicationStatusConditionDeleting, appStatusCondition)
 			return nil
-		}, mockedTenantLoaderFunc, mockedScheduler)
+		}}, mockedTenantLoaderFunc, mockedScheduler)
 
 		dummyResolver := &dummyResolver{}
 
@@ -1092,7 +1190,8 @@ func TestHandleOperation(t *testing.T) {
 			Object: "RegisterApplication",
 			Field: gqlgen.CollectedField{
 				Field: &ast.Field{
-					Name: operationCategory,
+					Name:       operationCategory,
+					Definition: &ast.FieldDefinition{Type: ast.NamedType("Application", nil)},
 				},
 			},
 			Args:     map[string]interface{}{operation.ModeParam: &operationMode, resourceIdField: resourceID},
@@ -1110,14 +1209,14 @@ func TestHandleOperation(t *testing.T) {
 		defer mockedTx.AssertExpectations(t)
 		defer mockedTransactioner.AssertExpectations(t)
 
-		directive := operation.NewDirective(mockedTransactioner, mockedEmptyWebhooksResponse, mockedResourceFetcherFunc, func(ctx context.Context, id string, ready bool, errorMsg *string, appStatusCondition model.ApplicationStatusCondition) error {
+		directive := operation.NewDirective(mockedTransactioner, map[resource.Type]operation.WebhookFetcherFunc{resource.Application: mockedEmptyWebhooksResponse}, map[resource.Type]operation.ResourceFetcherFunc{resource.Application: mockedResourceFetcherFunc}, map[resource.Type]operation.ResourceUpdaterFunc{resource.Application: func(ctx context.Context, id string, ready bool, errorMsg *string, appStatusCondition model.ApplicationStatusCondition) error {
 			require.NotNil(t, ctx)
 			require.Equal(t, resourceID, id)
 			require.Equal(t, false, ready)
 			require.Nil(t, errorMsg)
 			require.Equal(t, model.ApplicationStatusConditionDeleting, appStatusCondition)
 			return nil
-		}, mockedTenantLoaderFunc, mockedScheduler)
+		}}, mockedTenantLoaderFunc, mockedScheduler)
 
 		dummyResolver := &dummyResolver{}
 
@@ -1165,7 +1264,8 @@ func TestHandleOperation(t *testing.T) {
 			Object: "RegisterApplication",
 			Field: gqlgen.CollectedField{
 				Field: &ast.Field{
-					Name: operationCategory,
+					Name:       operationCategory,
+					Definition: &ast.FieldDefinition{Type: ast.NamedType("Application", nil)},
 				},
 			},
 			Args:     map[string]interface{}{operation.ModeParam: &operationMode, resourceIdField: resourceID},
@@ -1178,7 +1278,7 @@ func TestHandleOperation(t *testing.T) {
 		defer mockedTx.AssertExpectations(t)
 		defer mockedTransactioner.AssertExpectations(t)
 
-		directive := operation.NewDirective(mockedTransactioner, mockedWebhooksResponse, mockedResourceFetcherFunc, mockedEmptyResourceUpdaterFunc, mockedTenantLoaderFunc, nil)
+		directive := operation.NewDirective(mockedTransactioner, map[resource.Type]operation.WebhookFetcherFunc{resource.Application: mockedWebhooksResponse}, map[resource.Type]operation.ResourceFetcherFunc{resource.Application: mockedResourceFetcherFunc}, map[resource.Type]operation.ResourceUpdaterFunc{resource.Application: mockedEmptyResourceUpdaterFunc}, mockedTenantLoaderFunc, nil)
 
 		dummyResolver := &dummyResolver{}
 
@@ -1344,7 +1444,8 @@ func TestHandleOperation_ConcurrencyCheck(t *testing.T) {
 				Object: test.mutation,
 				Field: gqlgen.CollectedField{
 					Field: &ast.Field{
-						Name: test.mutation,
+						Name:       test.mutation,
+						Definition: &ast.FieldDefinition{Type: ast.NamedType("Application", nil)},
 					},
 				},
 				Args:     test.resolverCtxArgs,
@@ -1361,9 +1462,9 @@ func TestHandleOperation_ConcurrencyCheck(t *testing.T) {
 				defer test.scheduler.AssertExpectations(t)
 			}
 
-			directive := operation.NewDirective(mockedTransactioner, func(ctx context.Context, resourceID string) ([]*model.Webhook, error) {
+			directive := operation.NewDirective(mockedTransactioner, map[resource.Type]operation.WebhookFetcherFunc{resource.Application: func(ctx context.Context, resourceID string) ([]*model.Webhook, error) {
 				return nil, nil
-			}, test.resourceFetcherFunc, mockedEmptyResourceUpdaterFunc, test.tenantLoaderFunc, test.scheduler)
+			}}, map[resource.Type]operation.ResourceFetcherFunc{resource.Application: test.resourceFetcherFunc}, map[resource.Type]operation.ResourceUpdaterFunc{resource.Application: mockedEmptyResourceUpdaterFunc}, test.tenantLoaderFunc, test.scheduler)
 
 			// WHEN
 			res, err := directive.HandleOperation(ctx, nil, test.resolverFunc, graphql.OperationTypeDelete, nil, &resourceIdField)
@@ -1372,6 +1473,35 @@ func TestHandleOperation_ConcurrencyCheck(t *testing.T) {
 		})
 	}
 
+	t.Run("when the mutation does not return a supported resource it should roll-back", func(t *testing.T) {
+		// GIVEN
+		ctx := context.Background()
+		rCtx := &gqlgen.FieldContext{
+			Object: "DeleteBundle",
+			Field: gqlgen.CollectedField{
+				Field: &ast.Field{
+					Name:       "deleteBundle",
+					Definition: &ast.FieldDefinition{Type: ast.NamedType("Bundle", nil)},
+				},
+			},
+			Args:     resolverContextArgs(graphql.OperationModeAsync, resourceID),
+			IsMethod: false,
+		}
+
+		ctx = gqlgen.WithFieldContext(ctx, rCtx)
+		mockedTx, mockedTransactioner := txtest.NewTransactionContextGenerator(nil).ThatDoesntExpectCommit()
+		defer mockedTx.AssertExpectations(t)
+		defer mockedTransactioner.AssertExpectations(t)
+
+		directive := operation.NewDirective(mockedTransactioner, nil, map[resource.Type]operation.ResourceFetcherFunc{resource.Application: mockedResourceFetcherFunc}, nil, mockedTenantLoaderFunc, nil)
+
+		// WHEN
+		_, err := directive.HandleOperation(ctx, nil, nil, graphql.OperationTypeDelete, nil, &resourceIdField)
+		// THEN
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "unsupported resource type Bundle for async operation")
+	})
+
 	t.Run("when idField is not present in the directive it should roll-back", func(t *testing.T) {
 		// GIVEN
 		operationCategory := "registerApplication"
@@ -1380,7 +1510,8 @@ func TestHandleOperation_ConcurrencyCheck(t *testing.T) {
 			Object: "UnregisterApplication",
 			Field: gqlgen.CollectedField{
 				Field: &ast.Field{
-					Name: operationCategory,
+					Name:       operationCategory,
+					Definition: &ast.FieldDefinition{Type: ast.NamedType("Application", nil)},
 				},
 			},
 			Args:     resolverContextArgs(graphql.OperationModeAsync, resourceID),
@@ -1418,7 +1549,12 @@ func (d *dummyResolver) ErrorResolve(ctx context.Context) (res interface{}, err
 
 func (d *dummyResolver) NonEntityResolve(ctx context.Context) (res interface{}, err error) {
 	d.finalCtx = ctx
-	return &graphql.Runtime{}, nil
+	return &graphql.Label{}, nil
+}
+
+func (d *dummyResolver) RuntimeResolve(ctx context.Context) (res interface{}, err error) {
+	d.finalCtx = ctx
+	return mockedRuntimeResponse(), nil
 }
 
 func (d *dummyResolver) NonWebhookProviderResolve(ctx context.Context) (res interface{}, err error) {
@@ -1430,6 +1566,10 @@ func mockedNextResponse() interface{} {
 	return &graphql.Application{BaseEntity: &graphql.BaseEntity{ID: resourceID}}
 }
 
+func mockedRuntimeResponse() interface{} {
+	return &graphql.Runtime{ID: resourceID}
+}
+
 func mockedWebhooksResponse(_ context.Context, _ string) ([]*model.Webhook, error) {
 	return []*model.Webhook{
 		{ID: webhookID1, Type: model.WebhookTypeRegisterApplication},
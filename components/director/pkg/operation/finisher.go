@@ -0,0 +1,97 @@
+/*
+ * Copyright 2020 The Compass Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package operation
+
+import (
+	"context"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+)
+
+// Finisher applies the outcome of a finished Operation to its resource and records it in the operation history
+type Finisher struct {
+	resourceUpdaterFuncs map[resource.Type]ResourceUpdaterFunc
+	resourceDeleterFuncs map[resource.Type]ResourceDeleterFunc
+	historyRecorder      HistoryRecorder
+}
+
+// NewFinisher creates a new Finisher
+func NewFinisher(resourceUpdaterFuncs map[resource.Type]ResourceUpdaterFunc, resourceDeleterFuncs map[resource.Type]ResourceDeleterFunc, historyRecorder HistoryRecorder) *Finisher {
+	return &Finisher{
+		resourceUpdaterFuncs: resourceUpdaterFuncs,
+		resourceDeleterFuncs: resourceDeleterFuncs,
+		historyRecorder:      historyRecorder,
+	}
+}
+
+// Finish marks the resource of the finished Operation as ready, or deletes it if it has been successfully deleted.
+// The outcome of an Operation which has been cancelled is discarded, as the resource has already been marked as failed on cancellation.
+// It is expected to be called within the database transaction stored in the context.
+func (f *Finisher) Finish(ctx context.Context, operation *OperationRequest) error {
+	if operation.OperationID != "" {
+		cancelled, err := f.historyRecorder.IsCancelled(ctx, operation.OperationID)
+		if err != nil {
+			log.C(ctx).WithError(err).Errorf("While checking whether operation with id %s has been cancelled", operation.OperationID)
+			return apperrors.NewInternalError("Unable to check whether operation with id %s has been cancelled", operation.OperationID)
+		}
+		if cancelled {
+			log.C(ctx).Infof("Operation with id %s for resource %s with id %s has been cancelled, its outcome will be discarded", operation.OperationID, operation.ResourceType, operation.ResourceID)
+			return nil
+		}
+	}
+
+	resourceUpdaterFunc := f.resourceUpdaterFuncs[operation.ResourceType]
+	opError, err := stringifiedJsonError(operation.Error)
+	if err != nil {
+		log.C(ctx).WithError(err).Errorf("An error occurred while marshalling operation error: %s", err.Error())
+		return apperrors.NewInternalError("Unable to marshal error")
+	}
+
+	appConditionStatus := determineApplicationFinalStatus(operation.OperationType, opError)
+
+	switch operation.OperationType {
+	case OperationTypeCreate:
+		fallthrough
+	case OperationTypeUpdate:
+		if err := resourceUpdaterFunc(ctx, operation.ResourceID, true, opError, appConditionStatus); err != nil {
+			log.C(ctx).WithError(err).Errorf("While updating resource %s with id %s", operation.ResourceType, operation.ResourceID)
+			return apperrors.NewInternalError("Unable to update resource %s with id %s", operation.ResourceType, operation.ResourceID)
+		}
+	case OperationTypeDelete:
+		resourceDeleterFunc := f.resourceDeleterFuncs[operation.ResourceType]
+		if operation.Error != "" {
+			if err := resourceUpdaterFunc(ctx, operation.ResourceID, true, opError, appConditionStatus); err != nil {
+				log.C(ctx).WithError(err).Errorf("While updating resource %s with id %s", operation.ResourceType, operation.ResourceID)
+				return apperrors.NewInternalError("Unable to update resource %s with id %s", operation.ResourceType, operation.ResourceID)
+			}
+		} else {
+			if err := resourceDeleterFunc(ctx, operation.ResourceID); err != nil {
+				log.C(ctx).WithError(err).Errorf("While deleting resource %s with id %s", operation.ResourceType, operation.ResourceID)
+				return apperrors.NewInternalError("Unable to delete resource %s with id %s", operation.ResourceType, operation.ResourceID)
+			}
+		}
+	}
+
+	if err := f.historyRecorder.RecordFinished(ctx, operation); err != nil {
+		log.C(ctx).WithError(err).Errorf("While recording operation for resource %s with id %s", operation.ResourceType, operation.ResourceID)
+		return apperrors.NewInternalError("Unable to record operation for resource %s with id %s", operation.ResourceType, operation.ResourceID)
+	}
+
+	return nil
+}
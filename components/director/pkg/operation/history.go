@@ -0,0 +1,107 @@
+/*
+ * Copyright 2020 The Compass Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package operation
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+)
+
+// WebhookResult describes the outcome of the execution of a single webhook of an Operation
+type WebhookResult struct {
+	WebhookID      string `json:"webhook_id"`
+	State          string `json:"state"`
+	RetriesCount   int    `json:"retries_count"`
+	FailedAttempts int    `json:"failed_attempts"`
+}
+
+type recordingScheduler struct {
+	scheduler Scheduler
+	recorder  HistoryRecorder
+}
+
+// NewRecordingScheduler creates a Scheduler which records every successfully scheduled Operation in the operation history
+func NewRecordingScheduler(scheduler Scheduler, recorder HistoryRecorder) *recordingScheduler {
+	return &recordingScheduler{
+		scheduler: scheduler,
+		recorder:  recorder,
+	}
+}
+
+// Schedule schedules the Operation and records it in the operation history
+func (s *recordingScheduler) Schedule(ctx context.Context, op *Operation) (string, error) {
+	operationID, err := s.scheduler.Schedule(ctx, op)
+	if err != nil {
+		return "", err
+	}
+
+	scheduledOp := *op
+	scheduledOp.OperationID = operationID
+	if err := s.recorder.RecordScheduled(ctx, &scheduledOp); err != nil {
+		return "", errors.Wrapf(err, "while recording operation for %s with id %s", op.ResourceType, op.ResourceID)
+	}
+
+	return operationID, nil
+}
+
+type recordingManager struct {
+	manager  Manager
+	recorder HistoryRecorder
+}
+
+// NewRecordingManager creates a Manager which records every retried and cancelled Operation in the operation history
+func NewRecordingManager(manager Manager, recorder HistoryRecorder) *recordingManager {
+	return &recordingManager{
+		manager:  manager,
+		recorder: recorder,
+	}
+}
+
+// Retry retries the failed Operation and records the retry as a new Operation in the operation history
+func (m *recordingManager) Retry(ctx context.Context, op *Operation) (*Operation, ApplyFunc, error) {
+	retriedOp, apply, err := m.manager.Retry(ctx, op)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := m.recorder.RecordScheduled(ctx, retriedOp); err != nil {
+		return nil, nil, errors.Wrapf(err, "while recording retried operation for %s with id %s", op.ResourceType, op.ResourceID)
+	}
+
+	return retriedOp, apply, nil
+}
+
+// Cancel cancels the in-progress Operation and records it as failed in the operation history
+func (m *recordingManager) Cancel(ctx context.Context, op *Operation) (*Operation, ApplyFunc, error) {
+	cancelledOp, apply, err := m.manager.Cancel(ctx, op)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := m.recorder.RecordFinished(ctx, &OperationRequest{
+		OperationID:   cancelledOp.OperationID,
+		OperationType: cancelledOp.OperationType,
+		ResourceType:  cancelledOp.ResourceType,
+		ResourceID:    cancelledOp.ResourceID,
+		Error:         CancelledOperationError,
+	}); err != nil {
+		return nil, nil, errors.Wrapf(err, "while recording cancelled operation for %s with id %s", op.ResourceType, op.ResourceID)
+	}
+
+	return cancelledOp, apply, nil
+}
@@ -0,0 +1,147 @@
+/*
+ * Copyright 2020 The Compass Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package operation_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/operation"
+	"github.com/kyma-incubator/compass/components/director/pkg/operation/automock"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecordingScheduler_Schedule(t *testing.T) {
+	ctx := context.TODO()
+
+	t.Run("when scheduling fails it should not record the operation", func(t *testing.T) {
+		op := &operation.Operation{ResourceType: resource.Application, ResourceID: resourceID}
+
+		scheduler := &automock.Scheduler{}
+		defer scheduler.AssertExpectations(t)
+		scheduler.On("Schedule", ctx, op).Return("", mockedError()).Once()
+
+		historyRecorder := &automock.HistoryRecorder{}
+		defer historyRecorder.AssertExpectations(t)
+
+		_, err := operation.NewRecordingScheduler(scheduler, historyRecorder).Schedule(ctx, op)
+		require.Equal(t, mockedError(), err)
+	})
+
+	t.Run("when recording fails it should return an error", func(t *testing.T) {
+		op := &operation.Operation{ResourceType: resource.Application, ResourceID: resourceID}
+
+		scheduler := &automock.Scheduler{}
+		defer scheduler.AssertExpectations(t)
+		scheduler.On("Schedule", ctx, op).Return(operationID, nil).Once()
+
+		historyRecorder := &automock.HistoryRecorder{}
+		defer historyRecorder.AssertExpectations(t)
+		historyRecorder.On("RecordScheduled", ctx, mock.Anything).Return(mockedError()).Once()
+
+		_, err := operation.NewRecordingScheduler(scheduler, historyRecorder).Schedule(ctx, op)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "while recording operation")
+	})
+
+	t.Run("when the operation is scheduled it should record it with its ID", func(t *testing.T) {
+		op := &operation.Operation{ResourceType: resource.Application, ResourceID: resourceID}
+
+		scheduler := &automock.Scheduler{}
+		defer scheduler.AssertExpectations(t)
+		scheduler.On("Schedule", ctx, op).Return(operationID, nil).Once()
+
+		historyRecorder := &automock.HistoryRecorder{}
+		defer historyRecorder.AssertExpectations(t)
+		historyRecorder.On("RecordScheduled", ctx, &operation.Operation{OperationID: operationID, ResourceType: resource.Application, ResourceID: resourceID}).Return(nil).Once()
+
+		result, err := operation.NewRecordingScheduler(scheduler, historyRecorder).Schedule(ctx, op)
+		require.NoError(t, err)
+		require.Equal(t, operationID, result)
+	})
+}
+
+func TestRecordingManager(t *testing.T) {
+	ctx := context.TODO()
+	op := &operation.Operation{ResourceType: resource.Application, ResourceID: resourceID}
+	managedOp := &operation.Operation{OperationID: operationID, OperationType: operation.OperationTypeCreate, ResourceType: resource.Application, ResourceID: resourceID}
+	apply := operation.ApplyFunc(operation.NoopApply)
+
+	t.Run("when the operation is retried it should record it as scheduled", func(t *testing.T) {
+		manager := &automock.Manager{}
+		defer manager.AssertExpectations(t)
+		manager.On("Retry", ctx, op).Return(managedOp, apply, nil).Once()
+
+		historyRecorder := &automock.HistoryRecorder{}
+		defer historyRecorder.AssertExpectations(t)
+		historyRecorder.On("RecordScheduled", ctx, managedOp).Return(nil).Once()
+
+		result, resultApply, err := operation.NewRecordingManager(manager, historyRecorder).Retry(ctx, op)
+		require.NoError(t, err)
+		require.Equal(t, managedOp, result)
+		require.NotNil(t, resultApply)
+	})
+
+	t.Run("when retrying fails it should not record the operation", func(t *testing.T) {
+		manager := &automock.Manager{}
+		defer manager.AssertExpectations(t)
+		manager.On("Retry", ctx, op).Return(nil, nil, mockedError()).Once()
+
+		historyRecorder := &automock.HistoryRecorder{}
+		defer historyRecorder.AssertExpectations(t)
+
+		_, _, err := operation.NewRecordingManager(manager, historyRecorder).Retry(ctx, op)
+		require.Equal(t, mockedError(), err)
+	})
+
+	t.Run("when the operation is cancelled it should record it as failed", func(t *testing.T) {
+		manager := &automock.Manager{}
+		defer manager.AssertExpectations(t)
+		manager.On("Cancel", ctx, op).Return(managedOp, apply, nil).Once()
+
+		historyRecorder := &automock.HistoryRecorder{}
+		defer historyRecorder.AssertExpectations(t)
+		historyRecorder.On("RecordFinished", ctx, &operation.OperationRequest{
+			OperationID:   managedOp.OperationID,
+			OperationType: operation.OperationTypeCreate,
+			ResourceType:  resource.Application,
+			ResourceID:    resourceID,
+			Error:         "operation has been cancelled",
+		}).Return(nil).Once()
+
+		result, resultApply, err := operation.NewRecordingManager(manager, historyRecorder).Cancel(ctx, op)
+		require.NoError(t, err)
+		require.Equal(t, managedOp, result)
+		require.NotNil(t, resultApply)
+	})
+
+	t.Run("when recording the cancelled operation fails it should return an error", func(t *testing.T) {
+		manager := &automock.Manager{}
+		defer manager.AssertExpectations(t)
+		manager.On("Cancel", ctx, op).Return(managedOp, apply, nil).Once()
+
+		historyRecorder := &automock.HistoryRecorder{}
+		defer historyRecorder.AssertExpectations(t)
+		historyRecorder.On("RecordFinished", ctx, mock.Anything).Return(mockedError()).Once()
+
+		_, _, err := operation.NewRecordingManager(manager, historyRecorder).Cancel(ctx, op)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "while recording cancelled operation")
+	})
+}
@@ -0,0 +1,125 @@
+package k8s
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/operation"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/kyma-incubator/compass/components/operations-controller/api/v1alpha1"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+//go:generate mockery -name=UIDService -output=automock -outpkg=automock -case=underscore
+type UIDService interface {
+	Generate() string
+}
+
+type Manager struct {
+	kcli       K8SClient
+	uidService UIDService
+}
+
+func NewManager(kcli K8SClient, uidService UIDService) *Manager {
+	return &Manager{
+		kcli:       kcli,
+		uidService: uidService,
+	}
+}
+
+// Retry prepares the recreation of the failed operation of the given resource so that its webhooks are executed again.
+// The operation is recreated rather than updated so that its timeout is measured from the retry onwards.
+// The ID of the retried operation is generated upfront, so that it can be recorded before the operation is recreated by the returned ApplyFunc.
+func (m *Manager) Retry(ctx context.Context, op *operation.Operation) (*operation.Operation, operation.ApplyFunc, error) {
+	k8sOp, err := m.getOperation(ctx, op)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if !isOpFailed(k8sOp) {
+		return nil, nil, apperrors.NewInvalidOperationError(fmt.Sprintf("operation for resource with ID %q has not failed", op.ResourceID))
+	}
+
+	retriedOp := &v1alpha1.Operation{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: k8sOp.Name,
+		},
+		Spec: k8sOp.Spec,
+	}
+	retriedOp.Spec.OperationID = m.uidService.Generate()
+	retriedOp.Spec.CorrelationID = op.CorrelationID
+
+	apply := func(ctx context.Context) error {
+		if err := m.kcli.Delete(ctx, k8sOp.Name, metav1.DeleteOptions{}); err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+
+		_, err := m.kcli.Create(ctx, retriedOp)
+		return err
+	}
+
+	return fromK8SOperation(retriedOp), apply, nil
+}
+
+// Cancel prepares the deletion of the in-progress operation of the given resource so that no more of its webhooks are executed
+func (m *Manager) Cancel(ctx context.Context, op *operation.Operation) (*operation.Operation, operation.ApplyFunc, error) {
+	k8sOp, err := m.getOperation(ctx, op)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if !isOpInProgress(k8sOp) {
+		return nil, nil, apperrors.NewInvalidOperationError(fmt.Sprintf("operation for resource with ID %q is not in progress", op.ResourceID))
+	}
+
+	apply := func(ctx context.Context) error {
+		if err := m.kcli.Delete(ctx, k8sOp.Name, metav1.DeleteOptions{}); err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+		return nil
+	}
+
+	return fromK8SOperation(k8sOp), apply, nil
+}
+
+func (m *Manager) getOperation(ctx context.Context, op *operation.Operation) (*v1alpha1.Operation, error) {
+	operationName := fmt.Sprintf("%s-%s", op.ResourceType, op.ResourceID)
+	k8sOp, err := m.kcli.Get(ctx, operationName, metav1.GetOptions{})
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil, apperrors.NewNotFoundError(op.ResourceType, op.ResourceID)
+		}
+		return nil, err
+	}
+	return k8sOp, nil
+}
+
+func isOpFailed(op *v1alpha1.Operation) bool {
+	for _, cond := range op.Status.Conditions {
+		if cond.Type == v1alpha1.ConditionTypeError && cond.Status == v1.ConditionTrue {
+			return true
+		}
+	}
+	return op.Status.Phase == v1alpha1.StateFailed
+}
+
+func fromK8SOperation(k8sOp *v1alpha1.Operation) *operation.Operation {
+	operationID := k8sOp.Spec.OperationID
+	if operationID == "" {
+		operationID = string(k8sOp.UID)
+	}
+
+	return &operation.Operation{
+		OperationID:       operationID,
+		OperationType:     operation.OperationType(k8sOp.Spec.OperationType),
+		OperationCategory: k8sOp.Spec.OperationCategory,
+		ResourceID:        k8sOp.Spec.ResourceID,
+		ResourceType:      resource.Type(k8sOp.Spec.ResourceType),
+		CorrelationID:     k8sOp.Spec.CorrelationID,
+		WebhookIDs:        k8sOp.Spec.WebhookIDs,
+		RequestObject:     k8sOp.Spec.RequestObject,
+	}
+}
@@ -0,0 +1,244 @@
+package k8s_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/kyma-incubator/compass/components/operations-controller/api/v1alpha1"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	k8s_errors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/operation"
+	"github.com/kyma-incubator/compass/components/director/pkg/operation/k8s"
+	"github.com/kyma-incubator/compass/components/director/pkg/operation/k8s/automock"
+)
+
+const correlationID = "a1a0b2f8-8d5e-4c42-bf1b-4bb94a3e7e25"
+
+func TestManager_Retry(t *testing.T) {
+	ctx := context.TODO()
+	op := &operation.Operation{ResourceType: resource.Application, ResourceID: resourceID, CorrelationID: correlationID}
+	operationName := fmt.Sprintf("%s-%s", op.ResourceType, op.ResourceID)
+
+	t.Run("when the operation does not exist it should return not found error", func(t *testing.T) {
+		// GIVEN
+		cli := &automock.K8SClient{}
+		cli.On("Get", ctx, operationName, metav1.GetOptions{}).Return(nil, k8s_errors.NewNotFound(schema.GroupResource{}, "test")).Once()
+		m := k8s.NewManager(cli, &automock.UIDService{})
+
+		// WHEN
+		_, _, err := m.Retry(ctx, op)
+
+		// THEN
+		require.True(t, apperrors.IsNotFoundError(err))
+		cli.AssertExpectations(t)
+	})
+
+	t.Run("when the operation has not failed it should return invalid operation error", func(t *testing.T) {
+		// GIVEN
+		cli := &automock.K8SClient{}
+		cli.On("Get", ctx, operationName, metav1.GetOptions{}).Return(fixK8SOperation(operationName, v1alpha1.StateInProgress), nil).Once()
+		m := k8s.NewManager(cli, &automock.UIDService{})
+
+		// WHEN
+		_, _, err := m.Retry(ctx, op)
+
+		// THEN
+		require.True(t, apperrors.IsNewInvalidOperationError(err))
+		cli.AssertExpectations(t)
+	})
+
+	t.Run("when the k8s client fails to delete the failed operation applying the retry should fail", func(t *testing.T) {
+		// GIVEN
+		expErr := errors.New("error")
+		cli := &automock.K8SClient{}
+		cli.On("Get", ctx, operationName, metav1.GetOptions{}).Return(fixK8SOperation(operationName, v1alpha1.StateFailed), nil).Once()
+		cli.On("Delete", ctx, operationName, metav1.DeleteOptions{}).Return(expErr).Once()
+		m := k8s.NewManager(cli, fixUIDService())
+
+		// WHEN
+		_, apply, err := m.Retry(ctx, op)
+		require.NoError(t, err)
+		err = apply(ctx)
+
+		// THEN
+		require.Equal(t, expErr, err)
+		cli.AssertExpectations(t)
+	})
+
+	t.Run("when the k8s client fails to recreate the operation applying the retry should fail", func(t *testing.T) {
+		// GIVEN
+		expErr := errors.New("error")
+		cli := &automock.K8SClient{}
+		cli.On("Get", ctx, operationName, metav1.GetOptions{}).Return(fixK8SOperation(operationName, v1alpha1.StateFailed), nil).Once()
+		cli.On("Delete", ctx, operationName, metav1.DeleteOptions{}).Return(nil).Once()
+		cli.On("Create", ctx, mock.Anything).Return(nil, expErr).Once()
+		m := k8s.NewManager(cli, fixUIDService())
+
+		// WHEN
+		_, apply, err := m.Retry(ctx, op)
+		require.NoError(t, err)
+		err = apply(ctx)
+
+		// THEN
+		require.Equal(t, expErr, err)
+		cli.AssertExpectations(t)
+	})
+
+	t.Run("when the operation has failed it should be recreated with a new operation ID and the new correlation ID only when the retry is applied", func(t *testing.T) {
+		// GIVEN
+		failedOp := fixK8SOperation(operationName, v1alpha1.StateFailed)
+		retriedOp := &v1alpha1.Operation{
+			ObjectMeta: metav1.ObjectMeta{Name: operationName},
+			Spec:       failedOp.Spec,
+		}
+		retriedOp.Spec.OperationID = operationID
+		retriedOp.Spec.CorrelationID = correlationID
+
+		cli := &automock.K8SClient{}
+		cli.On("Get", ctx, operationName, metav1.GetOptions{}).Return(failedOp, nil).Once()
+		m := k8s.NewManager(cli, fixUIDService())
+
+		// WHEN
+		result, apply, err := m.Retry(ctx, op)
+
+		// THEN
+		require.NoError(t, err)
+		require.Equal(t, operationID, result.OperationID)
+		require.Equal(t, operation.OperationTypeCreate, result.OperationType)
+		require.Equal(t, resource.Application, result.ResourceType)
+		require.Equal(t, resourceID, result.ResourceID)
+		require.Equal(t, correlationID, result.CorrelationID)
+		cli.AssertExpectations(t)
+
+		// WHEN
+		cli.On("Delete", ctx, operationName, metav1.DeleteOptions{}).Return(nil).Once()
+		cli.On("Create", ctx, retriedOp).Return(retriedOp.DeepCopy(), nil).Once()
+		err = apply(ctx)
+
+		// THEN
+		require.NoError(t, err)
+		cli.AssertExpectations(t)
+	})
+}
+
+func TestManager_Cancel(t *testing.T) {
+	ctx := context.TODO()
+	op := &operation.Operation{ResourceType: resource.Application, ResourceID: resourceID}
+	operationName := fmt.Sprintf("%s-%s", op.ResourceType, op.ResourceID)
+
+	t.Run("when the k8s client fails to retrieve the operation it should fail", func(t *testing.T) {
+		// GIVEN
+		expErr := errors.New("error")
+		cli := &automock.K8SClient{}
+		cli.On("Get", ctx, operationName, metav1.GetOptions{}).Return(nil, expErr).Once()
+		m := k8s.NewManager(cli, &automock.UIDService{})
+
+		// WHEN
+		_, _, err := m.Cancel(ctx, op)
+
+		// THEN
+		require.Equal(t, expErr, err)
+		cli.AssertExpectations(t)
+	})
+
+	t.Run("when the operation is not in progress it should return invalid operation error", func(t *testing.T) {
+		// GIVEN
+		cli := &automock.K8SClient{}
+		cli.On("Get", ctx, operationName, metav1.GetOptions{}).Return(fixK8SOperation(operationName, v1alpha1.StateSuccess), nil).Once()
+		m := k8s.NewManager(cli, &automock.UIDService{})
+
+		// WHEN
+		_, _, err := m.Cancel(ctx, op)
+
+		// THEN
+		require.True(t, apperrors.IsNewInvalidOperationError(err))
+		cli.AssertExpectations(t)
+	})
+
+	t.Run("when the k8s client fails to delete the operation applying the cancellation should fail", func(t *testing.T) {
+		// GIVEN
+		expErr := errors.New("error")
+		cli := &automock.K8SClient{}
+		cli.On("Get", ctx, operationName, metav1.GetOptions{}).Return(fixK8SOperation(operationName, v1alpha1.StateInProgress), nil).Once()
+		cli.On("Delete", ctx, operationName, metav1.DeleteOptions{}).Return(expErr).Once()
+		m := k8s.NewManager(cli, &automock.UIDService{})
+
+		// WHEN
+		_, apply, err := m.Cancel(ctx, op)
+		require.NoError(t, err)
+		err = apply(ctx)
+
+		// THEN
+		require.Equal(t, expErr, err)
+		cli.AssertExpectations(t)
+	})
+
+	t.Run("when the operation is in progress it should be deleted only when the cancellation is applied", func(t *testing.T) {
+		// GIVEN
+		k8sOp := fixK8SOperation(operationName, v1alpha1.StateInProgress)
+		k8sOp.UID = operationID
+		cli := &automock.K8SClient{}
+		cli.On("Get", ctx, operationName, metav1.GetOptions{}).Return(k8sOp, nil).Once()
+		m := k8s.NewManager(cli, &automock.UIDService{})
+
+		// WHEN
+		result, apply, err := m.Cancel(ctx, op)
+
+		// THEN
+		require.NoError(t, err)
+		require.Equal(t, operationID, result.OperationID)
+		require.Equal(t, operation.OperationTypeCreate, result.OperationType)
+		require.Equal(t, resourceID, result.ResourceID)
+		cli.AssertExpectations(t)
+
+		// WHEN
+		cli.On("Delete", ctx, operationName, metav1.DeleteOptions{}).Return(k8s_errors.NewNotFound(schema.GroupResource{}, "test")).Once()
+		err = apply(ctx)
+
+		// THEN
+		require.NoError(t, err)
+		cli.AssertExpectations(t)
+	})
+}
+
+func fixUIDService() *automock.UIDService {
+	uidSvc := &automock.UIDService{}
+	uidSvc.On("Generate").Return(operationID).Once()
+	return uidSvc
+}
+
+func fixK8SOperation(name string, state v1alpha1.State) *v1alpha1.Operation {
+	k8sOp := &v1alpha1.Operation{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+		},
+		Spec: v1alpha1.OperationSpec{
+			OperationType: v1alpha1.OperationTypeCreate,
+			ResourceType:  string(resource.Application),
+			ResourceID:    resourceID,
+			CorrelationID: "previous-correlation-id",
+			WebhookIDs:    []string{"webhook-id"},
+		},
+		Status: v1alpha1.OperationStatus{
+			Phase: state,
+		},
+	}
+
+	switch state {
+	case v1alpha1.StateSuccess:
+		k8sOp.Status.Conditions = []v1alpha1.Condition{{Type: v1alpha1.ConditionTypeReady, Status: v1.ConditionTrue}}
+	case v1alpha1.StateFailed:
+		k8sOp.Status.Conditions = []v1alpha1.Condition{{Type: v1alpha1.ConditionTypeError, Status: v1.ConditionTrue}}
+	}
+
+	return k8sOp
+}
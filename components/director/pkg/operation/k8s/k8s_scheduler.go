@@ -17,6 +17,7 @@ type K8SClient interface {
 	Create(ctx context.Context, operation *v1alpha1.Operation) (*v1alpha1.Operation, error)
 	Get(ctx context.Context, name string, options metav1.GetOptions) (*v1alpha1.Operation, error)
 	Update(ctx context.Context, operation *v1alpha1.Operation) (*v1alpha1.Operation, error)
+	Delete(ctx context.Context, name string, options metav1.DeleteOptions) error
 }
 
 type Scheduler struct {
@@ -0,0 +1,296 @@
+/*
+ * Copyright 2020 The Compass Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package operation
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/gorilla/mux"
+	"github.com/kyma-incubator/compass/components/director/internal/consumer"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/kyma-incubator/compass/components/director/pkg/scope"
+	"github.com/pkg/errors"
+)
+
+const (
+	// CancelledOperationError is the error with which cancelled operations are finished
+	CancelledOperationError = "operation has been cancelled"
+
+	// RetryOperationScopesDefinition is the path of the scopes required for retrying operations in the scopes configuration
+	RetryOperationScopesDefinition = "operationsAPI.retry"
+	// CancelOperationScopesDefinition is the path of the scopes required for cancelling operations in the scopes configuration
+	CancelOperationScopesDefinition = "operationsAPI.cancel"
+)
+
+type operationAction func(ctx context.Context, manager Manager, op *Operation) (*Operation, ApplyFunc, error)
+
+type resourceStatus struct {
+	ready     bool
+	errorMsg  *string
+	condition model.ApplicationStatusCondition
+}
+
+type manageOperationHandler struct {
+	transact             persistence.Transactioner
+	resourceFetcherFuncs map[resource.Type]ResourceFetcherFunc
+	resourceUpdaterFuncs map[resource.Type]ResourceUpdaterFunc
+	tenantLoaderFunc     TenantLoaderFunc
+	scopesGetter         scope.ScopesGetter
+	scopesDefinition     string
+	manager              Manager
+	action               operationAction
+	statusFunc           func(opType OperationType) (*resourceStatus, error)
+}
+
+// NewRetryOperationHandler creates a handler which retries the failed operation of a resource.
+// The caller must have the scopes configured under RetryOperationScopesDefinition.
+func NewRetryOperationHandler(transact persistence.Transactioner, resourceFetcherFuncs map[resource.Type]ResourceFetcherFunc, resourceUpdaterFuncs map[resource.Type]ResourceUpdaterFunc, tenantLoaderFunc TenantLoaderFunc, scopesGetter scope.ScopesGetter, manager Manager) *manageOperationHandler {
+	return &manageOperationHandler{
+		transact:             transact,
+		resourceFetcherFuncs: resourceFetcherFuncs,
+		resourceUpdaterFuncs: resourceUpdaterFuncs,
+		tenantLoaderFunc:     tenantLoaderFunc,
+		scopesGetter:         scopesGetter,
+		scopesDefinition:     RetryOperationScopesDefinition,
+		manager:              manager,
+		action: func(ctx context.Context, manager Manager, op *Operation) (*Operation, ApplyFunc, error) {
+			return manager.Retry(ctx, op)
+		},
+		statusFunc: func(opType OperationType) (*resourceStatus, error) {
+			appConditionStatus, err := determineApplicationInProgressStatus(graphql.OperationType(strings.ToUpper(string(opType))))
+			if err != nil {
+				return nil, err
+			}
+			return &resourceStatus{ready: false, condition: *appConditionStatus}, nil
+		},
+	}
+}
+
+// NewCancelOperationHandler creates a handler which cancels the in-progress operation of a resource.
+// The caller must have the scopes configured under CancelOperationScopesDefinition.
+func NewCancelOperationHandler(transact persistence.Transactioner, resourceFetcherFuncs map[resource.Type]ResourceFetcherFunc, resourceUpdaterFuncs map[resource.Type]ResourceUpdaterFunc, tenantLoaderFunc TenantLoaderFunc, scopesGetter scope.ScopesGetter, manager Manager) *manageOperationHandler {
+	return &manageOperationHandler{
+		transact:             transact,
+		resourceFetcherFuncs: resourceFetcherFuncs,
+		resourceUpdaterFuncs: resourceUpdaterFuncs,
+		tenantLoaderFunc:     tenantLoaderFunc,
+		scopesGetter:         scopesGetter,
+		scopesDefinition:     CancelOperationScopesDefinition,
+		manager:              manager,
+		action: func(ctx context.Context, manager Manager, op *Operation) (*Operation, ApplyFunc, error) {
+			return manager.Cancel(ctx, op)
+		},
+		statusFunc: func(opType OperationType) (*resourceStatus, error) {
+			opError, err := stringifiedJsonError(CancelledOperationError)
+			if err != nil {
+				return nil, err
+			}
+			return &resourceStatus{ready: true, errorMsg: opError, condition: determineApplicationFinalStatus(opType, opError)}, nil
+		},
+	}
+}
+
+// ServeHTTP handles the Operations API retry and cancel requests
+func (h *manageOperationHandler) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+
+	if request.Method != http.MethodPost {
+		apperrors.WriteAppError(ctx, writer, apperrors.NewInternalError("Method not allowed"), http.StatusMethodNotAllowed)
+		return
+	}
+
+	if err := h.verifyScopes(ctx); err != nil {
+		log.C(ctx).WithError(err).Errorf("An error occurred while verifying scopes: %s", err.Error())
+		if apperrors.ErrorCode(err) == apperrors.InsufficientScopes {
+			apperrors.WriteAppError(ctx, writer, err, http.StatusForbidden)
+			return
+		}
+		apperrors.WriteAppError(ctx, writer, apperrors.NewInternalError("Unable to verify scopes for request"), http.StatusInternalServerError)
+		return
+	}
+
+	tenantID, err := h.tenantLoaderFunc(ctx)
+	if err != nil {
+		log.C(ctx).WithError(err).Errorf("An error occurred while retrieving tenant from context: %s", err.Error())
+		apperrors.WriteAppError(ctx, writer, apperrors.NewInternalError("Unable to determine tenant for request"), http.StatusInternalServerError)
+		return
+	}
+
+	routeVariables := mux.Vars(request)
+	op := &Operation{
+		ResourceID:   routeVariables[ResourceIDParam],
+		ResourceType: resource.Type(routeVariables[ResourceTypeParam]),
+	}
+
+	if correlationID, ok := log.C(ctx).Data[log.FieldRequestID].(string); ok {
+		op.CorrelationID = correlationID
+	}
+
+	if err := op.Validate(); err != nil {
+		apperrors.WriteAppError(ctx, writer, apperrors.NewInvalidDataError("Unexpected resource type and/or GUID"), http.StatusBadRequest)
+		return
+	}
+
+	consumerInfo, err := consumer.LoadFromContext(ctx)
+	if err != nil {
+		log.C(ctx).WithError(err).Errorf("An error occurred while retrieving consumer from context: %s", err.Error())
+		apperrors.WriteAppError(ctx, writer, apperrors.NewInternalError("Unable to determine consumer for request"), http.StatusInternalServerError)
+		return
+	}
+
+	if !isManageableByConsumer(consumerInfo, op) {
+		apperrors.WriteAppError(ctx, writer, apperrors.NewUnauthorizedError(fmt.Sprintf("%s %s cannot manage operations of %s with id %s",
+			consumerInfo.ConsumerType, consumerInfo.ConsumerID, op.ResourceType, op.ResourceID)), http.StatusForbidden)
+		return
+	}
+
+	resourceFetcherFunc, ok := h.resourceFetcherFuncs[op.ResourceType]
+	if !ok {
+		apperrors.WriteAppError(ctx, writer, apperrors.NewInvalidDataError("Unsupported resource type %s", op.ResourceType), http.StatusBadRequest)
+		return
+	}
+	resourceUpdaterFunc := h.resourceUpdaterFuncs[op.ResourceType]
+
+	tx, err := h.transact.Begin()
+	if err != nil {
+		log.C(ctx).WithError(err).Errorf("An error occurred while opening db transaction: %s", err.Error())
+		apperrors.WriteAppError(ctx, writer, apperrors.NewInternalError("Unable to establish connection with database"), http.StatusInternalServerError)
+		return
+	}
+	defer h.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	if _, err := resourceFetcherFunc(ctx, tenantID, op.ResourceID); err != nil {
+		log.C(ctx).WithError(err).Errorf("An error occurred while fetching resource from database: %s", err.Error())
+
+		if apperrors.IsNotFoundError(err) {
+			apperrors.WriteAppError(ctx, writer, apperrors.NewNotFoundError(op.ResourceType, op.ResourceID), http.StatusNotFound)
+			return
+		}
+
+		apperrors.WriteAppError(ctx, writer, apperrors.NewInternalError("Unable to execute database operation"), http.StatusInternalServerError)
+		return
+	}
+
+	managedOp, apply, err := h.action(ctx, h.manager, op)
+	if err != nil {
+		log.C(ctx).WithError(err).Errorf("An error occurred while managing operation for %s with id %s: %s", op.ResourceType, op.ResourceID, err.Error())
+
+		switch {
+		case apperrors.IsNotFoundError(err):
+			apperrors.WriteAppError(ctx, writer, apperrors.NewNotFoundErrorWithMessage(op.ResourceType, op.ResourceID,
+				fmt.Sprintf("Operation for %s with id %s not found", op.ResourceType, op.ResourceID)), http.StatusNotFound)
+		case apperrors.IsNewInvalidOperationError(err):
+			apperrors.WriteAppError(ctx, writer, err, http.StatusConflict)
+		default:
+			apperrors.WriteAppError(ctx, writer, apperrors.NewInternalError("Unable to manage operation"), http.StatusInternalServerError)
+		}
+		return
+	}
+
+	status, err := h.statusFunc(managedOp.OperationType)
+	if err != nil {
+		log.C(ctx).WithError(err).Errorf("An error occurred while determining resource status: %s", err.Error())
+		apperrors.WriteAppError(ctx, writer, apperrors.NewInternalError("Unable to determine resource status"), http.StatusInternalServerError)
+		return
+	}
+
+	if err := resourceUpdaterFunc(ctx, op.ResourceID, status.ready, status.errorMsg, status.condition); err != nil {
+		log.C(ctx).WithError(err).Errorf("While updating resource %s with id %s", op.ResourceType, op.ResourceID)
+		apperrors.WriteAppError(ctx, writer, apperrors.NewInternalError("Unable to update resource %s with id %s", op.ResourceType, op.ResourceID), http.StatusInternalServerError)
+		return
+	}
+
+	res, err := resourceFetcherFunc(ctx, tenantID, op.ResourceID)
+	if err != nil {
+		log.C(ctx).WithError(err).Errorf("An error occurred while fetching resource from database: %s", err.Error())
+		apperrors.WriteAppError(ctx, writer, apperrors.NewInternalError("Unable to execute database operation"), http.StatusInternalServerError)
+		return
+	}
+
+	if err := tx.Commit(); err != nil {
+		log.C(ctx).WithError(err).Errorf("An error occurred while closing database transaction: %s", err.Error())
+		apperrors.WriteAppError(ctx, writer, apperrors.NewInternalError("Unable to finalize database operation"), http.StatusInternalServerError)
+		return
+	}
+
+	// The operation is applied only once the database reflects it, so that a failing transaction does not leave it applied
+	if err := apply(ctx); err != nil {
+		log.C(ctx).WithError(err).Errorf("An error occurred while applying operation %s for %s with id %s: %s", managedOp.OperationID, op.ResourceType, op.ResourceID, err.Error())
+		apperrors.WriteAppError(ctx, writer, apperrors.NewInternalError("Unable to manage operation"), http.StatusInternalServerError)
+		return
+	}
+
+	opResponse := buildLastOperation(res)
+	opResponse.OperationID = managedOp.OperationID
+	opResponse.OperationType = managedOp.OperationType
+
+	err = json.NewEncoder(writer).Encode(opResponse)
+	if err != nil {
+		log.C(ctx).WithError(err).Error("An error occurred while encoding operation data")
+	}
+}
+
+// verifyScopes checks whether the caller has all scopes required by the handler
+func (h *manageOperationHandler) verifyScopes(ctx context.Context) error {
+	actualScopes, err := scope.LoadFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	requiredScopes, err := h.scopesGetter.GetRequiredScopes(h.scopesDefinition)
+	if err != nil {
+		return errors.Wrap(err, "while getting required scopes")
+	}
+
+	actual := make(map[string]bool, len(actualScopes))
+	for _, s := range actualScopes {
+		actual[s] = true
+	}
+
+	for _, s := range requiredScopes {
+		if !actual[s] {
+			return apperrors.NewInsufficientScopesError(requiredScopes, actualScopes)
+		}
+	}
+
+	return nil
+}
+
+// isManageableByConsumer reports whether the consumer can manage the operations of the given resource.
+// Applications and runtimes can manage only their own operations, while the rest of the consumers can manage
+// the operations of all resources in their tenant, which is enforced when fetching the resource.
+func isManageableByConsumer(consumerInfo consumer.Consumer, op *Operation) bool {
+	switch consumerInfo.ConsumerType {
+	case consumer.Application:
+		return op.ResourceType == resource.Application && op.ResourceID == consumerInfo.ConsumerID
+	case consumer.Runtime:
+		return op.ResourceType == resource.Runtime && op.ResourceID == consumerInfo.ConsumerID
+	default:
+		return true
+	}
+}
@@ -0,0 +1,403 @@
+/*
+ * Copyright 2020 The Compass Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package operation_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/kyma-incubator/compass/components/director/internal/consumer"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/tenant"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/operation"
+	"github.com/kyma-incubator/compass/components/director/pkg/operation/automock"
+	persistenceautomock "github.com/kyma-incubator/compass/components/director/pkg/persistence/automock"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence/txtest"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/kyma-incubator/compass/components/director/pkg/scope"
+	scopeautomock "github.com/kyma-incubator/compass/components/director/pkg/scope/automock"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const operationsWriteScope = "operations:write"
+
+var userConsumer = consumer.Consumer{ConsumerID: "admin", ConsumerType: consumer.User}
+
+func TestManageOperationHandler_ServeHTTP(t *testing.T) {
+	t.Run("when request method is not POST it should return method not allowed", func(t *testing.T) {
+		writer := httptest.NewRecorder()
+		req := fixEmptyRequest(t, context.Background(), string(resource.Application), resourceID)
+
+		handler := operation.NewRetryOperationHandler(nil, nil, nil, loadTenantFunc, fixScopesGetter(), nil)
+		handler.ServeHTTP(writer, req)
+
+		require.Equal(t, http.StatusMethodNotAllowed, writer.Code)
+	})
+
+	t.Run("when resource ID is not GUID it should return bad request", func(t *testing.T) {
+		ctx := fixManageOperationContext(userConsumer)
+
+		writer := httptest.NewRecorder()
+		req := fixManageOperationRequest(t, ctx, string(resource.Application), "123", "retry")
+
+		handler := operation.NewRetryOperationHandler(nil, nil, nil, loadTenantFunc, fixScopesGetter(), nil)
+		handler.ServeHTTP(writer, req)
+
+		require.Contains(t, writer.Body.String(), "Unexpected resource type and/or GUID")
+		require.Equal(t, http.StatusBadRequest, writer.Code)
+	})
+
+	t.Run("when the caller does not have the required scopes it should return forbidden", func(t *testing.T) {
+		ctx := scope.SaveToContext(fixManageOperationContext(userConsumer), []string{"application:write"})
+
+		writer := httptest.NewRecorder()
+		req := fixManageOperationRequest(t, ctx, string(resource.Application), resourceID, "retry")
+
+		scopesGetter := fixScopesGetter()
+		handler := operation.NewRetryOperationHandler(nil, nil, nil, loadTenantFunc, scopesGetter, nil)
+		handler.ServeHTTP(writer, req)
+
+		require.Contains(t, writer.Body.String(), "insufficient scopes provided")
+		require.Equal(t, http.StatusForbidden, writer.Code)
+		scopesGetter.AssertCalled(t, "GetRequiredScopes", operation.RetryOperationScopesDefinition)
+	})
+
+	t.Run("when the required scopes cannot be determined it should return internal server error", func(t *testing.T) {
+		writer := httptest.NewRecorder()
+		req := fixManageOperationRequest(t, fixManageOperationContext(userConsumer), string(resource.Application), resourceID, "cancel")
+
+		scopesGetter := &scopeautomock.ScopesGetter{}
+		scopesGetter.On("GetRequiredScopes", operation.CancelOperationScopesDefinition).Return(nil, apperrors.NewValueNotFoundInConfigurationError())
+		handler := operation.NewCancelOperationHandler(nil, nil, nil, loadTenantFunc, scopesGetter, nil)
+		handler.ServeHTTP(writer, req)
+
+		require.Contains(t, writer.Body.String(), "Unable to verify scopes for request")
+		require.Equal(t, http.StatusInternalServerError, writer.Code)
+	})
+
+	t.Run("when an application manages the operation of another application it should return forbidden", func(t *testing.T) {
+		ctx := fixManageOperationContext(consumer.Consumer{ConsumerID: "c1bc1f1c-2b5e-4b2b-a5b4-3c0b1a8d6e10", ConsumerType: consumer.Application})
+
+		writer := httptest.NewRecorder()
+		req := fixManageOperationRequest(t, ctx, string(resource.Application), resourceID, "retry")
+
+		handler := operation.NewRetryOperationHandler(nil, nil, nil, loadTenantFunc, fixScopesGetter(), nil)
+		handler.ServeHTTP(writer, req)
+
+		require.Contains(t, writer.Body.String(), "cannot manage operations of application")
+		require.Equal(t, http.StatusForbidden, writer.Code)
+	})
+
+	t.Run("when a runtime manages the operation of an application it should return forbidden", func(t *testing.T) {
+		ctx := fixManageOperationContext(consumer.Consumer{ConsumerID: resourceID, ConsumerType: consumer.Runtime})
+
+		writer := httptest.NewRecorder()
+		req := fixManageOperationRequest(t, ctx, string(resource.Application), resourceID, "retry")
+
+		handler := operation.NewRetryOperationHandler(nil, nil, nil, loadTenantFunc, fixScopesGetter(), nil)
+		handler.ServeHTTP(writer, req)
+
+		require.Equal(t, http.StatusForbidden, writer.Code)
+	})
+
+	t.Run("when the consumer cannot be determined it should return internal server error", func(t *testing.T) {
+		ctx := scope.SaveToContext(tenant.SaveToContext(context.Background(), tenantID, tenantID), []string{operationsWriteScope})
+
+		writer := httptest.NewRecorder()
+		req := fixManageOperationRequest(t, ctx, string(resource.Application), resourceID, "retry")
+
+		handler := operation.NewRetryOperationHandler(nil, nil, nil, loadTenantFunc, fixScopesGetter(), nil)
+		handler.ServeHTTP(writer, req)
+
+		require.Contains(t, writer.Body.String(), "Unable to determine consumer for request")
+		require.Equal(t, http.StatusInternalServerError, writer.Code)
+	})
+
+	t.Run("when the resource does not exist it should return not found", func(t *testing.T) {
+		ctx := fixManageOperationContext(userConsumer)
+
+		writer := httptest.NewRecorder()
+		req := fixManageOperationRequest(t, ctx, string(resource.Application), resourceID, "retry")
+
+		mockedTx, mockedTransactioner := txtest.NewTransactionContextGenerator(nil).ThatDoesntExpectCommit()
+		defer mockedTx.AssertExpectations(t)
+		defer mockedTransactioner.AssertExpectations(t)
+
+		handler := operation.NewRetryOperationHandler(mockedTransactioner, map[resource.Type]operation.ResourceFetcherFunc{
+			resource.Application: func(_ context.Context, _, _ string) (model.Entity, error) {
+				return nil, apperrors.NewNotFoundError(resource.Application, resourceID)
+			},
+		}, nil, loadTenantFunc, fixScopesGetter(), nil)
+		handler.ServeHTTP(writer, req)
+
+		require.Equal(t, http.StatusNotFound, writer.Code)
+	})
+
+	t.Run("when the operation is not in a manageable state it should return conflict", func(t *testing.T) {
+		ctx := fixManageOperationContext(userConsumer)
+
+		writer := httptest.NewRecorder()
+		req := fixManageOperationRequest(t, ctx, string(resource.Application), resourceID, "retry")
+
+		mockedTx, mockedTransactioner := txtest.NewTransactionContextGenerator(nil).ThatDoesntExpectCommit()
+		defer mockedTx.AssertExpectations(t)
+		defer mockedTransactioner.AssertExpectations(t)
+
+		manager := &automock.Manager{}
+		defer manager.AssertExpectations(t)
+		manager.On("Retry", mock.Anything, mock.Anything).Return(nil, nil, apperrors.NewInvalidOperationError("operation has not failed")).Once()
+
+		handler := operation.NewRetryOperationHandler(mockedTransactioner, fixResourceFetcherFuncs(fixApplication(false, nil)), nil, loadTenantFunc, fixScopesGetter(), manager)
+		handler.ServeHTTP(writer, req)
+
+		require.Equal(t, http.StatusConflict, writer.Code)
+		require.Contains(t, writer.Body.String(), "operation has not failed")
+	})
+
+	t.Run("when the operation manager fails it should return internal server error", func(t *testing.T) {
+		ctx := fixManageOperationContext(userConsumer)
+
+		writer := httptest.NewRecorder()
+		req := fixManageOperationRequest(t, ctx, string(resource.Application), resourceID, "cancel")
+
+		mockedTx, mockedTransactioner := txtest.NewTransactionContextGenerator(nil).ThatDoesntExpectCommit()
+		defer mockedTx.AssertExpectations(t)
+		defer mockedTransactioner.AssertExpectations(t)
+
+		manager := &automock.Manager{}
+		defer manager.AssertExpectations(t)
+		manager.On("Cancel", mock.Anything, mock.Anything).Return(nil, nil, mockedError()).Once()
+
+		handler := operation.NewCancelOperationHandler(mockedTransactioner, fixResourceFetcherFuncs(fixApplication(false, nil)), nil, loadTenantFunc, fixScopesGetter(), manager)
+		handler.ServeHTTP(writer, req)
+
+		require.Equal(t, http.StatusInternalServerError, writer.Code)
+		require.Contains(t, writer.Body.String(), "Unable to manage operation")
+	})
+
+	t.Run("when the resource updater func fails it should return internal server error", func(t *testing.T) {
+		ctx := fixManageOperationContext(userConsumer)
+
+		writer := httptest.NewRecorder()
+		req := fixManageOperationRequest(t, ctx, string(resource.Application), resourceID, "retry")
+
+		mockedTx, mockedTransactioner := txtest.NewTransactionContextGenerator(nil).ThatDoesntExpectCommit()
+		defer mockedTx.AssertExpectations(t)
+		defer mockedTransactioner.AssertExpectations(t)
+
+		manager := &automock.Manager{}
+		defer manager.AssertExpectations(t)
+		manager.On("Retry", mock.Anything, mock.Anything).Return(fixManagedOperation(operation.OperationTypeCreate), fixApplyFunc(t, nil, nil), nil).Once()
+
+		handler := operation.NewRetryOperationHandler(mockedTransactioner, fixResourceFetcherFuncs(fixApplication(true, str("error"))), map[resource.Type]operation.ResourceUpdaterFunc{
+			resource.Application: func(_ context.Context, _ string, _ bool, _ *string, _ model.ApplicationStatusCondition) error {
+				return mockedError()
+			},
+		}, loadTenantFunc, fixScopesGetter(), manager)
+		handler.ServeHTTP(writer, req)
+
+		require.Equal(t, http.StatusInternalServerError, writer.Code)
+		require.Contains(t, writer.Body.String(), "Unable to update resource")
+	})
+
+	t.Run("when the transaction fails to commit it should not apply the operation", func(t *testing.T) {
+		ctx := fixManageOperationContext(userConsumer)
+
+		writer := httptest.NewRecorder()
+		req := fixManageOperationRequest(t, ctx, string(resource.Application), resourceID, "cancel")
+
+		mockedTx, mockedTransactioner := txtest.NewTransactionContextGenerator(mockedError()).ThatFailsOnCommit()
+		defer mockedTx.AssertExpectations(t)
+		defer mockedTransactioner.AssertExpectations(t)
+
+		manager := &automock.Manager{}
+		defer manager.AssertExpectations(t)
+		manager.On("Cancel", mock.Anything, mock.Anything).Return(fixManagedOperation(operation.OperationTypeCreate), fixApplyFunc(t, nil, nil), nil).Once()
+
+		handler := operation.NewCancelOperationHandler(mockedTransactioner, fixResourceFetcherFuncs(fixApplication(false, nil)), fixResourceUpdaterFuncs(), loadTenantFunc, fixScopesGetter(), manager)
+		handler.ServeHTTP(writer, req)
+
+		require.Equal(t, http.StatusInternalServerError, writer.Code)
+		require.Contains(t, writer.Body.String(), "Unable to finalize database operation")
+	})
+
+	t.Run("when applying the operation fails it should return internal server error", func(t *testing.T) {
+		ctx := fixManageOperationContext(userConsumer)
+
+		writer := httptest.NewRecorder()
+		req := fixManageOperationRequest(t, ctx, string(resource.Application), resourceID, "retry")
+
+		mockedTx, mockedTransactioner := txtest.NewTransactionContextGenerator(nil).ThatSucceeds()
+		defer mockedTx.AssertExpectations(t)
+		defer mockedTransactioner.AssertExpectations(t)
+
+		manager := &automock.Manager{}
+		defer manager.AssertExpectations(t)
+		manager.On("Retry", mock.Anything, mock.Anything).Return(fixManagedOperation(operation.OperationTypeCreate), fixApplyFunc(t, mockedTx, mockedError()), nil).Once()
+
+		handler := operation.NewRetryOperationHandler(mockedTransactioner, fixResourceFetcherFuncs(fixApplication(true, str("error"))), fixResourceUpdaterFuncs(), loadTenantFunc, fixScopesGetter(), manager)
+		handler.ServeHTTP(writer, req)
+
+		require.Equal(t, http.StatusInternalServerError, writer.Code)
+		require.Contains(t, writer.Body.String(), "Unable to manage operation")
+	})
+
+	t.Run("when the failed operation is retried it should mark the resource as in progress", func(t *testing.T) {
+		ctx := fixManageOperationContext(userConsumer)
+
+		writer := httptest.NewRecorder()
+		req := fixManageOperationRequest(t, ctx, string(resource.Application), resourceID, "retry")
+
+		mockedTx, mockedTransactioner := txtest.NewTransactionContextGenerator(nil).ThatSucceeds()
+		defer mockedTx.AssertExpectations(t)
+		defer mockedTransactioner.AssertExpectations(t)
+
+		manager := &automock.Manager{}
+		defer manager.AssertExpectations(t)
+		manager.On("Retry", mock.Anything, mock.MatchedBy(func(op *operation.Operation) bool {
+			return op.ResourceID == resourceID && op.ResourceType == resource.Application
+		})).Return(fixManagedOperation(operation.OperationTypeUpdate), fixApplyFunc(t, mockedTx, nil), nil).Once()
+
+		app := fixApplication(true, str("error"))
+		handler := operation.NewRetryOperationHandler(mockedTransactioner, fixResourceFetcherFuncs(app), map[resource.Type]operation.ResourceUpdaterFunc{
+			resource.Application: func(_ context.Context, id string, ready bool, errorMsg *string, appStatusCondition model.ApplicationStatusCondition) error {
+				require.Equal(t, resourceID, id)
+				require.False(t, ready)
+				require.Nil(t, errorMsg)
+				require.Equal(t, model.ApplicationStatusConditionUpdating, appStatusCondition)
+				app.Ready, app.Error = ready, errorMsg
+				return nil
+			},
+		}, loadTenantFunc, fixScopesGetter(), manager)
+		handler.ServeHTTP(writer, req)
+
+		require.Equal(t, http.StatusOK, writer.Code)
+
+		var response operation.OperationResponse
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), &response))
+		require.Equal(t, operationID, response.OperationID)
+		require.Equal(t, operation.OperationTypeUpdate, response.OperationType)
+		require.Equal(t, operation.OperationStatusInProgress, response.Status)
+	})
+
+	t.Run("when the in-progress operation is cancelled it should mark the resource as failed", func(t *testing.T) {
+		ctx := fixManageOperationContext(userConsumer)
+
+		writer := httptest.NewRecorder()
+		req := fixManageOperationRequest(t, ctx, string(resource.Application), resourceID, "cancel")
+
+		mockedTx, mockedTransactioner := txtest.NewTransactionContextGenerator(nil).ThatSucceeds()
+		defer mockedTx.AssertExpectations(t)
+		defer mockedTransactioner.AssertExpectations(t)
+
+		manager := &automock.Manager{}
+		defer manager.AssertExpectations(t)
+		manager.On("Cancel", mock.Anything, mock.Anything).Return(fixManagedOperation(operation.OperationTypeDelete), fixApplyFunc(t, mockedTx, nil), nil).Once()
+
+		app := fixApplication(false, nil)
+		handler := operation.NewCancelOperationHandler(mockedTransactioner, fixResourceFetcherFuncs(app), map[resource.Type]operation.ResourceUpdaterFunc{
+			resource.Application: func(_ context.Context, id string, ready bool, errorMsg *string, appStatusCondition model.ApplicationStatusCondition) error {
+				require.Equal(t, resourceID, id)
+				require.True(t, ready)
+				require.Equal(t, `{"error":"operation has been cancelled"}`, *errorMsg)
+				require.Equal(t, model.ApplicationStatusConditionDeleteFailed, appStatusCondition)
+				app.Ready, app.Error = ready, errorMsg
+				return nil
+			},
+		}, loadTenantFunc, fixScopesGetter(), manager)
+		handler.ServeHTTP(writer, req)
+
+		require.Equal(t, http.StatusOK, writer.Code)
+
+		var response operation.OperationResponse
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), &response))
+		require.Equal(t, operation.OperationTypeDelete, response.OperationType)
+		require.Equal(t, operation.OperationStatusFailed, response.Status)
+	})
+}
+
+func fixManageOperationContext(consumerInfo consumer.Consumer) context.Context {
+	ctx := tenant.SaveToContext(context.Background(), tenantID, tenantID)
+	ctx = scope.SaveToContext(ctx, []string{operationsWriteScope})
+	return consumer.SaveToContext(ctx, consumerInfo)
+}
+
+// fixApplyFunc returns an ApplyFunc which asserts that it is called only after the given transaction is committed, or never if no transaction is given
+func fixApplyFunc(t *testing.T, committedTx *persistenceautomock.PersistenceTx, err error) operation.ApplyFunc {
+	return func(_ context.Context) error {
+		require.NotNil(t, committedTx, "operation should not be applied")
+		committedTx.AssertCalled(t, "Commit")
+		return err
+	}
+}
+
+func fixResourceUpdaterFuncs() map[resource.Type]operation.ResourceUpdaterFunc {
+	return map[resource.Type]operation.ResourceUpdaterFunc{
+		resource.Application: func(_ context.Context, _ string, _ bool, _ *string, _ model.ApplicationStatusCondition) error {
+			return nil
+		},
+	}
+}
+
+func fixScopesGetter() *scopeautomock.ScopesGetter {
+	scopesGetter := &scopeautomock.ScopesGetter{}
+	scopesGetter.On("GetRequiredScopes", mock.Anything).Return([]string{operationsWriteScope}, nil)
+	return scopesGetter
+}
+
+func fixManageOperationRequest(t *testing.T, ctx context.Context, resourceType, resourceId, action string) *http.Request {
+	endpointPath := path.Join("/", resourceType, resourceId, action)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpointPath, nil)
+	require.NoError(t, err)
+
+	vars := map[string]string{"resource_type": resourceType, "resource_id": resourceId}
+	return mux.SetURLVars(req, vars)
+}
+
+func fixResourceFetcherFuncs(app *model.Application) map[resource.Type]operation.ResourceFetcherFunc {
+	return map[resource.Type]operation.ResourceFetcherFunc{
+		resource.Application: func(_ context.Context, _, _ string) (model.Entity, error) {
+			return app, nil
+		},
+	}
+}
+
+func fixApplication(ready bool, errorMsg *string) *model.Application {
+	now := time.Now()
+	return &model.Application{BaseEntity: &model.BaseEntity{ID: resourceID, CreatedAt: &now, UpdatedAt: &time.Time{}, DeletedAt: &time.Time{}, Ready: ready, Error: errorMsg}}
+}
+
+func fixManagedOperation(opType operation.OperationType) *operation.Operation {
+	return &operation.Operation{
+		OperationID:   operationID,
+		OperationType: opType,
+		ResourceID:    resourceID,
+		ResourceType:  resource.Application,
+	}
+}
+
+func str(s string) *string {
+	return &s
+}
@@ -79,7 +79,7 @@ type Operation struct {
 func (op *Operation) Validate() error {
 	return validation.ValidateStruct(op,
 		validation.Field(&op.ResourceID, is.UUID),
-		validation.Field(&op.ResourceType, validation.Required, validation.In(resource.Application)))
+		validation.Field(&op.ResourceType, validation.Required, validation.In(resource.Application, resource.Runtime)))
 }
 
 // SaveToContext saves Operation to the context
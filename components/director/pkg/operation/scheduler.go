@@ -29,3 +29,16 @@ type DisabledScheduler struct{}
 func (d *DisabledScheduler) Schedule(ctx context.Context, _ *Operation) (string, error) {
 	return "", apperrors.NewInvalidOperationError("operation scheduling is currently disabled")
 }
+
+// DisabledManager defines a Manager implementation that can be used when asynchronous operations are disabled
+type DisabledManager struct{}
+
+// Retry returns an error when called
+func (d *DisabledManager) Retry(ctx context.Context, _ *Operation) (*Operation, ApplyFunc, error) {
+	return nil, nil, apperrors.NewInvalidOperationError("operation management is currently disabled")
+}
+
+// Cancel returns an error when called
+func (d *DisabledManager) Cancel(ctx context.Context, _ *Operation) (*Operation, ApplyFunc, error) {
+	return nil, nil, apperrors.NewInvalidOperationError("operation management is currently disabled")
+}
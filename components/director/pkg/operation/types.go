@@ -9,3 +9,27 @@ import (
 type Scheduler interface {
 	Schedule(ctx context.Context, op *Operation) (string, error)
 }
+
+// ApplyFunc applies a retry or a cancellation prepared by a Manager outside of the database, e.g. to the Operation custom resources.
+// It is called only after the database transaction, in which the retry or the cancellation has been prepared, is committed.
+type ApplyFunc func(ctx context.Context) error
+
+// NoopApply is the ApplyFunc of the Managers which keep the Operation entities in the database only
+func NoopApply(_ context.Context) error {
+	return nil
+}
+
+// Manager is responsible for retrying failed and cancelling in-progress Operation entities
+//go:generate mockery -name=Manager -output=automock -outpkg=automock -case=underscore
+type Manager interface {
+	Retry(ctx context.Context, op *Operation) (*Operation, ApplyFunc, error)
+	Cancel(ctx context.Context, op *Operation) (*Operation, ApplyFunc, error)
+}
+
+// HistoryRecorder is responsible for keeping the history of the Operation entities of every resource
+//go:generate mockery -name=HistoryRecorder -output=automock -outpkg=automock -case=underscore
+type HistoryRecorder interface {
+	RecordScheduled(ctx context.Context, op *Operation) error
+	RecordFinished(ctx context.Context, request *OperationRequest) error
+	IsCancelled(ctx context.Context, operationID string) (bool, error)
+}
@@ -40,10 +40,14 @@ import (
 
 // OperationRequest is the expected request body when updating certain operation status
 type OperationRequest struct {
+	// OperationID is the ID assigned to the operation by the scheduler
+	OperationID   string        `json:"operation_id,omitempty"`
 	OperationType OperationType `json:"operation_type,omitempty"`
 	ResourceType  resource.Type `json:"resource_type"`
 	ResourceID    string        `json:"resource_id"`
 	Error         string        `json:"error"`
+	// WebhookResults are the outcomes of the executions of the webhooks of the operation
+	WebhookResults []WebhookResult `json:"webhook_results,omitempty"`
 }
 
 // ResourceUpdaterFunc defines a function which updates a particular resource ready and error status
@@ -53,9 +57,8 @@ type ResourceUpdaterFunc func(ctx context.Context, id string, ready bool, errorM
 type ResourceDeleterFunc func(ctx context.Context, id string) error
 
 type updateOperationHandler struct {
-	transact             persistence.Transactioner
-	resourceUpdaterFuncs map[resource.Type]ResourceUpdaterFunc
-	resourceDeleterFuncs map[resource.Type]ResourceDeleterFunc
+	transact persistence.Transactioner
+	finisher *Finisher
 }
 
 type errResponse struct {
@@ -68,11 +71,10 @@ type operationError struct {
 }
 
 // NewUpdateOperationHandler creates a new handler struct to update resource by operation
-func NewUpdateOperationHandler(transact persistence.Transactioner, resourceUpdaterFuncs map[resource.Type]ResourceUpdaterFunc, resourceDeleterFuncs map[resource.Type]ResourceDeleterFunc) *updateOperationHandler {
+func NewUpdateOperationHandler(transact persistence.Transactioner, resourceUpdaterFuncs map[resource.Type]ResourceUpdaterFunc, resourceDeleterFuncs map[resource.Type]ResourceDeleterFunc, historyRecorder HistoryRecorder) *updateOperationHandler {
 	return &updateOperationHandler{
-		transact:             transact,
-		resourceUpdaterFuncs: resourceUpdaterFuncs,
-		resourceDeleterFuncs: resourceDeleterFuncs,
+		transact: transact,
+		finisher: NewFinisher(resourceUpdaterFuncs, resourceDeleterFuncs, historyRecorder),
 	}
 }
 
@@ -94,7 +96,7 @@ func (h *updateOperationHandler) ServeHTTP(writer http.ResponseWriter, request *
 	if err := validation.ValidateStruct(operation,
 		validation.Field(&operation.ResourceID, is.UUID),
 		validation.Field(&operation.OperationType, validation.Required, validation.In(OperationTypeCreate, OperationTypeUpdate, OperationTypeDelete)),
-		validation.Field(&operation.ResourceType, validation.Required, validation.In(resource.Application))); err != nil {
+		validation.Field(&operation.ResourceType, validation.Required, validation.In(resource.Application, resource.Runtime))); err != nil {
 		apperrors.WriteAppError(ctx, writer, apperrors.NewInvalidDataError("Invalid operation properties: %s", err), http.StatusBadRequest)
 		return
 	}
@@ -109,42 +111,11 @@ func (h *updateOperationHandler) ServeHTTP(writer http.ResponseWriter, request *
 
 	ctx = persistence.SaveToContext(ctx, tx)
 
-	resourceUpdaterFunc := h.resourceUpdaterFuncs[operation.ResourceType]
-	opError, err := stringifiedJsonError(operation.Error)
-	if err != nil {
-		log.C(ctx).WithError(err).Errorf("An error occurred while marshalling operation error: %s", err.Error())
-		apperrors.WriteAppError(ctx, writer, apperrors.NewInternalError("Unable to marshal error"), http.StatusInternalServerError)
+	if err := h.finisher.Finish(ctx, operation); err != nil {
+		apperrors.WriteAppError(ctx, writer, err, http.StatusInternalServerError)
 		return
 	}
 
-	appConditionStatus := determineApplicationFinalStatus(operation.OperationType, opError)
-
-	switch operation.OperationType {
-	case OperationTypeCreate:
-		fallthrough
-	case OperationTypeUpdate:
-		if err := resourceUpdaterFunc(ctx, operation.ResourceID, true, opError, appConditionStatus); err != nil {
-			log.C(ctx).WithError(err).Errorf("While updating resource %s with id %s", operation.ResourceType, operation.ResourceID)
-			apperrors.WriteAppError(ctx, writer, apperrors.NewInternalError("Unable to update resource %s with id %s", operation.ResourceType, operation.ResourceID), http.StatusInternalServerError)
-			return
-		}
-	case OperationTypeDelete:
-		resourceDeleterFunc := h.resourceDeleterFuncs[operation.ResourceType]
-		if operation.Error != "" {
-			if err := resourceUpdaterFunc(ctx, operation.ResourceID, true, opError, appConditionStatus); err != nil {
-				log.C(ctx).WithError(err).Errorf("While updating resource %s with id %s", operation.ResourceType, operation.ResourceID)
-				apperrors.WriteAppError(ctx, writer, apperrors.NewInternalError("Unable to update resource %s with id %s", operation.ResourceType, operation.ResourceID), http.StatusInternalServerError)
-				return
-			}
-		} else {
-			if err := resourceDeleterFunc(ctx, operation.ResourceID); err != nil {
-				log.C(ctx).WithError(err).Errorf("While deleting resource %s with id %s", operation.ResourceType, operation.ResourceID)
-				apperrors.WriteAppError(ctx, writer, apperrors.NewInternalError("Unable to delete resource %s with id %s", operation.ResourceType, operation.ResourceID), http.StatusInternalServerError)
-				return
-			}
-		}
-	}
-
 	if err := tx.Commit(); err != nil {
 		log.C(ctx).WithError(err).Errorf("An error occurred while closing database transaction: %s", err.Error())
 		apperrors.WriteAppError(ctx, writer, apperrors.NewInternalError("Unable to finalize database operation"), http.StatusInternalServerError)
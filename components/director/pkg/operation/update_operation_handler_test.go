@@ -27,9 +27,11 @@ import (
 
 	"github.com/kyma-incubator/compass/components/director/internal/model"
 
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
 	"github.com/kyma-incubator/compass/components/director/pkg/operation"
+	"github.com/kyma-incubator/compass/components/director/pkg/operation/automock"
 	"github.com/kyma-incubator/compass/components/director/pkg/persistence/txtest"
 	"github.com/kyma-incubator/compass/components/director/pkg/resource"
 )
@@ -41,7 +43,7 @@ func TestUpdateOperationHandler(t *testing.T) {
 		req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, "/", nil)
 		require.NoError(t, err)
 
-		handler := operation.NewUpdateOperationHandler(nil, nil, nil)
+		handler := operation.NewUpdateOperationHandler(nil, nil, nil, nil)
 		handler.ServeHTTP(writer, req)
 
 		require.Contains(t, writer.Body.String(), "Method not allowed")
@@ -54,7 +56,7 @@ func TestUpdateOperationHandler(t *testing.T) {
 		req, err := http.NewRequestWithContext(context.Background(), http.MethodPut, "/", reader)
 		require.NoError(t, err)
 
-		handler := operation.NewUpdateOperationHandler(nil, nil, nil)
+		handler := operation.NewUpdateOperationHandler(nil, nil, nil, nil)
 		handler.ServeHTTP(writer, req)
 
 		require.Contains(t, writer.Body.String(), "Unable to decode body to JSON")
@@ -65,7 +67,7 @@ func TestUpdateOperationHandler(t *testing.T) {
 		writer := httptest.NewRecorder()
 		req := fixPostRequestWithBody(t, context.Background(), `{}`)
 
-		handler := operation.NewUpdateOperationHandler(nil, nil, nil)
+		handler := operation.NewUpdateOperationHandler(nil, nil, nil, nil)
 		handler.ServeHTTP(writer, req)
 
 		require.Contains(t, writer.Body.String(), "Invalid operation properties")
@@ -84,7 +86,7 @@ func TestUpdateOperationHandler(t *testing.T) {
 			resource.Application: func(ctx context.Context, id string, ready bool, errorMsg *string, appConditionStatus model.ApplicationStatusCondition) error {
 				return nil
 			},
-		}, nil)
+		}, nil, nil)
 		handler.ServeHTTP(writer, req)
 
 		require.Equal(t, http.StatusInternalServerError, writer.Code)
@@ -99,17 +101,87 @@ func TestUpdateOperationHandler(t *testing.T) {
 		defer mockedTx.AssertExpectations(t)
 		defer mockedTransactioner.AssertExpectations(t)
 
+		historyRecorder := &automock.HistoryRecorder{}
+		defer historyRecorder.AssertExpectations(t)
+		historyRecorder.On("RecordFinished", mock.Anything, mock.Anything).Return(nil).Once()
+
 		handler := operation.NewUpdateOperationHandler(mockedTransactioner, map[resource.Type]operation.ResourceUpdaterFunc{
 			resource.Application: func(ctx context.Context, id string, ready bool, errorMsg *string, appConditionStatus model.ApplicationStatusCondition) error {
 				return nil
 			},
-		}, nil)
+		}, nil, historyRecorder)
 		handler.ServeHTTP(writer, req)
 
 		require.Equal(t, http.StatusInternalServerError, writer.Code)
 		require.Contains(t, writer.Body.String(), "Unable to finalize database operation")
 	})
 
+	t.Run("when operation history fails to record the operation it should return internal server error", func(t *testing.T) {
+		writer := httptest.NewRecorder()
+		req := fixPostRequestWithBody(t, context.Background(), fmt.Sprintf(`{"resource_id": "%s", "resource_type": "%s", "operation_type": "%s"}`, resourceID, resource.Application, operation.OperationTypeCreate))
+
+		mockedTx, mockedTransactioner := txtest.NewTransactionContextGenerator(nil).ThatDoesntExpectCommit()
+		defer mockedTx.AssertExpectations(t)
+		defer mockedTransactioner.AssertExpectations(t)
+
+		historyRecorder := &automock.HistoryRecorder{}
+		defer historyRecorder.AssertExpectations(t)
+		historyRecorder.On("RecordFinished", mock.Anything, mock.Anything).Return(mockedError()).Once()
+
+		handler := operation.NewUpdateOperationHandler(mockedTransactioner, map[resource.Type]operation.ResourceUpdaterFunc{
+			resource.Application: func(ctx context.Context, id string, ready bool, errorMsg *string, appConditionStatus model.ApplicationStatusCondition) error {
+				return nil
+			},
+		}, nil, historyRecorder)
+		handler.ServeHTTP(writer, req)
+
+		mockedTx.AssertNotCalled(t, "Commit")
+		require.Equal(t, http.StatusInternalServerError, writer.Code)
+		require.Contains(t, writer.Body.String(), "Unable to record operation for resource application with id")
+	})
+
+	t.Run("when operation history fails to determine whether the operation has been cancelled it should return internal server error", func(t *testing.T) {
+		writer := httptest.NewRecorder()
+		req := fixPostRequestWithBody(t, context.Background(), fmt.Sprintf(`{"operation_id": "%s", "resource_id": "%s", "resource_type": "%s", "operation_type": "%s"}`, operationID, resourceID, resource.Application, operation.OperationTypeCreate))
+
+		mockedTx, mockedTransactioner := txtest.NewTransactionContextGenerator(nil).ThatDoesntExpectCommit()
+		defer mockedTx.AssertExpectations(t)
+		defer mockedTransactioner.AssertExpectations(t)
+
+		historyRecorder := &automock.HistoryRecorder{}
+		defer historyRecorder.AssertExpectations(t)
+		historyRecorder.On("IsCancelled", mock.Anything, operationID).Return(false, mockedError()).Once()
+
+		handler := operation.NewUpdateOperationHandler(mockedTransactioner, nil, nil, historyRecorder)
+		handler.ServeHTTP(writer, req)
+
+		mockedTx.AssertNotCalled(t, "Commit")
+		require.Equal(t, http.StatusInternalServerError, writer.Code)
+		require.Contains(t, writer.Body.String(), "Unable to check whether operation with id")
+	})
+
+	t.Run("when the operation has been cancelled it should discard its outcome", func(t *testing.T) {
+		writer := httptest.NewRecorder()
+		req := fixPostRequestWithBody(t, context.Background(), fmt.Sprintf(`{"operation_id": "%s", "resource_id": "%s", "resource_type": "%s", "operation_type": "%s"}`, operationID, resourceID, resource.Application, operation.OperationTypeDelete))
+
+		mockedTx, mockedTransactioner := txtest.NewTransactionContextGenerator(nil).ThatSucceeds()
+		defer mockedTx.AssertExpectations(t)
+		defer mockedTransactioner.AssertExpectations(t)
+
+		historyRecorder := &automock.HistoryRecorder{}
+		defer historyRecorder.AssertExpectations(t)
+		historyRecorder.On("IsCancelled", mock.Anything, operationID).Return(true, nil).Once()
+
+		handler := operation.NewUpdateOperationHandler(mockedTransactioner, nil, map[resource.Type]operation.ResourceDeleterFunc{
+			resource.Application: func(ctx context.Context, id string) error {
+				return errors.New("cancelled operation should not delete the resource")
+			},
+		}, historyRecorder)
+		handler.ServeHTTP(writer, req)
+
+		require.Equal(t, http.StatusOK, writer.Code)
+	})
+
 	t.Run("when update handler fails on CREATE/UPDATE operation", func(t *testing.T) {
 		writer := httptest.NewRecorder()
 		req := fixPostRequestWithBody(t, context.Background(), fmt.Sprintf(`{"resource_id": "%s", "resource_type": "%s", "operation_type": "%s"}`, resourceID, resource.Application, operation.OperationTypeCreate))
@@ -122,7 +194,7 @@ func TestUpdateOperationHandler(t *testing.T) {
 			resource.Application: func(ctx context.Context, id string, ready bool, errorMsg *string, appConditionStatus model.ApplicationStatusCondition) error {
 				return errors.New("failed to update")
 			},
-		}, nil)
+		}, nil, nil)
 		handler.ServeHTTP(writer, req)
 
 		mockedTx.AssertNotCalled(t, "Commit")
@@ -142,7 +214,7 @@ func TestUpdateOperationHandler(t *testing.T) {
 			resource.Application: func(ctx context.Context, id string) error {
 				return errors.New("failed to delete")
 			},
-		})
+		}, nil)
 		handler.ServeHTTP(writer, req)
 
 		mockedTx.AssertNotCalled(t, "Commit")
@@ -214,7 +286,17 @@ func TestUpdateOperationHandler(t *testing.T) {
 			t.Run(testCase.Name, func(t *testing.T) {
 				writer := httptest.NewRecorder()
 				expectedErrorMsg := testCase.ExpectedError
-				req := fixPostRequestWithBody(t, context.Background(), fmt.Sprintf(`{"resource_id": "%s", "resource_type": "%s", "operation_type": "%s", "error": "%s"}`, resourceID, resource.Application, testCase.OperationType, expectedErrorMsg))
+				req := fixPostRequestWithBody(t, context.Background(), fmt.Sprintf(`{"resource_id": "%s", "resource_type": "%s", "operation_type": "%s", "error": "%s", "webhook_results": [{"webhook_id": "%s", "state": "Success"}]}`, resourceID, resource.Application, testCase.OperationType, expectedErrorMsg, webhookID1))
+
+				historyRecorder := &automock.HistoryRecorder{}
+				defer historyRecorder.AssertExpectations(t)
+				historyRecorder.On("RecordFinished", mock.Anything, &operation.OperationRequest{
+					OperationType:  testCase.OperationType,
+					ResourceType:   resource.Application,
+					ResourceID:     resourceID,
+					Error:          expectedErrorMsg,
+					WebhookResults: []operation.WebhookResult{{WebhookID: webhookID1, State: "Success"}},
+				}).Return(nil).Once()
 
 				updateCalled := 0
 				deleteCalled := 0
@@ -237,7 +319,7 @@ func TestUpdateOperationHandler(t *testing.T) {
 						deleteCalled++
 						return nil
 					},
-				})
+				}, historyRecorder)
 
 				handler.ServeHTTP(writer, req)
 				require.Equal(t, testCase.UpdateCalled, updateCalled)
@@ -0,0 +1,117 @@
+package pagination
+
+import (
+	"encoding/base64"
+	"encoding/json"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/pkg/errors"
+)
+
+// keysetCursorVersion is the version of the cursors issued for keyset pagination.
+// The offset cursors issued before are treated as version 1.
+const keysetCursorVersion = 2
+
+// Request describes a requested page. The page is read forwards starting after the cursor,
+// or backwards ending before the cursor when Backward is set.
+type Request struct {
+	PageSize int
+	Cursor   string
+	Backward bool
+}
+
+// Keyset is the position of a row in a list ordered by a sort column, with the row ID used as a tie-breaker
+type Keyset struct {
+	OrderBy    string
+	Descending bool
+	SortValue  string
+	ID         string
+}
+
+type keysetCursor struct {
+	Version    int    `json:"v"`
+	OrderBy    string `json:"o"`
+	Descending bool   `json:"d,omitempty"`
+	SortValue  string `json:"s"`
+	ID         string `json:"id"`
+}
+
+// EncodeKeysetCursor returns an opaque cursor pointing at the given keyset
+func EncodeKeysetCursor(keyset Keyset) (string, error) {
+	payload, err := json.Marshal(keysetCursor{
+		Version:    keysetCursorVersion,
+		OrderBy:    keyset.OrderBy,
+		Descending: keyset.Descending,
+		SortValue:  keyset.SortValue,
+		ID:         keyset.ID,
+	})
+	if err != nil {
+		return "", errors.Wrap(err, "while marshalling cursor")
+	}
+
+	return base64.StdEncoding.EncodeToString(payload), nil
+}
+
+// DecodeCursor decodes both the keyset cursors and the offset cursors issued before keyset pagination was introduced.
+// For a keyset cursor the keyset is returned, for an offset cursor the offset is returned. An empty cursor decodes to offset 0.
+func DecodeCursor(cursor string) (*Keyset, int, error) {
+	if cursor == "" {
+		return nil, 0, nil
+	}
+
+	decodedValue, err := base64.StdEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "cursor is not correct")
+	}
+
+	if len(decodedValue) == 0 || decodedValue[0] != '{' {
+		offset, err := DecodeOffsetCursor(cursor)
+		return nil, offset, err
+	}
+
+	var decoded keysetCursor
+	if err := json.Unmarshal(decodedValue, &decoded); err != nil {
+		return nil, 0, errors.Wrap(err, "cursor is not correct")
+	}
+
+	if decoded.Version != keysetCursorVersion {
+		return nil, 0, apperrors.NewInvalidDataError("cursor version %d is not supported", decoded.Version)
+	}
+
+	if decoded.OrderBy == "" || decoded.ID == "" {
+		return nil, 0, apperrors.NewInvalidDataError("cursor is not correct")
+	}
+
+	return &Keyset{
+		OrderBy:    decoded.OrderBy,
+		Descending: decoded.Descending,
+		SortValue:  decoded.SortValue,
+		ID:         decoded.ID,
+	}, 0, nil
+}
+
+// NewRequest returns the page request for the connection arguments of a GraphQL query. The page is read backwards
+// when either last or before is provided, and its size is then taken from last if it is provided.
+func NewRequest(first *int, after string, last *int, before string) (Request, error) {
+	if after != "" && before != "" {
+		return Request{}, apperrors.NewInvalidDataError("parameters 'after' and 'before' cannot be used together")
+	}
+
+	if last != nil || before != "" {
+		pageSize := last
+		if pageSize == nil {
+			pageSize = first
+		}
+		if pageSize == nil {
+			return Request{}, apperrors.NewInvalidDataError("missing required parameter 'last'")
+		}
+
+		return Request{PageSize: *pageSize, Cursor: before, Backward: true}, nil
+	}
+
+	if first == nil {
+		return Request{}, apperrors.NewInvalidDataError("missing required parameter 'first'")
+	}
+
+	return Request{PageSize: *first, Cursor: after}, nil
+}
@@ -0,0 +1,161 @@
+package pagination
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncodeAndDecodeKeysetCursor(t *testing.T) {
+	t.Run("Success encoding and then decoding cursor", func(t *testing.T) {
+		//GIVEN
+		keyset := Keyset{
+			OrderBy:    "name",
+			Descending: true,
+			SortValue:  "foo",
+			ID:         "e3f3d5a3-8e3c-4c8e-9d2d-3b2bcd1a9a3a",
+		}
+
+		//WHEN
+		cursor, err := EncodeKeysetCursor(keyset)
+		require.NoError(t, err)
+		decodedKeyset, offset, err := DecodeCursor(cursor)
+
+		//THEN
+		require.NoError(t, err)
+		require.NotNil(t, decodedKeyset)
+		assert.Equal(t, keyset, *decodedKeyset)
+		assert.Equal(t, 0, offset)
+	})
+}
+
+func TestDecodeCursor(t *testing.T) {
+	testCases := []struct {
+		Name           string
+		InputCursor    string
+		ExpectedKeyset *Keyset
+		ExpectedOffset int
+		ExpectedErr    string
+	}{
+		{
+			Name:        "Success when cursor is empty",
+			InputCursor: "",
+		},
+		{
+			Name:           "Success for offset cursor",
+			InputCursor:    "RHBLdEo0ajlqRHExMDA=",
+			ExpectedOffset: 100,
+		},
+		{
+			Name:           "Success for keyset cursor",
+			InputCursor:    base64.StdEncoding.EncodeToString([]byte(`{"v":2,"o":"id","s":"foo","id":"foo"}`)),
+			ExpectedKeyset: &Keyset{OrderBy: "id", SortValue: "foo", ID: "foo"},
+		},
+		{
+			Name:        "Return error when keyset cursor version is not supported",
+			InputCursor: base64.StdEncoding.EncodeToString([]byte(`{"v":3,"o":"id","s":"foo","id":"foo"}`)),
+			ExpectedErr: "cursor version 3 is not supported",
+		},
+		{
+			Name:        "Return error when keyset cursor has no ID",
+			InputCursor: base64.StdEncoding.EncodeToString([]byte(`{"v":2,"o":"id","s":"foo"}`)),
+			ExpectedErr: "cursor is not correct",
+		},
+		{
+			Name:        "Return error when keyset cursor is not valid JSON",
+			InputCursor: base64.StdEncoding.EncodeToString([]byte(`{"v":2,`)),
+			ExpectedErr: "cursor is not correct",
+		},
+		{
+			Name:        "Return error when cursor is not valid BASE64 string",
+			InputCursor: "Zm9vLWJh-1cg==",
+			ExpectedErr: "cursor is not correct",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			//WHEN
+			keyset, offset, err := DecodeCursor(testCase.InputCursor)
+
+			//THEN
+			if testCase.ExpectedErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErr)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.ExpectedKeyset, keyset)
+				assert.Equal(t, testCase.ExpectedOffset, offset)
+			}
+		})
+	}
+}
+
+func TestNewRequest(t *testing.T) {
+	first := 10
+	last := 5
+
+	testCases := []struct {
+		Name            string
+		First           *int
+		After           string
+		Last            *int
+		Before          string
+		ExpectedRequest Request
+		ExpectedErr     string
+	}{
+		{
+			Name:            "Success for forward page",
+			First:           &first,
+			After:           "foo",
+			ExpectedRequest: Request{PageSize: first, Cursor: "foo"},
+		},
+		{
+			Name:            "Success for backward page",
+			First:           &first,
+			Last:            &last,
+			Before:          "foo",
+			ExpectedRequest: Request{PageSize: last, Cursor: "foo", Backward: true},
+		},
+		{
+			Name:            "Success for backward page sized by first",
+			First:           &first,
+			Before:          "foo",
+			ExpectedRequest: Request{PageSize: first, Cursor: "foo", Backward: true},
+		},
+		{
+			Name:            "Success for last page",
+			Last:            &last,
+			ExpectedRequest: Request{PageSize: last, Backward: true},
+		},
+		{
+			Name:        "Return error when both after and before are provided",
+			First:       &first,
+			After:       "foo",
+			Before:      "bar",
+			ExpectedErr: "parameters 'after' and 'before' cannot be used together",
+		},
+		{
+			Name:        "Return error when first is missing",
+			ExpectedErr: "missing required parameter 'first'",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			//WHEN
+			request, err := NewRequest(testCase.First, testCase.After, testCase.Last, testCase.Before)
+
+			//THEN
+			if testCase.ExpectedErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErr)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.ExpectedRequest, request)
+			}
+		})
+	}
+}
@@ -14,9 +14,10 @@ import (
 const surprise = "DpKtJ4j9jDq"
 
 type Page struct {
-	StartCursor string
-	EndCursor   string
-	HasNextPage bool
+	StartCursor     string
+	EndCursor       string
+	HasNextPage     bool
+	HasPreviousPage bool
 }
 
 func DecodeOffsetCursor(cursor string) (int, error) {
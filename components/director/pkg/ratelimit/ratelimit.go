@@ -0,0 +1,81 @@
+package ratelimit
+
+import (
+	"math"
+	"sync"
+	"time"
+
+	directorTime "github.com/kyma-incubator/compass/components/director/pkg/time"
+)
+
+// bucketsCleanupThreshold is the number of buckets after which the full buckets are dropped, as they are in the same state as new ones
+const bucketsCleanupThreshold = 10000
+
+// RateLimiter is a token bucket rate limiter, which keeps a separate bucket for every key.
+// Every bucket holds up to burst tokens and is refilled with rate tokens per second.
+// The buckets are kept in memory, so every replica of a component limits the keys on its own.
+type RateLimiter struct {
+	rate    float64
+	burst   float64
+	timeSvc directorTime.Service
+
+	mutex   sync.Mutex
+	buckets map[string]*bucket
+}
+
+type bucket struct {
+	tokens    float64
+	updatedAt time.Time
+}
+
+func NewRateLimiter(rate float64, burst int, timeSvc directorTime.Service) *RateLimiter {
+	return &RateLimiter{
+		rate:    rate,
+		burst:   math.Max(float64(burst), 1),
+		timeSvc: timeSvc,
+		buckets: make(map[string]*bucket),
+	}
+}
+
+// Allow takes a token from the bucket of the key. It returns false if the bucket is empty, together with the time after which a token is available.
+func (l *RateLimiter) Allow(key string) (bool, time.Duration) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	now := l.timeSvc.Now()
+	if len(l.buckets) >= bucketsCleanupThreshold {
+		l.dropFullBuckets(now)
+	}
+
+	b, ok := l.buckets[key]
+	if !ok {
+		b = &bucket{tokens: l.burst, updatedAt: now}
+		l.buckets[key] = b
+	}
+	b.tokens = l.tokensAt(b, now)
+	b.updatedAt = now
+
+	if b.tokens < 1 {
+		wait := time.Duration((1 - b.tokens) / l.rate * float64(time.Second))
+		return false, wait
+	}
+
+	b.tokens--
+	return true, 0
+}
+
+func (l *RateLimiter) tokensAt(b *bucket, now time.Time) float64 {
+	elapsed := now.Sub(b.updatedAt).Seconds()
+	if elapsed <= 0 {
+		return b.tokens
+	}
+	return math.Min(l.burst, b.tokens+elapsed*l.rate)
+}
+
+func (l *RateLimiter) dropFullBuckets(now time.Time) {
+	for key, b := range l.buckets {
+		if l.tokensAt(b, now) >= l.burst {
+			delete(l.buckets, key)
+		}
+	}
+}
@@ -0,0 +1,50 @@
+package ratelimit_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/ratelimit"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRateLimiter_Allow(t *testing.T) {
+	// GIVEN
+	clock := &fakeClock{now: time.Date(2021, 3, 1, 12, 0, 0, 0, time.UTC)}
+	limiter := ratelimit.NewRateLimiter(2, 2, clock)
+
+	// WHEN & THEN
+	allowed, _ := limiter.Allow("consumer")
+	assert.True(t, allowed)
+	allowed, _ = limiter.Allow("consumer")
+	assert.True(t, allowed)
+
+	allowed, retryAfter := limiter.Allow("consumer")
+	assert.False(t, allowed)
+	assert.Equal(t, 500*time.Millisecond, retryAfter)
+
+	allowed, _ = limiter.Allow("other-consumer")
+	assert.True(t, allowed)
+
+	clock.now = clock.now.Add(500 * time.Millisecond)
+	allowed, _ = limiter.Allow("consumer")
+	assert.True(t, allowed)
+	allowed, _ = limiter.Allow("consumer")
+	assert.False(t, allowed)
+
+	clock.now = clock.now.Add(time.Hour)
+	allowed, _ = limiter.Allow("consumer")
+	assert.True(t, allowed)
+	allowed, _ = limiter.Allow("consumer")
+	assert.True(t, allowed)
+	allowed, _ = limiter.Allow("consumer")
+	assert.False(t, allowed)
+}
+
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.now
+}
@@ -14,6 +14,7 @@ const (
 	Product                    Type = "product"
 	Vendor                     Type = "vendor"
 	Tombstone                  Type = "tombstone"
+	ORDSyncStatus              Type = "ordSyncStatus"
 	IntegrationSystem          Type = "integrationSystem"
 	Tenant                     Type = "tenant"
 	SystemAuth                 Type = "systemAuth"
@@ -25,6 +26,12 @@ const (
 	EventDefinition            Type = "eventDefinition"
 	AutomaticScenarioAssigment Type = "automaticScenarioAssigment"
 	Webhook                    Type = "webhook"
+	HealthCheck                Type = "healthCheck"
+	WebhookDelivery            Type = "webhookDelivery"
+	AppConfigurationChange     Type = "appConfigurationChange"
+	Operation                  Type = "operation"
+	ScheduledOperation         Type = "scheduledOperation"
+	JobLease                   Type = "jobLease"
 )
 
 type SQLOperation string
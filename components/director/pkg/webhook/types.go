@@ -21,7 +21,10 @@ import (
 	"encoding/json"
 	"net/http"
 	"net/url"
+	"strconv"
+	"strings"
 	"text/template"
+	"text/template/parse"
 
 	"github.com/kyma-incubator/compass/components/director/pkg/inputvalidation"
 
@@ -30,6 +33,17 @@ import (
 
 type Mode string
 
+// emptyIfMissingFunc is the name of the function appended to the pipelines of all printing actions in Webhook templates
+const emptyIfMissingFunc = "emptyIfMissing"
+
+// templateFuncs holds the helper functions available in all Webhook templates
+var templateFuncs = template.FuncMap{
+	"path":             lookupPath,
+	"toJSON":           toJSON,
+	"default":          defaultValue,
+	emptyIfMissingFunc: emptyIfMissing,
+}
+
 // Resource is used to identify entities which can be part of a webhook's request data
 type Resource interface {
 	Sentinel()
@@ -38,13 +52,17 @@ type Resource interface {
 // RequestObject struct contains parts of request that might be needed for later processing of a Webhook request
 type RequestObject struct {
 	Application Resource
+	Runtime     Resource
 	TenantID    string
 	Headers     map[string]string
 }
 
-// ResponseObject struct contains parts of response that might be needed for later processing of Webhook response
+// ResponseObject struct contains parts of response that might be needed for later processing of Webhook response.
+// Body holds the decoded JSON response body - objects are decoded as map[string]interface{}, arrays as []interface{}
+// and numbers as json.Number, so that nested values can be referenced either directly (e.g. {{.Body.status}})
+// or with the path template function (e.g. {{path .Body "result.items.0.id"}}) when parts of the path might be missing.
 type ResponseObject struct {
-	Body    map[string]string
+	Body    interface{}
 	Headers map[string]string
 }
 
@@ -149,20 +167,57 @@ func (rd *RequestObject) ParseHeadersTemplate(tmpl *string) (http.Header, error)
 
 func (rd *ResponseObject) ParseOutputTemplate(tmpl *string) (*Response, error) {
 	var resp Response
-	return &resp, parseTemplate(tmpl, *rd, &resp)
+	return &resp, parseTemplate(tmpl, rd.templateData(), &resp)
 }
 
 func (rd *ResponseObject) ParseStatusTemplate(tmpl *string) (*ResponseStatus, error) {
 	var respStatus ResponseStatus
-	return &respStatus, parseTemplate(tmpl, *rd, &respStatus)
+	return &respStatus, parseTemplate(tmpl, rd.templateData(), &respStatus)
+}
+
+// templateData returns a copy of the response object in which a missing body is replaced with an empty JSON object,
+// so that templates referencing fields of the body evaluate them to empty values instead of failing
+func (rd *ResponseObject) templateData() ResponseObject {
+	data := *rd
+	if data.Body == nil {
+		data.Body = map[string]interface{}{}
+	}
+
+	return data
+}
+
+// ParseResponseBody decodes the given JSON response body into a generic value suitable for ResponseObject.Body.
+// Numbers are decoded as json.Number in order to be rendered in templates exactly as they were received.
+func ParseResponseBody(body []byte) (interface{}, error) {
+	if len(bytes.TrimSpace(body)) == 0 {
+		return map[string]interface{}{}, nil
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+
+	var result interface{}
+	if err := decoder.Decode(&result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
 }
 
 func parseTemplate(tmpl *string, data interface{}, dest interface{}) error {
-	t, err := template.New("").Option("missingkey=zero").Parse(*tmpl)
+	t, err := template.New("").Option("missingkey=zero").Funcs(templateFuncs).Parse(*tmpl)
 	if err != nil {
 		return err
 	}
 
+	// the zero value of missing keys of generic JSON objects is a nil interface{}, which text/template prints as "<no value>",
+	// so printed values are passed through emptyIfMissing in order to keep them evaluating to empty values as with string-only bodies
+	for _, associated := range t.Templates() {
+		if associated.Tree != nil {
+			appendEmptyIfMissing(associated.Tree, associated.Tree.Root)
+		}
+	}
+
 	res := new(bytes.Buffer)
 	if err = t.Execute(res, data); err != nil {
 		return err
@@ -178,3 +233,99 @@ func parseTemplate(tmpl *string, data interface{}, dest interface{}) error {
 
 	return nil
 }
+
+// appendEmptyIfMissing appends the emptyIfMissing function to the pipelines of all actions printing a value in the given node
+func appendEmptyIfMissing(tree *parse.Tree, node parse.Node) {
+	switch typed := node.(type) {
+	case *parse.ListNode:
+		if typed == nil {
+			return
+		}
+		for _, child := range typed.Nodes {
+			appendEmptyIfMissing(tree, child)
+		}
+	case *parse.ActionNode:
+		if len(typed.Pipe.Decl) > 0 {
+			return
+		}
+		typed.Pipe.Cmds = append(typed.Pipe.Cmds, &parse.CommandNode{
+			NodeType: parse.NodeCommand,
+			Pos:      typed.Pos,
+			Args:     []parse.Node{parse.NewIdentifier(emptyIfMissingFunc).SetTree(tree).SetPos(typed.Pos)},
+		})
+	case *parse.IfNode:
+		appendEmptyIfMissing(tree, typed.List)
+		appendEmptyIfMissing(tree, typed.ElseList)
+	case *parse.RangeNode:
+		appendEmptyIfMissing(tree, typed.List)
+		appendEmptyIfMissing(tree, typed.ElseList)
+	case *parse.WithNode:
+		appendEmptyIfMissing(tree, typed.List)
+		appendEmptyIfMissing(tree, typed.ElseList)
+	}
+}
+
+// emptyIfMissing returns an empty string if the value is nil, and the value itself otherwise
+func emptyIfMissing(value interface{}) interface{} {
+	if value == nil {
+		return ""
+	}
+
+	return value
+}
+
+// lookupPath returns the value found under the given dot-separated path in a generic JSON value.
+// Path segments are used as keys for JSON objects and as indices for JSON arrays. If any part
+// of the path is missing nil is returned, which is rendered as an empty value in templates.
+func lookupPath(value interface{}, path string) interface{} {
+	if path == "" {
+		return value
+	}
+
+	current := value
+	for _, segment := range strings.Split(path, ".") {
+		switch typed := current.(type) {
+		case map[string]interface{}:
+			current = typed[segment]
+		case map[string]string:
+			current = typed[segment]
+		case []interface{}:
+			idx, err := strconv.Atoi(segment)
+			if err != nil || idx < 0 || idx >= len(typed) {
+				return nil
+			}
+			current = typed[idx]
+		default:
+			return nil
+		}
+
+		if current == nil {
+			return nil
+		}
+	}
+
+	return current
+}
+
+// toJSON encodes the given value as JSON, allowing whole objects, arrays or properly quoted strings to be embedded in templates
+func toJSON(value interface{}) (string, error) {
+	result, err := json.Marshal(value)
+	if err != nil {
+		return "", err
+	}
+
+	return string(result), nil
+}
+
+// defaultValue returns the given default if the value is nil or an empty string, and the value itself otherwise
+func defaultValue(defaultVal, value interface{}) interface{} {
+	if value == nil {
+		return defaultVal
+	}
+
+	if str, ok := value.(string); ok && str == "" {
+		return defaultVal
+	}
+
+	return value
+}
@@ -0,0 +1,129 @@
+/*
+ * Copyright 2020 The Compass Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package webhook_test
+
+import (
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/webhook"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResponseObject_ParseOutputTemplate(t *testing.T) {
+	testCases := []struct {
+		Name             string
+		Body             string
+		Template         string
+		ExpectedLocation string
+		ExpectedError    string
+		ExpectedErr      bool
+	}{
+		{
+			Name:             "Flat body keeps working with existing templates",
+			Body:             `{"location": "https://test-domain.com/operation", "error": "failure"}`,
+			Template:         `{"location": "{{.Body.location}}", "success_status_code": 202, "error": "{{.Body.error}}"}`,
+			ExpectedLocation: "https://test-domain.com/operation",
+			ExpectedError:    "failure",
+		},
+		{
+			Name:     "Missing keys are evaluated to empty values",
+			Body:     `{"status": "IN_PROGRESS"}`,
+			Template: `{"location": "{{.Body.location}}", "success_status_code": 202, "error": "{{.Body.error}}"}`,
+		},
+		{
+			Name:     "Missing keys inside conditionals and ranges are evaluated to empty values",
+			Body:     `{"operation": {"links": [{"rel": "self"}]}}`,
+			Template: `{"location": "{{range .Body.operation.links}}{{.href}}{{end}}", "success_status_code": 202, "error": "{{if .Body.operation}}{{.Body.error}}{{end}}"}`,
+		},
+		{
+			Name:          "Literal no value text in the body is kept",
+			Body:          `{"error": "<no value>"}`,
+			Template:      `{"location": "", "success_status_code": 202, "error": "{{.Body.error}}"}`,
+			ExpectedError: "<no value>",
+		},
+		{
+			Name:     "Empty body is evaluated as empty object",
+			Body:     ``,
+			Template: `{"location": "{{.Body.location}}", "success_status_code": 202, "error": "{{.Body.error}}"}`,
+		},
+		{
+			Name:             "Nested objects and arrays are navigated directly and with path",
+			Body:             `{"operation": {"links": [{"href": "https://test-domain.com/operation/1"}]}, "errors": [{"code": 42, "retryable": false}]}`,
+			Template:         `{"location": "{{(index .Body.operation.links 0).href}}", "success_status_code": 202, "error": "{{path .Body "errors.0.code"}}"}`,
+			ExpectedLocation: "https://test-domain.com/operation/1",
+			ExpectedError:    "42",
+		},
+		{
+			Name:             "Missing nested path is evaluated to the provided default",
+			Body:             `{"operation": {}}`,
+			Template:         `{"location": "{{path .Body "operation.links.0.href" | default "none"}}", "success_status_code": 202, "error": ""}`,
+			ExpectedLocation: "none",
+		},
+		{
+			Name:          "Nested object is embedded as JSON",
+			Body:          `{"error": {"message": "failure \"quoted\""}}`,
+			Template:      `{"location": "", "success_status_code": 202, "error": {{toJSON (toJSON .Body.error)}}}`,
+			ExpectedError: `{"message":"failure \"quoted\""}`,
+		},
+		{
+			Name:        "Missing intermediate object referenced directly fails",
+			Body:        `{}`,
+			Template:    `{"location": "{{.Body.operation.location}}", "success_status_code": 202, "error": ""}`,
+			ExpectedErr: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// GIVEN
+			body, err := webhook.ParseResponseBody([]byte(testCase.Body))
+			require.NoError(t, err)
+			responseObject := webhook.ResponseObject{Body: body}
+
+			// WHEN
+			response, err := responseObject.ParseOutputTemplate(&testCase.Template)
+
+			// THEN
+			if testCase.ExpectedErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, testCase.ExpectedLocation, *response.Location)
+			require.Equal(t, testCase.ExpectedError, *response.Error)
+		})
+	}
+}
+
+func TestResponseObject_ParseStatusTemplate_WhenBodyIsNotSet_ShouldEvaluateBodyFieldsToEmptyValues(t *testing.T) {
+	// GIVEN
+	tmpl := `{"status": "{{.Body.status}}", "success_status_code": 200, "success_status_identifier": "SUCCEEDED", "in_progress_status_identifier": "IN_PROGRESS", "failed_status_identifier": "FAILED", "error": "{{.Body.error}}"}`
+	responseObject := webhook.ResponseObject{}
+
+	// WHEN
+	status, err := responseObject.ParseStatusTemplate(&tmpl)
+
+	// THEN
+	require.NoError(t, err)
+	require.Equal(t, "", *status.Status)
+	require.Equal(t, "", *status.Error)
+}
+
+func TestParseResponseBody_WhenBodyIsInvalidJSON_ShouldReturnError(t *testing.T) {
+	_, err := webhook.ParseResponseBody([]byte(`{"status":`))
+	require.Error(t, err)
+}
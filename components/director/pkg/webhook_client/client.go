@@ -0,0 +1,263 @@
+package webhook_client
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/correlation"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	directorhttp "github.com/kyma-incubator/compass/components/director/pkg/http"
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	web_hook "github.com/kyma-incubator/compass/components/director/pkg/webhook"
+	"github.com/pkg/errors"
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/clientcredentials"
+)
+
+const emptyBody = `{}`
+
+// Client executes webhooks using their URL, input, header, output and status templates.
+// Request signing and client certificates of the webhooks are applied only if the underlying http client
+// uses the signing and client certificate transports of the http package.
+type Client struct {
+	httpClient *http.Client
+}
+
+// NewClient constructs a webhook Client executing the requests with the given http client
+func NewClient(httpClient *http.Client) *Client {
+	return &Client{
+		httpClient: httpClient,
+	}
+}
+
+// Do executes the webhook. The execution is successful if the response matches the output template of the webhook,
+// or has a 2xx status code if the webhook has no output template - in which case the returned response holds only the received status code.
+func (c *Client) Do(ctx context.Context, request *Request) (*web_hook.Response, error) {
+	var err error
+	webhook := request.Webhook
+
+	method := http.MethodPost
+	url := webhook.URL
+	if webhook.URLTemplate != nil {
+		resultURL, err := request.Object.ParseURLTemplate(webhook.URLTemplate)
+		if err != nil {
+			return nil, NewFatalErrorFromExisting(errors.Wrap(err, "unable to parse webhook URL"))
+		}
+		url = resultURL.Path
+		method = *resultURL.Method
+	}
+
+	if url == nil {
+		return nil, NewFatalError("missing webhook url")
+	}
+
+	body := []byte(emptyBody)
+	if webhook.InputTemplate != nil {
+		body, err = request.Object.ParseInputTemplate(webhook.InputTemplate)
+		if err != nil {
+			return nil, NewFatalErrorFromExisting(errors.Wrap(err, "unable to parse webhook input body"))
+		}
+	}
+
+	headers := http.Header{}
+	if webhook.HeaderTemplate != nil {
+		headers, err = request.Object.ParseHeadersTemplate(webhook.HeaderTemplate)
+		if err != nil {
+			return nil, NewFatalErrorFromExisting(errors.Wrap(err, "unable to parse webhook headers"))
+		}
+	}
+
+	ctx = correlation.SaveCorrelationIDHeaderToContext(ctx, webhook.CorrelationIDKey, &request.CorrelationID)
+
+	req, err := http.NewRequestWithContext(ctx, method, *url, bytes.NewBuffer(body))
+	if err != nil {
+		return nil, NewFatalErrorFromExisting(err)
+	}
+	req.Header = headers
+
+	resp, err := c.execute(ctx, req, webhook.Auth)
+	if err != nil {
+		return nil, err
+	}
+	defer closeResponseBody(ctx, resp)
+
+	if webhook.OutputTemplate == nil {
+		statusCode := resp.StatusCode
+		if statusCode < http.StatusOK || statusCode >= http.StatusMultipleChoices {
+			return nil, NewStatusCodeErr(statusCode, fmt.Sprintf("webhook responded with status code %d", statusCode))
+		}
+		return &web_hook.Response{SuccessStatusCode: &statusCode}, nil
+	}
+
+	responseObject, err := parseResponseObject(resp)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := responseObject.ParseOutputTemplate(webhook.OutputTemplate)
+	if err != nil {
+		return nil, NewFatalErrorFromExisting(errors.Wrap(err, "unable to parse response into webhook output template"))
+	}
+
+	if err = checkForGoneStatus(resp, response.GoneStatusCode); err != nil {
+		return response, err
+	}
+
+	isLocationEmpty := response.Location != nil && *response.Location == ""
+	isAsyncWebhook := webhook.Mode != nil && *webhook.Mode == graphql.WebhookModeAsync
+
+	if isLocationEmpty && isAsyncWebhook {
+		return nil, errors.New("missing location url after executing async webhook")
+	}
+
+	return response, checkForErr(resp, response.SuccessStatusCode, response.Error)
+}
+
+// Poll checks the status of the operation started by an async webhook using the status template of the webhook
+func (c *Client) Poll(ctx context.Context, request *PollRequest) (*web_hook.ResponseStatus, error) {
+	var err error
+	webhook := request.Webhook
+
+	if webhook.StatusTemplate == nil {
+		return nil, NewFatalError("missing status template")
+	}
+
+	headers := http.Header{}
+	if webhook.HeaderTemplate != nil {
+		headers, err = request.Object.ParseHeadersTemplate(webhook.HeaderTemplate)
+		if err != nil {
+			return nil, NewFatalErrorFromExisting(errors.Wrap(err, "unable to parse webhook headers"))
+		}
+	}
+
+	ctx = correlation.SaveCorrelationIDHeaderToContext(ctx, webhook.CorrelationIDKey, &request.CorrelationID)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, request.PollURL, nil)
+	if err != nil {
+		return nil, NewFatalErrorFromExisting(err)
+	}
+	req.Header = headers
+
+	resp, err := c.execute(ctx, req, webhook.Auth)
+	if err != nil {
+		return nil, err
+	}
+	defer closeResponseBody(ctx, resp)
+
+	responseObject, err := parseResponseObject(resp)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := responseObject.ParseStatusTemplate(webhook.StatusTemplate)
+	if err != nil {
+		return nil, NewFatalErrorFromExisting(errors.Wrap(err, "unable to parse response status into status template"))
+	}
+
+	return response, checkForErr(resp, response.SuccessStatusCode, response.Error)
+}
+
+// execute applies the authentication of the webhook to the request and executes it.
+// Basic credentials and additional headers are set on the request, OAuth credentials are used to obtain a token for it,
+// while the request signing and the client certificate are left to the transports of the http client.
+func (c *Client) execute(ctx context.Context, req *http.Request, auth *graphql.Auth) (*http.Response, error) {
+	if auth == nil {
+		return c.httpClient.Do(req)
+	}
+
+	for key, values := range auth.AdditionalHeaders {
+		for _, value := range values {
+			req.Header.Add(key, value)
+		}
+	}
+
+	if auth.Signing != nil {
+		ctx = directorhttp.SaveRequestSigningToContext(ctx, directorhttp.RequestSigning{
+			Header:          auth.Signing.Header,
+			TimestampHeader: auth.Signing.TimestampHeader,
+			Secrets:         auth.Signing.Secrets,
+		})
+	}
+
+	httpClient := c.httpClient
+	switch credential := auth.Credential.(type) {
+	case *graphql.BasicCredentialData:
+		req.SetBasicAuth(credential.Username, credential.Password)
+	case *graphql.OAuthCredentialData:
+		conf := &clientcredentials.Config{
+			ClientID:     credential.ClientID,
+			ClientSecret: credential.ClientSecret,
+			TokenURL:     credential.URL,
+		}
+
+		httpClient = conf.Client(context.WithValue(ctx, oauth2.HTTPClient, c.httpClient))
+		httpClient.Timeout = c.httpClient.Timeout
+	case *graphql.CertificateCredentialData:
+		ctx = directorhttp.SaveClientCertificateToContext(ctx, directorhttp.ClientCertificate{
+			Certificate: credential.Certificate,
+			PrivateKey:  credential.PrivateKey,
+		})
+	}
+
+	return httpClient.Do(req.WithContext(ctx))
+}
+
+func closeResponseBody(ctx context.Context, resp *http.Response) {
+	if err := resp.Body.Close(); err != nil {
+		log.C(ctx).WithError(err).Warn("An error has occurred while closing response body")
+	}
+}
+
+func parseResponseObject(resp *http.Response) (*web_hook.ResponseObject, error) {
+	bytes, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := web_hook.ParseResponseBody(bytes)
+	if err != nil {
+		return nil, err
+	}
+
+	headers := make(map[string]string)
+	for key, value := range resp.Header {
+		headers[key] = value[0]
+	}
+
+	return &web_hook.ResponseObject{
+		Headers: headers,
+		Body:    body,
+	}, nil
+}
+
+func checkForErr(resp *http.Response, successStatusCode *int, error *string) error {
+	var errMsg string
+	statusCodeMet := *successStatusCode == resp.StatusCode
+	if !statusCodeMet {
+		errMsg += fmt.Sprintf("response success status code was not met - expected %d, got %d; ", *successStatusCode, resp.StatusCode)
+	}
+
+	if error != nil && *error != "" {
+		errMsg += fmt.Sprintf("received error from webhook: %s", *error)
+	}
+
+	if !statusCodeMet {
+		return NewStatusCodeErr(resp.StatusCode, errMsg)
+	}
+
+	if errMsg != "" {
+		return errors.New(errMsg)
+	}
+
+	return nil
+}
+
+func checkForGoneStatus(resp *http.Response, goneStatusCode *int) error {
+	if goneStatusCode != nil && resp.StatusCode == *goneStatusCode {
+		return NewStatusGoneErr(*goneStatusCode)
+	}
+	return nil
+}
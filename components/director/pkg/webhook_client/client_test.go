@@ -0,0 +1,179 @@
+package webhook_client_test
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/str"
+	web_hook "github.com/kyma-incubator/compass/components/director/pkg/webhook"
+	"github.com/kyma-incubator/compass/components/director/pkg/webhook_client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	outputTemplate = `{"location": "{{.Headers.Location}}", "success_status_code": 202, "gone_status_code": 404, "error": "{{.Body.error}}"}`
+	statusTemplate = `{"status": "{{.Body.status}}", "success_status_code": 200, "success_status_identifier": "SUCCEEDED", "in_progress_status_identifier": "IN_PROGRESS", "failed_status_identifier": "FAILED", "error": "{{.Body.error}}"}`
+)
+
+func TestClient_Do(t *testing.T) {
+	var received *http.Request
+	var receivedBody string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := ioutil.ReadAll(r.Body)
+		require.NoError(t, err)
+		received, receivedBody = r, string(body)
+
+		switch r.URL.Path {
+		case "/ok":
+			w.WriteHeader(http.StatusOK)
+		case "/accepted":
+			w.Header().Set("Location", "https://test-domain.com/operation")
+			w.WriteHeader(http.StatusAccepted)
+		case "/error":
+			w.WriteHeader(http.StatusAccepted)
+			_, err := w.Write([]byte(`{"error": "application is not ready"}`))
+			require.NoError(t, err)
+		case "/gone":
+			w.WriteHeader(http.StatusNotFound)
+		default:
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	defer server.Close()
+
+	app := &graphql.Application{BaseEntity: &graphql.BaseEntity{ID: "appID"}, Name: "foo"}
+	urlTemplate := func(path string) *string {
+		return str.Ptr(`{"method": "DELETE", "path": "` + server.URL + path + `"}`)
+	}
+
+	t.Run("calls the webhook with the rendered templates and credentials", func(t *testing.T) {
+		request := webhook_client.NewRequest(graphql.Webhook{
+			URLTemplate:    urlTemplate("/accepted"),
+			InputTemplate:  str.Ptr(`{"application_id": "{{.Application.ID}}"}`),
+			HeaderTemplate: str.Ptr(`{"X-App-Name": ["{{.Application.Name}}"]}`),
+			OutputTemplate: str.Ptr(outputTemplate),
+			Auth: &graphql.Auth{
+				Credential:        &graphql.BasicCredentialData{Username: "user", Password: "pass"},
+				AdditionalHeaders: graphql.HttpHeaders{"X-Additional": {"value"}},
+			},
+		}, web_hook.RequestObject{Application: app}, "correlationID")
+		//WHEN
+		response, err := webhook_client.NewClient(server.Client()).Do(context.TODO(), request)
+		//THEN
+		require.NoError(t, err)
+		assert.Equal(t, "https://test-domain.com/operation", str.PtrStrToStr(response.Location))
+		assert.Equal(t, http.MethodDelete, received.Method)
+		assert.JSONEq(t, `{"application_id": "appID"}`, receivedBody)
+		assert.Equal(t, "foo", received.Header.Get("X-App-Name"))
+		assert.Equal(t, "value", received.Header.Get("X-Additional"))
+		username, password, ok := received.BasicAuth()
+		require.True(t, ok)
+		assert.Equal(t, "user", username)
+		assert.Equal(t, "pass", password)
+	})
+
+	t.Run("succeeds with a 2xx status code when the webhook has no output template", func(t *testing.T) {
+		request := webhook_client.NewRequest(graphql.Webhook{URL: str.Ptr(server.URL + "/ok")}, web_hook.RequestObject{}, "correlationID")
+		//WHEN
+		response, err := webhook_client.NewClient(server.Client()).Do(context.TODO(), request)
+		//THEN
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, *response.SuccessStatusCode)
+		assert.Equal(t, http.MethodPost, received.Method)
+		assert.JSONEq(t, `{}`, receivedBody)
+	})
+
+	t.Run("fails with the status code when the webhook without output template responds with an error status code", func(t *testing.T) {
+		request := webhook_client.NewRequest(graphql.Webhook{URL: str.Ptr(server.URL + "/unavailable")}, web_hook.RequestObject{}, "correlationID")
+		//WHEN
+		_, err := webhook_client.NewClient(server.Client()).Do(context.TODO(), request)
+		//THEN
+		require.EqualError(t, err, "webhook responded with status code 503")
+		statusCode, ok := webhook_client.StatusCode(err)
+		require.True(t, ok)
+		assert.Equal(t, http.StatusServiceUnavailable, statusCode)
+	})
+
+	t.Run("fails with the status code when the success status code is not met", func(t *testing.T) {
+		request := webhook_client.NewRequest(graphql.Webhook{URLTemplate: urlTemplate("/unavailable"), OutputTemplate: str.Ptr(outputTemplate)}, web_hook.RequestObject{}, "correlationID")
+		//WHEN
+		_, err := webhook_client.NewClient(server.Client()).Do(context.TODO(), request)
+		//THEN
+		require.Error(t, err)
+		statusCode, ok := webhook_client.StatusCode(err)
+		require.True(t, ok)
+		assert.Equal(t, http.StatusServiceUnavailable, statusCode)
+	})
+
+	t.Run("fails when the webhook responds with an error", func(t *testing.T) {
+		request := webhook_client.NewRequest(graphql.Webhook{URLTemplate: urlTemplate("/error"), OutputTemplate: str.Ptr(outputTemplate)}, web_hook.RequestObject{}, "correlationID")
+		//WHEN
+		_, err := webhook_client.NewClient(server.Client()).Do(context.TODO(), request)
+		//THEN
+		require.EqualError(t, err, "received error from webhook: application is not ready")
+		_, ok := webhook_client.StatusCode(err)
+		assert.False(t, ok)
+	})
+
+	t.Run("fails with gone error when the gone status code is met", func(t *testing.T) {
+		request := webhook_client.NewRequest(graphql.Webhook{URLTemplate: urlTemplate("/gone"), OutputTemplate: str.Ptr(outputTemplate)}, web_hook.RequestObject{}, "correlationID")
+		//WHEN
+		response, err := webhook_client.NewClient(server.Client()).Do(context.TODO(), request)
+		//THEN
+		require.Error(t, err)
+		assert.True(t, webhook_client.IsStatusGoneErr(err))
+		assert.Equal(t, http.StatusNotFound, *response.GoneStatusCode)
+	})
+
+	t.Run("fails with fatal error when the webhook has no url", func(t *testing.T) {
+		request := webhook_client.NewRequest(graphql.Webhook{OutputTemplate: str.Ptr(outputTemplate)}, web_hook.RequestObject{}, "correlationID")
+		//WHEN
+		_, err := webhook_client.NewClient(server.Client()).Do(context.TODO(), request)
+		//THEN
+		require.EqualError(t, err, "missing webhook url")
+		assert.True(t, webhook_client.IsFatalErr(err))
+	})
+
+	t.Run("fails with fatal error when the input template is invalid", func(t *testing.T) {
+		request := webhook_client.NewRequest(graphql.Webhook{URLTemplate: urlTemplate("/ok"), InputTemplate: str.Ptr("invalid")}, web_hook.RequestObject{}, "correlationID")
+		//WHEN
+		_, err := webhook_client.NewClient(server.Client()).Do(context.TODO(), request)
+		//THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "unable to parse webhook input body")
+		assert.True(t, webhook_client.IsFatalErr(err))
+	})
+}
+
+func TestClient_Poll(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		w.WriteHeader(http.StatusOK)
+		_, err := w.Write([]byte(`{"status": "IN_PROGRESS"}`))
+		require.NoError(t, err)
+	}))
+	defer server.Close()
+
+	t.Run("returns the status of the operation", func(t *testing.T) {
+		request := webhook_client.NewPollRequest(graphql.Webhook{StatusTemplate: str.Ptr(statusTemplate)}, web_hook.RequestObject{}, "correlationID", server.URL)
+		//WHEN
+		status, err := webhook_client.NewClient(server.Client()).Poll(context.TODO(), request)
+		//THEN
+		require.NoError(t, err)
+		assert.Equal(t, "IN_PROGRESS", str.PtrStrToStr(status.Status))
+	})
+
+	t.Run("fails with fatal error when the webhook has no status template", func(t *testing.T) {
+		request := webhook_client.NewPollRequest(graphql.Webhook{}, web_hook.RequestObject{}, "correlationID", server.URL)
+		//WHEN
+		_, err := webhook_client.NewClient(server.Client()).Poll(context.TODO(), request)
+		//THEN
+		require.EqualError(t, err, "missing status template")
+		assert.True(t, webhook_client.IsFatalErr(err))
+	})
+}
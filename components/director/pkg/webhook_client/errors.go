@@ -0,0 +1,68 @@
+package webhook_client
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+)
+
+// FatalErr denotes a failure of a webhook execution which would not be resolved by retrying the execution
+type FatalErr struct {
+	error
+}
+
+// NewFatalError constructs a new FatalErr with the given error message
+func NewFatalError(message string) *FatalErr {
+	return &FatalErr{error: errors.New(message)}
+}
+
+// NewFatalErrorFromExisting constructs a new FatalErr based on the provided error
+func NewFatalErrorFromExisting(err error) *FatalErr {
+	return &FatalErr{error: err}
+}
+
+// IsFatalErr checks whether an error is a FatalErr
+func IsFatalErr(err error) bool {
+	_, ok := err.(*FatalErr)
+	return ok
+}
+
+// StatusGoneErr denotes that a webhook has responded with the gone status code of its output template
+type StatusGoneErr struct {
+	error
+}
+
+// NewStatusGoneErr constructs a new StatusGoneErr for the given gone status code
+func NewStatusGoneErr(goneStatusCode int) StatusGoneErr {
+	return StatusGoneErr{error: fmt.Errorf("gone response status %d was met while calling webhook", goneStatusCode)}
+}
+
+// IsStatusGoneErr checks whether an error is a StatusGoneErr
+func IsStatusGoneErr(err error) bool {
+	_, ok := err.(StatusGoneErr)
+	return ok
+}
+
+// StatusCodeErr denotes that a webhook has responded with a status code other than the expected success status code
+type StatusCodeErr struct {
+	error
+	StatusCode int
+}
+
+// NewStatusCodeErr constructs a new StatusCodeErr for the given status code with the given error message
+func NewStatusCodeErr(statusCode int, message string) *StatusCodeErr {
+	return &StatusCodeErr{
+		error:      errors.New(message),
+		StatusCode: statusCode,
+	}
+}
+
+// StatusCode returns the status code carried by a StatusCodeErr and reports whether the provided error is such an error
+func StatusCode(err error) (int, bool) {
+	statusCodeErr, ok := err.(*StatusCodeErr)
+	if !ok {
+		return 0, false
+	}
+
+	return statusCodeErr.StatusCode, true
+}
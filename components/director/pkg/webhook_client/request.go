@@ -0,0 +1,36 @@
+package webhook_client
+
+import (
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	web_hook "github.com/kyma-incubator/compass/components/director/pkg/webhook"
+)
+
+// Request represents a webhook request to be executed
+type Request struct {
+	Webhook       graphql.Webhook
+	Object        web_hook.RequestObject
+	CorrelationID string
+}
+
+// PollRequest represents a webhook poll request to be executed
+type PollRequest struct {
+	*Request
+	PollURL string
+}
+
+// NewRequest constructs a webhook Request
+func NewRequest(webhook graphql.Webhook, requestObject web_hook.RequestObject, correlationID string) *Request {
+	return &Request{
+		Webhook:       webhook,
+		Object:        requestObject,
+		CorrelationID: correlationID,
+	}
+}
+
+// NewPollRequest constructs a webhook PollRequest
+func NewPollRequest(webhook graphql.Webhook, requestObject web_hook.RequestObject, correlationID string, pollURL string) *PollRequest {
+	return &PollRequest{
+		Request: NewRequest(webhook, requestObject, correlationID),
+		PollURL: pollURL,
+	}
+}
@@ -0,0 +1,70 @@
+package webhook_client_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/webhook_client"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewRetryPolicy_WhenPolicyIsMissing_ShouldRetryWithDefaultIntervalUntilTimeout(t *testing.T) {
+	retryPolicy := webhook_client.NewRetryPolicy(nil, time.Minute)
+
+	require.False(t, retryPolicy.AttemptsExhausted(100))
+	require.True(t, retryPolicy.IsRetryable(webhook_client.NewStatusCodeErr(500, "internal server error")))
+	require.Equal(t, time.Minute, retryPolicy.Backoff(1))
+	require.Equal(t, time.Minute, retryPolicy.Backoff(10))
+}
+
+func TestRetryPolicy_Backoff(t *testing.T) {
+	retryPolicy := webhook_client.NewRetryPolicy(&graphql.WebhookRetryPolicy{
+		InitialInterval: intPtr(1),
+		MaxInterval:     intPtr(10),
+		Multiplier:      floatPtr(2),
+	}, time.Minute)
+
+	require.Equal(t, 1*time.Second, retryPolicy.Backoff(1))
+	require.Equal(t, 2*time.Second, retryPolicy.Backoff(2))
+	require.Equal(t, 4*time.Second, retryPolicy.Backoff(3))
+	require.Equal(t, 8*time.Second, retryPolicy.Backoff(4))
+	require.Equal(t, 10*time.Second, retryPolicy.Backoff(5))
+}
+
+func TestRetryPolicy_Backoff_WhenJitterIsProvided_ShouldRandomizeIntervalWithinBounds(t *testing.T) {
+	retryPolicy := webhook_client.NewRetryPolicy(&graphql.WebhookRetryPolicy{
+		InitialInterval: intPtr(10),
+		Jitter:          floatPtr(0.5),
+	}, time.Minute)
+
+	for i := 0; i < 100; i++ {
+		backoff := retryPolicy.Backoff(1)
+		require.True(t, backoff >= 5*time.Second && backoff <= 15*time.Second, "unexpected backoff %s", backoff)
+	}
+}
+
+func TestRetryPolicy_AttemptsExhausted(t *testing.T) {
+	retryPolicy := webhook_client.NewRetryPolicy(&graphql.WebhookRetryPolicy{MaxAttempts: intPtr(3)}, time.Minute)
+
+	require.False(t, retryPolicy.AttemptsExhausted(1))
+	require.False(t, retryPolicy.AttemptsExhausted(2))
+	require.True(t, retryPolicy.AttemptsExhausted(3))
+}
+
+func TestRetryPolicy_IsRetryable(t *testing.T) {
+	retryPolicy := webhook_client.NewRetryPolicy(&graphql.WebhookRetryPolicy{RetryableStatusCodes: []int{429, 503}}, time.Minute)
+
+	require.True(t, retryPolicy.IsRetryable(webhook_client.NewStatusCodeErr(503, "service unavailable")))
+	require.False(t, retryPolicy.IsRetryable(webhook_client.NewStatusCodeErr(400, "bad request")))
+	require.True(t, retryPolicy.IsRetryable(errors.New("connection refused")))
+}
+
+func intPtr(i int) *int {
+	return &i
+}
+
+func floatPtr(f float64) *float64 {
+	return &f
+}
@@ -14,6 +14,7 @@ ROOT_PATH=$( cd "$( dirname "${BASH_SOURCE[0]}" )" && pwd )
 SKIP_DB_CLEANUP=false
 REUSE_DB=false
 DISABLE_ASYNC_MODE=true
+OPERATIONS_SCHEDULER=kubernetes
 
 POSITIONAL=()
 while [[ $# -gt 0 ]]
@@ -43,6 +44,11 @@ do
           DISABLE_ASYNC_MODE=false
           shift
         ;;
+        --async-db-scheduler)
+          DISABLE_ASYNC_MODE=false
+          OPERATIONS_SCHEDULER=database
+          shift
+        ;;
         --debug-port)
             DEBUG_PORT=$2
             shift
@@ -151,6 +157,7 @@ export APP_CONNECTOR_URL="http://connector.not.configured.url/connector/graphql"
 export APP_LEGACY_CONNECTOR_URL="https://adapter-gateway.kyma.local/v1/applications/signingRequests/info"
 export APP_LOG_LEVEL=debug
 export APP_DISABLE_ASYNC_MODE=${DISABLE_ASYNC_MODE}
+export APP_OPERATIONS_SCHEDULER=${OPERATIONS_SCHEDULER}
 
 if [[  ${DEBUG} ]]; then
     echo -e "${GREEN}Debug mode activated on port $DEBUG_PORT${NC}"
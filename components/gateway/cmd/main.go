@@ -12,11 +12,13 @@ import (
 
 	"github.com/gorilla/mux"
 	"github.com/kyma-incubator/compass/components/director/pkg/correlation"
+	director "github.com/kyma-incubator/compass/components/director/pkg/graphql"
 	timeouthandler "github.com/kyma-incubator/compass/components/director/pkg/handler"
 	httputil "github.com/kyma-incubator/compass/components/director/pkg/http"
 	"github.com/kyma-incubator/compass/components/director/pkg/log"
 	"github.com/kyma-incubator/compass/components/director/pkg/signal"
 	"github.com/kyma-incubator/compass/components/gateway/internal/auditlog"
+	"github.com/kyma-incubator/compass/components/gateway/internal/querylimit"
 	timeservices "github.com/kyma-incubator/compass/components/gateway/internal/time"
 	"github.com/kyma-incubator/compass/components/gateway/internal/uuid"
 	"github.com/kyma-incubator/compass/components/gateway/pkg/proxy"
@@ -73,13 +75,21 @@ func main() {
 		auditlogSvc = &auditlog.NoOpService{}
 	}
 
+	queryLimitCfg := querylimit.Config{}
+	err = envconfig.InitWithPrefix(&queryLimitCfg, "APP")
+	exitOnError(err, "Error while loading query limit config")
+
+	queryLimitCollector := metrics.NewQueryLimitCollector()
+	prometheus.MustRegister(queryLimitCollector)
+	queryLimitMiddleware := querylimit.NewMiddleware(queryLimitCfg, queryLimitCollector, &timeservices.TimeService{})
+
 	correlationTr := httputil.NewCorrelationIDTransport(http.DefaultTransport)
 	tr := proxy.NewTransport(auditlogSink, auditlogSvc, correlationTr)
 
-	err = proxyRequestsForComponent(ctx, router, "/connector", cfg.ConnectorOrigin, tr)
+	err = proxyRequestsForComponent(ctx, router, "/connector", cfg.ConnectorOrigin, tr, queryLimitMiddleware.Handler())
 	exitOnError(err, "Error while initializing proxy for Connector")
 
-	err = proxyRequestsForComponent(ctx, router, "/director", cfg.DirectorOrigin, tr)
+	err = proxyRequestsForComponent(ctx, router, "/director", cfg.DirectorOrigin, tr, queryLimitMiddleware.Handler())
 	exitOnError(err, "Error while initializing proxy for Director")
 
 	router.HandleFunc("/healthz", func(writer http.ResponseWriter, request *http.Request) {
@@ -240,13 +250,29 @@ func initAuditLogs(ctx context.Context, collector *metrics.AuditlogCollector) (p
 		return nil, nil, errors.Wrap(err, "Error while creating auditlog client from cfg")
 	}
 
-	auditlogSvc := auditlog.NewService(auditlogClient, msgFactory)
-	msgChannel := make(chan proxy.AuditlogMessage, cfg.MsgChannelSize)
+	redactedFields := append(append([]string{}, auditlog.DefaultRedactedFields...), cfg.RedactedFields...)
+	redactor, err := auditlog.NewRedactor(director.NewExecutableSchema(director.Config{}).Schema(), redactedFields)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "while creating auditlog redactor")
+	}
+
+	auditlogSvc := auditlog.NewService(auditlogClient, msgFactory, redactor)
+	spool, err := auditlog.NewDiskSpool(ctx, cfg.SpoolDir, cfg.SpoolMaxSize, timeSvc)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "while initializing auditlog spool")
+	}
+
+	retryPolicy := auditlog.RetryPolicy{
+		InitialBackoff: cfg.RetryInitialBackoff,
+		MaxBackoff:     cfg.RetryMaxBackoff,
+		MaxAttempts:    cfg.RetryMaxAttempts,
+	}
 	workers := make(chan bool, cfg.WriteWorkers)
-	initWorkers(ctx, workers, auditlogSvc, msgChannel, collector)
+	initWorkers(ctx, workers, auditlogSvc, spool, retryPolicy, timeSvc, collector)
+	go auditlog.MonitorBacklog(ctx, spool, timeSvc, collector, cfg.MetricsInterval)
 
-	log.C(ctx).Infof("Auditlog configured successfully, auth mode: %s", cfg.AuthMode)
-	return auditlog.NewSink(msgChannel, cfg.MsgChannelTimeout, collector), auditlogSvc, nil
+	log.C(ctx).Infof("Auditlog configured successfully, auth mode: %s, spool directory: %s", cfg.AuthMode, cfg.SpoolDir)
+	return auditlog.NewSink(spool, redactor), auditlogSvc, nil
 }
 
 func fillJWTCredentials(cfg auditlog.OAuthConfig) clientcredentials.Config {
@@ -258,7 +284,7 @@ func fillJWTCredentials(cfg auditlog.OAuthConfig) clientcredentials.Config {
 	}
 }
 
-func initWorkers(ctx context.Context, workers chan bool, auditlogSvc proxy.AuditlogService, msgChannel chan proxy.AuditlogMessage, collector *metrics.AuditlogCollector) {
+func initWorkers(ctx context.Context, workers chan bool, auditlogSvc proxy.AuditlogService, spool auditlog.Spool, retryPolicy auditlog.RetryPolicy, timeSvc auditlog.TimeService, collector *metrics.AuditlogCollector) {
 	logger := log.C(ctx)
 
 	go func() {
@@ -269,7 +295,7 @@ func initWorkers(ctx context.Context, workers chan bool, auditlogSvc proxy.Audit
 				return
 			case workers <- true:
 			}
-			worker := auditlog.NewWorker(auditlogSvc, msgChannel, collector)
+			worker := auditlog.NewWorker(auditlogSvc, spool, retryPolicy, timeSvc, collector)
 			go func() {
 				logger.Infoln("Starting worker for auditlog message processing")
 				worker.Start(ctx)
@@ -6,10 +6,14 @@ require (
 	github.com/form3tech-oss/jwt-go v3.2.2+incompatible
 	github.com/google/uuid v1.2.0
 	github.com/gorilla/mux v1.8.0
-	github.com/kyma-incubator/compass/components/director v0.0.0-20210203135116-086a057e4d3c
+	github.com/kyma-incubator/compass/components/director v0.0.0-20210318113202-92270340fe2c
 	github.com/pkg/errors v0.9.1
 	github.com/prometheus/client_golang v1.9.0
 	github.com/stretchr/testify v1.7.0
+	github.com/vektah/gqlparser/v2 v2.0.1
 	github.com/vrischmann/envconfig v1.3.0
 	golang.org/x/oauth2 v0.0.0-20210220000619-9bb904979d93
+	golang.org/x/sys v0.0.0-20210124154548-22da62e12c0c // indirect
 )
+
+replace github.com/kyma-incubator/compass/components/director => ../director
@@ -2,14 +2,28 @@
 
 package automock
 
-import mock "github.com/stretchr/testify/mock"
+import (
+	time "time"
+
+	mock "github.com/stretchr/testify/mock"
+)
 
 // MetricCollector is an autogenerated mock type for the MetricCollector type
 type MetricCollector struct {
 	mock.Mock
 }
 
-// SetChannelSize provides a mock function with given fields: size
-func (_m *MetricCollector) SetChannelSize(size int) {
-	_m.Called(size)
+// RecordDeadLetter provides a mock function with given fields:
+func (_m *MetricCollector) RecordDeadLetter() {
+	_m.Called()
+}
+
+// RecordDeliveryFailure provides a mock function with given fields:
+func (_m *MetricCollector) RecordDeliveryFailure() {
+	_m.Called()
+}
+
+// SetBacklog provides a mock function with given fields: size, age
+func (_m *MetricCollector) SetBacklog(size int, age time.Duration) {
+	_m.Called(size, age)
 }
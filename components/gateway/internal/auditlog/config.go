@@ -3,14 +3,22 @@ package auditlog
 import "time"
 
 type Config struct {
-	URL               string        `envconfig:"APP_AUDITLOG_URL"`
-	ConfigPath        string        `envconfig:"APP_AUDITLOG_CONFIG_PATH"`
-	SecurityPath      string        `envconfig:"APP_AUDITLOG_SECURITY_PATH"`
-	AuthMode          AuthMode      `envconfig:"APP_AUDITLOG_AUTH_MODE"`
-	ClientTimeout     time.Duration `envconfig:"APP_AUDITLOG_CLIENT_TIMEOUT,default=30s"`
-	MsgChannelSize    int           `envconfig:"APP_AUDITLOG_CHANNEL_SIZE,default=100"`
-	MsgChannelTimeout time.Duration `envconfig:"APP_AUDITLOG_CHANNEL_TIMEOUT,default=5s"`
-	WriteWorkers      int           `envconfig:"APP_AUDITLOG_WRITE_WORKERS,default=5"`
+	URL           string        `envconfig:"APP_AUDITLOG_URL"`
+	ConfigPath    string        `envconfig:"APP_AUDITLOG_CONFIG_PATH"`
+	SecurityPath  string        `envconfig:"APP_AUDITLOG_SECURITY_PATH"`
+	AuthMode      AuthMode      `envconfig:"APP_AUDITLOG_AUTH_MODE"`
+	ClientTimeout time.Duration `envconfig:"APP_AUDITLOG_CLIENT_TIMEOUT,default=30s"`
+	WriteWorkers  int           `envconfig:"APP_AUDITLOG_WRITE_WORKERS,default=5"`
+
+	SpoolDir            string        `envconfig:"APP_AUDITLOG_SPOOL_DIR,default=/var/lib/compass-gateway/auditlog"`
+	SpoolMaxSize        int           `envconfig:"APP_AUDITLOG_SPOOL_MAX_SIZE,default=100000"`
+	RetryInitialBackoff time.Duration `envconfig:"APP_AUDITLOG_RETRY_INITIAL_BACKOFF,default=1s"`
+	RetryMaxBackoff     time.Duration `envconfig:"APP_AUDITLOG_RETRY_MAX_BACKOFF,default=5m"`
+	RetryMaxAttempts    int           `envconfig:"APP_AUDITLOG_RETRY_MAX_ATTEMPTS,default=0"`
+	MetricsInterval     time.Duration `envconfig:"APP_AUDITLOG_METRICS_INTERVAL,default=10s"`
+
+	// RedactedFields are redacted in addition to DefaultRedactedFields
+	RedactedFields []string `envconfig:"APP_AUDITLOG_REDACTED_FIELDS,optional"`
 }
 
 type BasicAuthConfig struct {
@@ -5,6 +5,8 @@ import (
 	"testing"
 
 	"github.com/kyma-incubator/compass/components/director/pkg/correlation"
+	director "github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/gateway/internal/auditlog"
 	"github.com/kyma-incubator/compass/components/gateway/pkg/auditlog/model"
 	"github.com/kyma-incubator/compass/components/gateway/pkg/proxy"
 	"github.com/stretchr/testify/require"
@@ -32,19 +34,47 @@ func fixFabricatedSecurityEventMsg() model.SecurityEvent {
 	}}
 }
 
-func fixSuccessConfigChangeMsg(claims proxy.Claims, request, response string, auditlogType string) model.ConfigurationChange {
+func fixSuccessConfigChangeMsg(claims proxy.Claims, requestAttributes []model.Attribute, response string, auditlogType string) model.ConfigurationChange {
 	msg := fixFabricatedConfigChangeMsg()
 	msg.Object = model.Object{ID: fillID(claims, "Config Change")}
-	msg.Attributes = []model.Attribute{
-		{Name: "auditlog_type", Old: "", New: auditlogType},
-		{Name: "request", Old: "", New: request},
-		{Name: "correlation_id", Old: "", New: fixCorrelationID()[correlation.RequestIDHeaderKey]},
-		{Name: "response", Old: "", New: response},
-	}
+	msg.Attributes = []model.Attribute{{Name: "auditlog_type", Old: "", New: auditlogType}}
+	msg.Attributes = append(msg.Attributes, requestAttributes...)
+	msg.Attributes = append(msg.Attributes,
+		model.Attribute{Name: "correlation_id", Old: "", New: fixCorrelationID()[correlation.RequestIDHeaderKey]},
+		model.Attribute{Name: "response", Old: "", New: response},
+	)
 
 	return msg
 }
 
+func fixRequestAttributes(registerApplicationName string) []model.Attribute {
+	return []model.Attribute{
+		{Name: "mutation.registerApplication", Old: "", New: `{"name":"registerApplication","arguments":{"in":{"name":"` + registerApplicationName + `"}}}`},
+		{Name: "mutation.registerRuntime", Old: "", New: `{"name":"registerRuntime","arguments":{"in":{"name":"app2"}}}`},
+	}
+}
+
+func fixQueryRequestAttributes() []model.Attribute {
+	return []model.Attribute{
+		{Name: "query.result", Old: "", New: `{"name":"viewer","alias":"result"}`},
+	}
+}
+
+func fixRedactor(t *testing.T) *auditlog.Redactor {
+	redactor, err := auditlog.NewRedactor(director.NewExecutableSchema(director.Config{}).Schema(), auditlog.DefaultRedactedFields)
+	require.NoError(t, err)
+	return redactor
+}
+
+// fixRedactedResponse returns the response as it is logged after redaction, which does not preserve the order of the JSON keys
+func fixRedactedResponse(t *testing.T, response string) string {
+	var body map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(response), &body))
+	redacted, err := json.Marshal(body)
+	require.NoError(t, err)
+	return string(redacted)
+}
+
 func fixSecurityEventMsg(t *testing.T, errors []model.ErrorMessage, claims proxy.Claims, correlationID string) model.SecurityEvent {
 	msgData := model.SecurityEventData{
 		ID:            fillID(claims, "Security Event"),
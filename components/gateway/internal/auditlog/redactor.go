@@ -0,0 +1,469 @@
+package auditlog
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/kyma-incubator/compass/components/gateway/pkg/proxy"
+	"github.com/pkg/errors"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/formatter"
+	"github.com/vektah/gqlparser/v2/parser"
+)
+
+// RedactedValue replaces the values of sensitive fields in the auditlog messages
+const RedactedValue = "[REDACTED]"
+
+// DefaultRedactedFields are the input and output fields of the Director API carrying credentials or tokens.
+// Fields are defined as `Type.field`, where the type can also be an interface, whose field is then redacted in all its implementations.
+var DefaultRedactedFields = []string{
+	"BasicCredentialDataInput.password",
+	"OAuthCredentialDataInput.clientSecret",
+	"CertificateCredentialDataInput.privateKey",
+	"RequestSigningInput.secrets",
+	"AuthInput.additionalHeaders",
+	"AuthInput.additionalHeadersSerialized",
+	"AuthInput.additionalQueryParams",
+	"AuthInput.additionalQueryParamsSerialized",
+	"CSRFTokenCredentialRequestAuthInput.additionalHeaders",
+	"CSRFTokenCredentialRequestAuthInput.additionalHeadersSerialized",
+	"CSRFTokenCredentialRequestAuthInput.additionalQueryParams",
+	"CSRFTokenCredentialRequestAuthInput.additionalQueryParamsSerialized",
+	"BasicCredentialData.password",
+	"OAuthCredentialData.clientSecret",
+	"CertificateCredentialData.privateKey",
+	"RequestSigning.secrets",
+	"Auth.additionalHeaders",
+	"Auth.additionalHeadersSerialized",
+	"Auth.additionalQueryParams",
+	"Auth.additionalQueryParamsSerialized",
+	"CSRFTokenCredentialRequestAuth.additionalHeaders",
+	"CSRFTokenCredentialRequestAuth.additionalHeadersSerialized",
+	"CSRFTokenCredentialRequestAuth.additionalQueryParams",
+	"CSRFTokenCredentialRequestAuth.additionalQueryParamsSerialized",
+	"OneTimeToken.token",
+	"OneTimeToken.raw",
+	"OneTimeToken.rawEncoded",
+}
+
+// RedactedOperation is a GraphQL operation whose arguments are stripped of sensitive values
+type RedactedOperation struct {
+	Type   string
+	Name   string
+	Fields []RedactedField
+}
+
+// RedactedField is a root field of a GraphQL operation, together with its redacted arguments
+type RedactedField struct {
+	Name      string                 `json:"name"`
+	Alias     string                 `json:"alias,omitempty"`
+	Arguments map[string]interface{} `json:"arguments,omitempty"`
+}
+
+// Redactor masks the sensitive fields of GraphQL requests and responses, using the schema to resolve the types of arguments and response fields.
+// Arguments of fields which are not defined in the schema are redacted entirely, as their types are not known.
+type Redactor struct {
+	schema *ast.Schema
+	fields map[string]bool
+}
+
+func NewRedactor(schema *ast.Schema, redactedFields []string) (*Redactor, error) {
+	fields := make(map[string]bool, len(redactedFields))
+	for _, redactedField := range redactedFields {
+		redactedField = strings.TrimSpace(redactedField)
+		if redactedField == "" {
+			continue
+		}
+
+		parts := strings.Split(redactedField, ".")
+		if len(parts) != 2 {
+			return nil, errors.Errorf("invalid redacted field %q, expected format is Type.field", redactedField)
+		}
+
+		def := schema.Types[parts[0]]
+		if def == nil {
+			return nil, errors.Errorf("type of redacted field %q is not defined in the schema", redactedField)
+		}
+		if def.Fields.ForName(parts[1]) == nil {
+			return nil, errors.Errorf("redacted field %q is not defined in the schema", redactedField)
+		}
+
+		fields[redactedField] = true
+	}
+
+	return &Redactor{schema: schema, fields: fields}, nil
+}
+
+type graphqlRequest struct {
+	Query         string                 `json:"query"`
+	OperationName string                 `json:"operationName"`
+	Variables     map[string]interface{} `json:"variables"`
+}
+
+// RedactRequest parses the GraphQL request, sent either as JSON or as a plain query, and returns its operation with redacted arguments
+func (r *Redactor) RedactRequest(request string) (RedactedOperation, error) {
+	req, err := r.parseRequest(request)
+	if err != nil {
+		return RedactedOperation{}, err
+	}
+
+	redacted := RedactedOperation{
+		Type: string(req.operation.Operation),
+		Name: req.operation.Name,
+	}
+
+	rootType := r.rootType(req.operation.Operation)
+	for _, field := range r.collectFields(req.operation.SelectionSet, rootType, req.fragments, map[string]bool{}) {
+		redactedField := RedactedField{Name: field.Name}
+		if field.Alias != field.Name {
+			redactedField.Alias = field.Alias
+		}
+
+		arguments, err := r.redactArguments(field, req.variables)
+		if err != nil {
+			return RedactedOperation{}, err
+		}
+		if len(arguments) > 0 {
+			redactedField.Arguments = arguments
+		}
+
+		redacted.Fields = append(redacted.Fields, redactedField)
+	}
+
+	return redacted, nil
+}
+
+// RedactMessage strips the auditlog message of sensitive values before it leaves the memory of the gateway.
+// The arguments of the root fields are replaced with variables holding their redacted values, while all other arguments,
+// variable definitions and directive arguments are masked, as they are not logged. The selections of the request are kept,
+// so that the redacted request and response can still be processed by the auditlog service.
+// A request which cannot be parsed and a response which is not valid JSON are replaced entirely.
+func (r *Redactor) RedactMessage(msg proxy.AuditlogMessage) proxy.AuditlogMessage {
+	response, err := r.RedactResponse(msg.Request, msg.Response)
+	if err != nil {
+		response = RedactedValue
+	}
+
+	request, err := r.redactRequestBody(msg.Request)
+	if err != nil {
+		request = RedactedValue
+	}
+
+	msg.Request = request
+	msg.Response = response
+	return msg
+}
+
+func (r *Redactor) redactRequestBody(request string) (string, error) {
+	req, err := r.parseRequest(request)
+	if err != nil {
+		return "", err
+	}
+
+	variables := make(map[string]interface{})
+	rootFields := make(map[*ast.Field]bool)
+	rootType := r.rootType(req.operation.Operation)
+	for _, field := range r.collectFields(req.operation.SelectionSet, rootType, req.fragments, map[string]bool{}) {
+		if rootFields[field.Field] {
+			continue
+		}
+		rootFields[field.Field] = true
+
+		arguments, err := r.redactArguments(field, req.variables)
+		if err != nil {
+			return "", err
+		}
+		for _, arg := range field.Arguments {
+			name := fmt.Sprintf("arg%d", len(variables))
+			variables[name] = arguments[arg.Name]
+			arg.Value = &ast.Value{Kind: ast.Variable, Raw: name}
+		}
+	}
+
+	req.operation.VariableDefinitions = nil
+	maskDirectives(req.operation.Directives)
+	maskSelectionSet(req.operation.SelectionSet, rootFields)
+	for _, fragment := range req.fragments {
+		fragment.VariableDefinition = nil
+		maskDirectives(fragment.Directives)
+		maskSelectionSet(fragment.SelectionSet, rootFields)
+	}
+
+	var query bytes.Buffer
+	formatter.NewFormatter(&query).FormatQueryDocument(&ast.QueryDocument{
+		Operations: ast.OperationList{req.operation},
+		Fragments:  req.fragments,
+	})
+
+	body, err := json.Marshal(graphqlRequest{
+		Query:         query.String(),
+		OperationName: req.operation.Name,
+		Variables:     variables,
+	})
+	if err != nil {
+		return "", errors.Wrap(err, "while marshalling redacted request")
+	}
+	return string(body), nil
+}
+
+func (r *Redactor) redactArguments(field collectedField, variables map[string]interface{}) (map[string]interface{}, error) {
+	var fieldDef *ast.FieldDefinition
+	if field.parent != nil {
+		fieldDef = field.parent.Fields.ForName(field.Name)
+	}
+
+	arguments := make(map[string]interface{}, len(field.Arguments))
+	for _, arg := range field.Arguments {
+		var argDef *ast.ArgumentDefinition
+		if fieldDef != nil {
+			argDef = fieldDef.Arguments.ForName(arg.Name)
+		}
+		if argDef == nil {
+			arguments[arg.Name] = RedactedValue
+			continue
+		}
+
+		value, err := arg.Value.Value(variables)
+		if err != nil {
+			return nil, errors.Wrapf(err, "while resolving argument %s of field %s", arg.Name, field.Name)
+		}
+		arguments[arg.Name] = r.redactInput(value, argDef.Type)
+	}
+	return arguments, nil
+}
+
+// maskSelectionSet masks the arguments of all fields in the selection set except for the root fields,
+// whose arguments are already redacted
+func maskSelectionSet(selectionSet ast.SelectionSet, rootFields map[*ast.Field]bool) {
+	for _, selection := range selectionSet {
+		switch typed := selection.(type) {
+		case *ast.Field:
+			if !rootFields[typed] {
+				maskArguments(typed.Arguments)
+			}
+			maskDirectives(typed.Directives)
+			maskSelectionSet(typed.SelectionSet, rootFields)
+		case *ast.InlineFragment:
+			maskDirectives(typed.Directives)
+			maskSelectionSet(typed.SelectionSet, rootFields)
+		case *ast.FragmentSpread:
+			maskDirectives(typed.Directives)
+		}
+	}
+}
+
+func maskDirectives(directives ast.DirectiveList) {
+	for _, directive := range directives {
+		maskArguments(directive.Arguments)
+	}
+}
+
+func maskArguments(arguments ast.ArgumentList) {
+	for _, arg := range arguments {
+		arg.Value = &ast.Value{Kind: ast.StringValue, Raw: RedactedValue}
+	}
+}
+
+// RedactResponse masks the sensitive fields of the data of the GraphQL response, following the selections of the request.
+// The whole data is redacted if the request cannot be parsed.
+func (r *Redactor) RedactResponse(request, response string) (string, error) {
+	var body map[string]interface{}
+	if err := json.Unmarshal([]byte(response), &body); err != nil {
+		return "", errors.Wrap(err, "while unmarshalling response")
+	}
+
+	if data, ok := body["data"]; ok && data != nil {
+		req, err := r.parseRequest(request)
+		if err != nil {
+			body["data"] = RedactedValue
+		} else {
+			body["data"] = r.redactOutput(data, r.rootType(req.operation.Operation), req.operation.SelectionSet, req.fragments)
+		}
+	}
+
+	redacted, err := json.Marshal(body)
+	if err != nil {
+		return "", errors.Wrap(err, "while marshalling redacted response")
+	}
+	return string(redacted), nil
+}
+
+type parsedRequest struct {
+	operation *ast.OperationDefinition
+	fragments ast.FragmentDefinitionList
+	variables map[string]interface{}
+}
+
+func (r *Redactor) parseRequest(request string) (parsedRequest, error) {
+	req := graphqlRequest{Query: request}
+	trimmed := strings.TrimSpace(request)
+	if strings.HasPrefix(trimmed, "{") && json.Valid([]byte(trimmed)) {
+		if err := json.Unmarshal([]byte(trimmed), &req); err != nil {
+			return parsedRequest{}, errors.Wrap(err, "while unmarshalling request")
+		}
+	}
+
+	doc, gqlErr := parser.ParseQuery(&ast.Source{Input: req.Query})
+	if gqlErr != nil {
+		return parsedRequest{}, errors.Wrap(gqlErr, "while parsing query")
+	}
+
+	operation := doc.Operations.ForName(req.OperationName)
+	if operation == nil {
+		return parsedRequest{}, errors.Errorf("cannot determine operation %q of the query", req.OperationName)
+	}
+
+	return parsedRequest{
+		operation: operation,
+		fragments: doc.Fragments,
+		variables: req.Variables,
+	}, nil
+}
+
+func (r *Redactor) rootType(operation ast.Operation) *ast.Definition {
+	switch operation {
+	case ast.Mutation:
+		return r.schema.Mutation
+	case ast.Subscription:
+		return r.schema.Subscription
+	default:
+		return r.schema.Query
+	}
+}
+
+func (r *Redactor) redactInput(value interface{}, typ *ast.Type) interface{} {
+	if value == nil {
+		return nil
+	}
+
+	if typ.Elem != nil {
+		list, ok := value.([]interface{})
+		if !ok {
+			return r.redactInput(value, typ.Elem)
+		}
+		redacted := make([]interface{}, 0, len(list))
+		for _, item := range list {
+			redacted = append(redacted, r.redactInput(item, typ.Elem))
+		}
+		return redacted
+	}
+
+	def := r.schema.Types[typ.NamedType]
+	object, ok := value.(map[string]interface{})
+	if def == nil || def.Kind != ast.InputObject || !ok {
+		return value
+	}
+
+	redacted := make(map[string]interface{}, len(object))
+	for name, fieldValue := range object {
+		fieldDef := def.Fields.ForName(name)
+		if fieldDef == nil || r.isRedacted(def, name) {
+			redacted[name] = RedactedValue
+			continue
+		}
+		redacted[name] = r.redactInput(fieldValue, fieldDef.Type)
+	}
+	return redacted
+}
+
+// redactOutput masks the redacted fields of the response value. Values of fields which are not defined in the schema are redacted,
+// as it is not known what they contain.
+func (r *Redactor) redactOutput(value interface{}, def *ast.Definition, selectionSet ast.SelectionSet, fragments ast.FragmentDefinitionList) interface{} {
+	switch typed := value.(type) {
+	case []interface{}:
+		redacted := make([]interface{}, 0, len(typed))
+		for _, item := range typed {
+			redacted = append(redacted, r.redactOutput(item, def, selectionSet, fragments))
+		}
+		return redacted
+	case map[string]interface{}:
+		redacted := make(map[string]interface{}, len(typed))
+		for key, fieldValue := range typed {
+			redacted[key] = fieldValue
+		}
+
+		for _, field := range r.collectFields(selectionSet, def, fragments, map[string]bool{}) {
+			fieldValue, ok := typed[field.Alias]
+			if !ok || strings.HasPrefix(field.Name, "__") {
+				continue
+			}
+
+			var fieldDef *ast.FieldDefinition
+			if field.parent != nil {
+				fieldDef = field.parent.Fields.ForName(field.Name)
+			}
+			if fieldDef == nil || r.isRedacted(field.parent, field.Name) {
+				redacted[field.Alias] = RedactedValue
+				continue
+			}
+
+			if len(field.SelectionSet) > 0 {
+				redacted[field.Alias] = r.redactOutput(fieldValue, r.schema.Types[fieldDef.Type.Name()], field.SelectionSet, fragments)
+			}
+		}
+		return redacted
+	default:
+		return value
+	}
+}
+
+// isRedacted checks whether the field of the type is redacted, either directly, through an interface the type implements,
+// or, for abstract types, through any of their possible types
+func (r *Redactor) isRedacted(def *ast.Definition, field string) bool {
+	if r.fields[def.Name+"."+field] {
+		return true
+	}
+
+	for _, iface := range r.schema.GetImplements(def) {
+		if r.fields[iface.Name+"."+field] {
+			return true
+		}
+	}
+
+	if def.IsAbstractType() {
+		for _, possibleType := range r.schema.GetPossibleTypes(def) {
+			if r.fields[possibleType.Name+"."+field] {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+type collectedField struct {
+	*ast.Field
+	parent *ast.Definition
+}
+
+// collectFields flattens the selection set, including the fields of inline fragments and fragment spreads,
+// together with the type on which each field is selected. The parent is nil if the type is not defined in the schema.
+// Fragments are resolved by name, as the document is not validated against the schema.
+func (r *Redactor) collectFields(selectionSet ast.SelectionSet, parent *ast.Definition, fragments ast.FragmentDefinitionList, visited map[string]bool) []collectedField {
+	var fields []collectedField
+	for _, selection := range selectionSet {
+		switch typed := selection.(type) {
+		case *ast.Field:
+			fields = append(fields, collectedField{Field: typed, parent: parent})
+		case *ast.InlineFragment:
+			fields = append(fields, r.collectFields(typed.SelectionSet, r.fragmentType(parent, typed.TypeCondition), fragments, visited)...)
+		case *ast.FragmentSpread:
+			fragment := fragments.ForName(typed.Name)
+			if fragment == nil || visited[typed.Name] {
+				continue
+			}
+			visited[typed.Name] = true
+			fields = append(fields, r.collectFields(fragment.SelectionSet, r.fragmentType(parent, fragment.TypeCondition), fragments, visited)...)
+		}
+	}
+	return fields
+}
+
+func (r *Redactor) fragmentType(parent *ast.Definition, typeCondition string) *ast.Definition {
+	if typeCondition == "" {
+		return parent
+	}
+	return r.schema.Types[typeCondition]
+}
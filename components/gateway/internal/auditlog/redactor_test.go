@@ -0,0 +1,342 @@
+package auditlog_test
+
+import (
+	"testing"
+
+	director "github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/gateway/internal/auditlog"
+	"github.com/kyma-incubator/compass/components/gateway/pkg/proxy"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewRedactor(t *testing.T) {
+	schema := director.NewExecutableSchema(director.Config{}).Schema()
+
+	t.Run("should accept default redacted fields", func(t *testing.T) {
+		_, err := auditlog.NewRedactor(schema, auditlog.DefaultRedactedFields)
+		require.NoError(t, err)
+	})
+
+	t.Run("should return error when field has invalid format", func(t *testing.T) {
+		_, err := auditlog.NewRedactor(schema, []string{"password"})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "expected format is Type.field")
+	})
+
+	t.Run("should return error when type is not defined", func(t *testing.T) {
+		_, err := auditlog.NewRedactor(schema, []string{"Secret.value"})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "is not defined in the schema")
+	})
+
+	t.Run("should return error when field is not defined", func(t *testing.T) {
+		_, err := auditlog.NewRedactor(schema, []string{"BasicCredentialData.secret"})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "is not defined in the schema")
+	})
+}
+
+func TestRedactor_RedactRequest(t *testing.T) {
+	redactor := fixRedactor(t)
+
+	t.Run("should redact credentials passed inline", func(t *testing.T) {
+		//GIVEN
+		request := `mutation {
+			addWebhook(applicationID: "app-id", in: {type: CONFIGURATION_CHANGED, url: "http://webhook", auth: {
+				credential: {basic: {username: "admin", password: "secret"}}
+				additionalHeadersSerialized: "eyJhIjpbImIiXX0="
+			}}) {
+				id
+			}
+		}`
+
+		//WHEN
+		operation, err := redactor.RedactRequest(request)
+
+		//THEN
+		require.NoError(t, err)
+		assert.Equal(t, auditlog.RedactedOperation{
+			Type: "mutation",
+			Fields: []auditlog.RedactedField{
+				{
+					Name: "addWebhook",
+					Arguments: map[string]interface{}{
+						"applicationID": "app-id",
+						"in": map[string]interface{}{
+							"type": "CONFIGURATION_CHANGED",
+							"url":  "http://webhook",
+							"auth": map[string]interface{}{
+								"credential": map[string]interface{}{
+									"basic": map[string]interface{}{
+										"username": "admin",
+										"password": auditlog.RedactedValue,
+									},
+								},
+								"additionalHeadersSerialized": auditlog.RedactedValue,
+							},
+						},
+					},
+				},
+			},
+		}, operation)
+	})
+
+	t.Run("should redact credentials passed in variables of JSON request", func(t *testing.T) {
+		//GIVEN
+		request := `{
+			"query": "mutation setAuth($id: ID!, $in: BundleInstanceAuthSetInput!) { auth: setBundleInstanceAuth(authID: $id, in: $in) { id } }",
+			"operationName": "setAuth",
+			"variables": {"id": "auth-id", "in": {"auth": {"credential": {"oauth": {"clientId": "client", "clientSecret": "secret", "url": "http://token"}}}}}
+		}`
+
+		//WHEN
+		operation, err := redactor.RedactRequest(request)
+
+		//THEN
+		require.NoError(t, err)
+		assert.Equal(t, auditlog.RedactedOperation{
+			Type: "mutation",
+			Name: "setAuth",
+			Fields: []auditlog.RedactedField{
+				{
+					Name:  "setBundleInstanceAuth",
+					Alias: "auth",
+					Arguments: map[string]interface{}{
+						"authID": "auth-id",
+						"in": map[string]interface{}{
+							"auth": map[string]interface{}{
+								"credential": map[string]interface{}{
+									"oauth": map[string]interface{}{
+										"clientId":     "client",
+										"clientSecret": auditlog.RedactedValue,
+										"url":          "http://token",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		}, operation)
+	})
+
+	t.Run("should redact private keys and signing secrets passed inline", func(t *testing.T) {
+		//GIVEN
+		request := `mutation {
+			addWebhook(applicationID: "app-id", in: {type: CONFIGURATION_CHANGED, url: "http://webhook", auth: {
+				credential: {certificate: {certificate: "cert", privateKey: "key"}}
+				signing: {header: "X-Signature", secrets: ["first", "second"]}
+			}}) {
+				id
+			}
+		}`
+
+		//WHEN
+		operation, err := redactor.RedactRequest(request)
+
+		//THEN
+		require.NoError(t, err)
+		assert.Equal(t, []auditlog.RedactedField{
+			{
+				Name: "addWebhook",
+				Arguments: map[string]interface{}{
+					"applicationID": "app-id",
+					"in": map[string]interface{}{
+						"type": "CONFIGURATION_CHANGED",
+						"url":  "http://webhook",
+						"auth": map[string]interface{}{
+							"credential": map[string]interface{}{
+								"certificate": map[string]interface{}{
+									"certificate": "cert",
+									"privateKey":  auditlog.RedactedValue,
+								},
+							},
+							"signing": map[string]interface{}{
+								"header":  "X-Signature",
+								"secrets": auditlog.RedactedValue,
+							},
+						},
+					},
+				},
+			},
+		}, operation.Fields)
+	})
+
+	t.Run("should redact arguments of fields which are not defined in the schema", func(t *testing.T) {
+		//GIVEN
+		request := `mutation { signCertificateSigningRequest(csr: "csr") { result { certificateChain } } }`
+
+		//WHEN
+		operation, err := redactor.RedactRequest(request)
+
+		//THEN
+		require.NoError(t, err)
+		assert.Equal(t, []auditlog.RedactedField{
+			{Name: "signCertificateSigningRequest", Arguments: map[string]interface{}{"csr": auditlog.RedactedValue}},
+		}, operation.Fields)
+	})
+
+	t.Run("should return error when request cannot be parsed", func(t *testing.T) {
+		//WHEN
+		_, err := redactor.RedactRequest(`mutation { registerApplication(in: {name: "test"}`)
+
+		//THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "while parsing query")
+	})
+
+	t.Run("should return error when operation is not found", func(t *testing.T) {
+		//WHEN
+		_, err := redactor.RedactRequest(`{"query": "query a { viewer { id } } query b { viewer { id } }"}`)
+
+		//THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "cannot determine operation")
+	})
+}
+
+func TestRedactor_RedactResponse(t *testing.T) {
+	redactor := fixRedactor(t)
+
+	t.Run("should redact fields of interface implementations", func(t *testing.T) {
+		//GIVEN
+		request := `mutation { token: requestOneTimeTokenForApplication(id: "app-id") { token connectorURL raw rawEncoded } }`
+		response := `{"data":{"token":{"token":"secret","connectorURL":"http://connector","raw":"raw","rawEncoded":"encoded"}}}`
+
+		//WHEN
+		redacted, err := redactor.RedactResponse(request, response)
+
+		//THEN
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"data":{"token":{"token":"[REDACTED]","connectorURL":"http://connector","raw":"[REDACTED]","rawEncoded":"[REDACTED]"}}}`, redacted)
+	})
+
+	t.Run("should redact fields selected in fragments", func(t *testing.T) {
+		//GIVEN
+		request := `mutation {
+			requestClientCredentialsForApplication(id: "app-id") {
+				id
+				auth { ...credential }
+			}
+		}
+		fragment credential on Auth {
+			credential {
+				... on OAuthCredentialData { clientId clientSecret url }
+				... on BasicCredentialData { username password }
+			}
+		}`
+		response := `{"data":{"requestClientCredentialsForApplication":{"id":"auth-id","auth":{"credential":{"clientId":"client","clientSecret":"secret","url":"http://token"}}}},"errors":[{"message":"error","path":["requestClientCredentialsForApplication"]}]}`
+
+		//WHEN
+		redacted, err := redactor.RedactResponse(request, response)
+
+		//THEN
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"data":{"requestClientCredentialsForApplication":{"id":"auth-id","auth":{"credential":{"clientId":"client","clientSecret":"[REDACTED]","url":"http://token"}}}},"errors":[{"message":"error","path":["requestClientCredentialsForApplication"]}]}`, redacted)
+	})
+
+	t.Run("should redact private keys and signing secrets of webhooks", func(t *testing.T) {
+		//GIVEN
+		request := `query {
+			application(id: "app-id") {
+				webhooks {
+					auth {
+						credential { ... on CertificateCredentialData { certificate privateKey } }
+						signing { header secrets }
+					}
+				}
+			}
+		}`
+		response := `{"data":{"application":{"webhooks":[{"auth":{"credential":{"certificate":"cert","privateKey":"key"},"signing":{"header":"X-Signature","secrets":["first","second"]}}}]}}}`
+
+		//WHEN
+		redacted, err := redactor.RedactResponse(request, response)
+
+		//THEN
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"data":{"application":{"webhooks":[{"auth":{"credential":{"certificate":"cert","privateKey":"[REDACTED]"},"signing":{"header":"X-Signature","secrets":"[REDACTED]"}}}]}}}`, redacted)
+	})
+
+	t.Run("should redact fields which are not defined in the schema", func(t *testing.T) {
+		//GIVEN
+		request := `mutation { generateApplicationToken(appID: "app-id") { token } }`
+		response := `{"data":{"generateApplicationToken":{"token":"secret"}}}`
+
+		//WHEN
+		redacted, err := redactor.RedactResponse(request, response)
+
+		//THEN
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"data":{"generateApplicationToken":"[REDACTED]"}}`, redacted)
+	})
+
+	t.Run("should redact whole data when request cannot be parsed", func(t *testing.T) {
+		//WHEN
+		redacted, err := redactor.RedactResponse(`mutation {`, `{"data":{"token":"secret"}}`)
+
+		//THEN
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"data":"[REDACTED]"}`, redacted)
+	})
+}
+
+func TestRedactor_RedactMessage(t *testing.T) {
+	redactor := fixRedactor(t)
+
+	t.Run("should redact credentials in arguments and variables while keeping the redacted operation", func(t *testing.T) {
+		//GIVEN
+		request := `{
+			"query": "mutation setAuth($id: ID!, $in: BundleInstanceAuthSetInput!, $unused: String = \"default-secret\") { auth: setBundleInstanceAuth(authID: $id, in: $in) { id ...status } } fragment status on BundleInstanceAuth { status { condition message(prefix: \"nested-secret\") } }",
+			"operationName": "setAuth",
+			"variables": {"id": "auth-id", "unused": "unused-secret", "in": {"auth": {"credential": {"oauth": {"clientId": "client", "clientSecret": "secret", "url": "http://token"}}}}}
+		}`
+		response := `{"data":{"auth":{"id":"auth-id","status":{"condition":"SUCCEEDED","message":"ok"}}}}`
+		msg := proxy.AuditlogMessage{Request: request, Response: response, Claims: proxy.Claims{Tenant: "tenant"}}
+
+		expectedOperation, err := redactor.RedactRequest(request)
+		require.NoError(t, err)
+
+		//WHEN
+		redacted := redactor.RedactMessage(msg)
+
+		//THEN
+		assert.Equal(t, msg.Claims, redacted.Claims)
+		assert.NotContains(t, redacted.Request, "secret")
+
+		operation, err := redactor.RedactRequest(redacted.Request)
+		require.NoError(t, err)
+		assert.Equal(t, expectedOperation, operation)
+
+		redactedResponse, err := redactor.RedactResponse(redacted.Request, redacted.Response)
+		require.NoError(t, err)
+		assert.JSONEq(t, response, redactedResponse)
+	})
+
+	t.Run("should redact credentials selected in the response", func(t *testing.T) {
+		//GIVEN
+		request := `mutation { token: requestOneTimeTokenForApplication(id: "app-id") { token connectorURL } }`
+		response := `{"data":{"token":{"token":"secret","connectorURL":"http://connector"}}}`
+
+		//WHEN
+		redacted := redactor.RedactMessage(proxy.AuditlogMessage{Request: request, Response: response})
+
+		//THEN
+		assert.JSONEq(t, `{"data":{"token":{"token":"[REDACTED]","connectorURL":"http://connector"}}}`, redacted.Response)
+		operation, err := redactor.RedactRequest(redacted.Request)
+		require.NoError(t, err)
+		assert.Equal(t, auditlog.RedactedOperation{
+			Type:   "mutation",
+			Fields: []auditlog.RedactedField{{Name: "requestOneTimeTokenForApplication", Alias: "token", Arguments: map[string]interface{}{"id": "app-id"}}},
+		}, operation)
+	})
+
+	t.Run("should replace request which cannot be parsed and response which is not valid JSON", func(t *testing.T) {
+		//WHEN
+		redacted := redactor.RedactMessage(proxy.AuditlogMessage{Request: `mutation { registerApplication(in: {name: "secret"}`, Response: `secret`})
+
+		//THEN
+		assert.Equal(t, auditlog.RedactedValue, redacted.Request)
+		assert.Equal(t, auditlog.RedactedValue, redacted.Response)
+	})
+}
@@ -16,32 +16,30 @@ import (
 
 //go:generate mockery --name=MetricCollector --output=automock --outpkg=automock --case=underscore
 type MetricCollector interface {
-	SetChannelSize(size int)
+	SetBacklog(size int, age time.Duration)
+	RecordDeliveryFailure()
+	RecordDeadLetter()
 }
 
+// Sink persists the auditlog messages in the spool, from which they are delivered asynchronously by the workers.
+// Messages are redacted before they are persisted, so that no credentials are written to the disk.
 type Sink struct {
-	logsChannel chan proxy.AuditlogMessage
-	timeout     time.Duration
-	collector   MetricCollector
+	spool    Spool
+	redactor *Redactor
 }
 
-func NewSink(logsChannel chan proxy.AuditlogMessage, timeout time.Duration, collector MetricCollector) *Sink {
+func NewSink(spool Spool, redactor *Redactor) *Sink {
 	return &Sink{
-		logsChannel: logsChannel,
-		timeout:     timeout,
-		collector:   collector,
+		spool:    spool,
+		redactor: redactor,
 	}
 }
 
 func (sink *Sink) Log(ctx context.Context, msg proxy.AuditlogMessage) error {
-	select {
-	case sink.logsChannel <- msg:
-		log.C(ctx).Debugf("Successfully registered auditlog message for processing to the queue (size=%d, capacity=%d)",
-			len(sink.logsChannel), cap(sink.logsChannel))
-		sink.collector.SetChannelSize(len(sink.logsChannel))
-	case <-time.After(sink.timeout):
-		return errors.New("cannot write to the channel")
+	if err := sink.spool.Put(sink.redactor.RedactMessage(msg)); err != nil {
+		return errors.Wrap(err, "while writing auditlog message to the spool")
 	}
+	log.C(ctx).Debug("Successfully registered auditlog message for processing to the spool")
 	return nil
 }
 
@@ -72,15 +70,16 @@ type AuditlogMessageFactory interface {
 type Service struct {
 	client     AuditlogClient
 	msgFactory AuditlogMessageFactory
+	redactor   *Redactor
 }
 
-func NewService(client AuditlogClient, msgFactory AuditlogMessageFactory) *Service {
-	return &Service{client: client, msgFactory: msgFactory}
+func NewService(client AuditlogClient, msgFactory AuditlogMessageFactory, redactor *Redactor) *Service {
+	return &Service{client: client, msgFactory: msgFactory, redactor: redactor}
 }
 
 func (svc *Service) PreLog(ctx context.Context, msg proxy.AuditlogMessage) error {
 	correlationID := msg.CorrelationIDHeaders[correlation.RequestIDHeaderKey]
-	configChangeMsg := svc.createConfigChangeMsg(msg.Claims, msg.Request, correlationID, PreAuditlogOperation)
+	configChangeMsg := svc.createConfigChangeMsg(ctx, msg.Claims, msg.Request, correlationID, PreAuditlogOperation)
 	err := svc.client.LogConfigurationChange(ctx, configChangeMsg)
 	return errors.Wrap(err, "while sending configuration pre-change")
 }
@@ -94,7 +93,7 @@ func (svc *Service) Log(ctx context.Context, msg proxy.AuditlogMessage) error {
 	correlationID := msg.CorrelationIDHeaders[correlation.RequestIDHeaderKey]
 
 	if len(graphqlResponse.Errors) == 0 {
-		configChangeMsg := svc.createConfigChangeMsg(msg.Claims, msg.Request, correlationID, PostAuditlogOperation)
+		configChangeMsg := svc.createConfigChangeMsg(ctx, msg.Claims, msg.Request, correlationID, PostAuditlogOperation)
 		configChangeMsg.Attributes = append(configChangeMsg.Attributes,
 			model.Attribute{
 				Name: "response",
@@ -128,7 +127,7 @@ func (svc *Service) Log(ctx context.Context, msg proxy.AuditlogMessage) error {
 		return errors.Wrap(err, "while checking if error is read error")
 	}
 
-	configChangeMsg := svc.createConfigChangeMsg(msg.Claims, msg.Request, correlationID, PostAuditlogOperation)
+	configChangeMsg := svc.createConfigChangeMsg(ctx, msg.Claims, msg.Request, correlationID, PostAuditlogOperation)
 	if isReadErr {
 		configChangeMsg.Attributes = append(configChangeMsg.Attributes,
 			model.Attribute{
@@ -139,11 +138,16 @@ func (svc *Service) Log(ctx context.Context, msg proxy.AuditlogMessage) error {
 		err := svc.client.LogConfigurationChange(ctx, configChangeMsg)
 		return errors.Wrap(err, "while sending configuration change")
 	} else {
+		response, err := svc.redactor.RedactResponse(msg.Request, msg.Response)
+		if err != nil {
+			log.C(ctx).WithError(err).Error("while redacting response, the response is not logged")
+			response = RedactedValue
+		}
 		configChangeMsg.Attributes = append(configChangeMsg.Attributes,
 			model.Attribute{
 				Name: "response",
 				Old:  "",
-				New:  msg.Response,
+				New:  response,
 			})
 	}
 
@@ -160,7 +164,7 @@ func (svc *Service) parseResponse(response string) (model.GraphqlResponse, error
 	return graphqlResponse, nil
 }
 
-func (svc *Service) createConfigChangeMsg(claims proxy.Claims, request string, correlationID string, auditlogOperationType string) model.ConfigurationChange {
+func (svc *Service) createConfigChangeMsg(ctx context.Context, claims proxy.Claims, request string, correlationID string, auditlogOperationType string) model.ConfigurationChange {
 	msg := svc.msgFactory.CreateConfigurationChange()
 	msg.Object = model.Object{ID: fillID(claims, "Config Change")}
 
@@ -170,21 +174,49 @@ func (svc *Service) createConfigChangeMsg(claims proxy.Claims, request string, c
 			Old:  "",
 			New:  auditlogOperationType,
 		},
-		{
-			Name: "request",
-			Old:  "",
-			New:  request,
-		},
-		{
-			Name: "correlation_id",
-			Old:  "",
-			New:  correlationID,
-		},
 	}
+	msg.Attributes = append(msg.Attributes, svc.createRequestAttributes(ctx, request)...)
+	msg.Attributes = append(msg.Attributes, model.Attribute{
+		Name: "correlation_id",
+		Old:  "",
+		New:  correlationID,
+	})
 
 	return msg
 }
 
+// createRequestAttributes creates an attribute for every root field of the request, named after the operation type and the field,
+// which holds the field with its redacted arguments. The raw request is never logged, as it may contain credentials.
+func (svc *Service) createRequestAttributes(ctx context.Context, request string) []model.Attribute {
+	operation, err := svc.redactor.RedactRequest(request)
+	if err != nil {
+		log.C(ctx).WithError(err).Error("while redacting request, the request is not logged")
+		return []model.Attribute{{Name: "request", Old: "", New: RedactedValue}}
+	}
+
+	attributes := make([]model.Attribute, 0, len(operation.Fields))
+	for _, field := range operation.Fields {
+		name := field.Name
+		if field.Alias != "" {
+			name = field.Alias
+		}
+
+		value, err := json.Marshal(field)
+		if err != nil {
+			log.C(ctx).WithError(err).Errorf("while marshalling redacted field %s, the field is not logged", name)
+			value = []byte(RedactedValue)
+		}
+
+		attributes = append(attributes, model.Attribute{
+			Name: operation.Type + "." + name,
+			Old:  "",
+			New:  string(value),
+		})
+	}
+
+	return attributes
+}
+
 func (svc *Service) hasInsufficientScopeError(errors []model.ErrorMessage) bool {
 	for _, msg := range errors {
 		if strings.Contains(msg.Message, "insufficient scopes provided") {
@@ -6,7 +6,6 @@ import (
 	"errors"
 	"fmt"
 	"testing"
-	"time"
 
 	"github.com/kyma-incubator/compass/components/director/pkg/correlation"
 	"github.com/kyma-incubator/compass/components/gateway/internal/auditlog"
@@ -27,11 +26,11 @@ func TestAuditlogService_Log(t *testing.T) {
 		request := fixRequest()
 		response := fixNoErrorResponse(t)
 		claims := fixClaims()
-		log := fixSuccessConfigChangeMsg(claims, request, "success", auditlog.PostAuditlogOperation)
+		log := fixSuccessConfigChangeMsg(claims, fixRequestAttributes("test"), "success", auditlog.PostAuditlogOperation)
 
 		client := &automock.AuditlogClient{}
 		client.On("LogConfigurationChange", context.TODO(), log).Return(nil)
-		auditlogSvc := auditlog.NewService(client, factory)
+		auditlogSvc := auditlog.NewService(client, factory, fixRedactor(t))
 
 		//WHEN
 		msg := proxy.AuditlogMessage{
@@ -55,11 +54,11 @@ func TestAuditlogService_Log(t *testing.T) {
 		request := fixRequest()
 		response := fixGraphqlMutationError(t)
 		claims := fixClaims()
-		log := fixSuccessConfigChangeMsg(claims, request, response, auditlog.PostAuditlogOperation)
+		log := fixSuccessConfigChangeMsg(claims, fixRequestAttributes("test"), fixRedactedResponse(t, response), auditlog.PostAuditlogOperation)
 
 		client := &automock.AuditlogClient{}
 		client.On("LogConfigurationChange", context.TODO(), log).Return(nil)
-		auditlogSvc := auditlog.NewService(client, factory)
+		auditlogSvc := auditlog.NewService(client, factory, fixRedactor(t))
 
 		//WHEN
 		msg := proxy.AuditlogMessage{
@@ -75,6 +74,35 @@ func TestAuditlogService_Log(t *testing.T) {
 		mock.AssertExpectationsForObjects(t, client, factory)
 	})
 
+	t.Run("Unsuccessful mutation of redacted message", func(t *testing.T) {
+		//GIVEN
+		factory := &automock.AuditlogMessageFactory{}
+		factory.On("CreateConfigurationChange").Return(fixFabricatedConfigChangeMsg())
+
+		request := fixRequest()
+		response := fixGraphqlMutationError(t)
+		claims := fixClaims()
+		log := fixSuccessConfigChangeMsg(claims, fixRequestAttributes("test"), fixRedactedResponse(t, response), auditlog.PostAuditlogOperation)
+
+		client := &automock.AuditlogClient{}
+		client.On("LogConfigurationChange", context.TODO(), log).Return(nil)
+		redactor := fixRedactor(t)
+		auditlogSvc := auditlog.NewService(client, factory, redactor)
+
+		//WHEN
+		msg := redactor.RedactMessage(proxy.AuditlogMessage{
+			CorrelationIDHeaders: fixCorrelationID(),
+			Request:              request,
+			Response:             response,
+			Claims:               claims,
+		})
+		err := auditlogSvc.Log(context.TODO(), msg)
+
+		//THEN
+		require.NoError(t, err)
+		mock.AssertExpectationsForObjects(t, client, factory)
+	})
+
 	t.Run("Success mutation with read error", func(t *testing.T) {
 		//GIVEN
 		factory := &automock.AuditlogMessageFactory{}
@@ -83,11 +111,11 @@ func TestAuditlogService_Log(t *testing.T) {
 		request := fixRequestWithInvalidQuery()
 		response := fixResponseReadError(t)
 		claims := fixClaims()
-		log := fixSuccessConfigChangeMsg(claims, request, "success", auditlog.PostAuditlogOperation)
+		log := fixSuccessConfigChangeMsg(claims, fixRequestAttributes("test1"), "success", auditlog.PostAuditlogOperation)
 
 		client := &automock.AuditlogClient{}
 		client.On("LogConfigurationChange", context.TODO(), log).Return(nil)
-		auditlogSvc := auditlog.NewService(client, factory)
+		auditlogSvc := auditlog.NewService(client, factory, fixRedactor(t))
 
 		//WHEN
 		msg := proxy.AuditlogMessage{
@@ -111,11 +139,11 @@ func TestAuditlogService_Log(t *testing.T) {
 		request := fixRequest()
 		response := fixResponseMultipleError(t)
 		claims := fixClaims()
-		log := fixSuccessConfigChangeMsg(claims, request, "success", auditlog.PostAuditlogOperation)
+		log := fixSuccessConfigChangeMsg(claims, fixRequestAttributes("test"), "success", auditlog.PostAuditlogOperation)
 
 		client := &automock.AuditlogClient{}
 		client.On("LogConfigurationChange", context.TODO(), log).Return(nil)
-		auditlogSvc := auditlog.NewService(client, factory)
+		auditlogSvc := auditlog.NewService(client, factory, fixRedactor(t))
 
 		//WHEN
 		msg := proxy.AuditlogMessage{
@@ -139,11 +167,11 @@ func TestAuditlogService_Log(t *testing.T) {
 		request := fixRequest()
 		response := fixGraphqlMultiErrorWithMutation(t)
 		claims := fixClaims()
-		log := fixSuccessConfigChangeMsg(claims, request, response, auditlog.PostAuditlogOperation)
+		log := fixSuccessConfigChangeMsg(claims, fixRequestAttributes("test"), fixRedactedResponse(t, response), auditlog.PostAuditlogOperation)
 
 		client := &automock.AuditlogClient{}
 		client.On("LogConfigurationChange", context.TODO(), log).Return(nil)
-		auditlogSvc := auditlog.NewService(client, factory)
+		auditlogSvc := auditlog.NewService(client, factory, fixRedactor(t))
 
 		//WHEN
 		msg := proxy.AuditlogMessage{
@@ -167,11 +195,11 @@ func TestAuditlogService_Log(t *testing.T) {
 		request := fixRequestWithQuery()
 		response := fixResponseReadError(t)
 		claims := fixClaims()
-		log := fixSuccessConfigChangeMsg(claims, request, "success", auditlog.PostAuditlogOperation)
+		log := fixSuccessConfigChangeMsg(claims, fixQueryRequestAttributes(), "success", auditlog.PostAuditlogOperation)
 
 		client := &automock.AuditlogClient{}
 		client.On("LogConfigurationChange", context.TODO(), log).Return(nil)
-		auditlogSvc := auditlog.NewService(client, factory)
+		auditlogSvc := auditlog.NewService(client, factory, fixRedactor(t))
 
 		//WHEN
 		msg := proxy.AuditlogMessage{
@@ -195,11 +223,11 @@ func TestAuditlogService_Log(t *testing.T) {
 		request := fixJsonRequest()
 		response := fixResponseReadError(t)
 		claims := fixClaims()
-		log := fixSuccessConfigChangeMsg(claims, request, "success", auditlog.PostAuditlogOperation)
+		log := fixSuccessConfigChangeMsg(claims, fixRequestAttributes("test123"), "success", auditlog.PostAuditlogOperation)
 
 		client := &automock.AuditlogClient{}
 		client.On("LogConfigurationChange", context.TODO(), log).Return(nil)
-		auditlogSvc := auditlog.NewService(client, factory)
+		auditlogSvc := auditlog.NewService(client, factory, fixRedactor(t))
 
 		//WHEN
 		msg := proxy.AuditlogMessage{
@@ -230,7 +258,7 @@ func TestAuditlogService_Log(t *testing.T) {
 
 		client := &automock.AuditlogClient{}
 		client.On("LogSecurityEvent", context.TODO(), securityEventMsg).Return(nil)
-		auditlogSvc := auditlog.NewService(client, factory)
+		auditlogSvc := auditlog.NewService(client, factory, fixRedactor(t))
 
 		//WHEN
 		msg := proxy.AuditlogMessage{
@@ -255,11 +283,11 @@ func TestAuditlogService_Log(t *testing.T) {
 		request := fixRequest()
 		response := fixNoErrorResponse(t)
 		claims := fixClaims()
-		log := fixSuccessConfigChangeMsg(claims, request, "success", auditlog.PostAuditlogOperation)
+		log := fixSuccessConfigChangeMsg(claims, fixRequestAttributes("test"), "success", auditlog.PostAuditlogOperation)
 
 		client := &automock.AuditlogClient{}
 		client.On("LogConfigurationChange", context.TODO(), log).Return(testError)
-		auditlogSvc := auditlog.NewService(client, factory)
+		auditlogSvc := auditlog.NewService(client, factory, fixRedactor(t))
 
 		//WHEN
 		msg := proxy.AuditlogMessage{
@@ -277,55 +305,45 @@ func TestAuditlogService_Log(t *testing.T) {
 	})
 
 }
-func TestSink_TimeoutOnWrite(t *testing.T) {
-	//GIVEN
-	request := "test-request"
-	response := "test-response"
-	claims := proxy.Claims{}
-
-	chanMsg := make(chan proxy.AuditlogMessage)
-	defer close(chanMsg)
-	fakeMetric := &automock.MetricCollector{}
-	sink := auditlog.NewSink(chanMsg, time.Millisecond*100, fakeMetric)
-
-	//WHEN
+func TestSink_Log(t *testing.T) {
+	redactor := fixRedactor(t)
 	msg := proxy.AuditlogMessage{
 		CorrelationIDHeaders: fixCorrelationID(),
-		Request:              request,
-		Response:             response,
-		Claims:               claims,
+		Request:              `mutation { addWebhook(applicationID: "app-id", in: {type: CONFIGURATION_CHANGED, url: "http://webhook", auth: {credential: {basic: {username: "admin", password: "secret"}}}}) { id } }`,
+		Response:             `{"data":{"addWebhook":{"id":"webhook-id"}}}`,
+		Claims:               proxy.Claims{},
 	}
-	err := sink.Log(context.TODO(), msg)
+	redactedMsg := redactor.RedactMessage(msg)
 
-	//THEN
-	require.Error(t, err)
-	assert.EqualError(t, err, "cannot write to the channel")
-}
+	t.Run("should write redacted message to the spool", func(t *testing.T) {
+		//GIVEN
+		spool := &automock.Spool{}
+		spool.On("Put", redactedMsg).Return(nil)
+		sink := auditlog.NewSink(spool, redactor)
 
-func TestSink_Write(t *testing.T) {
-	//GIVEN
-	request := "test-request"
-	response := "test-response"
-	claims := proxy.Claims{}
+		//WHEN
+		err := sink.Log(context.TODO(), msg)
 
-	chanMsg := make(chan proxy.AuditlogMessage, 1)
-	defer close(chanMsg)
-	fakeMetric := &automock.MetricCollector{}
-	fakeMetric.On("SetChannelSize", 1).Return()
-	sink := auditlog.NewSink(chanMsg, time.Millisecond*100, fakeMetric)
+		//THEN
+		require.NoError(t, err)
+		assert.NotContains(t, redactedMsg.Request, "secret")
+		spool.AssertExpectations(t)
+	})
 
-	//WHEN
-	msg := proxy.AuditlogMessage{
-		CorrelationIDHeaders: fixCorrelationID(),
-		Request:              request,
-		Response:             response,
-		Claims:               claims,
-	}
-	err := sink.Log(context.TODO(), msg)
+	t.Run("should return error when failed to write to the spool", func(t *testing.T) {
+		//GIVEN
+		spool := &automock.Spool{}
+		spool.On("Put", redactedMsg).Return(errors.New("spool is full"))
+		sink := auditlog.NewSink(spool, redactor)
 
-	//THEN
-	require.NoError(t, err)
-	fakeMetric.AssertExpectations(t)
+		//WHEN
+		err := sink.Log(context.TODO(), msg)
+
+		//THEN
+		require.Error(t, err)
+		assert.EqualError(t, err, "while writing auditlog message to the spool: spool is full")
+		spool.AssertExpectations(t)
+	})
 }
 
 func fixClaims() proxy.Claims {
@@ -0,0 +1,396 @@
+package auditlog
+
+import (
+	"container/heap"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/kyma-incubator/compass/components/gateway/pkg/proxy"
+	"github.com/pkg/errors"
+)
+
+const (
+	spoolFileExtension    = ".json"
+	spoolTmpFileExtension = ".tmp"
+	deadLetterDir         = "dead-letter"
+)
+
+// SpooledMessage is an auditlog message persisted in the spool until it is delivered to the auditlog service
+type SpooledMessage struct {
+	ID         string                `json:"id"`
+	EnqueuedAt time.Time             `json:"enqueuedAt"`
+	Attempts   int                   `json:"attempts"`
+	Message    proxy.AuditlogMessage `json:"message"`
+}
+
+// SpoolStats describes the backlog of the spool
+type SpoolStats struct {
+	Size             int
+	OldestEnqueuedAt time.Time
+}
+
+//go:generate mockery --name=Spool --output=automock --outpkg=automock --case=underscore
+type Spool interface {
+	Put(msg proxy.AuditlogMessage) error
+	Next(ctx context.Context) (SpooledMessage, error)
+	Ack(msg SpooledMessage) error
+	Retry(msg SpooledMessage, retryAt time.Time) error
+	Reject(msg SpooledMessage) error
+	Stats() SpoolStats
+}
+
+// DiskSpool is a write-ahead queue of auditlog messages, which keeps every message in its own file until it is acknowledged.
+// Messages are written atomically before Put returns, so that messages which are not yet delivered survive restarts of the gateway.
+// Rejected messages are moved to the dead letter directory, where they are kept for manual inspection.
+// The mutex guards only the in-memory state, files are written and synced outside of it, so that concurrent requests
+// do not wait for each other's disk writes. Every message file is handled by a single caller at a time, either by Put
+// or by the consumer the message is handed out to.
+type DiskSpool struct {
+	dir     string
+	maxSize int
+	timeSvc TimeService
+
+	mutex    sync.Mutex
+	entries  map[string]SpooledMessage
+	reserved int
+	pending  spoolQueue
+	enqueued spoolQueue
+	sequence uint64
+	notify   chan struct{}
+}
+
+// NewDiskSpool creates the spool in the given directory and loads the messages left in it by the previous run.
+// A maxSize of 0 means that the size of the spool is not limited.
+func NewDiskSpool(ctx context.Context, dir string, maxSize int, timeSvc TimeService) (*DiskSpool, error) {
+	if err := os.MkdirAll(filepath.Join(dir, deadLetterDir), 0700); err != nil {
+		return nil, errors.Wrapf(err, "while creating auditlog spool directory %s", dir)
+	}
+
+	spool := &DiskSpool{
+		dir:     dir,
+		maxSize: maxSize,
+		timeSvc: timeSvc,
+		entries: make(map[string]SpooledMessage),
+		notify:  make(chan struct{}, 1),
+	}
+
+	if err := spool.load(ctx); err != nil {
+		return nil, err
+	}
+
+	return spool, nil
+}
+
+// Put persists the message in the spool. An error is returned if the message could not be persisted or the spool is full.
+func (s *DiskSpool) Put(msg proxy.AuditlogMessage) error {
+	spooled, err := s.reserve(msg)
+	if err != nil {
+		return err
+	}
+
+	err = s.write(spooled)
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.reserved--
+	if err != nil {
+		return err
+	}
+
+	s.entries[spooled.ID] = spooled
+	heap.Push(&s.pending, spoolItem{id: spooled.ID, at: spooled.EnqueuedAt})
+	heap.Push(&s.enqueued, spoolItem{id: spooled.ID, at: spooled.EnqueuedAt})
+	s.signal()
+
+	return nil
+}
+
+// reserve assigns the ID to the message and takes up its place in the spool while the message is being written
+func (s *DiskSpool) reserve(msg proxy.AuditlogMessage) (SpooledMessage, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if size := len(s.entries) + s.reserved; s.maxSize > 0 && size >= s.maxSize {
+		return SpooledMessage{}, errors.Errorf("auditlog spool is full (size=%d)", size)
+	}
+
+	now := s.timeSvc.Now()
+	s.sequence++
+	s.reserved++
+
+	return SpooledMessage{
+		ID:         fmt.Sprintf("%020d-%06d", now.UnixNano(), s.sequence%1000000),
+		EnqueuedAt: now,
+		Message:    msg,
+	}, nil
+}
+
+// Next blocks until a message is due for delivery and hands it out. The message is not handed out again
+// until it is retried, so the caller must either acknowledge, retry or reject it.
+func (s *DiskSpool) Next(ctx context.Context) (SpooledMessage, error) {
+	for {
+		s.mutex.Lock()
+		wait := time.Duration(-1)
+		if s.pending.Len() > 0 {
+			next := s.pending[0]
+			wait = next.at.Sub(s.timeSvc.Now())
+			if wait <= 0 {
+				heap.Pop(&s.pending)
+				if s.pending.Len() > 0 {
+					s.signal()
+				}
+				msg := s.entries[next.id]
+				s.mutex.Unlock()
+				return msg, nil
+			}
+		}
+		s.mutex.Unlock()
+
+		var timer *time.Timer
+		var due <-chan time.Time
+		if wait > 0 {
+			timer = time.NewTimer(wait)
+			due = timer.C
+		}
+
+		select {
+		case <-ctx.Done():
+		case <-s.notify:
+		case <-due:
+		}
+
+		if timer != nil {
+			timer.Stop()
+		}
+		if ctx.Err() != nil {
+			return SpooledMessage{}, ctx.Err()
+		}
+	}
+}
+
+// Ack removes the delivered message from the spool
+func (s *DiskSpool) Ack(msg SpooledMessage) error {
+	if err := os.Remove(s.path(msg.ID)); err != nil && !os.IsNotExist(err) {
+		return errors.Wrapf(err, "while removing auditlog message %s from the spool", msg.ID)
+	}
+
+	s.remove(msg.ID)
+	return nil
+}
+
+// Retry persists the failed delivery attempt and schedules the message to be handed out again at the given time.
+// The message is scheduled even if the attempt could not be persisted, so that it is not held back until the next restart.
+func (s *DiskSpool) Retry(msg SpooledMessage, retryAt time.Time) error {
+	msg.Attempts++
+	err := s.write(msg)
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.entries[msg.ID] = msg
+	heap.Push(&s.pending, spoolItem{id: msg.ID, at: retryAt})
+	s.signal()
+
+	return err
+}
+
+// Reject moves the message to the dead letter directory, so that it is no longer delivered but is not lost either
+func (s *DiskSpool) Reject(msg SpooledMessage) error {
+	if err := os.Rename(s.path(msg.ID), filepath.Join(s.dir, deadLetterDir, msg.ID+spoolFileExtension)); err != nil {
+		return errors.Wrapf(err, "while moving auditlog message %s to the dead letter directory", msg.ID)
+	}
+	if err := syncDir(filepath.Join(s.dir, deadLetterDir)); err != nil {
+		return errors.Wrapf(err, "while moving auditlog message %s to the dead letter directory", msg.ID)
+	}
+
+	s.remove(msg.ID)
+	return nil
+}
+
+// Stats returns the number of messages in the spool and the time at which the oldest of them was enqueued
+func (s *DiskSpool) Stats() SpoolStats {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	stats := SpoolStats{Size: len(s.entries)}
+	if s.enqueued.Len() > 0 {
+		stats.OldestEnqueuedAt = s.enqueued[0].at
+	}
+
+	return stats
+}
+
+// remove drops the message from the spool, together with the messages enqueued before it which are already removed,
+// so that the oldest message in the spool is always at the top of the enqueued heap
+func (s *DiskSpool) remove(id string) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	delete(s.entries, id)
+	for s.enqueued.Len() > 0 {
+		if _, ok := s.entries[s.enqueued[0].id]; ok {
+			break
+		}
+		heap.Pop(&s.enqueued)
+	}
+}
+
+func (s *DiskSpool) load(ctx context.Context) error {
+	files, err := ioutil.ReadDir(s.dir)
+	if err != nil {
+		return errors.Wrapf(err, "while reading auditlog spool directory %s", s.dir)
+	}
+
+	for _, file := range files {
+		name := file.Name()
+		if file.IsDir() {
+			continue
+		}
+
+		if strings.HasSuffix(name, spoolTmpFileExtension) {
+			// The message has not been acknowledged as enqueued, as the gateway stopped while writing it
+			if err := os.Remove(filepath.Join(s.dir, name)); err != nil {
+				return errors.Wrapf(err, "while removing incomplete auditlog spool file %s", name)
+			}
+			continue
+		}
+
+		if !strings.HasSuffix(name, spoolFileExtension) {
+			continue
+		}
+
+		msg, err := readSpooledMessage(filepath.Join(s.dir, name))
+		if err != nil {
+			log.C(ctx).WithError(err).Errorf("Moving unreadable auditlog spool file %s to the dead letter directory", name)
+			if err := os.Rename(filepath.Join(s.dir, name), filepath.Join(s.dir, deadLetterDir, name)); err != nil {
+				return errors.Wrapf(err, "while moving unreadable auditlog spool file %s", name)
+			}
+			continue
+		}
+
+		s.entries[msg.ID] = msg
+		heap.Push(&s.pending, spoolItem{id: msg.ID, at: msg.EnqueuedAt})
+		heap.Push(&s.enqueued, spoolItem{id: msg.ID, at: msg.EnqueuedAt})
+	}
+
+	log.C(ctx).Infof("Loaded %d undelivered auditlog messages from the spool", len(s.entries))
+	return nil
+}
+
+// write persists the message atomically, by writing it to a temporary file which replaces the message file once it is synced.
+// The directory is synced as well, so that the replaced file survives a crash of the node.
+func (s *DiskSpool) write(msg SpooledMessage) error {
+	data, err := json.Marshal(msg)
+	if err != nil {
+		return errors.Wrapf(err, "while marshalling auditlog message %s", msg.ID)
+	}
+
+	path := s.path(msg.ID)
+	tmpPath := path + spoolTmpFileExtension
+
+	file, err := os.OpenFile(tmpPath, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0600)
+	if err != nil {
+		return errors.Wrapf(err, "while creating auditlog spool file for message %s", msg.ID)
+	}
+
+	if _, err := file.Write(data); err != nil {
+		_ = file.Close()
+		return errors.Wrapf(err, "while writing auditlog message %s", msg.ID)
+	}
+	if err := file.Sync(); err != nil {
+		_ = file.Close()
+		return errors.Wrapf(err, "while syncing auditlog message %s", msg.ID)
+	}
+	if err := file.Close(); err != nil {
+		return errors.Wrapf(err, "while closing auditlog spool file for message %s", msg.ID)
+	}
+
+	if err := os.Rename(tmpPath, path); err != nil {
+		return errors.Wrapf(err, "while committing auditlog message %s", msg.ID)
+	}
+	if err := syncDir(s.dir); err != nil {
+		return errors.Wrapf(err, "while committing auditlog message %s", msg.ID)
+	}
+
+	return nil
+}
+
+func syncDir(dir string) error {
+	file, err := os.Open(dir)
+	if err != nil {
+		return err
+	}
+
+	if err := file.Sync(); err != nil {
+		_ = file.Close()
+		return err
+	}
+	return file.Close()
+}
+
+func (s *DiskSpool) path(id string) string {
+	return filepath.Join(s.dir, id+spoolFileExtension)
+}
+
+// signal wakes up one of the consumers waiting in Next, without blocking if one has already been signalled
+func (s *DiskSpool) signal() {
+	select {
+	case s.notify <- struct{}{}:
+	default:
+	}
+}
+
+func readSpooledMessage(path string) (SpooledMessage, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return SpooledMessage{}, err
+	}
+
+	var msg SpooledMessage
+	if err := json.Unmarshal(data, &msg); err != nil {
+		return SpooledMessage{}, err
+	}
+	if msg.ID == "" {
+		return SpooledMessage{}, errors.New("missing message ID")
+	}
+
+	return msg, nil
+}
+
+type spoolItem struct {
+	id string
+	at time.Time
+}
+
+// spoolQueue is a heap of messages ordered by time, which is the time they are due for the messages waiting for delivery
+// and the time they were enqueued for all messages in the spool
+type spoolQueue []spoolItem
+
+func (q spoolQueue) Len() int { return len(q) }
+
+func (q spoolQueue) Less(i, j int) bool {
+	if q[i].at.Equal(q[j].at) {
+		return q[i].id < q[j].id
+	}
+	return q[i].at.Before(q[j].at)
+}
+
+func (q spoolQueue) Swap(i, j int) { q[i], q[j] = q[j], q[i] }
+
+func (q *spoolQueue) Push(x interface{}) { *q = append(*q, x.(spoolItem)) }
+
+func (q *spoolQueue) Pop() interface{} {
+	old := *q
+	item := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return item
+}
@@ -0,0 +1,294 @@
+package auditlog_test
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/gateway/internal/auditlog"
+	"github.com/kyma-incubator/compass/components/gateway/pkg/proxy"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiskSpool(t *testing.T) {
+	msg := proxy.AuditlogMessage{
+		CorrelationIDHeaders: fixCorrelationID(),
+		Request:              fixRequest(),
+		Response:             "test-response",
+		Claims:               fixClaims(),
+	}
+
+	t.Run("should hand out and acknowledge message", func(t *testing.T) {
+		//GIVEN
+		dir := tempSpoolDir(t)
+		clock := &fakeClock{now: time.Date(2021, 3, 1, 12, 0, 0, 0, time.UTC)}
+		spool, err := auditlog.NewDiskSpool(context.TODO(), dir, 0, clock)
+		require.NoError(t, err)
+
+		//WHEN
+		err = spool.Put(msg)
+		require.NoError(t, err)
+		spooled, err := spool.Next(context.TODO())
+		require.NoError(t, err)
+
+		//THEN
+		assert.Equal(t, msg, spooled.Message)
+		assert.Equal(t, clock.Now(), spooled.EnqueuedAt)
+		assert.Equal(t, 1, spool.Stats().Size)
+
+		require.NoError(t, spool.Ack(spooled))
+		assert.Equal(t, 0, spool.Stats().Size)
+		assert.Empty(t, spoolFiles(t, dir))
+	})
+
+	t.Run("should recover undelivered messages after restart", func(t *testing.T) {
+		//GIVEN
+		dir := tempSpoolDir(t)
+		clock := &fakeClock{now: time.Date(2021, 3, 1, 12, 0, 0, 0, time.UTC)}
+		spool, err := auditlog.NewDiskSpool(context.TODO(), dir, 0, clock)
+		require.NoError(t, err)
+		require.NoError(t, spool.Put(msg))
+
+		inFlight, err := spool.Next(context.TODO())
+		require.NoError(t, err)
+		require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "incomplete.json.tmp"), []byte("{"), 0600))
+		require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "corrupted.json"), []byte("{"), 0600))
+
+		//WHEN
+		restarted, err := auditlog.NewDiskSpool(context.TODO(), dir, 0, clock)
+		require.NoError(t, err)
+
+		//THEN
+		stats := restarted.Stats()
+		assert.Equal(t, 1, stats.Size)
+		assert.Equal(t, clock.Now(), stats.OldestEnqueuedAt)
+
+		recovered, err := restarted.Next(context.TODO())
+		require.NoError(t, err)
+		assert.Equal(t, inFlight, recovered)
+
+		assert.Equal(t, []string{recovered.ID + ".json"}, spoolFiles(t, dir))
+		assert.Equal(t, []string{"corrupted.json"}, spoolFiles(t, filepath.Join(dir, "dead-letter")))
+	})
+
+	t.Run("should hand out retried message when it is due", func(t *testing.T) {
+		//GIVEN
+		dir := tempSpoolDir(t)
+		clock := &fakeClock{now: time.Date(2021, 3, 1, 12, 0, 0, 0, time.UTC)}
+		spool, err := auditlog.NewDiskSpool(context.TODO(), dir, 0, clock)
+		require.NoError(t, err)
+		require.NoError(t, spool.Put(msg))
+
+		spooled, err := spool.Next(context.TODO())
+		require.NoError(t, err)
+
+		//WHEN
+		err = spool.Retry(spooled, clock.Now().Add(time.Minute))
+		require.NoError(t, err)
+
+		//THEN
+		ctx, cancel := context.WithTimeout(context.TODO(), 50*time.Millisecond)
+		defer cancel()
+		_, err = spool.Next(ctx)
+		require.Equal(t, context.DeadlineExceeded, err)
+
+		clock.Add(time.Minute)
+		retried, err := spool.Next(context.TODO())
+		require.NoError(t, err)
+		assert.Equal(t, spooled.ID, retried.ID)
+		assert.Equal(t, 1, retried.Attempts)
+
+		restarted, err := auditlog.NewDiskSpool(context.TODO(), dir, 0, clock)
+		require.NoError(t, err)
+		recovered, err := restarted.Next(context.TODO())
+		require.NoError(t, err)
+		assert.Equal(t, 1, recovered.Attempts)
+	})
+
+	t.Run("should hand out retried message even if the attempt could not be persisted", func(t *testing.T) {
+		//GIVEN
+		dir := tempSpoolDir(t)
+		clock := &fakeClock{now: time.Date(2021, 3, 1, 12, 0, 0, 0, time.UTC)}
+		spool, err := auditlog.NewDiskSpool(context.TODO(), dir, 0, clock)
+		require.NoError(t, err)
+		require.NoError(t, spool.Put(msg))
+
+		spooled, err := spool.Next(context.TODO())
+		require.NoError(t, err)
+		require.NoError(t, os.Mkdir(filepath.Join(dir, spooled.ID+".json.tmp"), 0700))
+
+		//WHEN
+		err = spool.Retry(spooled, clock.Now())
+
+		//THEN
+		require.Error(t, err)
+		ctx, cancel := context.WithTimeout(context.TODO(), 5*time.Second)
+		defer cancel()
+		retried, err := spool.Next(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, spooled.ID, retried.ID)
+	})
+
+	t.Run("should wake up waiting consumer when message is put", func(t *testing.T) {
+		//GIVEN
+		dir := tempSpoolDir(t)
+		clock := &fakeClock{now: time.Date(2021, 3, 1, 12, 0, 0, 0, time.UTC)}
+		spool, err := auditlog.NewDiskSpool(context.TODO(), dir, 0, clock)
+		require.NoError(t, err)
+
+		ctx, cancel := context.WithTimeout(context.TODO(), 5*time.Second)
+		defer cancel()
+
+		received := make(chan auditlog.SpooledMessage)
+		go func() {
+			spooled, err := spool.Next(ctx)
+			if err == nil {
+				received <- spooled
+			}
+		}()
+
+		//WHEN
+		require.NoError(t, spool.Put(msg))
+
+		//THEN
+		select {
+		case spooled := <-received:
+			assert.Equal(t, msg, spooled.Message)
+		case <-ctx.Done():
+			t.Fatal("message was not handed out")
+		}
+	})
+
+	t.Run("should move rejected message to the dead letter directory", func(t *testing.T) {
+		//GIVEN
+		dir := tempSpoolDir(t)
+		clock := &fakeClock{now: time.Date(2021, 3, 1, 12, 0, 0, 0, time.UTC)}
+		spool, err := auditlog.NewDiskSpool(context.TODO(), dir, 0, clock)
+		require.NoError(t, err)
+		require.NoError(t, spool.Put(msg))
+
+		spooled, err := spool.Next(context.TODO())
+		require.NoError(t, err)
+
+		//WHEN
+		err = spool.Reject(spooled)
+
+		//THEN
+		require.NoError(t, err)
+		assert.Equal(t, 0, spool.Stats().Size)
+		assert.Empty(t, spoolFiles(t, dir))
+		assert.Equal(t, []string{spooled.ID + ".json"}, spoolFiles(t, filepath.Join(dir, "dead-letter")))
+	})
+
+	t.Run("should report the oldest message remaining in the spool", func(t *testing.T) {
+		//GIVEN
+		dir := tempSpoolDir(t)
+		clock := &fakeClock{now: time.Date(2021, 3, 1, 12, 0, 0, 0, time.UTC)}
+		spool, err := auditlog.NewDiskSpool(context.TODO(), dir, 0, clock)
+		require.NoError(t, err)
+
+		enqueuedAt := make([]time.Time, 0, 3)
+		for i := 0; i < 3; i++ {
+			enqueuedAt = append(enqueuedAt, clock.Now())
+			require.NoError(t, spool.Put(msg))
+			clock.Add(time.Minute)
+		}
+		first, err := spool.Next(context.TODO())
+		require.NoError(t, err)
+		second, err := spool.Next(context.TODO())
+		require.NoError(t, err)
+
+		//WHEN
+		require.NoError(t, spool.Ack(second))
+		afterSecond := spool.Stats()
+		require.NoError(t, spool.Reject(first))
+		afterFirst := spool.Stats()
+
+		//THEN
+		assert.Equal(t, auditlog.SpoolStats{Size: 2, OldestEnqueuedAt: enqueuedAt[0]}, afterSecond)
+		assert.Equal(t, auditlog.SpoolStats{Size: 1, OldestEnqueuedAt: enqueuedAt[2]}, afterFirst)
+	})
+
+	t.Run("should not exceed the size of the spool with concurrent puts", func(t *testing.T) {
+		//GIVEN
+		dir := tempSpoolDir(t)
+		clock := &fakeClock{now: time.Date(2021, 3, 1, 12, 0, 0, 0, time.UTC)}
+		spool, err := auditlog.NewDiskSpool(context.TODO(), dir, 5, clock)
+		require.NoError(t, err)
+
+		//WHEN
+		var wg sync.WaitGroup
+		for i := 0; i < 20; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				_ = spool.Put(msg)
+			}()
+		}
+		wg.Wait()
+
+		//THEN
+		assert.Equal(t, 5, spool.Stats().Size)
+		assert.Len(t, spoolFiles(t, dir), 5)
+	})
+
+	t.Run("should return error when spool is full", func(t *testing.T) {
+		//GIVEN
+		dir := tempSpoolDir(t)
+		clock := &fakeClock{now: time.Date(2021, 3, 1, 12, 0, 0, 0, time.UTC)}
+		spool, err := auditlog.NewDiskSpool(context.TODO(), dir, 1, clock)
+		require.NoError(t, err)
+		require.NoError(t, spool.Put(msg))
+
+		//WHEN
+		err = spool.Put(msg)
+
+		//THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "auditlog spool is full")
+	})
+}
+
+func tempSpoolDir(t *testing.T) string {
+	dir, err := ioutil.TempDir("", "auditlog-spool")
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = os.RemoveAll(dir)
+	})
+	return dir
+}
+
+func spoolFiles(t *testing.T, dir string) []string {
+	files, err := ioutil.ReadDir(dir)
+	require.NoError(t, err)
+
+	names := make([]string, 0)
+	for _, file := range files {
+		if !file.IsDir() {
+			names = append(names, file.Name())
+		}
+	}
+	return names
+}
+
+type fakeClock struct {
+	mutex sync.Mutex
+	now   time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return c.now
+}
+
+func (c *fakeClock) Add(d time.Duration) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.now = c.now.Add(d)
+}
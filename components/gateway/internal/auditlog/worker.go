@@ -2,6 +2,7 @@ package auditlog
 
 import (
 	"context"
+	"time"
 
 	"github.com/kyma-incubator/compass/components/director/pkg/log"
 
@@ -9,37 +10,111 @@ import (
 	"github.com/kyma-incubator/compass/components/gateway/pkg/proxy"
 )
 
+// RetryPolicy defines how the delivery of auditlog messages is retried. Delays grow exponentially from the initial backoff
+// up to the max backoff. Messages are moved to the dead letter directory of the spool after MaxAttempts, unless it is 0.
+type RetryPolicy struct {
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+	MaxAttempts    int
+}
+
+// Backoff returns the delay before the next delivery, after the given number of failed attempts
+func (p RetryPolicy) Backoff(attempts int) time.Duration {
+	backoff := p.InitialBackoff
+	for i := 1; i < attempts && backoff < p.MaxBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > p.MaxBackoff {
+		return p.MaxBackoff
+	}
+	return backoff
+}
+
+// Worker delivers the messages of the spool to the auditlog service. Messages are removed from the spool only after they are delivered,
+// so a message can be delivered more than once if the gateway stops before removing it.
 type Worker struct {
-	svc             proxy.AuditlogService
-	client          Client
-	auditlogChannel chan proxy.AuditlogMessage
-	done            chan bool
-	collector       MetricCollector
+	svc         proxy.AuditlogService
+	spool       Spool
+	retryPolicy RetryPolicy
+	timeSvc     TimeService
+	collector   MetricCollector
 }
 
-func NewWorker(svc proxy.AuditlogService, auditlogChannel chan proxy.AuditlogMessage, collector MetricCollector) *Worker {
+func NewWorker(svc proxy.AuditlogService, spool Spool, retryPolicy RetryPolicy, timeSvc TimeService, collector MetricCollector) *Worker {
 	return &Worker{
-		svc:             svc,
-		auditlogChannel: auditlogChannel,
-		collector:       collector,
+		svc:         svc,
+		spool:       spool,
+		retryPolicy: retryPolicy,
+		timeSvc:     timeSvc,
+		collector:   collector,
 	}
 }
 
 func (w *Worker) Start(ctx context.Context) {
 	logger := log.C(ctx)
 	for {
+		msg, err := w.spool.Next(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				logger.Infoln("Worker for auditlog message processing has finished")
+				return
+			}
+			logger.WithError(err).Error("while reading auditlog message from the spool")
+			continue
+		}
+
+		w.deliver(ctx, msg)
+	}
+}
+
+func (w *Worker) deliver(ctx context.Context, msg SpooledMessage) {
+	logger := log.C(ctx)
+	msgCtx := context.WithValue(ctx, correlation.HeadersContextKey, msg.Message.CorrelationIDHeaders)
+
+	err := w.svc.Log(msgCtx, msg.Message)
+	if err == nil {
+		if err := w.spool.Ack(msg); err != nil {
+			logger.WithError(err).Errorf("while removing delivered auditlog message %s from the spool", msg.ID)
+		}
+		return
+	}
+
+	w.collector.RecordDeliveryFailure()
+	attempts := msg.Attempts + 1
+	if w.retryPolicy.MaxAttempts > 0 && attempts >= w.retryPolicy.MaxAttempts {
+		logger.WithError(err).Errorf("while saving auditlog message %s, giving up after %d attempts", msg.ID, attempts)
+		rejectErr := w.spool.Reject(msg)
+		if rejectErr == nil {
+			w.collector.RecordDeadLetter()
+			return
+		}
+		logger.WithError(rejectErr).Errorf("while rejecting auditlog message %s, retrying its delivery", msg.ID)
+	}
+
+	retryAt := w.timeSvc.Now().Add(w.retryPolicy.Backoff(attempts))
+	logger.WithError(err).Errorf("while saving auditlog message %s, attempt %d, retrying at %s", msg.ID, attempts, retryAt)
+	if err := w.spool.Retry(msg, retryAt); err != nil {
+		logger.WithError(err).Errorf("while scheduling retry of auditlog message %s", msg.ID)
+	}
+}
+
+// MonitorBacklog reports the size and age of the spool backlog in the given interval, until the context is done
+func MonitorBacklog(ctx context.Context, spool Spool, timeSvc TimeService, collector MetricCollector, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		stats := spool.Stats()
+		age := time.Duration(0)
+		if stats.Size > 0 {
+			age = timeSvc.Now().Sub(stats.OldestEnqueuedAt)
+		}
+		collector.SetBacklog(stats.Size, age)
+
 		select {
 		case <-ctx.Done():
-			logger.Infoln("Worker for auditlog message processing has finished")
 			return
-		case msg := <-w.auditlogChannel:
-			logger.Debugf("Read from auditlog channel (size=%d, cap=%d)", len(w.auditlogChannel), cap(w.auditlogChannel))
-			w.collector.SetChannelSize(len(w.auditlogChannel))
-			ctx := context.WithValue(ctx, correlation.HeadersContextKey, msg.CorrelationIDHeaders)
-			err := w.svc.Log(ctx, msg)
-			if err != nil {
-				logger.WithError(err).Error("while saving auditlog message")
-			}
+		case <-ticker.C:
 		}
 	}
 }
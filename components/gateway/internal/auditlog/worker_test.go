@@ -0,0 +1,174 @@
+package auditlog_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/gateway/internal/auditlog"
+	"github.com/kyma-incubator/compass/components/gateway/internal/auditlog/automock"
+	"github.com/kyma-incubator/compass/components/gateway/pkg/proxy"
+	proxyMocks "github.com/kyma-incubator/compass/components/gateway/pkg/proxy/automock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestRetryPolicy_Backoff(t *testing.T) {
+	policy := auditlog.RetryPolicy{
+		InitialBackoff: time.Second,
+		MaxBackoff:     time.Minute,
+	}
+
+	assert.Equal(t, time.Second, policy.Backoff(1))
+	assert.Equal(t, 2*time.Second, policy.Backoff(2))
+	assert.Equal(t, 32*time.Second, policy.Backoff(6))
+	assert.Equal(t, time.Minute, policy.Backoff(7))
+	assert.Equal(t, time.Minute, policy.Backoff(1000))
+}
+
+func TestWorker_Start(t *testing.T) {
+	now := time.Date(2021, 3, 1, 12, 0, 0, 0, time.UTC)
+	retryPolicy := auditlog.RetryPolicy{
+		InitialBackoff: time.Second,
+		MaxBackoff:     time.Minute,
+		MaxAttempts:    3,
+	}
+	spooled := auditlog.SpooledMessage{
+		ID:         "1",
+		EnqueuedAt: now,
+		Message: proxy.AuditlogMessage{
+			CorrelationIDHeaders: fixCorrelationID(),
+			Request:              fixRequest(),
+			Claims:               fixClaims(),
+		},
+	}
+
+	t.Run("should acknowledge delivered message", func(t *testing.T) {
+		//GIVEN
+		ctx, cancel := context.WithCancel(context.TODO())
+		defer cancel()
+
+		svc := &proxyMocks.AuditlogService{}
+		svc.On("Log", mock.Anything, spooled.Message).Return(nil)
+		spool := fixSpoolHandingOut(ctx, spooled)
+		spool.On("Ack", spooled).Run(func(mock.Arguments) { cancel() }).Return(nil)
+		collector := &automock.MetricCollector{}
+		timeSvc := &automock.TimeService{}
+
+		worker := auditlog.NewWorker(svc, spool, retryPolicy, timeSvc, collector)
+
+		//WHEN
+		worker.Start(ctx)
+
+		//THEN
+		mock.AssertExpectationsForObjects(t, svc, spool, collector, timeSvc)
+	})
+
+	t.Run("should retry message with backoff when delivery failed", func(t *testing.T) {
+		//GIVEN
+		ctx, cancel := context.WithCancel(context.TODO())
+		defer cancel()
+
+		failed := spooled
+		failed.Attempts = 1
+
+		svc := &proxyMocks.AuditlogService{}
+		svc.On("Log", mock.Anything, failed.Message).Return(errors.New("auditlog is down"))
+		spool := fixSpoolHandingOut(ctx, failed)
+		spool.On("Retry", failed, now.Add(2*time.Second)).Run(func(mock.Arguments) { cancel() }).Return(nil)
+		collector := &automock.MetricCollector{}
+		collector.On("RecordDeliveryFailure").Return()
+		timeSvc := &automock.TimeService{}
+		timeSvc.On("Now").Return(now)
+
+		worker := auditlog.NewWorker(svc, spool, retryPolicy, timeSvc, collector)
+
+		//WHEN
+		worker.Start(ctx)
+
+		//THEN
+		mock.AssertExpectationsForObjects(t, svc, spool, collector, timeSvc)
+	})
+
+	t.Run("should reject message after max attempts", func(t *testing.T) {
+		//GIVEN
+		ctx, cancel := context.WithCancel(context.TODO())
+		defer cancel()
+
+		exhausted := spooled
+		exhausted.Attempts = 2
+
+		svc := &proxyMocks.AuditlogService{}
+		svc.On("Log", mock.Anything, exhausted.Message).Return(errors.New("invalid message"))
+		spool := fixSpoolHandingOut(ctx, exhausted)
+		spool.On("Reject", exhausted).Run(func(mock.Arguments) { cancel() }).Return(nil)
+		collector := &automock.MetricCollector{}
+		collector.On("RecordDeliveryFailure").Return()
+		collector.On("RecordDeadLetter").Return()
+		timeSvc := &automock.TimeService{}
+
+		worker := auditlog.NewWorker(svc, spool, retryPolicy, timeSvc, collector)
+
+		//WHEN
+		worker.Start(ctx)
+
+		//THEN
+		mock.AssertExpectationsForObjects(t, svc, spool, collector, timeSvc)
+	})
+
+	t.Run("should retry message when it could not be rejected", func(t *testing.T) {
+		//GIVEN
+		ctx, cancel := context.WithCancel(context.TODO())
+		defer cancel()
+
+		exhausted := spooled
+		exhausted.Attempts = 2
+
+		svc := &proxyMocks.AuditlogService{}
+		svc.On("Log", mock.Anything, exhausted.Message).Return(errors.New("invalid message"))
+		spool := fixSpoolHandingOut(ctx, exhausted)
+		spool.On("Reject", exhausted).Return(errors.New("disk is full"))
+		spool.On("Retry", exhausted, now.Add(4*time.Second)).Run(func(mock.Arguments) { cancel() }).Return(nil)
+		collector := &automock.MetricCollector{}
+		collector.On("RecordDeliveryFailure").Return()
+		timeSvc := &automock.TimeService{}
+		timeSvc.On("Now").Return(now)
+
+		worker := auditlog.NewWorker(svc, spool, retryPolicy, timeSvc, collector)
+
+		//WHEN
+		worker.Start(ctx)
+
+		//THEN
+		mock.AssertExpectationsForObjects(t, svc, spool, collector, timeSvc)
+	})
+}
+
+func TestMonitorBacklog(t *testing.T) {
+	//GIVEN
+	now := time.Date(2021, 3, 1, 12, 0, 0, 0, time.UTC)
+	ctx, cancel := context.WithCancel(context.TODO())
+	defer cancel()
+
+	spool := &automock.Spool{}
+	spool.On("Stats").Return(auditlog.SpoolStats{Size: 3, OldestEnqueuedAt: now.Add(-time.Minute)})
+	timeSvc := &automock.TimeService{}
+	timeSvc.On("Now").Return(now)
+	collector := &automock.MetricCollector{}
+	collector.On("SetBacklog", 3, time.Minute).Run(func(mock.Arguments) { cancel() }).Return()
+
+	//WHEN
+	auditlog.MonitorBacklog(ctx, spool, timeSvc, collector, time.Hour)
+
+	//THEN
+	mock.AssertExpectationsForObjects(t, spool, timeSvc, collector)
+}
+
+// fixSpoolHandingOut returns a spool which hands out the message once and blocks afterwards, until the context is done
+func fixSpoolHandingOut(ctx context.Context, msg auditlog.SpooledMessage) *automock.Spool {
+	spool := &automock.Spool{}
+	spool.On("Next", mock.Anything).Return(msg, nil).Once()
+	spool.On("Next", mock.Anything).Run(func(mock.Arguments) { <-ctx.Done() }).Return(auditlog.SpooledMessage{}, context.Canceled)
+	return spool
+}
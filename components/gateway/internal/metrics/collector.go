@@ -2,23 +2,45 @@ package metrics
 
 import (
 	"net/http"
+	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 )
 
 type AuditlogCollector struct {
-	channelLength           prometheus.Gauge
+	backlogSize             prometheus.Gauge
+	backlogAge              prometheus.Gauge
+	deliveryFailures        prometheus.Counter
+	deadLetters             prometheus.Counter
 	auditlogRequestDuration *prometheus.HistogramVec
 }
 
 func NewAuditlogMetricCollector() *AuditlogCollector {
 	return &AuditlogCollector{
-		channelLength: prometheus.NewGauge(prometheus.GaugeOpts{
+		backlogSize: prometheus.NewGauge(prometheus.GaugeOpts{
 			Namespace: "compass",
 			Subsystem: "gateway",
-			Name:      "auditlog_channel_length",
-			Help:      "current audit log async channel size",
+			Name:      "auditlog_backlog_size",
+			Help:      "current number of audit log messages waiting for delivery in the spool",
+		}),
+		backlogAge: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: "compass",
+			Subsystem: "gateway",
+			Name:      "auditlog_backlog_age_seconds",
+			Help:      "age of the oldest audit log message waiting for delivery in the spool",
+		}),
+		deliveryFailures: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: "compass",
+			Subsystem: "gateway",
+			Name:      "auditlog_delivery_failures_total",
+			Help:      "number of failed audit log message delivery attempts",
+		}),
+		deadLetters: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: "compass",
+			Subsystem: "gateway",
+			Name:      "auditlog_dead_letters_total",
+			Help:      "number of audit log messages moved to the dead letter directory after exhausting their retries",
 		}),
 		auditlogRequestDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
 			Namespace: "compass",
@@ -30,17 +52,32 @@ func NewAuditlogMetricCollector() *AuditlogCollector {
 }
 
 func (c *AuditlogCollector) Describe(ch chan<- *prometheus.Desc) {
-	c.channelLength.Describe(ch)
+	c.backlogSize.Describe(ch)
+	c.backlogAge.Describe(ch)
+	c.deliveryFailures.Describe(ch)
+	c.deadLetters.Describe(ch)
 	c.auditlogRequestDuration.Describe(ch)
 }
 
 func (c *AuditlogCollector) Collect(ch chan<- prometheus.Metric) {
-	c.channelLength.Collect(ch)
+	c.backlogSize.Collect(ch)
+	c.backlogAge.Collect(ch)
+	c.deliveryFailures.Collect(ch)
+	c.deadLetters.Collect(ch)
 	c.auditlogRequestDuration.Collect(ch)
 }
 
-func (c *AuditlogCollector) SetChannelSize(size int) {
-	c.channelLength.Set(float64(size))
+func (c *AuditlogCollector) SetBacklog(size int, age time.Duration) {
+	c.backlogSize.Set(float64(size))
+	c.backlogAge.Set(age.Seconds())
+}
+
+func (c *AuditlogCollector) RecordDeliveryFailure() {
+	c.deliveryFailures.Inc()
+}
+
+func (c *AuditlogCollector) RecordDeadLetter() {
+	c.deadLetters.Inc()
 }
 
 func (c *AuditlogCollector) InstrumentAuditlogHTTPClient(client *http.Client) {
@@ -0,0 +1,30 @@
+package metrics
+
+import "github.com/prometheus/client_golang/prometheus"
+
+type QueryLimitCollector struct {
+	rejectedRequests *prometheus.CounterVec
+}
+
+func NewQueryLimitCollector() *QueryLimitCollector {
+	return &QueryLimitCollector{
+		rejectedRequests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "compass",
+			Subsystem: "gateway",
+			Name:      "rejected_requests_total",
+			Help:      "number of GraphQL requests rejected because of their depth or the rate limit of their consumer",
+		}, []string{"reason"}),
+	}
+}
+
+func (c *QueryLimitCollector) Describe(ch chan<- *prometheus.Desc) {
+	c.rejectedRequests.Describe(ch)
+}
+
+func (c *QueryLimitCollector) Collect(ch chan<- prometheus.Metric) {
+	c.rejectedRequests.Collect(ch)
+}
+
+func (c *QueryLimitCollector) RecordRejectedRequest(reason string) {
+	c.rejectedRequests.WithLabelValues(reason).Inc()
+}
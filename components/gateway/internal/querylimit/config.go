@@ -0,0 +1,7 @@
+package querylimit
+
+type Config struct {
+	MaxDepth       int     `envconfig:"APP_QUERY_MAX_DEPTH,default=15"`
+	RateLimit      float64 `envconfig:"APP_QUERY_RATE_LIMIT,default=0"`
+	RateLimitBurst int     `envconfig:"APP_QUERY_RATE_LIMIT_BURST,default=50"`
+}
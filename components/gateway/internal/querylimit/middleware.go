@@ -0,0 +1,219 @@
+package querylimit
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/kyma-incubator/compass/components/director/pkg/ratelimit"
+	directorTime "github.com/kyma-incubator/compass/components/director/pkg/time"
+	"github.com/kyma-incubator/compass/components/gateway/pkg/proxy"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/parser"
+)
+
+const (
+	DepthLimitExceededCode = "DEPTH_LIMIT_EXCEEDED"
+	RateLimitExceededCode  = "RATE_LIMIT_EXCEEDED"
+
+	RejectedByDepth     = "depth"
+	RejectedByRateLimit = "rate_limit"
+)
+
+//go:generate mockery --name=MetricCollector --output=automock --outpkg=automock --case=underscore
+type MetricCollector interface {
+	RecordRejectedRequest(reason string)
+}
+
+// Middleware rejects GraphQL requests before they are proxied, if the consumer exceeded its rate limit or the operation is too deep.
+// The complexity of the operations is checked by the components serving them, as it depends on their schemas.
+type Middleware struct {
+	cfg         Config
+	rateLimiter *ratelimit.RateLimiter
+	collector   MetricCollector
+}
+
+func NewMiddleware(cfg Config, collector MetricCollector, timeSvc directorTime.Service) *Middleware {
+	var rateLimiter *ratelimit.RateLimiter
+	if cfg.RateLimit > 0 {
+		rateLimiter = ratelimit.NewRateLimiter(cfg.RateLimit, cfg.RateLimitBurst, timeSvc)
+	}
+
+	return &Middleware{
+		cfg:         cfg,
+		rateLimiter: rateLimiter,
+		collector:   collector,
+	}
+}
+
+func (m *Middleware) Handler() func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if m.rateLimiter != nil && !m.allowRate(w, r) {
+				return
+			}
+
+			if m.cfg.MaxDepth > 0 && !m.allowDepth(w, r) {
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+func (m *Middleware) allowRate(w http.ResponseWriter, r *http.Request) bool {
+	claims, err := proxy.ParseClaims(r.Header)
+	if err != nil {
+		log.C(r.Context()).WithError(err).Debug("Claims not found in request, the request is not rate limited")
+		return true
+	}
+
+	allowed, retryAfter := m.rateLimiter.Allow(fmt.Sprintf("%s/%s", claims.Tenant, claims.ConsumerID))
+	if allowed {
+		return true
+	}
+
+	retryAfterSeconds := int(math.Ceil(retryAfter.Seconds()))
+	w.Header().Set("Retry-After", strconv.Itoa(retryAfterSeconds))
+	m.reject(w, r, http.StatusTooManyRequests, RejectedByRateLimit, RateLimitExceededCode,
+		fmt.Sprintf("rate limit of %g requests per second exceeded for consumer %s", m.cfg.RateLimit, claims.ConsumerID))
+	return false
+}
+
+func (m *Middleware) allowDepth(w http.ResponseWriter, r *http.Request) bool {
+	query, err := readQuery(r)
+	if err != nil {
+		log.C(r.Context()).WithError(err).Debug("Query cannot be read, the depth of the request is not checked")
+		return true
+	}
+
+	doc, gqlErr := parser.ParseQuery(&ast.Source{Input: query})
+	if gqlErr != nil {
+		// Invalid queries are rejected by the component serving them
+		return true
+	}
+
+	depth := 0
+	calculator := newDepthCalculator(doc.Fragments)
+	for _, op := range doc.Operations {
+		if opDepth := calculator.selectionSetDepth(op.SelectionSet); opDepth > depth {
+			depth = opDepth
+		}
+	}
+	if depth <= m.cfg.MaxDepth {
+		return true
+	}
+
+	m.reject(w, r, http.StatusUnprocessableEntity, RejectedByDepth, DepthLimitExceededCode,
+		fmt.Sprintf("operation has depth %d, which exceeds the limit of %d", depth, m.cfg.MaxDepth))
+	return false
+}
+
+func (m *Middleware) reject(w http.ResponseWriter, r *http.Request, status int, reason, code, message string) {
+	m.collector.RecordRejectedRequest(reason)
+	log.C(r.Context()).Infof("Rejecting GraphQL request: %s", message)
+
+	response := graphqlErrorResponse{Errors: []graphqlError{{Message: message, Extensions: map[string]interface{}{"code": code}}}}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		log.C(r.Context()).WithError(err).Error("An error has occurred while writing the response")
+	}
+}
+
+type graphqlErrorResponse struct {
+	Errors []graphqlError `json:"errors"`
+}
+
+type graphqlError struct {
+	Message    string                 `json:"message"`
+	Extensions map[string]interface{} `json:"extensions"`
+}
+
+// readQuery returns the GraphQL query sent either in the JSON body of a POST request or as the query parameter of a GET request
+func readQuery(r *http.Request) (string, error) {
+	if r.Method == http.MethodGet {
+		return r.URL.Query().Get("query"), nil
+	}
+	if r.Body == nil {
+		return "", nil
+	}
+
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		return "", err
+	}
+	r.Body = ioutil.NopCloser(bytes.NewBuffer(body))
+
+	var request struct {
+		Query string `json:"query"`
+	}
+	if err := json.Unmarshal(body, &request); err != nil {
+		return "", err
+	}
+	return request.Query, nil
+}
+
+// depthCalculator computes the maximum nesting of fields, not counting the fragments which group them nor introspection fields.
+// The depth of every fragment is computed once, so that fragments spread many times do not make the computation expensive.
+type depthCalculator struct {
+	fragments ast.FragmentDefinitionList
+	depths    map[string]int
+	visiting  map[string]bool
+}
+
+func newDepthCalculator(fragments ast.FragmentDefinitionList) *depthCalculator {
+	return &depthCalculator{
+		fragments: fragments,
+		depths:    make(map[string]int),
+		visiting:  make(map[string]bool),
+	}
+}
+
+func (c *depthCalculator) selectionSetDepth(selectionSet ast.SelectionSet) int {
+	depth := 0
+	for _, selection := range selectionSet {
+		var selectionDepth int
+		switch typed := selection.(type) {
+		case *ast.Field:
+			if strings.HasPrefix(typed.Name, "__") {
+				continue
+			}
+			selectionDepth = 1 + c.selectionSetDepth(typed.SelectionSet)
+		case *ast.InlineFragment:
+			selectionDepth = c.selectionSetDepth(typed.SelectionSet)
+		case *ast.FragmentSpread:
+			selectionDepth = c.fragmentDepth(typed.Name)
+		}
+		if selectionDepth > depth {
+			depth = selectionDepth
+		}
+	}
+	return depth
+}
+
+func (c *depthCalculator) fragmentDepth(name string) int {
+	if depth, ok := c.depths[name]; ok {
+		return depth
+	}
+
+	fragment := c.fragments.ForName(name)
+	if fragment == nil || c.visiting[name] {
+		// Undefined and cyclic fragments are rejected by the component serving the query
+		return 0
+	}
+
+	c.visiting[name] = true
+	depth := c.selectionSetDepth(fragment.SelectionSet)
+	delete(c.visiting, name)
+
+	c.depths[name] = depth
+	return depth
+}
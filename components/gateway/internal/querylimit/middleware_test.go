@@ -0,0 +1,159 @@
+package querylimit_test
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/gateway/internal/querylimit"
+	"github.com/kyma-incubator/compass/components/gateway/internal/querylimit/automock"
+	"github.com/kyma-incubator/compass/components/gateway/pkg/proxy"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMiddleware_Handler(t *testing.T) {
+	deepQuery := `{"query": "{ applications { data { bundles { data { apiDefinitions { data { id } } } } } } }"}`
+	shallowQuery := `{"query": "{ applications { data { id } } }"}`
+
+	t.Run("should pass request within the limits", func(t *testing.T) {
+		//GIVEN
+		collector := &automock.MetricCollector{}
+		middleware := querylimit.NewMiddleware(querylimit.Config{MaxDepth: 7, RateLimit: 1, RateLimitBurst: 1}, collector, fixClock())
+		next := &recordingHandler{}
+
+		//WHEN
+		rec := serve(middleware, next, fixRequest(t, deepQuery, "tenant", "runtime"))
+
+		//THEN
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, deepQuery, next.body)
+		collector.AssertExpectations(t)
+	})
+
+	t.Run("should reject too deep query", func(t *testing.T) {
+		//GIVEN
+		collector := &automock.MetricCollector{}
+		collector.On("RecordRejectedRequest", querylimit.RejectedByDepth).Once()
+		middleware := querylimit.NewMiddleware(querylimit.Config{MaxDepth: 6}, collector, fixClock())
+		next := &recordingHandler{}
+
+		//WHEN
+		rec := serve(middleware, next, fixRequest(t, deepQuery, "tenant", "runtime"))
+
+		//THEN
+		assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+		assert.False(t, next.called)
+		assertGraphQLError(t, rec, "operation has depth 7, which exceeds the limit of 6", querylimit.DepthLimitExceededCode)
+		collector.AssertExpectations(t)
+	})
+
+	t.Run("should count depth of fragments", func(t *testing.T) {
+		//GIVEN
+		query := `{"query": "{ applications { ...apps } } fragment apps on ApplicationPage { data { ...app } } fragment app on Application { bundles { data { id } } }"}`
+		collector := &automock.MetricCollector{}
+		collector.On("RecordRejectedRequest", querylimit.RejectedByDepth).Once()
+		middleware := querylimit.NewMiddleware(querylimit.Config{MaxDepth: 4}, collector, fixClock())
+
+		//WHEN
+		rec := serve(middleware, &recordingHandler{}, fixRequest(t, query, "tenant", "runtime"))
+
+		//THEN
+		assertGraphQLError(t, rec, "operation has depth 5, which exceeds the limit of 4", querylimit.DepthLimitExceededCode)
+		collector.AssertExpectations(t)
+	})
+
+	t.Run("should pass request which is not a GraphQL query", func(t *testing.T) {
+		//GIVEN
+		collector := &automock.MetricCollector{}
+		middleware := querylimit.NewMiddleware(querylimit.Config{MaxDepth: 1}, collector, fixClock())
+		next := &recordingHandler{}
+
+		//WHEN
+		rec := serve(middleware, next, fixRequest(t, "not json", "tenant", "runtime"))
+
+		//THEN
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "not json", next.body)
+	})
+
+	t.Run("should reject request of consumer exceeding the rate limit", func(t *testing.T) {
+		//GIVEN
+		collector := &automock.MetricCollector{}
+		collector.On("RecordRejectedRequest", querylimit.RejectedByRateLimit).Once()
+		middleware := querylimit.NewMiddleware(querylimit.Config{RateLimit: 0.5, RateLimitBurst: 1}, collector, fixClock())
+
+		//WHEN
+		first := serve(middleware, &recordingHandler{}, fixRequest(t, shallowQuery, "tenant", "runtime"))
+		second := serve(middleware, &recordingHandler{}, fixRequest(t, shallowQuery, "tenant", "runtime"))
+		otherConsumer := serve(middleware, &recordingHandler{}, fixRequest(t, shallowQuery, "tenant", "application"))
+
+		//THEN
+		assert.Equal(t, http.StatusOK, first.Code)
+		assert.Equal(t, http.StatusTooManyRequests, second.Code)
+		assert.Equal(t, "2", second.Header().Get("Retry-After"))
+		assertGraphQLError(t, second, "rate limit of 0.5 requests per second exceeded for consumer runtime", querylimit.RateLimitExceededCode)
+		assert.Equal(t, http.StatusOK, otherConsumer.Code)
+		collector.AssertExpectations(t)
+	})
+}
+
+type recordingHandler struct {
+	called bool
+	body   string
+}
+
+func (h *recordingHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	h.called = true
+	body, _ := ioutil.ReadAll(r.Body)
+	h.body = string(body)
+	w.WriteHeader(http.StatusOK)
+}
+
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.now
+}
+
+func fixClock() *fakeClock {
+	return &fakeClock{now: time.Date(2021, 3, 1, 12, 0, 0, 0, time.UTC)}
+}
+
+func serve(middleware *querylimit.Middleware, next http.Handler, req *http.Request) *httptest.ResponseRecorder {
+	rec := httptest.NewRecorder()
+	middleware.Handler()(next).ServeHTTP(rec, req)
+	return rec
+}
+
+func fixRequest(t *testing.T, body, tenant, consumerID string) *http.Request {
+	claims, err := json.Marshal(proxy.Claims{Tenant: tenant, ConsumerID: consumerID})
+	require.NoError(t, err)
+	header := base64.RawURLEncoding.EncodeToString([]byte(`{"alg": "HS256","typ": "JWT"}`))
+	token := fmt.Sprintf("%s.%s.", header, base64.RawURLEncoding.EncodeToString(claims))
+
+	req := httptest.NewRequest(http.MethodPost, "http://localhost/director/graphql", bytes.NewBufferString(body))
+	req.Header.Set("Authorization", "Bearer "+token)
+	return req
+}
+
+func assertGraphQLError(t *testing.T, rec *httptest.ResponseRecorder, message, code string) {
+	var response struct {
+		Errors []struct {
+			Message    string                 `json:"message"`
+			Extensions map[string]interface{} `json:"extensions"`
+		} `json:"errors"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	require.Len(t, response.Errors, 1)
+	assert.Equal(t, message, response.Errors[0].Message)
+	assert.Equal(t, code, response.Errors[0].Extensions["code"])
+}
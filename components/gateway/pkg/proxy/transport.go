@@ -84,7 +84,7 @@ func (t *Transport) RoundTrip(req *http.Request) (resp *http.Response, err error
 		return nil, errors.New("Failed to type cast PreAuditlogService")
 	}
 
-	claims, err := t.getClaims(req.Header)
+	claims, err := ParseClaims(req.Header)
 	if err != nil {
 		return nil, errors.Wrap(err, "while parsing JWT")
 	}
@@ -156,7 +156,8 @@ func (c Claims) Valid() error {
 	return nil
 }
 
-func (t *Transport) getClaims(headers http.Header) (Claims, error) {
+// ParseClaims reads the claims of the bearer token issued by the tenant mapping, without verifying it
+func ParseClaims(headers http.Header) (Claims, error) {
 	token := headers.Get("Authorization")
 	if token == "" {
 		return Claims{}, errors.New("no bearer token")
@@ -64,6 +64,7 @@ const (
 type Webhook struct {
 	WebhookID         string `json:"webhook_id"`
 	RetriesCount      int    `json:"retries_count"`
+	FailedAttempts    int    `json:"failed_attempts"`
 	WebhookPollURL    string `json:"webhook_poll_url"`
 	LastPollTimestamp string `json:"last_poll_timestamp"`
 	State             State  `json:"state"`
@@ -129,37 +130,65 @@ func (o *OperationValidationErr) Error() string {
 
 // Validate implements validation logic for the Operation CR
 func (in *Operation) Validate() error {
-	webhookCount := len(in.Spec.WebhookIDs)
-	if webhookCount > 1 {
-		return &OperationValidationErr{Description: fmt.Sprintf("expected 0 or 1 webhook for execution, found: %d", webhookCount)}
+	webhookIDs := make(map[string]bool, len(in.Spec.WebhookIDs))
+	for _, webhookID := range in.Spec.WebhookIDs {
+		if webhookIDs[webhookID] {
+			return &OperationValidationErr{Description: fmt.Sprintf("expected unique webhook IDs for execution, found duplicate: %q", webhookID)}
+		}
+		webhookIDs[webhookID] = true
 	}
 
 	return nil
 }
 
-// HasPollURL checks whether the current Operation has been provided with a Poll URL
-func (in *Operation) HasPollURL() bool {
-	return in.PollURL() != ""
+// WebhookStatus returns the status entry of the webhook with the given ID
+// and nil if the webhook is not part of the current Operation status
+func (in *Operation) WebhookStatus(webhookID string) *Webhook {
+	for i := range in.Status.Webhooks {
+		if in.Status.Webhooks[i].WebhookID == webhookID {
+			return &in.Status.Webhooks[i]
+		}
+	}
+
+	return nil
+}
+
+// WebhookState returns the execution state of the webhook with the given ID.
+// Webhooks which are not yet part of the Operation status are considered In Progress.
+func (in *Operation) WebhookState(webhookID string) State {
+	webhookStatus := in.WebhookStatus(webhookID)
+	if webhookStatus == nil || webhookStatus.State == "" {
+		return StateInProgress
+	}
+
+	return webhookStatus.State
+}
+
+// HasPollURL checks whether the webhook with the given ID has been provided with a Poll URL
+func (in *Operation) HasPollURL(webhookID string) bool {
+	return in.PollURL(webhookID) != ""
 }
 
-// PollURL returns the Poll URL for the current Operation
+// PollURL returns the Poll URL for the webhook with the given ID
 // and empty string if a URL has not been provided
-func (in *Operation) PollURL() string {
-	if len(in.Status.Webhooks) == 0 {
+func (in *Operation) PollURL(webhookID string) string {
+	webhookStatus := in.WebhookStatus(webhookID)
+	if webhookStatus == nil {
 		return ""
 	}
 
-	return in.Status.Webhooks[0].WebhookPollURL
+	return webhookStatus.WebhookPollURL
 }
 
 // NextPollTime calculates the remaining time until the Poll URL associated with
-// the current Operation can be requested/polled again.
-func (in *Operation) NextPollTime(retryInterval *int, timeLayout string) (time.Duration, error) {
-	if len(in.Status.Webhooks) == 0 || in.Status.Webhooks[0].LastPollTimestamp == "" || retryInterval == nil {
+// the webhook with the given ID can be requested/polled again.
+func (in *Operation) NextPollTime(webhookID string, retryInterval *int, timeLayout string) (time.Duration, error) {
+	webhookStatus := in.WebhookStatus(webhookID)
+	if webhookStatus == nil || webhookStatus.LastPollTimestamp == "" || retryInterval == nil {
 		return 0, nil
 	}
 
-	lastPollTimestamp, err := time.Parse(timeLayout, in.Status.Webhooks[0].LastPollTimestamp)
+	lastPollTimestamp, err := time.Parse(timeLayout, webhookStatus.LastPollTimestamp)
 	if err != nil {
 		return 0, err
 	}
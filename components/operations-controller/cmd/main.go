@@ -19,6 +19,7 @@ import (
 	"net/http"
 	"os"
 
+	directorhttp "github.com/kyma-incubator/compass/components/director/pkg/http"
 	"github.com/kyma-incubator/compass/components/director/pkg/log"
 	"github.com/kyma-incubator/compass/components/director/pkg/signal"
 	"github.com/kyma-incubator/compass/components/operations-controller/internal/auth"
@@ -126,7 +127,8 @@ func fatalOnError(err error) {
 }
 
 func prepareHttpClient(cfg *httputil.Config) (*http.Client, error) {
-	httpTransport := httputil.NewCorrelationIDTransport(httputil.NewHTTPTransport(cfg))
+	baseTransport := httputil.NewHTTPTransport(cfg)
+	httpTransport := httputil.NewCorrelationIDTransport(baseTransport)
 
 	unsecuredClient := &http.Client{
 		Transport: httpTransport,
@@ -137,7 +139,10 @@ func prepareHttpClient(cfg *httputil.Config) (*http.Client, error) {
 	tokenProvider := auth.NewTokenAuthorizationProvider(unsecuredClient)
 	unsignedTokenProvider := auth.NewUnsignedTokenAuthorizationProvider()
 
-	securedTransport := httputil.NewSecuredTransport(httpTransport, basicProvider, tokenProvider, unsignedTokenProvider)
+	// webhook requests are additionally signed and authenticated with a client certificate, if configured in the webhook auth
+	webhookTransport := httputil.NewCorrelationIDTransport(directorhttp.NewSigningTransport(directorhttp.NewClientCertificateTransport(baseTransport)))
+
+	securedTransport := httputil.NewSecuredTransport(webhookTransport, basicProvider, tokenProvider, unsignedTokenProvider)
 	securedClient := &http.Client{
 		Transport: securedTransport,
 		Timeout:   cfg.Timeout,
@@ -97,15 +97,17 @@ func assertStatusManagerSuccessStatusCalledWithOperation(t *testing.T, statusMan
 
 func assertStatusManagerInProgressWithPollURLCalled(t *testing.T, statusManagerClient *controllersfakes.FakeStatusManager, expectedOperation *v1alpha1.Operation, expectedPollURL string) {
 	require.Equal(t, 1, statusManagerClient.InProgressWithPollURLCallCount())
-	_, actualOperation, pollURL := statusManagerClient.InProgressWithPollURLArgsForCall(0)
+	_, actualOperation, webhookID, pollURL := statusManagerClient.InProgressWithPollURLArgsForCall(0)
 	require.Equal(t, expectedOperation, actualOperation)
+	require.Equal(t, expectedOperation.Spec.WebhookIDs[0], webhookID)
 	require.Equal(t, expectedPollURL, pollURL)
 }
 
 func assertStatusManagerInProgressWithPollURLAndLastTimestampCalled(t *testing.T, statusManagerClient *controllersfakes.FakeStatusManager, expectedOperation *v1alpha1.Operation, expectedPollURL string) {
 	require.Equal(t, 1, statusManagerClient.InProgressWithPollURLAndLastPollTimestampCallCount())
-	_, actualOperation, pollURL, lastPollTimestamp, retryCount := statusManagerClient.InProgressWithPollURLAndLastPollTimestampArgsForCall(0)
+	_, actualOperation, webhookID, pollURL, lastPollTimestamp, retryCount := statusManagerClient.InProgressWithPollURLAndLastPollTimestampArgsForCall(0)
 	require.Equal(t, expectedOperation, actualOperation)
+	require.Equal(t, expectedOperation.Spec.WebhookIDs[0], webhookID)
 	require.Equal(t, expectedPollURL, pollURL)
 
 	timestamp, err := time.Parse(time.RFC3339Nano, lastPollTimestamp)
@@ -136,6 +138,11 @@ func assertDirectorUpdateOperationWithErrorCalled(t *testing.T, directorClient *
 
 func assertDirectorUpdateOperationWithErrorInvocation(t *testing.T, directorClient *controllersfakes.FakeDirectorClient, operation *v1alpha1.Operation, errMsg string, invocation int) {
 	_, actualRequest := directorClient.UpdateOperationArgsForCall(invocation)
+	expectedOperationID := operation.Spec.OperationID
+	if expectedOperationID == "" {
+		expectedOperationID = string(operation.UID)
+	}
+	require.Equal(t, expectedOperationID, actualRequest.OperationID)
 	require.Equal(t, graphql.OperationType(operation.Spec.OperationType), actualRequest.OperationType)
 	require.Equal(t, resource.Type(operation.Spec.ResourceType), actualRequest.ResourceType)
 	require.Equal(t, operation.Spec.ResourceID, actualRequest.ResourceID)
@@ -153,6 +160,13 @@ func assertDirectorFetchApplicationInvocation(t *testing.T, directorClient *cont
 	require.Equal(t, expectedTenantID, ctx.Value(tenant.ContextKey))
 }
 
+func assertDirectorFetchRuntimeCalled(t *testing.T, directorClient *controllersfakes.FakeDirectorClient, expectedResourceID, expectedTenantID string) {
+	require.Equal(t, 1, directorClient.FetchRuntimeCallCount())
+	ctx, resourceID := directorClient.FetchRuntimeArgsForCall(0)
+	require.Equal(t, expectedResourceID, resourceID)
+	require.Equal(t, expectedTenantID, ctx.Value(tenant.ContextKey))
+}
+
 func assertWebhookDoCalled(t *testing.T, webhookClient *controllersfakes.FakeWebhookClient, operation *v1alpha1.Operation, webhookEntity *graphql.Webhook) {
 	require.Equal(t, 1, webhookClient.DoCallCount())
 	assertWebhookDoInvocation(t, webhookClient, operation, webhookEntity, 0)
@@ -23,12 +23,13 @@ type FakeStatusManager struct {
 	failedStatusReturnsOnCall map[int]struct {
 		result1 error
 	}
-	InProgressWithPollURLStub        func(context.Context, *v1alpha1.Operation, string) error
+	InProgressWithPollURLStub        func(context.Context, *v1alpha1.Operation, string, string) error
 	inProgressWithPollURLMutex       sync.RWMutex
 	inProgressWithPollURLArgsForCall []struct {
 		arg1 context.Context
 		arg2 *v1alpha1.Operation
 		arg3 string
+		arg4 string
 	}
 	inProgressWithPollURLReturns struct {
 		result1 error
@@ -36,14 +37,15 @@ type FakeStatusManager struct {
 	inProgressWithPollURLReturnsOnCall map[int]struct {
 		result1 error
 	}
-	InProgressWithPollURLAndLastPollTimestampStub        func(context.Context, *v1alpha1.Operation, string, string, int) error
+	InProgressWithPollURLAndLastPollTimestampStub        func(context.Context, *v1alpha1.Operation, string, string, string, int) error
 	inProgressWithPollURLAndLastPollTimestampMutex       sync.RWMutex
 	inProgressWithPollURLAndLastPollTimestampArgsForCall []struct {
 		arg1 context.Context
 		arg2 *v1alpha1.Operation
 		arg3 string
 		arg4 string
-		arg5 int
+		arg5 string
+		arg6 int
 	}
 	inProgressWithPollURLAndLastPollTimestampReturns struct {
 		result1 error
@@ -74,6 +76,46 @@ type FakeStatusManager struct {
 	successStatusReturnsOnCall map[int]struct {
 		result1 error
 	}
+	WebhookFailedAttemptStub        func(context.Context, *v1alpha1.Operation, string, int) error
+	webhookFailedAttemptMutex       sync.RWMutex
+	webhookFailedAttemptArgsForCall []struct {
+		arg1 context.Context
+		arg2 *v1alpha1.Operation
+		arg3 string
+		arg4 int
+	}
+	webhookFailedAttemptReturns struct {
+		result1 error
+	}
+	webhookFailedAttemptReturnsOnCall map[int]struct {
+		result1 error
+	}
+	WebhookFailedStatusStub        func(context.Context, *v1alpha1.Operation, string) error
+	webhookFailedStatusMutex       sync.RWMutex
+	webhookFailedStatusArgsForCall []struct {
+		arg1 context.Context
+		arg2 *v1alpha1.Operation
+		arg3 string
+	}
+	webhookFailedStatusReturns struct {
+		result1 error
+	}
+	webhookFailedStatusReturnsOnCall map[int]struct {
+		result1 error
+	}
+	WebhookSuccessStatusStub        func(context.Context, *v1alpha1.Operation, string) error
+	webhookSuccessStatusMutex       sync.RWMutex
+	webhookSuccessStatusArgsForCall []struct {
+		arg1 context.Context
+		arg2 *v1alpha1.Operation
+		arg3 string
+	}
+	webhookSuccessStatusReturns struct {
+		result1 error
+	}
+	webhookSuccessStatusReturnsOnCall map[int]struct {
+		result1 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -140,18 +182,19 @@ func (fake *FakeStatusManager) FailedStatusReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
-func (fake *FakeStatusManager) InProgressWithPollURL(arg1 context.Context, arg2 *v1alpha1.Operation, arg3 string) error {
+func (fake *FakeStatusManager) InProgressWithPollURL(arg1 context.Context, arg2 *v1alpha1.Operation, arg3 string, arg4 string) error {
 	fake.inProgressWithPollURLMutex.Lock()
 	ret, specificReturn := fake.inProgressWithPollURLReturnsOnCall[len(fake.inProgressWithPollURLArgsForCall)]
 	fake.inProgressWithPollURLArgsForCall = append(fake.inProgressWithPollURLArgsForCall, struct {
 		arg1 context.Context
 		arg2 *v1alpha1.Operation
 		arg3 string
-	}{arg1, arg2, arg3})
-	fake.recordInvocation("InProgressWithPollURL", []interface{}{arg1, arg2, arg3})
+		arg4 string
+	}{arg1, arg2, arg3, arg4})
+	fake.recordInvocation("InProgressWithPollURL", []interface{}{arg1, arg2, arg3, arg4})
 	fake.inProgressWithPollURLMutex.Unlock()
 	if fake.InProgressWithPollURLStub != nil {
-		return fake.InProgressWithPollURLStub(arg1, arg2, arg3)
+		return fake.InProgressWithPollURLStub(arg1, arg2, arg3, arg4)
 	}
 	if specificReturn {
 		return ret.result1
@@ -166,17 +209,17 @@ func (fake *FakeStatusManager) InProgressWithPollURLCallCount() int {
 	return len(fake.inProgressWithPollURLArgsForCall)
 }
 
-func (fake *FakeStatusManager) InProgressWithPollURLCalls(stub func(context.Context, *v1alpha1.Operation, string) error) {
+func (fake *FakeStatusManager) InProgressWithPollURLCalls(stub func(context.Context, *v1alpha1.Operation, string, string) error) {
 	fake.inProgressWithPollURLMutex.Lock()
 	defer fake.inProgressWithPollURLMutex.Unlock()
 	fake.InProgressWithPollURLStub = stub
 }
 
-func (fake *FakeStatusManager) InProgressWithPollURLArgsForCall(i int) (context.Context, *v1alpha1.Operation, string) {
+func (fake *FakeStatusManager) InProgressWithPollURLArgsForCall(i int) (context.Context, *v1alpha1.Operation, string, string) {
 	fake.inProgressWithPollURLMutex.RLock()
 	defer fake.inProgressWithPollURLMutex.RUnlock()
 	argsForCall := fake.inProgressWithPollURLArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
 }
 
 func (fake *FakeStatusManager) InProgressWithPollURLReturns(result1 error) {
@@ -202,7 +245,7 @@ func (fake *FakeStatusManager) InProgressWithPollURLReturnsOnCall(i int, result1
 	}{result1}
 }
 
-func (fake *FakeStatusManager) InProgressWithPollURLAndLastPollTimestamp(arg1 context.Context, arg2 *v1alpha1.Operation, arg3 string, arg4 string, arg5 int) error {
+func (fake *FakeStatusManager) InProgressWithPollURLAndLastPollTimestamp(arg1 context.Context, arg2 *v1alpha1.Operation, arg3 string, arg4 string, arg5 string, arg6 int) error {
 	fake.inProgressWithPollURLAndLastPollTimestampMutex.Lock()
 	ret, specificReturn := fake.inProgressWithPollURLAndLastPollTimestampReturnsOnCall[len(fake.inProgressWithPollURLAndLastPollTimestampArgsForCall)]
 	fake.inProgressWithPollURLAndLastPollTimestampArgsForCall = append(fake.inProgressWithPollURLAndLastPollTimestampArgsForCall, struct {
@@ -210,12 +253,13 @@ func (fake *FakeStatusManager) InProgressWithPollURLAndLastPollTimestamp(arg1 co
 		arg2 *v1alpha1.Operation
 		arg3 string
 		arg4 string
-		arg5 int
-	}{arg1, arg2, arg3, arg4, arg5})
-	fake.recordInvocation("InProgressWithPollURLAndLastPollTimestamp", []interface{}{arg1, arg2, arg3, arg4, arg5})
+		arg5 string
+		arg6 int
+	}{arg1, arg2, arg3, arg4, arg5, arg6})
+	fake.recordInvocation("InProgressWithPollURLAndLastPollTimestamp", []interface{}{arg1, arg2, arg3, arg4, arg5, arg6})
 	fake.inProgressWithPollURLAndLastPollTimestampMutex.Unlock()
 	if fake.InProgressWithPollURLAndLastPollTimestampStub != nil {
-		return fake.InProgressWithPollURLAndLastPollTimestampStub(arg1, arg2, arg3, arg4, arg5)
+		return fake.InProgressWithPollURLAndLastPollTimestampStub(arg1, arg2, arg3, arg4, arg5, arg6)
 	}
 	if specificReturn {
 		return ret.result1
@@ -230,17 +274,17 @@ func (fake *FakeStatusManager) InProgressWithPollURLAndLastPollTimestampCallCoun
 	return len(fake.inProgressWithPollURLAndLastPollTimestampArgsForCall)
 }
 
-func (fake *FakeStatusManager) InProgressWithPollURLAndLastPollTimestampCalls(stub func(context.Context, *v1alpha1.Operation, string, string, int) error) {
+func (fake *FakeStatusManager) InProgressWithPollURLAndLastPollTimestampCalls(stub func(context.Context, *v1alpha1.Operation, string, string, string, int) error) {
 	fake.inProgressWithPollURLAndLastPollTimestampMutex.Lock()
 	defer fake.inProgressWithPollURLAndLastPollTimestampMutex.Unlock()
 	fake.InProgressWithPollURLAndLastPollTimestampStub = stub
 }
 
-func (fake *FakeStatusManager) InProgressWithPollURLAndLastPollTimestampArgsForCall(i int) (context.Context, *v1alpha1.Operation, string, string, int) {
+func (fake *FakeStatusManager) InProgressWithPollURLAndLastPollTimestampArgsForCall(i int) (context.Context, *v1alpha1.Operation, string, string, string, int) {
 	fake.inProgressWithPollURLAndLastPollTimestampMutex.RLock()
 	defer fake.inProgressWithPollURLAndLastPollTimestampMutex.RUnlock()
 	argsForCall := fake.inProgressWithPollURLAndLastPollTimestampArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5, argsForCall.arg6
 }
 
 func (fake *FakeStatusManager) InProgressWithPollURLAndLastPollTimestampReturns(result1 error) {
@@ -387,6 +431,197 @@ func (fake *FakeStatusManager) SuccessStatusReturnsOnCall(i int, result1 error)
 	}{result1}
 }
 
+func (fake *FakeStatusManager) WebhookFailedAttempt(arg1 context.Context, arg2 *v1alpha1.Operation, arg3 string, arg4 int) error {
+	fake.webhookFailedAttemptMutex.Lock()
+	ret, specificReturn := fake.webhookFailedAttemptReturnsOnCall[len(fake.webhookFailedAttemptArgsForCall)]
+	fake.webhookFailedAttemptArgsForCall = append(fake.webhookFailedAttemptArgsForCall, struct {
+		arg1 context.Context
+		arg2 *v1alpha1.Operation
+		arg3 string
+		arg4 int
+	}{arg1, arg2, arg3, arg4})
+	fake.recordInvocation("WebhookFailedAttempt", []interface{}{arg1, arg2, arg3, arg4})
+	fake.webhookFailedAttemptMutex.Unlock()
+	if fake.WebhookFailedAttemptStub != nil {
+		return fake.WebhookFailedAttemptStub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.webhookFailedAttemptReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeStatusManager) WebhookFailedAttemptCallCount() int {
+	fake.webhookFailedAttemptMutex.RLock()
+	defer fake.webhookFailedAttemptMutex.RUnlock()
+	return len(fake.webhookFailedAttemptArgsForCall)
+}
+
+func (fake *FakeStatusManager) WebhookFailedAttemptCalls(stub func(context.Context, *v1alpha1.Operation, string, int) error) {
+	fake.webhookFailedAttemptMutex.Lock()
+	defer fake.webhookFailedAttemptMutex.Unlock()
+	fake.WebhookFailedAttemptStub = stub
+}
+
+func (fake *FakeStatusManager) WebhookFailedAttemptArgsForCall(i int) (context.Context, *v1alpha1.Operation, string, int) {
+	fake.webhookFailedAttemptMutex.RLock()
+	defer fake.webhookFailedAttemptMutex.RUnlock()
+	argsForCall := fake.webhookFailedAttemptArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeStatusManager) WebhookFailedAttemptReturns(result1 error) {
+	fake.webhookFailedAttemptMutex.Lock()
+	defer fake.webhookFailedAttemptMutex.Unlock()
+	fake.WebhookFailedAttemptStub = nil
+	fake.webhookFailedAttemptReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStatusManager) WebhookFailedAttemptReturnsOnCall(i int, result1 error) {
+	fake.webhookFailedAttemptMutex.Lock()
+	defer fake.webhookFailedAttemptMutex.Unlock()
+	fake.WebhookFailedAttemptStub = nil
+	if fake.webhookFailedAttemptReturnsOnCall == nil {
+		fake.webhookFailedAttemptReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.webhookFailedAttemptReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStatusManager) WebhookFailedStatus(arg1 context.Context, arg2 *v1alpha1.Operation, arg3 string) error {
+	fake.webhookFailedStatusMutex.Lock()
+	ret, specificReturn := fake.webhookFailedStatusReturnsOnCall[len(fake.webhookFailedStatusArgsForCall)]
+	fake.webhookFailedStatusArgsForCall = append(fake.webhookFailedStatusArgsForCall, struct {
+		arg1 context.Context
+		arg2 *v1alpha1.Operation
+		arg3 string
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("WebhookFailedStatus", []interface{}{arg1, arg2, arg3})
+	fake.webhookFailedStatusMutex.Unlock()
+	if fake.WebhookFailedStatusStub != nil {
+		return fake.WebhookFailedStatusStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.webhookFailedStatusReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeStatusManager) WebhookFailedStatusCallCount() int {
+	fake.webhookFailedAttemptMutex.RLock()
+	defer fake.webhookFailedAttemptMutex.RUnlock()
+	fake.webhookFailedStatusMutex.RLock()
+	defer fake.webhookFailedStatusMutex.RUnlock()
+	return len(fake.webhookFailedStatusArgsForCall)
+}
+
+func (fake *FakeStatusManager) WebhookFailedStatusCalls(stub func(context.Context, *v1alpha1.Operation, string) error) {
+	fake.webhookFailedStatusMutex.Lock()
+	defer fake.webhookFailedStatusMutex.Unlock()
+	fake.WebhookFailedStatusStub = stub
+}
+
+func (fake *FakeStatusManager) WebhookFailedStatusArgsForCall(i int) (context.Context, *v1alpha1.Operation, string) {
+	fake.webhookFailedAttemptMutex.RLock()
+	defer fake.webhookFailedAttemptMutex.RUnlock()
+	fake.webhookFailedStatusMutex.RLock()
+	defer fake.webhookFailedStatusMutex.RUnlock()
+	argsForCall := fake.webhookFailedStatusArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeStatusManager) WebhookFailedStatusReturns(result1 error) {
+	fake.webhookFailedStatusMutex.Lock()
+	defer fake.webhookFailedStatusMutex.Unlock()
+	fake.WebhookFailedStatusStub = nil
+	fake.webhookFailedStatusReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStatusManager) WebhookFailedStatusReturnsOnCall(i int, result1 error) {
+	fake.webhookFailedStatusMutex.Lock()
+	defer fake.webhookFailedStatusMutex.Unlock()
+	fake.WebhookFailedStatusStub = nil
+	if fake.webhookFailedStatusReturnsOnCall == nil {
+		fake.webhookFailedStatusReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.webhookFailedStatusReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStatusManager) WebhookSuccessStatus(arg1 context.Context, arg2 *v1alpha1.Operation, arg3 string) error {
+	fake.webhookSuccessStatusMutex.Lock()
+	ret, specificReturn := fake.webhookSuccessStatusReturnsOnCall[len(fake.webhookSuccessStatusArgsForCall)]
+	fake.webhookSuccessStatusArgsForCall = append(fake.webhookSuccessStatusArgsForCall, struct {
+		arg1 context.Context
+		arg2 *v1alpha1.Operation
+		arg3 string
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("WebhookSuccessStatus", []interface{}{arg1, arg2, arg3})
+	fake.webhookSuccessStatusMutex.Unlock()
+	if fake.WebhookSuccessStatusStub != nil {
+		return fake.WebhookSuccessStatusStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.webhookSuccessStatusReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeStatusManager) WebhookSuccessStatusCallCount() int {
+	fake.webhookSuccessStatusMutex.RLock()
+	defer fake.webhookSuccessStatusMutex.RUnlock()
+	return len(fake.webhookSuccessStatusArgsForCall)
+}
+
+func (fake *FakeStatusManager) WebhookSuccessStatusCalls(stub func(context.Context, *v1alpha1.Operation, string) error) {
+	fake.webhookSuccessStatusMutex.Lock()
+	defer fake.webhookSuccessStatusMutex.Unlock()
+	fake.WebhookSuccessStatusStub = stub
+}
+
+func (fake *FakeStatusManager) WebhookSuccessStatusArgsForCall(i int) (context.Context, *v1alpha1.Operation, string) {
+	fake.webhookSuccessStatusMutex.RLock()
+	defer fake.webhookSuccessStatusMutex.RUnlock()
+	argsForCall := fake.webhookSuccessStatusArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeStatusManager) WebhookSuccessStatusReturns(result1 error) {
+	fake.webhookSuccessStatusMutex.Lock()
+	defer fake.webhookSuccessStatusMutex.Unlock()
+	fake.WebhookSuccessStatusStub = nil
+	fake.webhookSuccessStatusReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStatusManager) WebhookSuccessStatusReturnsOnCall(i int, result1 error) {
+	fake.webhookSuccessStatusMutex.Lock()
+	defer fake.webhookSuccessStatusMutex.Unlock()
+	fake.WebhookSuccessStatusStub = nil
+	if fake.webhookSuccessStatusReturnsOnCall == nil {
+		fake.webhookSuccessStatusReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.webhookSuccessStatusReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeStatusManager) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -400,6 +635,12 @@ func (fake *FakeStatusManager) Invocations() map[string][][]interface{} {
 	defer fake.initializeMutex.RUnlock()
 	fake.successStatusMutex.RLock()
 	defer fake.successStatusMutex.RUnlock()
+	fake.webhookFailedAttemptMutex.RLock()
+	defer fake.webhookFailedAttemptMutex.RUnlock()
+	fake.webhookFailedStatusMutex.RLock()
+	defer fake.webhookFailedStatusMutex.RUnlock()
+	fake.webhookSuccessStatusMutex.RLock()
+	defer fake.webhookSuccessStatusMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -17,6 +17,8 @@ package controllers
 import (
 	"context"
 	"fmt"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/kyma-incubator/compass/components/operations-controller/internal/errors"
@@ -57,6 +59,27 @@ func NewOperationReconciler(config *webhook.Config, statusManager StatusManager,
 	}
 }
 
+// webhookResult holds the outcome of a single reconciliation step over one of the webhooks of an Operation.
+// For failed webhooks err holds the reason for the failure, while for webhooks which are still in progress
+// result and err are what should be returned to the controller runtime.
+type webhookResult struct {
+	state  v1alpha1.State
+	result ctrl.Result
+	err    error
+}
+
+func webhookSucceeded() webhookResult {
+	return webhookResult{state: v1alpha1.StateSuccess}
+}
+
+func webhookFailed(err error) webhookResult {
+	return webhookResult{state: v1alpha1.StateFailed, err: err}
+}
+
+func webhookInProgress(result ctrl.Result, err error) webhookResult {
+	return webhookResult{state: v1alpha1.StateInProgress, result: result, err: err}
+}
+
 // +kubebuilder:rbac:groups=operations.compass,resources=operations,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=operations.compass,resources=operations/status,verbs=get;update;patch
 
@@ -81,13 +104,17 @@ func (r *OperationReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
 	}
 
 	ctx = tenant.SaveToContext(ctx, requestObject.TenantID)
-	app, err := r.directorClient.FetchApplication(ctx, operation.Spec.ResourceID)
+	target, err := r.fetchResource(ctx, operation)
 	if err != nil {
-		return r.handleFetchApplicationError(ctx, operation, err)
+		if _, ok := err.(*errors.FatalReconcileErr); ok {
+			log.C(ctx).Error(err, "Unable to reconcile operation")
+			return r.finalizeStatusWithError(ctx, operation, err)
+		}
+		return r.handleFetchResourceError(ctx, operation, err)
 	}
 
-	if app.Result.Ready {
-		return r.finalizeStatus(ctx, operation, app.Result.Error)
+	if target.ready {
+		return r.finalizeStatus(ctx, operation, target.err)
 	}
 
 	if len(operation.Spec.WebhookIDs) == 0 {
@@ -95,55 +122,17 @@ func (r *OperationReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
 		return r.finalizeStatusSuccess(ctx, operation)
 	}
 
-	webhookEntity, err := extractWebhook(app.Result.Webhooks, operation.Spec.WebhookIDs[0])
+	webhookEntities, err := extractWebhooks(target.webhooks, operation.Spec.WebhookIDs)
 	if err != nil {
-		log.C(ctx).Error(err, "Unable to retrieve webhook")
+		log.C(ctx).Error(err, "Unable to retrieve webhooks")
 		return r.finalizeStatusWithError(ctx, operation, err)
 	}
 
-	if operation.TimeoutReached(r.determineTimeout(webhookEntity)) {
-		log.C(ctx).Info("Reconciliation timeout reached")
-		return r.finalizeStatusWithError(ctx, operation, errors.ErrWebhookTimeoutReached)
+	if r.config.ExecutionPolicy == webhook.ExecutionPolicyParallel {
+		return r.executeWebhooksInParallel(ctx, operation, webhookEntities, requestObject)
 	}
 
-	if !operation.HasPollURL() {
-		log.C(ctx).Info("Webhook Poll URL is not found. Will attempt to execute the webhook")
-		request := webhook.NewRequest(*webhookEntity, requestObject, operation.Spec.CorrelationID)
-
-		response, err := r.webhookClient.Do(ctx, request)
-		if errors.IsWebhookStatusGoneErr(err) && operation.Spec.OperationType == v1alpha1.OperationTypeDelete {
-			log.C(ctx).Info(fmt.Sprintf("%s webhook initial request returned gone status %d", *(webhookEntity.Mode), *response.GoneStatusCode))
-			return r.finalizeStatusSuccess(ctx, operation)
-		}
-		if err != nil {
-			log.C(ctx).Error(err, "Unable to execute Webhook request")
-			return r.requeueUnlessTimeoutOrFatalError(ctx, operation, webhookEntity, err)
-		}
-
-		return r.handleWebhookResponse(ctx, operation, webhookEntity.Mode, response)
-	}
-
-	log.C(ctx).Info("Webhook Poll URL is found. Will calculate next poll time")
-	requeueAfter, err := operation.NextPollTime(webhookEntity.RetryInterval, r.config.TimeLayout)
-	if err != nil {
-		log.C(ctx).Error(err, "Unable to calculate next poll time")
-		return r.finalizeStatusWithError(ctx, operation, err)
-	}
-
-	if requeueAfter > 0 {
-		log.C(ctx).Info(fmt.Sprintf("Poll interval has not passed. Will requeue after: %d seconds", requeueAfter*time.Second))
-		return ctrl.Result{RequeueAfter: requeueAfter}, nil
-	}
-
-	request := webhook.NewPollRequest(*webhookEntity, requestObject, operation.Spec.CorrelationID, operation.PollURL())
-	response, err := r.webhookClient.Poll(ctx, request)
-	if err != nil {
-		log.C(ctx).Error(err, "Unable to execute Webhook Poll request")
-		return r.requeueUnlessTimeoutOrFatalError(ctx, operation, webhookEntity, err)
-	}
-
-	return r.handleWebhookPollResponse(ctx, operation, webhookEntity, response)
-
+	return r.executeWebhooksSequentially(ctx, operation, webhookEntities, requestObject)
 }
 
 func (r *OperationReconciler) SetupWithManager(mgr ctrl.Manager) error {
@@ -178,8 +167,35 @@ func (r *OperationReconciler) handleGetOperationError(ctx context.Context, req *
 	return ctrl.Result{}, err
 }
 
-func (r *OperationReconciler) handleFetchApplicationError(ctx context.Context, operation *v1alpha1.Operation, err error) (ctrl.Result, error) {
-	log.C(ctx).Error(err, "Unable to fetch application")
+// operationResource holds the state and the webhooks of the resource of an Operation
+type operationResource struct {
+	ready    bool
+	err      *string
+	webhooks []graphql.Webhook
+}
+
+// fetchResource fetches the resource of the operation from the Director depending on its resource type
+func (r *OperationReconciler) fetchResource(ctx context.Context, operation *v1alpha1.Operation) (*operationResource, error) {
+	switch resource.Type(operation.Spec.ResourceType) {
+	case resource.Application:
+		app, err := r.directorClient.FetchApplication(ctx, operation.Spec.ResourceID)
+		if err != nil {
+			return nil, err
+		}
+		return &operationResource{ready: app.Result.Ready, err: app.Result.Error, webhooks: app.Result.Webhooks}, nil
+	case resource.Runtime:
+		runtime, err := r.directorClient.FetchRuntime(ctx, operation.Spec.ResourceID)
+		if err != nil {
+			return nil, err
+		}
+		return &operationResource{err: runtime.Result.Error, webhooks: runtime.Result.Webhooks}, nil
+	default:
+		return nil, errors.NewFatalReconcileError(fmt.Sprintf("unsupported resource type %q", operation.Spec.ResourceType))
+	}
+}
+
+func (r *OperationReconciler) handleFetchResourceError(ctx context.Context, operation *v1alpha1.Operation, err error) (ctrl.Result, error) {
+	log.C(ctx).Error(err, fmt.Sprintf("Unable to fetch %s", operation.Spec.ResourceType))
 	if operation.TimeoutReached(time.Duration(r.config.TimeoutFactor) * r.config.WebhookTimeout) {
 		if err := r.k8sClient.Delete(ctx, operation); err != nil {
 			return ctrl.Result{}, err
@@ -192,51 +208,225 @@ func (r *OperationReconciler) handleFetchApplicationError(ctx context.Context, o
 	return ctrl.Result{}, err
 }
 
-func (r *OperationReconciler) handleWebhookResponse(ctx context.Context, operation *v1alpha1.Operation, webhookMode *graphql.WebhookMode, response *webhookdir.Response) (ctrl.Result, error) {
+// executeWebhooksSequentially executes the webhooks of the operation one after another and moves to the next webhook
+// only after the previous one has succeeded. The operation is finalized as failed with the first failing webhook.
+func (r *OperationReconciler) executeWebhooksSequentially(ctx context.Context, operation *v1alpha1.Operation, webhookEntities []*graphql.Webhook, requestObject webhookdir.RequestObject) (ctrl.Result, error) {
+	for i, webhookEntity := range webhookEntities {
+		if operation.WebhookState(webhookEntity.ID) == v1alpha1.StateSuccess {
+			continue
+		}
+
+		result := r.executeWebhook(ctx, operation, webhookEntity, requestObject)
+		switch result.state {
+		case v1alpha1.StateFailed:
+			return r.finalizeStatusWithError(ctx, operation, result.err)
+		case v1alpha1.StateInProgress:
+			return result.result, result.err
+		}
+
+		if i == len(webhookEntities)-1 {
+			break
+		}
+
+		if err := r.statusManager.WebhookSuccessStatus(ctx, operation, webhookEntity.ID); err != nil {
+			return ctrl.Result{}, err
+		}
+		log.C(ctx).Info(fmt.Sprintf("Webhook with ID %s has been executed successfully. Will proceed with the next webhook", webhookEntity.ID))
+	}
+
+	return r.finalizeStatusSuccess(ctx, operation)
+}
+
+// executeWebhooksInParallel executes all webhooks of the operation which have not completed yet concurrently, without waiting
+// for the previous ones to complete, and merges their outcomes. The operation is finalized only after all of its webhooks have
+// either succeeded or failed.
+func (r *OperationReconciler) executeWebhooksInParallel(ctx context.Context, operation *v1alpha1.Operation, webhookEntities []*graphql.Webhook, requestObject webhookdir.RequestObject) (ctrl.Result, error) {
+	var (
+		inProgress       bool
+		requeueResult    ctrl.Result
+		failedWebhookIDs []string
+		webhookErr       error
+		pending          []*graphql.Webhook
+	)
+
+	for _, webhookEntity := range webhookEntities {
+		switch operation.WebhookState(webhookEntity.ID) {
+		case v1alpha1.StateSuccess:
+			continue
+		case v1alpha1.StateFailed:
+			failedWebhookIDs = append(failedWebhookIDs, webhookEntity.ID)
+			continue
+		}
+		pending = append(pending, webhookEntity)
+	}
+
+	results := r.executeWebhooksConcurrently(ctx, operation, pending, requestObject)
+	for i, webhookEntity := range pending {
+		result := results[i]
+		switch result.state {
+		case v1alpha1.StateSuccess:
+			if err := r.statusManager.WebhookSuccessStatus(ctx, operation, webhookEntity.ID); err != nil {
+				return ctrl.Result{}, err
+			}
+			log.C(ctx).Info(fmt.Sprintf("Webhook with ID %s has been executed successfully", webhookEntity.ID))
+		case v1alpha1.StateFailed:
+			log.C(ctx).Error(result.err, fmt.Sprintf("Webhook with ID %s has failed", webhookEntity.ID))
+			if err := r.statusManager.WebhookFailedStatus(ctx, operation, webhookEntity.ID); err != nil {
+				return ctrl.Result{}, err
+			}
+			failedWebhookIDs = append(failedWebhookIDs, webhookEntity.ID)
+			if webhookErr == nil {
+				webhookErr = result.err
+			}
+		default:
+			if result.err != nil {
+				return ctrl.Result{}, result.err
+			}
+			inProgress = true
+			requeueResult = earliestRequeue(requeueResult, result.result)
+		}
+	}
+
+	if inProgress {
+		return requeueResult, nil
+	}
+
+	if len(failedWebhookIDs) > 0 {
+		if webhookErr == nil {
+			webhookErr = errors.ErrFailedWebhookStatus
+		}
+		return r.finalizeStatusWithError(ctx, operation, fmt.Errorf("webhooks with IDs %s have failed: %s", strings.Join(failedWebhookIDs, ", "), webhookErr))
+	}
+
+	return r.finalizeStatusSuccess(ctx, operation)
+}
+
+// executeWebhooksConcurrently executes the given webhooks of the operation, at most MaxParallelWebhooks at a time, and returns
+// their results in the same order. Only the webhook requests are made concurrently, while the rest of the execution, which reads
+// and updates the status of the shared operation, is serialized.
+func (r *OperationReconciler) executeWebhooksConcurrently(ctx context.Context, operation *v1alpha1.Operation, webhookEntities []*graphql.Webhook, requestObject webhookdir.RequestObject) []webhookResult {
+	var (
+		mu      sync.Mutex
+		wg      sync.WaitGroup
+		limiter = make(chan struct{}, r.config.MaxParallelWebhooks)
+	)
+
+	concurrent := *r
+	concurrent.webhookClient = &unlockingWebhookClient{client: r.webhookClient, mu: &mu}
+
+	results := make([]webhookResult, len(webhookEntities))
+	for i, webhookEntity := range webhookEntities {
+		wg.Add(1)
+		go func(i int, webhookEntity *graphql.Webhook) {
+			defer wg.Done()
+
+			limiter <- struct{}{}
+			defer func() { <-limiter }()
+
+			mu.Lock()
+			defer mu.Unlock()
+			results[i] = concurrent.executeWebhook(ctx, operation, webhookEntity, requestObject)
+		}(i, webhookEntity)
+	}
+	wg.Wait()
+
+	return results
+}
+
+// executeWebhook performs a single reconciliation step for the given webhook of the operation - it either executes
+// the initial webhook request or polls for the webhook status if a Poll URL has already been provided for the webhook.
+func (r *OperationReconciler) executeWebhook(ctx context.Context, operation *v1alpha1.Operation, webhookEntity *graphql.Webhook, requestObject webhookdir.RequestObject) webhookResult {
+	ctx = log.ContextWithLogger(ctx, log.C(ctx).WithValues("webhook", webhookEntity.ID))
+
+	if operation.TimeoutReached(r.determineTimeout(webhookEntity)) {
+		log.C(ctx).Info("Reconciliation timeout reached")
+		return webhookFailed(errors.ErrWebhookTimeoutReached)
+	}
+
+	if !operation.HasPollURL(webhookEntity.ID) {
+		log.C(ctx).Info("Webhook Poll URL is not found. Will attempt to execute the webhook")
+		request := webhook.NewRequest(*webhookEntity, requestObject, operation.Spec.CorrelationID)
+
+		response, err := r.webhookClient.Do(ctx, request)
+		if errors.IsWebhookStatusGoneErr(err) && operation.Spec.OperationType == v1alpha1.OperationTypeDelete {
+			log.C(ctx).Info(fmt.Sprintf("%s webhook initial request returned gone status %d", *(webhookEntity.Mode), *response.GoneStatusCode))
+			return webhookSucceeded()
+		}
+		if err != nil {
+			log.C(ctx).Error(err, "Unable to execute Webhook request")
+			return r.retryUnlessTimeoutOrFatalError(ctx, operation, webhookEntity, err)
+		}
+
+		return r.handleWebhookResponse(ctx, operation, webhookEntity, response)
+	}
+
+	log.C(ctx).Info("Webhook Poll URL is found. Will calculate next poll time")
+	requeueAfter, err := operation.NextPollTime(webhookEntity.ID, webhookEntity.RetryInterval, r.config.TimeLayout)
+	if err != nil {
+		log.C(ctx).Error(err, "Unable to calculate next poll time")
+		return webhookFailed(err)
+	}
+
+	if requeueAfter > 0 {
+		log.C(ctx).Info(fmt.Sprintf("Poll interval has not passed. Will requeue after: %d seconds", requeueAfter*time.Second))
+		return webhookInProgress(ctrl.Result{RequeueAfter: requeueAfter}, nil)
+	}
+
+	request := webhook.NewPollRequest(*webhookEntity, requestObject, operation.Spec.CorrelationID, operation.PollURL(webhookEntity.ID))
+	response, err := r.webhookClient.Poll(ctx, request)
+	if err != nil {
+		log.C(ctx).Error(err, "Unable to execute Webhook Poll request")
+		return r.retryUnlessTimeoutOrFatalError(ctx, operation, webhookEntity, err)
+	}
+
+	return r.handleWebhookPollResponse(ctx, operation, webhookEntity, response)
+}
+
+func (r *OperationReconciler) handleWebhookResponse(ctx context.Context, operation *v1alpha1.Operation, webhookEntity *graphql.Webhook, response *webhookdir.Response) webhookResult {
 	mode := graphql.WebhookModeSync
-	if webhookMode != nil {
-		mode = *webhookMode
+	if webhookEntity.Mode != nil {
+		mode = *webhookEntity.Mode
 	}
 
 	switch mode {
 	case graphql.WebhookModeAsync:
 		log.C(ctx).Info("Asynchronous webhook initial request has been executed successfully")
-		if err := r.statusManager.InProgressWithPollURL(ctx, operation, *response.Location); err != nil {
-			return ctrl.Result{}, err
+		if err := r.statusManager.InProgressWithPollURL(ctx, operation, webhookEntity.ID, *response.Location); err != nil {
+			return webhookInProgress(ctrl.Result{}, err)
 		}
 		log.C(ctx).Info("Successfully updated operation status with poll URL: " + *response.Location)
-		return ctrl.Result{Requeue: true}, nil
+		return webhookInProgress(ctrl.Result{Requeue: true}, nil)
 	case graphql.WebhookModeSync:
 		log.C(ctx).Info("Synchronous webhook has been executed successfully")
-		return r.finalizeStatusSuccess(ctx, operation)
+		return webhookSucceeded()
 	default:
 		log.C(ctx).Error(errors.ErrUnsupportedWebhookMode, "Unable to post-process Webhook response")
-		return ctrl.Result{}, nil
+		return webhookInProgress(ctrl.Result{}, nil)
 	}
 }
 
-func (r *OperationReconciler) handleWebhookPollResponse(ctx context.Context, operation *v1alpha1.Operation, webhookEntity *graphql.Webhook, response *webhookdir.ResponseStatus) (ctrl.Result, error) {
+func (r *OperationReconciler) handleWebhookPollResponse(ctx context.Context, operation *v1alpha1.Operation, webhookEntity *graphql.Webhook, response *webhookdir.ResponseStatus) webhookResult {
 	log.C(ctx).Info(fmt.Sprintf("Asynchronous webhook polling request has been executed successfully with response status: %s", *response.Status))
 	switch *response.Status {
 	case *response.InProgressStatusIdentifier:
 		lastPollTimestamp := time.Now().Format(r.config.TimeLayout)
-		retryCount := operation.Status.Webhooks[0].RetriesCount + 1
-		if err := r.statusManager.InProgressWithPollURLAndLastPollTimestamp(ctx, operation, operation.PollURL(), lastPollTimestamp, retryCount); err != nil {
-			return ctrl.Result{}, err
+		retryCount := operation.WebhookStatus(webhookEntity.ID).RetriesCount + 1
+		if err := r.statusManager.InProgressWithPollURLAndLastPollTimestamp(ctx, operation, webhookEntity.ID, operation.PollURL(webhookEntity.ID), lastPollTimestamp, retryCount); err != nil {
+			return webhookInProgress(ctrl.Result{}, err)
 		}
 		log.C(ctx).Info(fmt.Sprintf("Successfully updated operation status last poll timestamp to %s", lastPollTimestamp), "status", operation.Status)
 		return r.requeueUnlessTimeoutOrFatalError(ctx, operation, webhookEntity, errors.ErrWebhookPollTimeExpired)
 	case *response.SuccessStatusIdentifier:
-		return r.finalizeStatusSuccess(ctx, operation)
+		return webhookSucceeded()
 	case *response.FailedStatusIdentifier:
-		return r.finalizeStatusWithError(ctx, operation, errors.ErrFailedWebhookStatus)
+		return webhookFailed(errors.ErrFailedWebhookStatus)
 	default:
 		log.C(ctx).Error(fmt.Errorf("unexpected poll status response: %s", *response.Status), "Polling will be stopped due to an unknown status code received")
-		return ctrl.Result{}, nil
+		return webhookInProgress(ctrl.Result{}, nil)
 	}
 }
 
-func (r *OperationReconciler) requeueUnlessTimeoutOrFatalError(ctx context.Context, operation *v1alpha1.Operation, webhookEntity *graphql.Webhook, webhookErr error) (ctrl.Result, error) {
+func (r *OperationReconciler) requeueUnlessTimeoutOrFatalError(ctx context.Context, operation *v1alpha1.Operation, webhookEntity *graphql.Webhook, webhookErr error) webhookResult {
 	_, isFatalErr := webhookErr.(*errors.FatalReconcileErr)
 	if !operation.TimeoutReached(r.determineTimeout(webhookEntity)) && !isFatalErr {
 		requeueAfter := r.config.RequeueInterval
@@ -244,14 +434,54 @@ func (r *OperationReconciler) requeueUnlessTimeoutOrFatalError(ctx context.Conte
 			requeueAfter = time.Duration(*webhookEntity.RetryInterval)
 		}
 
-		return ctrl.Result{RequeueAfter: requeueAfter}, nil
+		return webhookInProgress(ctrl.Result{RequeueAfter: requeueAfter}, nil)
 	}
 
 	if !isFatalErr {
 		webhookErr = fmt.Errorf("%s: %s", errors.ErrWebhookTimeoutReached, webhookErr)
 	}
 
-	return r.finalizeStatusWithError(ctx, operation, webhookErr)
+	return webhookFailed(webhookErr)
+}
+
+// retryUnlessTimeoutOrFatalError handles a failed execution of the webhook according to its retry policy.
+// Webhooks without a retry policy are requeued with a fixed interval until they time out.
+func (r *OperationReconciler) retryUnlessTimeoutOrFatalError(ctx context.Context, operation *v1alpha1.Operation, webhookEntity *graphql.Webhook, webhookErr error) webhookResult {
+	if webhookEntity.RetryPolicy == nil {
+		return r.requeueUnlessTimeoutOrFatalError(ctx, operation, webhookEntity, webhookErr)
+	}
+
+	if _, isFatalErr := webhookErr.(*errors.FatalReconcileErr); isFatalErr {
+		return webhookFailed(webhookErr)
+	}
+
+	if operation.TimeoutReached(r.determineTimeout(webhookEntity)) {
+		return webhookFailed(fmt.Errorf("%s: %s", errors.ErrWebhookTimeoutReached, webhookErr))
+	}
+
+	retryPolicy := webhook.NewRetryPolicy(webhookEntity.RetryPolicy, r.determineRetryInterval(webhookEntity))
+	if !retryPolicy.IsRetryable(webhookErr) {
+		log.C(ctx).Info("Webhook failure is not retryable according to the webhook retry policy")
+		return webhookFailed(fmt.Errorf("%s: %s", errors.ErrWebhookNotRetryable, webhookErr))
+	}
+
+	failedAttempts := 1
+	if webhookStatus := operation.WebhookStatus(webhookEntity.ID); webhookStatus != nil {
+		failedAttempts += webhookStatus.FailedAttempts
+	}
+
+	if retryPolicy.AttemptsExhausted(failedAttempts) {
+		log.C(ctx).Info(fmt.Sprintf("Webhook has failed %d times and will not be retried", failedAttempts))
+		return webhookFailed(fmt.Errorf("%s: %s", errors.ErrWebhookAttemptsExhausted, webhookErr))
+	}
+
+	if err := r.statusManager.WebhookFailedAttempt(ctx, operation, webhookEntity.ID, failedAttempts); err != nil {
+		return webhookInProgress(ctrl.Result{}, err)
+	}
+
+	requeueAfter := retryPolicy.Backoff(failedAttempts)
+	log.C(ctx).Info(fmt.Sprintf("Webhook has failed %d times. Will retry after %s", failedAttempts, requeueAfter))
+	return webhookInProgress(ctrl.Result{RequeueAfter: requeueAfter}, nil)
 }
 
 func (r *OperationReconciler) finalizeStatus(ctx context.Context, operation *v1alpha1.Operation, errorMsg *string) (ctrl.Result, error) {
@@ -302,12 +532,30 @@ func (r *OperationReconciler) determineTimeout(webhook *graphql.Webhook) time.Du
 	return time.Duration(*webhook.Timeout) * time.Second
 }
 
+func (r *OperationReconciler) determineRetryInterval(webhook *graphql.Webhook) time.Duration {
+	if webhook.RetryInterval == nil {
+		return r.config.RequeueInterval
+	}
+
+	return time.Duration(*webhook.RetryInterval) * time.Second
+}
+
+// operationID returns the ID under which the Director has recorded the operation.
+// Retried operations are recorded under an ID assigned by the Director, while the rest are recorded under their UID.
+func operationID(operation *v1alpha1.Operation) string {
+	if operation.Spec.OperationID != "" {
+		return operation.Spec.OperationID
+	}
+	return string(operation.UID)
+}
+
 func prepareDirectorRequest(operation *v1alpha1.Operation) *director.Request {
 	return prepareDirectorRequestWithError(operation, nil)
 }
 
 func prepareDirectorRequestWithError(operation *v1alpha1.Operation, err error) *director.Request {
 	request := &director.Request{
+		OperationID:   operationID(operation),
 		OperationType: graphql.OperationType(operation.Spec.OperationType),
 		ResourceType:  resource.Type(operation.Spec.ResourceType),
 		ResourceID:    operation.Spec.ResourceID,
@@ -317,9 +565,31 @@ func prepareDirectorRequestWithError(operation *v1alpha1.Operation, err error) *
 		request.Error = err.Error()
 	}
 
+	for _, webhook := range operation.Status.Webhooks {
+		request.WebhookResults = append(request.WebhookResults, director.WebhookResult{
+			WebhookID:      webhook.WebhookID,
+			State:          string(webhook.State),
+			RetriesCount:   webhook.RetriesCount,
+			FailedAttempts: webhook.FailedAttempts,
+		})
+	}
+
 	return request
 }
 
+func extractWebhooks(appWebhooks []graphql.Webhook, operationWebhookIDs []string) ([]*graphql.Webhook, error) {
+	webhookEntities := make([]*graphql.Webhook, 0, len(operationWebhookIDs))
+	for _, operationWebhookID := range operationWebhookIDs {
+		webhookEntity, err := extractWebhook(appWebhooks, operationWebhookID)
+		if err != nil {
+			return nil, err
+		}
+		webhookEntities = append(webhookEntities, webhookEntity)
+	}
+
+	return webhookEntities, nil
+}
+
 func extractWebhook(appWebhooks []graphql.Webhook, operationWebhookID string) (*graphql.Webhook, error) {
 	for _, appWebhook := range appWebhooks {
 		if appWebhook.ID == operationWebhookID {
@@ -329,3 +599,38 @@ func extractWebhook(appWebhooks []graphql.Webhook, operationWebhookID string) (*
 
 	return nil, fmt.Errorf("missing webhook with ID: %s", operationWebhookID)
 }
+
+// earliestRequeue returns whichever of the provided results requeues the operation sooner
+func earliestRequeue(current, next ctrl.Result) ctrl.Result {
+	switch {
+	case !current.Requeue && current.RequeueAfter == 0:
+		return next
+	case !next.Requeue && next.RequeueAfter == 0:
+		return current
+	case current.RequeueAfter == 0 || next.RequeueAfter == 0:
+		return ctrl.Result{Requeue: true}
+	case next.RequeueAfter < current.RequeueAfter:
+		return next
+	default:
+		return current
+	}
+}
+
+// unlockingWebhookClient releases the lock held by the executed webhook for the duration of its request,
+// so that the other webhooks of the operation can proceed in the meantime
+type unlockingWebhookClient struct {
+	client WebhookClient
+	mu     *sync.Mutex
+}
+
+func (c *unlockingWebhookClient) Do(ctx context.Context, request *webhook.Request) (*webhookdir.Response, error) {
+	c.mu.Unlock()
+	defer c.mu.Lock()
+	return c.client.Do(ctx, request)
+}
+
+func (c *unlockingWebhookClient) Poll(ctx context.Context, request *webhook.PollRequest) (*webhookdir.ResponseStatus, error) {
+	c.mu.Unlock()
+	defer c.mu.Lock()
+	return c.client.Poll(ctx, request)
+}
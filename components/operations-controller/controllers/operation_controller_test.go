@@ -19,6 +19,8 @@ package controllers_test
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"sync"
 	"testing"
 	"time"
 
@@ -31,6 +33,7 @@ import (
 	"github.com/kyma-incubator/compass/components/operations-controller/api/v1alpha1"
 	"github.com/kyma-incubator/compass/components/operations-controller/controllers"
 	"github.com/kyma-incubator/compass/components/operations-controller/controllers/controllersfakes"
+	opdirector "github.com/kyma-incubator/compass/components/operations-controller/internal/director"
 	"github.com/kyma-incubator/compass/components/operations-controller/internal/webhook"
 	"github.com/kyma-incubator/compass/components/system-broker/pkg/director"
 	"github.com/pkg/errors"
@@ -48,7 +51,9 @@ const (
 	anotherCorrelationGUID = "575b8042-8bb1-4ffa-9464-8ec633eae0d3"
 	tenantGUID             = "4b7aa2e1-e060-4633-a795-1be0d207c3e2"
 	webhookGUID            = "d09731af-bc0a-4abf-9b09-f3c9d25d064b"
+	anotherWebhookGUID     = "5f9c3b0e-7a3d-4d52-9d7e-1e2c8f6a4b31"
 	opName                 = "application-f92f1fce-631a-4231-b43a-8f9fccebb22c"
+	operationUID           = "5c9c8e2b-3a4d-4f5e-8a6b-7c8d9e0f1a2b"
 	opNamespace            = "compass-system"
 )
 
@@ -65,6 +70,7 @@ var (
 		ObjectMeta: ctrl.ObjectMeta{
 			Name:              ctrlRequest.Name,
 			Namespace:         ctrlRequest.Namespace,
+			UID:               operationUID,
 			CreationTimestamp: metav1.Time{Time: time.Now()},
 		},
 		Spec: v1alpha1.OperationSpec{
@@ -1431,6 +1437,92 @@ func TestReconcile_OperationWithoutWebhookPollURL_And_SyncWebhookExecutionSuccee
 		webhookClient.PollCallCount)
 }
 
+func TestReconcile_RuntimeOperation_And_SyncWebhookExecutionSucceeds_And_DirectorAndStatusManagerUpdateSucceeds_ShouldResultNoRequeueNoError(t *testing.T) {
+	// GIVEN:
+	operation := *mockedOperation
+	operation.Spec.ResourceType = "runtime"
+
+	k8sClient := &controllersfakes.FakeKubernetesClient{}
+	k8sClient.GetReturns(&operation, nil)
+
+	statusMgrClient := &controllersfakes.FakeStatusManager{}
+	statusMgrClient.InitializeReturns(nil)
+	statusMgrClient.SuccessStatusReturns(nil)
+
+	mode := graphql.WebhookModeSync
+	runtime := &opdirector.RuntimeOutput{Result: &graphql.RuntimeExt{
+		Runtime:  graphql.Runtime{ID: operation.Spec.ResourceID},
+		Webhooks: []graphql.Webhook{{ID: webhookGUID, Mode: &mode}},
+	}}
+
+	directorClient := &controllersfakes.FakeDirectorClient{}
+	directorClient.FetchRuntimeReturns(runtime, nil)
+	directorClient.UpdateOperationReturns(nil)
+
+	webhookClient := &controllersfakes.FakeWebhookClient{}
+	webhookClient.DoReturns(&web_hook.Response{}, nil)
+
+	// WHEN:
+	controller := controllers.NewOperationReconciler(webhook.DefaultConfig(), statusMgrClient, k8sClient, directorClient, webhookClient)
+	res, err := controller.Reconcile(ctrlRequest)
+
+	// THEN:
+	// GENERAL ASSERTIONS:
+	require.False(t, res.Requeue)
+	require.Zero(t, res.RequeueAfter)
+
+	require.NoError(t, err)
+
+	// SPECIFIC CLIENT ASSERTIONS:
+	assertK8sGetCalledWithName(t, k8sClient, ctrlRequest.NamespacedName)
+	assertStatusManagerInitializeCalledWithOperation(t, statusMgrClient, &operation)
+	assertStatusManagerSuccessStatusCalledWithOperation(t, statusMgrClient, &operation)
+	assertDirectorFetchRuntimeCalled(t, directorClient, operation.Spec.ResourceID, tenantGUID)
+	assertDirectorUpdateOperationCalled(t, directorClient, &operation)
+	assertWebhookDoCalled(t, webhookClient, &operation, &runtime.Result.Webhooks[0])
+	assertZeroInvocations(t, k8sClient.DeleteCallCount, directorClient.FetchApplicationCallCount,
+		statusMgrClient.InProgressWithPollURLCallCount, statusMgrClient.InProgressWithPollURLAndLastPollTimestampCallCount,
+		statusMgrClient.FailedStatusCallCount, webhookClient.PollCallCount)
+}
+
+func TestReconcile_OperationWithUnsupportedResourceType_When_DirectorAndStatusManagerUpdateSucceeds_ShouldResultNoRequeueNoError(t *testing.T) {
+	// GIVEN:
+	operation := *mockedOperation
+	operation.Spec.ResourceType = "unknown"
+	expectedErr := `unsupported resource type "unknown"`
+
+	k8sClient := &controllersfakes.FakeKubernetesClient{}
+	k8sClient.GetReturns(&operation, nil)
+
+	statusMgrClient := &controllersfakes.FakeStatusManager{}
+	statusMgrClient.InitializeReturns(nil)
+	statusMgrClient.FailedStatusReturns(nil)
+
+	directorClient := &controllersfakes.FakeDirectorClient{}
+	directorClient.UpdateOperationReturns(nil)
+
+	webhookClient := &controllersfakes.FakeWebhookClient{}
+
+	// WHEN:
+	controller := controllers.NewOperationReconciler(webhook.DefaultConfig(), statusMgrClient, k8sClient, directorClient, webhookClient)
+	res, err := controller.Reconcile(ctrlRequest)
+
+	// THEN:
+	// GENERAL ASSERTIONS:
+	require.False(t, res.Requeue)
+	require.Zero(t, res.RequeueAfter)
+
+	require.NoError(t, err)
+
+	// SPECIFIC CLIENT ASSERTIONS:
+	assertK8sGetCalledWithName(t, k8sClient, ctrlRequest.NamespacedName)
+	assertStatusManagerInitializeCalledWithOperation(t, statusMgrClient, &operation)
+	assertStatusManagerFailedStatusCalledWithOperation(t, statusMgrClient, &operation, expectedErr)
+	assertDirectorUpdateOperationWithErrorCalled(t, directorClient, &operation, expectedErr)
+	assertZeroInvocations(t, k8sClient.DeleteCallCount, directorClient.FetchApplicationCallCount, directorClient.FetchRuntimeCallCount,
+		statusMgrClient.SuccessStatusCallCount, webhookClient.DoCallCount, webhookClient.PollCallCount)
+}
+
 func TestReconcile_OperationHasWebhookPollURL_And_TimeLayoutParsingFails_When_DirectorUpdateOperationFails_ShouldResultNoRequeueError(t *testing.T) {
 	// GIVEN:
 	expectedErr := "cannot parse"
@@ -2591,6 +2683,456 @@ func TestReconcile_OperationHasWebhookPollURL_And_PollExecutionSucceeds_And_Stat
 		webhookClient.DoCallCount)
 }
 
+func TestReconcile_OperationWithMultipleWebhooks_And_SequentialPolicy_And_FirstSyncWebhookSucceeds_And_SecondAsyncWebhookStarted_ShouldResultRequeueNoError(t *testing.T) {
+	// GIVEN:
+	operation := *mockedOperation
+	operation.Spec.WebhookIDs = []string{webhookGUID, anotherWebhookGUID}
+
+	k8sClient := &controllersfakes.FakeKubernetesClient{}
+	k8sClient.GetReturns(&operation, nil)
+
+	statusMgrClient := &controllersfakes.FakeStatusManager{}
+	statusMgrClient.InitializeReturns(nil)
+	statusMgrClient.WebhookSuccessStatusReturns(nil)
+	statusMgrClient.InProgressWithPollURLReturns(nil)
+
+	syncMode, asyncMode := graphql.WebhookModeSync, graphql.WebhookModeAsync
+	application := prepareApplicationOutput(&graphql.Application{BaseEntity: &graphql.BaseEntity{}},
+		graphql.Webhook{ID: webhookGUID, Mode: &syncMode}, graphql.Webhook{ID: anotherWebhookGUID, Mode: &asyncMode})
+
+	directorClient := &controllersfakes.FakeDirectorClient{}
+	directorClient.FetchApplicationReturns(application, nil)
+
+	webhookClient := &controllersfakes.FakeWebhookClient{}
+	webhookClient.DoReturnsOnCall(0, &web_hook.Response{}, nil)
+	webhookClient.DoReturnsOnCall(1, &web_hook.Response{Location: &mockedLocationURL}, nil)
+
+	// WHEN:
+	controller := controllers.NewOperationReconciler(webhook.DefaultConfig(), statusMgrClient, k8sClient, directorClient, webhookClient)
+	res, err := controller.Reconcile(ctrlRequest)
+
+	// THEN:
+	// GENERAL ASSERTIONS:
+	require.True(t, res.Requeue)
+	require.Zero(t, res.RequeueAfter)
+
+	require.NoError(t, err)
+
+	// SPECIFIC CLIENT ASSERTIONS:
+	assertK8sGetCalledWithName(t, k8sClient, ctrlRequest.NamespacedName)
+	assertStatusManagerInitializeCalledWithOperation(t, statusMgrClient, &operation)
+	assertDirectorFetchApplicationCalled(t, directorClient, operation.Spec.ResourceID, tenantGUID)
+
+	require.Equal(t, 2, webhookClient.DoCallCount())
+	require.Equal(t, 1, statusMgrClient.WebhookSuccessStatusCallCount())
+	_, _, succeededWebhookID := statusMgrClient.WebhookSuccessStatusArgsForCall(0)
+	require.Equal(t, webhookGUID, succeededWebhookID)
+
+	require.Equal(t, 1, statusMgrClient.InProgressWithPollURLCallCount())
+	_, _, inProgressWebhookID, pollURL := statusMgrClient.InProgressWithPollURLArgsForCall(0)
+	require.Equal(t, anotherWebhookGUID, inProgressWebhookID)
+	require.Equal(t, mockedLocationURL, pollURL)
+
+	assertZeroInvocations(t, k8sClient.DeleteCallCount, directorClient.UpdateOperationCallCount,
+		statusMgrClient.InProgressWithPollURLAndLastPollTimestampCallCount, statusMgrClient.SuccessStatusCallCount,
+		statusMgrClient.FailedStatusCallCount, statusMgrClient.WebhookFailedStatusCallCount, webhookClient.PollCallCount)
+}
+
+func TestReconcile_OperationWithMultipleWebhooks_And_SequentialPolicy_And_FirstWebhookAlreadySucceeded_ShouldExecuteOnlyRemainingWebhook(t *testing.T) {
+	// GIVEN:
+	operation := *mockedOperation
+	operation.Spec.WebhookIDs = []string{webhookGUID, anotherWebhookGUID}
+	operation.Status.Webhooks = []v1alpha1.Webhook{
+		{WebhookID: webhookGUID, State: v1alpha1.StateSuccess},
+		{WebhookID: anotherWebhookGUID, State: v1alpha1.StateInProgress},
+	}
+
+	k8sClient := &controllersfakes.FakeKubernetesClient{}
+	k8sClient.GetReturns(&operation, nil)
+
+	statusMgrClient := &controllersfakes.FakeStatusManager{}
+	statusMgrClient.InitializeReturns(nil)
+	statusMgrClient.SuccessStatusReturns(nil)
+
+	mode := graphql.WebhookModeSync
+	application := prepareApplicationOutput(&graphql.Application{BaseEntity: &graphql.BaseEntity{}},
+		graphql.Webhook{ID: webhookGUID, Mode: &mode}, graphql.Webhook{ID: anotherWebhookGUID, Mode: &mode})
+
+	directorClient := &controllersfakes.FakeDirectorClient{}
+	directorClient.FetchApplicationReturns(application, nil)
+	directorClient.UpdateOperationReturns(nil)
+
+	webhookClient := &controllersfakes.FakeWebhookClient{}
+	webhookClient.DoReturns(&web_hook.Response{}, nil)
+
+	// WHEN:
+	controller := controllers.NewOperationReconciler(webhook.DefaultConfig(), statusMgrClient, k8sClient, directorClient, webhookClient)
+	res, err := controller.Reconcile(ctrlRequest)
+
+	// THEN:
+	// GENERAL ASSERTIONS:
+	require.False(t, res.Requeue)
+	require.Zero(t, res.RequeueAfter)
+
+	require.NoError(t, err)
+
+	// SPECIFIC CLIENT ASSERTIONS:
+	assertK8sGetCalledWithName(t, k8sClient, ctrlRequest.NamespacedName)
+	assertStatusManagerInitializeCalledWithOperation(t, statusMgrClient, &operation)
+	assertStatusManagerSuccessStatusCalledWithOperation(t, statusMgrClient, &operation)
+	assertDirectorFetchApplicationCalled(t, directorClient, operation.Spec.ResourceID, tenantGUID)
+	assertDirectorUpdateOperationCalled(t, directorClient, &operation)
+	assertWebhookDoCalled(t, webhookClient, &operation, &application.Result.Webhooks[1])
+	assertZeroInvocations(t, k8sClient.DeleteCallCount, statusMgrClient.InProgressWithPollURLCallCount,
+		statusMgrClient.InProgressWithPollURLAndLastPollTimestampCallCount, statusMgrClient.FailedStatusCallCount,
+		statusMgrClient.WebhookSuccessStatusCallCount, statusMgrClient.WebhookFailedStatusCallCount, webhookClient.PollCallCount)
+}
+
+func TestReconcile_OperationWithMultipleWebhooks_And_ParallelPolicy_And_OneWebhookFails_And_OtherWebhookInProgress_ShouldResultRequeueAfterNoError(t *testing.T) {
+	// GIVEN:
+	operation := *mockedOperation
+	operation.Spec.WebhookIDs = []string{webhookGUID, anotherWebhookGUID}
+	operation.Status.Webhooks = []v1alpha1.Webhook{
+		{WebhookID: webhookGUID, State: v1alpha1.StateInProgress, WebhookPollURL: mockedLocationURL},
+		{WebhookID: anotherWebhookGUID, State: v1alpha1.StateInProgress},
+	}
+
+	k8sClient := &controllersfakes.FakeKubernetesClient{}
+	k8sClient.GetReturns(&operation, nil)
+
+	statusMgrClient := &controllersfakes.FakeStatusManager{}
+	statusMgrClient.InitializeReturns(nil)
+	statusMgrClient.WebhookFailedStatusReturns(nil)
+
+	mode := graphql.WebhookModeAsync
+	application := prepareApplicationOutput(&graphql.Application{BaseEntity: &graphql.BaseEntity{}},
+		graphql.Webhook{ID: webhookGUID, Mode: &mode}, graphql.Webhook{ID: anotherWebhookGUID, Mode: &mode, RetryInterval: intToIntPtr(30)})
+
+	directorClient := &controllersfakes.FakeDirectorClient{}
+	directorClient.FetchApplicationReturns(application, nil)
+
+	webhookClient := &controllersfakes.FakeWebhookClient{}
+	webhookClient.PollReturns(prepareResponseStatus("FAILED"), nil)
+	webhookClient.DoReturns(nil, mockedErr)
+
+	config := webhook.DefaultConfig()
+	config.ExecutionPolicy = webhook.ExecutionPolicyParallel
+
+	// WHEN:
+	controller := controllers.NewOperationReconciler(config, statusMgrClient, k8sClient, directorClient, webhookClient)
+	res, err := controller.Reconcile(ctrlRequest)
+
+	// THEN:
+	// GENERAL ASSERTIONS:
+	require.False(t, res.Requeue)
+	require.Equal(t, time.Duration(*application.Result.Webhooks[1].RetryInterval), res.RequeueAfter)
+
+	require.NoError(t, err)
+
+	// SPECIFIC CLIENT ASSERTIONS:
+	assertK8sGetCalledWithName(t, k8sClient, ctrlRequest.NamespacedName)
+	assertStatusManagerInitializeCalledWithOperation(t, statusMgrClient, &operation)
+	assertDirectorFetchApplicationCalled(t, directorClient, operation.Spec.ResourceID, tenantGUID)
+	assertWebhookPollCalled(t, webhookClient, &operation, &application.Result.Webhooks[0])
+	assertWebhookDoCalled(t, webhookClient, &operation, &application.Result.Webhooks[1])
+
+	require.Equal(t, 1, statusMgrClient.WebhookFailedStatusCallCount())
+	_, _, failedWebhookID := statusMgrClient.WebhookFailedStatusArgsForCall(0)
+	require.Equal(t, webhookGUID, failedWebhookID)
+
+	assertZeroInvocations(t, k8sClient.DeleteCallCount, directorClient.UpdateOperationCallCount, statusMgrClient.InProgressWithPollURLCallCount,
+		statusMgrClient.InProgressWithPollURLAndLastPollTimestampCallCount, statusMgrClient.SuccessStatusCallCount,
+		statusMgrClient.FailedStatusCallCount, statusMgrClient.WebhookSuccessStatusCallCount)
+}
+
+func TestReconcile_OperationWithMultipleWebhooks_And_ParallelPolicy_ShouldExecuteWebhooksConcurrently(t *testing.T) {
+	// GIVEN:
+	operation := *mockedOperation
+	operation.Spec.WebhookIDs = []string{webhookGUID, anotherWebhookGUID}
+	operation.Status.Webhooks = []v1alpha1.Webhook{
+		{WebhookID: webhookGUID, State: v1alpha1.StateInProgress},
+		{WebhookID: anotherWebhookGUID, State: v1alpha1.StateInProgress},
+	}
+
+	k8sClient := &controllersfakes.FakeKubernetesClient{}
+	k8sClient.GetReturns(&operation, nil)
+
+	statusMgrClient := &controllersfakes.FakeStatusManager{}
+	statusMgrClient.InitializeReturns(nil)
+	statusMgrClient.WebhookSuccessStatusReturns(nil)
+	statusMgrClient.SuccessStatusReturns(nil)
+
+	mode := graphql.WebhookModeSync
+	application := prepareApplicationOutput(&graphql.Application{BaseEntity: &graphql.BaseEntity{}},
+		graphql.Webhook{ID: webhookGUID, Mode: &mode}, graphql.Webhook{ID: anotherWebhookGUID, Mode: &mode})
+
+	directorClient := &controllersfakes.FakeDirectorClient{}
+	directorClient.FetchApplicationReturns(application, nil)
+	directorClient.UpdateOperationReturns(nil)
+
+	// each request waits for the other one, so that they succeed only if they are made concurrently
+	var requests sync.WaitGroup
+	requests.Add(2)
+	allRequestsMade := make(chan struct{})
+	go func() {
+		requests.Wait()
+		close(allRequestsMade)
+	}()
+
+	webhookClient := &controllersfakes.FakeWebhookClient{}
+	webhookClient.DoStub = func(_ context.Context, _ *webhook.Request) (*web_hook.Response, error) {
+		requests.Done()
+		select {
+		case <-allRequestsMade:
+			return &web_hook.Response{}, nil
+		case <-time.After(5 * time.Second):
+			return nil, recerr.NewFatalReconcileError("webhooks were not executed concurrently")
+		}
+	}
+
+	config := webhook.DefaultConfig()
+	config.ExecutionPolicy = webhook.ExecutionPolicyParallel
+
+	// WHEN:
+	controller := controllers.NewOperationReconciler(config, statusMgrClient, k8sClient, directorClient, webhookClient)
+	res, err := controller.Reconcile(ctrlRequest)
+
+	// THEN:
+	// GENERAL ASSERTIONS:
+	require.False(t, res.Requeue)
+	require.Zero(t, res.RequeueAfter)
+
+	require.NoError(t, err)
+
+	// SPECIFIC CLIENT ASSERTIONS:
+	require.Equal(t, 2, webhookClient.DoCallCount())
+	require.Equal(t, 2, statusMgrClient.WebhookSuccessStatusCallCount())
+	assertStatusManagerSuccessStatusCalledWithOperation(t, statusMgrClient, &operation)
+	assertDirectorUpdateOperationCalled(t, directorClient, &operation)
+	assertZeroInvocations(t, k8sClient.DeleteCallCount, statusMgrClient.InProgressWithPollURLCallCount,
+		statusMgrClient.InProgressWithPollURLAndLastPollTimestampCallCount, statusMgrClient.FailedStatusCallCount,
+		statusMgrClient.WebhookFailedStatusCallCount, webhookClient.PollCallCount)
+}
+
+func TestReconcile_OperationWithMultipleWebhooks_And_ParallelPolicy_And_AllWebhooksCompleted_With_OneFailure_ShouldFinalizeOperationAsFailed(t *testing.T) {
+	// GIVEN:
+	operation := *mockedOperation
+	operation.Spec.WebhookIDs = []string{webhookGUID, anotherWebhookGUID}
+	operation.Status.Webhooks = []v1alpha1.Webhook{
+		{WebhookID: webhookGUID, State: v1alpha1.StateFailed},
+		{WebhookID: anotherWebhookGUID, State: v1alpha1.StateInProgress},
+	}
+
+	k8sClient := &controllersfakes.FakeKubernetesClient{}
+	k8sClient.GetReturns(&operation, nil)
+
+	statusMgrClient := &controllersfakes.FakeStatusManager{}
+	statusMgrClient.InitializeReturns(nil)
+	statusMgrClient.WebhookSuccessStatusReturns(nil)
+	statusMgrClient.FailedStatusReturns(nil)
+
+	mode := graphql.WebhookModeSync
+	application := prepareApplicationOutput(&graphql.Application{BaseEntity: &graphql.BaseEntity{}},
+		graphql.Webhook{ID: webhookGUID, Mode: &mode}, graphql.Webhook{ID: anotherWebhookGUID, Mode: &mode})
+
+	directorClient := &controllersfakes.FakeDirectorClient{}
+	directorClient.FetchApplicationReturns(application, nil)
+	directorClient.UpdateOperationReturns(nil)
+
+	webhookClient := &controllersfakes.FakeWebhookClient{}
+	webhookClient.DoReturns(&web_hook.Response{}, nil)
+
+	config := webhook.DefaultConfig()
+	config.ExecutionPolicy = webhook.ExecutionPolicyParallel
+
+	// WHEN:
+	controller := controllers.NewOperationReconciler(config, statusMgrClient, k8sClient, directorClient, webhookClient)
+	res, err := controller.Reconcile(ctrlRequest)
+
+	// THEN:
+	// GENERAL ASSERTIONS:
+	require.False(t, res.Requeue)
+	require.Zero(t, res.RequeueAfter)
+
+	require.NoError(t, err)
+
+	// SPECIFIC CLIENT ASSERTIONS:
+	assertK8sGetCalledWithName(t, k8sClient, ctrlRequest.NamespacedName)
+	assertStatusManagerInitializeCalledWithOperation(t, statusMgrClient, &operation)
+	assertDirectorFetchApplicationCalled(t, directorClient, operation.Spec.ResourceID, tenantGUID)
+	assertWebhookDoCalled(t, webhookClient, &operation, &application.Result.Webhooks[1])
+
+	require.Equal(t, 1, statusMgrClient.WebhookSuccessStatusCallCount())
+	_, _, succeededWebhookID := statusMgrClient.WebhookSuccessStatusArgsForCall(0)
+	require.Equal(t, anotherWebhookGUID, succeededWebhookID)
+
+	require.Equal(t, 1, statusMgrClient.FailedStatusCallCount())
+	_, _, errMsg := statusMgrClient.FailedStatusArgsForCall(0)
+	require.Contains(t, errMsg, webhookGUID)
+
+	require.Equal(t, 1, directorClient.UpdateOperationCallCount())
+	_, request := directorClient.UpdateOperationArgsForCall(0)
+	require.Contains(t, request.Error, webhookGUID)
+	require.Contains(t, request.WebhookResults, opdirector.WebhookResult{WebhookID: webhookGUID, State: string(v1alpha1.StateFailed)})
+
+	assertZeroInvocations(t, k8sClient.DeleteCallCount, statusMgrClient.InProgressWithPollURLCallCount,
+		statusMgrClient.InProgressWithPollURLAndLastPollTimestampCallCount, statusMgrClient.SuccessStatusCallCount,
+		statusMgrClient.WebhookFailedStatusCallCount, webhookClient.PollCallCount)
+}
+
+func TestReconcile_OperationWithoutWebhookPollURL_And_WebhookWithRetryPolicyFails_And_AttemptsNotExhausted_ShouldResultRequeueAfterBackoffNoError(t *testing.T) {
+	// GIVEN:
+	stubLoggerAssertion(t, mockedErr.Error(), "Unable to execute Webhook request")
+	defer func() { ctrl.Log = &originalLogger }()
+
+	operation := *mockedOperation
+	operation.ObjectMeta.CreationTimestamp = metav1.Time{Time: time.Now()}
+	operation.Status.Webhooks = []v1alpha1.Webhook{{WebhookID: webhookGUID, State: v1alpha1.StateInProgress, FailedAttempts: 2}}
+
+	k8sClient := &controllersfakes.FakeKubernetesClient{}
+	k8sClient.GetReturns(&operation, nil)
+
+	statusMgrClient := &controllersfakes.FakeStatusManager{}
+	statusMgrClient.InitializeReturns(nil)
+	statusMgrClient.WebhookFailedAttemptReturns(nil)
+
+	retryPolicy := &graphql.WebhookRetryPolicy{MaxAttempts: intToIntPtr(5), InitialInterval: intToIntPtr(10), Multiplier: floatToFloatPtr(2)}
+	application := prepareApplicationOutput(&graphql.Application{BaseEntity: &graphql.BaseEntity{}}, graphql.Webhook{ID: webhookGUID, RetryPolicy: retryPolicy})
+
+	directorClient := &controllersfakes.FakeDirectorClient{}
+	directorClient.FetchApplicationReturns(application, nil)
+
+	webhookClient := &controllersfakes.FakeWebhookClient{}
+	webhookClient.DoReturns(nil, mockedErr)
+
+	// WHEN:
+	controller := controllers.NewOperationReconciler(webhook.DefaultConfig(), statusMgrClient, k8sClient, directorClient, webhookClient)
+	res, err := controller.Reconcile(ctrlRequest)
+
+	// THEN:
+	// GENERAL ASSERTIONS:
+	require.False(t, res.Requeue)
+	require.Equal(t, 40*time.Second, res.RequeueAfter)
+
+	require.NoError(t, err)
+
+	// SPECIFIC CLIENT ASSERTIONS:
+	assertK8sGetCalledWithName(t, k8sClient, ctrlRequest.NamespacedName)
+	assertStatusManagerInitializeCalledWithOperation(t, statusMgrClient, &operation)
+	assertDirectorFetchApplicationCalled(t, directorClient, operation.Spec.ResourceID, tenantGUID)
+	assertWebhookDoCalled(t, webhookClient, &operation, &application.Result.Webhooks[0])
+
+	require.Equal(t, 1, statusMgrClient.WebhookFailedAttemptCallCount())
+	_, _, webhookID, failedAttempts := statusMgrClient.WebhookFailedAttemptArgsForCall(0)
+	require.Equal(t, webhookGUID, webhookID)
+	require.Equal(t, 3, failedAttempts)
+
+	assertZeroInvocations(t, k8sClient.DeleteCallCount, directorClient.UpdateOperationCallCount, statusMgrClient.InProgressWithPollURLCallCount,
+		statusMgrClient.InProgressWithPollURLAndLastPollTimestampCallCount, statusMgrClient.SuccessStatusCallCount, statusMgrClient.FailedStatusCallCount,
+		webhookClient.PollCallCount)
+}
+
+func TestReconcile_OperationWithoutWebhookPollURL_And_WebhookWithRetryPolicyFails_And_AttemptsExhausted_ShouldFinalizeOperationAsFailed(t *testing.T) {
+	// GIVEN:
+	stubLoggerAssertion(t, mockedErr.Error(), "Unable to execute Webhook request")
+	defer func() { ctrl.Log = &originalLogger }()
+
+	operation := *mockedOperation
+	operation.ObjectMeta.CreationTimestamp = metav1.Time{Time: time.Now()}
+	operation.Status.Webhooks = []v1alpha1.Webhook{{WebhookID: webhookGUID, State: v1alpha1.StateInProgress, FailedAttempts: 2}}
+
+	k8sClient := &controllersfakes.FakeKubernetesClient{}
+	k8sClient.GetReturns(&operation, nil)
+
+	statusMgrClient := &controllersfakes.FakeStatusManager{}
+	statusMgrClient.InitializeReturns(nil)
+	statusMgrClient.FailedStatusReturns(nil)
+
+	retryPolicy := &graphql.WebhookRetryPolicy{MaxAttempts: intToIntPtr(3)}
+	application := prepareApplicationOutput(&graphql.Application{BaseEntity: &graphql.BaseEntity{}}, graphql.Webhook{ID: webhookGUID, RetryPolicy: retryPolicy})
+
+	directorClient := &controllersfakes.FakeDirectorClient{}
+	directorClient.FetchApplicationReturns(application, nil)
+	directorClient.UpdateOperationReturns(nil)
+
+	webhookClient := &controllersfakes.FakeWebhookClient{}
+	webhookClient.DoReturns(nil, mockedErr)
+
+	// WHEN:
+	controller := controllers.NewOperationReconciler(webhook.DefaultConfig(), statusMgrClient, k8sClient, directorClient, webhookClient)
+	res, err := controller.Reconcile(ctrlRequest)
+
+	// THEN:
+	// GENERAL ASSERTIONS:
+	require.False(t, res.Requeue)
+	require.Zero(t, res.RequeueAfter)
+
+	require.NoError(t, err)
+
+	// SPECIFIC CLIENT ASSERTIONS:
+	expectedErr := fmt.Sprintf("%s: %s", recerr.ErrWebhookAttemptsExhausted, mockedErr)
+	assertK8sGetCalledWithName(t, k8sClient, ctrlRequest.NamespacedName)
+	assertStatusManagerInitializeCalledWithOperation(t, statusMgrClient, &operation)
+	assertStatusManagerFailedStatusCalledWithOperation(t, statusMgrClient, &operation, expectedErr)
+	assertDirectorFetchApplicationCalled(t, directorClient, operation.Spec.ResourceID, tenantGUID)
+	assertDirectorUpdateOperationWithErrorCalled(t, directorClient, &operation, expectedErr)
+	assertWebhookDoCalled(t, webhookClient, &operation, &application.Result.Webhooks[0])
+	assertZeroInvocations(t, k8sClient.DeleteCallCount, statusMgrClient.InProgressWithPollURLCallCount,
+		statusMgrClient.InProgressWithPollURLAndLastPollTimestampCallCount, statusMgrClient.SuccessStatusCallCount,
+		statusMgrClient.WebhookFailedAttemptCallCount, webhookClient.PollCallCount)
+}
+
+func TestReconcile_OperationWithoutWebhookPollURL_And_WebhookWithRetryPolicyFails_With_NonRetryableStatusCode_ShouldFinalizeOperationAsFailed(t *testing.T) {
+	// GIVEN:
+	webhookErr := recerr.NewWebhookStatusCodeErr(http.StatusBadRequest, "response success status code was not met")
+
+	stubLoggerAssertion(t, webhookErr.Error(), "Unable to execute Webhook request")
+	defer func() { ctrl.Log = &originalLogger }()
+
+	operation := *mockedOperation
+	operation.ObjectMeta.CreationTimestamp = metav1.Time{Time: time.Now()}
+
+	k8sClient := &controllersfakes.FakeKubernetesClient{}
+	k8sClient.GetReturns(&operation, nil)
+
+	statusMgrClient := &controllersfakes.FakeStatusManager{}
+	statusMgrClient.InitializeReturns(nil)
+	statusMgrClient.FailedStatusReturns(nil)
+
+	retryPolicy := &graphql.WebhookRetryPolicy{MaxAttempts: intToIntPtr(3), RetryableStatusCodes: []int{http.StatusServiceUnavailable}}
+	application := prepareApplicationOutput(&graphql.Application{BaseEntity: &graphql.BaseEntity{}}, graphql.Webhook{ID: webhookGUID, RetryPolicy: retryPolicy})
+
+	directorClient := &controllersfakes.FakeDirectorClient{}
+	directorClient.FetchApplicationReturns(application, nil)
+	directorClient.UpdateOperationReturns(nil)
+
+	webhookClient := &controllersfakes.FakeWebhookClient{}
+	webhookClient.DoReturns(nil, webhookErr)
+
+	// WHEN:
+	controller := controllers.NewOperationReconciler(webhook.DefaultConfig(), statusMgrClient, k8sClient, directorClient, webhookClient)
+	res, err := controller.Reconcile(ctrlRequest)
+
+	// THEN:
+	// GENERAL ASSERTIONS:
+	require.False(t, res.Requeue)
+	require.Zero(t, res.RequeueAfter)
+
+	require.NoError(t, err)
+
+	// SPECIFIC CLIENT ASSERTIONS:
+	expectedErr := fmt.Sprintf("%s: %s", recerr.ErrWebhookNotRetryable, webhookErr)
+	assertK8sGetCalledWithName(t, k8sClient, ctrlRequest.NamespacedName)
+	assertStatusManagerInitializeCalledWithOperation(t, statusMgrClient, &operation)
+	assertStatusManagerFailedStatusCalledWithOperation(t, statusMgrClient, &operation, expectedErr)
+	assertDirectorFetchApplicationCalled(t, directorClient, operation.Spec.ResourceID, tenantGUID)
+	assertDirectorUpdateOperationWithErrorCalled(t, directorClient, &operation, expectedErr)
+	assertWebhookDoCalled(t, webhookClient, &operation, &application.Result.Webhooks[0])
+	assertZeroInvocations(t, k8sClient.DeleteCallCount, statusMgrClient.InProgressWithPollURLCallCount,
+		statusMgrClient.InProgressWithPollURLAndLastPollTimestampCallCount, statusMgrClient.SuccessStatusCallCount,
+		statusMgrClient.WebhookFailedAttemptCallCount, webhookClient.PollCallCount)
+}
+
 func prepareApplicationOutput(app *graphql.Application, webhooks ...graphql.Webhook) *director.ApplicationOutput {
 	return &director.ApplicationOutput{Result: &graphql.ApplicationExt{
 		Application: *app,
@@ -2614,3 +3156,7 @@ func strToStrPtr(str string) *string {
 func intToIntPtr(i int) *int {
 	return &i
 }
+
+func floatToFloatPtr(f float64) *float64 {
+	return &f
+}
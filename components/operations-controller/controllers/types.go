@@ -32,8 +32,11 @@ import (
 //go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . StatusManager
 type StatusManager interface {
 	Initialize(operation *v1alpha1.Operation) error
-	InProgressWithPollURL(ctx context.Context, operation *v1alpha1.Operation, pollURL string) error
-	InProgressWithPollURLAndLastPollTimestamp(ctx context.Context, operation *v1alpha1.Operation, pollURL, lastPollTimestamp string, retryCount int) error
+	InProgressWithPollURL(ctx context.Context, operation *v1alpha1.Operation, webhookID, pollURL string) error
+	InProgressWithPollURLAndLastPollTimestamp(ctx context.Context, operation *v1alpha1.Operation, webhookID, pollURL, lastPollTimestamp string, retryCount int) error
+	WebhookFailedAttempt(ctx context.Context, operation *v1alpha1.Operation, webhookID string, failedAttempts int) error
+	WebhookSuccessStatus(ctx context.Context, operation *v1alpha1.Operation, webhookID string) error
+	WebhookFailedStatus(ctx context.Context, operation *v1alpha1.Operation, webhookID string) error
 	SuccessStatus(ctx context.Context, operation *v1alpha1.Operation) error
 	FailedStatus(ctx context.Context, operation *v1alpha1.Operation, errorMsg string) error
 }
@@ -45,11 +48,12 @@ type KubernetesClient interface {
 	Delete(ctx context.Context, obj runtime.Object, opts ...client.DeleteOption) error
 }
 
-// DirectorClient defines a Director client which is capable of fetching an application
+// DirectorClient defines a Director client which is capable of fetching an application or a runtime
 // and notifying Director for operation state changes
 //go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . DirectorClient
 type DirectorClient interface {
 	typesbroker.ApplicationLister
+	FetchRuntime(ctx context.Context, id string) (*director.RuntimeOutput, error)
 	UpdateOperation(ctx context.Context, request *director.Request) error
 }
 
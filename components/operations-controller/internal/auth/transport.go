@@ -0,0 +1,49 @@
+/*
+ * Copyright 2020 The Compass Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package auth
+
+import (
+	"context"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	directorhttp "github.com/kyma-incubator/compass/components/director/pkg/http"
+)
+
+// SaveTransportAuthToContext saves the request signing configuration and the client certificate of the given auth in the specified context,
+// so that they are applied by the signing and client certificate transports of the http client
+func SaveTransportAuthToContext(ctx context.Context, auth *graphql.Auth) context.Context {
+	if auth == nil {
+		return ctx
+	}
+
+	if auth.Signing != nil {
+		ctx = directorhttp.SaveRequestSigningToContext(ctx, directorhttp.RequestSigning{
+			Header:          auth.Signing.Header,
+			TimestampHeader: auth.Signing.TimestampHeader,
+			Secrets:         auth.Signing.Secrets,
+		})
+	}
+
+	if certificate, ok := auth.Credential.(*graphql.CertificateCredentialData); ok {
+		ctx = directorhttp.SaveClientCertificateToContext(ctx, directorhttp.ClientCertificate{
+			Certificate: certificate.Certificate,
+			PrivateKey:  certificate.PrivateKey,
+		})
+	}
+
+	return ctx
+}
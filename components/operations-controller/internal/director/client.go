@@ -24,24 +24,44 @@ import (
 	"net/http"
 
 	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql/graphqlizer"
 	"github.com/kyma-incubator/compass/components/director/pkg/resource"
 	graphqlbroker "github.com/kyma-incubator/compass/components/system-broker/pkg/graphql"
 	"github.com/kyma-incubator/compass/components/system-broker/pkg/types"
+	gcli "github.com/machinebox/graphql"
+	"github.com/pkg/errors"
 )
 
 // client implements the DirectorClient interface
 type client struct {
 	types.ApplicationLister
+	gqlClient         *graphqlbroker.Client
+	fieldsProvider    *graphqlizer.GqlFieldsProvider
 	httpClient        *http.Client
 	directorURL       string
 	operationEndpoint string
 }
 
+// RuntimeOutput holds the runtime fetched from the Director together with its webhooks
+type RuntimeOutput struct {
+	Result *graphql.RuntimeExt `json:"result"`
+}
+
 type Request struct {
-	OperationType graphql.OperationType `json:"operation_type"`
-	ResourceType  resource.Type         `json:"resource_type"`
-	ResourceID    string                `json:"resource_id"`
-	Error         string                `json:"error,omitempty"`
+	OperationID    string                `json:"operation_id,omitempty"`
+	OperationType  graphql.OperationType `json:"operation_type"`
+	ResourceType   resource.Type         `json:"resource_type"`
+	ResourceID     string                `json:"resource_id"`
+	Error          string                `json:"error,omitempty"`
+	WebhookResults []WebhookResult       `json:"webhook_results,omitempty"`
+}
+
+// WebhookResult describes the outcome of the execution of a single webhook of the operation
+type WebhookResult struct {
+	WebhookID      string `json:"webhook_id"`
+	State          string `json:"state"`
+	RetriesCount   int    `json:"retries_count"`
+	FailedAttempts int    `json:"failed_attempts"`
 }
 
 // NewClient constructs a default implementation of the Client interface
@@ -53,11 +73,35 @@ func NewClient(operationEndpoint string, cfg *graphqlbroker.Config, httpClient *
 
 	return &client{
 		ApplicationLister: graphqlClient,
+		gqlClient:         graphqlbroker.NewClient(cfg, gcli.NewClient(cfg.GraphqlEndpoint, gcli.WithHTTPClient(httpClient))),
+		fieldsProvider:    &graphqlizer.GqlFieldsProvider{},
 		httpClient:        httpClient,
 		operationEndpoint: operationEndpoint,
 	}, nil
 }
 
+// FetchRuntime fetches the runtime with the given ID together with its webhooks
+func (c *client) FetchRuntime(ctx context.Context, id string) (*RuntimeOutput, error) {
+	query := fmt.Sprintf(`query {
+			result: runtime(id: "%s") {
+					id
+					name
+					error
+					webhooks {%s}
+			}
+	}`, id, c.fieldsProvider.ForWebhooks())
+
+	runtime := RuntimeOutput{}
+	if err := c.gqlClient.Do(ctx, gcli.NewRequest(query), &runtime); err != nil {
+		return nil, errors.Wrap(err, "while fetching runtime in gqlclient")
+	}
+	if runtime.Result == nil {
+		return nil, errors.New("failed to fetch runtime")
+	}
+
+	return &runtime, nil
+}
+
 // UpdateOperation makes an http request to the Director to notify about any operation state changes
 func (c *client) UpdateOperation(ctx context.Context, request *Request) error {
 	body, err := json.Marshal(request)
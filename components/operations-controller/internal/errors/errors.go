@@ -28,6 +28,8 @@ var (
 	ErrWebhookPollTimeExpired       = errors.New("polling time has expired")
 	ErrFailedWebhookStatus          = errors.New("webhook operation has finished with failed status")
 	ErrUnsupportedWebhookMode       = errors.New("unsupported webhook mode")
+	ErrWebhookNotRetryable          = errors.New("webhook failure is not retryable")
+	ErrWebhookAttemptsExhausted     = errors.New("webhook retry attempts have been exhausted")
 )
 
 // FatalReconcileErr represents an error type which denotes a failure to proceed with the reconciliation of an Operation CR.
@@ -67,3 +69,29 @@ func IsWebhookStatusGoneErr(err error) (ok bool) {
 	_, ok = err.(WebhookStatusGoneErr)
 	return
 }
+
+// WebhookStatusCodeErr represents an error type which denotes that the webhook
+// has responded with a status code other than the expected success status code.
+type WebhookStatusCodeErr struct {
+	error
+	StatusCode int
+}
+
+// NewWebhookStatusCodeErr constructs a new WebhookStatusCodeErr for the given status code with the given error message
+func NewWebhookStatusCodeErr(statusCode int, message string) *WebhookStatusCodeErr {
+	return &WebhookStatusCodeErr{
+		error:      errors.New(message),
+		StatusCode: statusCode,
+	}
+}
+
+// WebhookStatusCode returns the status code carried by a WebhookStatusCodeErr
+// and reports whether the provided error is such an error.
+func WebhookStatusCode(err error) (int, bool) {
+	statusCodeErr, ok := err.(*WebhookStatusCodeErr)
+	if !ok {
+		return 0, false
+	}
+
+	return statusCodeErr.StatusCode, true
+}
@@ -61,22 +61,21 @@ func (m *manager) Initialize(operation *v1alpha1.Operation) error {
 		{Type: v1alpha1.ConditionTypeError, Status: corev1.ConditionFalse},
 	}
 
-	if len(operation.Spec.WebhookIDs) > 0 {
-		status.Webhooks = []v1alpha1.Webhook{
-			{WebhookID: operation.Spec.WebhookIDs[0], State: v1alpha1.StateInProgress},
-		}
+	status.Webhooks = nil
+	for _, webhookID := range operation.Spec.WebhookIDs {
+		status.Webhooks = append(status.Webhooks, v1alpha1.Webhook{WebhookID: webhookID, State: v1alpha1.StateInProgress})
 	}
 	return nil
 }
 
 // InProgressWithPollURL sets the status of an Operation CR to In Progress, ensures that none of the conditions are set to True,
-// and also initializes the slice of webhooks in the status with a single webhook with the provided pollURL.
-func (m *manager) InProgressWithPollURL(ctx context.Context, operation *v1alpha1.Operation, pollURL string) error {
-	return m.InProgressWithPollURLAndLastPollTimestamp(ctx, operation, pollURL, "", 0)
+// and also sets the provided pollURL for the webhook with the given ID in the webhooks slice of the status.
+func (m *manager) InProgressWithPollURL(ctx context.Context, operation *v1alpha1.Operation, webhookID, pollURL string) error {
+	return m.InProgressWithPollURLAndLastPollTimestamp(ctx, operation, webhookID, pollURL, "", 0)
 }
 
 // InProgressWithPollURLAndLastPollTimestamp builds on what InProgressWithPollURL does, but also sets the last poll timestamp and retry count for the given webhook.
-func (m *manager) InProgressWithPollURLAndLastPollTimestamp(ctx context.Context, operation *v1alpha1.Operation, pollURL, lastPollTimestamp string, retryCount int) error {
+func (m *manager) InProgressWithPollURLAndLastPollTimestamp(ctx context.Context, operation *v1alpha1.Operation, webhookID, pollURL, lastPollTimestamp string, retryCount int) error {
 	return m.updateStatusFunc(ctx, operation, func(operation *v1alpha1.Operation) {
 		status := &operation.Status
 
@@ -86,16 +85,48 @@ func (m *manager) InProgressWithPollURLAndLastPollTimestamp(ctx context.Context,
 			{Type: v1alpha1.ConditionTypeError, Status: corev1.ConditionFalse},
 		}
 
-		if len(operation.Spec.WebhookIDs) > 0 {
-			status.Webhooks = []v1alpha1.Webhook{
-				{WebhookID: operation.Spec.WebhookIDs[0], State: v1alpha1.StateInProgress, WebhookPollURL: pollURL, LastPollTimestamp: lastPollTimestamp, RetriesCount: retryCount},
-			}
-		}
+		setWebhookStatus(status, webhookID, func(webhook *v1alpha1.Webhook) {
+			webhook.State = v1alpha1.StateInProgress
+			webhook.WebhookPollURL = pollURL
+			webhook.LastPollTimestamp = lastPollTimestamp
+			webhook.RetriesCount = retryCount
+		})
+	})
+}
+
+// WebhookFailedAttempt records the number of failed execution attempts of the webhook with the given ID
+// in the webhooks slice of the status, while keeping the webhook and the Operation CR In Progress until it is retried.
+func (m *manager) WebhookFailedAttempt(ctx context.Context, operation *v1alpha1.Operation, webhookID string, failedAttempts int) error {
+	return m.updateStatusFunc(ctx, operation, func(operation *v1alpha1.Operation) {
+		setWebhookStatus(&operation.Status, webhookID, func(webhook *v1alpha1.Webhook) {
+			webhook.State = v1alpha1.StateInProgress
+			webhook.FailedAttempts = failedAttempts
+		})
+	})
+}
+
+// WebhookSuccessStatus marks the webhook with the given ID as Success in the webhooks slice of the status
+// without affecting the phase and the conditions of the Operation CR, as other webhooks might still be executing.
+func (m *manager) WebhookSuccessStatus(ctx context.Context, operation *v1alpha1.Operation, webhookID string) error {
+	return m.updateStatusFunc(ctx, operation, func(operation *v1alpha1.Operation) {
+		setWebhookStatus(&operation.Status, webhookID, func(webhook *v1alpha1.Webhook) {
+			webhook.State = v1alpha1.StateSuccess
+		})
+	})
+}
+
+// WebhookFailedStatus marks the webhook with the given ID as Failed in the webhooks slice of the status
+// without affecting the phase and the conditions of the Operation CR, as other webhooks might still be executing.
+func (m *manager) WebhookFailedStatus(ctx context.Context, operation *v1alpha1.Operation, webhookID string) error {
+	return m.updateStatusFunc(ctx, operation, func(operation *v1alpha1.Operation) {
+		setWebhookStatus(&operation.Status, webhookID, func(webhook *v1alpha1.Webhook) {
+			webhook.State = v1alpha1.StateFailed
+		})
 	})
 }
 
 // SuccessStatus sets the status of an Operation CR to Success, ensures that the Ready condition is True, the Error condition is False,
-// and that the webhook part of the webhooks slice in the status is marked with Success.
+// and that all webhooks part of the webhooks slice in the status are marked with Success.
 func (m *manager) SuccessStatus(ctx context.Context, operation *v1alpha1.Operation) error {
 	return m.updateStatusFunc(ctx, operation, func(operation *v1alpha1.Operation) {
 		status := &operation.Status
@@ -106,20 +137,16 @@ func (m *manager) SuccessStatus(ctx context.Context, operation *v1alpha1.Operati
 			{Type: v1alpha1.ConditionTypeError, Status: corev1.ConditionFalse},
 		}
 
-		if len(operation.Spec.WebhookIDs) > 0 {
-			webhook := v1alpha1.Webhook{WebhookID: operation.Spec.WebhookIDs[0], State: v1alpha1.StateSuccess}
-			if len(operation.Status.Webhooks) > 0 {
-				webhook.RetriesCount = operation.Status.Webhooks[0].RetriesCount
-				webhook.WebhookPollURL = operation.Status.Webhooks[0].WebhookPollURL
-				webhook.LastPollTimestamp = operation.Status.Webhooks[0].LastPollTimestamp
-			}
-			status.Webhooks = []v1alpha1.Webhook{webhook}
+		for _, webhookID := range operation.Spec.WebhookIDs {
+			setWebhookStatus(status, webhookID, func(webhook *v1alpha1.Webhook) {
+				webhook.State = v1alpha1.StateSuccess
+			})
 		}
 	})
 }
 
 // FailedStatus sets the status of an Operation CR to Failed, ensures that the Ready condition is False, the Error condition is True,
-// and that the webhook part of the webhooks slice in the status is marked with Failed.
+// and that the webhooks part of the webhooks slice in the status which have not succeeded are marked with Failed.
 func (m *manager) FailedStatus(ctx context.Context, operation *v1alpha1.Operation, errorMsg string) error {
 	return m.updateStatusFunc(ctx, operation, func(operation *v1alpha1.Operation) {
 		status := &operation.Status
@@ -130,15 +157,12 @@ func (m *manager) FailedStatus(ctx context.Context, operation *v1alpha1.Operatio
 			{Type: v1alpha1.ConditionTypeError, Status: corev1.ConditionTrue, Message: errorMsg},
 		}
 
-		if len(operation.Spec.WebhookIDs) > 0 {
-			webhook := v1alpha1.Webhook{WebhookID: operation.Spec.WebhookIDs[0], State: v1alpha1.StateFailed}
-			if len(operation.Status.Webhooks) > 0 {
-				webhook.RetriesCount = operation.Status.Webhooks[0].RetriesCount
-				webhook.WebhookPollURL = operation.Status.Webhooks[0].WebhookPollURL
-				webhook.LastPollTimestamp = operation.Status.Webhooks[0].LastPollTimestamp
-			}
-
-			status.Webhooks = []v1alpha1.Webhook{webhook}
+		for _, webhookID := range operation.Spec.WebhookIDs {
+			setWebhookStatus(status, webhookID, func(webhook *v1alpha1.Webhook) {
+				if webhook.State != v1alpha1.StateSuccess {
+					webhook.State = v1alpha1.StateFailed
+				}
+			})
 		}
 	})
 }
@@ -154,3 +178,18 @@ func (m *manager) updateStatusFunc(ctx context.Context, operation *v1alpha1.Oper
 		return m.k8sClient.Status().Update(ctx, operation)
 	})
 }
+
+// setWebhookStatus applies the given changes to the status entry of the webhook with the provided ID,
+// appending a new In Progress entry for the webhook if it is not yet part of the status.
+func setWebhookStatus(status *v1alpha1.OperationStatus, webhookID string, updateFunc func(webhook *v1alpha1.Webhook)) {
+	for i := range status.Webhooks {
+		if status.Webhooks[i].WebhookID == webhookID {
+			updateFunc(&status.Webhooks[i])
+			return
+		}
+	}
+
+	webhook := v1alpha1.Webhook{WebhookID: webhookID, State: v1alpha1.StateInProgress}
+	updateFunc(&webhook)
+	status.Webhooks = append(status.Webhooks, webhook)
+}
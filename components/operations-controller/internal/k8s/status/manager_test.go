@@ -34,8 +34,9 @@ import (
 )
 
 const (
-	webhookID     = "866e6b9c-f03b-442b-a6a5-4b90e21e503a"
-	mockedPollURL = "https://test-domain.com/operation"
+	webhookID       = "866e6b9c-f03b-442b-a6a5-4b90e21e503a"
+	secondWebhookID = "c0d3c2a1-26b5-4d1b-8c6e-6b3f2f4a9e1d"
+	mockedPollURL   = "https://test-domain.com/operation"
 )
 
 func TestStatusManager(t *testing.T) {
@@ -77,7 +78,7 @@ func TestStatusManager(t *testing.T) {
 		invalidOperation := operation.DeepCopy()
 		invalidOperation.ResourceVersion = ""
 		invalidOperation.ObjectMeta.Name = "invalid-operation"
-		invalidOperation.Spec.WebhookIDs = make([]string, 2)
+		invalidOperation.Spec.WebhookIDs = []string{webhookID, webhookID}
 
 		err = k8sClient.Create(ctx, invalidOperation)
 		require.NoError(t, err)
@@ -87,7 +88,7 @@ func TestStatusManager(t *testing.T) {
 
 		_, isValErr := err.(*v1alpha1.OperationValidationErr)
 		require.True(t, isValErr)
-		require.Contains(t, err.Error(), "expected 0 or 1 webhook for execution, found: 2")
+		require.Contains(t, err.Error(), "expected unique webhook IDs for execution, found duplicate")
 	})
 
 	t.Run("Test Initialize when generation and observed generation mismatch should initialize status with initial values", func(t *testing.T) {
@@ -152,7 +153,7 @@ func TestStatusManager(t *testing.T) {
 		err = k8sClient.Get(ctx, namespacedName, originOperation)
 		require.NoError(t, err)
 
-		err = statusManager.InProgressWithPollURL(ctx, originOperation, mockedPollURL)
+		err = statusManager.InProgressWithPollURL(ctx, originOperation, webhookID, mockedPollURL)
 		require.NoError(t, err)
 
 		var actualOperation = &v1alpha1.Operation{}
@@ -184,7 +185,7 @@ func TestStatusManager(t *testing.T) {
 
 		retryCount := 1
 		lastPollTimestamp := time.Now().Format(time.RFC3339Nano)
-		err = statusManager.InProgressWithPollURLAndLastPollTimestamp(ctx, originOperation, mockedPollURL, lastPollTimestamp, retryCount)
+		err = statusManager.InProgressWithPollURLAndLastPollTimestamp(ctx, originOperation, webhookID, mockedPollURL, lastPollTimestamp, retryCount)
 		require.NoError(t, err)
 
 		var actualOperation = &v1alpha1.Operation{}
@@ -209,6 +210,29 @@ func TestStatusManager(t *testing.T) {
 		}
 	})
 
+	t.Run("Test Webhook Failed Attempt should keep the webhook In Progress and record the failed attempts", func(t *testing.T) {
+		var originOperation = &v1alpha1.Operation{}
+		err = k8sClient.Get(ctx, namespacedName, originOperation)
+		require.NoError(t, err)
+
+		failedAttempts := 2
+		err = statusManager.WebhookFailedAttempt(ctx, originOperation, webhookID, failedAttempts)
+		require.NoError(t, err)
+
+		var actualOperation = &v1alpha1.Operation{}
+		err = k8sClient.Get(ctx, namespacedName, actualOperation)
+		require.NoError(t, err)
+
+		for _, op := range []*v1alpha1.Operation{originOperation, actualOperation} {
+			require.Equal(t, v1alpha1.StateInProgress, op.Status.Phase)
+
+			require.Len(t, op.Status.Webhooks, 1)
+			require.Equal(t, webhookID, op.Status.Webhooks[0].WebhookID)
+			require.Equal(t, v1alpha1.StateInProgress, op.Status.Webhooks[0].State)
+			require.Equal(t, failedAttempts, op.Status.Webhooks[0].FailedAttempts)
+		}
+	})
+
 	t.Run("Test Success Status should succeed", func(t *testing.T) {
 		var originOperation = &v1alpha1.Operation{}
 		err = k8sClient.Get(ctx, namespacedName, originOperation)
@@ -250,7 +274,7 @@ func TestStatusManager(t *testing.T) {
 
 		retryCount := 1
 		lastPollTimestamp := time.Now().Format(time.RFC3339Nano)
-		err = statusManager.InProgressWithPollURLAndLastPollTimestamp(ctx, originOperation, mockedPollURL, lastPollTimestamp, retryCount)
+		err = statusManager.InProgressWithPollURLAndLastPollTimestamp(ctx, originOperation, webhookID, mockedPollURL, lastPollTimestamp, retryCount)
 		require.NoError(t, err)
 
 		err = statusManager.SuccessStatus(ctx, originOperation)
@@ -290,6 +314,9 @@ func TestStatusManager(t *testing.T) {
 		err = k8sClient.Get(ctx, namespacedName, originOperation)
 		require.NoError(t, err)
 
+		err = statusManager.InProgressWithPollURL(ctx, originOperation, webhookID, mockedPollURL)
+		require.NoError(t, err)
+
 		errMsg := "test error"
 		err = statusManager.FailedStatus(ctx, originOperation, errMsg)
 		require.NoError(t, err)
@@ -327,7 +354,7 @@ func TestStatusManager(t *testing.T) {
 
 		retryCount := 1
 		lastPollTimestamp := time.Now().Format(time.RFC3339Nano)
-		err = statusManager.InProgressWithPollURLAndLastPollTimestamp(ctx, originOperation, mockedPollURL, lastPollTimestamp, retryCount)
+		err = statusManager.InProgressWithPollURLAndLastPollTimestamp(ctx, originOperation, webhookID, mockedPollURL, lastPollTimestamp, retryCount)
 		require.NoError(t, err)
 
 		errMsg := "test error"
@@ -362,4 +389,54 @@ func TestStatusManager(t *testing.T) {
 			}
 		}
 	})
+
+	t.Run("Test statuses of an operation with multiple webhooks should be tracked per webhook", func(t *testing.T) {
+		multiWebhookOperation := operation.DeepCopy()
+		multiWebhookOperation.ResourceVersion = ""
+		multiWebhookOperation.ObjectMeta.Name = "multi-webhook-operation"
+		multiWebhookOperation.Spec.WebhookIDs = []string{webhookID, secondWebhookID}
+		multiWebhookOperation.Status = v1alpha1.OperationStatus{}
+
+		err = k8sClient.Create(ctx, multiWebhookOperation)
+		require.NoError(t, err)
+
+		err = statusManager.Initialize(multiWebhookOperation)
+		require.NoError(t, err)
+
+		require.Len(t, multiWebhookOperation.Status.Webhooks, 2)
+		for i, id := range []string{webhookID, secondWebhookID} {
+			require.Equal(t, id, multiWebhookOperation.Status.Webhooks[i].WebhookID)
+			require.Equal(t, v1alpha1.StateInProgress, multiWebhookOperation.Status.Webhooks[i].State)
+		}
+
+		err = statusManager.InProgressWithPollURL(ctx, multiWebhookOperation, secondWebhookID, mockedPollURL)
+		require.NoError(t, err)
+
+		err = statusManager.WebhookSuccessStatus(ctx, multiWebhookOperation, webhookID)
+		require.NoError(t, err)
+
+		var actualOperation = &v1alpha1.Operation{}
+		err = k8sClient.Get(ctx, types.NamespacedName{Namespace: multiWebhookOperation.Namespace, Name: multiWebhookOperation.Name}, actualOperation)
+		require.NoError(t, err)
+
+		require.Equal(t, v1alpha1.StateInProgress, actualOperation.Status.Phase)
+		require.Len(t, actualOperation.Status.Webhooks, 2)
+		require.Equal(t, v1alpha1.StateSuccess, actualOperation.Status.Webhooks[0].State)
+		require.Empty(t, actualOperation.Status.Webhooks[0].WebhookPollURL)
+		require.Equal(t, v1alpha1.StateInProgress, actualOperation.Status.Webhooks[1].State)
+		require.Equal(t, mockedPollURL, actualOperation.Status.Webhooks[1].WebhookPollURL)
+
+		errMsg := "test error"
+		err = statusManager.FailedStatus(ctx, multiWebhookOperation, errMsg)
+		require.NoError(t, err)
+
+		err = k8sClient.Get(ctx, types.NamespacedName{Namespace: multiWebhookOperation.Namespace, Name: multiWebhookOperation.Name}, actualOperation)
+		require.NoError(t, err)
+
+		require.Equal(t, v1alpha1.StateFailed, actualOperation.Status.Phase)
+		require.Len(t, actualOperation.Status.Webhooks, 2)
+		require.Equal(t, v1alpha1.StateSuccess, actualOperation.Status.Webhooks[0].State)
+		require.Equal(t, v1alpha1.StateFailed, actualOperation.Status.Webhooks[1].State)
+		require.Equal(t, mockedPollURL, actualOperation.Status.Webhooks[1].WebhookPollURL)
+	})
 }
@@ -19,7 +19,6 @@ package webhook
 import (
 	"bytes"
 	"context"
-	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"net/http"
@@ -96,6 +95,7 @@ func (c *client) Do(ctx context.Context, request *Request) (*web_hook.Response,
 
 	if webhook.Auth != nil {
 		ctx = auth.SaveToContext(ctx, webhook.Auth.Credential)
+		ctx = auth.SaveTransportAuthToContext(ctx, webhook.Auth)
 		req = req.WithContext(ctx)
 	}
 
@@ -157,6 +157,7 @@ func (c *client) Poll(ctx context.Context, request *PollRequest) (*web_hook.Resp
 
 	if webhook.Auth != nil {
 		ctx = auth.SaveToContext(ctx, webhook.Auth.Credential)
+		ctx = auth.SaveTransportAuthToContext(ctx, webhook.Auth)
 		req = req.WithContext(ctx)
 	}
 
@@ -186,11 +187,9 @@ func parseResponseObject(resp *http.Response) (*web_hook.ResponseObject, error)
 		return nil, err
 	}
 
-	body := make(map[string]string, 0)
-	if len(bytes) > 0 {
-		if err := json.Unmarshal(bytes, &body); err != nil {
-			return nil, err
-		}
+	body, err := web_hook.ParseResponseBody(bytes)
+	if err != nil {
+		return nil, err
 	}
 
 	headers := make(map[string]string, 0)
@@ -206,7 +205,8 @@ func parseResponseObject(resp *http.Response) (*web_hook.ResponseObject, error)
 
 func checkForErr(resp *http.Response, successStatusCode *int, error *string) error {
 	var errMsg string
-	if *successStatusCode != resp.StatusCode {
+	statusCodeMet := *successStatusCode == resp.StatusCode
+	if !statusCodeMet {
 		errMsg += fmt.Sprintf("response success status code was not met - expected %q, got %q; ", *successStatusCode, resp.StatusCode)
 	}
 
@@ -214,6 +214,10 @@ func checkForErr(resp *http.Response, successStatusCode *int, error *string) err
 		errMsg += fmt.Sprintf("received error while polling external system: %s", *error)
 	}
 
+	if !statusCodeMet {
+		return recerr.NewWebhookStatusCodeErr(resp.StatusCode, errMsg)
+	}
+
 	if errMsg != "" {
 		return errors.New(errMsg)
 	}
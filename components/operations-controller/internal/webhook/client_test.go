@@ -31,6 +31,7 @@ import (
 	"github.com/kyma-incubator/compass/components/operations-controller/internal/auth"
 
 	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	directorhttp "github.com/kyma-incubator/compass/components/director/pkg/http"
 	web_hook "github.com/kyma-incubator/compass/components/director/pkg/webhook"
 
 	"github.com/kyma-incubator/compass/components/operations-controller/internal/webhook"
@@ -238,6 +239,37 @@ func TestClient_Do_WhenWebhookResponseBodyContainsError_ShouldReturnError(t *tes
 	require.Contains(t, err.Error(), "received error while polling external system")
 }
 
+func TestClient_Do_WhenWebhookResponseStatusCodeIsNotSuccessful_ShouldReturnWebhookStatusCodeError(t *testing.T) {
+	URLTemplate := "{\"method\": \"DELETE\",\"path\":\"https://test-domain.com/api/v1/applicaitons/{{.Application.ID}}\"}"
+	outputTemplate := "{\"location\":\"{{.Headers.Location}}\",\"success_status_code\": 202,\"error\": \"{{.Body.error}}\"}"
+	app := &graphql.Application{BaseEntity: &graphql.BaseEntity{ID: "appID"}}
+	webhookReq := &webhook.Request{
+		Webhook: graphql.Webhook{
+			URLTemplate:    &URLTemplate,
+			OutputTemplate: &outputTemplate,
+			Mode:           &webhookAsyncMode,
+		},
+		Object: web_hook.RequestObject{Application: app},
+	}
+
+	client := webhook.NewClient(&http.Client{
+		Transport: mockedTransport{
+			resp: &http.Response{
+				Body:       ioutil.NopCloser(bytes.NewReader([]byte("{}"))),
+				Header:     http.Header{"Location": []string{mockedLocationURL}},
+				StatusCode: http.StatusServiceUnavailable,
+			},
+		},
+	})
+
+	_, err := client.Do(context.Background(), webhookReq)
+
+	require.Error(t, err)
+	statusCode, ok := internal_errors.WebhookStatusCode(err)
+	require.True(t, ok)
+	require.Equal(t, http.StatusServiceUnavailable, statusCode)
+}
+
 func TestClient_Do_WhenWebhookResponseStatusCodeIsGoneAndGoneStatusISDefined_ShouldReturnWebhookStatusGoneError(t *testing.T) {
 	goneCodeString := "404"
 	URLTemplate := "{\"method\": \"DELETE\",\"path\":\"https://test-domain.com/api/v1/applicaitons/{{.Application.ID}}\"}"
@@ -402,6 +434,62 @@ func TestClient_Do_WhenSuccessfulOAuthWebhook_ShouldBeSuccessful(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestClient_Do_WhenSuccessfulCertificateAndSignedWebhook_ShouldBeSuccessful(t *testing.T) {
+	URLTemplate := "{\"method\": \"DELETE\",\"path\":\"https://test-domain.com/api/v1/applicaitons/{{.Application.ID}}\"}"
+	outputTemplate := "{\"location\":\"{{.Headers.Location}}\",\"success_status_code\": 202,\"error\": \"{{.Body.error}}\"}"
+	certificate, privateKey := "certificate", "private-key"
+	signing := &graphql.RequestSigning{
+		Header:          "X-Signature",
+		TimestampHeader: "X-Signature-Timestamp",
+		Secrets:         []string{"old-secret", "new-secret"},
+	}
+	app := &graphql.Application{BaseEntity: &graphql.BaseEntity{ID: "appID"}}
+	webhookReq := &webhook.Request{
+		Webhook: graphql.Webhook{
+			URLTemplate:    &URLTemplate,
+			OutputTemplate: &outputTemplate,
+			Mode:           &webhookAsyncMode,
+			Auth: &graphql.Auth{
+				Credential: &graphql.CertificateCredentialData{
+					Certificate: certificate,
+					PrivateKey:  privateKey,
+				},
+				Signing: signing,
+			},
+		},
+		Object: web_hook.RequestObject{Application: app},
+	}
+
+	client := webhook.NewClient(&http.Client{
+		Transport: mockedTransport{
+			resp: &http.Response{
+				Body:       ioutil.NopCloser(bytes.NewReader([]byte("{}"))),
+				Header:     http.Header{"Location": []string{mockedLocationURL}},
+				StatusCode: http.StatusAccepted,
+			},
+			roundTripExpectations: func(r *http.Request) {
+				_, err := auth.LoadFromContext(r.Context())
+				require.Error(t, err)
+
+				clientCertificate, ok := directorhttp.LoadClientCertificateFromContext(r.Context())
+				require.True(t, ok)
+				require.Equal(t, certificate, clientCertificate.Certificate)
+				require.Equal(t, privateKey, clientCertificate.PrivateKey)
+
+				requestSigning, ok := directorhttp.LoadRequestSigningFromContext(r.Context())
+				require.True(t, ok)
+				require.Equal(t, signing.Header, requestSigning.Header)
+				require.Equal(t, signing.TimestampHeader, requestSigning.TimestampHeader)
+				require.Equal(t, signing.Secrets, requestSigning.Secrets)
+			},
+		},
+	})
+
+	_, err := client.Do(context.Background(), webhookReq)
+
+	require.NoError(t, err)
+}
+
 func TestClient_Do_WhenMissingCorrelationID_ShouldBeSuccessful(t *testing.T) {
 	URLTemplate := "{\"method\": \"DELETE\",\"path\":\"https://test-domain.com/api/v1/applicaitons/{{.Application.ID}}\"}"
 	inputTemplate := "{\"application_id\": \"{{.Application.ID}}\",\"name\": \"{{.Application.Name}}\"}"
@@ -743,6 +831,36 @@ func TestClient_Poll_WhenMissingCorrelationID_ShouldBeSuccessful(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestClient_Poll_WhenWebhookResponseBodyIsNested_ShouldParseStatusTemplate(t *testing.T) {
+	statusTemplate := "{\"status\":\"{{.Body.result.state}}\",\"success_status_code\": 200,\"success_status_identifier\":\"SUCCEEDED\",\"in_progress_status_identifier\":\"IN_PROGRESS\",\"failed_status_identifier\":\"FAILED\",\"error\": {{toJSON (path .Body \"result.errors.0.message\" | default \"\")}}}"
+	app := &graphql.Application{BaseEntity: &graphql.BaseEntity{ID: "appID"}}
+	webhookReq := &webhook.PollRequest{
+		Request: &webhook.Request{
+			Webhook: graphql.Webhook{
+				StatusTemplate: &statusTemplate,
+				Mode:           &webhookAsyncMode,
+			},
+			Object: web_hook.RequestObject{Application: app},
+		},
+		PollURL: mockedLocationURL,
+	}
+
+	client := webhook.NewClient(&http.Client{
+		Transport: mockedTransport{
+			resp: &http.Response{
+				Body:       ioutil.NopCloser(bytes.NewReader([]byte(`{"result": {"state": "SUCCEEDED", "progress": 100, "done": true, "errors": []}}`))),
+				StatusCode: http.StatusOK,
+			},
+		},
+	})
+
+	response, err := client.Poll(context.Background(), webhookReq)
+
+	require.NoError(t, err)
+	require.Equal(t, "SUCCEEDED", *response.Status)
+	require.Equal(t, "", *response.Error)
+}
+
 type mockedTransport struct {
 	resp                  *http.Response
 	err                   error
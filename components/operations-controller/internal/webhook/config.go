@@ -23,21 +23,37 @@ import (
 	"github.com/pkg/errors"
 )
 
+// ExecutionPolicy defines how the webhooks of a single Operation are executed
+type ExecutionPolicy string
+
+const (
+	// ExecutionPolicySequential executes the webhooks of an Operation one after another in the order
+	// in which they are listed, and stops with the first webhook which fails
+	ExecutionPolicySequential ExecutionPolicy = "Sequential"
+	// ExecutionPolicyParallel executes the webhooks of an Operation concurrently, at most MaxParallelWebhooks at a time,
+	// without waiting for the previous ones to complete
+	ExecutionPolicyParallel ExecutionPolicy = "Parallel"
+)
+
 // Settings type to be loaded from the environment
 type Config struct {
-	TimeoutFactor   int           `mapstructure:"timeout_factor" description:"the factor by which to multiple the reconciliation timeout"`
-	WebhookTimeout  time.Duration `mapstructure:"webhook_timeout" description:"defines the maximum time to process a webhook"`
-	RequeueInterval time.Duration `mapstructure:"requeue_interval" description:"defines the default requeue interval"`
-	TimeLayout      string        `mapstructure:"time_layout" description:"defines the default timestamp time layout"`
+	TimeoutFactor       int             `mapstructure:"timeout_factor" description:"the factor by which to multiple the reconciliation timeout"`
+	WebhookTimeout      time.Duration   `mapstructure:"webhook_timeout" description:"defines the maximum time to process a webhook"`
+	RequeueInterval     time.Duration   `mapstructure:"requeue_interval" description:"defines the default requeue interval"`
+	TimeLayout          string          `mapstructure:"time_layout" description:"defines the default timestamp time layout"`
+	ExecutionPolicy     ExecutionPolicy `mapstructure:"execution_policy" description:"defines whether the webhooks of an operation are executed Sequential or Parallel"`
+	MaxParallelWebhooks int             `mapstructure:"max_parallel_webhooks" description:"defines how many webhooks of an operation are executed concurrently with the Parallel execution policy"`
 }
 
 // DefaultSettings returns the default values for configuring the System Broker
 func DefaultConfig() *Config {
 	return &Config{
-		TimeoutFactor:   2,
-		WebhookTimeout:  2 * time.Hour,
-		RequeueInterval: 2 * time.Minute,
-		TimeLayout:      time.RFC3339Nano,
+		TimeoutFactor:       2,
+		WebhookTimeout:      2 * time.Hour,
+		RequeueInterval:     2 * time.Minute,
+		TimeLayout:          time.RFC3339Nano,
+		ExecutionPolicy:     ExecutionPolicySequential,
+		MaxParallelWebhooks: 5,
 	}
 }
 
@@ -54,5 +70,11 @@ func (s *Config) Validate() error {
 	if s.TimeLayout != time.RFC3339Nano {
 		return fmt.Errorf("validate webhook settings: time layout should be %s", time.RFC3339Nano)
 	}
+	if s.ExecutionPolicy != ExecutionPolicySequential && s.ExecutionPolicy != ExecutionPolicyParallel {
+		return fmt.Errorf("validate webhook settings: execution policy should be one of %s, %s", ExecutionPolicySequential, ExecutionPolicyParallel)
+	}
+	if s.MaxParallelWebhooks <= 0 {
+		return errors.New("validate webhook settings: max parallel webhooks should be > 0")
+	}
 	return nil
 }
@@ -70,6 +70,14 @@ func TestConfig_Validate(t *testing.T) {
 				return config
 			},
 		},
+		{
+			Msg: "Zero MaxParallelWebhooks should be invalid",
+			ConfigProvider: func() *webhook.Config {
+				config := webhook.DefaultConfig()
+				config.MaxParallelWebhooks = 0
+				return config
+			},
+		},
 		{
 			Msg: "Time Layout different from RFC3339Nano should be invalid",
 			ConfigProvider: func() *webhook.Config {
@@ -0,0 +1,115 @@
+/*
+ * Copyright 2020 The Compass Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package webhook
+
+import (
+	"math"
+	"math/rand"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	recerr "github.com/kyma-incubator/compass/components/operations-controller/internal/errors"
+)
+
+// RetryPolicy determines whether and when a failed webhook execution should be retried
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of executions of the webhook, zero meaning that the webhook is retried until it times out
+	MaxAttempts int
+	// InitialInterval is the interval before the first retry
+	InitialInterval time.Duration
+	// MaxInterval caps the interval between retries, zero meaning that the interval is not capped
+	MaxInterval time.Duration
+	// Multiplier is the factor by which the interval grows after each retry
+	Multiplier float64
+	// Jitter is the fraction of the interval by which it is randomized
+	Jitter float64
+	// RetryableStatusCodes are the response status codes for which a failed execution is retried, all failures being retried if empty
+	RetryableStatusCodes []int
+}
+
+// NewRetryPolicy constructs the RetryPolicy defined by the given webhook retry policy.
+// Intervals which are not provided default to defaultInterval, meaning that a webhook without
+// a retry policy is retried with a fixed interval until it times out.
+func NewRetryPolicy(policy *graphql.WebhookRetryPolicy, defaultInterval time.Duration) RetryPolicy {
+	retryPolicy := RetryPolicy{
+		InitialInterval: defaultInterval,
+		Multiplier:      1,
+	}
+
+	if policy == nil {
+		return retryPolicy
+	}
+
+	if policy.MaxAttempts != nil {
+		retryPolicy.MaxAttempts = *policy.MaxAttempts
+	}
+	if policy.InitialInterval != nil {
+		retryPolicy.InitialInterval = time.Duration(*policy.InitialInterval) * time.Second
+	}
+	if policy.MaxInterval != nil {
+		retryPolicy.MaxInterval = time.Duration(*policy.MaxInterval) * time.Second
+	}
+	if policy.Multiplier != nil {
+		retryPolicy.Multiplier = *policy.Multiplier
+	}
+	if policy.Jitter != nil {
+		retryPolicy.Jitter = *policy.Jitter
+	}
+	retryPolicy.RetryableStatusCodes = policy.RetryableStatusCodes
+
+	return retryPolicy
+}
+
+// IsRetryable checks whether the given webhook execution error should be retried.
+// Only errors caused by an unexpected response status code can be excluded from retries.
+func (p RetryPolicy) IsRetryable(err error) bool {
+	statusCode, ok := recerr.WebhookStatusCode(err)
+	if !ok || len(p.RetryableStatusCodes) == 0 {
+		return true
+	}
+
+	for _, retryableStatusCode := range p.RetryableStatusCodes {
+		if retryableStatusCode == statusCode {
+			return true
+		}
+	}
+
+	return false
+}
+
+// AttemptsExhausted checks whether no more retries are allowed after the given number of failed attempts
+func (p RetryPolicy) AttemptsExhausted(failedAttempts int) bool {
+	return p.MaxAttempts > 0 && failedAttempts >= p.MaxAttempts
+}
+
+// Backoff returns the interval to wait before retrying after the given number of failed attempts
+func (p RetryPolicy) Backoff(failedAttempts int) time.Duration {
+	interval := float64(p.InitialInterval)
+	if failedAttempts > 1 {
+		interval *= math.Pow(p.Multiplier, float64(failedAttempts-1))
+	}
+
+	if p.MaxInterval > 0 && interval > float64(p.MaxInterval) {
+		interval = float64(p.MaxInterval)
+	}
+
+	if p.Jitter > 0 {
+		interval += interval * p.Jitter * (2*rand.Float64() - 1)
+	}
+
+	return time.Duration(interval)
+}
@@ -0,0 +1,87 @@
+/*
+ * Copyright 2020 The Compass Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package webhook_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	internal_errors "github.com/kyma-incubator/compass/components/operations-controller/internal/errors"
+	"github.com/kyma-incubator/compass/components/operations-controller/internal/webhook"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewRetryPolicy_WhenPolicyIsMissing_ShouldRetryWithDefaultIntervalUntilTimeout(t *testing.T) {
+	retryPolicy := webhook.NewRetryPolicy(nil, time.Minute)
+
+	require.False(t, retryPolicy.AttemptsExhausted(100))
+	require.True(t, retryPolicy.IsRetryable(internal_errors.NewWebhookStatusCodeErr(500, "internal server error")))
+	require.Equal(t, time.Minute, retryPolicy.Backoff(1))
+	require.Equal(t, time.Minute, retryPolicy.Backoff(10))
+}
+
+func TestRetryPolicy_Backoff(t *testing.T) {
+	retryPolicy := webhook.NewRetryPolicy(&graphql.WebhookRetryPolicy{
+		InitialInterval: intPtr(1),
+		MaxInterval:     intPtr(10),
+		Multiplier:      floatPtr(2),
+	}, time.Minute)
+
+	require.Equal(t, 1*time.Second, retryPolicy.Backoff(1))
+	require.Equal(t, 2*time.Second, retryPolicy.Backoff(2))
+	require.Equal(t, 4*time.Second, retryPolicy.Backoff(3))
+	require.Equal(t, 8*time.Second, retryPolicy.Backoff(4))
+	require.Equal(t, 10*time.Second, retryPolicy.Backoff(5))
+}
+
+func TestRetryPolicy_Backoff_WhenJitterIsProvided_ShouldRandomizeIntervalWithinBounds(t *testing.T) {
+	retryPolicy := webhook.NewRetryPolicy(&graphql.WebhookRetryPolicy{
+		InitialInterval: intPtr(10),
+		Jitter:          floatPtr(0.5),
+	}, time.Minute)
+
+	for i := 0; i < 100; i++ {
+		backoff := retryPolicy.Backoff(1)
+		require.True(t, backoff >= 5*time.Second && backoff <= 15*time.Second, "unexpected backoff %s", backoff)
+	}
+}
+
+func TestRetryPolicy_AttemptsExhausted(t *testing.T) {
+	retryPolicy := webhook.NewRetryPolicy(&graphql.WebhookRetryPolicy{MaxAttempts: intPtr(3)}, time.Minute)
+
+	require.False(t, retryPolicy.AttemptsExhausted(1))
+	require.False(t, retryPolicy.AttemptsExhausted(2))
+	require.True(t, retryPolicy.AttemptsExhausted(3))
+}
+
+func TestRetryPolicy_IsRetryable(t *testing.T) {
+	retryPolicy := webhook.NewRetryPolicy(&graphql.WebhookRetryPolicy{RetryableStatusCodes: []int{429, 503}}, time.Minute)
+
+	require.True(t, retryPolicy.IsRetryable(internal_errors.NewWebhookStatusCodeErr(503, "service unavailable")))
+	require.False(t, retryPolicy.IsRetryable(internal_errors.NewWebhookStatusCodeErr(400, "bad request")))
+	require.True(t, retryPolicy.IsRetryable(errors.New("connection refused")))
+}
+
+func intPtr(i int) *int {
+	return &i
+}
+
+func floatPtr(f float64) *float64 {
+	return &f
+}
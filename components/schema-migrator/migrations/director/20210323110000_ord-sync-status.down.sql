@@ -0,0 +1,5 @@
+BEGIN;
+
+DROP TABLE ord_sync_statuses;
+
+COMMIT;
@@ -0,0 +1,18 @@
+BEGIN;
+
+CREATE TABLE ord_sync_statuses
+(
+    app_id               UUID PRIMARY KEY,
+    tenant_id            UUID    NOT NULL,
+    FOREIGN KEY (tenant_id, app_id) REFERENCES applications (tenant_id, id) ON DELETE CASCADE,
+    last_sync_at         TIMESTAMP,
+    last_success_at      TIMESTAMP,
+    last_error           TEXT,
+    consecutive_failures INTEGER NOT NULL DEFAULT 0,
+    next_sync_at         TIMESTAMP,
+    document_hashes      JSONB
+);
+
+CREATE INDEX ON ord_sync_statuses (tenant_id);
+
+COMMIT;
@@ -0,0 +1,9 @@
+BEGIN;
+
+COMMENT ON COLUMN ord_sync_statuses.document_hashes IS NULL;
+
+ALTER TABLE ord_sync_statuses
+    DROP COLUMN documents,
+    DROP COLUMN resource_hashes;
+
+COMMIT;
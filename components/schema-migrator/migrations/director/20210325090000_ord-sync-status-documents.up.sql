@@ -0,0 +1,10 @@
+BEGIN;
+
+-- document_hashes is kept so that its data survives a rollback to the previous version, which still reads it
+ALTER TABLE ord_sync_statuses
+    ADD COLUMN documents JSONB,
+    ADD COLUMN resource_hashes JSONB;
+
+COMMENT ON COLUMN ord_sync_statuses.document_hashes IS 'Deprecated: superseded by documents and resource_hashes';
+
+COMMIT;
@@ -0,0 +1,9 @@
+BEGIN;
+
+DROP INDEX IF EXISTS fetch_requests_spec_id_status_timestamp_idx;
+
+ALTER TABLE fetch_requests
+    DROP COLUMN spec_hash,
+    DROP COLUMN spec_changed_at;
+
+COMMIT;
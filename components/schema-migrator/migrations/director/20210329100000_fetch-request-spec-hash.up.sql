@@ -0,0 +1,9 @@
+BEGIN;
+
+ALTER TABLE fetch_requests
+    ADD COLUMN spec_hash VARCHAR(64),
+    ADD COLUMN spec_changed_at TIMESTAMP;
+
+CREATE INDEX fetch_requests_spec_id_status_timestamp_idx ON fetch_requests (status_timestamp) WHERE spec_id IS NOT NULL;
+
+COMMIT;
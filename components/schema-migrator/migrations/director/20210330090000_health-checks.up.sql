@@ -0,0 +1,18 @@
+BEGIN;
+
+CREATE TABLE health_checks
+(
+    id        UUID PRIMARY KEY CHECK (id <> '00000000-0000-0000-0000-000000000000'),
+    tenant_id UUID         NOT NULL,
+    type      VARCHAR(256) NOT NULL,
+    origin    UUID         NOT NULL,
+    FOREIGN KEY (tenant_id, origin) REFERENCES applications (tenant_id, id) ON DELETE CASCADE,
+    condition VARCHAR(256) NOT NULL,
+    message   TEXT,
+    timestamp TIMESTAMP    NOT NULL,
+    UNIQUE (type, origin)
+);
+
+CREATE INDEX ON health_checks (tenant_id);
+
+COMMIT;
@@ -0,0 +1,16 @@
+BEGIN;
+
+DROP TABLE webhook_deliveries;
+
+DROP TRIGGER record_spec_configuration_change ON specifications;
+DROP FUNCTION record_spec_configuration_change();
+
+DROP TRIGGER record_label_configuration_change ON labels;
+DROP TRIGGER record_event_def_configuration_change ON event_api_definitions;
+DROP TRIGGER record_api_def_configuration_change ON api_definitions;
+DROP TRIGGER record_bundle_configuration_change ON bundles;
+DROP FUNCTION record_app_configuration_change();
+
+DROP TABLE app_configuration_changes;
+
+COMMIT;
@@ -0,0 +1,115 @@
+BEGIN;
+
+CREATE TABLE app_configuration_changes
+(
+    app_id     UUID PRIMARY KEY,
+    tenant_id  UUID      NOT NULL,
+    FOREIGN KEY (tenant_id, app_id) REFERENCES applications (tenant_id, id) ON DELETE CASCADE,
+    changed_at TIMESTAMP NOT NULL
+);
+
+-- Records that the configuration of the application owning the changed row has changed,
+-- so that its CONFIGURATION_CHANGED webhooks are called regardless of which component made the change
+CREATE OR REPLACE FUNCTION record_app_configuration_change() RETURNS TRIGGER AS
+$$
+DECLARE
+    changed RECORD;
+BEGIN
+    IF TG_OP = 'DELETE' THEN
+        changed := OLD;
+    ELSE
+        changed := NEW;
+    END IF;
+
+    -- Rows removed together with their application do not trigger a notification
+    IF changed.app_id IS NOT NULL AND EXISTS(SELECT 1 FROM applications WHERE id = changed.app_id) THEN
+        INSERT INTO app_configuration_changes (app_id, tenant_id, changed_at)
+        VALUES (changed.app_id, changed.tenant_id, now() AT TIME ZONE 'UTC')
+        ON CONFLICT (app_id) DO UPDATE SET changed_at = EXCLUDED.changed_at;
+    END IF;
+
+    RETURN NULL;
+END
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER record_bundle_configuration_change
+    AFTER INSERT OR UPDATE OR DELETE
+    ON bundles
+    FOR EACH ROW
+EXECUTE PROCEDURE record_app_configuration_change();
+
+CREATE TRIGGER record_api_def_configuration_change
+    AFTER INSERT OR UPDATE OR DELETE
+    ON api_definitions
+    FOR EACH ROW
+EXECUTE PROCEDURE record_app_configuration_change();
+
+CREATE TRIGGER record_event_def_configuration_change
+    AFTER INSERT OR UPDATE OR DELETE
+    ON event_api_definitions
+    FOR EACH ROW
+EXECUTE PROCEDURE record_app_configuration_change();
+
+CREATE TRIGGER record_label_configuration_change
+    AFTER INSERT OR UPDATE OR DELETE
+    ON labels
+    FOR EACH ROW
+EXECUTE PROCEDURE record_app_configuration_change();
+
+-- Specifications do not reference their application directly, so it is resolved through their API or Event definition
+CREATE OR REPLACE FUNCTION record_spec_configuration_change() RETURNS TRIGGER AS
+$$
+DECLARE
+    changed     RECORD;
+    changed_app UUID;
+BEGIN
+    IF TG_OP = 'DELETE' THEN
+        changed := OLD;
+    ELSE
+        changed := NEW;
+    END IF;
+
+    IF changed.api_def_id IS NOT NULL THEN
+        SELECT app_id INTO changed_app FROM api_definitions WHERE id = changed.api_def_id;
+    ELSIF changed.event_def_id IS NOT NULL THEN
+        SELECT app_id INTO changed_app FROM event_api_definitions WHERE id = changed.event_def_id;
+    END IF;
+
+    -- Specifications removed together with their definition or application do not trigger a notification
+    IF changed_app IS NOT NULL AND EXISTS(SELECT 1 FROM applications WHERE id = changed_app) THEN
+        INSERT INTO app_configuration_changes (app_id, tenant_id, changed_at)
+        VALUES (changed_app, changed.tenant_id, now() AT TIME ZONE 'UTC')
+        ON CONFLICT (app_id) DO UPDATE SET changed_at = EXCLUDED.changed_at;
+    END IF;
+
+    RETURN NULL;
+END
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER record_spec_configuration_change
+    AFTER INSERT OR UPDATE OR DELETE
+    ON specifications
+    FOR EACH ROW
+EXECUTE PROCEDURE record_spec_configuration_change();
+
+CREATE TABLE webhook_deliveries
+(
+    id              UUID PRIMARY KEY CHECK (id <> '00000000-0000-0000-0000-000000000000'),
+    tenant_id       UUID         NOT NULL,
+    webhook_id      UUID         NOT NULL REFERENCES webhooks (id) ON DELETE CASCADE,
+    app_id          UUID         NOT NULL,
+    FOREIGN KEY (tenant_id, app_id) REFERENCES applications (tenant_id, id) ON DELETE CASCADE,
+    status          VARCHAR(256) NOT NULL,
+    attempts        INTEGER      NOT NULL DEFAULT 0,
+    last_error      TEXT,
+    response_code   INTEGER,
+    created_at      TIMESTAMP    NOT NULL,
+    next_attempt_at TIMESTAMP,
+    finished_at     TIMESTAMP
+);
+
+CREATE INDEX ON webhook_deliveries (next_attempt_at) WHERE status = 'PENDING';
+CREATE INDEX ON webhook_deliveries (finished_at);
+CREATE INDEX ON webhook_deliveries (webhook_id);
+
+COMMIT;
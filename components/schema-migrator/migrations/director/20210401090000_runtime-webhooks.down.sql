@@ -0,0 +1,26 @@
+BEGIN;
+
+ALTER TABLE runtimes
+    DROP COLUMN ready,
+    DROP COLUMN updated_at,
+    DROP COLUMN deleted_at,
+    DROP COLUMN error;
+
+DELETE FROM webhooks WHERE type IN ('REGISTER_RUNTIME', 'UNREGISTER_RUNTIME');
+
+ALTER TABLE webhooks
+    ALTER COLUMN type TYPE VARCHAR(255);
+
+DROP TYPE webhook_type;
+
+CREATE TYPE webhook_type AS ENUM (
+    'CONFIGURATION_CHANGED',
+    'REGISTER_APPLICATION',
+    'UNREGISTER_APPLICATION',
+    'OPEN_RESOURCE_DISCOVERY'
+    );
+
+ALTER TABLE webhooks
+    ALTER COLUMN type TYPE webhook_type USING (type::webhook_type);
+
+COMMIT;
@@ -0,0 +1,26 @@
+BEGIN;
+
+ALTER TABLE webhooks
+    ALTER COLUMN type TYPE VARCHAR(255);
+
+DROP TYPE webhook_type;
+
+CREATE TYPE webhook_type AS ENUM (
+    'CONFIGURATION_CHANGED',
+    'REGISTER_APPLICATION',
+    'UNREGISTER_APPLICATION',
+    'OPEN_RESOURCE_DISCOVERY',
+    'REGISTER_RUNTIME',
+    'UNREGISTER_RUNTIME'
+    );
+
+ALTER TABLE webhooks
+    ALTER COLUMN type TYPE webhook_type USING (type::webhook_type);
+
+ALTER TABLE runtimes
+    ADD COLUMN ready bool DEFAULT TRUE,
+    ADD COLUMN updated_at timestamp,
+    ADD COLUMN deleted_at timestamp,
+    ADD COLUMN error jsonb;
+
+COMMIT;
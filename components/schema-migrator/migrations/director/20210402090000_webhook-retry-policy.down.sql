@@ -0,0 +1,6 @@
+BEGIN;
+
+ALTER TABLE webhooks
+    DROP COLUMN retry_policy;
+
+COMMIT;
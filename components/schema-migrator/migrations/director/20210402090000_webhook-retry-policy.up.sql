@@ -0,0 +1,6 @@
+BEGIN;
+
+ALTER TABLE webhooks
+    ADD COLUMN retry_policy jsonb;
+
+COMMIT;
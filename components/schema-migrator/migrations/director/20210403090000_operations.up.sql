@@ -0,0 +1,26 @@
+BEGIN;
+
+-- Operations are not bound to their resource so that the history of a resource outlives its deletion
+CREATE TABLE operations
+(
+    id                 UUID PRIMARY KEY CHECK (id <> '00000000-0000-0000-0000-000000000000'),
+    -- operation_id is the ID assigned by the scheduler, which may be shared by subsequent operations of the same resource
+    operation_id       VARCHAR(256) NOT NULL,
+    tenant_id          UUID         NOT NULL,
+    resource_type      VARCHAR(256) NOT NULL,
+    resource_id        UUID         NOT NULL,
+    operation_type     VARCHAR(256) NOT NULL,
+    operation_category VARCHAR(256) NOT NULL,
+    correlation_id     VARCHAR(256) NOT NULL,
+    status             VARCHAR(256) NOT NULL,
+    error              TEXT,
+    webhook_results    JSONB,
+    created_at         TIMESTAMP    NOT NULL,
+    finished_at        TIMESTAMP
+);
+
+CREATE INDEX ON operations (tenant_id, resource_id, created_at);
+CREATE INDEX ON operations (resource_type, resource_id) WHERE status = 'IN_PROGRESS';
+CREATE INDEX ON operations (operation_id) WHERE status = 'IN_PROGRESS';
+
+COMMIT;
@@ -0,0 +1,30 @@
+BEGIN;
+
+-- Scheduled operations are processed by the director itself when operations are not scheduled as Kubernetes resources
+CREATE TABLE scheduled_operations
+(
+    id                 UUID PRIMARY KEY CHECK (id <> '00000000-0000-0000-0000-000000000000'),
+    resource_type      VARCHAR(256) NOT NULL,
+    resource_id        UUID         NOT NULL,
+    operation_type     VARCHAR(256) NOT NULL,
+    operation_category VARCHAR(256) NOT NULL,
+    correlation_id     VARCHAR(256) NOT NULL,
+    webhook_ids        JSONB,
+    request_object     TEXT         NOT NULL,
+    status             VARCHAR(256) NOT NULL,
+    error              TEXT,
+    webhooks           JSONB,
+    created_at         TIMESTAMP    NOT NULL,
+    next_attempt_at    TIMESTAMP    NOT NULL,
+    finished_at        TIMESTAMP,
+    -- The worker processing the operation holds a lease on it, so that no transaction is held open while its webhooks are executed
+    locked_by          VARCHAR(256),
+    locked_until       TIMESTAMP
+);
+
+-- At most one operation of a resource can be in progress
+CREATE UNIQUE INDEX ON scheduled_operations (resource_type, resource_id) WHERE status = 'IN_PROGRESS';
+CREATE INDEX ON scheduled_operations (next_attempt_at) WHERE status = 'IN_PROGRESS';
+CREATE INDEX ON scheduled_operations (resource_type, resource_id, created_at);
+
+COMMIT;
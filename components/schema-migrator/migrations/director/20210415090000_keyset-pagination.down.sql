@@ -0,0 +1,10 @@
+BEGIN;
+
+DROP INDEX IF EXISTS applications_tenant_id_id_idx;
+DROP INDEX IF EXISTS applications_tenant_id_name_id_idx;
+
+DROP INDEX IF EXISTS runtimes_tenant_id_id_idx;
+DROP INDEX IF EXISTS runtimes_tenant_id_name_id_idx;
+DROP INDEX IF EXISTS runtimes_tenant_id_creation_timestamp_id_idx;
+
+COMMIT;
@@ -0,0 +1,11 @@
+BEGIN;
+
+-- Keyset pagination orders by the requested column and uses the ID as a tie-breaker
+CREATE INDEX applications_tenant_id_id_idx ON applications (tenant_id, id);
+CREATE INDEX applications_tenant_id_name_id_idx ON applications (tenant_id, name, id);
+
+CREATE INDEX runtimes_tenant_id_id_idx ON runtimes (tenant_id, id);
+CREATE INDEX runtimes_tenant_id_name_id_idx ON runtimes (tenant_id, name, id);
+CREATE INDEX runtimes_tenant_id_creation_timestamp_id_idx ON runtimes (tenant_id, creation_timestamp, id);
+
+COMMIT;
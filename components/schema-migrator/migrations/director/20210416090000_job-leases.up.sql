@@ -0,0 +1,11 @@
+BEGIN;
+
+-- Leases of the periodic jobs which must run on a single director replica at a time
+CREATE TABLE job_leases
+(
+    name         VARCHAR(256) PRIMARY KEY,
+    locked_by    VARCHAR(256) NOT NULL,
+    locked_until TIMESTAMP    NOT NULL
+);
+
+COMMIT;
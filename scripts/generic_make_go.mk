@@ -21,6 +21,10 @@ IMG_GOPATH := /workspace/go
 IMG_GOCACHE := /root/.cache/go-build
 # VERIFY_IGNORE is a grep pattern to exclude files and directories from verification
 VERIFY_IGNORE := /vendor\|/automock
+# DOCKER_BUILD_CONTEXT is the path to the context of the image build, components which replace their dependencies with sibling modules set it to the components directory
+DOCKER_BUILD_CONTEXT ?= .
+# BUILDPACK_MOUNTS are additional volumes mounted to the buildpack container, such as the sibling modules the component depends on
+BUILDPACK_MOUNTS ?=
 
 # Other variables
 # LOCAL_DIR in a local path to scripts folder
@@ -45,7 +49,7 @@ DEPLOYMENT_NAME="compass-"$(COMPONENT_NAME)
 NAMESPACE="compass-system"
 
 # Base docker configuration
-DOCKER_CREATE_OPTS := -v $(LOCAL_DIR):$(WORKSPACE_LOCAL_DIR):delegated --rm -w $(WORKSPACE_COMPONENT_DIR) $(BUILDPACK)
+DOCKER_CREATE_OPTS := -v $(LOCAL_DIR):$(WORKSPACE_LOCAL_DIR):delegated $(BUILDPACK_MOUNTS) --rm -w $(WORKSPACE_COMPONENT_DIR) $(BUILDPACK)
 
 # Check if go is available
 ifneq (,$(shell go version 2>/dev/null))
@@ -95,7 +99,7 @@ release: verify build-image push-image
 
 .PHONY: build-image push-image
 build-image: pull-licenses
-	docker build -t $(IMG_NAME) .
+	docker build -t $(IMG_NAME) -f Dockerfile $(DOCKER_BUILD_CONTEXT)
 push-image:
 	docker tag $(IMG_NAME) $(IMG_NAME):$(TAG)
 	docker push $(IMG_NAME):$(TAG)
@@ -108,7 +112,7 @@ $(foreach t,$(MOUNT_TARGETS),$(eval $(call buildpack-mount,$(t))))
 
 # Builds new Docker image into Minikube's Docker Registry
 build-to-minikube: pull-licenses
-	@eval $$(minikube docker-env) && docker build -t $(IMG_NAME) .
+	@eval $$(minikube docker-env) && docker build -t $(IMG_NAME) -f Dockerfile $(DOCKER_BUILD_CONTEXT)
 
 build-local:
 	env CGO_ENABLED=0 go build -o $(APP_NAME) ./$(ENTRYPOINT)